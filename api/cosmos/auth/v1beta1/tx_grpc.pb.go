@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateAccountPubKey_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateAccountPubKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateAccountPubKey defines a method for rotating the pubkey of an account
+	// to a multisig pubkey, e.g. to change the members of a multisig account,
+	// while preserving the account address, number and sequence. It is only
+	// enabled when the enable_pubkey_rotation auth param is set.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateAccountPubKey(ctx context.Context, in *MsgUpdateAccountPubKey, opts ...grpc.CallOption) (*MsgUpdateAccountPubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +58,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateAccountPubKey(ctx context.Context, in *MsgUpdateAccountPubKey, opts ...grpc.CallOption) (*MsgUpdateAccountPubKeyResponse, error) {
+	out := new(MsgUpdateAccountPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateAccountPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +76,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateAccountPubKey defines a method for rotating the pubkey of an account
+	// to a multisig pubkey, e.g. to change the members of a multisig account,
+	// while preserving the account address, number and sequence. It is only
+	// enabled when the enable_pubkey_rotation auth param is set.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateAccountPubKey(context.Context, *MsgUpdateAccountPubKey) (*MsgUpdateAccountPubKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +93,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateAccountPubKey(context.Context, *MsgUpdateAccountPubKey) (*MsgUpdateAccountPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountPubKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +127,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAccountPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccountPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccountPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAccountPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccountPubKey(ctx, req.(*MsgUpdateAccountPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +156,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateAccountPubKey",
+			Handler:    _Msg_UpdateAccountPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
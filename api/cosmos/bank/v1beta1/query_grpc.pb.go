@@ -24,12 +24,16 @@ const (
 	Query_SpendableBalances_FullMethodName       = "/cosmos.bank.v1beta1.Query/SpendableBalances"
 	Query_SpendableBalanceByDenom_FullMethodName = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
 	Query_TotalSupply_FullMethodName             = "/cosmos.bank.v1beta1.Query/TotalSupply"
+	Query_SupplyByDenomPrefix_FullMethodName     = "/cosmos.bank.v1beta1.Query/SupplyByDenomPrefix"
 	Query_SupplyOf_FullMethodName                = "/cosmos.bank.v1beta1.Query/SupplyOf"
 	Query_Params_FullMethodName                  = "/cosmos.bank.v1beta1.Query/Params"
 	Query_DenomMetadata_FullMethodName           = "/cosmos.bank.v1beta1.Query/DenomMetadata"
 	Query_DenomsMetadata_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
 	Query_DenomOwners_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_SendEnabled_FullMethodName             = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_TransferVolume_FullMethodName          = "/cosmos.bank.v1beta1.Query/TransferVolume"
+	Query_Holds_FullMethodName                   = "/cosmos.bank.v1beta1.Query/Holds"
+	Query_BalanceAtHeight_FullMethodName         = "/cosmos.bank.v1beta1.Query/BalanceAtHeight"
 )
 
 // QueryClient is the client API for Query service.
@@ -61,9 +65,21 @@ type QueryClient interface {
 	SpendableBalanceByDenom(ctx context.Context, in *QuerySpendableBalanceByDenomRequest, opts ...grpc.CallOption) (*QuerySpendableBalanceByDenomResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	//
+	// The supplies are ordered by the bytes of their denom. When paginating with
+	// pagination.key, the next_key of a page is the denom of the first supply of
+	// the next page, so that the pages neither skip nor duplicate a denom even
+	// if denoms are added between the requests. Offset based pagination doesn't
+	// have this guarantee.
+	//
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
+	// SupplyByDenomPrefix queries the total supply of the coins whose denom starts
+	// with a prefix, e.g. "ibc/", iterating only over the matching denoms. The
+	// supplies are ordered and paginated as in TotalSupply.
+	//
+	// Since: cosmos-sdk 0.48
+	SupplyByDenomPrefix(ctx context.Context, in *QuerySupplyByDenomPrefixRequest, opts ...grpc.CallOption) (*QuerySupplyByDenomPrefixResponse, error)
 	// SupplyOf queries the supply of a single coin.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -92,6 +108,21 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// TransferVolume queries the volume of a denom transferred between accounts over the last blocks.
+	// The volume is only aggregated while the track_transfer_volume param is enabled.
+	TransferVolume(ctx context.Context, in *QueryTransferVolumeRequest, opts ...grpc.CallOption) (*QueryTransferVolumeResponse, error)
+	// Holds queries the coins of an account placed on hold by modules.
+	Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error)
+	// BalanceAtHeight queries the balance of a single coin for a single account
+	// at a past height. Unlike a Balance query made at that height, it fails with
+	// an error telling the earliest height which can be queried when the height
+	// is pruned.
+	//
+	// It depends on the pruning of the queried node and is thus not module query
+	// safe.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceAtHeight(ctx context.Context, in *QueryBalanceAtHeightRequest, opts ...grpc.CallOption) (*QueryBalanceAtHeightResponse, error)
 }
 
 type queryClient struct {
@@ -147,6 +178,15 @@ func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyReque
 	return out, nil
 }
 
+func (c *queryClient) SupplyByDenomPrefix(ctx context.Context, in *QuerySupplyByDenomPrefixRequest, opts ...grpc.CallOption) (*QuerySupplyByDenomPrefixResponse, error) {
+	out := new(QuerySupplyByDenomPrefixResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyByDenomPrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error) {
 	out := new(QuerySupplyOfResponse)
 	err := c.cc.Invoke(ctx, Query_SupplyOf_FullMethodName, in, out, opts...)
@@ -201,6 +241,33 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) TransferVolume(ctx context.Context, in *QueryTransferVolumeRequest, opts ...grpc.CallOption) (*QueryTransferVolumeResponse, error) {
+	out := new(QueryTransferVolumeResponse)
+	err := c.cc.Invoke(ctx, Query_TransferVolume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error) {
+	out := new(QueryHoldsResponse)
+	err := c.cc.Invoke(ctx, Query_Holds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceAtHeight(ctx context.Context, in *QueryBalanceAtHeightRequest, opts ...grpc.CallOption) (*QueryBalanceAtHeightResponse, error) {
+	out := new(QueryBalanceAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -230,9 +297,21 @@ type QueryServer interface {
 	SpendableBalanceByDenom(context.Context, *QuerySpendableBalanceByDenomRequest) (*QuerySpendableBalanceByDenomResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	//
+	// The supplies are ordered by the bytes of their denom. When paginating with
+	// pagination.key, the next_key of a page is the denom of the first supply of
+	// the next page, so that the pages neither skip nor duplicate a denom even
+	// if denoms are added between the requests. Offset based pagination doesn't
+	// have this guarantee.
+	//
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
+	// SupplyByDenomPrefix queries the total supply of the coins whose denom starts
+	// with a prefix, e.g. "ibc/", iterating only over the matching denoms. The
+	// supplies are ordered and paginated as in TotalSupply.
+	//
+	// Since: cosmos-sdk 0.48
+	SupplyByDenomPrefix(context.Context, *QuerySupplyByDenomPrefixRequest) (*QuerySupplyByDenomPrefixResponse, error)
 	// SupplyOf queries the supply of a single coin.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -261,6 +340,21 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// TransferVolume queries the volume of a denom transferred between accounts over the last blocks.
+	// The volume is only aggregated while the track_transfer_volume param is enabled.
+	TransferVolume(context.Context, *QueryTransferVolumeRequest) (*QueryTransferVolumeResponse, error)
+	// Holds queries the coins of an account placed on hold by modules.
+	Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error)
+	// BalanceAtHeight queries the balance of a single coin for a single account
+	// at a past height. Unlike a Balance query made at that height, it fails with
+	// an error telling the earliest height which can be queried when the height
+	// is pruned.
+	//
+	// It depends on the pruning of the queried node and is thus not module query
+	// safe.
+	//
+	// Since: cosmos-sdk 0.48
+	BalanceAtHeight(context.Context, *QueryBalanceAtHeightRequest) (*QueryBalanceAtHeightResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -283,6 +377,9 @@ func (UnimplementedQueryServer) SpendableBalanceByDenom(context.Context, *QueryS
 func (UnimplementedQueryServer) TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
+func (UnimplementedQueryServer) SupplyByDenomPrefix(context.Context, *QuerySupplyByDenomPrefixRequest) (*QuerySupplyByDenomPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyByDenomPrefix not implemented")
+}
 func (UnimplementedQueryServer) SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
@@ -301,6 +398,15 @@ func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRe
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) TransferVolume(context.Context, *QueryTransferVolumeRequest) (*QueryTransferVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVolume not implemented")
+}
+func (UnimplementedQueryServer) Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holds not implemented")
+}
+func (UnimplementedQueryServer) BalanceAtHeight(context.Context, *QueryBalanceAtHeightRequest) (*QueryBalanceAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceAtHeight not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -404,6 +510,24 @@ func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyByDenomPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyByDenomPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyByDenomPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyByDenomPrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyByDenomPrefix(ctx, req.(*QuerySupplyByDenomPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySupplyOfRequest)
 	if err := dec(in); err != nil {
@@ -512,6 +636,60 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TransferVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferVolume(ctx, req.(*QueryTransferVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Holds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Holds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holds(ctx, req.(*QueryHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAtHeight(ctx, req.(*QueryBalanceAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -539,6 +717,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalSupply",
 			Handler:    _Query_TotalSupply_Handler,
 		},
+		{
+			MethodName: "SupplyByDenomPrefix",
+			Handler:    _Query_SupplyByDenomPrefix_Handler,
+		},
 		{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
@@ -563,6 +745,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "TransferVolume",
+			Handler:    _Query_TransferVolume_Handler,
+		},
+		{
+			MethodName: "Holds",
+			Handler:    _Query_Holds_Handler,
+		},
+		{
+			MethodName: "BalanceAtHeight",
+			Handler:    _Query_BalanceAtHeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Config_FullMethodName = "/cosmos.base.node.v1beta1.Service/Config"
-	Service_Status_FullMethodName = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_Config_FullMethodName            = "/cosmos.base.node.v1beta1.Service/Config"
+	Service_Status_FullMethodName            = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_LatestBlockHeight_FullMethodName = "/cosmos.base.node.v1beta1.Service/LatestBlockHeight"
+	Service_BlockResults_FullMethodName      = "/cosmos.base.node.v1beta1.Service/BlockResults"
+	Service_TxsByEvents_FullMethodName       = "/cosmos.base.node.v1beta1.Service/TxsByEvents"
+	Service_StoreStats_FullMethodName        = "/cosmos.base.node.v1beta1.Service/StoreStats"
+	Service_SnapshotProgress_FullMethodName  = "/cosmos.base.node.v1beta1.Service/SnapshotProgress"
+	Service_Ready_FullMethodName             = "/cosmos.base.node.v1beta1.Service/Ready"
 )
 
 // ServiceClient is the client API for Service service.
@@ -31,6 +37,34 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// LatestBlockHeight queries for the height of the latest block of the node.
+	//
+	// Since: cosmos-sdk 0.50
+	LatestBlockHeight(ctx context.Context, in *LatestBlockHeightRequest, opts ...grpc.CallOption) (*LatestBlockHeightResponse, error)
+	// BlockResults queries for the results of the execution of a block.
+	//
+	// Since: cosmos-sdk 0.50
+	BlockResults(ctx context.Context, in *BlockResultsRequest, opts ...grpc.CallOption) (*BlockResultsResponse, error)
+	// TxsByEvents queries for the txs matching an events query.
+	//
+	// Since: cosmos-sdk 0.50
+	TxsByEvents(ctx context.Context, in *TxsByEventsRequest, opts ...grpc.CallOption) (*TxsByEventsResponse, error)
+	// StoreStats queries for the number of keys and the approximate size of each
+	// mounted store at the latest height. As it scans the stores, it must be
+	// enabled in the node configuration.
+	//
+	// Since: cosmos-sdk 0.50
+	StoreStats(ctx context.Context, in *StoreStatsRequest, opts ...grpc.CallOption) (*StoreStatsResponse, error)
+	// SnapshotProgress queries for the progress of the state-sync snapshot being
+	// created or restored by the node, if any.
+	//
+	// Since: cosmos-sdk 0.50
+	SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*SnapshotProgressResponse, error)
+	// Ready queries whether the node is ready to serve queries, i.e. it is not
+	// catching up, migrating its state in an upgrade or shutting down.
+	//
+	// Since: cosmos-sdk 0.50
+	Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error)
 }
 
 type serviceClient struct {
@@ -59,6 +93,60 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) LatestBlockHeight(ctx context.Context, in *LatestBlockHeightRequest, opts ...grpc.CallOption) (*LatestBlockHeightResponse, error) {
+	out := new(LatestBlockHeightResponse)
+	err := c.cc.Invoke(ctx, Service_LatestBlockHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) BlockResults(ctx context.Context, in *BlockResultsRequest, opts ...grpc.CallOption) (*BlockResultsResponse, error) {
+	out := new(BlockResultsResponse)
+	err := c.cc.Invoke(ctx, Service_BlockResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) TxsByEvents(ctx context.Context, in *TxsByEventsRequest, opts ...grpc.CallOption) (*TxsByEventsResponse, error) {
+	out := new(TxsByEventsResponse)
+	err := c.cc.Invoke(ctx, Service_TxsByEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) StoreStats(ctx context.Context, in *StoreStatsRequest, opts ...grpc.CallOption) (*StoreStatsResponse, error) {
+	out := new(StoreStatsResponse)
+	err := c.cc.Invoke(ctx, Service_StoreStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SnapshotProgress(ctx context.Context, in *SnapshotProgressRequest, opts ...grpc.CallOption) (*SnapshotProgressResponse, error) {
+	out := new(SnapshotProgressResponse)
+	err := c.cc.Invoke(ctx, Service_SnapshotProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Ready(ctx context.Context, in *ReadyRequest, opts ...grpc.CallOption) (*ReadyResponse, error) {
+	out := new(ReadyResponse)
+	err := c.cc.Invoke(ctx, Service_Ready_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -67,6 +155,34 @@ type ServiceServer interface {
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// LatestBlockHeight queries for the height of the latest block of the node.
+	//
+	// Since: cosmos-sdk 0.50
+	LatestBlockHeight(context.Context, *LatestBlockHeightRequest) (*LatestBlockHeightResponse, error)
+	// BlockResults queries for the results of the execution of a block.
+	//
+	// Since: cosmos-sdk 0.50
+	BlockResults(context.Context, *BlockResultsRequest) (*BlockResultsResponse, error)
+	// TxsByEvents queries for the txs matching an events query.
+	//
+	// Since: cosmos-sdk 0.50
+	TxsByEvents(context.Context, *TxsByEventsRequest) (*TxsByEventsResponse, error)
+	// StoreStats queries for the number of keys and the approximate size of each
+	// mounted store at the latest height. As it scans the stores, it must be
+	// enabled in the node configuration.
+	//
+	// Since: cosmos-sdk 0.50
+	StoreStats(context.Context, *StoreStatsRequest) (*StoreStatsResponse, error)
+	// SnapshotProgress queries for the progress of the state-sync snapshot being
+	// created or restored by the node, if any.
+	//
+	// Since: cosmos-sdk 0.50
+	SnapshotProgress(context.Context, *SnapshotProgressRequest) (*SnapshotProgressResponse, error)
+	// Ready queries whether the node is ready to serve queries, i.e. it is not
+	// catching up, migrating its state in an upgrade or shutting down.
+	//
+	// Since: cosmos-sdk 0.50
+	Ready(context.Context, *ReadyRequest) (*ReadyResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -80,6 +196,24 @@ func (UnimplementedServiceServer) Config(context.Context, *ConfigRequest) (*Conf
 func (UnimplementedServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedServiceServer) LatestBlockHeight(context.Context, *LatestBlockHeightRequest) (*LatestBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestBlockHeight not implemented")
+}
+func (UnimplementedServiceServer) BlockResults(context.Context, *BlockResultsRequest) (*BlockResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockResults not implemented")
+}
+func (UnimplementedServiceServer) TxsByEvents(context.Context, *TxsByEventsRequest) (*TxsByEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByEvents not implemented")
+}
+func (UnimplementedServiceServer) StoreStats(context.Context, *StoreStatsRequest) (*StoreStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreStats not implemented")
+}
+func (UnimplementedServiceServer) SnapshotProgress(context.Context, *SnapshotProgressRequest) (*SnapshotProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotProgress not implemented")
+}
+func (UnimplementedServiceServer) Ready(context.Context, *ReadyRequest) (*ReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ready not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +263,114 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_LatestBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).LatestBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_LatestBlockHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).LatestBlockHeight(ctx, req.(*LatestBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_BlockResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BlockResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_BlockResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BlockResults(ctx, req.(*BlockResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_TxsByEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxsByEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TxsByEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_TxsByEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TxsByEvents(ctx, req.(*TxsByEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_StoreStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StoreStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_StoreStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StoreStats(ctx, req.(*StoreStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SnapshotProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SnapshotProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SnapshotProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SnapshotProgress(ctx, req.(*SnapshotProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Ready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Ready(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Ready_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Ready(ctx, req.(*ReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +386,30 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "LatestBlockHeight",
+			Handler:    _Service_LatestBlockHeight_Handler,
+		},
+		{
+			MethodName: "BlockResults",
+			Handler:    _Service_BlockResults_Handler,
+		},
+		{
+			MethodName: "TxsByEvents",
+			Handler:    _Service_TxsByEvents_Handler,
+		},
+		{
+			MethodName: "StoreStats",
+			Handler:    _Service_StoreStats_Handler,
+		},
+		{
+			MethodName: "SnapshotProgress",
+			Handler:    _Service_SnapshotProgress_Handler,
+		},
+		{
+			MethodName: "Ready",
+			Handler:    _Service_Ready_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
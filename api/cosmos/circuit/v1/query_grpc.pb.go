@@ -22,6 +22,7 @@ const (
 	Query_Account_FullMethodName      = "/cosmos.circuit.v1.Query/Account"
 	Query_Accounts_FullMethodName     = "/cosmos.circuit.v1.Query/Accounts"
 	Query_DisabledList_FullMethodName = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_CheckMsgs_FullMethodName    = "/cosmos.circuit.v1.Query/CheckMsgs"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,10 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// CheckMsgs returns whether the given Msg type URLs, or the Msgs of the given
+	// tx including the ones nested in authz MsgExec's, are allowed by the circuit
+	// breaker.
+	CheckMsgs(ctx context.Context, in *QueryCheckMsgsRequest, opts ...grpc.CallOption) (*CheckMsgsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +76,15 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) CheckMsgs(ctx context.Context, in *QueryCheckMsgsRequest, opts ...grpc.CallOption) (*CheckMsgsResponse, error) {
+	out := new(CheckMsgsResponse)
+	err := c.cc.Invoke(ctx, Query_CheckMsgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +95,10 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// CheckMsgs returns whether the given Msg type URLs, or the Msgs of the given
+	// tx including the ones nested in authz MsgExec's, are allowed by the circuit
+	// breaker.
+	CheckMsgs(context.Context, *QueryCheckMsgsRequest) (*CheckMsgsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +115,9 @@ func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest)
 func (UnimplementedQueryServer) DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (UnimplementedQueryServer) CheckMsgs(context.Context, *QueryCheckMsgsRequest) (*CheckMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMsgs not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +185,24 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CheckMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CheckMsgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckMsgs(ctx, req.(*QueryCheckMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +222,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "CheckMsgs",
+			Handler:    _Query_CheckMsgs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
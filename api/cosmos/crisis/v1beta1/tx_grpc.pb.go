@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_VerifyInvariant_FullMethodName = "/cosmos.crisis.v1beta1.Msg/VerifyInvariant"
-	Msg_UpdateParams_FullMethodName    = "/cosmos.crisis.v1beta1.Msg/UpdateParams"
+	Msg_VerifyInvariant_FullMethodName     = "/cosmos.crisis.v1beta1.Msg/VerifyInvariant"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.crisis.v1beta1.Msg/UpdateParams"
+	Msg_VerifyAllInvariants_FullMethodName = "/cosmos.crisis.v1beta1.Msg/VerifyAllInvariants"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +35,8 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// VerifyAllInvariants defines a method to verify all the registered invariants.
+	VerifyAllInvariants(ctx context.Context, in *MsgVerifyAllInvariants, opts ...grpc.CallOption) (*MsgVerifyAllInvariantsResponse, error)
 }
 
 type msgClient struct {
@@ -62,6 +65,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) VerifyAllInvariants(ctx context.Context, in *MsgVerifyAllInvariants, opts ...grpc.CallOption) (*MsgVerifyAllInvariantsResponse, error) {
+	out := new(MsgVerifyAllInvariantsResponse)
+	err := c.cc.Invoke(ctx, Msg_VerifyAllInvariants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -73,6 +85,8 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// VerifyAllInvariants defines a method to verify all the registered invariants.
+	VerifyAllInvariants(context.Context, *MsgVerifyAllInvariants) (*MsgVerifyAllInvariantsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -86,6 +100,9 @@ func (UnimplementedMsgServer) VerifyInvariant(context.Context, *MsgVerifyInvaria
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) VerifyAllInvariants(context.Context, *MsgVerifyAllInvariants) (*MsgVerifyAllInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAllInvariants not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -135,6 +152,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VerifyAllInvariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyAllInvariants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyAllInvariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VerifyAllInvariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyAllInvariants(ctx, req.(*MsgVerifyAllInvariants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,6 +185,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "VerifyAllInvariants",
+			Handler:    _Msg_VerifyAllInvariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/crisis/v1beta1/tx.proto",
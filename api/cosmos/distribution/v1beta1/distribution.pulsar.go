@@ -17,11 +17,14 @@ import (
 )
 
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_community_tax         protoreflect.FieldDescriptor
-	fd_Params_base_proposer_reward  protoreflect.FieldDescriptor
-	fd_Params_bonus_proposer_reward protoreflect.FieldDescriptor
-	fd_Params_withdraw_addr_enabled protoreflect.FieldDescriptor
+	md_Params                                  protoreflect.MessageDescriptor
+	fd_Params_community_tax                    protoreflect.FieldDescriptor
+	fd_Params_base_proposer_reward             protoreflect.FieldDescriptor
+	fd_Params_bonus_proposer_reward            protoreflect.FieldDescriptor
+	fd_Params_withdraw_addr_enabled            protoreflect.FieldDescriptor
+	fd_Params_signing_performance_reward_share protoreflect.FieldDescriptor
+	fd_Params_signing_performance_window       protoreflect.FieldDescriptor
+	fd_Params_max_withdraw_per_msg             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -31,6 +34,9 @@ func init() {
 	fd_Params_base_proposer_reward = md_Params.Fields().ByName("base_proposer_reward")
 	fd_Params_bonus_proposer_reward = md_Params.Fields().ByName("bonus_proposer_reward")
 	fd_Params_withdraw_addr_enabled = md_Params.Fields().ByName("withdraw_addr_enabled")
+	fd_Params_signing_performance_reward_share = md_Params.Fields().ByName("signing_performance_reward_share")
+	fd_Params_signing_performance_window = md_Params.Fields().ByName("signing_performance_window")
+	fd_Params_max_withdraw_per_msg = md_Params.Fields().ByName("max_withdraw_per_msg")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -122,6 +128,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SigningPerformanceRewardShare != "" {
+		value := protoreflect.ValueOfString(x.SigningPerformanceRewardShare)
+		if !f(fd_Params_signing_performance_reward_share, value) {
+			return
+		}
+	}
+	if x.SigningPerformanceWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SigningPerformanceWindow)
+		if !f(fd_Params_signing_performance_window, value) {
+			return
+		}
+	}
+	if x.MaxWithdrawPerMsg != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxWithdrawPerMsg)
+		if !f(fd_Params_max_withdraw_per_msg, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -145,6 +169,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BonusProposerReward != ""
 	case "cosmos.distribution.v1beta1.Params.withdraw_addr_enabled":
 		return x.WithdrawAddrEnabled != false
+	case "cosmos.distribution.v1beta1.Params.signing_performance_reward_share":
+		return x.SigningPerformanceRewardShare != ""
+	case "cosmos.distribution.v1beta1.Params.signing_performance_window":
+		return x.SigningPerformanceWindow != int64(0)
+	case "cosmos.distribution.v1beta1.Params.max_withdraw_per_msg":
+		return x.MaxWithdrawPerMsg != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.Params"))
@@ -169,6 +199,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.BonusProposerReward = ""
 	case "cosmos.distribution.v1beta1.Params.withdraw_addr_enabled":
 		x.WithdrawAddrEnabled = false
+	case "cosmos.distribution.v1beta1.Params.signing_performance_reward_share":
+		x.SigningPerformanceRewardShare = ""
+	case "cosmos.distribution.v1beta1.Params.signing_performance_window":
+		x.SigningPerformanceWindow = int64(0)
+	case "cosmos.distribution.v1beta1.Params.max_withdraw_per_msg":
+		x.MaxWithdrawPerMsg = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.Params"))
@@ -197,6 +233,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.distribution.v1beta1.Params.withdraw_addr_enabled":
 		value := x.WithdrawAddrEnabled
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.distribution.v1beta1.Params.signing_performance_reward_share":
+		value := x.SigningPerformanceRewardShare
+		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.Params.signing_performance_window":
+		value := x.SigningPerformanceWindow
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.distribution.v1beta1.Params.max_withdraw_per_msg":
+		value := x.MaxWithdrawPerMsg
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.Params"))
@@ -225,6 +270,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.BonusProposerReward = value.Interface().(string)
 	case "cosmos.distribution.v1beta1.Params.withdraw_addr_enabled":
 		x.WithdrawAddrEnabled = value.Bool()
+	case "cosmos.distribution.v1beta1.Params.signing_performance_reward_share":
+		x.SigningPerformanceRewardShare = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.Params.signing_performance_window":
+		x.SigningPerformanceWindow = value.Int()
+	case "cosmos.distribution.v1beta1.Params.max_withdraw_per_msg":
+		x.MaxWithdrawPerMsg = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.Params"))
@@ -253,6 +304,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field bonus_proposer_reward of message cosmos.distribution.v1beta1.Params is not mutable"))
 	case "cosmos.distribution.v1beta1.Params.withdraw_addr_enabled":
 		panic(fmt.Errorf("field withdraw_addr_enabled of message cosmos.distribution.v1beta1.Params is not mutable"))
+	case "cosmos.distribution.v1beta1.Params.signing_performance_reward_share":
+		panic(fmt.Errorf("field signing_performance_reward_share of message cosmos.distribution.v1beta1.Params is not mutable"))
+	case "cosmos.distribution.v1beta1.Params.signing_performance_window":
+		panic(fmt.Errorf("field signing_performance_window of message cosmos.distribution.v1beta1.Params is not mutable"))
+	case "cosmos.distribution.v1beta1.Params.max_withdraw_per_msg":
+		panic(fmt.Errorf("field max_withdraw_per_msg of message cosmos.distribution.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.Params"))
@@ -274,6 +331,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.distribution.v1beta1.Params.withdraw_addr_enabled":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.distribution.v1beta1.Params.signing_performance_reward_share":
+		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.Params.signing_performance_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.distribution.v1beta1.Params.max_withdraw_per_msg":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.Params"))
@@ -358,6 +421,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.WithdrawAddrEnabled {
 			n += 2
 		}
+		l = len(x.SigningPerformanceRewardShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SigningPerformanceWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigningPerformanceWindow))
+		}
+		if x.MaxWithdrawPerMsg != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxWithdrawPerMsg))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -387,6 +460,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxWithdrawPerMsg != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxWithdrawPerMsg))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SigningPerformanceWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigningPerformanceWindow))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.SigningPerformanceRewardShare) > 0 {
+			i -= len(x.SigningPerformanceRewardShare)
+			copy(dAtA[i:], x.SigningPerformanceRewardShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SigningPerformanceRewardShare)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.WithdrawAddrEnabled {
 			i--
 			if x.WithdrawAddrEnabled {
@@ -583,6 +673,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.WithdrawAddrEnabled = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningPerformanceRewardShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SigningPerformanceRewardShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigningPerformanceWindow", wireType)
+				}
+				x.SigningPerformanceWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigningPerformanceWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawPerMsg", wireType)
+				}
+				x.MaxWithdrawPerMsg = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxWithdrawPerMsg |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -618,79 +778,32 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorHistoricalRewards_1_list)(nil)
-
-type _ValidatorHistoricalRewards_1_list struct {
-	list *[]*v1beta1.DecCoin
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorHistoricalRewards_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ValidatorHistoricalRewards                         protoreflect.MessageDescriptor
-	fd_ValidatorHistoricalRewards_cumulative_reward_ratio protoreflect.FieldDescriptor
-	fd_ValidatorHistoricalRewards_reference_count         protoreflect.FieldDescriptor
+	md_ValidatorSignatureWindow              protoreflect.MessageDescriptor
+	fd_ValidatorSignatureWindow_window       protoreflect.FieldDescriptor
+	fd_ValidatorSignatureWindow_index        protoreflect.FieldDescriptor
+	fd_ValidatorSignatureWindow_missed       protoreflect.FieldDescriptor
+	fd_ValidatorSignatureWindow_missed_count protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_ValidatorHistoricalRewards = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorHistoricalRewards")
-	fd_ValidatorHistoricalRewards_cumulative_reward_ratio = md_ValidatorHistoricalRewards.Fields().ByName("cumulative_reward_ratio")
-	fd_ValidatorHistoricalRewards_reference_count = md_ValidatorHistoricalRewards.Fields().ByName("reference_count")
+	md_ValidatorSignatureWindow = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorSignatureWindow")
+	fd_ValidatorSignatureWindow_window = md_ValidatorSignatureWindow.Fields().ByName("window")
+	fd_ValidatorSignatureWindow_index = md_ValidatorSignatureWindow.Fields().ByName("index")
+	fd_ValidatorSignatureWindow_missed = md_ValidatorSignatureWindow.Fields().ByName("missed")
+	fd_ValidatorSignatureWindow_missed_count = md_ValidatorSignatureWindow.Fields().ByName("missed_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorHistoricalRewards)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorSignatureWindow)(nil)
 
-type fastReflection_ValidatorHistoricalRewards ValidatorHistoricalRewards
+type fastReflection_ValidatorSignatureWindow ValidatorSignatureWindow
 
-func (x *ValidatorHistoricalRewards) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorHistoricalRewards)(x)
+func (x *ValidatorSignatureWindow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSignatureWindow)(x)
 }
 
-func (x *ValidatorHistoricalRewards) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorSignatureWindow) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -702,43 +815,43 @@ func (x *ValidatorHistoricalRewards) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorHistoricalRewards_messageType fastReflection_ValidatorHistoricalRewards_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorHistoricalRewards_messageType{}
+var _fastReflection_ValidatorSignatureWindow_messageType fastReflection_ValidatorSignatureWindow_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSignatureWindow_messageType{}
 
-type fastReflection_ValidatorHistoricalRewards_messageType struct{}
+type fastReflection_ValidatorSignatureWindow_messageType struct{}
 
-func (x fastReflection_ValidatorHistoricalRewards_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorHistoricalRewards)(nil)
+func (x fastReflection_ValidatorSignatureWindow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSignatureWindow)(nil)
 }
-func (x fastReflection_ValidatorHistoricalRewards_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorHistoricalRewards)
+func (x fastReflection_ValidatorSignatureWindow_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSignatureWindow)
 }
-func (x fastReflection_ValidatorHistoricalRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorHistoricalRewards
+func (x fastReflection_ValidatorSignatureWindow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSignatureWindow
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorHistoricalRewards) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorHistoricalRewards
+func (x *fastReflection_ValidatorSignatureWindow) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSignatureWindow
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorHistoricalRewards) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorHistoricalRewards_messageType
+func (x *fastReflection_ValidatorSignatureWindow) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSignatureWindow_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorHistoricalRewards) New() protoreflect.Message {
-	return new(fastReflection_ValidatorHistoricalRewards)
+func (x *fastReflection_ValidatorSignatureWindow) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSignatureWindow)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorHistoricalRewards) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorHistoricalRewards)(x)
+func (x *fastReflection_ValidatorSignatureWindow) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSignatureWindow)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -746,16 +859,28 @@ func (x *fastReflection_ValidatorHistoricalRewards) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorHistoricalRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.CumulativeRewardRatio) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatio})
-		if !f(fd_ValidatorHistoricalRewards_cumulative_reward_ratio, value) {
+func (x *fastReflection_ValidatorSignatureWindow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Window)
+		if !f(fd_ValidatorSignatureWindow_window, value) {
 			return
 		}
 	}
-	if x.ReferenceCount != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.ReferenceCount)
-		if !f(fd_ValidatorHistoricalRewards_reference_count, value) {
+	if x.Index != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Index)
+		if !f(fd_ValidatorSignatureWindow_index, value) {
+			return
+		}
+	}
+	if len(x.Missed) != 0 {
+		value := protoreflect.ValueOfBytes(x.Missed)
+		if !f(fd_ValidatorSignatureWindow_missed, value) {
+			return
+		}
+	}
+	if x.MissedCount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MissedCount)
+		if !f(fd_ValidatorSignatureWindow_missed_count, value) {
 			return
 		}
 	}
@@ -772,17 +897,21 @@ func (x *fastReflection_ValidatorHistoricalRewards) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorHistoricalRewards) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorSignatureWindow) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
-		return len(x.CumulativeRewardRatio) != 0
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
-		return x.ReferenceCount != uint32(0)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.window":
+		return x.Window != int64(0)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.index":
+		return x.Index != int64(0)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed":
+		return len(x.Missed) != 0
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed_count":
+		return x.MissedCount != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSignatureWindow"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSignatureWindow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -792,17 +921,21 @@ func (x *fastReflection_ValidatorHistoricalRewards) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorSignatureWindow) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
-		x.CumulativeRewardRatio = nil
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
-		x.ReferenceCount = uint32(0)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.window":
+		x.Window = int64(0)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.index":
+		x.Index = int64(0)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed":
+		x.Missed = nil
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed_count":
+		x.MissedCount = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSignatureWindow"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSignatureWindow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -812,22 +945,25 @@ func (x *fastReflection_ValidatorHistoricalRewards) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorHistoricalRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSignatureWindow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
-		if len(x.CumulativeRewardRatio) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{})
-		}
-		listValue := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatio}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
-		value := x.ReferenceCount
-		return protoreflect.ValueOfUint32(value)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.window":
+		value := x.Window
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.index":
+		value := x.Index
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed":
+		value := x.Missed
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed_count":
+		value := x.MissedCount
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSignatureWindow"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSignatureWindow does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -841,19 +977,21 @@ func (x *fastReflection_ValidatorHistoricalRewards) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorSignatureWindow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
-		lv := value.List()
-		clv := lv.(*_ValidatorHistoricalRewards_1_list)
-		x.CumulativeRewardRatio = *clv.list
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
-		x.ReferenceCount = uint32(value.Uint())
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.window":
+		x.Window = value.Int()
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.index":
+		x.Index = value.Int()
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed":
+		x.Missed = value.Bytes()
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed_count":
+		x.MissedCount = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSignatureWindow"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSignatureWindow does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -867,49 +1005,52 @@ func (x *fastReflection_ValidatorHistoricalRewards) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSignatureWindow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
-		if x.CumulativeRewardRatio == nil {
-			x.CumulativeRewardRatio = []*v1beta1.DecCoin{}
-		}
-		value := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatio}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
-		panic(fmt.Errorf("field reference_count of message cosmos.distribution.v1beta1.ValidatorHistoricalRewards is not mutable"))
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.window":
+		panic(fmt.Errorf("field window of message cosmos.distribution.v1beta1.ValidatorSignatureWindow is not mutable"))
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.index":
+		panic(fmt.Errorf("field index of message cosmos.distribution.v1beta1.ValidatorSignatureWindow is not mutable"))
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed":
+		panic(fmt.Errorf("field missed of message cosmos.distribution.v1beta1.ValidatorSignatureWindow is not mutable"))
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed_count":
+		panic(fmt.Errorf("field missed_count of message cosmos.distribution.v1beta1.ValidatorSignatureWindow is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSignatureWindow"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSignatureWindow does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorHistoricalRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSignatureWindow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &list})
-	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.index":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.distribution.v1beta1.ValidatorSignatureWindow.missed_count":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSignatureWindow"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSignatureWindow does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorHistoricalRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorSignatureWindow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorHistoricalRewards", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorSignatureWindow", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -917,7 +1058,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorHistoricalRewards) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorSignatureWindow) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -928,7 +1069,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorHistoricalRewards) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorSignatureWindow) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -940,7 +1081,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorHistoricalRewards) IsValid() bool {
+func (x *fastReflection_ValidatorSignatureWindow) IsValid() bool {
 	return x != nil
 }
 
@@ -950,9 +1091,9 @@ func (x *fastReflection_ValidatorHistoricalRewards) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorSignatureWindow) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorHistoricalRewards)
+		x := input.Message.Interface().(*ValidatorSignatureWindow)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -964,14 +1105,18 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if len(x.CumulativeRewardRatio) > 0 {
-			for _, e := range x.CumulativeRewardRatio {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
 		}
-		if x.ReferenceCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.ReferenceCount))
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Missed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MissedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -983,7 +1128,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorHistoricalRewards)
+		x := input.Message.Interface().(*ValidatorSignatureWindow)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1002,26 +1147,27 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ReferenceCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferenceCount))
+		if x.MissedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedCount))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x20
 		}
-		if len(x.CumulativeRewardRatio) > 0 {
-			for iNdEx := len(x.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CumulativeRewardRatio[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Missed) > 0 {
+			i -= len(x.Missed)
+			copy(dAtA[i:], x.Missed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Missed)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1034,7 +1180,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorHistoricalRewards)
+		x := input.Message.Interface().(*ValidatorSignatureWindow)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1066,17 +1212,55 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSignatureWindow: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSignatureWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1086,31 +1270,31 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CumulativeRewardRatio = append(x.CumulativeRewardRatio, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CumulativeRewardRatio[len(x.CumulativeRewardRatio)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Missed = append(x.Missed[:0], dAtA[iNdEx:postIndex]...)
+				if x.Missed == nil {
+					x.Missed = []byte{}
 				}
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
 				}
-				x.ReferenceCount = 0
+				x.MissedCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1120,7 +1304,7 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ReferenceCount |= uint32(b&0x7F) << shift
+					x.MissedCount |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1160,79 +1344,79 @@ func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorCurrentRewards_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorHistoricalRewards_1_list)(nil)
 
-type _ValidatorCurrentRewards_1_list struct {
+type _ValidatorHistoricalRewards_1_list struct {
 	list *[]*v1beta1.DecCoin
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Len() int {
+func (x *_ValidatorHistoricalRewards_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorHistoricalRewards_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorHistoricalRewards_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorHistoricalRewards_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorCurrentRewards_1_list) AppendMutable() protoreflect.Value {
+func (x *_ValidatorHistoricalRewards_1_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorCurrentRewards_1_list) Truncate(n int) {
+func (x *_ValidatorHistoricalRewards_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorCurrentRewards_1_list) NewElement() protoreflect.Value {
+func (x *_ValidatorHistoricalRewards_1_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorCurrentRewards_1_list) IsValid() bool {
+func (x *_ValidatorHistoricalRewards_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorCurrentRewards         protoreflect.MessageDescriptor
-	fd_ValidatorCurrentRewards_rewards protoreflect.FieldDescriptor
-	fd_ValidatorCurrentRewards_period  protoreflect.FieldDescriptor
+	md_ValidatorHistoricalRewards                         protoreflect.MessageDescriptor
+	fd_ValidatorHistoricalRewards_cumulative_reward_ratio protoreflect.FieldDescriptor
+	fd_ValidatorHistoricalRewards_reference_count         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_ValidatorCurrentRewards = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorCurrentRewards")
-	fd_ValidatorCurrentRewards_rewards = md_ValidatorCurrentRewards.Fields().ByName("rewards")
-	fd_ValidatorCurrentRewards_period = md_ValidatorCurrentRewards.Fields().ByName("period")
+	md_ValidatorHistoricalRewards = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorHistoricalRewards")
+	fd_ValidatorHistoricalRewards_cumulative_reward_ratio = md_ValidatorHistoricalRewards.Fields().ByName("cumulative_reward_ratio")
+	fd_ValidatorHistoricalRewards_reference_count = md_ValidatorHistoricalRewards.Fields().ByName("reference_count")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorCurrentRewards)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorHistoricalRewards)(nil)
 
-type fastReflection_ValidatorCurrentRewards ValidatorCurrentRewards
+type fastReflection_ValidatorHistoricalRewards ValidatorHistoricalRewards
 
-func (x *ValidatorCurrentRewards) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorCurrentRewards)(x)
+func (x *ValidatorHistoricalRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorHistoricalRewards)(x)
 }
 
-func (x *ValidatorCurrentRewards) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorHistoricalRewards) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1244,43 +1428,43 @@ func (x *ValidatorCurrentRewards) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorCurrentRewards_messageType fastReflection_ValidatorCurrentRewards_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorCurrentRewards_messageType{}
+var _fastReflection_ValidatorHistoricalRewards_messageType fastReflection_ValidatorHistoricalRewards_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorHistoricalRewards_messageType{}
 
-type fastReflection_ValidatorCurrentRewards_messageType struct{}
+type fastReflection_ValidatorHistoricalRewards_messageType struct{}
 
-func (x fastReflection_ValidatorCurrentRewards_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorCurrentRewards)(nil)
+func (x fastReflection_ValidatorHistoricalRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorHistoricalRewards)(nil)
 }
-func (x fastReflection_ValidatorCurrentRewards_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorCurrentRewards)
+func (x fastReflection_ValidatorHistoricalRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorHistoricalRewards)
 }
-func (x fastReflection_ValidatorCurrentRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorCurrentRewards
+func (x fastReflection_ValidatorHistoricalRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorHistoricalRewards
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorCurrentRewards) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorCurrentRewards
+func (x *fastReflection_ValidatorHistoricalRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorHistoricalRewards
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorCurrentRewards) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorCurrentRewards_messageType
+func (x *fastReflection_ValidatorHistoricalRewards) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorHistoricalRewards_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorCurrentRewards) New() protoreflect.Message {
-	return new(fastReflection_ValidatorCurrentRewards)
+func (x *fastReflection_ValidatorHistoricalRewards) New() protoreflect.Message {
+	return new(fastReflection_ValidatorHistoricalRewards)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorCurrentRewards) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorCurrentRewards)(x)
+func (x *fastReflection_ValidatorHistoricalRewards) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorHistoricalRewards)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1288,16 +1472,16 @@ func (x *fastReflection_ValidatorCurrentRewards) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorCurrentRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &x.Rewards})
-		if !f(fd_ValidatorCurrentRewards_rewards, value) {
+func (x *fastReflection_ValidatorHistoricalRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CumulativeRewardRatio) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatio})
+		if !f(fd_ValidatorHistoricalRewards_cumulative_reward_ratio, value) {
 			return
 		}
 	}
-	if x.Period != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Period)
-		if !f(fd_ValidatorCurrentRewards_period, value) {
+	if x.ReferenceCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ReferenceCount)
+		if !f(fd_ValidatorHistoricalRewards_reference_count, value) {
 			return
 		}
 	}
@@ -1314,17 +1498,17 @@ func (x *fastReflection_ValidatorCurrentRewards) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorCurrentRewards) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorHistoricalRewards) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
-		return len(x.Rewards) != 0
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
-		return x.Period != uint64(0)
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
+		return len(x.CumulativeRewardRatio) != 0
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
+		return x.ReferenceCount != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1334,17 +1518,17 @@ func (x *fastReflection_ValidatorCurrentRewards) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorHistoricalRewards) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
-		x.Rewards = nil
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
-		x.Period = uint64(0)
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
+		x.CumulativeRewardRatio = nil
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
+		x.ReferenceCount = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1354,22 +1538,22 @@ func (x *fastReflection_ValidatorCurrentRewards) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorCurrentRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorHistoricalRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{})
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
+		if len(x.CumulativeRewardRatio) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{})
 		}
-		listValue := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
+		listValue := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatio}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
-		value := x.Period
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
+		value := x.ReferenceCount
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1383,19 +1567,19 @@ func (x *fastReflection_ValidatorCurrentRewards) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorHistoricalRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
 		lv := value.List()
-		clv := lv.(*_ValidatorCurrentRewards_1_list)
-		x.Rewards = *clv.list
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
-		x.Period = value.Uint()
+		clv := lv.(*_ValidatorHistoricalRewards_1_list)
+		x.CumulativeRewardRatio = *clv.list
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
+		x.ReferenceCount = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1409,49 +1593,49 @@ func (x *fastReflection_ValidatorCurrentRewards) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorHistoricalRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*v1beta1.DecCoin{}
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
+		if x.CumulativeRewardRatio == nil {
+			x.CumulativeRewardRatio = []*v1beta1.DecCoin{}
 		}
-		value := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
+		value := &_ValidatorHistoricalRewards_1_list{list: &x.CumulativeRewardRatio}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
-		panic(fmt.Errorf("field period of message cosmos.distribution.v1beta1.ValidatorCurrentRewards is not mutable"))
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
+		panic(fmt.Errorf("field reference_count of message cosmos.distribution.v1beta1.ValidatorHistoricalRewards is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorCurrentRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorHistoricalRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio":
 		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &list})
-	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
-		return protoreflect.ValueOfUint64(uint64(0))
+		return protoreflect.ValueOfList(&_ValidatorHistoricalRewards_1_list{list: &list})
+	case "cosmos.distribution.v1beta1.ValidatorHistoricalRewards.reference_count":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorHistoricalRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorHistoricalRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorCurrentRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorHistoricalRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorCurrentRewards", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorHistoricalRewards", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1459,7 +1643,7 @@ func (x *fastReflection_ValidatorCurrentRewards) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorCurrentRewards) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorHistoricalRewards) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1470,7 +1654,7 @@ func (x *fastReflection_ValidatorCurrentRewards) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorCurrentRewards) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorHistoricalRewards) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1482,7 +1666,7 @@ func (x *fastReflection_ValidatorCurrentRewards) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorCurrentRewards) IsValid() bool {
+func (x *fastReflection_ValidatorHistoricalRewards) IsValid() bool {
 	return x != nil
 }
 
@@ -1492,9 +1676,9 @@ func (x *fastReflection_ValidatorCurrentRewards) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorHistoricalRewards) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorCurrentRewards)
+		x := input.Message.Interface().(*ValidatorHistoricalRewards)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1506,14 +1690,14 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
+		if len(x.CumulativeRewardRatio) > 0 {
+			for _, e := range x.CumulativeRewardRatio {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Period != 0 {
-			n += 1 + runtime.Sov(uint64(x.Period))
+		if x.ReferenceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReferenceCount))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1525,7 +1709,7 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorCurrentRewards)
+		x := input.Message.Interface().(*ValidatorHistoricalRewards)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1544,14 +1728,14 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Period != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+		if x.ReferenceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferenceCount))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
+		if len(x.CumulativeRewardRatio) > 0 {
+			for iNdEx := len(x.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CumulativeRewardRatio[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1576,7 +1760,7 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorCurrentRewards)
+		x := input.Message.Interface().(*ValidatorHistoricalRewards)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1608,15 +1792,15 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1643,16 +1827,16 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+				x.CumulativeRewardRatio = append(x.CumulativeRewardRatio, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CumulativeRewardRatio[len(x.CumulativeRewardRatio)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
 				}
-				x.Period = 0
+				x.ReferenceCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1662,7 +1846,7 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Period |= uint64(b&0x7F) << shift
+					x.ReferenceCount |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1702,77 +1886,79 @@ func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorAccumulatedCommission_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorCurrentRewards_1_list)(nil)
 
-type _ValidatorAccumulatedCommission_1_list struct {
+type _ValidatorCurrentRewards_1_list struct {
 	list *[]*v1beta1.DecCoin
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Len() int {
+func (x *_ValidatorCurrentRewards_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorCurrentRewards_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorCurrentRewards_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorCurrentRewards_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) AppendMutable() protoreflect.Value {
+func (x *_ValidatorCurrentRewards_1_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) Truncate(n int) {
+func (x *_ValidatorCurrentRewards_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) NewElement() protoreflect.Value {
+func (x *_ValidatorCurrentRewards_1_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorAccumulatedCommission_1_list) IsValid() bool {
+func (x *_ValidatorCurrentRewards_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorAccumulatedCommission            protoreflect.MessageDescriptor
-	fd_ValidatorAccumulatedCommission_commission protoreflect.FieldDescriptor
+	md_ValidatorCurrentRewards         protoreflect.MessageDescriptor
+	fd_ValidatorCurrentRewards_rewards protoreflect.FieldDescriptor
+	fd_ValidatorCurrentRewards_period  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_ValidatorAccumulatedCommission = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorAccumulatedCommission")
-	fd_ValidatorAccumulatedCommission_commission = md_ValidatorAccumulatedCommission.Fields().ByName("commission")
+	md_ValidatorCurrentRewards = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorCurrentRewards")
+	fd_ValidatorCurrentRewards_rewards = md_ValidatorCurrentRewards.Fields().ByName("rewards")
+	fd_ValidatorCurrentRewards_period = md_ValidatorCurrentRewards.Fields().ByName("period")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorAccumulatedCommission)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorCurrentRewards)(nil)
 
-type fastReflection_ValidatorAccumulatedCommission ValidatorAccumulatedCommission
+type fastReflection_ValidatorCurrentRewards ValidatorCurrentRewards
 
-func (x *ValidatorAccumulatedCommission) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorAccumulatedCommission)(x)
+func (x *ValidatorCurrentRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorCurrentRewards)(x)
 }
 
-func (x *ValidatorAccumulatedCommission) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorCurrentRewards) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1784,43 +1970,43 @@ func (x *ValidatorAccumulatedCommission) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorAccumulatedCommission_messageType fastReflection_ValidatorAccumulatedCommission_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorAccumulatedCommission_messageType{}
+var _fastReflection_ValidatorCurrentRewards_messageType fastReflection_ValidatorCurrentRewards_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorCurrentRewards_messageType{}
 
-type fastReflection_ValidatorAccumulatedCommission_messageType struct{}
+type fastReflection_ValidatorCurrentRewards_messageType struct{}
 
-func (x fastReflection_ValidatorAccumulatedCommission_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorAccumulatedCommission)(nil)
+func (x fastReflection_ValidatorCurrentRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorCurrentRewards)(nil)
 }
-func (x fastReflection_ValidatorAccumulatedCommission_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorAccumulatedCommission)
+func (x fastReflection_ValidatorCurrentRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorCurrentRewards)
 }
-func (x fastReflection_ValidatorAccumulatedCommission_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorAccumulatedCommission
+func (x fastReflection_ValidatorCurrentRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorCurrentRewards
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorAccumulatedCommission) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorAccumulatedCommission
+func (x *fastReflection_ValidatorCurrentRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorCurrentRewards
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorAccumulatedCommission) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorAccumulatedCommission_messageType
+func (x *fastReflection_ValidatorCurrentRewards) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorCurrentRewards_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorAccumulatedCommission) New() protoreflect.Message {
-	return new(fastReflection_ValidatorAccumulatedCommission)
+func (x *fastReflection_ValidatorCurrentRewards) New() protoreflect.Message {
+	return new(fastReflection_ValidatorCurrentRewards)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorAccumulatedCommission) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorAccumulatedCommission)(x)
+func (x *fastReflection_ValidatorCurrentRewards) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorCurrentRewards)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1828,10 +2014,16 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorAccumulatedCommission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Commission) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &x.Commission})
-		if !f(fd_ValidatorAccumulatedCommission_commission, value) {
+func (x *fastReflection_ValidatorCurrentRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &x.Rewards})
+		if !f(fd_ValidatorCurrentRewards_rewards, value) {
+			return
+		}
+	}
+	if x.Period != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Period)
+		if !f(fd_ValidatorCurrentRewards_period, value) {
 			return
 		}
 	}
@@ -1848,15 +2040,17 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorAccumulatedCommission) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorCurrentRewards) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
-		return len(x.Commission) != 0
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
+		return len(x.Rewards) != 0
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
+		return x.Period != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1866,15 +2060,17 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorCurrentRewards) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
-		x.Commission = nil
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
+		x.Rewards = nil
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
+		x.Period = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1884,19 +2080,22 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorAccumulatedCommission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorCurrentRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
-		if len(x.Commission) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{})
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{})
 		}
-		listValue := &_ValidatorAccumulatedCommission_1_list{list: &x.Commission}
+		listValue := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
+		value := x.Period
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1910,17 +2109,19 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorCurrentRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
 		lv := value.List()
-		clv := lv.(*_ValidatorAccumulatedCommission_1_list)
-		x.Commission = *clv.list
+		clv := lv.(*_ValidatorCurrentRewards_1_list)
+		x.Rewards = *clv.list
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
+		x.Period = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1934,45 +2135,49 @@ func (x *fastReflection_ValidatorAccumulatedCommission) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorCurrentRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
-		if x.Commission == nil {
-			x.Commission = []*v1beta1.DecCoin{}
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta1.DecCoin{}
 		}
-		value := &_ValidatorAccumulatedCommission_1_list{list: &x.Commission}
+		value := &_ValidatorCurrentRewards_1_list{list: &x.Rewards}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
+		panic(fmt.Errorf("field period of message cosmos.distribution.v1beta1.ValidatorCurrentRewards is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorAccumulatedCommission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorCurrentRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards":
 		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &list})
+		return protoreflect.ValueOfList(&_ValidatorCurrentRewards_1_list{list: &list})
+	case "cosmos.distribution.v1beta1.ValidatorCurrentRewards.period":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorCurrentRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorCurrentRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorAccumulatedCommission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorCurrentRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorAccumulatedCommission", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorCurrentRewards", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1980,7 +2185,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorAccumulatedCommission) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorCurrentRewards) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1991,7 +2196,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorAccumulatedCommission) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorCurrentRewards) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2003,7 +2208,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorAccumulatedCommission) IsValid() bool {
+func (x *fastReflection_ValidatorCurrentRewards) IsValid() bool {
 	return x != nil
 }
 
@@ -2013,9 +2218,9 @@ func (x *fastReflection_ValidatorAccumulatedCommission) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorCurrentRewards) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
+		x := input.Message.Interface().(*ValidatorCurrentRewards)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2027,12 +2232,15 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		if len(x.Commission) > 0 {
-			for _, e := range x.Commission {
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Period != 0 {
+			n += 1 + runtime.Sov(uint64(x.Period))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2043,7 +2251,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
+		x := input.Message.Interface().(*ValidatorCurrentRewards)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2062,9 +2270,14 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Commission) > 0 {
-			for iNdEx := len(x.Commission) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Commission[iNdEx])
+		if x.Period != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2089,7 +2302,7 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
+		x := input.Message.Interface().(*ValidatorCurrentRewards)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2121,15 +2334,15 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2156,11 +2369,30 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Commission = append(x.Commission, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commission[len(x.Commission)-1]); err != nil {
+				x.Rewards = append(x.Rewards, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				x.Period = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Period |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2196,77 +2428,77 @@ func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoifa
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorOutstandingRewards_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorAccumulatedCommission_1_list)(nil)
 
-type _ValidatorOutstandingRewards_1_list struct {
+type _ValidatorAccumulatedCommission_1_list struct {
 	list *[]*v1beta1.DecCoin
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Len() int {
+func (x *_ValidatorAccumulatedCommission_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorAccumulatedCommission_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorAccumulatedCommission_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorAccumulatedCommission_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) AppendMutable() protoreflect.Value {
+func (x *_ValidatorAccumulatedCommission_1_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) Truncate(n int) {
+func (x *_ValidatorAccumulatedCommission_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) NewElement() protoreflect.Value {
+func (x *_ValidatorAccumulatedCommission_1_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_ValidatorOutstandingRewards_1_list) IsValid() bool {
+func (x *_ValidatorAccumulatedCommission_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ValidatorOutstandingRewards         protoreflect.MessageDescriptor
-	fd_ValidatorOutstandingRewards_rewards protoreflect.FieldDescriptor
+	md_ValidatorAccumulatedCommission            protoreflect.MessageDescriptor
+	fd_ValidatorAccumulatedCommission_commission protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_ValidatorOutstandingRewards = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorOutstandingRewards")
-	fd_ValidatorOutstandingRewards_rewards = md_ValidatorOutstandingRewards.Fields().ByName("rewards")
+	md_ValidatorAccumulatedCommission = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorAccumulatedCommission")
+	fd_ValidatorAccumulatedCommission_commission = md_ValidatorAccumulatedCommission.Fields().ByName("commission")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorOutstandingRewards)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorAccumulatedCommission)(nil)
 
-type fastReflection_ValidatorOutstandingRewards ValidatorOutstandingRewards
+type fastReflection_ValidatorAccumulatedCommission ValidatorAccumulatedCommission
 
-func (x *ValidatorOutstandingRewards) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorOutstandingRewards)(x)
+func (x *ValidatorAccumulatedCommission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorAccumulatedCommission)(x)
 }
 
-func (x *ValidatorOutstandingRewards) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorAccumulatedCommission) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2278,43 +2510,43 @@ func (x *ValidatorOutstandingRewards) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorOutstandingRewards_messageType fastReflection_ValidatorOutstandingRewards_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorOutstandingRewards_messageType{}
+var _fastReflection_ValidatorAccumulatedCommission_messageType fastReflection_ValidatorAccumulatedCommission_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorAccumulatedCommission_messageType{}
 
-type fastReflection_ValidatorOutstandingRewards_messageType struct{}
+type fastReflection_ValidatorAccumulatedCommission_messageType struct{}
 
-func (x fastReflection_ValidatorOutstandingRewards_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorOutstandingRewards)(nil)
+func (x fastReflection_ValidatorAccumulatedCommission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorAccumulatedCommission)(nil)
 }
-func (x fastReflection_ValidatorOutstandingRewards_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorOutstandingRewards)
+func (x fastReflection_ValidatorAccumulatedCommission_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccumulatedCommission)
 }
-func (x fastReflection_ValidatorOutstandingRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorOutstandingRewards
+func (x fastReflection_ValidatorAccumulatedCommission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccumulatedCommission
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorOutstandingRewards) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorOutstandingRewards
+func (x *fastReflection_ValidatorAccumulatedCommission) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorAccumulatedCommission
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorOutstandingRewards) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorOutstandingRewards_messageType
+func (x *fastReflection_ValidatorAccumulatedCommission) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorAccumulatedCommission_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorOutstandingRewards) New() protoreflect.Message {
-	return new(fastReflection_ValidatorOutstandingRewards)
+func (x *fastReflection_ValidatorAccumulatedCommission) New() protoreflect.Message {
+	return new(fastReflection_ValidatorAccumulatedCommission)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorOutstandingRewards) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorOutstandingRewards)(x)
+func (x *fastReflection_ValidatorAccumulatedCommission) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorAccumulatedCommission)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2322,10 +2554,10 @@ func (x *fastReflection_ValidatorOutstandingRewards) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorOutstandingRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &x.Rewards})
-		if !f(fd_ValidatorOutstandingRewards_rewards, value) {
+func (x *fastReflection_ValidatorAccumulatedCommission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Commission) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &x.Commission})
+		if !f(fd_ValidatorAccumulatedCommission_commission, value) {
 			return
 		}
 	}
@@ -2342,15 +2574,15 @@ func (x *fastReflection_ValidatorOutstandingRewards) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorOutstandingRewards) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorAccumulatedCommission) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
-		return len(x.Rewards) != 0
+	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
+		return len(x.Commission) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2360,15 +2592,15 @@ func (x *fastReflection_ValidatorOutstandingRewards) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorAccumulatedCommission) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
-		x.Rewards = nil
+	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
+		x.Commission = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2378,19 +2610,19 @@ func (x *fastReflection_ValidatorOutstandingRewards) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorOutstandingRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccumulatedCommission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{})
+	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
+		if len(x.Commission) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{})
 		}
-		listValue := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
+		listValue := &_ValidatorAccumulatedCommission_1_list{list: &x.Commission}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2404,17 +2636,17 @@ func (x *fastReflection_ValidatorOutstandingRewards) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorAccumulatedCommission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
 		lv := value.List()
-		clv := lv.(*_ValidatorOutstandingRewards_1_list)
-		x.Rewards = *clv.list
+		clv := lv.(*_ValidatorAccumulatedCommission_1_list)
+		x.Commission = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2428,45 +2660,45 @@ func (x *fastReflection_ValidatorOutstandingRewards) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccumulatedCommission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []*v1beta1.DecCoin{}
+	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
+		if x.Commission == nil {
+			x.Commission = []*v1beta1.DecCoin{}
 		}
-		value := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
+		value := &_ValidatorAccumulatedCommission_1_list{list: &x.Commission}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorOutstandingRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorAccumulatedCommission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+	case "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission":
 		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &list})
+		return protoreflect.ValueOfList(&_ValidatorAccumulatedCommission_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorAccumulatedCommission does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorOutstandingRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorAccumulatedCommission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorOutstandingRewards", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorAccumulatedCommission", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2474,7 +2706,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorOutstandingRewards) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorAccumulatedCommission) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2485,7 +2717,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorOutstandingRewards) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorAccumulatedCommission) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2497,7 +2729,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorOutstandingRewards) IsValid() bool {
+func (x *fastReflection_ValidatorAccumulatedCommission) IsValid() bool {
 	return x != nil
 }
 
@@ -2507,9 +2739,9 @@ func (x *fastReflection_ValidatorOutstandingRewards) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorAccumulatedCommission) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorOutstandingRewards)
+		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2521,8 +2753,8 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if len(x.Rewards) > 0 {
-			for _, e := range x.Rewards {
+		if len(x.Commission) > 0 {
+			for _, e := range x.Commission {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -2537,7 +2769,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorOutstandingRewards)
+		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2556,9 +2788,9 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Rewards[iNdEx])
+		if len(x.Commission) > 0 {
+			for iNdEx := len(x.Commission) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Commission[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2583,7 +2815,7 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorOutstandingRewards)
+		x := input.Message.Interface().(*ValidatorAccumulatedCommission)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2615,15 +2847,15 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2650,8 +2882,8 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+				x.Commission = append(x.Commission, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Commission[len(x.Commission)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2690,76 +2922,125 @@ func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.
 	}
 }
 
-var (
-	md_ValidatorSlashEvent                  protoreflect.MessageDescriptor
-	fd_ValidatorSlashEvent_validator_period protoreflect.FieldDescriptor
-	fd_ValidatorSlashEvent_fraction         protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_ValidatorOutstandingRewards_1_list)(nil)
 
-func init() {
-	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_ValidatorSlashEvent = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorSlashEvent")
-	fd_ValidatorSlashEvent_validator_period = md_ValidatorSlashEvent.Fields().ByName("validator_period")
-	fd_ValidatorSlashEvent_fraction = md_ValidatorSlashEvent.Fields().ByName("fraction")
+type _ValidatorOutstandingRewards_1_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvent)(nil)
-
-type fastReflection_ValidatorSlashEvent ValidatorSlashEvent
-
-func (x *ValidatorSlashEvent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvent)(x)
+func (x *_ValidatorOutstandingRewards_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *ValidatorSlashEvent) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_ValidatorOutstandingRewards_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-var _fastReflection_ValidatorSlashEvent_messageType fastReflection_ValidatorSlashEvent_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvent_messageType{}
+func (x *_ValidatorOutstandingRewards_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
 
-type fastReflection_ValidatorSlashEvent_messageType struct{}
+func (x *_ValidatorOutstandingRewards_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
 
-func (x fastReflection_ValidatorSlashEvent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvent)(nil)
+func (x *_ValidatorOutstandingRewards_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_ValidatorSlashEvent_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvent)
+
+func (x *_ValidatorOutstandingRewards_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_ValidatorSlashEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvent
+
+func (x *_ValidatorOutstandingRewards_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValidatorOutstandingRewards_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ValidatorOutstandingRewards         protoreflect.MessageDescriptor
+	fd_ValidatorOutstandingRewards_rewards protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_distribution_v1beta1_distribution_proto_init()
+	md_ValidatorOutstandingRewards = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorOutstandingRewards")
+	fd_ValidatorOutstandingRewards_rewards = md_ValidatorOutstandingRewards.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorOutstandingRewards)(nil)
+
+type fastReflection_ValidatorOutstandingRewards ValidatorOutstandingRewards
+
+func (x *ValidatorOutstandingRewards) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorOutstandingRewards)(x)
+}
+
+func (x *ValidatorOutstandingRewards) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorOutstandingRewards_messageType fastReflection_ValidatorOutstandingRewards_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorOutstandingRewards_messageType{}
+
+type fastReflection_ValidatorOutstandingRewards_messageType struct{}
+
+func (x fastReflection_ValidatorOutstandingRewards_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorOutstandingRewards)(nil)
+}
+func (x fastReflection_ValidatorOutstandingRewards_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOutstandingRewards)
+}
+func (x fastReflection_ValidatorOutstandingRewards_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOutstandingRewards
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorSlashEvent) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvent
+func (x *fastReflection_ValidatorOutstandingRewards) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorOutstandingRewards
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorSlashEvent) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorSlashEvent_messageType
+func (x *fastReflection_ValidatorOutstandingRewards) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorOutstandingRewards_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorSlashEvent) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvent)
+func (x *fastReflection_ValidatorOutstandingRewards) New() protoreflect.Message {
+	return new(fastReflection_ValidatorOutstandingRewards)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorSlashEvent) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorSlashEvent)(x)
+func (x *fastReflection_ValidatorOutstandingRewards) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorOutstandingRewards)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2767,16 +3048,10 @@ func (x *fastReflection_ValidatorSlashEvent) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorSlashEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorPeriod != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ValidatorPeriod)
-		if !f(fd_ValidatorSlashEvent_validator_period, value) {
-			return
-		}
-	}
-	if x.Fraction != "" {
-		value := protoreflect.ValueOfString(x.Fraction)
-		if !f(fd_ValidatorSlashEvent_fraction, value) {
+func (x *fastReflection_ValidatorOutstandingRewards) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &x.Rewards})
+		if !f(fd_ValidatorOutstandingRewards_rewards, value) {
 			return
 		}
 	}
@@ -2793,17 +3068,15 @@ func (x *fastReflection_ValidatorSlashEvent) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorSlashEvent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorOutstandingRewards) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
-		return x.ValidatorPeriod != uint64(0)
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
-		return x.Fraction != ""
+	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+		return len(x.Rewards) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2813,17 +3086,15 @@ func (x *fastReflection_ValidatorSlashEvent) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorOutstandingRewards) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
-		x.ValidatorPeriod = uint64(0)
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
-		x.Fraction = ""
+	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+		x.Rewards = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2833,19 +3104,19 @@ func (x *fastReflection_ValidatorSlashEvent) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorSlashEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOutstandingRewards) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
-		value := x.ValidatorPeriod
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
-		value := x.Fraction
-		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{})
+		}
+		listValue := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2859,17 +3130,17 @@ func (x *fastReflection_ValidatorSlashEvent) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorOutstandingRewards) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
-		x.ValidatorPeriod = value.Uint()
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
-		x.Fraction = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+		lv := value.List()
+		clv := lv.(*_ValidatorOutstandingRewards_1_list)
+		x.Rewards = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2883,44 +3154,45 @@ func (x *fastReflection_ValidatorSlashEvent) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOutstandingRewards) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
-		panic(fmt.Errorf("field validator_period of message cosmos.distribution.v1beta1.ValidatorSlashEvent is not mutable"))
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
-		panic(fmt.Errorf("field fraction of message cosmos.distribution.v1beta1.ValidatorSlashEvent is not mutable"))
+	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta1.DecCoin{}
+		}
+		value := &_ValidatorOutstandingRewards_1_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorSlashEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorOutstandingRewards) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
-		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_ValidatorOutstandingRewards_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorOutstandingRewards"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorOutstandingRewards does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorSlashEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorOutstandingRewards) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorSlashEvent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorOutstandingRewards", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2928,7 +3200,7 @@ func (x *fastReflection_ValidatorSlashEvent) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorSlashEvent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorOutstandingRewards) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2939,7 +3211,7 @@ func (x *fastReflection_ValidatorSlashEvent) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorOutstandingRewards) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2951,7 +3223,7 @@ func (x *fastReflection_ValidatorSlashEvent) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorSlashEvent) IsValid() bool {
+func (x *fastReflection_ValidatorOutstandingRewards) IsValid() bool {
 	return x != nil
 }
 
@@ -2961,9 +3233,9 @@ func (x *fastReflection_ValidatorSlashEvent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorOutstandingRewards) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorSlashEvent)
+		x := input.Message.Interface().(*ValidatorOutstandingRewards)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2975,12 +3247,11 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.ValidatorPeriod != 0 {
-			n += 1 + runtime.Sov(uint64(x.ValidatorPeriod))
-		}
-		l = len(x.Fraction)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2992,7 +3263,7 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvent)
+		x := input.Message.Interface().(*ValidatorOutstandingRewards)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3011,17 +3282,21 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Fraction) > 0 {
-			i -= len(x.Fraction)
-			copy(dAtA[i:], x.Fraction)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fraction)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.ValidatorPeriod != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPeriod))
-			i--
-			dAtA[i] = 0x8
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3034,7 +3309,7 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvent)
+		x := input.Message.Interface().(*ValidatorOutstandingRewards)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3066,36 +3341,17 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
-				}
-				x.ValidatorPeriod = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ValidatorPeriod |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3105,23 +3361,25 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Fraction = string(dAtA[iNdEx:postIndex])
+				x.Rewards = append(x.Rewards, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3158,77 +3416,28 @@ func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_ValidatorSlashEvents_1_list)(nil)
-
-type _ValidatorSlashEvents_1_list struct {
-	list *[]*ValidatorSlashEvent
-}
-
-func (x *_ValidatorSlashEvents_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_ValidatorSlashEvents_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_ValidatorSlashEvents_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_ValidatorSlashEvents_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_ValidatorSlashEvents_1_list) AppendMutable() protoreflect.Value {
-	v := new(ValidatorSlashEvent)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorSlashEvents_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_ValidatorSlashEvents_1_list) NewElement() protoreflect.Value {
-	v := new(ValidatorSlashEvent)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_ValidatorSlashEvents_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_ValidatorSlashEvents                        protoreflect.MessageDescriptor
-	fd_ValidatorSlashEvents_validator_slash_events protoreflect.FieldDescriptor
+	md_ValidatorSlashEvent                  protoreflect.MessageDescriptor
+	fd_ValidatorSlashEvent_validator_period protoreflect.FieldDescriptor
+	fd_ValidatorSlashEvent_fraction         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_ValidatorSlashEvents = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorSlashEvents")
-	fd_ValidatorSlashEvents_validator_slash_events = md_ValidatorSlashEvents.Fields().ByName("validator_slash_events")
+	md_ValidatorSlashEvent = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorSlashEvent")
+	fd_ValidatorSlashEvent_validator_period = md_ValidatorSlashEvent.Fields().ByName("validator_period")
+	fd_ValidatorSlashEvent_fraction = md_ValidatorSlashEvent.Fields().ByName("fraction")
 }
 
-var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvents)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvent)(nil)
 
-type fastReflection_ValidatorSlashEvents ValidatorSlashEvents
+type fastReflection_ValidatorSlashEvent ValidatorSlashEvent
 
-func (x *ValidatorSlashEvents) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvents)(x)
+func (x *ValidatorSlashEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvent)(x)
 }
 
-func (x *ValidatorSlashEvents) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorSlashEvent) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3240,43 +3449,43 @@ func (x *ValidatorSlashEvents) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ValidatorSlashEvents_messageType fastReflection_ValidatorSlashEvents_messageType
-var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvents_messageType{}
+var _fastReflection_ValidatorSlashEvent_messageType fastReflection_ValidatorSlashEvent_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvent_messageType{}
 
-type fastReflection_ValidatorSlashEvents_messageType struct{}
+type fastReflection_ValidatorSlashEvent_messageType struct{}
 
-func (x fastReflection_ValidatorSlashEvents_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ValidatorSlashEvents)(nil)
+func (x fastReflection_ValidatorSlashEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvent)(nil)
 }
-func (x fastReflection_ValidatorSlashEvents_messageType) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvents)
+func (x fastReflection_ValidatorSlashEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvent)
 }
-func (x fastReflection_ValidatorSlashEvents_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvents
+func (x fastReflection_ValidatorSlashEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ValidatorSlashEvents) Descriptor() protoreflect.MessageDescriptor {
-	return md_ValidatorSlashEvents
+func (x *fastReflection_ValidatorSlashEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ValidatorSlashEvents) Type() protoreflect.MessageType {
-	return _fastReflection_ValidatorSlashEvents_messageType
+func (x *fastReflection_ValidatorSlashEvent) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSlashEvent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ValidatorSlashEvents) New() protoreflect.Message {
-	return new(fastReflection_ValidatorSlashEvents)
+func (x *fastReflection_ValidatorSlashEvent) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ValidatorSlashEvents) Interface() protoreflect.ProtoMessage {
-	return (*ValidatorSlashEvents)(x)
+func (x *fastReflection_ValidatorSlashEvent) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSlashEvent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3284,10 +3493,16 @@ func (x *fastReflection_ValidatorSlashEvents) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ValidatorSlashEvents) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValidatorSlashEvents) != 0 {
-		value := protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents})
-		if !f(fd_ValidatorSlashEvents_validator_slash_events, value) {
+func (x *fastReflection_ValidatorSlashEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorPeriod)
+		if !f(fd_ValidatorSlashEvent_validator_period, value) {
+			return
+		}
+	}
+	if x.Fraction != "" {
+		value := protoreflect.ValueOfString(x.Fraction)
+		if !f(fd_ValidatorSlashEvent_fraction, value) {
 			return
 		}
 	}
@@ -3304,15 +3519,17 @@ func (x *fastReflection_ValidatorSlashEvents) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ValidatorSlashEvents) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorSlashEvent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
-		return len(x.ValidatorSlashEvents) != 0
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
+		return x.ValidatorPeriod != uint64(0)
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
+		return x.Fraction != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3322,15 +3539,17 @@ func (x *fastReflection_ValidatorSlashEvents) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorSlashEvent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
-		x.ValidatorSlashEvents = nil
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
+		x.ValidatorPeriod = uint64(0)
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
+		x.Fraction = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3340,19 +3559,19 @@ func (x *fastReflection_ValidatorSlashEvents) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ValidatorSlashEvents) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
-		if len(x.ValidatorSlashEvents) == 0 {
-			return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{})
-		}
-		listValue := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
+		value := x.ValidatorPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
+		value := x.Fraction
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3366,17 +3585,17 @@ func (x *fastReflection_ValidatorSlashEvents) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorSlashEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
-		lv := value.List()
-		clv := lv.(*_ValidatorSlashEvents_1_list)
-		x.ValidatorSlashEvents = *clv.list
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
+		x.ValidatorPeriod = value.Uint()
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
+		x.Fraction = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3390,45 +3609,44 @@ func (x *fastReflection_ValidatorSlashEvents) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
-		if x.ValidatorSlashEvents == nil {
-			x.ValidatorSlashEvents = []*ValidatorSlashEvent{}
-		}
-		value := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
-		return protoreflect.ValueOfList(value)
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
+		panic(fmt.Errorf("field validator_period of message cosmos.distribution.v1beta1.ValidatorSlashEvent is not mutable"))
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
+		panic(fmt.Errorf("field fraction of message cosmos.distribution.v1beta1.ValidatorSlashEvent is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ValidatorSlashEvents) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
-		list := []*ValidatorSlashEvent{}
-		return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &list})
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.validator_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvent.fraction":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvent"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ValidatorSlashEvents) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorSlashEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorSlashEvents", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorSlashEvent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3436,7 +3654,7 @@ func (x *fastReflection_ValidatorSlashEvents) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ValidatorSlashEvents) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorSlashEvent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3447,7 +3665,7 @@ func (x *fastReflection_ValidatorSlashEvents) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ValidatorSlashEvents) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorSlashEvent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3459,7 +3677,7 @@ func (x *fastReflection_ValidatorSlashEvents) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ValidatorSlashEvents) IsValid() bool {
+func (x *fastReflection_ValidatorSlashEvent) IsValid() bool {
 	return x != nil
 }
 
@@ -3469,9 +3687,9 @@ func (x *fastReflection_ValidatorSlashEvents) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorSlashEvent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ValidatorSlashEvents)
+		x := input.Message.Interface().(*ValidatorSlashEvent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3483,11 +3701,12 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.ValidatorSlashEvents) > 0 {
-			for _, e := range x.ValidatorSlashEvents {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.ValidatorPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorPeriod))
+		}
+		l = len(x.Fraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3499,7 +3718,7 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvents)
+		x := input.Message.Interface().(*ValidatorSlashEvent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3518,21 +3737,17 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValidatorSlashEvents) > 0 {
-			for iNdEx := len(x.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ValidatorSlashEvents[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Fraction) > 0 {
+			i -= len(x.Fraction)
+			copy(dAtA[i:], x.Fraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fraction)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ValidatorPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPeriod))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3545,7 +3760,7 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ValidatorSlashEvents)
+		x := input.Message.Interface().(*ValidatorSlashEvent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3577,17 +3792,36 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+				}
+				x.ValidatorPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3597,25 +3831,23 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorSlashEvents = append(x.ValidatorSlashEvents, &ValidatorSlashEvent{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorSlashEvents[len(x.ValidatorSlashEvents)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Fraction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3652,77 +3884,77 @@ func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_FeePool_1_list)(nil)
+var _ protoreflect.List = (*_ValidatorSlashEvents_1_list)(nil)
 
-type _FeePool_1_list struct {
-	list *[]*v1beta1.DecCoin
+type _ValidatorSlashEvents_1_list struct {
+	list *[]*ValidatorSlashEvent
 }
 
-func (x *_FeePool_1_list) Len() int {
+func (x *_ValidatorSlashEvents_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_FeePool_1_list) Get(i int) protoreflect.Value {
+func (x *_ValidatorSlashEvents_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_FeePool_1_list) Set(i int, value protoreflect.Value) {
+func (x *_ValidatorSlashEvents_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_FeePool_1_list) Append(value protoreflect.Value) {
+func (x *_ValidatorSlashEvents_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	concreteValue := valueUnwrapped.Interface().(*ValidatorSlashEvent)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_FeePool_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_ValidatorSlashEvents_1_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorSlashEvent)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_FeePool_1_list) Truncate(n int) {
+func (x *_ValidatorSlashEvents_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_FeePool_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
+func (x *_ValidatorSlashEvents_1_list) NewElement() protoreflect.Value {
+	v := new(ValidatorSlashEvent)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_FeePool_1_list) IsValid() bool {
+func (x *_ValidatorSlashEvents_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_FeePool                protoreflect.MessageDescriptor
-	fd_FeePool_community_pool protoreflect.FieldDescriptor
+	md_ValidatorSlashEvents                        protoreflect.MessageDescriptor
+	fd_ValidatorSlashEvents_validator_slash_events protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_FeePool = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("FeePool")
-	fd_FeePool_community_pool = md_FeePool.Fields().ByName("community_pool")
+	md_ValidatorSlashEvents = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("ValidatorSlashEvents")
+	fd_ValidatorSlashEvents_validator_slash_events = md_ValidatorSlashEvents.Fields().ByName("validator_slash_events")
 }
 
-var _ protoreflect.Message = (*fastReflection_FeePool)(nil)
+var _ protoreflect.Message = (*fastReflection_ValidatorSlashEvents)(nil)
 
-type fastReflection_FeePool FeePool
+type fastReflection_ValidatorSlashEvents ValidatorSlashEvents
 
-func (x *FeePool) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_FeePool)(x)
+func (x *ValidatorSlashEvents) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvents)(x)
 }
 
-func (x *FeePool) slowProtoReflect() protoreflect.Message {
+func (x *ValidatorSlashEvents) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3734,43 +3966,43 @@ func (x *FeePool) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_FeePool_messageType fastReflection_FeePool_messageType
-var _ protoreflect.MessageType = fastReflection_FeePool_messageType{}
+var _fastReflection_ValidatorSlashEvents_messageType fastReflection_ValidatorSlashEvents_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorSlashEvents_messageType{}
 
-type fastReflection_FeePool_messageType struct{}
+type fastReflection_ValidatorSlashEvents_messageType struct{}
 
-func (x fastReflection_FeePool_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_FeePool)(nil)
+func (x fastReflection_ValidatorSlashEvents_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorSlashEvents)(nil)
 }
-func (x fastReflection_FeePool_messageType) New() protoreflect.Message {
-	return new(fastReflection_FeePool)
+func (x fastReflection_ValidatorSlashEvents_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvents)
 }
-func (x fastReflection_FeePool_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeePool
+func (x fastReflection_ValidatorSlashEvents_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvents
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_FeePool) Descriptor() protoreflect.MessageDescriptor {
-	return md_FeePool
+func (x *fastReflection_ValidatorSlashEvents) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorSlashEvents
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_FeePool) Type() protoreflect.MessageType {
-	return _fastReflection_FeePool_messageType
+func (x *fastReflection_ValidatorSlashEvents) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorSlashEvents_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_FeePool) New() protoreflect.Message {
-	return new(fastReflection_FeePool)
+func (x *fastReflection_ValidatorSlashEvents) New() protoreflect.Message {
+	return new(fastReflection_ValidatorSlashEvents)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_FeePool) Interface() protoreflect.ProtoMessage {
-	return (*FeePool)(x)
+func (x *fastReflection_ValidatorSlashEvents) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorSlashEvents)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3778,10 +4010,10 @@ func (x *fastReflection_FeePool) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_FeePool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.CommunityPool) != 0 {
-		value := protoreflect.ValueOfList(&_FeePool_1_list{list: &x.CommunityPool})
-		if !f(fd_FeePool_community_pool, value) {
+func (x *fastReflection_ValidatorSlashEvents) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValidatorSlashEvents) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents})
+		if !f(fd_ValidatorSlashEvents_validator_slash_events, value) {
 			return
 		}
 	}
@@ -3798,15 +4030,15 @@ func (x *fastReflection_FeePool) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_FeePool) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ValidatorSlashEvents) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.FeePool.community_pool":
-		return len(x.CommunityPool) != 0
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
+		return len(x.ValidatorSlashEvents) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3816,15 +4048,15 @@ func (x *fastReflection_FeePool) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePool) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ValidatorSlashEvents) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.FeePool.community_pool":
-		x.CommunityPool = nil
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
+		x.ValidatorSlashEvents = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3834,19 +4066,19 @@ func (x *fastReflection_FeePool) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FeePool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvents) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.FeePool.community_pool":
-		if len(x.CommunityPool) == 0 {
-			return protoreflect.ValueOfList(&_FeePool_1_list{})
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
+		if len(x.ValidatorSlashEvents) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{})
 		}
-		listValue := &_FeePool_1_list{list: &x.CommunityPool}
+		listValue := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3860,17 +4092,17 @@ func (x *fastReflection_FeePool) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ValidatorSlashEvents) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.FeePool.community_pool":
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
 		lv := value.List()
-		clv := lv.(*_FeePool_1_list)
-		x.CommunityPool = *clv.list
+		clv := lv.(*_ValidatorSlashEvents_1_list)
+		x.ValidatorSlashEvents = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3884,45 +4116,45 @@ func (x *fastReflection_FeePool) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvents) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.FeePool.community_pool":
-		if x.CommunityPool == nil {
-			x.CommunityPool = []*v1beta1.DecCoin{}
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
+		if x.ValidatorSlashEvents == nil {
+			x.ValidatorSlashEvents = []*ValidatorSlashEvent{}
 		}
-		value := &_FeePool_1_list{list: &x.CommunityPool}
+		value := &_ValidatorSlashEvents_1_list{list: &x.ValidatorSlashEvents}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FeePool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ValidatorSlashEvents) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.FeePool.community_pool":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_FeePool_1_list{list: &list})
+	case "cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events":
+		list := []*ValidatorSlashEvent{}
+		return protoreflect.ValueOfList(&_ValidatorSlashEvents_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.ValidatorSlashEvents"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.ValidatorSlashEvents does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FeePool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ValidatorSlashEvents) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.FeePool", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.ValidatorSlashEvents", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3930,7 +4162,7 @@ func (x *fastReflection_FeePool) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FeePool) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ValidatorSlashEvents) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3941,7 +4173,7 @@ func (x *fastReflection_FeePool) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePool) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ValidatorSlashEvents) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3953,7 +4185,7 @@ func (x *fastReflection_FeePool) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FeePool) IsValid() bool {
+func (x *fastReflection_ValidatorSlashEvents) IsValid() bool {
 	return x != nil
 }
 
@@ -3963,9 +4195,9 @@ func (x *fastReflection_FeePool) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ValidatorSlashEvents) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FeePool)
+		x := input.Message.Interface().(*ValidatorSlashEvents)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3977,9 +4209,9 @@ func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.CommunityPool) > 0 {
-			for _, e := range x.CommunityPool {
-				l = options.Size(e)
+		if len(x.ValidatorSlashEvents) > 0 {
+			for _, e := range x.ValidatorSlashEvents {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -3993,7 +4225,7 @@ func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FeePool)
+		x := input.Message.Interface().(*ValidatorSlashEvents)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4012,9 +4244,9 @@ func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CommunityPool) > 0 {
-			for iNdEx := len(x.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CommunityPool[iNdEx])
+		if len(x.ValidatorSlashEvents) > 0 {
+			for iNdEx := len(x.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorSlashEvents[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4039,7 +4271,7 @@ func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeePool)
+		x := input.Message.Interface().(*ValidatorSlashEvents)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4071,15 +4303,15 @@ func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4106,8 +4338,8 @@ func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CommunityPool = append(x.CommunityPool, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommunityPool[len(x.CommunityPool)-1]); err != nil {
+				x.ValidatorSlashEvents = append(x.ValidatorSlashEvents, &ValidatorSlashEvent{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorSlashEvents[len(x.ValidatorSlashEvents)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4146,83 +4378,77 @@ func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_CommunityPoolSpendProposal_4_list)(nil)
+var _ protoreflect.List = (*_FeePool_1_list)(nil)
 
-type _CommunityPoolSpendProposal_4_list struct {
-	list *[]*v1beta1.Coin
+type _FeePool_1_list struct {
+	list *[]*v1beta1.DecCoin
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) Len() int {
+func (x *_FeePool_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) Get(i int) protoreflect.Value {
+func (x *_FeePool_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) Set(i int, value protoreflect.Value) {
+func (x *_FeePool_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) Append(value protoreflect.Value) {
+func (x *_FeePool_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_FeePool_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) Truncate(n int) {
+func (x *_FeePool_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_FeePool_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_CommunityPoolSpendProposal_4_list) IsValid() bool {
+func (x *_FeePool_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_CommunityPoolSpendProposal             protoreflect.MessageDescriptor
-	fd_CommunityPoolSpendProposal_title       protoreflect.FieldDescriptor
-	fd_CommunityPoolSpendProposal_description protoreflect.FieldDescriptor
-	fd_CommunityPoolSpendProposal_recipient   protoreflect.FieldDescriptor
-	fd_CommunityPoolSpendProposal_amount      protoreflect.FieldDescriptor
+	md_FeePool                protoreflect.MessageDescriptor
+	fd_FeePool_community_pool protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_CommunityPoolSpendProposal = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("CommunityPoolSpendProposal")
-	fd_CommunityPoolSpendProposal_title = md_CommunityPoolSpendProposal.Fields().ByName("title")
-	fd_CommunityPoolSpendProposal_description = md_CommunityPoolSpendProposal.Fields().ByName("description")
-	fd_CommunityPoolSpendProposal_recipient = md_CommunityPoolSpendProposal.Fields().ByName("recipient")
-	fd_CommunityPoolSpendProposal_amount = md_CommunityPoolSpendProposal.Fields().ByName("amount")
+	md_FeePool = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("FeePool")
+	fd_FeePool_community_pool = md_FeePool.Fields().ByName("community_pool")
 }
 
-var _ protoreflect.Message = (*fastReflection_CommunityPoolSpendProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_FeePool)(nil)
 
-type fastReflection_CommunityPoolSpendProposal CommunityPoolSpendProposal
+type fastReflection_FeePool FeePool
 
-func (x *CommunityPoolSpendProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CommunityPoolSpendProposal)(x)
+func (x *FeePool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeePool)(x)
 }
 
-func (x *CommunityPoolSpendProposal) slowProtoReflect() protoreflect.Message {
+func (x *FeePool) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4234,43 +4460,43 @@ func (x *CommunityPoolSpendProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CommunityPoolSpendProposal_messageType fastReflection_CommunityPoolSpendProposal_messageType
-var _ protoreflect.MessageType = fastReflection_CommunityPoolSpendProposal_messageType{}
+var _fastReflection_FeePool_messageType fastReflection_FeePool_messageType
+var _ protoreflect.MessageType = fastReflection_FeePool_messageType{}
 
-type fastReflection_CommunityPoolSpendProposal_messageType struct{}
+type fastReflection_FeePool_messageType struct{}
 
-func (x fastReflection_CommunityPoolSpendProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CommunityPoolSpendProposal)(nil)
+func (x fastReflection_FeePool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeePool)(nil)
 }
-func (x fastReflection_CommunityPoolSpendProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_CommunityPoolSpendProposal)
+func (x fastReflection_FeePool_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeePool)
 }
-func (x fastReflection_CommunityPoolSpendProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommunityPoolSpendProposal
+func (x fastReflection_FeePool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePool
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CommunityPoolSpendProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommunityPoolSpendProposal
+func (x *fastReflection_FeePool) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePool
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CommunityPoolSpendProposal) Type() protoreflect.MessageType {
-	return _fastReflection_CommunityPoolSpendProposal_messageType
+func (x *fastReflection_FeePool) Type() protoreflect.MessageType {
+	return _fastReflection_FeePool_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CommunityPoolSpendProposal) New() protoreflect.Message {
-	return new(fastReflection_CommunityPoolSpendProposal)
+func (x *fastReflection_FeePool) New() protoreflect.Message {
+	return new(fastReflection_FeePool)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CommunityPoolSpendProposal) Interface() protoreflect.ProtoMessage {
-	return (*CommunityPoolSpendProposal)(x)
+func (x *fastReflection_FeePool) Interface() protoreflect.ProtoMessage {
+	return (*FeePool)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4278,28 +4504,10 @@ func (x *fastReflection_CommunityPoolSpendProposal) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CommunityPoolSpendProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Title != "" {
-		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_CommunityPoolSpendProposal_title, value) {
-			return
-		}
-	}
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_CommunityPoolSpendProposal_description, value) {
-			return
-		}
-	}
-	if x.Recipient != "" {
-		value := protoreflect.ValueOfString(x.Recipient)
-		if !f(fd_CommunityPoolSpendProposal_recipient, value) {
-			return
-		}
-	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_CommunityPoolSpendProposal_4_list{list: &x.Amount})
-		if !f(fd_CommunityPoolSpendProposal_amount, value) {
+func (x *fastReflection_FeePool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CommunityPool) != 0 {
+		value := protoreflect.ValueOfList(&_FeePool_1_list{list: &x.CommunityPool})
+		if !f(fd_FeePool_community_pool, value) {
 			return
 		}
 	}
@@ -4316,21 +4524,15 @@ func (x *fastReflection_CommunityPoolSpendProposal) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CommunityPoolSpendProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeePool) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
-		return x.Title != ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
-		return x.Description != ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
-		return x.Recipient != ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
-		return len(x.Amount) != 0
+	case "cosmos.distribution.v1beta1.FeePool.community_pool":
+		return len(x.CommunityPool) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4340,21 +4542,15 @@ func (x *fastReflection_CommunityPoolSpendProposal) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeePool) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
-		x.Title = ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
-		x.Description = ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
-		x.Recipient = ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
-		x.Amount = nil
+	case "cosmos.distribution.v1beta1.FeePool.community_pool":
+		x.CommunityPool = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4364,28 +4560,19 @@ func (x *fastReflection_CommunityPoolSpendProposal) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CommunityPoolSpendProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeePool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
-		value := x.Title
-		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
-		value := x.Description
-		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
-		value := x.Recipient
-		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_CommunityPoolSpendProposal_4_list{})
+	case "cosmos.distribution.v1beta1.FeePool.community_pool":
+		if len(x.CommunityPool) == 0 {
+			return protoreflect.ValueOfList(&_FeePool_1_list{})
 		}
-		listValue := &_CommunityPoolSpendProposal_4_list{list: &x.Amount}
+		listValue := &_FeePool_1_list{list: &x.CommunityPool}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4399,23 +4586,17 @@ func (x *fastReflection_CommunityPoolSpendProposal) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeePool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
-		x.Title = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
-		x.Description = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
-		x.Recipient = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
+	case "cosmos.distribution.v1beta1.FeePool.community_pool":
 		lv := value.List()
-		clv := lv.(*_CommunityPoolSpendProposal_4_list)
-		x.Amount = *clv.list
+		clv := lv.(*_FeePool_1_list)
+		x.CommunityPool = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4429,57 +4610,45 @@ func (x *fastReflection_CommunityPoolSpendProposal) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeePool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
+	case "cosmos.distribution.v1beta1.FeePool.community_pool":
+		if x.CommunityPool == nil {
+			x.CommunityPool = []*v1beta1.DecCoin{}
 		}
-		value := &_CommunityPoolSpendProposal_4_list{list: &x.Amount}
+		value := &_FeePool_1_list{list: &x.CommunityPool}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
-		panic(fmt.Errorf("field title of message cosmos.distribution.v1beta1.CommunityPoolSpendProposal is not mutable"))
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
-		panic(fmt.Errorf("field description of message cosmos.distribution.v1beta1.CommunityPoolSpendProposal is not mutable"))
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
-		panic(fmt.Errorf("field recipient of message cosmos.distribution.v1beta1.CommunityPoolSpendProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CommunityPoolSpendProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeePool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
-		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
-		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
-		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_CommunityPoolSpendProposal_4_list{list: &list})
+	case "cosmos.distribution.v1beta1.FeePool.community_pool":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_FeePool_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.FeePool"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.FeePool does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CommunityPoolSpendProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeePool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.CommunityPoolSpendProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.FeePool", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4487,7 +4656,7 @@ func (x *fastReflection_CommunityPoolSpendProposal) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CommunityPoolSpendProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeePool) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4498,7 +4667,7 @@ func (x *fastReflection_CommunityPoolSpendProposal) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeePool) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4510,7 +4679,7 @@ func (x *fastReflection_CommunityPoolSpendProposal) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CommunityPoolSpendProposal) IsValid() bool {
+func (x *fastReflection_FeePool) IsValid() bool {
 	return x != nil
 }
 
@@ -4520,9 +4689,9 @@ func (x *fastReflection_CommunityPoolSpendProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeePool) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CommunityPoolSpendProposal)
+		x := input.Message.Interface().(*FeePool)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4534,20 +4703,8 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Title)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Description)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Recipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
+		if len(x.CommunityPool) > 0 {
+			for _, e := range x.CommunityPool {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -4562,7 +4719,7 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CommunityPoolSpendProposal)
+		x := input.Message.Interface().(*FeePool)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4581,9 +4738,9 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
+		if len(x.CommunityPool) > 0 {
+			for iNdEx := len(x.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CommunityPool[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4594,30 +4751,9 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Recipient) > 0 {
-			i -= len(x.Recipient)
-			copy(dAtA[i:], x.Recipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4629,7 +4765,7 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CommunityPoolSpendProposal)
+		x := input.Message.Interface().(*FeePool)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4661,111 +4797,15 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePool: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Title = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Description = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Recipient = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4792,8 +4832,8 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+				x.CommunityPool = append(x.CommunityPool, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommunityPool[len(x.CommunityPool)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4832,30 +4872,83 @@ func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.M
 	}
 }
 
+var _ protoreflect.List = (*_CommunityPoolSpendProposal_4_list)(nil)
+
+type _CommunityPoolSpendProposal_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CommunityPoolSpendProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_DelegatorStartingInfo                 protoreflect.MessageDescriptor
-	fd_DelegatorStartingInfo_previous_period protoreflect.FieldDescriptor
-	fd_DelegatorStartingInfo_stake           protoreflect.FieldDescriptor
-	fd_DelegatorStartingInfo_height          protoreflect.FieldDescriptor
+	md_CommunityPoolSpendProposal             protoreflect.MessageDescriptor
+	fd_CommunityPoolSpendProposal_title       protoreflect.FieldDescriptor
+	fd_CommunityPoolSpendProposal_description protoreflect.FieldDescriptor
+	fd_CommunityPoolSpendProposal_recipient   protoreflect.FieldDescriptor
+	fd_CommunityPoolSpendProposal_amount      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_DelegatorStartingInfo = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("DelegatorStartingInfo")
-	fd_DelegatorStartingInfo_previous_period = md_DelegatorStartingInfo.Fields().ByName("previous_period")
-	fd_DelegatorStartingInfo_stake = md_DelegatorStartingInfo.Fields().ByName("stake")
-	fd_DelegatorStartingInfo_height = md_DelegatorStartingInfo.Fields().ByName("height")
+	md_CommunityPoolSpendProposal = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("CommunityPoolSpendProposal")
+	fd_CommunityPoolSpendProposal_title = md_CommunityPoolSpendProposal.Fields().ByName("title")
+	fd_CommunityPoolSpendProposal_description = md_CommunityPoolSpendProposal.Fields().ByName("description")
+	fd_CommunityPoolSpendProposal_recipient = md_CommunityPoolSpendProposal.Fields().ByName("recipient")
+	fd_CommunityPoolSpendProposal_amount = md_CommunityPoolSpendProposal.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_DelegatorStartingInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_CommunityPoolSpendProposal)(nil)
 
-type fastReflection_DelegatorStartingInfo DelegatorStartingInfo
+type fastReflection_CommunityPoolSpendProposal CommunityPoolSpendProposal
 
-func (x *DelegatorStartingInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DelegatorStartingInfo)(x)
+func (x *CommunityPoolSpendProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CommunityPoolSpendProposal)(x)
 }
 
-func (x *DelegatorStartingInfo) slowProtoReflect() protoreflect.Message {
+func (x *CommunityPoolSpendProposal) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4867,43 +4960,43 @@ func (x *DelegatorStartingInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DelegatorStartingInfo_messageType fastReflection_DelegatorStartingInfo_messageType
-var _ protoreflect.MessageType = fastReflection_DelegatorStartingInfo_messageType{}
+var _fastReflection_CommunityPoolSpendProposal_messageType fastReflection_CommunityPoolSpendProposal_messageType
+var _ protoreflect.MessageType = fastReflection_CommunityPoolSpendProposal_messageType{}
 
-type fastReflection_DelegatorStartingInfo_messageType struct{}
+type fastReflection_CommunityPoolSpendProposal_messageType struct{}
 
-func (x fastReflection_DelegatorStartingInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DelegatorStartingInfo)(nil)
+func (x fastReflection_CommunityPoolSpendProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CommunityPoolSpendProposal)(nil)
 }
-func (x fastReflection_DelegatorStartingInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_DelegatorStartingInfo)
+func (x fastReflection_CommunityPoolSpendProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_CommunityPoolSpendProposal)
 }
-func (x fastReflection_DelegatorStartingInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegatorStartingInfo
+func (x fastReflection_CommunityPoolSpendProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommunityPoolSpendProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DelegatorStartingInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegatorStartingInfo
+func (x *fastReflection_CommunityPoolSpendProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommunityPoolSpendProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DelegatorStartingInfo) Type() protoreflect.MessageType {
-	return _fastReflection_DelegatorStartingInfo_messageType
+func (x *fastReflection_CommunityPoolSpendProposal) Type() protoreflect.MessageType {
+	return _fastReflection_CommunityPoolSpendProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DelegatorStartingInfo) New() protoreflect.Message {
-	return new(fastReflection_DelegatorStartingInfo)
+func (x *fastReflection_CommunityPoolSpendProposal) New() protoreflect.Message {
+	return new(fastReflection_CommunityPoolSpendProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DelegatorStartingInfo) Interface() protoreflect.ProtoMessage {
-	return (*DelegatorStartingInfo)(x)
+func (x *fastReflection_CommunityPoolSpendProposal) Interface() protoreflect.ProtoMessage {
+	return (*CommunityPoolSpendProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4911,22 +5004,28 @@ func (x *fastReflection_DelegatorStartingInfo) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DelegatorStartingInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PreviousPeriod != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.PreviousPeriod)
-		if !f(fd_DelegatorStartingInfo_previous_period, value) {
+func (x *fastReflection_CommunityPoolSpendProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_CommunityPoolSpendProposal_title, value) {
 			return
 		}
 	}
-	if x.Stake != "" {
-		value := protoreflect.ValueOfString(x.Stake)
-		if !f(fd_DelegatorStartingInfo_stake, value) {
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_CommunityPoolSpendProposal_description, value) {
 			return
 		}
 	}
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_DelegatorStartingInfo_height, value) {
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_CommunityPoolSpendProposal_recipient, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_CommunityPoolSpendProposal_4_list{list: &x.Amount})
+		if !f(fd_CommunityPoolSpendProposal_amount, value) {
 			return
 		}
 	}
@@ -4943,19 +5042,21 @@ func (x *fastReflection_DelegatorStartingInfo) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DelegatorStartingInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CommunityPoolSpendProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
-		return x.PreviousPeriod != uint64(0)
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
-		return x.Stake != ""
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
-		return x.Height != uint64(0)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
+		return x.Title != ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
+		return x.Description != ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
+		return x.Recipient != ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
+		return len(x.Amount) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4965,19 +5066,21 @@ func (x *fastReflection_DelegatorStartingInfo) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CommunityPoolSpendProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
-		x.PreviousPeriod = uint64(0)
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
-		x.Stake = ""
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
-		x.Height = uint64(0)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
+		x.Title = ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
+		x.Description = ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
+		x.Recipient = ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
+		x.Amount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4987,22 +5090,28 @@ func (x *fastReflection_DelegatorStartingInfo) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DelegatorStartingInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CommunityPoolSpendProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
-		value := x.PreviousPeriod
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
-		value := x.Stake
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
+		value := x.Title
 		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
-		value := x.Height
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_CommunityPoolSpendProposal_4_list{})
+		}
+		listValue := &_CommunityPoolSpendProposal_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5016,19 +5125,23 @@ func (x *fastReflection_DelegatorStartingInfo) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CommunityPoolSpendProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
-		x.PreviousPeriod = value.Uint()
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
-		x.Stake = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
-		x.Height = value.Uint()
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
+		x.Description = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
+		x.Recipient = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
+		lv := value.List()
+		clv := lv.(*_CommunityPoolSpendProposal_4_list)
+		x.Amount = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5042,48 +5155,57 @@ func (x *fastReflection_DelegatorStartingInfo) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CommunityPoolSpendProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
-		panic(fmt.Errorf("field previous_period of message cosmos.distribution.v1beta1.DelegatorStartingInfo is not mutable"))
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
-		panic(fmt.Errorf("field stake of message cosmos.distribution.v1beta1.DelegatorStartingInfo is not mutable"))
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
-		panic(fmt.Errorf("field height of message cosmos.distribution.v1beta1.DelegatorStartingInfo is not mutable"))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_CommunityPoolSpendProposal_4_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
+		panic(fmt.Errorf("field title of message cosmos.distribution.v1beta1.CommunityPoolSpendProposal is not mutable"))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
+		panic(fmt.Errorf("field description of message cosmos.distribution.v1beta1.CommunityPoolSpendProposal is not mutable"))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
+		panic(fmt.Errorf("field recipient of message cosmos.distribution.v1beta1.CommunityPoolSpendProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DelegatorStartingInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CommunityPoolSpendProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.title":
 		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.description":
+		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.recipient":
+		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_CommunityPoolSpendProposal_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DelegatorStartingInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CommunityPoolSpendProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.DelegatorStartingInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.CommunityPoolSpendProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5091,7 +5213,7 @@ func (x *fastReflection_DelegatorStartingInfo) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DelegatorStartingInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CommunityPoolSpendProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5102,7 +5224,7 @@ func (x *fastReflection_DelegatorStartingInfo) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegatorStartingInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CommunityPoolSpendProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5114,7 +5236,7 @@ func (x *fastReflection_DelegatorStartingInfo) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DelegatorStartingInfo) IsValid() bool {
+func (x *fastReflection_CommunityPoolSpendProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -5124,9 +5246,9 @@ func (x *fastReflection_DelegatorStartingInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CommunityPoolSpendProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DelegatorStartingInfo)
+		x := input.Message.Interface().(*CommunityPoolSpendProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5138,15 +5260,23 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.PreviousPeriod != 0 {
-			n += 1 + runtime.Sov(uint64(x.PreviousPeriod))
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Stake)
+		l = len(x.Description)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5158,7 +5288,7 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DelegatorStartingInfo)
+		x := input.Message.Interface().(*CommunityPoolSpendProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5177,22 +5307,42 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.Stake) > 0 {
-			i -= len(x.Stake)
-			copy(dAtA[i:], x.Stake)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stake)))
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.PreviousPeriod != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousPeriod))
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5205,7 +5355,7 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DelegatorStartingInfo)
+		x := input.Message.Interface().(*CommunityPoolSpendProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5237,17 +5387,17 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 				}
-				x.PreviousPeriod = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5257,14 +5407,27 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PreviousPeriod |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5292,13 +5455,13 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Stake = string(dAtA[iNdEx:postIndex])
+				x.Description = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 				}
-				x.Height = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5308,11 +5471,58 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5348,79 +5558,30 @@ func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_DelegationDelegatorReward_2_list)(nil)
-
-type _DelegationDelegatorReward_2_list struct {
-	list *[]*v1beta1.DecCoin
-}
-
-func (x *_DelegationDelegatorReward_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DelegationDelegatorReward_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DelegationDelegatorReward_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DelegationDelegatorReward_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DelegationDelegatorReward_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DelegationDelegatorReward_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DelegationDelegatorReward_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.DecCoin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DelegationDelegatorReward_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DelegationDelegatorReward                   protoreflect.MessageDescriptor
-	fd_DelegationDelegatorReward_validator_address protoreflect.FieldDescriptor
-	fd_DelegationDelegatorReward_reward            protoreflect.FieldDescriptor
+	md_DelegatorStartingInfo                 protoreflect.MessageDescriptor
+	fd_DelegatorStartingInfo_previous_period protoreflect.FieldDescriptor
+	fd_DelegatorStartingInfo_stake           protoreflect.FieldDescriptor
+	fd_DelegatorStartingInfo_height          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_DelegationDelegatorReward = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("DelegationDelegatorReward")
-	fd_DelegationDelegatorReward_validator_address = md_DelegationDelegatorReward.Fields().ByName("validator_address")
-	fd_DelegationDelegatorReward_reward = md_DelegationDelegatorReward.Fields().ByName("reward")
+	md_DelegatorStartingInfo = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("DelegatorStartingInfo")
+	fd_DelegatorStartingInfo_previous_period = md_DelegatorStartingInfo.Fields().ByName("previous_period")
+	fd_DelegatorStartingInfo_stake = md_DelegatorStartingInfo.Fields().ByName("stake")
+	fd_DelegatorStartingInfo_height = md_DelegatorStartingInfo.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_DelegationDelegatorReward)(nil)
+var _ protoreflect.Message = (*fastReflection_DelegatorStartingInfo)(nil)
 
-type fastReflection_DelegationDelegatorReward DelegationDelegatorReward
+type fastReflection_DelegatorStartingInfo DelegatorStartingInfo
 
-func (x *DelegationDelegatorReward) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DelegationDelegatorReward)(x)
+func (x *DelegatorStartingInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegatorStartingInfo)(x)
 }
 
-func (x *DelegationDelegatorReward) slowProtoReflect() protoreflect.Message {
+func (x *DelegatorStartingInfo) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5432,43 +5593,43 @@ func (x *DelegationDelegatorReward) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DelegationDelegatorReward_messageType fastReflection_DelegationDelegatorReward_messageType
-var _ protoreflect.MessageType = fastReflection_DelegationDelegatorReward_messageType{}
+var _fastReflection_DelegatorStartingInfo_messageType fastReflection_DelegatorStartingInfo_messageType
+var _ protoreflect.MessageType = fastReflection_DelegatorStartingInfo_messageType{}
 
-type fastReflection_DelegationDelegatorReward_messageType struct{}
+type fastReflection_DelegatorStartingInfo_messageType struct{}
 
-func (x fastReflection_DelegationDelegatorReward_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DelegationDelegatorReward)(nil)
+func (x fastReflection_DelegatorStartingInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegatorStartingInfo)(nil)
 }
-func (x fastReflection_DelegationDelegatorReward_messageType) New() protoreflect.Message {
-	return new(fastReflection_DelegationDelegatorReward)
+func (x fastReflection_DelegatorStartingInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegatorStartingInfo)
 }
-func (x fastReflection_DelegationDelegatorReward_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegationDelegatorReward
+func (x fastReflection_DelegatorStartingInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorStartingInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DelegationDelegatorReward) Descriptor() protoreflect.MessageDescriptor {
-	return md_DelegationDelegatorReward
+func (x *fastReflection_DelegatorStartingInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegatorStartingInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DelegationDelegatorReward) Type() protoreflect.MessageType {
-	return _fastReflection_DelegationDelegatorReward_messageType
+func (x *fastReflection_DelegatorStartingInfo) Type() protoreflect.MessageType {
+	return _fastReflection_DelegatorStartingInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DelegationDelegatorReward) New() protoreflect.Message {
-	return new(fastReflection_DelegationDelegatorReward)
+func (x *fastReflection_DelegatorStartingInfo) New() protoreflect.Message {
+	return new(fastReflection_DelegatorStartingInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DelegationDelegatorReward) Interface() protoreflect.ProtoMessage {
-	return (*DelegationDelegatorReward)(x)
+func (x *fastReflection_DelegatorStartingInfo) Interface() protoreflect.ProtoMessage {
+	return (*DelegatorStartingInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5476,16 +5637,22 @@ func (x *fastReflection_DelegationDelegatorReward) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DelegationDelegatorReward) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ValidatorAddress != "" {
-		value := protoreflect.ValueOfString(x.ValidatorAddress)
-		if !f(fd_DelegationDelegatorReward_validator_address, value) {
+func (x *fastReflection_DelegatorStartingInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PreviousPeriod)
+		if !f(fd_DelegatorStartingInfo_previous_period, value) {
 			return
 		}
 	}
-	if len(x.Reward) != 0 {
-		value := protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &x.Reward})
-		if !f(fd_DelegationDelegatorReward_reward, value) {
+	if x.Stake != "" {
+		value := protoreflect.ValueOfString(x.Stake)
+		if !f(fd_DelegatorStartingInfo_stake, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_DelegatorStartingInfo_height, value) {
 			return
 		}
 	}
@@ -5502,17 +5669,19 @@ func (x *fastReflection_DelegationDelegatorReward) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DelegationDelegatorReward) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DelegatorStartingInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
-		return x.ValidatorAddress != ""
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
-		return len(x.Reward) != 0
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
+		return x.PreviousPeriod != uint64(0)
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
+		return x.Stake != ""
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
+		return x.Height != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5522,17 +5691,19 @@ func (x *fastReflection_DelegationDelegatorReward) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DelegatorStartingInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
-		x.ValidatorAddress = ""
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
-		x.Reward = nil
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
+		x.PreviousPeriod = uint64(0)
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
+		x.Stake = ""
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
+		x.Height = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5542,22 +5713,22 @@ func (x *fastReflection_DelegationDelegatorReward) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DelegationDelegatorReward) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegatorStartingInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
-		value := x.ValidatorAddress
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
+		value := x.PreviousPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
+		value := x.Stake
 		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
-		if len(x.Reward) == 0 {
-			return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{})
-		}
-		listValue := &_DelegationDelegatorReward_2_list{list: &x.Reward}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5571,19 +5742,19 @@ func (x *fastReflection_DelegationDelegatorReward) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DelegatorStartingInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
-		x.ValidatorAddress = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
-		lv := value.List()
-		clv := lv.(*_DelegationDelegatorReward_2_list)
-		x.Reward = *clv.list
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
+		x.PreviousPeriod = value.Uint()
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
+		x.Stake = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
+		x.Height = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5597,49 +5768,48 @@ func (x *fastReflection_DelegationDelegatorReward) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegatorStartingInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
-		if x.Reward == nil {
-			x.Reward = []*v1beta1.DecCoin{}
-		}
-		value := &_DelegationDelegatorReward_2_list{list: &x.Reward}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
-		panic(fmt.Errorf("field validator_address of message cosmos.distribution.v1beta1.DelegationDelegatorReward is not mutable"))
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
+		panic(fmt.Errorf("field previous_period of message cosmos.distribution.v1beta1.DelegatorStartingInfo is not mutable"))
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
+		panic(fmt.Errorf("field stake of message cosmos.distribution.v1beta1.DelegatorStartingInfo is not mutable"))
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
+		panic(fmt.Errorf("field height of message cosmos.distribution.v1beta1.DelegatorStartingInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DelegationDelegatorReward) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegatorStartingInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.previous_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.stake":
 		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
-		list := []*v1beta1.DecCoin{}
-		return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &list})
+	case "cosmos.distribution.v1beta1.DelegatorStartingInfo.height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegatorStartingInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegatorStartingInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DelegationDelegatorReward) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DelegatorStartingInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.DelegationDelegatorReward", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.DelegatorStartingInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5647,7 +5817,7 @@ func (x *fastReflection_DelegationDelegatorReward) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DelegationDelegatorReward) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DelegatorStartingInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5658,7 +5828,7 @@ func (x *fastReflection_DelegationDelegatorReward) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DelegationDelegatorReward) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DelegatorStartingInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5670,7 +5840,7 @@ func (x *fastReflection_DelegationDelegatorReward) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DelegationDelegatorReward) IsValid() bool {
+func (x *fastReflection_DelegatorStartingInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -5680,9 +5850,9 @@ func (x *fastReflection_DelegationDelegatorReward) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DelegatorStartingInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DelegationDelegatorReward)
+		x := input.Message.Interface().(*DelegatorStartingInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5694,15 +5864,15 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValidatorAddress)
+		if x.PreviousPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousPeriod))
+		}
+		l = len(x.Stake)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Reward) > 0 {
-			for _, e := range x.Reward {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5714,7 +5884,7 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DelegationDelegatorReward)
+		x := input.Message.Interface().(*DelegatorStartingInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5733,28 +5903,22 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Reward) > 0 {
-			for iNdEx := len(x.Reward) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Reward[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
 		}
-		if len(x.ValidatorAddress) > 0 {
-			i -= len(x.ValidatorAddress)
-			copy(dAtA[i:], x.ValidatorAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
+		if len(x.Stake) > 0 {
+			i -= len(x.Stake)
+			copy(dAtA[i:], x.Stake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stake)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.PreviousPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousPeriod))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5767,7 +5931,7 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DelegationDelegatorReward)
+		x := input.Message.Interface().(*DelegatorStartingInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5799,15 +5963,34 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+				}
+				x.PreviousPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5835,13 +6018,13 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+				x.Stake = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5851,26 +6034,11 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Reward = append(x.Reward, &v1beta1.DecCoin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reward[len(x.Reward)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5906,34 +6074,79 @@ func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_DelegationDelegatorReward_2_list)(nil)
+
+type _DelegationDelegatorReward_2_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_DelegationDelegatorReward_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DelegationDelegatorReward_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_DelegationDelegatorReward_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DelegationDelegatorReward_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DelegationDelegatorReward_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegationDelegatorReward_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DelegationDelegatorReward_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_DelegationDelegatorReward_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_CommunityPoolSpendProposalWithDeposit             protoreflect.MessageDescriptor
-	fd_CommunityPoolSpendProposalWithDeposit_title       protoreflect.FieldDescriptor
-	fd_CommunityPoolSpendProposalWithDeposit_description protoreflect.FieldDescriptor
-	fd_CommunityPoolSpendProposalWithDeposit_recipient   protoreflect.FieldDescriptor
-	fd_CommunityPoolSpendProposalWithDeposit_amount      protoreflect.FieldDescriptor
-	fd_CommunityPoolSpendProposalWithDeposit_deposit     protoreflect.FieldDescriptor
+	md_DelegationDelegatorReward                   protoreflect.MessageDescriptor
+	fd_DelegationDelegatorReward_validator_address protoreflect.FieldDescriptor
+	fd_DelegationDelegatorReward_reward            protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_distribution_v1beta1_distribution_proto_init()
-	md_CommunityPoolSpendProposalWithDeposit = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("CommunityPoolSpendProposalWithDeposit")
-	fd_CommunityPoolSpendProposalWithDeposit_title = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("title")
-	fd_CommunityPoolSpendProposalWithDeposit_description = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("description")
-	fd_CommunityPoolSpendProposalWithDeposit_recipient = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("recipient")
-	fd_CommunityPoolSpendProposalWithDeposit_amount = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("amount")
-	fd_CommunityPoolSpendProposalWithDeposit_deposit = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("deposit")
+	md_DelegationDelegatorReward = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("DelegationDelegatorReward")
+	fd_DelegationDelegatorReward_validator_address = md_DelegationDelegatorReward.Fields().ByName("validator_address")
+	fd_DelegationDelegatorReward_reward = md_DelegationDelegatorReward.Fields().ByName("reward")
 }
 
-var _ protoreflect.Message = (*fastReflection_CommunityPoolSpendProposalWithDeposit)(nil)
+var _ protoreflect.Message = (*fastReflection_DelegationDelegatorReward)(nil)
 
-type fastReflection_CommunityPoolSpendProposalWithDeposit CommunityPoolSpendProposalWithDeposit
+type fastReflection_DelegationDelegatorReward DelegationDelegatorReward
 
-func (x *CommunityPoolSpendProposalWithDeposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CommunityPoolSpendProposalWithDeposit)(x)
+func (x *DelegationDelegatorReward) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DelegationDelegatorReward)(x)
 }
 
-func (x *CommunityPoolSpendProposalWithDeposit) slowProtoReflect() protoreflect.Message {
+func (x *DelegationDelegatorReward) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5945,43 +6158,43 @@ func (x *CommunityPoolSpendProposalWithDeposit) slowProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CommunityPoolSpendProposalWithDeposit_messageType fastReflection_CommunityPoolSpendProposalWithDeposit_messageType
-var _ protoreflect.MessageType = fastReflection_CommunityPoolSpendProposalWithDeposit_messageType{}
+var _fastReflection_DelegationDelegatorReward_messageType fastReflection_DelegationDelegatorReward_messageType
+var _ protoreflect.MessageType = fastReflection_DelegationDelegatorReward_messageType{}
 
-type fastReflection_CommunityPoolSpendProposalWithDeposit_messageType struct{}
+type fastReflection_DelegationDelegatorReward_messageType struct{}
 
-func (x fastReflection_CommunityPoolSpendProposalWithDeposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CommunityPoolSpendProposalWithDeposit)(nil)
+func (x fastReflection_DelegationDelegatorReward_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DelegationDelegatorReward)(nil)
 }
-func (x fastReflection_CommunityPoolSpendProposalWithDeposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_CommunityPoolSpendProposalWithDeposit)
+func (x fastReflection_DelegationDelegatorReward_messageType) New() protoreflect.Message {
+	return new(fastReflection_DelegationDelegatorReward)
 }
-func (x fastReflection_CommunityPoolSpendProposalWithDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommunityPoolSpendProposalWithDeposit
+func (x fastReflection_DelegationDelegatorReward_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationDelegatorReward
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_CommunityPoolSpendProposalWithDeposit
+func (x *fastReflection_DelegationDelegatorReward) Descriptor() protoreflect.MessageDescriptor {
+	return md_DelegationDelegatorReward
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Type() protoreflect.MessageType {
-	return _fastReflection_CommunityPoolSpendProposalWithDeposit_messageType
+func (x *fastReflection_DelegationDelegatorReward) Type() protoreflect.MessageType {
+	return _fastReflection_DelegationDelegatorReward_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) New() protoreflect.Message {
-	return new(fastReflection_CommunityPoolSpendProposalWithDeposit)
+func (x *fastReflection_DelegationDelegatorReward) New() protoreflect.Message {
+	return new(fastReflection_DelegationDelegatorReward)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Interface() protoreflect.ProtoMessage {
-	return (*CommunityPoolSpendProposalWithDeposit)(x)
+func (x *fastReflection_DelegationDelegatorReward) Interface() protoreflect.ProtoMessage {
+	return (*DelegationDelegatorReward)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5989,34 +6202,16 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Interface() proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Title != "" {
-		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_CommunityPoolSpendProposalWithDeposit_title, value) {
-			return
-		}
-	}
-	if x.Description != "" {
-		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_CommunityPoolSpendProposalWithDeposit_description, value) {
-			return
-		}
-	}
-	if x.Recipient != "" {
-		value := protoreflect.ValueOfString(x.Recipient)
-		if !f(fd_CommunityPoolSpendProposalWithDeposit_recipient, value) {
-			return
-		}
-	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_CommunityPoolSpendProposalWithDeposit_amount, value) {
+func (x *fastReflection_DelegationDelegatorReward) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorAddress != "" {
+		value := protoreflect.ValueOfString(x.ValidatorAddress)
+		if !f(fd_DelegationDelegatorReward_validator_address, value) {
 			return
 		}
 	}
-	if x.Deposit != "" {
-		value := protoreflect.ValueOfString(x.Deposit)
-		if !f(fd_CommunityPoolSpendProposalWithDeposit_deposit, value) {
+	if len(x.Reward) != 0 {
+		value := protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &x.Reward})
+		if !f(fd_DelegationDelegatorReward_reward, value) {
 			return
 		}
 	}
@@ -6033,23 +6228,17 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Range(f func(prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DelegationDelegatorReward) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
-		return x.Title != ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
-		return x.Description != ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
-		return x.Recipient != ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
-		return x.Amount != ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
-		return x.Deposit != ""
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
+		return x.ValidatorAddress != ""
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
+		return len(x.Reward) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6059,23 +6248,17 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Has(fd protorefle
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DelegationDelegatorReward) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
-		x.Title = ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
-		x.Description = ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
-		x.Recipient = ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
-		x.Amount = ""
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
-		x.Deposit = ""
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
+		x.ValidatorAddress = ""
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
+		x.Reward = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6085,28 +6268,22 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Clear(fd protoref
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegationDelegatorReward) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
-		value := x.Title
-		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
-		value := x.Description
-		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
-		value := x.Recipient
-		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
-		value := x.Amount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
-		value := x.Deposit
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
+		value := x.ValidatorAddress
 		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
+		if len(x.Reward) == 0 {
+			return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{})
+		}
+		listValue := &_DelegationDelegatorReward_2_list{list: &x.Reward}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6120,23 +6297,19 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Get(descriptor pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DelegationDelegatorReward) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
-		x.Title = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
-		x.Description = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
-		x.Recipient = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
-		x.Amount = value.Interface().(string)
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
-		x.Deposit = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
+		x.ValidatorAddress = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
+		lv := value.List()
+		clv := lv.(*_DelegationDelegatorReward_2_list)
+		x.Reward = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6150,56 +6323,49 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Set(fd protorefle
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegationDelegatorReward) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
-		panic(fmt.Errorf("field title of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
-		panic(fmt.Errorf("field description of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
-		panic(fmt.Errorf("field recipient of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
-		panic(fmt.Errorf("field amount of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
-		panic(fmt.Errorf("field deposit of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
+		if x.Reward == nil {
+			x.Reward = []*v1beta1.DecCoin{}
+		}
+		value := &_DelegationDelegatorReward_2_list{list: &x.Reward}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
+		panic(fmt.Errorf("field validator_address of message cosmos.distribution.v1beta1.DelegationDelegatorReward is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DelegationDelegatorReward) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
-		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
-		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
-		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
-		return protoreflect.ValueOfString("")
-	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.validator_address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.DelegationDelegatorReward.reward":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_DelegationDelegatorReward_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.DelegationDelegatorReward"))
 		}
-		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.DelegationDelegatorReward does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DelegationDelegatorReward) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.DelegationDelegatorReward", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6207,7 +6373,7 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) WhichOneof(d prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DelegationDelegatorReward) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6218,7 +6384,7 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) GetUnknown() prot
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DelegationDelegatorReward) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6230,7 +6396,7 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) IsValid() bool {
+func (x *fastReflection_DelegationDelegatorReward) IsValid() bool {
 	return x != nil
 }
 
@@ -6240,9 +6406,9 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DelegationDelegatorReward) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CommunityPoolSpendProposalWithDeposit)
+		x := input.Message.Interface().(*DelegationDelegatorReward)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6254,25 +6420,15 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 		var n int
 		var l int
 		_ = l
-		l = len(x.Title)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Description)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Recipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Amount)
+		l = len(x.ValidatorAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Deposit)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Reward) > 0 {
+			for _, e := range x.Reward {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6284,7 +6440,7 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CommunityPoolSpendProposalWithDeposit)
+		x := input.Message.Interface().(*DelegationDelegatorReward)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6303,38 +6459,26 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Deposit) > 0 {
-			i -= len(x.Deposit)
-			copy(dAtA[i:], x.Deposit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Recipient) > 0 {
-			i -= len(x.Recipient)
-			copy(dAtA[i:], x.Recipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Description) > 0 {
-			i -= len(x.Description)
-			copy(dAtA[i:], x.Description)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Reward) > 0 {
+			for iNdEx := len(x.Reward) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Reward[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+		if len(x.ValidatorAddress) > 0 {
+			i -= len(x.ValidatorAddress)
+			copy(dAtA[i:], x.ValidatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6349,7 +6493,7 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CommunityPoolSpendProposalWithDeposit)
+		x := input.Message.Interface().(*DelegationDelegatorReward)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6381,15 +6525,15 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6417,13 +6561,13 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Title = string(dAtA[iNdEx:postIndex])
+				x.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6433,61 +6577,1201 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Description = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				x.Reward = append(x.Reward, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reward[len(x.Reward)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CommunityPoolSpendProposalWithDeposit             protoreflect.MessageDescriptor
+	fd_CommunityPoolSpendProposalWithDeposit_title       protoreflect.FieldDescriptor
+	fd_CommunityPoolSpendProposalWithDeposit_description protoreflect.FieldDescriptor
+	fd_CommunityPoolSpendProposalWithDeposit_recipient   protoreflect.FieldDescriptor
+	fd_CommunityPoolSpendProposalWithDeposit_amount      protoreflect.FieldDescriptor
+	fd_CommunityPoolSpendProposalWithDeposit_deposit     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_distribution_v1beta1_distribution_proto_init()
+	md_CommunityPoolSpendProposalWithDeposit = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("CommunityPoolSpendProposalWithDeposit")
+	fd_CommunityPoolSpendProposalWithDeposit_title = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("title")
+	fd_CommunityPoolSpendProposalWithDeposit_description = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("description")
+	fd_CommunityPoolSpendProposalWithDeposit_recipient = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("recipient")
+	fd_CommunityPoolSpendProposalWithDeposit_amount = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("amount")
+	fd_CommunityPoolSpendProposalWithDeposit_deposit = md_CommunityPoolSpendProposalWithDeposit.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_CommunityPoolSpendProposalWithDeposit)(nil)
+
+type fastReflection_CommunityPoolSpendProposalWithDeposit CommunityPoolSpendProposalWithDeposit
+
+func (x *CommunityPoolSpendProposalWithDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CommunityPoolSpendProposalWithDeposit)(x)
+}
+
+func (x *CommunityPoolSpendProposalWithDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CommunityPoolSpendProposalWithDeposit_messageType fastReflection_CommunityPoolSpendProposalWithDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_CommunityPoolSpendProposalWithDeposit_messageType{}
+
+type fastReflection_CommunityPoolSpendProposalWithDeposit_messageType struct{}
+
+func (x fastReflection_CommunityPoolSpendProposalWithDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CommunityPoolSpendProposalWithDeposit)(nil)
+}
+func (x fastReflection_CommunityPoolSpendProposalWithDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_CommunityPoolSpendProposalWithDeposit)
+}
+func (x fastReflection_CommunityPoolSpendProposalWithDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommunityPoolSpendProposalWithDeposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_CommunityPoolSpendProposalWithDeposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_CommunityPoolSpendProposalWithDeposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) New() protoreflect.Message {
+	return new(fastReflection_CommunityPoolSpendProposalWithDeposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Interface() protoreflect.ProtoMessage {
+	return (*CommunityPoolSpendProposalWithDeposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_CommunityPoolSpendProposalWithDeposit_title, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_CommunityPoolSpendProposalWithDeposit_description, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_CommunityPoolSpendProposalWithDeposit_recipient, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_CommunityPoolSpendProposalWithDeposit_amount, value) {
+			return
+		}
+	}
+	if x.Deposit != "" {
+		value := protoreflect.ValueOfString(x.Deposit)
+		if !f(fd_CommunityPoolSpendProposalWithDeposit_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
+		return x.Title != ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
+		return x.Description != ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
+		return x.Recipient != ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
+		return x.Amount != ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
+		return x.Deposit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
+		x.Title = ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
+		x.Description = ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
+		x.Recipient = ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
+		x.Amount = ""
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
+		x.Deposit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
+		x.Description = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
+		x.Recipient = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
+		x.Amount = value.Interface().(string)
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
+		x.Deposit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
+		panic(fmt.Errorf("field title of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
+		panic(fmt.Errorf("field description of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
+		panic(fmt.Errorf("field recipient of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
+		panic(fmt.Errorf("field amount of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
+		panic(fmt.Errorf("field deposit of message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.title":
+		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.description":
+		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.recipient":
+		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.amount":
+		return protoreflect.ValueOfString("")
+	case "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit.deposit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CommunityPoolSpendProposalWithDeposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CommunityPoolSpendProposalWithDeposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Deposit) > 0 {
+			i -= len(x.Deposit)
+			copy(dAtA[i:], x.Deposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CommunityPoolSpendProposalWithDeposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AutoCommissionWithdraw_1_list)(nil)
+
+type _AutoCommissionWithdraw_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_AutoCommissionWithdraw_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AutoCommissionWithdraw_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_AutoCommissionWithdraw_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AutoCommissionWithdraw_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AutoCommissionWithdraw_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AutoCommissionWithdraw_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AutoCommissionWithdraw_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_AutoCommissionWithdraw_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AutoCommissionWithdraw           protoreflect.MessageDescriptor
+	fd_AutoCommissionWithdraw_threshold protoreflect.FieldDescriptor
+	fd_AutoCommissionWithdraw_enabled   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_distribution_v1beta1_distribution_proto_init()
+	md_AutoCommissionWithdraw = File_cosmos_distribution_v1beta1_distribution_proto.Messages().ByName("AutoCommissionWithdraw")
+	fd_AutoCommissionWithdraw_threshold = md_AutoCommissionWithdraw.Fields().ByName("threshold")
+	fd_AutoCommissionWithdraw_enabled = md_AutoCommissionWithdraw.Fields().ByName("enabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_AutoCommissionWithdraw)(nil)
+
+type fastReflection_AutoCommissionWithdraw AutoCommissionWithdraw
+
+func (x *AutoCommissionWithdraw) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AutoCommissionWithdraw)(x)
+}
+
+func (x *AutoCommissionWithdraw) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AutoCommissionWithdraw_messageType fastReflection_AutoCommissionWithdraw_messageType
+var _ protoreflect.MessageType = fastReflection_AutoCommissionWithdraw_messageType{}
+
+type fastReflection_AutoCommissionWithdraw_messageType struct{}
+
+func (x fastReflection_AutoCommissionWithdraw_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AutoCommissionWithdraw)(nil)
+}
+func (x fastReflection_AutoCommissionWithdraw_messageType) New() protoreflect.Message {
+	return new(fastReflection_AutoCommissionWithdraw)
+}
+func (x fastReflection_AutoCommissionWithdraw_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoCommissionWithdraw
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AutoCommissionWithdraw) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoCommissionWithdraw
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AutoCommissionWithdraw) Type() protoreflect.MessageType {
+	return _fastReflection_AutoCommissionWithdraw_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AutoCommissionWithdraw) New() protoreflect.Message {
+	return new(fastReflection_AutoCommissionWithdraw)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AutoCommissionWithdraw) Interface() protoreflect.ProtoMessage {
+	return (*AutoCommissionWithdraw)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AutoCommissionWithdraw) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Threshold) != 0 {
+		value := protoreflect.ValueOfList(&_AutoCommissionWithdraw_1_list{list: &x.Threshold})
+		if !f(fd_AutoCommissionWithdraw_threshold, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_AutoCommissionWithdraw_enabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AutoCommissionWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.threshold":
+		return len(x.Threshold) != 0
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.enabled":
+		return x.Enabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.AutoCommissionWithdraw"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.AutoCommissionWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCommissionWithdraw) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.threshold":
+		x.Threshold = nil
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.enabled":
+		x.Enabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.AutoCommissionWithdraw"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.AutoCommissionWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AutoCommissionWithdraw) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.threshold":
+		if len(x.Threshold) == 0 {
+			return protoreflect.ValueOfList(&_AutoCommissionWithdraw_1_list{})
+		}
+		listValue := &_AutoCommissionWithdraw_1_list{list: &x.Threshold}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.AutoCommissionWithdraw"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.AutoCommissionWithdraw does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCommissionWithdraw) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.threshold":
+		lv := value.List()
+		clv := lv.(*_AutoCommissionWithdraw_1_list)
+		x.Threshold = *clv.list
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.enabled":
+		x.Enabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.AutoCommissionWithdraw"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.AutoCommissionWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCommissionWithdraw) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.threshold":
+		if x.Threshold == nil {
+			x.Threshold = []*v1beta1.Coin{}
+		}
+		value := &_AutoCommissionWithdraw_1_list{list: &x.Threshold}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.enabled":
+		panic(fmt.Errorf("field enabled of message cosmos.distribution.v1beta1.AutoCommissionWithdraw is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.AutoCommissionWithdraw"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.AutoCommissionWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AutoCommissionWithdraw) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.threshold":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_AutoCommissionWithdraw_1_list{list: &list})
+	case "cosmos.distribution.v1beta1.AutoCommissionWithdraw.enabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.distribution.v1beta1.AutoCommissionWithdraw"))
+		}
+		panic(fmt.Errorf("message cosmos.distribution.v1beta1.AutoCommissionWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AutoCommissionWithdraw) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.distribution.v1beta1.AutoCommissionWithdraw", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AutoCommissionWithdraw) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCommissionWithdraw) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AutoCommissionWithdraw) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AutoCommissionWithdraw) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AutoCommissionWithdraw)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Threshold) > 0 {
+			for _, e := range x.Threshold {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AutoCommissionWithdraw)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Threshold) > 0 {
+			for iNdEx := len(x.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Threshold[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AutoCommissionWithdraw)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Recipient = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoCommissionWithdraw: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoCommissionWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6497,29 +7781,31 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Threshold = append(x.Threshold, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Threshold[len(x.Threshold)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6529,24 +7815,12 @@ func (x *fastReflection_CommunityPoolSpendProposalWithDeposit) ProtoMethods() *p
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Deposit = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
+				x.Enabled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6613,6 +7887,18 @@ type Params struct {
 	// Deprecated: Do not use.
 	BonusProposerReward string `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3" json:"bonus_proposer_reward,omitempty"`
 	WithdrawAddrEnabled bool   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// signing_performance_reward_share is the portion of the validators rewards
+	// which is allocated proportionally to the voting power weighted by the
+	// signature inclusion consistency of the validators over the last
+	// signing_performance_window blocks. Zero disables this allocation mode.
+	SigningPerformanceRewardShare string `protobuf:"bytes,5,opt,name=signing_performance_reward_share,json=signingPerformanceRewardShare,proto3" json:"signing_performance_reward_share,omitempty"`
+	// signing_performance_window is the number of blocks over which the
+	// signature inclusion consistency of the validators is measured.
+	SigningPerformanceWindow int64 `protobuf:"varint,6,opt,name=signing_performance_window,json=signingPerformanceWindow,proto3" json:"signing_performance_window,omitempty"`
+	// max_withdraw_per_msg is the maximum number of delegations a delegator can
+	// withdraw the rewards of with a single MsgWithdrawAllDelegatorRewards. Zero
+	// means no limit.
+	MaxWithdrawPerMsg uint32 `protobuf:"varint,7,opt,name=max_withdraw_per_msg,json=maxWithdrawPerMsg,proto3" json:"max_withdraw_per_msg,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -6665,6 +7951,95 @@ func (x *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (x *Params) GetSigningPerformanceRewardShare() string {
+	if x != nil {
+		return x.SigningPerformanceRewardShare
+	}
+	return ""
+}
+
+func (x *Params) GetSigningPerformanceWindow() int64 {
+	if x != nil {
+		return x.SigningPerformanceWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxWithdrawPerMsg() uint32 {
+	if x != nil {
+		return x.MaxWithdrawPerMsg
+	}
+	return 0
+}
+
+// ValidatorSignatureWindow is the rolling window of the inclusion of the
+// signatures of a validator in the last commits, used to measure its signature
+// inclusion consistency.
+type ValidatorSignatureWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window is the size of the window.
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// index is the number of blocks recorded since the window was created.
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// missed is a bit array of the blocks of the window whose commit didn't
+	// include the validator signature, a block is at the bit index%window.
+	Missed []byte `protobuf:"bytes,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	// missed_count is the number of blocks of the window whose commit didn't
+	// include the validator signature.
+	MissedCount int64 `protobuf:"varint,4,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+}
+
+func (x *ValidatorSignatureWindow) Reset() {
+	*x = ValidatorSignatureWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSignatureWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSignatureWindow) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSignatureWindow.ProtoReflect.Descriptor instead.
+func (*ValidatorSignatureWindow) Descriptor() ([]byte, []int) {
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorSignatureWindow) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *ValidatorSignatureWindow) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorSignatureWindow) GetMissed() []byte {
+	if x != nil {
+		return x.Missed
+	}
+	return nil
+}
+
+func (x *ValidatorSignatureWindow) GetMissedCount() int64 {
+	if x != nil {
+		return x.MissedCount
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -6690,7 +8065,7 @@ type ValidatorHistoricalRewards struct {
 func (x *ValidatorHistoricalRewards) Reset() {
 	*x = ValidatorHistoricalRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[1]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6704,7 +8079,7 @@ func (*ValidatorHistoricalRewards) ProtoMessage() {}
 
 // Deprecated: Use ValidatorHistoricalRewards.ProtoReflect.Descriptor instead.
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ValidatorHistoricalRewards) GetCumulativeRewardRatio() []*v1beta1.DecCoin {
@@ -6736,7 +8111,7 @@ type ValidatorCurrentRewards struct {
 func (x *ValidatorCurrentRewards) Reset() {
 	*x = ValidatorCurrentRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[2]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6750,7 +8125,7 @@ func (*ValidatorCurrentRewards) ProtoMessage() {}
 
 // Deprecated: Use ValidatorCurrentRewards.ProtoReflect.Descriptor instead.
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ValidatorCurrentRewards) GetRewards() []*v1beta1.DecCoin {
@@ -6780,7 +8155,7 @@ type ValidatorAccumulatedCommission struct {
 func (x *ValidatorAccumulatedCommission) Reset() {
 	*x = ValidatorAccumulatedCommission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[3]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6794,7 +8169,7 @@ func (*ValidatorAccumulatedCommission) ProtoMessage() {}
 
 // Deprecated: Use ValidatorAccumulatedCommission.ProtoReflect.Descriptor instead.
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ValidatorAccumulatedCommission) GetCommission() []*v1beta1.DecCoin {
@@ -6817,7 +8192,7 @@ type ValidatorOutstandingRewards struct {
 func (x *ValidatorOutstandingRewards) Reset() {
 	*x = ValidatorOutstandingRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[4]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6831,7 +8206,7 @@ func (*ValidatorOutstandingRewards) ProtoMessage() {}
 
 // Deprecated: Use ValidatorOutstandingRewards.ProtoReflect.Descriptor instead.
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ValidatorOutstandingRewards) GetRewards() []*v1beta1.DecCoin {
@@ -6857,7 +8232,7 @@ type ValidatorSlashEvent struct {
 func (x *ValidatorSlashEvent) Reset() {
 	*x = ValidatorSlashEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[5]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6871,7 +8246,7 @@ func (*ValidatorSlashEvent) ProtoMessage() {}
 
 // Deprecated: Use ValidatorSlashEvent.ProtoReflect.Descriptor instead.
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ValidatorSlashEvent) GetValidatorPeriod() uint64 {
@@ -6900,7 +8275,7 @@ type ValidatorSlashEvents struct {
 func (x *ValidatorSlashEvents) Reset() {
 	*x = ValidatorSlashEvents{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[6]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6914,7 +8289,7 @@ func (*ValidatorSlashEvents) ProtoMessage() {}
 
 // Deprecated: Use ValidatorSlashEvents.ProtoReflect.Descriptor instead.
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValidatorSlashEvents) GetValidatorSlashEvents() []*ValidatorSlashEvent {
@@ -6936,7 +8311,7 @@ type FeePool struct {
 func (x *FeePool) Reset() {
 	*x = FeePool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[7]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6950,7 +8325,7 @@ func (*FeePool) ProtoMessage() {}
 
 // Deprecated: Use FeePool.ProtoReflect.Descriptor instead.
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FeePool) GetCommunityPool() []*v1beta1.DecCoin {
@@ -6984,7 +8359,7 @@ type CommunityPoolSpendProposal struct {
 func (x *CommunityPoolSpendProposal) Reset() {
 	*x = CommunityPoolSpendProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[8]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6998,7 +8373,7 @@ func (*CommunityPoolSpendProposal) ProtoMessage() {}
 
 // Deprecated: Use CommunityPoolSpendProposal.ProtoReflect.Descriptor instead.
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CommunityPoolSpendProposal) GetTitle() string {
@@ -7048,7 +8423,7 @@ type DelegatorStartingInfo struct {
 func (x *DelegatorStartingInfo) Reset() {
 	*x = DelegatorStartingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[9]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7062,7 +8437,7 @@ func (*DelegatorStartingInfo) ProtoMessage() {}
 
 // Deprecated: Use DelegatorStartingInfo.ProtoReflect.Descriptor instead.
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DelegatorStartingInfo) GetPreviousPeriod() uint64 {
@@ -7100,7 +8475,7 @@ type DelegationDelegatorReward struct {
 func (x *DelegationDelegatorReward) Reset() {
 	*x = DelegationDelegatorReward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[10]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7114,7 +8489,7 @@ func (*DelegationDelegatorReward) ProtoMessage() {}
 
 // Deprecated: Use DelegationDelegatorReward.ProtoReflect.Descriptor instead.
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DelegationDelegatorReward) GetValidatorAddress() string {
@@ -7148,7 +8523,7 @@ type CommunityPoolSpendProposalWithDeposit struct {
 func (x *CommunityPoolSpendProposalWithDeposit) Reset() {
 	*x = CommunityPoolSpendProposalWithDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[11]
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7162,7 +8537,7 @@ func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage() {}
 
 // Deprecated: Use CommunityPoolSpendProposalWithDeposit.ProtoReflect.Descriptor instead.
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CommunityPoolSpendProposalWithDeposit) GetTitle() string {
@@ -7200,6 +8575,53 @@ func (x *CommunityPoolSpendProposalWithDeposit) GetDeposit() string {
 	return ""
 }
 
+// AutoCommissionWithdraw is the automatic commission withdrawal setting of a
+// validator. Once enabled, the accumulated commission of the validator is
+// withdrawn to its withdraw address at the end of the block it reaches the
+// threshold in any of the threshold denoms.
+type AutoCommissionWithdraw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Threshold []*v1beta1.Coin `protobuf:"bytes,1,rep,name=threshold,proto3" json:"threshold,omitempty"`
+	Enabled   bool            `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *AutoCommissionWithdraw) Reset() {
+	*x = AutoCommissionWithdraw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoCommissionWithdraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoCommissionWithdraw) ProtoMessage() {}
+
+// Deprecated: Use AutoCommissionWithdraw.ProtoReflect.Descriptor instead.
+func (*AutoCommissionWithdraw) Descriptor() ([]byte, []int) {
+	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AutoCommissionWithdraw) GetThreshold() []*v1beta1.Coin {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *AutoCommissionWithdraw) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
 var File_cosmos_distribution_v1beta1_distribution_proto protoreflect.FileDescriptor
 
 var file_cosmos_distribution_v1beta1_distribution_proto_rawDesc = []byte{
@@ -7214,7 +8636,7 @@ var file_cosmos_distribution_v1beta1_distribution_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xbb, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x66, 0x0a, 0x0d,
+	0x6f, 0x22, 0xb7, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x66, 0x0a, 0x0d,
 	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x78, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
@@ -7239,156 +8661,191 @@ var file_cosmos_distribution_v1beta1_distribution_proto_rawDesc = []byte{
 	0x77, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
 	0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64,
-	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x25, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x64, 0x69, 0x73, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0xd6, 0x01, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x8e,
-	0x01, 0x0a, 0x17, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38,
-	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f,
-	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
-	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x12, 0x70, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x98,
-	0x01, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x75,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x76, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74,
+	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x8a, 0x01, 0x0a, 0x20, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x1b, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x70, 0x0a, 0x07, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x65,
+	0x72, 0x4d, 0x73, 0x67, 0x3a, 0x25, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x18,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xd6, 0x01, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x8e, 0x01, 0x0a, 0x17, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x69,
+	0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x17, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x70, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x22, 0x98, 0x01, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x76, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x1b,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x70, 0x0a, 0x07, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x9a, 0x01,
+	0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x58, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x71, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x12, 0x7d, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
 	0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x13,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x58,
-	0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2a, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f,
+	0x6c, 0x22, 0x97, 0x02, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x28, 0x18, 0x01, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0xca, 0xb4, 0x2d,
+	0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x57,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xea, 0xde, 0x1f, 0x0f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0xa2, 0xe7, 0xb0, 0x2a,
+	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe1, 0x01,
+	0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x4e, 0x0a, 0x11, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6e, 0x0a, 0x06, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f,
+	0x00, 0x22, 0xd3, 0x01, 0x0a, 0x25, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x3a, 0x22, 0x88, 0xa0, 0x1f, 0x00, 0xca, 0xb4, 0x2d, 0x1a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x6f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x7f, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63,
-	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08,
-	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x71, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73,
-	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
-	0x12, 0x7d, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44,
-	0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x22,
-	0x97, 0x02, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f,
-	0x6c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
-	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
-	0x28, 0x18, 0x01, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0xca, 0xb4, 0x2d, 0x1a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x57, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f,
-	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0xea, 0xde, 0x1f, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0xa2, 0xe7, 0xb0, 0x2a, 0x0f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x19,
-	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x4e, 0x0a, 0x11, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6e, 0x0a, 0x06, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
-	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22,
-	0xd3, 0x01, 0x0a, 0x25, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f,
-	0x6c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x57, 0x69,
-	0x74, 0x68, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x3a, 0x22, 0x88, 0xa0, 0x1f, 0x00, 0xca, 0xb4, 0x2d, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x88, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x64, 0x69, 0x73,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xa2, 0x02, 0x03, 0x43, 0x44, 0x58, 0xaa, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xe2, 0x02, 0x27, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x44, 0x69, 0x73, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1d, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x88, 0x02,
+	0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x42, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x3b, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x44, 0x58, 0xaa, 0x02,
+	0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x1b, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x27, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7403,37 +8860,40 @@ func file_cosmos_distribution_v1beta1_distribution_proto_rawDescGZIP() []byte {
 	return file_cosmos_distribution_v1beta1_distribution_proto_rawDescData
 }
 
-var file_cosmos_distribution_v1beta1_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_cosmos_distribution_v1beta1_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_cosmos_distribution_v1beta1_distribution_proto_goTypes = []interface{}{
 	(*Params)(nil),                                // 0: cosmos.distribution.v1beta1.Params
-	(*ValidatorHistoricalRewards)(nil),            // 1: cosmos.distribution.v1beta1.ValidatorHistoricalRewards
-	(*ValidatorCurrentRewards)(nil),               // 2: cosmos.distribution.v1beta1.ValidatorCurrentRewards
-	(*ValidatorAccumulatedCommission)(nil),        // 3: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission
-	(*ValidatorOutstandingRewards)(nil),           // 4: cosmos.distribution.v1beta1.ValidatorOutstandingRewards
-	(*ValidatorSlashEvent)(nil),                   // 5: cosmos.distribution.v1beta1.ValidatorSlashEvent
-	(*ValidatorSlashEvents)(nil),                  // 6: cosmos.distribution.v1beta1.ValidatorSlashEvents
-	(*FeePool)(nil),                               // 7: cosmos.distribution.v1beta1.FeePool
-	(*CommunityPoolSpendProposal)(nil),            // 8: cosmos.distribution.v1beta1.CommunityPoolSpendProposal
-	(*DelegatorStartingInfo)(nil),                 // 9: cosmos.distribution.v1beta1.DelegatorStartingInfo
-	(*DelegationDelegatorReward)(nil),             // 10: cosmos.distribution.v1beta1.DelegationDelegatorReward
-	(*CommunityPoolSpendProposalWithDeposit)(nil), // 11: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit
-	(*v1beta1.DecCoin)(nil),                       // 12: cosmos.base.v1beta1.DecCoin
-	(*v1beta1.Coin)(nil),                          // 13: cosmos.base.v1beta1.Coin
+	(*ValidatorSignatureWindow)(nil),              // 1: cosmos.distribution.v1beta1.ValidatorSignatureWindow
+	(*ValidatorHistoricalRewards)(nil),            // 2: cosmos.distribution.v1beta1.ValidatorHistoricalRewards
+	(*ValidatorCurrentRewards)(nil),               // 3: cosmos.distribution.v1beta1.ValidatorCurrentRewards
+	(*ValidatorAccumulatedCommission)(nil),        // 4: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission
+	(*ValidatorOutstandingRewards)(nil),           // 5: cosmos.distribution.v1beta1.ValidatorOutstandingRewards
+	(*ValidatorSlashEvent)(nil),                   // 6: cosmos.distribution.v1beta1.ValidatorSlashEvent
+	(*ValidatorSlashEvents)(nil),                  // 7: cosmos.distribution.v1beta1.ValidatorSlashEvents
+	(*FeePool)(nil),                               // 8: cosmos.distribution.v1beta1.FeePool
+	(*CommunityPoolSpendProposal)(nil),            // 9: cosmos.distribution.v1beta1.CommunityPoolSpendProposal
+	(*DelegatorStartingInfo)(nil),                 // 10: cosmos.distribution.v1beta1.DelegatorStartingInfo
+	(*DelegationDelegatorReward)(nil),             // 11: cosmos.distribution.v1beta1.DelegationDelegatorReward
+	(*CommunityPoolSpendProposalWithDeposit)(nil), // 12: cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit
+	(*AutoCommissionWithdraw)(nil),                // 13: cosmos.distribution.v1beta1.AutoCommissionWithdraw
+	(*v1beta1.DecCoin)(nil),                       // 14: cosmos.base.v1beta1.DecCoin
+	(*v1beta1.Coin)(nil),                          // 15: cosmos.base.v1beta1.Coin
 }
 var file_cosmos_distribution_v1beta1_distribution_proto_depIdxs = []int32{
-	12, // 0: cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio:type_name -> cosmos.base.v1beta1.DecCoin
-	12, // 1: cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards:type_name -> cosmos.base.v1beta1.DecCoin
-	12, // 2: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission:type_name -> cosmos.base.v1beta1.DecCoin
-	12, // 3: cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards:type_name -> cosmos.base.v1beta1.DecCoin
-	5,  // 4: cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events:type_name -> cosmos.distribution.v1beta1.ValidatorSlashEvent
-	12, // 5: cosmos.distribution.v1beta1.FeePool.community_pool:type_name -> cosmos.base.v1beta1.DecCoin
-	13, // 6: cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount:type_name -> cosmos.base.v1beta1.Coin
-	12, // 7: cosmos.distribution.v1beta1.DelegationDelegatorReward.reward:type_name -> cosmos.base.v1beta1.DecCoin
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	14, // 0: cosmos.distribution.v1beta1.ValidatorHistoricalRewards.cumulative_reward_ratio:type_name -> cosmos.base.v1beta1.DecCoin
+	14, // 1: cosmos.distribution.v1beta1.ValidatorCurrentRewards.rewards:type_name -> cosmos.base.v1beta1.DecCoin
+	14, // 2: cosmos.distribution.v1beta1.ValidatorAccumulatedCommission.commission:type_name -> cosmos.base.v1beta1.DecCoin
+	14, // 3: cosmos.distribution.v1beta1.ValidatorOutstandingRewards.rewards:type_name -> cosmos.base.v1beta1.DecCoin
+	6,  // 4: cosmos.distribution.v1beta1.ValidatorSlashEvents.validator_slash_events:type_name -> cosmos.distribution.v1beta1.ValidatorSlashEvent
+	14, // 5: cosmos.distribution.v1beta1.FeePool.community_pool:type_name -> cosmos.base.v1beta1.DecCoin
+	15, // 6: cosmos.distribution.v1beta1.CommunityPoolSpendProposal.amount:type_name -> cosmos.base.v1beta1.Coin
+	14, // 7: cosmos.distribution.v1beta1.DelegationDelegatorReward.reward:type_name -> cosmos.base.v1beta1.DecCoin
+	15, // 8: cosmos.distribution.v1beta1.AutoCommissionWithdraw.threshold:type_name -> cosmos.base.v1beta1.Coin
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_distribution_v1beta1_distribution_proto_init() }
@@ -7455,7 +8915,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorHistoricalRewards); i {
+			switch v := v.(*ValidatorSignatureWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7467,7 +8927,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorCurrentRewards); i {
+			switch v := v.(*ValidatorHistoricalRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7479,7 +8939,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorAccumulatedCommission); i {
+			switch v := v.(*ValidatorCurrentRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7491,7 +8951,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorOutstandingRewards); i {
+			switch v := v.(*ValidatorAccumulatedCommission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7503,7 +8963,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSlashEvent); i {
+			switch v := v.(*ValidatorOutstandingRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7515,7 +8975,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorSlashEvents); i {
+			switch v := v.(*ValidatorSlashEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7527,7 +8987,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeePool); i {
+			switch v := v.(*ValidatorSlashEvents); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7539,7 +8999,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommunityPoolSpendProposal); i {
+			switch v := v.(*FeePool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7551,7 +9011,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegatorStartingInfo); i {
+			switch v := v.(*CommunityPoolSpendProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7563,7 +9023,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelegationDelegatorReward); i {
+			switch v := v.(*DelegatorStartingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7575,6 +9035,18 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			}
 		}
 		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationDelegatorReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommunityPoolSpendProposalWithDeposit); i {
 			case 0:
 				return &v.state
@@ -7586,6 +9058,18 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_distribution_v1beta1_distribution_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoCommissionWithdraw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7593,7 +9077,7 @@ func file_cosmos_distribution_v1beta1_distribution_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_distribution_v1beta1_distribution_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
-	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Evidence_FullMethodName      = "/cosmos.evidence.v1beta1.Query/Evidence"
+	Query_AllEvidence_FullMethodName   = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_EvidenceStats_FullMethodName = "/cosmos.evidence.v1beta1.Query/EvidenceStats"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,12 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceStats queries the number of pieces of evidence rejected by the
+	// evidence module for each reason, along with the most recently rejected
+	// ones.
+	//
+	// Since: cosmos-sdk 0.48
+	EvidenceStats(ctx context.Context, in *QueryEvidenceStatsRequest, opts ...grpc.CallOption) (*QueryEvidenceStatsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +66,15 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceStats(ctx context.Context, in *QueryEvidenceStatsRequest, opts ...grpc.CallOption) (*QueryEvidenceStatsResponse, error) {
+	out := new(QueryEvidenceStatsResponse)
+	err := c.cc.Invoke(ctx, Query_EvidenceStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +83,12 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceStats queries the number of pieces of evidence rejected by the
+	// evidence module for each reason, along with the most recently rejected
+	// ones.
+	//
+	// Since: cosmos-sdk 0.48
+	EvidenceStats(context.Context, *QueryEvidenceStatsRequest) (*QueryEvidenceStatsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +102,9 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) EvidenceStats(context.Context, *QueryEvidenceStatsRequest) (*QueryEvidenceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceStats not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +154,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EvidenceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceStats(ctx, req.(*QueryEvidenceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +187,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceStats",
+			Handler:    _Query_EvidenceStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -21,15 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName              = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName                  = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName                 = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                      = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                     = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                    = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName                   = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName                  = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName               = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ValidatorGovParticipation_FullMethodName = "/cosmos.gov.v1.Query/ValidatorGovParticipation"
+	Query_ProposalTrack_FullMethodName             = "/cosmos.gov.v1.Query/ProposalTrack"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +56,14 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ValidatorGovParticipation queries the participation of a validator in the concluded proposals
+	// tallied while it was bonded.
+	ValidatorGovParticipation(ctx context.Context, in *QueryValidatorGovParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorGovParticipationResponse, error)
+	// ProposalTrack queries the proposal track resolved for a proposal, with the parameters which
+	// apply to it.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalTrack(ctx context.Context, in *QueryProposalTrackRequest, opts ...grpc.CallOption) (*QueryProposalTrackResponse, error)
 }
 
 type queryClient struct {
@@ -145,6 +155,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ValidatorGovParticipation(ctx context.Context, in *QueryValidatorGovParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorGovParticipationResponse, error) {
+	out := new(QueryValidatorGovParticipationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorGovParticipation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalTrack(ctx context.Context, in *QueryProposalTrackRequest, opts ...grpc.CallOption) (*QueryProposalTrackResponse, error) {
+	out := new(QueryProposalTrackResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -167,6 +195,14 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ValidatorGovParticipation queries the participation of a validator in the concluded proposals
+	// tallied while it was bonded.
+	ValidatorGovParticipation(context.Context, *QueryValidatorGovParticipationRequest) (*QueryValidatorGovParticipationResponse, error)
+	// ProposalTrack queries the proposal track resolved for a proposal, with the parameters which
+	// apply to it.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalTrack(context.Context, *QueryProposalTrackRequest) (*QueryProposalTrackResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -201,6 +237,12 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ValidatorGovParticipation(context.Context, *QueryValidatorGovParticipationRequest) (*QueryValidatorGovParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorGovParticipation not implemented")
+}
+func (UnimplementedQueryServer) ProposalTrack(context.Context, *QueryProposalTrackRequest) (*QueryProposalTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTrack not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +418,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorGovParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorGovParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorGovParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorGovParticipation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorGovParticipation(ctx, req.(*QueryValidatorGovParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTrack(ctx, req.(*QueryProposalTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +497,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ValidatorGovParticipation",
+			Handler:    _Query_ValidatorGovParticipation_Handler,
+		},
+		{
+			MethodName: "ProposalTrack",
+			Handler:    _Query_ProposalTrack_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
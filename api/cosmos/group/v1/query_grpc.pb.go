@@ -34,7 +34,9 @@ const (
 	Query_VotesByVoter_FullMethodName           = "/cosmos.group.v1.Query/VotesByVoter"
 	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
 	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
+	Query_ProposalTallyContext_FullMethodName   = "/cosmos.group.v1.Query/ProposalTallyContext"
 	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_GroupPolicyTreasury_FullMethodName    = "/cosmos.group.v1.Query/GroupPolicyTreasury"
 )
 
 // QueryClient is the client API for Query service.
@@ -71,10 +73,21 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalTallyContext returns the group members and weights the votes of a
+	// proposal are tallied against. For a proposal whose tally is final, these
+	// are the members of the group when the final tally was computed, otherwise
+	// the current members of the group.
+	ProposalTallyContext(ctx context.Context, in *QueryProposalTallyContextRequest, opts ...grpc.CallOption) (*QueryProposalTallyContextResponse, error)
 	// Groups queries all groups in state.
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// GroupPolicyTreasury queries the balances of a group policy account, the
+	// amounts its pending proposals would spend from it, and the number of its
+	// proposals by stage.
+	//
+	// Since: cosmos-sdk 0.48
+	GroupPolicyTreasury(ctx context.Context, in *QueryGroupPolicyTreasuryRequest, opts ...grpc.CallOption) (*QueryGroupPolicyTreasuryResponse, error)
 }
 
 type queryClient struct {
@@ -202,6 +215,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalTallyContext(ctx context.Context, in *QueryProposalTallyContextRequest, opts ...grpc.CallOption) (*QueryProposalTallyContextResponse, error) {
+	out := new(QueryProposalTallyContextResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalTallyContext_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error) {
 	out := new(QueryGroupsResponse)
 	err := c.cc.Invoke(ctx, Query_Groups_FullMethodName, in, out, opts...)
@@ -211,6 +233,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GroupPolicyTreasury(ctx context.Context, in *QueryGroupPolicyTreasuryRequest, opts ...grpc.CallOption) (*QueryGroupPolicyTreasuryResponse, error) {
+	out := new(QueryGroupPolicyTreasuryResponse)
+	err := c.cc.Invoke(ctx, Query_GroupPolicyTreasury_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -245,10 +276,21 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalTallyContext returns the group members and weights the votes of a
+	// proposal are tallied against. For a proposal whose tally is final, these
+	// are the members of the group when the final tally was computed, otherwise
+	// the current members of the group.
+	ProposalTallyContext(context.Context, *QueryProposalTallyContextRequest) (*QueryProposalTallyContextResponse, error)
 	// Groups queries all groups in state.
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// GroupPolicyTreasury queries the balances of a group policy account, the
+	// amounts its pending proposals would spend from it, and the number of its
+	// proposals by stage.
+	//
+	// Since: cosmos-sdk 0.48
+	GroupPolicyTreasury(context.Context, *QueryGroupPolicyTreasuryRequest) (*QueryGroupPolicyTreasuryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -295,9 +337,15 @@ func (UnimplementedQueryServer) GroupsByMember(context.Context, *QueryGroupsByMe
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ProposalTallyContext(context.Context, *QueryProposalTallyContextRequest) (*QueryProposalTallyContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTallyContext not implemented")
+}
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) GroupPolicyTreasury(context.Context, *QueryGroupPolicyTreasuryRequest) (*QueryGroupPolicyTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupPolicyTreasury not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -545,6 +593,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalTallyContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTallyContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTallyContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalTallyContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTallyContext(ctx, req.(*QueryProposalTallyContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGroupsRequest)
 	if err := dec(in); err != nil {
@@ -563,6 +629,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupPolicyTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupPolicyTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupPolicyTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GroupPolicyTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupPolicyTreasury(ctx, req.(*QueryGroupPolicyTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -622,10 +706,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalTallyContext",
+			Handler:    _Query_ProposalTallyContext_Handler,
+		},
 		{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "GroupPolicyTreasury",
+			Handler:    _Query_GroupPolicyTreasury_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -34,6 +34,7 @@ const (
 	Msg_WithdrawProposal_FullMethodName                = "/cosmos.group.v1.Msg/WithdrawProposal"
 	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
 	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
+	Msg_RetryExec_FullMethodName                       = "/cosmos.group.v1.Msg/RetryExec"
 	Msg_LeaveGroup_FullMethodName                      = "/cosmos.group.v1.Msg/LeaveGroup"
 )
 
@@ -67,6 +68,9 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// RetryExec re-attempts the execution of an accepted proposal whose
+	// previous execution failed.
+	RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
 }
@@ -196,6 +200,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error) {
+	out := new(MsgRetryExecResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryExec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
 	out := new(MsgLeaveGroupResponse)
 	err := c.cc.Invoke(ctx, Msg_LeaveGroup_FullMethodName, in, out, opts...)
@@ -235,6 +248,9 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// RetryExec re-attempts the execution of an accepted proposal whose
+	// previous execution failed.
+	RetryExec(context.Context, *MsgRetryExec) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -283,6 +299,9 @@ func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse,
 func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedMsgServer) RetryExec(context.Context, *MsgRetryExec) (*MsgRetryExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryExec not implemented")
+}
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
@@ -533,6 +552,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetryExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryExec(ctx, req.(*MsgRetryExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLeaveGroup)
 	if err := dec(in); err != nil {
@@ -610,6 +647,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "RetryExec",
+			Handler:    _Msg_RetryExec_Handler,
+		},
 		{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
@@ -19,13 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balance_FullMethodName = "/cosmos.nft.v1beta1.Query/Balance"
-	Query_Owner_FullMethodName   = "/cosmos.nft.v1beta1.Query/Owner"
-	Query_Supply_FullMethodName  = "/cosmos.nft.v1beta1.Query/Supply"
-	Query_NFTs_FullMethodName    = "/cosmos.nft.v1beta1.Query/NFTs"
-	Query_NFT_FullMethodName     = "/cosmos.nft.v1beta1.Query/NFT"
-	Query_Class_FullMethodName   = "/cosmos.nft.v1beta1.Query/Class"
-	Query_Classes_FullMethodName = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_Balance_FullMethodName         = "/cosmos.nft.v1beta1.Query/Balance"
+	Query_Owner_FullMethodName           = "/cosmos.nft.v1beta1.Query/Owner"
+	Query_Supply_FullMethodName          = "/cosmos.nft.v1beta1.Query/Supply"
+	Query_NFTs_FullMethodName            = "/cosmos.nft.v1beta1.Query/NFTs"
+	Query_NFT_FullMethodName             = "/cosmos.nft.v1beta1.Query/NFT"
+	Query_Class_FullMethodName           = "/cosmos.nft.v1beta1.Query/Class"
+	Query_Classes_FullMethodName         = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_OwnerStats_FullMethodName      = "/cosmos.nft.v1beta1.Query/OwnerStats"
+	Query_RemainingSupply_FullMethodName = "/cosmos.nft.v1beta1.Query/RemainingSupply"
 )
 
 // QueryClient is the client API for Query service.
@@ -47,6 +49,10 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// OwnerStats queries the number of NFTs owned by the owner in each class
+	OwnerStats(ctx context.Context, in *QueryOwnerStatsRequest, opts ...grpc.CallOption) (*QueryOwnerStatsResponse, error)
+	// RemainingSupply queries the number of NFTs of a class which can still be minted before reaching its max supply
+	RemainingSupply(ctx context.Context, in *QueryRemainingSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -120,6 +126,24 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) OwnerStats(ctx context.Context, in *QueryOwnerStatsRequest, opts ...grpc.CallOption) (*QueryOwnerStatsResponse, error) {
+	out := new(QueryOwnerStatsResponse)
+	err := c.cc.Invoke(ctx, Query_OwnerStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingSupply(ctx context.Context, in *QueryRemainingSupplyRequest, opts ...grpc.CallOption) (*QueryRemainingSupplyResponse, error) {
+	out := new(QueryRemainingSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_RemainingSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -139,6 +163,10 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// OwnerStats queries the number of NFTs owned by the owner in each class
+	OwnerStats(context.Context, *QueryOwnerStatsRequest) (*QueryOwnerStatsResponse, error)
+	// RemainingSupply queries the number of NFTs of a class which can still be minted before reaching its max supply
+	RemainingSupply(context.Context, *QueryRemainingSupplyRequest) (*QueryRemainingSupplyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -167,6 +195,12 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) OwnerStats(context.Context, *QueryOwnerStatsRequest) (*QueryOwnerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerStats not implemented")
+}
+func (UnimplementedQueryServer) RemainingSupply(context.Context, *QueryRemainingSupplyRequest) (*QueryRemainingSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingSupply not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -306,6 +340,42 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OwnerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OwnerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerStats(ctx, req.(*QueryOwnerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemainingSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingSupply(ctx, req.(*QueryRemainingSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +411,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "OwnerStats",
+			Handler:    _Query_OwnerStats_Handler,
+		},
+		{
+			MethodName: "RemainingSupply",
+			Handler:    _Query_RemainingSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
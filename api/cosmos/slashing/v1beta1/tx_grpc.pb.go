@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName          = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName    = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_RemoveTombstone_FullMethodName = "/cosmos.slashing.v1beta1.Msg/RemoveTombstone"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +37,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RemoveTombstone defines a governance operation for removing the tombstone
+	// of a validator, e.g. when its double-sign was provably caused by a bug. The
+	// slashed tokens are not refunded. The authority defaults to the x/gov module
+	// account.
+	//
+	// Since: cosmos-sdk 0.48
+	RemoveTombstone(ctx context.Context, in *MsgRemoveTombstone, opts ...grpc.CallOption) (*MsgRemoveTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -64,6 +72,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RemoveTombstone(ctx context.Context, in *MsgRemoveTombstone, opts ...grpc.CallOption) (*MsgRemoveTombstoneResponse, error) {
+	out := new(MsgRemoveTombstoneResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveTombstone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -77,6 +94,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RemoveTombstone defines a governance operation for removing the tombstone
+	// of a validator, e.g. when its double-sign was provably caused by a bug. The
+	// slashed tokens are not refunded. The authority defaults to the x/gov module
+	// account.
+	//
+	// Since: cosmos-sdk 0.48
+	RemoveTombstone(context.Context, *MsgRemoveTombstone) (*MsgRemoveTombstoneResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -90,6 +114,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RemoveTombstone(context.Context, *MsgRemoveTombstone) (*MsgRemoveTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTombstone not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -139,6 +166,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveTombstone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTombstone(ctx, req.(*MsgRemoveTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +199,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RemoveTombstone",
+			Handler:    _Msg_RemoveTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -33,6 +33,10 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_BondDenom_FullMethodName                     = "/cosmos.staking.v1beta1.Query/BondDenom"
+	Query_ValidatorFlows_FullMethodName                = "/cosmos.staking.v1beta1.Query/ValidatorFlows"
+	Query_ValidatorLiquidShares_FullMethodName         = "/cosmos.staking.v1beta1.Query/ValidatorLiquidShares"
+	Query_ValidatorJailInfo_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorJailInfo"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +96,17 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BondDenom queries the denom of the coins staked to validators.
+	BondDenom(ctx context.Context, in *QueryBondDenomRequest, opts ...grpc.CallOption) (*QueryBondDenomResponse, error)
+	// ValidatorFlows queries the total balance of the unbonding delegations and
+	// outbound redelegations of a validator.
+	ValidatorFlows(ctx context.Context, in *QueryValidatorFlowsRequest, opts ...grpc.CallOption) (*QueryValidatorFlowsResponse, error)
+	// ValidatorLiquidShares queries the delegator shares of a validator held by
+	// module and contract accounts.
+	ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error)
+	// ValidatorJailInfo queries the reason the validator is jailed for, and the
+	// record of its last jailing once unjailed.
+	ValidatorJailInfo(ctx context.Context, in *QueryValidatorJailInfoRequest, opts ...grpc.CallOption) (*QueryValidatorJailInfoResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +243,42 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BondDenom(ctx context.Context, in *QueryBondDenomRequest, opts ...grpc.CallOption) (*QueryBondDenomResponse, error) {
+	out := new(QueryBondDenomResponse)
+	err := c.cc.Invoke(ctx, Query_BondDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorFlows(ctx context.Context, in *QueryValidatorFlowsRequest, opts ...grpc.CallOption) (*QueryValidatorFlowsResponse, error) {
+	out := new(QueryValidatorFlowsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorFlows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error) {
+	out := new(QueryValidatorLiquidSharesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorLiquidShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorJailInfo(ctx context.Context, in *QueryValidatorJailInfoRequest, opts ...grpc.CallOption) (*QueryValidatorJailInfoResponse, error) {
+	out := new(QueryValidatorJailInfoResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorJailInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +336,17 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BondDenom queries the denom of the coins staked to validators.
+	BondDenom(context.Context, *QueryBondDenomRequest) (*QueryBondDenomResponse, error)
+	// ValidatorFlows queries the total balance of the unbonding delegations and
+	// outbound redelegations of a validator.
+	ValidatorFlows(context.Context, *QueryValidatorFlowsRequest) (*QueryValidatorFlowsResponse, error)
+	// ValidatorLiquidShares queries the delegator shares of a validator held by
+	// module and contract accounts.
+	ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error)
+	// ValidatorJailInfo queries the reason the validator is jailed for, and the
+	// record of its last jailing once unjailed.
+	ValidatorJailInfo(context.Context, *QueryValidatorJailInfoRequest) (*QueryValidatorJailInfoResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +396,18 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) BondDenom(context.Context, *QueryBondDenomRequest) (*QueryBondDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondDenom not implemented")
+}
+func (UnimplementedQueryServer) ValidatorFlows(context.Context, *QueryValidatorFlowsRequest) (*QueryValidatorFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorFlows not implemented")
+}
+func (UnimplementedQueryServer) ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorLiquidShares not implemented")
+}
+func (UnimplementedQueryServer) ValidatorJailInfo(context.Context, *QueryValidatorJailInfoRequest) (*QueryValidatorJailInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorJailInfo not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +673,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BondDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBondDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BondDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BondDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BondDenom(ctx, req.(*QueryBondDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorFlows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorFlows(ctx, req.(*QueryValidatorFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorLiquidShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorLiquidSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorLiquidShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, req.(*QueryValidatorLiquidSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorJailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorJailInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorJailInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorJailInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorJailInfo(ctx, req.(*QueryValidatorJailInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +808,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BondDenom",
+			Handler:    _Query_BondDenom_Handler,
+		},
+		{
+			MethodName: "ValidatorFlows",
+			Handler:    _Query_ValidatorFlows_Handler,
+		},
+		{
+			MethodName: "ValidatorLiquidShares",
+			Handler:    _Query_ValidatorLiquidShares_Handler,
+		},
+		{
+			MethodName: "ValidatorJailInfo",
+			Handler:    _Query_ValidatorJailInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
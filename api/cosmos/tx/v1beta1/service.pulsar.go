@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -63,13 +64,14 @@ func (x *_GetTxsEventRequest_1_list) IsValid() bool {
 }
 
 var (
-	md_GetTxsEventRequest            protoreflect.MessageDescriptor
-	fd_GetTxsEventRequest_events     protoreflect.FieldDescriptor
-	fd_GetTxsEventRequest_pagination protoreflect.FieldDescriptor
-	fd_GetTxsEventRequest_order_by   protoreflect.FieldDescriptor
-	fd_GetTxsEventRequest_page       protoreflect.FieldDescriptor
-	fd_GetTxsEventRequest_limit      protoreflect.FieldDescriptor
-	fd_GetTxsEventRequest_query      protoreflect.FieldDescriptor
+	md_GetTxsEventRequest             protoreflect.MessageDescriptor
+	fd_GetTxsEventRequest_events      protoreflect.FieldDescriptor
+	fd_GetTxsEventRequest_pagination  protoreflect.FieldDescriptor
+	fd_GetTxsEventRequest_order_by    protoreflect.FieldDescriptor
+	fd_GetTxsEventRequest_page        protoreflect.FieldDescriptor
+	fd_GetTxsEventRequest_limit       protoreflect.FieldDescriptor
+	fd_GetTxsEventRequest_query       protoreflect.FieldDescriptor
+	fd_GetTxsEventRequest_event_query protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -81,6 +83,7 @@ func init() {
 	fd_GetTxsEventRequest_page = md_GetTxsEventRequest.Fields().ByName("page")
 	fd_GetTxsEventRequest_limit = md_GetTxsEventRequest.Fields().ByName("limit")
 	fd_GetTxsEventRequest_query = md_GetTxsEventRequest.Fields().ByName("query")
+	fd_GetTxsEventRequest_event_query = md_GetTxsEventRequest.Fields().ByName("event_query")
 }
 
 var _ protoreflect.Message = (*fastReflection_GetTxsEventRequest)(nil)
@@ -184,6 +187,12 @@ func (x *fastReflection_GetTxsEventRequest) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.EventQuery != nil {
+		value := protoreflect.ValueOfMessage(x.EventQuery.ProtoReflect())
+		if !f(fd_GetTxsEventRequest_event_query, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -211,6 +220,8 @@ func (x *fastReflection_GetTxsEventRequest) Has(fd protoreflect.FieldDescriptor)
 		return x.Limit != uint64(0)
 	case "cosmos.tx.v1beta1.GetTxsEventRequest.query":
 		return x.Query != ""
+	case "cosmos.tx.v1beta1.GetTxsEventRequest.event_query":
+		return x.EventQuery != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventRequest"))
@@ -239,6 +250,8 @@ func (x *fastReflection_GetTxsEventRequest) Clear(fd protoreflect.FieldDescripto
 		x.Limit = uint64(0)
 	case "cosmos.tx.v1beta1.GetTxsEventRequest.query":
 		x.Query = ""
+	case "cosmos.tx.v1beta1.GetTxsEventRequest.event_query":
+		x.EventQuery = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventRequest"))
@@ -276,6 +289,9 @@ func (x *fastReflection_GetTxsEventRequest) Get(descriptor protoreflect.FieldDes
 	case "cosmos.tx.v1beta1.GetTxsEventRequest.query":
 		value := x.Query
 		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.GetTxsEventRequest.event_query":
+		value := x.EventQuery
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventRequest"))
@@ -310,6 +326,8 @@ func (x *fastReflection_GetTxsEventRequest) Set(fd protoreflect.FieldDescriptor,
 		x.Limit = value.Uint()
 	case "cosmos.tx.v1beta1.GetTxsEventRequest.query":
 		x.Query = value.Interface().(string)
+	case "cosmos.tx.v1beta1.GetTxsEventRequest.event_query":
+		x.EventQuery = value.Message().Interface().(*TxEventQuery)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventRequest"))
@@ -341,6 +359,11 @@ func (x *fastReflection_GetTxsEventRequest) Mutable(fd protoreflect.FieldDescrip
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxsEventRequest.event_query":
+		if x.EventQuery == nil {
+			x.EventQuery = new(TxEventQuery)
+		}
+		return protoreflect.ValueOfMessage(x.EventQuery.ProtoReflect())
 	case "cosmos.tx.v1beta1.GetTxsEventRequest.order_by":
 		panic(fmt.Errorf("field order_by of message cosmos.tx.v1beta1.GetTxsEventRequest is not mutable"))
 	case "cosmos.tx.v1beta1.GetTxsEventRequest.page":
@@ -376,6 +399,9 @@ func (x *fastReflection_GetTxsEventRequest) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.tx.v1beta1.GetTxsEventRequest.query":
 		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.GetTxsEventRequest.event_query":
+		m := new(TxEventQuery)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventRequest"))
@@ -468,6 +494,10 @@ func (x *fastReflection_GetTxsEventRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.EventQuery != nil {
+			l = options.Size(x.EventQuery)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -497,6 +527,20 @@ func (x *fastReflection_GetTxsEventRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EventQuery != nil {
+			encoded, err := options.Marshal(x.EventQuery)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.Query) > 0 {
 			i -= len(x.Query)
 			copy(dAtA[i:], x.Query)
@@ -748,6 +792,42 @@ func (x *fastReflection_GetTxsEventRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Query = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EventQuery", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EventQuery == nil {
+					x.EventQuery = &TxEventQuery{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EventQuery); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -783,134 +863,81 @@ func (x *fastReflection_GetTxsEventRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_GetTxsEventResponse_1_list)(nil)
-
-type _GetTxsEventResponse_1_list struct {
-	list *[]*Tx
-}
-
-func (x *_GetTxsEventResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_GetTxsEventResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_GetTxsEventResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Tx)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_GetTxsEventResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Tx)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_GetTxsEventResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Tx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_GetTxsEventResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_GetTxsEventResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Tx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_GetTxsEventResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_GetTxsEventResponse_2_list)(nil)
+var _ protoreflect.List = (*_TxEventQuery_1_list)(nil)
 
-type _GetTxsEventResponse_2_list struct {
-	list *[]*v1beta11.TxResponse
+type _TxEventQuery_1_list struct {
+	list *[]*TxEventConditionGroup
 }
 
-func (x *_GetTxsEventResponse_2_list) Len() int {
+func (x *_TxEventQuery_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_GetTxsEventResponse_2_list) Get(i int) protoreflect.Value {
+func (x *_TxEventQuery_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_GetTxsEventResponse_2_list) Set(i int, value protoreflect.Value) {
+func (x *_TxEventQuery_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.TxResponse)
+	concreteValue := valueUnwrapped.Interface().(*TxEventConditionGroup)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_GetTxsEventResponse_2_list) Append(value protoreflect.Value) {
+func (x *_TxEventQuery_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.TxResponse)
+	concreteValue := valueUnwrapped.Interface().(*TxEventConditionGroup)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_GetTxsEventResponse_2_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.TxResponse)
+func (x *_TxEventQuery_1_list) AppendMutable() protoreflect.Value {
+	v := new(TxEventConditionGroup)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GetTxsEventResponse_2_list) Truncate(n int) {
+func (x *_TxEventQuery_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_GetTxsEventResponse_2_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.TxResponse)
+func (x *_TxEventQuery_1_list) NewElement() protoreflect.Value {
+	v := new(TxEventConditionGroup)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GetTxsEventResponse_2_list) IsValid() bool {
+func (x *_TxEventQuery_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_GetTxsEventResponse              protoreflect.MessageDescriptor
-	fd_GetTxsEventResponse_txs          protoreflect.FieldDescriptor
-	fd_GetTxsEventResponse_tx_responses protoreflect.FieldDescriptor
-	fd_GetTxsEventResponse_pagination   protoreflect.FieldDescriptor
-	fd_GetTxsEventResponse_total        protoreflect.FieldDescriptor
+	md_TxEventQuery             protoreflect.MessageDescriptor
+	fd_TxEventQuery_groups      protoreflect.FieldDescriptor
+	fd_TxEventQuery_from_height protoreflect.FieldDescriptor
+	fd_TxEventQuery_to_height   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetTxsEventResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxsEventResponse")
-	fd_GetTxsEventResponse_txs = md_GetTxsEventResponse.Fields().ByName("txs")
-	fd_GetTxsEventResponse_tx_responses = md_GetTxsEventResponse.Fields().ByName("tx_responses")
-	fd_GetTxsEventResponse_pagination = md_GetTxsEventResponse.Fields().ByName("pagination")
-	fd_GetTxsEventResponse_total = md_GetTxsEventResponse.Fields().ByName("total")
+	md_TxEventQuery = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEventQuery")
+	fd_TxEventQuery_groups = md_TxEventQuery.Fields().ByName("groups")
+	fd_TxEventQuery_from_height = md_TxEventQuery.Fields().ByName("from_height")
+	fd_TxEventQuery_to_height = md_TxEventQuery.Fields().ByName("to_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetTxsEventResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEventQuery)(nil)
 
-type fastReflection_GetTxsEventResponse GetTxsEventResponse
+type fastReflection_TxEventQuery TxEventQuery
 
-func (x *GetTxsEventResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetTxsEventResponse)(x)
+func (x *TxEventQuery) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEventQuery)(x)
 }
 
-func (x *GetTxsEventResponse) slowProtoReflect() protoreflect.Message {
+func (x *TxEventQuery) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -922,43 +949,43 @@ func (x *GetTxsEventResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetTxsEventResponse_messageType fastReflection_GetTxsEventResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetTxsEventResponse_messageType{}
+var _fastReflection_TxEventQuery_messageType fastReflection_TxEventQuery_messageType
+var _ protoreflect.MessageType = fastReflection_TxEventQuery_messageType{}
 
-type fastReflection_GetTxsEventResponse_messageType struct{}
+type fastReflection_TxEventQuery_messageType struct{}
 
-func (x fastReflection_GetTxsEventResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetTxsEventResponse)(nil)
+func (x fastReflection_TxEventQuery_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEventQuery)(nil)
 }
-func (x fastReflection_GetTxsEventResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetTxsEventResponse)
+func (x fastReflection_TxEventQuery_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEventQuery)
 }
-func (x fastReflection_GetTxsEventResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxsEventResponse
+func (x fastReflection_TxEventQuery_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEventQuery
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetTxsEventResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxsEventResponse
+func (x *fastReflection_TxEventQuery) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEventQuery
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetTxsEventResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetTxsEventResponse_messageType
+func (x *fastReflection_TxEventQuery) Type() protoreflect.MessageType {
+	return _fastReflection_TxEventQuery_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetTxsEventResponse) New() protoreflect.Message {
-	return new(fastReflection_GetTxsEventResponse)
+func (x *fastReflection_TxEventQuery) New() protoreflect.Message {
+	return new(fastReflection_TxEventQuery)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetTxsEventResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetTxsEventResponse)(x)
+func (x *fastReflection_TxEventQuery) Interface() protoreflect.ProtoMessage {
+	return (*TxEventQuery)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -966,28 +993,22 @@ func (x *fastReflection_GetTxsEventResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetTxsEventResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_GetTxsEventResponse_1_list{list: &x.Txs})
-		if !f(fd_GetTxsEventResponse_txs, value) {
+func (x *fastReflection_TxEventQuery) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Groups) != 0 {
+		value := protoreflect.ValueOfList(&_TxEventQuery_1_list{list: &x.Groups})
+		if !f(fd_TxEventQuery_groups, value) {
 			return
 		}
 	}
-	if len(x.TxResponses) != 0 {
-		value := protoreflect.ValueOfList(&_GetTxsEventResponse_2_list{list: &x.TxResponses})
-		if !f(fd_GetTxsEventResponse_tx_responses, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_GetTxsEventResponse_pagination, value) {
+	if x.FromHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FromHeight)
+		if !f(fd_TxEventQuery_from_height, value) {
 			return
 		}
 	}
-	if x.Total != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Total)
-		if !f(fd_GetTxsEventResponse_total, value) {
+	if x.ToHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ToHeight)
+		if !f(fd_TxEventQuery_to_height, value) {
 			return
 		}
 	}
@@ -1004,21 +1025,19 @@ func (x *fastReflection_GetTxsEventResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetTxsEventResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEventQuery) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
-		return len(x.Txs) != 0
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
-		return len(x.TxResponses) != 0
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
-		return x.Pagination != nil
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
-		return x.Total != uint64(0)
+	case "cosmos.tx.v1beta1.TxEventQuery.groups":
+		return len(x.Groups) != 0
+	case "cosmos.tx.v1beta1.TxEventQuery.from_height":
+		return x.FromHeight != int64(0)
+	case "cosmos.tx.v1beta1.TxEventQuery.to_height":
+		return x.ToHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventQuery"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventQuery does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1028,21 +1047,19 @@ func (x *fastReflection_GetTxsEventResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxsEventResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEventQuery) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
-		x.Txs = nil
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
-		x.TxResponses = nil
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
-		x.Pagination = nil
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
-		x.Total = uint64(0)
+	case "cosmos.tx.v1beta1.TxEventQuery.groups":
+		x.Groups = nil
+	case "cosmos.tx.v1beta1.TxEventQuery.from_height":
+		x.FromHeight = int64(0)
+	case "cosmos.tx.v1beta1.TxEventQuery.to_height":
+		x.ToHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventQuery"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventQuery does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1052,31 +1069,25 @@ func (x *fastReflection_GetTxsEventResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetTxsEventResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEventQuery) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_GetTxsEventResponse_1_list{})
-		}
-		listValue := &_GetTxsEventResponse_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
-		if len(x.TxResponses) == 0 {
-			return protoreflect.ValueOfList(&_GetTxsEventResponse_2_list{})
+	case "cosmos.tx.v1beta1.TxEventQuery.groups":
+		if len(x.Groups) == 0 {
+			return protoreflect.ValueOfList(&_TxEventQuery_1_list{})
 		}
-		listValue := &_GetTxsEventResponse_2_list{list: &x.TxResponses}
+		listValue := &_TxEventQuery_1_list{list: &x.Groups}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
-		value := x.Total
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.tx.v1beta1.TxEventQuery.from_height":
+		value := x.FromHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.tx.v1beta1.TxEventQuery.to_height":
+		value := x.ToHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventQuery"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventQuery does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1090,25 +1101,21 @@ func (x *fastReflection_GetTxsEventResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxsEventResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEventQuery) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
-		lv := value.List()
-		clv := lv.(*_GetTxsEventResponse_1_list)
-		x.Txs = *clv.list
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
+	case "cosmos.tx.v1beta1.TxEventQuery.groups":
 		lv := value.List()
-		clv := lv.(*_GetTxsEventResponse_2_list)
-		x.TxResponses = *clv.list
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
-		x.Total = value.Uint()
+		clv := lv.(*_TxEventQuery_1_list)
+		x.Groups = *clv.list
+	case "cosmos.tx.v1beta1.TxEventQuery.from_height":
+		x.FromHeight = value.Int()
+	case "cosmos.tx.v1beta1.TxEventQuery.to_height":
+		x.ToHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventQuery"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventQuery does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1122,66 +1129,53 @@ func (x *fastReflection_GetTxsEventResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxsEventResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEventQuery) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
-		if x.Txs == nil {
-			x.Txs = []*Tx{}
-		}
-		value := &_GetTxsEventResponse_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
-		if x.TxResponses == nil {
-			x.TxResponses = []*v1beta11.TxResponse{}
+	case "cosmos.tx.v1beta1.TxEventQuery.groups":
+		if x.Groups == nil {
+			x.Groups = []*TxEventConditionGroup{}
 		}
-		value := &_GetTxsEventResponse_2_list{list: &x.TxResponses}
+		value := &_TxEventQuery_1_list{list: &x.Groups}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
-		panic(fmt.Errorf("field total of message cosmos.tx.v1beta1.GetTxsEventResponse is not mutable"))
+	case "cosmos.tx.v1beta1.TxEventQuery.from_height":
+		panic(fmt.Errorf("field from_height of message cosmos.tx.v1beta1.TxEventQuery is not mutable"))
+	case "cosmos.tx.v1beta1.TxEventQuery.to_height":
+		panic(fmt.Errorf("field to_height of message cosmos.tx.v1beta1.TxEventQuery is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventQuery"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventQuery does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetTxsEventResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEventQuery) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
-		list := []*Tx{}
-		return protoreflect.ValueOfList(&_GetTxsEventResponse_1_list{list: &list})
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
-		list := []*v1beta11.TxResponse{}
-		return protoreflect.ValueOfList(&_GetTxsEventResponse_2_list{list: &list})
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.tx.v1beta1.TxEventQuery.groups":
+		list := []*TxEventConditionGroup{}
+		return protoreflect.ValueOfList(&_TxEventQuery_1_list{list: &list})
+	case "cosmos.tx.v1beta1.TxEventQuery.from_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.tx.v1beta1.TxEventQuery.to_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventQuery"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventQuery does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetTxsEventResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEventQuery) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxsEventResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEventQuery", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1189,7 +1183,7 @@ func (x *fastReflection_GetTxsEventResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetTxsEventResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEventQuery) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1200,7 +1194,7 @@ func (x *fastReflection_GetTxsEventResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxsEventResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEventQuery) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1212,7 +1206,7 @@ func (x *fastReflection_GetTxsEventResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetTxsEventResponse) IsValid() bool {
+func (x *fastReflection_TxEventQuery) IsValid() bool {
 	return x != nil
 }
 
@@ -1222,9 +1216,9 @@ func (x *fastReflection_GetTxsEventResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEventQuery) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetTxsEventResponse)
+		x := input.Message.Interface().(*TxEventQuery)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1236,24 +1230,17 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.TxResponses) > 0 {
-			for _, e := range x.TxResponses {
+		if len(x.Groups) > 0 {
+			for _, e := range x.Groups {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.FromHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromHeight))
 		}
-		if x.Total != 0 {
-			n += 1 + runtime.Sov(uint64(x.Total))
+		if x.ToHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToHeight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1265,7 +1252,7 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxsEventResponse)
+		x := input.Message.Interface().(*TxEventQuery)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1284,44 +1271,19 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Total != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Total))
+		if x.ToHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToHeight))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x18
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.FromHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromHeight))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.TxResponses) > 0 {
-			for iNdEx := len(x.TxResponses) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TxResponses[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+			dAtA[i] = 0x10
 		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
+		if len(x.Groups) > 0 {
+			for iNdEx := len(x.Groups) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Groups[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1346,7 +1308,7 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxsEventResponse)
+		x := input.Message.Interface().(*TxEventQuery)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1378,15 +1340,15 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEventQuery: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEventQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1413,16 +1375,16 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Txs = append(x.Txs, &Tx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+				x.Groups = append(x.Groups, &TxEventConditionGroup{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Groups[len(x.Groups)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 				}
-				var msglen int
+				x.FromHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1432,67 +1394,16 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.FromHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TxResponses = append(x.TxResponses, &v1beta11.TxResponse{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponses[len(x.TxResponses)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 				}
-				x.Total = 0
+				x.ToHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1502,7 +1413,7 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Total |= uint64(b&0x7F) << shift
+					x.ToHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1542,28 +1453,72 @@ func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_TxEventConditionGroup_1_list)(nil)
+
+type _TxEventConditionGroup_1_list struct {
+	list *[]string
+}
+
+func (x *_TxEventConditionGroup_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TxEventConditionGroup_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TxEventConditionGroup_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TxEventConditionGroup_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TxEventConditionGroup_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TxEventConditionGroup at list field Conditions as it is not of Message kind"))
+}
+
+func (x *_TxEventConditionGroup_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TxEventConditionGroup_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TxEventConditionGroup_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_BroadcastTxRequest          protoreflect.MessageDescriptor
-	fd_BroadcastTxRequest_tx_bytes protoreflect.FieldDescriptor
-	fd_BroadcastTxRequest_mode     protoreflect.FieldDescriptor
+	md_TxEventConditionGroup            protoreflect.MessageDescriptor
+	fd_TxEventConditionGroup_conditions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_BroadcastTxRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("BroadcastTxRequest")
-	fd_BroadcastTxRequest_tx_bytes = md_BroadcastTxRequest.Fields().ByName("tx_bytes")
-	fd_BroadcastTxRequest_mode = md_BroadcastTxRequest.Fields().ByName("mode")
+	md_TxEventConditionGroup = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEventConditionGroup")
+	fd_TxEventConditionGroup_conditions = md_TxEventConditionGroup.Fields().ByName("conditions")
 }
 
-var _ protoreflect.Message = (*fastReflection_BroadcastTxRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEventConditionGroup)(nil)
 
-type fastReflection_BroadcastTxRequest BroadcastTxRequest
+type fastReflection_TxEventConditionGroup TxEventConditionGroup
 
-func (x *BroadcastTxRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BroadcastTxRequest)(x)
+func (x *TxEventConditionGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEventConditionGroup)(x)
 }
 
-func (x *BroadcastTxRequest) slowProtoReflect() protoreflect.Message {
+func (x *TxEventConditionGroup) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1575,43 +1530,43 @@ func (x *BroadcastTxRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BroadcastTxRequest_messageType fastReflection_BroadcastTxRequest_messageType
-var _ protoreflect.MessageType = fastReflection_BroadcastTxRequest_messageType{}
+var _fastReflection_TxEventConditionGroup_messageType fastReflection_TxEventConditionGroup_messageType
+var _ protoreflect.MessageType = fastReflection_TxEventConditionGroup_messageType{}
 
-type fastReflection_BroadcastTxRequest_messageType struct{}
+type fastReflection_TxEventConditionGroup_messageType struct{}
 
-func (x fastReflection_BroadcastTxRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BroadcastTxRequest)(nil)
+func (x fastReflection_TxEventConditionGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEventConditionGroup)(nil)
 }
-func (x fastReflection_BroadcastTxRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_BroadcastTxRequest)
+func (x fastReflection_TxEventConditionGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEventConditionGroup)
 }
-func (x fastReflection_BroadcastTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BroadcastTxRequest
+func (x fastReflection_TxEventConditionGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEventConditionGroup
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BroadcastTxRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_BroadcastTxRequest
+func (x *fastReflection_TxEventConditionGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEventConditionGroup
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BroadcastTxRequest) Type() protoreflect.MessageType {
-	return _fastReflection_BroadcastTxRequest_messageType
+func (x *fastReflection_TxEventConditionGroup) Type() protoreflect.MessageType {
+	return _fastReflection_TxEventConditionGroup_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BroadcastTxRequest) New() protoreflect.Message {
-	return new(fastReflection_BroadcastTxRequest)
+func (x *fastReflection_TxEventConditionGroup) New() protoreflect.Message {
+	return new(fastReflection_TxEventConditionGroup)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BroadcastTxRequest) Interface() protoreflect.ProtoMessage {
-	return (*BroadcastTxRequest)(x)
+func (x *fastReflection_TxEventConditionGroup) Interface() protoreflect.ProtoMessage {
+	return (*TxEventConditionGroup)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1619,16 +1574,10 @@ func (x *fastReflection_BroadcastTxRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BroadcastTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TxBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.TxBytes)
-		if !f(fd_BroadcastTxRequest_tx_bytes, value) {
-			return
-		}
-	}
-	if x.Mode != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
-		if !f(fd_BroadcastTxRequest_mode, value) {
+func (x *fastReflection_TxEventConditionGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Conditions) != 0 {
+		value := protoreflect.ValueOfList(&_TxEventConditionGroup_1_list{list: &x.Conditions})
+		if !f(fd_TxEventConditionGroup_conditions, value) {
 			return
 		}
 	}
@@ -1645,17 +1594,15 @@ func (x *fastReflection_BroadcastTxRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BroadcastTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEventConditionGroup) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
-		return len(x.TxBytes) != 0
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
-		return x.Mode != 0
+	case "cosmos.tx.v1beta1.TxEventConditionGroup.conditions":
+		return len(x.Conditions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventConditionGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventConditionGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1665,17 +1612,15 @@ func (x *fastReflection_BroadcastTxRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEventConditionGroup) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
-		x.TxBytes = nil
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
-		x.Mode = 0
+	case "cosmos.tx.v1beta1.TxEventConditionGroup.conditions":
+		x.Conditions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventConditionGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventConditionGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1685,19 +1630,19 @@ func (x *fastReflection_BroadcastTxRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BroadcastTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEventConditionGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
-		value := x.TxBytes
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
-		value := x.Mode
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.tx.v1beta1.TxEventConditionGroup.conditions":
+		if len(x.Conditions) == 0 {
+			return protoreflect.ValueOfList(&_TxEventConditionGroup_1_list{})
+		}
+		listValue := &_TxEventConditionGroup_1_list{list: &x.Conditions}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventConditionGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventConditionGroup does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1711,17 +1656,17 @@ func (x *fastReflection_BroadcastTxRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEventConditionGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
-		x.TxBytes = value.Bytes()
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
-		x.Mode = (BroadcastMode)(value.Enum())
+	case "cosmos.tx.v1beta1.TxEventConditionGroup.conditions":
+		lv := value.List()
+		clv := lv.(*_TxEventConditionGroup_1_list)
+		x.Conditions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventConditionGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventConditionGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1735,44 +1680,45 @@ func (x *fastReflection_BroadcastTxRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEventConditionGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
-		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.BroadcastTxRequest is not mutable"))
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
-		panic(fmt.Errorf("field mode of message cosmos.tx.v1beta1.BroadcastTxRequest is not mutable"))
+	case "cosmos.tx.v1beta1.TxEventConditionGroup.conditions":
+		if x.Conditions == nil {
+			x.Conditions = []string{}
+		}
+		value := &_TxEventConditionGroup_1_list{list: &x.Conditions}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventConditionGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventConditionGroup does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BroadcastTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEventConditionGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
-		return protoreflect.ValueOfEnum(0)
+	case "cosmos.tx.v1beta1.TxEventConditionGroup.conditions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TxEventConditionGroup_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEventConditionGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEventConditionGroup does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BroadcastTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEventConditionGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.BroadcastTxRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEventConditionGroup", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1780,7 +1726,7 @@ func (x *fastReflection_BroadcastTxRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BroadcastTxRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEventConditionGroup) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1791,7 +1737,7 @@ func (x *fastReflection_BroadcastTxRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEventConditionGroup) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1803,7 +1749,7 @@ func (x *fastReflection_BroadcastTxRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BroadcastTxRequest) IsValid() bool {
+func (x *fastReflection_TxEventConditionGroup) IsValid() bool {
 	return x != nil
 }
 
@@ -1813,9 +1759,9 @@ func (x *fastReflection_BroadcastTxRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEventConditionGroup) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BroadcastTxRequest)
+		x := input.Message.Interface().(*TxEventConditionGroup)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1827,12 +1773,11 @@ func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.TxBytes)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Mode != 0 {
-			n += 1 + runtime.Sov(uint64(x.Mode))
+		if len(x.Conditions) > 0 {
+			for _, s := range x.Conditions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1844,7 +1789,7 @@ func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BroadcastTxRequest)
+		x := input.Message.Interface().(*TxEventConditionGroup)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1863,17 +1808,14 @@ func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Mode != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.TxBytes) > 0 {
-			i -= len(x.TxBytes)
-			copy(dAtA[i:], x.TxBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Conditions) > 0 {
+			for iNdEx := len(x.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Conditions[iNdEx])
+				copy(dAtA[i:], x.Conditions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Conditions[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1886,7 +1828,7 @@ func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BroadcastTxRequest)
+		x := input.Message.Interface().(*TxEventConditionGroup)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1918,17 +1860,17 @@ func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEventConditionGroup: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEventConditionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1938,45 +1880,24 @@ func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.TxBytes == nil {
-					x.TxBytes = []byte{}
-				}
+				x.Conditions = append(x.Conditions, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
-				}
-				x.Mode = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Mode |= BroadcastMode(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2012,26 +1933,136 @@ func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_GetTxsEventResponse_1_list)(nil)
+
+type _GetTxsEventResponse_1_list struct {
+	list *[]*Tx
+}
+
+func (x *_GetTxsEventResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GetTxsEventResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GetTxsEventResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GetTxsEventResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GetTxsEventResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Tx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetTxsEventResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GetTxsEventResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Tx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetTxsEventResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GetTxsEventResponse_2_list)(nil)
+
+type _GetTxsEventResponse_2_list struct {
+	list *[]*v1beta11.TxResponse
+}
+
+func (x *_GetTxsEventResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GetTxsEventResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GetTxsEventResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.TxResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GetTxsEventResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.TxResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GetTxsEventResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.TxResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetTxsEventResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GetTxsEventResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.TxResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetTxsEventResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_BroadcastTxResponse             protoreflect.MessageDescriptor
-	fd_BroadcastTxResponse_tx_response protoreflect.FieldDescriptor
+	md_GetTxsEventResponse              protoreflect.MessageDescriptor
+	fd_GetTxsEventResponse_txs          protoreflect.FieldDescriptor
+	fd_GetTxsEventResponse_tx_responses protoreflect.FieldDescriptor
+	fd_GetTxsEventResponse_pagination   protoreflect.FieldDescriptor
+	fd_GetTxsEventResponse_total        protoreflect.FieldDescriptor
+	fd_GetTxsEventResponse_merged       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_BroadcastTxResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("BroadcastTxResponse")
-	fd_BroadcastTxResponse_tx_response = md_BroadcastTxResponse.Fields().ByName("tx_response")
+	md_GetTxsEventResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxsEventResponse")
+	fd_GetTxsEventResponse_txs = md_GetTxsEventResponse.Fields().ByName("txs")
+	fd_GetTxsEventResponse_tx_responses = md_GetTxsEventResponse.Fields().ByName("tx_responses")
+	fd_GetTxsEventResponse_pagination = md_GetTxsEventResponse.Fields().ByName("pagination")
+	fd_GetTxsEventResponse_total = md_GetTxsEventResponse.Fields().ByName("total")
+	fd_GetTxsEventResponse_merged = md_GetTxsEventResponse.Fields().ByName("merged")
 }
 
-var _ protoreflect.Message = (*fastReflection_BroadcastTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GetTxsEventResponse)(nil)
 
-type fastReflection_BroadcastTxResponse BroadcastTxResponse
+type fastReflection_GetTxsEventResponse GetTxsEventResponse
 
-func (x *BroadcastTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BroadcastTxResponse)(x)
+func (x *GetTxsEventResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetTxsEventResponse)(x)
 }
 
-func (x *BroadcastTxResponse) slowProtoReflect() protoreflect.Message {
+func (x *GetTxsEventResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2043,43 +2074,43 @@ func (x *BroadcastTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BroadcastTxResponse_messageType fastReflection_BroadcastTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_BroadcastTxResponse_messageType{}
+var _fastReflection_GetTxsEventResponse_messageType fastReflection_GetTxsEventResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetTxsEventResponse_messageType{}
 
-type fastReflection_BroadcastTxResponse_messageType struct{}
+type fastReflection_GetTxsEventResponse_messageType struct{}
 
-func (x fastReflection_BroadcastTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BroadcastTxResponse)(nil)
+func (x fastReflection_GetTxsEventResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetTxsEventResponse)(nil)
 }
-func (x fastReflection_BroadcastTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_BroadcastTxResponse)
+func (x fastReflection_GetTxsEventResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetTxsEventResponse)
 }
-func (x fastReflection_BroadcastTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BroadcastTxResponse
+func (x fastReflection_GetTxsEventResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxsEventResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BroadcastTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_BroadcastTxResponse
+func (x *fastReflection_GetTxsEventResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxsEventResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BroadcastTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_BroadcastTxResponse_messageType
+func (x *fastReflection_GetTxsEventResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetTxsEventResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BroadcastTxResponse) New() protoreflect.Message {
-	return new(fastReflection_BroadcastTxResponse)
+func (x *fastReflection_GetTxsEventResponse) New() protoreflect.Message {
+	return new(fastReflection_GetTxsEventResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BroadcastTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*BroadcastTxResponse)(x)
+func (x *fastReflection_GetTxsEventResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetTxsEventResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2087,10 +2118,34 @@ func (x *fastReflection_BroadcastTxResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BroadcastTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TxResponse != nil {
-		value := protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
-		if !f(fd_BroadcastTxResponse_tx_response, value) {
+func (x *fastReflection_GetTxsEventResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_GetTxsEventResponse_1_list{list: &x.Txs})
+		if !f(fd_GetTxsEventResponse_txs, value) {
+			return
+		}
+	}
+	if len(x.TxResponses) != 0 {
+		value := protoreflect.ValueOfList(&_GetTxsEventResponse_2_list{list: &x.TxResponses})
+		if !f(fd_GetTxsEventResponse_tx_responses, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_GetTxsEventResponse_pagination, value) {
+			return
+		}
+	}
+	if x.Total != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Total)
+		if !f(fd_GetTxsEventResponse_total, value) {
+			return
+		}
+	}
+	if x.Merged != false {
+		value := protoreflect.ValueOfBool(x.Merged)
+		if !f(fd_GetTxsEventResponse_merged, value) {
 			return
 		}
 	}
@@ -2107,16 +2162,24 @@ func (x *fastReflection_BroadcastTxResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BroadcastTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetTxsEventResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
-		return x.TxResponse != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
-		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
-	}
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
+		return len(x.Txs) != 0
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
+		return len(x.TxResponses) != 0
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
+		return x.Pagination != nil
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
+		return x.Total != uint64(0)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.merged":
+		return x.Merged != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
+	}
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
@@ -2125,15 +2188,23 @@ func (x *fastReflection_BroadcastTxResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetTxsEventResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
-		x.TxResponse = nil
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
+		x.Txs = nil
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
+		x.TxResponses = nil
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
+		x.Pagination = nil
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
+		x.Total = uint64(0)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.merged":
+		x.Merged = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2143,16 +2214,34 @@ func (x *fastReflection_BroadcastTxResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BroadcastTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxsEventResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
-		value := x.TxResponse
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_GetTxsEventResponse_1_list{})
+		}
+		listValue := &_GetTxsEventResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
+		if len(x.TxResponses) == 0 {
+			return protoreflect.ValueOfList(&_GetTxsEventResponse_2_list{})
+		}
+		listValue := &_GetTxsEventResponse_2_list{list: &x.TxResponses}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
+		value := x.Total
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.merged":
+		value := x.Merged
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2166,15 +2255,27 @@ func (x *fastReflection_BroadcastTxResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetTxsEventResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
-		x.TxResponse = value.Message().Interface().(*v1beta11.TxResponse)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
+		lv := value.List()
+		clv := lv.(*_GetTxsEventResponse_1_list)
+		x.Txs = *clv.list
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
+		lv := value.List()
+		clv := lv.(*_GetTxsEventResponse_2_list)
+		x.TxResponses = *clv.list
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
+		x.Total = value.Uint()
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.merged":
+		x.Merged = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2188,44 +2289,70 @@ func (x *fastReflection_BroadcastTxResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxsEventResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
-		if x.TxResponse == nil {
-			x.TxResponse = new(v1beta11.TxResponse)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
+		if x.Txs == nil {
+			x.Txs = []*Tx{}
 		}
-		return protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
+		value := &_GetTxsEventResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
+		if x.TxResponses == nil {
+			x.TxResponses = []*v1beta11.TxResponse{}
+		}
+		value := &_GetTxsEventResponse_2_list{list: &x.TxResponses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
+		panic(fmt.Errorf("field total of message cosmos.tx.v1beta1.GetTxsEventResponse is not mutable"))
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.merged":
+		panic(fmt.Errorf("field merged of message cosmos.tx.v1beta1.GetTxsEventResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BroadcastTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxsEventResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
-		m := new(v1beta11.TxResponse)
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.txs":
+		list := []*Tx{}
+		return protoreflect.ValueOfList(&_GetTxsEventResponse_1_list{list: &list})
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses":
+		list := []*v1beta11.TxResponse{}
+		return protoreflect.ValueOfList(&_GetTxsEventResponse_2_list{list: &list})
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.total":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.tx.v1beta1.GetTxsEventResponse.merged":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxsEventResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxsEventResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BroadcastTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetTxsEventResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.BroadcastTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxsEventResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2233,7 +2360,7 @@ func (x *fastReflection_BroadcastTxResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BroadcastTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetTxsEventResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2244,7 +2371,7 @@ func (x *fastReflection_BroadcastTxResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BroadcastTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetTxsEventResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2256,7 +2383,7 @@ func (x *fastReflection_BroadcastTxResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BroadcastTxResponse) IsValid() bool {
+func (x *fastReflection_GetTxsEventResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2266,9 +2393,9 @@ func (x *fastReflection_BroadcastTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetTxsEventResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BroadcastTxResponse)
+		x := input.Message.Interface().(*GetTxsEventResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2280,10 +2407,28 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.TxResponse != nil {
-			l = options.Size(x.TxResponse)
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TxResponses) > 0 {
+			for _, e := range x.TxResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Total != 0 {
+			n += 1 + runtime.Sov(uint64(x.Total))
+		}
+		if x.Merged {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2294,7 +2439,7 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BroadcastTxResponse)
+		x := input.Message.Interface().(*GetTxsEventResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2313,8 +2458,23 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TxResponse != nil {
-			encoded, err := options.Marshal(x.TxResponse)
+		if x.Merged {
+			i--
+			if x.Merged {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Total != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Total))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2325,7 +2485,39 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+		if len(x.TxResponses) > 0 {
+			for iNdEx := len(x.TxResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TxResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2338,7 +2530,7 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BroadcastTxResponse)
+		x := input.Message.Interface().(*GetTxsEventResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2370,15 +2562,15 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2405,29 +2597,136 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TxResponse == nil {
-					x.TxResponse = &v1beta11.TxResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponse); err != nil {
+				x.Txs = append(x.Txs, &Tx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.TxResponses = append(x.TxResponses, &v1beta11.TxResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponses[len(x.TxResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				x.Total = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Total |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Merged", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Merged = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
 			}
 		}
 
@@ -2448,27 +2747,27 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_SimulateRequest          protoreflect.MessageDescriptor
-	fd_SimulateRequest_tx       protoreflect.FieldDescriptor
-	fd_SimulateRequest_tx_bytes protoreflect.FieldDescriptor
+	md_BroadcastTxRequest          protoreflect.MessageDescriptor
+	fd_BroadcastTxRequest_tx_bytes protoreflect.FieldDescriptor
+	fd_BroadcastTxRequest_mode     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_SimulateRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateRequest")
-	fd_SimulateRequest_tx = md_SimulateRequest.Fields().ByName("tx")
-	fd_SimulateRequest_tx_bytes = md_SimulateRequest.Fields().ByName("tx_bytes")
+	md_BroadcastTxRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("BroadcastTxRequest")
+	fd_BroadcastTxRequest_tx_bytes = md_BroadcastTxRequest.Fields().ByName("tx_bytes")
+	fd_BroadcastTxRequest_mode = md_BroadcastTxRequest.Fields().ByName("mode")
 }
 
-var _ protoreflect.Message = (*fastReflection_SimulateRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_BroadcastTxRequest)(nil)
 
-type fastReflection_SimulateRequest SimulateRequest
+type fastReflection_BroadcastTxRequest BroadcastTxRequest
 
-func (x *SimulateRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SimulateRequest)(x)
+func (x *BroadcastTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BroadcastTxRequest)(x)
 }
 
-func (x *SimulateRequest) slowProtoReflect() protoreflect.Message {
+func (x *BroadcastTxRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2480,43 +2779,43 @@ func (x *SimulateRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SimulateRequest_messageType fastReflection_SimulateRequest_messageType
-var _ protoreflect.MessageType = fastReflection_SimulateRequest_messageType{}
+var _fastReflection_BroadcastTxRequest_messageType fastReflection_BroadcastTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_BroadcastTxRequest_messageType{}
 
-type fastReflection_SimulateRequest_messageType struct{}
+type fastReflection_BroadcastTxRequest_messageType struct{}
 
-func (x fastReflection_SimulateRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SimulateRequest)(nil)
+func (x fastReflection_BroadcastTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BroadcastTxRequest)(nil)
 }
-func (x fastReflection_SimulateRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_SimulateRequest)
+func (x fastReflection_BroadcastTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_BroadcastTxRequest)
 }
-func (x fastReflection_SimulateRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SimulateRequest
+func (x fastReflection_BroadcastTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BroadcastTxRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SimulateRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_SimulateRequest
+func (x *fastReflection_BroadcastTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_BroadcastTxRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SimulateRequest) Type() protoreflect.MessageType {
-	return _fastReflection_SimulateRequest_messageType
+func (x *fastReflection_BroadcastTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_BroadcastTxRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SimulateRequest) New() protoreflect.Message {
-	return new(fastReflection_SimulateRequest)
+func (x *fastReflection_BroadcastTxRequest) New() protoreflect.Message {
+	return new(fastReflection_BroadcastTxRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SimulateRequest) Interface() protoreflect.ProtoMessage {
-	return (*SimulateRequest)(x)
+func (x *fastReflection_BroadcastTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*BroadcastTxRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2524,16 +2823,16 @@ func (x *fastReflection_SimulateRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SimulateRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_SimulateRequest_tx, value) {
+func (x *fastReflection_BroadcastTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_BroadcastTxRequest_tx_bytes, value) {
 			return
 		}
 	}
-	if len(x.TxBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.TxBytes)
-		if !f(fd_SimulateRequest_tx_bytes, value) {
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_BroadcastTxRequest_mode, value) {
 			return
 		}
 	}
@@ -2550,17 +2849,17 @@ func (x *fastReflection_SimulateRequest) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SimulateRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BroadcastTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateRequest.tx":
-		return x.Tx != nil
-	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
 		return len(x.TxBytes) != 0
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
+		return x.Mode != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2570,17 +2869,17 @@ func (x *fastReflection_SimulateRequest) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BroadcastTxRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateRequest.tx":
-		x.Tx = nil
-	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
 		x.TxBytes = nil
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
+		x.Mode = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2590,19 +2889,19 @@ func (x *fastReflection_SimulateRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SimulateRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BroadcastTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.SimulateRequest.tx":
-		value := x.Tx
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
 		value := x.TxBytes
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2616,17 +2915,17 @@ func (x *fastReflection_SimulateRequest) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BroadcastTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateRequest.tx":
-		x.Tx = value.Message().Interface().(*Tx)
-	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
 		x.TxBytes = value.Bytes()
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
+		x.Mode = (BroadcastMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2640,48 +2939,44 @@ func (x *fastReflection_SimulateRequest) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BroadcastTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateRequest.tx":
-		if x.Tx == nil {
-			x.Tx = new(Tx)
-		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
-		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.SimulateRequest is not mutable"))
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.BroadcastTxRequest is not mutable"))
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
+		panic(fmt.Errorf("field mode of message cosmos.tx.v1beta1.BroadcastTxRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SimulateRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BroadcastTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateRequest.tx":
-		m := new(Tx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.tx_bytes":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.BroadcastTxRequest.mode":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SimulateRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BroadcastTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.SimulateRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.BroadcastTxRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2689,7 +2984,7 @@ func (x *fastReflection_SimulateRequest) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SimulateRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BroadcastTxRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2700,7 +2995,7 @@ func (x *fastReflection_SimulateRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BroadcastTxRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2712,7 +3007,7 @@ func (x *fastReflection_SimulateRequest) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SimulateRequest) IsValid() bool {
+func (x *fastReflection_BroadcastTxRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2722,9 +3017,9 @@ func (x *fastReflection_SimulateRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BroadcastTxRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SimulateRequest)
+		x := input.Message.Interface().(*BroadcastTxRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2736,14 +3031,13 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		l = len(x.TxBytes)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Mode != 0 {
+			n += 1 + runtime.Sov(uint64(x.Mode))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2754,7 +3048,7 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SimulateRequest)
+		x := input.Message.Interface().(*BroadcastTxRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2773,25 +3067,16 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.TxBytes) > 0 {
 			i -= len(x.TxBytes)
 			copy(dAtA[i:], x.TxBytes)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -2805,7 +3090,7 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SimulateRequest)
+		x := input.Message.Interface().(*BroadcastTxRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2837,17 +3122,17 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2857,33 +3142,31 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &Tx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 				}
-				var byteLen int
+				x.Mode = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2893,26 +3176,11 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.Mode |= BroadcastMode(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.TxBytes == nil {
-					x.TxBytes = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2949,27 +3217,25 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SimulateResponse          protoreflect.MessageDescriptor
-	fd_SimulateResponse_gas_info protoreflect.FieldDescriptor
-	fd_SimulateResponse_result   protoreflect.FieldDescriptor
+	md_BroadcastTxResponse             protoreflect.MessageDescriptor
+	fd_BroadcastTxResponse_tx_response protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_SimulateResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateResponse")
-	fd_SimulateResponse_gas_info = md_SimulateResponse.Fields().ByName("gas_info")
-	fd_SimulateResponse_result = md_SimulateResponse.Fields().ByName("result")
+	md_BroadcastTxResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("BroadcastTxResponse")
+	fd_BroadcastTxResponse_tx_response = md_BroadcastTxResponse.Fields().ByName("tx_response")
 }
 
-var _ protoreflect.Message = (*fastReflection_SimulateResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_BroadcastTxResponse)(nil)
 
-type fastReflection_SimulateResponse SimulateResponse
+type fastReflection_BroadcastTxResponse BroadcastTxResponse
 
-func (x *SimulateResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SimulateResponse)(x)
+func (x *BroadcastTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BroadcastTxResponse)(x)
 }
 
-func (x *SimulateResponse) slowProtoReflect() protoreflect.Message {
+func (x *BroadcastTxResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2981,43 +3247,43 @@ func (x *SimulateResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SimulateResponse_messageType fastReflection_SimulateResponse_messageType
-var _ protoreflect.MessageType = fastReflection_SimulateResponse_messageType{}
+var _fastReflection_BroadcastTxResponse_messageType fastReflection_BroadcastTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_BroadcastTxResponse_messageType{}
 
-type fastReflection_SimulateResponse_messageType struct{}
+type fastReflection_BroadcastTxResponse_messageType struct{}
 
-func (x fastReflection_SimulateResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SimulateResponse)(nil)
+func (x fastReflection_BroadcastTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BroadcastTxResponse)(nil)
 }
-func (x fastReflection_SimulateResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_SimulateResponse)
+func (x fastReflection_BroadcastTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_BroadcastTxResponse)
 }
-func (x fastReflection_SimulateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SimulateResponse
+func (x fastReflection_BroadcastTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BroadcastTxResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SimulateResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_SimulateResponse
+func (x *fastReflection_BroadcastTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_BroadcastTxResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SimulateResponse) Type() protoreflect.MessageType {
-	return _fastReflection_SimulateResponse_messageType
+func (x *fastReflection_BroadcastTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_BroadcastTxResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SimulateResponse) New() protoreflect.Message {
-	return new(fastReflection_SimulateResponse)
+func (x *fastReflection_BroadcastTxResponse) New() protoreflect.Message {
+	return new(fastReflection_BroadcastTxResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SimulateResponse) Interface() protoreflect.ProtoMessage {
-	return (*SimulateResponse)(x)
+func (x *fastReflection_BroadcastTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*BroadcastTxResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3025,16 +3291,10 @@ func (x *fastReflection_SimulateResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SimulateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GasInfo != nil {
-		value := protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
-		if !f(fd_SimulateResponse_gas_info, value) {
-			return
-		}
-	}
-	if x.Result != nil {
-		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
-		if !f(fd_SimulateResponse_result, value) {
+func (x *fastReflection_BroadcastTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TxResponse != nil {
+		value := protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
+		if !f(fd_BroadcastTxResponse_tx_response, value) {
 			return
 		}
 	}
@@ -3051,17 +3311,15 @@ func (x *fastReflection_SimulateResponse) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SimulateResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BroadcastTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
-		return x.GasInfo != nil
-	case "cosmos.tx.v1beta1.SimulateResponse.result":
-		return x.Result != nil
+	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
+		return x.TxResponse != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3071,17 +3329,15 @@ func (x *fastReflection_SimulateResponse) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BroadcastTxResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
-		x.GasInfo = nil
-	case "cosmos.tx.v1beta1.SimulateResponse.result":
-		x.Result = nil
+	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
+		x.TxResponse = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3091,19 +3347,16 @@ func (x *fastReflection_SimulateResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SimulateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BroadcastTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
-		value := x.GasInfo
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.SimulateResponse.result":
-		value := x.Result
+	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
+		value := x.TxResponse
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3117,17 +3370,15 @@ func (x *fastReflection_SimulateResponse) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BroadcastTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
-		x.GasInfo = value.Message().Interface().(*v1beta11.GasInfo)
-	case "cosmos.tx.v1beta1.SimulateResponse.result":
-		x.Result = value.Message().Interface().(*v1beta11.Result)
+	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
+		x.TxResponse = value.Message().Interface().(*v1beta11.TxResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3141,52 +3392,44 @@ func (x *fastReflection_SimulateResponse) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BroadcastTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
-		if x.GasInfo == nil {
-			x.GasInfo = new(v1beta11.GasInfo)
-		}
-		return protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
-	case "cosmos.tx.v1beta1.SimulateResponse.result":
-		if x.Result == nil {
-			x.Result = new(v1beta11.Result)
+	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
+		if x.TxResponse == nil {
+			x.TxResponse = new(v1beta11.TxResponse)
 		}
-		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SimulateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BroadcastTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
-		m := new(v1beta11.GasInfo)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.SimulateResponse.result":
-		m := new(v1beta11.Result)
+	case "cosmos.tx.v1beta1.BroadcastTxResponse.tx_response":
+		m := new(v1beta11.TxResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BroadcastTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BroadcastTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SimulateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BroadcastTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.SimulateResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.BroadcastTxResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3194,7 +3437,7 @@ func (x *fastReflection_SimulateResponse) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SimulateResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BroadcastTxResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3205,7 +3448,7 @@ func (x *fastReflection_SimulateResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SimulateResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BroadcastTxResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3217,7 +3460,7 @@ func (x *fastReflection_SimulateResponse) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SimulateResponse) IsValid() bool {
+func (x *fastReflection_BroadcastTxResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3227,9 +3470,9 @@ func (x *fastReflection_SimulateResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SimulateResponse)
+		x := input.Message.Interface().(*BroadcastTxResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3241,12 +3484,8 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.GasInfo != nil {
-			l = options.Size(x.GasInfo)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Result != nil {
-			l = options.Size(x.Result)
+		if x.TxResponse != nil {
+			l = options.Size(x.TxResponse)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3259,7 +3498,7 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SimulateResponse)
+		x := input.Message.Interface().(*BroadcastTxResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3278,8 +3517,8 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Result != nil {
-			encoded, err := options.Marshal(x.Result)
+		if x.TxResponse != nil {
+			encoded, err := options.Marshal(x.TxResponse)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3290,21 +3529,7 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.GasInfo != nil {
-			encoded, err := options.Marshal(x.GasInfo)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3317,7 +3542,7 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SimulateResponse)
+		x := input.Message.Interface().(*BroadcastTxResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3349,51 +3574,15 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.GasInfo == nil {
-					x.GasInfo = &v1beta11.GasInfo{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasInfo); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3420,10 +3609,10 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Result == nil {
-					x.Result = &v1beta11.Result{}
+				if x.TxResponse == nil {
+					x.TxResponse = &v1beta11.TxResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponse); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3463,25 +3652,27 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GetTxRequest      protoreflect.MessageDescriptor
-	fd_GetTxRequest_hash protoreflect.FieldDescriptor
+	md_SimulateRequest          protoreflect.MessageDescriptor
+	fd_SimulateRequest_tx       protoreflect.FieldDescriptor
+	fd_SimulateRequest_tx_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetTxRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxRequest")
-	fd_GetTxRequest_hash = md_GetTxRequest.Fields().ByName("hash")
+	md_SimulateRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateRequest")
+	fd_SimulateRequest_tx = md_SimulateRequest.Fields().ByName("tx")
+	fd_SimulateRequest_tx_bytes = md_SimulateRequest.Fields().ByName("tx_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetTxRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_SimulateRequest)(nil)
 
-type fastReflection_GetTxRequest GetTxRequest
+type fastReflection_SimulateRequest SimulateRequest
 
-func (x *GetTxRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetTxRequest)(x)
+func (x *SimulateRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulateRequest)(x)
 }
 
-func (x *GetTxRequest) slowProtoReflect() protoreflect.Message {
+func (x *SimulateRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3493,43 +3684,43 @@ func (x *GetTxRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetTxRequest_messageType fastReflection_GetTxRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetTxRequest_messageType{}
+var _fastReflection_SimulateRequest_messageType fastReflection_SimulateRequest_messageType
+var _ protoreflect.MessageType = fastReflection_SimulateRequest_messageType{}
 
-type fastReflection_GetTxRequest_messageType struct{}
+type fastReflection_SimulateRequest_messageType struct{}
 
-func (x fastReflection_GetTxRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetTxRequest)(nil)
+func (x fastReflection_SimulateRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulateRequest)(nil)
 }
-func (x fastReflection_GetTxRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetTxRequest)
+func (x fastReflection_SimulateRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulateRequest)
 }
-func (x fastReflection_GetTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxRequest
+func (x fastReflection_SimulateRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetTxRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxRequest
+func (x *fastReflection_SimulateRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetTxRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetTxRequest_messageType
+func (x *fastReflection_SimulateRequest) Type() protoreflect.MessageType {
+	return _fastReflection_SimulateRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetTxRequest) New() protoreflect.Message {
-	return new(fastReflection_GetTxRequest)
+func (x *fastReflection_SimulateRequest) New() protoreflect.Message {
+	return new(fastReflection_SimulateRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetTxRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetTxRequest)(x)
+func (x *fastReflection_SimulateRequest) Interface() protoreflect.ProtoMessage {
+	return (*SimulateRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3537,10 +3728,16 @@ func (x *fastReflection_GetTxRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_GetTxRequest_hash, value) {
+func (x *fastReflection_SimulateRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_SimulateRequest_tx, value) {
+			return
+		}
+	}
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_SimulateRequest_tx_bytes, value) {
 			return
 		}
 	}
@@ -3557,15 +3754,17 @@ func (x *fastReflection_GetTxRequest) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SimulateRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		return x.Hash != ""
+	case "cosmos.tx.v1beta1.SimulateRequest.tx":
+		return x.Tx != nil
+	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+		return len(x.TxBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3575,15 +3774,17 @@ func (x *fastReflection_GetTxRequest) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SimulateRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		x.Hash = ""
+	case "cosmos.tx.v1beta1.SimulateRequest.tx":
+		x.Tx = nil
+	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+		x.TxBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3593,16 +3794,19 @@ func (x *fastReflection_GetTxRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		value := x.Hash
-		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.SimulateRequest.tx":
+		value := x.Tx
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3616,15 +3820,17 @@ func (x *fastReflection_GetTxRequest) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SimulateRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		x.Hash = value.Interface().(string)
+	case "cosmos.tx.v1beta1.SimulateRequest.tx":
+		x.Tx = value.Message().Interface().(*Tx)
+	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+		x.TxBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3638,40 +3844,48 @@ func (x *fastReflection_GetTxRequest) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		panic(fmt.Errorf("field hash of message cosmos.tx.v1beta1.GetTxRequest is not mutable"))
+	case "cosmos.tx.v1beta1.SimulateRequest.tx":
+		if x.Tx == nil {
+			x.Tx = new(Tx)
+		}
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.SimulateRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.SimulateRequest.tx":
+		m := new(Tx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SimulateRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.SimulateRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3679,7 +3893,7 @@ func (x *fastReflection_GetTxRequest) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetTxRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SimulateRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3690,7 +3904,7 @@ func (x *fastReflection_GetTxRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SimulateRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3702,7 +3916,7 @@ func (x *fastReflection_GetTxRequest) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetTxRequest) IsValid() bool {
+func (x *fastReflection_SimulateRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3712,9 +3926,9 @@ func (x *fastReflection_GetTxRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetTxRequest)
+		x := input.Message.Interface().(*SimulateRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3726,7 +3940,11 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TxBytes)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3740,7 +3958,7 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxRequest)
+		x := input.Message.Interface().(*SimulateRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3759,10 +3977,24 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3777,7 +4009,7 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxRequest)
+		x := input.Message.Interface().(*SimulateRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3809,17 +4041,17 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3829,23 +4061,61 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				if x.Tx == nil {
+					x.Tx = &Tx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3883,27 +4153,27 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GetTxResponse             protoreflect.MessageDescriptor
-	fd_GetTxResponse_tx          protoreflect.FieldDescriptor
-	fd_GetTxResponse_tx_response protoreflect.FieldDescriptor
+	md_SimulateResponse          protoreflect.MessageDescriptor
+	fd_SimulateResponse_gas_info protoreflect.FieldDescriptor
+	fd_SimulateResponse_result   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetTxResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxResponse")
-	fd_GetTxResponse_tx = md_GetTxResponse.Fields().ByName("tx")
-	fd_GetTxResponse_tx_response = md_GetTxResponse.Fields().ByName("tx_response")
+	md_SimulateResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateResponse")
+	fd_SimulateResponse_gas_info = md_SimulateResponse.Fields().ByName("gas_info")
+	fd_SimulateResponse_result = md_SimulateResponse.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_SimulateResponse)(nil)
 
-type fastReflection_GetTxResponse GetTxResponse
+type fastReflection_SimulateResponse SimulateResponse
 
-func (x *GetTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetTxResponse)(x)
+func (x *SimulateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulateResponse)(x)
 }
 
-func (x *GetTxResponse) slowProtoReflect() protoreflect.Message {
+func (x *SimulateResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3915,43 +4185,43 @@ func (x *GetTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetTxResponse_messageType fastReflection_GetTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetTxResponse_messageType{}
+var _fastReflection_SimulateResponse_messageType fastReflection_SimulateResponse_messageType
+var _ protoreflect.MessageType = fastReflection_SimulateResponse_messageType{}
 
-type fastReflection_GetTxResponse_messageType struct{}
+type fastReflection_SimulateResponse_messageType struct{}
 
-func (x fastReflection_GetTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetTxResponse)(nil)
+func (x fastReflection_SimulateResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulateResponse)(nil)
 }
-func (x fastReflection_GetTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetTxResponse)
+func (x fastReflection_SimulateResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulateResponse)
 }
-func (x fastReflection_GetTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxResponse
+func (x fastReflection_SimulateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxResponse
+func (x *fastReflection_SimulateResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetTxResponse_messageType
+func (x *fastReflection_SimulateResponse) Type() protoreflect.MessageType {
+	return _fastReflection_SimulateResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetTxResponse) New() protoreflect.Message {
-	return new(fastReflection_GetTxResponse)
+func (x *fastReflection_SimulateResponse) New() protoreflect.Message {
+	return new(fastReflection_SimulateResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetTxResponse)(x)
+func (x *fastReflection_SimulateResponse) Interface() protoreflect.ProtoMessage {
+	return (*SimulateResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3959,16 +4229,16 @@ func (x *fastReflection_GetTxResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_GetTxResponse_tx, value) {
+func (x *fastReflection_SimulateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GasInfo != nil {
+		value := protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+		if !f(fd_SimulateResponse_gas_info, value) {
 			return
 		}
 	}
-	if x.TxResponse != nil {
-		value := protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
-		if !f(fd_GetTxResponse_tx_response, value) {
+	if x.Result != nil {
+		value := protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+		if !f(fd_SimulateResponse_result, value) {
 			return
 		}
 	}
@@ -3985,17 +4255,17 @@ func (x *fastReflection_GetTxResponse) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SimulateResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		return x.Tx != nil
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		return x.TxResponse != nil
+	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
+		return x.GasInfo != nil
+	case "cosmos.tx.v1beta1.SimulateResponse.result":
+		return x.Result != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4005,17 +4275,17 @@ func (x *fastReflection_GetTxResponse) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SimulateResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		x.Tx = nil
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		x.TxResponse = nil
+	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
+		x.GasInfo = nil
+	case "cosmos.tx.v1beta1.SimulateResponse.result":
+		x.Result = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4025,19 +4295,19 @@ func (x *fastReflection_GetTxResponse) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		value := x.Tx
+	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
+		value := x.GasInfo
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		value := x.TxResponse
+	case "cosmos.tx.v1beta1.SimulateResponse.result":
+		value := x.Result
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4051,17 +4321,17 @@ func (x *fastReflection_GetTxResponse) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SimulateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		x.Tx = value.Message().Interface().(*Tx)
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		x.TxResponse = value.Message().Interface().(*v1beta11.TxResponse)
+	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
+		x.GasInfo = value.Message().Interface().(*v1beta11.GasInfo)
+	case "cosmos.tx.v1beta1.SimulateResponse.result":
+		x.Result = value.Message().Interface().(*v1beta11.Result)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4075,52 +4345,52 @@ func (x *fastReflection_GetTxResponse) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		if x.Tx == nil {
-			x.Tx = new(Tx)
+	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
+		if x.GasInfo == nil {
+			x.GasInfo = new(v1beta11.GasInfo)
 		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		if x.TxResponse == nil {
-			x.TxResponse = new(v1beta11.TxResponse)
+		return protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateResponse.result":
+		if x.Result == nil {
+			x.Result = new(v1beta11.Result)
 		}
-		return protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		m := new(Tx)
+	case "cosmos.tx.v1beta1.SimulateResponse.gas_info":
+		m := new(v1beta11.GasInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		m := new(v1beta11.TxResponse)
+	case "cosmos.tx.v1beta1.SimulateResponse.result":
+		m := new(v1beta11.Result)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SimulateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.SimulateResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4128,7 +4398,7 @@ func (x *fastReflection_GetTxResponse) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SimulateResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4139,7 +4409,7 @@ func (x *fastReflection_GetTxResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SimulateResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4151,7 +4421,7 @@ func (x *fastReflection_GetTxResponse) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetTxResponse) IsValid() bool {
+func (x *fastReflection_SimulateResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4161,9 +4431,9 @@ func (x *fastReflection_GetTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetTxResponse)
+		x := input.Message.Interface().(*SimulateResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4175,12 +4445,12 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
+		if x.GasInfo != nil {
+			l = options.Size(x.GasInfo)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TxResponse != nil {
-			l = options.Size(x.TxResponse)
+		if x.Result != nil {
+			l = options.Size(x.Result)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4193,7 +4463,7 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxResponse)
+		x := input.Message.Interface().(*SimulateResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4212,8 +4482,8 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TxResponse != nil {
-			encoded, err := options.Marshal(x.TxResponse)
+		if x.Result != nil {
+			encoded, err := options.Marshal(x.Result)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4226,8 +4496,8 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
+		if x.GasInfo != nil {
+			encoded, err := options.Marshal(x.GasInfo)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4251,7 +4521,7 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxResponse)
+		x := input.Message.Interface().(*SimulateResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4283,15 +4553,15 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4318,16 +4588,16 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &Tx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
+				if x.GasInfo == nil {
+					x.GasInfo = &v1beta11.GasInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasInfo); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4354,10 +4624,10 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TxResponse == nil {
-					x.TxResponse = &v1beta11.TxResponse{}
+				if x.Result == nil {
+					x.Result = &v1beta11.Result{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponse); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Result); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4397,27 +4667,25 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GetBlockWithTxsRequest            protoreflect.MessageDescriptor
-	fd_GetBlockWithTxsRequest_height     protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsRequest_pagination protoreflect.FieldDescriptor
+	md_GetTxRequest      protoreflect.MessageDescriptor
+	fd_GetTxRequest_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetBlockWithTxsRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsRequest")
-	fd_GetBlockWithTxsRequest_height = md_GetBlockWithTxsRequest.Fields().ByName("height")
-	fd_GetBlockWithTxsRequest_pagination = md_GetBlockWithTxsRequest.Fields().ByName("pagination")
+	md_GetTxRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxRequest")
+	fd_GetTxRequest_hash = md_GetTxRequest.Fields().ByName("hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GetTxRequest)(nil)
 
-type fastReflection_GetBlockWithTxsRequest GetBlockWithTxsRequest
+type fastReflection_GetTxRequest GetTxRequest
 
-func (x *GetBlockWithTxsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsRequest)(x)
+func (x *GetTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetTxRequest)(x)
 }
 
-func (x *GetBlockWithTxsRequest) slowProtoReflect() protoreflect.Message {
+func (x *GetTxRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4429,43 +4697,43 @@ func (x *GetBlockWithTxsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetBlockWithTxsRequest_messageType fastReflection_GetBlockWithTxsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsRequest_messageType{}
+var _fastReflection_GetTxRequest_messageType fastReflection_GetTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetTxRequest_messageType{}
 
-type fastReflection_GetBlockWithTxsRequest_messageType struct{}
+type fastReflection_GetTxRequest_messageType struct{}
 
-func (x fastReflection_GetBlockWithTxsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsRequest)(nil)
+func (x fastReflection_GetTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetTxRequest)(nil)
 }
-func (x fastReflection_GetBlockWithTxsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsRequest)
+func (x fastReflection_GetTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetTxRequest)
 }
-func (x fastReflection_GetBlockWithTxsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsRequest
+func (x fastReflection_GetTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetBlockWithTxsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsRequest
+func (x *fastReflection_GetTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetBlockWithTxsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetBlockWithTxsRequest_messageType
+func (x *fastReflection_GetTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetTxRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetBlockWithTxsRequest) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsRequest)
+func (x *fastReflection_GetTxRequest) New() protoreflect.Message {
+	return new(fastReflection_GetTxRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetBlockWithTxsRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetBlockWithTxsRequest)(x)
+func (x *fastReflection_GetTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetTxRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4473,16 +4741,10 @@ func (x *fastReflection_GetBlockWithTxsRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetBlockWithTxsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Height != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Height)
-		if !f(fd_GetBlockWithTxsRequest_height, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_GetBlockWithTxsRequest_pagination, value) {
+func (x *fastReflection_GetTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_GetTxRequest_hash, value) {
 			return
 		}
 	}
@@ -4499,17 +4761,15 @@ func (x *fastReflection_GetBlockWithTxsRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetBlockWithTxsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		return x.Height != int64(0)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		return x.Pagination != nil
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		return x.Hash != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4519,17 +4779,15 @@ func (x *fastReflection_GetBlockWithTxsRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetTxRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		x.Height = int64(0)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		x.Pagination = nil
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		x.Hash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4539,19 +4797,16 @@ func (x *fastReflection_GetBlockWithTxsRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetBlockWithTxsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		value := x.Height
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4565,17 +4820,15 @@ func (x *fastReflection_GetBlockWithTxsRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		x.Height = value.Int()
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		x.Hash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4589,48 +4842,40 @@ func (x *fastReflection_GetBlockWithTxsRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		panic(fmt.Errorf("field height of message cosmos.tx.v1beta1.GetBlockWithTxsRequest is not mutable"))
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		panic(fmt.Errorf("field hash of message cosmos.tx.v1beta1.GetTxRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetBlockWithTxsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetBlockWithTxsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4638,7 +4883,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetBlockWithTxsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetTxRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4649,7 +4894,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetTxRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4661,7 +4906,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetBlockWithTxsRequest) IsValid() bool {
+func (x *fastReflection_GetTxRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4671,9 +4916,9 @@ func (x *fastReflection_GetBlockWithTxsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		x := input.Message.Interface().(*GetTxRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4685,11 +4930,8 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Hash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4702,7 +4944,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		x := input.Message.Interface().(*GetTxRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4721,24 +4963,12 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4751,7 +4981,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		x := input.Message.Interface().(*GetTxRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4783,36 +5013,17 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-				}
-				x.Height = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Height |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4822,27 +5033,23 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4879,83 +5086,28 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_GetBlockWithTxsResponse_1_list)(nil)
-
-type _GetBlockWithTxsResponse_1_list struct {
-	list *[]*Tx
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Tx)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Tx)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Tx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Tx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_GetBlockWithTxsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_GetBlockWithTxsResponse            protoreflect.MessageDescriptor
-	fd_GetBlockWithTxsResponse_txs        protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsResponse_block_id   protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsResponse_block      protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsResponse_pagination protoreflect.FieldDescriptor
-)
+var (
+	md_GetTxResponse             protoreflect.MessageDescriptor
+	fd_GetTxResponse_tx          protoreflect.FieldDescriptor
+	fd_GetTxResponse_tx_response protoreflect.FieldDescriptor
+)
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetBlockWithTxsResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsResponse")
-	fd_GetBlockWithTxsResponse_txs = md_GetBlockWithTxsResponse.Fields().ByName("txs")
-	fd_GetBlockWithTxsResponse_block_id = md_GetBlockWithTxsResponse.Fields().ByName("block_id")
-	fd_GetBlockWithTxsResponse_block = md_GetBlockWithTxsResponse.Fields().ByName("block")
-	fd_GetBlockWithTxsResponse_pagination = md_GetBlockWithTxsResponse.Fields().ByName("pagination")
+	md_GetTxResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxResponse")
+	fd_GetTxResponse_tx = md_GetTxResponse.Fields().ByName("tx")
+	fd_GetTxResponse_tx_response = md_GetTxResponse.Fields().ByName("tx_response")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GetTxResponse)(nil)
 
-type fastReflection_GetBlockWithTxsResponse GetBlockWithTxsResponse
+type fastReflection_GetTxResponse GetTxResponse
 
-func (x *GetBlockWithTxsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsResponse)(x)
+func (x *GetTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetTxResponse)(x)
 }
 
-func (x *GetBlockWithTxsResponse) slowProtoReflect() protoreflect.Message {
+func (x *GetTxResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4967,43 +5119,43 @@ func (x *GetBlockWithTxsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetBlockWithTxsResponse_messageType fastReflection_GetBlockWithTxsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsResponse_messageType{}
+var _fastReflection_GetTxResponse_messageType fastReflection_GetTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetTxResponse_messageType{}
 
-type fastReflection_GetBlockWithTxsResponse_messageType struct{}
+type fastReflection_GetTxResponse_messageType struct{}
 
-func (x fastReflection_GetBlockWithTxsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsResponse)(nil)
+func (x fastReflection_GetTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetTxResponse)(nil)
 }
-func (x fastReflection_GetBlockWithTxsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsResponse)
+func (x fastReflection_GetTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetTxResponse)
 }
-func (x fastReflection_GetBlockWithTxsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsResponse
+func (x fastReflection_GetTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetBlockWithTxsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsResponse
+func (x *fastReflection_GetTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetBlockWithTxsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetBlockWithTxsResponse_messageType
+func (x *fastReflection_GetTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetTxResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetBlockWithTxsResponse) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsResponse)
+func (x *fastReflection_GetTxResponse) New() protoreflect.Message {
+	return new(fastReflection_GetTxResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetBlockWithTxsResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetBlockWithTxsResponse)(x)
+func (x *fastReflection_GetTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetTxResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5011,28 +5163,16 @@ func (x *fastReflection_GetBlockWithTxsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetBlockWithTxsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &x.Txs})
-		if !f(fd_GetBlockWithTxsResponse_txs, value) {
-			return
-		}
-	}
-	if x.BlockId != nil {
-		value := protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
-		if !f(fd_GetBlockWithTxsResponse_block_id, value) {
-			return
-		}
-	}
-	if x.Block != nil {
-		value := protoreflect.ValueOfMessage(x.Block.ProtoReflect())
-		if !f(fd_GetBlockWithTxsResponse_block, value) {
+func (x *fastReflection_GetTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_GetTxResponse_tx, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_GetBlockWithTxsResponse_pagination, value) {
+	if x.TxResponse != nil {
+		value := protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
+		if !f(fd_GetTxResponse_tx_response, value) {
 			return
 		}
 	}
@@ -5049,21 +5189,17 @@ func (x *fastReflection_GetBlockWithTxsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetBlockWithTxsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GetTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		return len(x.Txs) != 0
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		return x.BlockId != nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		return x.Block != nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		return x.Pagination != nil
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		return x.Tx != nil
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		return x.TxResponse != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5073,21 +5209,17 @@ func (x *fastReflection_GetBlockWithTxsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GetTxResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		x.Txs = nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		x.BlockId = nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		x.Block = nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		x.Pagination = nil
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		x.Tx = nil
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		x.TxResponse = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5097,28 +5229,19 @@ func (x *fastReflection_GetBlockWithTxsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetBlockWithTxsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{})
-		}
-		listValue := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		value := x.BlockId
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		value := x.Block
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		value := x.Tx
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		value := x.Pagination
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		value := x.TxResponse
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5132,23 +5255,17 @@ func (x *fastReflection_GetBlockWithTxsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GetTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		lv := value.List()
-		clv := lv.(*_GetBlockWithTxsResponse_1_list)
-		x.Txs = *clv.list
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		x.BlockId = value.Message().Interface().(*types.BlockID)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		x.Block = value.Message().Interface().(*types.Block)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		x.Tx = value.Message().Interface().(*Tx)
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		x.TxResponse = value.Message().Interface().(*v1beta11.TxResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5162,69 +5279,52 @@ func (x *fastReflection_GetBlockWithTxsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		if x.Txs == nil {
-			x.Txs = []*Tx{}
-		}
-		value := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		if x.BlockId == nil {
-			x.BlockId = new(types.BlockID)
-		}
-		return protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		if x.Block == nil {
-			x.Block = new(types.Block)
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		if x.Tx == nil {
+			x.Tx = new(Tx)
 		}
-		return protoreflect.ValueOfMessage(x.Block.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		if x.TxResponse == nil {
+			x.TxResponse = new(v1beta11.TxResponse)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetBlockWithTxsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GetTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		list := []*Tx{}
-		return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &list})
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		m := new(types.BlockID)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		m := new(types.Block)
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		m := new(Tx)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		m := new(v1beta11.TxResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetBlockWithTxsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GetTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5232,7 +5332,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetBlockWithTxsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GetTxResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5243,7 +5343,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GetTxResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5255,7 +5355,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetBlockWithTxsResponse) IsValid() bool {
+func (x *fastReflection_GetTxResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5265,9 +5365,9 @@ func (x *fastReflection_GetBlockWithTxsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		x := input.Message.Interface().(*GetTxResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5279,22 +5379,12 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.BlockId != nil {
-			l = options.Size(x.BlockId)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Block != nil {
-			l = options.Size(x.Block)
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.TxResponse != nil {
+			l = options.Size(x.TxResponse)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5307,7 +5397,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		x := input.Message.Interface().(*GetTxResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5326,22 +5416,8 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Block != nil {
-			encoded, err := options.Marshal(x.Block)
+		if x.TxResponse != nil {
+			encoded, err := options.Marshal(x.TxResponse)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5352,10 +5428,10 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
-		if x.BlockId != nil {
-			encoded, err := options.Marshal(x.BlockId)
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5366,23 +5442,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5395,7 +5455,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		x := input.Message.Interface().(*GetTxResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5427,15 +5487,15 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5462,14 +5522,16 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Txs = append(x.Txs, &Tx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+				if x.Tx == nil {
+					x.Tx = &Tx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5496,52 +5558,2711 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockId == nil {
-					x.BlockId = &types.BlockID{}
+				if x.TxResponse == nil {
+					x.TxResponse = &v1beta11.TxResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockId); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponse); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if msglen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetBlockWithTxsRequest                    protoreflect.MessageDescriptor
+	fd_GetBlockWithTxsRequest_height             protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsRequest_pagination         protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsRequest_skip_decode_errors protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_GetBlockWithTxsRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsRequest")
+	fd_GetBlockWithTxsRequest_height = md_GetBlockWithTxsRequest.Fields().ByName("height")
+	fd_GetBlockWithTxsRequest_pagination = md_GetBlockWithTxsRequest.Fields().ByName("pagination")
+	fd_GetBlockWithTxsRequest_skip_decode_errors = md_GetBlockWithTxsRequest.Fields().ByName("skip_decode_errors")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsRequest)(nil)
+
+type fastReflection_GetBlockWithTxsRequest GetBlockWithTxsRequest
+
+func (x *GetBlockWithTxsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsRequest)(x)
+}
+
+func (x *GetBlockWithTxsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetBlockWithTxsRequest_messageType fastReflection_GetBlockWithTxsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsRequest_messageType{}
+
+type fastReflection_GetBlockWithTxsRequest_messageType struct{}
+
+func (x fastReflection_GetBlockWithTxsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsRequest)(nil)
+}
+func (x fastReflection_GetBlockWithTxsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsRequest)
+}
+func (x fastReflection_GetBlockWithTxsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetBlockWithTxsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetBlockWithTxsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetBlockWithTxsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetBlockWithTxsRequest) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetBlockWithTxsRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetBlockWithTxsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetBlockWithTxsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_GetBlockWithTxsRequest_height, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_GetBlockWithTxsRequest_pagination, value) {
+			return
+		}
+	}
+	if x.SkipDecodeErrors != false {
+		value := protoreflect.ValueOfBool(x.SkipDecodeErrors)
+		if !f(fd_GetBlockWithTxsRequest_skip_decode_errors, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetBlockWithTxsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		return x.Height != int64(0)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		return x.Pagination != nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.skip_decode_errors":
+		return x.SkipDecodeErrors != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		x.Height = int64(0)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		x.Pagination = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.skip_decode_errors":
+		x.SkipDecodeErrors = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetBlockWithTxsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.skip_decode_errors":
+		value := x.SkipDecodeErrors
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		x.Height = value.Int()
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.skip_decode_errors":
+		x.SkipDecodeErrors = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		panic(fmt.Errorf("field height of message cosmos.tx.v1beta1.GetBlockWithTxsRequest is not mutable"))
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.skip_decode_errors":
+		panic(fmt.Errorf("field skip_decode_errors of message cosmos.tx.v1beta1.GetBlockWithTxsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetBlockWithTxsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.skip_decode_errors":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetBlockWithTxsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetBlockWithTxsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetBlockWithTxsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SkipDecodeErrors {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SkipDecodeErrors {
+			i--
+			if x.SkipDecodeErrors {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkipDecodeErrors", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SkipDecodeErrors = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GetBlockWithTxsResponse_1_list)(nil)
+
+type _GetBlockWithTxsResponse_1_list struct {
+	list *[]*Tx
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Tx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Tx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GetBlockWithTxsResponse_5_list)(nil)
+
+type _GetBlockWithTxsResponse_5_list struct {
+	list *[]*TxDecodeError
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TxDecodeError)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TxDecodeError)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) AppendMutable() protoreflect.Value {
+	v := new(TxDecodeError)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) NewElement() protoreflect.Value {
+	v := new(TxDecodeError)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GetBlockWithTxsResponse               protoreflect.MessageDescriptor
+	fd_GetBlockWithTxsResponse_txs           protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsResponse_block_id      protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsResponse_block         protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsResponse_pagination    protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsResponse_decode_errors protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_GetBlockWithTxsResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsResponse")
+	fd_GetBlockWithTxsResponse_txs = md_GetBlockWithTxsResponse.Fields().ByName("txs")
+	fd_GetBlockWithTxsResponse_block_id = md_GetBlockWithTxsResponse.Fields().ByName("block_id")
+	fd_GetBlockWithTxsResponse_block = md_GetBlockWithTxsResponse.Fields().ByName("block")
+	fd_GetBlockWithTxsResponse_pagination = md_GetBlockWithTxsResponse.Fields().ByName("pagination")
+	fd_GetBlockWithTxsResponse_decode_errors = md_GetBlockWithTxsResponse.Fields().ByName("decode_errors")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsResponse)(nil)
+
+type fastReflection_GetBlockWithTxsResponse GetBlockWithTxsResponse
+
+func (x *GetBlockWithTxsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsResponse)(x)
+}
+
+func (x *GetBlockWithTxsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetBlockWithTxsResponse_messageType fastReflection_GetBlockWithTxsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsResponse_messageType{}
+
+type fastReflection_GetBlockWithTxsResponse_messageType struct{}
+
+func (x fastReflection_GetBlockWithTxsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsResponse)(nil)
+}
+func (x fastReflection_GetBlockWithTxsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsResponse)
+}
+func (x fastReflection_GetBlockWithTxsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetBlockWithTxsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetBlockWithTxsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetBlockWithTxsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetBlockWithTxsResponse) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetBlockWithTxsResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetBlockWithTxsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetBlockWithTxsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &x.Txs})
+		if !f(fd_GetBlockWithTxsResponse_txs, value) {
+			return
+		}
+	}
+	if x.BlockId != nil {
+		value := protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
+		if !f(fd_GetBlockWithTxsResponse_block_id, value) {
+			return
+		}
+	}
+	if x.Block != nil {
+		value := protoreflect.ValueOfMessage(x.Block.ProtoReflect())
+		if !f(fd_GetBlockWithTxsResponse_block, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_GetBlockWithTxsResponse_pagination, value) {
+			return
+		}
+	}
+	if len(x.DecodeErrors) != 0 {
+		value := protoreflect.ValueOfList(&_GetBlockWithTxsResponse_5_list{list: &x.DecodeErrors})
+		if !f(fd_GetBlockWithTxsResponse_decode_errors, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetBlockWithTxsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		return len(x.Txs) != 0
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		return x.BlockId != nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		return x.Block != nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		return x.Pagination != nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.decode_errors":
+		return len(x.DecodeErrors) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		x.Txs = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		x.BlockId = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		x.Block = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		x.Pagination = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.decode_errors":
+		x.DecodeErrors = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetBlockWithTxsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{})
+		}
+		listValue := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		value := x.BlockId
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		value := x.Block
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.decode_errors":
+		if len(x.DecodeErrors) == 0 {
+			return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_5_list{})
+		}
+		listValue := &_GetBlockWithTxsResponse_5_list{list: &x.DecodeErrors}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		lv := value.List()
+		clv := lv.(*_GetBlockWithTxsResponse_1_list)
+		x.Txs = *clv.list
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		x.BlockId = value.Message().Interface().(*types.BlockID)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		x.Block = value.Message().Interface().(*types.Block)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.decode_errors":
+		lv := value.List()
+		clv := lv.(*_GetBlockWithTxsResponse_5_list)
+		x.DecodeErrors = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		if x.Txs == nil {
+			x.Txs = []*Tx{}
+		}
+		value := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		if x.BlockId == nil {
+			x.BlockId = new(types.BlockID)
+		}
+		return protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		if x.Block == nil {
+			x.Block = new(types.Block)
+		}
+		return protoreflect.ValueOfMessage(x.Block.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.decode_errors":
+		if x.DecodeErrors == nil {
+			x.DecodeErrors = []*TxDecodeError{}
+		}
+		value := &_GetBlockWithTxsResponse_5_list{list: &x.DecodeErrors}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetBlockWithTxsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		list := []*Tx{}
+		return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &list})
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		m := new(types.BlockID)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		m := new(types.Block)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.decode_errors":
+		list := []*TxDecodeError{}
+		return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetBlockWithTxsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetBlockWithTxsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetBlockWithTxsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockId != nil {
+			l = options.Size(x.BlockId)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Block != nil {
+			l = options.Size(x.Block)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DecodeErrors) > 0 {
+			for _, e := range x.DecodeErrors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DecodeErrors) > 0 {
+			for iNdEx := len(x.DecodeErrors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DecodeErrors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Block != nil {
+			encoded, err := options.Marshal(x.Block)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockId != nil {
+			encoded, err := options.Marshal(x.BlockId)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, &Tx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockId == nil {
+					x.BlockId = &types.BlockID{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockId); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Block == nil {
+					x.Block = &types.Block{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Block); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecodeErrors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DecodeErrors = append(x.DecodeErrors, &TxDecodeError{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecodeErrors[len(x.DecodeErrors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TxDecodeError       protoreflect.MessageDescriptor
+	fd_TxDecodeError_index protoreflect.FieldDescriptor
+	fd_TxDecodeError_error protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_TxDecodeError = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeError")
+	fd_TxDecodeError_index = md_TxDecodeError.Fields().ByName("index")
+	fd_TxDecodeError_error = md_TxDecodeError.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_TxDecodeError)(nil)
+
+type fastReflection_TxDecodeError TxDecodeError
+
+func (x *TxDecodeError) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeError)(x)
+}
+
+func (x *TxDecodeError) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TxDecodeError_messageType fastReflection_TxDecodeError_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeError_messageType{}
+
+type fastReflection_TxDecodeError_messageType struct{}
+
+func (x fastReflection_TxDecodeError_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeError)(nil)
+}
+func (x fastReflection_TxDecodeError_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeError)
+}
+func (x fastReflection_TxDecodeError_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeError
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TxDecodeError) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeError
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TxDecodeError) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeError_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TxDecodeError) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeError)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxDecodeError) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeError)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TxDecodeError) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_TxDecodeError_index, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_TxDecodeError_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TxDecodeError) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeError.index":
+		return x.Index != uint64(0)
+	case "cosmos.tx.v1beta1.TxDecodeError.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeError"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeError does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeError) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeError.index":
+		x.Index = uint64(0)
+	case "cosmos.tx.v1beta1.TxDecodeError.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeError"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeError does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TxDecodeError) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeError.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.tx.v1beta1.TxDecodeError.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeError"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeError does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeError) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeError.index":
+		x.Index = value.Uint()
+	case "cosmos.tx.v1beta1.TxDecodeError.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeError"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeError does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeError) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeError.index":
+		panic(fmt.Errorf("field index of message cosmos.tx.v1beta1.TxDecodeError is not mutable"))
+	case "cosmos.tx.v1beta1.TxDecodeError.error":
+		panic(fmt.Errorf("field error of message cosmos.tx.v1beta1.TxDecodeError is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeError"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeError does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TxDecodeError) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeError.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.tx.v1beta1.TxDecodeError.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeError"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeError does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TxDecodeError) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeError", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TxDecodeError) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeError) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TxDecodeError) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TxDecodeError) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TxDecodeError)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeError)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeError)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeError: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeError: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TxDecodeRequest          protoreflect.MessageDescriptor
+	fd_TxDecodeRequest_tx_bytes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_TxDecodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeRequest")
+	fd_TxDecodeRequest_tx_bytes = md_TxDecodeRequest.Fields().ByName("tx_bytes")
+}
+
+var _ protoreflect.Message = (*fastReflection_TxDecodeRequest)(nil)
+
+type fastReflection_TxDecodeRequest TxDecodeRequest
+
+func (x *TxDecodeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeRequest)(x)
+}
+
+func (x *TxDecodeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TxDecodeRequest_messageType fastReflection_TxDecodeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeRequest_messageType{}
+
+type fastReflection_TxDecodeRequest_messageType struct{}
+
+func (x fastReflection_TxDecodeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeRequest)(nil)
+}
+func (x fastReflection_TxDecodeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeRequest)
+}
+func (x fastReflection_TxDecodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TxDecodeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TxDecodeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TxDecodeRequest) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxDecodeRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TxDecodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_TxDecodeRequest_tx_bytes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TxDecodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		return len(x.TxBytes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		x.TxBytes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TxDecodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		x.TxBytes = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxDecodeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TxDecodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TxDecodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TxDecodeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TxDecodeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TxDecodeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxBytes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TxDecodeResponse    protoreflect.MessageDescriptor
+	fd_TxDecodeResponse_tx protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_TxDecodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeResponse")
+	fd_TxDecodeResponse_tx = md_TxDecodeResponse.Fields().ByName("tx")
+}
+
+var _ protoreflect.Message = (*fastReflection_TxDecodeResponse)(nil)
+
+type fastReflection_TxDecodeResponse TxDecodeResponse
+
+func (x *TxDecodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeResponse)(x)
+}
+
+func (x *TxDecodeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TxDecodeResponse_messageType fastReflection_TxDecodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeResponse_messageType{}
+
+type fastReflection_TxDecodeResponse_messageType struct{}
+
+func (x fastReflection_TxDecodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeResponse)(nil)
+}
+func (x fastReflection_TxDecodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeResponse)
+}
+func (x fastReflection_TxDecodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TxDecodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TxDecodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TxDecodeResponse) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxDecodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TxDecodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_TxDecodeResponse_tx, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TxDecodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		return x.Tx != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		x.Tx = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TxDecodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		value := x.Tx
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		x.Tx = value.Message().Interface().(*Tx)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		if x.Tx == nil {
+			x.Tx = new(Tx)
+		}
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TxDecodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		m := new(Tx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TxDecodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TxDecodeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TxDecodeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TxDecodeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Block == nil {
-					x.Block = &types.Block{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Block); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 4:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5568,10 +8289,10 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Tx == nil {
+					x.Tx = &Tx{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5611,26 +8332,26 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_TxDecodeRequest          protoreflect.MessageDescriptor
-	fd_TxDecodeRequest_tx_bytes protoreflect.FieldDescriptor
+	md_TxEncodeRequest    protoreflect.MessageDescriptor
+	fd_TxEncodeRequest_tx protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeRequest")
-	fd_TxDecodeRequest_tx_bytes = md_TxDecodeRequest.Fields().ByName("tx_bytes")
+	md_TxEncodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeRequest")
+	fd_TxEncodeRequest_tx = md_TxEncodeRequest.Fields().ByName("tx")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEncodeRequest)(nil)
 
-type fastReflection_TxDecodeRequest TxDecodeRequest
+type fastReflection_TxEncodeRequest TxEncodeRequest
 
-func (x *TxDecodeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeRequest)(x)
+func (x *TxEncodeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeRequest)(x)
 }
 
-func (x *TxDecodeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
+func (x *TxEncodeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5641,43 +8362,43 @@ func (x *TxDecodeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeRequest_messageType fastReflection_TxDecodeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeRequest_messageType{}
+var _fastReflection_TxEncodeRequest_messageType fastReflection_TxEncodeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeRequest_messageType{}
 
-type fastReflection_TxDecodeRequest_messageType struct{}
+type fastReflection_TxEncodeRequest_messageType struct{}
 
-func (x fastReflection_TxDecodeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeRequest)(nil)
+func (x fastReflection_TxEncodeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeRequest)(nil)
 }
-func (x fastReflection_TxDecodeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeRequest)
+func (x fastReflection_TxEncodeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeRequest)
 }
-func (x fastReflection_TxDecodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeRequest
+func (x fastReflection_TxEncodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeRequest
+func (x *fastReflection_TxEncodeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeRequest_messageType
+func (x *fastReflection_TxEncodeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeRequest) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeRequest)
+func (x *fastReflection_TxEncodeRequest) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeRequest)(x)
+func (x *fastReflection_TxEncodeRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5685,10 +8406,10 @@ func (x *fastReflection_TxDecodeRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TxBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.TxBytes)
-		if !f(fd_TxDecodeRequest_tx_bytes, value) {
+func (x *fastReflection_TxEncodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_TxEncodeRequest_tx, value) {
 			return
 		}
 	}
@@ -5705,15 +8426,15 @@ func (x *fastReflection_TxDecodeRequest) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEncodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
-		return len(x.TxBytes) != 0
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		return x.Tx != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5723,15 +8444,15 @@ func (x *fastReflection_TxDecodeRequest) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEncodeRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
-		x.TxBytes = nil
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		x.Tx = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5741,16 +8462,16 @@ func (x *fastReflection_TxDecodeRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
-		value := x.TxBytes
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		value := x.Tx
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5764,15 +8485,15 @@ func (x *fastReflection_TxDecodeRequest) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEncodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
-		x.TxBytes = value.Bytes()
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		x.Tx = value.Message().Interface().(*Tx)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5786,40 +8507,44 @@ func (x *fastReflection_TxDecodeRequest) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
-		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxDecodeRequest is not mutable"))
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		if x.Tx == nil {
+			x.Tx = new(Tx)
+		}
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		m := new(Tx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEncodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5827,7 +8552,7 @@ func (x *fastReflection_TxDecodeRequest) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEncodeRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5838,7 +8563,7 @@ func (x *fastReflection_TxDecodeRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEncodeRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5850,7 +8575,7 @@ func (x *fastReflection_TxDecodeRequest) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeRequest) IsValid() bool {
+func (x *fastReflection_TxEncodeRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5860,9 +8585,9 @@ func (x *fastReflection_TxDecodeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeRequest)
+		x := input.Message.Interface().(*TxEncodeRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5874,8 +8599,8 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.TxBytes)
-		if l > 0 {
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5888,7 +8613,7 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeRequest)
+		x := input.Message.Interface().(*TxEncodeRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5907,10 +8632,17 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TxBytes) > 0 {
-			i -= len(x.TxBytes)
-			copy(dAtA[i:], x.TxBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5925,7 +8657,7 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeRequest)
+		x := input.Message.Interface().(*TxEncodeRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5957,17 +8689,17 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5977,24 +8709,26 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.TxBytes == nil {
-					x.TxBytes = []byte{}
+				if x.Tx == nil {
+					x.Tx = &Tx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -6033,26 +8767,26 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxDecodeResponse    protoreflect.MessageDescriptor
-	fd_TxDecodeResponse_tx protoreflect.FieldDescriptor
+	md_TxEncodeResponse          protoreflect.MessageDescriptor
+	fd_TxEncodeResponse_tx_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeResponse")
-	fd_TxDecodeResponse_tx = md_TxDecodeResponse.Fields().ByName("tx")
+	md_TxEncodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeResponse")
+	fd_TxEncodeResponse_tx_bytes = md_TxEncodeResponse.Fields().ByName("tx_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEncodeResponse)(nil)
 
-type fastReflection_TxDecodeResponse TxDecodeResponse
+type fastReflection_TxEncodeResponse TxEncodeResponse
 
-func (x *TxDecodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeResponse)(x)
+func (x *TxEncodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeResponse)(x)
 }
 
-func (x *TxDecodeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
+func (x *TxEncodeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6063,43 +8797,43 @@ func (x *TxDecodeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeResponse_messageType fastReflection_TxDecodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeResponse_messageType{}
+var _fastReflection_TxEncodeResponse_messageType fastReflection_TxEncodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeResponse_messageType{}
 
-type fastReflection_TxDecodeResponse_messageType struct{}
+type fastReflection_TxEncodeResponse_messageType struct{}
 
-func (x fastReflection_TxDecodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeResponse)(nil)
+func (x fastReflection_TxEncodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeResponse)(nil)
 }
-func (x fastReflection_TxDecodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeResponse)
+func (x fastReflection_TxEncodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeResponse)
 }
-func (x fastReflection_TxDecodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeResponse
+func (x fastReflection_TxEncodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeResponse
+func (x *fastReflection_TxEncodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeResponse_messageType
+func (x *fastReflection_TxEncodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeResponse) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeResponse)
+func (x *fastReflection_TxEncodeResponse) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeResponse)(x)
+func (x *fastReflection_TxEncodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6107,10 +8841,10 @@ func (x *fastReflection_TxDecodeResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_TxDecodeResponse_tx, value) {
+func (x *fastReflection_TxEncodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_TxEncodeResponse_tx_bytes, value) {
 			return
 		}
 	}
@@ -6127,15 +8861,15 @@ func (x *fastReflection_TxDecodeResponse) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEncodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		return x.Tx != nil
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+		return len(x.TxBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6145,15 +8879,15 @@ func (x *fastReflection_TxDecodeResponse) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEncodeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		x.Tx = nil
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+		x.TxBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6163,16 +8897,16 @@ func (x *fastReflection_TxDecodeResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		value := x.Tx
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6186,15 +8920,15 @@ func (x *fastReflection_TxDecodeResponse) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEncodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		x.Tx = value.Message().Interface().(*Tx)
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+		x.TxBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6208,44 +8942,40 @@ func (x *fastReflection_TxDecodeResponse) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		if x.Tx == nil {
-			x.Tx = new(Tx)
-		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxEncodeResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		m := new(Tx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEncodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6253,7 +8983,7 @@ func (x *fastReflection_TxDecodeResponse) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEncodeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6264,7 +8994,7 @@ func (x *fastReflection_TxDecodeResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEncodeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6276,7 +9006,7 @@ func (x *fastReflection_TxDecodeResponse) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeResponse) IsValid() bool {
+func (x *fastReflection_TxEncodeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6286,9 +9016,9 @@ func (x *fastReflection_TxDecodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeResponse)
+		x := input.Message.Interface().(*TxEncodeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6300,8 +9030,8 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
+		l = len(x.TxBytes)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6314,7 +9044,7 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeResponse)
+		x := input.Message.Interface().(*TxEncodeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6333,17 +9063,10 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6358,7 +9081,7 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeResponse)
+		x := input.Message.Interface().(*TxEncodeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6390,17 +9113,17 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6410,26 +9133,24 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &Tx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -6468,26 +9189,26 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxEncodeRequest    protoreflect.MessageDescriptor
-	fd_TxEncodeRequest_tx protoreflect.FieldDescriptor
+	md_TxEncodeAminoRequest            protoreflect.MessageDescriptor
+	fd_TxEncodeAminoRequest_amino_json protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeRequest")
-	fd_TxEncodeRequest_tx = md_TxEncodeRequest.Fields().ByName("tx")
+	md_TxEncodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoRequest")
+	fd_TxEncodeAminoRequest_amino_json = md_TxEncodeAminoRequest.Fields().ByName("amino_json")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEncodeAminoRequest)(nil)
 
-type fastReflection_TxEncodeRequest TxEncodeRequest
+type fastReflection_TxEncodeAminoRequest TxEncodeAminoRequest
 
-func (x *TxEncodeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeRequest)(x)
+func (x *TxEncodeAminoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoRequest)(x)
 }
 
-func (x *TxEncodeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
+func (x *TxEncodeAminoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6498,54 +9219,54 @@ func (x *TxEncodeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeRequest_messageType fastReflection_TxEncodeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeRequest_messageType{}
+var _fastReflection_TxEncodeAminoRequest_messageType fastReflection_TxEncodeAminoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeAminoRequest_messageType{}
 
-type fastReflection_TxEncodeRequest_messageType struct{}
+type fastReflection_TxEncodeAminoRequest_messageType struct{}
 
-func (x fastReflection_TxEncodeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeRequest)(nil)
+func (x fastReflection_TxEncodeAminoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoRequest)(nil)
 }
-func (x fastReflection_TxEncodeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeRequest)
+func (x fastReflection_TxEncodeAminoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoRequest)
 }
-func (x fastReflection_TxEncodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeRequest
+func (x fastReflection_TxEncodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeRequest
+func (x *fastReflection_TxEncodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeRequest_messageType
+func (x *fastReflection_TxEncodeAminoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeAminoRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeRequest) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeRequest)
+func (x *fastReflection_TxEncodeAminoRequest) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeRequest)(x)
+func (x *fastReflection_TxEncodeAminoRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeAminoRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
 // calling f for each field descriptor and value encountered.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_TxEncodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_TxEncodeRequest_tx, value) {
+// on the current field descriptor.
+func (x *fastReflection_TxEncodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AminoJson != "" {
+		value := protoreflect.ValueOfString(x.AminoJson)
+		if !f(fd_TxEncodeAminoRequest_amino_json, value) {
 			return
 		}
 	}
@@ -6562,15 +9283,15 @@ func (x *fastReflection_TxEncodeRequest) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEncodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		return x.Tx != nil
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		return x.AminoJson != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6580,15 +9301,15 @@ func (x *fastReflection_TxEncodeRequest) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEncodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		x.Tx = nil
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		x.AminoJson = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6598,16 +9319,16 @@ func (x *fastReflection_TxEncodeRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		value := x.Tx
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		value := x.AminoJson
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6621,15 +9342,15 @@ func (x *fastReflection_TxEncodeRequest) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEncodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		x.Tx = value.Message().Interface().(*Tx)
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		x.AminoJson = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6643,44 +9364,40 @@ func (x *fastReflection_TxEncodeRequest) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		if x.Tx == nil {
-			x.Tx = new(Tx)
-		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxEncodeAminoRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		m := new(Tx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEncodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6688,7 +9405,7 @@ func (x *fastReflection_TxEncodeRequest) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEncodeAminoRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6699,7 +9416,7 @@ func (x *fastReflection_TxEncodeRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEncodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6711,7 +9428,7 @@ func (x *fastReflection_TxEncodeRequest) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeRequest) IsValid() bool {
+func (x *fastReflection_TxEncodeAminoRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6721,9 +9438,9 @@ func (x *fastReflection_TxEncodeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeRequest)
+		x := input.Message.Interface().(*TxEncodeAminoRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6735,8 +9452,8 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
+		l = len(x.AminoJson)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6749,7 +9466,7 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeRequest)
+		x := input.Message.Interface().(*TxEncodeAminoRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6768,17 +9485,10 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.AminoJson) > 0 {
+			i -= len(x.AminoJson)
+			copy(dAtA[i:], x.AminoJson)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoJson)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6793,7 +9503,7 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeRequest)
+		x := input.Message.Interface().(*TxEncodeAminoRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6825,17 +9535,17 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6845,27 +9555,23 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &Tx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.AminoJson = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6903,26 +9609,26 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxEncodeResponse          protoreflect.MessageDescriptor
-	fd_TxEncodeResponse_tx_bytes protoreflect.FieldDescriptor
+	md_TxEncodeAminoResponse              protoreflect.MessageDescriptor
+	fd_TxEncodeAminoResponse_amino_binary protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeResponse")
-	fd_TxEncodeResponse_tx_bytes = md_TxEncodeResponse.Fields().ByName("tx_bytes")
+	md_TxEncodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoResponse")
+	fd_TxEncodeAminoResponse_amino_binary = md_TxEncodeAminoResponse.Fields().ByName("amino_binary")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEncodeAminoResponse)(nil)
 
-type fastReflection_TxEncodeResponse TxEncodeResponse
+type fastReflection_TxEncodeAminoResponse TxEncodeAminoResponse
 
-func (x *TxEncodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeResponse)(x)
+func (x *TxEncodeAminoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoResponse)(x)
 }
 
-func (x *TxEncodeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
+func (x *TxEncodeAminoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6933,43 +9639,43 @@ func (x *TxEncodeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeResponse_messageType fastReflection_TxEncodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeResponse_messageType{}
+var _fastReflection_TxEncodeAminoResponse_messageType fastReflection_TxEncodeAminoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeAminoResponse_messageType{}
 
-type fastReflection_TxEncodeResponse_messageType struct{}
+type fastReflection_TxEncodeAminoResponse_messageType struct{}
 
-func (x fastReflection_TxEncodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeResponse)(nil)
+func (x fastReflection_TxEncodeAminoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoResponse)(nil)
 }
-func (x fastReflection_TxEncodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeResponse)
+func (x fastReflection_TxEncodeAminoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoResponse)
 }
-func (x fastReflection_TxEncodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeResponse
+func (x fastReflection_TxEncodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeResponse
+func (x *fastReflection_TxEncodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeResponse_messageType
+func (x *fastReflection_TxEncodeAminoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeAminoResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeResponse) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeResponse)
+func (x *fastReflection_TxEncodeAminoResponse) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeResponse)(x)
+func (x *fastReflection_TxEncodeAminoResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeAminoResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6977,10 +9683,10 @@ func (x *fastReflection_TxEncodeResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxEncodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TxBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.TxBytes)
-		if !f(fd_TxEncodeResponse_tx_bytes, value) {
+func (x *fastReflection_TxEncodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AminoBinary) != 0 {
+		value := protoreflect.ValueOfBytes(x.AminoBinary)
+		if !f(fd_TxEncodeAminoResponse_amino_binary, value) {
 			return
 		}
 	}
@@ -6997,15 +9703,15 @@ func (x *fastReflection_TxEncodeResponse) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEncodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		return len(x.TxBytes) != 0
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		return len(x.AminoBinary) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7015,15 +9721,15 @@ func (x *fastReflection_TxEncodeResponse) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEncodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		x.TxBytes = nil
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		x.AminoBinary = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7033,16 +9739,16 @@ func (x *fastReflection_TxEncodeResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		value := x.TxBytes
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		value := x.AminoBinary
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7056,15 +9762,15 @@ func (x *fastReflection_TxEncodeResponse) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEncodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		x.TxBytes = value.Bytes()
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		x.AminoBinary = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7078,40 +9784,40 @@ func (x *fastReflection_TxEncodeResponse) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxEncodeResponse is not mutable"))
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxEncodeAminoResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEncodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7119,7 +9825,7 @@ func (x *fastReflection_TxEncodeResponse) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEncodeAminoResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7130,7 +9836,7 @@ func (x *fastReflection_TxEncodeResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEncodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7142,7 +9848,7 @@ func (x *fastReflection_TxEncodeResponse) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeResponse) IsValid() bool {
+func (x *fastReflection_TxEncodeAminoResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7152,9 +9858,9 @@ func (x *fastReflection_TxEncodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeResponse)
+		x := input.Message.Interface().(*TxEncodeAminoResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7166,7 +9872,7 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.TxBytes)
+		l = len(x.AminoBinary)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7180,7 +9886,7 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeResponse)
+		x := input.Message.Interface().(*TxEncodeAminoResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7199,10 +9905,10 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TxBytes) > 0 {
-			i -= len(x.TxBytes)
-			copy(dAtA[i:], x.TxBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+		if len(x.AminoBinary) > 0 {
+			i -= len(x.AminoBinary)
+			copy(dAtA[i:], x.AminoBinary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7217,7 +9923,7 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeResponse)
+		x := input.Message.Interface().(*TxEncodeAminoResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7249,15 +9955,15 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -7284,9 +9990,9 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.TxBytes == nil {
-					x.TxBytes = []byte{}
+				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
+				if x.AminoBinary == nil {
+					x.AminoBinary = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -7325,26 +10031,26 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxEncodeAminoRequest            protoreflect.MessageDescriptor
-	fd_TxEncodeAminoRequest_amino_json protoreflect.FieldDescriptor
+	md_TxDecodeAminoRequest              protoreflect.MessageDescriptor
+	fd_TxDecodeAminoRequest_amino_binary protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoRequest")
-	fd_TxEncodeAminoRequest_amino_json = md_TxEncodeAminoRequest.Fields().ByName("amino_json")
+	md_TxDecodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoRequest")
+	fd_TxDecodeAminoRequest_amino_binary = md_TxDecodeAminoRequest.Fields().ByName("amino_binary")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeAminoRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_TxDecodeAminoRequest)(nil)
 
-type fastReflection_TxEncodeAminoRequest TxEncodeAminoRequest
+type fastReflection_TxDecodeAminoRequest TxDecodeAminoRequest
 
-func (x *TxEncodeAminoRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoRequest)(x)
+func (x *TxDecodeAminoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoRequest)(x)
 }
 
-func (x *TxEncodeAminoRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
+func (x *TxDecodeAminoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7355,43 +10061,43 @@ func (x *TxEncodeAminoRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeAminoRequest_messageType fastReflection_TxEncodeAminoRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeAminoRequest_messageType{}
+var _fastReflection_TxDecodeAminoRequest_messageType fastReflection_TxDecodeAminoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeAminoRequest_messageType{}
 
-type fastReflection_TxEncodeAminoRequest_messageType struct{}
+type fastReflection_TxDecodeAminoRequest_messageType struct{}
 
-func (x fastReflection_TxEncodeAminoRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoRequest)(nil)
+func (x fastReflection_TxDecodeAminoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoRequest)(nil)
 }
-func (x fastReflection_TxEncodeAminoRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoRequest)
+func (x fastReflection_TxDecodeAminoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoRequest)
 }
-func (x fastReflection_TxEncodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoRequest
+func (x fastReflection_TxDecodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoRequest
+func (x *fastReflection_TxDecodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeAminoRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeAminoRequest_messageType
+func (x *fastReflection_TxDecodeAminoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeAminoRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeAminoRequest) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoRequest)
+func (x *fastReflection_TxDecodeAminoRequest) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeAminoRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeAminoRequest)(x)
+func (x *fastReflection_TxDecodeAminoRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeAminoRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7399,10 +10105,10 @@ func (x *fastReflection_TxEncodeAminoRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxEncodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AminoJson != "" {
-		value := protoreflect.ValueOfString(x.AminoJson)
-		if !f(fd_TxEncodeAminoRequest_amino_json, value) {
+func (x *fastReflection_TxDecodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AminoBinary) != 0 {
+		value := protoreflect.ValueOfBytes(x.AminoBinary)
+		if !f(fd_TxDecodeAminoRequest_amino_binary, value) {
 			return
 		}
 	}
@@ -7419,15 +10125,15 @@ func (x *fastReflection_TxEncodeAminoRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxDecodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
-		return x.AminoJson != ""
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		return len(x.AminoBinary) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7437,15 +10143,15 @@ func (x *fastReflection_TxEncodeAminoRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxDecodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
-		x.AminoJson = ""
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		x.AminoBinary = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7455,16 +10161,16 @@ func (x *fastReflection_TxEncodeAminoRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
-		value := x.AminoJson
-		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		value := x.AminoBinary
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7478,15 +10184,15 @@ func (x *fastReflection_TxEncodeAminoRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxDecodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
-		x.AminoJson = value.Interface().(string)
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		x.AminoBinary = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7500,40 +10206,40 @@ func (x *fastReflection_TxEncodeAminoRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
-		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxEncodeAminoRequest is not mutable"))
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxDecodeAminoRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
-		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxDecodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7541,7 +10247,7 @@ func (x *fastReflection_TxEncodeAminoRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeAminoRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxDecodeAminoRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7552,7 +10258,7 @@ func (x *fastReflection_TxEncodeAminoRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxDecodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7564,7 +10270,7 @@ func (x *fastReflection_TxEncodeAminoRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeAminoRequest) IsValid() bool {
+func (x *fastReflection_TxDecodeAminoRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7574,9 +10280,9 @@ func (x *fastReflection_TxEncodeAminoRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeAminoRequest)
+		x := input.Message.Interface().(*TxDecodeAminoRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7588,7 +10294,7 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.AminoJson)
+		l = len(x.AminoBinary)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7602,7 +10308,7 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoRequest)
+		x := input.Message.Interface().(*TxDecodeAminoRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7621,10 +10327,10 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AminoJson) > 0 {
-			i -= len(x.AminoJson)
-			copy(dAtA[i:], x.AminoJson)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoJson)))
+		if len(x.AminoBinary) > 0 {
+			i -= len(x.AminoBinary)
+			copy(dAtA[i:], x.AminoBinary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7639,7 +10345,7 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoRequest)
+		x := input.Message.Interface().(*TxDecodeAminoRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7671,17 +10377,17 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7691,23 +10397,25 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AminoJson = string(dAtA[iNdEx:postIndex])
+				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
+				if x.AminoBinary == nil {
+					x.AminoBinary = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7745,26 +10453,26 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_TxEncodeAminoResponse              protoreflect.MessageDescriptor
-	fd_TxEncodeAminoResponse_amino_binary protoreflect.FieldDescriptor
+	md_TxDecodeAminoResponse            protoreflect.MessageDescriptor
+	fd_TxDecodeAminoResponse_amino_json protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoResponse")
-	fd_TxEncodeAminoResponse_amino_binary = md_TxEncodeAminoResponse.Fields().ByName("amino_binary")
+	md_TxDecodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoResponse")
+	fd_TxDecodeAminoResponse_amino_json = md_TxDecodeAminoResponse.Fields().ByName("amino_json")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeAminoResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_TxDecodeAminoResponse)(nil)
 
-type fastReflection_TxEncodeAminoResponse TxEncodeAminoResponse
+type fastReflection_TxDecodeAminoResponse TxDecodeAminoResponse
 
-func (x *TxEncodeAminoResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoResponse)(x)
+func (x *TxDecodeAminoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoResponse)(x)
 }
 
-func (x *TxEncodeAminoResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
+func (x *TxDecodeAminoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7775,43 +10483,43 @@ func (x *TxEncodeAminoResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeAminoResponse_messageType fastReflection_TxEncodeAminoResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeAminoResponse_messageType{}
+var _fastReflection_TxDecodeAminoResponse_messageType fastReflection_TxDecodeAminoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeAminoResponse_messageType{}
 
-type fastReflection_TxEncodeAminoResponse_messageType struct{}
+type fastReflection_TxDecodeAminoResponse_messageType struct{}
 
-func (x fastReflection_TxEncodeAminoResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoResponse)(nil)
+func (x fastReflection_TxDecodeAminoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoResponse)(nil)
 }
-func (x fastReflection_TxEncodeAminoResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoResponse)
+func (x fastReflection_TxDecodeAminoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoResponse)
 }
-func (x fastReflection_TxEncodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoResponse
+func (x fastReflection_TxDecodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoResponse
+func (x *fastReflection_TxDecodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeAminoResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeAminoResponse_messageType
+func (x *fastReflection_TxDecodeAminoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeAminoResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeAminoResponse) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoResponse)
+func (x *fastReflection_TxDecodeAminoResponse) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoResponse)
 }
 
 // Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeAminoResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeAminoResponse)(x)
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxDecodeAminoResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeAminoResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7819,10 +10527,10 @@ func (x *fastReflection_TxEncodeAminoResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxEncodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AminoBinary) != 0 {
-		value := protoreflect.ValueOfBytes(x.AminoBinary)
-		if !f(fd_TxEncodeAminoResponse_amino_binary, value) {
+func (x *fastReflection_TxDecodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AminoJson != "" {
+		value := protoreflect.ValueOfString(x.AminoJson)
+		if !f(fd_TxDecodeAminoResponse_amino_json, value) {
 			return
 		}
 	}
@@ -7839,15 +10547,15 @@ func (x *fastReflection_TxEncodeAminoResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxDecodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		return len(x.AminoBinary) != 0
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+		return x.AminoJson != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7857,15 +10565,15 @@ func (x *fastReflection_TxEncodeAminoResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxDecodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		x.AminoBinary = nil
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+		x.AminoJson = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7875,16 +10583,16 @@ func (x *fastReflection_TxEncodeAminoResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		value := x.AminoBinary
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+		value := x.AminoJson
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7898,15 +10606,15 @@ func (x *fastReflection_TxEncodeAminoResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxDecodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		x.AminoBinary = value.Bytes()
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+		x.AminoJson = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7920,40 +10628,40 @@ func (x *fastReflection_TxEncodeAminoResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxEncodeAminoResponse is not mutable"))
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxDecodeAminoResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxDecodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7961,7 +10669,7 @@ func (x *fastReflection_TxEncodeAminoResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeAminoResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxDecodeAminoResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7972,7 +10680,7 @@ func (x *fastReflection_TxEncodeAminoResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxDecodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7984,7 +10692,7 @@ func (x *fastReflection_TxEncodeAminoResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeAminoResponse) IsValid() bool {
+func (x *fastReflection_TxDecodeAminoResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7994,9 +10702,9 @@ func (x *fastReflection_TxEncodeAminoResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeAminoResponse)
+		x := input.Message.Interface().(*TxDecodeAminoResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8008,7 +10716,7 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.AminoBinary)
+		l = len(x.AminoJson)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8022,7 +10730,7 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoResponse)
+		x := input.Message.Interface().(*TxDecodeAminoResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8041,10 +10749,10 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AminoBinary) > 0 {
-			i -= len(x.AminoBinary)
-			copy(dAtA[i:], x.AminoBinary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
+		if len(x.AminoJson) > 0 {
+			i -= len(x.AminoJson)
+			copy(dAtA[i:], x.AminoJson)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoJson)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8059,7 +10767,7 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoResponse)
+		x := input.Message.Interface().(*TxDecodeAminoResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8091,17 +10799,17 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8111,25 +10819,23 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
-				if x.AminoBinary == nil {
-					x.AminoBinary = []byte{}
-				}
+				x.AminoJson = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8167,26 +10873,28 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_TxDecodeAminoRequest              protoreflect.MessageDescriptor
-	fd_TxDecodeAminoRequest_amino_binary protoreflect.FieldDescriptor
+	md_SimulateParamsUpdateRequest        protoreflect.MessageDescriptor
+	fd_SimulateParamsUpdateRequest_msg    protoreflect.FieldDescriptor
+	fd_SimulateParamsUpdateRequest_strict protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoRequest")
-	fd_TxDecodeAminoRequest_amino_binary = md_TxDecodeAminoRequest.Fields().ByName("amino_binary")
+	md_SimulateParamsUpdateRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateParamsUpdateRequest")
+	fd_SimulateParamsUpdateRequest_msg = md_SimulateParamsUpdateRequest.Fields().ByName("msg")
+	fd_SimulateParamsUpdateRequest_strict = md_SimulateParamsUpdateRequest.Fields().ByName("strict")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeAminoRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_SimulateParamsUpdateRequest)(nil)
 
-type fastReflection_TxDecodeAminoRequest TxDecodeAminoRequest
+type fastReflection_SimulateParamsUpdateRequest SimulateParamsUpdateRequest
 
-func (x *TxDecodeAminoRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoRequest)(x)
+func (x *SimulateParamsUpdateRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulateParamsUpdateRequest)(x)
 }
 
-func (x *TxDecodeAminoRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
+func (x *SimulateParamsUpdateRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8197,43 +10905,43 @@ func (x *TxDecodeAminoRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeAminoRequest_messageType fastReflection_TxDecodeAminoRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeAminoRequest_messageType{}
+var _fastReflection_SimulateParamsUpdateRequest_messageType fastReflection_SimulateParamsUpdateRequest_messageType
+var _ protoreflect.MessageType = fastReflection_SimulateParamsUpdateRequest_messageType{}
 
-type fastReflection_TxDecodeAminoRequest_messageType struct{}
+type fastReflection_SimulateParamsUpdateRequest_messageType struct{}
 
-func (x fastReflection_TxDecodeAminoRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoRequest)(nil)
+func (x fastReflection_SimulateParamsUpdateRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulateParamsUpdateRequest)(nil)
 }
-func (x fastReflection_TxDecodeAminoRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoRequest)
+func (x fastReflection_SimulateParamsUpdateRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulateParamsUpdateRequest)
 }
-func (x fastReflection_TxDecodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoRequest
+func (x fastReflection_SimulateParamsUpdateRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateParamsUpdateRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoRequest
+func (x *fastReflection_SimulateParamsUpdateRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateParamsUpdateRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeAminoRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeAminoRequest_messageType
+func (x *fastReflection_SimulateParamsUpdateRequest) Type() protoreflect.MessageType {
+	return _fastReflection_SimulateParamsUpdateRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeAminoRequest) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoRequest)
+func (x *fastReflection_SimulateParamsUpdateRequest) New() protoreflect.Message {
+	return new(fastReflection_SimulateParamsUpdateRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeAminoRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeAminoRequest)(x)
+func (x *fastReflection_SimulateParamsUpdateRequest) Interface() protoreflect.ProtoMessage {
+	return (*SimulateParamsUpdateRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8241,10 +10949,16 @@ func (x *fastReflection_TxDecodeAminoRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AminoBinary) != 0 {
-		value := protoreflect.ValueOfBytes(x.AminoBinary)
-		if !f(fd_TxDecodeAminoRequest_amino_binary, value) {
+func (x *fastReflection_SimulateParamsUpdateRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_SimulateParamsUpdateRequest_msg, value) {
+			return
+		}
+	}
+	if x.Strict != false {
+		value := protoreflect.ValueOfBool(x.Strict)
+		if !f(fd_SimulateParamsUpdateRequest_strict, value) {
 			return
 		}
 	}
@@ -8261,15 +10975,17 @@ func (x *fastReflection_TxDecodeAminoRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SimulateParamsUpdateRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		return len(x.AminoBinary) != 0
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.msg":
+		return x.Msg != nil
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.strict":
+		return x.Strict != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8279,15 +10995,17 @@ func (x *fastReflection_TxDecodeAminoRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SimulateParamsUpdateRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		x.AminoBinary = nil
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.msg":
+		x.Msg = nil
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.strict":
+		x.Strict = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8297,16 +11015,19 @@ func (x *fastReflection_TxDecodeAminoRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateParamsUpdateRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		value := x.AminoBinary
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.strict":
+		value := x.Strict
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8320,15 +11041,17 @@ func (x *fastReflection_TxDecodeAminoRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SimulateParamsUpdateRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		x.AminoBinary = value.Bytes()
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.msg":
+		x.Msg = value.Message().Interface().(*anypb.Any)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.strict":
+		x.Strict = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8342,40 +11065,48 @@ func (x *fastReflection_TxDecodeAminoRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateParamsUpdateRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxDecodeAminoRequest is not mutable"))
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.msg":
+		if x.Msg == nil {
+			x.Msg = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.strict":
+		panic(fmt.Errorf("field strict of message cosmos.tx.v1beta1.SimulateParamsUpdateRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateParamsUpdateRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.msg":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateRequest.strict":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SimulateParamsUpdateRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.SimulateParamsUpdateRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8383,7 +11114,7 @@ func (x *fastReflection_TxDecodeAminoRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeAminoRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SimulateParamsUpdateRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8394,7 +11125,7 @@ func (x *fastReflection_TxDecodeAminoRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SimulateParamsUpdateRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8406,7 +11137,7 @@ func (x *fastReflection_TxDecodeAminoRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeAminoRequest) IsValid() bool {
+func (x *fastReflection_SimulateParamsUpdateRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8416,9 +11147,9 @@ func (x *fastReflection_TxDecodeAminoRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SimulateParamsUpdateRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeAminoRequest)
+		x := input.Message.Interface().(*SimulateParamsUpdateRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8430,10 +11161,13 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.AminoBinary)
-		if l > 0 {
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Strict {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8444,7 +11178,7 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoRequest)
+		x := input.Message.Interface().(*SimulateParamsUpdateRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8463,10 +11197,27 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AminoBinary) > 0 {
-			i -= len(x.AminoBinary)
-			copy(dAtA[i:], x.AminoBinary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
+		if x.Strict {
+			i--
+			if x.Strict {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8481,7 +11232,7 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoRequest)
+		x := input.Message.Interface().(*SimulateParamsUpdateRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8513,17 +11264,17 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateParamsUpdateRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateParamsUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8533,26 +11284,48 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
-				if x.AminoBinary == nil {
-					x.AminoBinary = []byte{}
+				if x.Msg == nil {
+					x.Msg = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Strict = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8589,26 +11362,30 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_TxDecodeAminoResponse            protoreflect.MessageDescriptor
-	fd_TxDecodeAminoResponse_amino_json protoreflect.FieldDescriptor
+	md_SimulateParamsUpdateResponse          protoreflect.MessageDescriptor
+	fd_SimulateParamsUpdateResponse_valid    protoreflect.FieldDescriptor
+	fd_SimulateParamsUpdateResponse_error    protoreflect.FieldDescriptor
+	fd_SimulateParamsUpdateResponse_gas_info protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoResponse")
-	fd_TxDecodeAminoResponse_amino_json = md_TxDecodeAminoResponse.Fields().ByName("amino_json")
+	md_SimulateParamsUpdateResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateParamsUpdateResponse")
+	fd_SimulateParamsUpdateResponse_valid = md_SimulateParamsUpdateResponse.Fields().ByName("valid")
+	fd_SimulateParamsUpdateResponse_error = md_SimulateParamsUpdateResponse.Fields().ByName("error")
+	fd_SimulateParamsUpdateResponse_gas_info = md_SimulateParamsUpdateResponse.Fields().ByName("gas_info")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeAminoResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_SimulateParamsUpdateResponse)(nil)
 
-type fastReflection_TxDecodeAminoResponse TxDecodeAminoResponse
+type fastReflection_SimulateParamsUpdateResponse SimulateParamsUpdateResponse
 
-func (x *TxDecodeAminoResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoResponse)(x)
+func (x *SimulateParamsUpdateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulateParamsUpdateResponse)(x)
 }
 
-func (x *TxDecodeAminoResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
+func (x *SimulateParamsUpdateResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8619,43 +11396,43 @@ func (x *TxDecodeAminoResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeAminoResponse_messageType fastReflection_TxDecodeAminoResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeAminoResponse_messageType{}
+var _fastReflection_SimulateParamsUpdateResponse_messageType fastReflection_SimulateParamsUpdateResponse_messageType
+var _ protoreflect.MessageType = fastReflection_SimulateParamsUpdateResponse_messageType{}
 
-type fastReflection_TxDecodeAminoResponse_messageType struct{}
+type fastReflection_SimulateParamsUpdateResponse_messageType struct{}
 
-func (x fastReflection_TxDecodeAminoResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoResponse)(nil)
+func (x fastReflection_SimulateParamsUpdateResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulateParamsUpdateResponse)(nil)
 }
-func (x fastReflection_TxDecodeAminoResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoResponse)
+func (x fastReflection_SimulateParamsUpdateResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulateParamsUpdateResponse)
 }
-func (x fastReflection_TxDecodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoResponse
+func (x fastReflection_SimulateParamsUpdateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateParamsUpdateResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoResponse
+func (x *fastReflection_SimulateParamsUpdateResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateParamsUpdateResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeAminoResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeAminoResponse_messageType
+func (x *fastReflection_SimulateParamsUpdateResponse) Type() protoreflect.MessageType {
+	return _fastReflection_SimulateParamsUpdateResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeAminoResponse) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoResponse)
+func (x *fastReflection_SimulateParamsUpdateResponse) New() protoreflect.Message {
+	return new(fastReflection_SimulateParamsUpdateResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeAminoResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeAminoResponse)(x)
+func (x *fastReflection_SimulateParamsUpdateResponse) Interface() protoreflect.ProtoMessage {
+	return (*SimulateParamsUpdateResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8663,10 +11440,22 @@ func (x *fastReflection_TxDecodeAminoResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AminoJson != "" {
-		value := protoreflect.ValueOfString(x.AminoJson)
-		if !f(fd_TxDecodeAminoResponse_amino_json, value) {
+func (x *fastReflection_SimulateParamsUpdateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Valid != false {
+		value := protoreflect.ValueOfBool(x.Valid)
+		if !f(fd_SimulateParamsUpdateResponse_valid, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_SimulateParamsUpdateResponse_error, value) {
+			return
+		}
+	}
+	if x.GasInfo != nil {
+		value := protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+		if !f(fd_SimulateParamsUpdateResponse_gas_info, value) {
 			return
 		}
 	}
@@ -8683,15 +11472,19 @@ func (x *fastReflection_TxDecodeAminoResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SimulateParamsUpdateResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		return x.AminoJson != ""
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.valid":
+		return x.Valid != false
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.error":
+		return x.Error != ""
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.gas_info":
+		return x.GasInfo != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8701,15 +11494,19 @@ func (x *fastReflection_TxDecodeAminoResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SimulateParamsUpdateResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		x.AminoJson = ""
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.valid":
+		x.Valid = false
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.error":
+		x.Error = ""
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.gas_info":
+		x.GasInfo = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8719,16 +11516,22 @@ func (x *fastReflection_TxDecodeAminoResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateParamsUpdateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		value := x.AminoJson
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.valid":
+		value := x.Valid
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.error":
+		value := x.Error
 		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.gas_info":
+		value := x.GasInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8742,15 +11545,19 @@ func (x *fastReflection_TxDecodeAminoResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SimulateParamsUpdateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		x.AminoJson = value.Interface().(string)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.valid":
+		x.Valid = value.Bool()
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.error":
+		x.Error = value.Interface().(string)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.gas_info":
+		x.GasInfo = value.Message().Interface().(*v1beta11.GasInfo)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8764,40 +11571,52 @@ func (x *fastReflection_TxDecodeAminoResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateParamsUpdateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxDecodeAminoResponse is not mutable"))
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.gas_info":
+		if x.GasInfo == nil {
+			x.GasInfo = new(v1beta11.GasInfo)
+		}
+		return protoreflect.ValueOfMessage(x.GasInfo.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.valid":
+		panic(fmt.Errorf("field valid of message cosmos.tx.v1beta1.SimulateParamsUpdateResponse is not mutable"))
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.error":
+		panic(fmt.Errorf("field error of message cosmos.tx.v1beta1.SimulateParamsUpdateResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SimulateParamsUpdateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.valid":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.error":
 		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.SimulateParamsUpdateResponse.gas_info":
+		m := new(v1beta11.GasInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateParamsUpdateResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.SimulateParamsUpdateResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SimulateParamsUpdateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.SimulateParamsUpdateResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8805,7 +11624,7 @@ func (x *fastReflection_TxDecodeAminoResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeAminoResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SimulateParamsUpdateResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8816,7 +11635,7 @@ func (x *fastReflection_TxDecodeAminoResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SimulateParamsUpdateResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8828,7 +11647,7 @@ func (x *fastReflection_TxDecodeAminoResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeAminoResponse) IsValid() bool {
+func (x *fastReflection_SimulateParamsUpdateResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8838,9 +11657,9 @@ func (x *fastReflection_TxDecodeAminoResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SimulateParamsUpdateResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeAminoResponse)
+		x := input.Message.Interface().(*SimulateParamsUpdateResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8852,10 +11671,17 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.AminoJson)
+		if x.Valid {
+			n += 2
+		}
+		l = len(x.Error)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.GasInfo != nil {
+			l = options.Size(x.GasInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8866,7 +11692,7 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoResponse)
+		x := input.Message.Interface().(*SimulateParamsUpdateResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8885,12 +11711,36 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AminoJson) > 0 {
-			i -= len(x.AminoJson)
-			copy(dAtA[i:], x.AminoJson)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoJson)))
+		if x.GasInfo != nil {
+			encoded, err := options.Marshal(x.GasInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Valid {
+			i--
+			if x.Valid {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8903,7 +11753,7 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoResponse)
+		x := input.Message.Interface().(*SimulateParamsUpdateResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8935,15 +11785,35 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateParamsUpdateResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateParamsUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Valid = bool(v != 0)
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8971,7 +11841,43 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AminoJson = string(dAtA[iNdEx:postIndex])
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasInfo == nil {
+					x.GasInfo = &v1beta11.GasInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9168,6 +12074,12 @@ type GetTxsEventRequest struct {
 	//
 	// Since Cosmos SDK 0.48
 	Query string `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	// event_query defines a structured transaction event query whose condition
+	// groups are combined with OR. If query is also set, it is combined with
+	// every group with AND.
+	//
+	// Since Cosmos SDK 0.48
+	EventQuery *TxEventQuery `protobuf:"bytes,7,opt,name=event_query,json=eventQuery,proto3" json:"event_query,omitempty"`
 }
 
 func (x *GetTxsEventRequest) Reset() {
@@ -9234,6 +12146,114 @@ func (x *GetTxsEventRequest) GetQuery() string {
 	return ""
 }
 
+func (x *GetTxsEventRequest) GetEventQuery() *TxEventQuery {
+	if x != nil {
+		return x.EventQuery
+	}
+	return nil
+}
+
+// TxEventQuery defines a structured transaction event query.
+//
+// Since Cosmos SDK 0.48
+type TxEventQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// groups is the list of condition groups of the query. A transaction matches
+	// the query if it matches all the conditions of any of the groups.
+	Groups []*TxEventConditionGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// from_height is the minimum height of the queried transactions, inclusive.
+	// It is ignored if zero.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the maximum height of the queried transactions, inclusive.
+	// It is ignored if zero.
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (x *TxEventQuery) Reset() {
+	*x = TxEventQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxEventQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxEventQuery) ProtoMessage() {}
+
+// Deprecated: Use TxEventQuery.ProtoReflect.Descriptor instead.
+func (*TxEventQuery) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TxEventQuery) GetGroups() []*TxEventConditionGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *TxEventQuery) GetFromHeight() int64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *TxEventQuery) GetToHeight() int64 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+// TxEventConditionGroup defines a group of transaction event conditions
+// combined with AND.
+//
+// Since Cosmos SDK 0.48
+type TxEventConditionGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// conditions is the list of conditions of the group in the CometBFT event
+	// query syntax, e.g. "message.sender='cosmos1...'".
+	Conditions []string `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+}
+
+func (x *TxEventConditionGroup) Reset() {
+	*x = TxEventConditionGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxEventConditionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxEventConditionGroup) ProtoMessage() {}
+
+// Deprecated: Use TxEventConditionGroup.ProtoReflect.Descriptor instead.
+func (*TxEventConditionGroup) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TxEventConditionGroup) GetConditions() []string {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 // GetTxsEventResponse is the response type for the Service.TxsByEvents
 // RPC method.
 type GetTxsEventResponse struct {
@@ -9252,12 +12272,17 @@ type GetTxsEventResponse struct {
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// total is total number of results available
 	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// merged is true if the results were merged from one transaction search per
+	// condition group of the event query.
+	//
+	// Since Cosmos SDK 0.48
+	Merged bool `protobuf:"varint,5,opt,name=merged,proto3" json:"merged,omitempty"`
 }
 
 func (x *GetTxsEventResponse) Reset() {
 	*x = GetTxsEventResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[1]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9271,7 +12296,7 @@ func (*GetTxsEventResponse) ProtoMessage() {}
 
 // Deprecated: Use GetTxsEventResponse.ProtoReflect.Descriptor instead.
 func (*GetTxsEventResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetTxsEventResponse) GetTxs() []*Tx {
@@ -9303,6 +12328,13 @@ func (x *GetTxsEventResponse) GetTotal() uint64 {
 	return 0
 }
 
+func (x *GetTxsEventResponse) GetMerged() bool {
+	if x != nil {
+		return x.Merged
+	}
+	return false
+}
+
 // BroadcastTxRequest is the request type for the Service.BroadcastTxRequest
 // RPC method.
 type BroadcastTxRequest struct {
@@ -9318,7 +12350,7 @@ type BroadcastTxRequest struct {
 func (x *BroadcastTxRequest) Reset() {
 	*x = BroadcastTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[2]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9332,7 +12364,7 @@ func (*BroadcastTxRequest) ProtoMessage() {}
 
 // Deprecated: Use BroadcastTxRequest.ProtoReflect.Descriptor instead.
 func (*BroadcastTxRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BroadcastTxRequest) GetTxBytes() []byte {
@@ -9363,7 +12395,7 @@ type BroadcastTxResponse struct {
 func (x *BroadcastTxResponse) Reset() {
 	*x = BroadcastTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[3]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9377,7 +12409,7 @@ func (*BroadcastTxResponse) ProtoMessage() {}
 
 // Deprecated: Use BroadcastTxResponse.ProtoReflect.Descriptor instead.
 func (*BroadcastTxResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BroadcastTxResponse) GetTxResponse() *v1beta11.TxResponse {
@@ -9408,7 +12440,7 @@ type SimulateRequest struct {
 func (x *SimulateRequest) Reset() {
 	*x = SimulateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[4]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9422,7 +12454,7 @@ func (*SimulateRequest) ProtoMessage() {}
 
 // Deprecated: Use SimulateRequest.ProtoReflect.Descriptor instead.
 func (*SimulateRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{6}
 }
 
 // Deprecated: Do not use.
@@ -9456,7 +12488,7 @@ type SimulateResponse struct {
 func (x *SimulateResponse) Reset() {
 	*x = SimulateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[5]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9470,7 +12502,7 @@ func (*SimulateResponse) ProtoMessage() {}
 
 // Deprecated: Use SimulateResponse.ProtoReflect.Descriptor instead.
 func (*SimulateResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SimulateResponse) GetGasInfo() *v1beta11.GasInfo {
@@ -9501,7 +12533,7 @@ type GetTxRequest struct {
 func (x *GetTxRequest) Reset() {
 	*x = GetTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[6]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9515,7 +12547,7 @@ func (*GetTxRequest) ProtoMessage() {}
 
 // Deprecated: Use GetTxRequest.ProtoReflect.Descriptor instead.
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTxRequest) GetHash() string {
@@ -9540,7 +12572,7 @@ type GetTxResponse struct {
 func (x *GetTxResponse) Reset() {
 	*x = GetTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[7]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9554,7 +12586,7 @@ func (*GetTxResponse) ProtoMessage() {}
 
 // Deprecated: Use GetTxResponse.ProtoReflect.Descriptor instead.
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetTxResponse) GetTx() *Tx {
@@ -9584,12 +12616,17 @@ type GetBlockWithTxsRequest struct {
 	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	// pagination defines a pagination for the request.
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// skip_decode_errors makes the txs which cannot be decoded be reported in
+	// the decode_errors of the response instead of failing the request.
+	//
+	// Since: cosmos-sdk 0.48
+	SkipDecodeErrors bool `protobuf:"varint,3,opt,name=skip_decode_errors,json=skipDecodeErrors,proto3" json:"skip_decode_errors,omitempty"`
 }
 
 func (x *GetBlockWithTxsRequest) Reset() {
 	*x = GetBlockWithTxsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[8]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9603,7 +12640,7 @@ func (*GetBlockWithTxsRequest) ProtoMessage() {}
 
 // Deprecated: Use GetBlockWithTxsRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetBlockWithTxsRequest) GetHeight() int64 {
@@ -9620,6 +12657,13 @@ func (x *GetBlockWithTxsRequest) GetPagination() *v1beta1.PageRequest {
 	return nil
 }
 
+func (x *GetBlockWithTxsRequest) GetSkipDecodeErrors() bool {
+	if x != nil {
+		return x.SkipDecodeErrors
+	}
+	return false
+}
+
 // GetBlockWithTxsResponse is the response type for the Service.GetBlockWithTxs
 // method.
 //
@@ -9635,12 +12679,17 @@ type GetBlockWithTxsResponse struct {
 	Block   *types.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
 	// pagination defines a pagination for the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// decode_errors are the errors of the txs of the requested page which could
+	// not be decoded, when skip_decode_errors is set.
+	//
+	// Since: cosmos-sdk 0.48
+	DecodeErrors []*TxDecodeError `protobuf:"bytes,5,rep,name=decode_errors,json=decodeErrors,proto3" json:"decode_errors,omitempty"`
 }
 
 func (x *GetBlockWithTxsResponse) Reset() {
 	*x = GetBlockWithTxsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[9]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9654,7 +12703,7 @@ func (*GetBlockWithTxsResponse) ProtoMessage() {}
 
 // Deprecated: Use GetBlockWithTxsResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetBlockWithTxsResponse) GetTxs() []*Tx {
@@ -9685,6 +12734,61 @@ func (x *GetBlockWithTxsResponse) GetPagination() *v1beta1.PageResponse {
 	return nil
 }
 
+func (x *GetBlockWithTxsResponse) GetDecodeErrors() []*TxDecodeError {
+	if x != nil {
+		return x.DecodeErrors
+	}
+	return nil
+}
+
+// TxDecodeError is the error of a block tx which could not be decoded.
+//
+// Since: cosmos-sdk 0.48
+type TxDecodeError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the index of the tx in the block.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// error is the decoding error.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TxDecodeError) Reset() {
+	*x = TxDecodeError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxDecodeError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxDecodeError) ProtoMessage() {}
+
+// Deprecated: Use TxDecodeError.ProtoReflect.Descriptor instead.
+func (*TxDecodeError) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TxDecodeError) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TxDecodeError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // TxDecodeRequest is the request type for the Service.TxDecode
 // RPC method.
 //
@@ -9701,7 +12805,7 @@ type TxDecodeRequest struct {
 func (x *TxDecodeRequest) Reset() {
 	*x = TxDecodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9715,7 +12819,7 @@ func (*TxDecodeRequest) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeRequest.ProtoReflect.Descriptor instead.
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TxDecodeRequest) GetTxBytes() []byte {
@@ -9741,7 +12845,7 @@ type TxDecodeResponse struct {
 func (x *TxDecodeResponse) Reset() {
 	*x = TxDecodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9755,7 +12859,7 @@ func (*TxDecodeResponse) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeResponse.ProtoReflect.Descriptor instead.
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TxDecodeResponse) GetTx() *Tx {
@@ -9781,7 +12885,7 @@ type TxEncodeRequest struct {
 func (x *TxEncodeRequest) Reset() {
 	*x = TxEncodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9795,7 +12899,7 @@ func (*TxEncodeRequest) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeRequest.ProtoReflect.Descriptor instead.
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TxEncodeRequest) GetTx() *Tx {
@@ -9821,7 +12925,7 @@ type TxEncodeResponse struct {
 func (x *TxEncodeResponse) Reset() {
 	*x = TxEncodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9835,7 +12939,7 @@ func (*TxEncodeResponse) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeResponse.ProtoReflect.Descriptor instead.
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TxEncodeResponse) GetTxBytes() []byte {
@@ -9860,7 +12964,7 @@ type TxEncodeAminoRequest struct {
 func (x *TxEncodeAminoRequest) Reset() {
 	*x = TxEncodeAminoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9874,7 +12978,7 @@ func (*TxEncodeAminoRequest) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeAminoRequest.ProtoReflect.Descriptor instead.
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TxEncodeAminoRequest) GetAminoJson() string {
@@ -9899,7 +13003,7 @@ type TxEncodeAminoResponse struct {
 func (x *TxEncodeAminoResponse) Reset() {
 	*x = TxEncodeAminoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9913,7 +13017,7 @@ func (*TxEncodeAminoResponse) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeAminoResponse.ProtoReflect.Descriptor instead.
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TxEncodeAminoResponse) GetAminoBinary() []byte {
@@ -9938,7 +13042,7 @@ type TxDecodeAminoRequest struct {
 func (x *TxDecodeAminoRequest) Reset() {
 	*x = TxDecodeAminoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9952,7 +13056,7 @@ func (*TxDecodeAminoRequest) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeAminoRequest.ProtoReflect.Descriptor instead.
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TxDecodeAminoRequest) GetAminoBinary() []byte {
@@ -9977,7 +13081,7 @@ type TxDecodeAminoResponse struct {
 func (x *TxDecodeAminoResponse) Reset() {
 	*x = TxDecodeAminoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9991,7 +13095,7 @@ func (*TxDecodeAminoResponse) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeAminoResponse.ProtoReflect.Descriptor instead.
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TxDecodeAminoResponse) GetAminoJson() string {
@@ -10001,6 +13105,112 @@ func (x *TxDecodeAminoResponse) GetAminoJson() string {
 	return ""
 }
 
+// SimulateParamsUpdateRequest is the request type for the
+// Service.SimulateParamsUpdate RPC method.
+type SimulateParamsUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg is the MsgUpdateParams-style message to dry-run.
+	Msg *anypb.Any `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// strict, when set, runs msg with its own authority so that a signer which
+	// isn't the module authority is refused. Otherwise the authority is
+	// replaced with the governance module account and only the params are
+	// validated.
+	Strict bool `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (x *SimulateParamsUpdateRequest) Reset() {
+	*x = SimulateParamsUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateParamsUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateParamsUpdateRequest) ProtoMessage() {}
+
+// Deprecated: Use SimulateParamsUpdateRequest.ProtoReflect.Descriptor instead.
+func (*SimulateParamsUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SimulateParamsUpdateRequest) GetMsg() *anypb.Any {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *SimulateParamsUpdateRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+// SimulateParamsUpdateResponse is the response type for the
+// Service.SimulateParamsUpdate RPC method.
+type SimulateParamsUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// valid is true if the message would have been executed successfully.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// error is the validation or execution error, if any.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_info is the information about gas used in the dry-run.
+	GasInfo *v1beta11.GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+}
+
+func (x *SimulateParamsUpdateResponse) Reset() {
+	*x = SimulateParamsUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateParamsUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateParamsUpdateResponse) ProtoMessage() {}
+
+// Deprecated: Use SimulateParamsUpdateResponse.ProtoReflect.Descriptor instead.
+func (*SimulateParamsUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SimulateParamsUpdateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *SimulateParamsUpdateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SimulateParamsUpdateResponse) GetGasInfo() *v1beta11.GasInfo {
+	if x != nil {
+		return x.GasInfo
+	}
+	return nil
+}
+
 var File_cosmos_tx_v1beta1_service_proto protoreflect.FileDescriptor
 
 var file_cosmos_tx_v1beta1_service_proto_rawDesc = []byte{
@@ -10009,84 +13219,104 @@ var file_cosmos_tx_v1beta1_service_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
-	0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x62, 0x63,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1c, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x01, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4a,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x03, 0x74,
-	0x78, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b,
-	0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x65,
-	0x0a, 0x12, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x78,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x0b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x8e,
+	0x01, 0x0a, 0x0c, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x40, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x37, 0x0a, 0x15, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
-	0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0b,
-	0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x74,
-	0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a,
-	0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3c, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
-	0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62,
-	0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x7d, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54,
-	0x78, 0x52, 0x02, 0x74, 0x78, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x0a, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x16,
+	0x31, 0x2e, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x78, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62,
+	0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x22, 0x65, 0x0a,
+	0x12, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x74,
+	0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61,
+	0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x57, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x74, 0x78,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x10,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63,
+	0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x7d, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78,
+	0x52, 0x02, 0x74, 0x78, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x16,
 	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x46,
@@ -10094,149 +13324,186 @@ var file_cosmos_tx_v1beta1_service_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
 	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
 	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x64, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x0f, 0x54, 0x78, 0x44,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x54, 0x78, 0x44, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x02, 0x74,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02,
-	0x74, 0x78, 0x22, 0x38, 0x0a, 0x0f, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x74, 0x78, 0x22, 0x2d, 0x0a, 0x10,
-	0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x54,
-	0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x4a, 0x73,
-	0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d,
-	0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22, 0x39,
-	0x0a, 0x14, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f,
-	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x6d,
-	0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22, 0x36, 0x0a, 0x15, 0x54, 0x78, 0x44,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x4a, 0x73, 0x6f,
-	0x6e, 0x2a, 0x48, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x14,
-	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x42, 0x59, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x80, 0x01, 0x0a, 0x0d,
-	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a,
-	0x1a, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a,
-	0x14, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
-	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x42,
-	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53,
-	0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x03, 0x32, 0xaa,
-	0x09, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7b, 0x0a, 0x08, 0x53, 0x69,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0xb7, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3b,
+	0x0a, 0x0d, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x0f, 0x54,
+	0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x54, 0x78, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78,
+	0x52, 0x02, 0x74, 0x78, 0x22, 0x38, 0x0a, 0x0f, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x74, 0x78, 0x22, 0x2d,
+	0x0a, 0x10, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x35, 0x0a,
+	0x14, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x22, 0x39, 0x0a, 0x14, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22, 0x36, 0x0a, 0x15, 0x54,
+	0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x4a,
+	0x73, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1b, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x1c, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x3c, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2a, 0x48, 0x0a,
+	0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x42, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x41,
+	0x53, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59,
+	0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x80, 0x01, 0x0a, 0x0d, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x52, 0x4f,
+	0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x14, 0x42, 0x52, 0x4f,
+	0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x52, 0x4f, 0x41, 0x44,
+	0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x02,
+	0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x03, 0x32, 0xda, 0x0a, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7b, 0x0a, 0x08, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x54, 0x78,
-	0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x74, 0x78, 0x73, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x7f, 0x0a, 0x0b, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x12, 0x7c, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x12, 0x16, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x12, 0x29, 0x2e,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x54, 0x78, 0x12, 0x1f, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x63,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x2f,
+	0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x7f, 0x0a, 0x0b, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x54, 0x78, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x74, 0x78, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x7d, 0x12, 0x79, 0x0a, 0x08, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x79,
-	0x0a, 0x08, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54,
-	0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x54, 0x78,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x27, 0x2e, 0x63, 0x6f,
+	0x2f, 0x74, 0x78, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x78,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x74, 0x78, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73,
+	0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12,
+	0x79, 0x0a, 0x08, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x54,
-	0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x27, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
-	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0xb9, 0x01, 0x0a, 0x15,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74,
-	0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x78, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x54, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x54, 0x78, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x11,
+	0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x08, 0x54, 0x78,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54,
+	0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69,
+	0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74,
+	0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x54, 0x78, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d,
+	0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0xad, 0x01, 0x0a, 0x14, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0xb9, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x78, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x54, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x54,
+	0x78, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1d,
 	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0xe2, 0x02, 0x1d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x54, 0x78, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x54, 0x78, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10252,80 +13519,93 @@ func file_cosmos_tx_v1beta1_service_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_tx_v1beta1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_tx_v1beta1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_cosmos_tx_v1beta1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_cosmos_tx_v1beta1_service_proto_goTypes = []interface{}{
-	(OrderBy)(0),                    // 0: cosmos.tx.v1beta1.OrderBy
-	(BroadcastMode)(0),              // 1: cosmos.tx.v1beta1.BroadcastMode
-	(*GetTxsEventRequest)(nil),      // 2: cosmos.tx.v1beta1.GetTxsEventRequest
-	(*GetTxsEventResponse)(nil),     // 3: cosmos.tx.v1beta1.GetTxsEventResponse
-	(*BroadcastTxRequest)(nil),      // 4: cosmos.tx.v1beta1.BroadcastTxRequest
-	(*BroadcastTxResponse)(nil),     // 5: cosmos.tx.v1beta1.BroadcastTxResponse
-	(*SimulateRequest)(nil),         // 6: cosmos.tx.v1beta1.SimulateRequest
-	(*SimulateResponse)(nil),        // 7: cosmos.tx.v1beta1.SimulateResponse
-	(*GetTxRequest)(nil),            // 8: cosmos.tx.v1beta1.GetTxRequest
-	(*GetTxResponse)(nil),           // 9: cosmos.tx.v1beta1.GetTxResponse
-	(*GetBlockWithTxsRequest)(nil),  // 10: cosmos.tx.v1beta1.GetBlockWithTxsRequest
-	(*GetBlockWithTxsResponse)(nil), // 11: cosmos.tx.v1beta1.GetBlockWithTxsResponse
-	(*TxDecodeRequest)(nil),         // 12: cosmos.tx.v1beta1.TxDecodeRequest
-	(*TxDecodeResponse)(nil),        // 13: cosmos.tx.v1beta1.TxDecodeResponse
-	(*TxEncodeRequest)(nil),         // 14: cosmos.tx.v1beta1.TxEncodeRequest
-	(*TxEncodeResponse)(nil),        // 15: cosmos.tx.v1beta1.TxEncodeResponse
-	(*TxEncodeAminoRequest)(nil),    // 16: cosmos.tx.v1beta1.TxEncodeAminoRequest
-	(*TxEncodeAminoResponse)(nil),   // 17: cosmos.tx.v1beta1.TxEncodeAminoResponse
-	(*TxDecodeAminoRequest)(nil),    // 18: cosmos.tx.v1beta1.TxDecodeAminoRequest
-	(*TxDecodeAminoResponse)(nil),   // 19: cosmos.tx.v1beta1.TxDecodeAminoResponse
-	(*v1beta1.PageRequest)(nil),     // 20: cosmos.base.query.v1beta1.PageRequest
-	(*Tx)(nil),                      // 21: cosmos.tx.v1beta1.Tx
-	(*v1beta11.TxResponse)(nil),     // 22: cosmos.base.abci.v1beta1.TxResponse
-	(*v1beta1.PageResponse)(nil),    // 23: cosmos.base.query.v1beta1.PageResponse
-	(*v1beta11.GasInfo)(nil),        // 24: cosmos.base.abci.v1beta1.GasInfo
-	(*v1beta11.Result)(nil),         // 25: cosmos.base.abci.v1beta1.Result
-	(*types.BlockID)(nil),           // 26: tendermint.types.BlockID
-	(*types.Block)(nil),             // 27: tendermint.types.Block
+	(OrderBy)(0),                         // 0: cosmos.tx.v1beta1.OrderBy
+	(BroadcastMode)(0),                   // 1: cosmos.tx.v1beta1.BroadcastMode
+	(*GetTxsEventRequest)(nil),           // 2: cosmos.tx.v1beta1.GetTxsEventRequest
+	(*TxEventQuery)(nil),                 // 3: cosmos.tx.v1beta1.TxEventQuery
+	(*TxEventConditionGroup)(nil),        // 4: cosmos.tx.v1beta1.TxEventConditionGroup
+	(*GetTxsEventResponse)(nil),          // 5: cosmos.tx.v1beta1.GetTxsEventResponse
+	(*BroadcastTxRequest)(nil),           // 6: cosmos.tx.v1beta1.BroadcastTxRequest
+	(*BroadcastTxResponse)(nil),          // 7: cosmos.tx.v1beta1.BroadcastTxResponse
+	(*SimulateRequest)(nil),              // 8: cosmos.tx.v1beta1.SimulateRequest
+	(*SimulateResponse)(nil),             // 9: cosmos.tx.v1beta1.SimulateResponse
+	(*GetTxRequest)(nil),                 // 10: cosmos.tx.v1beta1.GetTxRequest
+	(*GetTxResponse)(nil),                // 11: cosmos.tx.v1beta1.GetTxResponse
+	(*GetBlockWithTxsRequest)(nil),       // 12: cosmos.tx.v1beta1.GetBlockWithTxsRequest
+	(*GetBlockWithTxsResponse)(nil),      // 13: cosmos.tx.v1beta1.GetBlockWithTxsResponse
+	(*TxDecodeError)(nil),                // 14: cosmos.tx.v1beta1.TxDecodeError
+	(*TxDecodeRequest)(nil),              // 15: cosmos.tx.v1beta1.TxDecodeRequest
+	(*TxDecodeResponse)(nil),             // 16: cosmos.tx.v1beta1.TxDecodeResponse
+	(*TxEncodeRequest)(nil),              // 17: cosmos.tx.v1beta1.TxEncodeRequest
+	(*TxEncodeResponse)(nil),             // 18: cosmos.tx.v1beta1.TxEncodeResponse
+	(*TxEncodeAminoRequest)(nil),         // 19: cosmos.tx.v1beta1.TxEncodeAminoRequest
+	(*TxEncodeAminoResponse)(nil),        // 20: cosmos.tx.v1beta1.TxEncodeAminoResponse
+	(*TxDecodeAminoRequest)(nil),         // 21: cosmos.tx.v1beta1.TxDecodeAminoRequest
+	(*TxDecodeAminoResponse)(nil),        // 22: cosmos.tx.v1beta1.TxDecodeAminoResponse
+	(*SimulateParamsUpdateRequest)(nil),  // 23: cosmos.tx.v1beta1.SimulateParamsUpdateRequest
+	(*SimulateParamsUpdateResponse)(nil), // 24: cosmos.tx.v1beta1.SimulateParamsUpdateResponse
+	(*v1beta1.PageRequest)(nil),          // 25: cosmos.base.query.v1beta1.PageRequest
+	(*Tx)(nil),                           // 26: cosmos.tx.v1beta1.Tx
+	(*v1beta11.TxResponse)(nil),          // 27: cosmos.base.abci.v1beta1.TxResponse
+	(*v1beta1.PageResponse)(nil),         // 28: cosmos.base.query.v1beta1.PageResponse
+	(*v1beta11.GasInfo)(nil),             // 29: cosmos.base.abci.v1beta1.GasInfo
+	(*v1beta11.Result)(nil),              // 30: cosmos.base.abci.v1beta1.Result
+	(*types.BlockID)(nil),                // 31: tendermint.types.BlockID
+	(*types.Block)(nil),                  // 32: tendermint.types.Block
+	(*anypb.Any)(nil),                    // 33: google.protobuf.Any
 }
 var file_cosmos_tx_v1beta1_service_proto_depIdxs = []int32{
-	20, // 0: cosmos.tx.v1beta1.GetTxsEventRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	25, // 0: cosmos.tx.v1beta1.GetTxsEventRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
 	0,  // 1: cosmos.tx.v1beta1.GetTxsEventRequest.order_by:type_name -> cosmos.tx.v1beta1.OrderBy
-	21, // 2: cosmos.tx.v1beta1.GetTxsEventResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
-	22, // 3: cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses:type_name -> cosmos.base.abci.v1beta1.TxResponse
-	23, // 4: cosmos.tx.v1beta1.GetTxsEventResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	1,  // 5: cosmos.tx.v1beta1.BroadcastTxRequest.mode:type_name -> cosmos.tx.v1beta1.BroadcastMode
-	22, // 6: cosmos.tx.v1beta1.BroadcastTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
-	21, // 7: cosmos.tx.v1beta1.SimulateRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
-	24, // 8: cosmos.tx.v1beta1.SimulateResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
-	25, // 9: cosmos.tx.v1beta1.SimulateResponse.result:type_name -> cosmos.base.abci.v1beta1.Result
-	21, // 10: cosmos.tx.v1beta1.GetTxResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
-	22, // 11: cosmos.tx.v1beta1.GetTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
-	20, // 12: cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	21, // 13: cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
-	26, // 14: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id:type_name -> tendermint.types.BlockID
-	27, // 15: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block:type_name -> tendermint.types.Block
-	23, // 16: cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	21, // 17: cosmos.tx.v1beta1.TxDecodeResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
-	21, // 18: cosmos.tx.v1beta1.TxEncodeRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
-	6,  // 19: cosmos.tx.v1beta1.Service.Simulate:input_type -> cosmos.tx.v1beta1.SimulateRequest
-	8,  // 20: cosmos.tx.v1beta1.Service.GetTx:input_type -> cosmos.tx.v1beta1.GetTxRequest
-	4,  // 21: cosmos.tx.v1beta1.Service.BroadcastTx:input_type -> cosmos.tx.v1beta1.BroadcastTxRequest
-	2,  // 22: cosmos.tx.v1beta1.Service.GetTxsEvent:input_type -> cosmos.tx.v1beta1.GetTxsEventRequest
-	10, // 23: cosmos.tx.v1beta1.Service.GetBlockWithTxs:input_type -> cosmos.tx.v1beta1.GetBlockWithTxsRequest
-	12, // 24: cosmos.tx.v1beta1.Service.TxDecode:input_type -> cosmos.tx.v1beta1.TxDecodeRequest
-	14, // 25: cosmos.tx.v1beta1.Service.TxEncode:input_type -> cosmos.tx.v1beta1.TxEncodeRequest
-	16, // 26: cosmos.tx.v1beta1.Service.TxEncodeAmino:input_type -> cosmos.tx.v1beta1.TxEncodeAminoRequest
-	18, // 27: cosmos.tx.v1beta1.Service.TxDecodeAmino:input_type -> cosmos.tx.v1beta1.TxDecodeAminoRequest
-	7,  // 28: cosmos.tx.v1beta1.Service.Simulate:output_type -> cosmos.tx.v1beta1.SimulateResponse
-	9,  // 29: cosmos.tx.v1beta1.Service.GetTx:output_type -> cosmos.tx.v1beta1.GetTxResponse
-	5,  // 30: cosmos.tx.v1beta1.Service.BroadcastTx:output_type -> cosmos.tx.v1beta1.BroadcastTxResponse
-	3,  // 31: cosmos.tx.v1beta1.Service.GetTxsEvent:output_type -> cosmos.tx.v1beta1.GetTxsEventResponse
-	11, // 32: cosmos.tx.v1beta1.Service.GetBlockWithTxs:output_type -> cosmos.tx.v1beta1.GetBlockWithTxsResponse
-	13, // 33: cosmos.tx.v1beta1.Service.TxDecode:output_type -> cosmos.tx.v1beta1.TxDecodeResponse
-	15, // 34: cosmos.tx.v1beta1.Service.TxEncode:output_type -> cosmos.tx.v1beta1.TxEncodeResponse
-	17, // 35: cosmos.tx.v1beta1.Service.TxEncodeAmino:output_type -> cosmos.tx.v1beta1.TxEncodeAminoResponse
-	19, // 36: cosmos.tx.v1beta1.Service.TxDecodeAmino:output_type -> cosmos.tx.v1beta1.TxDecodeAminoResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	3,  // 2: cosmos.tx.v1beta1.GetTxsEventRequest.event_query:type_name -> cosmos.tx.v1beta1.TxEventQuery
+	4,  // 3: cosmos.tx.v1beta1.TxEventQuery.groups:type_name -> cosmos.tx.v1beta1.TxEventConditionGroup
+	26, // 4: cosmos.tx.v1beta1.GetTxsEventResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
+	27, // 5: cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses:type_name -> cosmos.base.abci.v1beta1.TxResponse
+	28, // 6: cosmos.tx.v1beta1.GetTxsEventResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	1,  // 7: cosmos.tx.v1beta1.BroadcastTxRequest.mode:type_name -> cosmos.tx.v1beta1.BroadcastMode
+	27, // 8: cosmos.tx.v1beta1.BroadcastTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
+	26, // 9: cosmos.tx.v1beta1.SimulateRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
+	29, // 10: cosmos.tx.v1beta1.SimulateResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
+	30, // 11: cosmos.tx.v1beta1.SimulateResponse.result:type_name -> cosmos.base.abci.v1beta1.Result
+	26, // 12: cosmos.tx.v1beta1.GetTxResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
+	27, // 13: cosmos.tx.v1beta1.GetTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
+	25, // 14: cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	26, // 15: cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
+	31, // 16: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id:type_name -> tendermint.types.BlockID
+	32, // 17: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block:type_name -> tendermint.types.Block
+	28, // 18: cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	14, // 19: cosmos.tx.v1beta1.GetBlockWithTxsResponse.decode_errors:type_name -> cosmos.tx.v1beta1.TxDecodeError
+	26, // 20: cosmos.tx.v1beta1.TxDecodeResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
+	26, // 21: cosmos.tx.v1beta1.TxEncodeRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
+	33, // 22: cosmos.tx.v1beta1.SimulateParamsUpdateRequest.msg:type_name -> google.protobuf.Any
+	29, // 23: cosmos.tx.v1beta1.SimulateParamsUpdateResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
+	8,  // 24: cosmos.tx.v1beta1.Service.Simulate:input_type -> cosmos.tx.v1beta1.SimulateRequest
+	10, // 25: cosmos.tx.v1beta1.Service.GetTx:input_type -> cosmos.tx.v1beta1.GetTxRequest
+	6,  // 26: cosmos.tx.v1beta1.Service.BroadcastTx:input_type -> cosmos.tx.v1beta1.BroadcastTxRequest
+	2,  // 27: cosmos.tx.v1beta1.Service.GetTxsEvent:input_type -> cosmos.tx.v1beta1.GetTxsEventRequest
+	12, // 28: cosmos.tx.v1beta1.Service.GetBlockWithTxs:input_type -> cosmos.tx.v1beta1.GetBlockWithTxsRequest
+	15, // 29: cosmos.tx.v1beta1.Service.TxDecode:input_type -> cosmos.tx.v1beta1.TxDecodeRequest
+	17, // 30: cosmos.tx.v1beta1.Service.TxEncode:input_type -> cosmos.tx.v1beta1.TxEncodeRequest
+	19, // 31: cosmos.tx.v1beta1.Service.TxEncodeAmino:input_type -> cosmos.tx.v1beta1.TxEncodeAminoRequest
+	21, // 32: cosmos.tx.v1beta1.Service.TxDecodeAmino:input_type -> cosmos.tx.v1beta1.TxDecodeAminoRequest
+	23, // 33: cosmos.tx.v1beta1.Service.SimulateParamsUpdate:input_type -> cosmos.tx.v1beta1.SimulateParamsUpdateRequest
+	9,  // 34: cosmos.tx.v1beta1.Service.Simulate:output_type -> cosmos.tx.v1beta1.SimulateResponse
+	11, // 35: cosmos.tx.v1beta1.Service.GetTx:output_type -> cosmos.tx.v1beta1.GetTxResponse
+	7,  // 36: cosmos.tx.v1beta1.Service.BroadcastTx:output_type -> cosmos.tx.v1beta1.BroadcastTxResponse
+	5,  // 37: cosmos.tx.v1beta1.Service.GetTxsEvent:output_type -> cosmos.tx.v1beta1.GetTxsEventResponse
+	13, // 38: cosmos.tx.v1beta1.Service.GetBlockWithTxs:output_type -> cosmos.tx.v1beta1.GetBlockWithTxsResponse
+	16, // 39: cosmos.tx.v1beta1.Service.TxDecode:output_type -> cosmos.tx.v1beta1.TxDecodeResponse
+	18, // 40: cosmos.tx.v1beta1.Service.TxEncode:output_type -> cosmos.tx.v1beta1.TxEncodeResponse
+	20, // 41: cosmos.tx.v1beta1.Service.TxEncodeAmino:output_type -> cosmos.tx.v1beta1.TxEncodeAminoResponse
+	22, // 42: cosmos.tx.v1beta1.Service.TxDecodeAmino:output_type -> cosmos.tx.v1beta1.TxDecodeAminoResponse
+	24, // 43: cosmos.tx.v1beta1.Service.SimulateParamsUpdate:output_type -> cosmos.tx.v1beta1.SimulateParamsUpdateResponse
+	34, // [34:44] is the sub-list for method output_type
+	24, // [24:34] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_tx_v1beta1_service_proto_init() }
@@ -10348,7 +13628,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxsEventResponse); i {
+			switch v := v.(*TxEventQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10360,7 +13640,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastTxRequest); i {
+			switch v := v.(*TxEventConditionGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10372,7 +13652,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastTxResponse); i {
+			switch v := v.(*GetTxsEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10384,7 +13664,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulateRequest); i {
+			switch v := v.(*BroadcastTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10396,7 +13676,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimulateResponse); i {
+			switch v := v.(*BroadcastTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10408,7 +13688,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxRequest); i {
+			switch v := v.(*SimulateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10420,7 +13700,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxResponse); i {
+			switch v := v.(*SimulateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10432,7 +13712,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockWithTxsRequest); i {
+			switch v := v.(*GetTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10444,7 +13724,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockWithTxsResponse); i {
+			switch v := v.(*GetTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10456,7 +13736,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxDecodeRequest); i {
+			switch v := v.(*GetBlockWithTxsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10468,7 +13748,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxDecodeResponse); i {
+			switch v := v.(*GetBlockWithTxsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10480,7 +13760,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeRequest); i {
+			switch v := v.(*TxDecodeError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10492,7 +13772,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeResponse); i {
+			switch v := v.(*TxDecodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10504,7 +13784,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeAminoRequest); i {
+			switch v := v.(*TxDecodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10516,7 +13796,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeAminoResponse); i {
+			switch v := v.(*TxEncodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10528,7 +13808,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxDecodeAminoRequest); i {
+			switch v := v.(*TxEncodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10540,6 +13820,42 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxEncodeAminoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxEncodeAminoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxDecodeAminoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxDecodeAminoResponse); i {
 			case 0:
 				return &v.state
@@ -10551,6 +13867,30 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateParamsUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateParamsUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10558,7 +13898,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_tx_v1beta1_service_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   18,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
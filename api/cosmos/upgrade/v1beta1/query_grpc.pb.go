@@ -24,6 +24,10 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_SkipHeights_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/SkipHeights"
+	Query_MigrationProgress_FullMethodName      = "/cosmos.upgrade.v1beta1.Query/MigrationProgress"
+	Query_Params_FullMethodName                 = "/cosmos.upgrade.v1beta1.Query/Params"
+	Query_UpgradePlanBinaries_FullMethodName    = "/cosmos.upgrade.v1beta1.Query/UpgradePlanBinaries"
 )
 
 // QueryClient is the client API for Query service.
@@ -50,6 +54,18 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// SkipHeights queries the governance-approved heights at which scheduled
+	// upgrades are skipped.
+	SkipHeights(ctx context.Context, in *QuerySkipHeightsRequest, opts ...grpc.CallOption) (*QuerySkipHeightsResponse, error)
+	// MigrationProgress queries the progress of the store migrations run by the
+	// upgrade handler. It is served from the node's memory, so it only reports
+	// the migrations run by the queried node since it was started.
+	MigrationProgress(ctx context.Context, in *QueryMigrationProgressRequest, opts ...grpc.CallOption) (*QueryMigrationProgressResponse, error)
+	// Params queries the parameters of the x/upgrade module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// UpgradePlanBinaries queries the binaries of the current upgrade plan, as
+	// parsed from the binaries JSON of its info.
+	UpgradePlanBinaries(ctx context.Context, in *QueryUpgradePlanBinariesRequest, opts ...grpc.CallOption) (*QueryUpgradePlanBinariesResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +122,42 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) SkipHeights(ctx context.Context, in *QuerySkipHeightsRequest, opts ...grpc.CallOption) (*QuerySkipHeightsResponse, error) {
+	out := new(QuerySkipHeightsResponse)
+	err := c.cc.Invoke(ctx, Query_SkipHeights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationProgress(ctx context.Context, in *QueryMigrationProgressRequest, opts ...grpc.CallOption) (*QueryMigrationProgressResponse, error) {
+	out := new(QueryMigrationProgressResponse)
+	err := c.cc.Invoke(ctx, Query_MigrationProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradePlanBinaries(ctx context.Context, in *QueryUpgradePlanBinariesRequest, opts ...grpc.CallOption) (*QueryUpgradePlanBinariesResponse, error) {
+	out := new(QueryUpgradePlanBinariesResponse)
+	err := c.cc.Invoke(ctx, Query_UpgradePlanBinaries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -130,6 +182,18 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// SkipHeights queries the governance-approved heights at which scheduled
+	// upgrades are skipped.
+	SkipHeights(context.Context, *QuerySkipHeightsRequest) (*QuerySkipHeightsResponse, error)
+	// MigrationProgress queries the progress of the store migrations run by the
+	// upgrade handler. It is served from the node's memory, so it only reports
+	// the migrations run by the queried node since it was started.
+	MigrationProgress(context.Context, *QueryMigrationProgressRequest) (*QueryMigrationProgressResponse, error)
+	// Params queries the parameters of the x/upgrade module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// UpgradePlanBinaries queries the binaries of the current upgrade plan, as
+	// parsed from the binaries JSON of its info.
+	UpgradePlanBinaries(context.Context, *QueryUpgradePlanBinariesRequest) (*QueryUpgradePlanBinariesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -152,6 +216,18 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) SkipHeights(context.Context, *QuerySkipHeightsRequest) (*QuerySkipHeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipHeights not implemented")
+}
+func (UnimplementedQueryServer) MigrationProgress(context.Context, *QueryMigrationProgressRequest) (*QueryMigrationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationProgress not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) UpgradePlanBinaries(context.Context, *QueryUpgradePlanBinariesRequest) (*QueryUpgradePlanBinariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePlanBinaries not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -255,6 +331,78 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SkipHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkipHeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkipHeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SkipHeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkipHeights(ctx, req.(*QuerySkipHeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMigrationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MigrationProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationProgress(ctx, req.(*QueryMigrationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradePlanBinaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradePlanBinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradePlanBinaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpgradePlanBinaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradePlanBinaries(ctx, req.(*QueryUpgradePlanBinariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +430,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "SkipHeights",
+			Handler:    _Query_SkipHeights_Handler,
+		},
+		{
+			MethodName: "MigrationProgress",
+			Handler:    _Query_MigrationProgress_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UpgradePlanBinaries",
+			Handler:    _Query_UpgradePlanBinaries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -218,7 +218,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		if err := abciListener.ListenBeginBlock(ctx, req, res); err != nil {
-			app.logger.Error("BeginBlock listening hook failed", "height", blockHeight, "err", err)
+			app.handleStreamingErr(abciListener, "BeginBlock", blockHeight, err)
 		}
 	}
 
@@ -248,7 +248,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		if err := abciListener.ListenEndBlock(ctx, req, res); err != nil {
-			app.logger.Error("EndBlock listening hook failed", "height", blockHeight, "err", err)
+			app.handleStreamingErr(abciListener, "EndBlock", blockHeight, err)
 		}
 	}
 
@@ -421,7 +421,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 			ctx := app.deliverState.ctx
 			blockHeight := ctx.BlockHeight()
 			if err := abciListener.ListenDeliverTx(ctx, req, res); err != nil {
-				app.logger.Error("DeliverTx listening hook failed", "height", blockHeight, "err", err)
+				app.handleStreamingErr(abciListener, "DeliverTx", blockHeight, err)
 			}
 		}
 	}()
@@ -487,7 +487,7 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 		changeSet := app.cms.PopStateCache()
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, res, changeSet); err != nil {
-				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
+				app.handleStreamingErr(abciListener, "Commit", blockHeight, err)
 			}
 		}
 	}
@@ -702,9 +702,18 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
 	}
 
-	_, err := app.snapshotManager.RestoreChunk(req.Chunk)
+	done, err := app.snapshotManager.RestoreChunk(req.Chunk)
 	switch {
 	case err == nil:
+		// derived stores are not part of snapshots, they are rebuilt once the
+		// primary state is restored
+		if done {
+			if err := app.rebuildDerivedStores(); err != nil {
+				app.logger.Error("failed to rebuild derived stores", "err", err)
+				return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ABORT}
+			}
+		}
+
 		return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
 
 	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
@@ -732,7 +741,7 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
-	res, err := handler(ctx, req)
+	res, err := runQuery(ctx, func() (abci.ResponseQuery, error) { return handler(ctx, req) })
 	if err != nil {
 		res = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
 		res.Height = req.Height
@@ -757,11 +766,36 @@ func gRPCErrorToSDKError(err error) error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	case codes.Unauthenticated:
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	case codes.ResourceExhausted:
+		return errorsmod.Wrap(sdkerrors.ErrOutOfGas, err.Error())
 	default:
 		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
 	}
 }
 
+// runQuery runs a query with the query context ctx, converting the out of gas
+// panic raised once the query gas limit is exceeded into a gRPC
+// ResourceExhausted error.
+func runQuery[T any](ctx sdk.Context, query func() (T, error)) (res T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			var zero T
+			res, err = zero, grpcstatus.Errorf(
+				codes.ResourceExhausted,
+				"query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+				oog.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+			)
+		}
+	}()
+
+	return query()
+}
+
 func checkNegativeHeight(height int64) error {
 	if height < 0 {
 		// Reject invalid heights.
@@ -826,6 +860,18 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 		WithMinGasPrices(app.minGasPrices).
 		WithBlockHeight(height)
 
+	// queriers may read other committed versions, e.g. to tell whether a
+	// height is pruned
+	if querier, ok := qms.(storetypes.VersionQuerier); ok {
+		ctx = ctx.WithVersionQuerier(querier)
+	}
+
+	// the gas meter is shared with the nested queries made by modules while
+	// handling the query, which are thus bounded by the gas left
+	if app.queryGasLimit > 0 {
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(app.queryGasLimit))
+	}
+
 	if height != lastBlockHeight {
 		rms, ok := app.cms.(*rootmulti.Store)
 		if ok {
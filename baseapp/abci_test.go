@@ -2,20 +2,26 @@ package baseapp_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/stretchr/testify/require"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
@@ -23,11 +29,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
@@ -215,6 +224,93 @@ func TestABCI_GRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+// gasQueryServer writes a key per character of the name of SayHello requests,
+// and handles Echo requests with a nested SayHello query.
+type gasQueryServer struct {
+	testdata.QueryImpl
+	router *baseapp.GRPCQueryRouter
+}
+
+func (q gasQueryServer) SayHello(ctx context.Context, req *testdata.SayHelloRequest) (*testdata.SayHelloResponse, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(capKey1)
+	for i := range req.Name {
+		store.Set([]byte(fmt.Sprintf("query-%d", i)), []byte(req.Name))
+	}
+
+	return &testdata.SayHelloResponse{Greeting: "Hello " + req.Name}, nil
+}
+
+func (q gasQueryServer) Echo(ctx context.Context, req *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	reqBz, err := (&testdata.SayHelloRequest{Name: req.Message}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := q.router.Route("/testpb.Query/SayHello")(sdk.UnwrapSDKContext(ctx), abci.RequestQuery{Data: reqBz})
+	if err != nil {
+		return nil, err
+	}
+
+	var helloRes testdata.SayHelloResponse
+	if err := helloRes.Unmarshal(res.Value); err != nil {
+		return nil, err
+	}
+
+	return &testdata.EchoResponse{Message: helloRes.Greeting}, nil
+}
+
+func TestABCI_GRPCQuery_GasLimit(t *testing.T) {
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), gasQueryServer{router: bapp.GRPCQueryRouter()})
+	}
+	suite := NewBaseAppSuite(t, grpcQueryOpt, baseapp.SetQueryGasLimit(50_000))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	suite.baseApp.Commit()
+
+	query := func(path string, req interface{ Marshal() ([]byte, error) }) abci.ResponseQuery {
+		reqBz, err := req.Marshal()
+		require.NoError(t, err)
+		return suite.baseApp.Query(abci.RequestQuery{Data: reqBz, Path: path})
+	}
+	longName := strings.Repeat("a", 1000)
+
+	testCases := []struct {
+		name      string
+		path      string
+		req       interface{ Marshal() ([]byte, error) }
+		expOutGas bool
+	}{
+		{"query within the limit", "/testpb.Query/SayHello", &testdata.SayHelloRequest{Name: "foo"}, false},
+		{"query exceeding the limit", "/testpb.Query/SayHello", &testdata.SayHelloRequest{Name: longName}, true},
+		{"nested query within the limit", "/testpb.Query/Echo", &testdata.EchoRequest{Message: "foo"}, false},
+		{"nested query exceeding the limit", "/testpb.Query/Echo", &testdata.EchoRequest{Message: longName}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := query(tc.path, tc.req)
+			if !tc.expOutGas {
+				require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+				return
+			}
+
+			require.Equal(t, sdkerrors.ErrOutOfGas.Codespace(), res.Codespace, res.Log)
+			require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), res.Code, res.Log)
+			require.Contains(t, res.Log, "ResourceExhausted")
+			require.Contains(t, res.Log, "query out of gas")
+		})
+	}
+
+	// the writes made by queries are never persisted
+	store := suite.baseApp.CommitMultiStore().GetKVStore(capKey1)
+	require.Nil(t, store.Get([]byte("query-0")))
+	require.Nil(t, store.Get([]byte("query-999")))
+}
+
 func TestABCI_P2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAddrPeerFilter(func(addrport string) abci.ResponseQuery {
@@ -809,6 +905,116 @@ func TestABCI_DeliverTx_MultiMsg(t *testing.T) {
 	require.Equal(t, int64(2), msgCounter2)
 }
 
+func TestABCI_DeliverTx_PanicAbortReasons(t *testing.T) {
+	// two independent app instances execute the same panicking txs
+	suites := []*BaseAppSuite{NewBaseAppSuite(t), NewBaseAppSuite(t)}
+	for _, suite := range suites {
+		suite.baseApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplPanic{})
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	}
+
+	testCases := []struct {
+		name    string
+		counter int64
+		expErr  *errorsmod.Error
+	}{
+		{"abort with reason", 1, sdkerrors.ErrInsufficientFunds},
+		{"nil pointer dereference", 2, sdkerrors.ErrNilPointerDereference},
+		{"store corruption", 3, storetypes.ErrCorruptedStore},
+		{"unclassified panic", 5, sdkerrors.ErrPanic},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newTxCounter(t, suites[0].txConfig, 0, tc.counter)
+			txBytes, err := suites[0].txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			var results []abci.ResponseDeliverTx
+			for _, suite := range suites {
+				results = append(results, suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}))
+			}
+
+			for _, res := range results {
+				require.Equal(t, tc.expErr.Codespace(), res.Codespace, res.Log)
+				require.Equal(t, tc.expErr.ABCICode(), res.Code, res.Log)
+			}
+			require.Equal(t, results[0].Code, results[1].Code)
+			require.Equal(t, results[0].Codespace, results[1].Codespace)
+			require.Equal(t, results[0].GasUsed, results[1].GasUsed)
+		})
+	}
+}
+
+func TestABCI_DeliverTx_MsgGasAndEvents(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasAndEvents{})
+
+	header := cmtproto.Header{Height: 1}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	msgCounters := []int64{10, 250_000, 3_000}
+	tx := newTxCounter(t, suite.txConfig, 0, msgCounters...)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, txMsgData.Unmarshal(res.Data))
+	require.Len(t, txMsgData.MsgResponses, len(msgCounters))
+	require.Len(t, txMsgData.MsgGasUsed, len(msgCounters))
+
+	var totalMsgGas uint64
+	for i, counter := range msgCounters {
+		// each message is charged at least the gas it consumed explicitly, and
+		// none of it is attributed to the other messages
+		require.GreaterOrEqual(t, txMsgData.MsgGasUsed[i], uint64(counter))
+		require.Less(t, txMsgData.MsgGasUsed[i], uint64(counter)+1_000)
+		totalMsgGas += txMsgData.MsgGasUsed[i]
+	}
+	require.LessOrEqual(t, totalMsgGas, uint64(res.GasUsed))
+
+	// every event is tagged with the index of the message which emitted it
+	msgEvents := make([]int, len(msgCounters))
+	for _, event := range res.Events {
+		var msgIndex []string
+		for _, attr := range event.Attributes {
+			if attr.Key == sdk.AttributeKeyMsgIndex {
+				msgIndex = append(msgIndex, attr.Value)
+			}
+		}
+		require.Len(t, msgIndex, 1, "event %s", event.Type)
+
+		i, err := strconv.Atoi(msgIndex[0])
+		require.NoError(t, err)
+		msgEvents[i]++
+	}
+	require.Equal(t, []int{2, 2, 2}, msgEvents)
+
+	// the breakdown is surfaced in the tx response logs
+	txRes := sdk.NewResponseResultTx(&coretypes.ResultTx{Height: 1, TxResult: res}, nil, "")
+	require.Len(t, txRes.Logs, len(msgCounters))
+	for i, counter := range msgCounters {
+		msgLog := txRes.Logs[i]
+		require.Equal(t, uint32(i), msgLog.MsgIndex)
+		require.Equal(t, txMsgData.MsgGasUsed[i], msgLog.GasUsed)
+		require.Len(t, msgLog.Events, 2)
+		require.Equal(t, sdk.EventTypeMessage, msgLog.Events[0].Type)
+		require.Equal(t, "counter", msgLog.Events[1].Type)
+		require.Equal(t, sdk.Attribute{Key: "update_counter", Value: strconv.FormatInt(counter, 10)}, msgLog.Events[1].Attributes[0])
+	}
+}
+
 func TestABCI_Query_SimulateTx(t *testing.T) {
 	gasConsumed := uint64(5)
 	anteOpt := func(bapp *baseapp.BaseApp) {
@@ -1057,6 +1263,73 @@ func TestABCI_TxGasLimits(t *testing.T) {
 	}
 }
 
+// slowAnteDecorator sets a gas meter, and sleeps before consuming gas.
+type slowAnteDecorator struct {
+	delay time.Duration
+}
+
+func (d slowAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100))
+	time.Sleep(d.delay)
+	ctx.GasMeter().ConsumeGas(10, "slow-ante")
+
+	return next(ctx, tx, simulate)
+}
+
+func TestABCI_TxExecutionTimeout(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(slowAnteDecorator{delay: 100 * time.Millisecond}))
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetTxExecutionTimeout(20*time.Millisecond))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 1))
+	require.NoError(t, err)
+
+	// CheckTx aborts once the execution timeout expired
+	res := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, sdkerrors.ErrTxExecutionTimeout.Codespace(), res.Codespace)
+	require.Equal(t, sdkerrors.ErrTxExecutionTimeout.ABCICode(), res.Code)
+
+	// and so does Simulate
+	_, _, err = suite.baseApp.Simulate(txBytes)
+	require.ErrorIs(t, err, sdkerrors.ErrTxExecutionTimeout)
+
+	// DeliverTx of the same tx is never aborted
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	deliverRes := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	require.Equal(t, int64(11), deliverRes.GasUsed)
+}
+
+func TestABCI_TxExecutionTimeout_ProcessProposal(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(slowAnteDecorator{delay: 100 * time.Millisecond}))
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(mempool.NewSenderNonceMempool()), baseapp.SetTxExecutionTimeout(20*time.Millisecond))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 1))
+	require.NoError(t, err)
+
+	// a tx exceeding the execution timeout is still valid in a proposal, the
+	// timeout being local to the node
+	res := suite.baseApp.ProcessProposal(abci.RequestProcessProposal{
+		Txs:    [][]byte{txBytes},
+		Height: 1,
+	})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
 func TestABCI_MaxBlockGasLimits(t *testing.T) {
 	gasGranted := uint64(10)
 	anteOpt := func(bapp *baseapp.BaseApp) {
@@ -1555,6 +1828,305 @@ func TestABCI_PrepareProposal_ReachedMaxBytes(t *testing.T) {
 	require.Equal(t, 11, len(resPrepareProposal.Txs))
 }
 
+func TestABCI_PrepareProposal_TxBytes(t *testing.T) {
+	anteKey := []byte("ante-key")
+	pool := mempool.NewSenderNonceMempool()
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	// newPaddedTx returns a tx whose size is increased by padding bytes. The
+	// ante handler expects the counters of the verified txs to be consecutive.
+	newPaddedTx := func(nonce, counter int64, padding int) sdk.Tx {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: counter}))
+		builder.SetMemo(fmt.Sprintf("counter=%d&failOnAnte=false&padding=%s", counter, strings.Repeat("x", padding)))
+		setTxSignature(t, builder, uint64(nonce))
+
+		return builder.GetTx()
+	}
+
+	txs := []sdk.Tx{
+		newPaddedTx(0, 0, 0),
+		newPaddedTx(1, 1, 2000), // larger than the max tx bytes on its own
+		newPaddedTx(2, 1, 0),
+		newPaddedTx(3, 2, 0),
+		newPaddedTx(4, 3, 600), // does not fit in the bytes left
+		newPaddedTx(5, 3, 0),
+		newPaddedTx(6, 4, 0),
+	}
+	for _, tx := range txs {
+		require.NoError(t, pool.Insert(sdk.Context{}, tx))
+	}
+
+	res := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes: 1000,
+		Height:     1,
+	})
+
+	var expTxs [][]byte
+	for _, i := range []int{0, 2, 3, 5, 6} {
+		bz, err := suite.txConfig.TxEncoder()(txs[i])
+		require.NoError(t, err)
+		expTxs = append(expTxs, bz)
+	}
+	require.Equal(t, expTxs, res.Txs)
+
+	// only the oversize tx is removed from the mempool
+	require.Equal(t, len(txs)-1, pool.CountTx())
+}
+
+func TestABCI_PrepareProposal_TxEncodingFailure(t *testing.T) {
+	anteKey := []byte("ante-key")
+	pool := mempool.NewSenderNonceMempool()
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	// the txs marked as unencodable fail to encode with the tx encoder set
+	// after the app is created. The ante handler expects the counters of the
+	// verified txs to be consecutive.
+	suite.baseApp.SetTxEncoder(func(tx sdk.Tx) ([]byte, error) {
+		if strings.Contains(tx.(sdk.TxWithMemo).GetMemo(), "unencodable=true") {
+			return nil, errors.New("unencodable tx")
+		}
+		return suite.txConfig.TxEncoder()(tx)
+	})
+	newTx := func(nonce, counter int64, unencodable bool) sdk.Tx {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: counter}))
+		builder.SetMemo(fmt.Sprintf("counter=%d&failOnAnte=false&unencodable=%t", counter, unencodable))
+		setTxSignature(t, builder, uint64(nonce))
+
+		return builder.GetTx()
+	}
+
+	txs := []sdk.Tx{
+		newTx(0, 0, false),
+		newTx(1, 1, true),
+		newTx(2, 1, false),
+	}
+	for _, tx := range txs {
+		require.NoError(t, pool.Insert(sdk.Context{}, tx))
+	}
+
+	res := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes: 1000,
+		Height:     1,
+	})
+
+	var expTxs [][]byte
+	for _, i := range []int{0, 2} {
+		bz, err := suite.txConfig.TxEncoder()(txs[i])
+		require.NoError(t, err)
+		expTxs = append(expTxs, bz)
+	}
+	require.Equal(t, expTxs, res.Txs)
+
+	// the unencodable tx is removed from the mempool without being verified
+	require.Equal(t, len(txs)-1, pool.CountTx())
+}
+
+func TestABCI_PrepareProposal_MempoolLanes(t *testing.T) {
+	// the ante handler prioritizes txs by fee and counts the txs verified
+	// outside of CheckTx
+	var verifiedTxs int
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if !ctx.IsCheckTx() {
+				verifiedTxs++
+			}
+			return ctx.WithPriority(tx.(sdk.FeeTx).GetFee().AmountOf("stake").Int64()), nil
+		})
+	}
+	oracleLane := mempool.Lane{
+		Name:             "oracle",
+		Match:            mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{})),
+		MaxBlockGasShare: sdkmath.LegacyNewDecWithPrec(3, 1),
+	}
+
+	newLaneTx := func(t *testing.T, txConfig client.TxConfig, sender string, fee int64, msg sdk.Msg) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		builder.SetGasLimit(100)
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", fee)))
+		require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+			PubKey: secp256k1.GenPrivKeyFromSecret([]byte(sender)).PubKey(),
+			Data:   &signingtypes.SingleSignatureData{},
+		}))
+
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name           string
+		oracleTxs      int
+		expOracleTxs   int
+		expTransferTxs int
+	}{
+		{"lane filled up to its share", 5, 3, 7},
+		{"unused lane share available to the default lane", 1, 1, 9},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(mempool.DefaultPriorityMempool()), baseapp.SetMempoolLanes(oracleLane))
+			baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+			baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), NoopCounter2ServerImpl{})
+
+			suite.baseApp.InitChain(abci.RequestInitChain{
+				ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000}},
+			})
+
+			// low fee oracle txs compete with high fee transfers
+			for i := 0; i < tc.oracleTxs; i++ {
+				txBytes := newLaneTx(t, suite.txConfig, fmt.Sprintf("oracle%d", i), 1, &baseapptestutil.MsgCounter2{Counter: int64(i)})
+				res := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+				require.True(t, res.IsOK(), res.Log)
+			}
+			for i := 0; i < 20; i++ {
+				txBytes := newLaneTx(t, suite.txConfig, fmt.Sprintf("sender%d", i), int64(100+i), &baseapptestutil.MsgCounter{Counter: int64(i)})
+				res := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+				require.True(t, res.IsOK(), res.Log)
+			}
+
+			verifiedTxs = 0
+			res := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 1_000_000, Height: 1})
+			require.Len(t, res.Txs, tc.expOracleTxs+tc.expTransferTxs)
+
+			// the txs exceeding the gas of their lane are not verified
+			require.Equal(t, len(res.Txs), verifiedTxs)
+
+			var fees []int64
+			for i, txBytes := range res.Txs {
+				tx, err := suite.txConfig.TxDecoder()(txBytes)
+				require.NoError(t, err)
+
+				_, isOracle := tx.GetMsgs()[0].(*baseapptestutil.MsgCounter2)
+				require.Equal(t, i < tc.expOracleTxs, isOracle, "tx %d", i)
+				if !isOracle {
+					fees = append(fees, tx.(sdk.FeeTx).GetFee().AmountOf("stake").Int64())
+				}
+			}
+
+			// the transfers with the highest fees fill the default lane
+			for i, fee := range fees {
+				require.Equal(t, int64(119-i), fee)
+			}
+		})
+	}
+}
+
+func TestABCI_PrepareProposal_MempoolLanes_SenderSequence(t *testing.T) {
+	// the ante handler checks the sequence of the signer outside of CheckTx,
+	// and rejects the oracle txs with a negative counter
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if ctx.IsCheckTx() {
+				return ctx, nil
+			}
+			if msg, ok := tx.GetMsgs()[0].(*baseapptestutil.MsgCounter2); ok && msg.Counter < 0 {
+				return ctx, sdkerrors.ErrInvalidRequest
+			}
+
+			sigs, err := tx.(interface {
+				GetSignaturesV2() ([]signingtypes.SignatureV2, error)
+			}).GetSignaturesV2()
+			if err != nil {
+				return ctx, err
+			}
+
+			store := ctx.KVStore(capKey1)
+			key := sigs[0].PubKey.Address()
+			var seq uint64
+			if bz := store.Get(key); bz != nil {
+				seq = binary.BigEndian.Uint64(bz)
+			}
+			if sigs[0].Sequence != seq {
+				return ctx, sdkerrors.ErrWrongSequence
+			}
+			store.Set(key, sdk.Uint64ToBigEndian(seq+1))
+
+			return ctx, nil
+		})
+	}
+	oracleLane := mempool.Lane{
+		Name:             "oracle",
+		Match:            mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{})),
+		MaxBlockGasShare: sdkmath.LegacyNewDecWithPrec(3, 1),
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(mempool.DefaultPriorityMempool()), baseapp.SetMempoolLanes(oracleLane))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), NoopCounter2ServerImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000}},
+	})
+
+	newTx := func(sender string, sequence uint64, msg sdk.Msg) []byte {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		builder.SetGasLimit(100)
+		require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+			PubKey:   secp256k1.GenPrivKeyFromSecret([]byte(sender)).PubKey(),
+			Data:     &signingtypes.SingleSignatureData{},
+			Sequence: sequence,
+		}))
+
+		bz, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the oracle tx of alice follows her transfer, held by the default lane
+	transfer := newTx("alice", 0, &baseapptestutil.MsgCounter{Counter: 1})
+	oracle := newTx("alice", 1, &baseapptestutil.MsgCounter2{Counter: 1})
+	invalid := newTx("bob", 0, &baseapptestutil.MsgCounter2{Counter: -1})
+	for _, txBytes := range [][]byte{transfer, oracle, invalid} {
+		res := suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+	}
+	require.Equal(t, 3, suite.baseApp.Mempool().CountTx())
+
+	res := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{MaxTxBytes: 1_000_000, Height: 1})
+	require.Equal(t, [][]byte{transfer}, res.Txs)
+
+	// the oracle tx is kept for a later proposal, the invalid tx is removed
+	require.Equal(t, 2, suite.baseApp.Mempool().CountTx())
+}
+
+func TestABCI_PrepareProposal_MempoolLanes_NoOpMempool(t *testing.T) {
+	oracleLane := mempool.Lane{
+		Name:             "oracle",
+		Match:            mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{})),
+		MaxBlockGasShare: sdkmath.LegacyNewDecWithPrec(3, 1),
+	}
+	suite := NewBaseAppSuite(t, baseapp.SetMempoolLanes(oracleLane))
+	require.Equal(t, mempool.NoOpMempool{}, suite.baseApp.Mempool())
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	// the txs requested from CometBFT are proposed as is
+	reqTxs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	res := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{Txs: reqTxs, MaxTxBytes: 1000, Height: 1})
+	require.Equal(t, reqTxs, res.Txs)
+}
+
 func TestABCI_PrepareProposal_BadEncoding(t *testing.T) {
 	anteKey := []byte("ante-key")
 	pool := mempool.NewSenderNonceMempool()
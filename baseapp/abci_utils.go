@@ -0,0 +1,149 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/protoio"
+
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorStore defines the validator set the vote extensions of an extended
+// commit are verified against, e.g. the x/staking keeper.
+type ValidatorStore interface {
+	GetPubKeyByConsAddr(sdk.Context, sdk.ConsAddress) (cryptotypes.PubKey, error)
+}
+
+// VoteExtensionSignBytes returns the bytes a validator signs for its vote
+// extension at the given height and round, i.e. the length-prefixed encoding
+// of its canonical vote extension, as CometBFT does.
+func VoteExtensionSignBytes(chainID string, height int64, round int32, extension []byte) ([]byte, error) {
+	return protoio.MarshalDelimited(&sdk.CanonicalVoteExtension{
+		Extension: extension,
+		Height:    height,
+		Round:     int64(round),
+		ChainId:   chainID,
+	})
+}
+
+// ValidateVoteExtensions verifies the extended commit of the previous height
+// embedded into a proposal at the given height. The vote extension of every
+// validator which signed the previous block must be signed by its consensus
+// key, and these validators must hold more than 2/3 of the voting power of
+// the commit.
+//
+// NOTE: CometBFT v0.37 does not sign vote extensions, so the extended commit
+// is built by the app, e.g. from extensions gossiped out of band.
+func ValidateVoteExtensions(
+	ctx sdk.Context, valStore ValidatorStore, height int64, chainID string, extCommit sdk.ExtendedCommitInfo,
+) error {
+	if height <= 1 {
+		return fmt.Errorf("no extended commit before height 2, got height %d", height)
+	}
+
+	var (
+		seen        = make(map[string]bool, len(extCommit.Votes))
+		totalVP     = math.ZeroInt()
+		submittedVP = math.ZeroInt()
+	)
+	for _, vote := range extCommit.Votes {
+		if vote.ValidatorPower < 0 {
+			return fmt.Errorf("negative voting power %d of validator %X", vote.ValidatorPower, vote.ValidatorAddress)
+		}
+		if seen[string(vote.ValidatorAddress)] {
+			return fmt.Errorf("duplicate vote of validator %X", vote.ValidatorAddress)
+		}
+		seen[string(vote.ValidatorAddress)] = true
+
+		totalVP = totalVP.AddRaw(vote.ValidatorPower)
+		if !vote.SignedLastBlock {
+			continue
+		}
+
+		if len(vote.ExtensionSignature) == 0 {
+			return fmt.Errorf("vote extension signature is missing for validator %X", vote.ValidatorAddress)
+		}
+
+		consAddr := sdk.ConsAddress(vote.ValidatorAddress)
+		pubKey, err := valStore.GetPubKeyByConsAddr(ctx, consAddr)
+		if err != nil {
+			return fmt.Errorf("failed to get the pubkey of validator %s: %w", consAddr, err)
+		}
+		if !bytes.Equal(pubKey.Address(), consAddr) {
+			return fmt.Errorf("pubkey of validator %s does not match its consensus address", consAddr)
+		}
+
+		signBytes, err := VoteExtensionSignBytes(chainID, height-1, extCommit.Round, vote.VoteExtension)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, vote.ExtensionSignature) {
+			return fmt.Errorf("failed to verify the vote extension signature of validator %s at height %d", consAddr, height-1)
+		}
+
+		submittedVP = submittedVP.AddRaw(vote.ValidatorPower)
+	}
+
+	// more than 2/3 of the voting power must have signed its vote extension
+	if totalVP.IsZero() || submittedVP.MulRaw(3).LTE(totalVP.MulRaw(2)) {
+		return fmt.Errorf("insufficient voting power with vote extensions: got %s, expected more than 2/3 of %s", submittedVP, totalVP)
+	}
+
+	return nil
+}
+
+// ExtendedCommitCodec embeds the extended commit of the previous height into
+// the first tx of a proposal in PrepareProposal, and extracts it back in
+// ProcessProposal. The extended commit is proto encoded.
+type ExtendedCommitCodec struct{}
+
+// Encode encodes an extended commit.
+func (ExtendedCommitCodec) Encode(extCommit sdk.ExtendedCommitInfo) ([]byte, error) {
+	return extCommit.Marshal()
+}
+
+// Decode decodes an extended commit.
+func (ExtendedCommitCodec) Decode(bz []byte) (sdk.ExtendedCommitInfo, error) {
+	var extCommit sdk.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(bz); err != nil {
+		return sdk.ExtendedCommitInfo{}, fmt.Errorf("failed to decode the extended commit: %w", err)
+	}
+
+	return extCommit, nil
+}
+
+// Inject returns the txs of a proposal with the encoded extended commit
+// prepended as first tx. It fails if the extended commit alone exceeds
+// maxTxBytes, the room left for the other txs being reduced accordingly by the
+// caller.
+func (c ExtendedCommitCodec) Inject(extCommit sdk.ExtendedCommitInfo, txs [][]byte, maxTxBytes int64) ([][]byte, error) {
+	bz, err := c.Encode(extCommit)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(bz)) > maxTxBytes {
+		return nil, fmt.Errorf("extended commit of %d bytes exceeds the max tx bytes %d", len(bz), maxTxBytes)
+	}
+
+	return append([][]byte{bz}, txs...), nil
+}
+
+// Extract returns the extended commit embedded into the first tx of a
+// proposal, and the other txs.
+func (c ExtendedCommitCodec) Extract(txs [][]byte) (sdk.ExtendedCommitInfo, [][]byte, error) {
+	if len(txs) == 0 {
+		return sdk.ExtendedCommitInfo{}, nil, errors.New("proposal has no extended commit tx")
+	}
+
+	extCommit, err := c.Decode(txs[0])
+	if err != nil {
+		return sdk.ExtendedCommitInfo{}, nil, err
+	}
+
+	return extCommit, txs[1:], nil
+}
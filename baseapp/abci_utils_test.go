@@ -0,0 +1,201 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const voteExtChainID = "test-chain"
+
+// validatorStore is a validator set keyed by consensus address.
+type validatorStore map[string]cryptotypes.PubKey
+
+func (s validatorStore) GetPubKeyByConsAddr(_ sdk.Context, consAddr sdk.ConsAddress) (cryptotypes.PubKey, error) {
+	pk, ok := s[string(consAddr)]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+
+	return pk, nil
+}
+
+type voteExtValidator struct {
+	privKey *ed25519.PrivKey
+	power   int64
+}
+
+func signedVote(t *testing.T, val voteExtValidator, signer *ed25519.PrivKey, height int64, round int32, ext []byte) sdk.ExtendedVoteInfo {
+	t.Helper()
+
+	signBytes, err := baseapp.VoteExtensionSignBytes(voteExtChainID, height, round, ext)
+	require.NoError(t, err)
+	sig, err := signer.Sign(signBytes)
+	require.NoError(t, err)
+
+	return sdk.ExtendedVoteInfo{
+		ValidatorAddress:   val.privKey.PubKey().Address(),
+		ValidatorPower:     val.power,
+		SignedLastBlock:    true,
+		VoteExtension:      ext,
+		ExtensionSignature: sig,
+	}
+}
+
+func TestValidateVoteExtensions(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	vals := make([]voteExtValidator, 3)
+	valStore := validatorStore{}
+	for i := range vals {
+		vals[i] = voteExtValidator{privKey: ed25519.GenPrivKey(), power: 10}
+		valStore[string(vals[i].privKey.PubKey().Address())] = vals[i].privKey.PubKey()
+	}
+
+	const height, round = int64(5), int32(1)
+	vote := func(i int) sdk.ExtendedVoteInfo {
+		return signedVote(t, vals[i], vals[i].privKey, height-1, round, []byte{byte(i)})
+	}
+	absent := func(i int) sdk.ExtendedVoteInfo {
+		return sdk.ExtendedVoteInfo{ValidatorAddress: vals[i].privKey.PubKey().Address(), ValidatorPower: vals[i].power}
+	}
+
+	testCases := []struct {
+		name   string
+		height int64
+		votes  func() []sdk.ExtendedVoteInfo
+		expErr string
+	}{
+		{
+			name:   "all validators signed",
+			height: height,
+			votes:  func() []sdk.ExtendedVoteInfo { return []sdk.ExtendedVoteInfo{vote(0), vote(1), vote(2)} },
+		},
+		{
+			name:   "no extended commit at the first height",
+			height: 1,
+			votes:  func() []sdk.ExtendedVoteInfo { return []sdk.ExtendedVoteInfo{vote(0), vote(1), vote(2)} },
+			expErr: "no extended commit",
+		},
+		{
+			name:   "forged signature",
+			height: height,
+			votes: func() []sdk.ExtendedVoteInfo {
+				return []sdk.ExtendedVoteInfo{vote(0), vote(1), signedVote(t, vals[2], vals[0].privKey, height-1, round, []byte{2})}
+			},
+			expErr: "failed to verify the vote extension signature",
+		},
+		{
+			name:   "tampered vote extension",
+			height: height,
+			votes: func() []sdk.ExtendedVoteInfo {
+				v := vote(2)
+				v.VoteExtension = []byte("tampered")
+				return []sdk.ExtendedVoteInfo{vote(0), vote(1), v}
+			},
+			expErr: "failed to verify the vote extension signature",
+		},
+		{
+			name:   "missing signature",
+			height: height,
+			votes: func() []sdk.ExtendedVoteInfo {
+				v := vote(2)
+				v.ExtensionSignature = nil
+				return []sdk.ExtendedVoteInfo{vote(0), vote(1), v}
+			},
+			expErr: "signature is missing",
+		},
+		{
+			name:   "unknown validator",
+			height: height,
+			votes: func() []sdk.ExtendedVoteInfo {
+				unknown := voteExtValidator{privKey: ed25519.GenPrivKey(), power: 10}
+				return []sdk.ExtendedVoteInfo{vote(0), vote(1), signedVote(t, unknown, unknown.privKey, height-1, round, nil)}
+			},
+			expErr: "failed to get the pubkey",
+		},
+		{
+			name:   "height mismatch",
+			height: height + 1,
+			votes:  func() []sdk.ExtendedVoteInfo { return []sdk.ExtendedVoteInfo{vote(0), vote(1), vote(2)} },
+			expErr: "failed to verify the vote extension signature",
+		},
+		{
+			name:   "exactly 2/3 of the voting power signed",
+			height: height,
+			votes:  func() []sdk.ExtendedVoteInfo { return []sdk.ExtendedVoteInfo{vote(0), vote(1), absent(2)} },
+			expErr: "insufficient voting power",
+		},
+		{
+			name:   "more than 2/3 of the voting power signed",
+			height: height,
+			votes: func() []sdk.ExtendedVoteInfo {
+				signed := signedVote(t, voteExtValidator{privKey: vals[0].privKey, power: 11}, vals[0].privKey, height-1, round, []byte{0})
+				return []sdk.ExtendedVoteInfo{signed, vote(1), absent(2)}
+			},
+		},
+		{
+			name:   "duplicate votes",
+			height: height,
+			votes:  func() []sdk.ExtendedVoteInfo { return []sdk.ExtendedVoteInfo{vote(0), vote(0), absent(1), absent(2)} },
+			expErr: "duplicate vote",
+		},
+		{
+			name:   "empty commit",
+			height: height,
+			votes:  func() []sdk.ExtendedVoteInfo { return nil },
+			expErr: "insufficient voting power",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			extCommit := sdk.ExtendedCommitInfo{Round: round, Votes: tc.votes()}
+			err := baseapp.ValidateVoteExtensions(ctx, valStore, tc.height, voteExtChainID, extCommit)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// an extended commit signed for another round fails
+	extCommit := sdk.ExtendedCommitInfo{Round: round + 1, Votes: []sdk.ExtendedVoteInfo{vote(0), vote(1), vote(2)}}
+	require.ErrorContains(t, baseapp.ValidateVoteExtensions(ctx, valStore, height, voteExtChainID, extCommit), "failed to verify")
+}
+
+func TestExtendedCommitCodec(t *testing.T) {
+	var codec baseapp.ExtendedCommitCodec
+
+	val := voteExtValidator{privKey: ed25519.GenPrivKey(), power: 10}
+	extCommit := sdk.ExtendedCommitInfo{Round: 2, Votes: []sdk.ExtendedVoteInfo{signedVote(t, val, val.privKey, 3, 2, []byte("ext"))}}
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+
+	proposal, err := codec.Inject(extCommit, txs, 1024)
+	require.NoError(t, err)
+	require.Len(t, proposal, len(txs)+1)
+
+	extracted, rest, err := codec.Extract(proposal)
+	require.NoError(t, err)
+	require.Equal(t, extCommit, extracted)
+	require.Equal(t, txs, rest)
+
+	// the extended commit must fit in the max tx bytes
+	_, err = codec.Inject(extCommit, txs, 10)
+	require.ErrorContains(t, err, "exceeds the max tx bytes")
+
+	_, _, err = codec.Extract(nil)
+	require.Error(t, err)
+	_, _, err = codec.Extract([][]byte{{0xff, 0xff}})
+	require.ErrorContains(t, err, "failed to decode")
+}
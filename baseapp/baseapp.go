@@ -1,16 +1,24 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"math"
 	"sort"
 	"strconv"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
+	storecache "cosmossdk.io/store/cache"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/armon/go-metrics"
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -20,6 +28,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
@@ -63,6 +72,7 @@ type BaseApp struct {
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
 
 	mempool            mempool.Mempool            // application side mempool
+	mempoolLanes       []mempool.Lane             // lanes partitioning the mempool, if any
 	anteHandler        sdk.AnteHandler            // ante handler for fee and auth
 	postHandler        sdk.PostHandler            // post handler, optional, e.g. for tips
 	initChainer        sdk.InitChainer            // initialize state with validators and state blob
@@ -72,6 +82,7 @@ type BaseApp struct {
 	endBlocker         sdk.EndBlocker             // logic to run after all txs, and to determine valset changes
 	prepareCheckStater sdk.PrepareCheckStater     // logic to run during commit using the checkState
 	precommiter        sdk.Precommiter            // logic to run during commit using the deliverState
+	derivedRebuilder   sdk.DerivedStoreRebuilder  // logic to rebuild the stale derived stores
 	addrPeerFilter     sdk.PeerFilter             // filter peers by address and port
 	idPeerFilter       sdk.PeerFilter             // filter peers by node ID
 	fauxMerkleMode     bool                       // if true, IAVL MountStores uses MountStoresDB for simulation speed.
@@ -91,6 +102,10 @@ type BaseApp struct {
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache storetypes.MultiStorePersistentCache
 
+	// interBlockCacheSizes overrides the size of the inter-block cache of some
+	// stores, by store name.
+	interBlockCacheSizes map[string]int
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -133,6 +148,27 @@ type BaseApp struct {
 	// recovery handler for app.runTx method
 	runTxRecoveryMiddleware recoveryMiddleware
 
+	// txExecutionTimeout defines the maximum wall-clock duration of the
+	// execution of a tx in CheckTx and Simulate, never applied to DeliverTx nor
+	// to the verification of proposal txs so that consensus remains
+	// deterministic. A value of 0 disables the timeout.
+	txExecutionTimeout time.Duration
+
+	// sigVerificationCache caches the signatures verified in CheckTx so that
+	// their verification is skipped when executing the txs in a block. It is
+	// nil when disabled.
+	sigVerificationCache *sdk.SigVerificationCache
+
+	// maxTxsPerSender defines the maximum number of txs of a sender the
+	// mempool can hold, enforced by the ante handler in CheckTx. A value of 0
+	// disables the limit.
+	maxTxsPerSender int
+
+	// queryGasLimit defines the maximum gas a gRPC state query can consume,
+	// including the nested queries made while handling it. A value of 0
+	// disables the limit.
+	queryGasLimit uint64
+
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
 
@@ -143,6 +179,10 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// simDeliverHook is called with the transactions delivered with SimDeliver,
+	// if set.
+	simDeliverHook func(tx sdk.Tx, txBytes []byte)
+
 	chainID string
 }
 
@@ -174,7 +214,19 @@ func NewBaseApp(
 		app.SetMempool(mempool.NoOpMempool{})
 	}
 
-	abciProposalHandler := NewDefaultProposalHandler(app.mempool, app)
+	// lanes partition a mempool holding txs, with a no-op mempool the txs
+	// requested from CometBFT are proposed as is
+	if len(app.mempoolLanes) > 0 {
+		if _, isNoOp := app.mempool.(mempool.NoOpMempool); isNoOp {
+			app.logger.Info("ignoring the mempool lanes of a no-op mempool")
+		} else {
+			app.SetMempool(mempool.NewLaneMempool(app.mempool, app.mempoolLanes...))
+		}
+	}
+
+	// the tx encoder of the app may be set after it is created
+	txEncoder := func(tx sdk.Tx) ([]byte, error) { return app.txEncoder(tx) }
+	abciProposalHandler := NewDefaultProposalHandler(app.mempool, app, txEncoder)
 
 	if app.prepareProposal == nil {
 		app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
@@ -185,10 +237,15 @@ func NewBaseApp(
 	}
 
 	if app.interBlockCache != nil {
+		if cmgr, ok := app.interBlockCache.(*storecache.CommitKVStoreCacheManager); ok {
+			for name, size := range app.interBlockCacheSizes {
+				cmgr.SetStoreCacheSize(name, uint(size))
+			}
+		}
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
 
-	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
+	app.runTxRecoveryMiddleware = newAbortReasonRecoveryMiddleware(newDefaultRecoveryMiddleware())
 
 	return app
 }
@@ -218,6 +275,14 @@ func (app *BaseApp) Trace() bool {
 	return app.trace
 }
 
+// Mempool returns the application side mempool of a BaseApp.
+func (app *BaseApp) Mempool() mempool.Mempool { return app.mempool }
+
+// MaxTxsPerSender returns the maximum number of txs of a sender the mempool
+// can hold, 0 if unlimited. It is to be enforced by the ante handler, see
+// ante.NewMempoolSenderLimitDecorator.
+func (app *BaseApp) MaxTxsPerSender() int { return app.maxTxsPerSender }
+
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
@@ -266,6 +331,15 @@ func (app *BaseApp) MountKVStores(keys map[string]*storetypes.KVStoreKey) {
 	}
 }
 
+// MountDerivedStores mounts all derived stores to the provided keys in the
+// BaseApp multistore. Their contents are not part of the app hash, and are
+// rebuilt from the primary state by the derived store rebuilder when stale.
+func (app *BaseApp) MountDerivedStores(keys map[string]*storetypes.KVStoreKey) {
+	for _, key := range keys {
+		app.MountStore(key, storetypes.StoreTypeDerived)
+	}
+}
+
 // MountTransientStores mounts all transient stores to the provided keys in
 // the BaseApp multistore.
 func (app *BaseApp) MountTransientStores(keys map[string]*storetypes.TransientStoreKey) {
@@ -299,6 +373,10 @@ func (app *BaseApp) LoadLatestVersion() error {
 		return fmt.Errorf("failed to load latest version: %w", err)
 	}
 
+	if err := app.rebuildDerivedStores(); err != nil {
+		return err
+	}
+
 	return app.Init()
 }
 
@@ -329,6 +407,10 @@ func (app *BaseApp) LoadVersion(version int64) error {
 		return fmt.Errorf("failed to load version %d: %w", version, err)
 	}
 
+	if err := app.rebuildDerivedStores(); err != nil {
+		return err
+	}
+
 	return app.Init()
 }
 
@@ -342,6 +424,74 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// StoreStats returns the stats of the stores of the app at the latest height,
+// along with that height. See rootmulti.Store.StoreStats.
+func (app *BaseApp) StoreStats(opts rootmulti.StoreStatsOptions) (int64, []rootmulti.StoreStats, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return 0, nil, fmt.Errorf("expected %T multistore, got %T", &rootmulti.Store{}, app.cms)
+	}
+
+	return rms.StoreStats(opts)
+}
+
+// EarliestVersion returns the earliest height the state of the app is not
+// pruned at, or 0 if the multistore does not report it.
+func (app *BaseApp) EarliestVersion() int64 {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return 0
+	}
+
+	return rms.EarliestVersion()
+}
+
+// SnapshotProgress returns the progress of the operation of the snapshot
+// manager of the app. It errors if snapshots are not configured.
+func (app *BaseApp) SnapshotProgress() (snapshots.Progress, error) {
+	return app.snapshotManager.Progress()
+}
+
+// Close closes the multistore of the app, if it can be closed, waiting for the
+// heights it prunes in the background to be deleted. It must be called once
+// the app is done committing blocks, before its database is closed.
+func (app *BaseApp) Close() error {
+	if closer, ok := app.cms.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// rebuildDerivedStores rebuilds the derived stores of the multistore whose
+// contents are stale, e.g. because they were just mounted or the node stopped
+// while committing them, with the derived store rebuilder of the app.
+func (app *BaseApp) rebuildDerivedStores() error {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil
+	}
+
+	stale := rms.StaleDerivedStores()
+	if len(stale) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(stale))
+	for i, key := range stale {
+		names[i] = key.Name()
+	}
+	if app.derivedRebuilder == nil {
+		return fmt.Errorf("derived stores %v are stale but no derived store rebuilder is set", names)
+	}
+
+	app.logger.Info("rebuilding stale derived stores", "stores", names, "height", app.LastBlockHeight())
+	return rms.RebuildDerivedStores(stale, func(ms storetypes.CacheMultiStore) error {
+		header := cmtproto.Header{ChainID: app.chainID, Height: app.LastBlockHeight()}
+		return app.derivedRebuilder(sdk.NewContext(ms, header, false, app.logger))
+	})
+}
+
 // Init initializes the app. It seals the app, preventing any
 // further modifications. In addition, it validates the app against
 // the earlier provided settings. Returns an error if validation fails.
@@ -384,6 +534,33 @@ func (app *BaseApp) setInterBlockCache(cache storetypes.MultiStorePersistentCach
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setInterBlockCacheSizes(sizes map[string]int) {
+	for name, size := range sizes {
+		if size <= 0 {
+			panic(fmt.Sprintf("invalid inter-block cache size %d for store %s", size, name))
+		}
+	}
+
+	app.interBlockCacheSizes = sizes
+}
+
+func (app *BaseApp) setTxExecutionTimeout(timeout time.Duration) {
+	app.txExecutionTimeout = timeout
+}
+
+func (app *BaseApp) setSigVerificationCacheSize(size int) {
+	if size <= 0 {
+		app.sigVerificationCache = nil
+		return
+	}
+
+	app.sigVerificationCache = sdk.NewSigVerificationCache(size)
+}
+
+func (app *BaseApp) setQueryGasLimit(limit uint64) {
+	app.queryGasLimit = limit
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -575,6 +752,10 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 
 	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
 
+	if app.sigVerificationCache != nil {
+		ctx = ctx.WithSigVerificationCache(app.sigVerificationCache)
+	}
+
 	if mode == runTxModeReCheck {
 		ctx = ctx.WithIsReCheckTx(true)
 	}
@@ -619,6 +800,17 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	var gasWanted uint64
 
 	ctx := app.getContextForTx(mode, txBytes)
+
+	// the execution of a tx outside of consensus is aborted at its next gas
+	// consumption once it exceeds the execution timeout. Txs verified while
+	// preparing or processing a proposal are not, as the validity of a block
+	// must not depend on the wall clock of a validator.
+	if (mode == runTxModeCheck || mode == runTxModeReCheck || mode == runTxModeSimulate) && app.txExecutionTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = ctx.WithExecutionDeadline(time.Now().Add(app.txExecutionTimeout))
+		defer cancel()
+	}
+
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -629,6 +821,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	defer func() {
 		if r := recover(); r != nil {
 			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
+			recoveryMW = newExecutionTimeoutRecoveryMiddleware(app.txExecutionTimeout, recoveryMW)
 			err, result = processRecovery(r, recoveryMW), nil
 		}
 
@@ -773,7 +966,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 // Result is returned. The caller must not commit state if an error is returned.
 func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*sdk.Result, error) {
 	events := sdk.EmptyEvents()
-	var msgResponses []*codectypes.Any
+	var (
+		msgResponses []*codectypes.Any
+		msgGasUsed   []uint64
+	)
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
@@ -786,14 +982,21 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
 
+		// Each message is executed with its own EventManager so that every
+		// event emitted during its execution can be attributed to it.
+		msgCtx := ctx.WithEventManager(sdk.NewEventManager())
+		gasBefore := ctx.GasMeter().GasConsumed()
+
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgResult, err := handler(msgCtx, msg)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
 
+		msgGasUsed = append(msgGasUsed, ctx.GasMeter().GasConsumed()-gasBefore)
+
 		// create message events
-		msgEvents := createEvents(msgResult.GetEvents(), msg)
+		msgEvents := createEvents(msgCtx.EventManager().Events().AppendEvents(msgResult.GetEvents()), msg)
 
 		// append message events and data
 		//
@@ -801,7 +1004,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		// separate each result.
 		for j, event := range msgEvents {
 			// append message index to all events
-			msgEvents[j] = event.AppendAttributes(sdk.NewAttribute("msg_index", strconv.Itoa(i)))
+			msgEvents[j] = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(i)))
 		}
 
 		events = events.AppendEvents(msgEvents)
@@ -821,7 +1024,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 	}
 
-	data, err := makeABCIData(msgResponses)
+	data, err := makeABCIData(msgResponses, msgGasUsed)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
 	}
@@ -834,8 +1037,8 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 }
 
 // makeABCIData generates the Data field to be sent to ABCI Check/DeliverTx.
-func makeABCIData(msgResponses []*codectypes.Any) ([]byte, error) {
-	return proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
+func makeABCIData(msgResponses []*codectypes.Any, msgGasUsed []uint64) ([]byte, error) {
+	return proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses, MsgGasUsed: msgGasUsed})
 }
 
 func createEvents(events sdk.Events, msg sdk.Msg) sdk.Events {
@@ -909,13 +1112,18 @@ type (
 	DefaultProposalHandler struct {
 		mempool    mempool.Mempool
 		txVerifier ProposalTxVerifier
+		txEncoder  sdk.TxEncoder
 	}
 )
 
-func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) DefaultProposalHandler {
+// NewDefaultProposalHandler returns the default proposal handlers, selecting
+// the txs of mp verified by txVerifier. The txs are encoded with txEncoder, so
+// that their size is checked before they are verified.
+func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier, txEncoder sdk.TxEncoder) DefaultProposalHandler {
 	return DefaultProposalHandler{
 		mempool:    mp,
 		txVerifier: txVerifier,
+		txEncoder:  txEncoder,
 	}
 }
 
@@ -926,8 +1134,12 @@ func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier
 // 1) Successfully encode to bytes.
 // 2) Are valid (i.e. pass runTx, AnteHandler only).
 //
-// Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
-// reached or the mempool is exhausted.
+// Enumeration is halted once RequestPrepareProposal.MaxTxBytes of transactions
+// is reached or the mempool is exhausted. A transaction which does not fit in
+// the bytes left in the proposal is skipped before being verified, and one
+// which fails to encode or is larger than RequestPrepareProposal.MaxTxBytes on
+// its own is removed from the mempool, as it can never be included in a
+// proposal.
 //
 // Note:
 //
@@ -948,6 +1160,10 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 			return abci.ResponsePrepareProposal{Txs: req.Txs}
 		}
 
+		if lanes, ok := h.mempool.(*mempool.LaneMempool); ok {
+			return abci.ResponsePrepareProposal{Txs: h.selectLaneTxs(ctx, req, lanes)}
+		}
+
 		var (
 			selectedTxs  [][]byte
 			totalTxBytes int64
@@ -958,23 +1174,28 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 		for iterator != nil {
 			memTx := iterator.Tx()
 
+			bz, ok := h.fitTx(ctx, h.mempool, memTx, req.MaxTxBytes, req.MaxTxBytes-totalTxBytes)
+			if !ok {
+				iterator = iterator.Next()
+				continue
+			}
+
 			// NOTE: Since transaction verification was already executed in CheckTx,
 			// which calls mempool.Insert, in theory everything in the pool should be
 			// valid. But some mempool implementations may insert invalid txs, so we
 			// check again.
-			bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
+			if _, err := h.txVerifier.PrepareProposalVerifyTx(memTx); err != nil {
 				err := h.mempool.Remove(memTx)
 				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 					panic(err)
 				}
 			} else {
-				txSize := int64(len(bz))
-				if totalTxBytes += txSize; totalTxBytes <= req.MaxTxBytes {
-					selectedTxs = append(selectedTxs, bz)
-				} else {
-					// We've reached capacity per req.MaxTxBytes so we cannot select any
-					// more transactions.
+				selectedTxs = append(selectedTxs, bz)
+				totalTxBytes += int64(len(bz))
+
+				// We've reached capacity per req.MaxTxBytes so we cannot select any
+				// more transactions.
+				if totalTxBytes >= req.MaxTxBytes {
 					break
 				}
 			}
@@ -986,6 +1207,151 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 	}
 }
 
+// fitTx encodes a mempool tx and reports whether it fits in the bytes left in
+// a proposal. It is checked before the tx is verified, so that a skipped tx
+// does not leave the state changes of its AnteHandler, e.g. a sequence
+// increment, in the proposal state. The encoded tx is returned to be included
+// in the proposal once verified. A tx which fails to encode or is larger than
+// maxTxBytes on its own is removed from mp.
+func (h DefaultProposalHandler) fitTx(ctx sdk.Context, mp mempool.Mempool, memTx sdk.Tx, maxTxBytes, bytesLeft int64) ([]byte, bool) {
+	bz, err := h.txEncoder(memTx)
+	if err != nil {
+		err := mp.Remove(memTx)
+		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			panic(err)
+		}
+
+		return nil, false
+	}
+
+	txSize := int64(len(bz))
+	switch {
+	case txSize > maxTxBytes:
+		ctx.Logger().Debug(
+			"removing tx larger than the proposal max bytes from the mempool",
+			"txHash", fmt.Sprintf("%X", tmhash.Sum(bz)), "txBytes", txSize, "maxTxBytes", maxTxBytes,
+		)
+
+		err := mp.Remove(memTx)
+		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			panic(err)
+		}
+		telemetry.IncrCounter(1, "mempool", "oversize_tx_evicted")
+
+		return nil, false
+
+	case txSize > bytesLeft:
+		ctx.Logger().Debug(
+			"skipping tx which does not fit in the proposal",
+			"txHash", fmt.Sprintf("%X", tmhash.Sum(bz)), "txBytes", txSize, "bytesLeft", bytesLeft,
+		)
+
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// selectLaneTxs selects the txs of a proposal from a LaneMempool. Its lanes
+// are filled in turn, each up to its share of the maximum block gas, then the
+// default lane is filled up to the block gas left unused. Like in the default
+// case, invalid and oversize txs are removed from the mempool, txs which do not
+// fit in the bytes left are skipped and the selection stops once
+// RequestPrepareProposal.MaxTxBytes is reached. Txs failing on a wrong sequence
+// are skipped rather than removed, as the tx of their sender they follow may be
+// held by another lane.
+func (h DefaultProposalHandler) selectLaneTxs(ctx sdk.Context, req abci.RequestPrepareProposal, mp *mempool.LaneMempool) [][]byte {
+	maxBlockGas := uint64(math.MaxUint64)
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+		maxBlockGas = uint64(block.MaxGas)
+	}
+
+	var (
+		selectedTxs  [][]byte
+		totalTxBytes int64
+		totalTxGas   uint64
+	)
+
+	// fillLane selects the txs of a lane up to maxGas, and reports whether the
+	// proposal has room left for more txs.
+	fillLane := func(name string, laneMempool mempool.Mempool, maxGas uint64) bool {
+		var laneGas uint64
+		defer func() {
+			telemetry.SetGaugeWithLabels(
+				[]string{"mempool", "lane", "block_gas"},
+				float32(laneGas),
+				[]metrics.Label{telemetry.NewLabel("lane", name)},
+			)
+		}()
+
+		for iterator := laneMempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+			memTx := iterator.Tx()
+
+			var txGas uint64
+			if feeTx, ok := memTx.(sdk.FeeTx); ok {
+				txGas = feeTx.GetGas()
+			}
+
+			// the txs of a lane are selected in order, the lane is thus full
+			// once its next tx exceeds its gas. Like the size, the gas is
+			// checked before the tx is verified, so that the AnteHandler of a
+			// tx left out of the proposal does not change the proposal state.
+			if txGas > maxGas-laneGas {
+				return true
+			}
+
+			bz, ok := h.fitTx(ctx, mp, memTx, req.MaxTxBytes, req.MaxTxBytes-totalTxBytes)
+			if !ok {
+				continue
+			}
+
+			if _, err := h.txVerifier.PrepareProposalVerifyTx(memTx); err != nil {
+				// every lane keeps the txs of a sender in nonce order, but a tx
+				// may follow a tx of its sender held by a lane filled later,
+				// it is then skipped and kept for a later proposal
+				if errors.Is(err, sdkerrors.ErrWrongSequence) {
+					continue
+				}
+
+				err := mp.Remove(memTx)
+				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					panic(err)
+				}
+				continue
+			}
+
+			selectedTxs = append(selectedTxs, bz)
+			totalTxBytes += int64(len(bz))
+			totalTxGas += txGas
+			laneGas += txGas
+
+			if totalTxBytes >= req.MaxTxBytes {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, lane := range mp.Lanes() {
+		maxLaneGas := maxBlockGas - totalTxGas
+		if maxBlockGas != math.MaxUint64 {
+			share := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(maxBlockGas)).Mul(lane.MaxBlockGasShare).TruncateInt().Uint64()
+			if share < maxLaneGas {
+				maxLaneGas = share
+			}
+		}
+
+		if !fillLane(lane.Name, lane.Mempool, maxLaneGas) {
+			return selectedTxs
+		}
+	}
+
+	fillLane(mempool.DefaultLaneName, mp.DefaultLane(), maxBlockGas-totalTxGas)
+
+	return selectedTxs
+}
+
 // ProcessProposalHandler returns the default implementation for processing an
 // ABCI proposal. Every transaction in the proposal must pass 2 conditions:
 //
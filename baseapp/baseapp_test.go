@@ -8,6 +8,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storecache "cosmossdk.io/store/cache"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/rootmulti"
@@ -211,6 +212,25 @@ func TestLoadVersion(t *testing.T) {
 	testLoadVersionHelper(t, app, int64(2), commitID2)
 }
 
+func TestInterBlockCacheSizes(t *testing.T) {
+	cmgr := storecache.NewCommitKVStoreCacheManager(storecache.DefaultCommitKVStoreCacheSize)
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil,
+		baseapp.SetInterBlockCacheSizes(map[string]int{capKey1.Name(): 10}),
+		baseapp.SetInterBlockCache(cmgr),
+	)
+	app.MountStores(capKey1, capKey2)
+	require.NoError(t, app.LoadLatestVersion())
+
+	stats := cmgr.CacheStats()
+	require.Equal(t, 10, stats[capKey1.Name()].Size)
+	require.Equal(t, int(storecache.DefaultCommitKVStoreCacheSize), stats[capKey2.Name()].Size)
+
+	require.Panics(t, func() {
+		baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil,
+			baseapp.SetInterBlockCacheSizes(map[string]int{capKey1.Name(): 0}))
+	})
+}
+
 func TestSetLoader(t *testing.T) {
 	useDefaultLoader := func(app *baseapp.BaseApp) {
 		app.SetStoreLoader(baseapp.DefaultStoreLoader)
@@ -570,11 +590,12 @@ func TestABCI_CreateQueryContext(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := app.CreateQueryContext(tc.height, tc.prove)
+			ctx, err := app.CreateQueryContext(tc.height, tc.prove)
 			if tc.expErr {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
+				require.NotNil(t, ctx.VersionQuerier())
 			}
 		})
 	}
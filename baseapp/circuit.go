@@ -0,0 +1,13 @@
+package baseapp
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// CircuitBreaker is consulted by the MsgServiceRouter before invoking a Msg
+// handler, e.g. x/circuit.
+type CircuitBreaker interface {
+	// AllowExecution returns an error when the processing of the Msg's of the
+	// given type URL is disabled. It is called once for every dispatched Msg,
+	// so that implementations may count the executions, e.g. against per-block
+	// limits.
+	AllowExecution(ctx sdk.Context, msgTypeURL string) error
+}
@@ -66,7 +66,7 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			app.logger.Error("failed to set gRPC header", "err", err)
 		}
 
-		return handler(grpcCtx, req)
+		return runQuery(sdkCtx, func() (interface{}, error) { return handler(grpcCtx, req) })
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -26,6 +26,7 @@ type MessageRouter interface {
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -122,6 +123,13 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				return handler(goCtx, msg)
 			}
 
+			if msr.circuitBreaker != nil {
+				msgURL := sdk.MsgTypeURL(msg)
+				if err := msr.circuitBreaker.AllowExecution(ctx, msgURL); err != nil {
+					return nil, errorsmod.Wrapf(sdkerrors.ErrMsgDisabledByCircuitBreaker, "%s: %s", msgURL, err)
+				}
+			}
+
 			if m, ok := msg.(sdk.HasValidateBasic); ok {
 				if err := m.ValidateBasic(); err != nil {
 					return nil, err
@@ -145,6 +153,12 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// SetCircuitBreaker sets the circuit breaker consulted before invoking any
+// handler.
+func (msr *MsgServiceRouter) SetCircuitBreaker(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -17,6 +18,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -160,3 +163,48 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+// disabledMsgs is a circuit breaker disabling the given Msg type URLs.
+type disabledMsgs map[string]bool
+
+func (d disabledMsgs) AllowExecution(_ sdk.Context, msgTypeURL string) error {
+	if d[msgTypeURL] {
+		return fmt.Errorf("%s disabled", msgTypeURL)
+	}
+
+	return nil
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	var (
+		appBuilder        *runtime.AppBuilder
+		interfaceRegistry codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewNopLogger()),
+		), &appBuilder, &interfaceRegistry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+
+	testdata.RegisterInterfaces(interfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	app.SetCircuitBreaker(disabledMsgs{sdk.MsgTypeURL(msg): true})
+
+	_ = app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	ctx := app.NewContext(false, cmtproto.Header{Height: 1})
+
+	handler := app.MsgServiceRouter().Handler(msg)
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrMsgDisabledByCircuitBreaker)
+
+	app.SetCircuitBreaker(disabledMsgs{})
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+}
@@ -3,9 +3,11 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -56,6 +58,70 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetTxExecutionTimeout returns a BaseApp option function that sets the maximum
+// wall-clock duration of the execution of a tx in CheckTx and Simulate. It is
+// never applied to DeliverTx. A value of 0 disables the timeout.
+func SetTxExecutionTimeout(timeout time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) { app.setTxExecutionTimeout(timeout) }
+}
+
+// SetSigVerificationCacheSize returns a BaseApp option function that enables
+// a cache of the signatures verified in CheckTx, holding the signatures of at
+// most size txs, so that they are not verified again when the txs are executed
+// in a block. A size of 0 disables the cache.
+func SetSigVerificationCacheSize(size int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setSigVerificationCacheSize(size) }
+}
+
+// SetQueryGasLimit returns a BaseApp option function that sets the maximum gas
+// a gRPC state query can consume, including the nested queries made while
+// handling it. Queries exceeding it fail with a ResourceExhausted error. A
+// value of 0 disables the limit.
+func SetQueryGasLimit(limit uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setQueryGasLimit(limit) }
+}
+
+// SetPruningMaxBatchSize returns a BaseApp option function that sets the
+// maximum number of heights deleted from the stores in a single background
+// pruning batch.
+func SetPruningMaxBatchSize(maxBatchSize int) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			rms.SetPruningMaxBatchSize(maxBatchSize)
+		}
+	}
+}
+
+// SetHistoricalVersionCacheSize returns a BaseApp option function that sets the
+// number of past heights whose loaded stores are kept for the queries at these
+// heights. A size of 0 disables the cache.
+func SetHistoricalVersionCacheSize(size int) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			rms.SetHistoricalVersionCacheSize(size)
+		}
+	}
+}
+
+// SetStoreOperationMetrics returns a BaseApp option function that enables or
+// disables the counting of the operations made on each store, emitted as
+// telemetry counters labeled by store on each commit.
+func SetStoreOperationMetrics(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			rms.SetOperationMetrics(enabled)
+		}
+	}
+}
+
+// SetStreamingListenerFilter returns a BaseApp option function that registers
+// listener as a streaming service, only receiving the state changes of the
+// stores allowed by filter, in the delivery mode of filter. It must be applied
+// after any SetStreamingManager call, which replaces the registered listeners.
+func SetStreamingListenerFilter(listener storetypes.ABCIListener, filter StreamingListenerFilter) func(*BaseApp) {
+	return func(app *BaseApp) { app.addStreamingListener(listener, filter) }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
@@ -82,6 +148,13 @@ func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseAp
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetInterBlockCacheSizes provides a BaseApp option function that overrides the
+// size of the inter-block cache of the stores with the given names, so that
+// stores read on every block can be given more entries than the default.
+func SetInterBlockCacheSizes(sizes map[string]int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setInterBlockCacheSizes(sizes) }
+}
+
 // SetSnapshot sets the snapshot store.
 func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
@@ -92,11 +165,34 @@ func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetMempoolLanes partitions the mempool of BaseApp into the given lanes, the
+// mempool set with SetMempool holding the txs matched by none of them. The
+// default PrepareProposal handler fills blocks lane by lane, each up to its
+// share of the block gas, before filling the gas left with the other txs.
+// The lanes are ignored with a no-op mempool.
+func SetMempoolLanes(lanes ...mempool.Lane) func(*BaseApp) {
+	return func(app *BaseApp) { app.mempoolLanes = lanes }
+}
+
+// SetMaxTxsPerSender returns a BaseApp option function that sets the maximum
+// number of txs of a sender the mempool can hold, returned by
+// BaseApp.MaxTxsPerSender for the ante handler to enforce. A value of 0
+// disables the limit.
+func SetMaxTxsPerSender(max int) func(*BaseApp) {
+	return func(app *BaseApp) { app.maxTxsPerSender = max }
+}
+
 // SetChainID sets the chain ID in BaseApp.
 func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
 }
 
+// SetCircuitBreaker sets the circuit breaker consulted by the MsgServiceRouter
+// of BaseApp, see BaseApp.SetCircuitBreaker.
+func SetCircuitBreaker(cb CircuitBreaker) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetCircuitBreaker(cb) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -183,6 +279,16 @@ func (app *BaseApp) SetPrecommiter(precommiter sdk.Precommiter) {
 	app.precommiter = precommiter
 }
 
+// SetDerivedStoreRebuilder sets the function rebuilding the derived stores of
+// the app when they are found stale on load.
+func (app *BaseApp) SetDerivedStoreRebuilder(rebuilder sdk.DerivedStoreRebuilder) {
+	if app.sealed {
+		panic("SetDerivedStoreRebuilder() on sealed BaseApp")
+	}
+
+	app.derivedRebuilder = rebuilder
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
@@ -309,6 +415,17 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 	app.cms.SetMetrics(gatherer)
 }
 
+// SetCircuitBreaker sets the circuit breaker consulted by the MsgServiceRouter
+// before invoking any Msg handler, including for the Msg's dispatched by other
+// modules after the ante handler, e.g. the Msg's of an executed gov proposal.
+func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
+	if app.sealed {
+		panic("SetCircuitBreaker() on sealed BaseApp")
+	}
+
+	app.msgServiceRouter.SetCircuitBreaker(cb)
+}
+
 // SetStreamingManager sets the streaming manager for the BaseApp.
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
@@ -1,8 +1,12 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
+	"runtime"
 	"runtime/debug"
+	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -66,6 +70,61 @@ func newOutOfGasRecoveryMiddleware(gasWanted uint64, ctx sdk.Context, next recov
 	return newRecoveryMiddleware(handler, next)
 }
 
+// newExecutionTimeoutRecoveryMiddleware creates a recovery middleware for app.runTx
+// method, handling the panics raised once the execution deadline of a tx expired.
+func newExecutionTimeoutRecoveryMiddleware(timeout time.Duration, next recoveryMiddleware) recoveryMiddleware {
+	handler := func(recoveryObj interface{}) error {
+		err, ok := recoveryObj.(sdk.ErrorExecutionTimeout)
+		if !ok {
+			return nil
+		}
+
+		return errorsmod.Wrapf(
+			sdkerrors.ErrTxExecutionTimeout,
+			"execution exceeded %s in location: %v", timeout, err.Descriptor,
+		)
+	}
+
+	return newRecoveryMiddleware(handler, next)
+}
+
+// newAbortReasonRecoveryMiddleware creates a recovery middleware for app.runTx
+// method, classifying the panics with a known cause into errors with a
+// deterministic codespace and code:
+//   - sdk.AbortWithReason panics are converted into the error of their reason;
+//   - failures to read a store are converted into storetypes.ErrCorruptedStore;
+//   - nil pointer dereferences are converted into sdkerrors.ErrNilPointerDereference.
+func newAbortReasonRecoveryMiddleware(next recoveryMiddleware) recoveryMiddleware {
+	handler := func(recoveryObj interface{}) error {
+		switch obj := recoveryObj.(type) {
+		case sdk.AbortReason:
+			return obj.Err
+
+		case runtime.Error:
+			if !strings.Contains(obj.Error(), "nil pointer dereference") {
+				return nil
+			}
+
+			return errorsmod.Wrap(
+				sdkerrors.ErrNilPointerDereference, fmt.Sprintf(
+					"recovered: %v\nstack:\n%v", obj, string(debug.Stack()),
+				),
+			)
+
+		case error:
+			if !errors.Is(obj, storetypes.ErrCorruptedStore) {
+				return nil
+			}
+
+			return obj
+		}
+
+		return nil
+	}
+
+	return newRecoveryMiddleware(handler, next)
+}
+
 // newDefaultRecoveryMiddleware creates a default (last in chain) recovery middleware for app.runTx method.
 func newDefaultRecoveryMiddleware() recoveryMiddleware {
 	handler := func(recoveryObj interface{}) error {
@@ -1,16 +1,22 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
+	"sync/atomic"
 
+	"cosmossdk.io/log"
 	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/armon/go-metrics"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/spf13/cast"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 const (
@@ -70,12 +76,9 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
 	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
 	app.cms.AddListeners(exposedKeys)
-	app.SetStreamingManager(
-		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
-			StopNodeOnErr: stopNodeOnErr,
-		},
-	)
+	// keep the listeners registered with SetStreamingListenerFilter
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, abciListener)
+	app.streamingManager.StopNodeOnErr = stopNodeOnErr
 }
 
 func exposeAll(list []string) bool {
@@ -109,3 +112,191 @@ func exposeStoreKeysSorted(keysStr []string, keys map[string]*storetypes.KVStore
 
 	return exposeStoreKeys
 }
+
+// StreamingDeliveryMode is the way the ABCI messages and state changes of a
+// block are delivered to a streaming listener.
+type StreamingDeliveryMode int
+
+const (
+	// StreamingDeliverySync delivers to the listener within the ABCI methods.
+	// A delivery failure halts the node, so that no block is committed without
+	// its state changes being delivered, as required by exactly-once
+	// pipelines.
+	StreamingDeliverySync StreamingDeliveryMode = iota
+
+	// StreamingDeliveryAsync delivers to the listener from a bounded buffer,
+	// never slowing down nor halting the node. Deliveries are dropped while
+	// the buffer is full, and counted by the streaming_listener_dropped
+	// telemetry counter.
+	StreamingDeliveryAsync
+)
+
+// DefaultStreamingBufferSize is the default buffer size of the listeners
+// delivered to asynchronously.
+const DefaultStreamingBufferSize = 1024
+
+// StreamingListenerFilter configures the delivery to a streaming listener
+// registered with SetStreamingListenerFilter.
+type StreamingListenerFilter struct {
+	// Name identifies the listener in logs and telemetry.
+	Name string
+
+	// StoreKeys is the allowlist of the stores whose state changes are
+	// delivered to the listener. The state changes of all listened stores are
+	// delivered if it is empty.
+	StoreKeys []storetypes.StoreKey
+
+	// Mode is the delivery mode of the listener.
+	Mode StreamingDeliveryMode
+
+	// BufferSize is the number of deliveries buffered for a listener delivered
+	// to asynchronously, and defaults to DefaultStreamingBufferSize.
+	BufferSize int
+}
+
+// addStreamingListener registers listener as a streaming service, receiving
+// the state changes of the stores allowed by filter in its delivery mode.
+func (app *BaseApp) addStreamingListener(listener storetypes.ABCIListener, filter StreamingListenerFilter) {
+	app.cms.AddListeners(filter.StoreKeys)
+
+	filtered := &filteredStreamingListener{ABCIListener: listener}
+	if len(filter.StoreKeys) > 0 {
+		filtered.storeKeys = make(map[string]struct{}, len(filter.StoreKeys))
+		for _, key := range filter.StoreKeys {
+			filtered.storeKeys[key.Name()] = struct{}{}
+		}
+	}
+
+	switch filter.Mode {
+	case StreamingDeliverySync:
+		listener = &syncStreamingListener{filtered}
+
+	case StreamingDeliveryAsync:
+		bufferSize := filter.BufferSize
+		if bufferSize <= 0 {
+			bufferSize = DefaultStreamingBufferSize
+		}
+		listener = newAsyncStreamingListener(filter.Name, filtered, bufferSize, app.logger)
+
+	default:
+		panic(fmt.Sprintf("invalid streaming delivery mode %d", filter.Mode))
+	}
+
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listener)
+}
+
+// handleStreamingErr logs the failure of the hook of a streaming listener, and
+// halts the node if the listener is delivered to synchronously.
+func (app *BaseApp) handleStreamingErr(listener storetypes.ABCIListener, hook string, height int64, err error) {
+	app.logger.Error(fmt.Sprintf("%s listening hook failed", hook), "height", height, "err", err)
+
+	if _, ok := listener.(*syncStreamingListener); ok {
+		panic(fmt.Errorf("%s listening hook failed at height %d: %w", hook, height, err))
+	}
+}
+
+// filteredStreamingListener is an ABCIListener only receiving the state
+// changes of an allowlist of stores.
+type filteredStreamingListener struct {
+	storetypes.ABCIListener
+
+	// storeKeys holds the names of the allowed stores, all being allowed if
+	// it is nil.
+	storeKeys map[string]struct{}
+}
+
+// ListenCommit implements the ABCIListener interface.
+func (l *filteredStreamingListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	if l.storeKeys != nil {
+		filtered := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+		for _, pair := range changeSet {
+			if _, ok := l.storeKeys[pair.StoreKey]; ok {
+				filtered = append(filtered, pair)
+			}
+		}
+		changeSet = filtered
+	}
+
+	return l.ABCIListener.ListenCommit(ctx, res, changeSet)
+}
+
+// syncStreamingListener is a listener delivered to synchronously, whose
+// failures halt the node.
+type syncStreamingListener struct {
+	*filteredStreamingListener
+}
+
+// asyncStreamingListener delivers to a listener from a bounded buffer drained
+// in the background.
+type asyncStreamingListener struct {
+	name     string
+	listener storetypes.ABCIListener
+	logger   log.Logger
+
+	deliveries chan func() error
+	dropped    atomic.Uint64
+}
+
+func newAsyncStreamingListener(name string, listener storetypes.ABCIListener, bufferSize int, logger log.Logger) *asyncStreamingListener {
+	l := &asyncStreamingListener{
+		name:       name,
+		listener:   listener,
+		logger:     logger,
+		deliveries: make(chan func() error, bufferSize),
+	}
+	go l.run()
+
+	return l
+}
+
+// run delivers the buffered deliveries to the listener.
+func (l *asyncStreamingListener) run() {
+	for deliver := range l.deliveries {
+		if err := deliver(); err != nil {
+			l.logger.Error("asynchronous streaming listener failed", "listener", l.name, "err", err)
+		}
+	}
+}
+
+// enqueue buffers a delivery, dropping it if the buffer is full.
+func (l *asyncStreamingListener) enqueue(deliver func() error) {
+	select {
+	case l.deliveries <- deliver:
+	default:
+		l.dropped.Add(1)
+		telemetry.IncrCounterWithLabels(
+			[]string{"streaming", "listener", "dropped"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("listener", l.name)},
+		)
+	}
+}
+
+// Dropped returns the number of deliveries dropped because of a full buffer.
+func (l *asyncStreamingListener) Dropped() uint64 {
+	return l.dropped.Load()
+}
+
+// ListenBeginBlock implements the ABCIListener interface.
+func (l *asyncStreamingListener) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.enqueue(func() error { return l.listener.ListenBeginBlock(ctx, req, res) })
+	return nil
+}
+
+// ListenEndBlock implements the ABCIListener interface.
+func (l *asyncStreamingListener) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	l.enqueue(func() error { return l.listener.ListenEndBlock(ctx, req, res) })
+	return nil
+}
+
+// ListenDeliverTx implements the ABCIListener interface.
+func (l *asyncStreamingListener) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	l.enqueue(func() error { return l.listener.ListenDeliverTx(ctx, req, res) })
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface.
+func (l *asyncStreamingListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.enqueue(func() error { return l.listener.ListenCommit(ctx, res, changeSet) })
+	return nil
+}
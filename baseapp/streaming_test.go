@@ -2,8 +2,11 @@ package baseapp_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -46,7 +49,10 @@ func (m *MockABCIListener) ListenCommit(ctx context.Context, res abci.ResponseCo
 	return nil
 }
 
-var distKey1 = storetypes.NewKVStoreKey("distKey1")
+var (
+	distKey1 = storetypes.NewKVStoreKey("distKey1")
+	distKey2 = storetypes.NewKVStoreKey("distKey2")
+)
 
 func TestABCI_MultiListener_StateChanges(t *testing.T) {
 	anteKey := []byte("ante-key")
@@ -145,3 +151,185 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		suite.baseApp.Commit()
 	}
 }
+
+// recordingABCIListener records the change sets it receives, is safe for
+// concurrent use and fails its commit hook with err if set.
+type recordingABCIListener struct {
+	mu         sync.Mutex
+	deliveries int
+	changeSets [][]*storetypes.StoreKVPair
+	err        error
+
+	// release blocks the deliveries until it is closed, if set
+	release chan struct{}
+}
+
+func (l *recordingABCIListener) deliver() {
+	if l.release != nil {
+		<-l.release
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.deliveries++
+}
+
+func (l *recordingABCIListener) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	l.deliver()
+	return nil
+}
+
+func (l *recordingABCIListener) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	l.deliver()
+	return nil
+}
+
+func (l *recordingABCIListener) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	l.deliver()
+	return nil
+}
+
+func (l *recordingABCIListener) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.deliver()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.changeSets = append(l.changeSets, changeSet)
+	return l.err
+}
+
+func (l *recordingABCIListener) Deliveries() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.deliveries
+}
+
+func (l *recordingABCIListener) ChangeSets() [][]*storetypes.StoreKVPair {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.changeSets
+}
+
+// commitStreamingBlock writes a key to each store and commits the block at
+// the given height.
+func commitStreamingBlock(t *testing.T, app *baseapp.BaseApp, height int64, keys ...*storetypes.KVStoreKey) []*storetypes.StoreKVPair {
+	t.Helper()
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+	var changeSet []*storetypes.StoreKVPair
+	for _, key := range keys {
+		pair := &storetypes.StoreKVPair{
+			StoreKey: key.Name(),
+			Key:      []byte(fmt.Sprintf("key%d", height)),
+			Value:    []byte(fmt.Sprintf("val%d", height)),
+		}
+		getDeliverStateCtx(app).KVStore(key).Set(pair.Key, pair.Value)
+		changeSet = append(changeSet, pair)
+	}
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	return changeSet
+}
+
+func TestABCI_StreamingListenerFilter(t *testing.T) {
+	syncListener := &recordingABCIListener{}
+	asyncListener := &recordingABCIListener{}
+	suite := NewBaseAppSuite(t,
+		func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1, distKey2) },
+		baseapp.SetStreamingListenerFilter(syncListener, baseapp.StreamingListenerFilter{
+			Name:      "sync",
+			StoreKeys: []storetypes.StoreKey{distKey1},
+		}),
+		baseapp.SetStreamingListenerFilter(asyncListener, baseapp.StreamingListenerFilter{
+			Name:      "async",
+			StoreKeys: []storetypes.StoreKey{distKey2},
+			Mode:      baseapp.StreamingDeliveryAsync,
+		}),
+	)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	var expectedSync, expectedAsync [][]*storetypes.StoreKVPair
+	for height := int64(1); height <= 3; height++ {
+		changeSet := commitStreamingBlock(t, suite.baseApp, height, distKey1, distKey2)
+		expectedSync = append(expectedSync, changeSet[:1])
+		expectedAsync = append(expectedAsync, changeSet[1:])
+
+		// the sync listener is delivered to before Commit returns
+		require.Equal(t, expectedSync, syncListener.ChangeSets())
+	}
+
+	require.Eventually(t, func() bool {
+		return len(asyncListener.ChangeSets()) == len(expectedAsync)
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, expectedAsync, asyncListener.ChangeSets())
+}
+
+func TestABCI_StreamingListenerFilter_SyncFailureHalts(t *testing.T) {
+	listenerErr := errors.New("sink unavailable")
+	asyncListener := &recordingABCIListener{err: listenerErr}
+	syncListener := &recordingABCIListener{}
+	suite := NewBaseAppSuite(t,
+		func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) },
+		baseapp.SetStreamingListenerFilter(asyncListener, baseapp.StreamingListenerFilter{
+			StoreKeys: []storetypes.StoreKey{distKey1},
+			Mode:      baseapp.StreamingDeliveryAsync,
+		}),
+		baseapp.SetStreamingListenerFilter(syncListener, baseapp.StreamingListenerFilter{
+			StoreKeys: []storetypes.StoreKey{distKey1},
+		}),
+	)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	// failures of asynchronous listeners do not affect the node
+	commitStreamingBlock(t, suite.baseApp, 1, distKey1)
+	require.Len(t, syncListener.ChangeSets(), 1)
+
+	syncListener.err = listenerErr
+	require.PanicsWithError(t, "Commit listening hook failed at height 2: sink unavailable", func() {
+		commitStreamingBlock(t, suite.baseApp, 2, distKey1)
+	})
+}
+
+func TestABCI_StreamingListenerFilter_AsyncDrops(t *testing.T) {
+	listener := &recordingABCIListener{release: make(chan struct{})}
+	suite := NewBaseAppSuite(t,
+		func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) },
+		baseapp.SetStreamingListenerFilter(listener, baseapp.StreamingListenerFilter{
+			Name:       "slow",
+			StoreKeys:  []storetypes.StoreKey{distKey1},
+			Mode:       baseapp.StreamingDeliveryAsync,
+			BufferSize: 1,
+		}),
+	)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	dropper := getDeliverStateCtx(suite.baseApp).StreamingManager().ABCIListeners[0].(interface{ Dropped() uint64 })
+
+	// each block is delivered in its BeginBlock, EndBlock and Commit hooks
+	nBlocks := 5
+	nDeliveries := 3 * nBlocks
+	for height := int64(1); height <= int64(nBlocks); height++ {
+		commitStreamingBlock(t, suite.baseApp, height, distKey1)
+	}
+
+	// the blocked listener holds at most one delivery, and one is buffered
+	dropped := int(dropper.Dropped())
+	require.GreaterOrEqual(t, dropped, nDeliveries-2)
+
+	close(listener.release)
+	require.Eventually(t, func() bool {
+		return listener.Deliveries() == nDeliveries-dropped
+	}, time.Second, 10*time.Millisecond)
+}
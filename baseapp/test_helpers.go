@@ -4,6 +4,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -28,16 +29,51 @@ func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	return gasInfo, result, err
 }
 
+// SimulateMsg executes a single message in simulate mode on a branch of the
+// given context. The AnteHandler is skipped and no state is ever written, which
+// makes it suitable for dry-running messages that can't be signed by the
+// caller, e.g. governance-gated MsgUpdateParams.
+func (app *BaseApp) SimulateMsg(ctx sdk.Context, msg sdk.Msg) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	ctx, _ = ctx.WithGasMeter(gasMeter).CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err, result = processRecovery(r, app.runTxRecoveryMiddleware), nil
+		}
+
+		gInfo = sdk.GasInfo{GasUsed: gasMeter.GasConsumed()}
+	}()
+
+	msgs := []sdk.Msg{msg}
+	if err := validateBasicTxMsgs(msgs); err != nil {
+		return sdk.GasInfo{}, nil, err
+	}
+
+	result, err = app.runMsgs(ctx, msgs, runTxModeSimulate)
+	return gInfo, result, err
+}
+
 func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	// See comment for Check().
 	bz, err := txEncoder(tx)
 	if err != nil {
 		return sdk.GasInfo{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s", err)
 	}
+	if app.simDeliverHook != nil {
+		app.simDeliverHook(tx, bz)
+	}
 	gasInfo, result, _, _, err := app.runTx(runTxModeDeliver, bz)
 	return gasInfo, result, err
 }
 
+// SetSimDeliverHook sets a function called with each transaction, and its
+// bytes, delivered with SimDeliver, e.g. to record the transactions of a
+// simulation. A nil hook unsets it.
+func (app *BaseApp) SetSimDeliverHook(hook func(tx sdk.Tx, txBytes []byte)) {
+	app.simDeliverHook = hook
+}
+
 // Context with current {check, deliver}State of the app used by tests.
 func (app *BaseApp) NewContext(isCheckTx bool, header cmtproto.Header) sdk.Context {
 	if isCheckTx {
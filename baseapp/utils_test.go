@@ -123,6 +123,35 @@ func (m CounterServerImplGasMeterOnly) IncrementCounter(ctx context.Context, msg
 	return &baseapptestutil.MsgCreateCounterResponse{}, nil
 }
 
+// CounterServerImplGasAndEvents consumes the counter of each message as gas
+// and emits one event per message on the context EventManager.
+type CounterServerImplGasAndEvents struct{}
+
+func (m CounterServerImplGasAndEvents) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.GasMeter().ConsumeGas(uint64(msg.Counter), "test")
+	sdkCtx.EventManager().EmitEvents(counterEvent("counter", msg.Counter))
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+// CounterServerImplPanic panics with a value selected by the counter of each
+// message.
+type CounterServerImplPanic struct{}
+
+func (m CounterServerImplPanic) IncrementCounter(_ context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	switch msg.Counter {
+	case 1:
+		sdk.AbortWithReason(sdkerrors.ErrInsufficientFunds, "aborted by handler")
+	case 2:
+		var res *baseapptestutil.MsgCreateCounterResponse
+		_ = *res
+	case 3:
+		panic(errorsmod.Wrap(storetypes.ErrCorruptedStore, "missing node"))
+	}
+
+	panic("unexpected panic")
+}
+
 type NoopCounterServerImpl struct{}
 
 func (m NoopCounterServerImpl) IncrementCounter(
@@ -132,6 +161,15 @@ func (m NoopCounterServerImpl) IncrementCounter(
 	return &baseapptestutil.MsgCreateCounterResponse{}, nil
 }
 
+type NoopCounter2ServerImpl struct{}
+
+func (m NoopCounter2ServerImpl) IncrementCounter(
+	_ context.Context,
+	_ *baseapptestutil.MsgCounter2,
+) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
 type CounterServerImpl struct {
 	t          *testing.T
 	capKey     storetypes.StoreKey
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReadAccAddress parses arg, an argument of cmd, as an account address with
+// the account address prefix of the chain. On a prefix mismatch, the returned
+// error names the prefix of arg and, if relevant, the address with the same
+// bytes under the expected prefix.
+func ReadAccAddress(cmd *cobra.Command, arg string) (sdk.AccAddress, error) {
+	bz, err := readAddress(cmd, arg, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.AccAddress(bz), nil
+}
+
+// ReadValAddress parses arg, an argument of cmd, as a validator operator
+// address with the validator operator address prefix of the chain, see
+// ReadAccAddress.
+func ReadValAddress(cmd *cobra.Command, arg string) (sdk.ValAddress, error) {
+	bz, err := readAddress(cmd, arg, sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ValAddress(bz), nil
+}
+
+// ReadConsAddress parses arg, an argument of cmd, as a validator consensus
+// address with the validator consensus address prefix of the chain, see
+// ReadAccAddress.
+func ReadConsAddress(cmd *cobra.Command, arg string) (sdk.ConsAddress, error) {
+	bz, err := readAddress(cmd, arg, sdk.GetConfig().GetBech32ConsensusAddrPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ConsAddress(bz), nil
+}
+
+func readAddress(cmd *cobra.Command, arg, prefix string) ([]byte, error) {
+	bz, err := parseAddress(arg, prefix)
+	if err != nil && cmd != nil {
+		// name the positional argument, if arg is one
+		for i, a := range cmd.Flags().Args() {
+			if a == arg {
+				return nil, errorsmod.Wrapf(err, "argument %d", i+1)
+			}
+		}
+	}
+
+	return bz, err
+}
+
+// parseAddress decodes the bech32 address and checks that it has the expected
+// prefix and a valid length.
+func parseAddress(address, prefix string) ([]byte, error) {
+	kind := addressKind(prefix)
+	if len(strings.TrimSpace(address)) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "empty address string is not allowed")
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "%s is not a valid bech32 %s address: %s", address, kind, err)
+	}
+
+	if hrp != prefix {
+		msg := fmt.Sprintf("invalid Bech32 prefix; expected %s, got %s", prefix, hrp)
+		if otherKind := addressKind(hrp); otherKind != "" {
+			msg += fmt.Sprintf(": %s is %s address, not %s address", address, withArticle(otherKind), withArticle(kind))
+		} else {
+			msg += fmt.Sprintf(": %s is not %s address of this chain", address, withArticle(kind))
+		}
+
+		// consensus addresses are derived from a different key than account
+		// and validator operator addresses, so their bytes cannot be reused
+		consPrefix := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+		if hrp != consPrefix && prefix != consPrefix && sdk.VerifyAddressFormat(bz) == nil {
+			if expected, err := bech32.ConvertAndEncode(prefix, bz); err == nil {
+				msg += fmt.Sprintf(" (did you mean %s?)", expected)
+			}
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg)
+	}
+
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid %s address %s", kind, address)
+	}
+
+	return bz, nil
+}
+
+// addressKind returns the kind of the addresses of the chain with the given
+// prefix, or an empty string if it is not an address prefix of the chain.
+func addressKind(prefix string) string {
+	config := sdk.GetConfig()
+	switch prefix {
+	case config.GetBech32AccountAddrPrefix():
+		return "account"
+	case config.GetBech32ValidatorAddrPrefix():
+		return "validator operator"
+	case config.GetBech32ConsensusAddrPrefix():
+		return "validator consensus"
+	default:
+		return ""
+	}
+}
+
+func withArticle(kind string) string {
+	if strings.HasPrefix(kind, "a") {
+		return "an " + kind
+	}
+	return "a " + kind
+}
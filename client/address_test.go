@@ -0,0 +1,104 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func TestReadAddress(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", addr)
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(addr)
+	invalidChecksum := addr.String()[:len(addr.String())-1] + "q"
+	if invalidChecksum == addr.String() {
+		invalidChecksum = addr.String()[:len(addr.String())-1] + "p"
+	}
+
+	testCases := []struct {
+		name   string
+		read   func(cmd *cobra.Command, arg string) (sdk.Address, error)
+		arg    string
+		expErr string
+	}{
+		{
+			name: "valid account address",
+			read: readAcc,
+			arg:  addr.String(),
+		},
+		{
+			name:   "account address of another chain",
+			read:   readAcc,
+			arg:    osmoAddr,
+			expErr: "argument 1: invalid Bech32 prefix; expected cosmos, got osmo: " + osmoAddr + " is not an account address of this chain (did you mean " + addr.String() + "?)",
+		},
+		{
+			name:   "invalid checksum",
+			read:   readAcc,
+			arg:    invalidChecksum,
+			expErr: "argument 1: " + invalidChecksum + " is not a valid bech32 account address: decoding bech32 failed: invalid checksum",
+		},
+		{
+			name:   "validator operator address instead of account address",
+			read:   readAcc,
+			arg:    valAddr.String(),
+			expErr: "invalid Bech32 prefix; expected cosmos, got cosmosvaloper: " + valAddr.String() + " is a validator operator address, not an account address (did you mean " + addr.String() + "?)",
+		},
+		{
+			name: "valid validator operator address",
+			read: readVal,
+			arg:  valAddr.String(),
+		},
+		{
+			name:   "validator consensus address instead of validator operator address",
+			read:   readVal,
+			arg:    consAddr.String(),
+			expErr: "argument 1: invalid Bech32 prefix; expected cosmosvaloper, got cosmosvalcons: " + consAddr.String() + " is a validator consensus address, not a validator operator address: invalid address",
+		},
+		{
+			name:   "validator operator address instead of validator consensus address",
+			read:   readCons,
+			arg:    valAddr.String(),
+			expErr: "argument 1: invalid Bech32 prefix; expected cosmosvalcons, got cosmosvaloper: " + valAddr.String() + " is a validator operator address, not a validator consensus address: invalid address",
+		},
+		{
+			name:   "empty address",
+			read:   readAcc,
+			arg:    "",
+			expErr: "empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			require.NoError(t, cmd.Flags().Parse([]string{tc.arg}))
+
+			_, err := tc.read(cmd, tc.arg)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func readAcc(cmd *cobra.Command, arg string) (sdk.Address, error) {
+	return client.ReadAccAddress(cmd, arg)
+}
+
+func readVal(cmd *cobra.Command, arg string) (sdk.Address, error) {
+	return client.ReadValAddress(cmd, arg)
+}
+
+func readCons(cmd *cobra.Command, arg string) (sdk.Address, error) {
+	return client.ReadConsAddress(cmd, arg)
+}
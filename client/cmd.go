@@ -3,17 +3,23 @@ package client
 import (
 	"crypto/tls"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip" // Register gzip to accept compressed responses
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -136,12 +142,18 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		}
 	}
 
-	if clientCtx.Client == nil || flagSet.Changed(flags.FlagNode) {
+	nodeVerbose, _ := flagSet.GetBool(flags.FlagNodeVerbose)
+	if clientCtx.Client == nil || flagSet.Changed(flags.FlagNode) || nodeVerbose {
 		rpcURI, _ := flagSet.GetString(flags.FlagNode)
+		if !flagSet.Changed(flags.FlagNode) && clientCtx.NodeURI != "" {
+			// keep the node read from the client config
+			rpcURI = clientCtx.NodeURI
+		}
+
 		if rpcURI != "" {
 			clientCtx = clientCtx.WithNodeURI(rpcURI)
 
-			client, err := NewClientFromNode(rpcURI)
+			client, err := newClientFromNodes(rpcURI, nodeVerbose)
 			if err != nil {
 				return clientCtx, err
 			}
@@ -164,6 +176,11 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 				})))
 			}
 
+			maxMsgSize, _ := flagSet.GetInt(flags.FlagGRPCMaxMsgSize)
+			if maxMsgSize > 0 {
+				dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize)))
+			}
+
 			grpcClient, err := grpc.Dial(grpcURI, dialOpts...)
 			if err != nil {
 				return Context{}, err
@@ -175,6 +192,27 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	return clientCtx, nil
 }
 
+// ledgerSignMode returns the sign mode of the txs signed with a Ledger key
+// when no sign mode is given. With --ledger, the connected device is probed,
+// and SIGN_MODE_TEXTUAL is used if both the sign mode handler and the device
+// support it. Otherwise amino-json is used, which all the device app versions
+// support.
+func ledgerSignMode(clientCtx Context) string {
+	if clientCtx.UseLedger && clientCtx.TxConfig != nil &&
+		slices.Contains(clientCtx.TxConfig.SignModeHandler().SupportedModes(), apisigning.SignMode_SIGN_MODE_TEXTUAL) {
+		supported, err := ledger.SupportsSignModeTextual()
+		if err == nil && supported {
+			return flags.SignModeTextual
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to check the Ledger support for sign-mode 'textual': %v\n", err)
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
+	return flags.SignModeLegacyAminoJSON
+}
+
 // readQueryCommandFlags returns an updated Context with fields set based on flags
 // defined in AddQueryFlagsToCmd. An error is returned if any flag query fails.
 //
@@ -280,21 +318,12 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 
 		clientCtx = clientCtx.WithFrom(from).WithFromAddress(fromAddr).WithFromName(fromName)
 
-		// TODO Remove this once SIGN_MODE_TEXTUAL is released
-		// ref: https://github.com/cosmos/cosmos-sdk/issues/11970
-		if keyType == keyring.TypeLedger && clientCtx.SignModeStr == flags.SignModeTextual {
-			return clientCtx, fmt.Errorf("SIGN_MODE_TEXTUAL is currently not supported, please follow https://github.com/cosmos/cosmos-sdk/issues/11970")
-		}
-
-		// If the `from` signer account is a ledger key, we need to use
-		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet.
+		// If the `from` signer account is a ledger key and no sign mode is
+		// given, we need to use a sign mode the device supports, as it doesn't
+		// support proto yet. A given sign mode is used as is.
 		// ref: https://github.com/cosmos/cosmos-sdk/issues/8109
-		if keyType == keyring.TypeLedger &&
-			clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON &&
-			clientCtx.SignModeStr != flags.SignModeTextual &&
-			!clientCtx.LedgerHasProtobuf {
-			fmt.Println("Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
-			clientCtx = clientCtx.WithSignModeStr(flags.SignModeLegacyAminoJSON)
+		if keyType == keyring.TypeLedger && clientCtx.SignModeStr == "" && !clientCtx.LedgerHasProtobuf {
+			clientCtx = clientCtx.WithSignModeStr(ledgerSignMode(clientCtx))
 		}
 	}
 
@@ -119,3 +119,56 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestSetCmdClientContextHandlerNodes(t *testing.T) {
+	testCases := []struct {
+		name        string
+		args        []string
+		expNodeURI  string
+		expFailover bool
+	}{
+		{
+			"single node",
+			[]string{fmt.Sprintf("--%s=http://localhost:1", flags.FlagNode)},
+			"http://localhost:1",
+			false,
+		},
+		{
+			"list of nodes",
+			[]string{fmt.Sprintf("--%s=http://localhost:1,http://localhost:2", flags.FlagNode)},
+			"http://localhost:1,http://localhost:2",
+			true,
+		},
+		{
+			"single node with verbose output",
+			[]string{fmt.Sprintf("--%s=http://localhost:1", flags.FlagNode), fmt.Sprintf("--%s", flags.FlagNodeVerbose)},
+			"http://localhost:1",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				PreRunE: func(cmd *cobra.Command, args []string) error {
+					return client.SetCmdClientContextHandler(client.Context{}, cmd)
+				},
+				RunE: func(*cobra.Command, []string) error { return nil },
+			}
+			cmd.Flags().String(flags.FlagNode, "", "node")
+			cmd.Flags().Bool(flags.FlagNodeVerbose, false, "node verbose")
+			_ = testutil.ApplyMockIODiscardOutErr(cmd)
+			cmd.SetArgs(tc.args)
+
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+			require.NoError(t, cmd.ExecuteContext(ctx))
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			require.Equal(t, tc.expNodeURI, clientCtx.NodeURI)
+			_, isFailover := clientCtx.Client.(*client.FailoverClient)
+			require.Equal(t, tc.expFailover, isFailover)
+		})
+	}
+}
@@ -84,7 +84,7 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	ctx = ctx.WithKeyring(keyring)
 
 	// https://github.com/cosmos/cosmos-sdk/issues/8986
-	client, err := client.NewClientFromNode(conf.Node)
+	client, err := client.NewClientFromNodes(conf.Node)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get client from nodeURI: %v", err)
 	}
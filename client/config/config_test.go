@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -92,3 +93,19 @@ func TestConfigCmdEnvFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigNodeList(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "client.toml"), []byte(
+		fmt.Sprintf("chain-id = \"test-chain\"\nkeyring-backend = \"test\"\nnode = [%q, %q]\n", testNode1, testNode2),
+	), 0o600))
+
+	clientCtx, err := config.ReadFromClientConfig(client.Context{}.
+		WithHomeDir(home).
+		WithViper("").
+		WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry())))
+	require.NoError(t, err)
+	require.Equal(t, testNode1+","+testNode2, clientCtx.NodeURI)
+	require.IsType(t, &client.FailoverClient{}, clientCtx.Client)
+}
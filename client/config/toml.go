@@ -2,9 +2,13 @@ package config
 
 import (
 	"bytes"
+	"fmt"
 	"os"
+	"reflect"
+	"strings"
 	"text/template"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
 
@@ -21,7 +25,8 @@ chain-id = "{{ .ChainID }}"
 keyring-backend = "{{ .KeyringBackend }}"
 # CLI output format (text|json)
 output = "{{ .Output }}"
-# <host>:<port> to CometBFT RPC interface for this chain
+# <host>:<port> to CometBFT RPC interface for this chain, or a comma-separated list
+# of them to fail over to when an endpoint is down
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async)
 broadcast-mode = "{{ .BroadcastMode }}"
@@ -56,9 +61,32 @@ func getClientConfig(configPath string, v *viper.Viper) (*ClientConfig, error) {
 	}
 
 	conf := DefaultConfig()
-	if err := v.Unmarshal(conf); err != nil {
+	if err := v.Unmarshal(conf, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		joinSliceHookFunc(","),
+	))); err != nil {
 		return nil, err
 	}
 
 	return conf, nil
 }
+
+// joinSliceHookFunc returns a mapstructure.DecodeHookFunc joining the arrays
+// decoded into strings with the given separator, so that the node can be set
+// to an array of endpoints in client.toml.
+func joinSliceHookFunc(sep string) mapstructure.DecodeHookFunc {
+	return func(from, to reflect.Type, data interface{}) (interface{}, error) {
+		if from.Kind() != reflect.Slice || to.Kind() != reflect.String {
+			return data, nil
+		}
+
+		v := reflect.ValueOf(data)
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+
+		return strings.Join(elems, sep), nil
+	}
+}
@@ -17,6 +17,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
 // PreprocessTxFn defines a hook by which chains can preprocess transactions before broadcasting
@@ -376,7 +377,8 @@ func GetFromFields(clientCtx Context, kr keyring.Keyring, from string) (sdk.AccA
 		return nil, "", 0, nil
 	}
 
-	addr, err := sdk.AccAddressFromBech32(from)
+	bz, err := parseAddress(from, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	addr := sdk.AccAddress(bz)
 	switch {
 	case clientCtx.Simulate:
 		if err != nil {
@@ -400,6 +402,10 @@ func GetFromFields(clientCtx Context, kr keyring.Keyring, from string) (sdk.AccA
 	} else {
 		k, err = kr.Key(from)
 		if err != nil {
+			// report an address of another chain or kind rather than a missing key
+			if _, _, bech32Err := bech32.DecodeAndConvert(from); bech32Err == nil {
+				_, err = parseAddress(from, sdk.GetConfig().GetBech32AccountAddrPrefix())
+			}
 			return nil, "", 0, err
 		}
 	}
@@ -192,6 +192,13 @@ func TestGetFromFields(t *testing.T) {
 			from:        "alice",
 			expectedErr: "alice.info: key not found",
 		},
+		{
+			keyring: func() keyring.Keyring {
+				return keyring.NewInMemory(cfg.Codec)
+			},
+			from:        "osmo139f7kncmglres2nf3h4hc4tade85ekfr0t005x",
+			expectedErr: "invalid Bech32 prefix; expected cosmos, got osmo: osmo139f7kncmglres2nf3h4hc4tade85ekfr0t005x is not an account address of this chain (did you mean cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5?)",
+		},
 		{
 			keyring: func() keyring.Keyring {
 				return keyring.NewInMemory(cfg.Codec)
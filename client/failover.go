@@ -0,0 +1,307 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var _ CometRPC = (*FailoverClient)(nil)
+
+// FailoverClient is a CometRPC implementation communicating with several
+// CometBFT nodes. Requests are sent to the endpoints in order until one of
+// them serves it, starting with the endpoint which served the last request.
+//
+// Requests fail over to the next endpoint when the connection to an endpoint
+// cannot be established, or when the endpoint responds with a 502, 503 or 504
+// HTTP status. Broadcast requests only fail over when the connection cannot be
+// established, as the transaction may otherwise have been received by the node.
+type FailoverClient struct {
+	endpoints []failoverEndpoint
+
+	mtx       sync.Mutex
+	preferred int
+	verbose   io.Writer
+}
+
+type failoverEndpoint struct {
+	uri    string
+	client CometRPC
+}
+
+// NewFailoverClient returns a FailoverClient communicating with the given
+// CometBFT nodes over JSON RPC and WebSockets.
+func NewFailoverClient(nodeURIs []string) (*FailoverClient, error) {
+	return newFailoverClient(nodeURIs, jsonrpcclient.DefaultHTTPClient)
+}
+
+func newFailoverClient(nodeURIs []string, newHTTPClient func(string) (*http.Client, error)) (*FailoverClient, error) {
+	if len(nodeURIs) == 0 {
+		return nil, errors.New("no CometBFT RPC endpoint")
+	}
+
+	endpoints := make([]failoverEndpoint, len(nodeURIs))
+	for i, uri := range nodeURIs {
+		httpClient, err := newHTTPClient(uri)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CometBFT RPC endpoint %s: %w", uri, err)
+		}
+
+		httpClient.Transport = unavailableStatusTransport{base: httpClient.Transport}
+		client, err := rpchttp.NewWithClient(uri, "/websocket", httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CometBFT RPC endpoint %s: %w", uri, err)
+		}
+
+		endpoints[i] = failoverEndpoint{uri: uri, client: client}
+	}
+
+	return &FailoverClient{endpoints: endpoints}, nil
+}
+
+// SetVerboseOutput makes the client write the endpoint serving each request,
+// and the failures of the endpoints, to the given writer.
+func (c *FailoverClient) SetVerboseOutput(w io.Writer) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.verbose = w
+}
+
+// LastEndpoint returns the endpoint which served the last request, or the
+// first endpoint if no request was served yet.
+func (c *FailoverClient) LastEndpoint() string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.endpoints[c.preferred].uri
+}
+
+// logf writes the given message to the verbose output, if any.
+func (c *FailoverClient) logf(format string, args ...interface{}) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.verbose != nil {
+		_, _ = fmt.Fprintf(c.verbose, format+"\n", args...)
+	}
+}
+
+// failover sends a request to the endpoints of the client in order, starting
+// with the endpoint which served the last request, until it is served or its
+// error cannot be failed over according to retryable.
+func failover[T any](c *FailoverClient, method string, retryable func(error) bool, call func(CometRPC) (T, error)) (T, error) {
+	c.mtx.Lock()
+	start := c.preferred
+	c.mtx.Unlock()
+
+	var (
+		res T
+		err error
+	)
+	for i := range c.endpoints {
+		idx := (start + i) % len(c.endpoints)
+		endpoint := c.endpoints[idx]
+
+		res, err = call(endpoint.client)
+		if err != nil && retryable(err) {
+			c.logf("%s: endpoint %s failed: %v", method, endpoint.uri, err)
+			continue
+		}
+
+		c.mtx.Lock()
+		c.preferred = idx
+		c.mtx.Unlock()
+		c.logf("%s: served by %s", method, endpoint.uri)
+
+		return res, err
+	}
+
+	return res, fmt.Errorf("all CometBFT RPC endpoints failed: %w", err)
+}
+
+// isConnectError returns whether the error occurred while establishing the
+// connection to an endpoint, before the request was sent.
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isUnavailableError returns whether the request could not be served by an
+// endpoint, either because it cannot be connected to or because it reported
+// being unavailable.
+func isUnavailableError(err error) bool {
+	var statusErr *unavailableStatusError
+	return isConnectError(err) || errors.As(err, &statusErr)
+}
+
+// unavailableStatusError is returned for the HTTP responses with a status
+// reporting the endpoint as unavailable.
+type unavailableStatusError struct {
+	status string
+}
+
+func (e *unavailableStatusError) Error() string {
+	return fmt.Sprintf("endpoint unavailable: %s", e.status)
+}
+
+// unavailableStatusTransport is an http.RoundTripper turning the responses
+// with a 502, 503 or 504 status into an unavailableStatusError, as the
+// CometBFT RPC client does not expose the status of the responses.
+type unavailableStatusTransport struct {
+	base http.RoundTripper
+}
+
+func (t unavailableStatusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		_ = resp.Body.Close()
+		return nil, &unavailableStatusError{status: resp.Status}
+	default:
+		return resp, nil
+	}
+}
+
+// splitNodeURIs returns the endpoints of a comma-separated list of CometBFT
+// RPC endpoints.
+func splitNodeURIs(nodeURIs string) []string {
+	var uris []string
+	for _, uri := range strings.Split(nodeURIs, ",") {
+		if uri = strings.TrimSpace(uri); uri != "" {
+			uris = append(uris, uri)
+		}
+	}
+
+	return uris
+}
+
+func (c *FailoverClient) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return failover(c, "abci_info", isUnavailableError, func(client CometRPC) (*coretypes.ResultABCIInfo, error) {
+		return client.ABCIInfo(ctx)
+	})
+}
+
+func (c *FailoverClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return failover(c, "abci_query", isUnavailableError, func(client CometRPC) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQuery(ctx, path, data)
+	})
+}
+
+func (c *FailoverClient) ABCIQueryWithOptions(
+	ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	return failover(c, "abci_query", isUnavailableError, func(client CometRPC) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQueryWithOptions(ctx, path, data, opts)
+	})
+}
+
+func (c *FailoverClient) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	return failover(c, "broadcast_tx_commit", isConnectError, func(client CometRPC) (*coretypes.ResultBroadcastTxCommit, error) {
+		return client.BroadcastTxCommit(ctx, tx)
+	})
+}
+
+func (c *FailoverClient) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return failover(c, "broadcast_tx_async", isConnectError, func(client CometRPC) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxAsync(ctx, tx)
+	})
+}
+
+func (c *FailoverClient) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return failover(c, "broadcast_tx_sync", isConnectError, func(client CometRPC) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxSync(ctx, tx)
+	})
+}
+
+func (c *FailoverClient) Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	return failover(c, "validators", isUnavailableError, func(client CometRPC) (*coretypes.ResultValidators, error) {
+		return client.Validators(ctx, height, page, perPage)
+	})
+}
+
+func (c *FailoverClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return failover(c, "status", isUnavailableError, func(client CometRPC) (*coretypes.ResultStatus, error) {
+		return client.Status(ctx)
+	})
+}
+
+func (c *FailoverClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return failover(c, "block", isUnavailableError, func(client CometRPC) (*coretypes.ResultBlock, error) {
+		return client.Block(ctx, height)
+	})
+}
+
+func (c *FailoverClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return failover(c, "block_results", isUnavailableError, func(client CometRPC) (*coretypes.ResultBlockResults, error) {
+		return client.BlockResults(ctx, height)
+	})
+}
+
+func (c *FailoverClient) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	return failover(c, "block_by_hash", isUnavailableError, func(client CometRPC) (*coretypes.ResultBlock, error) {
+		return client.BlockByHash(ctx, hash)
+	})
+}
+
+func (c *FailoverClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	return failover(c, "blockchain", isUnavailableError, func(client CometRPC) (*coretypes.ResultBlockchainInfo, error) {
+		return client.BlockchainInfo(ctx, minHeight, maxHeight)
+	})
+}
+
+func (c *FailoverClient) Commit(ctx context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	return failover(c, "commit", isUnavailableError, func(client CometRPC) (*coretypes.ResultCommit, error) {
+		return client.Commit(ctx, height)
+	})
+}
+
+func (c *FailoverClient) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	return failover(c, "tx", isUnavailableError, func(client CometRPC) (*coretypes.ResultTx, error) {
+		return client.Tx(ctx, hash, prove)
+	})
+}
+
+func (c *FailoverClient) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*coretypes.ResultTxSearch, error) {
+	return failover(c, "tx_search", isUnavailableError, func(client CometRPC) (*coretypes.ResultTxSearch, error) {
+		return client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	})
+}
+
+func (c *FailoverClient) BlockSearch(
+	ctx context.Context,
+	query string,
+	page, perPage *int,
+	orderBy string,
+) (*coretypes.ResultBlockSearch, error) {
+	return failover(c, "block_search", isUnavailableError, func(client CometRPC) (*coretypes.ResultBlockSearch, error) {
+		return client.BlockSearch(ctx, query, page, perPage, orderBy)
+	})
+}
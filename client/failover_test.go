@@ -0,0 +1,244 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEndpoint1 = "http://node1:26657"
+	testEndpoint2 = "http://node2:26657"
+)
+
+// endpointBehavior is the behavior of an endpoint of the mockTransport.
+type endpointBehavior int
+
+const (
+	// serve serves the requests
+	serve endpointBehavior = iota
+	// refuse refuses the connections, before the request is sent
+	refuse
+	// unavailable responds with a 503 status
+	unavailable
+	// reset resets the connection after the request is sent
+	reset
+)
+
+// mockTransport is an http.RoundTripper mocking CometBFT RPC endpoints,
+// recording the hosts of the requests sent to them.
+type mockTransport struct {
+	behaviors map[string]endpointBehavior
+
+	mtx   sync.Mutex
+	hosts []string
+}
+
+func (t *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mtx.Lock()
+	t.hosts = append(t.hosts, req.URL.Host)
+	t.mtx.Unlock()
+
+	switch t.behaviors[req.URL.Host] {
+	case refuse:
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	case unavailable:
+		return &http.Response{
+			Status:     "503 Service Unavailable",
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+			Request:    req,
+		}, nil
+	case reset:
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	}
+
+	var rpcReq rpctypes.RPCRequest
+	if err := json.NewDecoder(req.Body).Decode(&rpcReq); err != nil {
+		return nil, err
+	}
+
+	// the results identify the endpoint which served the request
+	var result interface{}
+	switch rpcReq.Method {
+	case "status":
+		result = &coretypes.ResultStatus{NodeInfo: p2p.DefaultNodeInfo{Moniker: req.URL.Host}}
+	case "abci_query":
+		result = &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Log: req.URL.Host}}
+	case "broadcast_tx_sync":
+		result = &coretypes.ResultBroadcastTx{Log: req.URL.Host}
+	}
+
+	bz, err := json.Marshal(rpctypes.NewRPCSuccessResponse(rpcReq.ID, result))
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(bz)),
+		Request:    req,
+	}, nil
+}
+
+func newMockFailoverClient(t *testing.T, behaviors ...endpointBehavior) (*FailoverClient, *mockTransport) {
+	transport := &mockTransport{behaviors: map[string]endpointBehavior{
+		"node1:26657": behaviors[0],
+		"node2:26657": behaviors[1],
+	}}
+
+	client, err := newFailoverClient([]string{testEndpoint1, testEndpoint2}, func(string) (*http.Client, error) {
+		return &http.Client{Transport: transport}, nil
+	})
+	require.NoError(t, err)
+
+	return client, transport
+}
+
+func TestFailoverClientQuery(t *testing.T) {
+	testCases := []struct {
+		name        string
+		behaviors   []endpointBehavior
+		expHosts    []string
+		expEndpoint string
+		expErr      string
+	}{
+		{
+			name:        "first endpoint serving",
+			behaviors:   []endpointBehavior{serve, serve},
+			expHosts:    []string{"node1:26657"},
+			expEndpoint: testEndpoint1,
+		},
+		{
+			name:        "first endpoint refusing connections",
+			behaviors:   []endpointBehavior{refuse, serve},
+			expHosts:    []string{"node1:26657", "node2:26657"},
+			expEndpoint: testEndpoint2,
+		},
+		{
+			name:        "first endpoint unavailable",
+			behaviors:   []endpointBehavior{unavailable, serve},
+			expHosts:    []string{"node1:26657", "node2:26657"},
+			expEndpoint: testEndpoint2,
+		},
+		{
+			name:      "first endpoint resetting connections",
+			behaviors: []endpointBehavior{reset, serve},
+			expHosts:  []string{"node1:26657"},
+			expErr:    "connection reset by peer",
+		},
+		{
+			name:      "all endpoints refusing connections",
+			behaviors: []endpointBehavior{refuse, refuse},
+			expHosts:  []string{"node1:26657", "node2:26657"},
+			expErr:    "all CometBFT RPC endpoints failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, transport := newMockFailoverClient(t, tc.behaviors...)
+
+			res, err := client.ABCIQueryWithOptions(context.Background(), "/store/bank/key", nil, rpcclient.DefaultABCIQueryOptions)
+			require.Equal(t, tc.expHosts, transport.hosts)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expEndpoint, "http://"+res.Response.Log)
+			require.Equal(t, tc.expEndpoint, client.LastEndpoint())
+
+			// the endpoint which served the last request is tried first
+			transport.hosts = nil
+			status, err := client.Status(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.expEndpoint, "http://"+status.NodeInfo.Moniker)
+			require.Equal(t, tc.expHosts[len(tc.expHosts)-1:], transport.hosts)
+		})
+	}
+}
+
+func TestFailoverClientBroadcast(t *testing.T) {
+	testCases := []struct {
+		name      string
+		behaviors []endpointBehavior
+		expHosts  []string
+		expErr    string
+	}{
+		{
+			name:      "first endpoint refusing connections",
+			behaviors: []endpointBehavior{refuse, serve},
+			expHosts:  []string{"node1:26657", "node2:26657"},
+		},
+		{
+			// the tx may have been received by the node behind a proxy
+			name:      "first endpoint unavailable",
+			behaviors: []endpointBehavior{unavailable, serve},
+			expHosts:  []string{"node1:26657"},
+			expErr:    "503 Service Unavailable",
+		},
+		{
+			name:      "first endpoint resetting connections",
+			behaviors: []endpointBehavior{reset, serve},
+			expHosts:  []string{"node1:26657"},
+			expErr:    "connection reset by peer",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, transport := newMockFailoverClient(t, tc.behaviors...)
+
+			res, err := client.BroadcastTxSync(context.Background(), []byte{0x1})
+			require.Equal(t, tc.expHosts, transport.hosts)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "node2:26657", res.Log)
+		})
+	}
+}
+
+func TestFailoverClientVerboseOutput(t *testing.T) {
+	client, _ := newMockFailoverClient(t, refuse, serve)
+	var out bytes.Buffer
+	client.SetVerboseOutput(&out)
+
+	_, err := client.Status(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "status: endpoint http://node1:26657 failed")
+	require.Contains(t, out.String(), "status: served by http://node2:26657\n")
+}
+
+func TestNewClientFromNodes(t *testing.T) {
+	client, err := NewClientFromNodes(testEndpoint1)
+	require.NoError(t, err)
+	_, ok := client.(*FailoverClient)
+	require.False(t, ok)
+
+	client, err = NewClientFromNodes(testEndpoint1 + ", " + testEndpoint2)
+	require.NoError(t, err)
+	require.IsType(t, &FailoverClient{}, client)
+	require.Equal(t, testEndpoint1, client.(*FailoverClient).LastEndpoint())
+
+	_, err = NewClientFromNodes(",")
+	require.EqualError(t, err, "no CometBFT RPC endpoint")
+}
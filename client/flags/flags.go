@@ -49,8 +49,10 @@ const (
 	FlagUseLedger        = "ledger"
 	FlagChainID          = "chain-id"
 	FlagNode             = "node"
+	FlagNodeVerbose      = "node-verbose"
 	FlagGRPC             = "grpc-addr"
 	FlagGRPCInsecure     = "grpc-insecure"
+	FlagGRPCMaxMsgSize   = "grpc-max-msg-size"
 	FlagHeight           = "height"
 	FlagGasAdjustment    = "gas-adjustment"
 	FlagFrom             = "from"
@@ -83,6 +85,8 @@ const (
 	FlagTip              = "tip"
 	FlagAux              = "aux"
 	FlagInitHeight       = "initial-height"
+	FlagRetryOnOutOfGas  = "retry-on-out-of-gas"
+	FlagMaxFee           = "max-fee"
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
@@ -103,9 +107,11 @@ var LineBreak = &cobra.Command{Run: func(*cobra.Command, []string) {}}
 
 // AddQueryFlagsToCmd adds common flags to a module query command.
 func AddQueryFlagsToCmd(cmd *cobra.Command) {
-	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain")
+	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain, or a comma-separated list of them to fail over to")
+	cmd.Flags().Bool(FlagNodeVerbose, false, "Print the CometBFT RPC endpoint serving each request to stderr")
 	cmd.Flags().String(FlagGRPC, "", "the gRPC endpoint to use for this chain")
 	cmd.Flags().Bool(FlagGRPCInsecure, false, "allow gRPC over insecure channels, if not the server must use TLS")
+	cmd.Flags().Int(FlagGRPCMaxMsgSize, 0, "the maximum size in bytes of the gRPC responses, 0 for the gRPC default of 4MB")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
 	cmd.Flags().StringP(FlagOutput, "o", "text", "Output format (text|json)")
 
@@ -124,7 +130,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
 	f.String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 	f.String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom)")
-	f.String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT rpc interface for this chain")
+	f.String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT rpc interface for this chain, or a comma-separated list of them to fail over to")
+	f.Bool(FlagNodeVerbose, false, "Print the CometBFT RPC endpoint serving each request to stderr")
 	f.Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	f.Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
 	f.StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async)")
@@ -138,6 +145,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
 	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
 	f.Bool(FlagAux, false, "Generate aux signer data instead of sending a tx")
+	f.Uint64(FlagRetryOnOutOfGas, 0, "Number of times the transaction is re-simulated and broadcast again with more gas if it runs out of gas; the transaction is then waited for until it is included in a block (sync broadcast mode only)")
+	f.String(FlagMaxFee, "", "Maximum fee the transaction may pay when it is broadcast again with more gas; eg: 100uatom")
 	f.String(FlagChainID, "", "The network chain ID")
 	// --gas can accept integers and "auto"
 	f.String(FlagGas, "", fmt.Sprintf("gas limit to set per-transaction; set to %q to calculate sufficient gas automatically. Note: %q option doesn't always report accurate results. Set a valid coin value to adjust the result. Can be used instead of %q. (default %d)",
@@ -2,18 +2,73 @@ package node
 
 import (
 	context "context"
+	"errors"
+	"strings"
+	"sync/atomic"
 
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+type (
+	storeStatsFn       = func(rootmulti.StoreStatsOptions) (int64, []rootmulti.StoreStats, error)
+	snapshotProgressFn = func() (snapshots.Progress, error)
+	earliestVersionFn  = func() int64
+	migrationRunningFn = func() bool
 )
 
+// Option configures the queries of the node service which depend on the
+// application.
+type Option func(*queryServer)
+
+// WithStoreStats sets the function computing the stats of the stores of the
+// application for the StoreStats query.
+func WithStoreStats(storeStats storeStatsFn) Option {
+	return func(s *queryServer) {
+		s.storeStats = storeStats
+	}
+}
+
+// WithSnapshotProgress sets the function reporting the progress of the snapshot
+// manager of the application for the SnapshotProgress query.
+func WithSnapshotProgress(snapshotProgress snapshotProgressFn) Option {
+	return func(s *queryServer) {
+		s.snapshotProgress = snapshotProgress
+	}
+}
+
+// WithEarliestVersion sets the function returning the earliest height the
+// state of the application is not pruned at.
+func WithEarliestVersion(earliestVersion earliestVersionFn) Option {
+	return func(s *queryServer) {
+		s.earliestVersion = earliestVersion
+	}
+}
+
+// WithMigrationRunning sets the function reporting whether the modules of the
+// application are being migrated, e.g. module.IsMigrationRunning.
+func WithMigrationRunning(migrationRunning migrationRunningFn) Option {
+	return func(s *queryServer) {
+		s.migrationRunning = migrationRunning
+	}
+}
+
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config, opts ...Option) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg, opts...))
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -24,15 +79,37 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 
 var _ ServiceServer = queryServer{}
 
+// draining is set once the node of the process started shutting down.
+var draining atomic.Bool
+
+// SetDraining marks the node of the process as shutting down, which is reported
+// by the Status query so that load balancers stop sending it requests.
+func SetDraining(isDraining bool) {
+	draining.Store(isDraining)
+}
+
 type queryServer struct {
-	clientCtx client.Context
-	cfg       config.Config
+	clientCtx        client.Context
+	cfg              config.Config
+	storeStats       storeStatsFn
+	snapshotProgress snapshotProgressFn
+	earliestVersion  earliestVersionFn
+	migrationRunning migrationRunningFn
 }
 
-func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
-	return queryServer{
+// NewQueryServer creates a new node query server. The queries depending on
+// the application, e.g. StoreStats, are unavailable unless configured with
+// the given options.
+func NewQueryServer(clientCtx client.Context, cfg config.Config, opts ...Option) ServiceServer {
+	s := queryServer{
 		clientCtx: clientCtx,
+		cfg:       cfg,
+	}
+	for _, opt := range opts {
+		opt(&s)
 	}
+
+	return s
 }
 
 func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigResponse, error) {
@@ -42,6 +119,7 @@ func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigRespo
 		MinimumGasPrice:   sdkCtx.MinGasPrices().String(),
 		PruningKeepRecent: s.cfg.PruningKeepRecent,
 		PruningInterval:   s.cfg.PruningInterval,
+		QueryGasLimit:     s.cfg.QueryGasLimit,
 	}, nil
 }
 
@@ -51,13 +129,267 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 	blockTime := sdkCtx.BlockTime()
 
 	return &StatusResponse{
-		// TODO: Get earliest version from store.
-		//
-		// Ref: ...
-		// EarliestStoreHeight: sdkCtx.MultiStore(),
-		Height:        uint64(sdkCtx.BlockHeight()),
-		Timestamp:     &blockTime,
-		AppHash:       sdkCtx.BlockHeader().AppHash,
-		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
+		EarliestStoreHeight: uint64(s.getEarliestVersion()),
+		Height:              uint64(sdkCtx.BlockHeight()),
+		Timestamp:           &blockTime,
+		AppHash:             sdkCtx.BlockHeader().AppHash,
+		ValidatorHash:       sdkCtx.BlockHeader().NextValidatorsHash,
+		Draining:            draining.Load(),
 	}, nil
 }
+
+// Ready implements ServiceServer.Ready
+func (s queryServer) Ready(ctx context.Context, _ *ReadyRequest) (*ReadyResponse, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	res, err := node.Status(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &ReadyResponse{
+		CatchingUp:       res.SyncInfo.CatchingUp,
+		EarliestHeight:   s.getEarliestVersion(),
+		MigrationRunning: s.migrationRunning != nil && s.migrationRunning(),
+		AcceptingQueries: !draining.Load(),
+	}
+	resp.Ready = !resp.CatchingUp && !resp.MigrationRunning && resp.AcceptingQueries
+
+	return resp, nil
+}
+
+// getEarliestVersion returns the earliest height the state is not pruned at,
+// or 0 if unknown.
+func (s queryServer) getEarliestVersion() int64 {
+	if s.earliestVersion == nil {
+		return 0
+	}
+
+	return s.earliestVersion()
+}
+
+// LatestBlockHeight implements ServiceServer.LatestBlockHeight
+func (s queryServer) LatestBlockHeight(ctx context.Context, _ *LatestBlockHeightRequest) (*LatestBlockHeightResponse, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	res, err := node.Status(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &LatestBlockHeightResponse{Height: res.SyncInfo.LatestBlockHeight}, nil
+}
+
+// BlockResults implements ServiceServer.BlockResults
+func (s queryServer) BlockResults(ctx context.Context, req *BlockResultsRequest) (*BlockResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	var height *int64
+	if req.Height > 0 {
+		height = &req.Height
+	}
+
+	res, err := node.BlockResults(ctx, height)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	txsResults := make([]*TxResult, len(res.TxsResults))
+	for i, txRes := range res.TxsResults {
+		txsResults[i] = &TxResult{
+			Code:      txRes.Code,
+			Codespace: txRes.Codespace,
+			Log:       txRes.Log,
+			GasWanted: txRes.GasWanted,
+			GasUsed:   txRes.GasUsed,
+			Events:    sdk.StringifyEvents(txRes.Events),
+		}
+	}
+
+	return &BlockResultsResponse{
+		Height:           res.Height,
+		TxsResults:       txsResults,
+		BeginBlockEvents: sdk.StringifyEvents(res.BeginBlockEvents),
+		EndBlockEvents:   sdk.StringifyEvents(res.EndBlockEvents),
+	}, nil
+}
+
+// TxsByEvents implements ServiceServer.TxsByEvents
+func (s queryServer) TxsByEvents(ctx context.Context, req *TxsByEventsRequest) (*TxsByEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if _, err := cmtquery.New(req.Query); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid events query %q: %v", req.Query, err)
+	}
+
+	page, limit, err := txSearchPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var orderBy string
+	switch req.OrderBy {
+	case txtypes.OrderBy_ORDER_BY_ASC:
+		orderBy = "asc"
+	case txtypes.OrderBy_ORDER_BY_DESC:
+		orderBy = "desc"
+	}
+
+	result, err := authtx.QueryTxsByEvents(s.clientCtx, page, limit, req.Query, orderBy)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	txs := make([]*txtypes.Tx, len(result.Txs))
+	for i, txRes := range result.Txs {
+		tx, ok := txRes.Tx.GetCachedValue().(*txtypes.Tx)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "expected %T, got %T", txtypes.Tx{}, txRes.Tx.GetCachedValue())
+		}
+
+		txs[i] = tx
+	}
+
+	return &TxsByEventsResponse{
+		Txs:         txs,
+		TxResponses: result.Txs,
+		Pagination:  &query.PageResponse{Total: result.TotalCount},
+	}, nil
+}
+
+// StoreStats implements ServiceServer.StoreStats
+func (s queryServer) StoreStats(_ context.Context, req *StoreStatsRequest) (*StoreStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if !s.cfg.EnableStoreStats || s.storeStats == nil {
+		return nil, status.Error(codes.FailedPrecondition, "store stats query is disabled on this node")
+	}
+
+	height, stats, err := s.storeStats(rootmulti.StoreStatsOptions{
+		PrefixDepth: int(req.PrefixDepth),
+		SampleRate:  req.SampleRate,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stores := make([]StoreStats, len(stats))
+	for i, storeStats := range stats {
+		prefixes := make([]PrefixStats, len(storeStats.Prefixes))
+		for j, prefixStats := range storeStats.Prefixes {
+			prefixes[j] = PrefixStats{
+				Prefix: prefixStats.Prefix,
+				Stats:  toKeyStats(prefixStats.KeyStats),
+			}
+		}
+
+		stores[i] = StoreStats{
+			Name:     storeStats.Name,
+			Stats:    toKeyStats(storeStats.KeyStats),
+			Prefixes: prefixes,
+		}
+	}
+
+	return &StoreStatsResponse{Height: height, Stores: stores}, nil
+}
+
+// SnapshotProgress implements ServiceServer.SnapshotProgress
+func (s queryServer) SnapshotProgress(_ context.Context, _ *SnapshotProgressRequest) (*SnapshotProgressResponse, error) {
+	if s.snapshotProgress == nil {
+		return nil, status.Error(codes.FailedPrecondition, "snapshot progress is not available on this node")
+	}
+
+	progress, err := s.snapshotProgress()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SnapshotProgressResponse{
+		Operation:       progress.Operation,
+		Height:          progress.Height,
+		Store:           progress.Store,
+		ChunksWritten:   progress.ChunksWritten,
+		EstimatedChunks: progress.EstimatedChunks,
+	}, nil
+}
+
+func toKeyStats(stats rootmulti.KeyStats) KeyStats {
+	return KeyStats{
+		Keys:       stats.Keys,
+		KeyBytes:   stats.KeyBytes,
+		ValueBytes: stats.ValueBytes,
+		Sampled:    stats.Sampled,
+	}
+}
+
+// txSearchPage converts an offset based page request into the page number and
+// size of a CometBFT tx search, which requires the offset to be a multiple of
+// the limit.
+func txSearchPage(pageReq *query.PageRequest) (page, limit int, err error) {
+	if pageReq == nil {
+		return 1, query.DefaultLimit, nil
+	}
+
+	if len(pageReq.Key) > 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+	}
+
+	limit = int(pageReq.Limit)
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	if pageReq.Offset%uint64(limit) != 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "offset %d must be a multiple of limit %d", pageReq.Offset, limit)
+	}
+
+	return int(pageReq.Offset)/limit + 1, limit, nil
+}
+
+// toGRPCError maps an error returned by the CometBFT RPC client to a gRPC
+// status error.
+func toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+
+	// heights above the latest one or pruned by the node
+	case strings.Contains(err.Error(), "must be less than or equal to the current blockchain height"),
+		strings.Contains(err.Error(), "is not available, lowest height is"):
+		return status.Error(codes.NotFound, err.Error())
+
+	// pages past the last txs of a search
+	case strings.Contains(err.Error(), "page should be within"):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case strings.Contains(err.Error(), "transaction indexing is disabled"):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -1,21 +1,148 @@
 package node
 
 import (
+	"context"
 	"testing"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestServiceServer_Config(t *testing.T) {
-	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+	cfg := config.DefaultConfig()
+	cfg.QueryGasLimit = 1_000_000
+	svr := NewQueryServer(client.Context{}, *cfg)
 	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)))
 
 	resp, err := svr.Config(ctx, &ConfigRequest{})
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
+	require.Equal(t, cfg.PruningKeepRecent, resp.PruningKeepRecent)
+	require.Equal(t, uint64(1_000_000), resp.QueryGasLimit)
+}
+
+func TestTxSearchPage(t *testing.T) {
+	page, limit, err := txSearchPage(nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, page)
+	require.Equal(t, query.DefaultLimit, limit)
+
+	page, limit, err = txSearchPage(&query.PageRequest{Offset: 20, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 3, page)
+	require.Equal(t, 10, limit)
+
+	_, _, err = txSearchPage(&query.PageRequest{Offset: 5, Limit: 10})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, _, err = txSearchPage(&query.PageRequest{Key: []byte("key")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceServer_StoreStats(t *testing.T) {
+	storeStats := func(opts rootmulti.StoreStatsOptions) (int64, []rootmulti.StoreStats, error) {
+		require.Equal(t, rootmulti.StoreStatsOptions{PrefixDepth: 1, SampleRate: 10}, opts)
+		return 5, []rootmulti.StoreStats{{
+			Name:     "bank",
+			KeyStats: rootmulti.KeyStats{Keys: 2, KeyBytes: 20, ValueBytes: 40, Sampled: true},
+			Prefixes: []rootmulti.PrefixStats{{Prefix: []byte{1}, KeyStats: rootmulti.KeyStats{Keys: 2, KeyBytes: 20, ValueBytes: 40, Sampled: true}}},
+		}}, nil
+	}
+	req := &StoreStatsRequest{PrefixDepth: 1, SampleRate: 10}
+
+	cfg := config.DefaultConfig()
+	_, err := NewQueryServer(client.Context{}, *cfg, WithStoreStats(storeStats)).StoreStats(sdk.Context{}, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "disabled by default")
+
+	cfg.EnableStoreStats = true
+	resp, err := NewQueryServer(client.Context{}, *cfg, WithStoreStats(storeStats)).StoreStats(sdk.Context{}, req)
+	require.NoError(t, err)
+	stats := KeyStats{Keys: 2, KeyBytes: 20, ValueBytes: 40, Sampled: true}
+	require.Equal(t, &StoreStatsResponse{
+		Height: 5,
+		Stores: []StoreStats{{Name: "bank", Stats: stats, Prefixes: []PrefixStats{{Prefix: []byte{1}, Stats: stats}}}},
+	}, resp)
+}
+
+func TestServiceServer_SnapshotProgress(t *testing.T) {
+	cfg := config.DefaultConfig()
+	_, err := NewQueryServer(client.Context{}, *cfg).SnapshotProgress(sdk.Context{}, &SnapshotProgressRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	snapshotProgress := func() (snapshots.Progress, error) {
+		return snapshots.Progress{Operation: "snapshot", Height: 100, Store: "bank", ChunksWritten: 3, EstimatedChunks: 10}, nil
+	}
+	resp, err := NewQueryServer(client.Context{}, *cfg, WithSnapshotProgress(snapshotProgress)).SnapshotProgress(sdk.Context{}, &SnapshotProgressRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &SnapshotProgressResponse{
+		Operation:       "snapshot",
+		Height:          100,
+		Store:           "bank",
+		ChunksWritten:   3,
+		EstimatedChunks: 10,
+	}, resp)
+}
+
+// statusClient is a CometBFT client whose status reports catchingUp.
+type statusClient struct {
+	client.CometRPC
+
+	catchingUp bool
+}
+
+func (c statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: c.catchingUp}}, nil
+}
+
+func TestServiceServer_Ready(t *testing.T) {
+	cfg := config.DefaultConfig()
+	earliestVersion := func() int64 { return 10 }
+
+	testCases := []struct {
+		name             string
+		catchingUp       bool
+		migrationRunning bool
+		draining         bool
+		expReady         bool
+	}{
+		{"ready", false, false, false, true},
+		{"catching up", true, false, false, false},
+		{"migration running", false, true, false, false},
+		{"draining", false, false, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetDraining(tc.draining)
+			defer SetDraining(false)
+
+			clientCtx := client.Context{}.WithClient(statusClient{catchingUp: tc.catchingUp})
+			migrationRunning := func() bool { return tc.migrationRunning }
+			resp, err := NewQueryServer(clientCtx, *cfg, WithEarliestVersion(earliestVersion), WithMigrationRunning(migrationRunning)).Ready(context.Background(), &ReadyRequest{})
+			require.NoError(t, err)
+			require.Equal(t, &ReadyResponse{
+				Ready:            tc.expReady,
+				CatchingUp:       tc.catchingUp,
+				EarliestHeight:   10,
+				MigrationRunning: tc.migrationRunning,
+				AcceptingQueries: !tc.draining,
+			}, resp)
+		})
+	}
+
+	// the node is not ready when its CometBFT client is unavailable
+	_, err := NewQueryServer(client.Context{}, *cfg).Ready(context.Background(), &ReadyRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
 }
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
@@ -33,6 +35,13 @@ const (
 	flagNoSort      = "nosort"
 	flagHDPath      = "hd-path"
 
+	flagBIP39Passphrase = "bip39-passphrase"
+	flagEntropyFile     = "entropy-file"
+
+	// bip39PassphrasePrompt is the value of the --bip39-passphrase flag set
+	// without a value, for which the passphrase is prompted.
+	bip39PassphrasePrompt = "<prompt>"
+
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
 )
@@ -49,6 +58,12 @@ and encrypted with the given password. The only input that is required is the en
 
 If run with -i, it will prompt the user for BIP44 path, BIP39 mnemonic, and passphrase.
 The flag --recover allows one to recover a key from a seed passphrase.
+The flag --bip39-passphrase=<passphrase> sets the BIP39 passphrase (sometimes called the 25th
+word) combined with the mnemonic to derive the key. If set without a value, the passphrase is
+prompted for.
+The flag --entropy-file allows one to generate the mnemonic from the entropy read from the
+given file, e.g. dice rolls on an air-gapped machine, instead of the system entropy. The file
+must contain at least 256 bits of entropy, i.e. 43 characters in Base-64 or 100 in Base-6.
 If run with --dry-run, a key would be generated (or recovered) but not stored to the
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
@@ -72,6 +87,9 @@ Example:
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
+	f.String(flagBIP39Passphrase, "", "BIP39 passphrase combined with the mnemonic to derive the key, prompted for if the flag is set without a value")
+	f.Lookup(flagBIP39Passphrase).NoOptDefVal = bip39PassphrasePrompt
+	f.String(flagEntropyFile, "", "File to read the entropy used to generate the mnemonic from, instead of the system entropy")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
 	f.String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config)")
@@ -250,9 +268,19 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		}
 	}
 
+	entropyFile, _ := cmd.Flags().GetString(flagEntropyFile)
+	if entropyFile != "" && len(mnemonic) != 0 {
+		return fmt.Errorf("cannot use --%s with an existing mnemonic", flagEntropyFile)
+	}
+
 	if len(mnemonic) == 0 {
-		// read entropy seed straight from cmtcrypto.Rand and convert to mnemonic
-		entropySeed, err := bip39.NewEntropy(mnemonicEntropySize)
+		var entropySeed []byte
+		if entropyFile != "" {
+			entropySeed, err = readEntropyFile(entropyFile)
+		} else {
+			// read entropy seed straight from cmtcrypto.Rand and convert to mnemonic
+			entropySeed, err = bip39.NewEntropy(mnemonicEntropySize)
+		}
 		if err != nil {
 			return err
 		}
@@ -264,25 +292,12 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	}
 
 	// override bip39 passphrase
-	if interactive {
-		bip39Passphrase, err = input.GetString(
-			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
-				"Most users should just hit enter to use the default, \"\"", inBuf)
+	bip39Passphrase, _ = cmd.Flags().GetString(flagBIP39Passphrase)
+	if bip39Passphrase == bip39PassphrasePrompt || (interactive && !cmd.Flags().Changed(flagBIP39Passphrase)) {
+		bip39Passphrase, err = readBIP39Passphrase(inBuf)
 		if err != nil {
 			return err
 		}
-
-		// if they use one, make them re-enter it
-		if len(bip39Passphrase) != 0 {
-			p2, err := input.GetString("Repeat the passphrase:", inBuf)
-			if err != nil {
-				return err
-			}
-
-			if bip39Passphrase != p2 {
-				return errors.New("passphrases don't match")
-			}
-		}
 	}
 
 	k, err := kb.NewAccount(name, mnemonic, bip39Passphrase, hdPath, algo)
@@ -300,6 +315,47 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	return printCreate(cmd, k, showMnemonic, mnemonic, outputFormat)
 }
 
+// readBIP39Passphrase prompts the user for a bip39 passphrase, which must be
+// repeated if not empty.
+func readBIP39Passphrase(inBuf *bufio.Reader) (string, error) {
+	bip39Passphrase, err := input.GetString(
+		"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
+			"Most users should just hit enter to use the default, \"\"", inBuf)
+	if err != nil {
+		return "", err
+	}
+
+	// if they use one, make them re-enter it
+	if len(bip39Passphrase) != 0 {
+		p2, err := input.GetString("Repeat the passphrase:", inBuf)
+		if err != nil {
+			return "", err
+		}
+
+		if bip39Passphrase != p2 {
+			return "", errors.New("passphrases don't match")
+		}
+	}
+
+	return bip39Passphrase, nil
+}
+
+// readEntropyFile returns the entropy seed hashed from the entropy supplied
+// by the user in the given file.
+func readEntropyFile(file string) ([]byte, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	inputEntropy := strings.TrimSpace(string(bz))
+	if err := validateUserEntropy(inputEntropy); err != nil {
+		return nil, err
+	}
+
+	return hashUserEntropy(inputEntropy), nil
+}
+
 func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemonic, outputFormat string) error {
 	switch outputFormat {
 	case flags.OutputFormatText:
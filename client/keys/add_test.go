@@ -3,8 +3,12 @@ package keys
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -274,3 +278,102 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func Test_runAddCmdBIP39PassphraseAndEntropy(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	const mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	requireAddress := func(name, expected string) {
+		k, err := kb.Key(name)
+		require.NoError(t, err)
+		addr, err := k.GetAddress()
+		require.NoError(t, err)
+		require.Equal(t, expected, addr.String())
+		require.Equal(t, sdk.FullFundraiserPath, k.GetHDPath())
+	}
+
+	// recover with the passphrase set by the flag
+	cmd.SetArgs([]string{
+		"keyname1",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s", flagRecover),
+		fmt.Sprintf("--%s=TREZOR", flagBIP39Passphrase),
+	})
+	mockIn.Reset(mnemonic + "\n")
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	requireAddress("keyname1", "cosmos12fdxecq3dp28aaswp2n3yk35p782g3w9dz32m6")
+	require.NoError(t, kb.Delete("keyname1"))
+
+	// recover with the passphrase prompted for
+	cmd.SetArgs([]string{
+		"keyname2",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s", flagRecover),
+		fmt.Sprintf("--%s", flagBIP39Passphrase),
+	})
+	mockIn.Reset(mnemonic + "\nTREZOR\nTREZOR\n")
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	requireAddress("keyname2", "cosmos12fdxecq3dp28aaswp2n3yk35p782g3w9dz32m6")
+
+	// recover without passphrase
+	cmd.SetArgs([]string{
+		"keyname3",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s", flagRecover),
+		fmt.Sprintf("--%s=", flagBIP39Passphrase),
+	})
+	mockIn.Reset(mnemonic + "\n")
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	requireAddress("keyname3", "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4")
+
+	// generate the mnemonic from the entropy file
+	entropy := strings.Repeat("1234563", 15)
+	entropyFile := filepath.Join(t.TempDir(), "entropy.txt")
+	require.NoError(t, os.WriteFile(entropyFile, []byte(entropy+"\n"), 0o600))
+	cmd.SetArgs([]string{
+		"keyname4",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=false", flagRecover),
+		fmt.Sprintf("--%s=%s", flagEntropyFile, entropyFile),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	hashedEntropy := sha256.Sum256([]byte(entropy))
+	expectedMnemonic, err := bip39.NewMnemonic(hashedEntropy[:])
+	require.NoError(t, err)
+	expected, err := keyring.NewInMemory(cdc).NewAccount("expected", expectedMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	expectedAddr, err := expected.GetAddress()
+	require.NoError(t, err)
+	requireAddress("keyname4", expectedAddr.String())
+
+	// not enough entropy
+	require.NoError(t, os.WriteFile(entropyFile, []byte("123456"), 0o600))
+	cmd.SetArgs([]string{
+		"keyname5",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagEntropyFile, entropyFile),
+	})
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "256-bits is 43 characters in Base-64")
+
+	// the entropy file cannot be used to recover a key
+	cmd.SetArgs([]string{
+		"keyname5",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagEntropyFile, entropyFile),
+		fmt.Sprintf("--%s", flagRecover),
+	})
+	mockIn.Reset(mnemonic + "\n")
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "cannot use --entropy-file with an existing mnemonic")
+}
@@ -69,6 +69,40 @@ and export your keys in ASCII-armored encrypted format.`,
 	return cmd
 }
 
+// ExportAllKeysCommand exports all the keys of the key store in a single bundle.
+func ExportAllKeysCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-all",
+		Short: "Export all keys in a single encrypted bundle",
+		Long: `Export all the keys of the local keyring in a single ASCII-armored bundle, encrypted
+with one passphrase. Private keys, public keys of offline and multisig keys and Ledger
+key references are exported along with their names, so that the keyring can be restored
+on another machine with the import-all command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported keys:", buf)
+			if err != nil {
+				return err
+			}
+
+			armored, err := clientCtx.Keyring.ExportKeyBundle(encryptPassword)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(armored)
+
+			return nil
+		},
+	}
+}
+
 func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kr keyring.Keyring) error {
 	// confirm deletion, unless -y is passed
 	if yes, err := input.GetConfirmation("WARNING: The private key will be exported as an unarmored hexadecimal string. USE AT YOUR OWN RISK. Continue?", buf, cmd.ErrOrStderr()); err != nil {
@@ -3,6 +3,7 @@ package keys
 import (
 	"bufio"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/input"
 )
 
+const flagOverwrite = "overwrite"
+
 // ImportKeyCommand imports private keys from a keyfile.
 func ImportKeyCommand() *cobra.Command {
 	return &cobra.Command{
@@ -38,3 +41,48 @@ func ImportKeyCommand() *cobra.Command {
 		},
 	}
 }
+
+// ImportAllKeysCommand imports the keys of a bundle exported by export-all.
+func ImportAllKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-all <bundlefile>",
+		Short: "Import all keys of an encrypted bundle into the local keybase",
+		Long: `Import all the keys of an ASCII-armored bundle exported with the export-all command.
+Keys whose name already exists in the local keybase are skipped, unless --overwrite is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(clientCtx.Input)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt your keys:", buf)
+			if err != nil {
+				return err
+			}
+
+			overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+			imported, skipped, err := clientCtx.Keyring.ImportKeyBundle(string(bz), passphrase, overwrite)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("imported %d keys: %s\n", len(imported), strings.Join(imported, ", "))
+			if len(skipped) > 0 {
+				cmd.Printf("skipped %d existing keys: %s\n", len(skipped), strings.Join(skipped, ", "))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagOverwrite, false, "Overwrite the existing keys with the same names")
+
+	return cmd
+}
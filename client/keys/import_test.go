@@ -11,8 +11,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
@@ -115,3 +117,61 @@ HbP+c6JmeJy9JXe2rbbF1QtCX1gLqGcDQPBXiCtFvP7/8wTZtVOPj8vREzhZ9ElO
 		})
 	}
 }
+
+func Test_runExportAllImportAllCmd(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	path := sdk.GetConfig().GetFullBIP44Path()
+
+	sourceHome := t.TempDir()
+	source, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, sourceHome, nil, cdc)
+	require.NoError(t, err)
+	k1, err := source.NewAccount("keyname1", testdata.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = source.NewMnemonic("keyname2", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	cmd := ExportAllKeysCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn, mockOut := testutil.ApplyMockIO(cmd)
+	mockIn.Reset("123456789\n")
+	clientCtx := client.Context{}.
+		WithKeyringDir(sourceHome).
+		WithKeyring(source).
+		WithInput(mockIn).
+		WithCodec(cdc)
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)})
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+	targetHome := t.TempDir()
+	bundleFile := filepath.Join(targetHome, "keys.asc")
+	require.NoError(t, os.WriteFile(bundleFile, mockOut.Bytes(), 0o600))
+	target, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, targetHome, nil, cdc)
+	require.NoError(t, err)
+	_, _, err = target.NewMnemonic("keyname1", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	importAll := func(extraArgs ...string) string {
+		cmd := ImportAllKeysCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset("123456789\n")
+		clientCtx := client.Context{}.
+			WithKeyringDir(targetHome).
+			WithKeyring(target).
+			WithInput(mockIn).
+			WithCodec(cdc)
+		cmd.SetArgs(append([]string{bundleFile, fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest)}, extraArgs...))
+		require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+		return mockOut.String()
+	}
+
+	require.Equal(t, "imported 1 keys: keyname2\nskipped 1 existing keys: keyname1\n", importAll())
+	k, err := target.Key("keyname1")
+	require.NoError(t, err)
+	require.NotEqual(t, k1.PubKey, k.PubKey)
+
+	require.Equal(t, "imported 2 keys: keyname1, keyname2\n", importAll(fmt.Sprintf("--%s", flagOverwrite)))
+	k, err = target.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, k1.PubKey, k.PubKey)
+}
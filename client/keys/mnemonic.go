@@ -35,8 +35,8 @@ func MnemonicKeyCommand() *cobra.Command {
 					return err
 				}
 
-				if len(inputEntropy) < 43 {
-					return fmt.Errorf("256-bits is 43 characters in Base-64, and 100 in Base-6. You entered %v, and probably want more", len(inputEntropy))
+				if err := validateUserEntropy(inputEntropy); err != nil {
+					return err
 				}
 
 				conf, err := input.GetConfirmation(fmt.Sprintf("> Input length: %d", len(inputEntropy)), buf, cmd.ErrOrStderr())
@@ -48,9 +48,7 @@ func MnemonicKeyCommand() *cobra.Command {
 					return nil
 				}
 
-				// hash input entropy to get entropy seed
-				hashedEntropy := sha256.Sum256([]byte(inputEntropy))
-				entropySeed = hashedEntropy[:]
+				entropySeed = hashUserEntropy(inputEntropy)
 			} else {
 				// read entropy seed straight from crypto.Rand
 				var err error
@@ -73,3 +71,19 @@ func MnemonicKeyCommand() *cobra.Command {
 	cmd.Flags().Bool(flagUserEntropy, false, "Prompt the user to supply their own entropy, instead of relying on the system")
 	return cmd
 }
+
+// validateUserEntropy checks that entropy supplied by the user is long enough
+// to hold 256 bits of entropy.
+func validateUserEntropy(inputEntropy string) error {
+	if len(inputEntropy) < 43 {
+		return fmt.Errorf("256-bits is 43 characters in Base-64, and 100 in Base-6. You entered %v, and probably want more", len(inputEntropy))
+	}
+
+	return nil
+}
+
+// hashUserEntropy hashes entropy supplied by the user to get an entropy seed.
+func hashUserEntropy(inputEntropy string) []byte {
+	hashedEntropy := sha256.Sum256([]byte(inputEntropy))
+	return hashedEntropy[:]
+}
@@ -27,6 +27,8 @@ const (
 	FlagBechPrefix = "bech"
 	// FlagDevice indicates that the information should be shown in the device
 	FlagDevice = "device"
+	// FlagDerive indicates that the HD derivation path of the key should be shown.
+	FlagDerive = "derive"
 
 	flagMultiSigThreshold = "multisig-threshold"
 )
@@ -47,6 +49,7 @@ consisting of all the keys provided by name and multisig threshold.`,
 	f.BoolP(FlagAddress, "a", false, "Output the address only (overrides --output)")
 	f.BoolP(FlagPublicKey, "p", false, "Output the public key only (overrides --output)")
 	f.BoolP(FlagDevice, "d", false, "Output the address in a ledger device")
+	f.Bool(FlagDerive, false, "Output the HD path the key was derived with only (overrides --output)")
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures")
 
 	return cmd
@@ -95,6 +98,7 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 	isShowAddr, _ := cmd.Flags().GetBool(FlagAddress)
 	isShowPubKey, _ := cmd.Flags().GetBool(FlagPublicKey)
 	isShowDevice, _ := cmd.Flags().GetBool(FlagDevice)
+	isShowDerive, _ := cmd.Flags().GetBool(FlagDerive)
 
 	isOutputSet := false
 	tmp := cmd.Flag(flags.FlagOutput)
@@ -106,8 +110,12 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 		return errors.New("cannot use both --address and --pubkey at once")
 	}
 
-	if isOutputSet && (isShowAddr || isShowPubKey) {
-		return errors.New("cannot use --output with --address or --pubkey")
+	if isShowDerive && (isShowAddr || isShowPubKey) {
+		return errors.New("cannot use --derive with --address or --pubkey")
+	}
+
+	if isOutputSet && (isShowAddr || isShowPubKey || isShowDerive) {
+		return errors.New("cannot use --output with --address, --pubkey or --derive")
 	}
 
 	bechPrefix, _ := cmd.Flags().GetString(FlagBechPrefix)
@@ -121,6 +129,15 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	switch {
+	case isShowDerive:
+		hdPath := k.GetHDPath()
+		if hdPath == "" {
+			return fmt.Errorf("the HD path of key %s is unknown", k.Name)
+		}
+
+		if _, err := fmt.Fprintln(cmd.OutOrStdout(), hdPath); err != nil {
+			return err
+		}
 	case isShowAddr, isShowPubKey:
 		ko, err := bechKeyOut(k)
 		if err != nil {
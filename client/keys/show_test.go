@@ -169,6 +169,51 @@ func Test_runShowCmd(t *testing.T) {
 	require.EqualError(t, cmd.ExecuteContext(ctx), "the device flag (-d) can only be used for addresses not pubkeys")
 }
 
+func Test_runShowCmdDerive(t *testing.T) {
+	cmd := ShowKeysCmd()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	_, mockOut := testutil.ApplyMockIO(cmd)
+
+	kbHome := t.TempDir()
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	path := hd.NewFundraiserParams(1, sdk.CoinType, 2).String()
+	_, err = kb.NewAccount("derived", testdata.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	cmd.SetArgs([]string{
+		"derived",
+		fmt.Sprintf("--%s", FlagDerive),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Equal(t, "m/44'/118'/1'/0/2\n", mockOut.String())
+
+	cmd.SetArgs([]string{
+		"offline",
+		fmt.Sprintf("--%s", FlagDerive),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "the HD path of key offline is unknown")
+
+	cmd.SetArgs([]string{
+		"derived",
+		fmt.Sprintf("--%s", FlagDerive),
+		fmt.Sprintf("--%s", FlagAddress),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "cannot use --derive with --address or --pubkey")
+}
+
 func Test_validateMultisigThreshold(t *testing.T) {
 	type args struct {
 		k     int
@@ -46,6 +46,8 @@ type Factory struct {
 	signMode           signing.SignMode
 	simulateAndExecute bool
 	preprocessTxHook   client.PreprocessTxFn
+	outOfGasRetries    uint64
+	maxFee             sdk.Coins
 }
 
 // NewFactoryCLI creates a new Factory.
@@ -79,6 +81,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagNote)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	outOfGasRetries, _ := flagSet.GetUint64(flags.FlagRetryOnOutOfGas)
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -100,6 +103,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		signMode:           signMode,
 		feeGranter:         clientCtx.FeeGranter,
 		feePayer:           clientCtx.FeePayer,
+		outOfGasRetries:    outOfGasRetries,
 	}
 
 	feesStr, _ := flagSet.GetString(flags.FlagFees)
@@ -113,6 +117,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	maxFeeStr, _ := flagSet.GetString(flags.FlagMaxFee)
+	f = f.WithMaxFee(maxFeeStr)
+
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
 	return f, nil
@@ -129,6 +136,8 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) OutOfGasRetries() uint64                   { return f.outOfGasRetries }
+func (f Factory) MaxFee() sdk.Coins                         { return f.maxFee }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -169,6 +178,25 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithOutOfGasRetries returns a copy of the Factory with an updated number of
+// times a tx running out of gas is broadcast again with more gas.
+func (f Factory) WithOutOfGasRetries(retries uint64) Factory {
+	f.outOfGasRetries = retries
+	return f
+}
+
+// WithMaxFee returns a copy of the Factory with an updated maximum fee a tx
+// broadcast again with more gas may pay.
+func (f Factory) WithMaxFee(maxFee string) Factory {
+	parsedMaxFee, err := sdk.ParseCoinsNormalized(maxFee)
+	if err != nil {
+		panic(err)
+	}
+
+	f.maxFee = parsedMaxFee
+	return f
+}
+
 // WithTips returns a copy of the Factory with an updated tip.
 func (f Factory) WithTips(tip, tipper string) Factory {
 	parsedTips, err := sdk.ParseCoinsNormalized(tip)
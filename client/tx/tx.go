@@ -3,15 +3,19 @@ package tx
 import (
 	"bufio"
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +25,14 @@ import (
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
+const (
+	// TxInclusionTimeout is how long a tx retried when running out of gas is
+	// waited for until it is included in a block.
+	TxInclusionTimeout = time.Minute
+
+	txInclusionPollInterval = time.Second
+)
+
 // GenerateOrBroadcastTxCLI will either generate and print an unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) error {
@@ -76,22 +88,36 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	if txf.OutOfGasRetries() > 0 && clientCtx.BroadcastMode != flags.BroadcastSync {
+		return fmt.Errorf("retrying txs running out of gas requires the %s broadcast mode", flags.BroadcastSync)
+	}
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
 		}
 
-		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+		simRes, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
 		if err != nil {
 			return err
 		}
 
 		txf = txf.WithGas(adjusted)
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
-	}
 
-	if clientCtx.Simulate {
-		return nil
+		if clientCtx.Simulate {
+			dryRunRes, err := NewDryRunResponse(clientCtx, simRes, txf.Gas())
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(dryRunRes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		}
 	}
 
 	tx, err := txf.BuildUnsignedTx(msgs...)
@@ -121,23 +147,122 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		}
 	}
 
-	err = Sign(clientCtx.CmdContext, txf, clientCtx.GetFromName(), tx, true)
+	res, err := signAndBroadcast(clientCtx, txf, tx)
 	if err != nil {
 		return err
 	}
 
+	if txf.OutOfGasRetries() > 0 {
+		res, err = retryOnOutOfGas(clientCtx, txf, res, msgs...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// signAndBroadcast signs the given tx and broadcasts it to a CometBFT node.
+func signAndBroadcast(clientCtx client.Context, txf Factory, tx client.TxBuilder) (*sdk.TxResponse, error) {
+	err := Sign(clientCtx.CmdContext, txf, clientCtx.GetFromName(), tx, true)
+	if err != nil {
+		return nil, err
+	}
+
 	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// broadcast to a CometBFT node
-	res, err := clientCtx.BroadcastTx(txBytes)
+	return clientCtx.BroadcastTx(txBytes)
+}
+
+// retryOnOutOfGas waits for the tx broadcast with res to be included in a
+// block. While it runs out of gas, when checked or executed, the tx is
+// simulated again and broadcast with more gas, up to txf.OutOfGasRetries()
+// times. It returns the response of the last tx broadcast.
+func retryOnOutOfGas(clientCtx client.Context, txf Factory, res *sdk.TxResponse, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	for retry := uint64(1); ; retry++ {
+		if res.Code == 0 {
+			var err error
+			res, err = waitForTx(clientCtx, res.TxHash)
+			if err != nil {
+				return nil, err
+			}
+
+			// the sequence of a tx failing once included is used up
+			if res.Code != 0 {
+				txf = txf.WithSequence(txf.Sequence() + 1)
+			}
+		}
+
+		if !isOutOfGas(res) || retry > txf.OutOfGasRetries() {
+			return res, nil
+		}
+
+		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		gas := uint64(txf.GasAdjustment() * float64(txf.Gas()))
+		if adjusted > gas {
+			gas = adjusted
+		}
+		if gas <= txf.Gas() {
+			return nil, fmt.Errorf("tx %s ran out of gas, and the gas adjustment %v does not increase its gas limit", res.TxHash, txf.GasAdjustment())
+		}
+
+		prevGas := txf.Gas()
+		txf = txf.WithGas(gas)
+		tx, err := txf.BuildUnsignedTx(msgs...)
+		if err != nil {
+			return nil, err
+		}
+		if fee := tx.GetTx().GetFee(); !txf.MaxFee().IsZero() && !fee.IsAllLTE(txf.MaxFee()) {
+			return nil, fmt.Errorf("tx %s ran out of gas, and its fee %s with %d gas would exceed the max fee %s", res.TxHash, fee, gas, txf.MaxFee())
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "tx %s ran out of gas with %d gas, broadcasting it again with %d gas (retry %d of %d)\n",
+			res.TxHash, prevGas, gas, retry, txf.OutOfGasRetries())
+		res, err = signAndBroadcast(clientCtx, txf, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isOutOfGas returns whether the tx of res ran out of gas.
+func isOutOfGas(res *sdk.TxResponse) bool {
+	return res.Codespace == sdkerrors.ErrOutOfGas.Codespace() && res.Code == sdkerrors.ErrOutOfGas.ABCICode()
+}
+
+// waitForTx polls the CometBFT node until the tx with the given hash is
+// included in a block, and returns its response.
+func waitForTx(clientCtx client.Context, txHash string) (*sdk.TxResponse, error) {
+	node, err := clientCtx.GetNode()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return clientCtx.PrintProto(res)
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), TxInclusionTimeout)
+	defer cancel()
+	for {
+		resTx, err := node.Tx(ctx, hash, false)
+		if err == nil {
+			return sdk.NewResponseResultTx(resTx, nil, ""), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for tx %s to be included in a block: %w", txHash, err)
+		case <-time.After(txInclusionPollInterval):
+		}
+	}
 }
 
 // CalculateGas simulates the execution of a transaction and returns the
@@ -367,6 +492,49 @@ func (gr GasEstimateResponse) String() string {
 	return fmt.Sprintf("gas estimate: %d", gr.GasEstimate)
 }
 
+// DryRunResponse defines the outcome of a simulated transaction, as printed
+// when running a transaction with the --dry-run flag. The gas wanted is not
+// included as transactions are simulated with an infinite gas meter.
+type DryRunResponse struct {
+	GasEstimate  uint64            `json:"gas_estimate" yaml:"gas_estimate"`
+	GasUsed      uint64            `json:"gas_used" yaml:"gas_used"`
+	MsgResponses []json.RawMessage `json:"msg_responses" yaml:"msg_responses"`
+	Events       []abci.Event      `json:"events" yaml:"events"`
+	Log          string            `json:"log" yaml:"log"`
+}
+
+// NewDryRunResponse builds a DryRunResponse from the response of the Simulate
+// gRPC call, decoding the message responses with the interface registry of
+// the client context.
+func NewDryRunResponse(clientCtx client.Context, simRes *tx.SimulateResponse, gasEstimate uint64) (DryRunResponse, error) {
+	res := DryRunResponse{GasEstimate: gasEstimate}
+	if simRes.GasInfo != nil {
+		res.GasUsed = simRes.GasInfo.GasUsed
+	}
+
+	if simRes.Result == nil {
+		return res, nil
+	}
+
+	res.Events = simRes.Result.Events
+	res.Log = simRes.Result.Log
+	for _, msgAny := range simRes.Result.MsgResponses {
+		var msgRes tx.MsgResponse
+		if err := clientCtx.InterfaceRegistry.UnpackAny(msgAny, &msgRes); err != nil {
+			return DryRunResponse{}, fmt.Errorf("failed to decode message response %s: %w", msgAny.TypeUrl, err)
+		}
+
+		bz, err := clientCtx.Codec.MarshalJSON(msgAny)
+		if err != nil {
+			return DryRunResponse{}, err
+		}
+
+		res.MsgResponses = append(res.MsgResponses, bz)
+	}
+
+	return res, nil
+}
+
 // makeAuxSignerData generates an AuxSignerData from the client inputs.
 func makeAuxSignerData(clientCtx client.Context, f Factory, msgs ...sdk.Msg) (tx.AuxSignerData, error) {
 	b := NewAuxTxBuilder()
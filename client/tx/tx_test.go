@@ -1,17 +1,25 @@
 package tx_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -20,12 +28,14 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	ante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -105,6 +115,44 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+func TestNewDryRunResponse(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	// message responses are decoded from Anys received over the wire, without
+	// cached values
+	msgRes := &codectypes.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSendResponse"}
+	transfer := abci.Event{
+		Type:       banktypes.EventTypeTransfer,
+		Attributes: []abci.EventAttribute{{Key: sdk.AttributeKeyAmount, Value: "10stake"}},
+	}
+	simRes := &txtypes.SimulateResponse{
+		GasInfo: &sdk.GasInfo{GasWanted: 200000, GasUsed: 50000},
+		Result: &sdk.Result{
+			Log:          "log",
+			Events:       []abci.Event{transfer},
+			MsgResponses: []*codectypes.Any{msgRes},
+		},
+	}
+
+	res, err := tx.NewDryRunResponse(clientCtx, simRes, 60000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60000), res.GasEstimate)
+	require.Equal(t, uint64(50000), res.GasUsed)
+	require.Equal(t, "log", res.Log)
+	require.Equal(t, []abci.Event{transfer}, res.Events)
+	require.Len(t, res.MsgResponses, 1)
+	require.JSONEq(t, `{"@type":"/cosmos.bank.v1beta1.MsgSendResponse"}`, string(res.MsgResponses[0]))
+
+	// message responses which are not registered in the interface registry
+	// cannot be decoded
+	simRes.Result.MsgResponses = []*codectypes.Any{{TypeUrl: "/testpb.Dog"}}
+	_, err = tx.NewDryRunResponse(clientCtx, simRes, 60000)
+	require.ErrorContains(t, err, "failed to decode message response /testpb.Dog")
+}
+
 func mockTxFactory(txCfg client.TxConfig) tx.Factory {
 	return tx.Factory{}.
 		WithTxConfig(txCfg).
@@ -450,3 +498,156 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+// mockBroadcastClient is a mock CometBFT client which checks and executes the
+// txs it is broadcast with the given result codes, used to unit test retrying
+// txs running out of gas.
+type mockBroadcastClient struct {
+	rpcclientmock.Client
+
+	gasUsed      uint64
+	checkTxCodes []uint32
+	execTxCodes  []uint32
+	txs          []cmttypes.Tx
+}
+
+func (m *mockBroadcastClient) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	code := m.checkTxCodes[len(m.txs)]
+	m.txs = append(m.txs, tx)
+
+	return &coretypes.ResultBroadcastTx{Code: code, Codespace: codespace(code), Hash: tx.Hash()}, nil
+}
+
+func (m *mockBroadcastClient) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	code := m.execTxCodes[len(m.txs)-1]
+
+	return &coretypes.ResultTx{Hash: hash, Height: 1, TxResult: abci.ResponseDeliverTx{Code: code, Codespace: codespace(code)}}, nil
+}
+
+func (m *mockBroadcastClient) ABCIQueryWithOptions(context.Context, string, cmtbytes.HexBytes, rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	bz, err := (&txtypes.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: m.gasUsed}, Result: &sdk.Result{}}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func codespace(code uint32) string {
+	if code == 0 {
+		return ""
+	}
+	return sdkerrors.RootCodespace
+}
+
+func TestBroadcastTxRetryOnOutOfGas(t *testing.T) {
+	outOfGas, insufficientFunds := sdkerrors.ErrOutOfGas.ABCICode(), sdkerrors.ErrInsufficientFunds.ABCICode()
+
+	testCases := []struct {
+		name          string
+		broadcastMode string
+		maxFee        string
+		checkTxCodes  []uint32
+		execTxCodes   []uint32
+		expErr        string
+		expGas        []uint64
+		expSequences  []uint64
+	}{
+		{
+			name:         "out of gas when checked then executed",
+			checkTxCodes: []uint32{outOfGas, 0, 0},
+			execTxCodes:  []uint32{0, outOfGas, 0},
+			expGas:       []uint64{100000, 180000, 270000},
+			expSequences: []uint64{0, 0, 1},
+		},
+		{
+			name:         "retries exhausted",
+			checkTxCodes: []uint32{outOfGas, outOfGas, outOfGas},
+			expGas:       []uint64{100000, 180000, 270000},
+			expSequences: []uint64{0, 0, 0},
+		},
+		{
+			name:         "no retry on other failures",
+			checkTxCodes: []uint32{insufficientFunds},
+			expGas:       []uint64{100000},
+			expSequences: []uint64{0},
+		},
+		{
+			name:         "max fee exceeded",
+			maxFee:       "150000stake",
+			checkTxCodes: []uint32{outOfGas},
+			expErr:       "would exceed the max fee 150000stake",
+			expGas:       []uint64{100000},
+			expSequences: []uint64{0},
+		},
+		{
+			name:          "async broadcast mode",
+			broadcastMode: flags.BroadcastAsync,
+			expErr:        "requires the sync broadcast mode",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+			txCfg, cdc := encCfg.TxConfig, encCfg.Codec
+			kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+			require.NoError(t, err)
+			path := hd.CreateHDPath(118, 0, 0).String()
+			k, _, err := kb.NewMnemonic("test_key", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+			require.NoError(t, err)
+			addr, err := k.GetAddress()
+			require.NoError(t, err)
+
+			broadcastMode := tc.broadcastMode
+			if broadcastMode == "" {
+				broadcastMode = flags.BroadcastSync
+			}
+			mockClient := &mockBroadcastClient{gasUsed: 120000, checkTxCodes: tc.checkTxCodes, execTxCodes: tc.execTxCodes}
+			clientCtx := client.Context{}.
+				WithTxConfig(txCfg).
+				WithCodec(cdc).
+				WithKeyring(kb).
+				WithFromName("test_key").
+				WithFromAddress(addr).
+				WithClient(mockClient).
+				WithAccountRetriever(client.MockAccountRetriever{}).
+				WithBroadcastMode(broadcastMode).
+				WithSkipConfirmation(true).
+				WithChainID("test-chain").
+				WithOutput(&bytes.Buffer{})
+
+			txf := tx.Factory{}.
+				WithTxConfig(txCfg).
+				WithAccountRetriever(client.MockAccountRetriever{}).
+				WithKeybase(kb).
+				WithChainID("test-chain").
+				WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+				WithGas(100000).
+				WithGasAdjustment(1.5).
+				WithGasPrices("1stake").
+				WithOutOfGasRetries(2)
+			if tc.maxFee != "" {
+				txf = txf.WithMaxFee(tc.maxFee)
+			}
+
+			err = tx.BroadcastTx(clientCtx, txf, banktypes.NewMsgSend(addr, sdk.AccAddress("to"), nil))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, mockClient.txs, len(tc.expGas))
+			for i, txBytes := range mockClient.txs {
+				decoded, err := txCfg.TxDecoder()(txBytes)
+				require.NoError(t, err)
+				sigTx := decoded.(signing.Tx)
+				require.Equal(t, tc.expGas[i], sigTx.GetGas())
+				sigs, err := sigTx.GetSignaturesV2()
+				require.NoError(t, err)
+				require.Equal(t, tc.expSequences[i], sigs[0].Sequence)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	txsigning "cosmossdk.io/x/tx/signing"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -10,6 +11,12 @@ import (
 )
 
 type (
+	// LenientTxDecoder unmarshals transaction bytes, skipping the fields
+	// unknown to the binary instead of rejecting them, and returns the skipped
+	// fields. It is meant for tooling only and must never be used in consensus
+	// paths.
+	LenientTxDecoder func(txBytes []byte) (sdk.Tx, []unknownproto.UnknownField, error)
+
 	// TxEncodingConfig defines an interface that contains transaction
 	// encoders and decoders
 	TxEncodingConfig interface {
@@ -30,6 +37,11 @@ type (
 		NewTxBuilder() TxBuilder
 		WrapTxBuilder(sdk.Tx) (TxBuilder, error)
 		SignModeHandler() *txsigning.HandlerMap
+
+		// TxDecoderLenient returns a decoder skipping and reporting the fields
+		// unknown to the binary, for tooling decoding txs produced by other
+		// versions of the SDK. It must never be used in consensus paths.
+		TxDecoderLenient() LenientTxDecoder
 	}
 
 	// TxBuilder defines an interface which an application-defined concrete transaction
@@ -2,6 +2,7 @@ package client
 
 import (
 	"encoding/base64"
+	"os"
 
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/spf13/pflag"
@@ -81,6 +82,33 @@ func NewClientFromNode(nodeURI string) (*rpchttp.HTTP, error) {
 	return rpchttp.New(nodeURI, "/websocket")
 }
 
+// NewClientFromNodes sets up Client implementation from a comma-separated list of CometBFT node
+// endpoints. A single endpoint is communicated with as in NewClientFromNode, several endpoints are
+// wrapped in a FailoverClient.
+func NewClientFromNodes(nodeURIs string) (CometRPC, error) {
+	return newClientFromNodes(nodeURIs, false)
+}
+
+// newClientFromNodes is NewClientFromNodes, always using a FailoverClient writing the endpoint
+// serving each request to stderr when verbose is set.
+func newClientFromNodes(nodeURIs string, verbose bool) (CometRPC, error) {
+	uris := splitNodeURIs(nodeURIs)
+	if len(uris) == 1 && !verbose {
+		return NewClientFromNode(uris[0])
+	}
+
+	client, err := NewFailoverClient(uris)
+	if err != nil {
+		return nil, err
+	}
+
+	if verbose {
+		client.SetVerboseOutput(os.Stderr)
+	}
+
+	return client, nil
+}
+
 // FlagSetWithPageKeyDecoded returns the provided flagSet with the page-key value base64 decoded (if it exists).
 // This is for when the page-key is provided as a base64 string (e.g. from the CLI).
 // ReadPageRequest expects it to be the raw bytes.
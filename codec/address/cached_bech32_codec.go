@@ -0,0 +1,82 @@
+package address
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultCacheSize is the default number of conversions cached in each
+// direction by the address codecs of the SDK modules. An entry takes around
+// 100 bytes.
+const DefaultCacheSize = 10_000
+
+// cachedBech32Codec is a Bech32Codec caching the results of its successful
+// conversions, in both directions, in bounded LRU caches. Failed conversions
+// are not cached so that errors are always reported.
+type cachedBech32Codec struct {
+	Bech32Codec
+
+	// stringToBytes maps bech32 strings to address bytes.
+	stringToBytes *lru.Cache
+	// bytesToString maps address bytes, as strings, to bech32 strings.
+	bytesToString *lru.Cache
+}
+
+var _ address.Codec = &cachedBech32Codec{}
+
+// NewCachedBech32Codec returns a bech32 address codec with the given prefix
+// caching up to cacheSize conversions in each direction. It is safe for
+// concurrent use. If cacheSize is not positive, the returned codec does not
+// cache conversions.
+func NewCachedBech32Codec(prefix string, cacheSize int) address.Codec {
+	if cacheSize <= 0 {
+		return NewBech32Codec(prefix)
+	}
+
+	stringToBytes, err := lru.New(cacheSize)
+	if err != nil {
+		panic(err)
+	}
+	bytesToString, err := lru.New(cacheSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return &cachedBech32Codec{
+		Bech32Codec:   Bech32Codec{prefix},
+		stringToBytes: stringToBytes,
+		bytesToString: bytesToString,
+	}
+}
+
+// StringToBytes encodes text to bytes
+func (cc *cachedBech32Codec) StringToBytes(text string) ([]byte, error) {
+	if bz, ok := cc.stringToBytes.Get(text); ok {
+		// the caller may modify the returned bytes
+		return append([]byte(nil), bz.([]byte)...), nil
+	}
+
+	bz, err := cc.Bech32Codec.StringToBytes(text)
+	if err != nil {
+		return bz, err
+	}
+
+	cc.stringToBytes.Add(text, append([]byte(nil), bz...))
+	return bz, nil
+}
+
+// BytesToString decodes bytes to text
+func (cc *cachedBech32Codec) BytesToString(bz []byte) (string, error) {
+	if text, ok := cc.bytesToString.Get(string(bz)); ok {
+		return text.(string), nil
+	}
+
+	text, err := cc.Bech32Codec.BytesToString(bz)
+	if err != nil {
+		return "", err
+	}
+
+	cc.bytesToString.Add(string(bz), text)
+	return text, nil
+}
@@ -0,0 +1,129 @@
+package address
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func genAddresses(t testing.TB, n int) ([][]byte, []string) {
+	t.Helper()
+
+	addrs := make([][]byte, n)
+	strs := make([]string, n)
+	for i := range addrs {
+		addrs[i] = make([]byte, 20)
+		copy(addrs[i], fmt.Sprintf("addr%d", i))
+		var err error
+		strs[i], err = bech32.ConvertAndEncode("cosmos", addrs[i])
+		require.NoError(t, err)
+	}
+
+	return addrs, strs
+}
+
+func TestCachedBech32Codec(t *testing.T) {
+	plain := NewBech32Codec("cosmos")
+	cached := NewCachedBech32Codec("cosmos", 2)
+	addrs, strs := genAddresses(t, 3)
+
+	// convert more addresses than the size of the caches, twice, so that
+	// results are returned both from and after evicting the cache entries
+	for i := 0; i < 2; i++ {
+		for j := range addrs {
+			text, err := cached.BytesToString(addrs[j])
+			require.NoError(t, err)
+			require.Equal(t, strs[j], text)
+
+			bz, err := cached.StringToBytes(strs[j])
+			require.NoError(t, err)
+			require.Equal(t, addrs[j], bz)
+
+			// the returned bytes can be modified without affecting the cache
+			bz[0] ^= 0xff
+			bz, err = cached.StringToBytes(strs[j])
+			require.NoError(t, err)
+			require.Equal(t, addrs[j], bz)
+		}
+	}
+
+	// invalid inputs are not cached and fail as with the plain codec
+	otherPrefix, err := bech32.ConvertAndEncode("osmo", addrs[0])
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		for _, text := range []string{"", "  ", "cosmos1invalid", otherPrefix} {
+			_, expErr := plain.StringToBytes(text)
+			require.Error(t, expErr)
+			_, err := cached.StringToBytes(text)
+			require.EqualError(t, err, expErr.Error())
+		}
+	}
+}
+
+func TestCachedBech32CodecDisabled(t *testing.T) {
+	require.Equal(t, NewBech32Codec("cosmos"), NewCachedBech32Codec("cosmos", 0))
+	require.Equal(t, NewBech32Codec("cosmos"), NewCachedBech32Codec("cosmos", -1))
+}
+
+func TestCachedBech32CodecConcurrency(t *testing.T) {
+	cached := NewCachedBech32Codec("cosmos", 50)
+	addrs, strs := genAddresses(t, 100)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(offset int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				k := (offset + j) % len(addrs)
+
+				text, err := cached.BytesToString(addrs[k])
+				require.NoError(t, err)
+				require.Equal(t, strs[k], text)
+
+				bz, err := cached.StringToBytes(strs[k])
+				require.NoError(t, err)
+				require.Equal(t, addrs[k], bz)
+			}
+		}(i * 7)
+	}
+	wg.Wait()
+}
+
+// BenchmarkCodecs simulates the conversions of msg-heavy blocks, where each msg
+// converts its signer string to bytes and some addresses to strings, with a
+// set of active accounts smaller than the cache size.
+func BenchmarkCodecs(b *testing.B) {
+	addrs, strs := genAddresses(b, 1000)
+
+	for _, bc := range []struct {
+		name  string
+		codec address.Codec
+	}{
+		{"plain", NewBech32Codec("cosmos")},
+		{"cached", NewCachedBech32Codec("cosmos", DefaultCacheSize)},
+		{"cached/small", NewCachedBech32Codec("cosmos", 100)},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					i = (i + 7) % len(addrs)
+					if _, err := bc.codec.StringToBytes(strs[i]); err != nil {
+						b.Fatal(err)
+					}
+					if _, err := bc.codec.BytesToString(addrs[(i+1)%len(addrs)]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
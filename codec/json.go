@@ -2,10 +2,14 @@ package codec
 
 import (
 	"bytes"
+	"reflect"
+	"sync"
 
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/cosmos/gogoproto/proto"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -13,6 +17,9 @@ var defaultJM = &jsonpb.Marshaler{OrigName: true, EmitDefaults: true, AnyResolve
 
 // ProtoMarshalJSON provides an auxiliary function to return Proto3 JSON encoded
 // bytes of a message.
+//
+// Unset LegacyDec values of msg are set to zero before msg is encoded, see
+// NormalizeJSONValues.
 func ProtoMarshalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, error) {
 	// We use the OrigName because camel casing fields just doesn't make sense.
 	// EmitDefaults is also often the more expected behavior for CLI users
@@ -20,6 +27,7 @@ func ProtoMarshalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, e
 	if resolver != nil {
 		jm = &jsonpb.Marshaler{OrigName: true, EmitDefaults: true, AnyResolver: resolver}
 	}
+	NormalizeJSONValues(msg)
 	err := types.UnpackInterfaces(msg, types.ProtoJSONPacker{JSONPBMarshaler: jm})
 	if err != nil {
 		return nil, err
@@ -32,3 +40,87 @@ func ProtoMarshalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, e
 
 	return buf.Bytes(), nil
 }
+
+var (
+	decType = reflect.TypeOf(math.LegacyDec{})
+	anyType = reflect.TypeOf(types.Any{})
+
+	// holdsDecCache caches whether the values of a type may hold a LegacyDec.
+	holdsDecCache sync.Map
+)
+
+// NormalizeJSONValues sets the unset LegacyDec values held by msg, including
+// those held by the cached values of its Anys, to zero. An unset LegacyDec has
+// the binary encoding of zero but is JSON encoded as "0" instead of
+// "0.000000000000000000", which would make the JSON encoding of msg change
+// after a round trip.
+func NormalizeJSONValues(msg proto.Message) {
+	normalizeJSONValue(reflect.ValueOf(msg))
+}
+
+func normalizeJSONValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			normalizeJSONValue(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		if !holdsDec(v.Type().Elem()) {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			normalizeJSONValue(v.Index(i))
+		}
+	case reflect.Struct:
+		switch v.Type() {
+		case decType:
+			if v.CanSet() && v.Interface().(math.LegacyDec).IsNil() {
+				v.Set(reflect.ValueOf(math.LegacyZeroDec()))
+			}
+		case anyType:
+			if v.CanAddr() {
+				if cached := v.Addr().Interface().(*types.Any).GetCachedValue(); cached != nil {
+					normalizeJSONValue(reflect.ValueOf(cached))
+				}
+			}
+		default:
+			for i := 0; i < v.NumField(); i++ {
+				if field := v.Type().Field(i); field.IsExported() && holdsDec(field.Type) {
+					normalizeJSONValue(v.Field(i))
+				}
+			}
+		}
+	}
+}
+
+// holdsDec reports whether values of type t may hold a LegacyDec.
+func holdsDec(t reflect.Type) bool {
+	if holds, ok := holdsDecCache.Load(t); ok {
+		return holds.(bool)
+	}
+
+	// recursive types are assumed to hold a LegacyDec while being inspected
+	holdsDecCache.Store(t, true)
+
+	var holds bool
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		holds = holdsDec(t.Elem())
+	case reflect.Interface:
+		holds = true
+	case reflect.Struct:
+		if t == decType || t == anyType {
+			holds = true
+			break
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if field := t.Field(i); field.IsExported() && holdsDec(field.Type) {
+				holds = true
+				break
+			}
+		}
+	}
+
+	holdsDecCache.Store(t, holds)
+	return holds
+}
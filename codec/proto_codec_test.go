@@ -116,6 +116,26 @@ func TestProtoCodecMarshal(t *testing.T) {
 	require.Empty(t, bz)
 }
 
+func TestProtoCodecMarshalJSONUnsetDec(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	bz, err := cdc.MarshalJSON(&sdk.DecProto{})
+	require.NoError(t, err)
+	require.Equal(t, `{"dec":"0.000000000000000000"}`, string(bz))
+
+	var dec sdk.DecProto
+	require.NoError(t, cdc.UnmarshalJSON(bz, &dec))
+	require.True(t, dec.Dec.IsZero())
+
+	reencoded, err := cdc.MarshalJSON(&dec)
+	require.NoError(t, err)
+	require.Equal(t, string(bz), string(reencoded))
+
+	bz, err = cdc.MarshalJSON(&sdk.DecCoin{Denom: "stake"})
+	require.NoError(t, err)
+	require.Equal(t, `{"denom":"stake","amount":"0.000000000000000000"}`, string(bz))
+}
+
 // Emulate grpc server implementation
 // https://github.com/grpc/grpc-go/blob/b1d7f56b81b7902d871111b82dec6ba45f854ede/rpc_util.go#L590
 func grpcServerEncode(c encoding.Codec, msg interface{}) ([]byte, error) {
@@ -0,0 +1,133 @@
+package unknownproto
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// UnknownField describes a field of a protobuf serialized byte sequence which
+// is unknown to the proto.Message it is checked against.
+type UnknownField struct {
+	// Message is the full name of the message containing the field.
+	Message  string           `json:"message" yaml:"message"`
+	TagNum   protowire.Number `json:"tag_num" yaml:"tag_num"`
+	WireType protowire.Type   `json:"wire_type" yaml:"wire_type"`
+}
+
+// IsCritical returns whether the field is critical, i.e. whether bit 11 of
+// its tag number is not set.
+func (f UnknownField) IsCritical() bool {
+	return f.TagNum&bit11NonCritical == 0
+}
+
+// String implements fmt.Stringer.
+func (f UnknownField) String() string {
+	return fmt.Sprintf("%s: {TagNum: %d, WireType: %q}", f.Message, f.TagNum, wireTypeToString(f.WireType))
+}
+
+// FindUnknownFields returns the unknown fields, critical or not, of the bytes
+// bz for the provided proto.Message type. Like RejectUnknownFields, it
+// traverses inside of messages nested via google.protobuf.Any using the given
+// AnyResolver, and errors on mismatched wire types, but it never rejects bz
+// because of unknown fields.
+//
+// It is meant for tooling decoding messages produced by other versions of the
+// protobuf definitions, and must not be used in place of RejectUnknownFields
+// for security.
+func FindUnknownFields(bz []byte, msg proto.Message, resolver jsonpb.AnyResolver) ([]UnknownField, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	desc, ok := msg.(descriptorIface)
+	if !ok {
+		return nil, fmt.Errorf("%T does not have a Descriptor() method", msg)
+	}
+
+	fieldDescProtoFromTagNum, _, err := getDescriptorInfo(desc, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var unknownFields []UnknownField
+	for len(bz) > 0 {
+		tagNum, wireType, m := protowire.ConsumeTag(bz)
+		if m < 0 {
+			return nil, errors.New("invalid length")
+		}
+
+		fieldDescProto, ok := fieldDescProtoFromTagNum[int32(tagNum)]
+		if !ok {
+			unknownFields = append(unknownFields, UnknownField{
+				Message:  proto.MessageName(msg),
+				TagNum:   tagNum,
+				WireType: wireType,
+			})
+		} else if !canEncodeType(wireType, fieldDescProto.GetType()) {
+			return nil, &errMismatchedWireType{
+				Type:         reflect.ValueOf(msg).Type().String(),
+				TagNum:       tagNum,
+				GotWireType:  wireType,
+				WantWireType: protowire.Type(fieldDescProto.WireType()),
+			}
+		}
+
+		// Skip over the bytes that store fieldNumber and wireType bytes.
+		bz = bz[m:]
+		n := protowire.ConsumeFieldValue(tagNum, wireType, bz)
+		if n < 0 {
+			return nil, fmt.Errorf("could not consume field value for tagNum: %d, wireType: %q; %w",
+				tagNum, wireTypeToString(wireType), protowire.ParseError(n))
+		}
+		fieldBytes := bz[:n]
+		bz = bz[n:]
+
+		// Only the known fields of message types are traversed.
+		if fieldDescProto == nil || fieldDescProto.IsScalar() || fieldDescProto.GetTypeName() == "" {
+			continue
+		}
+
+		// consume length prefix of nested message
+		_, o := protowire.ConsumeVarint(fieldBytes)
+		fieldBytes = fieldBytes[o:]
+
+		var nested proto.Message
+		if protoMessageName := fieldDescProto.GetTypeName(); protoMessageName == ".google.protobuf.Any" {
+			anyUnknownFields, err := FindUnknownFields(fieldBytes, (*types.Any)(nil), resolver)
+			if err != nil {
+				return nil, err
+			}
+			unknownFields = append(unknownFields, anyUnknownFields...)
+
+			any := new(types.Any)
+			if err := proto.Unmarshal(fieldBytes, any); err != nil {
+				return nil, err
+			}
+			fieldBytes = any.Value
+			nested, err = resolver.Resolve(any.TypeUrl)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			nested, err = protoMessageForTypeName(protoMessageName[1:])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		nestedUnknownFields, err := FindUnknownFields(fieldBytes, nested, resolver)
+		if err != nil {
+			return nil, err
+		}
+		unknownFields = append(unknownFields, nestedUnknownFields...)
+	}
+
+	return unknownFields, nil
+}
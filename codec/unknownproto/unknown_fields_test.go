@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -671,3 +672,40 @@ func mustMarshal(msg proto.Message) []byte {
 	}
 	return blob
 }
+
+func TestFindUnknownFields(t *testing.T) {
+	tests := []struct {
+		name string
+		in   proto.Message
+		want []UnknownField
+	}{
+		{
+			name: "no unknown fields",
+			in:   &testdata.Customer2{Id: 289},
+		},
+		{
+			name: "critical and non-critical unknown fields",
+			in: &testdata.Customer2{
+				Id:       289,
+				City:     testdata.Customer2_PaloAlto,
+				Reserved: 99,
+			},
+			want: []UnknownField{
+				{Message: "testpb.Customer1", TagNum: 6, WireType: protowire.VarintType},
+				{Message: "testpb.Customer1", TagNum: 1047, WireType: protowire.VarintType},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := proto.Marshal(tt.in)
+			require.NoError(t, err)
+
+			got, err := FindUnknownFields(blob, new(testdata.Customer1), DefaultAnyResolver{})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
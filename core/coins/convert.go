@@ -0,0 +1,160 @@
+package coins
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+)
+
+var (
+	// amountRegex matches a non-negative decimal amount without sign or
+	// exponent, e.g. 12 or 0.000001.
+	amountRegex = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
+	// decCoinRegex is like coinRegex, but also allows whitespace between the
+	// amount and the denom, e.g. "1.5 ATOM".
+	decCoinRegex = regexp.MustCompile(`^(\d+(\.\d+)?)\s*([a-zA-Z][a-zA-Z0-9\/\:\._\-]{2,127})$`)
+)
+
+// DenomExponent returns the exponent of the given denom unit in the metadata,
+// and whether it was found.
+func DenomExponent(metadata *bankv1beta1.Metadata, denom string) (uint32, bool) {
+	if metadata == nil {
+		return 0, false
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit != nil && unit.Denom == denom {
+			return unit.Exponent, true
+		}
+	}
+
+	return 0, false
+}
+
+// ConvertAmount converts a decimal amount expressed in a denom unit of
+// exponent fromExp into the denom unit of exponent toExp, by moving the
+// decimal point. The conversion is exact: no rounding is ever applied. The
+// result has no leading zeros in its integer part, no trailing zeros in its
+// fractional part, and no decimal point if it is a whole number.
+func ConvertAmount(amount string, fromExp, toExp uint32) (string, error) {
+	if !amountRegex.MatchString(amount) {
+		return "", fmt.Errorf("invalid amount %q", amount)
+	}
+
+	intPart, fracPart, _ := strings.Cut(amount, ".")
+	digits := intPart + fracPart
+	// point is the position of the decimal point in digits.
+	point := len(intPart) + int(fromExp) - int(toExp)
+
+	if point < 0 {
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	}
+	if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	intPart = strings.TrimLeft(digits[:point], "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	fracPart = strings.TrimRight(digits[point:], "0")
+	if fracPart == "" {
+		return intPart, nil
+	}
+
+	return intPart + "." + fracPart, nil
+}
+
+// validateMetadata checks that the metadata is usable to convert between its
+// denom units: the base and display denoms must be set and be denom units,
+// the base denom must have exponent 0, and denom units must be unique.
+func validateMetadata(metadata *bankv1beta1.Metadata) error {
+	if metadata == nil {
+		return fmt.Errorf("missing denom metadata")
+	}
+
+	seen := make(map[string]bool, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		if unit == nil || unit.Denom == "" {
+			return fmt.Errorf("empty denom unit in metadata of %s", metadata.Base)
+		}
+		if seen[unit.Denom] {
+			return fmt.Errorf("duplicate denom unit %s in metadata of %s", unit.Denom, metadata.Base)
+		}
+		seen[unit.Denom] = true
+	}
+
+	baseExp, ok := DenomExponent(metadata, metadata.Base)
+	if !ok {
+		return fmt.Errorf("base denom %q is not a denom unit of its metadata", metadata.Base)
+	}
+	if baseExp != 0 {
+		return fmt.Errorf("base denom %s must have exponent 0, got %d", metadata.Base, baseExp)
+	}
+	if _, ok := DenomExponent(metadata, metadata.Display); !ok {
+		return fmt.Errorf("display denom %q is not a denom unit of the metadata of %s", metadata.Display, metadata.Base)
+	}
+
+	return nil
+}
+
+// ToDisplayUnits converts a coin in any denom unit of the metadata into an
+// exact decimal amount of its display denom. It returns the amount and the
+// display denom.
+func ToDisplayUnits(coin *basev1beta1.Coin, metadata *bankv1beta1.Metadata) (string, string, error) {
+	if err := validateMetadata(metadata); err != nil {
+		return "", "", err
+	}
+
+	coinExp, ok := DenomExponent(metadata, coin.Denom)
+	if !ok {
+		return "", "", fmt.Errorf("denom %s is not a denom unit of the metadata of %s", coin.Denom, metadata.Base)
+	}
+	dispExp, _ := DenomExponent(metadata, metadata.Display)
+
+	amount, err := ConvertAmount(coin.Amount, coinExp, dispExp)
+	if err != nil {
+		return "", "", err
+	}
+
+	return amount, metadata.Display, nil
+}
+
+// ParseToBaseUnits parses a decimal coin string, e.g. "1.5ATOM" or "1.5 ATOM",
+// whose denom is any denom unit of the metadata, into a coin of the base
+// denom. It errors if the amount can't be represented in base units without
+// losing precision.
+func ParseToBaseUnits(input string, metadata *bankv1beta1.Metadata) (*basev1beta1.Coin, error) {
+	if err := validateMetadata(metadata); err != nil {
+		return nil, err
+	}
+
+	matches := decCoinRegex.FindStringSubmatch(strings.TrimSpace(input))
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("invalid decimal coin %q", input)
+	}
+
+	amount, denom := matches[1], matches[3]
+	coinExp, ok := DenomExponent(metadata, denom)
+	if !ok {
+		return nil, fmt.Errorf("denom %s is not a denom unit of the metadata of %s", denom, metadata.Base)
+	}
+
+	baseAmount, err := ConvertAmount(amount, coinExp, 0)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(baseAmount, ".") {
+		return nil, fmt.Errorf("%s can't be represented in %s without losing precision", input, metadata.Base)
+	}
+
+	return &basev1beta1.Coin{
+		Amount: baseAmount,
+		Denom:  metadata.Base,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package coins_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/coins"
+)
+
+func TestConvertAmount(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		fromExp  uint32
+		toExp    uint32
+		expected string
+		expErr   bool
+	}{
+		{"1234567", 0, 0, "1234567", false},
+		{"1234567", 0, 6, "1.234567", false},
+		{"1", 0, 6, "0.000001", false},
+		{"1000000", 0, 6, "1", false},
+		{"0", 0, 18, "0", false},
+		{"1", 0, 18, "0.000000000000000001", false},
+		{"1.5", 6, 0, "1500000", false},
+		{"0.0000015", 6, 0, "1.5", false},
+		{"001.2300", 0, 0, "1.23", false},
+		{"1", 18, 0, "1000000000000000000", false},
+		{"", 0, 6, "", true},
+		{"-1", 0, 6, "", true},
+		{"1.", 0, 6, "", true},
+		{"1e6", 0, 6, "", true},
+	}
+
+	for _, tc := range testCases {
+		res, err := coins.ConvertAmount(tc.amount, tc.fromExp, tc.toExp)
+		if tc.expErr {
+			require.Error(t, err, tc.amount)
+			continue
+		}
+
+		require.NoError(t, err, tc.amount)
+		require.Equal(t, tc.expected, res, tc.amount)
+	}
+}
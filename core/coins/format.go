@@ -33,18 +33,8 @@ func formatCoin(coin *basev1beta1.Coin, metadata *bankv1beta1.Metadata) (string,
 	dispDenom := metadata.Display
 
 	// Find exponents of both denoms.
-	var coinExp, dispExp uint32
-	foundCoinExp, foundDispExp := false, false
-	for _, unit := range metadata.DenomUnits {
-		if coinDenom == unit.Denom {
-			coinExp = unit.Exponent
-			foundCoinExp = true
-		}
-		if dispDenom == unit.Denom {
-			dispExp = unit.Exponent
-			foundDispExp = true
-		}
-	}
+	coinExp, foundCoinExp := DenomExponent(metadata, coinDenom)
+	dispExp, foundDispExp := DenomExponent(metadata, dispDenom)
 
 	// If we didn't find either exponent, then we return early.
 	if !foundCoinExp || !foundDispExp {
@@ -52,18 +42,12 @@ func formatCoin(coin *basev1beta1.Coin, metadata *bankv1beta1.Metadata) (string,
 		return vr + " " + coin.Denom, err
 	}
 
-	dispAmount, err := math.LegacyNewDecFromStr(coin.Amount)
+	dispAmount, err := ConvertAmount(coin.Amount, coinExp, dispExp)
 	if err != nil {
 		return "", err
 	}
 
-	if coinExp > dispExp {
-		dispAmount = dispAmount.Mul(math.LegacyNewDec(10).Power(uint64(coinExp - dispExp)))
-	} else {
-		dispAmount = dispAmount.Quo(math.LegacyNewDec(10).Power(uint64(dispExp - coinExp)))
-	}
-
-	vr, err := math.FormatDec(dispAmount.String())
+	vr, err := math.FormatDec(dispAmount)
 	return vr + " " + dispDenom, err
 }
 
@@ -27,6 +27,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
 	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestArmorUnarmorPrivKey(t *testing.T) {
@@ -75,6 +76,21 @@ func TestArmorUnarmorPrivKey(t *testing.T) {
 	require.Equal(t, "unrecognized KDF type: wrong", err.Error())
 }
 
+func TestArmorUnarmorKeyBundle(t *testing.T) {
+	bundle := []byte(`{"version":1,"keys":[]}`)
+	armored := crypto.EncryptArmorKeyBundle(bundle, "passphrase")
+	_, err := crypto.UnarmorDecryptKeyBundle(armored, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+	decrypted, err := crypto.UnarmorDecryptKeyBundle(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, bundle, decrypted)
+
+	// wrong armor type
+	armored = crypto.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", "")
+	_, err = crypto.UnarmorDecryptKeyBundle(armored, "passphrase")
+	require.ErrorContains(t, err, "unrecognized armor type")
+}
+
 func TestArmorUnarmorPubKey(t *testing.T) {
 	// Select the encryption and storage for your cryptostore
 	var cdc codec.Codec
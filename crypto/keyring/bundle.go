@@ -0,0 +1,182 @@
+package keyring
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// KeyBundleVersion is the version of the key bundles exported by
+// ExportKeyBundle. Bundles of a later version cannot be imported.
+const KeyBundleVersion = 1
+
+// keyBundle is the JSON document encrypted in a key bundle.
+type keyBundle struct {
+	Version uint32       `json:"version"`
+	Keys    []bundledKey `json:"keys"`
+}
+
+// bundledKey is a key record of a key bundle. The private keys of local
+// records are armored and encrypted with the passphrase of the bundle.
+type bundledKey struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	PubKeyType string `json:"pubkey_type"`
+	PubKey     string `json:"pubkey"`
+	HDPath     string `json:"hd_path,omitempty"`
+	PrivKey    string `json:"privkey,omitempty"`
+}
+
+// ExportKeyBundle exports all the keys of the keyring in a single ASCII
+// armored bundle encrypted with encryptPassphrase.
+func (ks keystore) ExportKeyBundle(encryptPassphrase string) (string, error) {
+	records, err := ks.List()
+	if err != nil {
+		return "", err
+	}
+
+	bundle := keyBundle{Version: KeyBundleVersion, Keys: make([]bundledKey, 0, len(records))}
+	for _, k := range records {
+		key, err := ks.bundleKey(k, encryptPassphrase)
+		if err != nil {
+			return "", errorsmod.Wrapf(err, "failed to export key %s", k.Name)
+		}
+		bundle.Keys = append(bundle.Keys, key)
+	}
+
+	bz, err := json.Marshal(bundle)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.EncryptArmorKeyBundle(bz, encryptPassphrase), nil
+}
+
+func (ks keystore) bundleKey(k *Record, encryptPassphrase string) (bundledKey, error) {
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return bundledKey{}, err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(pubKey)
+	if err != nil {
+		return bundledKey{}, err
+	}
+
+	key := bundledKey{
+		Name:       k.Name,
+		Type:       k.GetType().String(),
+		PubKeyType: pubKey.Type(),
+		PubKey:     crypto.ArmorPubKeyBytes(bz, pubKey.Type()),
+		HDPath:     k.GetHDPath(),
+	}
+
+	if k.GetLocal() != nil {
+		priv, err := extractPrivKeyFromRecord(k)
+		if err != nil {
+			return bundledKey{}, err
+		}
+		key.PrivKey = crypto.EncryptArmorPrivKey(priv, encryptPassphrase, priv.Type())
+	}
+
+	return key, nil
+}
+
+// ImportKeyBundle imports the keys of a bundle exported by ExportKeyBundle.
+// Keys whose name already exists in the keyring are skipped, unless
+// overwrite is true. It returns the names of the imported and skipped keys.
+// No key is imported if any key of the bundle cannot be decoded.
+func (ks keystore) ImportKeyBundle(armor, passphrase string, overwrite bool) (imported, skipped []string, err error) {
+	bz, err := crypto.UnarmorDecryptKeyBundle(armor, passphrase)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to decrypt key bundle")
+	}
+
+	var bundle keyBundle
+	if err := json.Unmarshal(bz, &bundle); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to decode key bundle")
+	}
+	if bundle.Version == 0 || bundle.Version > KeyBundleVersion {
+		return nil, nil, fmt.Errorf("unsupported key bundle version %d, expected at most %d", bundle.Version, KeyBundleVersion)
+	}
+
+	records := make([]*Record, 0, len(bundle.Keys))
+	for _, key := range bundle.Keys {
+		k, err := ks.unbundleKey(key, passphrase)
+		if err != nil {
+			return nil, nil, errorsmod.Wrapf(err, "failed to import key %s", key.Name)
+		}
+		records = append(records, k)
+	}
+
+	for _, k := range records {
+		if _, err := ks.Key(k.Name); err == nil {
+			if !overwrite {
+				skipped = append(skipped, k.Name)
+				continue
+			}
+			if err := ks.Delete(k.Name); err != nil {
+				return imported, skipped, err
+			}
+		}
+
+		if err := ks.writeRecord(k); err != nil {
+			return imported, skipped, errorsmod.Wrapf(err, "failed to import key %s", k.Name)
+		}
+		imported = append(imported, k.Name)
+	}
+
+	return imported, skipped, nil
+}
+
+func (ks keystore) unbundleKey(key bundledKey, passphrase string) (*Record, error) {
+	pubBytes, _, err := crypto.UnarmorPubKeyBytes(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey types.PubKey
+	if err := ks.cdc.UnmarshalInterface(pubBytes, &pubKey); err != nil {
+		return nil, err
+	}
+
+	switch key.Type {
+	case TypeLocal.String():
+		privKey, _, err := crypto.UnarmorDecryptPrivKey(key.PrivKey, passphrase)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to decrypt private key")
+		}
+		if !privKey.PubKey().Equals(pubKey) {
+			return nil, fmt.Errorf("private key does not match public key %s", pubKey)
+		}
+
+		k, err := NewLocalRecord(key.Name, privKey, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		k.GetLocal().HdPath = key.HDPath
+
+		return k, nil
+	case TypeLedger.String():
+		var path *hd.BIP44Params
+		if key.HDPath != "" {
+			path, err = hd.NewParamsFromPath(key.HDPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return NewLedgerRecord(key.Name, pubKey, path)
+	case TypeOffline.String():
+		return NewOfflineRecord(key.Name, pubKey)
+	case TypeMulti.String():
+		return NewMultiRecord(key.Name, pubKey)
+	default:
+		return nil, fmt.Errorf("unknown key type %q", key.Type)
+	}
+}
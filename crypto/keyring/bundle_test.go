@@ -0,0 +1,136 @@
+package keyring
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const bundlePassphrase = "bundle passphrase"
+
+func newBundleTestKeyring(t *testing.T, backend string) keystore {
+	t.Helper()
+	kr, err := New(t.Name(), backend, t.TempDir(), strings.NewReader(strings.Repeat("keyring passphrase\n", 4)), getCodec())
+	require.NoError(t, err)
+	return kr.(keystore)
+}
+
+func TestExportImportKeyBundle(t *testing.T) {
+	backends := []struct {
+		source, target string
+	}{
+		{BackendTest, BackendFile},
+		{BackendFile, BackendTest},
+	}
+
+	for _, tc := range backends {
+		t.Run(tc.source+" to "+tc.target, func(t *testing.T) {
+			source := newBundleTestKeyring(t, tc.source)
+
+			secp, _, err := source.NewMnemonic("secp", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+			require.NoError(t, err)
+			_, err = source.writeLocalKey("ed", ed25519.GenPrivKey())
+			require.NoError(t, err)
+			secpPub, err := secp.GetPubKey()
+			require.NoError(t, err)
+			multi := multisig.NewLegacyAminoPubKey(2, []types.PubKey{secpPub, secp256k1.GenPrivKey().PubKey()})
+			_, err = source.SaveMultisig("multi", multi)
+			require.NoError(t, err)
+			_, err = source.writeLedgerKey("ledger", secp256k1.GenPrivKey().PubKey(), hd.NewFundraiserParams(1, sdk.CoinType, 2))
+			require.NoError(t, err)
+
+			bundle, err := source.ExportKeyBundle(bundlePassphrase)
+			require.NoError(t, err)
+
+			target := newBundleTestKeyring(t, tc.target)
+			imported, skipped, err := target.ImportKeyBundle(bundle, bundlePassphrase, false)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"secp", "ed", "multi", "ledger"}, imported)
+			require.Empty(t, skipped)
+
+			sourceRecords, err := source.List()
+			require.NoError(t, err)
+			for _, expected := range sourceRecords {
+				k, err := target.Key(expected.Name)
+				require.NoError(t, err)
+				require.Equal(t, expected.GetType(), k.GetType())
+				require.Equal(t, expected.PubKey, k.PubKey)
+				require.Equal(t, expected.GetHDPath(), k.GetHDPath())
+
+				switch expected.GetType() {
+				case TypeLocal:
+					expectedPriv, err := source.ExportPrivateKeyObject(expected.Name)
+					require.NoError(t, err)
+					priv, err := target.ExportPrivateKeyObject(k.Name)
+					require.NoError(t, err)
+					require.True(t, expectedPriv.Equals(priv))
+				case TypeLedger:
+					require.Equal(t, expected.GetLedger().GetPath(), k.GetLedger().GetPath())
+				}
+			}
+		})
+	}
+}
+
+func TestImportKeyBundleExistingKeys(t *testing.T) {
+	source := newBundleTestKeyring(t, BackendTest)
+	k1, _, err := source.NewMnemonic("k1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = source.NewMnemonic("k2", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	bundle, err := source.ExportKeyBundle(bundlePassphrase)
+	require.NoError(t, err)
+
+	target := newBundleTestKeyring(t, BackendTest)
+	existing, _, err := target.NewMnemonic("k1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	imported, skipped, err := target.ImportKeyBundle(bundle, bundlePassphrase, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"k2"}, imported)
+	require.Equal(t, []string{"k1"}, skipped)
+	k, err := target.Key("k1")
+	require.NoError(t, err)
+	require.Equal(t, existing.PubKey, k.PubKey)
+
+	imported, skipped, err = target.ImportKeyBundle(bundle, bundlePassphrase, true)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"k1", "k2"}, imported)
+	require.Empty(t, skipped)
+	k, err = target.Key("k1")
+	require.NoError(t, err)
+	require.Equal(t, k1.PubKey, k.PubKey)
+}
+
+func TestImportKeyBundleErrors(t *testing.T) {
+	source := newBundleTestKeyring(t, BackendTest)
+	_, _, err := source.NewMnemonic("k1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	bundle, err := source.ExportKeyBundle(bundlePassphrase)
+	require.NoError(t, err)
+
+	target := newBundleTestKeyring(t, BackendTest)
+	_, _, err = target.ImportKeyBundle(bundle, "wrong passphrase", false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	bz, err := json.Marshal(keyBundle{Version: KeyBundleVersion + 1})
+	require.NoError(t, err)
+	_, _, err = target.ImportKeyBundle(crypto.EncryptArmorKeyBundle(bz, bundlePassphrase), bundlePassphrase, false)
+	require.ErrorContains(t, err, "unsupported key bundle version 2")
+
+	records, err := target.List()
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
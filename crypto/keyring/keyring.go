@@ -83,6 +83,11 @@ type Keyring interface {
 	// A passphrase set to the empty string will set the passphrase to the DefaultBIP39Passphrase value.
 	NewMnemonic(uid string, language Language, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error)
 
+	// NewMnemonicFromEntropy is like NewMnemonic, but generates the mnemonic from the given
+	// entropy instead of the system entropy. The entropy must be 128 to 256 bits long, in a
+	// multiple of 32 bits.
+	NewMnemonicFromEntropy(uid string, language Language, entropy []byte, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error)
+
 	// NewAccount converts a mnemonic to a private key and BIP-39 HD Path and persists it.
 	// It fails if there is an existing key Info with the same address.
 	NewAccount(uid, mnemonic, bip39Passphrase, hdPath string, algo SignatureAlgo) (*Record, error)
@@ -120,6 +125,10 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid, armor string) error
+
+	// ImportKeyBundle imports the keys of an ASCII armored passphrase-encrypted
+	// bundle, skipping the keys whose name already exists unless overwrite is true.
+	ImportKeyBundle(armor, passphrase string, overwrite bool) (imported, skipped []string, err error)
 }
 
 // Migrator is implemented by key stores and enables migration of keys from amino to proto
@@ -137,6 +146,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+
+	// ExportKeyBundle returns all the keys of the key store in a single ASCII
+	// armored bundle encrypted with encryptPassphrase.
+	ExportKeyBundle(encryptPassphrase string) (armor string, err error)
 }
 
 // Option overrides keyring configuration options.
@@ -521,6 +534,17 @@ func (ks keystore) List() ([]*Record, error) {
 }
 
 func (ks keystore) NewMnemonic(uid string, language Language, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error) {
+	// Default number of words (24): This generates a mnemonic directly from the
+	// number of words by reading system entropy.
+	entropy, err := bip39.NewEntropy(defaultEntropySize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return ks.NewMnemonicFromEntropy(uid, language, entropy, hdPath, bip39Passphrase, algo)
+}
+
+func (ks keystore) NewMnemonicFromEntropy(uid string, language Language, entropy []byte, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error) {
 	if language != English {
 		return nil, "", ErrUnsupportedLanguage
 	}
@@ -529,13 +553,6 @@ func (ks keystore) NewMnemonic(uid string, language Language, hdPath, bip39Passp
 		return nil, "", ErrUnsupportedSigningAlgo
 	}
 
-	// Default number of words (24): This generates a mnemonic directly from the
-	// number of words by reading system entropy.
-	entropy, err := bip39.NewEntropy(defaultEntropySize)
-	if err != nil {
-		return nil, "", err
-	}
-
 	mnemonic, err := bip39.NewMnemonic(entropy)
 	if err != nil {
 		return nil, "", err
@@ -573,7 +590,13 @@ func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, al
 		return nil, ErrDuplicatedAddress
 	}
 
-	return ks.writeLocalKey(name, privKey)
+	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
+	if err != nil {
+		return nil, err
+	}
+	k.GetLocal().HdPath = hdPath
+
+	return k, ks.writeRecord(k)
 }
 
 func (ks keystore) isSupportedSigningAlgo(algo SignatureAlgo) bool {
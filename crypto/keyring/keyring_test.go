@@ -13,6 +13,7 @@ import (
 
 	"github.com/99designs/keyring"
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/go-bip39"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
@@ -156,6 +157,33 @@ func TestNewMnemonic(t *testing.T) {
 	}
 }
 
+func TestNewMnemonicFromEntropy(t *testing.T) {
+	kr, err := New("cosmos", BackendMemory, t.TempDir(), nil, getCodec())
+	require.NoError(t, err)
+
+	// BIP39 test vector: the all zero 128 bits entropy with the "TREZOR" passphrase
+	k, mnemonic, err := kr.NewMnemonicFromEntropy("foo", English, make([]byte, 16), sdk.FullFundraiserPath, "TREZOR", hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", mnemonic)
+	require.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		hex.EncodeToString(bip39.NewSeed(mnemonic, "TREZOR")))
+
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, "cosmos12fdxecq3dp28aaswp2n3yk35p782g3w9dz32m6", addr.String())
+	require.Equal(t, sdk.FullFundraiserPath, k.GetHDPath())
+
+	// the passphrase changes the derived key
+	k, err = kr.NewAccount("bar", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err = k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4", addr.String())
+
+	_, _, err = kr.NewMnemonicFromEntropy("baz", English, make([]byte, 15), sdk.FullFundraiserPath, "", hd.Secp256k1)
+	require.Error(t, err)
+}
+
 func TestKeyringDirectory(t *testing.T) {
 	dir := t.TempDir()
 	kb, err := New("keybasename", "test", dir, nil, getCodec())
@@ -36,7 +36,7 @@ func NewLocalRecord(name string, priv cryptotypes.PrivKey, pk cryptotypes.PubKey
 		return nil, err
 	}
 
-	recordLocal := &Record_Local{any}
+	recordLocal := &Record_Local{PrivKey: any}
 	recordLocalItem := &Record_Local_{recordLocal}
 
 	return newRecord(name, pk, recordLocalItem)
@@ -102,6 +102,19 @@ func (k Record) GetType() KeyType {
 	}
 }
 
+// GetHDPath returns the BIP-32 derivation path of the key of the record, or
+// an empty string if it is unknown.
+func (k Record) GetHDPath() string {
+	switch {
+	case k.GetLocal() != nil:
+		return k.GetLocal().HdPath
+	case k.GetLedger() != nil && k.GetLedger().GetPath() != nil:
+		return k.GetLedger().GetPath().String()
+	default:
+		return ""
+	}
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (k *Record) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var pk cryptotypes.PubKey
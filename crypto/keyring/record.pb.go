@@ -147,6 +147,9 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 // Local item
 type Record_Local struct {
 	PrivKey *types.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+	// hd_path is the BIP-32 derivation path the private key was derived from
+	// the mnemonic with, if known.
+	HdPath string `protobuf:"bytes,2,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
 }
 
 func (m *Record_Local) Reset()         { *m = Record_Local{} }
@@ -307,33 +310,34 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0x4d, 0x13, 0x3b, 0xde, 0x86, 0x05, 0x63, 0x90, 0xa1, 0x08, 0x6a, 0x41, 0x76,
+	0x86, 0xd5, 0x9e, 0x17, 0xb6, 0x78, 0xa8, 0xe8, 0x62, 0x9d, 0xa3, 0x97, 0x25, 0x2f, 0xd3, 0x4c,
+	0x68, 0x92, 0x09, 0xd3, 0xa4, 0x90, 0xb3, 0x5f, 0xc0, 0xa3, 0x1f, 0x69, 0x8f, 0x7b, 0xf4, 0xa8,
+	0xcd, 0x17, 0x91, 0x79, 0x26, 0x3d, 0xb8, 0xa0, 0xf5, 0x94, 0x09, 0xf3, 0x7b, 0xfe, 0x2f, 0x0f,
+	0x83, 0x5e, 0xa4, 0x6a, 0x57, 0xa9, 0x1d, 0x4b, 0x75, 0xdf, 0xb4, 0x8a, 0x6d, 0x45, 0xaf, 0x8b,
+	0x3a, 0x67, 0xfb, 0x0b, 0xa6, 0x45, 0xaa, 0x74, 0x46, 0x1b, 0xad, 0x5a, 0x85, 0x43, 0x8b, 0x51,
+	0x8b, 0xd1, 0x11, 0xa3, 0xfb, 0x8b, 0xe8, 0x2c, 0x57, 0xb9, 0x02, 0x88, 0x99, 0x93, 0xe5, 0xa3,
+	0xa7, 0xb9, 0x52, 0x79, 0x29, 0x18, 0xfc, 0x25, 0xdd, 0x86, 0xc5, 0x75, 0x3f, 0x5e, 0x3d, 0xfb,
+	0xd3, 0x51, 0x66, 0xc6, 0x4c, 0x8e, 0x46, 0xcf, 0xbf, 0x7a, 0xc8, 0xe7, 0xe0, 0x8c, 0x31, 0xf2,
+	0xea, 0xb8, 0x12, 0xa1, 0x3b, 0x73, 0xe7, 0x53, 0x0e, 0x67, 0x7c, 0x8e, 0x82, 0xa6, 0x4b, 0x6e,
+	0xb6, 0xa2, 0x0f, 0x1f, 0xcc, 0xdc, 0xf9, 0xe3, 0x37, 0x67, 0xd4, 0x3a, 0xd1, 0xa3, 0x13, 0xbd,
+	0xaa, 0x7b, 0xee, 0x37, 0x5d, 0xf2, 0x41, 0xf4, 0xf8, 0x12, 0x4d, 0x4a, 0x95, 0xc6, 0x65, 0xf8,
+	0x10, 0xe0, 0x97, 0xf4, 0x6f, 0x35, 0xa8, 0xf5, 0xa4, 0x1f, 0x0d, 0xbd, 0x72, 0xb8, 0x1d, 0xc3,
+	0x57, 0xc8, 0x2f, 0x45, 0x96, 0x0b, 0x1d, 0x7a, 0x20, 0xf0, 0xea, 0xb4, 0x00, 0xe0, 0x2b, 0x87,
+	0x8f, 0x83, 0x26, 0x42, 0xd5, 0x95, 0x6d, 0x11, 0x4e, 0xfe, 0x33, 0xc2, 0xb5, 0xa1, 0x4d, 0x04,
+	0x18, 0xc3, 0xef, 0x50, 0xa0, 0x36, 0x9b, 0xb2, 0xa8, 0x45, 0xe8, 0x83, 0xc2, 0xfc, 0xa4, 0xc2,
+	0x27, 0xcb, 0xaf, 0x1c, 0x7e, 0x1c, 0x8d, 0x3e, 0xa3, 0x09, 0x54, 0xc3, 0x0c, 0x3d, 0x6a, 0x74,
+	0xb1, 0x87, 0x0d, 0xba, 0xff, 0xd8, 0x60, 0x60, 0x28, 0xb3, 0xc2, 0x27, 0x28, 0x90, 0xd9, 0x4d,
+	0x13, 0xb7, 0x12, 0x36, 0x3e, 0xe5, 0xbe, 0xcc, 0xd6, 0x71, 0x2b, 0xa3, 0x4b, 0xe4, 0xdb, 0xb2,
+	0x78, 0x81, 0x3c, 0xb8, 0xb7, 0x7a, 0xb3, 0x7b, 0xf9, 0x64, 0x66, 0xa2, 0x2d, 0xdf, 0xaf, 0x17,
+	0x8b, 0x75, 0xac, 0xe3, 0x6a, 0xc7, 0x81, 0x8e, 0x02, 0x34, 0x81, 0xaa, 0xd1, 0x14, 0x05, 0x63,
+	0xe2, 0xa5, 0x8f, 0xbc, 0xa2, 0x15, 0xd5, 0xf2, 0xfa, 0xf6, 0x17, 0x71, 0x6e, 0x0f, 0xc4, 0xbd,
+	0x3b, 0x10, 0xf7, 0xe7, 0x81, 0xb8, 0xdf, 0x06, 0xe2, 0x7c, 0x1f, 0x88, 0x73, 0x37, 0x10, 0xe7,
+	0xc7, 0x40, 0x9c, 0x2f, 0xaf, 0xf3, 0xa2, 0x95, 0x5d, 0x42, 0x53, 0x55, 0xb1, 0xe3, 0x83, 0x82,
+	0xcf, 0xf9, 0x2e, 0xdb, 0xde, 0x7b, 0xcd, 0x89, 0x0f, 0xd5, 0xde, 0xfe, 0x1e, 0x00, 0x0c, 0x47,
+	0x27, 0x88, 0xed, 0x02, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -491,6 +495,13 @@ func (m *Record_Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HdPath) > 0 {
+		i -= len(m.HdPath)
+		copy(dAtA[i:], m.HdPath)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.HdPath)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PrivKey != nil {
 		{
 			size, err := m.PrivKey.MarshalToSizedBuffer(dAtA[:i])
@@ -676,6 +687,10 @@ func (m *Record_Local) Size() (n int) {
 		l = m.PrivKey.Size()
 		n += 1 + l + sovRecord(uint64(l))
 	}
+	l = len(m.HdPath)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -1039,6 +1054,38 @@ func (m *Record_Local) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HdPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HdPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -0,0 +1,221 @@
+// Package bls12_381 implements BLS12-381 public and private keys compatible
+// with the CometBFT ones: public keys are points of the G1 group and
+// signatures points of the G2 group of the curve.
+//
+// The keys can be protobuf serialized and packed in Any. They are not
+// supported by the keyring and signature verification with them is disabled
+// by default in the ante handler, see x/auth/ante.
+package bls12_381 //nolint:revive,stylecheck // the package name matches the proto package
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	bls "github.com/kilic/bls12-381"
+	"golang.org/x/crypto/hkdf"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// PubKeySize is the size, in bytes, of public keys, uncompressed G1 points.
+	PubKeySize = 96
+	// PrivKeySize is the size, in bytes, of private keys.
+	PrivKeySize = 32
+	// SignatureSize is the size, in bytes, of signatures, compressed G2 points.
+	SignatureSize = 96
+
+	keyType = "bls12_381"
+)
+
+// dst is the domain separation tag used by CometBFT to hash messages to G2.
+var dst = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+
+var (
+	_ cryptotypes.PrivKey = &PrivKey{}
+	_ cryptotypes.PubKey  = &PubKey{}
+)
+
+//-------------------------------------
+
+// Bytes returns the privkey byte format.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// Sign produces a signature on the provided message.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk, err := privKey.scalar()
+	if err != nil {
+		return nil, err
+	}
+
+	g2 := bls.NewG2()
+	h, err := g2.HashToCurve(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), h, sk)), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+//
+// Panics if the private key is invalid.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	sk, err := privKey.scalar()
+	if err != nil {
+		panic(err)
+	}
+
+	g1 := bls.NewG1()
+	return &PubKey{Key: g1.ToUncompressed(g1.MulScalarBig(g1.New(), g1.One(), sk))}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	if privKey.Type() != other.Type() {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// String returns the type of the private key, not its contents.
+func (privKey *PrivKey) String() string {
+	return "PrivKeyBls12_381{...}"
+}
+
+// scalar returns the private key as a scalar of the curve.
+func (privKey *PrivKey) scalar() (*big.Int, error) {
+	if len(privKey.Key) != PrivKeySize {
+		return nil, fmt.Errorf("invalid bls12_381 private key size %d, expected %d", len(privKey.Key), PrivKeySize)
+	}
+
+	sk := new(big.Int).SetBytes(privKey.Key)
+	if sk.Sign() == 0 || sk.Cmp(bls.NewG1().Q()) >= 0 {
+		return nil, errors.New("invalid bls12_381 private key")
+	}
+
+	return sk, nil
+}
+
+// GenPrivKey generates a new BLS12-381 private key. It uses OS randomness in
+// conjunction with the current global random seed in cometbft/libs/rand to
+// generate the private key.
+func GenPrivKey() *PrivKey {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) *PrivKey {
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		panic(err)
+	}
+
+	return &PrivKey{Key: keyGen(ikm)}
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses that 32 byte
+// output as the input keying material of the private key.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	return &PrivKey{Key: keyGen(crypto.Sha256(secret))}
+}
+
+// keyGen derives a private key from the input keying material ikm, of at least
+// 32 bytes, following the KeyGen procedure of the IRTF BLS signature draft, as
+// CometBFT does.
+func keyGen(ikm []byte) []byte {
+	const l = 48 // ceil((3 * ceil(log2(r))) / 16)
+	r := bls.NewG1().Q()
+
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+
+		okm := make([]byte, l)
+		kdf := hkdf.New(sha256.New, append(append([]byte{}, ikm...), 0), salt, []byte{0, l})
+		if _, err := io.ReadFull(kdf, okm); err != nil {
+			panic(err)
+		}
+		sk.Mod(new(big.Int).SetBytes(okm), r)
+	}
+
+	return sk.FillBytes(make([]byte, PrivKeySize))
+}
+
+//-------------------------------------
+
+// Address is the SHA256-20 of the raw pubkey bytes, as for CometBFT.
+// It doesn't implement ADR-28 addresses.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
+}
+
+// Bytes returns the PubKey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature verifies a signature of msg, checking that both the public
+// key and the signature are valid points of their group.
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	if len(pubKey.Key) != PubKeySize || len(sig) != SignatureSize {
+		return false
+	}
+
+	engine := bls.NewEngine()
+	pk, err := engine.G1.FromUncompressed(pubKey.Key)
+	if err != nil || engine.G1.IsZero(pk) {
+		return false
+	}
+
+	s, err := engine.G2.FromCompressed(sig)
+	if err != nil {
+		return false
+	}
+
+	h, err := engine.G2.HashToCurve(msg, dst)
+	if err != nil {
+		return false
+	}
+
+	// e(pk, H(msg)) == e(g1, sig)
+	return engine.AddPair(pk, h).AddPairInv(engine.G1.One(), s).Check()
+}
+
+// String returns Hex representation of a pubkey with it's type
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12_381{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	if pubKey.Type() != other.Type() {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(pubKey.Bytes(), other.Bytes()) == 1
+}
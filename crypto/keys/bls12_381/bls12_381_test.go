@@ -0,0 +1,137 @@
+package bls12_381 //nolint:revive,stylecheck // the package name matches the proto package
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// vectors were generated with the blst library, as used by CometBFT.
+var vectors = []struct {
+	secret  string
+	privKey string
+	pubKey  string
+	address string
+	sigs    map[string]string
+}{
+	{
+		secret:  "bls12_381 test vector 1",
+		privKey: "1fa01631d2c5818f4cdd220b1949e33afd04089cb33ecfef1530dd980cda8446",
+		pubKey:  "0f769d524bbf07f4460ec158e34921cbfdb23445921952d11a95bb58fee1a445b71cb68f23126a2d300701f0cf3765060df0b0356bec0cbfd71db156767920a8719bd0509178d5844cfae4912e6d79765521e731833a1a8554da3088d1973b7f",
+		address: "a247bf931427484283443a83ae0aa1e59e71e71f",
+		sigs: map[string]string{
+			"":      "b4511ab36b809690917da07d371d16badeaeb1e256686c989808e8224e8d7bc718b24d35101ddda157f361d885c2a9420401e8cf17ddc694f61505a804f9f17dc9e07372f6cb22c273f15120af596592f2e649c848595f7ae81ac06b120f3fd3",
+			"hello": "a754649aede9826779a40351cd01e799754c7e18c28966b13b50d4ad737a71efe95bec1fa9371d8d3f5301a9f43da39e00c16276bff1d5ab8c530f7f47adbb71960766ca09554edebcb76b0d20a533a42d5c7d2093ebd6dff55c222ded812002",
+			"a message longer than thirty-two bytes, to be signed": "9695768e4e38ccd23e8a646f06d0abfc4cc86b60bc7fb2fadacfe55fde343fc26a483e500ae86266a59ad059f075b39b08ea1407bf951ff31f84f13b5d27f2dea6778fc27325c47104153a8cd26beda3feb5c58e8a72392bed2b217beb7dc88c",
+		},
+	},
+	{
+		secret:  "bls12_381 test vector 2",
+		privKey: "0302a92eb12a7d069a3934c75c1daa59ba2cffc533a002a19ee313c8fdc712c9",
+		pubKey:  "0dcdbdda9705eb11143e92932d4a091060c85937ffba18aa50508e1dc870ff45e5abcc62bbea2bc7835036ad4a43fe110fc6475d2197adc1b7d6386d54c890ff42da252fef91986e4103c55d61785cda6ecf0149c879f1db80411fd27baeb656",
+		address: "559c6a2e0d2eabc56c397c0b46a9371ecbe5b604",
+		sigs: map[string]string{
+			"":      "968a39faf1682f27685461580c923c93d9e12cea8c37e071c95b1036543b408e421371bb6031d56419ce85db02be218f0a2d214e97cead44c4afd18cff801b4ac1796673a86eb0f11c32e86dcdf4a748d50957780cbed9788d70feaee43b6663",
+			"hello": "b9dab6a81e7ccba9158fb5114b81bdc22e52a9cbefecd05264ea25a1c5af21f31774a7bff5ba44a4bef1d96d341c9a750099f40fe6f15c58395799b8a7d00a3fcdbcf5fe4fa0c8e0cad840ca589ca771204be3bbeaa9ac5eefa8d7dd95fda215",
+			"a message longer than thirty-two bytes, to be signed": "89f32462fee4fc7b971764f8ef537262f17854eead69329b642ad40e82a324d6c65252ef2b2ef793ec4a5deba204ad220733cfde8e5aff1b5798002e29beec2cb7cf58cba34d70f7d7eac24b941d57e8f355ff132cbbe9572803e36192d4be3a",
+		},
+	},
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		t.Run(v.secret, func(t *testing.T) {
+			privKey := GenPrivKeyFromSecret([]byte(v.secret))
+			require.Equal(t, v.privKey, hex.EncodeToString(privKey.Bytes()))
+
+			pubKey := privKey.PubKey()
+			require.Equal(t, v.pubKey, hex.EncodeToString(pubKey.Bytes()))
+			require.Equal(t, v.address, hex.EncodeToString(pubKey.Address()))
+
+			for msg, sig := range v.sigs {
+				bz, err := privKey.Sign([]byte(msg))
+				require.NoError(t, err)
+				require.Equal(t, sig, hex.EncodeToString(bz))
+				require.True(t, pubKey.VerifySignature([]byte(msg), bz))
+				require.False(t, pubKey.VerifySignature([]byte(msg+"!"), bz))
+			}
+		})
+	}
+}
+
+func TestVerifySignatureInvalid(t *testing.T) {
+	v := vectors[0]
+	pubKey := &PubKey{Key: mustDecodeHex(t, v.pubKey)}
+	sig := mustDecodeHex(t, v.sigs["hello"])
+	require.True(t, pubKey.VerifySignature([]byte("hello"), sig))
+
+	// signature of another key
+	require.False(t, pubKey.VerifySignature([]byte("hello"), mustDecodeHex(t, vectors[1].sigs["hello"])))
+	// truncated signature
+	require.False(t, pubKey.VerifySignature([]byte("hello"), sig[:SignatureSize-1]))
+	// signature not on the curve
+	notOnCurve := append([]byte{}, sig...)
+	notOnCurve[SignatureSize-1] ^= 0x01
+	require.False(t, pubKey.VerifySignature([]byte("hello"), notOnCurve))
+
+	// infinite public key and signature
+	infinitePubKey := &PubKey{Key: make([]byte, PubKeySize)}
+	infinitePubKey.Key[0] = 0x40
+	infiniteSig := make([]byte, SignatureSize)
+	infiniteSig[0] = 0xc0
+	require.False(t, infinitePubKey.VerifySignature([]byte("hello"), infiniteSig))
+
+	// compressed public key
+	require.False(t, (&PubKey{Key: pubKey.Key[:48]}).VerifySignature([]byte("hello"), sig))
+}
+
+func TestGenPrivKey(t *testing.T) {
+	privKey := GenPrivKey()
+	require.Len(t, privKey.Bytes(), PrivKeySize)
+	require.False(t, privKey.Equals(GenPrivKey()))
+
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), PubKeySize)
+	require.Len(t, pubKey.Address(), 20)
+
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature([]byte("msg"), sig))
+	require.False(t, GenPrivKey().PubKey().VerifySignature([]byte("msg"), sig))
+}
+
+func TestEquals(t *testing.T) {
+	privKey := GenPrivKeyFromSecret([]byte("secret"))
+	pubKey := privKey.PubKey()
+
+	require.True(t, privKey.Equals(GenPrivKeyFromSecret([]byte("secret"))))
+	require.True(t, pubKey.Equals(&PubKey{Key: pubKey.Bytes()}))
+	require.False(t, pubKey.Equals(GenPrivKey().PubKey()))
+}
+
+func TestMarshalAny(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	cdc := codec.NewProtoCodec(registry)
+
+	pubKey := GenPrivKey().PubKey()
+	bz, err := cdc.MarshalInterface(pubKey)
+	require.NoError(t, err)
+
+	var decoded cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.True(t, pubKey.Equals(decoded))
+	require.Equal(t, pubKey.Address(), decoded.Address())
+}
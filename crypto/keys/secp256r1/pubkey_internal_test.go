@@ -1,9 +1,11 @@
 package secp256r1
 
 import (
+	"encoding/hex"
 	"testing"
 
 	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -126,3 +128,70 @@ func (suite *PKSuite) TestSize() {
 	var nilPk *ecdsaPK
 	require.Equal(0, nilPk.Size(), "nil value must have zero size")
 }
+
+// TestVectors checks the signature verification and address derivation with
+// the P-256 key and SHA-256 signatures of RFC 6979, appendix A.2.5.
+func TestVectors(t *testing.T) {
+	decode := func(s string) []byte {
+		bz, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return bz
+	}
+
+	sk := &PrivKey{&ecdsaSK{}}
+	require.NoError(t, sk.Secret.Unmarshal(decode("C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721")))
+
+	pk := &PubKey{&ecdsaPK{}}
+	require.NoError(t, pk.Key.Unmarshal(decode("0360FED4BA255A9D31C961EB74C6356D68C049B8923B61FA6CE669622E60F29FB6")))
+	require.True(t, pk.Equals(sk.PubKey()))
+	require.Equal(t, "d1ef2a2a0bae94eb5f2ad0a5c6cc1bf8129f75df63b25055151a027b5afbacfe", hex.EncodeToString(pk.Address()))
+
+	tests := []struct {
+		name  string
+		msg   string
+		sig   string
+		valid bool
+	}{
+		{
+			"sample, low s",
+			"sample",
+			"EFD48B2AACB6A8FD1140DD9CD45E81D69D2C877B56AAF991C34D0EA84EAF3716" + "0834E36AD29A83BF2BC9385E491D6099C8FDF9D1ED67AA7EA5F51F93782857A9",
+			true,
+		},
+		{
+			// signatures are valid only with the s value in the lower half of
+			// the curve order to prevent malleability
+			"sample, high s",
+			"sample",
+			"EFD48B2AACB6A8FD1140DD9CD45E81D69D2C877B56AAF991C34D0EA84EAF3716" + "F7CB1C942D657C41D436C7A1B6E29F65F3E900DBB9AFF4064DC4AB2F843ACDA8",
+			false,
+		},
+		{
+			"test",
+			"test",
+			"F1ABB023518351CD71D881567B1EA663ED3EFCF6C5132B354F28D3B0B7D38367" + "019F4113742A2B14BD25926B49C649155F267E60D3814B4C0CC84250E46F0083",
+			true,
+		},
+		{
+			"wrong message",
+			"sample",
+			"F1ABB023518351CD71D881567B1EA663ED3EFCF6C5132B354F28D3B0B7D38367" + "019F4113742A2B14BD25926B49C649155F267E60D3814B4C0CC84250E46F0083",
+			false,
+		},
+		{
+			"truncated signature",
+			"test",
+			"F1ABB023518351CD71D881567B1EA663ED3EFCF6C5132B354F28D3B0B7D38367" + "019F4113742A2B14BD25926B49C649155F267E60D3814B4C0CC84250E46F00",
+			false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.valid, pk.VerifySignature([]byte(tc.msg), decode(tc.sig)))
+		})
+	}
+
+	sig, err := sk.Sign([]byte("sample"))
+	require.NoError(t, err)
+	require.True(t, pk.VerifySignature([]byte("sample"), sig))
+}
@@ -105,6 +105,12 @@ func (mock LedgerSECP256K1Mock) SignSECP256K1(derivationPath []uint32, message [
 	return sig.Serialize(), nil
 }
 
+// SupportsSignModeTextual mocks a device running a Cosmos app version
+// supporting SIGN_MODE_TEXTUAL.
+func (mock LedgerSECP256K1Mock) SupportsSignModeTextual() (bool, error) {
+	return true, nil
+}
+
 // ShowAddressSECP256K1 shows the address for the corresponding bip32 derivation path
 func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp string) error {
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
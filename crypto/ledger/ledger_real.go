@@ -17,8 +17,24 @@ func init() {
 			return nil, err
 		}
 
-		return device, nil
+		return cosmosDevice{device}, nil
 	}
 
 	initOptionsDefault()
 }
+
+// cosmosDevice is a device running the Cosmos app, which reports whether it
+// supports SIGN_MODE_TEXTUAL from its version.
+type cosmosDevice struct {
+	*ledger.LedgerCosmos
+}
+
+// SupportsSignModeTextual implements TextualCapableSECP256K1.
+func (d cosmosDevice) SupportsSignModeTextual() (bool, error) {
+	version, err := d.GetVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return appVersionSupportsTextual(version.Major, version.Minor, version.Patch), nil
+}
@@ -17,6 +17,10 @@ import (
 // options stores the Ledger Options that can be used to customize Ledger usage
 var options Options
 
+// minTextualAppVersion is the first version of the Cosmos app supporting
+// SIGN_MODE_TEXTUAL.
+var minTextualAppVersion = [3]uint8{2, 34, 12}
+
 type (
 	// discoverLedgerFn defines a Ledger discovery function that returns a
 	// connected device or an error upon failure. Its allows a method to avoid CGO
@@ -41,6 +45,13 @@ type (
 		SignSECP256K1([]uint32, []byte, byte) ([]byte, error)
 	}
 
+	// TextualCapableSECP256K1 is implemented by the devices which can report
+	// whether their app supports SIGN_MODE_TEXTUAL, the app versions predating
+	// it only signing LEGACY_AMINO_JSON payloads.
+	TextualCapableSECP256K1 interface {
+		SupportsSignModeTextual() (bool, error)
+	}
+
 	// Options hosts customization options to account for differences in Ledger
 	// signing and usage across chains.
 	Options struct {
@@ -156,6 +167,37 @@ func (pkl PrivKeyLedgerSecp256k1) SignLedgerAminoJSON(message []byte) ([]byte, e
 	return sign(device, pkl, message, 0)
 }
 
+// SupportsSignModeTextual returns whether the app of the connected device
+// supports SIGN_MODE_TEXTUAL. Devices which cannot report it are assumed to
+// only support LEGACY_AMINO_JSON.
+func SupportsSignModeTextual() (bool, error) {
+	device, err := getDevice()
+	if err != nil {
+		return false, err
+	}
+	defer warnIfErrors(device.Close)
+
+	capable, ok := device.(TextualCapableSECP256K1)
+	if !ok {
+		return false, nil
+	}
+
+	return capable.SupportsSignModeTextual()
+}
+
+// appVersionSupportsTextual returns whether the given version of the Cosmos
+// app supports SIGN_MODE_TEXTUAL.
+func appVersionSupportsTextual(major, minor, patch uint8) bool {
+	version := [3]uint8{major, minor, patch}
+	for i := range version {
+		if version[i] != minTextualAppVersion[i] {
+			return version[i] > minTextualAppVersion[i]
+		}
+	}
+
+	return true
+}
+
 // ShowAddress triggers a ledger device to show the corresponding address.
 func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey, accountAddressPrefix string) error {
 	device, err := getDevice()
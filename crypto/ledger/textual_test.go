@@ -0,0 +1,94 @@
+package ledger
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// textualDevice is a device reporting whether it supports SIGN_MODE_TEXTUAL.
+type textualDevice struct {
+	priv    *secp.PrivateKey
+	textual bool
+
+	payload []byte
+	p2      byte
+}
+
+func newTextualDevice(textual bool) *textualDevice {
+	return &textualDevice{priv: secp.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32)), textual: textual}
+}
+
+func (d *textualDevice) Close() error { return nil }
+
+func (d *textualDevice) GetPublicKeySECP256K1([]uint32) ([]byte, error) {
+	return d.priv.PubKey().SerializeUncompressed(), nil
+}
+
+func (d *textualDevice) GetAddressPubKeySECP256K1([]uint32, string) ([]byte, string, error) {
+	return nil, "", errors.New("not implemented")
+}
+
+func (d *textualDevice) SignSECP256K1(_ []uint32, message []byte, p2 byte) ([]byte, error) {
+	d.payload = message
+	d.p2 = p2
+
+	hash := sha256.Sum256(message)
+	return ecdsa.Sign(d.priv, hash[:]).Serialize(), nil
+}
+
+func (d *textualDevice) SupportsSignModeTextual() (bool, error) {
+	return d.textual, nil
+}
+
+// useDevice makes device the discovered device until the end of the test.
+func useDevice(t *testing.T, device SECP256K1) {
+	t.Helper()
+
+	discoverLedger := options.discoverLedger
+	SetDiscoverLedger(func() (SECP256K1, error) { return device, nil })
+	t.Cleanup(func() { options.discoverLedger = discoverLedger })
+}
+
+func TestSignTextual(t *testing.T) {
+	device := newTextualDevice(true)
+	useDevice(t, device)
+
+	pubKey := &secp256k1.PubKey{Key: device.priv.PubKey().SerializeCompressed()}
+	priv := PrivKeyLedgerSecp256k1{CachedPubKey: pubKey, Path: *hd.NewFundraiserParams(0, 118, 0)}
+
+	// the payload is sent whole, the device app splits it into APDUs
+	msg := bytes.Repeat([]byte{'a'}, 1000)
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.Equal(t, msg, device.payload)
+	require.Equal(t, byte(1), device.p2, "signed with SIGN_MODE_TEXTUAL")
+}
+
+func TestSupportsSignModeTextual(t *testing.T) {
+	useDevice(t, newTextualDevice(true))
+	supported, err := SupportsSignModeTextual()
+	require.NoError(t, err)
+	require.True(t, supported)
+
+	// old device app versions only support LEGACY_AMINO_JSON
+	useDevice(t, newTextualDevice(false))
+	supported, err = SupportsSignModeTextual()
+	require.NoError(t, err)
+	require.False(t, supported)
+
+	require.True(t, appVersionSupportsTextual(2, 34, 12))
+	require.True(t, appVersionSupportsTextual(2, 35, 0))
+	require.True(t, appVersionSupportsTextual(3, 0, 0))
+	require.False(t, appVersionSupportsTextual(2, 34, 11))
+	require.False(t, appVersionSupportsTextual(1, 99, 99))
+}
@@ -82,6 +82,7 @@ func (a *App) RegisterModules(modules ...module.AppModule) error {
 func (a *App) Load(loadLatest bool) error {
 	if len(a.config.InitGenesis) != 0 {
 		a.ModuleManager.SetOrderInitGenesis(a.config.InitGenesis...)
+		a.ModuleManager.ValidateOrder()
 		if a.initChainer == nil {
 			a.SetInitChainer(a.InitChainer)
 		}
@@ -174,7 +175,7 @@ func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (a *App) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry)
+	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.SimulateMsg, a.interfaceRegistry)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -189,7 +190,12 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 
 // RegisterNodeService registers the node gRPC service on the app gRPC router.
 func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
-	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg,
+		nodeservice.WithStoreStats(a.StoreStats),
+		nodeservice.WithSnapshotProgress(a.SnapshotProgress),
+		nodeservice.WithEarliestVersion(a.EarliestVersion),
+		nodeservice.WithMigrationRunning(module.IsMigrationRunning),
+	)
 }
 
 // Configurator returns the app's configurator.
@@ -0,0 +1,67 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/server/api"
+)
+
+// readyServer is a node service answering the Ready query with res, or err.
+type readyServer struct {
+	node.UnimplementedServiceServer
+
+	res *node.ReadyResponse
+	err error
+}
+
+func (s readyServer) Ready(context.Context, *node.ReadyRequest) (*node.ReadyResponse, error) {
+	return s.res, s.err
+}
+
+func TestServer_Healthz(t *testing.T) {
+	testCases := []struct {
+		name    string
+		server  readyServer
+		expCode int
+	}{
+		{"ready", readyServer{res: &node.ReadyResponse{Ready: true, EarliestHeight: 10, AcceptingQueries: true}}, http.StatusOK},
+		{"catching up", readyServer{res: &node.ReadyResponse{CatchingUp: true, AcceptingQueries: true}}, http.StatusServiceUnavailable},
+		{"migration running", readyServer{res: &node.ReadyResponse{MigrationRunning: true, AcceptingQueries: true}}, http.StatusServiceUnavailable},
+		{"node unavailable", readyServer{err: status.Error(codes.Unavailable, "no RPC client is defined in offline mode")}, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := api.New(client.Context{}, log.NewNopLogger(), nil)
+			require.NoError(t, node.RegisterServiceHandlerServer(context.Background(), srv.GRPCGatewayRouter, &tc.server))
+
+			rec := httptest.NewRecorder()
+			srv.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.server.res != nil {
+				var res struct {
+					Ready            bool `json:"ready"`
+					CatchingUp       bool `json:"catching_up"`
+					MigrationRunning bool `json:"migration_running"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+				require.Equal(t, tc.server.res.Ready, res.Ready)
+				require.Equal(t, tc.server.res.CatchingUp, res.CatchingUp)
+				require.Equal(t, tc.server.res.MigrationRunning, res.MigrationRunning)
+			}
+		})
+	}
+}
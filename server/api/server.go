@@ -2,9 +2,11 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"time"
@@ -41,6 +43,12 @@ type Server struct {
 	// this mutex to avoid data races.
 	mtx      sync.Mutex
 	listener net.Listener
+
+	// inFlight tracks the requests being served, so that they are drained
+	// before the server is stopped. Requests are refused once draining is set.
+	inFlightMtx sync.RWMutex
+	inFlight    sync.WaitGroup
+	draining    bool
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -68,7 +76,7 @@ func New(clientCtx client.Context, logger log.Logger, grpcSrv *grpc.Server) *Ser
 		AnyResolver:  clientCtx.InterfaceRegistry,
 	}
 
-	return &Server{
+	s := &Server{
 		logger:    logger,
 		Router:    mux.NewRouter(),
 		ClientCtx: clientCtx,
@@ -86,6 +94,45 @@ func New(clientCtx client.Context, logger log.Logger, grpcSrv *grpc.Server) *Ser
 		),
 		GRPCSrv: grpcSrv,
 	}
+
+	s.Router.HandleFunc("/healthz", s.healthz).Methods("GET")
+
+	return s
+}
+
+// readyPath is the gRPC-gateway route of the Ready query of the node service.
+const readyPath = "/cosmos/base/node/v1beta1/ready"
+
+// healthz serves the Ready query of the node service, with a 503 Service
+// Unavailable status when the node is not ready, so that it can be used as a
+// readiness probe.
+func (s *Server) healthz(w http.ResponseWriter, r *http.Request) {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, readyPath, nil)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	rec := httptest.NewRecorder()
+	s.GRPCGatewayRouter.ServeHTTP(rec, req)
+
+	code := rec.Code
+	if code == http.StatusOK {
+		var res struct {
+			Ready bool `json:"ready"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("failed to decode ready response: %s", err))
+			return
+		}
+		if !res.Ready {
+			code = http.StatusServiceUnavailable
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(rec.Body.Bytes())
 }
 
 // Start starts the API server. Internally, the API server leverages CometBFT's
@@ -140,6 +187,7 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 
 	errCh := make(chan error)
+	handler := s.trackInFlight(s.Router)
 
 	// Start the API in an external goroutine as Serve is blocking and will return
 	// an error upon failure, which we'll send on the error channel that will be
@@ -149,9 +197,9 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 
 		if enableUnsafeCORS {
 			allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(s.Router), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(handler), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		} else {
-			errCh <- tmrpcserver.Serve(s.listener, s.Router, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, handler, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		}
 	}(cfg.API.EnableUnsafeCORS)
 
@@ -162,7 +210,13 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 		// The calling process canceled or closed the provided context, so we must
 		// gracefully stop the API server.
 		s.logger.Info("stopping API server...", "address", cfg.API.Address)
-		return s.Close()
+
+		gracePeriod := cfg.GRPC.ShutdownGracePeriod
+		if gracePeriod == 0 {
+			gracePeriod = config.DefaultGRPCShutdownGracePeriod
+		}
+
+		return s.drain(gracePeriod)
 
 	case err := <-errCh:
 		s.logger.Error("failed to start API server", "err", err)
@@ -170,6 +224,54 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	}
 }
 
+// drain closes the API server and waits, for at most gracePeriod, for its
+// in-flight requests to be served. New requests received meanwhile on open
+// connections are refused with a 503 Service Unavailable status.
+func (s *Server) drain(gracePeriod time.Duration) error {
+	s.inFlightMtx.Lock()
+	s.draining = true
+	s.inFlightMtx.Unlock()
+
+	if err := s.Close(); err != nil {
+		return err
+	}
+
+	served := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		close(served)
+	}()
+
+	timer := time.NewTimer(gracePeriod)
+	defer timer.Stop()
+
+	select {
+	case <-served:
+	case <-timer.C:
+		s.logger.Error("API server stopped with in-flight requests after shutdown grace period", "grace_period", gracePeriod)
+	}
+
+	return nil
+}
+
+// trackInFlight wraps handler so that its requests are tracked until they are
+// served, and refused once the server is draining.
+func (s *Server) trackInFlight(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.inFlightMtx.RLock()
+		if s.draining {
+			s.inFlightMtx.RUnlock()
+			writeErrorResponse(w, http.StatusServiceUnavailable, "node is shutting down")
+			return
+		}
+		s.inFlight.Add(1)
+		s.inFlightMtx.RUnlock()
+
+		defer s.inFlight.Done()
+		handler.ServeHTTP(w, r)
+	})
+}
+
 // Close closes the API server.
 func (s *Server) Close() error {
 	s.mtx.Lock()
@@ -0,0 +1,79 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestServer_Shutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	srv := api.New(client.Context{}, log.NewNopLogger(), nil)
+	started := make(chan struct{})
+	srv.Router.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte("served"))
+	})
+
+	cfg := config.DefaultConfig()
+	cfg.API.Address = fmt.Sprintf("tcp://%s", address)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error)
+	go func() { stopped <- srv.Start(ctx, *cfg) }()
+
+	type response struct {
+		body string
+		err  error
+	}
+	resCh := make(chan response)
+	go func() {
+		// the server is listening once a request succeeds
+		for {
+			res, err := http.Get(fmt.Sprintf("http://%s/slow", address))
+			if err != nil {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			defer res.Body.Close()
+
+			body, err := io.ReadAll(res.Body)
+			resCh <- response{string(body), err}
+			return
+		}
+	}()
+
+	// shut down the server while the request is in-flight
+	<-started
+	cancel()
+
+	res := <-resCh
+	require.NoError(t, res.err)
+	require.Equal(t, "served", res.body)
+	require.NoError(t, <-stopped)
+
+	// new requests are refused once the server is stopped, with a 503 status on
+	// connections kept alive
+	res2, err := http.Get(fmt.Sprintf("http://%s/slow", address))
+	if err == nil {
+		defer res2.Body.Close()
+		require.Equal(t, http.StatusServiceUnavailable, res2.StatusCode)
+	}
+}
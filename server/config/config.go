@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,6 +32,14 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// DefaultGRPCShutdownGracePeriod defines the default duration the gRPC and
+	// API servers wait for their in-flight requests when shutting down.
+	DefaultGRPCShutdownGracePeriod = 5 * time.Second
+
+	// DefaultTxExecutionTimeout defines the default maximum duration of the
+	// execution of a tx in CheckTx and Simulate.
+	DefaultTxExecutionTimeout = 5 * time.Second
 )
 
 // BaseConfig defines the server's basic configuration
@@ -43,6 +53,10 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningMaxBatchSize is the maximum number of heights deleted from the
+	// stores in a single batch by the background pruning worker.
+	PruningMaxBatchSize uint64 `mapstructure:"pruning-max-batch-size"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -75,6 +89,24 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// TxExecutionTimeout defines the maximum wall-clock duration of the execution
+	// of a tx in CheckTx and Simulate. It is never applied to the execution of
+	// txs in blocks. A value of 0 disables the timeout.
+	TxExecutionTimeout time.Duration `mapstructure:"tx-execution-timeout"`
+
+	// SigVerificationCacheSize defines the number of txs whose signatures,
+	// verified in CheckTx, are cached so that they are not verified again when
+	// the txs are executed in a block. A value of 0 disables the cache.
+	SigVerificationCacheSize uint64 `mapstructure:"sig-verification-cache-size"`
+
+	// QueryGasLimit defines the maximum gas a gRPC state query can consume. A
+	// value of 0 disables the limit.
+	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
+
+	// EnableStoreStats enables the StoreStats query of the node service, which
+	// scans every store of the application.
+	EnableStoreStats bool `mapstructure:"enable-store-stats"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
@@ -88,6 +120,10 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// HistoricalVersionCacheSize is the number of past heights whose loaded
+	// stores are kept for the queries at these heights. 0 disables the cache.
+	HistoricalVersionCacheSize uint64 `mapstructure:"historical-version-cache-size"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -139,6 +175,15 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// EnableCompression defines if the server compresses its responses with gzip
+	// to the clients supporting it.
+	EnableCompression bool `mapstructure:"enable-compression"`
+
+	// ShutdownGracePeriod defines the maximum duration the gRPC and API servers
+	// wait for their in-flight requests to be served when the node shuts down,
+	// after which they are aborted. The default value is 5s.
+	ShutdownGracePeriod time.Duration `mapstructure:"shutdown-grace-period"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -166,6 +211,11 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int
+
+	// MaxTxsPerSender defines the maximum amount of txs of a single sender the
+	// mempool may contain, enforced by the ante handler in CheckTx. Zero
+	// indicates no limit.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
 }
 
 // State Streaming configuration
@@ -227,17 +277,20 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			IAVLLazyLoading:     false,
-			AppDBBackend:        "",
+			MinGasPrices:               defaultMinGasPrices,
+			InterBlockCache:            true,
+			TxExecutionTimeout:         DefaultTxExecutionTimeout,
+			Pruning:                    pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:          "0",
+			PruningInterval:            "0",
+			PruningMaxBatchSize:        rootmulti.DefaultPruningMaxBatchSize,
+			MinRetainBlocks:            0,
+			IndexEvents:                make([]string, 0),
+			IAVLCacheSize:              781250,
+			IAVLDisableFastNode:        false,
+			IAVLLazyLoading:            false,
+			HistoricalVersionCacheSize: 0,
+			AppDBBackend:               "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -252,10 +305,11 @@ func DefaultConfig() *Config {
 			RPCMaxBodyBytes:    1000000,
 		},
 		GRPC: GRPCConfig{
-			Enable:         true,
-			Address:        DefaultGRPCAddress,
-			MaxRecvMsgSize: DefaultGRPCMaxRecvMsgSize,
-			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
+			Enable:              true,
+			Address:             DefaultGRPCAddress,
+			MaxRecvMsgSize:      DefaultGRPCMaxRecvMsgSize,
+			MaxSendMsgSize:      DefaultGRPCMaxSendMsgSize,
+			ShutdownGracePeriod: DefaultGRPCShutdownGracePeriod,
 		},
 		GRPCWeb: GRPCWebConfig{
 			Enable: true,
@@ -31,6 +31,11 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningMaxBatchSize is the maximum number of heights deleted from the stores
+# in a single batch. Pruned heights are deleted in the background, one batch at
+# a time, so that committing a block never waits for a large pruning backlog.
+pruning-max-batch-size = {{ .BaseConfig.PruningMaxBatchSize }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -63,6 +68,27 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# TxExecutionTimeout defines the maximum wall-clock duration of the execution of
+# a tx in CheckTx and Simulate, after which its execution is aborted. It is never
+# applied to the execution of txs in blocks. A value of 0 disables the timeout.
+tx-execution-timeout = "{{ .BaseConfig.TxExecutionTimeout }}"
+
+# SigVerificationCacheSize defines the number of txs whose signatures, verified
+# in CheckTx, are cached so that they are not verified again when the txs are
+# executed in a block. A value of 0 disables the cache.
+sig-verification-cache-size = {{ .BaseConfig.SigVerificationCacheSize }}
+
+# QueryGasLimit defines the maximum gas a gRPC state query can consume, including
+# the nested queries made while handling it. Queries exceeding it fail with a
+# ResourceExhausted error. A value of 0 disables the limit.
+query-gas-limit = {{ .BaseConfig.QueryGasLimit }}
+
+# EnableStoreStats enables the StoreStats query of the node service, returning
+# the number of keys and the approximate size of each store. As it scans every
+# store at the latest height, it should only be enabled on nodes whose queries
+# are restricted to their operator.
+enable-store-stats = {{ .BaseConfig.EnableStoreStats }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs CometBFT what to index. If empty, all events will be indexed.
 #
@@ -81,6 +107,12 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# HistoricalVersionCacheSize is the number of past heights whose loaded stores
+# are kept in memory, so that repeated queries at the same height, frequent on
+# archive nodes, do not load the stores again. The least recently queried
+# height is evicted first. 0 disables the cache.
+historical-version-cache-size = {{ .BaseConfig.HistoricalVersionCacheSize }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # First fallback is the deprecated compile-time types.DBBackend value.
@@ -110,6 +142,11 @@ enable-hostname-label = {{ .Telemetry.EnableHostnameLabel }}
 # Enable adding service to labels.
 enable-service-label = {{ .Telemetry.EnableServiceLabel }}
 
+# EnableStoreOperationMetrics enables the counting of the operations made on each
+# store (reads, writes, iterated entries and bytes), emitted on each commit as
+# counters labeled by store, such as store_get_count{store="staking"}.
+enable-store-operation-metrics = {{ .Telemetry.EnableStoreOperationMetrics }}
+
 # PrometheusRetentionTime, when positive, enables a Prometheus metrics sink.
 prometheus-retention-time = {{ .Telemetry.PrometheusRetentionTime }}
 
@@ -172,6 +209,15 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+# EnableCompression defines if the server compresses its responses with gzip to
+# the clients supporting it.
+enable-compression = {{ .GRPC.EnableCompression }}
+
+# ShutdownGracePeriod defines the maximum duration the gRPC and API servers wait
+# for their in-flight requests to be served when the node shuts down, after which
+# they are aborted. The default value is 5s.
+shutdown-grace-period = "{{ .GRPC.ShutdownGracePeriod }}"
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -236,6 +282,10 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+# Setting max-txs-per-sender to a positive number (> 0) will limit the number of transactions of a single sender
+# in the mempool, rejecting the additional ones in CheckTx. Setting it to 0 will not limit them.
+max-txs-per-sender = "{{ .Mempool.MaxTxsPerSender }}"
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/genesisarchive"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	FlagArchiveDir = "archive-dir"
+	FlagChunkSize  = "chunk-size"
+)
+
+// SnapshotsCmd returns the snapshots command, exporting the app state to and
+// importing it from portable genesis archives.
+func SnapshotsCmd(archiveExporter types.GenesisArchiveExporter, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Export the app state to and import it from portable genesis archives",
+	}
+
+	cmd.AddCommand(
+		ExportGenesisArchiveCmd(archiveExporter, defaultNodeHome),
+		ImportGenesisArchiveCmd(),
+	)
+
+	return cmd
+}
+
+// ExportGenesisArchiveCmd writes the app state at a height to a genesis archive,
+// one module after the other, instead of a single genesis doc.
+func ExportGenesisArchiveCmd(archiveExporter types.GenesisArchiveExporter, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-genesis-archive <height>",
+		Short: "Export the app state at a height to a genesis archive",
+		Long: `Export the app state at a height (-1 meaning the latest height) to a genesis archive.
+The genesis state of each module is written to compressed chunk files, listed along with
+their hashes in the manifest of the archive, so that the state of a large chain is never
+held in memory as a single genesis doc. The archive is written to --archive-dir, by default
+<home>/data/genesis-archives/<height>.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			archiveDir, _ := cmd.Flags().GetString(FlagArchiveDir)
+			if archiveDir == "" {
+				archiveDir = filepath.Join(config.RootDir, "data", "genesis-archives", args[0])
+			}
+
+			chunkSize, _ := cmd.Flags().GetInt(FlagChunkSize)
+			archive, err := genesisarchive.NewWriter(archiveDir, chunkSize)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			traceWriterFile, _ := cmd.Flags().GetString(flagTraceStore)
+			traceWriter, err := openTraceWriter(traceWriterFile)
+			if err != nil {
+				return err
+			}
+
+			exported, err := archiveExporter(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper, archive)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+
+			appGenesis.InitialHeight = exported.Height
+			appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
+			if err := archive.Close(exported.Height, appGenesis); err != nil {
+				return err
+			}
+
+			cmd.Println(archiveDir)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagArchiveDir, "", "The directory the archive is written to")
+	cmd.Flags().Int(FlagChunkSize, genesisarchive.DefaultChunkSize, "The uncompressed size in bytes of the chunks of the module genesis states")
+
+	return cmd
+}
+
+// ImportGenesisArchiveCmd reconstructs the genesis doc of a genesis archive.
+func ImportGenesisArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-genesis-archive <archive-dir>",
+		Short: "Reconstruct the genesis doc of a genesis archive",
+		Long: `Reconstruct the genesis doc of a genesis archive, verifying the hashes of its chunk files.
+The genesis states of the modules are streamed from the archive to the genesis doc, which
+is written to STDOUT, or to --output-document.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			archive, err := genesisarchive.NewReader(args[0])
+			if err != nil {
+				return err
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				out := bufio.NewWriter(cmd.OutOrStdout())
+				if err := archive.WriteGenesis(out); err != nil {
+					return err
+				}
+				return out.Flush()
+			}
+
+			f, err := os.Create(outputDocument)
+			if err != nil {
+				return err
+			}
+
+			out := bufio.NewWriter(f)
+			if err = archive.WriteGenesis(out); err == nil {
+				err = out.Flush()
+			}
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				// do not leave a partial genesis doc behind
+				os.Remove(outputDocument)
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The genesis doc is written to the given file instead of STDOUT")
+
+	return cmd
+}
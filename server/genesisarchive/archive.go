@@ -0,0 +1,77 @@
+// Package genesisarchive implements a portable archive of the genesis state of
+// an app, written and read one module at a time so that the state of a large
+// chain never has to be held in memory as a single genesis doc.
+//
+// An archive is a directory holding a manifest and, for each module, the gzip
+// compressed chunks of its genesis state as JSON:
+//
+//	manifest.json
+//	<module>/0.json.gz
+//	<module>/1.json.gz
+//	...
+//
+// The manifest lists the modules in export order along with the SHA-256 hashes
+// of their chunk files, and holds the genesis doc of the app without its app
+// state.
+package genesisarchive
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	// ManifestFileName is the name of the manifest file of an archive.
+	ManifestFileName = "manifest.json"
+
+	// DefaultChunkSize is the default uncompressed size of the chunks of the
+	// genesis state of a module.
+	DefaultChunkSize = 64 << 20
+)
+
+// Manifest describes the contents of an archive.
+type Manifest struct {
+	// Height is the height of the exported app state.
+	Height int64 `json:"height"`
+	// Genesis is the genesis doc of the app, without its app state.
+	Genesis *genutiltypes.AppGenesis `json:"genesis"`
+	// Modules are the modules of the archive, in export order.
+	Modules []ModuleManifest `json:"modules"`
+}
+
+// ModuleManifest describes the genesis state of a module in an archive.
+type ModuleManifest struct {
+	// Name is the name of the module.
+	Name string `json:"name"`
+	// Chunks are the hex encoded SHA-256 hashes of the chunk files of the
+	// module, in order.
+	Chunks []string `json:"chunks"`
+}
+
+// chunkPath returns the path of a chunk file of a module in an archive.
+func chunkPath(dir, moduleName string, index int) string {
+	return filepath.Join(dir, moduleName, fmt.Sprintf("%d.json.gz", index))
+}
+
+// readManifest reads the manifest of the archive in dir.
+func readManifest(dir string) (Manifest, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to read archive manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("failed to decode archive manifest: %w", err)
+	}
+
+	if manifest.Genesis == nil {
+		return Manifest{}, fmt.Errorf("archive manifest has no genesis")
+	}
+
+	return manifest, nil
+}
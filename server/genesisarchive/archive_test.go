@@ -0,0 +1,123 @@
+package genesisarchive_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/genesisarchive"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func writeArchive(t *testing.T, dir string, modules map[string]string, order []string) {
+	t.Helper()
+
+	w, err := genesisarchive.NewWriter(dir, 16)
+	require.NoError(t, err)
+
+	for _, name := range order {
+		err := w.WriteModule(name, func(w io.Writer) error {
+			// written in several parts to span chunks
+			for _, part := range strings.SplitAfter(modules[name], ",") {
+				if _, err := io.WriteString(w, part); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	genesis := genutiltypes.NewAppGenesisWithVersion("test-chain", json.RawMessage(`{"ignored":{}}`))
+	require.NoError(t, w.Close(10, genesis))
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	modules := map[string]string{
+		"auth": `{"params":{"max_memo_characters":"256"},"accounts":[{"address":"cosmos1a"},{"address":"cosmos1b"}]}`,
+		"bank": `{"balances":[{"address":"cosmos1a","coins":[{"denom":"stake","amount":"100"}]}]}`,
+		"mint": `{}`,
+	}
+	order := []string{"bank", "auth", "mint"}
+	writeArchive(t, dir, modules, order)
+
+	// the module genesis states are split in chunks
+	r, err := genesisarchive.NewReader(dir)
+	require.NoError(t, err)
+
+	manifest := r.Manifest()
+	require.Equal(t, int64(10), manifest.Height)
+	require.Equal(t, "test-chain", manifest.Genesis.ChainID)
+	require.Len(t, manifest.Modules, len(order))
+	for i, module := range manifest.Modules {
+		require.Equal(t, order[i], module.Name)
+		require.Len(t, module.Chunks, (len(modules[module.Name])+15)/16)
+
+		reader, err := r.OpenModule(module.Name)
+		require.NoError(t, err)
+		bz, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		require.Equal(t, modules[module.Name], string(bz))
+	}
+
+	_, err = r.OpenModule("gov")
+	require.ErrorContains(t, err, "module gov not found")
+
+	// the genesis doc holds the genesis states of the modules as app state
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteGenesis(&buf))
+
+	genFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(genFile, buf.Bytes(), 0o600))
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", appGenesis.ChainID)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+	require.Len(t, appState, len(modules))
+	for name, state := range modules {
+		require.JSONEq(t, state, string(appState[name]))
+	}
+
+	// an archive is not overwritten
+	_, err = genesisarchive.NewWriter(dir, 16)
+	require.ErrorContains(t, err, "already holds an archive")
+}
+
+func TestArchiveChunkHashMismatch(t *testing.T) {
+	dir := t.TempDir()
+	writeArchive(t, dir, map[string]string{"bank": `{"balances":[],"supply":[]}`}, []string{"bank"})
+
+	// replace the second chunk by a valid chunk with other contents
+	other := t.TempDir()
+	writeArchive(t, other, map[string]string{"bank": `{"balances":[],"supply":[{}]}`}, []string{"bank"})
+	bz, err := os.ReadFile(filepath.Join(other, "bank", "1.json.gz"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bank", "1.json.gz"), bz, 0o600))
+
+	r, err := genesisarchive.NewReader(dir)
+	require.NoError(t, err)
+
+	reader, err := r.OpenModule("bank")
+	require.NoError(t, err)
+	defer reader.Close()
+
+	_, err = io.ReadAll(reader)
+	require.ErrorContains(t, err, "chunk 1 of module bank: hash mismatch")
+
+	require.ErrorContains(t, r.WriteGenesis(io.Discard), "hash mismatch")
+}
+
+func TestNewReaderMissingManifest(t *testing.T) {
+	_, err := genesisarchive.NewReader(t.TempDir())
+	require.ErrorContains(t, err, "failed to read archive manifest")
+}
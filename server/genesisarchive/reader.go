@@ -0,0 +1,204 @@
+package genesisarchive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+)
+
+// Reader reads an archive from a directory.
+type Reader struct {
+	dir      string
+	manifest Manifest
+}
+
+// NewReader opens the archive in dir, reading its manifest.
+func NewReader(dir string) (*Reader, error) {
+	manifest, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{dir: dir, manifest: manifest}, nil
+}
+
+// Manifest returns the manifest of the archive.
+func (r *Reader) Manifest() Manifest {
+	return r.manifest
+}
+
+// OpenModule returns a reader of the genesis state of a module as JSON. The
+// hash of each chunk file is verified once it is fully read, a mismatch being
+// returned as an error by Read.
+func (r *Reader) OpenModule(moduleName string) (io.ReadCloser, error) {
+	for _, module := range r.manifest.Modules {
+		if module.Name == moduleName {
+			return &moduleReader{dir: r.dir, module: module}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("module %s not found in archive", moduleName)
+}
+
+// WriteGenesis writes the genesis doc of the archive as JSON to w, streaming
+// the genesis state of the modules from their chunk files into its app state.
+func (r *Reader) WriteGenesis(w io.Writer) error {
+	appGenesis := *r.manifest.Genesis
+	appGenesis.AppState = nil
+
+	bz, err := json.Marshal(&appGenesis)
+	if err != nil {
+		return err
+	}
+
+	// the app state is written first, followed by the fields of the genesis
+	// doc, i.e. the JSON object without its opening brace
+	bz = bytes.TrimSpace(bz)
+	if len(bz) < 2 || bz[0] != '{' {
+		return fmt.Errorf("unexpected genesis JSON")
+	}
+	rest := bytes.TrimSpace(bz[1:])
+
+	if _, err := io.WriteString(w, `{"app_state":{`); err != nil {
+		return err
+	}
+
+	for i, module := range r.manifest.Modules {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+
+		name, err := json.Marshal(module.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(name, ':')); err != nil {
+			return err
+		}
+
+		if err := r.copyModule(w, module.Name); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, "}"); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(rest, []byte("}")) {
+		if _, err := io.WriteString(w, ","); err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(rest)
+	return err
+}
+
+func (r *Reader) copyModule(w io.Writer, moduleName string) error {
+	module, err := r.OpenModule(moduleName)
+	if err != nil {
+		return err
+	}
+	defer module.Close()
+
+	if _, err := io.Copy(w, module); err != nil {
+		return fmt.Errorf("failed to read the genesis state of module %s: %w", moduleName, err)
+	}
+
+	return nil
+}
+
+// moduleReader reads the chunk files of a module in turn.
+type moduleReader struct {
+	dir    string
+	module ModuleManifest
+	index  int
+
+	file    *os.File
+	zReader *gzip.Reader
+	hasher  hash.Hash
+}
+
+// Read implements io.Reader.
+func (m *moduleReader) Read(p []byte) (int, error) {
+	for {
+		if m.file == nil {
+			if m.index == len(m.module.Chunks) {
+				return 0, io.EOF
+			}
+
+			if err := m.openChunk(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := m.zReader.Read(p)
+		if err == io.EOF {
+			err = m.closeChunk()
+			if n > 0 || err != nil {
+				return n, err
+			}
+			continue
+		}
+
+		return n, err
+	}
+}
+
+// Close implements io.Closer.
+func (m *moduleReader) Close() error {
+	if m.file == nil {
+		return nil
+	}
+
+	err := m.file.Close()
+	m.file = nil
+
+	return err
+}
+
+func (m *moduleReader) openChunk() error {
+	file, err := os.Open(chunkPath(m.dir, m.module.Name, m.index))
+	if err != nil {
+		return err
+	}
+
+	m.hasher = sha256.New()
+	zReader, err := gzip.NewReader(io.TeeReader(file, m.hasher))
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("chunk %d: %w", m.index, err)
+	}
+
+	m.file = file
+	m.zReader = zReader
+
+	return nil
+}
+
+// closeChunk verifies the hash of the current chunk file once fully read and
+// closes it.
+func (m *moduleReader) closeChunk() error {
+	defer m.Close()
+
+	// read any trailing bytes of the chunk file into the hash
+	if _, err := io.Copy(io.Discard, io.TeeReader(m.file, m.hasher)); err != nil {
+		return err
+	}
+
+	if hash := hex.EncodeToString(m.hasher.Sum(nil)); hash != m.module.Chunks[m.index] {
+		return fmt.Errorf("chunk %d of module %s: hash mismatch, expected %s, got %s", m.index, m.module.Name, m.module.Chunks[m.index], hash)
+	}
+	m.index++
+
+	return nil
+}
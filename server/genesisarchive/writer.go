@@ -0,0 +1,175 @@
+package genesisarchive
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+var _ servertypes.GenesisArchiveWriter = (*Writer)(nil)
+
+// Writer writes an archive to a directory. The genesis state of the modules
+// is written with WriteModule, then the archive is completed by Close, which
+// writes its manifest.
+type Writer struct {
+	dir       string
+	chunkSize int
+	modules   []ModuleManifest
+}
+
+// NewWriter creates the directory of an archive, whose module genesis states
+// are split in chunks of chunkSize uncompressed bytes. The directory must not
+// hold an archive already.
+func NewWriter(dir string, chunkSize int) (*Writer, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, ManifestFileName)); err == nil {
+		return nil, fmt.Errorf("%s already holds an archive", dir)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &Writer{dir: dir, chunkSize: chunkSize}, nil
+}
+
+// WriteModule implements servertypes.GenesisArchiveWriter. It writes the
+// genesis state of a module, streamed by write, to the chunk files of the
+// module.
+func (w *Writer) WriteModule(moduleName string, write func(w io.Writer) error) error {
+	if moduleName == "" || moduleName != filepath.Base(moduleName) {
+		return fmt.Errorf("invalid module name %q", moduleName)
+	}
+
+	for _, module := range w.modules {
+		if module.Name == moduleName {
+			return fmt.Errorf("module %s already written", moduleName)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(w.dir, moduleName), 0o755); err != nil {
+		return err
+	}
+
+	chunks := &chunkWriter{dir: w.dir, moduleName: moduleName, chunkSize: w.chunkSize}
+	err := write(chunks)
+	if closeErr := chunks.closeChunk(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	w.modules = append(w.modules, ModuleManifest{Name: moduleName, Chunks: chunks.hashes})
+
+	return nil
+}
+
+// Close completes the archive by writing its manifest, holding genesis without
+// its app state.
+func (w *Writer) Close(height int64, genesis *genutiltypes.AppGenesis) error {
+	appGenesis := *genesis
+	appGenesis.AppState = nil
+
+	bz, err := json.MarshalIndent(Manifest{
+		Height:  height,
+		Genesis: &appGenesis,
+		Modules: w.modules,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(w.dir, ManifestFileName), bz, 0o600)
+}
+
+// chunkWriter writes the genesis state of a module to gzip compressed chunk
+// files of at most chunkSize uncompressed bytes each.
+type chunkWriter struct {
+	dir        string
+	moduleName string
+	chunkSize  int
+
+	file    *os.File
+	zWriter *gzip.Writer
+	hasher  hash.Hash
+	written int
+	hashes  []string
+}
+
+// Write implements io.Writer.
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		if c.file == nil {
+			if err := c.openChunk(); err != nil {
+				return n, err
+			}
+		}
+
+		size := len(p)
+		if left := c.chunkSize - c.written; size > left {
+			size = left
+		}
+
+		written, err := c.zWriter.Write(p[:size])
+		n += written
+		c.written += written
+		if err != nil {
+			return n, err
+		}
+		p = p[size:]
+
+		if c.written == c.chunkSize {
+			if err := c.closeChunk(); err != nil {
+				return n, err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+func (c *chunkWriter) openChunk() error {
+	file, err := os.Create(chunkPath(c.dir, c.moduleName, len(c.hashes)))
+	if err != nil {
+		return err
+	}
+
+	c.file = file
+	c.hasher = sha256.New()
+	c.zWriter = gzip.NewWriter(io.MultiWriter(file, c.hasher))
+	c.written = 0
+
+	return nil
+}
+
+// closeChunk closes the current chunk file, if any, and records its hash.
+func (c *chunkWriter) closeChunk() error {
+	if c.file == nil {
+		return nil
+	}
+
+	err := errors.Join(c.zWriter.Close(), c.file.Close())
+	c.file = nil
+	if err != nil {
+		return err
+	}
+
+	c.hashes = append(c.hashes, hex.EncodeToString(c.hasher.Sum(nil)))
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// echoApp is an application serving the testdata query service.
+type echoApp struct {
+	servertypes.Application
+}
+
+func (a echoApp) RegisterGRPCServer(server gogogrpc.Server) {
+	testdata.RegisterQueryServer(server, testdata.QueryImpl{})
+}
+
+// startEchoServer starts a gRPC server configured with cfg and returns its
+// address and a client context.
+func startEchoServer(t *testing.T, cfg config.GRPCConfig) (string, client.Context) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+
+	grpcSrv, err := servergrpc.NewGRPCServer(clientCtx, echoApp{}, cfg)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	return listener.Addr().String(), clientCtx
+}
+
+// dialEchoServer returns clientCtx connected to address with the given query
+// command arguments.
+func dialEchoServer(t *testing.T, clientCtx client.Context, address string, args ...string) client.Context {
+	t.Helper()
+
+	cmd := &cobra.Command{}
+	flags.AddQueryFlagsToCmd(cmd)
+	require.NoError(t, cmd.ParseFlags(append([]string{"--grpc-addr", address, "--grpc-insecure"}, args...)))
+
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	require.NoError(t, err)
+	t.Cleanup(func() { clientCtx.GRPCClient.Close() })
+
+	return clientCtx
+}
+
+func TestNewGRPCServer_MaxMsgSize(t *testing.T) {
+	// a response just over the default 4MB limit of the clients
+	message := strings.Repeat("a", 4*1024*1024+1024)
+
+	testCases := []struct {
+		name    string
+		cfg     config.GRPCConfig
+		args    []string
+		expCode codes.Code
+	}{
+		{"default client limit", config.GRPCConfig{}, nil, codes.ResourceExhausted},
+		{"raised client limit", config.GRPCConfig{}, []string{"--grpc-max-msg-size", "8388608"}, codes.OK},
+		{"lowered server send limit", config.GRPCConfig{MaxSendMsgSize: 4 * 1024 * 1024}, []string{"--grpc-max-msg-size", "8388608"}, codes.ResourceExhausted},
+		{"lowered server recv limit", config.GRPCConfig{MaxRecvMsgSize: 4 * 1024 * 1024}, []string{"--grpc-max-msg-size", "8388608"}, codes.ResourceExhausted},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, clientCtx := startEchoServer(t, tc.cfg)
+			clientCtx = dialEchoServer(t, clientCtx, address, tc.args...)
+
+			res, err := testdata.NewQueryClient(clientCtx).Echo(context.Background(), &testdata.EchoRequest{Message: message})
+			require.Equal(t, tc.expCode, status.Code(err), err)
+			if tc.expCode == codes.OK {
+				require.Equal(t, message, res.Message)
+			}
+		})
+	}
+}
+
+// compressionRecorder records the compression of the responses received by a
+// client.
+type compressionRecorder struct {
+	compression []string
+}
+
+func (r *compressionRecorder) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleRPC(_ context.Context, s stats.RPCStats) {
+	if h, ok := s.(*stats.InHeader); ok && h.Client {
+		r.compression = append(r.compression, h.Compression)
+	}
+}
+
+func (r *compressionRecorder) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (r *compressionRecorder) HandleConn(context.Context, stats.ConnStats) {}
+
+func TestNewGRPCServer_Compression(t *testing.T) {
+	testCases := []struct {
+		name           string
+		enable         bool
+		expCompression string
+	}{
+		{"compression disabled", false, ""},
+		{"compression enabled", true, gzip.Name},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, _ := startEchoServer(t, config.GRPCConfig{EnableCompression: tc.enable})
+
+			recorder := &compressionRecorder{}
+			conn, err := grpc.Dial(
+				address,
+				grpc.WithInsecure(), //nolint:staticcheck // ignore SA1019, we don't need to use a secure connection for tests
+				grpc.WithStatsHandler(recorder),
+			)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			// the client advertises gzip, which is registered, without
+			// compressing its requests
+			message := strings.Repeat("a", 1024)
+			res, err := testdata.NewQueryClient(conn).Echo(context.Background(), &testdata.EchoRequest{Message: message})
+			require.NoError(t, err)
+			require.Equal(t, message, res.Message)
+			require.Equal(t, []string{tc.expCompression}, recorder.compression)
+		})
+	}
+}
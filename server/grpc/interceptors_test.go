@@ -0,0 +1,74 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// interceptorsApp is an application serving the gRPC health service and
+// providing unary interceptors for the gRPC server.
+type interceptorsApp struct {
+	servertypes.Application
+
+	interceptors []grpc.UnaryServerInterceptor
+}
+
+func (a interceptorsApp) RegisterGRPCServer(server gogogrpc.Server) {
+	healthpb.RegisterHealthServer(server, health.NewServer())
+}
+
+func (a interceptorsApp) GRPCUnaryInterceptors() []grpc.UnaryServerInterceptor {
+	return a.interceptors
+}
+
+func TestNewGRPCServer_UnaryInterceptors(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig)
+
+	var intercepted []string
+	app := interceptorsApp{
+		interceptors: []grpc.UnaryServerInterceptor{
+			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				intercepted = append(intercepted, info.FullMethod)
+				if info.FullMethod == "/grpc.health.v1.Health/Check" {
+					return nil, status.Error(codes.Unavailable, "disabled")
+				}
+				return handler(ctx, req)
+			},
+		},
+	}
+
+	grpcSrv, err := servergrpc.NewGRPCServer(clientCtx, app, config.GRPCConfig{})
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	defer grpcSrv.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, []string{"/grpc.health.v1.Health/Check"}, intercepted)
+}
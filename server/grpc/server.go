@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
 
 	"cosmossdk.io/log"
 
@@ -19,6 +22,13 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino" // Import amino.proto file for reflection
 )
 
+// UnaryInterceptorsProvider is implemented by applications which install unary
+// interceptors on the gRPC server, e.g. the x/circuit interceptor rejecting the
+// queries to disabled query routes.
+type UnaryInterceptorsProvider interface {
+	GRPCUnaryInterceptors() []grpc.UnaryServerInterceptor
+}
+
 // NewGRPCServer returns a correctly configured and initialized gRPC server.
 // Note, the caller is responsible for starting the server. See StartGRPCServer.
 func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig) (*grpc.Server, error) {
@@ -32,11 +42,21 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 		maxRecvMsgSize = config.DefaultGRPCMaxRecvMsgSize
 	}
 
-	grpcSrv := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
 		grpc.MaxSendMsgSize(maxSendMsgSize),
 		grpc.MaxRecvMsgSize(maxRecvMsgSize),
-	)
+	}
+
+	if cfg.EnableCompression {
+		opts = append(opts, grpc.ChainUnaryInterceptor(compressionInterceptor))
+	}
+
+	if p, ok := app.(UnaryInterceptorsProvider); ok {
+		opts = append(opts, grpc.ChainUnaryInterceptor(p.GRPCUnaryInterceptors()...))
+	}
+
+	grpcSrv := grpc.NewServer(opts...)
 
 	app.RegisterGRPCServer(grpcSrv)
 
@@ -68,6 +88,19 @@ func NewGRPCServer(clientCtx client.Context, app types.Application, cfg config.G
 	return grpcSrv, nil
 }
 
+// compressionInterceptor compresses the responses with gzip when the client
+// advertises it supports it.
+func compressionInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	compressors, err := grpc.ClientSupportedCompressors(ctx)
+	if err == nil && slices.Contains(compressors, gzip.Name) {
+		if err := grpc.SetSendCompressor(ctx, gzip.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
 // StartGRPCServer starts the provided gRPC server on the address specified in cfg.
 //
 // Note, this creates a blocking process if the server is started successfully.
@@ -96,7 +129,15 @@ func StartGRPCServer(ctx context.Context, logger log.Logger, cfg config.GRPCConf
 		// The calling process canceled or closed the provided context, so we must
 		// gracefully stop the gRPC server.
 		logger.Info("stopping gRPC server...", "address", cfg.Address)
-		grpcSrv.GracefulStop()
+
+		gracePeriod := cfg.ShutdownGracePeriod
+		if gracePeriod == 0 {
+			gracePeriod = config.DefaultGRPCShutdownGracePeriod
+		}
+
+		if !gracefulStop(grpcSrv, gracePeriod) {
+			logger.Error("in-flight gRPC requests aborted after shutdown grace period", "grace_period", gracePeriod)
+		}
 
 		return nil
 
@@ -105,3 +146,28 @@ func StartGRPCServer(ctx context.Context, logger log.Logger, cfg config.GRPCConf
 		return err
 	}
 }
+
+// gracefulStop stops grpcSrv from accepting new connections and requests, and
+// waits for its in-flight requests to be served. Once gracePeriod elapsed, the
+// requests still in-flight are aborted, failing with an Unavailable error, and
+// false is returned.
+func gracefulStop(grpcSrv *grpc.Server, gracePeriod time.Duration) bool {
+	stopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(gracePeriod)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		return true
+
+	case <-timer.C:
+		grpcSrv.Stop()
+		<-stopped
+		return false
+	}
+}
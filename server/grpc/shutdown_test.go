@@ -0,0 +1,92 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+)
+
+// slowHealthServer is a health server whose checks are served after a delay.
+type slowHealthServer struct {
+	healthpb.UnimplementedHealthServer
+
+	delay   time.Duration
+	started chan struct{}
+}
+
+func (s slowHealthServer) Check(ctx context.Context, _ *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	close(s.started)
+
+	select {
+	case <-time.After(s.delay):
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestStartGRPCServer_Shutdown(t *testing.T) {
+	testCases := []struct {
+		name        string
+		delay       time.Duration
+		gracePeriod time.Duration
+		expCode     codes.Code
+	}{
+		{"in-flight query completes within grace period", 200 * time.Millisecond, 5 * time.Second, codes.OK},
+		{"in-flight query aborted after grace period", time.Minute, 100 * time.Millisecond, codes.Unavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			address := listener.Addr().String()
+			require.NoError(t, listener.Close())
+
+			grpcSrv := grpc.NewServer()
+			started := make(chan struct{})
+			healthpb.RegisterHealthServer(grpcSrv, slowHealthServer{delay: tc.delay, started: started})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan error)
+			go func() {
+				cfg := config.GRPCConfig{Address: address, ShutdownGracePeriod: tc.gracePeriod}
+				stopped <- servergrpc.StartGRPCServer(ctx, log.NewNopLogger(), cfg, grpcSrv)
+			}()
+
+			conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			require.NoError(t, err)
+			defer conn.Close()
+
+			queryErr := make(chan error)
+			go func() {
+				_, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true))
+				queryErr <- err
+			}()
+
+			// shut down the server while the query is in-flight
+			<-started
+			cancel()
+
+			require.Equal(t, tc.expCode, status.Code(<-queryErr))
+			require.NoError(t, <-stopped)
+
+			// new queries are refused once the server is stopped
+			_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+			require.Equal(t, codes.Unavailable, status.Code(err))
+		})
+	}
+}
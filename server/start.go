@@ -3,11 +3,13 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"runtime/pprof"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/abci/server"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
@@ -25,6 +27,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -48,6 +51,9 @@ const (
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
+	FlagTxExecutionTimeout = "tx-execution-timeout"
+	FlagSigVerifyCacheSize = "sig-verification-cache-size"
+	FlagQueryGasLimit      = "query-gas-limit"
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
@@ -55,16 +61,22 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningMaxBatchSize = "pruning-max-batch-size"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
 
+	FlagHistoricalVersionCacheSize = "historical-version-cache-size"
+
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 
+	// telemetry-related flags
+	FlagTelemetryStoreOperationMetrics = "telemetry.enable-store-operation-metrics"
+
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
 	FlagAPISwagger            = "api.swagger"
@@ -82,7 +94,8 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs          = "mempool.max-txs"
+	FlagMempoolMaxTxsPerSender = "mempool.max-txs-per-sender"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -162,11 +175,15 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Duration(FlagTxExecutionTimeout, serverconfig.DefaultTxExecutionTimeout, "Maximum duration of the execution of a tx in CheckTx and Simulate (0 to disable)")
+	cmd.Flags().Uint64(FlagSigVerifyCacheSize, 0, "Number of txs whose signatures verified in CheckTx are cached for their execution in blocks (0 to disable)")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a gRPC state query can consume (0 for unlimited)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningMaxBatchSize, rootmulti.DefaultPruningMaxBatchSize, "Maximum number of heights deleted from disk in a single pruning batch")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -184,7 +201,10 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Uint64(FlagHistoricalVersionCacheSize, 0, "Number of past heights whose loaded stores are kept in memory for queries (0 to disable)")
+	cmd.Flags().Bool(FlagTelemetryStoreOperationMetrics, false, "Count the operations made on each store and emit them as telemetry counters")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Sets the maximum number of txs of a sender in the app-side mempool (0 for no limit)")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -368,8 +388,35 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 	ctx, cancelFn := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	// listen for quit signals so the calling parent process can gracefully exit
-	ListenForQuitSignals(cancelFn, svrCtx.Logger)
+	// listen for quit signals so the calling parent process can gracefully exit,
+	// reporting the node as draining while its in-flight requests are served
+	ListenForQuitSignals(func() {
+		nodeservice.SetDraining(true)
+		cancelFn()
+	}, svrCtx.Logger)
+
+	// The servers are stopped first, once their in-flight requests are served,
+	// then the node and finally the stores, so that no request is served with
+	// closed stores.
+	defer func() {
+		if tmNode != nil && tmNode.IsRunning() {
+			_ = tmNode.Stop()
+		}
+
+		if closer, ok := app.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				svrCtx.Logger.Error("failed to close application", "err", err)
+			}
+		}
+
+		if err := db.Close(); err != nil {
+			svrCtx.Logger.Error("failed to close application database", "err", err)
+		}
+
+		if traceWriterCleanup != nil {
+			traceWriterCleanup()
+		}
+	}()
 
 	if config.GRPC.Enable {
 		_, port, err := net.SplitHostPort(config.GRPC.Address)
@@ -453,17 +500,6 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 		return nil
 	})
 
-	// deferred cleanup function
-	defer func() {
-		if tmNode != nil && tmNode.IsRunning() {
-			_ = tmNode.Stop()
-		}
-
-		if traceWriterCleanup != nil {
-			traceWriterCleanup()
-		}
-	}()
-
 	// wait for signal capture and gracefully return
 	return g.Wait()
 }
@@ -88,4 +88,25 @@ type (
 		opts AppOptions,
 		modulesToExport []string,
 	) (ExportedApp, error)
+
+	// GenesisArchiveWriter writes the genesis state of the modules of an app to
+	// an archive, one module after the other.
+	GenesisArchiveWriter interface {
+		// WriteModule writes the genesis state of a module as JSON, which is
+		// written by write to the given writer.
+		WriteModule(moduleName string, write func(w io.Writer) error) error
+	}
+
+	// GenesisArchiveExporter is a function that writes the genesis state of the
+	// app modules at a height to a genesis archive, and returns the validator
+	// set, height and consensus params of the app. The returned ExportedApp has
+	// no AppState.
+	GenesisArchiveExporter func(
+		logger log.Logger,
+		db dbm.DB,
+		traceWriter io.Writer,
+		height int64,
+		opts AppOptions,
+		archive GenesisArchiveWriter,
+	) (ExportedApp, error)
 )
@@ -10,6 +10,7 @@ import (
 	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
 	authzmodulev1 "cosmossdk.io/api/cosmos/authz/module/v1"
 	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	circuitmodulev1 "cosmossdk.io/api/cosmos/circuit/module/v1"
 	consensusmodulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
 	crisismodulev1 "cosmossdk.io/api/cosmos/crisis/module/v1"
 	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
@@ -28,6 +29,7 @@ import (
 	vestingmodulev1 "cosmossdk.io/api/cosmos/vesting/module/v1"
 	"cosmossdk.io/depinject"
 
+	_ "cosmossdk.io/x/circuit/module"                 // import for side-effects
 	_ "cosmossdk.io/x/evidence"                       // import for side-effects
 	_ "cosmossdk.io/x/feegrant/module"                // import for side-effects
 	_ "cosmossdk.io/x/nft/module"                     // import for side-effects
@@ -48,6 +50,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/staking"      // import for side-effects
 
 	"cosmossdk.io/core/appconfig"
+	circuittypes "cosmossdk.io/x/circuit/types"
 	evidencetypes "cosmossdk.io/x/evidence/types"
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/nft"
@@ -96,72 +99,67 @@ var (
 		// govtypes.ModuleName
 	}
 
-	// application configuration (used by depinject)
-	AppConfig = depinject.Configs(appconfig.Compose(&appv1alpha1.Config{
-		Modules: []*appv1alpha1.ModuleConfig{
-			{
-				Name: runtime.ModuleName,
-				Config: appconfig.WrapAny(&runtimev1alpha1.Module{
-					AppName: "SimApp",
-					// During begin block slashing happens after distr.BeginBlocker so that
-					// there is nothing left over in the validator fee pool, so as to keep the
-					// CanWithdrawInvariant invariant.
-					// NOTE: staking module is required if HistoricalEntries param > 0
-					BeginBlockers: []string{
-						upgradetypes.ModuleName,
-						minttypes.ModuleName,
-						distrtypes.ModuleName,
-						slashingtypes.ModuleName,
-						evidencetypes.ModuleName,
-						stakingtypes.ModuleName,
-						genutiltypes.ModuleName,
-						authz.ModuleName,
-					},
-					EndBlockers: []string{
-						crisistypes.ModuleName,
-						govtypes.ModuleName,
-						stakingtypes.ModuleName,
-						genutiltypes.ModuleName,
-						feegrant.ModuleName,
-						group.ModuleName,
-					},
-					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
-						{
-							ModuleName: authtypes.ModuleName,
-							KvStoreKey: "acc",
-						},
-					},
-					// NOTE: The genutils module must occur after staking so that pools are
-					// properly initialized with tokens from genesis accounts.
-					// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
-					InitGenesis: []string{
-						authtypes.ModuleName,
-						banktypes.ModuleName,
-						distrtypes.ModuleName,
-						stakingtypes.ModuleName,
-						slashingtypes.ModuleName,
-						govtypes.ModuleName,
-						minttypes.ModuleName,
-						crisistypes.ModuleName,
-						genutiltypes.ModuleName,
-						evidencetypes.ModuleName,
-						authz.ModuleName,
-						feegrant.ModuleName,
-						nft.ModuleName,
-						group.ModuleName,
-						paramstypes.ModuleName,
-						upgradetypes.ModuleName,
-						vestingtypes.ModuleName,
-						consensustypes.ModuleName,
-					},
-					// When ExportGenesis is not specified, the export genesis module order
-					// is equal to the init genesis order
-					// ExportGenesis: []string{},
-					// Uncomment if you want to set a custom migration order here.
-					// OrderMigrations: []string{},
-				}),
-			},
-			{
+	// beginBlockers is the order of the BeginBlock of the modules.
+	// During begin block slashing happens after distr.BeginBlocker so that
+	// there is nothing left over in the validator fee pool, so as to keep the
+	// CanWithdrawInvariant invariant.
+	// NOTE: staking module is required if HistoricalEntries param > 0
+	beginBlockers = []string{
+		upgradetypes.ModuleName,
+		minttypes.ModuleName,
+		distrtypes.ModuleName,
+		slashingtypes.ModuleName,
+		evidencetypes.ModuleName,
+		stakingtypes.ModuleName,
+		genutiltypes.ModuleName,
+		authz.ModuleName,
+	}
+
+	// endBlockers is the order of the EndBlock of the modules.
+	endBlockers = []string{
+		crisistypes.ModuleName,
+		govtypes.ModuleName,
+		stakingtypes.ModuleName,
+		distrtypes.ModuleName,
+		genutiltypes.ModuleName,
+		feegrant.ModuleName,
+		group.ModuleName,
+		banktypes.ModuleName,
+		circuittypes.ModuleName,
+	}
+
+	// initGenesis is the order of the InitGenesis of the modules.
+	// NOTE: The genutils module must occur after staking so that pools are
+	// properly initialized with tokens from genesis accounts.
+	// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
+	initGenesis = []string{
+		authtypes.ModuleName,
+		banktypes.ModuleName,
+		distrtypes.ModuleName,
+		stakingtypes.ModuleName,
+		slashingtypes.ModuleName,
+		govtypes.ModuleName,
+		minttypes.ModuleName,
+		crisistypes.ModuleName,
+		genutiltypes.ModuleName,
+		evidencetypes.ModuleName,
+		authz.ModuleName,
+		feegrant.ModuleName,
+		nft.ModuleName,
+		group.ModuleName,
+		paramstypes.ModuleName,
+		upgradetypes.ModuleName,
+		vestingtypes.ModuleName,
+		consensustypes.ModuleName,
+		circuittypes.ModuleName,
+	}
+
+	// appModules is the registry of the modules of the SimApp. It drives the
+	// keeper construction, the store keys, the module manager and the module
+	// orders of the app, see newAppConfig.
+	appModules = []appModule{
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name: authtypes.ModuleName,
 				Config: appconfig.WrapAny(&authmodulev1.Module{
 					Bech32Prefix:             "cosmos",
@@ -171,85 +169,189 @@ var (
 					// Authority: "cosmos1cwwv22j5ca08ggdv9c2uky355k908694z577tv", // or a specific address
 				}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   vestingtypes.ModuleName,
 				Config: appconfig.WrapAny(&vestingmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name: banktypes.ModuleName,
 				Config: appconfig.WrapAny(&bankmodulev1.Module{
 					BlockedModuleAccountsOverride: blockAccAddrs,
 				}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   stakingtypes.ModuleName,
 				Config: appconfig.WrapAny(&stakingmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   slashingtypes.ModuleName,
 				Config: appconfig.WrapAny(&slashingmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   paramstypes.ModuleName,
 				Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   "tx",
 				Config: appconfig.WrapAny(&txconfigv1.Config{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   genutiltypes.ModuleName,
 				Config: appconfig.WrapAny(&genutilmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   authz.ModuleName,
 				Config: appconfig.WrapAny(&authzmodulev1.Module{}),
 			},
-			{
+			optional: true,
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   upgradetypes.ModuleName,
 				Config: appconfig.WrapAny(&upgrademodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   distrtypes.ModuleName,
 				Config: appconfig.WrapAny(&distrmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   evidencetypes.ModuleName,
 				Config: appconfig.WrapAny(&evidencemodulev1.Module{}),
 			},
-			{
+			optional: true,
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   minttypes.ModuleName,
 				Config: appconfig.WrapAny(&mintmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name: group.ModuleName,
 				Config: appconfig.WrapAny(&groupmodulev1.Module{
 					MaxExecutionPeriod: durationpb.New(time.Second * 1209600),
 					MaxMetadataLen:     255,
 				}),
 			},
-			{
+			optional: true,
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   nft.ModuleName,
 				Config: appconfig.WrapAny(&nftmodulev1.Module{}),
 			},
-			{
+			optional: true,
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   feegrant.ModuleName,
 				Config: appconfig.WrapAny(&feegrantmodulev1.Module{}),
 			},
-			{
+			optional: true,
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
+				Name:   circuittypes.ModuleName,
+				Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
+			},
+			optional: true,
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   govtypes.ModuleName,
 				Config: appconfig.WrapAny(&govmodulev1.Module{}),
 			},
-			{
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   crisistypes.ModuleName,
 				Config: appconfig.WrapAny(&crisismodulev1.Module{}),
 			},
-			{
+			optional: true,
+		},
+		{
+			config: &appv1alpha1.ModuleConfig{
 				Name:   consensustypes.ModuleName,
 				Config: appconfig.WrapAny(&consensusmodulev1.Module{}),
 			},
 		},
-	}),
+	}
+
+	// application configuration (used by depinject)
+	AppConfig = newAppConfig(nil)
+)
+
+// appModule is an entry of the module registry of the SimApp.
+type appModule struct {
+	config *appv1alpha1.ModuleConfig
+
+	// optional modules can be removed from the app, see WithoutModules.
+	optional bool
+}
+
+// newAppConfig returns the depinject configuration of the SimApp without the
+// given disabled modules.
+func newAppConfig(disabled map[string]bool) depinject.Config {
+	enabled := func(moduleNames []string) []string {
+		var names []string
+		for _, name := range moduleNames {
+			if !disabled[name] {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+
+	modules := []*appv1alpha1.ModuleConfig{
+		{
+			Name: runtime.ModuleName,
+			Config: appconfig.WrapAny(&runtimev1alpha1.Module{
+				AppName:       "SimApp",
+				BeginBlockers: enabled(beginBlockers),
+				EndBlockers:   enabled(endBlockers),
+				OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
+					{
+						ModuleName: authtypes.ModuleName,
+						KvStoreKey: "acc",
+					},
+				},
+				InitGenesis: enabled(initGenesis),
+				// When ExportGenesis is not specified, the export genesis module order
+				// is equal to the init genesis order
+				// ExportGenesis: []string{},
+				// Uncomment if you want to set a custom migration order here.
+				// OrderMigrations: []string{},
+			}),
+		},
+	}
+	for _, m := range appModules {
+		if !disabled[m.config.Name] {
+			modules = append(modules, m.config)
+		}
+	}
+
+	return depinject.Configs(appconfig.Compose(&appv1alpha1.Config{Modules: modules}),
 		depinject.Supply(
 			// supply custom module basics
 			map[string]module.AppModuleBasic{
@@ -261,4 +363,4 @@ var (
 				),
 			},
 		))
-)
+}
@@ -0,0 +1,109 @@
+package simapp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// ModuleOption configures the modules wired in the SimApp, see
+// NewSimAppWithModules.
+type ModuleOption func(*moduleOptions)
+
+// moduleOptions holds the modules disabled by the module options.
+type moduleOptions struct {
+	disabled map[string]bool
+	errs     []string
+}
+
+// WithModules keeps only the given optional modules in the SimApp, disabling
+// the other optional modules. Modules which are not optional are always
+// included.
+func WithModules(moduleNames ...string) ModuleOption {
+	return func(o *moduleOptions) {
+		keep := make(map[string]bool, len(moduleNames))
+		for _, name := range moduleNames {
+			if _, found := findAppModule(name); !found {
+				o.errs = append(o.errs, fmt.Sprintf("unknown module %s", name))
+			}
+			keep[name] = true
+		}
+
+		for _, m := range appModules {
+			if m.optional && !keep[m.config.Name] {
+				o.disabled[m.config.Name] = true
+			}
+		}
+	}
+}
+
+// WithoutModules removes the given optional modules from the SimApp.
+func WithoutModules(moduleNames ...string) ModuleOption {
+	return func(o *moduleOptions) {
+		for _, name := range moduleNames {
+			m, found := findAppModule(name)
+			switch {
+			case !found:
+				o.errs = append(o.errs, fmt.Sprintf("unknown module %s", name))
+			case !m.optional:
+				o.errs = append(o.errs, fmt.Sprintf("module %s cannot be disabled", name))
+			default:
+				o.disabled[name] = true
+			}
+		}
+	}
+}
+
+// newModuleOptions applies the given module options, and returns the names of
+// the disabled modules.
+func newModuleOptions(opts ...ModuleOption) (map[string]bool, error) {
+	o := &moduleOptions{disabled: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if len(o.errs) != 0 {
+		return nil, fmt.Errorf("invalid module options: %s", strings.Join(o.errs, "; "))
+	}
+
+	return o.disabled, nil
+}
+
+// findAppModule returns the entry of the module registry of the given module.
+func findAppModule(moduleName string) (appModule, bool) {
+	for _, m := range appModules {
+		if m.config.Name == moduleName {
+			return m, true
+		}
+	}
+
+	return appModule{}, false
+}
+
+// validateModuleDependencies checks that none of the modules registered in the
+// module manager and implementing module.HasDependencies depends on a disabled
+// module.
+func validateModuleDependencies(modules map[string]interface{}, disabled map[string]bool) error {
+	var violations []string
+	for name, m := range modules {
+		m, ok := m.(module.HasDependencies)
+		if !ok {
+			continue
+		}
+
+		for _, dep := range m.Dependencies() {
+			if disabled[dep] {
+				violations = append(violations, fmt.Sprintf("%s depends on %s", name, dep))
+			}
+		}
+	}
+
+	if len(violations) != 0 {
+		sort.Strings(violations)
+		return fmt.Errorf("enabled modules depend on disabled modules: %s", strings.Join(violations, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+//go:build !app_v1
+
+package simapp
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSimAppWithoutModules(t *testing.T) {
+	app := NewSimAppWithModules(log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+		simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+		[]ModuleOption{WithoutModules(nft.ModuleName, group.ModuleName)},
+	)
+
+	for _, name := range []string{nft.ModuleName, group.ModuleName} {
+		require.NotContains(t, app.ModuleManager.Modules, name)
+		require.NotContains(t, app.ModuleManager.OrderInitGenesis, name)
+		require.NotContains(t, app.ModuleManager.OrderEndBlockers, name)
+		require.Nil(t, app.GetKey(name))
+	}
+	require.Contains(t, app.ModuleManager.Modules, authz.ModuleName)
+	require.NotNil(t, app.GetKey(authz.ModuleName))
+
+	genesisState := app.DefaultGenesis()
+	require.NotContains(t, genesisState, nft.ModuleName)
+	require.NotContains(t, genesisState, group.ModuleName)
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+	genesisState, err = simtestutil.GenesisStateWithValSet(app.AppCodec(), genesisState, valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	for i := 0; i < 3; i++ {
+		header := cmtproto.Header{
+			Height:             app.LastBlockHeight() + 1,
+			AppHash:            app.LastCommitID().Hash,
+			ValidatorsHash:     valSet.Hash(),
+			NextValidatorsHash: valSet.Hash(),
+		}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+	require.Equal(t, int64(4), app.LastBlockHeight())
+
+	ctx := app.NewContext(true, cmtproto.Header{})
+	require.Len(t, app.StakingKeeper.GetAllValidators(ctx), 1)
+}
+
+func TestSimAppWithModules(t *testing.T) {
+	app := NewSimAppWithModules(log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+		simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+		[]ModuleOption{WithModules(authz.ModuleName)},
+	)
+
+	for _, m := range appModules {
+		if !m.optional {
+			continue
+		}
+		if m.config.Name == authz.ModuleName {
+			require.Contains(t, app.ModuleManager.Modules, m.config.Name)
+		} else {
+			require.NotContains(t, app.ModuleManager.Modules, m.config.Name)
+		}
+	}
+	require.Contains(t, app.ModuleManager.Modules, stakingtypes.ModuleName)
+	require.Nil(t, app.CrisisKeeper)
+}
+
+func TestSimAppInvalidModuleOptions(t *testing.T) {
+	newApp := func(opts ...ModuleOption) func() {
+		return func() {
+			NewSimAppWithModules(log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+				simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), opts)
+		}
+	}
+
+	require.PanicsWithError(t, "invalid module options: unknown module foo", newApp(WithoutModules("foo")))
+	require.PanicsWithError(t, "invalid module options: unknown module foo", newApp(WithModules("foo")))
+	require.PanicsWithError(t, "invalid module options: module staking cannot be disabled", newApp(WithoutModules(stakingtypes.ModuleName)))
+}
+
+func TestValidateModuleDependencies(t *testing.T) {
+	app := Setup(t, false)
+
+	require.NoError(t, validateModuleDependencies(app.ModuleManager.Modules, map[string]bool{nft.ModuleName: true}))
+	require.EqualError(t,
+		validateModuleDependencies(app.ModuleManager.Modules, map[string]bool{stakingtypes.ModuleName: true}),
+		"enabled modules depend on disabled modules: crisis depends on staking; genutil depends on staking; slashing depends on staking",
+	)
+}
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -16,8 +17,12 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	circuitmodule "cosmossdk.io/x/circuit/module"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server/genesisarchive"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/conformance"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +36,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -72,6 +78,48 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppExportGenesisArchive(t *testing.T) {
+	db := dbm.NewMemDB()
+	logger := log.NewTestLogger(t)
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:  logger.With("instance", "first"),
+		DB:      db,
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	app.Commit()
+
+	app2 := NewSimApp(logger.With("instance", "second"), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	exported, err := app2.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	// chunks are kept small so that module genesis states span several of them
+	dir := t.TempDir()
+	archive, err := genesisarchive.NewWriter(dir, 512)
+	require.NoError(t, err)
+	archived, err := app2.ExportGenesisArchive(archive)
+	require.NoError(t, err)
+	require.Equal(t, exported.Height, archived.Height)
+	require.Equal(t, exported.Validators, archived.Validators)
+	require.NoError(t, archive.Close(archived.Height, genutiltypes.NewAppGenesisWithVersion("test-chain", nil)))
+
+	// the genesis doc rebuilt from the archive holds the exported app state
+	reader, err := genesisarchive.NewReader(dir)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, reader.WriteGenesis(&buf))
+
+	var appGenesis genutiltypes.AppGenesis
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &appGenesis))
+
+	var expected, actual map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &expected))
+	require.NoError(t, json.Unmarshal(appGenesis.AppState, &actual))
+	require.Len(t, actual, len(expected))
+	for name, state := range expected {
+		require.JSONEq(t, string(state), string(actual[name]), name)
+	}
+}
+
 func TestRunMigrations(t *testing.T) {
 	db := dbm.NewMemDB()
 	logger := log.NewTestLogger(t)
@@ -199,6 +247,7 @@ func TestRunMigrations(t *testing.T) {
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
+					"circuit":      circuitmodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -212,6 +261,278 @@ func TestRunMigrations(t *testing.T) {
 	}
 }
 
+// newConfiguratorWithoutBank returns a new configurator with the services of
+// all modules except x/bank registered, so that tests can register the x/bank
+// migrations.
+func newConfiguratorWithoutBank(t *testing.T, app *SimApp) module.Configurator {
+	t.Helper()
+
+	msgRouter, queryRouter := baseapp.NewMsgServiceRouter(), baseapp.NewGRPCQueryRouter()
+	msgRouter.SetInterfaceRegistry(app.InterfaceRegistry())
+	queryRouter.SetInterfaceRegistry(app.InterfaceRegistry())
+	configurator := module.NewConfigurator(app.appCodec, msgRouter, queryRouter)
+	for name, mod := range app.ModuleManager.Modules {
+		if name == banktypes.ModuleName {
+			continue
+		}
+
+		if mod, ok := mod.(module.HasServices); ok {
+			mod.RegisterServices(configurator)
+		}
+
+		if mod, ok := mod.(appmodule.HasServices); ok {
+			require.NoError(t, mod.RegisterServices(configurator))
+		}
+
+		require.NoError(t, configurator.Error())
+	}
+
+	return configurator
+}
+
+func TestRunBatchedMigrations(t *testing.T) {
+	db := dbm.NewMemDB()
+	logger := log.NewTestLogger(t)
+	app := NewSimApp(logger.With("instance", "simapp"), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	configurator := newConfiguratorWithoutBank(t, app)
+
+	ctx := app.NewContext(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	// Populate the entries migrated by the batched 2->3 migration.
+	storeKey := app.GetKey(banktypes.StoreKey)
+	keyPrefix := []byte("batched")
+	const numEntries = 10
+	for i := 0; i < numEntries; i++ {
+		ctx.KVStore(storeKey).Set(append(keyPrefix, byte(i)), []byte{0})
+	}
+
+	called := 0
+	require.NoError(t, configurator.RegisterMigration(banktypes.ModuleName, 1, func(sdk.Context) error {
+		called++
+		return nil
+	}))
+
+	// The migration fails on the 8th entry the first time it is run, simulating
+	// an upgrade handler failing in the middle of the migration.
+	failed := false
+	require.NoError(t, configurator.RegisterBatchedMigration(banktypes.ModuleName, 2,
+		testutil.NewBatchedValueMigration(storeKey, keyPrefix, 3, func(key, value []byte) ([]byte, error) {
+			if key[0] == 7 && !failed {
+				failed = true
+				return nil, fmt.Errorf("migration interrupted")
+			}
+
+			return []byte{value[0] + 1}, nil
+		}),
+	))
+	for i := uint64(3); i < (bank.AppModule{}).ConsensusVersion(); i++ {
+		require.NoError(t, configurator.RegisterMigration(banktypes.ModuleName, i, func(sdk.Context) error {
+			return nil
+		}))
+	}
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[banktypes.ModuleName] = 1
+	recorder := &progressRecorder{MigrationProgressReporter: app.UpgradeKeeper}
+	runMigrations := func(ctx sdk.Context) (module.VersionMap, error) {
+		recorder.processed = nil
+		return app.ModuleManager.RunMigrations(ctx, configurator, fromVM, module.WithMigrationProgressReporter(recorder))
+	}
+
+	// The failing run is discarded with its block, as its writes are never
+	// committed.
+	failingCtx, _ := ctx.CacheContext()
+	_, err := runMigrations(failingCtx)
+	require.EqualError(t, err, "migration interrupted")
+	require.Equal(t, []uint64{3, 6}, recorder.processed)
+
+	progress := app.UpgradeKeeper.GetMigrationProgress()
+	require.Len(t, progress, 1)
+	require.Equal(t, banktypes.ModuleName, progress[0].ModuleName)
+	require.Equal(t, uint64(2), progress[0].Step)
+	require.Equal(t, uint64(6), progress[0].Processed)
+	require.Equal(t, uint64(numEntries), progress[0].Total)
+
+	// Running the migrations again starts from scratch, the progress being
+	// reported after every batch.
+	toVM, err := runMigrations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bank.AppModule{}.ConsensusVersion(), toVM[banktypes.ModuleName])
+	require.Equal(t, 2, called)
+	require.Equal(t, []uint64{3, 6, 9, numEntries}, recorder.processed)
+	for i := 0; i < numEntries; i++ {
+		require.Equal(t, []byte{1}, ctx.KVStore(storeKey).Get(append(keyPrefix, byte(i))))
+	}
+
+	progress = app.UpgradeKeeper.GetMigrationProgress()
+	require.Equal(t, uint64(numEntries), progress[0].Processed)
+}
+
+func TestRunBatchedMigrations_KillResume(t *testing.T) {
+	db := dbm.NewMemDB()
+	logger := log.NewTestLogger(t)
+	app := NewSimApp(logger.With("instance", "simapp"), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	configurator := newConfiguratorWithoutBank(t, app)
+
+	ctx := app.NewContext(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	// Populate the entries migrated by the batched 2->3 migration.
+	storeKey := app.GetKey(banktypes.StoreKey)
+	keyPrefix := []byte("batched")
+	const numEntries = 10
+	for i := 0; i < numEntries; i++ {
+		ctx.KVStore(storeKey).Set(append(keyPrefix, byte(i)), []byte{0})
+	}
+
+	called := 0
+	require.NoError(t, configurator.RegisterMigration(banktypes.ModuleName, 1, func(ctx sdk.Context) error {
+		called++
+		ctx.KVStore(storeKey).Set([]byte("migrated-from-v1"), []byte{1})
+		return nil
+	}))
+
+	// The node is killed while migrating the 8th entry the first time.
+	var (
+		killed   bool
+		migrated []byte
+	)
+	require.NoError(t, configurator.RegisterBatchedMigration(banktypes.ModuleName, 2,
+		testutil.NewBatchedValueMigration(storeKey, keyPrefix, 3, func(key, value []byte) ([]byte, error) {
+			if key[0] == 7 && !killed {
+				killed = true
+				return nil, fmt.Errorf("node killed")
+			}
+
+			migrated = append(migrated, key[0])
+			return []byte{value[0] + 1}, nil
+		}),
+	))
+	for i := uint64(3); i < (bank.AppModule{}).ConsensusVersion(); i++ {
+		require.NoError(t, configurator.RegisterMigration(banktypes.ModuleName, i, func(sdk.Context) error {
+			return nil
+		}))
+	}
+
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[banktypes.ModuleName] = 1
+	recorder := &progressRecorder{MigrationProgressReporter: app.UpgradeKeeper}
+	runMigrations := func(ctx sdk.Context) (module.VersionMap, error) {
+		recorder.processed = nil
+
+		checkpoints, err := app.UpgradeKeeper.OpenMigrationCheckpointDB()
+		require.NoError(t, err)
+		defer checkpoints.Close()
+
+		return app.ModuleManager.RunMigrations(ctx, configurator, fromVM,
+			module.WithMigrationProgressReporter(recorder),
+			module.WithMigrationCheckpointStore(checkpoints, app.GetStoreKeys()),
+		)
+	}
+
+	// The writes of the killed run are lost with its uncommitted block, but its
+	// checkpoints are kept in the node-local database.
+	killedCtx, _ := ctx.CacheContext()
+	_, err := runMigrations(killedCtx)
+	require.EqualError(t, err, "node killed")
+	require.Equal(t, []uint64{3, 6}, recorder.processed)
+	require.Equal(t, []byte{0, 1, 2, 3, 4, 5, 6}, migrated)
+	require.Nil(t, ctx.KVStore(storeKey).Get([]byte("migrated-from-v1")))
+
+	// After the restart, the block is replayed on the last committed state: the
+	// checkpointed writes are replayed, the 1->2 migration is not run again,
+	// and the batched migration continues from its last checkpoint.
+	migrated = nil
+	toVM, err := runMigrations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bank.AppModule{}.ConsensusVersion(), toVM[banktypes.ModuleName])
+	require.Equal(t, 1, called)
+	require.Equal(t, []byte{1}, ctx.KVStore(storeKey).Get([]byte("migrated-from-v1")))
+	require.Equal(t, []byte{6, 7, 8, 9}, migrated)
+	require.Equal(t, []uint64{9, numEntries}, recorder.processed)
+	for i := 0; i < numEntries; i++ {
+		require.Equal(t, []byte{1}, ctx.KVStore(storeKey).Get(append(keyPrefix, byte(i))), "entry %d", i)
+	}
+
+	// The checkpoints are cleared once the migrations have been run.
+	checkpoints, err := app.UpgradeKeeper.OpenMigrationCheckpointDB()
+	require.NoError(t, err)
+	defer checkpoints.Close()
+	for version := uint64(1); version < 3; version++ {
+		batches, err := checkpoints.MigrationBatches(ctx.BlockHeight(), banktypes.ModuleName, version)
+		require.NoError(t, err)
+		require.Empty(t, batches)
+	}
+}
+
+// progressRecorder records the number of processed items of every progress
+// report before forwarding it to the wrapped MigrationProgressReporter.
+type progressRecorder struct {
+	module.MigrationProgressReporter
+	processed []uint64
+}
+
+func (r *progressRecorder) ReportMigrationProgress(moduleName string, step, processed, total uint64) {
+	r.processed = append(r.processed, processed)
+	r.MigrationProgressReporter.ReportMigrationProgress(moduleName, step, processed, total)
+}
+
+func TestMigrationRunning(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewTestLogger(t), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+
+	// Create a new configurator for the purpose of this test, and register all
+	// modules except x/bank, whose migrations check the migration state.
+	msgRouter, queryRouter := baseapp.NewMsgServiceRouter(), baseapp.NewGRPCQueryRouter()
+	msgRouter.SetInterfaceRegistry(app.InterfaceRegistry())
+	queryRouter.SetInterfaceRegistry(app.InterfaceRegistry())
+	configurator := module.NewConfigurator(app.appCodec, msgRouter, queryRouter)
+	for name, mod := range app.ModuleManager.Modules {
+		if name == banktypes.ModuleName {
+			continue
+		}
+
+		if mod, ok := mod.(module.HasServices); ok {
+			mod.RegisterServices(configurator)
+		}
+
+		if mod, ok := mod.(appmodule.HasServices); ok {
+			require.NoError(t, mod.RegisterServices(configurator))
+		}
+
+		require.NoError(t, configurator.Error())
+	}
+
+	failing := true
+	require.NoError(t, configurator.RegisterMigration(banktypes.ModuleName, 1, func(sdk.Context) error {
+		require.True(t, module.IsMigrationRunning())
+		if failing {
+			return fmt.Errorf("migration failed")
+		}
+		return nil
+	}))
+	for i := uint64(2); i < (bank.AppModule{}).ConsensusVersion(); i++ {
+		require.NoError(t, configurator.RegisterMigration(banktypes.ModuleName, i, func(sdk.Context) error {
+			require.True(t, module.IsMigrationRunning())
+			return nil
+		}))
+	}
+
+	ctx := app.NewContext(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	fromVM := app.ModuleManager.GetVersionMap()
+	fromVM[banktypes.ModuleName] = 1
+	require.False(t, module.IsMigrationRunning())
+
+	// the migration state is cleared whether the migrations fail or succeed
+	_, err := app.ModuleManager.RunMigrations(ctx, configurator, fromVM)
+	require.EqualError(t, err, "migration failed")
+	require.False(t, module.IsMigrationRunning())
+
+	failing = false
+	_, err = app.ModuleManager.RunMigrations(ctx, configurator, fromVM)
+	require.NoError(t, err)
+	require.False(t, module.IsMigrationRunning())
+}
+
 func TestInitGenesisOnMigration(t *testing.T) {
 	db := dbm.NewMemDB()
 	app := NewSimApp(log.NewTestLogger(t), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
@@ -287,3 +608,10 @@ func TestProtoAnnotations(t *testing.T) {
 	err = msgservice.ValidateProtoAnnotations(r)
 	require.NoError(t, err)
 }
+
+// TestMsgJSONConformance tests that all the messages registered in the app
+// round trip through their proto3 JSON encoding.
+func TestMsgJSONConformance(t *testing.T) {
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	conformance.RunJSONConformance(t, conformance.JSONConfig{Codec: app.AppCodec()})
+}
@@ -9,9 +9,12 @@ import (
 
 	"cosmossdk.io/log"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 
 	"cosmossdk.io/depinject"
 	storetypes "cosmossdk.io/store/types"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	nftkeeper "cosmossdk.io/x/nft/keeper"
@@ -78,6 +81,7 @@ type SimApp struct {
 	AuthzKeeper           authzkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
+	CircuitKeeper         circuitkeeper.Keeper
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
@@ -104,13 +108,50 @@ func NewSimApp(
 	appOpts servertypes.AppOptions,
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *SimApp {
+	return NewSimAppWithModules(logger, db, traceStore, loadLatest, appOpts, nil, baseAppOptions...)
+}
+
+// NewSimAppWithModules returns a reference to an initialized SimApp, with the
+// modules configured by the given module options, e.g.
+//
+//	NewSimAppWithModules(logger, db, nil, true, appOpts, []ModuleOption{WithoutModules(nft.ModuleName, group.ModuleName)})
+//
+// It panics if the options are invalid or if an enabled module depends on a
+// disabled one.
+func NewSimAppWithModules(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	loadLatest bool,
+	appOpts servertypes.AppOptions,
+	moduleOpts []ModuleOption,
+	baseAppOptions ...func(*baseapp.BaseApp),
+) *SimApp {
+	disabled, err := newModuleOptions(moduleOpts...)
+	if err != nil {
+		panic(err)
+	}
+
 	var (
 		app        = &SimApp{}
 		appBuilder *runtime.AppBuilder
 
+		// the keepers of the optional modules, left empty when the module is disabled
+		optionalKeepers struct {
+			depinject.In
+
+			CrisisKeeper   *crisiskeeper.Keeper  `optional:"true"`
+			AuthzKeeper    authzkeeper.Keeper    `optional:"true"`
+			EvidenceKeeper evidencekeeper.Keeper `optional:"true"`
+			FeeGrantKeeper feegrantkeeper.Keeper `optional:"true"`
+			CircuitKeeper  circuitkeeper.Keeper  `optional:"true"`
+			GroupKeeper    groupkeeper.Keeper    `optional:"true"`
+			NFTKeeper      nftkeeper.Keeper      `optional:"true"`
+		}
+
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
-			AppConfig,
+			newAppConfig(disabled),
 			depinject.Supply(
 				// supply the application options
 				appOpts,
@@ -156,19 +197,22 @@ func NewSimApp(
 		&app.MintKeeper,
 		&app.DistrKeeper,
 		&app.GovKeeper,
-		&app.CrisisKeeper,
 		&app.UpgradeKeeper,
 		&app.ParamsKeeper,
-		&app.AuthzKeeper,
-		&app.EvidenceKeeper,
-		&app.FeeGrantKeeper,
-		&app.GroupKeeper,
-		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
+		&optionalKeepers,
 	); err != nil {
 		panic(err)
 	}
 
+	app.CrisisKeeper = optionalKeepers.CrisisKeeper
+	app.AuthzKeeper = optionalKeepers.AuthzKeeper
+	app.EvidenceKeeper = optionalKeepers.EvidenceKeeper
+	app.FeeGrantKeeper = optionalKeepers.FeeGrantKeeper
+	app.CircuitKeeper = optionalKeepers.CircuitKeeper
+	app.GroupKeeper = optionalKeepers.GroupKeeper
+	app.NFTKeeper = optionalKeepers.NFTKeeper
+
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
 	// already set in the SDK's BaseApp, this shows an example of how to override
@@ -178,7 +222,7 @@ func NewSimApp(
 	//
 	// app.App = appBuilder.Build(...)
 	// nonceMempool := mempool.NewSenderNonceMempool()
-	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, app.App.BaseApp)
+	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, app.App.BaseApp, app.txConfig.TxEncoder())
 	//
 	// app.App.BaseApp.SetMempool(nonceMempool)
 	// app.App.BaseApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
@@ -190,13 +234,23 @@ func NewSimApp(
 	// Example:
 	//
 	// prepareOpt = func(app *baseapp.BaseApp) {
-	// 	abciPropHandler := baseapp.NewDefaultProposalHandler(nonceMempool, app)
+	// 	abciPropHandler := baseapp.NewDefaultProposalHandler(nonceMempool, app, txConfig.TxEncoder())
 	// 	app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	if err := validateModuleDependencies(app.ModuleManager.Modules, disabled); err != nil {
+		panic(err)
+	}
+
+	// consult the circuit breaker before dispatching any message, including
+	// the ones executed by other modules such as gov proposals or authz grants
+	if !disabled[circuittypes.ModuleName] {
+		app.SetCircuitBreaker(app.CircuitKeeper)
+	}
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		panic(err)
@@ -204,7 +258,13 @@ func NewSimApp(
 
 	/****  Module Options ****/
 
-	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+	if app.CrisisKeeper != nil {
+		app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+	}
+
+	if cast.ToBool(appOpts.Get(FlagVoteExtensionsExample)) {
+		app.setVoteExtensionProposalHandlers(app.App.BaseApp)
+	}
 
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	app.RegisterUpgradeHandlers()
@@ -49,6 +49,28 @@ func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAd
 	}, err
 }
 
+// ExportGenesisArchive writes the state of the application to a genesis
+// archive, one module after the other, and returns the validators of the
+// exported app. The returned ExportedApp has no AppState.
+func (app *SimApp) ExportGenesisArchive(archive servertypes.GenesisArchiveWriter) (servertypes.ExportedApp, error) {
+	ctx := app.NewContext(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	// We export at last height + 1, because that's the height at which
+	// CometBFT will start InitChain.
+	height := app.LastBlockHeight() + 1
+
+	if err := app.ModuleManager.ExportGenesisArchive(ctx, app.appCodec, archive); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	return servertypes.ExportedApp{
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, err
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"testing"
@@ -398,3 +400,88 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 }
+
+// TestAppSimulationReplay records the operations of a short simulation and
+// checks that replaying them against a fresh app leads to the same state.
+func TestAppSimulationReplay(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	config.NumBlocks = 10
+	config.BlockSize = 20
+	config.Commit = true
+	config.LogOperations = true
+	config.OperationLogPath = filepath.Join(t.TempDir(), "simulation.oplog")
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	appOptions[server.FlagInvCheckPeriod] = uint(1)
+
+	newApp := func() *SimApp {
+		return NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(SimAppChainID))
+	}
+
+	app := newApp()
+	_, _, err := simulation.SimulateFromSeed(
+		t,
+		io.Discard,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		BlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(config.NumBlocks), app.LastBlockHeight())
+
+	replayed := newApp()
+	require.NoError(t, simulation.ReplaySimulation(config.OperationLogPath, replayed.BaseApp))
+	require.Equal(t, app.LastCommitID(), replayed.LastCommitID())
+}
+
+// TestAppSimulationOperationStats runs a short simulation and checks that the
+// per-operation statistics are exported.
+func TestAppSimulationOperationStats(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	config.NumBlocks = 5
+	config.BlockSize = 20
+	config.Commit = true
+	config.OperationStatsPath = filepath.Join(t.TempDir(), "operation_stats.json")
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	appOptions[server.FlagInvCheckPeriod] = uint(1)
+
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(SimAppChainID))
+	_, _, err := simulation.SimulateFromSeed(
+		t,
+		io.Discard,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		BlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(config.OperationStatsPath)
+	require.NoError(t, err)
+
+	var stats simulation.OperationStats
+	require.NoError(t, json.Unmarshal(bz, &stats))
+	require.Contains(t, stats.Operations, "bank")
+	require.Contains(t, stats.Operations, "staking")
+
+	var submitted int
+	for _, ops := range stats.Operations {
+		for _, stat := range ops {
+			require.Equal(t, stat.Submitted, stat.OK+stat.NoOp+stat.Failed)
+			submitted += stat.Submitted
+		}
+	}
+	require.Positive(t, submitted)
+}
@@ -2,6 +2,7 @@ package simapp
 
 import (
 	storetypes "cosmossdk.io/store/types"
+	circuittypes "cosmossdk.io/x/circuit/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,7 +21,30 @@ func (app SimApp) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
 		UpgradeName,
 		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			// the migrations are checkpointed in a node-local database, so that
+			// they continue where they stopped if the node is restarted meanwhile
+			checkpoints, err := app.UpgradeKeeper.OpenMigrationCheckpointDB()
+			if err != nil {
+				return nil, err
+			}
+			defer checkpoints.Close()
+
+			vm, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM,
+				module.WithMigrationProgressReporter(app.UpgradeKeeper),
+				module.WithMigrationCheckpointStore(checkpoints, app.GetStoreKeys()),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			// the denom index is kept in a derived store, rebuilt from the
+			// balances, when the bank keeper is built with a denom index store,
+			// so the index entries left in the bank store are deleted
+			if err := app.BankKeeper.DeleteBankStoreDenomIndex(ctx); err != nil {
+				return nil, err
+			}
+
+			return vm, nil
 		},
 	)
 
@@ -30,7 +54,11 @@ func (app SimApp) RegisterUpgradeHandlers() {
 	}
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{}
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{
+				circuittypes.ModuleName,
+			},
+		}
 
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
@@ -0,0 +1,87 @@
+package simapp
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FlagVoteExtensionsExample enables the example proposal handlers embedding
+// the extended commit of the previous height into the first tx of every
+// proposal, and verifying it in ProcessProposal.
+//
+// NOTE: CometBFT v0.37 neither produces nor signs vote extensions, so the
+// extended commit of the example carries no extension signatures and every
+// proposal from height 2 on is rejected. It shows how to wire the vote
+// extension helpers of baseapp, and is meant to be used with a CometBFT
+// version signing vote extensions.
+const FlagVoteExtensionsExample = "vote-extensions-example"
+
+// setVoteExtensionProposalHandlers sets the example proposal handlers, which
+// wrap the default ones.
+func (app *SimApp) setVoteExtensionProposalHandlers(bApp *baseapp.BaseApp) {
+	var (
+		codec          baseapp.ExtendedCommitCodec
+		defaultHandler = baseapp.NewDefaultProposalHandler(bApp.Mempool(), bApp, app.txConfig.TxEncoder())
+		prepare        = defaultHandler.PrepareProposalHandler()
+		process        = defaultHandler.ProcessProposalHandler()
+	)
+
+	bApp.SetPrepareProposal(func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		if req.Height <= 1 {
+			return prepare(ctx, req)
+		}
+
+		extCommit := toExtendedCommitInfo(req.LocalLastCommit)
+		bz, err := codec.Encode(extCommit)
+		if err != nil || int64(len(bz)) > req.MaxTxBytes {
+			return abci.ResponsePrepareProposal{}
+		}
+
+		// the other txs fill the room left by the extended commit
+		req.MaxTxBytes -= int64(len(bz))
+		res := prepare(ctx, req)
+
+		txs, err := codec.Inject(extCommit, res.Txs, int64(len(bz)))
+		if err != nil {
+			return abci.ResponsePrepareProposal{}
+		}
+
+		return abci.ResponsePrepareProposal{Txs: txs}
+	})
+
+	bApp.SetProcessProposal(func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		if req.Height <= 1 {
+			return process(ctx, req)
+		}
+
+		extCommit, txs, err := codec.Extract(req.Txs)
+		if err != nil {
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, app.StakingKeeper, req.Height, ctx.ChainID(), extCommit); err != nil {
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+
+		req.Txs = txs
+		return process(ctx, req)
+	})
+}
+
+// toExtendedCommitInfo converts the local extended commit received from
+// CometBFT, which has no extension signatures in v0.37.
+func toExtendedCommitInfo(commit abci.ExtendedCommitInfo) sdk.ExtendedCommitInfo {
+	votes := make([]sdk.ExtendedVoteInfo, len(commit.Votes))
+	for i, vote := range commit.Votes {
+		votes[i] = sdk.ExtendedVoteInfo{
+			ValidatorAddress: vote.Validator.Address,
+			ValidatorPower:   vote.Validator.Power,
+			SignedLastBlock:  vote.SignedLastBlock,
+			VoteExtension:    vote.VoteExtension,
+		}
+	}
+
+	return sdk.ExtendedCommitInfo{Round: commit.Round, Votes: votes}
+}
@@ -1,8 +1,14 @@
 package cache
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/iavl"
+
+	iavlstore "cosmossdk.io/store/iavl"
 	"cosmossdk.io/store/types"
 )
 
@@ -47,3 +53,57 @@ func BenchmarkReset(b *testing.B) {
 		b.Fatal("Impossible condition")
 	}
 }
+
+// benchmarkSkewedWorkload reads keys of a "bank" store spread over many more
+// keys than its cache can hold, and of a "staking" store with a small working
+// set, reporting the hit rate of the caches. The total cache size is the same
+// whatever the sizes given per store.
+func benchmarkSkewedWorkload(b *testing.B, sizes map[string]uint) {
+	const (
+		bankKeys    = 20_000
+		stakingKeys = 1_500
+	)
+
+	mgr := NewCommitKVStoreCacheManager(1_000)
+	for name, size := range sizes {
+		mgr.SetStoreCacheSize(name, size)
+	}
+
+	stores := make(map[string]types.CommitKVStore)
+	for name, numKeys := range map[string]int{"bank": bankKeys, "staking": stakingKeys} {
+		tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100, false)
+		if err != nil {
+			b.Fatal(err)
+		}
+		store := iavlstore.UnsafeNewStore(tree)
+		for i := 0; i < numKeys; i++ {
+			store.Set([]byte(fmt.Sprintf("key_%d", i)), []byte("value"))
+		}
+		store.Commit()
+		stores[name] = mgr.GetStoreCache(types.NewKVStoreKey(name), store)
+	}
+
+	r := rand.New(rand.NewSource(0))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stores["bank"].Get([]byte(fmt.Sprintf("key_%d", r.Intn(bankKeys))))
+		stores["staking"].Get([]byte(fmt.Sprintf("key_%d", r.Intn(stakingKeys))))
+	}
+
+	var hits, misses uint64
+	for _, stats := range mgr.CacheStats() {
+		hits += stats.Hits
+		misses += stats.Misses
+	}
+	b.ReportMetric(100*float64(hits)/float64(hits+misses), "hit%")
+}
+
+func BenchmarkSkewedWorkloadUniformSizes(b *testing.B) {
+	benchmarkSkewedWorkload(b, nil)
+}
+
+func BenchmarkSkewedWorkloadPerStoreSizes(b *testing.B) {
+	benchmarkSkewedWorkload(b, map[string]uint{"bank": 400, "staking": 1_600})
+}
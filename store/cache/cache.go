@@ -2,6 +2,10 @@ package cache
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/armon/go-metrics"
 
 	"cosmossdk.io/store/cachekv"
 	"cosmossdk.io/store/types"
@@ -28,6 +32,14 @@ type (
 	CommitKVStoreCache struct {
 		types.CommitKVStore
 		cache *lru.ARCCache
+		size  int
+
+		hits      atomic.Uint64
+		misses    atomic.Uint64
+		evictions atomic.Uint64
+
+		// emitted holds the counts already emitted as telemetry.
+		emitted CacheStats
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -35,8 +47,20 @@ type (
 	// in an inter-block (persistent) manner and typically provided by a
 	// CommitMultiStore.
 	CommitKVStoreCacheManager struct {
-		cacheSize uint
-		caches    map[string]types.CommitKVStore
+		mtx        sync.RWMutex
+		cacheSize  uint
+		cacheSizes map[string]uint
+		caches     map[string]types.CommitKVStore
+	}
+
+	// CacheStats holds the number of entries of a CommitKVStoreCache and the
+	// number of hits, misses and evictions since it was created.
+	CacheStats struct {
+		Len       int
+		Size      int
+		Hits      uint64
+		Misses    uint64
+		Evictions uint64
 	}
 )
 
@@ -49,22 +73,44 @@ func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreC
 	return &CommitKVStoreCache{
 		CommitKVStore: store,
 		cache:         cache,
+		size:          int(size),
 	}
 }
 
 func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
 	return &CommitKVStoreCacheManager{
-		cacheSize: size,
-		caches:    make(map[string]types.CommitKVStore),
+		cacheSize:  size,
+		cacheSizes: make(map[string]uint),
+		caches:     make(map[string]types.CommitKVStore),
 	}
 }
 
+// SetStoreCacheSize overrides the size of the cache of the store with the given
+// name, e.g. to give a store read on every block more entries than a store
+// written on every block. It only applies to the caches created afterwards.
+func (cmgr *CommitKVStoreCacheManager) SetStoreCacheSize(name string, size uint) {
+	cmgr.mtx.Lock()
+	defer cmgr.mtx.Unlock()
+
+	if cmgr.cacheSizes == nil {
+		cmgr.cacheSizes = make(map[string]uint)
+	}
+	cmgr.cacheSizes[name] = size
+}
+
 // GetStoreCache returns a Cache from the CommitStoreCacheManager for a given
 // StoreKey. If no Cache exists for the StoreKey, then one is created and set.
 // The returned Cache is meant to be used in a persistent manner.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
+	cmgr.mtx.Lock()
+	defer cmgr.mtx.Unlock()
+
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		size, ok := cmgr.cacheSizes[key.Name()]
+		if !ok {
+			size = cmgr.cacheSize
+		}
+		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, size)
 	}
 
 	return cmgr.caches[key.Name()]
@@ -72,6 +118,9 @@ func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store t
 
 // Unwrap returns the underlying CommitKVStore for a given StoreKey.
 func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKVStore {
+	cmgr.mtx.RLock()
+	defer cmgr.mtx.RUnlock()
+
 	if ckv, ok := cmgr.caches[key.Name()]; ok {
 		return ckv.(*CommitKVStoreCache).CommitKVStore
 	}
@@ -79,8 +128,39 @@ func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKV
 	return nil
 }
 
+// CacheStats returns the stats of the cache of each store, by store name.
+func (cmgr *CommitKVStoreCacheManager) CacheStats() map[string]CacheStats {
+	cmgr.mtx.RLock()
+	defer cmgr.mtx.RUnlock()
+
+	stats := make(map[string]CacheStats, len(cmgr.caches))
+	for name, store := range cmgr.caches {
+		if ckv, ok := store.(*CommitKVStoreCache); ok {
+			stats[name] = ckv.Stats()
+		}
+	}
+
+	return stats
+}
+
+// EmitMetrics emits the hits, misses and evictions of the cache of each store
+// since the previous call as telemetry counters labeled by store.
+func (cmgr *CommitKVStoreCacheManager) EmitMetrics(labels []metrics.Label) {
+	cmgr.mtx.RLock()
+	defer cmgr.mtx.RUnlock()
+
+	for name, store := range cmgr.caches {
+		if ckv, ok := store.(*CommitKVStoreCache); ok {
+			ckv.emitMetrics(append([]metrics.Label{{Name: "store", Value: name}}, labels...))
+		}
+	}
+}
+
 // Reset resets in the internal caches.
 func (cmgr *CommitKVStoreCacheManager) Reset() {
+	cmgr.mtx.Lock()
+	defer cmgr.mtx.Unlock()
+
 	// Clear the map.
 	// Please note that we are purposefully using the map clearing idiom.
 	// See https://github.com/cosmos/cosmos-sdk/issues/6681.
@@ -89,6 +169,46 @@ func (cmgr *CommitKVStoreCacheManager) Reset() {
 	}
 }
 
+// Stats returns the number of entries of the cache and the number of hits,
+// misses and evictions since it was created.
+func (ckv *CommitKVStoreCache) Stats() CacheStats {
+	return CacheStats{
+		Len:       ckv.cache.Len(),
+		Size:      ckv.size,
+		Hits:      ckv.hits.Load(),
+		Misses:    ckv.misses.Load(),
+		Evictions: ckv.evictions.Load(),
+	}
+}
+
+// emitMetrics emits the counts of the cache since the previous call. It must
+// not be called concurrently.
+func (ckv *CommitKVStoreCache) emitMetrics(labels []metrics.Label) {
+	stats := ckv.Stats()
+	for _, counter := range []struct {
+		key       string
+		val, prev uint64
+	}{
+		{"hits", stats.Hits, ckv.emitted.Hits},
+		{"misses", stats.Misses, ckv.emitted.Misses},
+		{"evictions", stats.Evictions, ckv.emitted.Evictions},
+	} {
+		if counter.val > counter.prev {
+			metrics.IncrCounterWithLabels([]string{"store", "inter_block_cache", counter.key}, float32(counter.val-counter.prev), labels)
+		}
+	}
+	ckv.emitted = stats
+}
+
+// add adds a key/value pair to the cache, counting the eviction it causes if
+// the key is new and the cache is full.
+func (ckv *CommitKVStoreCache) add(key string, value []byte) {
+	if ckv.cache.Len() >= ckv.size && !ckv.cache.Contains(key) {
+		ckv.evictions.Add(1)
+	}
+	ckv.cache.Add(key, value)
+}
+
 // CacheWrap implements the CacheWrapper interface
 func (ckv *CommitKVStoreCache) CacheWrap() types.CacheWrap {
 	return cachekv.NewStore(ckv)
@@ -104,12 +224,14 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	valueI, ok := ckv.cache.Get(keyStr)
 	if ok {
 		// cache hit
+		ckv.hits.Add(1)
 		return valueI.([]byte)
 	}
 
 	// cache miss; write to cache
+	ckv.misses.Add(1)
 	value := ckv.CommitKVStore.Get(key)
-	ckv.cache.Add(keyStr, value)
+	ckv.add(keyStr, value)
 
 	return value
 }
@@ -120,7 +242,7 @@ func (ckv *CommitKVStoreCache) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 
-	ckv.cache.Add(string(key), value)
+	ckv.add(string(key), value)
 	ckv.CommitKVStore.Set(key, value)
 }
 
@@ -3,7 +3,9 @@ package cache_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/iavl"
 	"github.com/stretchr/testify/require"
@@ -102,3 +104,58 @@ func TestCacheWrap(t *testing.T) {
 	cacheWrapper := mngr.GetStoreCache(sKey, store).CacheWrap()
 	require.IsType(t, &cachekv.Store{}, cacheWrapper)
 }
+
+func TestStoreCacheSizes(t *testing.T) {
+	mngr := cache.NewCommitKVStoreCacheManager(10)
+	mngr.SetStoreCacheSize("hot", 100)
+
+	newStore := func() types.CommitKVStore {
+		tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100, false)
+		require.NoError(t, err)
+		return iavlstore.UnsafeNewStore(tree)
+	}
+	hot := mngr.GetStoreCache(types.NewKVStoreKey("hot"), newStore())
+	cold := mngr.GetStoreCache(types.NewKVStoreKey("cold"), newStore())
+
+	for i := 0; i < 50; i++ {
+		key := []byte(fmt.Sprintf("key_%d", i))
+		hot.Set(key, []byte("value"))
+		cold.Set(key, []byte("value"))
+	}
+	for i := 0; i < 50; i++ {
+		key := []byte(fmt.Sprintf("key_%d", i))
+		require.Equal(t, []byte("value"), hot.Get(key))
+		require.Equal(t, []byte("value"), cold.Get(key), "cache misses read through")
+	}
+
+	stats := mngr.CacheStats()
+	require.Equal(t, cache.CacheStats{Len: 50, Size: 100, Hits: 50}, stats["hot"])
+	require.Equal(t, 10, stats["cold"].Len)
+	require.Equal(t, 10, stats["cold"].Size)
+	require.GreaterOrEqual(t, stats["cold"].Misses, uint64(40))
+	require.GreaterOrEqual(t, stats["cold"].Evictions, uint64(40+40))
+}
+
+func TestStoreCacheEmitMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	_, err := metrics.NewGlobal(metrics.DefaultConfig("test"), sink)
+	require.NoError(t, err)
+
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100, false)
+	require.NoError(t, err)
+	kvStore := mngr.GetStoreCache(types.NewKVStoreKey("bank"), iavlstore.UnsafeNewStore(tree))
+
+	kvStore.Get([]byte("key"))
+	kvStore.Get([]byte("key"))
+	mngr.EmitMetrics(nil)
+	// only the counts since the previous call are emitted
+	kvStore.Get([]byte("key"))
+	mngr.EmitMetrics(nil)
+
+	counters := sink.Data()[0].Counters
+	require.Equal(t, 2, counters["test.store.inter_block_cache.hits;store=bank"].Count)
+	require.Equal(t, float64(2), counters["test.store.inter_block_cache.hits;store=bank"].Sum)
+	require.Equal(t, 1, counters["test.store.inter_block_cache.misses;store=bank"].Count)
+	require.NotContains(t, counters, "test.store.inter_block_cache.evictions;store=bank")
+}
@@ -0,0 +1,200 @@
+package derived
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	_ types.KVStore   = (*Store)(nil)
+	_ types.Committer = (*Store)(nil)
+)
+
+var (
+	// dataPrefix prefixes the entries of the store.
+	dataPrefix = []byte("d/")
+
+	// versionKey holds the version the store was last committed at.
+	versionKey = []byte("m/version")
+
+	// dirtyKey is set while entries written after the last commit are not
+	// committed yet.
+	dirtyKey = []byte("m/dirty")
+)
+
+// Store is a DB backed store holding state derived from the primary state of
+// the application, such as secondary indexes. It is neither versioned nor part
+// of the app hash, so its writes skip Merkle hashing entirely. It keeps the
+// version it was last committed at as a watermark, so that its contents can be
+// rebuilt from the primary state when they are stale, e.g. after a crash in
+// the middle of a commit or once it is mounted on an existing chain.
+type Store struct {
+	dbadapter.Store
+
+	db      dbm.DB
+	version int64
+	dirty   bool
+}
+
+// NewStore returns the derived store persisted in db.
+func NewStore(db dbm.DB) (*Store, error) {
+	bz, err := db.Get(versionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var version int64
+	if bz != nil {
+		if len(bz) != 8 {
+			return nil, fmt.Errorf("invalid derived store version %X", bz)
+		}
+		version = int64(binary.BigEndian.Uint64(bz))
+	}
+
+	dirty, err := db.Has(dirtyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		Store:   dbadapter.Store{DB: dbm.NewPrefixDB(db, dataPrefix)},
+		db:      db,
+		version: version,
+		dirty:   dirty,
+	}, nil
+}
+
+// GetStoreType implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeDerived
+}
+
+// Set implements KVStore.
+func (s *Store) Set(key, value []byte) {
+	s.markDirty()
+	s.Store.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.markDirty()
+	s.Store.Delete(key)
+}
+
+// markDirty records that the store holds uncommitted entries before the first
+// write following a commit.
+func (s *Store) markDirty() {
+	if s.dirty {
+		return
+	}
+
+	if err := s.db.SetSync(dirtyKey, []byte{}); err != nil {
+		panic(err)
+	}
+	s.dirty = true
+}
+
+// CacheWrap implements KVStore.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Commit implements Committer, committing the store at the version following
+// the last one.
+func (s *Store) Commit() types.CommitID {
+	return s.CommitVersion(s.version + 1)
+}
+
+// CommitVersion commits the store at version, the version of the primary
+// state its contents are derived from.
+func (s *Store) CommitVersion(version int64) types.CommitID {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], uint64(version))
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(versionKey, bz[:]); err != nil {
+		panic(err)
+	}
+	if err := batch.Delete(dirtyKey); err != nil {
+		panic(err)
+	}
+	if err := batch.WriteSync(); err != nil {
+		panic(err)
+	}
+
+	s.version = version
+	s.dirty = false
+
+	return s.LastCommitID()
+}
+
+// LastCommitID implements Committer. Derived stores have no hash.
+func (s *Store) LastCommitID() types.CommitID {
+	return types.CommitID{Version: s.version}
+}
+
+// WorkingHash implements Committer. Derived stores have no hash.
+func (s *Store) WorkingHash() []byte {
+	return []byte{}
+}
+
+// IsStale returns whether the contents of the store may not match the primary
+// state at version, because the store was committed at another version or
+// holds uncommitted entries.
+func (s *Store) IsStale(version int64) bool {
+	return s.dirty || s.version != version
+}
+
+// Clear deletes all the entries of the store, before it is rebuilt.
+func (s *Store) Clear() error {
+	s.markDirty()
+
+	it, err := s.Store.DB.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, bytes.Clone(it.Key()))
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	batch := s.Store.DB.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// SetPruning implements Committer. Derived stores are not versioned.
+func (s *Store) SetPruning(_ pruningtypes.PruningOptions) {}
+
+// GetPruning is a no-op as pruning options cannot be directly set on this store.
+// They must be set on the root commit multi-store.
+func (s *Store) GetPruning() pruningtypes.PruningOptions {
+	return pruningtypes.NewPruningOptions(pruningtypes.PruningUndefined)
+}
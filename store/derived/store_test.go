@@ -0,0 +1,77 @@
+package derived_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/derived"
+	"cosmossdk.io/store/types"
+)
+
+var k, v = []byte("hello"), []byte("world")
+
+func TestDerivedStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := derived.NewStore(db)
+	require.NoError(t, err)
+	require.Equal(t, types.StoreTypeDerived, store.GetStoreType())
+	require.False(t, store.IsStale(0))
+
+	store.Set(k, v)
+	require.Equal(t, v, store.Get(k))
+	require.True(t, store.IsStale(0))
+
+	require.Equal(t, types.CommitID{Version: 3}, store.CommitVersion(3))
+	require.False(t, store.IsStale(3))
+	require.True(t, store.IsStale(4))
+	require.Empty(t, store.WorkingHash())
+
+	// the entries and the version are persisted
+	store, err = derived.NewStore(db)
+	require.NoError(t, err)
+	require.Equal(t, v, store.Get(k))
+	require.Equal(t, types.CommitID{Version: 3}, store.LastCommitID())
+	require.False(t, store.IsStale(3))
+
+	require.Equal(t, types.CommitID{Version: 4}, store.Commit())
+}
+
+func TestDerivedStoreUncommittedWrites(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := derived.NewStore(db)
+	require.NoError(t, err)
+	store.CommitVersion(1)
+
+	// writes through a branch mark the store as holding uncommitted entries
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Delete(k)
+	cache.Write()
+
+	store, err = derived.NewStore(db)
+	require.NoError(t, err)
+	require.True(t, store.IsStale(1))
+
+	store.CommitVersion(1)
+	store, err = derived.NewStore(db)
+	require.NoError(t, err)
+	require.False(t, store.IsStale(1))
+}
+
+func TestDerivedStoreClear(t *testing.T) {
+	store, err := derived.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+
+	for i := byte(0); i < 10; i++ {
+		store.Set([]byte{i}, v)
+	}
+	store.CommitVersion(1)
+
+	require.NoError(t, store.Clear())
+	require.True(t, store.IsStale(1))
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	require.False(t, it.Valid())
+}
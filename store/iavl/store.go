@@ -218,7 +218,7 @@ func (st *Store) Get(key []byte) []byte {
 	defer st.metrics.MeasureSince("store", "iavl", "get")
 	value, err := st.tree.Get(key)
 	if err != nil {
-		panic(err)
+		panic(errorsmod.Wrap(types.ErrCorruptedStore, err.Error()))
 	}
 	return value
 }
@@ -228,7 +228,7 @@ func (st *Store) Has(key []byte) (exists bool) {
 	defer st.metrics.MeasureSince("store", "iavl", "has")
 	has, err := st.tree.Has(key)
 	if err != nil {
-		panic(err)
+		panic(errorsmod.Wrap(types.ErrCorruptedStore, err.Error()))
 	}
 	return has
 }
@@ -261,7 +261,7 @@ func (st *Store) LazyLoadVersionForOverwriting(targetVersion int64) (int64, erro
 func (st *Store) Iterator(start, end []byte) types.Iterator {
 	iterator, err := st.tree.Iterator(start, end, true)
 	if err != nil {
-		panic(err)
+		panic(errorsmod.Wrap(types.ErrCorruptedStore, err.Error()))
 	}
 	return iterator
 }
@@ -270,7 +270,7 @@ func (st *Store) Iterator(start, end []byte) types.Iterator {
 func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
 	iterator, err := st.tree.Iterator(start, end, false)
 	if err != nil {
-		panic(err)
+		panic(errorsmod.Wrap(types.ErrCorruptedStore, err.Error()))
 	}
 	return iterator
 }
@@ -0,0 +1,211 @@
+package meteredkv
+
+import (
+	"io"
+	"sync/atomic"
+
+	"github.com/armon/go-metrics"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Counters holds the counters of the operations made on a store. It is safe
+// for concurrent use.
+type Counters struct {
+	gets            atomic.Uint64
+	has             atomic.Uint64
+	sets            atomic.Uint64
+	deletes         atomic.Uint64
+	iterators       atomic.Uint64
+	iteratedEntries atomic.Uint64
+	readBytes       atomic.Uint64
+	writeBytes      atomic.Uint64
+}
+
+// CountersSnapshot holds the values of Counters at a point in time.
+type CountersSnapshot struct {
+	Gets            uint64
+	Has             uint64
+	Sets            uint64
+	Deletes         uint64
+	Iterators       uint64
+	IteratedEntries uint64
+	// ReadBytes is the size of the keys and values read by gets and iterators.
+	ReadBytes uint64
+	// WriteBytes is the size of the keys and values written by sets.
+	WriteBytes uint64
+}
+
+// Snapshot returns the current values of the counters.
+func (c *Counters) Snapshot() CountersSnapshot {
+	return CountersSnapshot{
+		Gets:            c.gets.Load(),
+		Has:             c.has.Load(),
+		Sets:            c.sets.Load(),
+		Deletes:         c.deletes.Load(),
+		Iterators:       c.iterators.Load(),
+		IteratedEntries: c.iteratedEntries.Load(),
+		ReadBytes:       c.readBytes.Load(),
+		WriteBytes:      c.writeBytes.Load(),
+	}
+}
+
+// Emit emits the counters of the store with the given name as telemetry
+// counters, such as store_get_count{store="staking"}, and resets them.
+func (c *Counters) Emit(storeName string, labels []metrics.Label) {
+	labels = append([]metrics.Label{{Name: "store", Value: storeName}}, labels...)
+
+	for _, counter := range []struct {
+		keys []string
+		val  *atomic.Uint64
+	}{
+		{[]string{"store", "get", "count"}, &c.gets},
+		{[]string{"store", "has", "count"}, &c.has},
+		{[]string{"store", "set", "count"}, &c.sets},
+		{[]string{"store", "delete", "count"}, &c.deletes},
+		{[]string{"store", "iterator", "count"}, &c.iterators},
+		{[]string{"store", "iterate", "entries"}, &c.iteratedEntries},
+		{[]string{"store", "read", "bytes"}, &c.readBytes},
+		{[]string{"store", "write", "bytes"}, &c.writeBytes},
+	} {
+		if val := counter.val.Swap(0); val > 0 {
+			metrics.IncrCounterWithLabels(counter.keys, float32(val), labels)
+		}
+	}
+}
+
+// Store implements the KVStore interface, counting the operations made on its
+// parent store.
+type Store struct {
+	parent   types.KVStore
+	counters *Counters
+}
+
+// NewStore returns a reference to a new meteredkv Store counting the
+// operations made on parent in counters.
+func NewStore(parent types.KVStore, counters *Counters) *Store {
+	return &Store{parent: parent, counters: counters}
+}
+
+// Get implements the KVStore interface. It counts a read operation and
+// delegates a Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	value := s.parent.Get(key)
+	s.counters.gets.Add(1)
+	s.counters.readBytes.Add(uint64(len(key) + len(value)))
+	return value
+}
+
+// Set implements the KVStore interface. It counts a write operation and
+// delegates the Set call to the parent KVStore.
+func (s *Store) Set(key, value []byte) {
+	s.parent.Set(key, value)
+	s.counters.sets.Add(1)
+	s.counters.writeBytes.Add(uint64(len(key) + len(value)))
+}
+
+// Delete implements the KVStore interface. It counts a delete operation and
+// delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.counters.deletes.Add(1)
+}
+
+// Has implements the KVStore interface. It counts a has operation and
+// delegates the Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.counters.has.Add(1)
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It delegates the Iterator call
+// to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.counters.iterators.Add(1)
+	return &meteredIterator{parent: s.parent.Iterator(start, end), counters: s.counters}
+}
+
+// ReverseIterator implements the KVStore interface. It delegates the
+// ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.counters.iterators.Add(1)
+	return &meteredIterator{parent: s.parent.ReverseIterator(start, end), counters: s.counters}
+}
+
+// meteredIterator counts the entries read from its parent iterator, an entry
+// being counted once whatever the number of times its key or value is read.
+type meteredIterator struct {
+	parent   types.Iterator
+	counters *Counters
+	counted  bool
+}
+
+// Domain implements the Iterator interface.
+func (mi *meteredIterator) Domain() (start, end []byte) {
+	return mi.parent.Domain()
+}
+
+// Valid implements the Iterator interface.
+func (mi *meteredIterator) Valid() bool {
+	return mi.parent.Valid()
+}
+
+// Next implements the Iterator interface.
+func (mi *meteredIterator) Next() {
+	mi.parent.Next()
+	mi.counted = false
+}
+
+// Key implements the Iterator interface.
+func (mi *meteredIterator) Key() []byte {
+	key := mi.parent.Key()
+	mi.countEntry(len(key))
+	return key
+}
+
+// Value implements the Iterator interface.
+func (mi *meteredIterator) Value() []byte {
+	value := mi.parent.Value()
+	mi.countEntry(len(value))
+	return value
+}
+
+// countEntry counts size read bytes, and the current entry if not already
+// counted.
+func (mi *meteredIterator) countEntry(size int) {
+	if !mi.counted {
+		mi.counted = true
+		mi.counters.iteratedEntries.Add(1)
+	}
+	mi.counters.readBytes.Add(uint64(size))
+}
+
+// Close implements the Iterator interface.
+func (mi *meteredIterator) Close() error {
+	return mi.parent.Close()
+}
+
+// Error delegates the Error call to the parent iterator.
+func (mi *meteredIterator) Error() error {
+	return mi.parent.Error()
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics as a Store
+// cannot be cache wrapped.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a MeteredKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a MeteredKVStore")
+}
@@ -0,0 +1,128 @@
+package meteredkv_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/meteredkv"
+	"cosmossdk.io/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+func newMeteredKVStore() (*meteredkv.Store, *meteredkv.Counters) {
+	counters := &meteredkv.Counters{}
+	return meteredkv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, counters), counters
+}
+
+func TestMeteredKVStoreCounters(t *testing.T) {
+	store, counters := newMeteredKVStore()
+
+	// 11-byte keys and 13-byte values
+	for i := 0; i < 5; i++ {
+		store.Set(keyFmt(i), valFmt(i))
+	}
+	store.Delete(keyFmt(4))
+
+	require.Equal(t, valFmt(0), store.Get(keyFmt(0)))
+	require.Nil(t, store.Get(keyFmt(4)))
+	require.True(t, store.Has(keyFmt(1)))
+
+	// entries are counted once, whatever the number of reads
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		require.Equal(t, it.Key(), it.Key())
+	}
+	require.NoError(t, it.Close())
+
+	it = store.ReverseIterator(keyFmt(1), keyFmt(3))
+	var values [][]byte
+	for ; it.Valid(); it.Next() {
+		values = append(values, it.Value())
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, [][]byte{valFmt(2), valFmt(1)}, values, "iterator semantics are unaltered")
+
+	require.Equal(t, meteredkv.CountersSnapshot{
+		Gets:            2,
+		Has:             1,
+		Sets:            5,
+		Deletes:         1,
+		Iterators:       2,
+		IteratedEntries: 6,
+		ReadBytes:       (11 + 13) + 11 + 4*2*11 + 2*13,
+		WriteBytes:      5 * (11 + 13),
+	}, counters.Snapshot())
+}
+
+func TestCountersEmit(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	_, err := metrics.NewGlobal(metrics.DefaultConfig("test"), sink)
+	require.NoError(t, err)
+
+	store, counters := newMeteredKVStore()
+	store.Set(keyFmt(0), valFmt(0))
+	require.Equal(t, valFmt(0), store.Get(keyFmt(0)))
+
+	counters.Emit("staking", nil)
+	require.Equal(t, meteredkv.CountersSnapshot{}, counters.Snapshot(), "counters are reset once emitted")
+
+	intervals := sink.Data()
+	require.Len(t, intervals, 1)
+	getCount, ok := intervals[0].Counters["test.store.get.count;store=staking"]
+	require.True(t, ok)
+	require.Equal(t, 1, getCount.Count)
+	writeBytes := intervals[0].Counters["test.store.write.bytes;store=staking"]
+	require.Equal(t, float64(11+13), writeBytes.Sum)
+	_, ok = intervals[0].Counters["test.store.delete.count;store=staking"]
+	require.False(t, ok, "zero counters are not emitted")
+}
+
+func BenchmarkMeteredKVStore(b *testing.B) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < 1000; i++ {
+		parent.Set(keyFmt(i), valFmt(i))
+	}
+
+	for _, bc := range []struct {
+		name  string
+		store types.KVStore
+	}{
+		{"disabled", parent},
+		{"enabled", meteredkv.NewStore(parent, &meteredkv.Counters{})},
+	} {
+		b.Run(bc.name+"/get", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bc.store.Get(keyFmt(i % 1000))
+			}
+		})
+
+		b.Run(bc.name+"/set", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bc.store.Set(keyFmt(i%1000), valFmt(i))
+			}
+		})
+
+		b.Run(bc.name+"/iterate", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				it := bc.store.Iterator(nil, nil)
+				for ; it.Valid(); it.Next() {
+					_, _ = it.Key(), it.Value()
+				}
+				it.Close()
+			}
+		})
+	}
+}
@@ -24,6 +24,10 @@ type Manager struct {
 	// we sync access to them to avoid soundness issues in the future if concurrency pattern changes.
 	pruneHeightsMx sync.Mutex
 	pruneHeights   []int64
+	// These are the heights returned by GetFlushAndResetPruningHeights which are
+	// not deleted yet. They are persisted along with pruneHeights until they are
+	// reported by HandlePrunedHeights, so that they are pruned again after a crash.
+	inFlightHeights []int64
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleHeightSnapshot.
 	// Therefore, we sync access to pruneSnapshotHeights with this mutex.
@@ -74,7 +78,8 @@ func (m *Manager) GetOptions() types.PruningOptions {
 }
 
 // GetFlushAndResetPruningHeights returns all heights to be pruned during the next call to Prune().
-// It also flushes and resets the pruning heights.
+// It also flushes and resets the pruning heights. The returned heights stay persisted until they
+// are reported as deleted with HandlePrunedHeights.
 func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
 		return []int64{}, nil
@@ -83,18 +88,56 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 	defer m.pruneHeightsMx.Unlock()
 
 	// flush the updates to disk so that it is not lost if crash happens.
-	if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+	if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pendingHeights())); err != nil {
 		return nil, err
 	}
 
 	// Return a copy to prevent data races.
 	pruningHeights := make([]int64, len(m.pruneHeights))
 	copy(pruningHeights, m.pruneHeights)
+	m.inFlightHeights = append(m.inFlightHeights, pruningHeights...)
 	m.pruneHeights = m.pruneHeights[:0]
 
 	return pruningHeights, nil
 }
 
+// HandlePrunedHeights removes the given heights, returned by GetFlushAndResetPruningHeights,
+// from the persisted pruning heights once they have been deleted from the store.
+func (m *Manager) HandlePrunedHeights(heights []int64) error {
+	if m.opts.GetPruningStrategy() == types.PruningNothing || len(heights) == 0 {
+		return nil
+	}
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	pruned := make(map[int64]struct{}, len(heights))
+	for _, h := range heights {
+		pruned[h] = struct{}{}
+	}
+
+	inFlightHeights := m.inFlightHeights[:0]
+	for _, h := range m.inFlightHeights {
+		if _, ok := pruned[h]; !ok {
+			inFlightHeights = append(inFlightHeights, h)
+		}
+	}
+	m.inFlightHeights = inFlightHeights
+
+	return m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pendingHeights()))
+}
+
+// pendingHeights returns the heights which are not deleted yet, to be persisted.
+// The caller must hold pruneHeightsMx.
+func (m *Manager) pendingHeights() []int64 {
+	if len(m.inFlightHeights) == 0 {
+		return m.pruneHeights
+	}
+
+	heights := make([]int64, 0, len(m.inFlightHeights)+len(m.pruneHeights))
+	heights = append(heights, m.inFlightHeights...)
+	return append(heights, m.pruneHeights...)
+}
+
 // HandleHeight determines if previousHeight height needs to be kept for pruning at the right interval prescribed by
 // the pruning strategy. Returns previousHeight, if it was kept to be pruned at the next call to Prune(), 0 otherwise.
 // previousHeight must be greater than 0 for the handling to take effect since valid heights start at 1 and 0 represents
@@ -128,7 +171,7 @@ func (m *Manager) HandleHeight(previousHeight int64) int64 {
 		}
 
 		// flush the updates to disk so that they are not lost if crash happens.
-		if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+		if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pendingHeights())); err != nil {
 			panic(err)
 		}
 	}()
@@ -192,10 +235,12 @@ func (m *Manager) LoadPruningHeights(db dbm.DB) error {
 		return err
 	}
 
+	// the loaded heights include the heights which were not deleted before the crash.
 	if len(loadedPruneHeights) > 0 {
 		m.pruneHeightsMx.Lock()
 		defer m.pruneHeightsMx.Unlock()
 		m.pruneHeights = loadedPruneHeights
+		m.inFlightHeights = nil
 	}
 
 	loadedPruneSnapshotHeights, err := loadPruningSnapshotHeights(db)
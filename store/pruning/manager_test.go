@@ -431,11 +431,49 @@ func TestFlushLoad(t *testing.T) {
 			require.Equal(t, len(heightsToPruneMirror), len(actualHeights))
 			require.Equal(t, heightsToPruneMirror, actualHeights)
 
+			require.NoError(t, manager.HandlePrunedHeights(actualHeights))
 			heightsToPruneMirror = make([]int64, 0)
 		}
 	}
 }
 
+func TestHandlePrunedHeights(t *testing.T) {
+	db := db.NewMemDB()
+	manager := pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(0, 10))
+
+	for h := int64(1); h <= 5; h++ {
+		manager.HandleHeight(h)
+	}
+	heights, err := manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, heights)
+
+	// the flushed heights stay persisted along with the new ones until they are pruned
+	manager.HandleHeight(6)
+	loadedHeights, err := pruning.LoadPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, loadedHeights)
+
+	require.NoError(t, manager.HandlePrunedHeights([]int64{1, 2, 3}))
+	loadedHeights, err = pruning.LoadPruningHeights(db)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5, 6}, loadedHeights)
+
+	// the heights not pruned before a crash are pruned again after a restart
+	manager = pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(0, 10))
+	require.NoError(t, manager.LoadPruningHeights(db))
+	heights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5, 6}, heights)
+
+	require.NoError(t, manager.HandlePrunedHeights(heights))
+	loadedHeights, err = pruning.LoadPruningHeights(db)
+	require.NoError(t, err)
+	require.Empty(t, loadedHeights)
+}
+
 func TestLoadPruningHeights(t *testing.T) {
 	var (
 		manager = pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
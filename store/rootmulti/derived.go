@@ -0,0 +1,61 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/derived"
+	"cosmossdk.io/store/types"
+)
+
+// StaleDerivedStores returns the keys of the derived stores, sorted by name,
+// whose contents may not match the primary state at the latest version and
+// must be rebuilt with RebuildDerivedStores.
+func (rs *Store) StaleDerivedStores() []types.StoreKey {
+	version := rs.LastCommitID().Version
+
+	var stale []types.StoreKey
+	for _, key := range keysFromStoreKeyMap(rs.stores) {
+		if ds, ok := rs.stores[key].(*derived.Store); ok && ds.IsStale(version) {
+			stale = append(stale, key)
+		}
+	}
+
+	return stale
+}
+
+// RebuildDerivedStores clears the given derived stores and calls rebuild to
+// write their contents from the primary state, on a branch of the multistore
+// of which only the writes to these stores are kept. The stores are then
+// committed at the latest version.
+func (rs *Store) RebuildDerivedStores(keys []types.StoreKey, rebuild func(ms types.CacheMultiStore) error) error {
+	stores := make([]*derived.Store, len(keys))
+	for i, key := range keys {
+		ds, ok := rs.stores[key].(*derived.Store)
+		if !ok {
+			return fmt.Errorf("store %s is not a derived store", key.Name())
+		}
+		if err := ds.Clear(); err != nil {
+			return fmt.Errorf("failed to clear derived store %s: %w", key.Name(), err)
+		}
+		stores[i] = ds
+	}
+
+	branched := make(map[types.StoreKey]types.CacheWrapper, len(rs.stores))
+	for key, store := range rs.stores {
+		branched[key] = store
+	}
+	ms := cachemulti.NewStore(rs.db, branched, rs.keysByName, nil, nil)
+
+	if err := rebuild(ms); err != nil {
+		return err
+	}
+
+	version := rs.LastCommitID().Version
+	for i, key := range keys {
+		ms.GetKVStore(key).(types.CacheKVStore).Write()
+		stores[i].CommitVersion(version)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/types"
+)
+
+var testDerivedStoreKey = types.NewKVStoreKey("derived")
+
+func newMultiStoreWithDerivedStore(db dbm.DB) *Store {
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.MountStoreWithDB(testDerivedStoreKey, types.StoreTypeDerived, nil)
+	return ms
+}
+
+// rebuildDerived writes the value of every key of store1 to the derived store
+// under the reversed key.
+func rebuildDerived(ms types.CacheMultiStore) error {
+	primary, derivedStore := ms.GetKVStore(testStoreKey1), ms.GetKVStore(testDerivedStoreKey)
+	it := primary.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		derivedStore.Set(append(it.Value(), it.Key()...), []byte{})
+	}
+
+	// writes to other stores are discarded
+	ms.GetKVStore(testStoreKey2).Set([]byte("ignored"), []byte{})
+	return nil
+}
+
+func derivedContents(t *testing.T, ms *Store) map[string]bool {
+	contents := make(map[string]bool)
+	it := ms.GetKVStore(testDerivedStoreKey).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		contents[string(it.Key())] = true
+	}
+	return contents
+}
+
+func TestDerivedStoreNotInAppHash(t *testing.T) {
+	withoutDerived := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, withoutDerived.LoadLatestVersion())
+	withDerived := newMultiStoreWithDerivedStore(dbm.NewMemDB())
+	require.NoError(t, withDerived.LoadLatestVersion())
+
+	withoutDerived.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	withDerived.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	withDerived.GetKVStore(testDerivedStoreKey).Set([]byte("1a"), []byte{})
+
+	require.Equal(t, withoutDerived.WorkingHash(), withDerived.WorkingHash())
+	require.Equal(t, withoutDerived.Commit(), withDerived.Commit())
+	require.Empty(t, withDerived.StaleDerivedStores())
+
+	cInfo, err := withDerived.GetCommitInfo(1)
+	require.NoError(t, err)
+	checkContains(t, cInfo.StoreInfos, []string{"store1", "store2", "store3"})
+	for _, info := range cInfo.StoreInfos {
+		require.NotEqual(t, testDerivedStoreKey.Name(), info.Name)
+	}
+}
+
+func TestRebuildDerivedStores(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	ms.GetKVStore(testStoreKey1).Set([]byte("b"), []byte("2"))
+	ms.Commit()
+
+	// a derived store mounted on existing state is stale
+	ms = newMultiStoreWithDerivedStore(db)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, []types.StoreKey{testDerivedStoreKey}, ms.StaleDerivedStores())
+
+	require.NoError(t, ms.RebuildDerivedStores(ms.StaleDerivedStores(), rebuildDerived))
+	require.Empty(t, ms.StaleDerivedStores())
+	expected := map[string]bool{"1a": true, "2b": true}
+	require.Equal(t, expected, derivedContents(t, ms))
+	require.Nil(t, ms.GetKVStore(testStoreKey2).Get([]byte("ignored")))
+
+	// the derived store is kept up to date and committed along the primary state
+	ms.GetKVStore(testStoreKey1).Set([]byte("c"), []byte("3"))
+	ms.GetKVStore(testDerivedStoreKey).Set([]byte("3c"), []byte{})
+	ms.Commit()
+	expected["3c"] = true
+
+	ms = newMultiStoreWithDerivedStore(db)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Empty(t, ms.StaleDerivedStores())
+	require.Equal(t, expected, derivedContents(t, ms))
+
+	// a store left with uncommitted writes is stale, and rebuilt to identical
+	// contents
+	ms.GetKVStore(testDerivedStoreKey).Set([]byte("4d"), []byte{})
+	ms = newMultiStoreWithDerivedStore(db)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, []types.StoreKey{testDerivedStoreKey}, ms.StaleDerivedStores())
+
+	require.NoError(t, ms.RebuildDerivedStores(ms.StaleDerivedStores(), rebuildDerived))
+	require.Equal(t, expected, derivedContents(t, ms))
+}
+
+func TestRebuildDerivedStoresNotDerived(t *testing.T) {
+	ms := NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(testStoreKey1, types.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	err := ms.RebuildDerivedStores([]types.StoreKey{testStoreKey1}, rebuildDerived)
+	require.ErrorContains(t, err, "not a derived store")
+}
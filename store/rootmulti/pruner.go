@@ -0,0 +1,221 @@
+package rootmulti
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultPruningMaxBatchSize is the default maximum number of heights
+	// deleted from the stores in a single pruning batch.
+	DefaultPruningMaxBatchSize = 1000
+
+	// defaultPruningBatchInterval is the pause between two pruning batches,
+	// which rate-limits the background deletion of pruned heights.
+	defaultPruningBatchInterval = 10 * time.Millisecond
+)
+
+// pruner is the background worker deleting the pruned heights of the stores
+// of a Store, so that Commit only enqueues them. It deletes at most
+// maxBatchSize heights at a time, one batch per interval, and never deletes a
+// height a snapshot is being taken at. The heights stay persisted by the
+// pruning manager until they are deleted, so that the heights left in the
+// queue by a crash are pruned again after a restart.
+type pruner struct {
+	rs           *Store
+	maxBatchSize int
+	interval     time.Duration
+
+	startOnce sync.Once
+	notify    chan struct{}
+	flushes   chan chan error
+	quit      chan struct{}
+	stopped   chan struct{}
+	closed    atomic.Bool
+
+	// queueMtx guards the heights waiting to be deleted. It is never held
+	// while the stores are modified, so that enqueuing never blocks Commit.
+	queueMtx sync.Mutex
+	queue    []int64
+
+	// mtx guards the fields below. It is held while a batch is deleted, so
+	// that the stores are never modified concurrently by Commit.
+	mtx    sync.Mutex
+	pinned map[int64]int
+}
+
+func newPruner(rs *Store) *pruner {
+	return &pruner{
+		rs:           rs,
+		maxBatchSize: DefaultPruningMaxBatchSize,
+		interval:     defaultPruningBatchInterval,
+		notify:       make(chan struct{}, 1),
+		flushes:      make(chan chan error),
+		quit:         make(chan struct{}),
+		stopped:      make(chan struct{}),
+		pinned:       make(map[int64]int),
+	}
+}
+
+// enqueue hands the given heights to the worker, starting it if needed, and
+// returns without waiting for them to be deleted. Once the pruner is closed,
+// they are deleted synchronously instead.
+func (p *pruner) enqueue(heights []int64) error {
+	if len(heights) == 0 {
+		return nil
+	}
+
+	if p.closed.Load() {
+		p.mtx.Lock()
+		defer p.mtx.Unlock()
+		if err := p.rs.deleteVersions(heights); err != nil {
+			return err
+		}
+		return p.rs.pruningManager.HandlePrunedHeights(heights)
+	}
+
+	p.queueMtx.Lock()
+	p.queue = append(p.queue, heights...)
+	p.queueMtx.Unlock()
+
+	p.startOnce.Do(func() { go p.run() })
+	select {
+	case p.notify <- struct{}{}:
+	default:
+		// the worker is already notified
+	}
+	return nil
+}
+
+// pin prevents height from being pruned until it is unpinned.
+func (p *pruner) pin(height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.pinned[height]++
+}
+
+// unpin releases a height pinned with pin.
+func (p *pruner) unpin(height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.pinned[height]--; p.pinned[height] <= 0 {
+		delete(p.pinned, height)
+	}
+}
+
+// flush synchronously deletes all the heights enqueued so far, except those
+// pinned by an ongoing snapshot.
+func (p *pruner) flush() error {
+	select {
+	case <-p.stopped:
+		return nil
+	default:
+	}
+
+	done := make(chan error)
+	p.startOnce.Do(func() { go p.run() })
+	select {
+	case p.flushes <- done:
+		return <-done
+	case <-p.stopped:
+		return nil
+	}
+}
+
+// close flushes the pruner and stops its worker. Heights pruned afterwards are
+// deleted synchronously.
+func (p *pruner) close() error {
+	err := p.flush()
+
+	if !p.closed.Swap(true) {
+		p.startOnce.Do(func() { go p.run() })
+		close(p.quit)
+		<-p.stopped
+	}
+
+	return err
+}
+
+func (p *pruner) run() {
+	defer close(p.stopped)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		var tick <-chan time.Time
+		if p.queueLen() > 0 {
+			tick = ticker.C
+		}
+
+		select {
+		case <-p.notify:
+
+		case <-tick:
+			if _, err := p.pruneBatch(); err != nil {
+				p.rs.logger.Error("failed to prune store", "err", err)
+			}
+
+		case done := <-p.flushes:
+			var err error
+			for {
+				var n int
+				if n, err = p.pruneBatch(); err != nil || n == 0 {
+					break
+				}
+			}
+			done <- err
+
+		case <-p.quit:
+			p.queueMtx.Lock()
+			if len(p.queue) > 0 {
+				p.rs.logger.Error("heights left unpruned on close", "heights", p.queue)
+			}
+			p.queueMtx.Unlock()
+			return
+		}
+	}
+}
+
+// queueLen returns the number of heights waiting to be deleted.
+func (p *pruner) queueLen() int {
+	p.queueMtx.Lock()
+	defer p.queueMtx.Unlock()
+	return len(p.queue)
+}
+
+// pruneBatch deletes up to maxBatchSize of the enqueued heights which are not
+// pinned, and returns the number of heights taken from the queue. Once they
+// are deleted, they are cleared from the heights persisted by the pruning
+// manager. Heights whose deletion fails are dropped from the queue, as they
+// are when pruning synchronously, but stay persisted, so that they are pruned
+// again after a restart.
+func (p *pruner) pruneBatch() (int, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.queueMtx.Lock()
+	batch := make([]int64, 0, p.maxBatchSize)
+	remaining := p.queue[:0]
+	for _, height := range p.queue {
+		if len(batch) < p.maxBatchSize && p.pinned[height] == 0 {
+			batch = append(batch, height)
+			continue
+		}
+		remaining = append(remaining, height)
+	}
+	p.queue = remaining
+	p.queueMtx.Unlock()
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	p.rs.logger.Debug("pruning store", "heights", batch)
+	if err := p.rs.deleteVersions(batch); err != nil {
+		return len(batch), err
+	}
+
+	return len(batch), p.rs.pruningManager.HandlePrunedHeights(batch)
+}
@@ -0,0 +1,132 @@
+package rootmulti
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/pruning"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+)
+
+func TestPruner_BacklogDoesNotBlockCommit(t *testing.T) {
+	const numVersions = 10_000
+
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 10))
+	ms.SetPruningMaxBatchSize(100)
+	// the worker never gets to prune while the backlog builds up
+	ms.pruner.interval = time.Hour
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < numVersions; i++ {
+		ms.Commit()
+	}
+
+	// Commit only enqueued the pruned heights
+	for _, v := range []int64{1, numVersions / 2, numVersions - 3} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+
+	// Close flushes the whole backlog synchronously
+	require.NoError(t, ms.Close())
+	for _, v := range []int64{1, numVersions / 2, numVersions - 10} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+	for _, v := range []int64{numVersions - 1, numVersions} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+
+	// heights pruned after Close are deleted synchronously
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	_, err := ms.CacheMultiStoreWithVersion(numVersions - 1)
+	require.Error(t, err)
+}
+
+func TestPruner_PinnedHeights(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(1, 1))
+	ms.SetPruningMaxBatchSize(2)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.Commit()
+	ms.Commit()
+	require.NoError(t, ms.pruner.flush())
+
+	// height 2 is being snapshotted when it is pruned
+	ms.pruner.pin(2)
+	for i := 0; i < 5; i++ {
+		ms.Commit()
+	}
+	require.NoError(t, ms.pruner.flush())
+
+	_, err := ms.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	for _, v := range []int64{1, 3, 4, 5} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+
+	// it is pruned once the snapshot is done
+	ms.pruner.unpin(2)
+	require.NoError(t, ms.pruner.flush())
+	_, err = ms.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+
+	require.NoError(t, ms.Close())
+}
+
+// persistedPruningHeights returns the pruning heights persisted in db.
+func persistedPruningHeights(t *testing.T, db dbm.DB) []int64 {
+	t.Helper()
+
+	manager := pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(pruningtypes.NewCustomPruningOptions(2, 10))
+	require.NoError(t, manager.LoadPruningHeights(db))
+	heights, err := manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+
+	return heights
+}
+
+func TestPruner_HeightsPersistedUntilDeleted(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 10))
+	// the worker never gets to prune before the restart
+	ms.pruner.interval = time.Hour
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+
+	// the enqueued heights are still persisted
+	expectedHeights := []int64{1, 2, 3, 4, 5, 6, 7}
+	require.Equal(t, expectedHeights, persistedPruningHeights(t, db))
+
+	// "restart" without deleting them, they are pruned again
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 10))
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+	require.NoError(t, ms.pruner.flush())
+
+	for _, v := range expectedHeights {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+
+	// they are cleared once deleted
+	require.Empty(t, persistedPruningHeights(t, db))
+
+	require.NoError(t, ms.Close())
+}
@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"cosmossdk.io/log"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -225,6 +227,94 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+// interruptingSnapshotter fails the snapshot of a multistore once the export of
+// its second store began, and records the stores a resumed snapshot skips.
+type interruptingSnapshotter struct {
+	*rootmulti.Store
+	interrupt       bool
+	completedStores []string
+}
+
+func (s *interruptingSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	if !s.interrupt {
+		return s.Store.Snapshot(height, protoWriter)
+	}
+	return s.Store.Snapshot(height, &interruptingWriter{Writer: protoWriter})
+}
+
+func (s *interruptingSnapshotter) ResumeSnapshot(height uint64, protoWriter protoio.Writer, completedStores []string) error {
+	s.completedStores = completedStores
+	return s.Store.ResumeSnapshot(height, protoWriter, completedStores)
+}
+
+type interruptingWriter struct {
+	protoio.Writer
+	stores int
+}
+
+func (w *interruptingWriter) WriteMsg(msg proto.Message) error {
+	if msg.(*snapshottypes.SnapshotItem).GetStore() != nil {
+		w.stores++
+	} else if w.stores > 1 {
+		return errors.New("interrupted")
+	}
+	return w.Writer.WriteMsg(msg)
+}
+
+func TestMultistoreSnapshot_Resume(t *testing.T) {
+	// the first store spans more than a chunk, so that the snapshot is
+	// interrupted in the middle of its second chunk
+	store := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 15000)
+	version := uint64(store.LastCommitID().Version)
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	snapshotter := &interruptingSnapshotter{Store: store, interrupt: true}
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(1, 1), snapshotter, nil, log.NewNopLogger())
+	_, err = manager.Create(version)
+	require.Error(t, err)
+
+	snapshotter.interrupt = false
+	resumed, err := manager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, []string{"store0"}, snapshotter.completedStores)
+
+	// the resumed snapshot is identical to an uninterrupted one
+	expectedStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	expectedManager := snapshots.NewManager(expectedStore, snapshottypes.NewSnapshotOptions(1, 1), store, nil, log.NewNopLogger())
+	expected, err := expectedManager.Create(version)
+	require.NoError(t, err)
+	require.Greater(t, expected.Chunks, uint32(2))
+	require.Equal(t, expected, resumed)
+
+	for i := uint32(0); i < expected.Chunks; i++ {
+		expectedChunk, err := expectedManager.LoadChunk(version, expected.Format, i)
+		require.NoError(t, err)
+		chunk, err := manager.LoadChunk(version, resumed.Format, i)
+		require.NoError(t, err)
+		require.Equal(t, expectedChunk, chunk, "chunk %d", i)
+	}
+
+	// the resumed snapshot can be restored
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for i := 0; i < 3; i++ {
+		target.MountStoreWithDB(types.NewKVStoreKey(fmt.Sprintf("store%v", i)), types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetManager := snapshots.NewManager(targetStore, snapshottypes.NewSnapshotOptions(1, 1), target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*resumed))
+	for i := uint32(0); i < resumed.Chunks; i++ {
+		chunk, err := manager.LoadChunk(version, resumed.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == resumed.Chunks-1, done)
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -0,0 +1,142 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// StoreStatsOptions defines how the stores are scanned by StoreStats.
+type StoreStatsOptions struct {
+	// PrefixDepth is the number of leading key bytes the stats of each store
+	// are broken down by, 0 meaning no breakdown.
+	PrefixDepth int
+
+	// SampleRate makes StoreStats only measure every SampleRate-th key and
+	// extrapolate the sizes, 0 or 1 meaning that every key is measured.
+	SampleRate uint64
+}
+
+// KeyStats holds the number of keys of a set and the sizes of their keys and
+// values, extrapolated from a sample of the keys if Sampled.
+type KeyStats struct {
+	Keys       uint64
+	KeyBytes   uint64
+	ValueBytes uint64
+	Sampled    bool
+}
+
+// PrefixStats holds the stats of the keys of a store sharing a prefix.
+type PrefixStats struct {
+	Prefix []byte
+	KeyStats
+}
+
+// StoreStats holds the stats of the keys of a store, broken down by prefix if
+// requested.
+type StoreStats struct {
+	Name string
+	KeyStats
+	Prefixes []PrefixStats
+}
+
+// StoreStats scans the IAVL stores at the latest version and returns their
+// stats, sorted by store name, along with that version. It iterates over every
+// key of every store, so it is expensive on large stores: sampling only saves
+// the measurement of the keys, the number of keys is always exact.
+func (rs *Store) StoreStats(opts StoreStatsOptions) (int64, []StoreStats, error) {
+	if opts.PrefixDepth < 0 {
+		return 0, nil, fmt.Errorf("invalid prefix depth %d", opts.PrefixDepth)
+	}
+	if opts.SampleRate == 0 {
+		opts.SampleRate = 1
+	}
+
+	// the version must not be pruned while it is scanned
+	rs.pruner.mtx.Lock()
+	version := rs.LastCommitID().Version
+	stores := make(map[string]*iavl.Store)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		immutable, err := rs.GetCommitKVStore(key).(*iavl.Store).GetImmutable(version)
+		if err != nil {
+			rs.pruner.mtx.Unlock()
+			return 0, nil, fmt.Errorf("failed to load store %s at version %d: %w", key.Name(), version, err)
+		}
+		stores[key.Name()] = immutable
+	}
+	rs.pruner.pinned[version]++
+	rs.pruner.mtx.Unlock()
+	defer rs.pruner.unpin(version)
+
+	stats := make([]StoreStats, 0, len(stores))
+	for name, store := range stores {
+		storeStats, err := scanStore(store, opts)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to scan store %s: %w", name, err)
+		}
+		storeStats.Name = name
+		stats = append(stats, storeStats)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+
+	return version, stats, nil
+}
+
+// scanStore returns the stats of the keys of store.
+func scanStore(store types.KVStore, opts StoreStatsOptions) (StoreStats, error) {
+	var (
+		stats    StoreStats
+		prefixes []PrefixStats
+	)
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+
+		var prefixStats *KeyStats
+		if opts.PrefixDepth > 0 {
+			prefix := key
+			if len(prefix) > opts.PrefixDepth {
+				prefix = prefix[:opts.PrefixDepth]
+			}
+
+			// keys are iterated in order, so keys sharing a prefix are adjacent
+			if n := len(prefixes); n == 0 || !bytes.Equal(prefixes[n-1].Prefix, prefix) {
+				prefixes = append(prefixes, PrefixStats{Prefix: bytes.Clone(prefix)})
+			}
+			prefixStats = &prefixes[len(prefixes)-1].KeyStats
+		}
+
+		stats.add(key, it.Value(), opts.SampleRate)
+		if prefixStats != nil {
+			prefixStats.add(key, it.Value(), opts.SampleRate)
+		}
+	}
+
+	stats.Prefixes = prefixes
+	return stats, it.Error()
+}
+
+// add accounts for a key of the set, measuring it if it is sampled with the
+// given rate.
+func (s *KeyStats) add(key, value []byte, sampleRate uint64) {
+	s.Keys++
+	if (s.Keys-1)%sampleRate != 0 {
+		return
+	}
+
+	s.KeyBytes += uint64(len(key)) * sampleRate
+	s.ValueBytes += uint64(len(value)) * sampleRate
+	s.Sampled = sampleRate > 1
+}
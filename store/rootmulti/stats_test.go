@@ -0,0 +1,62 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+)
+
+func TestStoreStats(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// store1 holds 100 keys of prefix 0x01 and 20 keys of prefix 0x02
+	kv := ms.GetKVStore(testStoreKey1)
+	for i := 0; i < 100; i++ {
+		kv.Set([]byte(fmt.Sprintf("\x01key%03d", i)), []byte("value"))
+	}
+	for i := 0; i < 20; i++ {
+		kv.Set([]byte(fmt.Sprintf("\x02key%03d", i)), []byte("longer value"))
+	}
+	ms.GetKVStore(testStoreKey2).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+
+	// uncommitted writes are not accounted for
+	kv.Set([]byte("\x03key"), []byte("value"))
+
+	height, stats, err := ms.StoreStats(StoreStatsOptions{PrefixDepth: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+	require.Equal(t, []StoreStats{
+		{
+			Name:     "store1",
+			KeyStats: KeyStats{Keys: 120, KeyBytes: 120 * 7, ValueBytes: 100*5 + 20*12},
+			Prefixes: []PrefixStats{
+				{Prefix: []byte{1}, KeyStats: KeyStats{Keys: 100, KeyBytes: 100 * 7, ValueBytes: 100 * 5}},
+				{Prefix: []byte{2}, KeyStats: KeyStats{Keys: 20, KeyBytes: 20 * 7, ValueBytes: 20 * 12}},
+			},
+		},
+		{
+			Name:     "store2",
+			KeyStats: KeyStats{Keys: 1, KeyBytes: 3, ValueBytes: 5},
+			Prefixes: []PrefixStats{
+				{Prefix: []byte("k"), KeyStats: KeyStats{Keys: 1, KeyBytes: 3, ValueBytes: 5}},
+			},
+		},
+		{Name: "store3"},
+	}, stats)
+
+	// sampling extrapolates the sizes from every 10th key
+	_, stats, err = ms.StoreStats(StoreStatsOptions{SampleRate: 10})
+	require.NoError(t, err)
+	require.Equal(t, KeyStats{Keys: 120, KeyBytes: 120 * 7, ValueBytes: 10*50 + 2*120, Sampled: true}, stats[0].KeyStats)
+	require.Nil(t, stats[0].Prefixes)
+
+	_, _, err = ms.StoreStats(StoreStatsOptions{PrefixDepth: -1})
+	require.Error(t, err)
+}
@@ -8,21 +8,27 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"cosmossdk.io/log"
+	gometrics "github.com/armon/go-metrics"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	protoio "github.com/cosmos/gogoproto/io"
 	gogotypes "github.com/cosmos/gogoproto/types"
 	iavltree "github.com/cosmos/iavl"
+	"golang.org/x/exp/slices"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/cache"
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/derived"
 	"cosmossdk.io/store/iavl"
 	"cosmossdk.io/store/listenkv"
 	"cosmossdk.io/store/mem"
+	"cosmossdk.io/store/meteredkv"
 	"cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
@@ -75,11 +81,28 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+
+	// operationCounters holds the operation counters of each store, and is nil
+	// when operation metrics are disabled.
+	operationMetrics  bool
+	operationCounters map[types.StoreKey]*meteredkv.Counters
+
+	// pruner deletes the pruned heights in the background.
+	pruner *pruner
+
+	// versionCache holds the stores loaded at past versions for queries, and
+	// is nil when disabled.
+	versionCache *versionCache
+
+	// earliestVersion caches the earliest version not pruned, guarded by the
+	// mutex of the pruner, and is 0 until computed.
+	earliestVersion int64
 }
 
 var (
 	_ types.CommitMultiStore = (*Store)(nil)
 	_ types.Queryable        = (*Store)(nil)
+	_ types.VersionQuerier   = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -87,7 +110,7 @@ var (
 // a store is created, KVStores must be mounted and finally LoadLatestVersion or
 // LoadVersion must be called.
 func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics) *Store {
-	return &Store{
+	rs := &Store{
 		db:                  db,
 		logger:              logger,
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
@@ -100,6 +123,9 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
 	}
+	rs.pruner = newPruner(rs)
+
+	return rs
 }
 
 // GetPruning fetches the pruning strategy from the root store.
@@ -119,12 +145,89 @@ func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
 }
 
+// SetOperationMetrics enables or disables the counting of the operations made
+// on each store: gets, sets, deletes, iterated entries and read and written
+// bytes. The counters are emitted as telemetry counters labeled by store on
+// each commit, such as store_get_count{store="staking"}.
+func (rs *Store) SetOperationMetrics(enabled bool) {
+	rs.operationMetrics = enabled
+	rs.operationCounters = nil
+	if enabled {
+		rs.operationCounters = newOperationCounters(rs.stores)
+	}
+}
+
+// OperationCounters returns the operation counters of the store with the given
+// key, or nil if operation metrics are disabled.
+func (rs *Store) OperationCounters(key types.StoreKey) *meteredkv.Counters {
+	return rs.operationCounters[key]
+}
+
+func newOperationCounters(stores map[types.StoreKey]types.CommitKVStore) map[types.StoreKey]*meteredkv.Counters {
+	counters := make(map[types.StoreKey]*meteredkv.Counters, len(stores))
+	for key := range stores {
+		counters[key] = &meteredkv.Counters{}
+	}
+
+	return counters
+}
+
+// meterStore wraps store so that its operations are counted, if operation
+// metrics are enabled.
+func (rs *Store) meterStore(key types.StoreKey, store types.KVStore) types.KVStore {
+	if rs.operationCounters == nil {
+		return store
+	}
+
+	counters, ok := rs.operationCounters[key]
+	if !ok {
+		return store
+	}
+
+	return meteredkv.NewStore(store, counters)
+}
+
+// emitOperationMetrics emits and resets the operation counters of the stores.
+func (rs *Store) emitOperationMetrics() {
+	labels := rs.metricsLabels()
+	for key, counters := range rs.operationCounters {
+		counters.Emit(key.Name(), labels)
+	}
+}
+
+// metricsLabels returns the global labels of the metrics of the store.
+func (rs *Store) metricsLabels() []gometrics.Label {
+	if m, ok := rs.metrics.(metrics.Metrics); ok {
+		return m.Labels
+	}
+
+	return nil
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
 	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
 }
 
+// SetPruningMaxBatchSize sets the maximum number of heights deleted from the
+// stores in a single background pruning batch. It must be called before the
+// first Commit.
+func (rs *Store) SetPruningMaxBatchSize(maxBatchSize int) {
+	if maxBatchSize > 0 {
+		rs.pruner.maxBatchSize = maxBatchSize
+	}
+}
+
+// SetHistoricalVersionCacheSize sets the number of past versions whose loaded
+// stores are kept for the queries at these heights, the least recently used
+// version being evicted first. A size of 0 disables the cache.
+func (rs *Store) SetHistoricalVersionCacheSize(size int) {
+	rs.pruner.mtx.Lock()
+	defer rs.pruner.mtx.Unlock()
+	rs.versionCache = newVersionCache(size)
+}
+
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
@@ -294,7 +397,16 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	}
 
 	rs.lastCommitInfo = cInfo
+	rs.pruner.mtx.Lock()
 	rs.stores = newStores
+	rs.earliestVersion = 0
+	if rs.versionCache != nil {
+		rs.versionCache.purge()
+	}
+	rs.pruner.mtx.Unlock()
+	if rs.operationMetrics {
+		rs.operationCounters = newOperationCounters(newStores)
+	}
 
 	// load any pruned heights we missed from disk to be pruned on the next run
 	if err := rs.pruningManager.LoadPruningHeights(rs.db); err != nil {
@@ -439,6 +551,56 @@ func (rs *Store) LatestVersion() int64 {
 	return rs.LastCommitID().Version
 }
 
+// EarliestVersion returns the earliest version which is not pruned, i.e. the
+// earliest version state can be queried at, or 0 if no version is committed.
+func (rs *Store) EarliestVersion() int64 {
+	latest := rs.LatestVersion()
+
+	rs.pruner.mtx.Lock()
+	defer rs.pruner.mtx.Unlock()
+
+	var stores []*iavl.Store
+	for _, store := range rs.stores {
+		if store, ok := store.(*iavl.Store); ok {
+			stores = append(stores, store)
+		}
+	}
+
+	// the versions are listed once, after which the earliest version only
+	// moves forward as versions are pruned
+	if rs.earliestVersion == 0 {
+		for _, store := range stores {
+			versions := store.GetAllVersions()
+			if len(versions) > 0 && (rs.earliestVersion == 0 || int64(versions[0]) < rs.earliestVersion) {
+				rs.earliestVersion = int64(versions[0])
+			}
+		}
+	}
+
+	for rs.earliestVersion != 0 && rs.earliestVersion < latest {
+		exists := slices.ContainsFunc(stores, func(store *iavl.Store) bool {
+			return store.VersionExists(rs.earliestVersion)
+		})
+		if exists {
+			break
+		}
+		rs.earliestVersion++
+	}
+
+	return rs.earliestVersion
+}
+
+// VersionTime returns the block time the version was committed with, false if
+// its commit info is not found.
+func (rs *Store) VersionTime(version int64) (time.Time, bool) {
+	cInfo, err := rs.GetCommitInfo(version)
+	if err != nil || cInfo == nil {
+		return time.Time{}, false
+	}
+
+	return cInfo.Timestamp, true
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -471,18 +633,29 @@ func (rs *Store) Commit() types.CommitID {
 		rs.logger.Debug("commit header and version mismatch", "header_height", rs.commitHeader.Height, "version", version)
 	}
 
+	// the stores must not be pruned while they are committed
+	rs.pruner.mtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
+	if rs.operationCounters != nil {
+		rs.emitOperationMetrics()
+	}
+	if cmgr, ok := rs.interBlockCache.(*cache.CommitKVStoreCacheManager); ok {
+		cmgr.EmitMetrics(rs.metricsLabels())
+	}
+
 	// remove remnants of removed stores
 	for sk := range rs.removalMap {
 		if _, ok := rs.stores[sk]; ok {
 			delete(rs.stores, sk)
 			delete(rs.storesParams, sk)
 			delete(rs.keysByName, sk.Name())
+			delete(rs.operationCounters, sk)
 		}
 	}
+	rs.pruner.mtx.Unlock()
 
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
@@ -543,7 +716,7 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		store := types.KVStore(v)
+		store := rs.meterStore(k, v)
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(k) {
@@ -557,51 +730,27 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// iterating at past heights. Stores which are not versioned, such as derived
+// stores, are branched at their current state.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	iavlStores, err := rs.getVersionStores(version)
+	if err != nil {
+		return nil, err
+	}
+
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
-	var commitInfo *types.CommitInfo
-	storeInfos := map[string]bool{}
 	for key, store := range rs.stores {
 		var cacheStore types.KVStore
 		switch store.GetStoreType() {
 		case types.StoreTypeIAVL:
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			// Attempt to lazy-load an already saved IAVL store version. If the
-			// version does not exist or is pruned, an error should be returned.
-			var err error
-			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
-			// if we got error from loading a module store
-			// we fetch commit info of this version
-			// we use commit info to check if the store existed at this version or not
-			if err != nil {
-				if commitInfo == nil {
-					var errCommitInfo error
-					commitInfo, errCommitInfo = rs.GetCommitInfo(version)
-
-					if errCommitInfo != nil {
-						return nil, errCommitInfo
-					}
-
-					for _, storeInfo := range commitInfo.StoreInfos {
-						storeInfos[storeInfo.Name] = true
-					}
-				}
-
-				// If the store existed at this version, it means there's actually an error
-				// getting the root store at this version.
-				if storeInfos[key.Name()] {
-					return nil, err
-				}
-			}
+			cacheStore = iavlStores[key]
 
 		default:
 			cacheStore = store
 		}
 
+		cacheStore = rs.meterStore(key, cacheStore)
+
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(key) {
@@ -614,6 +763,69 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
 }
 
+// getVersionStores returns the IAVL stores loaded at version, from the
+// historical version cache if it is enabled and version is a past one.
+func (rs *Store) getVersionStores(version int64) (versionStores, error) {
+	rs.pruner.mtx.Lock()
+	cache := rs.versionCache
+	rs.pruner.mtx.Unlock()
+
+	// only past versions are cached, the latest one being replaced on every
+	// commit
+	if cache == nil || rs.lastCommitInfo == nil || version >= rs.lastCommitInfo.Version {
+		return rs.loadVersionStores(version)
+	}
+
+	return cache.get(version, rs.loadVersionStores)
+}
+
+// loadVersionStores loads the IAVL stores at version. An error is returned if
+// any store existing at that version cannot be loaded.
+func (rs *Store) loadVersionStores(version int64) (versionStores, error) {
+	stores := make(versionStores)
+	var commitInfo *types.CommitInfo
+	storeInfos := map[string]bool{}
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		store = rs.GetCommitKVStore(key)
+
+		// Attempt to lazy-load an already saved IAVL store version. If the
+		// version does not exist or is pruned, an error should be returned.
+		var err error
+		stores[key], err = store.(*iavl.Store).GetImmutable(version)
+		// if we got error from loading a module store
+		// we fetch commit info of this version
+		// we use commit info to check if the store existed at this version or not
+		if err != nil {
+			if commitInfo == nil {
+				var errCommitInfo error
+				commitInfo, errCommitInfo = rs.GetCommitInfo(version)
+
+				if errCommitInfo != nil {
+					return nil, errCommitInfo
+				}
+
+				for _, storeInfo := range commitInfo.StoreInfos {
+					storeInfos[storeInfo.Name] = true
+				}
+			}
+
+			// If the store existed at this version, it means there's actually an error
+			// getting the root store at this version.
+			if storeInfos[key.Name()] {
+				return nil, err
+			}
+		}
+	}
+
+	return stores, nil
+}
+
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
 // not exist, it will panic. If the Store is wrapped in an inter-block cache, it
 // will be unwrapped prior to being returned.
@@ -640,7 +852,7 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if s == nil {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
-	store := types.KVStore(s)
+	store := rs.meterStore(key, s)
 
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
@@ -657,9 +869,22 @@ func (rs *Store) handlePruning(version int64) error {
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
 		return nil
 	}
-	rs.logger.Info("prune start", "height", version)
-	defer rs.logger.Info("prune end", "height", version)
-	return rs.PruneStores(true, nil)
+	heights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
+	if err != nil {
+		return err
+	}
+
+	// the heights stay persisted by the pruning manager until the pruner has
+	// deleted them, and enqueuing them does not wait for the pruner.
+	rs.logger.Info("prune enqueued", "height", version, "heights", len(heights))
+	return rs.pruner.enqueue(heights)
+}
+
+// Close deletes the heights still waiting to be pruned in the background and
+// stops the pruning worker. Heights pruned afterwards are deleted
+// synchronously on Commit.
+func (rs *Store) Close() error {
+	return rs.pruner.close()
 }
 
 // PruneStores prunes the specific heights of the multi store.
@@ -686,6 +911,24 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 
 	rs.logger.Debug("pruning store", "heights", pruningHeights)
 
+	rs.pruner.mtx.Lock()
+	defer rs.pruner.mtx.Unlock()
+	if err := rs.deleteVersions(pruningHeights); err != nil {
+		return err
+	}
+
+	// the heights are persisted until they are deleted
+	return rs.pruningManager.HandlePrunedHeights(pruningHeights)
+}
+
+// deleteVersions deletes the given heights from the IAVL stores, and evicts
+// them from the historical version cache. The caller must hold the pruner
+// mutex.
+func (rs *Store) deleteVersions(pruningHeights []int64) error {
+	if rs.versionCache != nil {
+		defer rs.versionCache.evict(pruningHeights...)
+	}
+
 	for key, store := range rs.stores {
 		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
 
@@ -819,6 +1062,22 @@ func parsePath(path string) (storeName, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return rs.snapshot(height, protoWriter, nil)
+}
+
+// ResumeSnapshot implements snapshottypes.ResumableSnapshotter. The items of
+// the stores which are not completed are written exactly as Snapshot would.
+func (rs *Store) ResumeSnapshot(height uint64, protoWriter protoio.Writer, completedStores []string) error {
+	skip := make(map[string]bool, len(completedStores))
+	for _, name := range completedStores {
+		skip[name] = true
+	}
+	return rs.snapshot(height, protoWriter, skip)
+}
+
+// snapshot writes the snapshot items of the stores at height, except those in
+// skip.
+func (rs *Store) snapshot(height uint64, protoWriter protoio.Writer, skip map[string]bool) error {
 	if height == 0 {
 		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
 	}
@@ -826,6 +1085,10 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
+	// the height must not be pruned while it is exported
+	rs.pruner.pin(int64(height))
+	defer rs.pruner.unpin(int64(height))
+
 	// Collect stores to snapshot (only IAVL stores are supported)
 	type namedStore struct {
 		*iavl.Store
@@ -834,6 +1097,9 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	stores := []namedStore{}
 	keys := keysFromStoreKeyMap(rs.stores)
 	for _, key := range keys {
+		if skip[key.Name()] {
+			continue
+		}
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
 			stores = append(stores, namedStore{name: key.Name(), Store: store})
@@ -1053,6 +1319,14 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return mem.NewStore(), nil
 
+	case types.StoreTypeDerived:
+		store, err := derived.NewStore(db)
+		if err != nil {
+			return nil, err
+		}
+
+		return store, nil
+
 	default:
 		panic(fmt.Sprintf("unrecognized store type %v", params.typ))
 	}
@@ -1063,7 +1337,7 @@ func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	storeInfos := []types.StoreInfo{}
 	for _, key := range keys {
 		store := rs.stores[key]
-		if store.GetStoreType() == types.StoreTypeTransient {
+		if store.GetStoreType() == types.StoreTypeTransient || store.GetStoreType() == types.StoreTypeDerived {
 			continue
 		}
 		storeInfos = append(storeInfos, types.StoreInfo{
@@ -1190,6 +1464,14 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 
 	for _, key := range storeKeys {
 		store := storeMap[key]
+
+		// derived stores are not part of the commit info, they are committed at
+		// the version of the primary state their contents are derived from
+		if ds, ok := store.(*derived.Store); ok {
+			ds.CommitVersion(version)
+			continue
+		}
+
 		last := store.LastCommitID()
 
 		// If a commit event execution is interrupted, a new iavl store's version
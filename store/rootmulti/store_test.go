@@ -8,12 +8,14 @@ import (
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/iavl"
 	sdkmaps "cosmossdk.io/store/internal/maps"
+	"cosmossdk.io/store/meteredkv"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/types"
@@ -518,6 +520,7 @@ func TestMultiStore_Pruning(t *testing.T) {
 			for i := int64(0); i < tc.numVersions; i++ {
 				ms.Commit()
 			}
+			require.NoError(t, ms.pruner.flush())
 
 			for _, v := range tc.saved {
 				_, err := ms.CacheMultiStoreWithVersion(v)
@@ -532,6 +535,44 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_EarliestVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, int64(0), ms.EarliestVersion())
+
+	ms.Commit()
+	require.Equal(t, int64(1), ms.EarliestVersion())
+
+	// the earliest version moves forward as versions are pruned
+	for i := 0; i < 11; i++ {
+		ms.Commit()
+	}
+	require.NoError(t, ms.pruner.flush())
+	require.Equal(t, int64(8), ms.EarliestVersion())
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, int64(8), ms.EarliestVersion())
+}
+
+func TestMultiStore_VersionTime(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	blockTime := time.Unix(100, 0).UTC()
+	ms.SetCommitHeader(cmtproto.Header{Height: 1, Time: blockTime})
+	ms.Commit()
+
+	versionTime, ok := ms.VersionTime(1)
+	require.True(t, ok)
+	require.Equal(t, blockTime, versionTime)
+
+	_, ok = ms.VersionTime(2)
+	require.False(t, ok)
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -553,6 +594,7 @@ func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	for i := int64(0); i < numVersions; i++ {
 		lastCommitInfo = ms.Commit()
 	}
+	require.NoError(t, ms.pruner.flush())
 
 	require.Equal(t, numVersions, lastCommitInfo.Version)
 
@@ -570,14 +612,16 @@ func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	err := ms.LoadVersion(numVersions - 1)
 	require.NoError(t, err)
 
-	// Ensure already pruned heights were loaded
+	// Ensure already pruned heights are no longer persisted
 	heights, err := ms.pruningManager.GetFlushAndResetPruningHeights()
 	require.NoError(t, err)
-	require.Equal(t, expectedHeights, heights)
+	require.Empty(t, heights)
 
 	require.NoError(t, ms.pruningManager.LoadPruningHeights(db))
 
-	// Test pruning the same heights again
+	// Test pruning the same heights again, as after a crash before they were
+	// cleared
+	require.NoError(t, ms.PruneStores(false, expectedHeights))
 	lastCommitInfo = ms.Commit()
 	require.Equal(t, numVersions, lastCommitInfo.Version)
 
@@ -920,6 +964,33 @@ func TestStateListeners(t *testing.T) {
 	require.Empty(t, ms.PopStateCache())
 }
 
+func TestOperationMetrics(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// stores are not wrapped when disabled
+	require.Nil(t, ms.OperationCounters(testStoreKey1))
+	require.IsType(t, &iavl.Store{}, ms.GetKVStore(testStoreKey1))
+
+	ms.SetOperationMetrics(true)
+	cacheMulti := ms.CacheMultiStore()
+	store := cacheMulti.GetKVStore(testStoreKey1)
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	require.Nil(t, store.Get([]byte("key3")))
+
+	// the cached writes reach the store once written
+	require.Equal(t, meteredkv.CountersSnapshot{Gets: 1, ReadBytes: 4}, ms.OperationCounters(testStoreKey1).Snapshot())
+	cacheMulti.Write()
+	require.Equal(t, meteredkv.CountersSnapshot{Gets: 1, Sets: 2, ReadBytes: 4, WriteBytes: 20}, ms.OperationCounters(testStoreKey1).Snapshot())
+	require.Equal(t, meteredkv.CountersSnapshot{}, ms.OperationCounters(testStoreKey2).Snapshot())
+
+	// counters are emitted and reset on commit
+	ms.Commit()
+	require.Equal(t, meteredkv.CountersSnapshot{}, ms.OperationCounters(testStoreKey1).Snapshot())
+}
+
 type commitKVStoreStub struct {
 	types.CommitKVStore
 	Committed int
@@ -0,0 +1,116 @@
+package rootmulti
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"cosmossdk.io/store/types"
+)
+
+// versionStores holds the IAVL stores of a Store loaded at a past version, by
+// key. A store which did not exist at that version is held as a nil store.
+type versionStores map[types.StoreKey]types.KVStore
+
+// versionLoad is the loading of the stores at a version, shared by all the
+// callers asking for that version while it is in progress.
+type versionLoad struct {
+	done   chan struct{}
+	stores versionStores
+	err    error
+
+	// invalidated is set when the version is evicted while it is loaded, so
+	// that the loaded stores are not cached.
+	invalidated bool
+}
+
+// versionCache is a bounded LRU cache of the stores loaded at past versions,
+// so that repeated queries at the same height do not reload the IAVL trees.
+// Concurrent loads of the same version are shared.
+type versionCache struct {
+	cache *lru.Cache
+
+	mtx   sync.Mutex
+	loads map[int64]*versionLoad
+}
+
+// newVersionCache returns a cache holding up to size versions, or nil if size
+// is not positive.
+func newVersionCache(size int) *versionCache {
+	if size <= 0 {
+		return nil
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+
+	return &versionCache{
+		cache: cache,
+		loads: make(map[int64]*versionLoad),
+	}
+}
+
+// get returns the stores at version, calling load if they are neither cached
+// nor being loaded. Failed loads are not cached.
+func (c *versionCache) get(version int64, load func(int64) (versionStores, error)) (versionStores, error) {
+	c.mtx.Lock()
+	if stores, ok := c.cache.Get(version); ok {
+		c.mtx.Unlock()
+		return stores.(versionStores), nil
+	}
+	if l, ok := c.loads[version]; ok {
+		c.mtx.Unlock()
+		<-l.done
+		return l.stores, l.err
+	}
+
+	l := &versionLoad{done: make(chan struct{})}
+	c.loads[version] = l
+	c.mtx.Unlock()
+
+	l.stores, l.err = load(version)
+
+	c.mtx.Lock()
+	delete(c.loads, version)
+	if l.err == nil && !l.invalidated {
+		c.cache.Add(version, l.stores)
+	}
+	c.mtx.Unlock()
+	close(l.done)
+
+	return l.stores, l.err
+}
+
+// evict removes the given versions from the cache, along with the stores
+// being loaded at them, so that the trees of deleted versions are released
+// and never served again.
+func (c *versionCache) evict(versions ...int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, version := range versions {
+		c.cache.Remove(version)
+		if l, ok := c.loads[version]; ok {
+			l.invalidated = true
+		}
+	}
+}
+
+// purge removes all the versions from the cache, along with the stores being
+// loaded.
+func (c *versionCache) purge() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.cache.Purge()
+	for _, l := range c.loads {
+		l.invalidated = true
+	}
+}
+
+// len returns the number of cached versions.
+func (c *versionCache) len() int {
+	return c.cache.Len()
+}
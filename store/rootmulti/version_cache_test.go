@@ -0,0 +1,194 @@
+package rootmulti
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/types"
+)
+
+// newVersionedMultiStore returns a multistore having committed numVersions
+// versions, the key "key" of store1 holding the version at which it was set.
+func newVersionedMultiStore(t testing.TB, po pruningtypes.PruningOptions, cacheSize, numVersions int) *Store {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), po)
+	ms.SetHistoricalVersionCacheSize(cacheSize)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 1; i <= numVersions; i++ {
+		ms.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		ms.Commit()
+	}
+
+	return ms
+}
+
+func queryVersion(t *testing.T, ms *Store, version int64) string {
+	cms, err := ms.CacheMultiStoreWithVersion(version)
+	require.NoError(t, err)
+
+	return string(cms.GetKVStore(testStoreKey1).Get([]byte("key")))
+}
+
+func TestHistoricalVersionCache(t *testing.T) {
+	ms := newVersionedMultiStore(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), 2, 5)
+
+	require.Equal(t, "1", queryVersion(t, ms, 1))
+	require.Equal(t, 1, ms.versionCache.len())
+	cached, err := ms.getVersionStores(1)
+	require.NoError(t, err)
+	reused, err := ms.getVersionStores(1)
+	require.NoError(t, err)
+	require.Same(t, cached[testStoreKey1], reused[testStoreKey1])
+
+	// the latest version is not cached
+	require.Equal(t, "5", queryVersion(t, ms, 5))
+	require.Equal(t, 1, ms.versionCache.len())
+
+	// the least recently used version is evicted
+	require.Equal(t, "2", queryVersion(t, ms, 2))
+	require.Equal(t, "1", queryVersion(t, ms, 1))
+	require.Equal(t, "3", queryVersion(t, ms, 3))
+	require.Equal(t, 2, ms.versionCache.len())
+	require.True(t, ms.versionCache.cache.Contains(int64(1)))
+	require.False(t, ms.versionCache.cache.Contains(int64(2)))
+
+	// an evicted version is loaded again
+	require.Equal(t, "2", queryVersion(t, ms, 2))
+	reloaded, err := ms.getVersionStores(2)
+	require.NoError(t, err)
+	require.True(t, ms.versionCache.cache.Contains(int64(2)))
+	require.False(t, ms.versionCache.cache.Contains(int64(1)))
+	require.NotNil(t, reloaded[testStoreKey1])
+
+	// failed loads are not cached
+	_, err = ms.CacheMultiStoreWithVersion(10)
+	require.Error(t, err)
+	require.False(t, ms.versionCache.cache.Contains(int64(10)))
+}
+
+func TestHistoricalVersionCachePruning(t *testing.T) {
+	ms := newVersionedMultiStore(t, pruningtypes.NewCustomPruningOptions(2, 1), 10, 2)
+
+	require.Equal(t, "1", queryVersion(t, ms, 1))
+	require.True(t, ms.versionCache.cache.Contains(int64(1)))
+
+	for i := 0; i < 3; i++ {
+		ms.Commit()
+	}
+	require.NoError(t, ms.pruner.flush())
+
+	// the pruned version is evicted rather than served from the cache
+	require.False(t, ms.versionCache.cache.Contains(int64(1)))
+	_, err := ms.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+}
+
+func TestHistoricalVersionCacheLoadVersion(t *testing.T) {
+	ms := newVersionedMultiStore(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), 10, 3)
+
+	require.Equal(t, "1", queryVersion(t, ms, 1))
+	require.Equal(t, 1, ms.versionCache.len())
+
+	require.NoError(t, ms.LoadVersion(2))
+	require.Zero(t, ms.versionCache.len())
+	require.Equal(t, "1", queryVersion(t, ms, 1))
+}
+
+func TestHistoricalVersionCacheDisabled(t *testing.T) {
+	ms := newVersionedMultiStore(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), 0, 3)
+	require.Nil(t, ms.versionCache)
+	require.Equal(t, "1", queryVersion(t, ms, 1))
+}
+
+func TestVersionCacheSharedLoad(t *testing.T) {
+	cache := newVersionCache(2)
+
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(int64) (versionStores, error) {
+		loads.Add(1)
+		<-release
+		return versionStores{}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := cache.get(1, load)
+			require.NoError(t, err)
+		}()
+	}
+
+	require.Eventually(t, func() bool { return loads.Load() == 1 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), loads.Load())
+	require.Equal(t, 1, cache.len())
+}
+
+func TestVersionCacheEvictDuringLoad(t *testing.T) {
+	cache := newVersionCache(2)
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := cache.get(1, func(int64) (versionStores, error) {
+			<-release
+			return versionStores{}, nil
+		})
+		require.NoError(t, err)
+	}()
+
+	require.Eventually(t, func() bool {
+		cache.mtx.Lock()
+		defer cache.mtx.Unlock()
+		return cache.loads[1] != nil
+	}, time.Second, time.Millisecond)
+	cache.evict(1)
+	close(release)
+	<-done
+
+	require.Zero(t, cache.len())
+}
+
+func BenchmarkCacheMultiStoreWithVersion(b *testing.B) {
+	for _, cacheSize := range []int{0, 10} {
+		b.Run(fmt.Sprintf("cache size %d", cacheSize), func(b *testing.B) {
+			ms := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+			ms.SetHistoricalVersionCacheSize(cacheSize)
+			require.NoError(b, ms.LoadLatestVersion())
+
+			for v := 0; v < 20; v++ {
+				for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+					store := ms.GetStoreByName(key.Name()).(types.KVStore)
+					for i := 0; i < 100; i++ {
+						store.Set([]byte(fmt.Sprintf("key-%d-%d", v, i)), []byte(fmt.Sprint(v)))
+					}
+				}
+				ms.Commit()
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cms, err := ms.CacheMultiStoreWithVersion(10)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if cms.GetKVStore(testStoreKey1).Get([]byte("key-5-50")) == nil {
+					b.Fatal("missing key")
+				}
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ type ChunkWriter struct {
 	chunkSize uint64
 	written   uint64
 	closed    bool
+
+	// resumeOffset is the number of bytes of the first chunk written before
+	// the writer was created, when resuming a stream.
+	resumeOffset uint64
 }
 
 // NewChunkWriter creates a new ChunkWriter. If chunkSize is 0, no chunking will be done.
@@ -38,7 +42,8 @@ func (w *ChunkWriter) chunk() error {
 	pr, pw := io.Pipe()
 	w.ch <- pr
 	w.pipe = pw
-	w.written = 0
+	w.written = w.resumeOffset
+	w.resumeOffset = 0
 	return nil
 }
 
@@ -11,6 +11,8 @@ import (
 	"sync"
 
 	"cosmossdk.io/log"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/snapshots/types"
@@ -45,6 +47,32 @@ type Manager struct {
 	chRestoreDone      <-chan restoreDone
 	restoreChunkHashes [][]byte
 	restoreChunkIndex  uint32
+	restoreHeight      uint64
+
+	// snapshotHeight, snapshotStore and snapshotWriter track the progress of
+	// the snapshot being created.
+	snapshotHeight uint64
+	snapshotStore  string
+	snapshotWriter *StreamWriter
+
+	// resumeOnce guards the resumption of the snapshot interrupted by the
+	// previous shutdown, if any.
+	resumeOnce sync.Once
+}
+
+// Progress is the progress of the operation of a Manager.
+type Progress struct {
+	// Operation is the operation in progress, empty if none is.
+	Operation string
+	// Height is the height of the snapshot being created or restored.
+	Height uint64
+	// Store is the name of the store being exported, when creating a snapshot.
+	Store string
+	// ChunksWritten is the number of chunks created or restored so far.
+	ChunksWritten uint64
+	// EstimatedChunks is the number of chunks of the snapshot, estimated
+	// from the latest snapshot when creating one, or 0 if unknown.
+	EstimatedChunks uint64
 }
 
 // operation represents a Manager operation. Only one operation can be in progress at a time.
@@ -137,6 +165,48 @@ func (m *Manager) endLocked() {
 	m.chRestoreDone = nil
 	m.restoreChunkHashes = nil
 	m.restoreChunkIndex = 0
+	m.restoreHeight = 0
+	m.snapshotHeight = 0
+	m.snapshotStore = ""
+	m.snapshotWriter = nil
+}
+
+// Progress returns the progress of the operation in progress, if any. It can be
+// concurrent with other operations.
+func (m *Manager) Progress() (Progress, error) {
+	if m == nil {
+		return Progress{}, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	m.mtx.Lock()
+	progress := Progress{Operation: string(m.operation)}
+	writer := m.snapshotWriter
+	switch m.operation {
+	case opSnapshot:
+		progress.Height = m.snapshotHeight
+		progress.Store = m.snapshotStore
+	case opRestore:
+		progress.Height = m.restoreHeight
+		progress.ChunksWritten = uint64(m.restoreChunkIndex)
+		progress.EstimatedChunks = uint64(len(m.restoreChunkHashes))
+	}
+	m.mtx.Unlock()
+
+	if progress.Operation != string(opSnapshot) {
+		return progress, nil
+	}
+
+	if writer != nil {
+		progress.ChunksWritten = writer.Written() / snapshotChunkSize
+	}
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		return Progress{}, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if latest != nil {
+		progress.EstimatedChunks = uint64(latest.Chunks)
+	}
+	return progress, nil
 }
 
 // GetInterval returns snapshot interval represented in heights.
@@ -157,7 +227,9 @@ func (m *Manager) GetSnapshotBlockRetentionHeights() int64 {
 	return int64(m.opts.Interval * uint64(m.opts.KeepRecent))
 }
 
-// Create creates a snapshot and returns its metadata.
+// Create creates a snapshot and returns its metadata. If the creation of the
+// snapshot was interrupted before, it is resumed from its last checkpoint when
+// the multistore supports it.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	if m == nil {
 		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
@@ -180,27 +252,83 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	checkpoint, err := m.resumableCheckpoint(height)
+	if err != nil {
+		return nil, err
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, ch, checkpoint)
 
+	if checkpoint != nil {
+		m.logger.Info("resuming state snapshot", "height", height, "completed_stores", len(checkpoint.Stores))
+		return m.store.resume(checkpoint, ch)
+	}
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// resumableCheckpoint returns the checkpoint the snapshot at height can be
+// resumed from, or nil if it must be created from scratch. Checkpoints which
+// cannot be resumed from are deleted.
+func (m *Manager) resumableCheckpoint(height uint64) (*types.SnapshotCheckpoint, error) {
+	checkpoint, err := m.store.getCheckpoint(height, types.CurrentFormat)
+	if err != nil || checkpoint == nil {
+		return nil, err
+	}
+
+	if _, ok := m.multistore.(types.ResumableSnapshotter); !ok {
+		return nil, m.store.deleteCheckpoint(height, types.CurrentFormat)
+	}
+	if err := m.store.checkResumable(checkpoint); err != nil {
+		m.logger.Error("cannot resume state snapshot; creating it from scratch", "height", height, "err", err)
+		return nil, m.store.deleteCheckpoint(height, types.CurrentFormat)
+	}
+
+	return checkpoint, nil
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
-		return
+// the produced chunks are written to the channel. The snapshot is resumed from
+// checkpoint if it is set.
+func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser, checkpoint *types.SnapshotCheckpoint) {
+	var streamWriter *StreamWriter
+	writer := &checkpointWriter{manager: m, height: height}
+	if checkpoint != nil {
+		sw, err := newStreamWriter(ch, checkpoint.Written, checkpoint.Checksum)
+		if err != nil {
+			return
+		}
+		streamWriter = sw
+		writer.completedStores = checkpoint.Stores
+	} else {
+		streamWriter = NewStreamWriter(ch)
+		if streamWriter == nil {
+			return
+		}
 	}
+	writer.streamWriter = streamWriter
 	defer func() {
 		if err := streamWriter.Close(); err != nil {
 			streamWriter.CloseWithError(err)
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	m.mtx.Lock()
+	m.snapshotHeight = height
+	m.snapshotWriter = streamWriter
+	m.mtx.Unlock()
+
+	var err error
+	if checkpoint != nil {
+		err = m.multistore.(types.ResumableSnapshotter).ResumeSnapshot(height, writer, checkpoint.Stores)
+	} else {
+		err = m.multistore.Snapshot(height, writer)
+	}
+	if err == nil {
+		err = writer.checkpoint()
+	}
+	if err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -307,6 +435,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
+	m.restoreHeight = snapshot.Height
 	return nil
 }
 
@@ -440,11 +569,13 @@ func IsFormatSupported(snapshotter types.ExtensionSnapshotter, format uint32) bo
 }
 
 // SnapshotIfApplicable takes a snapshot of the current state if we are on a snapshot height.
-// It also prunes any old snapshots.
+// It also prunes any old snapshots. The first call resumes the snapshot
+// interrupted by the previous shutdown, if any.
 func (m *Manager) SnapshotIfApplicable(height int64) {
 	if m == nil {
 		return
 	}
+	m.resumeOnce.Do(m.resumeSnapshot)
 	if !m.shouldTakeSnapshot(height) {
 		m.logger.Debug("snapshot is skipped", "height", height)
 		return
@@ -457,6 +588,33 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// resumeSnapshot resumes the latest snapshot whose creation was interrupted,
+// unless a more recent snapshot was created since.
+func (m *Manager) resumeSnapshot() {
+	checkpoint, err := m.store.getLatestCheckpoint()
+	if err != nil {
+		m.logger.Error("failed to examine interrupted state snapshots", "err", err)
+		return
+	}
+	if checkpoint == nil {
+		return
+	}
+
+	latest, err := m.store.GetLatest()
+	if err != nil {
+		m.logger.Error("failed to examine latest snapshot", "err", err)
+		return
+	}
+	if latest != nil && latest.Height >= checkpoint.Height {
+		if err := m.store.deleteCheckpoint(checkpoint.Height, checkpoint.Format); err != nil {
+			m.logger.Error("failed to delete stale snapshot checkpoint", "height", checkpoint.Height, "err", err)
+		}
+		return
+	}
+
+	m.snapshot(int64(checkpoint.Height))
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
@@ -485,3 +643,59 @@ func (m *Manager) snapshot(height int64) {
 		m.logger.Debug("pruned state snapshots", "pruned", pruned)
 	}
 }
+
+// checkpointWriter writes the snapshot items of the multistore to a
+// StreamWriter, checkpointing the stream whenever the export of a store is
+// completed, so that an interrupted snapshot can be resumed from there.
+type checkpointWriter struct {
+	manager      *Manager
+	streamWriter *StreamWriter
+	height       uint64
+
+	// completedStores are the names of the stores completely written before
+	// the current one.
+	completedStores []string
+	// store is the name of the store being written, if any.
+	store string
+}
+
+var _ protoio.Writer = (*checkpointWriter)(nil)
+
+// WriteMsg implements protoio.Writer.
+func (w *checkpointWriter) WriteMsg(msg proto.Message) error {
+	if item, ok := msg.(*types.SnapshotItem); ok && item.GetStore() != nil {
+		if err := w.checkpoint(); err != nil {
+			return err
+		}
+
+		w.store = item.GetStore().Name
+		w.manager.mtx.Lock()
+		w.manager.snapshotStore = w.store
+		w.manager.mtx.Unlock()
+	}
+
+	return w.streamWriter.WriteMsg(msg)
+}
+
+// checkpoint marks the store being written as completed and persists a
+// checkpoint of the stream.
+func (w *checkpointWriter) checkpoint() error {
+	if w.store == "" {
+		return nil
+	}
+	w.completedStores = append(w.completedStores, w.store)
+	w.store = ""
+
+	written, checksum, ok, err := w.streamWriter.checkpoint()
+	if err != nil || !ok {
+		return err
+	}
+
+	return w.manager.store.saveCheckpoint(&types.SnapshotCheckpoint{
+		Height:   w.height,
+		Format:   types.CurrentFormat,
+		Stores:   w.completedStores,
+		Written:  written,
+		Checksum: checksum,
+	})
+}
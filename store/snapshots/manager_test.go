@@ -132,6 +132,20 @@ func TestManager_Prune(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_Progress(t *testing.T) {
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, opts, &mockSnapshotter{}, nil, log.NewNopLogger())
+	progress, err := manager.Progress()
+	require.NoError(t, err)
+	assert.Equal(t, snapshots.Progress{}, progress)
+
+	// the number of chunks is unknown without a previous snapshot
+	manager = setupBusyManager(t)
+	progress, err = manager.Progress()
+	require.NoError(t, err)
+	assert.Equal(t, snapshots.Progress{Operation: "snapshot", Height: 1}, progress)
+}
+
 func TestManager_Restore(t *testing.T) {
 	store := setupStore(t)
 	target := &mockSnapshotter{
@@ -22,6 +22,9 @@ import (
 const (
 	// keyPrefixSnapshot is the prefix for snapshot database keys
 	keyPrefixSnapshot byte = 0x01
+	// keyPrefixCheckpoint is the prefix for the database keys of the
+	// checkpoints of the snapshots being created
+	keyPrefixCheckpoint byte = 0x02
 )
 
 // Store is a snapshot store, containing snapshot metadata and binary chunks.
@@ -64,6 +67,9 @@ func (s *Store) Delete(height uint64, format uint32) error {
 		return errors.Wrapf(err, "failed to delete snapshot for height %v format %v",
 			height, format)
 	}
+	if err := s.deleteCheckpoint(height, format); err != nil {
+		return err
+	}
 	err = os.RemoveAll(s.pathSnapshot(height, format))
 	return errors.Wrapf(err, "failed to delete snapshot chunks for height %v format %v",
 		height, format)
@@ -224,6 +230,19 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, chunks, nil)
+}
+
+// resume saves a snapshot to disk whose chunks up to the given checkpoint were
+// saved before, returning it. The checkpoint must have been checked with
+// checkResumable.
+func (s *Store) resume(checkpoint *types.SnapshotCheckpoint, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
+	return s.save(checkpoint.Height, checkpoint.Format, chunks, checkpoint)
+}
+
+func (s *Store) save(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, checkpoint *types.SnapshotCheckpoint,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -262,6 +281,17 @@ func (s *Store) Save(
 	index := uint32(0)
 	snapshotHasher := sha256.New()
 	chunkHasher := sha256.New()
+	// resumeChunk is set while the first chunk received continues a chunk
+	// saved before the checkpoint
+	resumeChunk := false
+	if checkpoint != nil {
+		index, err = s.loadCheckpointChunks(checkpoint, snapshot, chunkHasher, snapshotHasher)
+		if err != nil {
+			return nil, err
+		}
+		dirCreated = true
+		resumeChunk = checkpoint.Written%snapshotChunkSize > 0
+	}
 	for chunkBody := range chunks {
 		// Only create the snapshot directory on encountering the first chunk.
 		// If the directory disappears during chunk saving,
@@ -275,30 +305,44 @@ func (s *Store) Save(
 			dirCreated = true
 		}
 
-		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
+		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher, resumeChunk); err != nil {
 			return nil, err
 		}
+		resumeChunk = false
 		index++
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
-	return snapshot, s.saveSnapshot(snapshot)
+	if err := s.saveSnapshot(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, s.deleteCheckpoint(height, format)
 }
 
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
 // The hash of the chunk is appended to the snapshot's metadata,
 // and the overall snapshot hash is updated with the chunk content too.
-func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash) error {
+// If resume is set, chunkBody is appended to the chunk saved so far, which
+// chunkHasher was fed.
+func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash, resume bool) error {
 	defer chunkBody.Close()
 
 	path := s.pathChunk(snapshot.Height, snapshot.Format, index)
-	chunkFile, err := os.Create(path)
+	var (
+		chunkFile *os.File
+		err       error
+	)
+	if resume {
+		chunkFile, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	} else {
+		chunkFile, err = os.Create(path)
+		chunkHasher.Reset()
+	}
 	if err != nil {
 		return errors.Wrapf(err, "failed to create snapshot chunk file %q", path)
 	}
 	defer chunkFile.Close()
 
-	chunkHasher.Reset()
 	if _, err := io.Copy(io.MultiWriter(chunkFile, chunkHasher, snapshotHasher), chunkBody); err != nil {
 		return errors.Wrapf(err, "failed to generate snapshot chunk %d", index)
 	}
@@ -315,6 +359,120 @@ func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types
 	return nil
 }
 
+// checkResumable checks that the chunks written up to checkpoint are on disk,
+// truncating the last one to the checkpoint. The snapshot cannot be resumed
+// from the checkpoint otherwise.
+func (s *Store) checkResumable(checkpoint *types.SnapshotCheckpoint) error {
+	fullChunks := checkpoint.Written / snapshotChunkSize
+	for i := uint64(0); i <= fullChunks; i++ {
+		size := snapshotChunkSize
+		if i == fullChunks {
+			size = checkpoint.Written % snapshotChunkSize
+			if size == 0 {
+				break
+			}
+		}
+
+		path := s.pathChunk(checkpoint.Height, checkpoint.Format, uint32(i))
+		info, err := os.Stat(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to stat snapshot chunk file %q", path)
+		}
+		if uint64(info.Size()) < size {
+			return errors.Wrapf(storetypes.ErrLogic, "snapshot chunk file %q has %d bytes, expected %d",
+				path, info.Size(), size)
+		}
+		if uint64(info.Size()) > size {
+			if err := os.Truncate(path, int64(size)); err != nil {
+				return errors.Wrapf(err, "failed to truncate snapshot chunk file %q", path)
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadCheckpointChunks hashes the chunks written up to checkpoint, returning
+// the index of the next chunk to save. chunkHasher is left holding the hash of
+// the last chunk if it is incomplete.
+func (s *Store) loadCheckpointChunks(
+	checkpoint *types.SnapshotCheckpoint, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash,
+) (uint32, error) {
+	fullChunks := uint32(checkpoint.Written / snapshotChunkSize)
+	for i := uint32(0); i <= fullChunks; i++ {
+		if i == fullChunks && checkpoint.Written%snapshotChunkSize == 0 {
+			break
+		}
+
+		chunk, err := s.loadChunkFile(checkpoint.Height, checkpoint.Format, i)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to load snapshot chunk %d", i)
+		}
+		chunkHasher.Reset()
+		_, err = io.Copy(io.MultiWriter(chunkHasher, snapshotHasher), chunk)
+		chunk.Close()
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to load snapshot chunk %d", i)
+		}
+
+		if i < fullChunks {
+			snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+		}
+	}
+
+	return fullChunks, nil
+}
+
+// saveCheckpoint saves the checkpoint of a snapshot being created.
+func (s *Store) saveCheckpoint(checkpoint *types.SnapshotCheckpoint) error {
+	value, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot checkpoint")
+	}
+	err = s.db.SetSync(encodeCheckpointKey(checkpoint.Height, checkpoint.Format), value)
+	return errors.Wrap(err, "failed to store snapshot checkpoint")
+}
+
+// getCheckpoint returns the checkpoint of the snapshot being created at the
+// given height and format, or nil if there is none.
+func (s *Store) getCheckpoint(height uint64, format uint32) (*types.SnapshotCheckpoint, error) {
+	bz, err := s.db.Get(encodeCheckpointKey(height, format))
+	if err != nil || bz == nil {
+		return nil, errors.Wrapf(err, "failed to fetch snapshot checkpoint for height %v format %v", height, format)
+	}
+
+	checkpoint := &types.SnapshotCheckpoint{}
+	if err := proto.Unmarshal(bz, checkpoint); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode snapshot checkpoint for height %v format %v", height, format)
+	}
+	return checkpoint, nil
+}
+
+// getLatestCheckpoint returns the checkpoint of the snapshot being created at
+// the highest height, or nil if there is none.
+func (s *Store) getLatestCheckpoint() (*types.SnapshotCheckpoint, error) {
+	iter, err := s.db.ReverseIterator(encodeCheckpointKey(0, 0), encodeCheckpointKey(uint64(math.MaxUint64), math.MaxUint32))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find latest snapshot checkpoint")
+	}
+	defer iter.Close()
+
+	var checkpoint *types.SnapshotCheckpoint
+	if iter.Valid() {
+		checkpoint = &types.SnapshotCheckpoint{}
+		if err := proto.Unmarshal(iter.Value(), checkpoint); err != nil {
+			return nil, errors.Wrap(err, "failed to decode latest snapshot checkpoint")
+		}
+	}
+	return checkpoint, errors.Wrap(iter.Error(), "failed to find latest snapshot checkpoint")
+}
+
+// deleteCheckpoint deletes the checkpoint of a snapshot, if any.
+func (s *Store) deleteCheckpoint(height uint64, format uint32) error {
+	err := s.db.DeleteSync(encodeCheckpointKey(height, format))
+	return errors.Wrapf(err, "failed to delete snapshot checkpoint for height %v format %v", height, format)
+}
+
 // saveSnapshot saves snapshot metadata to the database.
 func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	value, err := proto.Marshal(snapshot)
@@ -361,3 +519,10 @@ func encodeKey(height uint64, format uint32) []byte {
 	binary.BigEndian.PutUint32(k[9:], format)
 	return k
 }
+
+// encodeCheckpointKey encodes a snapshot checkpoint key.
+func encodeCheckpointKey(height uint64, format uint32) []byte {
+	k := encodeKey(height, format)
+	k[0] = keyPrefixCheckpoint
+	return k
+}
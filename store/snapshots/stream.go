@@ -2,8 +2,15 @@ package snapshots
 
 import (
 	"bufio"
+	"bytes"
+	"compress/flate"
 	"compress/zlib"
+	"encoding"
+	"encoding/binary"
+	"hash"
+	"hash/adler32"
 	"io"
+	"sync/atomic"
 
 	"cosmossdk.io/errors"
 	protoio "github.com/cosmos/gogoproto/io"
@@ -23,26 +30,41 @@ const (
 type StreamWriter struct {
 	chunkWriter *ChunkWriter
 	bufWriter   *bufio.Writer
-	zWriter     *zlib.Writer
+	zWriter     *segmentedZlibWriter
 	protoWriter protoio.WriteCloser
+
+	// written is the number of bytes passed to the chunk writer.
+	written atomic.Uint64
 }
 
 // NewStreamWriter set up a stream pipeline to serialize snapshot DB records.
 func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
-	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
-	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
-	zWriter, err := zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
+	sw, err := newStreamWriter(ch, 0, nil)
 	if err != nil {
-		chunkWriter.CloseWithError(errors.Wrap(err, "zlib failure"))
 		return nil
 	}
-	protoWriter := protoio.NewDelimitedWriter(zWriter)
-	return &StreamWriter{
-		chunkWriter: chunkWriter,
-		bufWriter:   bufWriter,
-		zWriter:     zWriter,
-		protoWriter: protoWriter,
+	return sw
+}
+
+// newStreamWriter sets up a stream pipeline resuming a stream of which written
+// bytes were already written, at a checkpoint with the given checksum state.
+func newStreamWriter(ch chan<- io.ReadCloser, written uint64, checksum []byte) (*StreamWriter, error) {
+	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
+	chunkWriter.resumeOffset = written % snapshotChunkSize
+
+	sw := &StreamWriter{chunkWriter: chunkWriter}
+	sw.written.Store(written)
+	sw.bufWriter = bufio.NewWriterSize(countingWriter{w: chunkWriter, n: &sw.written}, snapshotBufferSize)
+
+	zWriter, err := newSegmentedZlibWriter(sw.bufWriter, snapshotCompressionLevel, written > 0, checksum)
+	if err != nil {
+		err = errors.Wrap(err, "zlib failure")
+		chunkWriter.CloseWithError(err)
+		return nil, err
 	}
+	sw.zWriter = zWriter
+	sw.protoWriter = protoio.NewDelimitedWriter(zWriter)
+	return sw, nil
 }
 
 // WriteMsg implements protoio.Write interface
@@ -50,6 +72,31 @@ func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
 	return sw.protoWriter.WriteMsg(msg)
 }
 
+// Written returns the number of bytes of the stream written so far.
+func (sw *StreamWriter) Written() uint64 {
+	return sw.written.Load()
+}
+
+// checkpoint ends the current compression segment and flushes the stream, so
+// that it can be resumed from here. It returns the number of bytes written and
+// the state of the checksum of the stream, or false if nothing was written
+// since the previous checkpoint.
+func (sw *StreamWriter) checkpoint() (uint64, []byte, bool, error) {
+	if !sw.zWriter.dirty {
+		return 0, nil, false, nil
+	}
+
+	checksum, err := sw.zWriter.endSegment()
+	if err != nil {
+		return 0, nil, false, err
+	}
+	if err := sw.bufWriter.Flush(); err != nil {
+		return 0, nil, false, err
+	}
+
+	return sw.written.Load(), checksum, true, nil
+}
+
 // Close implements io.Closer interface
 func (sw *StreamWriter) Close() error {
 	if err := sw.protoWriter.Close(); err != nil {
@@ -68,6 +115,107 @@ func (sw *StreamWriter) CloseWithError(err error) {
 	sw.chunkWriter.CloseWithError(err)
 }
 
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n *atomic.Uint64
+}
+
+func (cw countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n.Add(uint64(n))
+	return n, err
+}
+
+// segmentedZlibWriter writes a zlib stream whose deflate data is made of
+// independently compressed segments, each ended by a sync flush. A stream can
+// thus be resumed at a segment boundary with a fresh compressor, and still be
+// read by any zlib reader.
+type segmentedZlibWriter struct {
+	w        io.Writer
+	fw       *flate.Writer
+	checksum hash.Hash32
+
+	wroteHeader bool
+	// dirty is set when data was written since the end of the last segment.
+	dirty bool
+}
+
+// newSegmentedZlibWriter returns a segmentedZlibWriter, resuming a stream at
+// the end of a segment with the given checksum state if resume is set.
+func newSegmentedZlibWriter(w io.Writer, level int, resume bool, checksum []byte) (*segmentedZlibWriter, error) {
+	fw, err := flate.NewWriter(w, level)
+	if err != nil {
+		return nil, err
+	}
+
+	zw := &segmentedZlibWriter{w: w, fw: fw, checksum: adler32.New(), wroteHeader: resume}
+	if resume {
+		if err := zw.checksum.(encoding.BinaryUnmarshaler).UnmarshalBinary(checksum); err != nil {
+			return nil, err
+		}
+	}
+	return zw, nil
+}
+
+// Write implements io.Writer.
+func (zw *segmentedZlibWriter) Write(p []byte) (int, error) {
+	if !zw.wroteHeader {
+		if _, err := zw.w.Write(zlibHeader()); err != nil {
+			return 0, err
+		}
+		zw.wroteHeader = true
+	}
+
+	n, err := zw.fw.Write(p)
+	zw.checksum.Write(p[:n])
+	zw.dirty = zw.dirty || n > 0
+	return n, err
+}
+
+// endSegment ends the current segment, returning the state of the checksum.
+func (zw *segmentedZlibWriter) endSegment() ([]byte, error) {
+	if err := zw.fw.Flush(); err != nil {
+		return nil, err
+	}
+	zw.fw.Reset(zw.w)
+	zw.dirty = false
+
+	return zw.checksum.(encoding.BinaryMarshaler).MarshalBinary()
+}
+
+// Close implements io.Closer, ending the stream.
+func (zw *segmentedZlibWriter) Close() error {
+	if !zw.wroteHeader {
+		if _, err := zw.w.Write(zlibHeader()); err != nil {
+			return err
+		}
+		zw.wroteHeader = true
+	}
+
+	if err := zw.fw.Close(); err != nil {
+		return err
+	}
+
+	trailer := make([]byte, 4)
+	binary.BigEndian.PutUint32(trailer, zw.checksum.Sum32())
+	_, err := zw.w.Write(trailer)
+	return err
+}
+
+// zlibHeader returns the header of the zlib streams of snapshots.
+func zlibHeader() []byte {
+	var buf bytes.Buffer
+	zw, err := zlib.NewWriterLevel(&buf, snapshotCompressionLevel)
+	if err != nil {
+		panic(err)
+	}
+	if err := zw.Flush(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()[:2]
+}
+
 // StreamReader set up a restore stream pipeline
 // chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 type StreamReader struct {
@@ -145,6 +145,90 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+// SnapshotCheckpoint records the progress of the creation of a snapshot at a
+// store boundary, from which the creation can be resumed after a restart.
+//
+// Since: cosmos-sdk 0.50
+type SnapshotCheckpoint struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Format uint32 `protobuf:"varint,2,opt,name=format,proto3" json:"format,omitempty"`
+	// stores holds the names of the stores completely written.
+	Stores []string `protobuf:"bytes,3,rep,name=stores,proto3" json:"stores,omitempty"`
+	// written is the number of bytes of the snapshot written.
+	Written uint64 `protobuf:"varint,4,opt,name=written,proto3" json:"written,omitempty"`
+	// checksum is the marshaled state of the checksum of the uncompressed
+	// snapshot stream.
+	Checksum []byte `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *SnapshotCheckpoint) Reset()         { *m = SnapshotCheckpoint{} }
+func (m *SnapshotCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*SnapshotCheckpoint) ProtoMessage()    {}
+func (*SnapshotCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{2}
+}
+func (m *SnapshotCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotCheckpoint.Merge(m, src)
+}
+func (m *SnapshotCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotCheckpoint proto.InternalMessageInfo
+
+func (m *SnapshotCheckpoint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotCheckpoint) GetFormat() uint32 {
+	if m != nil {
+		return m.Format
+	}
+	return 0
+}
+
+func (m *SnapshotCheckpoint) GetStores() []string {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+func (m *SnapshotCheckpoint) GetWritten() uint64 {
+	if m != nil {
+		return m.Written
+	}
+	return 0
+}
+
+func (m *SnapshotCheckpoint) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -152,7 +236,6 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
@@ -164,7 +247,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{2}
+	return fileDescriptor_3d5cca1aa5b69183, []int{3}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +356,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{3}
+	return fileDescriptor_3d5cca1aa5b69183, []int{4}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +408,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{4}
+	return fileDescriptor_3d5cca1aa5b69183, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +477,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+	return fileDescriptor_3d5cca1aa5b69183, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +531,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+	return fileDescriptor_3d5cca1aa5b69183, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,6 +570,7 @@ func (m *SnapshotExtensionPayload) GetPayload() []byte {
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.store.snapshots.v1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v1.Metadata")
+	proto.RegisterType((*SnapshotCheckpoint)(nil), "cosmos.store.snapshots.v1.SnapshotCheckpoint")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
@@ -499,38 +583,41 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xb4, 0x74, 0x2f, 0x41, 0xea, 0xac, 0x81, 0x02, 0x87, 0x2c, 0x84, 0x03, 0x91,
-	0x80, 0x94, 0x65, 0x1c, 0xb9, 0x50, 0x98, 0x94, 0x09, 0x90, 0x26, 0x4f, 0xe2, 0xc0, 0x65, 0xf2,
-	0x56, 0xd3, 0x44, 0x6d, 0xe2, 0xaa, 0xf6, 0x22, 0xfa, 0x2f, 0xf8, 0x23, 0xfc, 0x8f, 0x1d, 0x77,
-	0xe4, 0x34, 0x50, 0xfb, 0x47, 0x90, 0xed, 0x26, 0xa0, 0x6d, 0x45, 0xdb, 0xed, 0x7d, 0x2f, 0xdf,
-	0xf7, 0xf9, 0xf9, 0xcb, 0x33, 0x44, 0xa7, 0x5c, 0x14, 0x5c, 0xf4, 0x85, 0xe4, 0x33, 0xd6, 0x17,
-	0x25, 0x9d, 0x8a, 0x8c, 0x4b, 0xd1, 0xaf, 0x76, 0x1b, 0x10, 0x4f, 0x67, 0x5c, 0x72, 0xfc, 0xc8,
-	0x30, 0x63, 0xcd, 0x8c, 0x1b, 0x66, 0x5c, 0xed, 0x3e, 0xde, 0x1e, 0xf1, 0x11, 0xd7, 0xac, 0xbe,
-	0xaa, 0x8c, 0x20, 0xfc, 0x81, 0xa0, 0x7b, 0xb4, 0xa2, 0xe1, 0x87, 0xd0, 0xc9, 0x58, 0x3e, 0xca,
-	0xa4, 0x87, 0x02, 0x14, 0xd9, 0x64, 0x85, 0x54, 0xff, 0x2b, 0x9f, 0x15, 0x54, 0x7a, 0x1b, 0x01,
-	0x8a, 0xee, 0x93, 0x15, 0x52, 0xfd, 0xd3, 0xec, 0xac, 0x1c, 0x0b, 0xaf, 0x65, 0xfa, 0x06, 0x61,
-	0x0c, 0x76, 0x46, 0x45, 0xe6, 0xd9, 0x01, 0x8a, 0x5c, 0xa2, 0x6b, 0xbc, 0x0f, 0xdd, 0x82, 0x49,
-	0x3a, 0xa4, 0x92, 0x7a, 0xed, 0x00, 0x45, 0x4e, 0xf2, 0x34, 0x5e, 0x3b, 0x6c, 0xfc, 0x69, 0x45,
-	0x1d, 0xd8, 0xe7, 0x97, 0x3b, 0x16, 0x69, 0xa4, 0xe1, 0x4b, 0xe8, 0xd6, 0xdf, 0xf0, 0x13, 0x70,
-	0xf5, 0x81, 0xc7, 0xea, 0x00, 0x26, 0x3c, 0x14, 0xb4, 0x22, 0x97, 0x38, 0xba, 0x97, 0xea, 0x56,
-	0xf8, 0x6b, 0x03, 0xdc, 0xfa, 0x7a, 0x07, 0x92, 0x15, 0xf8, 0x3d, 0xb4, 0xf5, 0x71, 0xfa, 0x86,
-	0x4e, 0xf2, 0xe2, 0x3f, 0x33, 0xd4, 0xba, 0x23, 0xf5, 0x49, 0x89, 0x53, 0x8b, 0x18, 0x31, 0xfe,
-	0x00, 0x76, 0x4e, 0xab, 0x89, 0x8e, 0xc3, 0x49, 0x9e, 0xdf, 0xc2, 0xe4, 0xe0, 0xed, 0xe7, 0x8f,
-	0xca, 0x63, 0xd0, 0x5d, 0x5c, 0xee, 0xd8, 0x0a, 0xa5, 0x16, 0xd1, 0x26, 0xf8, 0x10, 0x36, 0xd9,
-	0x37, 0xc9, 0x4a, 0x91, 0xf3, 0x52, 0x07, 0xe9, 0x24, 0xaf, 0x6e, 0xe1, 0xb8, 0x5f, 0x6b, 0x54,
-	0x1e, 0xa9, 0x45, 0xfe, 0x9a, 0xe0, 0x13, 0xd8, 0x6a, 0xc0, 0xf1, 0x94, 0xce, 0x27, 0x9c, 0x0e,
-	0xf5, 0xcf, 0x70, 0x92, 0xbd, 0xbb, 0x38, 0x1f, 0x1a, 0x69, 0x6a, 0x91, 0x1e, 0xbb, 0xd2, 0x1b,
-	0x74, 0xc0, 0xce, 0x25, 0x2b, 0xc2, 0x67, 0xb0, 0x75, 0x2d, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c,
-	0xc8, 0x9b, 0x44, 0xd7, 0xe1, 0x04, 0x7a, 0x57, 0xc3, 0xc0, 0x3d, 0x68, 0x8d, 0xd9, 0x5c, 0xd3,
-	0x5c, 0xa2, 0x4a, 0xbc, 0x0d, 0xed, 0x8a, 0x4e, 0xce, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f,
-	0xee, 0x55, 0x6c, 0xd6, 0x04, 0xd4, 0x22, 0x35, 0xfc, 0x67, 0x65, 0xd5, 0xfd, 0xda, 0xf5, 0xca,
-	0x86, 0xef, 0xe0, 0xc1, 0x8d, 0x41, 0xdd, 0x34, 0xda, 0xba, 0xfd, 0x0e, 0x5f, 0x83, 0xb7, 0x2e,
-	0x13, 0x35, 0x52, 0x9d, 0xac, 0x19, 0xbf, 0x86, 0x83, 0x37, 0xe7, 0x0b, 0x1f, 0x5d, 0x2c, 0x7c,
-	0xf4, 0x7b, 0xe1, 0xa3, 0xef, 0x4b, 0xdf, 0xba, 0x58, 0xfa, 0xd6, 0xcf, 0xa5, 0x6f, 0x7d, 0x09,
-	0x4d, 0xf6, 0x62, 0x38, 0x8e, 0x73, 0x7e, 0xed, 0x35, 0xcb, 0xf9, 0x94, 0x89, 0x93, 0x8e, 0x7e,
-	0x97, 0x7b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0xe9, 0x8e, 0x10, 0xf4, 0x03, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x5d, 0x37, 0x9b, 0x92, 0xcc, 0x2e, 0x52, 0x6a, 0x15, 0xb4, 0xf4, 0xb0, 0x0d, 0xcb, 0x81,
+	0x95, 0x80, 0x0d, 0x4d, 0x39, 0x72, 0x21, 0xa5, 0x52, 0x2a, 0x40, 0xaa, 0x5c, 0x89, 0x03, 0x97,
+	0xca, 0x4d, 0x4c, 0x76, 0x95, 0xec, 0x3a, 0x8a, 0x9d, 0x40, 0xfe, 0x02, 0xf1, 0x1f, 0xfc, 0x47,
+	0x8f, 0x3d, 0x72, 0x2a, 0x28, 0xf9, 0x11, 0x64, 0x3b, 0x5e, 0x50, 0xdb, 0xa0, 0xc2, 0x6d, 0xde,
+	0x78, 0xe6, 0xf9, 0xcd, 0xf3, 0xc8, 0x10, 0xf7, 0xb8, 0xc8, 0xb9, 0x68, 0x09, 0xc9, 0x27, 0xac,
+	0x25, 0x0a, 0x3a, 0x16, 0x29, 0x97, 0xa2, 0x35, 0xdb, 0x2b, 0x41, 0x32, 0x9e, 0x70, 0xc9, 0xf1,
+	0x03, 0x53, 0x99, 0xe8, 0xca, 0xa4, 0xac, 0x4c, 0x66, 0x7b, 0x3b, 0xdb, 0x03, 0x3e, 0xe0, 0xba,
+	0xaa, 0xa5, 0x22, 0xd3, 0x10, 0x7d, 0x43, 0x50, 0x3b, 0x59, 0x95, 0xe1, 0xfb, 0xb0, 0x99, 0xb2,
+	0x6c, 0x90, 0xca, 0x00, 0x35, 0x51, 0xec, 0x92, 0x15, 0x52, 0xf9, 0x8f, 0x7c, 0x92, 0x53, 0x19,
+	0x6c, 0x34, 0x51, 0x7c, 0x97, 0xac, 0x90, 0xca, 0xf7, 0xd2, 0x69, 0x31, 0x14, 0x41, 0xc5, 0xe4,
+	0x0d, 0xc2, 0x18, 0xdc, 0x94, 0x8a, 0x34, 0x70, 0x9b, 0x28, 0xf6, 0x89, 0x8e, 0xf1, 0x21, 0xd4,
+	0x72, 0x26, 0x69, 0x9f, 0x4a, 0x1a, 0x54, 0x9b, 0x28, 0xf6, 0xda, 0x8f, 0x92, 0xb5, 0x62, 0x93,
+	0x77, 0xab, 0xd2, 0x8e, 0x7b, 0x7e, 0xb9, 0xeb, 0x90, 0xb2, 0x35, 0x7a, 0x06, 0x35, 0x7b, 0x86,
+	0x1f, 0x82, 0xaf, 0x2f, 0x3c, 0x55, 0x17, 0x30, 0x11, 0xa0, 0x66, 0x25, 0xf6, 0x89, 0xa7, 0x73,
+	0x5d, 0x9d, 0x8a, 0xbe, 0x22, 0xc0, 0x76, 0xbc, 0x83, 0x94, 0xf5, 0x86, 0x63, 0x9e, 0x15, 0xff,
+	0x35, 0xa8, 0x16, 0xa9, 0x06, 0xad, 0xc4, 0x75, 0xb2, 0x42, 0x38, 0x80, 0x3b, 0x9f, 0x26, 0x99,
+	0x94, 0xac, 0xd0, 0xb3, 0xba, 0xc4, 0x42, 0xbc, 0x03, 0xb5, 0x9e, 0xba, 0x4f, 0x4c, 0x73, 0x3d,
+	0xae, 0x4f, 0x4a, 0x1c, 0xfd, 0xd8, 0x00, 0xdf, 0x8a, 0x3a, 0x92, 0x2c, 0xc7, 0xaf, 0xa1, 0xaa,
+	0x09, 0xb5, 0x1a, 0xaf, 0xfd, 0xf4, 0x2f, 0xc6, 0xd8, 0xbe, 0x13, 0x75, 0xa4, 0x9a, 0xbb, 0x0e,
+	0x31, 0xcd, 0xf8, 0x0d, 0xb8, 0x19, 0x9d, 0x8d, 0xb4, 0x74, 0xaf, 0xfd, 0xe4, 0x16, 0x24, 0x47,
+	0xaf, 0xde, 0xbf, 0x55, 0x1c, 0x9d, 0xda, 0xe2, 0x72, 0xd7, 0x55, 0xa8, 0xeb, 0x10, 0x4d, 0x82,
+	0x8f, 0xa1, 0xce, 0x3e, 0x4b, 0x56, 0x88, 0x8c, 0x17, 0xfa, 0x75, 0xbd, 0xf6, 0xf3, 0x5b, 0x30,
+	0x1e, 0xda, 0x1e, 0xf5, 0x48, 0x5d, 0x87, 0xfc, 0x26, 0xc1, 0x67, 0xb0, 0x55, 0x82, 0xd3, 0x31,
+	0x9d, 0x8f, 0x38, 0xed, 0x6b, 0xd7, 0xbc, 0xf6, 0xfe, 0xbf, 0x30, 0x1f, 0x9b, 0xd6, 0xae, 0x43,
+	0x1a, 0xec, 0x4a, 0xae, 0xb3, 0x09, 0x6e, 0x26, 0x59, 0x1e, 0x3d, 0x86, 0xad, 0x6b, 0x46, 0xa9,
+	0xad, 0x2c, 0x68, 0x6e, 0x4c, 0xae, 0x13, 0x1d, 0x47, 0x23, 0x68, 0x5c, 0x35, 0x03, 0x37, 0xa0,
+	0x32, 0x64, 0x73, 0x5d, 0xe6, 0x13, 0x15, 0xe2, 0x6d, 0xa8, 0xce, 0xe8, 0x68, 0xca, 0xb4, 0xb5,
+	0x3e, 0x31, 0x40, 0x3d, 0xfe, 0x8c, 0x4d, 0x4a, 0x83, 0x2a, 0xc4, 0xc2, 0x3f, 0xd6, 0x4b, 0xcd,
+	0x57, 0xb5, 0xeb, 0x15, 0x1d, 0xc0, 0xbd, 0x1b, 0x8d, 0xba, 0x49, 0xda, 0xba, 0x5d, 0x8c, 0x5e,
+	0x40, 0xb0, 0xce, 0x13, 0x25, 0xc9, 0x3a, 0x6b, 0xe4, 0x5b, 0xd8, 0x79, 0x79, 0xbe, 0x08, 0xd1,
+	0xc5, 0x22, 0x44, 0x3f, 0x17, 0x21, 0xfa, 0xb2, 0x0c, 0x9d, 0x8b, 0x65, 0xe8, 0x7c, 0x5f, 0x86,
+	0xce, 0x87, 0xc8, 0x78, 0x2f, 0xfa, 0xc3, 0x24, 0xe3, 0xd7, 0xbe, 0x18, 0x39, 0x1f, 0x33, 0x71,
+	0xb6, 0xa9, 0x3f, 0x8b, 0xfd, 0x5f, 0x03, 0x00, 0xf0, 0x52, 0x7c, 0x5c, 0x89, 0x04, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -620,6 +707,60 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Written != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Written))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stores[iNdEx])
+			copy(dAtA[i:], m.Stores[iNdEx])
+			i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Stores[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Format != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -928,6 +1069,34 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovSnapshot(uint64(m.Format))
+	}
+	if len(m.Stores) > 0 {
+		for _, s := range m.Stores {
+			l = len(s)
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	if m.Written != 0 {
+		n += 1 + sovSnapshot(uint64(m.Written))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
 func (m *SnapshotItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1315,6 +1484,179 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Written", wireType)
+			}
+			m.Written = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Written |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
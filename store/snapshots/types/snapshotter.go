@@ -26,6 +26,16 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// ResumableSnapshotter is a Snapshotter which can resume a snapshot interrupted
+// at a store boundary.
+type ResumableSnapshotter interface {
+	Snapshotter
+
+	// ResumeSnapshot writes the snapshot items of height into the protobuf writer, skipping
+	// the stores whose items were completely written before the snapshot was interrupted.
+	ResumeSnapshot(height uint64, protoWriter protoio.Writer, completedStores []string) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
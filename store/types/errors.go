@@ -26,6 +26,10 @@ var (
 	// ErrInvalidRequest defines an ABCI typed error where the request contains
 	// invalid data.
 	ErrInvalidRequest = errors.Register(StoreCodespace, 7, "invalid request")
+
+	// ErrCorruptedStore defines an error when the data of a store cannot be
+	// read, e.g. because nodes of a tree are missing or malformed on disk.
+	ErrCorruptedStore = errors.Register(StoreCodespace, 8, "corrupted store")
 )
 
 // ABCI QueryResult
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"io"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -210,6 +211,25 @@ type CommitMultiStore interface {
 	SetMetrics(metrics metrics.StoreMetrics)
 }
 
+// VersionQuerier gives queriers read access to the committed versions of a
+// multistore, the ones before its earliest version having been pruned.
+type VersionQuerier interface {
+	// EarliestVersion returns the earliest version which is not pruned, or 0
+	// if no version is committed.
+	EarliestVersion() int64
+
+	// LatestVersion returns the latest committed version.
+	LatestVersion() int64
+
+	// CacheMultiStoreWithVersion branches the multistore at a committed
+	// version.
+	CacheMultiStoreWithVersion(version int64) (CacheMultiStore, error)
+
+	// VersionTime returns the block time the version was committed with, false
+	// if it is not known.
+	VersionTime(version int64) (time.Time, bool)
+}
+
 //---------subsp-------------------------------
 // KVStore
 
@@ -316,6 +336,7 @@ const (
 	StoreTypeMemory
 	StoreTypeSMT
 	StoreTypePersistent
+	StoreTypeDerived
 )
 
 func (st StoreType) String() string {
@@ -340,6 +361,9 @@ func (st StoreType) String() string {
 
 	case StoreTypePersistent:
 		return "StoreTypePersistent"
+
+	case StoreTypeDerived:
+		return "StoreTypeDerived"
 	}
 
 	return "unknown store type"
@@ -42,6 +42,11 @@ type Config struct {
 	// Enable adding service to labels
 	EnableServiceLabel bool `mapstructure:"enable-service-label"`
 
+	// EnableStoreOperationMetrics enables the counting of the operations made
+	// on each store, emitted on each commit as counters labeled by store, such
+	// as store_get_count{store="staking"}.
+	EnableStoreOperationMetrics bool `mapstructure:"enable-store-operation-metrics"`
+
 	// PrometheusRetentionTime, when positive, enables a Prometheus metrics sink.
 	// It defines the retention duration in seconds.
 	PrometheusRetentionTime int64 `mapstructure:"prometheus-retention-time"`
@@ -1,10 +1,12 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -413,14 +416,54 @@ func (s *E2ETestSuite) TestNewSendTxCmdDryRun() {
 	r, w, _ := os.Pipe()
 	os.Stderr = w
 
-	_, err := clitestutil.MsgSendExec(clientCtx, from, to, amount, args...)
+	out, err := clitestutil.MsgSendExec(clientCtx, from, to, amount, args...)
 	s.Require().NoError(err)
 
 	w.Close()
-	out, _ := io.ReadAll(r)
+	errOut, _ := io.ReadAll(r)
 	os.Stderr = oldSterr
 
-	s.Require().Regexp("gas estimate: [0-9]+", string(out))
+	s.Require().Regexp("gas estimate: [0-9]+", string(errOut))
+
+	// the simulated events and message responses are printed as JSON
+	var res clienttx.DryRunResponse
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &res), out.String())
+	s.Require().NotZero(res.GasEstimate)
+	s.Require().NotZero(res.GasUsed)
+	s.Require().Len(res.MsgResponses, 1)
+	s.Require().JSONEq(`{"@type":"/cosmos.bank.v1beta1.MsgSendResponse"}`, string(res.MsgResponses[0]))
+
+	// the fees are transferred first, then the sent amount
+	var transfers [][]abci.EventAttribute
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeTransfer {
+			transfers = append(transfers, event.Attributes)
+		}
+	}
+	s.Require().Contains(transfers, []abci.EventAttribute{
+		{Key: types.AttributeKeyRecipient, Value: to.String()},
+		{Key: types.AttributeKeySender, Value: from.String()},
+		{Key: sdk.AttributeKeyAmount, Value: amount.String()},
+		{Key: "msg_index", Value: "0"},
+	}, out.String())
+
+	// and as YAML with the text output
+	out, err = clitestutil.MsgSendExec(clientCtx, from, to, amount, append(args, fmt.Sprintf("--%s=text", flags.FlagOutput))...)
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "type: transfer")
+	s.Require().Contains(out.String(), "'@type': /cosmos.bank.v1beta1.MsgSendResponse")
+
+	// nothing is broadcast
+	balances, err := clitestutil.QueryBalancesExec(clientCtx, from)
+	s.Require().NoError(err)
+	var balancesRes types.QueryAllBalancesResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(balances.Bytes(), &balancesRes))
+	s.Require().NoError(s.network.WaitForNextBlock())
+	balances, err = clitestutil.QueryBalancesExec(clientCtx, from)
+	s.Require().NoError(err)
+	var balancesAfter types.QueryAllBalancesResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(balances.Bytes(), &balancesAfter))
+	s.Require().Equal(balancesRes.Balances, balancesAfter.Balances)
 }
 
 func (s *E2ETestSuite) TestNewSendTxCmd() {
@@ -0,0 +1,172 @@
+//go:build e2e
+// +build e2e
+
+package node_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/simapp"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type E2ETestSuite struct {
+	suite.Suite
+
+	cfg         network.Config
+	network     *network.Network
+	queryClient node.ServiceClient
+	txRes       sdk.TxResponse
+}
+
+func TestE2ETestSuite(t *testing.T) {
+	suite.Run(t, new(E2ETestSuite))
+}
+
+func (s *E2ETestSuite) SetupSuite() {
+	s.T().Log("setting up e2e test suite")
+
+	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+	cfg.NumValidators = 1
+	s.cfg = cfg
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	s.queryClient = node.NewServiceClient(val.ClientCtx)
+
+	// send two txs from val to itself
+	for i := 0; i < 2; i++ {
+		out, err := cli.MsgSendExec(
+			val.ClientCtx,
+			val.Address,
+			val.Address,
+			sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		)
+		s.Require().NoError(err)
+
+		var txRes sdk.TxResponse
+		s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+		s.Require().Equal(uint32(0), txRes.Code, txRes)
+
+		s.txRes, err = cli.GetTxResponse(s.network, val.ClientCtx, txRes.TxHash)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *E2ETestSuite) TearDownSuite() {
+	s.T().Log("tearing down e2e test suite")
+	s.network.Cleanup()
+}
+
+func (s *E2ETestSuite) TestLatestBlockHeight() {
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.LatestBlockHeight(context.Background(), &node.LatestBlockHeightRequest{})
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(res.Height, height)
+}
+
+func (s *E2ETestSuite) TestBlockResults() {
+	res, err := s.queryClient.BlockResults(context.Background(), &node.BlockResultsRequest{Height: s.txRes.Height})
+	s.Require().NoError(err)
+	s.Require().Equal(s.txRes.Height, res.Height)
+	s.Require().NotEmpty(res.TxsResults)
+
+	var txResult *node.TxResult
+	for _, r := range res.TxsResults {
+		if r.GasUsed == s.txRes.GasUsed && r.GasWanted == s.txRes.GasWanted {
+			txResult = r
+		}
+	}
+	s.Require().NotNil(txResult)
+	s.Require().Equal(uint32(0), txResult.Code)
+
+	// events are decoded into string attributes
+	var transfer *sdk.StringEvent
+	for i, event := range txResult.Events {
+		if event.Type == banktypes.EventTypeTransfer {
+			transfer = &txResult.Events[i]
+		}
+	}
+	s.Require().NotNil(transfer)
+	s.Require().Contains(transfer.Attributes, sdk.Attribute{Key: banktypes.AttributeKeyRecipient, Value: s.network.Validators[0].Address.String()})
+
+	// the latest block is queried at height 0
+	res, err = s.queryClient.BlockResults(context.Background(), &node.BlockResultsRequest{})
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(res.Height, s.txRes.Height)
+
+	_, err = s.queryClient.BlockResults(context.Background(), &node.BlockResultsRequest{Height: 1 << 40})
+	s.Require().Equal(codes.NotFound, status.Code(err), err)
+
+	_, err = s.queryClient.BlockResults(context.Background(), &node.BlockResultsRequest{Height: -1})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err), err)
+}
+
+func (s *E2ETestSuite) TestTxsByEvents() {
+	sender := fmt.Sprintf("message.sender='%s'", s.network.Validators[0].Address)
+
+	res, err := s.queryClient.TxsByEvents(context.Background(), &node.TxsByEventsRequest{
+		Query:   sender,
+		OrderBy: tx.OrderBy_ORDER_BY_DESC,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Txs, 2)
+	s.Require().Len(res.TxResponses, 2)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+	s.Require().Equal(s.txRes.TxHash, res.TxResponses[0].TxHash)
+
+	// txs are decoded
+	s.Require().Len(res.Txs[0].Body.Messages, 1)
+	s.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), res.Txs[0].Body.Messages[0].TypeUrl)
+
+	res, err = s.queryClient.TxsByEvents(context.Background(), &node.TxsByEventsRequest{
+		Query:      sender,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.TxResponses, 1)
+	s.Require().Equal(s.txRes.TxHash, res.TxResponses[0].TxHash)
+
+	testCases := []struct {
+		name string
+		req  *node.TxsByEventsRequest
+		code codes.Code
+	}{
+		{"empty query", &node.TxsByEventsRequest{}, codes.InvalidArgument},
+		{"invalid query", &node.TxsByEventsRequest{Query: "message.sender=="}, codes.InvalidArgument},
+		{"key pagination", &node.TxsByEventsRequest{Query: sender, Pagination: &query.PageRequest{Key: []byte("key")}}, codes.InvalidArgument},
+		{"unaligned offset", &node.TxsByEventsRequest{Query: sender, Pagination: &query.PageRequest{Offset: 1, Limit: 2}}, codes.InvalidArgument},
+		{"page out of range", &node.TxsByEventsRequest{Query: sender, Pagination: &query.PageRequest{Offset: 10, Limit: 1}}, codes.InvalidArgument},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.queryClient.TxsByEvents(context.Background(), tc.req)
+			s.Require().Equal(tc.code, status.Code(err), err)
+		})
+	}
+}
@@ -24,7 +24,7 @@ func (s *E2ETestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_dest":"","expedited_voting_period":"86400s","expedited_threshold":"0.667000000000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true}}`,
+			`{"voting_params":{"voting_period":"172800s"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800s","voting_period":"172800s","quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","min_initial_deposit_ratio":"0.000000000000000000","proposal_cancel_ratio":"0.500000000000000000","proposal_cancel_dest":"","expedited_voting_period":"86400s","expedited_threshold":"0.667000000000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_quorum":false,"burn_proposal_deposit_prevote":false,"burn_vote_veto":true,"non_voting_commission_diversion":"0.000000000000000000","max_vote_metadata_len":"255","min_deposit_denoms_allowlist":[],"deposit_history_retention":"0","proposal_tracks":[{"name":"default","msg_type_urls":[],"min_deposit":[],"voting_period":null,"quorum":"","threshold":""}]}}`,
 		},
 		{
 			"text output",
@@ -39,18 +39,29 @@ params:
   burn_proposal_deposit_prevote: false
   burn_vote_quorum: false
   burn_vote_veto: true
+  deposit_history_retention: "0"
   expedited_min_deposit:
   - amount: "50000000"
     denom: stake
   expedited_threshold: "0.667000000000000000"
   expedited_voting_period: 86400s
   max_deposit_period: 172800s
+  max_vote_metadata_len: "255"
   min_deposit:
   - amount: "10000000"
     denom: stake
+  min_deposit_denoms_allowlist: []
   min_initial_deposit_ratio: "0.000000000000000000"
+  non_voting_commission_diversion: "0.000000000000000000"
   proposal_cancel_dest: ""
   proposal_cancel_ratio: "0.500000000000000000"
+  proposal_tracks:
+  - min_deposit: []
+    msg_type_urls: []
+    name: default
+    quorum: ""
+    threshold: ""
+    voting_period: null
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
@@ -406,6 +406,190 @@ func (s *E2ETestSuite) TestGetTxEvents_GRPCGateway() {
 	}
 }
 
+func (s *E2ETestSuite) TestGetTxsEventQuery_GRPC() {
+	val := s.network.Validators[0]
+	kr := val.ClientCtx.Keyring
+
+	newAddr := func(uid string) sdk.AccAddress {
+		k, _, err := kr.NewMnemonic(uid, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		s.Require().NoError(err)
+		addr, err := k.GetAddress()
+		s.Require().NoError(err)
+		return addr
+	}
+	alice, bob := newAddr("eventQueryAlice"), newAddr("eventQueryBob")
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	send := func(from, to sdk.AccAddress, amount int64) *sdk.TxResponse {
+		out, err := cli.MsgSendExec(
+			val.ClientCtx,
+			from,
+			to,
+			sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, amount)),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+		)
+		s.Require().NoError(err)
+		var res sdk.TxResponse
+		s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+		s.Require().Equal(uint32(0), res.Code, res)
+
+		txRes, err := cli.GetTxResponse(s.network, val.ClientCtx, res.TxHash)
+		s.Require().NoError(err)
+		return &txRes
+	}
+
+	// funding matches the recipient condition only
+	funding := send(val.Address, alice, 1000)
+	// toBob matches the sender condition only
+	toBob := send(alice, bob, 10)
+	// toSelf matches both conditions
+	toSelf := send(alice, alice, 10)
+	// unrelated matches neither condition
+	send(val.Address, bob, 10)
+
+	senderOrRecipient := []*tx.TxEventConditionGroup{
+		{Conditions: []string{fmt.Sprintf("message.sender='%s'", alice)}},
+		{Conditions: []string{fmt.Sprintf("transfer.recipient='%s'", alice)}},
+	}
+
+	testCases := []struct {
+		name      string
+		req       *tx.GetTxsEventRequest
+		expErrMsg string
+		expTxs    []*sdk.TxResponse
+		expMerged bool
+	}{
+		{
+			"sender or recipient",
+			&tx.GetTxsEventRequest{EventQuery: &tx.TxEventQuery{Groups: senderOrRecipient}},
+			"",
+			[]*sdk.TxResponse{funding, toBob, toSelf},
+			true,
+		},
+		{
+			"sender or recipient, descending",
+			&tx.GetTxsEventRequest{
+				EventQuery: &tx.TxEventQuery{Groups: senderOrRecipient},
+				OrderBy:    tx.OrderBy_ORDER_BY_DESC,
+			},
+			"",
+			[]*sdk.TxResponse{toSelf, toBob, funding},
+			true,
+		},
+		{
+			"sender or recipient, paginated",
+			&tx.GetTxsEventRequest{
+				EventQuery: &tx.TxEventQuery{Groups: senderOrRecipient},
+				Page:       2,
+				Limit:      2,
+			},
+			"",
+			[]*sdk.TxResponse{toSelf},
+			true,
+		},
+		{
+			"sender or recipient, from height",
+			&tx.GetTxsEventRequest{EventQuery: &tx.TxEventQuery{Groups: senderOrRecipient, FromHeight: toBob.Height}},
+			"",
+			[]*sdk.TxResponse{toBob, toSelf},
+			true,
+		},
+		{
+			"sender or recipient, to height",
+			&tx.GetTxsEventRequest{EventQuery: &tx.TxEventQuery{Groups: senderOrRecipient, ToHeight: toBob.Height}},
+			"",
+			[]*sdk.TxResponse{funding, toBob},
+			true,
+		},
+		{
+			"sender or recipient, height range",
+			&tx.GetTxsEventRequest{EventQuery: &tx.TxEventQuery{Groups: senderOrRecipient, FromHeight: toBob.Height, ToHeight: toSelf.Height - 1}},
+			"",
+			[]*sdk.TxResponse{toBob},
+			true,
+		},
+		{
+			"recipients, with query",
+			&tx.GetTxsEventRequest{
+				Query: fmt.Sprintf("transfer.sender='%s'", alice),
+				EventQuery: &tx.TxEventQuery{
+					Groups: []*tx.TxEventConditionGroup{
+						{Conditions: []string{fmt.Sprintf("transfer.recipient='%s'", bob)}},
+						{Conditions: []string{fmt.Sprintf("transfer.recipient='%s'", alice)}},
+					},
+				},
+			},
+			"",
+			[]*sdk.TxResponse{toBob, toSelf},
+			true,
+		},
+		{
+			"single group",
+			&tx.GetTxsEventRequest{
+				EventQuery: &tx.TxEventQuery{
+					Groups: []*tx.TxEventConditionGroup{{Conditions: []string{
+						fmt.Sprintf("transfer.sender='%s'", alice),
+						fmt.Sprintf("transfer.recipient='%s'", alice),
+					}}},
+				},
+			},
+			"",
+			[]*sdk.TxResponse{toSelf},
+			false,
+		},
+		{
+			"single group, height range",
+			&tx.GetTxsEventRequest{
+				Query:      bankMsgSendEventAction,
+				EventQuery: &tx.TxEventQuery{FromHeight: funding.Height + 1, ToHeight: toSelf.Height},
+			},
+			"",
+			[]*sdk.TxResponse{toBob, toSelf},
+			false,
+		},
+		{
+			"empty event query",
+			&tx.GetTxsEventRequest{EventQuery: &tx.TxEventQuery{}},
+			"event query cannot be empty",
+			nil,
+			false,
+		},
+		{
+			"empty condition group",
+			&tx.GetTxsEventRequest{EventQuery: &tx.TxEventQuery{Groups: append(senderOrRecipient, &tx.TxEventConditionGroup{})}},
+			"condition group 2 cannot be empty",
+			nil,
+			false,
+		},
+		{
+			"invalid height range",
+			&tx.GetTxsEventRequest{EventQuery: &tx.TxEventQuery{Groups: senderOrRecipient, FromHeight: 10, ToHeight: 5}},
+			"from height 10 is greater than to height 5",
+			nil,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.GetTxsEvent(context.Background(), tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expMerged, res.Merged)
+			s.Require().Len(res.TxResponses, len(tc.expTxs))
+			for i, expTx := range tc.expTxs {
+				s.Require().Equal(expTx.TxHash, res.TxResponses[i].TxHash)
+			}
+		})
+	}
+}
+
 func (s *E2ETestSuite) TestGetTx_GRPC() {
 	testCases := []struct {
 		name      string
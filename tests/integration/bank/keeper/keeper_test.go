@@ -780,10 +780,13 @@ func TestMsgMultiSendEvents(t *testing.T) {
 	event2.Attributes = append(
 		event2.Attributes,
 		abci.EventAttribute{Key: types.AttributeKeyRecipient, Value: addr3.String()},
+		abci.EventAttribute{Key: types.AttributeKeySender, Value: addr.String()},
 	)
 	event2.Attributes = append(
 		event2.Attributes,
-		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins.String()})
+		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins.String()},
+		abci.EventAttribute{Key: types.AttributeKeyOutputIndex, Value: "0"},
+	)
 	event3 := sdk.Event{
 		Type:       types.EventTypeTransfer,
 		Attributes: []abci.EventAttribute{},
@@ -791,10 +794,12 @@ func TestMsgMultiSendEvents(t *testing.T) {
 	event3.Attributes = append(
 		event3.Attributes,
 		abci.EventAttribute{Key: types.AttributeKeyRecipient, Value: addr4.String()},
+		abci.EventAttribute{Key: types.AttributeKeySender, Value: addr.String()},
 	)
 	event3.Attributes = append(
 		event3.Attributes,
 		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins2.String()},
+		abci.EventAttribute{Key: types.AttributeKeyOutputIndex, Value: "1"},
 	)
 	// events are shifted due to the funding account events
 	assert.DeepEqual(t, abci.Event(event1), events[25])
@@ -45,10 +45,11 @@ func TestGRPCParams(t *testing.T) {
 			name: "valid request",
 			malleate: func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:                  sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:            sdk.ZeroDec(),
+					BonusProposerReward:           sdk.ZeroDec(),
+					WithdrawAddrEnabled:           true,
+					SigningPerformanceRewardShare: sdk.ZeroDec(),
 				}
 
 				assert.NilError(t, f.distrKeeper.SetParams(f.sdkCtx, params))
@@ -635,6 +635,37 @@ func TestMsgFundCommunityPool(t *testing.T) {
 	}
 }
 
+func TestConsPubKeyRotationFee(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// reset fee pool
+	f.distrKeeper.SetFeePool(f.sdkCtx, distrtypes.InitialFeePool())
+	initPool, _ := f.distrKeeper.GetFeePool(f.sdkCtx)
+	assert.Assert(t, initPool.CommunityPool.Empty())
+
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, sdk.NewCoins(fee)))
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, f.addr, sdk.NewCoins(fee)))
+
+	// the fee is sent by the staking module to the distribution module account
+	assert.Equal(t, stakingtypes.KeyRotationFeeRecipientName, distrtypes.ModuleName)
+	assert.NilError(t, f.bankKeeper.SendCoinsFromAccountToModule(f.sdkCtx, f.addr, stakingtypes.KeyRotationFeeRecipientName, sdk.NewCoins(fee)))
+
+	hooks := f.distrKeeper.Hooks()
+
+	// a zero fee is not added to the community pool
+	assert.NilError(t, hooks.AfterConsensusPubKeyUpdate(f.sdkCtx, f.valAddr, PKS[0], PKS[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)))
+	feePool, _ := f.distrKeeper.GetFeePool(f.sdkCtx)
+	assert.Assert(t, feePool.CommunityPool.Empty())
+
+	// the hook only adds the fee to the community pool
+	assert.NilError(t, hooks.AfterConsensusPubKeyUpdate(f.sdkCtx, f.valAddr, PKS[0], PKS[1], fee))
+	feePool, _ = f.distrKeeper.GetFeePool(f.sdkCtx)
+	assert.DeepEqual(t, sdk.NewDecCoinsFromCoins(fee), feePool.CommunityPool)
+	assert.Assert(t, f.bankKeeper.GetAllBalances(f.sdkCtx, f.addr).Empty())
+}
+
 func TestMsgUpdateParams(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -724,10 +755,13 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:                  communityTax,
+					BaseProposerReward:            sdk.ZeroDec(),
+					BonusProposerReward:           sdk.ZeroDec(),
+					WithdrawAddrEnabled:           withdrawAddrEnabled,
+					SigningPerformanceRewardShare: sdk.ZeroDec(),
+					SigningPerformanceWindow:      100,
+					MaxWithdrawPerMsg:             50,
 				},
 			},
 			expErr: false,
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -136,6 +138,12 @@ func TestHandleDoubleSign(t *testing.T) {
 	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 
+	// the jailing for double signing should have been recorded
+	jailInfo, found := f.stakingKeeper.GetValidatorJailInfo(ctx, operatorAddr)
+	assert.Assert(t, found)
+	assert.Equal(t, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, jailInfo.Reason)
+	assert.Equal(t, "double-signed at height 0", jailInfo.InfractionDetails)
+
 	// tokens should be decreased
 	newTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
 	assert.Assert(t, newTokens.LT(oldTokens))
@@ -146,6 +154,15 @@ func TestHandleDoubleSign(t *testing.T) {
 	// tokens should be the same (capped slash)
 	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
 
+	// the duplicate evidence should have been rejected
+	stats, err := f.evidenceKeeper.EvidenceStats(ctx, &types.QueryEvidenceStatsRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []types.RejectionCount{{Reason: types.RejectionReasonAlreadyTombstoned, Count: 1}}, stats.RejectionCounts)
+	assert.Equal(t, 1, len(stats.RecentRejections))
+	assert.Equal(t, types.RejectionReasonAlreadyTombstoned, stats.RecentRejections[0].Reason)
+	assert.Equal(t, types.RouteEquivocation, stats.RecentRejections[0].Route)
+	assert.Equal(t, int64(0), stats.RecentRejections[0].Height)
+
 	// jump to past the unbonding period
 	ctx = ctx.WithBlockTime(time.Unix(1, 0).Add(stakingParams.UnbondingTime))
 
@@ -166,6 +183,124 @@ func TestHandleDoubleSign(t *testing.T) {
 	assert.Assert(t, len(evidences) == 1)
 }
 
+func TestHandleDoubleSign_RotatedConsPubKey(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, oldPk, newPk := valAddresses[0], pubkeys[0], pubkeys[2]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, oldPk, power, true)
+	f.stakingKeeper.EndBlocker(ctx)
+
+	// handle a signature to set signing info
+	f.slashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), selfDelegation.Int64(), true)
+
+	// rotate the consensus pubkey of the validator, the app has no community
+	// pool to pay the rotation fee to
+	stakingParams := f.stakingKeeper.GetParams(ctx)
+	stakingParams.KeyRotationFee = sdk.NewInt64Coin(stakingParams.BondDenom, 0)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, stakingParams))
+
+	ctx = ctx.WithBlockHeight(2)
+	newPkAny, err := codectypes.NewAnyWithValue(newPk)
+	assert.NilError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(f.stakingKeeper).RotateConsPubKey(ctx, &stakingtypes.MsgRotateConsPubKey{
+		ValidatorAddress: operatorAddr.String(),
+		NewPubkey:        newPkAny,
+	})
+	assert.NilError(t, err)
+	f.stakingKeeper.EndBlocker(ctx)
+
+	newConsAddr := sdk.ConsAddress(newPk.Address())
+	_, found := f.slashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	assert.Assert(t, found)
+
+	// double sign committed with the old consensus pubkey
+	oldTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	nci := NewCometInfo(abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Misbehavior{{
+			Validator: abci.Validator{Address: oldPk.Address(), Power: power},
+			Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+			Time:      time.Unix(0, 0),
+			Height:    1,
+		}},
+	})
+
+	ctx = ctx.WithCometInfo(nci)
+
+	f.evidenceKeeper.BeginBlocker(ctx)
+
+	// the validator should be jailed and tombstoned under its current consensus address
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, newConsAddr))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
+
+func TestHandleDoubleSign_RemoveTombstone(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	f.stakingKeeper.EndBlocker(ctx)
+	f.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	doubleSign := func(ctx sdk.Context, height int64) {
+		f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(NewCometInfo(abci.RequestBeginBlock{
+			ByzantineValidators: []abci.Misbehavior{{
+				Validator: abci.Validator{Address: val.Address(), Power: power},
+				Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+				Time:      time.Unix(0, 0),
+				Height:    height,
+			}},
+		})))
+	}
+
+	doubleSign(ctx, 0)
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, consAddr))
+	f.stakingKeeper.EndBlocker(ctx)
+	slashedTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	// governance removes the tombstone
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	msgServer := slashingkeeper.NewMsgServerImpl(f.slashingKeeper)
+	_, err := msgServer.RemoveTombstone(ctx, slashingtypes.NewMsgRemoveTombstone(f.slashingKeeper.GetAuthority(), consAddr))
+	assert.NilError(t, err)
+
+	info, found := f.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	assert.Assert(t, found)
+	assert.Assert(t, !info.Tombstoned)
+	assert.Equal(t, int64(2), info.TombstoneRemovedHeight)
+
+	// the slashed tokens are not refunded, and the validator can unjail
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(slashedTokens))
+	assert.NilError(t, f.slashingKeeper.Unjail(ctx, operatorAddr))
+	f.stakingKeeper.EndBlocker(ctx)
+	validator := f.stakingKeeper.Validator(ctx, operatorAddr)
+	assert.Assert(t, !validator.IsJailed())
+	assert.Assert(t, validator.IsBonded())
+
+	// double signing again tombstones the validator again
+	doubleSign(ctx, 2)
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, consAddr))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(slashedTokens))
+	assert.ErrorIs(t, f.slashingKeeper.Unjail(ctx, operatorAddr), slashingtypes.ErrValidatorJailed)
+}
+
 func TestHandleDoubleSign_TooOld(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -204,10 +339,112 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(cp.Evidence.MaxAgeDuration + 1))
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + cp.Evidence.MaxAgeNumBlocks + 1)
 
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	f.evidenceKeeper.BeginBlocker(ctx)
 
 	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed() == false)
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
+
+	// the stale evidence should have been rejected
+	evidence := types.FromABCIEvidence(nci.GetEvidence().Get(0))
+	stats, err := f.evidenceKeeper.EvidenceStats(ctx, &types.QueryEvidenceStatsRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []types.RejectionCount{{Reason: types.RejectionReasonTooOld, Count: 1}}, stats.RejectionCounts)
+	assert.DeepEqual(t, []types.RejectedEvidence{{
+		Hash:            strings.ToUpper(hex.EncodeToString(evidence.Hash())),
+		Route:           types.RouteEquivocation,
+		Height:          0,
+		Reason:          types.RejectionReasonTooOld,
+		RejectionHeight: ctx.BlockHeight(),
+	}}, stats.RecentRejections)
+
+	events := ctx.EventManager().Events()
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, types.EventTypeEvidenceRejected, events[0].Type)
+	reason, found := events[0].GetAttribute(types.AttributeKeyReason)
+	assert.Assert(t, found)
+	assert.Equal(t, types.RejectionReasonTooOld.String(), reason.Value)
+}
+
+func TestHandleDoubleSign_MaxAge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ageBlocks   int64
+		ageDuration time.Duration
+	}{
+		"older in blocks only":   {ageBlocks: 1},
+		"older in duration only": {ageDuration: time.Nanosecond},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			f := initFixture(t)
+
+			ctx := f.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
+			populateValidators(t, f)
+
+			operatorAddr, val := valAddresses[0], pubkeys[0]
+			tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+			amt := tstaking.CreateValidatorWithValPower(operatorAddr, val, 100, true)
+			f.stakingKeeper.EndBlocker(ctx)
+			f.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), amt.Int64(), true)
+
+			nci := NewCometInfo(abci.RequestBeginBlock{
+				ByzantineValidators: []abci.Misbehavior{{
+					Validator: abci.Validator{Address: val.Address(), Power: 100},
+					Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+					Time:      ctx.BlockTime(),
+					Height:    1,
+				}},
+			})
+
+			// evidence is stale only once older than both limits
+			cp := f.app.BaseApp.GetConsensusParams(ctx)
+			ctx = ctx.WithCometInfo(nci).WithConsensusParams(cp)
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(cp.Evidence.MaxAgeDuration + tc.ageDuration))
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + cp.Evidence.MaxAgeNumBlocks + tc.ageBlocks)
+
+			f.evidenceKeeper.BeginBlocker(ctx)
+
+			assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+			assert.Equal(t, 0, len(f.evidenceKeeper.GetRejectionCounts(ctx)))
+		})
+	}
+}
+
+func TestEvidenceStats(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx.WithIsCheckTx(false).WithBlockHeight(1000)
+
+	// evidence against validators which do not exist
+	numEvidence := types.MaxRecentRejectedEvidence + 5
+	misbehaviors := make([]abci.Misbehavior, numEvidence)
+	for i := range misbehaviors {
+		misbehaviors[i] = abci.Misbehavior{
+			Validator: abci.Validator{Address: ed25519.GenPrivKey().PubKey().Address(), Power: 100},
+			Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+			Time:      time.Unix(0, 0),
+			Height:    int64(i + 1),
+		}
+	}
+	ctx = ctx.WithCometInfo(NewCometInfo(abci.RequestBeginBlock{ByzantineValidators: misbehaviors}))
+
+	f.evidenceKeeper.BeginBlocker(ctx)
+
+	stats, err := f.evidenceKeeper.EvidenceStats(ctx, &types.QueryEvidenceStatsRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []types.RejectionCount{{Reason: types.RejectionReasonValidatorNotFound, Count: uint64(numEvidence)}}, stats.RejectionCounts)
+
+	// only the most recently rejected evidence is kept, newest first
+	assert.Equal(t, types.MaxRecentRejectedEvidence, len(stats.RecentRejections))
+	for i, rejected := range stats.RecentRejections {
+		assert.Equal(t, int64(numEvidence-i), rejected.Height)
+		assert.Equal(t, types.RejectionReasonValidatorNotFound, rejected.Reason)
+	}
 }
 
 func populateValidators(t assert.TestingT, f *fixture) {
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestPassedProposalWithTrippedMsgFails(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	// trip the circuit breaker for MsgSend, which is the first message of the test proposal
+	msgSendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	app.CircuitKeeper.DisableMsg(ctx, msgSendURL)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", "test", "description", valAccAddrs[0], false)
+	assert.NilError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	for _, addr := range valAccAddrs[:3] {
+		assert.NilError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addr, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	}
+
+	header := ctx.BlockHeader()
+	header.Time = header.Time.Add(*app.GovKeeper.GetParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(header)
+
+	govAcct := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	balanceBefore := app.BankKeeper.GetAllBalances(ctx, govAcct)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+	assert.Assert(t, ok)
+	assert.Equal(t, v1.StatusFailed, proposal.Status)
+
+	attr, ok := ctx.EventManager().Events().GetAttributes(types.AttributeKeyProposalLog)
+	assert.Assert(t, ok)
+	assert.Assert(t, strings.Contains(attr[0].Value, sdkerrors.ErrMsgDisabledByCircuitBreaker.Error()), attr[0].Value)
+
+	// no funds left the governance account
+	assert.DeepEqual(t, balanceBefore, app.BankKeeper.GetAllBalances(ctx, govAcct))
+
+	// dispatching the message directly surfaces the typed error
+	msg := TestProposal[0]
+	_, err = app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	assert.ErrorIs(t, err, sdkerrors.ErrMsgDisabledByCircuitBreaker)
+
+	// once the circuit is reset the message executes again
+	app.CircuitKeeper.EnableMsg(ctx, msgSendURL)
+	_, err = app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	assert.NilError(t, err)
+}
+
+func TestRateLimitedMsgCountedAtDispatch(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+	granter, grantee := addrs[3], addrs[4]
+
+	// allow a single MsgSend per block
+	msgSendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	app.CircuitKeeper.LimitMsg(ctx, msgSendURL, 1)
+
+	assert.NilError(t, app.AuthzKeeper.SaveGrant(ctx, grantee, granter, authz.NewGenericAuthorization(msgSendURL), nil))
+	msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+		banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))),
+	})
+	handler := app.MsgServiceRouter().Handler(&msgExec)
+
+	// the MsgSend nested in the MsgExec is counted against the limit
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := handler(cacheCtx, &msgExec)
+	assert.NilError(t, err)
+	_, err = handler(cacheCtx, &msgExec)
+	assert.ErrorIs(t, err, sdkerrors.ErrMsgDisabledByCircuitBreaker)
+	assert.ErrorContains(t, err, circuittypes.ErrMsgLimitExhausted.Error())
+
+	// the MsgSend of a passed proposal is counted against the limit too, so
+	// that only the first of two proposals executes
+	var proposalIDs []uint64
+	for i := 0; i < 2; i++ {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", "test", "description", addrs[0], false)
+		assert.NilError(t, err)
+		app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+		for _, addr := range addrs[:3] {
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addr, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+		}
+		proposalIDs = append(proposalIDs, proposal.Id)
+	}
+
+	header := ctx.BlockHeader()
+	header.Time = header.Time.Add(*app.GovKeeper.GetParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(header)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalIDs[0])
+	assert.Assert(t, ok)
+	assert.Equal(t, v1.StatusPassed, proposal.Status)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalIDs[1])
+	assert.Assert(t, ok)
+	assert.Equal(t, v1.StatusFailed, proposal.Status)
+
+	// the limit is exhausted for the top-level messages of the block as well
+	assert.ErrorIs(t, app.CircuitKeeper.CheckExecution(ctx, msgSendURL), circuittypes.ErrMsgLimitExhausted)
+}
@@ -5,6 +5,8 @@ import (
 
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/math"
+
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -501,3 +503,148 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	assert.Assert(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyRecordsValidatorParticipation(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	params := app.GovKeeper.GetParams(ctx)
+	params.NonVotingCommissionDiversion = "0.05"
+	assert.NilError(t, app.GovKeeper.SetParams(ctx, params))
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	assert.Assert(t, ok)
+	app.GovKeeper.Tally(ctx, proposal)
+
+	res, err := app.GovKeeper.ValidatorGovParticipation(ctx, &v1.QueryValidatorGovParticipationRequest{ValidatorAddr: valAddrs[0].String()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &v1.QueryValidatorGovParticipationResponse{TalliedProposals: 1}, res)
+
+	res, err = app.GovKeeper.ValidatorGovParticipation(ctx, &v1.QueryValidatorGovParticipationRequest{ValidatorAddr: valAddrs[2].String()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &v1.QueryValidatorGovParticipationResponse{TalliedProposals: 1, NonVotedProposals: 1, NonVotedProposalIds: []uint64{proposalID}}, res)
+
+	// only the non voting validator has its next commission withdrawal diverted
+	diversion, err := app.DistrKeeper.GetValidatorCommissionDiversion(ctx, valAddrs[2])
+	assert.NilError(t, err)
+	assert.Assert(t, diversion.Equal(math.LegacyNewDecWithPrec(5, 2)))
+	diversion, err = app.DistrKeeper.GetValidatorCommissionDiversion(ctx, valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(t, diversion.IsZero())
+}
+
+func TestTallyProposalTracks(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	msgSend, msgExecLegacyContent := TestProposal[0], TestProposal[1]
+
+	params := app.GovKeeper.GetParams(ctx)
+	params.ProposalTracks = []v1.ProposalTrack{
+		v1.NewProposalTrack(v1.DefaultProposalTrackName, nil, nil, nil, "", ""),
+		v1.NewProposalTrack("text", []string{sdk.MsgTypeURL(msgExecLegacyContent)}, nil, nil, "0.2", "0.4"),
+		v1.NewProposalTrack("bank", []string{sdk.MsgTypeURL(msgSend)}, nil, nil, "", "0.75"),
+	}
+	assert.NilError(t, app.GovKeeper.SetParams(ctx, params))
+
+	testCases := []struct {
+		name      string
+		msgs      []sdk.Msg
+		expTrack  string
+		votes     []v1.VoteOption
+		expPasses bool
+	}{
+		{
+			name:      "quorum of the text track reached",
+			msgs:      []sdk.Msg{msgExecLegacyContent},
+			expTrack:  "text",
+			votes:     []v1.VoteOption{v1.OptionYes},
+			expPasses: true,
+		},
+		{
+			name:      "quorum of the default track not reached",
+			msgs:      nil,
+			expTrack:  v1.DefaultProposalTrackName,
+			votes:     []v1.VoteOption{v1.OptionYes},
+			expPasses: false,
+		},
+		{
+			name:      "threshold of the text track reached",
+			msgs:      []sdk.Msg{msgExecLegacyContent},
+			expTrack:  "text",
+			votes:     []v1.VoteOption{v1.OptionYes, v1.OptionNo},
+			expPasses: true,
+		},
+		{
+			name:      "threshold of the default track not reached",
+			msgs:      nil,
+			expTrack:  v1.DefaultProposalTrackName,
+			votes:     []v1.VoteOption{v1.OptionYes, v1.OptionNo},
+			expPasses: false,
+		},
+		{
+			name:      "threshold of the default track reached",
+			msgs:      nil,
+			expTrack:  v1.DefaultProposalTrackName,
+			votes:     []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expPasses: true,
+		},
+		{
+			name:      "threshold of the bank track not reached",
+			msgs:      []sdk.Msg{msgSend},
+			expTrack:  "bank",
+			votes:     []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expPasses: false,
+		},
+		{
+			name:      "mixed proposal governed by the threshold of the bank track",
+			msgs:      TestProposal,
+			expTrack:  "text+bank",
+			votes:     []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expPasses: false,
+		},
+		{
+			name:      "mixed proposal not governed by the quorum of the text track",
+			msgs:      TestProposal,
+			expTrack:  "text+bank",
+			votes:     []v1.VoteOption{v1.OptionYes},
+			expPasses: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, tc.msgs, "", "test", "description", addrs[0], false)
+		assert.NilError(t, err, tc.name)
+		assert.Equal(t, tc.expTrack, proposal.Track.Name, tc.name)
+		proposalID := proposal.Id
+		proposal.Status = v1.StatusVotingPeriod
+		app.GovKeeper.SetProposal(ctx, proposal)
+
+		for i, option := range tc.votes {
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[i], v1.NewNonSplitVoteOption(option), ""), tc.name)
+		}
+
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+		assert.Assert(t, ok, tc.name)
+		passes, _, _ := app.GovKeeper.Tally(ctx, proposal)
+		assert.Equal(t, tc.expPasses, passes, tc.name)
+	}
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/simapp"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+type fixture struct {
+	app *simapp.SimApp
+	ctx sdk.Context
+
+	admin           sdk.AccAddress
+	bot             sdk.AccAddress
+	recipient       sdk.AccAddress
+	groupID         uint64
+	groupPolicyAddr sdk.AccAddress
+}
+
+func initFixture(t *testing.T) *fixture {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{Time: time.Now().UTC()})
+
+	addrs := simtestutil.AddTestAddrsIncremental(app.BankKeeper, app.StakingKeeper, ctx, 3, sdk.NewInt(30000000))
+
+	msg, err := group.NewMsgCreateGroupWithPolicy(
+		addrs[0].String(),
+		[]group.MemberRequest{{Address: addrs[0].String(), Weight: "1"}},
+		"", "", false,
+		group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+	)
+	assert.NilError(t, err)
+	res, err := app.GroupKeeper.CreateGroupWithPolicy(ctx, msg)
+	assert.NilError(t, err)
+
+	groupPolicyAddr := sdk.MustAccAddressFromBech32(res.GroupPolicyAddress)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	assert.NilError(t, app.BankKeeper.SendCoins(ctx, addrs[0], groupPolicyAddr, coins))
+
+	return &fixture{
+		app:             app,
+		ctx:             ctx,
+		admin:           addrs[0],
+		bot:             addrs[1],
+		recipient:       addrs[2],
+		groupID:         res.GroupId,
+		groupPolicyAddr: groupPolicyAddr,
+	}
+}
+
+// submitAndVote submits a proposal with the given messages on behalf of the
+// group policy and accepts it, without executing it.
+func (f *fixture) submitAndVote(t *testing.T, ctx sdk.Context, msgs ...sdk.Msg) uint64 {
+	msg, err := group.NewMsgSubmitProposal(f.groupPolicyAddr.String(), []string{f.admin.String()}, msgs, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+	assert.NilError(t, err)
+	res, err := f.app.GroupKeeper.SubmitProposal(ctx, msg)
+	assert.NilError(t, err)
+
+	_, err = f.app.GroupKeeper.Vote(ctx, &group.MsgVote{
+		ProposalId: res.ProposalId,
+		Voter:      f.admin.String(),
+		Option:     group.VOTE_OPTION_YES,
+	})
+	assert.NilError(t, err)
+
+	return res.ProposalId
+}
+
+func (f *fixture) exec(t *testing.T, ctx sdk.Context, proposalID uint64) group.ProposalExecutorResult {
+	res, err := f.app.GroupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: f.admin.String()})
+	assert.NilError(t, err)
+	return res.Result
+}
+
+func hasEvent(events []abci.Event, eventType string, attrs map[string]string) bool {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		found := 0
+		for _, attr := range event.Attributes {
+			if v, ok := attrs[attr.Key]; ok && v == attr.Value {
+				found++
+			}
+		}
+		if found == len(attrs) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestGroupPolicyAuthzGranter(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	sendAuthz := banktypes.NewSendAuthorization(spendLimit, nil)
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	// the expiration is only checked against the block time at execution
+	expiration := f.ctx.BlockTime().Add(2 * time.Hour)
+	grant, err := authz.NewMsgGrant(f.groupPolicyAddr, f.bot, sendAuthz, &expiration)
+	assert.NilError(t, err)
+
+	t.Run("grant expired at execution", func(t *testing.T) {
+		ctx, _ := f.ctx.CacheContext()
+		proposalID := f.submitAndVote(t, ctx, grant)
+
+		ctx = ctx.WithBlockTime(expiration.Add(time.Minute))
+		assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, f.exec(t, ctx, proposalID))
+
+		authorization, _ := f.app.AuthzKeeper.GetAuthorization(ctx, f.bot, f.groupPolicyAddr, sendTypeURL)
+		assert.Assert(t, authorization == nil)
+	})
+
+	proposalID := f.submitAndVote(t, f.ctx, grant)
+	ctx := f.ctx.WithBlockTime(f.ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, f.exec(t, ctx, proposalID))
+
+	// the grant is attributed to the group policy
+	events := ctx.EventManager().ABCIEvents()
+	assert.Assert(t, hasEvent(events, sdk.EventTypeMessage, map[string]string{
+		sdk.AttributeKeyAction: sdk.MsgTypeURL(grant),
+		sdk.AttributeKeySender: f.groupPolicyAddr.String(),
+		sdk.AttributeKeyModule: authz.ModuleName,
+	}))
+	assert.Assert(t, hasEvent(events, "cosmos.authz.v1beta1.EventGrant", map[string]string{
+		"granter": `"` + f.groupPolicyAddr.String() + `"`,
+		"grantee": `"` + f.bot.String() + `"`,
+	}))
+
+	authorization, exp := f.app.AuthzKeeper.GetAuthorization(ctx, f.bot, f.groupPolicyAddr, sendTypeURL)
+	assert.Assert(t, authorization != nil)
+	assert.Equal(t, expiration, *exp)
+	assert.DeepEqual(t, spendLimit, authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	// the bot spends the funds of the group policy on its behalf
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+	recipientBalance := f.app.BankKeeper.GetBalance(ctx, f.recipient, sdk.DefaultBondDenom)
+	msgExec := authz.NewMsgExec(f.bot, []sdk.Msg{banktypes.NewMsgSend(f.groupPolicyAddr, f.recipient, amount)})
+	_, err = f.app.AuthzKeeper.Exec(ctx, &msgExec)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, recipientBalance.Add(amount[0]), f.app.BankKeeper.GetBalance(ctx, f.recipient, sdk.DefaultBondDenom))
+
+	// the group revokes the grant through another proposal
+	revoke := authz.NewMsgRevoke(f.groupPolicyAddr, f.bot, sendTypeURL)
+	proposalID = f.submitAndVote(t, ctx, &revoke)
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, f.exec(t, ctx, proposalID))
+
+	_, err = f.app.AuthzKeeper.Exec(ctx, &msgExec)
+	assert.ErrorIs(t, err, authz.ErrNoAuthorizationFound)
+}
+
+func TestGroupVoteAuthorization(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// a second group the admin is a member of
+	msg, err := group.NewMsgCreateGroupWithPolicy(
+		f.admin.String(),
+		[]group.MemberRequest{{Address: f.admin.String(), Weight: "1"}},
+		"", "", false,
+		group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+	)
+	assert.NilError(t, err)
+	res, err := f.app.GroupKeeper.CreateGroupWithPolicy(f.ctx, msg)
+	assert.NilError(t, err)
+
+	submit := func(groupPolicyAddr string) uint64 {
+		msg, err := group.NewMsgSubmitProposal(groupPolicyAddr, []string{f.admin.String()}, nil, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+		assert.NilError(t, err)
+		res, err := f.app.GroupKeeper.SubmitProposal(f.ctx, msg)
+		assert.NilError(t, err)
+		return res.ProposalId
+	}
+	grantedProposalID := submit(f.groupPolicyAddr.String())
+	otherProposalID := submit(res.GroupPolicyAddress)
+
+	// the admin lets the bot vote yes in the first group only
+	authorization := group.NewGroupVoteAuthorization([]uint64{f.groupID}, group.VOTE_OPTION_YES)
+	grant, err := authz.NewMsgGrant(f.admin, f.bot, authorization, nil)
+	assert.NilError(t, err)
+	_, err = f.app.AuthzKeeper.Grant(f.ctx, grant)
+	assert.NilError(t, err)
+
+	vote := func(proposalID uint64, option group.VoteOption) error {
+		msgExec := authz.NewMsgExec(f.bot, []sdk.Msg{&group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      f.admin.String(),
+			Option:     option,
+		}})
+		_, err := f.app.AuthzKeeper.Exec(f.ctx, &msgExec)
+		return err
+	}
+
+	assert.ErrorIs(t, vote(otherProposalID, group.VOTE_OPTION_YES), sdkerrors.ErrUnauthorized)
+	assert.ErrorIs(t, vote(grantedProposalID, group.VOTE_OPTION_NO), sdkerrors.ErrUnauthorized)
+	assert.NilError(t, vote(grantedProposalID, group.VOTE_OPTION_YES))
+
+	voteRes, err := f.app.GroupKeeper.VoteByProposalVoter(f.ctx, &group.QueryVoteByProposalVoterRequest{
+		ProposalId: grantedProposalID,
+		Voter:      f.admin.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, group.VOTE_OPTION_YES, voteRes.Vote.Option)
+
+	_, err = f.app.GroupKeeper.VoteByProposalVoter(f.ctx, &group.QueryVoteByProposalVoterRequest{
+		ProposalId: otherProposalID,
+		Voter:      f.admin.String(),
+	})
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestGroupPolicyFeeGranter(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// the group grants a fee allowance to the bot through a proposal
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	grant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, f.groupPolicyAddr, f.bot)
+	assert.NilError(t, err)
+	proposalID := f.submitAndVote(t, f.ctx, grant)
+	ctx := f.ctx.WithBlockTime(f.ctx.BlockTime().Add(time.Hour))
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, f.exec(t, ctx, proposalID))
+
+	allowance, err := f.app.FeeGrantKeeper.GetAllowance(ctx, f.groupPolicyAddr, f.bot)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, spendLimit, allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the bot pays the fees of its transactions from the group policy account
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	txBuilder := f.app.TxConfig().NewTxBuilder()
+	assert.NilError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(f.bot, f.recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetFeeGranter(f.groupPolicyAddr)
+	txBuilder.SetGasLimit(200000)
+
+	policyBalance := f.app.BankKeeper.GetBalance(ctx, f.groupPolicyAddr, sdk.DefaultBondDenom)
+	botBalance := f.app.BankKeeper.GetBalance(ctx, f.bot, sdk.DefaultBondDenom)
+	anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(f.app.AccountKeeper, f.app.BankKeeper, f.app.FeeGrantKeeper, nil))
+	_, err = anteHandler(ctx, txBuilder.GetTx(), false)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, policyBalance.Sub(fee[0]), f.app.BankKeeper.GetBalance(ctx, f.groupPolicyAddr, sdk.DefaultBondDenom))
+	assert.DeepEqual(t, botBalance, f.app.BankKeeper.GetBalance(ctx, f.bot, sdk.DefaultBondDenom))
+	allowance, err = f.app.FeeGrantKeeper.GetAllowance(ctx, f.groupPolicyAddr, f.bot)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, spendLimit.Sub(fee...), allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the fees exceeding the allowance are rejected
+	txBuilder.SetFeeAmount(spendLimit)
+	_, err = anteHandler(ctx, txBuilder.GetTx(), false)
+	assert.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+}
+
+func TestGroupPolicyGrantAllowanceAuthorization(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// the group lets the bot issue fee allowances on its behalf
+	maxSpendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	authorization := feegrant.NewGrantAllowanceAuthorization(maxSpendLimit, f.recipient.String())
+	grant, err := authz.NewMsgGrant(f.groupPolicyAddr, f.bot, authorization, nil)
+	assert.NilError(t, err)
+	proposalID := f.submitAndVote(t, f.ctx, grant)
+	ctx := f.ctx.WithBlockTime(f.ctx.BlockTime().Add(time.Hour))
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, f.exec(t, ctx, proposalID))
+
+	grantAllowance := func(spendLimit sdk.Coins, grantee sdk.AccAddress) error {
+		msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, f.groupPolicyAddr, grantee)
+		assert.NilError(t, err)
+		msgExec := authz.NewMsgExec(f.bot, []sdk.Msg{msg})
+		_, err = f.app.AuthzKeeper.Exec(ctx, &msgExec)
+		return err
+	}
+
+	err = grantAllowance(maxSpendLimit.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), f.recipient)
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	err = grantAllowance(maxSpendLimit, f.admin)
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	assert.NilError(t, grantAllowance(maxSpendLimit, f.recipient))
+	allowance, err := f.app.FeeGrantKeeper.GetAllowance(ctx, f.groupPolicyAddr, f.recipient)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, maxSpendLimit, allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the authorization is kept for further allowances
+	stored, _ := f.app.AuthzKeeper.GetAuthorization(ctx, f.bot, f.groupPolicyAddr, sdk.MsgTypeURL(&feegrant.MsgGrantAllowance{}))
+	assert.Assert(t, stored != nil)
+}
@@ -131,12 +131,15 @@ var (
 
 		// distribution
 		GenType(&disttypes.MsgWithdrawDelegatorReward{}, &distapi.MsgWithdrawDelegatorReward{}, GenOpts),
+		GenType(&disttypes.MsgWithdrawAllDelegatorRewards{}, &distapi.MsgWithdrawAllDelegatorRewards{}, GenOpts),
 		GenType(&disttypes.MsgWithdrawValidatorCommission{}, &distapi.MsgWithdrawValidatorCommission{}, GenOpts),
 		GenType(&disttypes.MsgSetWithdrawAddress{}, &distapi.MsgSetWithdrawAddress{}, GenOpts),
 		GenType(&disttypes.MsgFundCommunityPool{}, &distapi.MsgFundCommunityPool{}, GenOpts),
 		GenType(&disttypes.MsgUpdateParams{}, &distapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 		GenType(&disttypes.MsgCommunityPoolSpend{}, &distapi.MsgCommunityPoolSpend{}, GenOpts),
 		GenType(&disttypes.MsgDepositValidatorRewardsPool{}, &distapi.MsgDepositValidatorRewardsPool{}, GenOpts),
+		GenType(&disttypes.MsgPruneHistoricalRewards{}, &distapi.MsgPruneHistoricalRewards{}, GenOpts),
+		GenType(&disttypes.MsgSetAutoCommissionWithdraw{}, &distapi.MsgSetAutoCommissionWithdraw{}, GenOpts),
 
 		// evidence
 		GenType(&evidencetypes.MsgSubmitEvidence{}, &evidenceapi.MsgSubmitEvidence{},
@@ -269,7 +272,7 @@ var (
 
 		GenType(&gov_v1beta1_types.TextProposal{}, &gov_v1beta1_api.TextProposal{}, GenOpts),
 
-		GenType(&minttypes.Params{}, &mintapi.Params{}, GenOpts),
+		GenType(&minttypes.Params{}, &mintapi.Params{}, GenOpts.WithDisallowNil()),
 
 		// params
 		GenType(&proposal.ParameterChangeProposal{}, &paramsapi.ParameterChangeProposal{}, GenOpts),
@@ -89,7 +89,7 @@ func initFixture(t testing.TB) *fixture {
 
 	stakingKeeper := stakingkeeper.NewKeeper(cdc, keys[stakingtypes.StoreKey], accountKeeper, bankKeeper, authority.String())
 
-	slashingKeeper := slashingkeeper.NewKeeper(cdc, &codec.LegacyAmino{}, keys[slashingtypes.StoreKey], stakingKeeper, authority.String())
+	slashingKeeper := slashingkeeper.NewKeeper(cdc, &codec.LegacyAmino{}, keys[slashingtypes.StoreKey], stakingKeeper, nil, authority.String())
 
 	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper, nil)
 	stakingModule := staking.NewAppModule(cdc, stakingKeeper, accountKeeper, bankKeeper, nil)
@@ -294,6 +294,12 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	resultingTokens := amt.Sub(f.stakingKeeper.TokensFromConsensusPower(f.ctx, 1))
 	assert.DeepEqual(t, resultingTokens, validator.GetTokens())
 
+	// the jailing for downtime should have been recorded
+	jailInfo, found := f.stakingKeeper.GetValidatorJailInfo(f.ctx, addr)
+	assert.Assert(t, found)
+	assert.Equal(t, stakingtypes.JailReason_JAIL_REASON_DOWNTIME, jailInfo.Reason)
+	assert.Equal(t, height-1, jailInfo.Height)
+
 	// another block missed
 	f.ctx = f.ctx.WithBlockHeight(height)
 	f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, false)
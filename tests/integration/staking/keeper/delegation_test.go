@@ -1,12 +1,14 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"cosmossdk.io/math"
 	"gotest.tools/v3/assert"
 
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -114,3 +116,105 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	assert.Assert(math.IntEq(t, newBonded, oldBonded.SubRaw(1)))
 	assert.Assert(math.IntEq(t, newNotBonded, oldNotBonded.AddRaw(1)))
 }
+
+func TestMaxEntriesPerBlock(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+
+	const (
+		numEntries         = 10000
+		maxEntriesPerBlock = 1000
+	)
+
+	params := f.stakingKeeper.GetParams(ctx)
+	params.MaxEntriesPerBlock = maxEntriesPerBlock
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+	notBondedPool := f.stakingKeeper.GetNotBondedPool(ctx)
+	assert.NilError(t, banktestutil.FundModuleAccount(ctx, f.bankKeeper, notBondedPool.GetName(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, numEntries))))
+	f.accountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// all the unbonding delegations mature at once
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	addrVal := sdk.ValAddress(PKs[0].Address())
+	addrDels := make([]sdk.AccAddress, numEntries)
+	timeslice := make([]types.DVPair, numEntries)
+	for i := range addrDels {
+		addrDels[i] = sdk.AccAddress(fmt.Sprintf("delegator%011d", i))
+		f.accountKeeper.SetAccount(ctx, f.accountKeeper.NewAccountWithAddress(ctx, addrDels[i]))
+
+		ubd := types.NewUnbondingDelegation(addrDels[i], addrVal, 0, completionTime, math.NewInt(1), uint64(i+1))
+		f.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		timeslice[i] = types.DVPair{DelegatorAddress: ubd.DelegatorAddress, ValidatorAddress: ubd.ValidatorAddress}
+	}
+	f.stakingKeeper.SetUBDQueueTimeSlice(ctx, completionTime, timeslice)
+
+	ctx = ctx.WithBlockTime(completionTime)
+	for block := 1; block <= numEntries/maxEntriesPerBlock; block++ {
+		f.stakingKeeper.BlockValidatorUpdates(ctx)
+
+		completed := block * maxEntriesPerBlock
+		assert.Assert(math.IntEq(t, math.NewInt(numEntries-int64(completed)), f.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount))
+
+		// the entries are completed in the order of the queue
+		_, found := f.stakingKeeper.GetUnbondingDelegation(ctx, addrDels[completed-1], addrVal)
+		assert.Assert(t, !found)
+		if completed < numEntries {
+			_, found = f.stakingKeeper.GetUnbondingDelegation(ctx, addrDels[completed], addrVal)
+			assert.Assert(t, found)
+		}
+	}
+
+	for _, addrDel := range addrDels {
+		assert.Assert(math.IntEq(t, math.OneInt(), f.bankKeeper.GetBalance(ctx, addrDel, bondDenom).Amount))
+	}
+
+	matureUnbonds := f.stakingKeeper.DequeueMatureUBDQueue(ctx, completionTime, maxEntriesPerBlock)
+	assert.Equal(t, 0, len(matureUnbonds))
+}
+
+func TestDequeueMatureRedelegationQueue(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+
+	addrDels := simtestutil.CreateIncrementalAccounts(5)
+	addrValSrc, addrValDst := sdk.ValAddress(PKs[0].Address()), sdk.ValAddress(PKs[1].Address())
+
+	// three redelegations mature at time1, and two at time2
+	time1 := ctx.BlockTime().Add(time.Hour)
+	time2 := time1.Add(time.Hour)
+	for i, addrDel := range addrDels {
+		completionTime := time1
+		if i >= 3 {
+			completionTime = time2
+		}
+		red := types.NewRedelegation(addrDel, addrValSrc, addrValDst, 0, completionTime, math.OneInt(), math.LegacyOneDec(), uint64(i+1))
+		f.stakingKeeper.InsertRedelegationQueue(ctx, red, completionTime)
+	}
+
+	matureRedelegations := f.stakingKeeper.DequeueMatureRedelegationQueue(ctx, time2, 2)
+	assert.Equal(t, 2, len(matureRedelegations))
+	assert.Equal(t, addrDels[0].String(), matureRedelegations[0].DelegatorAddress)
+	assert.Equal(t, addrDels[1].String(), matureRedelegations[1].DelegatorAddress)
+
+	// the dequeue stops at the limit, leaving the later timeslices untouched
+	assert.Equal(t, 1, len(f.stakingKeeper.GetRedelegationQueueTimeSlice(ctx, time1)))
+	assert.Equal(t, 2, len(f.stakingKeeper.GetRedelegationQueueTimeSlice(ctx, time2)))
+
+	// the rest of a partially dequeued timeslice is dequeued first
+	matureRedelegations = f.stakingKeeper.DequeueMatureRedelegationQueue(ctx, time2, 2)
+	assert.Equal(t, 2, len(matureRedelegations))
+	assert.Equal(t, addrDels[2].String(), matureRedelegations[0].DelegatorAddress)
+	assert.Equal(t, addrDels[3].String(), matureRedelegations[1].DelegatorAddress)
+
+	matureRedelegations = f.stakingKeeper.DequeueAllMatureRedelegationQueue(ctx, time2)
+	assert.Equal(t, 1, len(matureRedelegations))
+	assert.Equal(t, addrDels[4].String(), matureRedelegations[0].DelegatorAddress)
+	assert.Equal(t, 0, len(f.stakingKeeper.GetRedelegationQueueTimeSlice(ctx, time1)))
+	assert.Equal(t, 0, len(f.stakingKeeper.GetRedelegationQueueTimeSlice(ctx, time2)))
+}
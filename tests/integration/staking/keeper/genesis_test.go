@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -170,6 +171,69 @@ func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 	)
 }
 
+func TestInitGenesisJailInfos(t *testing.T) {
+	f, addrs := bootstrapGenesisTest(t, 1)
+
+	params := f.stakingKeeper.GetParams(f.sdkCtx)
+	valTokens := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, 1)
+
+	pk0, err := codectypes.NewAnyWithValue(PKs[0])
+	assert.NilError(t, err)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	jailedVal := types.Validator{
+		OperatorAddress: valAddr.String(),
+		ConsensusPubkey: pk0,
+		Jailed:          true,
+		Status:          types.Unbonded,
+		Tokens:          valTokens,
+		DelegatorShares: sdk.NewDecFromInt(valTokens),
+		Description:     types.NewDescription("hoop", "", "", "", ""),
+	}
+
+	// mint coins in the not bonded pool representing the validator coins
+	assert.NilError(t,
+		banktestutil.FundModuleAccount(
+			f.sdkCtx,
+			f.bankKeeper,
+			types.NotBondedPoolName,
+			sdk.NewCoins(sdk.NewCoin(params.BondDenom, valTokens)),
+		),
+	)
+
+	jailInfo := types.JailInfo{
+		Reason:            types.JailReason_JAIL_REASON_DOUBLE_SIGN,
+		Height:            20,
+		Time:              time.Unix(200, 0).UTC(),
+		InfractionDetails: "double sign",
+	}
+	lastJailInfo := types.JailInfo{
+		Reason:            types.JailReason_JAIL_REASON_DOWNTIME,
+		Height:            10,
+		Time:              time.Unix(100, 0).UTC(),
+		InfractionDetails: "missed blocks",
+	}
+
+	genesisState := types.NewGenesisState(params, []types.Validator{jailedVal}, nil)
+	genesisState.JailInfos = []types.ValidatorJailInfo{{ValidatorAddress: valAddr.String(), JailInfo: jailInfo}}
+	genesisState.LastJailInfos = []types.ValidatorJailInfo{{ValidatorAddress: valAddr.String(), JailInfo: lastJailInfo}}
+	assert.NilError(t, staking.ValidateGenesis(genesisState))
+
+	f.stakingKeeper.InitGenesis(f.sdkCtx, genesisState)
+
+	info, found := f.stakingKeeper.GetValidatorJailInfo(f.sdkCtx, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, jailInfo, info)
+
+	info, found = f.stakingKeeper.GetValidatorLastJailInfo(f.sdkCtx, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, lastJailInfo, info)
+
+	actualGenesis := f.stakingKeeper.ExportGenesis(f.sdkCtx)
+	assert.DeepEqual(t, genesisState.JailInfos, actualGenesis.JailInfos)
+	assert.DeepEqual(t, genesisState.LastJailInfos, actualGenesis.LastJailInfos)
+}
+
 func TestInitGenesisLargeValidatorSet(t *testing.T) {
 	size := 200
 	assert.Assert(t, size > 100)
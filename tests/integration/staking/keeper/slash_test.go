@@ -150,6 +150,9 @@ func TestSlashRedelegation(t *testing.T) {
 	// initialbalance unchanged
 	assert.DeepEqual(t, sdk.NewInt(10), rd.Entries[0].InitialBalance)
 
+	// balance decreased by the burned tokens
+	assert.DeepEqual(t, sdk.NewInt(5), rd.Entries[0].Balance)
+
 	// shares decreased
 	del, found = f.stakingKeeper.GetDelegation(f.sdkCtx, addrDels[0], addrVals[1])
 	assert.Assert(t, found)
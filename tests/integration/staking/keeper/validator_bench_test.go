@@ -1,32 +1,22 @@
 package keeper_test
 
 import (
-	"fmt"
 	"testing"
 
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func BenchmarkGetValidator(b *testing.B) {
-	// 900 is the max number we are allowed to use in order to avoid simtestutil.CreateTestPubKeys
-	// panic: encoding/hex: odd length hex string
-	powersNumber := 900
-
-	var totalPower int64
-	powers := make([]int64, powersNumber)
-	for i := range powers {
-		powers[i] = int64(i)
-		totalPower += int64(i)
-	}
-
-	f, _, valAddrs, vals := initValidators(b, totalPower, len(powers), powers)
+	f := initFixture(b)
+	vals, _ := testutil.PopulateValidatorsAndDelegations(b, f.sdkCtx, f.bankKeeper, f.stakingKeeper, 1000, 1)
 
-	for _, validator := range vals {
-		f.stakingKeeper.SetValidator(f.sdkCtx, validator)
+	valAddrs := make([]sdk.ValAddress, len(vals))
+	for i, val := range vals {
+		valAddrs[i] = val.GetOperator()
 	}
 
 	b.ResetTimer()
@@ -38,67 +28,22 @@ func BenchmarkGetValidator(b *testing.B) {
 }
 
 func BenchmarkGetValidatorDelegations(b *testing.B) {
-	var totalPower int64
-	powersNumber := 10
-
-	powers := make([]int64, powersNumber)
-	for i := range powers {
-		powers[i] = int64(i)
-		totalPower += int64(i)
-	}
-
-	f, _, valAddrs, vals := initValidators(b, totalPower, len(powers), powers)
-	for _, validator := range vals {
-		f.stakingKeeper.SetValidator(f.sdkCtx, validator)
-	}
-
-	delegationsNum := 1000
-	for _, val := range valAddrs {
-		for i := 0; i < delegationsNum; i++ {
-			delegator := sdk.AccAddress(fmt.Sprintf("address%d", i))
-			banktestutil.FundAccount(f.sdkCtx, f.bankKeeper, delegator,
-				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(i)))))
-			NewDel := types.NewDelegation(delegator, val, sdk.NewDec(int64(i)))
-			f.stakingKeeper.SetDelegation(f.sdkCtx, NewDel)
-		}
-	}
+	f := initFixture(b)
+	vals, _ := testutil.PopulateValidatorsAndDelegations(b, f.sdkCtx, f.bankKeeper, f.stakingKeeper, 10, 1000)
 
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		updateValidatorDelegations(f, valAddrs[0], sdk.ValAddress("val"))
+		updateValidatorDelegations(f, vals[0].GetOperator(), sdk.ValAddress("val"))
 	}
 }
 
 func BenchmarkGetValidatorDelegationsLegacy(b *testing.B) {
-	var totalPower int64
-	powersNumber := 10
-
-	powers := make([]int64, powersNumber)
-	for i := range powers {
-		powers[i] = int64(i)
-		totalPower += int64(i)
-	}
-
-	f, _, valAddrs, vals := initValidators(b, totalPower, len(powers), powers)
-
-	for _, validator := range vals {
-		f.stakingKeeper.SetValidator(f.sdkCtx, validator)
-	}
-
-	delegationsNum := 1000
-	for _, val := range valAddrs {
-		for i := 0; i < delegationsNum; i++ {
-			delegator := sdk.AccAddress(fmt.Sprintf("address%d", i))
-			banktestutil.FundAccount(f.sdkCtx, f.bankKeeper, delegator,
-				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(i)))))
-			NewDel := types.NewDelegation(delegator, val, sdk.NewDec(int64(i)))
-			f.stakingKeeper.SetDelegation(f.sdkCtx, NewDel)
-		}
-	}
+	f := initFixture(b)
+	vals, _ := testutil.PopulateValidatorsAndDelegations(b, f.sdkCtx, f.bankKeeper, f.stakingKeeper, 10, 1000)
 
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		updateValidatorDelegationsLegacy(f, valAddrs[0], sdk.ValAddress("val"))
+		updateValidatorDelegationsLegacy(f, vals[0].GetOperator(), sdk.ValAddress("val"))
 	}
 }
 
@@ -0,0 +1,135 @@
+package tx
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/simapp"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSimulateParamsUpdate(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	txtypes.RegisterServiceServer(queryHelper, authtx.NewTxServer(client.Context{}, app.Simulate, app.SimulateMsg, app.InterfaceRegistry()))
+	queryClient := txtypes.NewServiceClient(queryHelper)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	notAuthority := sdk.AccAddress("not_the_authority___").String()
+
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	newStakingParams := stakingParams
+	newStakingParams.MaxValidators = stakingParams.MaxValidators + 1
+	invalidStakingParams := stakingParams
+	invalidStakingParams.MaxValidators = 0
+
+	govParams := app.GovKeeper.GetParams(ctx)
+	invalidGovParams := govParams
+	invalidGovParams.Quorum = "-1"
+
+	mintParams := app.MintKeeper.GetParams(ctx)
+	invalidMintParams := mintParams
+	invalidMintParams.BlocksPerYear = 0
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		strict    bool
+		expValid  bool
+		expErrMsg string
+	}{
+		{
+			name:     "staking: valid params",
+			msg:      &stakingtypes.MsgUpdateParams{Authority: authority, Params: newStakingParams},
+			expValid: true,
+		},
+		{
+			name:      "staking: invalid params",
+			msg:       &stakingtypes.MsgUpdateParams{Authority: authority, Params: invalidStakingParams},
+			expErrMsg: "max validators must be positive",
+		},
+		{
+			name:     "staking: wrong authority is ignored outside of strict mode",
+			msg:      &stakingtypes.MsgUpdateParams{Authority: notAuthority, Params: newStakingParams},
+			expValid: true,
+		},
+		{
+			name:      "staking: wrong authority is refused in strict mode",
+			msg:       &stakingtypes.MsgUpdateParams{Authority: notAuthority, Params: newStakingParams},
+			strict:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:     "gov: valid params in strict mode",
+			msg:      &govv1.MsgUpdateParams{Authority: authority, Params: govParams},
+			strict:   true,
+			expValid: true,
+		},
+		{
+			name:      "gov: invalid params",
+			msg:       &govv1.MsgUpdateParams{Authority: notAuthority, Params: invalidGovParams},
+			expErrMsg: "quorom cannot be negative",
+		},
+		{
+			name:      "gov: wrong authority is refused in strict mode",
+			msg:       &govv1.MsgUpdateParams{Authority: notAuthority, Params: govParams},
+			strict:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:     "mint: valid params",
+			msg:      &minttypes.MsgUpdateParams{Authority: notAuthority, Params: mintParams},
+			expValid: true,
+		},
+		{
+			name:      "mint: invalid params",
+			msg:       &minttypes.MsgUpdateParams{Authority: authority, Params: invalidMintParams},
+			expErrMsg: "blocks per year must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msgAny, err := codectypes.NewAnyWithValue(tc.msg)
+			assert.NilError(t, err)
+
+			res, err := queryClient.SimulateParamsUpdate(ctx, &txtypes.SimulateParamsUpdateRequest{Msg: msgAny, Strict: tc.strict})
+			assert.NilError(t, err)
+			assert.Equal(t, tc.expValid, res.Valid)
+			if tc.expValid {
+				assert.Equal(t, "", res.Error)
+				assert.Assert(t, res.GasInfo.GasUsed > 0)
+			} else {
+				assert.Assert(t, strings.Contains(res.Error, tc.expErrMsg), res.Error)
+			}
+		})
+	}
+
+	// nothing is persisted
+	assert.DeepEqual(t, stakingParams, app.StakingKeeper.GetParams(ctx))
+
+	// only MsgUpdateParams-style messages are accepted
+	msgAny, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(sdk.AccAddress(authority), sdk.AccAddress(notAuthority), nil))
+	assert.NilError(t, err)
+	_, err = queryClient.SimulateParamsUpdate(ctx, &txtypes.SimulateParamsUpdateRequest{Msg: msgAny})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,251 @@
+package conformance
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+const maxFuzzDepth = 4
+
+var (
+	anyType  = reflect.TypeOf(codectypes.Any{})
+	intType  = reflect.TypeOf(sdkmath.Int{})
+	uintType = reflect.TypeOf(sdkmath.Uint{})
+	decType  = reflect.TypeOf(sdkmath.LegacyDec{})
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// valueFuzzer fills values of gogoproto types with random values. Any fields
+// are only filled when an interface registry is set, with a random
+// implementation of the interface they accept.
+type valueFuzzer struct {
+	r        *rand.Rand
+	registry codectypes.InterfaceRegistry
+}
+
+func (f valueFuzzer) fuzz(v reflect.Value, depth int) {
+	r := f.r
+	switch v.Type() {
+	case intType, uintType, decType:
+		// unset custom types are kept, as they are encoded differently from
+		// their zero values by some encodings
+		if r.Intn(4) == 0 {
+			return
+		}
+	}
+
+	switch v.Type() {
+	case intType:
+		v.Set(reflect.ValueOf(sdkmath.NewIntFromBigInt(f.randBigInt())))
+		return
+	case uintType:
+		v.Set(reflect.ValueOf(sdkmath.NewUintFromBigInt(new(big.Int).Abs(f.randBigInt()))))
+		return
+	case decType:
+		v.Set(reflect.ValueOf(sdkmath.LegacyNewDecFromBigIntWithPrec(f.randBigInt(), sdkmath.LegacyPrecision)))
+		return
+	case timeType:
+		v.Set(reflect.ValueOf(time.Unix(r.Int63n(1e10), r.Int63n(1e9)).UTC()))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 0)
+	case reflect.Int32:
+		// covers enums, which are mostly defined around small values
+		v.SetInt(int64(r.Intn(8) - 1))
+	case reflect.Int, reflect.Int64:
+		v.SetInt(r.Int63n(1<<40) - 1<<20)
+	case reflect.Uint32:
+		v.SetUint(uint64(r.Uint32()))
+	case reflect.Uint, reflect.Uint64:
+		v.SetUint(r.Uint64() >> r.Intn(64))
+	case reflect.String:
+		v.SetString(simtypes.RandStringOfLength(r, r.Intn(48)))
+	case reflect.Slice:
+		if depth >= maxFuzzDepth {
+			return
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, r.Intn(32))
+			r.Read(bz)
+			v.SetBytes(bz)
+			return
+		}
+		if v.Type().Elem() == reflect.PtrTo(anyType) {
+			return
+		}
+		n := r.Intn(4)
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			// slice elements are never nil
+			elem := s.Index(i)
+			if elem.Kind() == reflect.Ptr {
+				elem.Set(reflect.New(elem.Type().Elem()))
+				elem = elem.Elem()
+			}
+			f.fuzz(elem, depth+1)
+		}
+		v.Set(s)
+	case reflect.Ptr:
+		if depth >= maxFuzzDepth || v.Type().Elem() == anyType || r.Intn(4) == 0 {
+			return
+		}
+		p := reflect.New(v.Type().Elem())
+		f.fuzz(p.Elem(), depth+1)
+		v.Set(p)
+	case reflect.Struct:
+		if v.Type() == anyType {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() || len(field.Name) > 4 && field.Name[:4] == "XXX_" {
+				continue
+			}
+			if f.registry != nil && (field.Type == reflect.PtrTo(anyType) || field.Type == reflect.SliceOf(reflect.PtrTo(anyType))) {
+				f.fuzzAnyField(v, field, depth+1)
+				continue
+			}
+			f.fuzz(v.Field(i), depth+1)
+		}
+	}
+}
+
+// fuzzAnyField fills the Any field of the message held by v with random
+// implementations of the interface the field accepts. The field is left unset
+// if that interface has no registered implementation.
+func (f valueFuzzer) fuzzAnyField(v reflect.Value, field reflect.StructField, depth int) {
+	if depth >= maxFuzzDepth || !v.CanAddr() {
+		return
+	}
+
+	msg, ok := v.Addr().Interface().(gogoproto.Message)
+	if !ok {
+		return
+	}
+
+	// Any fields not declaring the interface they accept mostly hold Msgs.
+	// They are left unset if msg does not unpack them as such.
+	iface := acceptedInterface(msg, field)
+	if iface == "" {
+		iface = sdk.MsgInterfaceProtoName
+		defer func() {
+			if err := codectypes.UnpackInterfaces(msg, f.registry); err != nil {
+				v.FieldByIndex(field.Index).Set(reflect.Zero(field.Type))
+			}
+		}()
+	}
+
+	impls := f.registry.ListImplementations(iface)
+	if len(impls) == 0 {
+		return
+	}
+
+	// Implementations wrapping Go types which cannot be filled by reflection,
+	// such as secp256r1 keys, fail to be packed and are drawn again.
+	newAny := func() *codectypes.Any {
+		for attempt := 0; attempt < 8; attempt++ {
+			impl, err := f.registry.Resolve(impls[f.r.Intn(len(impls))])
+			if err != nil {
+				panic(err)
+			}
+
+			f.fuzz(reflect.ValueOf(impl).Elem(), depth+1)
+			if a, ok := tryNewAny(impl); ok {
+				return a
+			}
+		}
+
+		return nil
+	}
+
+	fv := v.FieldByIndex(field.Index)
+	if field.Type.Kind() == reflect.Ptr {
+		if a := newAny(); a != nil {
+			fv.Set(reflect.ValueOf(a))
+		}
+		return
+	}
+
+	var anys []*codectypes.Any
+	for n := f.r.Intn(3); len(anys) < n; {
+		a := newAny()
+		if a == nil {
+			break
+		}
+		anys = append(anys, a)
+	}
+	fv.Set(reflect.ValueOf(anys))
+}
+
+// tryNewAny packs msg into an Any, reporting false if msg cannot be encoded.
+func tryNewAny(msg gogoproto.Message) (a *codectypes.Any, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	a, err := codectypes.NewAnyWithValue(msg)
+	return a, err == nil
+}
+
+// randBigInt returns a random integer of up to 128 bits, small values being
+// as likely as large ones.
+func (f valueFuzzer) randBigInt() *big.Int {
+	bz := make([]byte, f.r.Intn(17))
+	f.r.Read(bz)
+	i := new(big.Int).SetBytes(bz)
+	if f.r.Intn(4) == 0 {
+		i.Neg(i)
+	}
+
+	return i
+}
+
+// acceptedInterface returns the interface accepted by the Any field of msg,
+// as declared by its cosmos_proto.accepts_interface option.
+func acceptedInterface(msg gogoproto.Message, field reflect.StructField) string {
+	var name string
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "name=") {
+			name = strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return ""
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return ""
+	}
+
+	fd := md.Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		return ""
+	}
+
+	iface, _ := proto.GetExtension(fd.Options(), cosmos_proto.E_AcceptsInterface).(string)
+	// some fields accept several interfaces, of which the first is used
+	iface, _, _ = strings.Cut(iface, ",")
+	return strings.TrimSpace(iface)
+}
@@ -0,0 +1,248 @@
+// Package conformance provides test kits checking that modules conform to the
+// behaviors expected by the SDK.
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// GenesisFuzzer returns a random genesis state of a module, encoded with the
+// given codec.
+type GenesisFuzzer func(r *rand.Rand, cdc codec.JSONCodec) json.RawMessage
+
+var (
+	fuzzersMu sync.RWMutex
+	fuzzers   = map[string]GenesisFuzzer{}
+)
+
+// RegisterGenesisFuzzer registers the genesis fuzzer of a module, which takes
+// precedence over the fuzzer derived from the module's simulation genesis.
+// The fuzzer should return valid genesis states, as only those are checked to
+// be exported unchanged.
+func RegisterGenesisFuzzer(moduleName string, fuzzer GenesisFuzzer) {
+	fuzzersMu.Lock()
+	defer fuzzersMu.Unlock()
+
+	if _, ok := fuzzers[moduleName]; ok {
+		panic(fmt.Sprintf("genesis fuzzer for module %s already registered", moduleName))
+	}
+	fuzzers[moduleName] = fuzzer
+}
+
+func registeredGenesisFuzzer(moduleName string) GenesisFuzzer {
+	fuzzersMu.RLock()
+	defer fuzzersMu.RUnlock()
+
+	return fuzzers[moduleName]
+}
+
+// SimulationGenesisFuzzer returns a genesis fuzzer generating the genesis
+// states of a module the same way the simulation does, for a set of random
+// accounts with no bonded validators.
+func SimulationGenesisFuzzer(moduleName string, m interface {
+	GenerateGenesisState(*module.SimulationState)
+},
+) GenesisFuzzer {
+	return func(r *rand.Rand, cdc codec.JSONCodec) json.RawMessage {
+		simState := &module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			GenState:     make(map[string]json.RawMessage),
+			Accounts:     simtypes.RandomAccounts(r, 1+r.Intn(10)),
+			InitialStake: sdkmath.NewInt(r.Int63n(1e12)),
+			BondDenom:    sdk.DefaultBondDenom,
+			GenTimestamp: time.Unix(r.Int63n(1e9), 0).UTC(),
+			UnbondTime:   time.Duration(1+r.Int63n(1e6)) * time.Second,
+		}
+		m.GenerateGenesisState(simState)
+
+		return simState.GenState[moduleName]
+	}
+}
+
+// ReflectionGenesisFuzzer returns a genesis fuzzer filling the fields of the
+// given proto genesis type with random values. The returned genesis states
+// are mostly invalid, and are meant to check that genesis validation does
+// not panic on arbitrary inputs. Any fields are left unset.
+func ReflectionGenesisFuzzer(genesisType proto.Message) GenesisFuzzer {
+	typ := reflect.TypeOf(genesisType).Elem()
+	return func(r *rand.Rand, cdc codec.JSONCodec) json.RawMessage {
+		genesis := reflect.New(typ)
+		valueFuzzer{r: r}.fuzz(genesis.Elem(), 0)
+
+		return cdc.MustMarshalJSON(genesis.Interface().(proto.Message))
+	}
+}
+
+// GenesisApp is a module instantiated on top of an empty state.
+type GenesisApp struct {
+	Ctx    sdk.Context
+	Codec  codec.JSONCodec
+	Module module.HasGenesis
+}
+
+// GenesisSetup instantiates a module on top of a new empty state.
+type GenesisSetup func(t *testing.T) GenesisApp
+
+// AppConfigGenesisSetup returns a genesis setup instantiating the module of
+// the given name from an app config, without running InitChain so that the
+// state of every module is empty.
+func AppConfigGenesisSetup(appConfig depinject.Config, moduleName string) GenesisSetup {
+	return func(t *testing.T) GenesisApp {
+		t.Helper()
+
+		var (
+			appBuilder *runtime.AppBuilder
+			cdc        codec.Codec
+		)
+		err := depinject.Inject(depinject.Configs(appConfig, depinject.Supply(log.NewNopLogger())), &appBuilder, &cdc)
+		require.NoError(t, err)
+
+		app := appBuilder.Build(dbm.NewMemDB(), nil)
+		require.NoError(t, app.Load(true))
+
+		m, ok := app.ModuleManager.Modules[moduleName].(module.HasGenesis)
+		require.True(t, ok, "module %s has no genesis", moduleName)
+
+		return GenesisApp{
+			Ctx:    app.NewUncachedContext(false, cmtproto.Header{Time: time.Unix(0, 0).UTC()}),
+			Codec:  cdc,
+			Module: m,
+		}
+	}
+}
+
+// GenesisConfig configures the genesis conformance checks of a module.
+type GenesisConfig struct {
+	// ModuleName is the name of the module under test.
+	ModuleName string
+	// Setup instantiates the module on top of an empty state.
+	Setup GenesisSetup
+	// GenesisType is the proto genesis type of the module, used for
+	// reflection-based fuzzing and for normalizing genesis states before
+	// comparing them.
+	GenesisType proto.Message
+	// Normalize rewrites a genesis state into the form the module exports it,
+	// e.g. sorting entries the module stores by key. Optional.
+	Normalize func(genesis proto.Message)
+	// Runs is the number of fuzzed genesis states checked per fuzzer,
+	// defaults to 10.
+	Runs int
+	// Seed is the seed of the fuzzers.
+	Seed int64
+}
+
+// RunGenesisConformance checks that the module of the config exports its
+// default genesis and fuzzed genesis states unchanged, modulo normalization,
+// and that exporting a second time after initializing a new state with the
+// exported genesis yields the same genesis.
+//
+// The fuzzed genesis states are generated by the fuzzer registered for the
+// module, or else derived from the module's simulation genesis, and must be
+// valid. When a genesis type is configured, genesis validation is also
+// checked not to panic on states filled by reflection.
+func RunGenesisConformance(t *testing.T, cfg GenesisConfig) {
+	t.Helper()
+
+	if cfg.Runs == 0 {
+		cfg.Runs = 10
+	}
+
+	app := cfg.Setup(t)
+	fuzzer := registeredGenesisFuzzer(cfg.ModuleName)
+	if m, ok := app.Module.(interface {
+		GenerateGenesisState(*module.SimulationState)
+	}); ok && fuzzer == nil {
+		fuzzer = SimulationGenesisFuzzer(cfg.ModuleName, m)
+	}
+
+	t.Run("default genesis", func(t *testing.T) {
+		genesis := app.Module.DefaultGenesis(app.Codec)
+		require.NoError(t, app.Module.ValidateGenesis(app.Codec, nil, genesis))
+		checkGenesisRoundTrip(t, cfg, genesis)
+	})
+
+	r := rand.New(rand.NewSource(cfg.Seed))
+	if fuzzer != nil {
+		t.Run("fuzzed genesis", func(t *testing.T) {
+			for run := 0; run < cfg.Runs; run++ {
+				genesis := fuzzer(r, app.Codec)
+				require.NoError(t, app.Module.ValidateGenesis(app.Codec, nil, genesis), "run %d: %s", run, genesis)
+				checkGenesisRoundTrip(t, cfg, genesis)
+			}
+		})
+	}
+
+	if cfg.GenesisType != nil {
+		t.Run("genesis validation", func(t *testing.T) {
+			fuzzer := ReflectionGenesisFuzzer(cfg.GenesisType)
+			for run := 0; run < cfg.Runs; run++ {
+				genesis := fuzzer(r, app.Codec)
+				require.NotPanics(t, func() {
+					_ = app.Module.ValidateGenesis(app.Codec, nil, genesis)
+				}, "run %d: genesis validation panicked on %s", run, genesis)
+			}
+		})
+	}
+}
+
+// checkGenesisRoundTrip runs init, export, init, export on new states, and
+// compares the exported genesis states to the normalized initial one.
+func checkGenesisRoundTrip(t *testing.T, cfg GenesisConfig, genesis json.RawMessage) {
+	t.Helper()
+
+	exportGenesis := func(genesis json.RawMessage) (json.RawMessage, codec.JSONCodec) {
+		app := cfg.Setup(t)
+		require.NotPanics(t, func() {
+			app.Module.InitGenesis(app.Ctx, app.Codec, genesis)
+		}, "init genesis panicked on %s", genesis)
+
+		return app.Module.ExportGenesis(app.Ctx, app.Codec), app.Codec
+	}
+
+	exported, cdc := exportGenesis(genesis)
+	reexported, _ := exportGenesis(exported)
+
+	require.JSONEq(t, string(normalizeGenesis(t, cfg, cdc, genesis)), string(normalizeGenesis(t, cfg, cdc, exported)))
+	require.JSONEq(t, string(exported), string(reexported))
+}
+
+func normalizeGenesis(t *testing.T, cfg GenesisConfig, cdc codec.JSONCodec, genesis json.RawMessage) json.RawMessage {
+	t.Helper()
+
+	if cfg.GenesisType == nil {
+		return genesis
+	}
+
+	msg := reflect.New(reflect.TypeOf(cfg.GenesisType).Elem()).Interface().(proto.Message)
+	require.NoError(t, cdc.UnmarshalJSON(genesis, msg))
+	if cfg.Normalize != nil {
+		cfg.Normalize(msg)
+	}
+
+	bz, err := cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,95 @@
+package conformance
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgFuzzer fills msg with random values. Any fields are filled with random
+// implementations, registered in registry, of the interface they accept.
+func MsgFuzzer(r *rand.Rand, cdc codec.Codec, msg proto.Message) {
+	valueFuzzer{r: r, registry: cdc.InterfaceRegistry()}.fuzz(reflect.ValueOf(msg).Elem(), 0)
+}
+
+// JSONConfig configures the JSON conformance checks of the messages of a
+// codec.
+type JSONConfig struct {
+	// Codec is the codec under test.
+	Codec codec.Codec
+	// TypeURLs are the type URLs of the messages checked. Defaults to all the
+	// Msg implementations registered in the interface registry of the codec.
+	TypeURLs []string
+	// Runs is the number of fuzzed messages checked per type, defaults to 20.
+	Runs int
+	// Seed is the seed of the fuzzer.
+	Seed int64
+}
+
+// RunJSONConformance checks that fuzzed instances of the messages of the
+// config round trip through the proto3 JSON encoding of the codec, see
+// CheckJSONRoundTrip.
+func RunJSONConformance(t *testing.T, cfg JSONConfig) {
+	t.Helper()
+
+	if cfg.Runs == 0 {
+		cfg.Runs = 20
+	}
+
+	registry := cfg.Codec.InterfaceRegistry()
+	typeURLs := cfg.TypeURLs
+	if len(typeURLs) == 0 {
+		typeURLs = registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	}
+	require.NotEmpty(t, typeURLs)
+
+	r := rand.New(rand.NewSource(cfg.Seed))
+	for _, typeURL := range typeURLs {
+		t.Run(typeURL, func(t *testing.T) {
+			for run := 0; run < cfg.Runs; run++ {
+				msg, err := registry.Resolve(typeURL)
+				require.NoError(t, err)
+
+				MsgFuzzer(r, cfg.Codec, msg)
+				CheckJSONRoundTrip(t, cfg.Codec, msg)
+			}
+		})
+	}
+}
+
+// CheckJSONRoundTrip checks that msg is encoded to the same JSON after being
+// decoded from its JSON encoding or from its binary encoding, and that the
+// message decoded from JSON has the binary encoding of msg.
+func CheckJSONRoundTrip(t *testing.T, cdc codec.Codec, msg proto.Message) {
+	t.Helper()
+
+	bz, err := cdc.Marshal(msg)
+	require.NoError(t, err)
+
+	jsonBz, err := cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+
+	fromJSON := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(proto.Message)
+	require.NoError(t, cdc.UnmarshalJSON(jsonBz, fromJSON), "decoding %s", jsonBz)
+
+	fromBinary := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(proto.Message)
+	require.NoError(t, cdc.Unmarshal(bz, fromBinary))
+
+	reencoded, err := cdc.MarshalJSON(fromJSON)
+	require.NoError(t, err)
+	require.Equal(t, string(jsonBz), string(reencoded), "JSON encoding changed after a JSON round trip")
+
+	reencoded, err = cdc.MarshalJSON(fromBinary)
+	require.NoError(t, err)
+	require.Equal(t, string(jsonBz), string(reencoded), "JSON encoding differs after a binary round trip")
+
+	reencoded, err = cdc.Marshal(fromJSON)
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded, "binary encoding changed after a JSON round trip of %s", jsonBz)
+}
@@ -0,0 +1,71 @@
+package testutil
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// NewBatchedValueMigration returns a reference batched migration which
+// rewrites every value stored under the given prefix with migrateFn, batchSize
+// entries per batch, and reports its progress after every batch. The cursor is
+// the number of processed entries followed by the key of the next entry to
+// migrate.
+func NewBatchedValueMigration(storeKey storetypes.StoreKey, keyPrefix []byte, batchSize int, migrateFn func(key, value []byte) ([]byte, error)) module.BatchedMigrationHandler {
+	return func(ctx sdk.Context, cursor []byte) ([]byte, error) {
+		store := prefix.NewStore(ctx.KVStore(storeKey), keyPrefix)
+
+		var (
+			processed uint64
+			start     []byte
+		)
+		if len(cursor) > 0 {
+			processed, start = binary.BigEndian.Uint64(cursor[:8]), cursor[8:]
+		}
+
+		var (
+			keys, values [][]byte
+			next         []byte
+		)
+		iter := store.Iterator(start, nil)
+		for ; iter.Valid(); iter.Next() {
+			if len(keys) == batchSize {
+				next = append(sdk.Uint64ToBigEndian(processed+uint64(len(keys))), iter.Key()...)
+				break
+			}
+
+			keys = append(keys, iter.Key())
+			values = append(values, iter.Value())
+		}
+		iter.Close()
+
+		for i, key := range keys {
+			value, err := migrateFn(key, values[i])
+			if err != nil {
+				return nil, err
+			}
+			store.Set(key, value)
+		}
+		processed += uint64(len(keys))
+
+		module.ReportMigrationProgress(ctx, processed, countEntries(store))
+
+		return next, nil
+	}
+}
+
+func countEntries(store storetypes.KVStore) uint64 {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+
+	return count
+}
@@ -19,6 +19,11 @@ exposed. Due to this exact same limitation, only a single test network can exist
 at a time. A caller must be certain it calls Cleanup after it no longer needs
 the network.
 
+Validators can be configured individually through Config.ValidatorConfigs, and
+taken offline and back online with StopValidator and StartValidator, e.g. to
+test the jailing of a validator missing blocks, waiting for an expected state
+with WaitForCondition.
+
 A typical testing flow might look like the following:
 
 	type IntegrationTestSuite struct {
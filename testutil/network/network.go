@@ -22,6 +22,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/math/unsafe"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	cmtdb "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/node"
 	cmtclient "github.com/cometbft/cometbft/rpc/client"
 	dbm "github.com/cosmos/cosmos-db"
@@ -129,6 +130,15 @@ type Config struct {
 	APIAddress       string                     // REST API listen address (including port)
 	GRPCAddress      string                     // GRPC server listen address (including port)
 	PrintMnemonic    bool                       // print the mnemonic of first validator as log output for testing
+	ValidatorConfigs []ValidatorConfig          // per-validator overrides, indexed by validator
+}
+
+// ValidatorConfig defines the configuration of a validator overriding the
+// network one. Zero fields keep the network configuration.
+type ValidatorConfig struct {
+	AppOptions      map[string]interface{} // app options set in the validator's server context
+	PruningStrategy string                 // the pruning strategy of the validator
+	MinGasPrices    string                 // the minimum gas prices the validator will accept
 }
 
 // DefaultConfig returns a sane default configuration suitable for nearly all
@@ -210,7 +220,7 @@ func DefaultConfigWithAppConfig(appConfig depinject.Config) (Config, error) {
 		if err := depinject.Inject(
 			depinject.Configs(
 				appConfig,
-				depinject.Supply(val.GetCtx().Logger),
+				depinject.Supply(val.GetCtx().Logger, servertypes.AppOptions(val.GetCtx().Viper)),
 			),
 			&appBuilder); err != nil {
 			panic(err)
@@ -278,6 +288,7 @@ type (
 		grpcWeb  *http.Server
 		errGroup *errgroup.Group
 		cancelFn context.CancelFunc
+		dbs      []cmtdb.DB
 	}
 
 	// ValidatorI expose a validator's context and configuration
@@ -368,6 +379,19 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		cmtCfg := ctx.Config
 		cmtCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
 
+		if i < len(cfg.ValidatorConfigs) {
+			valCfg := cfg.ValidatorConfigs[i]
+			if valCfg.PruningStrategy != "" {
+				appCfg.Pruning = valCfg.PruningStrategy
+			}
+			if valCfg.MinGasPrices != "" {
+				appCfg.MinGasPrices = valCfg.MinGasPrices
+			}
+			for key, value := range valCfg.AppOptions {
+				ctx.Viper.Set(key, value)
+			}
+		}
+
 		// Only allow the first validator to expose an RPC, API and gRPC
 		// server/client due to CometBFT in-process constraints.
 		apiAddr := ""
@@ -440,6 +464,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 
 		cmtCfg.SetRoot(nodeDir)
 		cmtCfg.Moniker = nodeDirName
+		ctx.Viper.Set(flags.FlagHome, nodeDir)
 		monikers[i] = nodeDirName
 
 		if len(portPool) == 0 {
@@ -760,6 +785,79 @@ func (n *Network) WaitForNextBlock() error {
 	return err
 }
 
+// WaitForCondition performs a blocking check where it waits for the given
+// condition to be met, checking it every 100 milliseconds. If the condition is
+// not met within the timeout, an error is returned.
+func (n *Network) WaitForCondition(cond func() bool, timeout time.Duration) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		if cond() {
+			return nil
+		}
+
+		select {
+		case <-timer.C:
+			return errors.New("timeout exceeded waiting for condition")
+		case <-ticker.C:
+		}
+	}
+}
+
+// StopValidator stops the CometBFT node and the gRPC and API servers of the
+// i-th validator, taking it offline until it is restarted with StartValidator.
+func (n *Network) StopValidator(i int) error {
+	if i < 0 || i >= len(n.Validators) {
+		return fmt.Errorf("invalid validator index %d", i)
+	}
+
+	v := n.Validators[i]
+	if v.tmNode == nil || !v.tmNode.IsRunning() {
+		return fmt.Errorf("validator %d is not running", i)
+	}
+
+	v.cancelFn()
+	if err := v.errGroup.Wait(); err != nil {
+		return err
+	}
+
+	if err := v.tmNode.Stop(); err != nil {
+		return err
+	}
+	v.tmNode.Wait()
+
+	// databases already closed by the node return an error, which is ignored
+	for _, db := range v.dbs {
+		_ = db.Close()
+	}
+
+	if v.grpcWeb != nil {
+		return v.grpcWeb.Close()
+	}
+
+	return nil
+}
+
+// StartValidator restarts the i-th validator after it was stopped with
+// StopValidator. A new application is created with the network AppConstructor,
+// which catches up by replaying the blocks stored by the validator's node.
+func (n *Network) StartValidator(i int) error {
+	if i < 0 || i >= len(n.Validators) {
+		return fmt.Errorf("invalid validator index %d", i)
+	}
+
+	v := n.Validators[i]
+	if v.tmNode != nil && v.tmNode.IsRunning() {
+		return fmt.Errorf("validator %d is already running", i)
+	}
+
+	return startInProcess(n.Config, v)
+}
+
 // Cleanup removes the root testing (temporary) directory and stops both the
 // CometBFT and API services. It allows other callers to create and start
 // test networks. This method must be called when a test is finished, typically
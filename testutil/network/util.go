@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 
+	cmtdb "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
@@ -50,13 +51,25 @@ func startInProcess(cfg Config, val *Validator) error {
 		return appGenesis.ToGenesisDoc()
 	}
 
+	// keep track of the node databases, as CometBFT does not close all of them
+	// when the node is stopped, so that the validator can be restarted
+	val.dbs = nil
+	dbProvider := func(ctx *node.DBContext) (cmtdb.DB, error) {
+		db, err := node.DefaultDBProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		val.dbs = append(val.dbs, db)
+		return db, nil
+	}
+
 	tmNode, err := node.NewNode( //resleak:notresource
 		cmtCfg,
 		pvm.LoadOrGenFilePV(cmtCfg.PrivValidatorKeyFile(), cmtCfg.PrivValidatorStateFile()),
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		appGenesisProvider,
-		node.DefaultDBProvider,
+		dbProvider,
 		node.DefaultMetricsProvider(cmtCfg.Instrumentation),
 		servercmtlog.CometLoggerWrapper{Logger: logger.With("module", val.Moniker)},
 	)
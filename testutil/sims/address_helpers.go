@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/rand"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
@@ -17,6 +18,9 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+// testPubKeysSeed is the seed of the pseudo random source of CreateTestPubKeys.
+const testPubKeysSeed = 42
+
 type GenerateAccountStrategy func(int) []sdk.AccAddress
 
 // BondDenomProvider is a subset of the staking keeper's public interface that
@@ -132,18 +136,19 @@ func ConvertAddrsToValAddrs(addrs []sdk.AccAddress) []sdk.ValAddress {
 	return valAddrs
 }
 
-// CreateTestPubKeys returns a total of numPubKeys public keys in ascending order.
+// CreateTestPubKeys returns a total of numPubKeys deterministic public keys.
+// The keys are derived from 32-byte seeds read from a pseudo random source with
+// a fixed seed, so that the first keys returned are the same for any numPubKeys.
 func CreateTestPubKeys(numPubKeys int) []cryptotypes.PubKey {
-	var publicKeys []cryptotypes.PubKey
-	var buffer bytes.Buffer
-
-	// start at 10 to avoid changing 1 to 01, 2 to 02, etc
-	for i := 100; i < (numPubKeys + 100); i++ {
-		numString := strconv.Itoa(i)
-		buffer.WriteString("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AF") // base pubkey string
-		buffer.WriteString(numString)                                                       // adding on final two digits to make pubkeys unique
-		publicKeys = append(publicKeys, NewPubKeyFromHex(buffer.String()))
-		buffer.Reset()
+	publicKeys := make([]cryptotypes.PubKey, numPubKeys)
+	r := rand.New(rand.NewSource(testPubKeysSeed))
+
+	seed := make([]byte, 32)
+	for i := range publicKeys {
+		if _, err := r.Read(seed); err != nil {
+			panic(err)
+		}
+		publicKeys[i] = ed25519.GenPrivKeyFromSecret(seed).PubKey()
 	}
 
 	return publicKeys
@@ -26,6 +26,10 @@ type PrepareCheckStater func(ctx Context)
 // Precommiter runs code during commit immediately before the `deliverState` is written to the `rootMultiStore`.
 type Precommiter func(ctx Context)
 
+// DerivedStoreRebuilder writes the contents of the derived stores of the app
+// from its primary state when they are stale, the stores being cleared.
+type DerivedStoreRebuilder func(ctx Context) error
+
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
 
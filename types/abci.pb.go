@@ -106,6 +106,10 @@ type ABCIMessageLog struct {
 	// Events contains a slice of Event objects that were emitted during some
 	// execution.
 	Events StringEvents `protobuf:"bytes,3,rep,name=events,proto3,castrepeated=StringEvents" json:"events"`
+	// gas_used is the amount of gas consumed by the execution of the message.
+	//
+	// Since: cosmos-sdk 0.50
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *ABCIMessageLog) Reset()      { *m = ABCIMessageLog{} }
@@ -161,6 +165,13 @@ func (m *ABCIMessageLog) GetEvents() StringEvents {
 	return nil
 }
 
+func (m *ABCIMessageLog) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 // StringEvent defines en Event object wrapper where all the attributes
 // contain key/value pairs that are strings instead of raw bytes.
 type StringEvent struct {
@@ -481,6 +492,11 @@ type TxMsgData struct {
 	//
 	// Since: cosmos-sdk 0.46
 	MsgResponses []*types.Any `protobuf:"bytes,2,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	// msg_gas_used contains the gas consumed by the execution of each Msg, in
+	// the same order as msg_responses.
+	//
+	// Since: cosmos-sdk 0.50
+	MsgGasUsed []uint64 `protobuf:"varint,3,rep,packed,name=msg_gas_used,json=msgGasUsed,proto3" json:"msg_gas_used,omitempty"`
 }
 
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
@@ -530,6 +546,13 @@ func (m *TxMsgData) GetMsgResponses() []*types.Any {
 	return nil
 }
 
+func (m *TxMsgData) GetMsgGasUsed() []uint64 {
+	if m != nil {
+		return m.MsgGasUsed
+	}
+	return nil
+}
+
 // SearchTxsResult defines a structure for querying txs pageable
 type SearchTxsResult struct {
 	// Count of all txs
@@ -729,68 +752,69 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0x1b, 0xb7,
-	0x13, 0xd5, 0x6a, 0x37, 0x2b, 0x8b, 0xb2, 0x7e, 0xf9, 0x81, 0x30, 0x6c, 0x3a, 0x4d, 0x25, 0x55,
-	0x49, 0x01, 0xa1, 0x40, 0x57, 0x88, 0x13, 0x14, 0x4d, 0x4e, 0x89, 0xdc, 0x7f, 0x06, 0x92, 0x1e,
-	0xd6, 0x0a, 0x0a, 0xf4, 0x22, 0x50, 0x12, 0x43, 0x2d, 0xac, 0x5d, 0x0a, 0x4b, 0xca, 0x96, 0x6f,
-	0xbd, 0xb5, 0xc7, 0x9e, 0x7a, 0xee, 0xb5, 0xfd, 0x24, 0x39, 0xf4, 0xe0, 0xa3, 0x0f, 0x81, 0xdb,
-	0xda, 0xb7, 0x7e, 0x8a, 0x62, 0x86, 0xd4, 0x9f, 0xd4, 0x95, 0x9b, 0x93, 0x87, 0x6f, 0x86, 0xd4,
-	0xbc, 0x37, 0x6f, 0x49, 0x93, 0x7b, 0x03, 0xa5, 0x53, 0xa5, 0xdb, 0x7d, 0xae, 0x45, 0x9b, 0xf7,
-	0x07, 0x49, 0xfb, 0xf8, 0x41, 0x5f, 0x18, 0xfe, 0x00, 0x17, 0xd1, 0x24, 0x57, 0x46, 0x51, 0x66,
-	0x8b, 0x22, 0x28, 0x8a, 0x10, 0x77, 0x45, 0x77, 0xb6, 0xa4, 0x92, 0x0a, 0x8b, 0xda, 0x10, 0xd9,
-	0xfa, 0x3b, 0xef, 0x19, 0x91, 0x0d, 0x45, 0x9e, 0x26, 0x99, 0xb1, 0x67, 0x9a, 0xd3, 0x89, 0xd0,
-	0x2e, 0x79, 0x77, 0x25, 0x89, 0x78, 0xbb, 0x3f, 0x56, 0x83, 0x23, 0x97, 0xdd, 0x95, 0x4a, 0xc9,
-	0xb1, 0x68, 0xe3, 0xaa, 0x3f, 0x7d, 0xd5, 0xe6, 0xd9, 0xa9, 0x4d, 0x35, 0x7f, 0xf3, 0x09, 0xe9,
-	0xce, 0x62, 0xa1, 0x27, 0x2a, 0xd3, 0x82, 0x6e, 0x93, 0x70, 0x24, 0x12, 0x39, 0x32, 0xcc, 0x6b,
-	0x78, 0x2d, 0x3f, 0x76, 0x2b, 0xda, 0x24, 0xa1, 0x99, 0x8d, 0xb8, 0x1e, 0xb1, 0x62, 0xc3, 0x6b,
-	0x95, 0x3b, 0xe4, 0xf2, 0xa2, 0x1e, 0x76, 0x67, 0x5f, 0x71, 0x3d, 0x8a, 0x5d, 0x86, 0xde, 0x25,
-	0xe5, 0x81, 0x1a, 0x0a, 0x3d, 0xe1, 0x03, 0xc1, 0x7c, 0x28, 0x8b, 0x97, 0x00, 0xa5, 0x24, 0x80,
-	0x05, 0x0b, 0x1a, 0x5e, 0xab, 0x1a, 0x63, 0x0c, 0xd8, 0x90, 0x1b, 0xce, 0x6e, 0x61, 0x31, 0xc6,
-	0x74, 0x87, 0x94, 0x72, 0x7e, 0xd2, 0x1b, 0x2b, 0xc9, 0x42, 0x84, 0xc3, 0x9c, 0x9f, 0x3c, 0x57,
-	0x92, 0xbe, 0x24, 0xc1, 0x58, 0x49, 0xcd, 0x4a, 0x0d, 0xbf, 0x55, 0xd9, 0x6b, 0x45, 0xeb, 0xe4,
-	0x8b, 0x9e, 0x75, 0xf6, 0x0f, 0x5e, 0x08, 0xad, 0xb9, 0x14, 0xcf, 0x95, 0xec, 0xec, 0xbc, 0xbe,
-	0xa8, 0x17, 0x7e, 0xfd, 0xbd, 0x7e, 0xfb, 0x6d, 0x5c, 0xc7, 0x78, 0x1c, 0xf4, 0x90, 0x64, 0xaf,
-	0x14, 0xdb, 0xb0, 0x3d, 0x40, 0x4c, 0xdf, 0x27, 0x44, 0x72, 0xdd, 0x3b, 0xe1, 0x99, 0x11, 0x43,
-	0x56, 0x46, 0x25, 0xca, 0x92, 0xeb, 0x6f, 0x10, 0xa0, 0xbb, 0x64, 0x03, 0xd2, 0x53, 0x2d, 0x86,
-	0x8c, 0x60, 0xb2, 0x24, 0xb9, 0x7e, 0xa9, 0xc5, 0x90, 0xde, 0x27, 0x45, 0x33, 0x63, 0x95, 0x86,
-	0xd7, 0xaa, 0xec, 0x6d, 0x45, 0x56, 0xf6, 0x68, 0x2e, 0x7b, 0xf4, 0x2c, 0x3b, 0x8d, 0x8b, 0x66,
-	0x06, 0x4a, 0x99, 0x24, 0x15, 0xda, 0xf0, 0x74, 0xc2, 0x36, 0xad, 0x52, 0x0b, 0x80, 0x3e, 0x22,
-	0xa1, 0x38, 0x16, 0x99, 0xd1, 0xac, 0x8a, 0x54, 0xb7, 0xa3, 0xe5, 0x70, 0x2d, 0xd3, 0xcf, 0x21,
-	0xdd, 0x09, 0x80, 0x58, 0xec, 0x6a, 0x9f, 0x04, 0x3f, 0xfc, 0x5c, 0x2f, 0x34, 0x7f, 0xf1, 0xc8,
-	0xff, 0xde, 0xe6, 0x49, 0x3f, 0x22, 0xe5, 0x54, 0xcb, 0x5e, 0x92, 0x0d, 0xc5, 0x0c, 0xa7, 0x5a,
-	0xed, 0x54, 0xff, 0xba, 0xa8, 0x2f, 0xc1, 0x78, 0x23, 0xd5, 0xf2, 0x00, 0x22, 0xfa, 0x7f, 0xe2,
-	0x83, 0xf0, 0x38, 0xe3, 0x18, 0x42, 0x7a, 0xb8, 0x68, 0xc6, 0xc7, 0x66, 0x3e, 0x5c, 0xaf, 0xfb,
-	0xa1, 0xc9, 0x93, 0x4c, 0xda, 0xde, 0xb6, 0x9c, 0xe8, 0x9b, 0x2b, 0xa0, 0x5e, 0xf6, 0xfa, 0xdd,
-	0x9b, 0x86, 0xd7, 0xcc, 0x49, 0x65, 0x25, 0x0b, 0x83, 0x00, 0xe7, 0x62, 0x8b, 0xe5, 0x18, 0x63,
-	0x7a, 0x40, 0x08, 0x37, 0x26, 0x4f, 0xfa, 0x53, 0x23, 0x34, 0x2b, 0x62, 0x07, 0xf7, 0x6e, 0x98,
-	0xfc, 0xbc, 0xd6, 0x69, 0xb3, 0xb2, 0xd9, 0xfd, 0xe6, 0x43, 0x52, 0x5e, 0x14, 0x01, 0xdb, 0x23,
-	0x71, 0xea, 0x7e, 0x10, 0x42, 0xba, 0x45, 0x6e, 0x1d, 0xf3, 0xf1, 0x54, 0x38, 0x05, 0xec, 0xa2,
-	0xb9, 0x4f, 0x4a, 0x5f, 0x72, 0x7d, 0x70, 0xdd, 0x19, 0xb0, 0x33, 0x58, 0xe7, 0x8c, 0x22, 0x26,
-	0xe7, 0xce, 0x80, 0xc9, 0x84, 0xb1, 0xd0, 0xd3, 0xb1, 0xa1, 0xdb, 0xce, 0xf6, 0xb0, 0x7d, 0xb3,
-	0x53, 0x64, 0x9e, 0xb3, 0xfe, 0x75, 0xf5, 0x1f, 0xfd, 0x43, 0xfd, 0x77, 0xb2, 0x02, 0x7d, 0x4c,
-	0xaa, 0x30, 0xdc, 0xdc, 0x7d, 0xd4, 0x9a, 0x05, 0xb8, 0xf9, 0xdf, 0xfd, 0xb8, 0x99, 0x6a, 0x39,
-	0xff, 0xfc, 0xe7, 0x2e, 0xfa, 0xc9, 0x23, 0xf4, 0x30, 0x49, 0xa7, 0x63, 0x6e, 0x12, 0x95, 0x2d,
-	0x2e, 0x87, 0x2f, 0x2c, 0x3b, 0xfc, 0x5c, 0x3c, 0xb4, 0xf8, 0x07, 0xeb, 0x67, 0xe1, 0x14, 0xeb,
-	0x6c, 0x40, 0x6b, 0x67, 0x17, 0x75, 0x0f, 0xa5, 0x40, 0x11, 0x3f, 0x25, 0x61, 0x8e, 0x4a, 0x20,
-	0xd5, 0xca, 0x5e, 0x63, 0xfd, 0x29, 0x56, 0xb1, 0xd8, 0xd5, 0x37, 0x9f, 0x92, 0xd2, 0x0b, 0x2d,
-	0x3f, 0x03, 0xb1, 0x76, 0x09, 0xd8, 0xb6, 0xb7, 0x62, 0x99, 0x52, 0xaa, 0x65, 0x17, 0x5c, 0x33,
-	0xbf, 0x56, 0xe0, 0xf4, 0x4d, 0xab, 0xed, 0x93, 0x10, 0xc6, 0xcf, 0xbc, 0xe6, 0xf7, 0x1e, 0x29,
-	0x77, 0x67, 0xf3, 0x43, 0x1e, 0x2f, 0x26, 0xe1, 0xdf, 0xcc, 0xc6, 0x6d, 0x58, 0x19, 0xd6, 0x35,
-	0x91, 0x8b, 0xef, 0x2e, 0x32, 0x5a, 0xf1, 0x8d, 0x47, 0x6e, 0x1f, 0x0a, 0x9e, 0x0f, 0x46, 0xdd,
-	0x99, 0x76, 0xce, 0xa8, 0x93, 0x8a, 0x51, 0x86, 0x8f, 0x7b, 0x03, 0x35, 0xcd, 0x8c, 0xf3, 0x17,
-	0x41, 0x68, 0x1f, 0x10, 0x30, 0xa8, 0x4d, 0x59, 0x77, 0xd9, 0x05, 0x6c, 0x9b, 0x70, 0x29, 0x7a,
-	0xd9, 0x34, 0xed, 0x8b, 0x1c, 0xef, 0xde, 0x20, 0x26, 0x00, 0x7d, 0x8d, 0x08, 0xd8, 0x16, 0x0b,
-	0xf0, 0x24, 0xbc, 0x82, 0x83, 0xb8, 0x0c, 0x48, 0x17, 0x00, 0x38, 0x75, 0x9c, 0xa4, 0x89, 0xc1,
-	0x8b, 0x38, 0x88, 0xed, 0x82, 0x7e, 0x42, 0x7c, 0x33, 0xd3, 0x2c, 0x44, 0x5e, 0xf7, 0xd7, 0x6b,
-	0xb3, 0x7c, 0x3e, 0x62, 0xd8, 0xe0, 0xe8, 0x9d, 0x83, 0x87, 0x90, 0x5e, 0x07, 0x5e, 0xa2, 0x1b,
-	0x18, 0xfa, 0xeb, 0x19, 0xfa, 0x37, 0x30, 0xf4, 0xff, 0x83, 0xa1, 0xbf, 0x96, 0xa1, 0x3f, 0x67,
-	0xd8, 0x26, 0x21, 0x3e, 0x93, 0x73, 0x92, 0x3b, 0xab, 0x9f, 0x97, 0x7d, 0x5e, 0xb1, 0xf9, 0xd8,
-	0x95, 0x59, 0x6a, 0x9d, 0xa7, 0xe7, 0x7f, 0xd6, 0x0a, 0xaf, 0x2f, 0x6b, 0xde, 0xd9, 0x65, 0xcd,
-	0xfb, 0xe3, 0xb2, 0xe6, 0xfd, 0x78, 0x55, 0x2b, 0x9c, 0x5d, 0xd5, 0x0a, 0xe7, 0x57, 0xb5, 0xc2,
-	0xb7, 0x4d, 0x99, 0x98, 0xd1, 0xb4, 0x1f, 0x0d, 0x54, 0xda, 0x76, 0xff, 0x07, 0xd8, 0x3f, 0x1f,
-	0xeb, 0xe1, 0x91, 0x7d, 0x9c, 0xfb, 0x21, 0xba, 0xe3, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4d, 0x51, 0x55, 0xd9, 0x29, 0x08, 0x00, 0x00,
+	// 987 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x3f, 0x6f, 0x1b, 0xc7,
+	0x13, 0xe5, 0xf1, 0xce, 0x47, 0x71, 0x48, 0xfe, 0xfc, 0xc3, 0x42, 0x90, 0x4e, 0x8e, 0x43, 0x32,
+	0xb4, 0x03, 0x10, 0x01, 0x72, 0x84, 0x65, 0x23, 0x88, 0x55, 0xd9, 0x54, 0x12, 0x47, 0x80, 0x9d,
+	0xe2, 0x44, 0x23, 0x40, 0x1a, 0x62, 0x49, 0xae, 0x97, 0x07, 0xf1, 0x6e, 0x89, 0xdb, 0xa5, 0x44,
+	0x76, 0x29, 0x53, 0xa6, 0x4a, 0x9d, 0x36, 0xf9, 0x1c, 0x29, 0x5c, 0xa4, 0x50, 0xa9, 0xc2, 0x50,
+	0x12, 0xa9, 0xcb, 0xa7, 0x08, 0x66, 0x77, 0xf9, 0x2f, 0x02, 0x15, 0x57, 0xda, 0x79, 0x33, 0xbb,
+	0x9c, 0xf7, 0xe6, 0xed, 0xad, 0xe0, 0x41, 0x5f, 0xc8, 0x44, 0xc8, 0x56, 0x8f, 0x4a, 0xd6, 0xa2,
+	0xbd, 0x7e, 0xdc, 0x3a, 0x7d, 0xd4, 0x63, 0x8a, 0x3e, 0xd2, 0x41, 0x38, 0xce, 0x84, 0x12, 0x24,
+	0x30, 0x45, 0x21, 0x16, 0x85, 0x1a, 0xb7, 0x45, 0xf7, 0xb6, 0xb9, 0xe0, 0x42, 0x17, 0xb5, 0x70,
+	0x65, 0xea, 0xef, 0x7d, 0xa0, 0x58, 0x3a, 0x60, 0x59, 0x12, 0xa7, 0xca, 0x9c, 0xa9, 0x66, 0x63,
+	0x26, 0x6d, 0xf2, 0xfe, 0x4a, 0x52, 0xe3, 0xad, 0xde, 0x48, 0xf4, 0x4f, 0x6c, 0x76, 0x8f, 0x0b,
+	0xc1, 0x47, 0xac, 0xa5, 0xa3, 0xde, 0xe4, 0x4d, 0x8b, 0xa6, 0x33, 0x93, 0x6a, 0xfc, 0xee, 0x02,
+	0x74, 0xa6, 0x11, 0x93, 0x63, 0x91, 0x4a, 0x46, 0x76, 0xc0, 0x1f, 0xb2, 0x98, 0x0f, 0x55, 0xe0,
+	0xd4, 0x9d, 0xa6, 0x1b, 0xd9, 0x88, 0x34, 0xc0, 0x57, 0xd3, 0x21, 0x95, 0xc3, 0x20, 0x5f, 0x77,
+	0x9a, 0xc5, 0x36, 0x5c, 0x5d, 0xd6, 0xfc, 0xce, 0xf4, 0x6b, 0x2a, 0x87, 0x91, 0xcd, 0x90, 0xfb,
+	0x50, 0xec, 0x8b, 0x01, 0x93, 0x63, 0xda, 0x67, 0x81, 0x8b, 0x65, 0xd1, 0x12, 0x20, 0x04, 0x3c,
+	0x0c, 0x02, 0xaf, 0xee, 0x34, 0x2b, 0x91, 0x5e, 0x23, 0x36, 0xa0, 0x8a, 0x06, 0x77, 0x74, 0xb1,
+	0x5e, 0x93, 0x5d, 0x28, 0x64, 0xf4, 0xac, 0x3b, 0x12, 0x3c, 0xf0, 0x35, 0xec, 0x67, 0xf4, 0xec,
+	0xa5, 0xe0, 0xe4, 0x35, 0x78, 0x23, 0xc1, 0x65, 0x50, 0xa8, 0xbb, 0xcd, 0xd2, 0x7e, 0x33, 0xdc,
+	0x24, 0x5f, 0xf8, 0xbc, 0x7d, 0x78, 0xf4, 0x8a, 0x49, 0x49, 0x39, 0x7b, 0x29, 0x78, 0x7b, 0xf7,
+	0xed, 0x65, 0x2d, 0xf7, 0xeb, 0x1f, 0xb5, 0xbb, 0xeb, 0xb8, 0x8c, 0xf4, 0x71, 0xd8, 0x43, 0x9c,
+	0xbe, 0x11, 0xc1, 0x96, 0xe9, 0x01, 0xd7, 0xe4, 0x43, 0x00, 0x4e, 0x65, 0xf7, 0x8c, 0xa6, 0x8a,
+	0x0d, 0x82, 0xa2, 0x56, 0xa2, 0xc8, 0xa9, 0xfc, 0x56, 0x03, 0x64, 0x0f, 0xb6, 0x30, 0x3d, 0x91,
+	0x6c, 0x10, 0x80, 0x4e, 0x16, 0x38, 0x95, 0xaf, 0x25, 0x1b, 0x90, 0x87, 0x90, 0x57, 0xd3, 0xa0,
+	0x54, 0x77, 0x9a, 0xa5, 0xfd, 0xed, 0xd0, 0xc8, 0x1e, 0xce, 0x65, 0x0f, 0x9f, 0xa7, 0xb3, 0x28,
+	0xaf, 0xa6, 0xa8, 0x94, 0x8a, 0x13, 0x26, 0x15, 0x4d, 0xc6, 0x41, 0xd9, 0x28, 0xb5, 0x00, 0xc8,
+	0x13, 0xf0, 0xd9, 0x29, 0x4b, 0x95, 0x0c, 0x2a, 0x9a, 0xea, 0x4e, 0xb8, 0x1c, 0xae, 0x61, 0xfa,
+	0x25, 0xa6, 0xdb, 0x1e, 0x12, 0x8b, 0x6c, 0xed, 0x81, 0xf7, 0xc3, 0xcf, 0xb5, 0x5c, 0xe3, 0x37,
+	0x07, 0xfe, 0xb7, 0xce, 0x93, 0x7c, 0x02, 0xc5, 0x44, 0xf2, 0x6e, 0x9c, 0x0e, 0xd8, 0x54, 0x4f,
+	0xb5, 0xd2, 0xae, 0xfc, 0x7d, 0x59, 0x5b, 0x82, 0xd1, 0x56, 0x22, 0xf9, 0x11, 0xae, 0xc8, 0xff,
+	0xc1, 0x45, 0xe1, 0xf5, 0x8c, 0x23, 0x5c, 0x92, 0xe3, 0x45, 0x33, 0xae, 0x6e, 0xe6, 0xe3, 0xcd,
+	0xba, 0x1f, 0xab, 0x2c, 0x4e, 0xb9, 0xe9, 0x6d, 0xdb, 0x8a, 0x5e, 0x5e, 0x01, 0xe5, 0xbc, 0xd7,
+	0x35, 0x01, 0xd1, 0x0f, 0xde, 0x42, 0xc0, 0x03, 0xef, 0xfb, 0x77, 0x75, 0xa7, 0x91, 0x41, 0x69,
+	0x65, 0x23, 0xce, 0x08, 0x4d, 0xad, 0xbb, 0x2f, 0x46, 0x7a, 0x4d, 0x8e, 0x00, 0xa8, 0x52, 0x59,
+	0xdc, 0x9b, 0x28, 0x26, 0x83, 0xbc, 0x6e, 0xee, 0xc1, 0x2d, 0xa6, 0x98, 0xd7, 0x5a, 0xd9, 0x56,
+	0x36, 0xdb, 0xdf, 0x7c, 0x0c, 0xc5, 0x45, 0x11, 0x0a, 0x71, 0xc2, 0x66, 0xf6, 0x07, 0x71, 0x49,
+	0xb6, 0xe1, 0xce, 0x29, 0x1d, 0x4d, 0x98, 0x15, 0xc7, 0x04, 0x8d, 0x43, 0x28, 0xbc, 0xa0, 0xf2,
+	0xe8, 0xa6, 0x69, 0x1c, 0x4d, 0x6b, 0x83, 0x69, 0xf2, 0x6b, 0x9c, 0x1b, 0xbf, 0x38, 0xe0, 0x47,
+	0x4c, 0x4e, 0x46, 0x8a, 0xec, 0xd8, 0x1b, 0x81, 0xdb, 0xcb, 0xed, 0x7c, 0xe0, 0xd8, 0x5b, 0x71,
+	0x73, 0x30, 0x4f, 0xfe, 0x35, 0x98, 0xf7, 0x72, 0x09, 0x79, 0x0a, 0x15, 0x9c, 0x7b, 0x66, 0xef,
+	0xbb, 0x0c, 0xbc, 0xba, 0xbb, 0xd1, 0xaa, 0xe5, 0x44, 0xf2, 0xf9, 0x97, 0x61, 0x6e, 0xb0, 0x9f,
+	0x1c, 0x20, 0xc7, 0x71, 0x32, 0x19, 0x51, 0x15, 0x8b, 0x74, 0x9e, 0x25, 0x5f, 0x19, 0x76, 0xfa,
+	0x26, 0x39, 0xda, 0xfd, 0x1f, 0x6d, 0x9e, 0x85, 0x55, 0xac, 0xbd, 0x85, 0xad, 0x9d, 0x5f, 0xd6,
+	0x1c, 0x2d, 0x85, 0x16, 0xf1, 0x73, 0xf0, 0x33, 0xad, 0x84, 0xa6, 0x5a, 0xda, 0xaf, 0x6f, 0x3e,
+	0xc5, 0x28, 0x16, 0xd9, 0xfa, 0xc6, 0x33, 0x28, 0xbc, 0x92, 0xfc, 0x0b, 0x14, 0x6b, 0x0f, 0xd0,
+	0xd1, 0xdd, 0x15, 0xcb, 0x14, 0x12, 0xc9, 0x3b, 0xb3, 0xf1, 0xf2, 0x8b, 0x83, 0xa7, 0x97, 0x8d,
+	0xb6, 0x07, 0x3e, 0x8e, 0x3f, 0x70, 0x70, 0x0c, 0xc5, 0xce, 0x74, 0x7e, 0xc8, 0xd3, 0xc5, 0x24,
+	0xdc, 0xdb, 0xd9, 0xd8, 0x0d, 0x2b, 0xc3, 0xba, 0x21, 0x72, 0xfe, 0x7d, 0x45, 0x26, 0x75, 0xc0,
+	0xb8, 0xbb, 0x70, 0x0a, 0xce, 0xd6, 0x8b, 0x20, 0x91, 0xfc, 0xc5, 0xda, 0x05, 0x79, 0xe7, 0xc0,
+	0xdd, 0x63, 0x46, 0xb3, 0xfe, 0xb0, 0x33, 0x95, 0xd6, 0x3b, 0x35, 0x28, 0x29, 0xa1, 0xe8, 0xa8,
+	0xdb, 0x17, 0x93, 0x54, 0x59, 0x07, 0x82, 0x86, 0x0e, 0x11, 0x41, 0x0b, 0x9b, 0x94, 0xf1, 0x9f,
+	0x09, 0x70, 0xdb, 0x98, 0x72, 0xd6, 0x4d, 0x27, 0x49, 0x8f, 0x65, 0xfa, 0xc3, 0xed, 0x45, 0x80,
+	0xd0, 0x37, 0x1a, 0x41, 0x63, 0xeb, 0x02, 0x7d, 0x92, 0xbd, 0xaf, 0x45, 0x44, 0x3a, 0x08, 0xe0,
+	0xa9, 0xa3, 0x38, 0x89, 0x95, 0xfe, 0x8a, 0x7b, 0x91, 0x09, 0xc8, 0x67, 0xe0, 0xaa, 0xa9, 0x0c,
+	0x7c, 0xcd, 0xfc, 0xe1, 0x66, 0xf5, 0x96, 0x6f, 0x4f, 0x84, 0x1b, 0x2c, 0xbd, 0x0b, 0x74, 0x99,
+	0xa6, 0xd7, 0xc6, 0x67, 0xec, 0x16, 0x86, 0xee, 0x66, 0x86, 0xee, 0x2d, 0x0c, 0xdd, 0xff, 0x60,
+	0xe8, 0x6e, 0x64, 0xe8, 0xce, 0x19, 0xb6, 0xc0, 0xd7, 0x6f, 0xec, 0x9c, 0xe4, 0xee, 0xea, 0x05,
+	0x34, 0x6f, 0xb3, 0x6e, 0x3e, 0xb2, 0x65, 0x86, 0x5a, 0xfb, 0xd9, 0xc5, 0x5f, 0xd5, 0xdc, 0xdb,
+	0xab, 0xaa, 0x73, 0x7e, 0x55, 0x75, 0xfe, 0xbc, 0xaa, 0x3a, 0x3f, 0x5e, 0x57, 0x73, 0xe7, 0xd7,
+	0xd5, 0xdc, 0xc5, 0x75, 0x35, 0xf7, 0x5d, 0x83, 0xc7, 0x6a, 0x38, 0xe9, 0x85, 0x7d, 0x91, 0xb4,
+	0xec, 0x3f, 0x11, 0xe6, 0xcf, 0xa7, 0x72, 0x70, 0x62, 0x5e, 0xf6, 0x9e, 0xaf, 0xfd, 0xf3, 0xf8,
+	0x9f, 0x01, 0x00, 0x4c, 0x58, 0x5d, 0x75, 0x66, 0x08, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -938,6 +962,11 @@ func (m *ABCIMessageLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1248,6 +1277,24 @@ func (m *TxMsgData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgGasUsed) > 0 {
+		dAtA5 := make([]byte, len(m.MsgGasUsed)*10)
+		var j4 int
+		for _, num := range m.MsgGasUsed {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintAbci(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.MsgResponses) > 0 {
 		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1494,6 +1541,9 @@ func (m *ABCIMessageLog) Size() (n int) {
 			n += 1 + l + sovAbci(uint64(l))
 		}
 	}
+	if m.GasUsed != 0 {
+		n += 1 + sovAbci(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -1627,6 +1677,13 @@ func (m *TxMsgData) Size() (n int) {
 			n += 1 + l + sovAbci(uint64(l))
 		}
 	}
+	if len(m.MsgGasUsed) > 0 {
+		l = 0
+		for _, e := range m.MsgGasUsed {
+			l += sovAbci(uint64(e))
+		}
+		n += 1 + sovAbci(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1709,6 +1766,7 @@ func (this *ABCIMessageLog) String() string {
 		`MsgIndex:` + fmt.Sprintf("%v", this.MsgIndex) + `,`,
 		`Log:` + fmt.Sprintf("%v", this.Log) + `,`,
 		`Events:` + repeatedStringForEvents + `,`,
+		`GasUsed:` + fmt.Sprintf("%v", this.GasUsed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1757,6 +1815,7 @@ func (this *TxMsgData) String() string {
 	s := strings.Join([]string{`&TxMsgData{`,
 		`Data:` + repeatedStringForData + `,`,
 		`MsgResponses:` + repeatedStringForMsgResponses + `,`,
+		`MsgGasUsed:` + fmt.Sprintf("%v", this.MsgGasUsed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2345,6 +2404,25 @@ func (m *ABCIMessageLog) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
@@ -3200,6 +3278,82 @@ func (m *TxMsgData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAbci
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MsgGasUsed = append(m.MsgGasUsed, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAbci
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAbci
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAbci
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MsgGasUsed) == 0 {
+					m.MsgGasUsed = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAbci
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MsgGasUsed = append(m.MsgGasUsed, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasUsed", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
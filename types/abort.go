@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// AbortReason is the panic value raised by AbortWithReason. When recovered
+// from the execution of a transaction, it is converted into its error, so
+// that the transaction result carries the codespace and code of its reason.
+type AbortReason struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (r AbortReason) Error() string {
+	return r.Err.Error()
+}
+
+// Unwrap returns the error of the abort reason.
+func (r AbortReason) Unwrap() error {
+	return r.Err
+}
+
+// AbortWithReason aborts the execution of the current transaction by
+// panicking with an AbortReason, so that modules can intentionally abort
+// deep in a call stack without returning an error through every layer. The
+// transaction fails with the codespace and code of the given registered error,
+// and its state changes are discarded like on any failure.
+func AbortWithReason(code *errorsmod.Error, msg string) {
+	panic(AbortReason{Err: errorsmod.Wrap(code, msg)})
+}
@@ -49,6 +49,9 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.BlockInfo
 	headerInfo           header.Info
+	executionDeadline    *executionDeadline
+	sigVerifyCache       *SigVerificationCache
+	versionQuerier       storetypes.VersionQuerier
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -75,6 +78,8 @@ func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.trans
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 func (c Context) CometInfo() comet.BlockInfo                    { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) SigVerificationCache() *SigVerificationCache   { return c.sigVerifyCache }
+func (c Context) VersionQuerier() storetypes.VersionQuerier     { return c.versionQuerier }
 
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
@@ -202,6 +207,10 @@ func (c Context) WithVoteInfos(voteInfo []abci.VoteInfo) Context {
 
 // WithGasMeter returns a Context with an updated transaction GasMeter.
 func (c Context) WithGasMeter(meter storetypes.GasMeter) Context {
+	if c.executionDeadline != nil {
+		meter = c.executionDeadline.wrapGasMeter(meter)
+	}
+
 	c.gasMeter = meter
 	return c
 }
@@ -284,6 +293,20 @@ func (c Context) WithHeaderInfo(headerInfo header.Info) Context {
 	return c
 }
 
+// WithSigVerificationCache returns a Context with an updated signature
+// verification cache, nil disabling it.
+func (c Context) WithSigVerificationCache(cache *SigVerificationCache) Context {
+	c.sigVerifyCache = cache
+	return c
+}
+
+// WithVersionQuerier returns a Context giving read access to the committed
+// versions of the multistore, set on query contexts.
+func (c Context) WithVersionQuerier(querier storetypes.VersionQuerier) Context {
+	c.versionQuerier = querier
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -242,3 +242,27 @@ func (s *contextTestSuite) TestUnwrapSDKContext() {
 	sdkCtx2 = types.UnwrapSDKContext(ctx)
 	s.Require().Equal(sdkCtx, sdkCtx2)
 }
+
+func (s *contextTestSuite) TestWithExecutionDeadline() {
+	ctx := types.NewContext(nil, cmtproto.Header{}, false, nil)
+	ctx, cancel := ctx.WithExecutionDeadline(time.Now().Add(10 * time.Millisecond))
+	defer cancel()
+
+	_, ok := ctx.Deadline()
+	s.Require().True(ok)
+
+	// gas meters set after the deadline are guarded too
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100))
+	ctx.GasMeter().ConsumeGas(10, "before")
+	s.Require().Equal(storetypes.Gas(10), ctx.GasMeter().GasConsumed())
+
+	<-ctx.Done()
+	s.Require().Eventually(func() (expired bool) {
+		defer func() { expired = recover() != nil }()
+		ctx.GasMeter().ConsumeGas(10, "after")
+		return
+	}, time.Second, time.Millisecond)
+	s.Require().PanicsWithValue(types.ErrorExecutionTimeout{Descriptor: "after"}, func() {
+		ctx.GasMeter().ConsumeGas(10, "after")
+	})
+}
@@ -0,0 +1,84 @@
+// Package denomutil converts coins between the base and display units of their
+// denom, as described by the bank module's denom metadata.
+//
+// The conversions are exact: amounts are never rounded. They share their
+// implementation with the coin renderer of SIGN_MODE_TEXTUAL, so that clients
+// and signers agree on how an amount is displayed.
+package denomutil
+
+import (
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	corecoins "cosmossdk.io/core/coins"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ToDisplayUnits converts a coin expressed in any denom unit of the metadata
+// into its display denom. It returns the exact decimal amount, e.g. "1.234567"
+// for 1234567uatom with an exponent of 6, and the display denom.
+func ToDisplayUnits(coin sdk.Coin, metadata banktypes.Metadata) (string, string, error) {
+	if coin.Amount.IsNil() {
+		coin.Amount = math.ZeroInt()
+	}
+
+	return corecoins.ToDisplayUnits(&basev1beta1.Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.String(),
+	}, toAPIMetadata(metadata))
+}
+
+// FormatCoinWithMetadata formats a coin in the display denom of its metadata,
+// e.g. 1234567uatom as "1.234567atom". The result can be parsed back with
+// ParseDecCoinWithMetadata.
+func FormatCoinWithMetadata(coin sdk.Coin, metadata banktypes.Metadata) (string, error) {
+	amount, denom, err := ToDisplayUnits(coin, metadata)
+	if err != nil {
+		return "", err
+	}
+
+	return amount + denom, nil
+}
+
+// ParseDecCoinWithMetadata parses a decimal coin expressed in any denom unit of
+// the metadata, e.g. "1.234567atom" or "1.234567 atom", into a coin of the base
+// denom. It returns an error if the amount has more decimals than the base denom
+// can represent.
+func ParseDecCoinWithMetadata(str string, metadata banktypes.Metadata) (sdk.Coin, error) {
+	coin, err := corecoins.ParseToBaseUnits(str, toAPIMetadata(metadata))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount, ok := math.NewIntFromString(coin.Amount)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount %s%s is out of bounds", coin.Amount, coin.Denom)
+	}
+
+	return sdk.Coin{Denom: coin.Denom, Amount: amount}, nil
+}
+
+// toAPIMetadata converts the denom units of the metadata to their API type.
+func toAPIMetadata(metadata banktypes.Metadata) *bankv1beta1.Metadata {
+	units := make([]*bankv1beta1.DenomUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		if unit == nil {
+			continue
+		}
+		units[i] = &bankv1beta1.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		}
+	}
+
+	return &bankv1beta1.Metadata{
+		Base:       metadata.Base,
+		Display:    metadata.Display,
+		DenomUnits: units,
+	}
+}
@@ -0,0 +1,117 @@
+package denomutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/denomutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func metadata(base, display string, units ...*banktypes.DenomUnit) banktypes.Metadata {
+	return banktypes.Metadata{Base: base, Display: display, DenomUnits: units}
+}
+
+func unit(denom string, exponent uint32) *banktypes.DenomUnit {
+	return &banktypes.DenomUnit{Denom: denom, Exponent: exponent}
+}
+
+var (
+	exp0  = metadata("stake", "stake", unit("stake", 0))
+	exp6  = metadata("uatom", "atom", unit("uatom", 0), unit("matom", 3), unit("atom", 6))
+	exp18 = metadata("aevmos", "evmos", unit("aevmos", 0), unit("evmos", 18))
+)
+
+func TestFormatCoinWithMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		coin     sdk.Coin
+		metadata banktypes.Metadata
+		expected string
+		expErr   string
+	}{
+		{"exponent 0", sdk.NewInt64Coin("stake", 1234567), exp0, "1234567stake", ""},
+		{"exponent 0, zero", sdk.NewInt64Coin("stake", 0), exp0, "0stake", ""},
+		{"exponent 6", sdk.NewInt64Coin("uatom", 1234567), exp6, "1.234567atom", ""},
+		{"exponent 6, whole", sdk.NewInt64Coin("uatom", 5000000), exp6, "5atom", ""},
+		{"exponent 6, smallest unit", sdk.NewInt64Coin("uatom", 1), exp6, "0.000001atom", ""},
+		{"exponent 6, intermediate unit", sdk.NewInt64Coin("matom", 1500), exp6, "1.5atom", ""},
+		{
+			"exponent 18, beyond 18 significant decimals",
+			sdk.NewCoin("aevmos", math.NewIntWithDecimal(123456789, 18).AddRaw(1)),
+			exp18, "123456789.000000000000000001evmos", "",
+		},
+		{"exponent 18, smallest unit", sdk.NewInt64Coin("aevmos", 1), exp18, "0.000000000000000001evmos", ""},
+		{"denom not in metadata", sdk.NewInt64Coin("uosmo", 1), exp6, "", "not a denom unit"},
+		{"base not a denom unit", sdk.NewInt64Coin("uatom", 1), metadata("uatom", "atom", unit("atom", 6)), "", "base denom"},
+		{"base with non-zero exponent", sdk.NewInt64Coin("uatom", 1), metadata("uatom", "atom", unit("uatom", 1), unit("atom", 6)), "", "exponent 0"},
+		{"display not a denom unit", sdk.NewInt64Coin("uatom", 1), metadata("uatom", "atom", unit("uatom", 0)), "", "display denom"},
+		{"duplicate denom unit", sdk.NewInt64Coin("uatom", 1), metadata("uatom", "atom", unit("uatom", 0), unit("atom", 6), unit("atom", 3)), "", "duplicate"},
+		{"nil denom unit", sdk.NewInt64Coin("uatom", 1), metadata("uatom", "atom", unit("uatom", 0), nil, unit("atom", 6)), "", "empty denom unit"},
+		{"empty metadata", sdk.NewInt64Coin("uatom", 1), banktypes.Metadata{}, "", "base denom"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := denomutil.FormatCoinWithMetadata(tc.coin, tc.metadata)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+
+			// Formatted coins parse back to the original base coin.
+			if tc.coin.Denom == tc.metadata.Base {
+				parsed, err := denomutil.ParseDecCoinWithMetadata(res, tc.metadata)
+				require.NoError(t, err)
+				require.Equal(t, tc.coin, parsed)
+			}
+		})
+	}
+}
+
+func TestParseDecCoinWithMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		metadata banktypes.Metadata
+		expected sdk.Coin
+		expErr   string
+	}{
+		{"exponent 0", "1234567stake", exp0, sdk.NewInt64Coin("stake", 1234567), ""},
+		{"exponent 0, fractional", "1.5stake", exp0, sdk.Coin{}, "losing precision"},
+		{"exponent 6", "1.234567atom", exp6, sdk.NewInt64Coin("uatom", 1234567), ""},
+		{"exponent 6, with space", " 1.234567 atom ", exp6, sdk.NewInt64Coin("uatom", 1234567), ""},
+		{"exponent 6, trailing zeros", "1.500000000atom", exp6, sdk.NewInt64Coin("uatom", 1500000), ""},
+		{"exponent 6, intermediate unit", "2.5matom", exp6, sdk.NewInt64Coin("uatom", 2500), ""},
+		{"exponent 6, base unit", "42uatom", exp6, sdk.NewInt64Coin("uatom", 42), ""},
+		{"exponent 6, precision loss", "1.2345678atom", exp6, sdk.Coin{}, "losing precision"},
+		{"exponent 18", "0.000000000000000001evmos", exp18, sdk.NewInt64Coin("aevmos", 1), ""},
+		{"exponent 18, large", "123456789.000000000000000001evmos", exp18, sdk.NewCoin("aevmos", math.NewIntWithDecimal(123456789, 18).AddRaw(1)), ""},
+		{"exponent 18, precision loss", "0.0000000000000000001evmos", exp18, sdk.Coin{}, "losing precision"},
+		{"denom not in metadata", "1osmo", exp6, sdk.Coin{}, "not a denom unit"},
+		{"negative amount", "-1atom", exp6, sdk.Coin{}, "invalid decimal coin"},
+		{"malformed amount", "1.atom", exp6, sdk.Coin{}, "invalid decimal coin"},
+		{"missing denom", "1.5", exp6, sdk.Coin{}, "invalid decimal coin"},
+		{"malformed metadata", "1atom", metadata("uatom", "atom", unit("atom", 6)), sdk.Coin{}, "base denom"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := denomutil.ParseDecCoinWithMetadata(tc.input, tc.metadata)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
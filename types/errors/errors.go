@@ -140,6 +140,23 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrTxExecutionTimeout defines an error when the execution of a transaction
+	// outside of consensus exceeds its time limit.
+	ErrTxExecutionTimeout = errorsmod.Register(RootCodespace, 42, "tx execution timed out")
+
+	// ErrNilPointerDereference defines an error when the execution of a
+	// transaction panics on a nil pointer dereference.
+	ErrNilPointerDereference = errorsmod.Register(RootCodespace, 43, "nil pointer dereference")
+
+	// ErrMsgDisabledByCircuitBreaker defines an error when a Msg is dispatched
+	// while its processing is disabled by the circuit breaker.
+	ErrMsgDisabledByCircuitBreaker = errorsmod.Register(RootCodespace, 44, "message disabled by circuit breaker")
+
+	// ErrMempoolSenderLimit defines an error when a transaction is rejected
+	// because the mempool already holds the maximum number of transactions of
+	// its sender.
+	ErrMempoolSenderLimit = errorsmod.Register(RootCodespace, 45, "too many transactions of sender in mempool")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
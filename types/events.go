@@ -267,6 +267,10 @@ const (
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	// AttributeKeyMsgIndex is set on every event emitted during the execution
+	// of a message to the index of that message in the transaction.
+	AttributeKeyMsgIndex = "msg_index"
 )
 
 type (
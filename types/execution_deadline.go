@@ -0,0 +1,73 @@
+package types
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// ErrorExecutionTimeout is the panic raised at a gas consumption point once
+// the execution deadline of a Context expired.
+type ErrorExecutionTimeout struct {
+	Descriptor string
+}
+
+// executionDeadline tracks the expiry of an execution deadline with an atomic
+// flag, cheap enough to check at every gas consumption point.
+type executionDeadline struct {
+	expired atomic.Bool
+}
+
+// wrapGasMeter returns a gas meter checking the deadline before consuming gas.
+func (d *executionDeadline) wrapGasMeter(meter storetypes.GasMeter) storetypes.GasMeter {
+	if meter == nil {
+		return nil
+	}
+	if m, ok := meter.(deadlineGasMeter); ok && m.deadline == d {
+		return meter
+	}
+
+	return deadlineGasMeter{GasMeter: meter, deadline: d}
+}
+
+// deadlineGasMeter wraps a gas meter, and panics with ErrorExecutionTimeout
+// when gas is consumed after the execution deadline expired.
+type deadlineGasMeter struct {
+	storetypes.GasMeter
+	deadline *executionDeadline
+}
+
+// ConsumeGas implements the GasMeter interface.
+func (m deadlineGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if m.deadline.expired.Load() {
+		panic(ErrorExecutionTimeout{Descriptor: descriptor})
+	}
+
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// WithExecutionDeadline returns a Context whose execution aborts with an
+// ErrorExecutionTimeout panic at the first gas consumption point after the
+// deadline, including on gas meters set afterwards, along with the function
+// releasing its resources. The deadline is also set on the underlying
+// context.Context.
+//
+// CONTRACT: the deadline depends on wall-clock time, and must never be set
+// when executing transactions in consensus.
+func (c Context) WithExecutionDeadline(deadline time.Time) (Context, context.CancelFunc) {
+	baseCtx, cancel := context.WithDeadline(c.baseCtx, deadline)
+
+	d := &executionDeadline{}
+	timer := time.AfterFunc(time.Until(deadline), func() { d.expired.Store(true) })
+
+	c.baseCtx = baseCtx
+	c.executionDeadline = d
+	c.gasMeter = d.wrapGasMeter(c.gasMeter)
+
+	return c, func() {
+		timer.Stop()
+		cancel()
+	}
+}
@@ -0,0 +1,225 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/armon/go-metrics"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultLaneName is the name of the lane holding the txs matched by no
+// registered lane.
+const DefaultLaneName = "default"
+
+var (
+	_ Mempool       = (*LaneMempool)(nil)
+	_ SenderCounter = (*LaneMempool)(nil)
+)
+
+// Lane is a partition of a LaneMempool reserved to the txs it matches, such as
+// oracle votes or IBC packets, so that they are not crowded out of blocks by
+// other txs. Lanes are filled before the default lane when building a block,
+// each up to its share of the block gas.
+type Lane struct {
+	// Name identifies the lane, e.g. in telemetry.
+	Name string
+
+	// Match reports whether a tx belongs to the lane. See MatchMsgTypeURLs.
+	Match func(tx sdk.Tx) bool
+
+	// MaxBlockGasShare is the maximum share of the block gas, in (0, 1], the
+	// txs of the lane can use. The share left unused is available to the
+	// default lane.
+	MaxBlockGasShare sdkmath.LegacyDec
+
+	// Mempool holds the txs of the lane, and defaults to a
+	// PriorityNonceMempool.
+	Mempool Mempool
+}
+
+// MatchMsgTypeURLs returns a Lane matcher accepting the txs whose messages all
+// have one of the given type URLs.
+func MatchMsgTypeURLs(typeURLs ...string) func(tx sdk.Tx) bool {
+	accepted := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		accepted[typeURL] = struct{}{}
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if _, ok := accepted[sdk.MsgTypeURL(msg)]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// LaneMempool is a Mempool partitioned into lanes. A tx is held by the first
+// lane matching it, or by the default lane if none does.
+type LaneMempool struct {
+	lanes       []Lane
+	defaultLane Mempool
+}
+
+// NewLaneMempool returns a LaneMempool holding the txs not matched by any of
+// the given lanes in defaultLane. It panics if a lane is invalid.
+func NewLaneMempool(defaultLane Mempool, lanes ...Lane) *LaneMempool {
+	lanes = append([]Lane(nil), lanes...)
+	names := map[string]bool{DefaultLaneName: true}
+	for i, lane := range lanes {
+		if names[lane.Name] {
+			panic(fmt.Sprintf("invalid mempool lane name %q: duplicate or reserved", lane.Name))
+		}
+		names[lane.Name] = true
+
+		if lane.Match == nil {
+			panic(fmt.Sprintf("mempool lane %s has no matcher", lane.Name))
+		}
+
+		if lane.MaxBlockGasShare.IsNil() || !lane.MaxBlockGasShare.IsPositive() || lane.MaxBlockGasShare.GT(sdkmath.LegacyOneDec()) {
+			panic(fmt.Sprintf("mempool lane %s max block gas share must be in (0, 1], got %s", lane.Name, lane.MaxBlockGasShare))
+		}
+
+		if lane.Mempool == nil {
+			lanes[i].Mempool = DefaultPriorityMempool()
+		}
+	}
+
+	return &LaneMempool{lanes: lanes, defaultLane: defaultLane}
+}
+
+// Lanes returns the lanes of the mempool, in the order they are filled.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// DefaultLane returns the mempool holding the txs matched by no lane.
+func (mp *LaneMempool) DefaultLane() Mempool {
+	return mp.defaultLane
+}
+
+// Insert implements the Mempool interface, inserting tx into the mempool of
+// its lane.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	name, laneMempool := mp.laneOf(tx)
+	if err := laneMempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	setLaneSizeGauge(name, laneMempool)
+	return nil
+}
+
+// Select implements the Mempool interface, iterating over the txs of each lane
+// in turn, the default lane last.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iterators := make([]Iterator, 0, len(mp.lanes)+1)
+	for _, lane := range mp.lanes {
+		iterators = append(iterators, lane.Mempool.Select(ctx, txs))
+	}
+	iterators = append(iterators, mp.defaultLane.Select(ctx, txs))
+
+	return newLanesIterator(iterators)
+}
+
+// CountTx implements the Mempool interface, counting the txs of all lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := mp.defaultLane.CountTx()
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// CountBySender implements the SenderCounter interface, counting the txs of
+// sender in all the lanes whose mempool is a SenderCounter.
+func (mp *LaneMempool) CountBySender(sender string) int {
+	count := 0
+	if counter, ok := mp.defaultLane.(SenderCounter); ok {
+		count += counter.CountBySender(sender)
+	}
+	for _, lane := range mp.lanes {
+		if counter, ok := lane.Mempool.(SenderCounter); ok {
+			count += counter.CountBySender(sender)
+		}
+	}
+
+	return count
+}
+
+// Remove implements the Mempool interface, removing tx from the mempool of its
+// lane.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	name, laneMempool := mp.laneOf(tx)
+	if err := laneMempool.Remove(tx); err != nil {
+		return err
+	}
+
+	setLaneSizeGauge(name, laneMempool)
+	return nil
+}
+
+// laneOf returns the name and the mempool of the lane of tx.
+func (mp *LaneMempool) laneOf(tx sdk.Tx) (string, Mempool) {
+	for _, lane := range mp.lanes {
+		if lane.Match(tx) {
+			return lane.Name, lane.Mempool
+		}
+	}
+
+	return DefaultLaneName, mp.defaultLane
+}
+
+// setLaneSizeGauge reports the number of txs held by a lane.
+func setLaneSizeGauge(name string, laneMempool Mempool) {
+	telemetry.SetGaugeWithLabels(
+		[]string{"mempool", "lane", "size"},
+		float32(laneMempool.CountTx()),
+		[]metrics.Label{telemetry.NewLabel("lane", name)},
+	)
+}
+
+// lanesIterator chains the iterators of several lanes.
+type lanesIterator struct {
+	current   Iterator
+	iterators []Iterator
+}
+
+// newLanesIterator returns an iterator over the txs of the given iterators in
+// turn, or nil if they are all exhausted.
+func newLanesIterator(iterators []Iterator) Iterator {
+	for i, it := range iterators {
+		if it != nil {
+			return &lanesIterator{current: it, iterators: iterators[i+1:]}
+		}
+	}
+
+	return nil
+}
+
+// Next implements the Iterator interface.
+func (it *lanesIterator) Next() Iterator {
+	if next := it.current.Next(); next != nil {
+		return &lanesIterator{current: next, iterators: it.iterators}
+	}
+
+	return newLanesIterator(it.iterators)
+}
+
+// Tx implements the Iterator interface.
+func (it *lanesIterator) Tx() sdk.Tx {
+	return it.current.Tx()
+}
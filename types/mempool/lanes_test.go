@@ -0,0 +1,87 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// msgsTx is a testTx holding messages.
+type msgsTx struct {
+	testTx
+	msgs []sdk.Msg
+}
+
+func (tx msgsTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func TestMatchMsgTypeURLs(t *testing.T) {
+	match := mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&testdata.TestMsg{}))
+
+	require.True(t, match(msgsTx{msgs: []sdk.Msg{&testdata.TestMsg{}}}))
+	require.True(t, match(msgsTx{msgs: []sdk.Msg{&testdata.TestMsg{}, &testdata.TestMsg{}}}))
+	require.False(t, match(msgsTx{msgs: []sdk.Msg{&testdata.TestMsg{}, &banktypes.MsgSend{}}}))
+	require.False(t, match(msgsTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}}))
+	require.False(t, match(msgsTx{}))
+}
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+
+	lane := mempool.Lane{
+		Name:             "even",
+		Match:            func(tx sdk.Tx) bool { return tx.(testTx).id%2 == 0 },
+		MaxBlockGasShare: sdkmath.LegacyNewDecWithPrec(5, 1),
+	}
+	mp := mempool.NewLaneMempool(mempool.DefaultPriorityMempool(), lane)
+	require.NotNil(t, mp.Lanes()[0].Mempool, "lanes default to a priority nonce mempool")
+
+	var txs []testTx
+	for i, acc := range accounts {
+		tx := testTx{id: i, priority: int64(i), address: acc.Address}
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+		txs = append(txs, tx)
+	}
+
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 2, mp.DefaultLane().CountTx())
+
+	// lanes are iterated over before the default lane, each by priority
+	var ids []int
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{2, 0, 3, 1}, ids)
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.NoError(t, mp.Remove(txs[3]))
+	require.Equal(t, 1, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 1, mp.DefaultLane().CountTx())
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, 1, mp.CountBySender(accounts[0].Address.String()))
+	require.Equal(t, 1, mp.CountBySender(accounts[1].Address.String()))
+	require.Equal(t, 0, mp.CountBySender(accounts[2].Address.String()))
+
+	require.Panics(t, func() {
+		mempool.NewLaneMempool(mempool.DefaultPriorityMempool(), lane, lane)
+	}, "duplicate lane")
+	require.Panics(t, func() {
+		mempool.NewLaneMempool(mempool.DefaultPriorityMempool(), mempool.Lane{
+			Name:             "empty share",
+			Match:            lane.Match,
+			MaxBlockGasShare: sdkmath.LegacyZeroDec(),
+		})
+	})
+}
@@ -25,6 +25,14 @@ type Mempool interface {
 	Remove(sdk.Tx) error
 }
 
+// SenderCounter is implemented by the mempools able to count the transactions
+// of a sender, e.g. to limit the number of pending transactions per sender.
+type SenderCounter interface {
+	// CountBySender returns the number of transactions of the given sender,
+	// as a bech32 account address, currently in the mempool.
+	CountBySender(sender string) int
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator = (*PriorityNonceIterator[int64])(nil)
+	_ Mempool       = (*PriorityNonceMempool[int64])(nil)
+	_ SenderCounter = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator      = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -412,6 +413,16 @@ func (mp *PriorityNonceMempool[C]) CountTx() int {
 	return mp.priorityIndex.Len()
 }
 
+// CountBySender returns the number of transactions of a sender in the mempool.
+func (mp *PriorityNonceMempool[C]) CountBySender(sender string) int {
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return 0
+	}
+
+	return senderIndex.Len()
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
 // error if unsuccessful.
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
@@ -619,6 +619,40 @@ func TestPriorityNonceMempool_NextSenderTx(t *testing.T) {
 	require.Equal(t, txs[0], tx)
 }
 
+func TestPriorityNonceMempool_CountBySender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accA := accounts[0].Address
+	accB := accounts[1].Address
+
+	mp := mempool.DefaultPriorityMempool()
+
+	txs := []testTx{
+		{priority: 20, nonce: 1, address: accA},
+		{priority: 15, nonce: 2, address: accA},
+		{priority: 66, nonce: 3, address: accA},
+		{priority: 20, nonce: 1, address: accB},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	require.Equal(t, 3, mp.CountBySender(accA.String()))
+	require.Equal(t, 1, mp.CountBySender(accB.String()))
+	require.Equal(t, 0, mp.CountBySender(accounts[2].Address.String()))
+
+	// replacing a tx of a sender does not change its count
+	replacement := testTx{priority: 30, nonce: 2, address: accA}
+	require.NoError(t, mp.Insert(ctx.WithPriority(replacement.priority), replacement))
+	require.Equal(t, 3, mp.CountBySender(accA.String()))
+
+	// removed txs, e.g. included in a block, are no longer counted
+	require.NoError(t, mp.Remove(txs[0]))
+	require.NoError(t, mp.Remove(txs[3]))
+	require.Equal(t, 2, mp.CountBySender(accA.String()))
+	require.Equal(t, 0, mp.CountBySender(accB.String()))
+}
+
 func TestNextSenderTx_TxLimit(t *testing.T) {
 	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
 	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
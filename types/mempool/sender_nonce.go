@@ -14,8 +14,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*SenderNonceMempool)(nil)
-	_ Iterator = (*senderNonceMempoolIterator)(nil)
+	_ Mempool       = (*SenderNonceMempool)(nil)
+	_ SenderCounter = (*SenderNonceMempool)(nil)
+	_ Iterator      = (*senderNonceMempoolIterator)(nil)
 )
 
 var DefaultMaxTx = 0
@@ -187,6 +188,16 @@ func (snm *SenderNonceMempool) CountTx() int {
 	return len(snm.existingTx)
 }
 
+// CountBySender returns the count of txs of a sender in the mempool.
+func (snm *SenderNonceMempool) CountBySender(sender string) int {
+	senderTxs, found := snm.senders[sender]
+	if !found {
+		return 0
+	}
+
+	return senderTxs.Len()
+}
+
 // Remove removes a tx from the mempool. It returns an error if the tx does not
 // have at least one signer or the tx was not found in the pool.
 func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
@@ -46,6 +46,13 @@ type Configurator interface {
 	// will panic. If the ConsensusVersion bump does not introduce any store
 	// changes, then a no-op function must be registered here.
 	RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error
+
+	// RegisterBatchedMigration registers an in-place store migration from
+	// version `fromVersion` to version `fromVersion+1` which processes the module
+	// state in batches, see BatchedMigrationHandler. Only one of
+	// RegisterMigration and RegisterBatchedMigration may be used for a given
+	// module version.
+	RegisterBatchedMigration(moduleName string, fromVersion uint64, handler BatchedMigrationHandler) error
 }
 
 type configurator struct {
@@ -56,6 +63,9 @@ type configurator struct {
 	// migrations is a map of moduleName -> fromVersion -> migration script handler
 	migrations map[string]map[uint64]MigrationHandler
 
+	// batchedMigrations is a map of moduleName -> fromVersion -> batched migration script handler
+	batchedMigrations map[string]map[uint64]BatchedMigrationHandler
+
 	registryCache *protoregistry.Files
 	err           error
 }
@@ -91,6 +101,8 @@ func NewConfigurator(cdc codec.Codec, msgServer, queryServer grpc.Server) Config
 		msgServer:   msgServer,
 		queryServer: queryServer,
 		migrations:  map[string]map[uint64]MigrationHandler{},
+
+		batchedMigrations: map[string]map[uint64]BatchedMigrationHandler{},
 	}
 }
 
@@ -108,49 +120,146 @@ func (c *configurator) QueryServer() grpc.Server {
 
 // RegisterMigration implements the Configurator.RegisterMigration method
 func (c *configurator) RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error {
-	if fromVersion == 0 {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidVersion, "module migration versions should start at 1")
+	if err := c.validateMigration(moduleName, fromVersion); err != nil {
+		return err
 	}
 
 	if c.migrations[moduleName] == nil {
 		c.migrations[moduleName] = map[uint64]MigrationHandler{}
 	}
 
-	if c.migrations[moduleName][fromVersion] != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrLogic, "another migration for module %s and version %d already exists", moduleName, fromVersion)
+	c.migrations[moduleName][fromVersion] = handler
+
+	return nil
+}
+
+// RegisterBatchedMigration implements the Configurator.RegisterBatchedMigration method
+func (c *configurator) RegisterBatchedMigration(moduleName string, fromVersion uint64, handler BatchedMigrationHandler) error {
+	if err := c.validateMigration(moduleName, fromVersion); err != nil {
+		return err
 	}
 
-	c.migrations[moduleName][fromVersion] = handler
+	if c.batchedMigrations[moduleName] == nil {
+		c.batchedMigrations[moduleName] = map[uint64]BatchedMigrationHandler{}
+	}
+
+	c.batchedMigrations[moduleName][fromVersion] = handler
+
+	return nil
+}
+
+// validateMigration checks that a migration can be registered for the given
+// module version.
+func (c *configurator) validateMigration(moduleName string, fromVersion uint64) error {
+	if fromVersion == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidVersion, "module migration versions should start at 1")
+	}
+
+	_, found := c.migrations[moduleName][fromVersion]
+	_, batchedFound := c.batchedMigrations[moduleName][fromVersion]
+	if found || batchedFound {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "another migration for module %s and version %d already exists", moduleName, fromVersion)
+	}
 
 	return nil
 }
 
 // runModuleMigrations runs all in-place store migrations for one given module from a
 // version to another version.
-func (c *configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
+func (c *configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64, opts migrationOptions) error {
 	// No-op if toVersion is the initial version or if the version is unchanged.
 	if toVersion <= 1 || fromVersion == toVersion {
 		return nil
 	}
 
 	moduleMigrationsMap, found := c.migrations[moduleName]
-	if !found {
+	batchedMigrationsMap, batchedFound := c.batchedMigrations[moduleName]
+	if !found && !batchedFound {
 		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "no migrations found for module %s", moduleName)
 	}
 
 	// Run in-place migrations for the module sequentially until toVersion.
 	for i := fromVersion; i < toVersion; i++ {
-		migrateFn, found := moduleMigrationsMap[i]
-		if !found {
+		stepCtx := ctx.WithValue(migrationProgressKey{}, migrationStep{moduleName: moduleName, step: i, reporter: opts.reporter})
+
+		if migrateFn, found := moduleMigrationsMap[i]; found {
+			ctx.Logger().Info(fmt.Sprintf("migrating module %s from version %d to version %d", moduleName, i, i+1))
+
+			// a migration is checkpointed as a single batch
+			singleBatchFn := func(ctx sdk.Context, _ []byte) ([]byte, error) {
+				return nil, migrateFn(ctx)
+			}
+			if err := runBatchedMigration(stepCtx, moduleName, i, singleBatchFn, opts); err != nil {
+				return err
+			}
+		} else if migrateFn, found := batchedMigrationsMap[i]; found {
+			ctx.Logger().Info(fmt.Sprintf("migrating module %s from version %d to version %d", moduleName, i, i+1), "batched", true)
+
+			if err := runBatchedMigration(stepCtx, moduleName, i, migrateFn, opts); err != nil {
+				return err
+			}
+		} else {
 			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "no migration found for module %s from version %d to version %d", moduleName, i, i+1)
 		}
-		ctx.Logger().Info(fmt.Sprintf("migrating module %s from version %d to version %d", moduleName, i, i+1))
+	}
+
+	return nil
+}
 
-		err := migrateFn(ctx)
+// runBatchedMigration runs a batched migration batch by batch, writing every
+// batch once it succeeds. With a MigrationCheckpointStore, every written batch
+// is checkpointed, and the batches checkpointed by a previous run at the same
+// height are replayed before the migration continues from the cursor of the
+// last one.
+func runBatchedMigration(ctx sdk.Context, moduleName string, fromVersion uint64, migrateFn BatchedMigrationHandler, opts migrationOptions) error {
+	var cursor []byte
+	if opts.checkpoints != nil {
+		batches, err := opts.checkpoints.MigrationBatches(ctx.BlockHeight(), moduleName, fromVersion)
 		if err != nil {
 			return err
 		}
+
+		for _, batch := range batches {
+			if err := replayMigrationBatch(ctx, batch, opts.storeKeys); err != nil {
+				return err
+			}
+			cursor = batch.Next
+		}
+
+		if len(batches) > 0 {
+			ctx.Logger().Info("resuming migration from checkpoint", "module", moduleName, "step", fromVersion, "batches", len(batches), "complete", len(cursor) == 0)
+			if len(cursor) == 0 {
+				return nil
+			}
+		}
 	}
 
-	return nil
+	for {
+		var writes []MigrationWrite
+		cms := ctx.MultiStore().CacheMultiStore()
+		if opts.checkpoints != nil {
+			cms = newJournalMultiStore(cms, &writes)
+		}
+
+		batchCtx := ctx.WithMultiStore(cms).WithEventManager(sdk.NewEventManager())
+		next, err := migrateFn(batchCtx, cursor)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvents(batchCtx.EventManager().Events())
+		cms.Write()
+
+		if opts.checkpoints != nil {
+			if err := opts.checkpoints.AddMigrationBatch(ctx.BlockHeight(), moduleName, fromVersion, MigrationBatch{Writes: writes, Next: next}); err != nil {
+				return err
+			}
+		}
+
+		if len(next) == 0 {
+			return nil
+		}
+
+		cursor = next
+	}
 }
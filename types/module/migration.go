@@ -0,0 +1,225 @@
+package module
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// migrationRunning is set while RunMigrations runs.
+var migrationRunning atomic.Bool
+
+// IsMigrationRunning returns whether RunMigrations is running in the process,
+// e.g. in an upgrade handler, which the node service reports so that the node
+// is not sent queries meanwhile.
+func IsMigrationRunning() bool {
+	return migrationRunning.Load()
+}
+
+// BatchedMigrationHandler is a migration function that processes its state
+// in batches. It is called with the cursor returned by the previous batch (nil
+// for the first batch) and returns the cursor of the next batch, or nil once
+// the migration is complete.
+//
+// Every batch runs in its own cached context, which is written once the batch
+// succeeds, so that large migrations do not accumulate all their writes in a
+// single cache and can report their progress after every batch. Together with
+// a MigrationCheckpointStore, a migration interrupted by a failure or a
+// restart of the node continues from its last written batch.
+type BatchedMigrationHandler func(ctx sdk.Context, cursor []byte) (next []byte, err error)
+
+// MigrationProgressReporter receives progress updates of the in-place store
+// migrations run by RunMigrations.
+type MigrationProgressReporter interface {
+	// ReportMigrationProgress is called with the module being migrated, the
+	// version it is migrating from, and the number of processed and total items.
+	ReportMigrationProgress(moduleName string, step, processed, total uint64)
+}
+
+// MigrationWrite is a write to a KV store made by a migration batch.
+type MigrationWrite struct {
+	StoreKey string
+	Key      []byte
+	Value    []byte
+	Delete   bool
+}
+
+// MigrationBatch is a checkpointed batch of a migration: its writes, and the
+// cursor of the next batch, empty once the migration is complete.
+type MigrationBatch struct {
+	Writes []MigrationWrite
+	Next   []byte
+}
+
+// MigrationCheckpointStore persists the batches of the migrations run by
+// RunMigrations as they are written. The writes of an upgrade handler are only
+// committed with its block, so the checkpoints must be kept outside of the
+// block state, e.g. in a node-local database, to survive a restart of the node
+// in the middle of an upgrade: the block is then replayed on the last
+// committed state, and every migration step replays its checkpointed writes
+// and continues from the cursor of its last batch instead of starting over.
+// The x/upgrade keeper provides a database backed implementation.
+type MigrationCheckpointStore interface {
+	// MigrationBatches returns the checkpointed batches, in order, of the
+	// migration of a module from a version run at the given height.
+	MigrationBatches(height int64, moduleName string, fromVersion uint64) ([]MigrationBatch, error)
+	// AddMigrationBatch checkpoints a written batch of the migration of a
+	// module from a version run at the given height.
+	AddMigrationBatch(height int64, moduleName string, fromVersion uint64, batch MigrationBatch) error
+	// ClearMigrationCheckpoints removes all checkpoints, it is called once all
+	// migrations have been run.
+	ClearMigrationCheckpoints() error
+}
+
+// MigrationOption configures RunMigrations.
+type MigrationOption func(*migrationOptions)
+
+type migrationOptions struct {
+	reporter    MigrationProgressReporter
+	checkpoints MigrationCheckpointStore
+	storeKeys   map[string]storetypes.StoreKey
+}
+
+// WithMigrationProgressReporter sets a reporter which receives the progress
+// reported by module migrations through ReportMigrationProgress.
+func WithMigrationProgressReporter(reporter MigrationProgressReporter) MigrationOption {
+	return func(o *migrationOptions) {
+		o.reporter = reporter
+	}
+}
+
+// WithMigrationCheckpointStore sets the store used to checkpoint the batches
+// of the migrations, see MigrationCheckpointStore. The store keys are the keys
+// of the stores the migrations write to, which the checkpointed writes are
+// replayed into.
+func WithMigrationCheckpointStore(checkpoints MigrationCheckpointStore, storeKeys []storetypes.StoreKey) MigrationOption {
+	return func(o *migrationOptions) {
+		o.checkpoints = checkpoints
+		o.storeKeys = make(map[string]storetypes.StoreKey, len(storeKeys))
+		for _, key := range storeKeys {
+			if key != nil {
+				o.storeKeys[key.Name()] = key
+			}
+		}
+	}
+}
+
+type migrationProgressKey struct{}
+
+type migrationStep struct {
+	moduleName string
+	step       uint64
+	reporter   MigrationProgressReporter
+}
+
+// ReportMigrationProgress reports the progress of the migration currently being
+// run with the given context. The progress is logged and forwarded to the
+// MigrationProgressReporter passed to RunMigrations, if any. It is a no-op
+// outside of RunMigrations.
+func ReportMigrationProgress(ctx sdk.Context, processed, total uint64) {
+	s, ok := ctx.Value(migrationProgressKey{}).(migrationStep)
+	if !ok {
+		return
+	}
+
+	ctx.Logger().Info("migration progress", "module", s.moduleName, "step", s.step, "processed", processed, "total", total)
+	if s.reporter != nil {
+		s.reporter.ReportMigrationProgress(s.moduleName, s.step, processed, total)
+	}
+}
+
+// replayMigrationBatch applies the writes of a checkpointed batch.
+func replayMigrationBatch(ctx sdk.Context, batch MigrationBatch, storeKeys map[string]storetypes.StoreKey) error {
+	for _, write := range batch.Writes {
+		key, ok := storeKeys[write.StoreKey]
+		if !ok {
+			return fmt.Errorf("unknown store %s in migration checkpoint", write.StoreKey)
+		}
+
+		store := ctx.MultiStore().GetKVStore(key)
+		if write.Delete {
+			store.Delete(write.Key)
+		} else {
+			store.Set(write.Key, write.Value)
+		}
+	}
+
+	return nil
+}
+
+// cacheMultiStore is embedded in journalMultiStore, which overrides its
+// CacheMultiStore method.
+type cacheMultiStore = storetypes.CacheMultiStore
+
+// journalMultiStore is a cache multistore which journals the writes to its KV
+// stores. The writes of a nested cache multistore are appended to the journal
+// of its parent when it is written.
+type journalMultiStore struct {
+	cacheMultiStore
+	writes *[]MigrationWrite
+	parent *[]MigrationWrite
+}
+
+func newJournalMultiStore(cms storetypes.CacheMultiStore, writes *[]MigrationWrite) *journalMultiStore {
+	return &journalMultiStore{cacheMultiStore: cms, writes: writes}
+}
+
+func (s *journalMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return journalKVStore{KVStore: s.cacheMultiStore.GetKVStore(key), storeKey: key.Name(), writes: s.writes}
+}
+
+func (s *journalMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return s.GetKVStore(key)
+}
+
+func (s *journalMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return &journalMultiStore{cacheMultiStore: s.cacheMultiStore.CacheMultiStore(), writes: new([]MigrationWrite), parent: s.writes}
+}
+
+func (s *journalMultiStore) CacheWrap() storetypes.CacheWrap {
+	return s.CacheMultiStore()
+}
+
+func (s *journalMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheMultiStore()
+}
+
+func (s *journalMultiStore) Write() {
+	s.cacheMultiStore.Write()
+	if s.parent != nil {
+		*s.parent = append(*s.parent, *s.writes...)
+		*s.writes = nil
+	}
+}
+
+// journalKVStore journals the writes to a KV store.
+type journalKVStore struct {
+	storetypes.KVStore
+	storeKey string
+	writes   *[]MigrationWrite
+}
+
+func (s journalKVStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	*s.writes = append(*s.writes, MigrationWrite{StoreKey: s.storeKey, Key: bytes.Clone(key), Value: bytes.Clone(value)})
+}
+
+func (s journalKVStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	*s.writes = append(*s.writes, MigrationWrite{StoreKey: s.storeKey, Key: bytes.Clone(key), Delete: true})
+}
+
+func (s journalKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s journalKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
@@ -33,7 +33,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"sort"
+	"strings"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/genesis"
@@ -49,6 +51,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -192,6 +195,14 @@ type HasGenesis interface {
 	ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage
 }
 
+// HasStreamingGenesis is the extension interface for modules which can write
+// their exported genesis state as it is read from the store, without
+// materializing it in memory. The written JSON must be equivalent to the
+// output of ExportGenesis.
+type HasStreamingGenesis interface {
+	ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error
+}
+
 // AppModule is the form for an application module. Most of
 // its functionality has been moved to extension interfaces.
 type AppModule interface {
@@ -219,6 +230,23 @@ type HasConsensusVersion interface {
 	ConsensusVersion() uint64
 }
 
+// HasDerivedStores is the interface for modules keeping derived stores, whose
+// contents are derived from the primary state of the module and can be rebuilt
+// from it.
+type HasDerivedStores interface {
+	// RebuildDerivedStores writes the contents of the derived stores of the
+	// module from its primary state, the stores being cleared.
+	RebuildDerivedStores(context.Context) error
+}
+
+// HasDependencies is the interface for modules declaring the modules they depend
+// on, e.g. because their InitGenesis reads the state of these modules.
+type HasDependencies interface {
+	// Dependencies returns the names of the modules which must be initialized
+	// from genesis before the module.
+	Dependencies() []string
+}
+
 // BeginBlockAppModule is an extension interface that contains information about the AppModule and BeginBlock.
 type BeginBlockAppModule interface {
 	AppModule
@@ -265,6 +293,15 @@ func (gam GenesisOnlyAppModule) RegisterServices(Configurator) {}
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
 
+// Dependencies implements HasDependencies, returning the dependencies of the
+// wrapped module if it declares any.
+func (gam GenesisOnlyAppModule) Dependencies() []string {
+	if m, ok := gam.AppModuleGenesis.(HasDependencies); ok {
+		return m.Dependencies()
+	}
+	return nil
+}
+
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -555,6 +592,57 @@ func (m *Manager) ExportGenesisForModules(ctx sdk.Context, cdc codec.JSONCodec,
 	return genesisData, nil
 }
 
+// ExportGenesisArchive writes the genesis state of the modules to a genesis
+// archive in OrderExportGenesis. Unlike ExportGenesis, the modules are exported
+// one after the other, and the modules implementing HasStreamingGenesis are
+// written to the archive without materializing their genesis state.
+func (m *Manager) ExportGenesisArchive(ctx sdk.Context, cdc codec.JSONCodec, archive servertypes.GenesisArchiveWriter) error {
+	for _, moduleName := range m.OrderExportGenesis {
+		var write func(w io.Writer) error
+
+		switch module := m.Modules[moduleName].(type) {
+		case HasStreamingGenesis:
+			write = func(w io.Writer) error {
+				return module.ExportGenesisTo(ctx, cdc, w)
+			}
+		case appmodule.HasGenesis:
+			write = func(w io.Writer) error {
+				target := genesis.RawJSONTarget{}
+				if err := module.ExportGenesis(ctx, target.Target()); err != nil {
+					return err
+				}
+
+				rawJSON, err := target.JSON()
+				if err != nil {
+					return err
+				}
+
+				_, err = w.Write(rawJSON)
+				return err
+			}
+		case HasGenesis:
+			write = func(w io.Writer) error {
+				bz := module.ExportGenesis(ctx, cdc)
+				if bz == nil {
+					// as marshaled by ExportGenesis
+					bz = json.RawMessage("null")
+				}
+
+				_, err := w.Write(bz)
+				return err
+			}
+		default:
+			continue
+		}
+
+		if err := archive.WriteModule(moduleName, write); err != nil {
+			return fmt.Errorf("failed to export the genesis state of module %s: %w", moduleName, err)
+		}
+	}
+
+	return nil
+}
+
 // checkModulesExists verifies that all modules in the list exist in the app
 func (m *Manager) checkModulesExists(moduleName []string) error {
 	for _, name := range moduleName {
@@ -566,6 +654,37 @@ func (m *Manager) checkModulesExists(moduleName []string) error {
 	return nil
 }
 
+// ValidateOrder checks that the modules implementing HasDependencies come after
+// their dependencies in OrderInitGenesis, and panics listing all the violations
+// otherwise. Dependencies which are not registered in the manager, or which do
+// not have genesis, are ignored. It should be called by app constructors once
+// the module orders are set.
+func (m *Manager) ValidateOrder() {
+	positions := make(map[string]int, len(m.OrderInitGenesis))
+	for i, moduleName := range m.OrderInitGenesis {
+		positions[moduleName] = i
+	}
+
+	var violations []string
+	for i, moduleName := range m.OrderInitGenesis {
+		module, ok := m.Modules[moduleName].(HasDependencies)
+		if !ok {
+			continue
+		}
+
+		for _, dep := range module.Dependencies() {
+			if j, ok := positions[dep]; ok && j > i {
+				violations = append(violations, fmt.Sprintf("%s must come after %s", moduleName, dep))
+			}
+		}
+	}
+
+	if len(violations) != 0 {
+		panic(fmt.Sprintf(
+			"invalid module order in OrderInitGenesis, modules must come after their dependencies: %s", strings.Join(violations, "; ")))
+	}
+}
+
 // assertNoForgottenModules checks that we didn't forget any modules in the
 // SetOrder* functions.
 // `pass` is a closure which allows one to omit modules from `moduleNames`. If you provide non-nil `pass` and it returns true, the module would not be subject of the assertion.
@@ -648,12 +767,53 @@ type VersionMap map[string]uint64
 //	    return app.mm.RunMigrations(ctx, cfg, fromVM)
 //	})
 //
+// Migrations can report their progress with ReportMigrationProgress, which is
+// logged and forwarded to the reporter set with WithMigrationProgressReporter.
+//
+// Large migrations can be registered with Configurator.RegisterBatchedMigration
+// to process the module state in batches, reporting their progress after
+// every batch.
+//
+// When a MigrationCheckpointStore is set with WithMigrationCheckpointStore,
+// every written batch, and every migration step as a single batch, is
+// checkpointed outside of the block state. If the node is stopped or
+// RunMigrations fails, running the migrations again at the same height, e.g.
+// when the upgrade block is replayed after a restart, replays the checkpointed
+// writes and continues from the last checkpoint instead of starting from
+// scratch. The checkpoints are cleared once all migrations have been run.
+//
+// Example:
+//
+//	app.UpgradeKeeper.SetUpgradeHandler("my-plan", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//	    checkpoints, err := app.UpgradeKeeper.OpenMigrationCheckpointDB()
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    defer checkpoints.Close()
+//
+//	    return app.mm.RunMigrations(ctx, cfg, fromVM,
+//	        module.WithMigrationProgressReporter(app.UpgradeKeeper),
+//	        module.WithMigrationCheckpointStore(checkpoints, app.GetStoreKeys()),
+//	    )
+//	})
+//
+// IsMigrationRunning reports whether RunMigrations is running.
+//
 // Please also refer to docs/core/upgrade.md for more information.
-func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap, opts ...MigrationOption) (VersionMap, error) {
 	c, ok := cfg.(*configurator)
 	if !ok {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &configurator{}, cfg)
 	}
+
+	migrationRunning.Store(true)
+	defer migrationRunning.Store(false)
+
+	var o migrationOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	modules := m.OrderMigrations
 	if modules == nil {
 		modules = DefaultMigrationsOrder(m.ModuleNames())
@@ -677,7 +837,7 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 		// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
 		// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
 		if exists {
-			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
+			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion, o)
 			if err != nil {
 				return nil, err
 			}
@@ -696,6 +856,12 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 		updatedVM[moduleName] = toVersion
 	}
 
+	if o.checkpoints != nil {
+		if err := o.checkpoints.ClearMigrationCheckpoints(); err != nil {
+			return nil, err
+		}
+	}
+
 	return updatedVM, nil
 }
 
@@ -801,6 +967,24 @@ func (m *Manager) PrepareCheckState(ctx sdk.Context) error {
 	return nil
 }
 
+// RebuildDerivedStores rebuilds the derived stores of all modules, in the
+// order of the module names. It is meant to be set as the derived store
+// rebuilder of the app.
+func (m *Manager) RebuildDerivedStores(ctx sdk.Context) error {
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		module, ok := m.Modules[moduleName].(HasDerivedStores)
+		if !ok {
+			continue
+		}
+		if err := module.RebuildDerivedStores(ctx); err != nil {
+			return fmt.Errorf("failed to rebuild the derived stores of module %s: %w", moduleName, err)
+		}
+	}
+	return nil
+}
+
 // GetVersionMap gets consensus version from all modules
 func (m *Manager) GetVersionMap() VersionMap {
 	vermap := make(VersionMap)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/core/appmodule"
@@ -98,8 +99,21 @@ func TestGenesisOnlyAppModule(t *testing.T) {
 
 	// no-op
 	goam.RegisterInvariants(mockInvariantRegistry)
+	require.Empty(t, goam.Dependencies())
+
+	// the dependencies of the wrapped module are exposed
+	goam = module.NewGenesisOnlyAppModule(dependentGenesisModule{mockModule, []string{"auth"}})
+	require.Equal(t, []string{"auth"}, goam.Dependencies())
+}
+
+// dependentGenesisModule is a genesis-only module declaring dependencies.
+type dependentGenesisModule struct {
+	*mock.MockAppModuleGenesis
+	deps []string
 }
 
+func (m dependentGenesisModule) Dependencies() []string { return m.deps }
+
 func TestAssertNoForgottenModules(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -316,6 +330,60 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Error(t, err)
 }
 
+// streamingAppModule is an app module streaming its exported genesis state.
+type streamingAppModule struct {
+	*mock.MockAppModuleWithAllExtensions
+}
+
+func (streamingAppModule) ExportGenesisTo(_ sdk.Context, _ codec.JSONCodec, w io.Writer) error {
+	_, err := io.WriteString(w, `{"key2": "streamed"}`)
+	return err
+}
+
+// mapGenesisArchive is a genesis archive holding the genesis state of the
+// modules in memory.
+type mapGenesisArchive struct {
+	order   []string
+	modules map[string]string
+}
+
+func (a *mapGenesisArchive) WriteModule(moduleName string, write func(w io.Writer) error) error {
+	var buf strings.Builder
+	if err := write(&buf); err != nil {
+		return err
+	}
+
+	a.order = append(a.order, moduleName)
+	a.modules[moduleName] = buf.String()
+
+	return nil
+}
+
+func TestManager_ExportGenesisArchive(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockAppModule2 := mock.NewMockAppModuleWithAllExtensions(mockCtrl)
+	mockCoreAppModule := MockCoreAppModule{}
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, streamingAppModule{mockAppModule2}, module.CoreAppModuleBasicAdaptor("mockCoreAppModule", mockCoreAppModule))
+	mm.SetOrderExportGenesis("mockCoreAppModule", "module2", "module1")
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	mockAppModule1.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key1": "value1"}`))
+
+	archive := &mapGenesisArchive{modules: map[string]string{}}
+	require.NoError(t, mm.ExportGenesisArchive(ctx, cdc, archive))
+	require.Equal(t, []string{"mockCoreAppModule", "module2", "module1"}, archive.order)
+	require.Equal(t, `{"key1": "value1"}`, archive.modules["module1"])
+	require.Equal(t, `{"key2": "streamed"}`, archive.modules["module2"])
+	require.JSONEq(t, `{"someField": "someKey"}`, archive.modules["mockCoreAppModule"])
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -640,3 +708,33 @@ var (
 	_ appmodule.HasGenesis  = MockCoreAppModule{}
 	_ appmodule.HasServices = MockCoreAppModule{}
 )
+
+// dependentModule is a core app module declaring dependencies.
+type dependentModule struct {
+	*mock.MockCoreAppModule
+	deps []string
+}
+
+func (m dependentModule) Dependencies() []string { return m.deps }
+
+func TestManagerValidateOrder(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"auth":     mock.NewMockCoreAppModule(mockCtrl),
+		"bank":     dependentModule{mock.NewMockCoreAppModule(mockCtrl), []string{"auth"}},
+		"staking":  dependentModule{mock.NewMockCoreAppModule(mockCtrl), []string{"auth", "bank"}},
+		"slashing": dependentModule{mock.NewMockCoreAppModule(mockCtrl), []string{"staking", "unknown"}},
+	})
+
+	mm.SetOrderInitGenesis("auth", "bank", "staking", "slashing")
+	require.NotPanics(t, mm.ValidateOrder)
+
+	mm.SetOrderInitGenesis("slashing", "staking", "auth", "bank")
+	require.PanicsWithValue(t,
+		"invalid module order in OrderInitGenesis, modules must come after their dependencies: "+
+			"slashing must come after staking; staking must come after auth; staking must come after bank",
+		mm.ValidateOrder,
+	)
+}
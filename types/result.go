@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 	"encoding/json"
+	"strconv"
 	"strings"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -65,6 +66,9 @@ func NewResponseResultTx(res *coretypes.ResultTx, anyTx *codectypes.Any, timesta
 	}
 
 	parsedLogs, _ := ParseABCILogs(res.TxResult.Log)
+	if len(parsedLogs) == 0 && res.TxResult.IsOK() {
+		parsedLogs = newMsgLogs(res.TxResult.Data, res.TxResult.Events)
+	}
 
 	return &TxResponse{
 		TxHash:    res.Hash.String(),
@@ -83,6 +87,42 @@ func NewResponseResultTx(res *coretypes.ResultTx, anyTx *codectypes.Any, timesta
 	}
 }
 
+// newMsgLogs returns a log per message of a successful transaction from its
+// TxMsgData and its events. Each log holds the gas consumed by its message and
+// the events tagged with its msg_index. Nil is returned if data cannot be
+// decoded.
+func newMsgLogs(data []byte, events []abci.Event) ABCIMessageLogs {
+	var txMsgData TxMsgData
+	if err := proto.Unmarshal(data, &txMsgData); err != nil || len(txMsgData.MsgResponses) == 0 {
+		return nil
+	}
+
+	logs := make(ABCIMessageLogs, len(txMsgData.MsgResponses))
+	for i := range logs {
+		logs[i] = ABCIMessageLog{MsgIndex: uint32(i), Events: StringEvents{}}
+		if i < len(txMsgData.MsgGasUsed) {
+			logs[i].GasUsed = txMsgData.MsgGasUsed[i]
+		}
+	}
+
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			if attr.Key != AttributeKeyMsgIndex {
+				continue
+			}
+
+			i, err := strconv.Atoi(attr.Value)
+			if err == nil && i >= 0 && i < len(logs) {
+				logs[i].Events = append(logs[i].Events, StringifyEvent(event))
+			}
+
+			break
+		}
+	}
+
+	return logs
+}
+
 // NewResponseResultBlock returns a BlockResponse given a ResultBlock from CometBFT
 func NewResponseResultBlock(res *coretypes.ResultBlock, timestamp string) *cmtproto.Block {
 	if res == nil {
@@ -0,0 +1,55 @@
+package types
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// SigVerificationCache is a bounded LRU cache of the signatures of txs
+// verified in CheckTx, so that their verification is not repeated when the
+// txs are executed in a block. Entries are keyed by tx hash and hold, for each
+// signer, a digest of the signer data the signature was verified against. A
+// signature must only be considered verified when the digest of its current
+// signer data matches, as the bytes it signs are otherwise different.
+//
+// It is safe for concurrent use.
+type SigVerificationCache struct {
+	entries *lru.Cache
+}
+
+// NewSigVerificationCache returns a SigVerificationCache holding the
+// signatures of at most size txs. It panics if size is not positive.
+func NewSigVerificationCache(size int) *SigVerificationCache {
+	entries, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+
+	return &SigVerificationCache{entries: entries}
+}
+
+// Get returns the signer data digests of the tx with the given hash, indexed
+// by signer.
+func (c *SigVerificationCache) Get(txHash []byte) ([][]byte, bool) {
+	v, ok := c.entries.Get(string(txHash))
+	if !ok {
+		return nil, false
+	}
+
+	return v.([][]byte), true
+}
+
+// Add records that all signatures of the tx with the given hash were verified
+// against signer data with the given digests, indexed by signer.
+func (c *SigVerificationCache) Add(txHash []byte, digests [][]byte) {
+	c.entries.Add(string(txHash), digests)
+}
+
+// Remove invalidates the entry of the tx with the given hash.
+func (c *SigVerificationCache) Remove(txHash []byte) {
+	c.entries.Remove(string(txHash))
+}
+
+// Len returns the number of txs in the cache.
+func (c *SigVerificationCache) Len() int {
+	return c.entries.Len()
+}
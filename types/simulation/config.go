@@ -9,6 +9,7 @@ type Config struct {
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	OperationStatsPath string // custom file path to save the per-operation statistics JSON
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -23,4 +24,7 @@ type Config struct {
 	AllInvariants bool // print all failed invariants if a broken invariant is found
 
 	DBBackend string // custom db backend type
+
+	LogOperations    bool   // record the executed operations to an operation log which can be replayed
+	OperationLogPath string // custom file path to save the operation log
 }
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -126,6 +127,12 @@ type GetTxsEventRequest struct {
 	//
 	// Since Cosmos SDK 0.48
 	Query string `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	// event_query defines a structured transaction event query whose condition
+	// groups are combined with OR. If query is also set, it is combined with
+	// every group with AND.
+	//
+	// Since Cosmos SDK 0.48
+	EventQuery *TxEventQuery `protobuf:"bytes,7,opt,name=event_query,json=eventQuery,proto3" json:"event_query,omitempty"`
 }
 
 func (m *GetTxsEventRequest) Reset()         { *m = GetTxsEventRequest{} }
@@ -205,6 +212,132 @@ func (m *GetTxsEventRequest) GetQuery() string {
 	return ""
 }
 
+func (m *GetTxsEventRequest) GetEventQuery() *TxEventQuery {
+	if m != nil {
+		return m.EventQuery
+	}
+	return nil
+}
+
+// TxEventQuery defines a structured transaction event query.
+//
+// Since Cosmos SDK 0.48
+type TxEventQuery struct {
+	// groups is the list of condition groups of the query. A transaction matches
+	// the query if it matches all the conditions of any of the groups.
+	Groups []*TxEventConditionGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// from_height is the minimum height of the queried transactions, inclusive.
+	// It is ignored if zero.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the maximum height of the queried transactions, inclusive.
+	// It is ignored if zero.
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *TxEventQuery) Reset()         { *m = TxEventQuery{} }
+func (m *TxEventQuery) String() string { return proto.CompactTextString(m) }
+func (*TxEventQuery) ProtoMessage()    {}
+func (*TxEventQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{1}
+}
+func (m *TxEventQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxEventQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxEventQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxEventQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEventQuery.Merge(m, src)
+}
+func (m *TxEventQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxEventQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxEventQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxEventQuery proto.InternalMessageInfo
+
+func (m *TxEventQuery) GetGroups() []*TxEventConditionGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *TxEventQuery) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *TxEventQuery) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// TxEventConditionGroup defines a group of transaction event conditions
+// combined with AND.
+//
+// Since Cosmos SDK 0.48
+type TxEventConditionGroup struct {
+	// conditions is the list of conditions of the group in the CometBFT event
+	// query syntax, e.g. "message.sender='cosmos1...'".
+	Conditions []string `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+}
+
+func (m *TxEventConditionGroup) Reset()         { *m = TxEventConditionGroup{} }
+func (m *TxEventConditionGroup) String() string { return proto.CompactTextString(m) }
+func (*TxEventConditionGroup) ProtoMessage()    {}
+func (*TxEventConditionGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{2}
+}
+func (m *TxEventConditionGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxEventConditionGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxEventConditionGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxEventConditionGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEventConditionGroup.Merge(m, src)
+}
+func (m *TxEventConditionGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxEventConditionGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxEventConditionGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxEventConditionGroup proto.InternalMessageInfo
+
+func (m *TxEventConditionGroup) GetConditions() []string {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
 // GetTxsEventResponse is the response type for the Service.TxsByEvents
 // RPC method.
 type GetTxsEventResponse struct {
@@ -217,13 +350,18 @@ type GetTxsEventResponse struct {
 	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"` // Deprecated: Do not use.
 	// total is total number of results available
 	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// merged is true if the results were merged from one transaction search per
+	// condition group of the event query.
+	//
+	// Since Cosmos SDK 0.48
+	Merged bool `protobuf:"varint,5,opt,name=merged,proto3" json:"merged,omitempty"`
 }
 
 func (m *GetTxsEventResponse) Reset()         { *m = GetTxsEventResponse{} }
 func (m *GetTxsEventResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxsEventResponse) ProtoMessage()    {}
 func (*GetTxsEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{1}
+	return fileDescriptor_e0b00a618705eca7, []int{3}
 }
 func (m *GetTxsEventResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,6 +419,13 @@ func (m *GetTxsEventResponse) GetTotal() uint64 {
 	return 0
 }
 
+func (m *GetTxsEventResponse) GetMerged() bool {
+	if m != nil {
+		return m.Merged
+	}
+	return false
+}
+
 // BroadcastTxRequest is the request type for the Service.BroadcastTxRequest
 // RPC method.
 type BroadcastTxRequest struct {
@@ -293,7 +438,7 @@ func (m *BroadcastTxRequest) Reset()         { *m = BroadcastTxRequest{} }
 func (m *BroadcastTxRequest) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTxRequest) ProtoMessage()    {}
 func (*BroadcastTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{2}
+	return fileDescriptor_e0b00a618705eca7, []int{4}
 }
 func (m *BroadcastTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +492,7 @@ func (m *BroadcastTxResponse) Reset()         { *m = BroadcastTxResponse{} }
 func (m *BroadcastTxResponse) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTxResponse) ProtoMessage()    {}
 func (*BroadcastTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{3}
+	return fileDescriptor_e0b00a618705eca7, []int{5}
 }
 func (m *BroadcastTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +544,7 @@ func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
 func (m *SimulateRequest) String() string { return proto.CompactTextString(m) }
 func (*SimulateRequest) ProtoMessage()    {}
 func (*SimulateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{4}
+	return fileDescriptor_e0b00a618705eca7, []int{6}
 }
 func (m *SimulateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +601,7 @@ func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
 func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
 func (*SimulateResponse) ProtoMessage()    {}
 func (*SimulateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{5}
+	return fileDescriptor_e0b00a618705eca7, []int{7}
 }
 func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +655,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +703,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,13 +755,18 @@ type GetBlockWithTxsRequest struct {
 	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	// pagination defines a pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// skip_decode_errors makes the txs which cannot be decoded be reported in
+	// the decode_errors of the response instead of failing the request.
+	//
+	// Since: cosmos-sdk 0.48
+	SkipDecodeErrors bool `protobuf:"varint,3,opt,name=skip_decode_errors,json=skipDecodeErrors,proto3" json:"skip_decode_errors,omitempty"`
 }
 
 func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{} }
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,6 +809,13 @@ func (m *GetBlockWithTxsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *GetBlockWithTxsRequest) GetSkipDecodeErrors() bool {
+	if m != nil {
+		return m.SkipDecodeErrors
+	}
+	return false
+}
+
 // GetBlockWithTxsResponse is the response type for the Service.GetBlockWithTxs
 // method.
 //
@@ -670,13 +827,18 @@ type GetBlockWithTxsResponse struct {
 	Block   *types1.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
 	// pagination defines a pagination for the response.
 	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// decode_errors are the errors of the txs of the requested page which could
+	// not be decoded, when skip_decode_errors is set.
+	//
+	// Since: cosmos-sdk 0.48
+	DecodeErrors []*TxDecodeError `protobuf:"bytes,5,rep,name=decode_errors,json=decodeErrors,proto3" json:"decode_errors,omitempty"`
 }
 
 func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse{} }
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,6 +895,70 @@ func (m *GetBlockWithTxsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *GetBlockWithTxsResponse) GetDecodeErrors() []*TxDecodeError {
+	if m != nil {
+		return m.DecodeErrors
+	}
+	return nil
+}
+
+// TxDecodeError is the error of a block tx which could not be decoded.
+//
+// Since: cosmos-sdk 0.48
+type TxDecodeError struct {
+	// index is the index of the tx in the block.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// error is the decoding error.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TxDecodeError) Reset()         { *m = TxDecodeError{} }
+func (m *TxDecodeError) String() string { return proto.CompactTextString(m) }
+func (*TxDecodeError) ProtoMessage()    {}
+func (*TxDecodeError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{12}
+}
+func (m *TxDecodeError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxDecodeError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxDecodeError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxDecodeError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxDecodeError.Merge(m, src)
+}
+func (m *TxDecodeError) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxDecodeError) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxDecodeError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxDecodeError proto.InternalMessageInfo
+
+func (m *TxDecodeError) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TxDecodeError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // TxDecodeRequest is the request type for the Service.TxDecode
 // RPC method.
 //
@@ -746,7 +972,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1021,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +1070,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +1119,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1167,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1215,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1263,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1311,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{20}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1121,10 +1347,136 @@ func (m *TxDecodeAminoResponse) GetAminoJson() string {
 	return ""
 }
 
+// SimulateParamsUpdateRequest is the request type for the
+// Service.SimulateParamsUpdate RPC method.
+type SimulateParamsUpdateRequest struct {
+	// msg is the MsgUpdateParams-style message to dry-run.
+	Msg *types2.Any `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// strict, when set, runs msg with its own authority so that a signer which
+	// isn't the module authority is refused. Otherwise the authority is
+	// replaced with the governance module account and only the params are
+	// validated.
+	Strict bool `protobuf:"varint,2,opt,name=strict,proto3" json:"strict,omitempty"`
+}
+
+func (m *SimulateParamsUpdateRequest) Reset()         { *m = SimulateParamsUpdateRequest{} }
+func (m *SimulateParamsUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateParamsUpdateRequest) ProtoMessage()    {}
+func (*SimulateParamsUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{21}
+}
+func (m *SimulateParamsUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateParamsUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateParamsUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateParamsUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateParamsUpdateRequest.Merge(m, src)
+}
+func (m *SimulateParamsUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateParamsUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateParamsUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateParamsUpdateRequest proto.InternalMessageInfo
+
+func (m *SimulateParamsUpdateRequest) GetMsg() *types2.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *SimulateParamsUpdateRequest) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+// SimulateParamsUpdateResponse is the response type for the
+// Service.SimulateParamsUpdate RPC method.
+type SimulateParamsUpdateResponse struct {
+	// valid is true if the message would have been executed successfully.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// error is the validation or execution error, if any.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_info is the information about gas used in the dry-run.
+	GasInfo *types.GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+}
+
+func (m *SimulateParamsUpdateResponse) Reset()         { *m = SimulateParamsUpdateResponse{} }
+func (m *SimulateParamsUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateParamsUpdateResponse) ProtoMessage()    {}
+func (*SimulateParamsUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{22}
+}
+func (m *SimulateParamsUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateParamsUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateParamsUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateParamsUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateParamsUpdateResponse.Merge(m, src)
+}
+func (m *SimulateParamsUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateParamsUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateParamsUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateParamsUpdateResponse proto.InternalMessageInfo
+
+func (m *SimulateParamsUpdateResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *SimulateParamsUpdateResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SimulateParamsUpdateResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
 	proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
+	proto.RegisterType((*TxEventQuery)(nil), "cosmos.tx.v1beta1.TxEventQuery")
+	proto.RegisterType((*TxEventConditionGroup)(nil), "cosmos.tx.v1beta1.TxEventConditionGroup")
 	proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
 	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
@@ -1134,6 +1486,7 @@ func init() {
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
 	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
+	proto.RegisterType((*TxDecodeError)(nil), "cosmos.tx.v1beta1.TxDecodeError")
 	proto.RegisterType((*TxDecodeRequest)(nil), "cosmos.tx.v1beta1.TxDecodeRequest")
 	proto.RegisterType((*TxDecodeResponse)(nil), "cosmos.tx.v1beta1.TxDecodeResponse")
 	proto.RegisterType((*TxEncodeRequest)(nil), "cosmos.tx.v1beta1.TxEncodeRequest")
@@ -1142,90 +1495,111 @@ func init() {
 	proto.RegisterType((*TxEncodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxEncodeAminoResponse")
 	proto.RegisterType((*TxDecodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxDecodeAminoRequest")
 	proto.RegisterType((*TxDecodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxDecodeAminoResponse")
+	proto.RegisterType((*SimulateParamsUpdateRequest)(nil), "cosmos.tx.v1beta1.SimulateParamsUpdateRequest")
+	proto.RegisterType((*SimulateParamsUpdateResponse)(nil), "cosmos.tx.v1beta1.SimulateParamsUpdateResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x18, 0x66, 0x6d, 0x83, 0xcd, 0x6b, 0x08, 0xce, 0x40, 0xc0, 0x38, 0xc4, 0x98, 0x0d, 0x1f, 0x8e,
-	0x55, 0xbc, 0x0a, 0x4d, 0xaa, 0x24, 0xaa, 0x54, 0xe1, 0x8f, 0x50, 0x92, 0x26, 0x44, 0x6b, 0xaa,
-	0x28, 0x55, 0x25, 0x6b, 0x6d, 0x4f, 0xec, 0x6d, 0xec, 0x5d, 0xe3, 0x19, 0xd0, 0x5a, 0x14, 0xb5,
-	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x0f, 0xfd, 0x0f, 0xfd, 0x25, 0x3d, 0x46, 0xea, 0xa5, 0xc7, 0x0a,
-	0x7a, 0xea, 0xa9, 0x52, 0xff, 0x40, 0xb5, 0x33, 0xb3, 0xb6, 0xd7, 0xde, 0xc5, 0x90, 0x0b, 0xcc,
-	0xc7, 0xf3, 0xbe, 0xcf, 0x33, 0xef, 0xcc, 0x3c, 0x3b, 0x86, 0xd5, 0xaa, 0x49, 0x5a, 0x26, 0x51,
-	0xa8, 0xa5, 0x9c, 0xdc, 0xaf, 0x60, 0xaa, 0xdd, 0x57, 0x08, 0xee, 0x9c, 0xe8, 0x55, 0x9c, 0x6d,
-	0x77, 0x4c, 0x6a, 0xa2, 0x9b, 0x1c, 0x90, 0xa5, 0x56, 0x56, 0x00, 0x12, 0x2b, 0x75, 0xd3, 0xac,
-	0x37, 0xb1, 0xa2, 0xb5, 0x75, 0x45, 0x33, 0x0c, 0x93, 0x6a, 0x54, 0x37, 0x0d, 0xc2, 0x03, 0x12,
-	0x77, 0x45, 0xc6, 0x8a, 0x46, 0xb0, 0xa2, 0x55, 0xaa, 0x7a, 0x2f, 0xb1, 0xdd, 0x11, 0xa0, 0xc4,
-	0x28, 0x2d, 0xb5, 0xc4, 0x5c, 0x66, 0x30, 0xc1, 0xd1, 0x31, 0xee, 0x74, 0x7b, 0x98, 0xb6, 0x56,
-	0xd7, 0x0d, 0xc6, 0x26, 0xb0, 0x2b, 0x14, 0x1b, 0x35, 0xdc, 0x69, 0xe9, 0x06, 0x55, 0x68, 0xb7,
-	0x8d, 0x89, 0x52, 0x69, 0x9a, 0xd5, 0x77, 0xbe, 0xb3, 0xec, 0x2f, 0x9f, 0x95, 0xff, 0x93, 0x00,
-	0xed, 0x61, 0x7a, 0x68, 0x91, 0xe2, 0x09, 0x36, 0xa8, 0x8a, 0x8f, 0x8e, 0x31, 0xa1, 0x28, 0x01,
-	0x53, 0xd8, 0xee, 0x93, 0xb8, 0x94, 0x0a, 0xa6, 0xa7, 0x73, 0x81, 0xb8, 0xa4, 0x8a, 0x11, 0xf4,
-	0x0c, 0xa0, 0x2f, 0x21, 0x1e, 0x48, 0x49, 0xe9, 0xe8, 0xce, 0x66, 0x56, 0x54, 0xc8, 0xd6, 0x9b,
-	0x65, 0x7a, 0x9d, 0x4a, 0x65, 0x5f, 0x69, 0x75, 0x2c, 0xf2, 0xb2, 0x3c, 0x03, 0xd1, 0xe8, 0x21,
-	0x44, 0xcc, 0x4e, 0x0d, 0x77, 0xca, 0x95, 0x6e, 0x3c, 0x98, 0x92, 0xd2, 0x37, 0x76, 0x12, 0xd9,
-	0x91, 0x5a, 0x67, 0x0f, 0x6c, 0x48, 0xae, 0xab, 0x86, 0x4d, 0xde, 0x40, 0x08, 0x42, 0x6d, 0xad,
-	0x8e, 0xe3, 0xa1, 0x94, 0x94, 0x0e, 0xa9, 0xac, 0x8d, 0x16, 0x60, 0xb2, 0xa9, 0xb7, 0x74, 0x1a,
-	0x9f, 0x64, 0x83, 0xbc, 0x63, 0x8f, 0x32, 0x35, 0xf1, 0xa9, 0x94, 0x94, 0x9e, 0x56, 0x79, 0x47,
-	0xfe, 0x47, 0x82, 0x79, 0xd7, 0xaa, 0x49, 0xdb, 0x34, 0x08, 0x46, 0x5b, 0x10, 0xa4, 0x16, 0x5f,
-	0x73, 0x74, 0xe7, 0x96, 0x87, 0x92, 0x43, 0x4b, 0xb5, 0x11, 0x68, 0x0f, 0x66, 0xa8, 0x55, 0xee,
-	0x88, 0x38, 0x12, 0x0f, 0xb0, 0x88, 0x75, 0x57, 0x15, 0xd8, 0x4e, 0x0f, 0x04, 0x0a, 0xb0, 0x1a,
-	0xa5, 0xbd, 0x36, 0x41, 0xcf, 0x5d, 0xc5, 0x0c, 0xb2, 0x62, 0x6e, 0x8d, 0x2d, 0x26, 0x8f, 0x1e,
-	0xa9, 0xe6, 0x02, 0x4c, 0x52, 0x93, 0x6a, 0x4d, 0x51, 0x17, 0xde, 0x91, 0x31, 0xa0, 0x5c, 0xc7,
-	0xd4, 0x6a, 0x55, 0x8d, 0x50, 0x5b, 0x06, 0xdf, 0xe1, 0x65, 0x88, 0x50, 0xab, 0x5c, 0xe9, 0x52,
-	0x6c, 0xaf, 0x57, 0x4a, 0xcf, 0xa8, 0x61, 0x6a, 0xe5, 0xec, 0x2e, 0x7a, 0x00, 0xa1, 0x96, 0x59,
-	0xc3, 0x6c, 0x6b, 0x6f, 0xec, 0xa4, 0x3c, 0xca, 0xd0, 0xcb, 0xf7, 0xc2, 0xac, 0x61, 0x95, 0xa1,
-	0xe5, 0xaf, 0x61, 0xde, 0x45, 0x23, 0x4a, 0x5a, 0x84, 0xe8, 0x40, 0xa5, 0x18, 0xd5, 0x55, 0x0b,
-	0x05, 0xfd, 0x42, 0xc9, 0xaf, 0x61, 0xae, 0xa4, 0xb7, 0x8e, 0x9b, 0x1a, 0x75, 0xce, 0x12, 0xba,
-	0x07, 0x01, 0x6a, 0x89, 0x84, 0xde, 0x7b, 0xc5, 0x0a, 0x14, 0xa0, 0x96, 0x6b, 0xb1, 0x01, 0xd7,
-	0x62, 0xe5, 0x1f, 0x25, 0x88, 0xf5, 0x33, 0x0b, 0xd1, 0x9f, 0x42, 0xa4, 0xae, 0x91, 0xb2, 0x6e,
-	0xbc, 0x35, 0x05, 0xc1, 0x9a, 0xbf, 0xe2, 0x3d, 0x8d, 0xec, 0x1b, 0x6f, 0x4d, 0x35, 0x5c, 0xe7,
-	0x0d, 0xf4, 0x08, 0xa6, 0x3a, 0x98, 0x1c, 0x37, 0xa9, 0xb8, 0x1c, 0x29, 0xff, 0x58, 0x95, 0xe1,
-	0x54, 0x81, 0x97, 0x65, 0x98, 0x61, 0xc7, 0xd2, 0x59, 0x22, 0x82, 0x50, 0x43, 0x23, 0x0d, 0xa6,
-	0x61, 0x5a, 0x65, 0x6d, 0xf9, 0x0c, 0x66, 0x05, 0x46, 0x88, 0xdd, 0x18, 0x5b, 0x07, 0x56, 0x83,
-	0xa1, 0x8d, 0x08, 0x7c, 0xe0, 0x46, 0x58, 0xb0, 0xb8, 0x87, 0x69, 0xce, 0x36, 0x98, 0xd7, 0x3a,
-	0x6d, 0x1c, 0x5a, 0xc4, 0x11, 0xbb, 0x08, 0x53, 0x0d, 0xac, 0xd7, 0x1b, 0x94, 0x69, 0x09, 0xaa,
-	0xa2, 0x87, 0x9e, 0x7e, 0xb8, 0x5f, 0x0c, 0x9e, 0x6e, 0xf9, 0x5f, 0x09, 0x96, 0x46, 0xa8, 0xaf,
-	0x7b, 0x71, 0x1f, 0x40, 0x84, 0x99, 0x63, 0x59, 0xaf, 0x09, 0x29, 0xcb, 0xd9, 0xbe, 0x41, 0x66,
-	0xb9, 0x35, 0x32, 0x8a, 0xfd, 0x82, 0x1a, 0x66, 0xd0, 0xfd, 0x1a, 0xda, 0x86, 0x49, 0xd6, 0x14,
-	0x17, 0x74, 0xc9, 0x27, 0x44, 0xe5, 0x28, 0xb4, 0xe7, 0x5a, 0x71, 0xe8, 0x5a, 0x97, 0xda, 0xb5,
-	0xe4, 0x8f, 0x60, 0xee, 0xd0, 0x2a, 0xe0, 0xaa, 0x7d, 0xcb, 0xc6, 0xde, 0x5b, 0xf9, 0x31, 0xc4,
-	0xfa, 0xe8, 0x6b, 0x1d, 0x0e, 0xf9, 0x91, 0x4d, 0x54, 0x34, 0x06, 0x89, 0xae, 0x18, 0xb9, 0x6d,
-	0x93, 0x3a, 0x91, 0x82, 0xf4, 0x12, 0x8d, 0x0f, 0x61, 0xc1, 0x81, 0xef, 0xb6, 0x74, 0xc3, 0x74,
-	0xd8, 0xee, 0x00, 0x68, 0x76, 0xbf, 0xfc, 0x0d, 0x31, 0x0d, 0x71, 0xde, 0xa7, 0xd9, 0xc8, 0x33,
-	0x62, 0x1a, 0xf2, 0x13, 0xb8, 0x35, 0x14, 0x26, 0xa8, 0xd6, 0x60, 0x86, 0xc7, 0x55, 0x74, 0x43,
-	0xeb, 0x74, 0x05, 0x5d, 0x94, 0x8d, 0xe5, 0xd8, 0x90, 0xfc, 0xd8, 0xa6, 0xe4, 0x65, 0x71, 0x51,
-	0x5e, 0x21, 0xf4, 0x13, 0x9b, 0xd6, 0x15, 0x2a, 0x68, 0x2f, 0x97, 0x9b, 0xf9, 0x1c, 0xc2, 0xe2,
-	0x9b, 0x85, 0xe2, 0xb0, 0x70, 0xa0, 0x16, 0x8a, 0x6a, 0x39, 0xf7, 0xa6, 0xfc, 0xe5, 0xcb, 0xd2,
-	0xab, 0x62, 0x7e, 0xff, 0xe9, 0x7e, 0xb1, 0x10, 0x9b, 0x40, 0x31, 0x98, 0xe9, 0xcd, 0xec, 0x96,
-	0xf2, 0x31, 0x09, 0xdd, 0x84, 0xd9, 0xde, 0x48, 0xa1, 0x58, 0xca, 0xc7, 0x02, 0x99, 0xef, 0x25,
-	0x98, 0x75, 0xb9, 0x2d, 0x4a, 0x42, 0x22, 0xa7, 0x1e, 0xec, 0x16, 0xf2, 0xbb, 0xa5, 0xc3, 0xf2,
-	0x8b, 0x83, 0x42, 0x71, 0x28, 0xed, 0x0a, 0x2c, 0x0c, 0xcd, 0xe7, 0xbe, 0x38, 0xc8, 0x3f, 0x8f,
-	0x49, 0x89, 0x40, 0x44, 0x42, 0x4b, 0x30, 0x3f, 0x34, 0x5b, 0x7a, 0xf3, 0x32, 0x1f, 0x0b, 0xd8,
-	0x3a, 0x87, 0x26, 0x76, 0xd9, 0x4c, 0x70, 0xe7, 0xb7, 0x69, 0x08, 0x97, 0xf8, 0x73, 0x08, 0x9d,
-	0x42, 0xc4, 0x31, 0x4b, 0x24, 0x7b, 0x1c, 0x8a, 0x21, 0x8f, 0x4e, 0xdc, 0xbd, 0x14, 0x23, 0x2c,
-	0x65, 0xf3, 0x87, 0x3f, 0xfe, 0xfe, 0x25, 0x90, 0x7a, 0x22, 0x65, 0xe4, 0xdb, 0x8a, 0xc7, 0x53,
-	0xcc, 0x21, 0x3c, 0x82, 0x49, 0xe6, 0x7c, 0x68, 0xd5, 0x23, 0xeb, 0xa0, 0x6f, 0x26, 0x52, 0xfe,
-	0x00, 0xc1, 0xb9, 0xc1, 0x38, 0x57, 0xd1, 0x1d, 0xc5, 0xeb, 0x11, 0x46, 0x94, 0x53, 0xdb, 0x6b,
-	0xcf, 0xd0, 0x77, 0x10, 0x1d, 0xf8, 0xa8, 0xa1, 0x8d, 0xcb, 0xbe, 0x85, 0x7d, 0xfa, 0xcd, 0x71,
-	0x30, 0x21, 0x62, 0x8d, 0x89, 0xb8, 0x6d, 0x2f, 0x7c, 0xd1, 0x5b, 0x07, 0xfa, 0x16, 0xa2, 0x03,
-	0x0f, 0x15, 0x4f, 0x01, 0xa3, 0xcf, 0x37, 0x4f, 0x01, 0x1e, 0xef, 0x1d, 0x39, 0xc9, 0x04, 0xc4,
-	0x91, 0x1f, 0xfb, 0xaf, 0x12, 0xcc, 0x0d, 0x59, 0x2e, 0xba, 0xe7, 0x9d, 0xdb, 0xe3, 0x8b, 0x90,
-	0xc8, 0x5c, 0x05, 0x2a, 0xa4, 0x6c, 0x33, 0x29, 0x5b, 0x68, 0xc3, 0x67, 0x43, 0x98, 0xb3, 0x2a,
-	0xa7, 0xfc, 0x9b, 0x72, 0x86, 0xba, 0x10, 0x71, 0x6e, 0xa6, 0xe7, 0x41, 0x1c, 0xb2, 0x4d, 0xcf,
-	0x83, 0x38, 0x6c, 0x96, 0xf2, 0x3a, 0xd3, 0x90, 0xb4, 0xf7, 0x63, 0xd9, 0x43, 0x46, 0x8d, 0xd3,
-	0x31, 0x6a, 0xee, 0x45, 0x3e, 0xd4, 0x2e, 0x23, 0xf5, 0xa1, 0x76, 0x5b, 0xe6, 0x38, 0x6a, 0xcc,
-	0xe9, 0x7e, 0x92, 0x60, 0xd6, 0xe5, 0x83, 0x68, 0xeb, 0x92, 0xe4, 0x83, 0x6e, 0x97, 0x48, 0x8f,
-	0x07, 0x0a, 0x29, 0x19, 0x26, 0x65, 0xdd, 0x96, 0xb2, 0xea, 0x2b, 0x45, 0x61, 0x66, 0x27, 0x04,
-	0x0d, 0x38, 0xa4, 0x8f, 0xa0, 0x51, 0xfb, 0xf5, 0x11, 0xe4, 0x61, 0xb6, 0xe3, 0x04, 0xf1, 0x6d,
-	0xe1, 0x82, 0x72, 0x9f, 0xfd, 0x7e, 0x9e, 0x94, 0xde, 0x9f, 0x27, 0xa5, 0xbf, 0xce, 0x93, 0xd2,
-	0xcf, 0x17, 0xc9, 0x89, 0xf7, 0x17, 0xc9, 0x89, 0x3f, 0x2f, 0x92, 0x13, 0x5f, 0x6d, 0xd4, 0x75,
-	0xda, 0x38, 0xae, 0x64, 0xab, 0x66, 0xcb, 0x49, 0xc2, 0xff, 0x6d, 0x93, 0xda, 0x3b, 0xe7, 0x97,
-	0x91, 0x55, 0x99, 0x62, 0xbf, 0x8b, 0x3e, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa8, 0xd7,
-	0x97, 0x14, 0x0e, 0x00, 0x00,
+	// 1552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x6f, 0x13, 0xc7,
+	0x16, 0xcf, 0xda, 0xf9, 0xe3, 0x1c, 0x27, 0x60, 0x06, 0x13, 0x1c, 0x27, 0x38, 0x66, 0x21, 0x89,
+	0xb1, 0xc8, 0x5a, 0xe4, 0xc2, 0xbd, 0x90, 0x7b, 0xa5, 0x4b, 0x9c, 0x98, 0x10, 0x28, 0x84, 0x4e,
+	0x82, 0x10, 0x55, 0xab, 0xd5, 0xda, 0x3b, 0x71, 0xb6, 0xc4, 0xbb, 0x66, 0x77, 0x1c, 0xd9, 0xa2,
+	0xa8, 0x15, 0x4f, 0x55, 0x1f, 0x50, 0xa5, 0x3e, 0xf4, 0x1b, 0xf4, 0xad, 0x8f, 0x55, 0xbf, 0x42,
+	0x1f, 0x91, 0xfa, 0x52, 0xf5, 0xa9, 0x82, 0x7e, 0x90, 0x6a, 0xcf, 0xce, 0xc6, 0xbb, 0xce, 0xda,
+	0x49, 0x78, 0x81, 0x3d, 0x73, 0xfe, 0xfc, 0x7e, 0x73, 0xe6, 0xcc, 0x39, 0xe3, 0xc0, 0x5c, 0xcd,
+	0x72, 0x1a, 0x96, 0x53, 0xe2, 0xed, 0xd2, 0xc1, 0x8d, 0x2a, 0xe3, 0xda, 0x8d, 0x92, 0xc3, 0xec,
+	0x03, 0xa3, 0xc6, 0x94, 0xa6, 0x6d, 0x71, 0x8b, 0x9c, 0xf3, 0x0c, 0x14, 0xde, 0x56, 0x84, 0x41,
+	0x76, 0xb6, 0x6e, 0x59, 0xf5, 0x7d, 0x56, 0xd2, 0x9a, 0x46, 0x49, 0x33, 0x4d, 0x8b, 0x6b, 0xdc,
+	0xb0, 0x4c, 0xc7, 0x73, 0xc8, 0x4e, 0x0b, 0x2d, 0x4a, 0xd5, 0xd6, 0x6e, 0x49, 0x33, 0x3b, 0x42,
+	0x75, 0x45, 0x80, 0x55, 0x35, 0x87, 0x95, 0xb4, 0x6a, 0xcd, 0x38, 0xc4, 0x74, 0x05, 0x61, 0x94,
+	0x3d, 0xca, 0x88, 0xb7, 0x85, 0xae, 0x18, 0x0c, 0xf0, 0xb2, 0xc5, 0xec, 0xce, 0xa1, 0x4d, 0x53,
+	0xab, 0x1b, 0x26, 0x12, 0x11, 0xb6, 0xb3, 0x9c, 0x99, 0x3a, 0xb3, 0x1b, 0x86, 0xc9, 0x4b, 0xbc,
+	0xd3, 0x64, 0x4e, 0xa9, 0xba, 0x6f, 0xd5, 0x5e, 0xf4, 0xd5, 0xe2, 0xbf, 0x9e, 0x56, 0xfe, 0x25,
+	0x06, 0x64, 0x83, 0xf1, 0x9d, 0xb6, 0x53, 0x39, 0x60, 0x26, 0xa7, 0xec, 0x65, 0x8b, 0x39, 0x9c,
+	0x64, 0x61, 0x94, 0xb9, 0xb2, 0x93, 0x91, 0xf2, 0xf1, 0xc2, 0x78, 0x39, 0x96, 0x91, 0xa8, 0x58,
+	0x21, 0x0f, 0x00, 0xba, 0x14, 0x32, 0xb1, 0xbc, 0x54, 0x48, 0x2e, 0x2f, 0x28, 0x22, 0x79, 0x2e,
+	0x5f, 0x05, 0xf9, 0xfa, 0x49, 0x54, 0x9e, 0x68, 0x75, 0x26, 0xe2, 0x62, 0x9c, 0x80, 0x37, 0xb9,
+	0x05, 0x09, 0xcb, 0xd6, 0x99, 0xad, 0x56, 0x3b, 0x99, 0x78, 0x5e, 0x2a, 0x9c, 0x59, 0xce, 0x2a,
+	0x47, 0x8e, 0x41, 0xd9, 0x72, 0x4d, 0xca, 0x1d, 0x3a, 0x66, 0x79, 0x1f, 0x84, 0xc0, 0x70, 0x53,
+	0xab, 0xb3, 0xcc, 0x70, 0x5e, 0x2a, 0x0c, 0x53, 0xfc, 0x26, 0x69, 0x18, 0xd9, 0x37, 0x1a, 0x06,
+	0xcf, 0x8c, 0xe0, 0xa2, 0x27, 0xb8, 0xab, 0xc8, 0x26, 0x33, 0x9a, 0x97, 0x0a, 0xe3, 0xd4, 0x13,
+	0xc8, 0x5d, 0x48, 0xe2, 0x66, 0x54, 0x4f, 0x37, 0x86, 0x7b, 0x98, 0x8b, 0x40, 0xde, 0x69, 0x63,
+	0x5a, 0x3e, 0x75, 0xcd, 0x28, 0xb0, 0xc3, 0x6f, 0xf9, 0xad, 0x04, 0x13, 0x41, 0x25, 0xb9, 0x0b,
+	0xa3, 0x75, 0xdb, 0x6a, 0x35, 0xbd, 0x8c, 0x25, 0x97, 0x0b, 0xfd, 0xa3, 0xad, 0x59, 0xa6, 0x6e,
+	0xb8, 0xdb, 0xdf, 0x70, 0x1d, 0xa8, 0xf0, 0x23, 0x73, 0x90, 0xdc, 0xb5, 0xad, 0x86, 0xba, 0xc7,
+	0x8c, 0xfa, 0x1e, 0xc7, 0xc4, 0xc6, 0x29, 0xb8, 0x4b, 0xf7, 0x71, 0x85, 0xcc, 0xc0, 0x38, 0xb7,
+	0x7c, 0x75, 0x1c, 0xd5, 0x09, 0x6e, 0x79, 0x4a, 0xf9, 0x3f, 0x70, 0x21, 0x32, 0x3c, 0xc9, 0x01,
+	0xd4, 0xfc, 0x15, 0x71, 0x9c, 0x34, 0xb0, 0x22, 0xbf, 0x89, 0xc1, 0xf9, 0x50, 0x05, 0x38, 0x4d,
+	0xcb, 0x74, 0x18, 0x59, 0x84, 0x38, 0x6f, 0xfb, 0xbb, 0xb9, 0x10, 0xb9, 0x1b, 0xea, 0x5a, 0x90,
+	0x0d, 0x98, 0xe0, 0x6d, 0xd5, 0x16, 0x7e, 0x4e, 0x26, 0x86, 0x1e, 0x57, 0x43, 0x15, 0x81, 0x55,
+	0x1f, 0x70, 0x14, 0xc6, 0x34, 0xc9, 0x0f, 0xbf, 0x1d, 0xf2, 0x30, 0x54, 0x58, 0x71, 0x3c, 0x94,
+	0xc5, 0x63, 0x0b, 0xcb, 0xf3, 0x3e, 0x52, 0x59, 0x69, 0x18, 0xe1, 0x16, 0xd7, 0xf6, 0x45, 0x8d,
+	0x78, 0x02, 0x99, 0x82, 0xd1, 0x06, 0xb3, 0xeb, 0x4c, 0xc7, 0x2a, 0x49, 0x50, 0x21, 0xc9, 0x0c,
+	0x48, 0xd9, 0xb6, 0x34, 0xbd, 0xa6, 0x39, 0xdc, 0xa5, 0xe7, 0xdd, 0x82, 0x69, 0x48, 0xf0, 0xb6,
+	0x5a, 0xed, 0x70, 0xe6, 0xe6, 0x41, 0x2a, 0x4c, 0xd0, 0x31, 0xde, 0x2e, 0xbb, 0x22, 0xb9, 0x09,
+	0xc3, 0x0d, 0x4b, 0x67, 0x78, 0x4a, 0x67, 0x96, 0xf3, 0x11, 0xe9, 0x39, 0x8c, 0xf7, 0xc8, 0xd2,
+	0x19, 0x45, 0x6b, 0xf9, 0x73, 0x38, 0x1f, 0x82, 0x11, 0xa9, 0xae, 0x40, 0x32, 0x90, 0x41, 0x84,
+	0x3a, 0x69, 0x02, 0xa1, 0x9b, 0x40, 0xf9, 0x19, 0x9c, 0xdd, 0x36, 0x1a, 0xad, 0x7d, 0x8d, 0xfb,
+	0xf7, 0x8d, 0x5c, 0x83, 0x18, 0x6f, 0x8b, 0x80, 0xd1, 0x67, 0x88, 0x89, 0x8b, 0xf1, 0x76, 0x68,
+	0xb3, 0xb1, 0xd0, 0x66, 0xe5, 0xef, 0x24, 0x48, 0x75, 0x23, 0x0b, 0xd2, 0xff, 0x83, 0x44, 0x5d,
+	0x73, 0x54, 0xc3, 0xdc, 0xb5, 0x04, 0xc0, 0xe5, 0xfe, 0x8c, 0x37, 0x34, 0x67, 0xd3, 0xdc, 0xb5,
+	0xe8, 0x58, 0xdd, 0xfb, 0x20, 0xb7, 0x61, 0xd4, 0x66, 0x4e, 0x6b, 0x9f, 0x8b, 0x06, 0x92, 0xef,
+	0xef, 0x4b, 0xd1, 0x8e, 0x0a, 0x7b, 0x59, 0x86, 0x09, 0x2c, 0x57, 0x7f, 0x8b, 0x04, 0x86, 0xf7,
+	0x34, 0x67, 0x0f, 0x39, 0x8c, 0x53, 0xfc, 0x96, 0x5f, 0xc3, 0xa4, 0xb0, 0x11, 0x64, 0xe7, 0x8f,
+	0xcd, 0x03, 0xe6, 0xa0, 0xe7, 0x20, 0x62, 0x1f, 0x79, 0x10, 0x3f, 0x49, 0x30, 0xb5, 0xc1, 0x78,
+	0xd9, 0xed, 0xc2, 0xcf, 0x0c, 0xbe, 0xb7, 0xd3, 0x76, 0x7c, 0xb6, 0x53, 0x30, 0x2a, 0x2e, 0xb0,
+	0x84, 0x17, 0x58, 0x48, 0xe4, 0xde, 0xc7, 0x37, 0xd5, 0x50, 0xd9, 0x5f, 0x07, 0xe2, 0xbc, 0x30,
+	0x9a, 0xaa, 0xce, 0x6a, 0x96, 0xce, 0x54, 0x66, 0xdb, 0x96, 0xed, 0xe0, 0x5d, 0x4a, 0xd0, 0x94,
+	0xab, 0x59, 0x47, 0x45, 0x05, 0xd7, 0xe5, 0x5f, 0x63, 0x70, 0xf1, 0x08, 0xd1, 0xd3, 0xde, 0xff,
+	0x9b, 0x90, 0xc0, 0x79, 0xa3, 0x1a, 0xba, 0x20, 0x3e, 0xad, 0x74, 0x67, 0x8e, 0xe2, 0x4d, 0x1b,
+	0x84, 0xd8, 0x5c, 0xa7, 0x63, 0x68, 0xba, 0xa9, 0x93, 0x25, 0x18, 0xc1, 0x4f, 0x71, 0xcf, 0x2f,
+	0xf6, 0x71, 0xa1, 0x9e, 0x15, 0xd9, 0x08, 0xe5, 0x67, 0xf8, 0x54, 0xbd, 0x21, 0x94, 0xa0, 0x0a,
+	0x4c, 0x86, 0x73, 0x33, 0x82, 0x1b, 0xcc, 0x47, 0x6e, 0x30, 0x90, 0x2c, 0x3a, 0xa1, 0x07, 0x33,
+	0xf7, 0x5f, 0x98, 0x0c, 0xa9, 0xdd, 0x7e, 0x63, 0x98, 0x3a, 0xf3, 0x8a, 0x6c, 0x98, 0x7a, 0x82,
+	0xbb, 0x8a, 0x30, 0x98, 0x98, 0x71, 0xea, 0x09, 0xf2, 0x75, 0x38, 0xeb, 0x3b, 0x1f, 0xdf, 0x6a,
+	0xe4, 0x3b, 0x90, 0xea, 0x5a, 0x9f, 0xaa, 0x9e, 0xe5, 0xdb, 0x2e, 0x50, 0xc5, 0x0c, 0x02, 0x9d,
+	0xd0, 0x73, 0xc9, 0x05, 0xf5, 0x3d, 0x05, 0xe8, 0x00, 0x8e, 0xb7, 0x20, 0xed, 0x9b, 0xaf, 0x36,
+	0x0c, 0xd3, 0xf2, 0xd1, 0x2e, 0x01, 0x68, 0xae, 0xac, 0x7e, 0xe9, 0x58, 0xa6, 0xb8, 0xa2, 0xe3,
+	0xb8, 0xf2, 0xc0, 0xb1, 0x4c, 0x79, 0x05, 0x87, 0x56, 0xd0, 0x4d, 0x40, 0x5d, 0x86, 0x09, 0xcf,
+	0xaf, 0x6a, 0x98, 0x9a, 0xdd, 0x11, 0x70, 0x49, 0x5c, 0x2b, 0xe3, 0x92, 0x7c, 0xc7, 0x85, 0xf4,
+	0xd2, 0x12, 0x82, 0x3c, 0x81, 0xeb, 0xbf, 0x5d, 0xd8, 0x90, 0xab, 0x80, 0x3d, 0x86, 0xee, 0x17,
+	0x30, 0xe3, 0xb7, 0xc1, 0x27, 0x9a, 0xad, 0x35, 0x9c, 0xa7, 0x4d, 0x3d, 0xd0, 0x6c, 0x17, 0x20,
+	0xde, 0x70, 0xea, 0x22, 0xb7, 0x69, 0xc5, 0x7b, 0x1d, 0x2a, 0xfe, 0xeb, 0x50, 0x59, 0x35, 0x3b,
+	0xd4, 0x35, 0x70, 0x7b, 0x80, 0xc3, 0x6d, 0xa3, 0xe6, 0xf5, 0xbe, 0x04, 0x15, 0x92, 0xfc, 0xad,
+	0x04, 0xb3, 0xd1, 0xf1, 0x05, 0xbd, 0x34, 0x8c, 0x1c, 0x68, 0xfb, 0x86, 0x8e, 0x10, 0x09, 0xea,
+	0x09, 0xd1, 0x35, 0x16, 0x6a, 0xcf, 0xf1, 0xd3, 0xb6, 0xe7, 0xe2, 0x7d, 0x18, 0x13, 0x8f, 0x2e,
+	0x92, 0x81, 0xf4, 0x16, 0x5d, 0xaf, 0x50, 0xb5, 0xfc, 0x5c, 0x7d, 0xfa, 0x78, 0xfb, 0x49, 0x65,
+	0x6d, 0xf3, 0xde, 0x66, 0x65, 0x3d, 0x35, 0x44, 0x52, 0x30, 0x71, 0xa8, 0x59, 0xdd, 0x5e, 0x4b,
+	0x49, 0xe4, 0x1c, 0x4c, 0x1e, 0xae, 0xac, 0x57, 0xb6, 0xd7, 0x52, 0xb1, 0xe2, 0x37, 0x12, 0x4c,
+	0x86, 0x46, 0x21, 0xc9, 0x41, 0xb6, 0x4c, 0xb7, 0x56, 0xd7, 0xd7, 0x56, 0xb7, 0x77, 0xd4, 0x47,
+	0x5b, 0xeb, 0x95, 0x9e, 0xb0, 0xb3, 0x90, 0xee, 0xd1, 0x97, 0x3f, 0xd9, 0x5a, 0x7b, 0x98, 0x92,
+	0xb2, 0xb1, 0x84, 0x44, 0x2e, 0xc2, 0xf9, 0x1e, 0xed, 0xf6, 0xf3, 0xc7, 0x6b, 0xa9, 0x98, 0xcb,
+	0xb3, 0x47, 0xb1, 0x8a, 0x9a, 0xf8, 0xf2, 0x9f, 0x00, 0x63, 0xdb, 0xde, 0x53, 0x9f, 0xbc, 0x82,
+	0x84, 0x9f, 0x62, 0x22, 0x47, 0x94, 0x7f, 0xcf, 0x00, 0xcd, 0x5e, 0x19, 0x68, 0x23, 0xfa, 0xfd,
+	0xc2, 0x9b, 0xdf, 0xff, 0xfe, 0x21, 0x96, 0x97, 0x67, 0x4a, 0x11, 0xbf, 0x31, 0x84, 0xf1, 0x8a,
+	0x54, 0x24, 0x2f, 0x61, 0x04, 0xc7, 0x12, 0x89, 0x7a, 0x6a, 0x06, 0x87, 0x5a, 0x36, 0xdf, 0xdf,
+	0x40, 0x60, 0xce, 0x23, 0xe6, 0x1c, 0xb9, 0x54, 0x8a, 0xfa, 0x15, 0xe1, 0x94, 0x5e, 0xb9, 0x83,
+	0xf0, 0x35, 0xf9, 0x1a, 0x92, 0x81, 0x17, 0x07, 0x99, 0x1f, 0xf4, 0x50, 0xe9, 0xc2, 0x2f, 0x1c,
+	0x67, 0x26, 0x48, 0x5c, 0x46, 0x12, 0x33, 0x2b, 0x52, 0x51, 0x9e, 0x8a, 0xe6, 0x41, 0xbe, 0x82,
+	0x64, 0xe0, 0x75, 0x19, 0x49, 0xe0, 0xe8, 0xef, 0x8f, 0x48, 0x02, 0x11, 0x8f, 0x54, 0x39, 0x87,
+	0x04, 0x32, 0xa4, 0x1f, 0xfa, 0x8f, 0x12, 0x9c, 0xed, 0x19, 0x70, 0xe4, 0x5a, 0x74, 0xec, 0x88,
+	0x69, 0x9d, 0x2d, 0x9e, 0xc4, 0x54, 0x50, 0x59, 0x42, 0x2a, 0x8b, 0x64, 0xbe, 0xcf, 0x81, 0xe0,
+	0x1c, 0x2b, 0xbd, 0xf2, 0xe6, 0xfd, 0x6b, 0xd2, 0x81, 0x84, 0xdf, 0x83, 0x22, 0x0b, 0xb1, 0x67,
+	0x40, 0x44, 0x16, 0x62, 0xef, 0x58, 0x90, 0xaf, 0x22, 0x87, 0x9c, 0x3c, 0x1d, 0xc1, 0xc1, 0x1b,
+	0x5f, 0x6e, 0x19, 0x22, 0xb4, 0xd7, 0x75, 0xfb, 0x40, 0x87, 0x46, 0x46, 0x1f, 0xe8, 0xf0, 0x70,
+	0x18, 0x08, 0xcd, 0x4c, 0x1f, 0xfa, 0xad, 0xe4, 0xce, 0xcd, 0x40, 0xc7, 0x27, 0x8b, 0x03, 0x82,
+	0x07, 0xfb, 0x7a, 0xb6, 0x70, 0xbc, 0xa1, 0xa0, 0x52, 0x44, 0x2a, 0x57, 0xe5, 0xb9, 0xbe, 0x54,
+	0x4a, 0xd8, 0xd3, 0xbb, 0x84, 0x02, 0xb3, 0xa0, 0x0f, 0xa1, 0xa3, 0x83, 0xa6, 0x0f, 0xa1, 0x88,
+	0xb1, 0x32, 0x90, 0x90, 0x77, 0x2c, 0x5d, 0x42, 0x3f, 0x4b, 0x90, 0x8e, 0x1a, 0x02, 0x44, 0x19,
+	0xd0, 0x89, 0x22, 0xa6, 0x51, 0xb6, 0x74, 0x62, 0x7b, 0xc1, 0xf2, 0x26, 0xb2, 0x54, 0xe4, 0x6b,
+	0x03, 0xba, 0x98, 0xda, 0x44, 0x4f, 0xb5, 0x85, 0xae, 0x2b, 0x52, 0xb1, 0xfc, 0xff, 0xdf, 0xde,
+	0xe7, 0xa4, 0x77, 0xef, 0x73, 0xd2, 0x5f, 0xef, 0x73, 0xd2, 0xf7, 0x1f, 0x72, 0x43, 0xef, 0x3e,
+	0xe4, 0x86, 0xfe, 0xf8, 0x90, 0x1b, 0xfa, 0x6c, 0xbe, 0x6e, 0xf0, 0xbd, 0x56, 0x55, 0xa9, 0x59,
+	0x0d, 0x3f, 0xa2, 0xf7, 0xdf, 0x92, 0xa3, 0xbf, 0xf0, 0xff, 0x14, 0xd1, 0xae, 0x8e, 0xe2, 0x80,
+	0xfc, 0xd7, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x6f, 0x2f, 0xef, 0xa0, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1268,6 +1642,9 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// SimulateParamsUpdate dry-runs a MsgUpdateParams-style message against the
+	// state at the current height without persisting any change.
+	SimulateParamsUpdate(ctx context.Context, in *SimulateParamsUpdateRequest, opts ...grpc.CallOption) (*SimulateParamsUpdateResponse, error)
 }
 
 type serviceClient struct {
@@ -1359,6 +1736,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) SimulateParamsUpdate(ctx context.Context, in *SimulateParamsUpdateRequest, opts ...grpc.CallOption) (*SimulateParamsUpdateResponse, error) {
+	out := new(SimulateParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/SimulateParamsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -1389,6 +1775,9 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// SimulateParamsUpdate dry-runs a MsgUpdateParams-style message against the
+	// state at the current height without persisting any change.
+	SimulateParamsUpdate(context.Context, *SimulateParamsUpdateRequest) (*SimulateParamsUpdateResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1422,6 +1811,9 @@ func (*UnimplementedServiceServer) TxEncodeAmino(ctx context.Context, req *TxEnc
 func (*UnimplementedServiceServer) TxDecodeAmino(ctx context.Context, req *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (*UnimplementedServiceServer) SimulateParamsUpdate(ctx context.Context, req *SimulateParamsUpdateRequest) (*SimulateParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateParamsUpdate not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1589,6 +1981,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateParamsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/SimulateParamsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateParamsUpdate(ctx, req.(*SimulateParamsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1629,6 +2039,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "SimulateParamsUpdate",
+			Handler:    _Service_SimulateParamsUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -1654,6 +2068,18 @@ func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EventQuery != nil {
+		{
+			size, err := m.EventQuery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Query) > 0 {
 		i -= len(m.Query)
 		copy(dAtA[i:], m.Query)
@@ -1700,7 +2126,7 @@ func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxsEventResponse) Marshal() (dAtA []byte, err error) {
+func (m *TxEventQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1710,23 +2136,112 @@ func (m *GetTxsEventResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxsEventResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxEventQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxsEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxEventQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Total != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Total))
+	if m.ToHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ToHeight))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 	}
-	if m.Pagination != nil {
-		{
+	if m.FromHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxEventConditionGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxEventConditionGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxEventConditionGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Conditions[iNdEx])
+			copy(dAtA[i:], m.Conditions[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Conditions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Merged {
+		i--
+		if m.Merged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Total != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -2024,6 +2539,16 @@ func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.SkipDecodeErrors {
+		i--
+		if m.SkipDecodeErrors {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2064,6 +2589,20 @@ func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecodeErrors) > 0 {
+		for iNdEx := len(m.DecodeErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodeErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2117,6 +2656,41 @@ func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *TxDecodeError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxDecodeError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxDecodeError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxDecodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2367,6 +2941,103 @@ func (m *TxDecodeAminoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SimulateParamsUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateParamsUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateParamsUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateParamsUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateParamsUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateParamsUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -2407,6 +3078,46 @@ func (m *GetTxsEventRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.EventQuery != nil {
+		l = m.EventQuery.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *TxEventQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovService(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovService(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *TxEventConditionGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, s := range m.Conditions {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2435,6 +3146,9 @@ func (m *GetTxsEventResponse) Size() (n int) {
 	if m.Total != 0 {
 		n += 1 + sovService(uint64(m.Total))
 	}
+	if m.Merged {
+		n += 2
+	}
 	return n
 }
 
@@ -2544,6 +3258,9 @@ func (m *GetBlockWithTxsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.SkipDecodeErrors {
+		n += 2
+	}
 	return n
 }
 
@@ -2571,6 +3288,28 @@ func (m *GetBlockWithTxsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.DecodeErrors) > 0 {
+		for _, e := range m.DecodeErrors {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxDecodeError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovService(uint64(m.Index))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	return n
 }
 
@@ -2678,19 +3417,55 @@ func (m *TxDecodeAminoResponse) Size() (n int) {
 	return n
 }
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SimulateParamsUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Strict {
+		n += 2
+	}
+	return n
 }
-func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *SimulateParamsUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowService
 			}
@@ -2870,6 +3645,246 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventQuery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventQuery == nil {
+				m.EventQuery = &TxEventQuery{}
+			}
+			if err := m.EventQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxEventQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEventQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEventQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &TxEventConditionGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxEventConditionGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEventConditionGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEventConditionGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -3043,6 +4058,26 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merged = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -3783,6 +4818,26 @@ func (m *GetBlockWithTxsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipDecodeErrors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipDecodeErrors = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -3938,12 +4993,151 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodeErrors = append(m.DecodeErrors, &TxDecodeError{})
+			if err := m.DecodeErrors[len(m.DecodeErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxDecodeError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxDecodeError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxDecodeError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3953,27 +5147,23 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4668,6 +5858,250 @@ func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateParamsUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateParamsUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateParamsUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types2.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Strict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateParamsUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateParamsUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateParamsUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
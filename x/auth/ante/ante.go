@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -17,10 +18,18 @@ type HandlerOptions struct {
 	AccountKeeper          AccountKeeper
 	BankKeeper             types.BankKeeper
 	ExtensionOptionChecker ExtensionOptionChecker
-	FeegrantKeeper         FeegrantKeeper
-	SignModeHandler        *txsigning.HandlerMap
-	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
-	TxFeeChecker           TxFeeChecker
+	// ExtensionOptionHandlers are the handlers of the extension options
+	// accepted by the chain. Optional, see NewExtensionOptionsDecoratorWithHandlers.
+	ExtensionOptionHandlers *ExtensionOptionHandlers
+	FeegrantKeeper          FeegrantKeeper
+	SignModeHandler         *txsigning.HandlerMap
+	SigGasConsumer          func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
+	TxFeeChecker            TxFeeChecker
+	// Mempool is the application side mempool, and MaxTxsPerSender the
+	// maximum number of txs of a sender it can hold. Optional, see
+	// NewMempoolSenderLimitDecorator.
+	Mempool         mempool.Mempool
+	MaxTxsPerSender int
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -41,8 +50,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		NewExtensionOptionsDecoratorWithHandlers(options.ExtensionOptionChecker, options.ExtensionOptionHandlers),
 		NewValidateBasicDecorator(),
+		NewMempoolSenderLimitDecorator(options.Mempool, options.MaxTxsPerSender),
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
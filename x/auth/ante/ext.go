@@ -1,11 +1,24 @@
 package ante
 
 import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+const (
+	// EventTypeIgnoredExtensionOption is the type of the event emitted for each
+	// non-critical extension option without handler.
+	EventTypeIgnoredExtensionOption = "ignored_extension_option"
+
+	// AttributeKeyExtensionOptionTypeURL is the type URL of the extension option.
+	AttributeKeyExtensionOptionTypeURL = "type_url"
+)
+
 type HasExtensionOptionsTx interface {
 	GetExtensionOptions() []*codectypes.Any
 	GetNonCriticalExtensionOptions() []*codectypes.Any
@@ -20,12 +33,51 @@ func rejectExtensionOption(*codectypes.Any) bool {
 	return false
 }
 
+// ExtensionOptionHandler validates an extension option of a transaction. It
+// rejects the transaction by returning an error, and can annotate the context
+// returned to the next AnteDecorators.
+type ExtensionOptionHandler func(ctx sdk.Context, tx sdk.Tx, opt *codectypes.Any) (sdk.Context, error)
+
+// ExtensionOptionHandlers is a registry of ExtensionOptionHandler, indexed by
+// the type URL of the extension options they handle.
+type ExtensionOptionHandlers struct {
+	handlers map[string]ExtensionOptionHandler
+}
+
+// NewExtensionOptionHandlers creates an empty ExtensionOptionHandlers registry.
+func NewExtensionOptionHandlers() *ExtensionOptionHandlers {
+	return &ExtensionOptionHandlers{handlers: make(map[string]ExtensionOptionHandler)}
+}
+
+// RegisterHandler registers the handler of the extension options of the same
+// type as opt. It panics if a handler is already registered for this type.
+func (r *ExtensionOptionHandlers) RegisterHandler(opt proto.Message, handler ExtensionOptionHandler) {
+	typeURL := "/" + proto.MessageName(opt)
+	if _, exists := r.handlers[typeURL]; exists {
+		panic(fmt.Sprintf("extension option handler already registered for %s", typeURL))
+	}
+
+	r.handlers[typeURL] = handler
+}
+
+// GetHandler returns the handler registered for the given extension option
+// type URL, if any.
+func (r *ExtensionOptionHandlers) GetHandler(typeURL string) (ExtensionOptionHandler, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	handler, ok := r.handlers[typeURL]
+	return handler, ok
+}
+
 // RejectExtensionOptionsDecorator is an AnteDecorator that rejects all extension
 // options which can optionally be included in protobuf transactions. Users that
 // need extension options should create a custom AnteHandler chain that handles
 // needed extension options properly and rejects unknown ones.
 type RejectExtensionOptionsDecorator struct {
-	checker ExtensionOptionChecker
+	checker  ExtensionOptionChecker
+	handlers *ExtensionOptionHandlers
 }
 
 // NewExtensionOptionsDecorator creates a new antehandler that rejects all extension
@@ -33,18 +85,31 @@ type RejectExtensionOptionsDecorator struct {
 // Users that need extension options should pass a custom checker that returns true for the
 // needed extension options.
 func NewExtensionOptionsDecorator(checker ExtensionOptionChecker) sdk.AnteDecorator {
+	return NewExtensionOptionsDecoratorWithHandlers(checker, nil)
+}
+
+// NewExtensionOptionsDecoratorWithHandlers creates a new antehandler which
+// invokes the registered handler of each extension option of a transaction.
+// Extension options without handler are treated as follows:
+//   - critical extension options are rejected unless they pass the checker,
+//   - non-critical extension options are ignored, and an event is emitted for
+//     each of them.
+//
+// If handlers is nil, it behaves as NewExtensionOptionsDecorator, ignoring
+// non-critical extension options silently.
+func NewExtensionOptionsDecoratorWithHandlers(checker ExtensionOptionChecker, handlers *ExtensionOptionHandlers) sdk.AnteDecorator {
 	if checker == nil {
 		checker = rejectExtensionOption
 	}
 
-	return RejectExtensionOptionsDecorator{checker: checker}
+	return RejectExtensionOptionsDecorator{checker: checker, handlers: handlers}
 }
 
 var _ sdk.AnteDecorator = RejectExtensionOptionsDecorator{}
 
 // AnteHandle implements the AnteDecorator.AnteHandle method
 func (r RejectExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	err = checkExtOpts(tx, r.checker)
+	ctx, err = r.handleExtOpts(ctx, tx)
 	if err != nil {
 		return ctx, err
 	}
@@ -52,14 +117,42 @@ func (r RejectExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 	return next(ctx, tx, simulate)
 }
 
-func checkExtOpts(tx sdk.Tx, checker ExtensionOptionChecker) error {
-	if hasExtOptsTx, ok := tx.(HasExtensionOptionsTx); ok {
-		for _, opt := range hasExtOptsTx.GetExtensionOptions() {
-			if !checker(opt) {
-				return sdkerrors.ErrUnknownExtensionOptions
+func (r RejectExtensionOptionsDecorator) handleExtOpts(ctx sdk.Context, tx sdk.Tx) (sdk.Context, error) {
+	hasExtOptsTx, ok := tx.(HasExtensionOptionsTx)
+	if !ok {
+		return ctx, nil
+	}
+
+	var err error
+	for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+		if handler, ok := r.handlers.GetHandler(opt.TypeUrl); ok {
+			if ctx, err = handler(ctx, tx, opt); err != nil {
+				return ctx, err
 			}
+			continue
+		}
+
+		if !r.checker(opt) {
+			return ctx, sdkerrors.ErrUnknownExtensionOptions
 		}
 	}
 
-	return nil
+	if r.handlers == nil {
+		return ctx, nil
+	}
+
+	for _, opt := range hasExtOptsTx.GetNonCriticalExtensionOptions() {
+		if handler, ok := r.handlers.GetHandler(opt.TypeUrl); ok {
+			if ctx, err = handler(ctx, tx, opt); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeIgnoredExtensionOption,
+			sdk.NewAttribute(AttributeKeyExtensionOptionTypeURL, opt.TypeUrl),
+		))
+	}
+
+	return ctx, nil
 }
@@ -1,13 +1,17 @@
 package ante_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,3 +59,137 @@ func TestRejectExtensionOptionsDecorator(t *testing.T) {
 		})
 	}
 }
+
+type signersKey struct{}
+
+func TestExtensionOptionHandlers(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	handlers := ante.NewExtensionOptionHandlers()
+	handlers.RegisterHandler(&testdata.TestMsg{}, func(ctx sdk.Context, _ sdk.Tx, opt *codectypes.Any) (sdk.Context, error) {
+		msg := opt.GetCachedValue().(*testdata.TestMsg)
+		if len(msg.Signers) == 0 {
+			return ctx, errors.New("no signers")
+		}
+		return ctx.WithValue(signersKey{}, msg.Signers), nil
+	})
+	require.Panics(t, func() {
+		handlers.RegisterHandler(&testdata.TestMsg{}, nil)
+	})
+
+	registered, err := codectypes.NewAnyWithValue(testdata.NewTestMsg(sdk.AccAddress("signer")))
+	require.NoError(t, err)
+	rejected, err := codectypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+	unregistered, err := codectypes.NewAnyWithValue(&testdata.Cat{Moniker: "kitty"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		msg            string
+		opts           []*codectypes.Any
+		nonCritical    []*codectypes.Any
+		expErr         string
+		expAnnotated   bool
+		expIgnoredURLs []string
+	}{
+		{
+			msg:          "registered critical extension",
+			opts:         []*codectypes.Any{registered},
+			expAnnotated: true,
+		},
+		{
+			msg:          "registered non-critical extension",
+			nonCritical:  []*codectypes.Any{registered},
+			expAnnotated: true,
+		},
+		{
+			msg:    "registered extension rejected by its handler",
+			opts:   []*codectypes.Any{rejected},
+			expErr: "no signers",
+		},
+		{
+			msg:    "unregistered critical extension",
+			opts:   []*codectypes.Any{unregistered},
+			expErr: "unknown extension options",
+		},
+		{
+			msg:            "unregistered non-critical extension",
+			nonCritical:    []*codectypes.Any{unregistered},
+			expIgnoredURLs: []string{unregistered.TypeUrl},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			extOptsTxBldr, ok := txBuilder.(tx.ExtensionOptionsTxBuilder)
+			require.True(t, ok)
+			extOptsTxBldr.SetExtensionOptions(tc.opts...)
+			extOptsTxBldr.SetNonCriticalExtensionOptions(tc.nonCritical...)
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewExtensionOptionsDecoratorWithHandlers(nil, handlers))
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			newCtx, err := antehandler(ctx, txBuilder.GetTx(), false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expAnnotated, newCtx.Value(signersKey{}) != nil)
+
+			var ignoredURLs []string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != ante.EventTypeIgnoredExtensionOption {
+					continue
+				}
+				attr, ok := event.GetAttribute(ante.AttributeKeyExtensionOptionTypeURL)
+				require.True(t, ok)
+				ignoredURLs = append(ignoredURLs, attr.Value)
+			}
+			require.Equal(t, tc.expIgnoredURLs, ignoredURLs)
+		})
+	}
+}
+
+func TestTxNoteExtensionOptionHandler(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	handlers := ante.NewExtensionOptionHandlers()
+	handlers.RegisterHandler(&types.TxNote{}, ante.NewTxNoteExtensionOptionHandler(suite.accountKeeper))
+	antehandler := sdk.ChainAnteDecorators(ante.NewExtensionOptionsDecoratorWithHandlers(nil, handlers))
+
+	testCases := []struct {
+		msg    string
+		note   string
+		expErr error
+	}{
+		{"valid note", "hello", nil},
+		{"note too long", strings.Repeat("a", int(types.DefaultMaxMemoCharacters)+1), sdkerrors.ErrMemoTooLarge},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			opt, err := codectypes.NewAnyWithValue(&types.TxNote{Note: tc.note})
+			require.NoError(t, err)
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			txBuilder.(tx.ExtensionOptionsTxBuilder).SetExtensionOptions(opt)
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			newCtx, err := antehandler(ctx, txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			note, ok := ante.TxNoteFromContext(newCtx)
+			require.True(t, ok)
+			require.Equal(t, tc.note, note)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			attr, ok := events[0].GetAttribute(ante.AttributeKeyTxNote)
+			require.True(t, ok)
+			require.Equal(t, tc.note, attr.Value)
+		})
+	}
+}
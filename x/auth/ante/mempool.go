@@ -0,0 +1,59 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// MempoolSenderLimitDecorator rejects in CheckTx the txs whose sender, their
+// first signer, already has the maximum number of txs in the mempool, with an
+// ErrMempoolSenderLimit error so that clients can back off. It is a no-op if the
+// limit is 0 or if the mempool cannot count the txs of a sender.
+//
+// On ReCheckTx, the tx is itself held by the mempool and counted, while the txs
+// included in the last block have been removed from it.
+type MempoolSenderLimitDecorator struct {
+	counter         mempool.SenderCounter
+	maxTxsPerSender int
+}
+
+func NewMempoolSenderLimitDecorator(mp mempool.Mempool, maxTxsPerSender int) MempoolSenderLimitDecorator {
+	counter, _ := mp.(mempool.SenderCounter)
+	return MempoolSenderLimitDecorator{
+		counter:         counter,
+		maxTxsPerSender: maxTxsPerSender,
+	}
+}
+
+func (msld MempoolSenderLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate || msld.counter == nil || msld.maxTxsPerSender <= 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers := sigTx.GetSigners()
+	if len(signers) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	count := msld.counter.CountBySender(signers[0].String())
+	if ctx.IsReCheckTx() {
+		// the tx itself is in the mempool
+		count--
+	}
+
+	if count >= msld.maxTxsPerSender {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrMempoolSenderLimit,
+			"sender %s has %d txs in mempool, maximum is %d", signers[0], count, msld.maxTxsPerSender)
+	}
+
+	return next(ctx, tx, simulate)
+}
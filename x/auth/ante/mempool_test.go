@@ -0,0 +1,69 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// senderCountMempool is a mempool holding no tx but reporting the given
+// number of txs per sender.
+type senderCountMempool struct {
+	mempool.NoOpMempool
+	counts map[string]int
+}
+
+func (mp senderCountMempool) CountBySender(sender string) int { return mp.counts[sender] }
+
+func TestMempoolSenderLimitDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	mp := senderCountMempool{counts: map[string]int{addr1.String(): 2}}
+
+	testCases := []struct {
+		name            string
+		ctx             sdk.Context
+		mempool         mempool.Mempool
+		maxTxsPerSender int
+		simulate        bool
+		expErr          bool
+	}{
+		{"below limit", suite.ctx, mp, 3, false, false},
+		{"at limit", suite.ctx, mp, 2, false, true},
+		{"at limit on recheck", suite.ctx.WithIsReCheckTx(true), mp, 2, false, false},
+		{"above limit on recheck", suite.ctx.WithIsReCheckTx(true), mp, 1, false, true},
+		{"no limit", suite.ctx, mp, 0, false, false},
+		{"deliver tx", suite.ctx.WithIsCheckTx(false), mp, 1, false, false},
+		{"simulation", suite.ctx, mp, 1, true, false},
+		{"mempool not counting senders", suite.ctx, mempool.NoOpMempool{}, 1, false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			antehandler := sdk.ChainAnteDecorators(ante.NewMempoolSenderLimitDecorator(tc.mempool, tc.maxTxsPerSender))
+			_, err := antehandler(tc.ctx, tx, tc.simulate)
+			if tc.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrMempoolSenderLimit)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
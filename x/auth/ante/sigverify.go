@@ -2,10 +2,13 @@ package ante
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	errorsmod "cosmossdk.io/errors"
@@ -13,6 +16,7 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -78,16 +82,20 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
+
+		// Only make check if simulate=false. The pubkey of an account rotated
+		// with MsgUpdateAccountPubKey doesn't match its address, but it is the
+		// one set on the account.
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && !isRotatedPubKey(acc, pk) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
+
 		// account already has pubkey set,no need to reset
 		if acc.GetPubKey() != nil {
 			continue
@@ -130,6 +138,17 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// isRotatedPubKey returns whether pk is the pubkey set on an account marked as
+// rotated with MsgUpdateAccountPubKey.
+func isRotatedPubKey(acc sdk.AccountI, pk cryptotypes.PubKey) bool {
+	rotatableAcc, ok := acc.(types.PubKeyRotatableAccount)
+	if !ok || !rotatableAcc.IsPubKeyRotated() {
+		return false
+	}
+
+	return acc.GetPubKey() != nil && acc.GetPubKey().Equals(pk)
+}
+
 // Consume parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
@@ -200,6 +219,9 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 // SigVerificationDecorator verifies all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator will not check signatures on ReCheck.
+// Each signature is verified against the sign bytes of its own sign mode, so that
+// the signers of a tx can use different sign modes, e.g. SIGN_MODE_DIRECT and
+// SIGN_MODE_TEXTUAL.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
@@ -256,6 +278,24 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// Signatures verified in CheckTx are cached with a digest of the signer
+	// data they were verified against, and are not verified again as long as
+	// the signer data, and thus the signed bytes, remain the same.
+	cache := ctx.SigVerificationCache()
+	var (
+		txHash          []byte
+		cachedDigests   [][]byte
+		verifiedDigests [][]byte
+	)
+	if cache != nil && !simulate && !ctx.IsReCheckTx() && len(ctx.TxBytes()) > 0 {
+		txHash = tmhash.Sum(ctx.TxBytes())
+		cachedDigests, _ = cache.Get(txHash)
+		if len(cachedDigests) != len(sigs) {
+			cachedDigests = nil
+		}
+		verifiedDigests = make([][]byte, len(sigs))
+	}
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -270,6 +310,9 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// Check account sequence number.
 		if sig.Sequence != acc.GetSequence() {
+			if cachedDigests != nil {
+				cache.Remove(txHash)
+			}
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -298,6 +341,22 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 					Value:   anyPk.Value,
 				},
 			}
+
+			if verifiedDigests != nil {
+				digest := signerDataDigest(signerData)
+				verifiedDigests[i] = digest
+				if cachedDigests != nil {
+					if bytes.Equal(cachedDigests[i], digest) {
+						continue
+					}
+
+					// the signer data changed since CheckTx, e.g. the account
+					// sequence or pubkey
+					cache.Remove(txHash)
+					cachedDigests = nil
+				}
+			}
+
 			adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
 			if !ok {
 				return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
@@ -319,9 +378,33 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	if verifiedDigests != nil && cachedDigests == nil && ctx.IsCheckTx() {
+		cache.Add(txHash, verifiedDigests)
+	}
+
 	return next(ctx, tx, simulate)
 }
 
+// signerDataDigest returns a digest of the signer data a signature is verified
+// against. Together with the tx bytes, it determines the bytes signed by the
+// signature.
+func signerDataDigest(signerData txsigning.SignerData) []byte {
+	h := sha256.New()
+	for _, field := range []string{signerData.Address, signerData.ChainID, signerData.PubKey.TypeUrl, string(signerData.PubKey.Value)} {
+		var n [8]byte
+		binary.BigEndian.PutUint64(n[:], uint64(len(field)))
+		h.Write(n[:])
+		h.Write([]byte(field))
+	}
+
+	var nums [16]byte
+	binary.BigEndian.PutUint64(nums[:8], signerData.AccountNumber)
+	binary.BigEndian.PutUint64(nums[8:], signerData.Sequence)
+	h.Write(nums[:])
+
+	return h.Sum(nil)
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
@@ -400,24 +483,35 @@ func (vscd ValidateSigCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 
 // DefaultSigVerificationGasConsumer is the default implementation of SignatureVerificationGasConsumer. It consumes gas
 // for signature verification based upon the public key type. The cost is fetched from the given params and is matched
-// by the concrete type.
+// by the concrete type, and can be overridden per type with the SigVerifyCosts param.
+//
+// BLS12-381 public keys are rejected unless a cost is set for them with the SigVerifyCosts param.
 func DefaultSigVerificationGasConsumer(
 	meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params,
 ) error {
 	pubkey := sig.PubKey
 	switch pubkey := pubkey.(type) {
 	case *ed25519.PubKey:
-		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+		meter.ConsumeGas(sigVerifyCost(params, pubkey, params.SigVerifyCostED25519), "ante verify: ed25519")
 		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
 
 	case *secp256k1.PubKey:
-		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+		meter.ConsumeGas(sigVerifyCost(params, pubkey, params.SigVerifyCostSecp256k1), "ante verify: secp256k1")
 		return nil
 
 	case *secp256r1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12_381.PubKey:
+		cost, ok := params.SigVerifyCost(pubkey.Type())
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"BLS12-381 public keys are unsupported unless a %s signature verification cost is set in the auth params", pubkey.Type())
+		}
+		meter.ConsumeGas(cost, "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -434,6 +528,15 @@ func DefaultSigVerificationGasConsumer(
 	}
 }
 
+// sigVerifyCost returns the signature verification cost of pubkey set in the
+// SigVerifyCosts param, or defaultCost if there is none.
+func sigVerifyCost(params types.Params, pubkey cryptotypes.PubKey, defaultCost uint64) uint64 {
+	if cost, ok := params.SigVerifyCost(pubkey.Type()); ok {
+		return cost
+	}
+	return defaultCost
+}
+
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature
 func ConsumeMultisignatureVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
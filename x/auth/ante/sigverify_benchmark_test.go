@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"fmt"
 	"testing"
 
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
@@ -8,6 +9,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 // This benchmark is used to asses the ante.Secp256k1ToR1GasFactor value
@@ -42,3 +48,59 @@ func BenchmarkSig(b *testing.B) {
 		}
 	})
 }
+
+// BenchmarkSigVerificationCacheBlock measures the verification of the
+// signatures of a block of 1,000 txs already checked in CheckTx, with and
+// without a signature verification cache.
+func BenchmarkSigVerificationCacheBlock(b *testing.B) {
+	const blockSize = 1000
+
+	suite := SetupTestSuite(b, true)
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	txs := make([]sdk.Tx, blockSize)
+	txBytes := make([][]byte, blockSize)
+	for i := range txs {
+		priv, _, addr := testdata.KeyTestPubAddr()
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(b, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(b, err)
+		txs[i] = tx
+		txBytes[i], err = suite.clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(b, err)
+	}
+
+	for _, cacheSize := range []int{0, blockSize} {
+		b.Run(fmt.Sprintf("cache size %d", cacheSize), func(b *testing.B) {
+			ctx := suite.ctx
+			if cacheSize > 0 {
+				ctx = ctx.WithSigVerificationCache(sdk.NewSigVerificationCache(cacheSize))
+			}
+
+			for i, tx := range txs {
+				_, err := antehandler(ctx.WithIsCheckTx(true).WithTxBytes(txBytes[i]), tx, false)
+				require.NoError(b, err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				for i, tx := range txs {
+					_, err := antehandler(ctx.WithIsCheckTx(false).WithTxBytes(txBytes[i]), tx, false)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
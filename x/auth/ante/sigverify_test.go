@@ -2,15 +2,19 @@ package ante_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
 
 	storetypes "cosmossdk.io/store/types"
 	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -19,8 +23,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
@@ -78,6 +84,13 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 
 	p := types.DefaultParams()
 	skR1, _ := secp256r1.GenPrivKey()
+	pkBLS := bls12_381.GenPrivKey().PubKey()
+	customParams := types.DefaultParams()
+	customParams.SigVerifyCosts = []types.SigVerifyCost{
+		{PubKeyType: "secp256k1", Cost: 1100},
+		{PubKeyType: "secp256r1", Cost: 700},
+		{PubKeyType: "bls12_381", Cost: 2500},
+	}
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
 	multisignature1 := multisig.NewMultisig(len(pkSet1))
@@ -105,6 +118,10 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyBls12_381", args{storetypes.NewInfiniteGasMeter(), nil, pkBLS, params}, 0, true},
+		{"PubKeySecp256k1 custom cost", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), customParams}, 1100, false},
+		{"PubKeySecp256r1 custom cost", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), customParams}, 700, false},
+		{"PubKeyBls12_381 custom cost", args{storetypes.NewInfiniteGasMeter(), nil, pkBLS, customParams}, 2500, false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -239,6 +256,221 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+// The golden file below pins the sign bytes of the signers of
+// TestSigVerificationMixedSignModes, run
+// `go test ./ante -run TestSigVerificationMixedSignModes -update` from x/auth
+// to update it.
+
+func TestSigVerificationMixedSignModes(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBankKeeper.EXPECT().DenomMetadata(gomock.Any(), &banktypes.QueryDenomMetadataRequest{Denom: "atom"}).Return(&banktypes.QueryDenomMetadataResponse{
+		Metadata: banktypes.Metadata{
+			Base:    "atom",
+			Display: "ATOM",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "atom", Exponent: 0},
+				{Denom: "ATOM", Exponent: 6},
+			},
+		},
+	}, nil).AnyTimes()
+
+	// the textual coin metadata is read from the bank keeper, as on chain
+	txConfig := authtx.NewTxConfigWithOptions(
+		codec.NewProtoCodec(suite.encCfg.InterfaceRegistry),
+		authtx.ConfigOptions{
+			TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(suite.txBankKeeper),
+			EnabledSignModes:           []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_TEXTUAL},
+		},
+	)
+	suite.clientCtx.TxConfig = txConfig
+	suite.txBuilder = txConfig.NewTxBuilder()
+
+	privs := []cryptotypes.PrivKey{
+		secp256k1.GenPrivKeyFromSecret([]byte("direct signer")),
+		secp256k1.GenPrivKeyFromSecret([]byte("textual signer")),
+	}
+	signModes := []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_TEXTUAL}
+	msgs := make([]sdk.Msg, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+	}
+
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	// all signer infos, with their sign mode, are set before signing since they
+	// are part of the sign bytes
+	sigs := make([]signing.SignatureV2, len(privs))
+	for i, priv := range privs {
+		sigs[i] = signing.SignatureV2{
+			PubKey: priv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signModes[i]},
+		}
+	}
+	require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+
+	var signBytes strings.Builder
+	for i, priv := range privs {
+		signerData := authsign.SignerData{
+			Address:       sdk.AccAddress(priv.PubKey().Address()).String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: uint64(i),
+			PubKey:        priv.PubKey(),
+		}
+		bz, err := authsign.GetSignBytesAdapter(suite.ctx, txConfig.SignModeHandler(), signModes[i], signerData, suite.txBuilder.GetTx())
+		require.NoError(t, err)
+		fmt.Fprintf(&signBytes, "%s: %X\n", signModes[i], bz)
+
+		sigs[i], err = clienttx.SignWithPrivKey(suite.ctx, signModes[i], signerData, suite.txBuilder, priv, txConfig, 0)
+		require.NoError(t, err)
+	}
+	golden.Assert(t, signBytes.String(), "mixed_sign_modes_sign_bytes.golden")
+	require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, txConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+	_, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.NoError(t, err)
+
+	// swapping the signatures of the signers breaks both
+	swapped := []signing.SignatureV2{sigs[1], sigs[0]}
+	swapped[0].PubKey, swapped[1].PubKey = sigs[0].PubKey, sigs[1].PubKey
+	require.NoError(t, suite.txBuilder.SetSignatures(swapped...))
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestSigVerificationRotatedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	params := types.DefaultParams()
+	params.EnablePubkeyRotation = true
+	require.NoError(t, suite.accountKeeper.SetParams(suite.ctx, params))
+
+	oldPriv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(oldPriv.PubKey().Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	accNum := acc.GetAccountNumber()
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	isd := ante.NewIncrementSequenceDecorator(suite.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(spkd, svd, isd)
+
+	newTxBuilder := func() {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	}
+
+	// before the rotation, the account signs with its original key
+	newTxBuilder()
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{oldPriv}, []uint64{accNum}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	suite.ctx, err = antehandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+
+	memberPrivs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	memberPubKeys := make([]cryptotypes.PubKey, len(memberPrivs))
+	for i, priv := range memberPrivs {
+		memberPubKeys[i] = priv.PubKey()
+	}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, memberPubKeys)
+
+	msg, err := types.NewMsgUpdateAccountPubKey(addr, multisigPubKey)
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(suite.accountKeeper).UpdateAccountPubKey(suite.ctx, msg)
+	require.NoError(t, err)
+
+	acc = suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.True(t, multisigPubKey.Equals(acc.GetPubKey()))
+	require.Equal(t, accNum, acc.GetAccountNumber())
+	require.Equal(t, uint64(1), acc.GetSequence())
+
+	// after the rotation, the original key is rejected
+	newTxBuilder()
+	tx, err = suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{oldPriv}, []uint64{accNum}, []uint64{1}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// and the account signs with the multisig key, whose address differs from
+	// the account address
+	newTxBuilder()
+	multiSigData := func(sigs ...[]byte) *signing.MultiSignatureData {
+		data := multisig.NewMultisig(len(memberPubKeys))
+		for i, sig := range sigs {
+			require.NoError(t, multisig.AddSignatureV2(data, signing.SignatureV2{
+				PubKey: memberPubKeys[i],
+				Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+			}, memberPubKeys))
+		}
+		return data
+	}
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     multiSigData(nil, nil),
+		Sequence: 1,
+	}))
+	signerData := authsign.SignerData{
+		Address:       addr.String(),
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      1,
+		PubKey:        multisigPubKey,
+	}
+	signBytes, err := authsign.GetSignBytesAdapter(suite.ctx, suite.clientCtx.TxConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, suite.txBuilder.GetTx())
+	require.NoError(t, err)
+	sigs := make([][]byte, 2)
+	for i := range sigs {
+		sigs[i], err = memberPrivs[i].Sign(signBytes)
+		require.NoError(t, err)
+	}
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     multiSigData(sigs...),
+		Sequence: 1,
+	}))
+	suite.ctx, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+}
+
+func TestSetPubKeyNotRotatedMismatch(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	// the account has a pubkey which doesn't match its address, but it was not
+	// rotated with MsgUpdateAccountPubKey
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(priv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(spkd)
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -351,3 +583,88 @@ func TestIncrementSequenceDecorator(t *testing.T) {
 		require.Equal(t, tc.expectedSeq, suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+func TestSigVerificationCache(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithChainID("cache-chain")
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}
+	signedTx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(signedTx)
+	require.NoError(t, err)
+	tx, err := suite.clientCtx.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	// a tx with the same bytes whose signature is invalid, only accepted when
+	// the verification of the signature is skipped
+	badSig, err := priv.Sign([]byte("unrelated message"))
+	require.NoError(t, err)
+	suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: badSig},
+		Sequence: 0,
+	})
+	badSigTx := suite.txBuilder.GetTx()
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	cache := sdk.NewSigVerificationCache(10)
+	checkCtx := suite.ctx.WithIsCheckTx(true).WithTxBytes(txBytes).WithSigVerificationCache(cache)
+	deliverCtx := checkCtx.WithIsCheckTx(false)
+
+	setSequence := func(seq uint64) {
+		acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+		require.NoError(t, acc.SetSequence(seq))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	// signatures are not cached on recheck and simulation
+	_, err = antehandler(checkCtx.WithIsReCheckTx(true), tx, false)
+	require.NoError(t, err)
+	_, err = antehandler(checkCtx, tx, true)
+	require.NoError(t, err)
+	require.Zero(t, cache.Len())
+
+	_, err = antehandler(checkCtx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	// the signature verified in CheckTx is not verified again
+	_, err = antehandler(deliverCtx, badSigTx, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	// the entry is invalidated on a sequence mismatch, and the signature is
+	// verified again once the sequence matches
+	setSequence(1)
+	_, err = antehandler(deliverCtx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	require.Zero(t, cache.Len())
+
+	setSequence(0)
+	_, err = antehandler(deliverCtx, badSigTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = antehandler(deliverCtx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, cache.Len(), "signatures must only be cached in CheckTx")
+
+	// the entry is invalidated when the signed bytes differ
+	_, err = antehandler(checkCtx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	_, err = antehandler(deliverCtx.WithChainID("other-chain"), badSigTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Zero(t, cache.Len())
+}
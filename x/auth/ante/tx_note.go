@@ -0,0 +1,49 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AttributeKeyTxNote is the tx event attribute holding the note of a TxNote
+// extension option.
+const AttributeKeyTxNote = "note"
+
+type txNoteKey struct{}
+
+// NewTxNoteExtensionOptionHandler returns the handler of the TxNote reference
+// extension option. Notes longer than the MaxMemoCharacters param are rejected,
+// accepted notes are emitted as a tx event and stored in the context.
+func NewTxNoteExtensionOptionHandler(ak AccountKeeper) ExtensionOptionHandler {
+	return func(ctx sdk.Context, _ sdk.Tx, opt *codectypes.Any) (sdk.Context, error) {
+		note, ok := opt.GetCachedValue().(*types.TxNote)
+		if !ok {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (*types.TxNote)(nil), opt.GetCachedValue())
+		}
+
+		params := ak.GetParams(ctx)
+		if length := uint64(len(note.Note)); length > params.MaxMemoCharacters {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrMemoTooLarge,
+				"maximum number of characters is %d but received %d characters",
+				params.MaxMemoCharacters, length,
+			)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+			sdk.NewAttribute(AttributeKeyTxNote, note.Note),
+		))
+
+		return ctx.WithValue(txNoteKey{}, note.Note), nil
+	}
+}
+
+// TxNoteFromContext returns the note of the TxNote extension option of the
+// transaction being processed, as stored by the TxNote extension option handler.
+func TxNoteFromContext(ctx sdk.Context) (string, bool) {
+	note, ok := ctx.Value(txNoteKey{}).(string)
+	return note, ok
+}
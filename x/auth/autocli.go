@@ -25,6 +25,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 			},
 		},
-		// Tx is purposely left empty, as the only tx is MsgUpdateParams which is gov gated.
+		// Tx is purposely left empty, as MsgUpdateParams is gov gated and MsgUpdateAccountPubKey
+		// takes a multisig pubkey, which is built from the keyring.
 	}
 }
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// FlagTrustedValidatorsHash is the flag for the validator set hash a proof
+// bundle is verified against.
+const FlagTrustedValidatorsHash = "trusted-validators-hash"
+
+// GetAccountProofBundleCmd returns a query command that builds a proof bundle
+// of an account and its balances.
+func GetAccountProofBundleCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-proof-bundle [address]",
+		Short: "Build a proof bundle of an account and its balances at a height",
+		Long: `Query an account and its balances with their store proofs at a height, and bundle them with the
+signed header of the next block and its validator set. The bundle can be verified offline with the
+verify-proof-bundle command. The latest provable height is used if --height is not set.`,
+		Example: fmt.Sprintf("%s q auth account-proof-bundle cosmos1... --height 100 > bundle.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := authclient.GetAccountProofBundle(cmd.Context(), clientCtx, ac, args[0], clientCtx.Height)
+			if err != nil {
+				return err
+			}
+
+			bz, err := cmtjson.MarshalIndent(bundle, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(append(bz, '\n'))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// VerifyProofBundleCmd returns a command that verifies an account proof bundle
+// without connecting to a node.
+func VerifyProofBundleCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof-bundle [file]",
+		Short: "Verify an account proof bundle offline against a trusted validator set hash",
+		Long: `Verify an account proof bundle built by the account-proof-bundle command. The validator set of the
+bundle must match the trusted validator set hash and have signed the bundled header, and the
+account and balance proofs must verify against its app hash. No node is contacted.`,
+		Example: fmt.Sprintf("%s q auth verify-proof-bundle bundle.json --%s 6A0B...", version.AppName, FlagTrustedValidatorsHash),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			trustedHashStr, err := cmd.Flags().GetString(FlagTrustedValidatorsHash)
+			if err != nil {
+				return err
+			}
+
+			trustedHash, err := hex.DecodeString(trustedHashStr)
+			if err != nil || len(trustedHash) == 0 {
+				return fmt.Errorf("invalid --%s %q", FlagTrustedValidatorsHash, trustedHashStr)
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var bundle authclient.AccountProofBundle
+			if err := cmtjson.Unmarshal(bz, &bundle); err != nil {
+				return err
+			}
+
+			state, err := authclient.VerifyAccountProofBundle(clientCtx.Codec, ac, &bundle, trustedHash)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(state)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(FlagTrustedValidatorsHash, "", "Hex-encoded hash of the validator set trusted to have signed the bundle")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+	_ = cmd.MarkFlagRequired(FlagTrustedValidatorsHash)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	querytypes "github.com/cosmos/cosmos-sdk/types/query"
@@ -26,6 +28,8 @@ const (
 	FlagType    = "type"
 	FlagOrderBy = "order_by"
 
+	FlagLenientDecode = "lenient-decode"
+
 	TypeHash   = "hash"
 	TypeAccSeq = "acc_seq"
 	TypeSig    = "signature"
@@ -51,6 +55,8 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		QueryParamsCmd(),
 		QueryModuleAccountsCmd(),
 		QueryModuleAccountByNameCmd(),
+		GetAccountProofBundleCmd(ac),
+		VerifyProofBundleCmd(ac),
 	)
 
 	return cmd
@@ -328,6 +334,10 @@ $ %s query tx --%s=%s <sig1_base64>,<sig2_base64...>
 			}
 
 			typ, _ := cmd.Flags().GetString(FlagType)
+			lenientDecode, _ := cmd.Flags().GetBool(FlagLenientDecode)
+			if lenientDecode && typ != TypeHash {
+				return fmt.Errorf("--%s is only supported with --%s=%s", FlagLenientDecode, FlagType, TypeHash)
+			}
 
 			switch typ {
 			case TypeHash:
@@ -335,6 +345,10 @@ $ %s query tx --%s=%s <sig1_base64>,<sig2_base64...>
 					return fmt.Errorf("argument should be a tx hash")
 				}
 
+				if lenientDecode {
+					return queryTxLenient(clientCtx, args[0])
+				}
+
 				// if hash is given, then query the tx by hash
 				output, err := authtx.QueryTx(clientCtx, args[0])
 				if err != nil {
@@ -407,6 +421,7 @@ $ %s query tx --%s=%s <sig1_base64>,<sig2_base64...>
 
 	flags.AddQueryFlagsToCmd(cmd)
 	cmd.Flags().String(FlagType, TypeHash, fmt.Sprintf("The type to be used when querying tx, can be one of \"%s\", \"%s\", \"%s\"", TypeHash, TypeAccSeq, TypeSig))
+	cmd.Flags().Bool(FlagLenientDecode, false, "Decode the tx skipping and reporting the fields unknown to this binary, e.g. for txs produced by other versions of the SDK")
 
 	return cmd
 }
@@ -419,3 +434,34 @@ func ParseSigArgs(args []string) ([]string, error) {
 
 	return strings.Split(args[0], ","), nil
 }
+
+// lenientTxResponse is the output of the tx query decoding the tx leniently.
+type lenientTxResponse struct {
+	TxResponse    json.RawMessage             `json:"tx_response"`
+	UnknownFields []unknownproto.UnknownField `json:"unknown_fields"`
+}
+
+// queryTxLenient queries for a tx by hash, decoding it with the lenient
+// decoder, and prints it along with the unknown fields skipped while decoding.
+func queryTxLenient(clientCtx client.Context, hash string) error {
+	output, unknownFields, err := authtx.QueryTxLenient(clientCtx, hash)
+	if err != nil {
+		return err
+	}
+
+	if output.Empty() {
+		return fmt.Errorf("no transaction found with hash %s", hash)
+	}
+
+	txResponse, err := clientCtx.Codec.MarshalJSON(output)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(lenientTxResponse{TxResponse: txResponse, UnknownFields: unknownFields})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintRaw(bz)
+}
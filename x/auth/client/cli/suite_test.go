@@ -2,8 +2,11 @@ package cli_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -11,11 +14,15 @@ import (
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -221,6 +228,70 @@ func (s *CLITestSuite) TestCLIQueryTxCmdByHash() {
 	}
 }
 
+// txCometRPC is a mock CometBFT RPC client serving a single tx.
+type txCometRPC struct {
+	clitestutil.MockCometRPC
+
+	txBz []byte
+}
+
+func (m txCometRPC) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	return &coretypes.ResultTx{Hash: hash, Height: 1, Tx: m.txBz}, nil
+}
+
+func (txCometRPC) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: *height}}}, nil
+}
+
+func (s *CLITestSuite) TestCLIQueryTxCmdLenientDecode() {
+	txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(s.val, s.val1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetMemo("foo")
+	txBz, err := s.encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	// inject a field unknown to this binary in the tx body, as if the tx was
+	// produced by another version of the SDK
+	var raw tx.TxRaw
+	s.Require().NoError(raw.Unmarshal(txBz))
+	raw.BodyBytes = protowire.AppendTag(raw.BodyBytes, 4, protowire.VarintType)
+	raw.BodyBytes = protowire.AppendVarint(raw.BodyBytes, 10)
+	txBz, err = raw.Marshal()
+	s.Require().NoError(err)
+
+	clientCtx := s.clientCtx.WithClient(txCometRPC{txBz: txBz})
+	hash := "C7E7D3A86A17AB3A321172239F3B61357937AF0F25D9FA4D2F4DCCAD9B0D7747"
+
+	// the tx cannot be decoded by default
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryTxCmd(), []string{hash, fmt.Sprintf("--%s=json", flags.FlagOutput)})
+	s.Require().ErrorContains(err, "tx parse error")
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryTxCmd(), []string{
+		hash, fmt.Sprintf("--%s", authcli.FlagLenientDecode), fmt.Sprintf("--%s=json", flags.FlagOutput),
+	})
+	s.Require().NoError(err)
+
+	var res struct {
+		TxResponse    json.RawMessage             `json:"tx_response"`
+		UnknownFields []unknownproto.UnknownField `json:"unknown_fields"`
+	}
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &res))
+	s.Require().Equal([]unknownproto.UnknownField{
+		{Message: "cosmos.tx.v1beta1.TxBody", TagNum: 4, WireType: protowire.VarintType},
+	}, res.UnknownFields)
+
+	var txRes sdk.TxResponse
+	s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(res.TxResponse, &txRes))
+	s.Require().Equal(hash, txRes.TxHash)
+	s.Require().Equal("foo", txRes.GetTx().(*tx.Tx).Body.Memo)
+
+	// lenient decoding is only supported when querying by hash
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryTxCmd(), []string{
+		"cosmos1abc/1", fmt.Sprintf("--%s=%s", authcli.FlagType, authcli.TypeAccSeq), fmt.Sprintf("--%s", authcli.FlagLenientDecode),
+	})
+	s.Require().EqualError(err, "--lenient-decode is only supported with --type=hash")
+}
+
 func (s *CLITestSuite) TestCLIQueryTxCmdByEvents() {
 	testCases := []struct {
 		name         string
@@ -708,6 +779,110 @@ func (s *CLITestSuite) TestCLIMultisign() {
 	s.Require().NoError(err)
 }
 
+func (s *CLITestSuite) TestCLISignAggregate() {
+	account, err := s.clientCtx.Keyring.Key("newAccount")
+	s.Require().NoError(err)
+	account1, err := s.clientCtx.Keyring.Key("newAccount1")
+	s.Require().NoError(err)
+	account2, err := s.clientCtx.Keyring.Key("newAccount2")
+	s.Require().NoError(err)
+	dummyAccount, err := s.clientCtx.Keyring.Key("dummyAccount")
+	s.Require().NoError(err)
+
+	// 2-of-3 multisig whose third member is a nested 1-of-2 multisig
+	pub, err := account.GetPubKey()
+	s.Require().NoError(err)
+	dummyPub, err := dummyAccount.GetPubKey()
+	s.Require().NoError(err)
+	nestedPub := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pub, dummyPub})
+	_, err = s.clientCtx.Keyring.SaveMultisig("aggregateNested", nestedPub)
+	s.Require().NoError(err)
+
+	pub1, err := account1.GetPubKey()
+	s.Require().NoError(err)
+	pub2, err := account2.GetPubKey()
+	s.Require().NoError(err)
+	multisigRecord, err := s.clientCtx.Keyring.SaveMultisig("aggregate", kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pub1, pub2, nestedPub}))
+	s.Require().NoError(err)
+	addr, err := multisigRecord.GetAddress()
+	s.Require().NoError(err)
+
+	// Generate multisig transaction.
+	multiGeneratedTx, err := clitestutil.MsgSendExec(
+		s.clientCtx,
+		addr,
+		s.val,
+		sdk.NewCoins(
+			sdk.NewInt64Coin("stake", 5),
+		),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	multiGeneratedTxFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String())
+	defer multiGeneratedTxFile.Close()
+
+	addr1, err := account1.GetAddress()
+	s.Require().NoError(err)
+	addr2, err := account2.GetAddress()
+	s.Require().NoError(err)
+	accountAddr, err := account.GetAddress()
+	s.Require().NoError(err)
+
+	// account2 signs first
+	account2Signature, err := authtestutil.TxSignExec(s.clientCtx, addr2, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+	s.Require().NoError(err)
+	sign2File := testutil.WriteToNewTempFile(s.T(), account2Signature.String())
+	defer sign2File.Close()
+
+	partialTxFile := filepath.Join(s.T().TempDir(), "partial.json")
+	out, err := authtestutil.TxSignAggregateExec(s.clientCtx, multiGeneratedTxFile.Name(), multisigRecord.Name, sign2File.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, partialTxFile))
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "added signature of newAccount2 ("+addr2.String()+") (1 of 3 signatures, threshold 2)")
+
+	// account1 signs for another account number
+	account1Signature, err := authtestutil.TxSignExec(s.clientCtx, addr1, multiGeneratedTxFile.Name(), "--multisig", addr.String(),
+		fmt.Sprintf("--%s", flags.FlagOffline), fmt.Sprintf("--%s=5", flags.FlagAccountNumber), fmt.Sprintf("--%s=0", flags.FlagSequence))
+	s.Require().NoError(err)
+	sign1File := testutil.WriteToNewTempFile(s.T(), account1Signature.String())
+	defer sign1File.Close()
+
+	_, err = authtestutil.TxSignAggregateExec(s.clientCtx, partialTxFile, multisigRecord.Name, sign1File.Name())
+	s.Require().ErrorContains(err, "signature of newAccount1 ("+addr1.String()+")")
+	s.Require().ErrorContains(err, "account-number 0")
+
+	// a member of the nested multisig signs later
+	nestedSignature, err := authtestutil.TxSignExec(s.clientCtx, accountAddr, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+	s.Require().NoError(err)
+	nestedSignatureFile := testutil.WriteToNewTempFile(s.T(), nestedSignature.String())
+	defer nestedSignatureFile.Close()
+
+	signedTxFile := filepath.Join(s.T().TempDir(), "signed.json")
+	_, err = authtestutil.TxSignAggregateExec(s.clientCtx, partialTxFile, multisigRecord.Name, nestedSignatureFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, signedTxFile))
+	s.Require().NoError(err)
+
+	_, err = authtestutil.TxValidateSignaturesExec(s.clientCtx, signedTxFile)
+	s.Require().NoError(err)
+
+	// merging a signature again replaces it
+	amendedTxFile := filepath.Join(s.T().TempDir(), "amended.json")
+	_, err = authtestutil.TxSignAggregateExec(s.clientCtx, signedTxFile, multisigRecord.Name, sign2File.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, amendedTxFile))
+	s.Require().NoError(err)
+	signedTx, err := os.ReadFile(signedTxFile)
+	s.Require().NoError(err)
+	amendedTx, err := os.ReadFile(amendedTxFile)
+	s.Require().NoError(err)
+	s.Require().Equal(signedTx, amendedTx)
+
+	// the signatures of non members are rejected
+	_, err = authtestutil.TxSignAggregateExec(s.clientCtx, multiGeneratedTxFile.Name(), "multi", nestedSignatureFile.Name())
+	s.Require().ErrorContains(err, "signature of newAccount ("+accountAddr.String()+") in "+nestedSignatureFile.Name()+": signer is not a member of multisig multi")
+}
+
 func (s *CLITestSuite) TestSignBatchMultisig() {
 	// Fetch 2 accounts and a multisig.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
@@ -375,13 +375,7 @@ func signTx(cmd *cobra.Command, clientCtx client.Context, txF tx.Factory, newTx
 			return err
 		}
 
-		var found bool
-		for _, pubkey := range multisigLegacyPub.GetPubKeys() {
-			if pubkey.Equals(fromPubKey) {
-				found = true
-			}
-		}
-		if !found {
+		if !isMultisigMember(multisigLegacyPub, fromPubKey) {
 			return fmt.Errorf("signing key is not a part of multisig key")
 		}
 		err = authclient.SignTxWithSignerAddress(
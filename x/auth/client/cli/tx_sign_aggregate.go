@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// GetSignAggregateCommand returns the sign-aggregate command, which merges
+// partial signatures into a partially signed multisig transaction.
+func GetSignAggregateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-aggregate [file] [name] [[signature]...]",
+		Short: "Merge multisig signatures into a partially signed transaction, one signature file at a time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Merge the signatures of the members of the multisig key [name] into the transaction read
+from [file], which may be the partially signed transaction output by a previous sign-aggregate
+command. Signatures can thus be collected in any order, over several invocations.
+
+Each signature read from the [signature] files is checked to be made by a member of the multisig
+key, for the sequence of the multisig account, and over the signing data of the transaction. The
+first signature which does not pass these checks is reported along with the signer and the
+mismatching field. The signatures of the members of a nested multisig key are merged into the
+signature of the nested multisig, which may also be given as a whole, e.g. as output by
+'multisign --signature-only'.
+
+Example:
+$ %s tx sign-aggregate transaction.json k1k2k3 k2sig.json --output-document partial.json
+$ %s tx sign-aggregate partial.json k1k2k3 k1sig.json --output-document signed.json
+
+Signatures are verified in the sign mode they were made with. As SIGN_MODE_DIRECT signatures cover
+the signer infos of the transaction, they can only be merged if they were made over the signer
+infos of the merged transaction.
+
+If the --offline flag is on, the client will not reach out to an external node.
+Account number or sequence number lookups are not performed so you must
+set these parameters manually.
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: makeSignAggregateCmd(),
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeSignAggregateCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		if err != nil {
+			return err
+		}
+		if txFactory.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+
+		txCfg := clientCtx.TxConfig
+		txBuilder, err := txCfg.WrapTxBuilder(parsedTx)
+		if err != nil {
+			return err
+		}
+
+		k, err := getMultisigRecord(clientCtx, args[1])
+		if err != nil {
+			return err
+		}
+		pubKey, err := k.GetPubKey()
+		if err != nil {
+			return err
+		}
+		multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+		if !ok {
+			return fmt.Errorf("key %s is not a multisig key", args[1])
+		}
+
+		if !clientCtx.Offline {
+			addr, err := k.GetAddress()
+			if err != nil {
+				return err
+			}
+			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+			if err != nil {
+				return err
+			}
+
+			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+		}
+
+		multisigSig, err := partialMultisig(txBuilder.GetTx(), multisigPub, txFactory.Sequence())
+		if err != nil {
+			return err
+		}
+
+		adaptableTx, ok := txBuilder.GetTx().(signing.V2AdaptableTx)
+		if !ok {
+			return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", txBuilder.GetTx())
+		}
+		txData := adaptableTx.GetSigningTxData()
+
+		for _, filename := range args[2:] {
+			sigs, err := unmarshalSignatureJSON(clientCtx, filename)
+			if err != nil {
+				return fmt.Errorf("failed to read signatures from %s: %w", filename, err)
+			}
+
+			for _, sig := range sigs {
+				signer := signerName(clientCtx, sig.PubKey)
+				if !isMultisigMember(multisigPub, sig.PubKey) {
+					return fmt.Errorf("signature of %s in %s: signer is not a member of multisig %s", signer, filename, args[1])
+				}
+				if sig.Sequence != txFactory.Sequence() {
+					return fmt.Errorf("signature of %s in %s: signed sequence %d, expected sequence %d",
+						signer, filename, sig.Sequence, txFactory.Sequence())
+				}
+
+				anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+				if err != nil {
+					return err
+				}
+				txSignerData := txsigning.SignerData{
+					ChainID:       txFactory.ChainID(),
+					AccountNumber: txFactory.AccountNumber(),
+					Sequence:      txFactory.Sequence(),
+					Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+					PubKey: &anypb.Any{
+						TypeUrl: anyPk.TypeUrl,
+						Value:   anyPk.Value,
+					},
+				}
+				err = signing.VerifySignature(cmd.Context(), sig.PubKey, txSignerData, sig.Data,
+					txCfg.SignModeHandler(), txData)
+				if err != nil {
+					return fmt.Errorf("signature of %s in %s does not match the signing data (chain-id %s, account-number %d, sequence %d): "+
+						"check that the signer used the same chain ID and account number: %w",
+						signer, filename, txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence(), err)
+				}
+
+				addMultisigSignature(multisigSig, multisigPub, sig)
+				cmd.PrintErrf("added signature of %s (%d of %d signatures, threshold %d)\n",
+					signer, len(multisigSig.Signatures), len(multisigPub.PubKeys), multisigPub.Threshold)
+			}
+		}
+
+		err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+			PubKey:   multisigPub,
+			Data:     multisigSig,
+			Sequence: txFactory.Sequence(),
+		})
+		if err != nil {
+			return err
+		}
+
+		json, err := marshalSignatureJSON(txCfg, txBuilder, false)
+		if err != nil {
+			return err
+		}
+
+		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+		if outputDoc == "" {
+			cmd.Printf("%s\n", json)
+			return nil
+		}
+
+		return os.WriteFile(outputDoc, append(json, '\n'), 0o644)
+	}
+}
+
+// partialMultisig returns the signatures of the multisig key collected so far
+// in tx, or an empty multisig if there are none. They must be for the given
+// sequence.
+func partialMultisig(tx signing.Tx, multisigPub *kmultisig.LegacyAminoPubKey, sequence uint64) (*signingtypes.MultiSignatureData, error) {
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sig := range sigs {
+		if sig.PubKey == nil || !sig.PubKey.Equals(multisigPub) {
+			continue
+		}
+
+		data, ok := sig.Data.(*signingtypes.MultiSignatureData)
+		if !ok || data.BitArray == nil || data.BitArray.Count() != len(multisigPub.PubKeys) {
+			return nil, fmt.Errorf("invalid multisig signature in the transaction")
+		}
+		if sig.Sequence != sequence {
+			return nil, fmt.Errorf("the transaction is partially signed for sequence %d, expected sequence %d", sig.Sequence, sequence)
+		}
+
+		return data, nil
+	}
+
+	return multisig.NewMultisig(len(multisigPub.PubKeys)), nil
+}
+
+// isMultisigMember returns whether pubKey is one of the keys of multisigPub,
+// or of the multisig keys nested in it.
+func isMultisigMember(multisigPub *kmultisig.LegacyAminoPubKey, pubKey cryptotypes.PubKey) bool {
+	for _, member := range multisigPub.GetPubKeys() {
+		if member.Equals(pubKey) {
+			return true
+		}
+		if nested, ok := member.(*kmultisig.LegacyAminoPubKey); ok && isMultisigMember(nested, pubKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addMultisigSignature adds sig to the signatures of multisigPub collected in
+// mSig, replacing any previous signature of the signer. The signature of a
+// member of a nested multisig key is added to the signature of the nested key.
+// It returns false if the signer is not a member of multisigPub.
+func addMultisigSignature(mSig *signingtypes.MultiSignatureData, multisigPub *kmultisig.LegacyAminoPubKey, sig signingtypes.SignatureV2) bool {
+	members := multisigPub.GetPubKeys()
+	for i, member := range members {
+		if member.Equals(sig.PubKey) {
+			multisig.AddSignature(mSig, sig.Data, i)
+			return true
+		}
+	}
+
+	for i, member := range members {
+		nested, ok := member.(*kmultisig.LegacyAminoPubKey)
+		if !ok || !isMultisigMember(nested, sig.PubKey) {
+			continue
+		}
+
+		nestedSig := multisig.NewMultisig(len(nested.PubKeys))
+		if mSig.BitArray.GetIndex(i) {
+			if existing, ok := mSig.Signatures[mSig.BitArray.NumTrueBitsBefore(i)].(*signingtypes.MultiSignatureData); ok {
+				nestedSig = existing
+			}
+		}
+		addMultisigSignature(nestedSig, nested, sig)
+		multisig.AddSignature(mSig, nestedSig, i)
+		return true
+	}
+
+	return false
+}
+
+// signerName returns the address of the given signer, along with the name of
+// its key if it is in the keyring.
+func signerName(clientCtx client.Context, pubKey cryptotypes.PubKey) string {
+	addr := sdk.AccAddress(pubKey.Address())
+	if clientCtx.Keyring != nil {
+		if record, err := clientCtx.Keyring.KeyByAddress(addr); err == nil {
+			return fmt.Sprintf("%s (%s)", record.Name, addr)
+		}
+	}
+
+	return addr.String()
+}
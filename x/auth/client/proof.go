@@ -0,0 +1,329 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// validatorsPerPage is the page size used when fetching the validator set
+// from the node.
+const validatorsPerPage = 100
+
+// balanceKeyCodec is the key codec of the x/bank balances store.
+var balanceKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+
+// AccountProofBundle is a self-contained proof of the state of an account at
+// a given height. It holds the store proofs of the account and of its
+// balances together with the signed header committing to the app hash they
+// are proven against, and the validator set which signed it, so that it can
+// be verified without access to a node.
+type AccountProofBundle struct {
+	ChainID string `json:"chain_id"`
+	// Height is the height of the proven state. Its app hash is committed to
+	// by the header at Height+1.
+	Height       int64                  `json:"height"`
+	Address      string                 `json:"address"`
+	Account      StoreProof             `json:"account"`
+	Balances     []BalanceProof         `json:"balances"`
+	SignedHeader *cmttypes.SignedHeader `json:"signed_header"`
+	ValidatorSet *cmttypes.ValidatorSet `json:"validator_set"`
+}
+
+// StoreProof is a value of a store together with its proof of inclusion.
+type StoreProof struct {
+	Value []byte              `json:"value"`
+	Proof *cmtcrypto.ProofOps `json:"proof"`
+}
+
+// BalanceProof is the store proof of the balance of a single denom.
+type BalanceProof struct {
+	Denom string `json:"denom"`
+	StoreProof
+}
+
+// VerifiedAccountState is the account state attested by a verified
+// AccountProofBundle. Balances only holds the denoms included in the bundle.
+type VerifiedAccountState struct {
+	ChainID       string    `json:"chain_id"`
+	Height        int64     `json:"height"`
+	AppHash       string    `json:"app_hash"`
+	Address       string    `json:"address"`
+	AccountNumber uint64    `json:"account_number"`
+	Sequence      uint64    `json:"sequence"`
+	Balances      sdk.Coins `json:"balances"`
+}
+
+// GetAccountProofBundle queries the account and balances of address at height
+// with their store proofs and packages them with the commit of the next block,
+// whose app hash attests them. A non-positive height selects the latest height
+// for which a commit is available.
+func GetAccountProofBundle(ctx context.Context, clientCtx client.Context, ac address.Codec, address string, height int64) (*AccountProofBundle, error) {
+	addr, err := ac.StringToBytes(address)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if height <= 0 {
+		status, err := node.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+
+	account, err := queryStoreProof(clientCtx, types.StoreKey, types.AddressStoreKey(addr), height)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(account.Value) == 0 {
+		return nil, fmt.Errorf("account %s not found at height %d", address, height)
+	}
+
+	balances, err := getBalanceProofs(ctx, clientCtx, addr, address, height)
+	if err != nil {
+		return nil, err
+	}
+
+	headerHeight := height + 1
+	commit, err := node.Commit(ctx, &headerHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	valSet, err := getValidatorSet(ctx, node, headerHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountProofBundle{
+		ChainID:      commit.ChainID,
+		Height:       height,
+		Address:      address,
+		Account:      account,
+		Balances:     balances,
+		SignedHeader: &commit.SignedHeader,
+		ValidatorSet: valSet,
+	}, nil
+}
+
+// VerifyAccountProofBundle verifies bundle offline. The validator set of the
+// bundle must hash to trustedValidatorsHash and must have signed the header
+// with more than 2/3 of its voting power. The account and balance proofs are
+// then verified against the app hash of that header.
+func VerifyAccountProofBundle(cdc codec.Codec, ac address.Codec, bundle *AccountProofBundle, trustedValidatorsHash []byte) (*VerifiedAccountState, error) {
+	if bundle == nil || bundle.SignedHeader == nil || bundle.SignedHeader.Header == nil || bundle.ValidatorSet == nil {
+		return nil, fmt.Errorf("incomplete proof bundle")
+	}
+
+	addr, err := ac.StringToBytes(bundle.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sh := bundle.SignedHeader
+	if err := sh.ValidateBasic(bundle.ChainID); err != nil {
+		return nil, fmt.Errorf("invalid signed header: %w", err)
+	}
+
+	if sh.Height != bundle.Height+1 {
+		return nil, fmt.Errorf("expected header at height %d, got %d", bundle.Height+1, sh.Height)
+	}
+
+	if err := bundle.ValidatorSet.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid validator set: %w", err)
+	}
+
+	valSetHash := bundle.ValidatorSet.Hash()
+	if !bytes.Equal(valSetHash, trustedValidatorsHash) {
+		return nil, fmt.Errorf("validator set hash %X does not match trusted hash %X", valSetHash, trustedValidatorsHash)
+	}
+
+	if !bytes.Equal(sh.ValidatorsHash, valSetHash) {
+		return nil, fmt.Errorf("header validators hash %X does not match validator set hash %X", sh.ValidatorsHash, valSetHash)
+	}
+
+	if err := bundle.ValidatorSet.VerifyCommitLight(bundle.ChainID, sh.Commit.BlockID, sh.Height, sh.Commit); err != nil {
+		return nil, fmt.Errorf("invalid commit: %w", err)
+	}
+
+	if err := verifyStoreProof(bundle.Account, sh.AppHash, types.StoreKey, types.AddressStoreKey(addr)); err != nil {
+		return nil, fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	var account sdk.AccountI
+	if err := cdc.UnmarshalInterface(bundle.Account.Value, &account); err != nil {
+		return nil, err
+	}
+
+	if !account.GetAddress().Equals(sdk.AccAddress(addr)) {
+		return nil, fmt.Errorf("proven account %s does not match %s", account.GetAddress(), bundle.Address)
+	}
+
+	balances := sdk.NewCoins()
+	seen := make(map[string]bool, len(bundle.Balances))
+	for _, balance := range bundle.Balances {
+		if seen[balance.Denom] {
+			return nil, fmt.Errorf("duplicate balance proof for %s", balance.Denom)
+		}
+		seen[balance.Denom] = true
+
+		key, err := balanceStoreKey(addr, balance.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := verifyStoreProof(balance.StoreProof, sh.AppHash, banktypes.StoreKey, key); err != nil {
+			return nil, fmt.Errorf("invalid %s balance proof: %w", balance.Denom, err)
+		}
+
+		amount, err := banktypes.NewBalanceCompatValueCodec().Decode(balance.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = balances.Add(sdk.NewCoin(balance.Denom, amount))
+	}
+
+	return &VerifiedAccountState{
+		ChainID:       bundle.ChainID,
+		Height:        bundle.Height,
+		AppHash:       sh.AppHash.String(),
+		Address:       bundle.Address,
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+		Balances:      balances,
+	}, nil
+}
+
+// getBalanceProofs returns the store proofs of all balances of addr at height.
+func getBalanceProofs(ctx context.Context, clientCtx client.Context, addr sdk.AccAddress, address string, height int64) ([]BalanceProof, error) {
+	queryClient := banktypes.NewQueryClient(clientCtx.WithHeight(height))
+
+	var (
+		proofs     []BalanceProof
+		pagination = &query.PageRequest{}
+	)
+	for {
+		res, err := queryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: address, Pagination: pagination})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, coin := range res.Balances {
+			key, err := balanceStoreKey(addr, coin.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			proof, err := queryStoreProof(clientCtx, banktypes.StoreKey, key, height)
+			if err != nil {
+				return nil, err
+			}
+
+			proofs = append(proofs, BalanceProof{Denom: coin.Denom, StoreProof: proof})
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return proofs, nil
+		}
+
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// getValidatorSet returns the full validator set of the node at height.
+func getValidatorSet(ctx context.Context, node client.CometRPC, height int64) (*cmttypes.ValidatorSet, error) {
+	var (
+		validators []*cmttypes.Validator
+		page       = 1
+		perPage    = validatorsPerPage
+	)
+	for {
+		res, err := node.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			break
+		}
+
+		page++
+	}
+
+	return cmttypes.NewValidatorSet(validators), nil
+}
+
+// queryStoreProof queries key of the given store at height with its proof.
+func queryStoreProof(clientCtx client.Context, storeKey string, key []byte, height int64) (StoreProof, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeKey),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return StoreProof{}, err
+	}
+
+	if res.ProofOps == nil {
+		return StoreProof{}, fmt.Errorf("no proof returned for %X in store %s", key, storeKey)
+	}
+
+	return StoreProof{Value: res.Value, Proof: res.ProofOps}, nil
+}
+
+// verifyStoreProof verifies that proof proves the value of key in the given
+// store against appHash.
+func verifyStoreProof(proof StoreProof, appHash []byte, storeKey string, key []byte) error {
+	if proof.Proof == nil {
+		return fmt.Errorf("missing proof")
+	}
+
+	if len(proof.Value) == 0 {
+		return fmt.Errorf("missing value")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex)
+
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof.Proof, appHash, keyPath.String(), proof.Value)
+}
+
+// balanceStoreKey returns the key of the balance of denom of addr in the
+// x/bank store.
+func balanceStoreKey(addr sdk.AccAddress, denom string) ([]byte, error) {
+	k := collections.Join(addr, denom)
+	key := make([]byte, balanceKeyCodec.Size(k))
+	if _, err := balanceKeyCodec.Encode(key, k); err != nil {
+		return nil, err
+	}
+
+	return append(banktypes.BalancesPrefix.Bytes(), key...), nil
+}
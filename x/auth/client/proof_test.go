@@ -0,0 +1,253 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const proofChainID = "proof-chain"
+
+var proofAddr = sdk.AccAddress("proof_bundle_address")
+
+// makeAccountProofBundle commits an account with a stake and a foo balance to
+// a multistore and bundles their proofs with a header signed by a random
+// validator set. It returns the bundle and the hash of the validator set.
+func makeAccountProofBundle(t *testing.T, cdc codec.Codec) (*authclient.AccountProofBundle, []byte) {
+	t.Helper()
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	accKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	ms.MountStoreWithDB(accKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	accBz, err := cdc.MarshalInterface(authtypes.NewBaseAccount(proofAddr, nil, 7, 3))
+	require.NoError(t, err)
+	accStoreKey := authtypes.AddressStoreKey(proofAddr)
+	ms.GetKVStore(accKey).Set(accStoreKey, accBz)
+
+	balanceKeys := make(map[string][]byte)
+	for denom, amount := range map[string]int64{"stake": 100, "foo": 5} {
+		key := append(append(append([]byte{}, banktypes.BalancesPrefix...), address.MustLengthPrefix(proofAddr)...), denom...)
+		ms.GetKVStore(bankKey).Set(key, []byte(math.NewInt(amount).String()))
+		balanceKeys[denom] = key
+	}
+
+	cid := ms.Commit()
+
+	queryProof := func(storeKey string, key []byte) authclient.StoreProof {
+		res := ms.Query(abci.RequestQuery{Path: "/" + storeKey + "/key", Data: key, Height: cid.Version, Prove: true})
+		require.EqualValues(t, 0, res.Code, res.Log)
+		return authclient.StoreProof{Value: res.Value, Proof: res.ProofOps}
+	}
+
+	valSet, privVals := cmttypes.RandValidatorSet(4, 10)
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            proofChainID,
+		Height:             cid.Version + 1,
+		Time:               time.Now(),
+		AppHash:            cid.Hash,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		ProposerAddress:    valSet.Proposer.Address,
+	}
+
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := cmttypes.NewVoteSet(proofChainID, header.Height, 0, cmtproto.PrecommitType, valSet)
+	commit, err := cmttypes.MakeCommit(blockID, header.Height, 0, voteSet, privVals, time.Now())
+	require.NoError(t, err)
+
+	return &authclient.AccountProofBundle{
+		ChainID: proofChainID,
+		Height:  cid.Version,
+		Address: proofAddr.String(),
+		Account: queryProof(authtypes.StoreKey, accStoreKey),
+		Balances: []authclient.BalanceProof{
+			{Denom: "foo", StoreProof: queryProof(banktypes.StoreKey, balanceKeys["foo"])},
+			{Denom: "stake", StoreProof: queryProof(banktypes.StoreKey, balanceKeys["stake"])},
+		},
+		SignedHeader: &cmttypes.SignedHeader{Header: header, Commit: commit},
+		ValidatorSet: valSet,
+	}, valSet.Hash()
+}
+
+func TestVerifyAccountProofBundle(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}).Codec
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	testCases := []struct {
+		name      string
+		malleate  func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte
+		expErrMsg string
+	}{
+		{
+			name: "valid bundle",
+		},
+		{
+			name: "valid bundle after json round trip",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bz, err := cmtjson.Marshal(bundle)
+				require.NoError(t, err)
+
+				var decoded authclient.AccountProofBundle
+				require.NoError(t, cmtjson.Unmarshal(bz, &decoded))
+				*bundle = decoded
+				return trustedHash
+			},
+		},
+		{
+			name: "untrusted validator set",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				valSet, _ := cmttypes.RandValidatorSet(4, 10)
+				return valSet.Hash()
+			},
+			expErrMsg: "does not match trusted hash",
+		},
+		{
+			name: "validator set not matching the header",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.ValidatorSet, _ = cmttypes.RandValidatorSet(4, 10)
+				return bundle.ValidatorSet.Hash()
+			},
+			expErrMsg: "header validators hash",
+		},
+		{
+			name: "not enough signatures",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				for i := 1; i < len(bundle.SignedHeader.Commit.Signatures); i++ {
+					bundle.SignedHeader.Commit.Signatures[i] = cmttypes.NewCommitSigAbsent()
+				}
+				return trustedHash
+			},
+			expErrMsg: "invalid commit",
+		},
+		{
+			name: "tampered app hash",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.SignedHeader.AppHash = tmhash.Sum([]byte("tampered"))
+				return trustedHash
+			},
+			expErrMsg: "invalid signed header",
+		},
+		{
+			name: "wrong height",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.Height++
+				return trustedHash
+			},
+			expErrMsg: "expected header at height",
+		},
+		{
+			name: "wrong chain id",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.ChainID = "other-chain"
+				return trustedHash
+			},
+			expErrMsg: "invalid signed header",
+		},
+		{
+			name: "tampered account",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bz, err := cdc.MarshalInterface(authtypes.NewBaseAccount(proofAddr, nil, 7, 4))
+				require.NoError(t, err)
+				bundle.Account.Value = bz
+				return trustedHash
+			},
+			expErrMsg: "invalid account proof",
+		},
+		{
+			name: "other address",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.Address = sdk.AccAddress("other_bundle_address").String()
+				return trustedHash
+			},
+			expErrMsg: "invalid account proof",
+		},
+		{
+			name: "tampered balance",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.Balances[1].Value = []byte(math.NewInt(1000).String())
+				return trustedHash
+			},
+			expErrMsg: "invalid stake balance proof",
+		},
+		{
+			name: "balance proven for another denom",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.Balances[0].Denom = "bar"
+				return trustedHash
+			},
+			expErrMsg: "invalid bar balance proof",
+		},
+		{
+			name: "duplicate balance",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.Balances = append(bundle.Balances, bundle.Balances[1])
+				return trustedHash
+			},
+			expErrMsg: "duplicate balance proof",
+		},
+		{
+			name: "missing signed header",
+			malleate: func(t *testing.T, bundle *authclient.AccountProofBundle, trustedHash []byte) []byte {
+				bundle.SignedHeader = nil
+				return trustedHash
+			},
+			expErrMsg: "incomplete proof bundle",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bundle, trustedHash := makeAccountProofBundle(t, cdc)
+			if tc.malleate != nil {
+				trustedHash = tc.malleate(t, bundle, trustedHash)
+			}
+
+			state, err := authclient.VerifyAccountProofBundle(cdc, ac, bundle, trustedHash)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, proofAddr.String(), state.Address)
+			require.Equal(t, bundle.Height, state.Height)
+			require.Equal(t, uint64(7), state.AccountNumber)
+			require.Equal(t, uint64(3), state.Sequence)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("stake", 100)), state.Balances)
+		})
+	}
+}
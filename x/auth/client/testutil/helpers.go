@@ -63,6 +63,16 @@ func TxMultiSignExec(clientCtx client.Context, from, filename string, extraArgs
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignCommand(), append(args, extraArgs...))
 }
 
+func TxSignAggregateExec(clientCtx client.Context, filename, multisig string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, clientCtx.ChainID),
+		filename,
+		multisig,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetSignAggregateCommand(), append(args, extraArgs...))
+}
+
 func TxSignBatchExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--from=%s", from.String()),
@@ -13,10 +13,10 @@ import (
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -100,7 +100,7 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	return AccountKeeper{
-		Codec:         authcodec.NewBech32Codec(bech32Prefix),
+		Codec:         addresscodec.NewCachedBech32Codec(bech32Prefix, addresscodec.DefaultCacheSize),
 		bech32Prefix:  bech32Prefix,
 		storeService:  storeService,
 		proto:         proto,
@@ -5,8 +5,12 @@ import (
 
 	"cosmossdk.io/errors"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -39,3 +43,77 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateAccountPubKey implements MsgServer.UpdateAccountPubKey method.
+// It rotates the pubkey of an account to a multisig pubkey, preserving the
+// account number and sequence.
+func (ms msgServer) UpdateAccountPubKey(goCtx context.Context, msg *types.MsgUpdateAccountPubKey) (*types.MsgUpdateAccountPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !ms.ak.GetParams(ctx).EnablePubkeyRotation {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "pubkey rotation is disabled")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidPubKey, "empty new pubkey")
+	}
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", msg.NewPubKey.GetCachedValue())
+	}
+	// the address of a multisig pubkey is not expected to match the account
+	// address, which is allowed for the accounts marked as rotated, see
+	// BaseAccount.Validate
+	multisigPubKey, ok := newPubKey.(multisig.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "new pubkey must be a multisig pubkey, got %T", newPubKey)
+	}
+	if threshold := multisigPubKey.GetThreshold(); threshold == 0 || threshold > uint(len(multisigPubKey.GetPubKeys())) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid multisig threshold %d for %d pubkeys", threshold, len(multisigPubKey.GetPubKeys()))
+	}
+
+	acc := ms.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Address)
+	}
+
+	switch acc.(type) {
+	case sdk.ModuleAccountI:
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "cannot rotate the pubkey of module account %s", msg.Address)
+	case vestexported.VestingAccount:
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "cannot rotate the pubkey of vesting account %s", msg.Address)
+	}
+	rotatableAcc, ok := acc.(types.PubKeyRotatableAccount)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "cannot rotate the pubkey of account %s of type %T", msg.Address, acc)
+	}
+
+	// the account signed the message under its current pubkey, which was set
+	// by the ante handler if it was not already
+	if acc.GetPubKey() == nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "pubkey of account %s is not set", msg.Address)
+	}
+
+	// the address of the new pubkey must differ from the account address,
+	// otherwise the account already has this pubkey, and must not be the
+	// address of another account, which would then share its pubkey
+	newAddr := sdk.AccAddress(newPubKey.Address())
+	if newAddr.Equals(addr) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "new pubkey address matches the address of account %s", msg.Address)
+	}
+	if ms.ak.HasAccount(ctx, newAddr) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "new pubkey address is the address of the existing account %s", newAddr)
+	}
+
+	if err := rotatableAcc.SetPubKey(newPubKey); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	rotatableAcc.SetPubKeyRotated()
+	ms.ak.SetAccount(ctx, rotatableAcc)
+
+	return &types.MsgUpdateAccountPubKeyResponse{}, nil
+}
@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +116,135 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateAccountPubKey() {
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	newPubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	})
+
+	vestingPubKey := secp256k1.GenPrivKey().PubKey()
+	vestingAddr := sdk.AccAddress(vestingPubKey.Address())
+	noPubKeyAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// a multisig account, and an account at the address of a multisig pubkey
+	multisigAccPubKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey()})
+	multisigAddr := sdk.AccAddress(multisigAccPubKey.Address())
+	takenPubKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey()})
+	takenAddr := sdk.AccAddress(takenPubKey.Address())
+
+	newMsg := func(addr sdk.AccAddress, pubKey cryptotypes.PubKey) *types.MsgUpdateAccountPubKey {
+		msg, err := types.NewMsgUpdateAccountPubKey(addr, pubKey)
+		s.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name     string
+		disabled bool
+		req      *types.MsgUpdateAccountPubKey
+		expErr   error
+	}{
+		{
+			name:     "rotation disabled",
+			disabled: true,
+			req:      newMsg(addr, newPubKey),
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "invalid address",
+			req:    &types.MsgUpdateAccountPubKey{Address: "foo", NewPubKey: newMsg(addr, newPubKey).NewPubKey},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:   "empty new pubkey",
+			req:    newMsg(addr, nil),
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "new pubkey is not a multisig",
+			req:    newMsg(addr, ed25519.GenPrivKey().PubKey()),
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "unknown account",
+			req:    newMsg(sdk.AccAddress([]byte("unknown-------------")), newPubKey),
+			expErr: sdkerrors.ErrUnknownAddress,
+		},
+		{
+			name:   "module account",
+			req:    newMsg(types.NewModuleAddress(multiPerm), newPubKey),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "vesting account",
+			req:    newMsg(vestingAddr, newPubKey),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "account without pubkey",
+			req:    newMsg(noPubKeyAddr, newPubKey),
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "new pubkey address matches the account address",
+			req:    newMsg(multisigAddr, multisigAccPubKey),
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "new pubkey address is another account",
+			req:    newMsg(addr, takenPubKey),
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name: "rotate to multisig pubkey",
+			req:  newMsg(addr, newPubKey),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			params := types.DefaultParams()
+			params.EnablePubkeyRotation = !tc.disabled
+			s.Require().NoError(s.accountKeeper.SetParams(s.ctx, params))
+
+			acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+			s.Require().NoError(acc.SetPubKey(oldPubKey))
+			s.Require().NoError(acc.SetSequence(7))
+			s.accountKeeper.SetAccount(s.ctx, acc)
+
+			baseAcc := types.NewBaseAccount(vestingAddr, vestingPubKey, s.accountKeeper.NextAccountNumber(s.ctx), 0)
+			vestingAcc := vestingtypes.NewDelayedVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1000)
+			s.accountKeeper.SetAccount(s.ctx, vestingAcc)
+
+			s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, noPubKeyAddr))
+
+			multisigAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, multisigAddr)
+			s.Require().NoError(multisigAcc.SetPubKey(multisigAccPubKey))
+			s.accountKeeper.SetAccount(s.ctx, multisigAcc)
+			s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, takenAddr))
+			s.accountKeeper.GetModuleAccount(s.ctx, multiPerm)
+
+			_, err := s.msgServer.UpdateAccountPubKey(s.ctx, tc.req)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the pubkey is swapped, the account number and sequence are preserved
+			rotated := s.accountKeeper.GetAccount(s.ctx, addr)
+			s.Require().True(newPubKey.Equals(rotated.GetPubKey()))
+			s.Require().Equal(acc.GetAccountNumber(), rotated.GetAccountNumber())
+			s.Require().Equal(uint64(7), rotated.GetSequence())
+			s.Require().True(rotated.(*types.BaseAccount).PubKeyRotated)
+			s.Require().NoError(rotated.(*types.BaseAccount).Validate())
+		})
+	}
+}
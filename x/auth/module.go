@@ -10,7 +10,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/depinject"
-	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -21,6 +20,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -206,9 +206,10 @@ func init() {
 }
 
 // ProvideAddressCodec provides an address.Codec to the container for any
-// modules that want to do address string <> bytes conversion.
+// modules that want to do address string <> bytes conversion. The codec caches
+// the results of its conversions.
 func ProvideAddressCodec(config *modulev1.Module) address.Codec {
-	return authcodec.NewBech32Codec(config.Bech32Prefix)
+	return addresscodec.NewCachedBech32Codec(config.Bech32Prefix, addresscodec.DefaultCacheSize)
 }
 
 type ModuleInputs struct {
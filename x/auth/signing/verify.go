@@ -68,6 +68,11 @@ func VerifySignature(
 ) error {
 	switch data := signatureData.(type) {
 	case *signing.SingleSignatureData:
+		// a multisig pubkey can only verify a MultiSignatureData, e.g. the
+		// pubkey of an account rotated with MsgUpdateAccountPubKey
+		if _, ok := pubKey.(multisig.PubKey); ok {
+			return fmt.Errorf("expected a single signer pubkey, got %T", pubKey)
+		}
 		signMode, err := internalSignModeToAPI(data.SignMode)
 		if err != nil {
 			return err
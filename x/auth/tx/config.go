@@ -18,12 +18,13 @@ import (
 )
 
 type config struct {
-	handler     *txsigning.HandlerMap
-	decoder     sdk.TxDecoder
-	encoder     sdk.TxEncoder
-	jsonDecoder sdk.TxDecoder
-	jsonEncoder sdk.TxEncoder
-	protoCodec  codec.ProtoCodecMarshaler
+	handler        *txsigning.HandlerMap
+	decoder        sdk.TxDecoder
+	lenientDecoder client.LenientTxDecoder
+	encoder        sdk.TxEncoder
+	jsonDecoder    sdk.TxDecoder
+	jsonEncoder    sdk.TxEncoder
+	protoCodec     codec.ProtoCodecMarshaler
 }
 
 // ConfigOptions define the configuration of a TxConfig when calling NewTxConfigWithOptions.
@@ -84,11 +85,12 @@ func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signin
 // custom sign mode handlers. If ConfigOptions is an empty struct then default values will be used.
 func NewTxConfigWithOptions(protoCodec codec.ProtoCodecMarshaler, configOptions ConfigOptions) client.TxConfig {
 	txConfig := &config{
-		decoder:     DefaultTxDecoder(protoCodec),
-		encoder:     DefaultTxEncoder(),
-		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
-		jsonEncoder: DefaultJSONTxEncoder(protoCodec),
-		protoCodec:  protoCodec,
+		decoder:        DefaultTxDecoder(protoCodec),
+		lenientDecoder: DefaultLenientTxDecoder(protoCodec),
+		encoder:        DefaultTxEncoder(),
+		jsonDecoder:    DefaultJSONTxDecoder(protoCodec),
+		jsonEncoder:    DefaultJSONTxEncoder(protoCodec),
+		protoCodec:     protoCodec,
 	}
 
 	opts := &configOptions
@@ -196,6 +198,10 @@ func (g config) TxDecoder() sdk.TxDecoder {
 	return g.decoder
 }
 
+func (g config) TxDecoderLenient() client.LenientTxDecoder {
+	return g.lenientDecoder
+}
+
 func (g config) TxJSONEncoder() sdk.TxEncoder {
 	return g.jsonEncoder
 }
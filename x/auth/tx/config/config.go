@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/registry"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -46,6 +47,9 @@ type ModuleInputs struct {
 	AccountKeeper          ante.AccountKeeper                 `optional:"true"`
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
+	// TxBankKeeper is used to read the coin metadata of SIGN_MODE_TEXTUAL,
+	// which is enabled when it is provided.
+	TxBankKeeper BankKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -77,6 +81,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		},
 		CustomSignModes: customSignModeHandlers,
 	}
+
+	// the coin metadata rendered by SIGN_MODE_TEXTUAL is read from the local
+	// store so that signature verification is deterministic
+	if in.TxBankKeeper != nil {
+		txConfigOptions.EnabledSignModes = append(append([]signingtypes.SignMode{}, tx.DefaultSignModes...), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+		txConfigOptions.TextualCoinMetadataQueryFn = NewBankKeeperCoinMetadataQueryFn(in.TxBankKeeper)
+	}
 	txConfig := tx.NewTxConfigWithOptions(in.ProtoCodecMarshaler, txConfigOptions)
 
 	baseAppOption := func(app *baseapp.BaseApp) {
@@ -7,6 +7,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -77,6 +78,69 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 	}
 }
 
+// DefaultLenientTxDecoder returns a protobuf LenientTxDecoder using the provided Marshaler.
+// Unlike DefaultTxDecoder, it skips the fields unknown to the binary and reports them, and does
+// not check the txBytes follow ADR-027, so that tooling can decode txs produced by other versions
+// of the SDK. It must never be used in consensus paths.
+func DefaultLenientTxDecoder(cdc codec.ProtoCodecMarshaler) client.LenientTxDecoder {
+	return func(txBytes []byte) (sdk.Tx, []unknownproto.UnknownField, error) {
+		var raw tx.TxRaw
+		unknownFields, err := unknownproto.FindUnknownFields(txBytes, &raw, cdc.InterfaceRegistry())
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		err = cdc.Unmarshal(txBytes, &raw)
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		var body tx.TxBody
+		bodyUnknownFields, err := unknownproto.FindUnknownFields(raw.BodyBytes, &body, cdc.InterfaceRegistry())
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+		unknownFields = append(unknownFields, bodyUnknownFields...)
+
+		err = cdc.Unmarshal(raw.BodyBytes, &body)
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		var authInfo tx.AuthInfo
+		authInfoUnknownFields, err := unknownproto.FindUnknownFields(raw.AuthInfoBytes, &authInfo, cdc.InterfaceRegistry())
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+		unknownFields = append(unknownFields, authInfoUnknownFields...)
+
+		err = cdc.Unmarshal(raw.AuthInfoBytes, &authInfo)
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		txBodyHasUnknownNonCriticals := false
+		for _, f := range bodyUnknownFields {
+			if !f.IsCritical() {
+				txBodyHasUnknownNonCriticals = true
+			}
+		}
+
+		theTx := &tx.Tx{
+			Body:       &body,
+			AuthInfo:   &authInfo,
+			Signatures: raw.Signatures,
+		}
+
+		return &wrapper{
+			tx:                           theTx,
+			bodyBz:                       raw.BodyBytes,
+			authInfoBz:                   raw.AuthInfoBytes,
+			txBodyHasUnknownNonCriticals: txBodyHasUnknownNonCriticals,
+		}, unknownFields, nil
+	}
+}
+
 // DefaultJSONTxDecoder returns a default protobuf JSON TxDecoder using the provided Marshaler.
 func DefaultJSONTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
@@ -11,7 +11,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -161,6 +163,77 @@ func TestUnknownFields(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestLenientTxDecoder(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	decoder := DefaultTxDecoder(cdc)
+	lenientDecoder := DefaultLenientTxDecoder(cdc)
+
+	// a message with an unknown field injected into it
+	msgBz, err := testdata.NewTestMsg().Marshal()
+	require.NoError(t, err)
+	msgBz = protowire.AppendTag(msgBz, 20, protowire.VarintType)
+	msgBz = protowire.AppendVarint(msgBz, 1)
+	msgAny := &codectypes.Any{TypeUrl: "/testpb.TestMsg", Value: msgBz}
+
+	bodyBz, err := (&testdata.TestUpdatedTxBody{
+		Messages:                     []*codectypes.Any{msgAny},
+		Memo:                         "foo",
+		SomeNewField:                 10,
+		SomeNewFieldNonCriticalField: "blah",
+	}).Marshal()
+	require.NoError(t, err)
+	authInfoBz, err := (&testdata.TestUpdatedAuthInfo{
+		Fee:           &tx.Fee{GasLimit: 100},
+		NewField_1024: []byte("xyz"),
+	}).Marshal()
+	require.NoError(t, err)
+	txBz, err := (&testdata.TestUpdatedTxRaw{
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+		Signatures:    [][]byte{{42}},
+		NewField_5:    []byte("abc"),
+	}).Marshal()
+	require.NoError(t, err)
+
+	// consensus decoding still rejects the tx
+	_, err = decoder(txBz)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+
+	theTx, unknownFields, err := lenientDecoder(txBz)
+	require.NoError(t, err)
+	require.Equal(t, []unknownproto.UnknownField{
+		{Message: "cosmos.tx.v1beta1.TxRaw", TagNum: 5, WireType: protowire.BytesType},
+		{Message: "testpb.TestMsg", TagNum: 20, WireType: protowire.VarintType},
+		{Message: "cosmos.tx.v1beta1.TxBody", TagNum: 4, WireType: protowire.VarintType},
+		{Message: "cosmos.tx.v1beta1.TxBody", TagNum: 1050, WireType: protowire.BytesType},
+		{Message: "cosmos.tx.v1beta1.AuthInfo", TagNum: 1024, WireType: protowire.BytesType},
+	}, unknownFields)
+	require.True(t, unknownFields[0].IsCritical())
+	require.False(t, unknownFields[3].IsCritical())
+
+	// the known fields are decoded
+	w := theTx.(*wrapper)
+	require.Equal(t, "foo", w.GetMemo())
+	require.Equal(t, uint64(100), w.GetGas())
+	require.Equal(t, [][]byte{{42}}, w.tx.Signatures)
+	require.Equal(t, []sdk.Msg{testdata.NewTestMsg()}, w.GetMsgs())
+	require.True(t, w.txBodyHasUnknownNonCriticals)
+
+	// a tx without unknown fields is decoded the same way by both decoders
+	builder := newBuilder(nil)
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg()))
+	txBz, err = DefaultTxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	expTx, err := decoder(txBz)
+	require.NoError(t, err)
+	theTx, unknownFields, err = lenientDecoder(txBz)
+	require.NoError(t, err)
+	require.Empty(t, unknownFields)
+	require.Equal(t, expTx, theTx)
+}
+
 func TestRejectNonADR027(t *testing.T) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
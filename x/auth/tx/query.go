@@ -5,12 +5,14 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	querytypes "github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -60,32 +62,118 @@ func QueryTxsByEvents(clientCtx client.Context, page, limit int, query, orderBy
 	return sdk.NewSearchTxsResult(uint64(resTxs.TotalCount), uint64(len(txs)), uint64(page), uint64(limit), txs), nil
 }
 
-// QueryTx queries for a single transaction by a hash string in hex format. An
-// error is returned if the transaction does not exist or cannot be queried.
-func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, error) {
-	hash, err := hex.DecodeString(hashHexStr)
+// maxMergedTxSearchResults is the maximum number of transactions that each of
+// the searches merged by QueryTxsByEventQueries may return.
+const maxMergedTxSearchResults = 10_000
+
+// txSearchPerPage is the maximum number of transactions per page returned by
+// CometBFT's TxSearch RPC method.
+const txSearchPerPage = 100
+
+// QueryTxsByEventQueries retrieves a list of paginated transactions matching
+// any of the given events queries. As CometBFT's TxSearch RPC method does not
+// support OR, every query is searched for separately and the results are
+// merged, deduplicated and ordered by height and index in the block before
+// being paginated. Each query must not match more than 10000 transactions.
+// If maxHeight is positive, the transactions above it are filtered out.
+//
+// See QueryTxsByEvents for the semantics of page, limit and orderBy.
+func QueryTxsByEventQueries(clientCtx client.Context, page, limit int, queries []string, maxHeight int64, orderBy string) (*sdk.SearchTxsResult, error) {
+	if len(queries) == 0 {
+		return nil, errors.New("queries cannot be empty")
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = querytypes.DefaultLimit
+	}
+
+	node, err := clientCtx.GetNode()
 	if err != nil {
 		return nil, err
 	}
 
-	node, err := clientCtx.GetNode()
+	seen := make(map[string]bool)
+	var resTxs []*coretypes.ResultTx
+	for _, query := range queries {
+		if len(query) == 0 {
+			return nil, errors.New("query cannot be empty")
+		}
+
+		for searchPage, perPage, fetched := 1, txSearchPerPage, 0; ; searchPage++ {
+			res, err := node.TxSearch(context.Background(), query, false, &searchPage, &perPage, "asc")
+			if err != nil {
+				return nil, fmt.Errorf("failed to search for txs: %w", err)
+			}
+			if res.TotalCount > maxMergedTxSearchResults {
+				return nil, fmt.Errorf("query %q matches %d txs, more than the maximum of %d", query, res.TotalCount, maxMergedTxSearchResults)
+			}
+
+			for _, resTx := range res.Txs {
+				if maxHeight > 0 && resTx.Height > maxHeight {
+					continue
+				}
+				if !seen[string(resTx.Hash)] {
+					seen[string(resTx.Hash)] = true
+					resTxs = append(resTxs, resTx)
+				}
+			}
+
+			fetched += len(res.Txs)
+			if len(res.Txs) == 0 || fetched >= res.TotalCount {
+				break
+			}
+		}
+	}
+
+	desc := orderBy == "desc"
+	sort.SliceStable(resTxs, func(i, j int) bool {
+		a, b := resTxs[i], resTxs[j]
+		if desc {
+			a, b = b, a
+		}
+		if a.Height != b.Height {
+			return a.Height < b.Height
+		}
+		return a.Index < b.Index
+	})
+
+	total := len(resTxs)
+	start := (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+	resTxs = resTxs[start:end]
+
+	resBlocks, err := getBlocksForTxResults(clientCtx, resTxs)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: this may not always need to be proven
-	// https://github.com/cosmos/cosmos-sdk/issues/6807
-	resTx, err := node.Tx(context.Background(), hash, true)
+	txs, err := formatTxResults(clientCtx.TxConfig, resTxs, resBlocks)
 	if err != nil {
 		return nil, err
 	}
 
-	resBlocks, err := getBlocksForTxResults(clientCtx, []*coretypes.ResultTx{resTx})
+	return sdk.NewSearchTxsResult(uint64(total), uint64(len(txs)), uint64(page), uint64(limit), txs), nil
+}
+
+// QueryTx queries for a single transaction by a hash string in hex format. An
+// error is returned if the transaction does not exist or cannot be queried.
+func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, error) {
+	resTx, resBlock, err := queryTx(clientCtx, hashHexStr)
 	if err != nil {
 		return nil, err
 	}
 
-	out, err := mkTxResult(clientCtx.TxConfig, resTx, resBlocks[resTx.Height])
+	out, err := mkTxResult(clientCtx.TxConfig, resTx, resBlock)
 	if err != nil {
 		return out, err
 	}
@@ -93,6 +181,57 @@ func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, erro
 	return out, nil
 }
 
+// QueryTxLenient is QueryTx decoding the transaction with the lenient decoder
+// of the TxConfig, so that transactions containing fields unknown to the
+// binary can be queried. The fields skipped while decoding are returned along
+// with the transaction.
+func QueryTxLenient(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, []unknownproto.UnknownField, error) {
+	resTx, resBlock, err := queryTx(clientCtx, hashHexStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txb, unknownFields, err := clientCtx.TxConfig.TxDecoderLenient()(resTx.Tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out, err := newTxResponse(txb, resTx, resBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return out, unknownFields, nil
+}
+
+// queryTx queries for a single transaction by a hash string in hex format,
+// and for the block it is included in.
+func queryTx(clientCtx client.Context, hashHexStr string) (*coretypes.ResultTx, *coretypes.ResultBlock, error) {
+	hash, err := hex.DecodeString(hashHexStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: this may not always need to be proven
+	// https://github.com/cosmos/cosmos-sdk/issues/6807
+	resTx, err := node.Tx(context.Background(), hash, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resBlocks, err := getBlocksForTxResults(clientCtx, []*coretypes.ResultTx{resTx})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resTx, resBlocks[resTx.Height], nil
+}
+
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
 func formatTxResults(txConfig client.TxConfig, resTxs []*coretypes.ResultTx, resBlocks map[int64]*coretypes.ResultBlock) ([]*sdk.TxResponse, error) {
 	var err error
@@ -134,6 +273,11 @@ func mkTxResult(txConfig client.TxConfig, resTx *coretypes.ResultTx, resBlock *c
 	if err != nil {
 		return nil, err
 	}
+
+	return newTxResponse(txb, resTx, resBlock)
+}
+
+func newTxResponse(txb sdk.Tx, resTx *coretypes.ResultTx, resBlock *coretypes.ResultBlock) (*sdk.TxResponse, error) {
 	p, ok := txb.(intoAny)
 	if !ok {
 		return nil, fmt.Errorf("expecting a type implementing intoAny, got: %T", txb)
@@ -2,7 +2,9 @@ package tx
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -19,23 +21,33 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateMsgFn is the signature of the Baseapp#SimulateMsg function.
+type baseAppSimulateMsgFn func(ctx sdk.Context, msg sdk.Msg) (sdk.GasInfo, *sdk.Result, error)
+
+// paramsAuthority is the authority set on MsgUpdateParams-style messages
+// dry-run in non-strict mode, i.e. the governance module account.
+var paramsAuthority = authtypes.NewModuleAddress("gov")
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateMsg       baseAppSimulateMsgFn
 	interfaceRegistry codectypes.InterfaceRegistry
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, simulateMsg baseAppSimulateMsgFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
+		simulateMsg:       simulateMsg,
 		interfaceRegistry: interfaceRegistry,
 	}
 }
@@ -50,7 +62,24 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 
 	orderBy := parseOrderBy(req.OrderBy)
 
-	result, err := QueryTxsByEvents(s.clientCtx, int(req.Page), int(req.Limit), req.Query, orderBy)
+	queries, maxHeight := []string{req.Query}, int64(0)
+	if req.EventQuery != nil {
+		var err error
+		queries, maxHeight, err = eventQueries(req.Query, req.EventQuery)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var (
+		result *sdk.SearchTxsResult
+		err    error
+	)
+	if len(queries) == 1 && maxHeight == 0 {
+		result, err = QueryTxsByEvents(s.clientCtx, int(req.Page), int(req.Limit), queries[0], orderBy)
+	} else {
+		result, err = QueryTxsByEventQueries(s.clientCtx, int(req.Page), int(req.Limit), queries, maxHeight, orderBy)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -69,9 +98,66 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		Txs:         txsList,
 		TxResponses: result.Txs,
 		Total:       result.TotalCount,
+		Merged:      len(queries) > 1,
 	}, nil
 }
 
+// eventQueries translates a structured event query into CometBFT events
+// queries, one per condition group, as CometBFT does not support OR. The base
+// query, if any, and the height bounds are added to every group with AND.
+//
+// CometBFT only applies the first bound of a height range combined with events
+// conditions, so if both bounds are set and differ, the upper bound is returned
+// as maxHeight to be applied to the search results instead.
+func eventQueries(base string, eventQuery *txtypes.TxEventQuery) (queries []string, maxHeight int64, err error) {
+	fromHeight, toHeight := eventQuery.FromHeight, eventQuery.ToHeight
+	if fromHeight < 0 || toHeight < 0 {
+		return nil, 0, errors.New("heights cannot be negative")
+	}
+	if toHeight != 0 && fromHeight > toHeight {
+		return nil, 0, fmt.Errorf("from height %d is greater than to height %d", fromHeight, toHeight)
+	}
+
+	var common []string
+	if base = strings.TrimSpace(base); base != "" {
+		common = append(common, base)
+	}
+	switch {
+	case fromHeight != 0 && fromHeight == toHeight:
+		common = append(common, fmt.Sprintf("tx.height=%d", fromHeight))
+	case fromHeight != 0:
+		common = append(common, fmt.Sprintf("tx.height>=%d", fromHeight))
+		maxHeight = toHeight
+	case toHeight != 0:
+		common = append(common, fmt.Sprintf("tx.height<=%d", toHeight))
+	}
+
+	if len(eventQuery.Groups) == 0 {
+		if len(common) == 0 {
+			return nil, 0, errors.New("event query cannot be empty")
+		}
+		return []string{strings.Join(common, " AND ")}, maxHeight, nil
+	}
+
+	queries = make([]string, len(eventQuery.Groups))
+	for i, group := range eventQuery.Groups {
+		if group == nil || len(group.Conditions) == 0 {
+			return nil, 0, fmt.Errorf("condition group %d cannot be empty", i)
+		}
+
+		conditions := append([]string{}, common...)
+		for _, condition := range group.Conditions {
+			if condition = strings.TrimSpace(condition); condition == "" {
+				return nil, 0, fmt.Errorf("condition group %d cannot have empty conditions", i)
+			}
+			conditions = append(conditions, condition)
+		}
+		queries[i] = strings.Join(conditions, " AND ")
+	}
+
+	return queries, maxHeight, nil
+}
+
 // Simulate implements the ServiceServer.Simulate RPC method.
 func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
 	if req == nil {
@@ -106,6 +192,45 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateParamsUpdate implements the ServiceServer.SimulateParamsUpdate RPC method.
+func (s txServer) SimulateParamsUpdate(ctx context.Context, req *txtypes.SimulateParamsUpdateRequest) (*txtypes.SimulateParamsUpdateResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty msg")
+	}
+
+	if !strings.HasSuffix(req.Msg.TypeUrl, ".MsgUpdateParams") {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a MsgUpdateParams message", req.Msg.TypeUrl)
+	}
+
+	var msg sdk.Msg
+	if err := s.interfaceRegistry.UnpackAny(req.Msg, &msg); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid msg; %v", err)
+	}
+
+	if _, ok := msg.(interface{ GetAuthority() string }); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s has no authority", req.Msg.TypeUrl)
+	}
+
+	// Outside of strict mode the caller is only interested in whether the new
+	// params are valid, so the message is run on behalf of the authority.
+	if !req.Strict {
+		if err := setAuthority(msg, paramsAuthority.String()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	gasInfo, _, err := s.simulateMsg(sdk.UnwrapSDKContext(ctx), msg)
+	res := &txtypes.SimulateParamsUpdateResponse{
+		Valid:   err == nil,
+		GasInfo: &gasInfo,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -175,45 +300,66 @@ func (s txServer) GetBlockWithTxs(ctx context.Context, req *txtypes.GetBlockWith
 
 	blockTxs := block.Data.Txs
 	blockTxsLn := uint64(len(blockTxs))
-	txs := make([]*txtypes.Tx, 0, limit)
 	if offset >= blockTxsLn && blockTxsLn != 0 {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("out of range: cannot paginate %d txs with offset %d and limit %d", blockTxsLn, offset, limit)
 	}
+
+	reverse := req.Pagination != nil && req.Pagination.Reverse
+	txs, decodeErrors, err := decodeBlockTxs(s.clientCtx.TxConfig.TxDecoder(), blockTxs, offset, limit, reverse, req.SkipDecodeErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetBlockWithTxsResponse{
+		Txs:     txs,
+		BlockId: &blockID,
+		Block:   block,
+		Pagination: &query.PageResponse{
+			Total: blockTxsLn,
+		},
+		DecodeErrors: decodeErrors,
+	}, nil
+}
+
+// decodeBlockTxs decodes up to limit txs of a block starting from offset. If
+// skipDecodeErrors is set, the txs which cannot be decoded are left out of the
+// returned txs and their errors are returned along with their index in the
+// block, otherwise the first decoding error is returned.
+func decodeBlockTxs(txDecoder sdk.TxDecoder, blockTxs [][]byte, offset, limit uint64, reverse, skipDecodeErrors bool) ([]*txtypes.Tx, []*txtypes.TxDecodeError, error) {
+	blockTxsLn := uint64(len(blockTxs))
+	txs := make([]*txtypes.Tx, 0, limit)
+	var decodeErrors []*txtypes.TxDecodeError
 	decodeTxAt := func(i uint64) error {
-		tx := blockTxs[i]
-		txb, err := s.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			return err
+		txb, err := txDecoder(blockTxs[i])
+		if err == nil {
+			p, ok := txb.(protoTxProvider)
+			if ok {
+				txs = append(txs, p.GetProtoTx())
+				return nil
+			}
+			err = sdkerrors.ErrTxDecode.Wrapf("could not cast %T to %T", txb, txtypes.Tx{})
 		}
-		p, ok := txb.(protoTxProvider)
-		if !ok {
-			return sdkerrors.ErrTxDecode.Wrapf("could not cast %T to %T", txb, txtypes.Tx{})
+		if !skipDecodeErrors {
+			return err
 		}
-		txs = append(txs, p.GetProtoTx())
+		decodeErrors = append(decodeErrors, &txtypes.TxDecodeError{Index: i, Error: err.Error()})
 		return nil
 	}
-	if req.Pagination != nil && req.Pagination.Reverse {
+	if reverse {
 		for i, count := offset, uint64(0); i > 0 && count != limit; i, count = i-1, count+1 {
-			if err = decodeTxAt(i); err != nil {
-				return nil, err
+			if err := decodeTxAt(i); err != nil {
+				return nil, nil, err
 			}
 		}
 	} else {
 		for i, count := offset, uint64(0); i < blockTxsLn && count != limit; i, count = i+1, count+1 {
-			if err = decodeTxAt(i); err != nil {
-				return nil, err
+			if err := decodeTxAt(i); err != nil {
+				return nil, nil, err
 			}
 		}
 	}
 
-	return &txtypes.GetBlockWithTxsResponse{
-		Txs:     txs,
-		BlockId: &blockID,
-		Block:   block,
-		Pagination: &query.PageResponse{
-			Total: blockTxsLn,
-		},
-	}, nil
+	return txs, decodeErrors, nil
 }
 
 // BroadcastTx implements the ServiceServer.BroadcastTx RPC method.
@@ -309,11 +455,12 @@ func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
+	simulateMsgFn baseAppSimulateMsgFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, simulateMsgFn, interfaceRegistry),
 	)
 }
 
@@ -323,6 +470,23 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 	txtypes.RegisterServiceHandlerClient(context.Background(), mux, txtypes.NewServiceClient(clientConn))
 }
 
+// setAuthority overwrites the Authority field of a MsgUpdateParams-style
+// message.
+func setAuthority(msg sdk.Msg, authority string) error {
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	field := v.FieldByName("Authority")
+	if !field.IsValid() || field.Kind() != reflect.String || !field.CanSet() {
+		return fmt.Errorf("cannot set authority on %s", sdk.MsgTypeURL(msg))
+	}
+
+	field.SetString(authority)
+	return nil
+}
+
 func parseOrderBy(orderBy txtypes.OrderBy) string {
 	switch orderBy {
 	case txtypes.OrderBy_ORDER_BY_ASC:
@@ -0,0 +1,56 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestDecodeBlockTxs(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	encoder := DefaultTxEncoder()
+	decoder := DefaultTxDecoder(cdc)
+
+	tip := &tx.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Tipper: "tipper"}
+	blockTxs := make([][]byte, 3)
+	for i, memo := range []string{"first", "", "third"} {
+		builder := newBuilder(cdc)
+		require.NoError(t, builder.SetMsgs(testdata.NewTestMsg()))
+		builder.SetMemo(memo)
+		if i == 2 {
+			builder.SetTip(tip)
+		}
+		txBz, err := encoder(builder.GetTx())
+		require.NoError(t, err)
+		blockTxs[i] = txBz
+	}
+	blockTxs[1] = []byte("not a tx")
+
+	// the undecodable tx fails the whole page by default
+	_, _, err := decodeBlockTxs(decoder, blockTxs, 0, 10, false, false)
+	require.Error(t, err)
+
+	txs, decodeErrors, err := decodeBlockTxs(decoder, blockTxs, 0, 10, false, true)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, "first", txs[0].Body.Memo)
+	require.Equal(t, "third", txs[1].Body.Memo)
+	require.Equal(t, tip, txs[1].AuthInfo.Tip)
+	require.Len(t, decodeErrors, 1)
+	require.Equal(t, uint64(1), decodeErrors[0].Index)
+	require.NotEmpty(t, decodeErrors[0].Error)
+
+	// pages without undecodable txs have no decode errors
+	txs, decodeErrors, err = decodeBlockTxs(decoder, blockTxs, 2, 10, false, true)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Empty(t, decodeErrors)
+}
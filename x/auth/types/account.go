@@ -11,6 +11,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -104,6 +105,18 @@ func (acc *BaseAccount) SetAccountNumber(accNumber uint64) error {
 	return nil
 }
 
+// SetPubKeyRotated marks the pubkey of the account as rotated with
+// MsgUpdateAccountPubKey, so that it is not required to match the address.
+func (acc *BaseAccount) SetPubKeyRotated() {
+	acc.PubKeyRotated = true
+}
+
+// IsPubKeyRotated returns whether the pubkey of the account was rotated with
+// MsgUpdateAccountPubKey.
+func (acc BaseAccount) IsPubKeyRotated() bool {
+	return acc.PubKeyRotated
+}
+
 // GetSequence - Implements sdk.AccountI.
 func (acc BaseAccount) GetSequence() uint64 {
 	return acc.Sequence
@@ -126,7 +139,14 @@ func (acc BaseAccount) Validate() error {
 		return err
 	}
 
-	if !bytes.Equal(acc.GetPubKey().Address().Bytes(), accAddr.Bytes()) {
+	// a multisig pubkey rotated with MsgUpdateAccountPubKey doesn't match the
+	// account address
+	pubKey := acc.GetPubKey()
+	if _, ok := pubKey.(multisig.PubKey); ok && acc.PubKeyRotated {
+		return nil
+	}
+
+	if !bytes.Equal(pubKey.Address().Bytes(), accAddr.Bytes()) {
 		return errors.New("account address and pubkey address do not match")
 	}
 
@@ -300,6 +320,15 @@ func (ga GenesisAccounts) Contains(addr sdk.Address) bool {
 	return false
 }
 
+// PubKeyRotatableAccount defines an account whose pubkey can be rotated with
+// MsgUpdateAccountPubKey, e.g. a BaseAccount.
+type PubKeyRotatableAccount interface {
+	sdk.AccountI
+
+	SetPubKeyRotated()
+	IsPubKeyRotated() bool
+}
+
 // GenesisAccount defines a genesis account that embeds an AccountI with validation capabilities.
 type GenesisAccount interface {
 	sdk.AccountI
@@ -11,7 +11,9 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -101,6 +103,10 @@ func TestGenesisAccountValidate(t *testing.T) {
 	addr := sdk.AccAddress(pubkey.Address())
 	baseAcc := types.NewBaseAccount(addr, pubkey, 0, 0)
 
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pubkey, secp256k1.GenPrivKey().PubKey()})
+	rotatedAcc := types.NewBaseAccount(addr, multisigPubKey, 0, 0)
+	rotatedAcc.SetPubKeyRotated()
+
 	tests := []struct {
 		name   string
 		acc    types.GenesisAccount
@@ -116,6 +122,16 @@ func TestGenesisAccountValidate(t *testing.T) {
 			types.NewBaseAccount(addr, secp256k1.GenPrivKey().PubKey(), 0, 0),
 			true,
 		},
+		{
+			"multisig pubkey not matching the address of a rotated account",
+			rotatedAcc,
+			false,
+		},
+		{
+			"multisig pubkey not matching the address of a non rotated account",
+			types.NewBaseAccount(addr, multisigPubKey, 0, 0),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -34,6 +34,11 @@ type BaseAccount struct {
 	PubKey        *types.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key,omitempty"`
 	AccountNumber uint64     `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	Sequence      uint64     `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// pub_key_rotated is set once the pubkey of the account is rotated with
+	// MsgUpdateAccountPubKey, after which the pubkey doesn't match the address.
+	//
+	// Since: cosmos-sdk 0.48
+	PubKeyRotated bool `protobuf:"varint,5,opt,name=pub_key_rotated,json=pubKeyRotated,proto3" json:"pub_key_rotated,omitempty"`
 }
 
 func (m *BaseAccount) Reset()         { *m = BaseAccount{} }
@@ -174,6 +179,17 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// sig_verify_costs defines the signature verification gas costs of public
+	// key types, overriding the costs above. The cost of a type which is not
+	// listed is the one of the corresponding field above, if any.
+	//
+	// Since: cosmos-sdk 0.48
+	SigVerifyCosts []SigVerifyCost `protobuf:"bytes,6,rep,name=sig_verify_costs,json=sigVerifyCosts,proto3" json:"sig_verify_costs"`
+	// enable_pubkey_rotation defines whether accounts can rotate their pubkey
+	// to a multisig pubkey with MsgUpdateAccountPubKey.
+	//
+	// Since: cosmos-sdk 0.48
+	EnablePubkeyRotation bool `protobuf:"varint,7,opt,name=enable_pubkey_rotation,json=enablePubkeyRotation,proto3" json:"enable_pubkey_rotation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,63 +260,144 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigVerifyCosts() []SigVerifyCost {
+	if m != nil {
+		return m.SigVerifyCosts
+	}
+	return nil
+}
+
+func (m *Params) GetEnablePubkeyRotation() bool {
+	if m != nil {
+		return m.EnablePubkeyRotation
+	}
+	return false
+}
+
+// SigVerifyCost defines the signature verification gas cost of a public key
+// type.
+//
+// Since: cosmos-sdk 0.48
+type SigVerifyCost struct {
+	// pub_key_type is the type of the public keys, as returned by their Type
+	// method, e.g. "secp256r1".
+	PubKeyType string `protobuf:"bytes,1,opt,name=pub_key_type,json=pubKeyType,proto3" json:"pub_key_type,omitempty"`
+	// cost is the gas consumed to verify a signature of a public key of the type.
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (m *SigVerifyCost) Reset()         { *m = SigVerifyCost{} }
+func (m *SigVerifyCost) String() string { return proto.CompactTextString(m) }
+func (*SigVerifyCost) ProtoMessage()    {}
+func (*SigVerifyCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *SigVerifyCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigVerifyCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigVerifyCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigVerifyCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigVerifyCost.Merge(m, src)
+}
+func (m *SigVerifyCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigVerifyCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigVerifyCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigVerifyCost proto.InternalMessageInfo
+
+func (m *SigVerifyCost) GetPubKeyType() string {
+	if m != nil {
+		return m.PubKeyType
+	}
+	return ""
+}
+
+func (m *SigVerifyCost) GetCost() uint64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*SigVerifyCost)(nil), "cosmos.auth.v1beta1.SigVerifyCost")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xeb, 0x46,
-	0x14, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x29,
-	0x2a, 0x76, 0x93, 0x8a, 0x4a, 0xcd, 0x8e, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x2a, 0x0b, 0x36,
-	0xd6, 0xd8, 0x19, 0xcc, 0x88, 0x8c, 0xc7, 0xf5, 0x8c, 0x51, 0xcc, 0xba, 0x0b, 0xd4, 0x55, 0xd5,
-	0x2f, 0xa0, 0xfd, 0x02, 0x16, 0x7c, 0x44, 0xd5, 0x15, 0xea, 0xa6, 0x6f, 0x15, 0x3d, 0x85, 0x05,
-	0xe8, 0xe9, 0x7d, 0xc4, 0x93, 0x67, 0x1c, 0x48, 0x78, 0xd9, 0x44, 0x9e, 0x73, 0xce, 0xbd, 0xf7,
-	0xdc, 0xe3, 0x89, 0x41, 0xd5, 0xa3, 0x8c, 0x50, 0x66, 0xc1, 0x98, 0x9f, 0x59, 0x17, 0x0d, 0x17,
-	0x71, 0xd8, 0x10, 0x07, 0x33, 0x8c, 0x28, 0xa7, 0xea, 0xaa, 0xe4, 0x4d, 0x01, 0x65, 0x7c, 0x65,
-	0x05, 0x12, 0x1c, 0x50, 0x4b, 0xfc, 0x4a, 0x5d, 0x65, 0x5d, 0xea, 0x1c, 0x71, 0xb2, 0xb2, 0x22,
-	0x49, 0x95, 0x7d, 0xea, 0x53, 0x89, 0xa7, 0x4f, 0xe3, 0x02, 0x9f, 0x52, 0xbf, 0x8f, 0x2c, 0x71,
-	0x72, 0xe3, 0x53, 0x0b, 0x06, 0x89, 0xa4, 0x6a, 0x7f, 0xe5, 0x41, 0xa9, 0x0d, 0x19, 0xda, 0xf5,
-	0x3c, 0x1a, 0x07, 0x5c, 0x6d, 0x82, 0x79, 0xd8, 0xeb, 0x45, 0x88, 0x31, 0x4d, 0x31, 0x94, 0xfa,
-	0x42, 0x5b, 0xfb, 0xef, 0x76, 0xbb, 0x9c, 0xcd, 0xd8, 0x95, 0x4c, 0x97, 0x47, 0x38, 0xf0, 0xed,
-	0xb1, 0x50, 0x3d, 0x06, 0xf3, 0x61, 0xec, 0x3a, 0xe7, 0x28, 0xd1, 0xf2, 0x86, 0x52, 0x2f, 0x35,
-	0xcb, 0xa6, 0x1c, 0x68, 0x8e, 0x07, 0x9a, 0xbb, 0x41, 0xd2, 0xde, 0x7c, 0x33, 0xd4, 0xcb, 0x61,
-	0xec, 0xf6, 0xb1, 0x97, 0x6a, 0xbf, 0xa2, 0x04, 0x73, 0x44, 0x42, 0x9e, 0xfc, 0xfd, 0x70, 0xb3,
-	0x05, 0x9e, 0x09, 0x7b, 0x2e, 0x8c, 0xdd, 0x7d, 0x94, 0xa8, 0x9f, 0x83, 0x65, 0x28, 0x6d, 0x39,
-	0x41, 0x4c, 0x5c, 0x14, 0x69, 0x05, 0x43, 0xa9, 0x17, 0xed, 0xa5, 0x0c, 0x3d, 0x14, 0xa0, 0x5a,
-	0x01, 0x1f, 0x32, 0xf4, 0x6b, 0x8c, 0x02, 0x0f, 0x69, 0x45, 0x21, 0x78, 0x3a, 0xb7, 0x3a, 0x57,
-	0xd7, 0x7a, 0xee, 0xf1, 0x5a, 0xcf, 0xfd, 0x7b, 0xbb, 0xfd, 0xd9, 0x8c, 0x78, 0xcd, 0x6c, 0xef,
-	0xbd, 0xdf, 0x1f, 0x6e, 0xb6, 0xd6, 0xa4, 0x60, 0x9b, 0xf5, 0xce, 0xad, 0x89, 0x4c, 0x6a, 0x6f,
-	0x15, 0xb0, 0x74, 0x40, 0x7b, 0x71, 0xff, 0x29, 0xa5, 0x3d, 0xb0, 0xe8, 0x42, 0x86, 0x9c, 0xcc,
-	0x88, 0x88, 0xaa, 0xd4, 0x34, 0xcc, 0x59, 0x13, 0x26, 0x3a, 0xb5, 0x8b, 0x77, 0x43, 0x5d, 0xb1,
-	0x4b, 0xee, 0x44, 0xe0, 0x2a, 0x28, 0x06, 0x90, 0x20, 0x91, 0xdc, 0x82, 0x2d, 0x9e, 0x55, 0x03,
-	0x94, 0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0xbe, 0x60, 0x4f, 0x42,
-	0xad, 0x93, 0x2b, 0xb9, 0x53, 0x6d, 0xd6, 0xc4, 0x29, 0xaf, 0x62, 0x33, 0x6d, 0x62, 0xb3, 0x29,
-	0xf6, 0xcf, 0x87, 0x9b, 0xad, 0x65, 0x22, 0x90, 0xf1, 0x32, 0xb5, 0xdf, 0x14, 0xf0, 0xb1, 0x14,
-	0x75, 0x22, 0xd4, 0x43, 0x01, 0xc7, 0xb0, 0xaf, 0xea, 0xa0, 0x94, 0xc9, 0x84, 0x5b, 0x71, 0x37,
-	0x6c, 0x20, 0xa1, 0xc3, 0xd4, 0xf3, 0x26, 0xf8, 0xa8, 0x87, 0x22, 0x7c, 0x01, 0x39, 0xa6, 0x41,
-	0xfa, 0x1a, 0x99, 0x96, 0x37, 0x0a, 0xf5, 0x45, 0x7b, 0xf9, 0x19, 0xde, 0x47, 0x09, 0x6b, 0x7d,
-	0x91, 0x1a, 0xda, 0x98, 0x30, 0xf4, 0x63, 0x44, 0xe3, 0x30, 0xf3, 0xf3, 0x3c, 0xb1, 0xf6, 0x7f,
-	0x1e, 0xcc, 0x1d, 0xc1, 0x08, 0x12, 0xa6, 0x9a, 0x60, 0x95, 0xc0, 0x81, 0x43, 0x10, 0xa1, 0x8e,
-	0x77, 0x06, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x0b, 0x5a, 0xb4, 0x57, 0x08, 0x1c, 0x1c, 0x20, 0x42,
-	0x3b, 0x4f, 0x84, 0x6a, 0x80, 0x45, 0x3e, 0x70, 0x18, 0xf6, 0x9d, 0x3e, 0x26, 0x98, 0x8b, 0x6c,
-	0x8b, 0x36, 0xe0, 0x83, 0x2e, 0xf6, 0x7f, 0x4a, 0x11, 0xf5, 0x6b, 0xf0, 0x89, 0x50, 0x5c, 0x22,
-	0xc7, 0xa3, 0x8c, 0x3b, 0x21, 0x8a, 0x1c, 0x37, 0xe1, 0x28, 0xbb, 0x61, 0x2b, 0xa9, 0xf4, 0x12,
-	0x75, 0x28, 0xe3, 0x47, 0x28, 0x6a, 0x27, 0x1c, 0xa9, 0x3f, 0x83, 0x4f, 0xd3, 0x86, 0x17, 0x28,
-	0xc2, 0xa7, 0x89, 0x2c, 0x42, 0xbd, 0xe6, 0xce, 0x4e, 0xe3, 0x3b, 0x79, 0xe9, 0xda, 0xda, 0x68,
-	0xa8, 0x97, 0xbb, 0xd8, 0x3f, 0x16, 0x8a, 0xb4, 0xf4, 0x87, 0xef, 0x05, 0x6f, 0x97, 0xd9, 0x14,
-	0x2a, 0xab, 0xd4, 0x5f, 0xc0, 0xfa, 0xcb, 0x86, 0x0c, 0x79, 0x61, 0x73, 0xe7, 0xdb, 0xf3, 0x86,
-	0xf6, 0x81, 0x68, 0x59, 0x19, 0x0d, 0xf5, 0xb5, 0xa9, 0x96, 0xdd, 0xb1, 0xc2, 0x5e, 0x63, 0x33,
-	0xf1, 0xd6, 0xc6, 0xe3, 0xb5, 0xae, 0xbc, 0x7c, 0xe7, 0x03, 0xf9, 0xcd, 0x91, 0x71, 0xb6, 0x3b,
-	0xff, 0x8c, 0xaa, 0xca, 0xdd, 0xa8, 0xaa, 0xbc, 0x1e, 0x55, 0x95, 0x3f, 0xee, 0xab, 0xb9, 0xbb,
-	0xfb, 0x6a, 0xee, 0xd5, 0x7d, 0x35, 0x77, 0xf2, 0xa5, 0x8f, 0xf9, 0x59, 0xec, 0x9a, 0x1e, 0x25,
-	0xd9, 0x77, 0xc5, 0x7a, 0xbf, 0x0b, 0x4f, 0x42, 0xc4, 0xdc, 0x39, 0xf1, 0xdf, 0xfe, 0xe6, 0x5d,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x84, 0x5b, 0x40, 0xd5, 0x04, 0x00, 0x00,
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0xd0, 0x6e, 0x27, 0x6d, 0x77, 0xeb, 0x0d, 0xc5, 0x5b, 0xa1, 0xd8, 0x1b, 0x89,
+	0xdd, 0x50, 0x51, 0x87, 0x06, 0x8a, 0x44, 0x6e, 0x75, 0x40, 0x68, 0x55, 0x76, 0xa9, 0x5c, 0x58,
+	0xa4, 0xbd, 0x58, 0x63, 0xe7, 0xad, 0x3b, 0x6a, 0xc6, 0x63, 0x3c, 0xe3, 0x2a, 0xde, 0x33, 0x87,
+	0x15, 0x27, 0xc4, 0x2f, 0xa8, 0x38, 0x71, 0xec, 0x61, 0x7f, 0xc4, 0xc2, 0xa9, 0xe2, 0xc4, 0x29,
+	0x42, 0xa9, 0x44, 0x56, 0x88, 0x1f, 0x81, 0x3c, 0xe3, 0xa4, 0x49, 0x37, 0x17, 0xcb, 0xf3, 0x7d,
+	0xdf, 0xbc, 0xf7, 0xcd, 0x7b, 0x6f, 0x06, 0xd5, 0x03, 0xc6, 0x29, 0xe3, 0x2d, 0x9c, 0x8a, 0x93,
+	0xd6, 0xd9, 0x9e, 0x0f, 0x02, 0xef, 0xc9, 0x85, 0x1d, 0x27, 0x4c, 0x30, 0xfd, 0xae, 0xe2, 0x6d,
+	0x09, 0x15, 0xfc, 0xf6, 0x26, 0xa6, 0x24, 0x62, 0x2d, 0xf9, 0x55, 0xba, 0xed, 0x7b, 0x4a, 0xe7,
+	0xc9, 0x55, 0xab, 0xd8, 0xa4, 0xa8, 0x5a, 0xc8, 0x42, 0xa6, 0xf0, 0xfc, 0x6f, 0xb2, 0x21, 0x64,
+	0x2c, 0xec, 0x43, 0x4b, 0xae, 0xfc, 0xf4, 0x79, 0x0b, 0x47, 0x99, 0xa2, 0x1a, 0xbf, 0x2f, 0xa1,
+	0xaa, 0x83, 0x39, 0x1c, 0x04, 0x01, 0x4b, 0x23, 0xa1, 0xb7, 0xd1, 0x0a, 0xee, 0xf5, 0x12, 0xe0,
+	0xdc, 0xd0, 0x2c, 0xad, 0xb9, 0xea, 0x18, 0x7f, 0xbe, 0xda, 0xad, 0x15, 0x39, 0x0e, 0x14, 0x73,
+	0x2c, 0x12, 0x12, 0x85, 0xee, 0x44, 0xa8, 0x3f, 0x45, 0x2b, 0x71, 0xea, 0x7b, 0xa7, 0x90, 0x19,
+	0x4b, 0x96, 0xd6, 0xac, 0xb6, 0x6b, 0xb6, 0x4a, 0x68, 0x4f, 0x12, 0xda, 0x07, 0x51, 0xe6, 0x3c,
+	0xfc, 0x77, 0x68, 0xd6, 0xe2, 0xd4, 0xef, 0x93, 0x20, 0xd7, 0x7e, 0xc4, 0x28, 0x11, 0x40, 0x63,
+	0x91, 0xfd, 0x3a, 0xbe, 0xd8, 0x41, 0xd7, 0x84, 0xbb, 0x1c, 0xa7, 0xfe, 0x21, 0x64, 0xfa, 0x07,
+	0x68, 0x03, 0x2b, 0x5b, 0x5e, 0x94, 0x52, 0x1f, 0x12, 0xa3, 0x6c, 0x69, 0xcd, 0x8a, 0xbb, 0x5e,
+	0xa0, 0x4f, 0x24, 0xa8, 0x6f, 0xa3, 0x5b, 0x1c, 0x7e, 0x48, 0x21, 0x0a, 0xc0, 0xa8, 0x48, 0xc1,
+	0x74, 0xad, 0x3f, 0x40, 0xb7, 0x0b, 0x6b, 0x5e, 0xc2, 0x04, 0x16, 0xd0, 0x33, 0xde, 0xb1, 0xb4,
+	0xe6, 0x2d, 0x77, 0x5d, 0xe5, 0x70, 0x15, 0xd8, 0xe9, 0xbe, 0x3c, 0x37, 0x4b, 0x6f, 0xce, 0xcd,
+	0xd2, 0x1f, 0xaf, 0x76, 0xdf, 0x5f, 0xd0, 0x06, 0xbb, 0xa8, 0xcf, 0xa3, 0x9f, 0xc6, 0x17, 0x3b,
+	0x5b, 0x4a, 0xb0, 0xcb, 0x7b, 0xa7, 0xad, 0x99, 0xda, 0x35, 0xfe, 0xd3, 0xd0, 0xfa, 0x63, 0xd6,
+	0x4b, 0xfb, 0xd3, 0x6a, 0x3e, 0x42, 0x6b, 0x3e, 0xe6, 0xe0, 0x15, 0x86, 0x65, 0x49, 0xab, 0x6d,
+	0xcb, 0x5e, 0x94, 0x61, 0x26, 0x92, 0x53, 0xb9, 0x1c, 0x9a, 0x9a, 0x5b, 0xf5, 0x67, 0x1a, 0xa3,
+	0xa3, 0x4a, 0x84, 0x29, 0xc8, 0x0a, 0xaf, 0xba, 0xf2, 0x5f, 0xb7, 0x50, 0x35, 0x86, 0x84, 0x12,
+	0xce, 0x09, 0x8b, 0xb8, 0x51, 0xb6, 0xca, 0xcd, 0x55, 0x77, 0x16, 0xea, 0x3c, 0x7b, 0xa9, 0xce,
+	0xd4, 0x58, 0x94, 0x71, 0xce, 0xab, 0x3c, 0x99, 0x31, 0x73, 0xb2, 0x39, 0xf6, 0x97, 0xf1, 0xc5,
+	0xce, 0x06, 0x95, 0xc8, 0xe4, 0x30, 0x8d, 0x1f, 0x35, 0x74, 0x47, 0x89, 0xba, 0x09, 0xf4, 0x20,
+	0x12, 0x04, 0xf7, 0x75, 0x13, 0x55, 0x0b, 0x99, 0x74, 0x2b, 0x67, 0xc8, 0x45, 0x0a, 0x7a, 0x92,
+	0x7b, 0x7e, 0x88, 0x6e, 0xf7, 0x20, 0x21, 0x67, 0x58, 0x10, 0x16, 0xe5, 0x8d, 0xe1, 0xc6, 0x92,
+	0x55, 0x6e, 0xae, 0xb9, 0x1b, 0xd7, 0xf0, 0x21, 0x64, 0xbc, 0xf3, 0x20, 0x37, 0x74, 0x7f, 0xc6,
+	0xd0, 0x57, 0x09, 0x4b, 0xe3, 0xc2, 0xcf, 0x75, 0xc6, 0xc6, 0x3f, 0x65, 0xb4, 0x7c, 0x84, 0x13,
+	0x4c, 0xb9, 0x6e, 0xa3, 0xbb, 0x14, 0x0f, 0x3c, 0x0a, 0x94, 0x79, 0xc1, 0x09, 0x4e, 0x70, 0x20,
+	0x20, 0x51, 0x83, 0x5c, 0x71, 0x37, 0x29, 0x1e, 0x3c, 0x06, 0xca, 0xba, 0x53, 0x42, 0xb7, 0xd0,
+	0x9a, 0x18, 0x78, 0x9c, 0x84, 0x5e, 0x9f, 0x50, 0x22, 0x64, 0x6d, 0x2b, 0x2e, 0x12, 0x83, 0x63,
+	0x12, 0x7e, 0x9d, 0x23, 0xfa, 0xc7, 0xe8, 0x5d, 0xa9, 0x78, 0x01, 0x5e, 0xc0, 0xb8, 0xf0, 0x62,
+	0x48, 0x3c, 0x3f, 0x13, 0x50, 0x4c, 0xe2, 0x66, 0x2e, 0x7d, 0x01, 0x5d, 0xc6, 0xc5, 0x11, 0x24,
+	0x4e, 0x26, 0x40, 0xff, 0x06, 0xbd, 0x97, 0x07, 0x3c, 0x83, 0x84, 0x3c, 0xcf, 0xd4, 0x26, 0xe8,
+	0xb5, 0xf7, 0xf7, 0xf7, 0x3e, 0x57, 0xc3, 0xe9, 0x18, 0xa3, 0xa1, 0x59, 0x3b, 0x26, 0xe1, 0x53,
+	0xa9, 0xc8, 0xb7, 0x7e, 0xf9, 0x85, 0xe4, 0xdd, 0x1a, 0x9f, 0x43, 0xd5, 0x2e, 0xfd, 0x3b, 0x74,
+	0xef, 0x66, 0x40, 0x0e, 0x41, 0xdc, 0xde, 0xff, 0xec, 0x74, 0x4f, 0x0e, 0x73, 0xc5, 0xd9, 0x1e,
+	0x0d, 0xcd, 0xad, 0xb9, 0x90, 0xc7, 0x13, 0x85, 0xbb, 0xc5, 0x17, 0xe2, 0xfa, 0xf7, 0xe8, 0xce,
+	0x8d, 0xb0, 0xdc, 0x58, 0xb6, 0xca, 0xcd, 0x6a, 0xbb, 0xb1, 0x70, 0x3c, 0xe7, 0xc2, 0x3b, 0xab,
+	0xaf, 0x87, 0x66, 0xe9, 0xb7, 0xf1, 0xc5, 0x8e, 0xe6, 0x6e, 0xcc, 0x25, 0xe0, 0xfa, 0xa7, 0x68,
+	0x0b, 0x22, 0xec, 0xf7, 0xc1, 0x8b, 0x53, 0x7f, 0x7a, 0xf1, 0x08, 0x8b, 0x8c, 0x15, 0x79, 0xf3,
+	0x6a, 0x8a, 0x3d, 0x92, 0xa4, 0x5b, 0x70, 0x9d, 0xfb, 0x6f, 0xce, 0x4d, 0xed, 0xe6, 0x08, 0x0e,
+	0xd4, 0x53, 0xa9, 0xba, 0xdb, 0x38, 0x44, 0xeb, 0x73, 0x26, 0xf2, 0xf6, 0x4d, 0x2e, 0xb7, 0xc8,
+	0xe2, 0xe9, 0xb0, 0xa9, 0x9b, 0xfd, 0x6d, 0x16, 0x43, 0x7e, 0x69, 0xf2, 0x93, 0x15, 0x8d, 0x95,
+	0xff, 0x9d, 0x4a, 0x9e, 0xc9, 0xe9, 0xbe, 0x1e, 0xd5, 0xb5, 0xcb, 0x51, 0x5d, 0xfb, 0x7b, 0x54,
+	0xd7, 0x7e, 0xbe, 0xaa, 0x97, 0x2e, 0xaf, 0xea, 0xa5, 0xbf, 0xae, 0xea, 0xa5, 0x67, 0x1f, 0x86,
+	0x44, 0x9c, 0xa4, 0xbe, 0x1d, 0x30, 0x5a, 0xbc, 0xad, 0xad, 0xb7, 0x2d, 0xe5, 0xf9, 0xb8, 0xbf,
+	0x2c, 0xdf, 0xb7, 0x4f, 0xfe, 0x1f, 0x00, 0x02, 0x01, 0x96, 0xa3, 0xd9, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +434,44 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if len(this.SigVerifyCosts) != len(that1.SigVerifyCosts) {
+		return false
+	}
+	for i := range this.SigVerifyCosts {
+		if !this.SigVerifyCosts[i].Equal(&that1.SigVerifyCosts[i]) {
+			return false
+		}
+	}
+	if this.EnablePubkeyRotation != that1.EnablePubkeyRotation {
+		return false
+	}
+	return true
+}
+func (this *SigVerifyCost) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SigVerifyCost)
+	if !ok {
+		that2, ok := that.(SigVerifyCost)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PubKeyType != that1.PubKeyType {
+		return false
+	}
+	if this.Cost != that1.Cost {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -359,6 +494,16 @@ func (m *BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubKeyRotated {
+		i--
+		if m.PubKeyRotated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
 		i--
@@ -501,6 +646,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnablePubkeyRotation {
+		i--
+		if m.EnablePubkeyRotation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SigVerifyCosts) > 0 {
+		for iNdEx := len(m.SigVerifyCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigVerifyCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -529,6 +698,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigVerifyCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigVerifyCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigVerifyCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKeyType) > 0 {
+		i -= len(m.PubKeyType)
+		copy(dAtA[i:], m.PubKeyType)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.PubKeyType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -560,6 +764,9 @@ func (m *BaseAccount) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovAuth(uint64(m.Sequence))
 	}
+	if m.PubKeyRotated {
+		n += 2
+	}
 	return n
 }
 
@@ -626,6 +833,31 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if len(m.SigVerifyCosts) > 0 {
+		for _, e := range m.SigVerifyCosts {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.EnablePubkeyRotation {
+		n += 2
+	}
+	return n
+}
+
+func (m *SigVerifyCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKeyType)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovAuth(uint64(m.Cost))
+	}
 	return n
 }
 
@@ -770,6 +1002,26 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PubKeyRotated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -1179,6 +1431,161 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigVerifyCosts = append(m.SigVerifyCosts, SigVerifyCost{})
+			if err := m.SigVerifyCosts[len(m.SigVerifyCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePubkeyRotation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePubkeyRotation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigVerifyCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigVerifyCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigVerifyCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -1,13 +1,19 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
 var (
-	_ sdk.Msg            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgUpdateAccountPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateAccountPubKey)(nil)
+
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgUpdateAccountPubKey{}
 )
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -20,3 +26,35 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgUpdateAccountPubKey creates a new MsgUpdateAccountPubKey instance.
+func NewMsgUpdateAccountPubKey(addr sdk.AccAddress, newPubKey cryptotypes.PubKey) (*MsgUpdateAccountPubKey, error) {
+	var pkAny *codectypes.Any
+	if newPubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(newPubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgUpdateAccountPubKey{
+		Address:   addr.String(),
+		NewPubKey: pkAny,
+	}, nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateAccountPubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateAccountPubKey message.
+func (msg MsgUpdateAccountPubKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateAccountPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
@@ -43,10 +43,26 @@ func DefaultParams() Params {
 //
 // Based on the results above secp256k1 is 2.7x is slwer. However we propose to discount it
 // because we are we don't compare the cgo implementation of secp256k1, which is faster.
+//
+// The cost can be overridden with SigVerifyCosts.
 func (p Params) SigVerifyCostSecp256r1() uint64 {
+	if cost, ok := p.SigVerifyCost("secp256r1"); ok {
+		return cost
+	}
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCost returns the signature verification gas cost set for the public
+// key type pubKeyType in SigVerifyCosts, if any.
+func (p Params) SigVerifyCost(pubKeyType string) (uint64, bool) {
+	for _, c := range p.SigVerifyCosts {
+		if c.PubKeyType == pubKeyType {
+			return c.Cost, true
+		}
+	}
+	return 0, false
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -86,6 +102,30 @@ func validateSigVerifyCostSecp256k1(i interface{}) error {
 	return nil
 }
 
+func validateSigVerifyCosts(i interface{}) error {
+	v, ok := i.([]SigVerifyCost)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, c := range v {
+		if c.PubKeyType == "" {
+			return fmt.Errorf("empty public key type of signature verification cost")
+		}
+		if seen[c.PubKeyType] {
+			return fmt.Errorf("duplicate %s signature verification cost", c.PubKeyType)
+		}
+		seen[c.PubKeyType] = true
+
+		if c.Cost == 0 {
+			return fmt.Errorf("invalid %s signature verification cost: %d", c.PubKeyType, c.Cost)
+		}
+	}
+
+	return nil
+}
+
 func validateMaxMemoCharacters(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -123,6 +163,9 @@ func (p Params) Validate() error {
 	if err := validateSigVerifyCostSecp256k1(p.SigVerifyCostSecp256k1); err != nil {
 		return err
 	}
+	if err := validateSigVerifyCosts(p.SigVerifyCosts); err != nil {
+		return err
+	}
 	if err := validateMaxMemoCharacters(p.MaxMemoCharacters); err != nil {
 		return err
 	}
@@ -35,6 +35,14 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"valid signature verification costs", withSigVerifyCosts(types.SigVerifyCost{PubKeyType: "secp256r1", Cost: 700},
+			types.SigVerifyCost{PubKeyType: "bls12_381", Cost: 2500}), nil},
+		{"empty signature verification cost type", withSigVerifyCosts(types.SigVerifyCost{Cost: 700}),
+			fmt.Errorf("empty public key type of signature verification cost")},
+		{"duplicate signature verification cost", withSigVerifyCosts(types.SigVerifyCost{PubKeyType: "secp256r1", Cost: 700},
+			types.SigVerifyCost{PubKeyType: "secp256r1", Cost: 800}), fmt.Errorf("duplicate secp256r1 signature verification cost")},
+		{"invalid signature verification cost", withSigVerifyCosts(types.SigVerifyCost{PubKeyType: "secp256r1"}),
+			fmt.Errorf("invalid secp256r1 signature verification cost: 0")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -50,3 +58,22 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func withSigVerifyCosts(costs ...types.SigVerifyCost) types.Params {
+	p := types.DefaultParams()
+	p.SigVerifyCosts = costs
+	return p
+}
+
+func TestParams_SigVerifyCost(t *testing.T) {
+	p := types.DefaultParams()
+	_, ok := p.SigVerifyCost("secp256r1")
+	require.False(t, ok)
+	require.Equal(t, types.DefaultSigVerifyCostSecp256k1/2, p.SigVerifyCostSecp256r1())
+
+	p = withSigVerifyCosts(types.SigVerifyCost{PubKeyType: "secp256r1", Cost: 700})
+	cost, ok := p.SigVerifyCost("secp256r1")
+	require.True(t, ok)
+	require.Equal(t, uint64(700), cost)
+	require.Equal(t, uint64(700), p.SigVerifyCostSecp256r1())
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -130,37 +131,146 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateAccountPubKey is the Msg/UpdateAccountPubKey request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateAccountPubKey struct {
+	// address is the address of the account whose pubkey is rotated. The message
+	// must be signed by the account under its current pubkey.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// new_pub_key is the multisig pubkey the account signs with after the
+	// rotation. Its address may differ from the account address.
+	NewPubKey *types.Any `protobuf:"bytes,2,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+}
+
+func (m *MsgUpdateAccountPubKey) Reset()         { *m = MsgUpdateAccountPubKey{} }
+func (m *MsgUpdateAccountPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccountPubKey) ProtoMessage()    {}
+func (*MsgUpdateAccountPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{2}
+}
+func (m *MsgUpdateAccountPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccountPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccountPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccountPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccountPubKey.Merge(m, src)
+}
+func (m *MsgUpdateAccountPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccountPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccountPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccountPubKey proto.InternalMessageInfo
+
+func (m *MsgUpdateAccountPubKey) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgUpdateAccountPubKey) GetNewPubKey() *types.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+// MsgUpdateAccountPubKeyResponse defines the response structure for executing a
+// MsgUpdateAccountPubKey message.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateAccountPubKeyResponse struct {
+}
+
+func (m *MsgUpdateAccountPubKeyResponse) Reset()         { *m = MsgUpdateAccountPubKeyResponse{} }
+func (m *MsgUpdateAccountPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccountPubKeyResponse) ProtoMessage()    {}
+func (*MsgUpdateAccountPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{3}
+}
+func (m *MsgUpdateAccountPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccountPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccountPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccountPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccountPubKeyResponse.Merge(m, src)
+}
+func (m *MsgUpdateAccountPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccountPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccountPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccountPubKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateAccountPubKey)(nil), "cosmos.auth.v1beta1.MsgUpdateAccountPubKey")
+	proto.RegisterType((*MsgUpdateAccountPubKeyResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateAccountPubKeyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0x4a,
-	0x12, 0xa2, 0x34, 0x1e, 0x22, 0x01, 0xd5, 0x07, 0x91, 0x12, 0x87, 0xda, 0x91, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a,
-	0x24, 0x87, 0xcd, 0x3d, 0x60, 0xeb, 0xc1, 0xf2, 0x4a, 0xfb, 0x19, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x85, 0xcc, 0xb8, 0x38,
-	0x41, 0x2a, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28,
-	0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x8e, 0x8b, 0xad, 0x00, 0x6c, 0x82, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0x16, 0xef, 0xea, 0x41, 0x2c, 0x71, 0xe2, 0x3c, 0x71, 0x4f,
-	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x56, 0x26, 0x4d, 0xcf, 0x37, 0x68,
-	0x21, 0xcc, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x11, 0x62, 0x82, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0xc4, 0x13, 0x68, 0xae, 0x55, 0x92, 0xe4, 0x12, 0x47, 0x13, 0x0a, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf,
-	0x2b, 0x4e, 0x35, 0x2a, 0xe0, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x4a, 0xe2, 0xe2, 0x41, 0xf1, 0x9f,
-	0x0a, 0x56, 0x77, 0xa1, 0x19, 0x22, 0xa5, 0x43, 0x8c, 0x2a, 0x98, 0x55, 0x52, 0xac, 0x0d, 0x20,
-	0xaf, 0x38, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x34, 0x36, 0xf5, 0x31, 0xfd, 0x56, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x1a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba,
-	0x2c, 0xd6, 0xf9, 0x4c, 0x02, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x8f, 0xd3, 0x30,
+	0x18, 0xad, 0x41, 0x1c, 0xaa, 0x0f, 0x09, 0x91, 0xab, 0xb8, 0x5e, 0x40, 0xa6, 0x54, 0x0c, 0x47,
+	0xe1, 0x6c, 0xb5, 0x87, 0x18, 0x6e, 0x40, 0x6a, 0x19, 0xd1, 0xa1, 0x53, 0x11, 0x0b, 0x4b, 0xe5,
+	0xa4, 0xc6, 0x17, 0x1d, 0xb1, 0xa3, 0xd8, 0xb9, 0x5e, 0x36, 0xc4, 0xc8, 0xc4, 0xcf, 0x60, 0xec,
+	0xc0, 0xce, 0x7a, 0x62, 0xaa, 0x98, 0x98, 0x10, 0x6a, 0x87, 0x8a, 0x7f, 0x81, 0x12, 0x3b, 0x54,
+	0x0d, 0x11, 0x70, 0x4b, 0xe2, 0xf8, 0x3d, 0xbf, 0xf7, 0xbd, 0xef, 0x73, 0xe0, 0x6d, 0x5f, 0xaa,
+	0x50, 0x2a, 0x42, 0x13, 0x7d, 0x4c, 0x4e, 0xbb, 0x1e, 0xd3, 0xb4, 0x4b, 0xf4, 0x19, 0x8e, 0x62,
+	0xa9, 0xa5, 0xb3, 0x65, 0x50, 0x9c, 0xa1, 0xd8, 0xa2, 0x6e, 0x83, 0x4b, 0x2e, 0x73, 0x9c, 0x64,
+	0x2b, 0x43, 0x75, 0x77, 0x0c, 0x75, 0x64, 0x00, 0x7b, 0xce, 0x40, 0xdb, 0xd6, 0x23, 0x54, 0x9c,
+	0x9c, 0x76, 0xb3, 0x97, 0x05, 0x6e, 0xd0, 0x30, 0x10, 0x92, 0xe4, 0x4f, 0xbb, 0x85, 0xaa, 0xea,
+	0xc9, 0xed, 0xad, 0x0d, 0x97, 0x92, 0xbf, 0x61, 0x24, 0xff, 0xf2, 0x92, 0xd7, 0x84, 0x8a, 0xd4,
+	0x40, 0xed, 0xcf, 0x00, 0x5e, 0x3f, 0x54, 0xfc, 0x65, 0x34, 0xa6, 0x9a, 0x1d, 0xd1, 0x98, 0x86,
+	0xca, 0x79, 0x0c, 0xeb, 0xd9, 0x61, 0x19, 0x07, 0x3a, 0x6d, 0x82, 0x16, 0xd8, 0xad, 0x0f, 0x9a,
+	0x5f, 0x3f, 0xed, 0x35, 0x6c, 0x7d, 0xfd, 0xf1, 0x38, 0x66, 0x4a, 0xbd, 0xd0, 0x71, 0x20, 0xf8,
+	0x70, 0x45, 0x75, 0x9e, 0xc0, 0x8d, 0x28, 0x57, 0x68, 0x5e, 0x6a, 0x81, 0xdd, 0xcd, 0xde, 0x2d,
+	0x5c, 0xd1, 0x09, 0x6c, 0x4c, 0x06, 0xf5, 0xf3, 0xef, 0x77, 0x6a, 0x1f, 0x97, 0xd3, 0x0e, 0x18,
+	0xda, 0x53, 0x07, 0x8f, 0xde, 0x2d, 0xa7, 0x9d, 0x95, 0xde, 0xfb, 0xe5, 0xb4, 0x73, 0xd7, 0x28,
+	0xec, 0xa9, 0xf1, 0x09, 0x39, 0x33, 0xf9, 0x4a, 0xd5, 0xb6, 0x77, 0xe0, 0x76, 0x69, 0x6b, 0xc8,
+	0x54, 0x24, 0x85, 0x62, 0xed, 0x19, 0x80, 0x37, 0x7f, 0x63, 0x7d, 0xdf, 0x97, 0x89, 0xd0, 0x47,
+	0x89, 0xf7, 0x8c, 0xa5, 0x4e, 0x0f, 0x5e, 0xa5, 0x26, 0xc7, 0x3f, 0x13, 0x16, 0x44, 0xe7, 0x39,
+	0xdc, 0x14, 0x6c, 0x32, 0x8a, 0x12, 0x6f, 0x74, 0xc2, 0x52, 0x1b, 0xb2, 0x81, 0x4d, 0x73, 0x71,
+	0xd1, 0x5c, 0xdc, 0x17, 0xe9, 0xa0, 0xf9, 0x65, 0xa5, 0xe6, 0xc7, 0x69, 0xa4, 0x25, 0x36, 0xc6,
+	0xc3, 0xba, 0x60, 0x13, 0xb3, 0x3c, 0xe8, 0x65, 0x79, 0x0b, 0xf5, 0x72, 0xda, 0xea, 0xba, 0xdb,
+	0x2d, 0x88, 0xaa, 0x91, 0x22, 0x74, 0xef, 0x27, 0x80, 0x97, 0x0f, 0x15, 0x77, 0x3c, 0x78, 0x6d,
+	0x6d, 0xaa, 0xf7, 0x2a, 0xa7, 0x51, 0x6a, 0x9d, 0xfb, 0xf0, 0x7f, 0x58, 0x85, 0x97, 0x33, 0x81,
+	0x5b, 0x55, 0xcd, 0x7d, 0xf0, 0x77, 0x91, 0x35, 0xb2, 0xbb, 0x7f, 0x01, 0x72, 0x61, 0xec, 0x5e,
+	0x79, 0x9b, 0xdd, 0x9c, 0xc1, 0xd3, 0xf3, 0x39, 0x02, 0xb3, 0x39, 0x02, 0x3f, 0xe6, 0x08, 0x7c,
+	0x58, 0xa0, 0xda, 0x6c, 0x81, 0x6a, 0xdf, 0x16, 0xa8, 0xf6, 0xea, 0x3e, 0x0f, 0xf4, 0x71, 0xe2,
+	0x61, 0x5f, 0x86, 0xf6, 0xbf, 0x22, 0x7f, 0x5e, 0x25, 0x9d, 0x46, 0x4c, 0x79, 0x1b, 0xf9, 0xe4,
+	0xf6, 0x7f, 0x0d, 0x00, 0xb1, 0xcd, 0xfc, 0x80, 0xd6, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -180,6 +290,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateAccountPubKey defines a method for rotating the pubkey of an account
+	// to a multisig pubkey, e.g. to change the members of a multisig account,
+	// while preserving the account address, number and sequence. It is only
+	// enabled when the enable_pubkey_rotation auth param is set.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateAccountPubKey(ctx context.Context, in *MsgUpdateAccountPubKey, opts ...grpc.CallOption) (*MsgUpdateAccountPubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -199,6 +316,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateAccountPubKey(ctx context.Context, in *MsgUpdateAccountPubKey, opts ...grpc.CallOption) (*MsgUpdateAccountPubKeyResponse, error) {
+	out := new(MsgUpdateAccountPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/UpdateAccountPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
@@ -206,6 +332,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateAccountPubKey defines a method for rotating the pubkey of an account
+	// to a multisig pubkey, e.g. to change the members of a multisig account,
+	// while preserving the account address, number and sequence. It is only
+	// enabled when the enable_pubkey_rotation auth param is set.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateAccountPubKey(context.Context, *MsgUpdateAccountPubKey) (*MsgUpdateAccountPubKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -215,6 +348,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAccountPubKey(ctx context.Context, req *MsgUpdateAccountPubKey) (*MsgUpdateAccountPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountPubKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -238,6 +374,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAccountPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccountPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccountPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/UpdateAccountPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccountPubKey(ctx, req.(*MsgUpdateAccountPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -246,6 +400,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateAccountPubKey",
+			Handler:    _Msg_UpdateAccountPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -314,6 +472,71 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateAccountPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccountPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccountPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccountPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccountPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccountPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -349,6 +572,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateAccountPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAccountPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -520,6 +769,174 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateAccountPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAccountPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAccountPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &types.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAccountPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAccountPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAccountPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
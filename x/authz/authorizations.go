@@ -38,3 +38,10 @@ type AcceptResponse struct {
 	// it must use the updated version and handle the update on the storage level.
 	Updated Authorization
 }
+
+// AcceptContextDecorator decorates the context an Authorization is accepted
+// with, e.g. to give the authorizations of a module access to its state.
+type AcceptContextDecorator func(ctx sdk.Context) sdk.Context
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (AcceptContextDecorator) IsManyPerContainerType() {}
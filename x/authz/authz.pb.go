@@ -70,6 +70,100 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// ParamsUpdateAuthorization gives the grantee permissions to execute the
+// MsgUpdateParams of the allowed modules on behalf of the granter's account,
+// which must be their authority. Its grants are stored under its own type URL
+// and are used for the MsgUpdateParams of any module.
+//
+// Since: cosmos-sdk 0.48
+type ParamsUpdateAuthorization struct {
+	// allowed_modules are the names of the modules whose params can be updated,
+	// e.g. slashing.
+	AllowedModules []string `protobuf:"bytes,1,rep,name=allowed_modules,json=allowedModules,proto3" json:"allowed_modules,omitempty"`
+	// allowed_fields restricts the params fields which can be changed for some of
+	// the allowed modules. All the params fields of the other allowed modules can
+	// be changed.
+	AllowedFields []ModuleParamsFields `protobuf:"bytes,2,rep,name=allowed_fields,json=allowedFields,proto3" json:"allowed_fields"`
+}
+
+func (m *ParamsUpdateAuthorization) Reset()         { *m = ParamsUpdateAuthorization{} }
+func (m *ParamsUpdateAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ParamsUpdateAuthorization) ProtoMessage()    {}
+func (*ParamsUpdateAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *ParamsUpdateAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsUpdateAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsUpdateAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsUpdateAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsUpdateAuthorization.Merge(m, src)
+}
+func (m *ParamsUpdateAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsUpdateAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsUpdateAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsUpdateAuthorization proto.InternalMessageInfo
+
+// ModuleParamsFields are the params fields of a module which can be changed
+// with a ParamsUpdateAuthorization.
+//
+// Since: cosmos-sdk 0.48
+type ModuleParamsFields struct {
+	// module is the name of the module.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// fields are the names of the params fields, as in their proto definition,
+	// e.g. signed_blocks_window.
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (m *ModuleParamsFields) Reset()         { *m = ModuleParamsFields{} }
+func (m *ModuleParamsFields) String() string { return proto.CompactTextString(m) }
+func (*ModuleParamsFields) ProtoMessage()    {}
+func (*ModuleParamsFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *ModuleParamsFields) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleParamsFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleParamsFields.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleParamsFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleParamsFields.Merge(m, src)
+}
+func (m *ModuleParamsFields) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleParamsFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleParamsFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleParamsFields proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +178,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +220,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +259,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +290,8 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*ParamsUpdateAuthorization)(nil), "cosmos.authz.v1beta1.ParamsUpdateAuthorization")
+	proto.RegisterType((*ModuleParamsFields)(nil), "cosmos.authz.v1beta1.ModuleParamsFields")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +300,43 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x25, 0xa5, 0x90, 0x8b, 0x52, 0xc0, 0x8a, 0x50, 0x9a, 0xc1, 0x89, 0xac, 0x0a, 0xa2,
+	0x4a, 0xb1, 0xd5, 0xc0, 0xd4, 0x89, 0x58, 0x15, 0x15, 0x48, 0x08, 0x30, 0xed, 0xd2, 0x25, 0xba,
+	0xc4, 0xd7, 0x8b, 0x85, 0xcf, 0x67, 0xdd, 0x9d, 0xa1, 0xe9, 0x47, 0x60, 0xea, 0x67, 0x60, 0x62,
+	0x2c, 0x52, 0x3f, 0x44, 0xc4, 0x54, 0x31, 0x31, 0x15, 0x48, 0x86, 0xce, 0x7c, 0x03, 0x94, 0x3b,
+	0x5b, 0x24, 0x24, 0x88, 0x0c, 0x2c, 0x96, 0xdf, 0xbb, 0xdf, 0xef, 0xbd, 0xf7, 0xfb, 0xdd, 0x1f,
+	0xd8, 0xe8, 0x33, 0x41, 0x99, 0x70, 0x50, 0x22, 0x07, 0xa7, 0xce, 0xdb, 0x9d, 0x1e, 0x96, 0x68,
+	0x47, 0x47, 0x76, 0xcc, 0x99, 0x64, 0x46, 0x45, 0x23, 0x6c, 0x9d, 0x4b, 0x11, 0xb5, 0xbb, 0x88,
+	0x06, 0x11, 0x73, 0xd4, 0x57, 0x03, 0x6b, 0x9b, 0x1a, 0xd8, 0x55, 0x91, 0x93, 0xb2, 0xf4, 0x52,
+	0x9d, 0x30, 0x46, 0x42, 0xec, 0xa8, 0xa8, 0x97, 0x1c, 0x3b, 0x32, 0xa0, 0x58, 0x48, 0x44, 0xe3,
+	0x14, 0x50, 0x21, 0x8c, 0x30, 0x4d, 0x9c, 0xfe, 0x65, 0x15, 0xff, 0xa4, 0xa1, 0x68, 0xa8, 0x97,
+	0x2c, 0x09, 0x2b, 0xfb, 0x38, 0xc2, 0x3c, 0xe8, 0x77, 0x12, 0x39, 0x60, 0x3c, 0x38, 0x45, 0x32,
+	0x60, 0x91, 0x71, 0x07, 0x16, 0xa8, 0x20, 0x55, 0xd0, 0x00, 0xcd, 0xa2, 0x37, 0xfd, 0xdd, 0x7d,
+	0xf6, 0xf9, 0xa2, 0x65, 0x2d, 0xd3, 0x60, 0xcf, 0x31, 0xdf, 0x5f, 0x9f, 0x6f, 0xd7, 0x35, 0xac,
+	0x25, 0xfc, 0x37, 0xce, 0xb2, 0xea, 0xd6, 0x4f, 0x00, 0x37, 0x5f, 0x22, 0x8e, 0xa8, 0x38, 0x8c,
+	0x7d, 0x24, 0xf1, 0x7c, 0xef, 0x07, 0xf0, 0x36, 0x0a, 0x43, 0xf6, 0x0e, 0xfb, 0x5d, 0xca, 0xfc,
+	0x24, 0xc4, 0xa2, 0x0a, 0x1a, 0x85, 0x66, 0xd1, 0xdb, 0x48, 0xd3, 0xcf, 0x75, 0xd6, 0x38, 0x82,
+	0x59, 0xa6, 0x7b, 0x1c, 0xe0, 0xd0, 0x17, 0xd5, 0x7c, 0xa3, 0xd0, 0x2c, 0xb5, 0x9b, 0xf6, 0xd2,
+	0x39, 0x35, 0x4d, 0xf7, 0x7d, 0xa2, 0xf0, 0x6e, 0x71, 0x74, 0x55, 0xcf, 0x7d, 0xbc, 0x3e, 0xdf,
+	0x06, 0x5e, 0x39, 0x2d, 0xa5, 0x57, 0x76, 0x5f, 0xac, 0x2e, 0x77, 0x6b, 0x46, 0xee, 0x5f, 0x55,
+	0x59, 0x7b, 0xd0, 0x58, 0x1c, 0xc0, 0xb8, 0x07, 0xd7, 0xb5, 0xc6, 0xd4, 0xea, 0x34, 0x9a, 0xe6,
+	0x67, 0x24, 0x15, 0xbd, 0x34, 0xb2, 0x3e, 0x01, 0x78, 0x63, 0x9f, 0xa3, 0x48, 0x1a, 0x3d, 0x58,
+	0x46, 0xb3, 0x0d, 0x54, 0x81, 0x52, 0xbb, 0x62, 0xeb, 0xcd, 0xb6, 0xb3, 0xcd, 0xb6, 0x3b, 0xd1,
+	0xd0, 0xbd, 0xbf, 0x9a, 0x1a, 0x6f, 0xbe, 0xa4, 0xb1, 0x07, 0x21, 0x3e, 0x89, 0x03, 0xae, 0x1b,
+	0xe4, 0x55, 0x83, 0xda, 0x42, 0x83, 0x83, 0xec, 0x10, 0xba, 0xb7, 0x46, 0x57, 0x75, 0x70, 0xf6,
+	0xad, 0x0e, 0xbc, 0x19, 0x9e, 0xf5, 0x21, 0x0f, 0x0d, 0x35, 0xf3, 0xfc, 0x36, 0xb7, 0xe1, 0x4d,
+	0x32, 0xcd, 0x62, 0xae, 0xb5, 0xbb, 0xd5, 0x2f, 0x17, 0xad, 0xec, 0x96, 0x74, 0x7c, 0x9f, 0x63,
+	0x21, 0x5e, 0x4b, 0x1e, 0x44, 0xc4, 0xcb, 0x80, 0xbf, 0x39, 0x58, 0x4d, 0xb3, 0x02, 0x07, 0x2f,
+	0x1a, 0x55, 0xf8, 0xff, 0x46, 0x3d, 0x9e, 0x33, 0x6a, 0xed, 0x9f, 0x46, 0xad, 0x2d, 0x98, 0xf4,
+	0x08, 0x6e, 0x28, 0x8f, 0x5e, 0x25, 0x38, 0xc1, 0x4f, 0x25, 0xa6, 0x86, 0x05, 0xcb, 0x54, 0x90,
+	0xae, 0x1c, 0xc6, 0xb8, 0x9b, 0xf0, 0x30, 0xbb, 0x04, 0x25, 0x2a, 0xc8, 0xc1, 0x30, 0xc6, 0x87,
+	0x3c, 0x14, 0xae, 0x3b, 0xfa, 0x61, 0xe6, 0x46, 0x63, 0x13, 0x5c, 0x8e, 0x4d, 0xf0, 0x7d, 0x6c,
+	0x82, 0xb3, 0x89, 0x99, 0xbb, 0x9c, 0x98, 0xb9, 0xaf, 0x13, 0x33, 0x77, 0xb4, 0x45, 0x02, 0x39,
+	0x48, 0x7a, 0x76, 0x9f, 0xd1, 0xf4, 0x1d, 0x71, 0x66, 0x8e, 0xea, 0x89, 0x7e, 0x9e, 0x7a, 0xeb,
+	0x6a, 0xbe, 0x87, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x90, 0xcb, 0x67, 0xc3, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +369,91 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamsUpdateAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsUpdateAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsUpdateAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedFields) > 0 {
+		for iNdEx := len(m.AllowedFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedModules) > 0 {
+		for iNdEx := len(m.AllowedModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedModules[iNdEx])
+			copy(dAtA[i:], m.AllowedModules[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedModules[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleParamsFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleParamsFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleParamsFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -425,6 +614,46 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *ParamsUpdateAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedModules) > 0 {
+		for _, s := range m.AllowedModules {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedFields) > 0 {
+		for _, e := range m.AllowedFields {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleParamsFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +799,236 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParamsUpdateAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsUpdateAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsUpdateAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedModules = append(m.AllowedModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFields = append(m.AllowedFields, ModuleParamsFields{})
+			if err := m.AllowedFields[len(m.AllowedFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleParamsFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleParamsFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleParamsFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
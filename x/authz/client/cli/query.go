@@ -98,15 +98,18 @@ $ %s query %s grants cosmos1skjw.. cosmos1skjwj.. %s
 // GetQueryGranterGrants returns cmd to query for all grants for a granter.
 func GetQueryGranterGrants(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grants-by-granter [granter-addr]",
-		Args:  cobra.ExactArgs(1),
+		Use:   "grants-by-granter [granter-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(1, 2),
 		Short: "query authorization grants granted by granter",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query authorization grants granted by granter.
+			fmt.Sprintf(`Query authorization grants granted by granter. If msg-type-url
+is set, it will select grants only for that msg type.
 Examples:
 $ %s q %s grants-by-granter cosmos1skj..
+$ %s q %s grants-by-granter cosmos1skj.. %s
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -119,6 +122,10 @@ $ %s q %s grants-by-granter cosmos1skj..
 				return err
 			}
 
+			msgAuthorized := ""
+			if len(args) >= 2 {
+				msgAuthorized = args[1]
+			}
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -129,6 +136,7 @@ $ %s q %s grants-by-granter cosmos1skj..
 				cmd.Context(),
 				&authz.QueryGranterGrantsRequest{
 					Granter:    args[0],
+					MsgTypeUrl: msgAuthorized,
 					Pagination: pageReq,
 				},
 			)
@@ -147,15 +155,18 @@ $ %s q %s grants-by-granter cosmos1skj..
 // GetQueryGranteeGrants returns cmd to query for all grants for a grantee.
 func GetQueryGranteeGrants(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grants-by-grantee [grantee-addr]",
-		Args:  cobra.ExactArgs(1),
+		Use:   "grants-by-grantee [grantee-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(1, 2),
 		Short: "query authorization grants granted to a grantee",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query authorization grants granted to a grantee.
+			fmt.Sprintf(`Query authorization grants granted to a grantee. If msg-type-url
+is set, it will select grants only for that msg type.
 Examples:
 $ %s q %s grants-by-grantee cosmos1skj..
+$ %s q %s grants-by-grantee cosmos1skj.. %s
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -168,6 +179,10 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				return err
 			}
 
+			msgAuthorized := ""
+			if len(args) >= 2 {
+				msgAuthorized = args[1]
+			}
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -178,6 +193,7 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				cmd.Context(),
 				&authz.QueryGranteeGrantsRequest{
 					Grantee:    args[0],
+					MsgTypeUrl: msgAuthorized,
 					Pagination: pageReq,
 				},
 			)
@@ -224,6 +224,16 @@ func (s *CLITestSuite) TestQueryGranterGrants() {
 			false,
 			"",
 		},
+		{
+			"valid case with msg type url",
+			[]string{
+				val[0].Address.String(),
+				typeMsgSend,
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+			"",
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
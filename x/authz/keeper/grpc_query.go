@@ -9,9 +9,13 @@ import (
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/internal/conv"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
@@ -103,7 +107,7 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	authzStore := prefix.NewStore(store, grantStoreKey(nil, granter, ""))
 
-	grants, pageRes, err := query.GenericFilteredPaginate(k.cdc, authzStore, req.Pagination, func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
+	toGrantAuthorization := func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
 		auth1, err := auth.GetAuthorization()
 		if err != nil {
 			return nil, err
@@ -121,7 +125,21 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 			Authorization: any,
 			Expiration:    auth.Expiration,
 		}, nil
-	}, func() *authz.Grant {
+	}
+
+	if req.MsgTypeUrl != "" {
+		grants, pageRes, err := k.paginateGrantsBySuffix(authzStore, conv.UnsafeStrToBytes(req.MsgTypeUrl), req.Pagination, toGrantAuthorization)
+		if err != nil {
+			return nil, err
+		}
+
+		return &authz.QueryGranterGrantsResponse{
+			Grants:     grants,
+			Pagination: pageRes,
+		}, nil
+	}
+
+	grants, pageRes, err := query.GenericFilteredPaginate(k.cdc, authzStore, req.Pagination, toGrantAuthorization, func() *authz.Grant {
 		return &authz.Grant{}
 	})
 	if err != nil {
@@ -147,7 +165,7 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), GrantKey)
 
-	authorizations, pageRes, err := query.GenericFilteredPaginate(k.cdc, store, req.Pagination, func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
+	toGrantAuthorization := func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
 		auth1, err := auth.GetAuthorization()
 		if err != nil {
 			return nil, err
@@ -169,7 +187,22 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 			Granter:       granter.String(),
 			Grantee:       req.Grantee,
 		}, nil
-	}, func() *authz.Grant {
+	}
+
+	if req.MsgTypeUrl != "" {
+		suffix := append(address.MustLengthPrefix(grantee), req.MsgTypeUrl...)
+		authorizations, pageRes, err := k.paginateGrantsBySuffix(store, suffix, req.Pagination, toGrantAuthorization)
+		if err != nil {
+			return nil, err
+		}
+
+		return &authz.QueryGranteeGrantsResponse{
+			Grants:     authorizations,
+			Pagination: pageRes,
+		}, nil
+	}
+
+	authorizations, pageRes, err := query.GenericFilteredPaginate(k.cdc, store, req.Pagination, toGrantAuthorization, func() *authz.Grant {
 		return &authz.Grant{}
 	})
 	if err != nil {
@@ -181,3 +214,143 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// paginateGrantsBySuffix paginates the grants of store whose keys, made of a
+// length prefixed address followed by the rest of the grant key, end with
+// suffix. The grants are paginated as by query.GenericFilteredPaginate, but
+// without scanning the grants not matching suffix, see iterateGrantsBySuffix.
+func (k Keeper) paginateGrantsBySuffix(
+	store storetypes.KVStore,
+	suffix []byte,
+	pageRequest *query.PageRequest,
+	onResult func(key []byte, grant *authz.Grant) (*authz.GrantAuthorization, error),
+) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+	// if the PageRequest is nil, use default PageRequest
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	offset := pageRequest.Offset
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+	results := []*authz.GrantAuthorization{}
+
+	if offset > 0 && pageRequest.Key != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	// a key resumes the pagination, the results before it are not counted
+	if len(pageRequest.Key) != 0 {
+		countTotal = false
+	}
+
+	end := offset + limit
+
+	var (
+		numHits uint64
+		nextKey []byte
+		err     error
+	)
+
+	iterateGrantsBySuffix(store, suffix, pageRequest.Key, pageRequest.Reverse, func(key, value []byte) bool {
+		switch {
+		case numHits < offset:
+		case numHits < end:
+			var grant authz.Grant
+			if err = k.cdc.Unmarshal(value, &grant); err != nil {
+				return true
+			}
+
+			var result *authz.GrantAuthorization
+			if result, err = onResult(key, &grant); err != nil {
+				return true
+			}
+			if result != nil {
+				results = append(results, result)
+			}
+		case numHits == end:
+			nextKey = key
+			if !countTotal {
+				return true
+			}
+		}
+
+		numHits++
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = numHits
+	}
+
+	return results, res, nil
+}
+
+// iterateGrantsBySuffix calls cb, in key order starting from start (inclusive)
+// if set, with the grants of store whose keys, made of a length prefixed
+// address followed by the rest of the grant key, end with suffix. Rather than
+// iterating over all the grants of an address, the grant of the address ending
+// with suffix is fetched directly before skipping ahead to the next address.
+func iterateGrantsBySuffix(store storetypes.KVStore, suffix, start []byte, reverse bool, cb func(key, value []byte) (stop bool)) {
+	var cursor []byte
+	switch {
+	case start == nil:
+	case reverse:
+		// the end of a reverse iteration is exclusive
+		cursor = append(bytes.Clone(start), 0)
+	default:
+		cursor = start
+	}
+
+	for {
+		var iter storetypes.Iterator
+		if reverse {
+			iter = store.ReverseIterator(nil, cursor)
+		} else {
+			iter = store.Iterator(cursor, nil)
+		}
+
+		if !iter.Valid() {
+			iter.Close()
+			return
+		}
+
+		key := iter.Key()
+		kv.AssertKeyAtLeastLength(key, 1)
+		kv.AssertKeyAtLeastLength(key, 1+int(key[0]))
+		addrPrefix := bytes.Clone(key[:1+int(key[0])])
+		iter.Close()
+
+		grantKey := append(bytes.Clone(addrPrefix), suffix...)
+		inRange := cursor == nil ||
+			(!reverse && bytes.Compare(grantKey, cursor) >= 0) ||
+			(reverse && bytes.Compare(grantKey, cursor) < 0)
+		if inRange {
+			if value := store.Get(grantKey); value != nil && cb(grantKey, value) {
+				return
+			}
+		}
+
+		// skip ahead to the next address, whose keys are all past the keys
+		// starting with addrPrefix
+		if reverse {
+			cursor = addrPrefix
+		} else {
+			cursor = storetypes.PrefixEndBytes(addrPrefix)
+			if cursor == nil {
+				return
+			}
+		}
+	}
+}
@@ -304,6 +304,173 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryGrantsByMsgType() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	voteMsgType := "/cosmos.gov.v1.MsgVote"
+
+	// interleave send authorizations with generic ones, some addresses holding
+	// only one of them
+	granter, grantee := addrs[0], addrs[6]
+	for i, addr := range addrs[1:6] {
+		if i != 2 {
+			suite.createSendAuthorization(addr, granter)
+			suite.createSendAuthorization(grantee, addr)
+		}
+		if i%2 == 0 {
+			vote := authz.NewGenericAuthorization(voteMsgType)
+			require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, addr, granter, vote, &exp))
+			require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee, addr, vote, &exp))
+		}
+	}
+
+	filter := func(grants []*authz.GrantAuthorization, typeURL string) []*authz.GrantAuthorization {
+		var res []*authz.GrantAuthorization
+		for _, grant := range grants {
+			if grant.Authorization.TypeUrl == typeURL {
+				res = append(res, grant)
+			}
+		}
+		return res
+	}
+
+	testCases := []struct {
+		msg     string
+		msgType string
+		typeURL string
+		query   func(msgType string, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error)
+	}{
+		{
+			"granter send grants",
+			sendMsgType,
+			"/cosmos.bank.v1beta1.SendAuthorization",
+			func(msgType string, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+				res, err := queryClient.GranterGrants(gocontext.Background(), &authz.QueryGranterGrantsRequest{
+					Granter: granter.String(), MsgTypeUrl: msgType, Pagination: pageReq,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Grants, res.Pagination, nil
+			},
+		},
+		{
+			"granter vote grants",
+			voteMsgType,
+			"/cosmos.authz.v1beta1.GenericAuthorization",
+			func(msgType string, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+				res, err := queryClient.GranterGrants(gocontext.Background(), &authz.QueryGranterGrantsRequest{
+					Granter: granter.String(), MsgTypeUrl: msgType, Pagination: pageReq,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Grants, res.Pagination, nil
+			},
+		},
+		{
+			"grantee send grants",
+			sendMsgType,
+			"/cosmos.bank.v1beta1.SendAuthorization",
+			func(msgType string, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+				res, err := queryClient.GranteeGrants(gocontext.Background(), &authz.QueryGranteeGrantsRequest{
+					Grantee: grantee.String(), MsgTypeUrl: msgType, Pagination: pageReq,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Grants, res.Pagination, nil
+			},
+		},
+		{
+			"grantee vote grants",
+			voteMsgType,
+			"/cosmos.authz.v1beta1.GenericAuthorization",
+			func(msgType string, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+				res, err := queryClient.GranteeGrants(gocontext.Background(), &authz.QueryGranteeGrantsRequest{
+					Grantee: grantee.String(), MsgTypeUrl: msgType, Pagination: pageReq,
+				})
+				if err != nil {
+					return nil, nil, err
+				}
+				return res.Grants, res.Pagination, nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			all, _, err := tc.query("", nil)
+			require.NoError(err)
+			expected := filter(all, tc.typeURL)
+			require.NotEmpty(expected)
+			require.Less(len(expected), len(all))
+			for _, grant := range expected {
+				require.NotNil(grant.Expiration)
+				require.Equal(exp, *grant.Expiration)
+			}
+
+			// without pagination
+			grants, pageRes, err := tc.query(tc.msgType, nil)
+			require.NoError(err)
+			require.Equal(expected, grants)
+			require.Equal(uint64(len(expected)), pageRes.Total)
+			require.Nil(pageRes.NextKey)
+
+			// paginated by key, the pages hold all the grants without gaps
+			var paged []*authz.GrantAuthorization
+			pageReq := &query.PageRequest{Limit: 1}
+			for {
+				grants, pageRes, err := tc.query(tc.msgType, pageReq)
+				require.NoError(err)
+				require.Len(grants, 1)
+				paged = append(paged, grants...)
+				if pageRes.NextKey == nil {
+					break
+				}
+				pageReq.Key = pageRes.NextKey
+			}
+			require.Equal(expected, paged)
+
+			// paginated by offset
+			paged = nil
+			for offset := uint64(0); offset < uint64(len(expected)); offset += 2 {
+				grants, pageRes, err := tc.query(tc.msgType, &query.PageRequest{Offset: offset, Limit: 2, CountTotal: true})
+				require.NoError(err)
+				require.Equal(uint64(len(expected)), pageRes.Total)
+				paged = append(paged, grants...)
+			}
+			require.Equal(expected, paged)
+
+			// reverse, paginated by key
+			paged = nil
+			pageReq = &query.PageRequest{Limit: 1, Reverse: true}
+			for {
+				grants, pageRes, err := tc.query(tc.msgType, pageReq)
+				require.NoError(err)
+				require.Len(grants, 1)
+				paged = append([]*authz.GrantAuthorization{grants[0]}, paged...)
+				if pageRes.NextKey == nil {
+					break
+				}
+				pageReq.Key = pageRes.NextKey
+			}
+			require.Equal(expected, paged)
+
+			// unknown msg type
+			grants, _, err = tc.query("/cosmos.bank.v1beta1.MsgMultiSend", nil)
+			require.NoError(err)
+			require.Empty(grants)
+
+			_, _, err = tc.query(tc.msgType, &query.PageRequest{Offset: 1, Key: []byte("key")})
+			require.ErrorContains(err, "either offset or key is expected")
+		})
+	}
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -33,6 +33,8 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	router       baseapp.MessageRouter
 	authKeeper   authz.AccountKeeper
+
+	acceptContextDecorators []authz.AcceptContextDecorator
 }
 
 // NewKeeper constructs a message authorization Keeper
@@ -45,6 +47,21 @@ func NewKeeper(storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec
 	}
 }
 
+// WithAcceptContextDecorators returns a copy of the keeper decorating the
+// context authorizations are accepted with using the given decorators.
+func (k Keeper) WithAcceptContextDecorators(decorators ...authz.AcceptContextDecorator) Keeper {
+	k.acceptContextDecorators = append(append([]authz.AcceptContextDecorator{}, k.acceptContextDecorators...), decorators...)
+	return k
+}
+
+// acceptContext returns the context authorizations are accepted with.
+func (k Keeper) acceptContext(ctx sdk.Context) sdk.Context {
+	for _, decorate := range k.acceptContextDecorators {
+		ctx = decorate(ctx)
+	}
+	return ctx
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -112,6 +129,11 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
 
 			grant, found := k.getGrant(ctx, skey)
+			if _, ok := authz.ParamsUpdateModule(msg); !found && ok {
+				// the MsgUpdateParams of all modules are granted together
+				skey = grantStoreKey(grantee, granter, authz.ParamsUpdateAuthorization{}.MsgTypeURL())
+				grant, found = k.getGrant(ctx, skey)
+			}
 			if !found {
 				return nil, errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "failed to update grant with key %s", string(skey))
 			}
@@ -125,13 +147,13 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				return nil, err
 			}
 
-			resp, err := authorization.Accept(sdkCtx, msg)
+			resp, err := authorization.Accept(k.acceptContext(sdkCtx), msg)
 			if err != nil {
 				return nil, err
 			}
 
 			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+				err = k.DeleteGrant(ctx, grantee, granter, authorization.MsgTypeURL())
 			} else if resp.Updated != nil {
 				err = k.update(ctx, grantee, granter, resp.Updated)
 			}
@@ -306,6 +306,39 @@ func (s *TestSuite) TestDispatchAction() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionParamsUpdate() {
+	require := s.Require()
+	now := s.ctx.BlockTime()
+
+	granterAddr := s.addrs[0]
+	granteeAddr := s.addrs[1]
+	msg := &banktypes.MsgUpdateParams{Authority: granterAddr.String(), Params: banktypes.DefaultParams()}
+
+	_, err := s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{msg})
+	require.ErrorContains(err, "authorization not found")
+
+	// grants of ParamsUpdateAuthorization are looked up for any MsgUpdateParams
+	e := now.AddDate(0, 1, 0)
+	err = s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewParamsUpdateAuthorization([]string{"slashing"}), &e)
+	require.NoError(err)
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{msg})
+	require.ErrorContains(err, "cannot update the params of module bank")
+
+	err = s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewParamsUpdateAuthorization([]string{"slashing", "bank"}), &e)
+	require.NoError(err)
+	result, err := s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{msg})
+	require.NoError(err)
+	require.Len(result, 1)
+
+	// a grant for the MsgUpdateParams itself takes precedence
+	err = s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), &e)
+	require.NoError(err)
+	err = s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewParamsUpdateAuthorization([]string{"slashing"}), &e)
+	require.NoError(err)
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{msg})
+	require.NoError(err)
+}
+
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
 func (s *TestSuite) TestDispatchedEvents() {
@@ -46,8 +46,9 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, err
 	}
 
+	// ParamsUpdateAuthorization is granted for the MsgUpdateParams of all modules
 	t := authorization.MsgTypeURL()
-	if k.router.HandlerByTypeURL(t) == nil {
+	if _, ok := authorization.(*authz.ParamsUpdateAuthorization); !ok && k.router.HandlerByTypeURL(t) == nil {
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
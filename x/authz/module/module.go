@@ -179,6 +179,8 @@ type ModuleInputs struct {
 	Registry         cdctypes.InterfaceRegistry
 	MsgServiceRouter baseapp.MessageRouter
 	StoreService     store.KVStoreService
+
+	AcceptContextDecorators []authz.AcceptContextDecorator `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -189,7 +191,8 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.MsgServiceRouter, in.AccountKeeper)
+	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.MsgServiceRouter, in.AccountKeeper).
+		WithAcceptContextDecorators(in.AcceptContextDecorators...)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 	return ModuleOutputs{AuthzKeeper: k, Module: m}
 }
@@ -0,0 +1,291 @@
+package authz
+
+import (
+	context "context"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &ParamsUpdateAuthorization{}
+
+// msgUpdateParamsName is the name of the messages updating the params of a
+// module.
+const msgUpdateParamsName = "MsgUpdateParams"
+
+// protoVersionRegexp matches the version of a proto package, e.g. v1beta1.
+var protoVersionRegexp = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+// ParamsResolver resolves the current params of the modules whose params can
+// be updated with a ParamsUpdateAuthorization.
+type ParamsResolver interface {
+	ModuleParams(ctx sdk.Context, module string) (proto.Message, error)
+}
+
+// ModuleParamsResolver is a ParamsResolver getting the params of each module
+// with the function registered under its name.
+type ModuleParamsResolver map[string]func(ctx sdk.Context) (proto.Message, error)
+
+// ModuleParams implements ParamsResolver.
+func (r ModuleParamsResolver) ModuleParams(ctx sdk.Context, module string) (proto.Message, error) {
+	getParams, ok := r[module]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no params resolver for module %s", module)
+	}
+
+	return getParams(ctx)
+}
+
+type paramsResolverKey struct{}
+
+// ParamsUpdateAcceptContextDecorator returns an accept context decorator
+// giving ParamsUpdateAuthorization access to the current params of the
+// modules.
+func ParamsUpdateAcceptContextDecorator(resolver ParamsResolver) AcceptContextDecorator {
+	return func(ctx sdk.Context) sdk.Context {
+		return ctx.WithValue(paramsResolverKey{}, resolver)
+	}
+}
+
+// NewParamsUpdateAuthorization creates a new ParamsUpdateAuthorization object.
+func NewParamsUpdateAuthorization(allowedModules []string, allowedFields ...ModuleParamsFields) *ParamsUpdateAuthorization {
+	return &ParamsUpdateAuthorization{
+		AllowedModules: allowedModules,
+		AllowedFields:  allowedFields,
+	}
+}
+
+// ParamsUpdateModule returns the name of the module whose params msg updates,
+// and false if msg is not a MsgUpdateParams. The name of the module is the
+// last component of the proto package of msg which is not a version, e.g.
+// slashing for cosmos.slashing.v1beta1.MsgUpdateParams.
+func ParamsUpdateModule(msg sdk.Msg) (string, bool) {
+	name := proto.MessageName(msg)
+	i := strings.LastIndexByte(name, '.')
+	if i < 0 || name[i+1:] != msgUpdateParamsName {
+		return "", false
+	}
+
+	pkg := strings.Split(name[:i], ".")
+	for j := len(pkg) - 1; j >= 0; j-- {
+		if !protoVersionRegexp.MatchString(pkg[j]) {
+			return pkg[j], true
+		}
+	}
+
+	return "", false
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL. It returns the type URL of
+// ParamsUpdateAuthorization itself, under which its grants are stored since
+// they are used for the MsgUpdateParams of several modules.
+func (a ParamsUpdateAuthorization) MsgTypeURL() string {
+	return "/" + proto.MessageName(&ParamsUpdateAuthorization{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ParamsUpdateAuthorization) ValidateBasic() error {
+	if len(a.AllowedModules) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed modules cannot be empty")
+	}
+
+	modules := make(map[string]bool, len(a.AllowedModules))
+	for _, module := range a.AllowedModules {
+		if module == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed module cannot be empty")
+		}
+		if modules[module] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed module %s", module)
+		}
+		modules[module] = true
+	}
+
+	restricted := make(map[string]bool, len(a.AllowedFields))
+	for _, allowed := range a.AllowedFields {
+		if !modules[allowed.Module] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowed fields of module %s which is not allowed", allowed.Module)
+		}
+		if restricted[allowed.Module] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed fields of module %s", allowed.Module)
+		}
+		restricted[allowed.Module] = true
+
+		if len(allowed.Fields) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowed fields of module %s cannot be empty", allowed.Module)
+		}
+		fields := make(map[string]bool, len(allowed.Fields))
+		for _, field := range allowed.Fields {
+			if field == "" {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowed field of module %s cannot be empty", allowed.Module)
+			}
+			if fields[field] {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed field %s of module %s", field, allowed.Module)
+			}
+			fields[field] = true
+		}
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. It checks that msg updates the
+// params of an allowed module, and, if the fields of its params are
+// restricted, that only allowed fields differ from the current params, which
+// requires the authz keeper to be decorated with
+// ParamsUpdateAcceptContextDecorator.
+func (a ParamsUpdateAuthorization) Accept(ctx context.Context, msg sdk.Msg) (AcceptResponse, error) {
+	module, ok := ParamsUpdateModule(msg)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if !a.allowsModule(module) {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot update the params of module %s", module)
+	}
+
+	allowedFields, restricted := a.allowedFieldsOf(module)
+	if !restricted {
+		return AcceptResponse{Accept: true}, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	resolver, ok := sdkCtx.Value(paramsResolverKey{}).(ParamsResolver)
+	if !ok {
+		return AcceptResponse{}, sdkerrors.ErrLogic.Wrap("params update authorization requires a params resolver")
+	}
+
+	current, err := resolver.ModuleParams(sdkCtx, module)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	updated, err := msgParams(msg)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	changed, err := changedParamsFields(current, updated)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	for _, field := range changed {
+		if !allowedFields[field] {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot change the params field %s of module %s", field, module)
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+func (a ParamsUpdateAuthorization) allowsModule(module string) bool {
+	for _, m := range a.AllowedModules {
+		if m == module {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedFieldsOf returns the params fields of module which can be changed,
+// and false if all of them can.
+func (a ParamsUpdateAuthorization) allowedFieldsOf(module string) (map[string]bool, bool) {
+	for _, allowed := range a.AllowedFields {
+		if allowed.Module == module {
+			fields := make(map[string]bool, len(allowed.Fields))
+			for _, field := range allowed.Fields {
+				fields[field] = true
+			}
+			return fields, true
+		}
+	}
+	return nil, false
+}
+
+// msgParams returns the Params field of a MsgUpdateParams.
+func msgParams(msg sdk.Msg) (proto.Message, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	var field reflect.Value
+	if v.Kind() == reflect.Struct {
+		field = v.FieldByName("Params")
+	}
+	if !field.IsValid() {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("%s has no params", sdk.MsgTypeURL(msg))
+	}
+
+	if field.Kind() != reflect.Ptr {
+		if !field.CanAddr() {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("cannot address the params of %s", sdk.MsgTypeURL(msg))
+		}
+		field = field.Addr()
+	}
+
+	params, ok := field.Interface().(proto.Message)
+	if !ok || field.IsNil() {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid params of %s", sdk.MsgTypeURL(msg))
+	}
+
+	return params, nil
+}
+
+// changedParamsFields returns the sorted names of the fields differing between
+// the current and updated params.
+func changedParamsFields(current, updated proto.Message) ([]string, error) {
+	if proto.MessageName(current) != proto.MessageName(updated) {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected params %s, got %s", proto.MessageName(current), proto.MessageName(updated))
+	}
+
+	currentFields, err := paramsFields(current)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedFields, err := paramsFields(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for name, value := range updatedFields {
+		if currentValue, ok := currentFields[name]; !ok || string(currentValue) != string(value) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range currentFields {
+		if _, ok := updatedFields[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed, nil
+}
+
+// paramsFields returns the proto JSON encoding of each field of params, by
+// field name.
+func paramsFields(params proto.Message) (map[string]json.RawMessage, error) {
+	bz, err := codec.ProtoMarshalJSON(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
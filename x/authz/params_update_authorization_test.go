@@ -0,0 +1,150 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestParamsUpdateModule(t *testing.T) {
+	module, ok := authz.ParamsUpdateModule(&slashingtypes.MsgUpdateParams{})
+	require.True(t, ok)
+	require.Equal(t, "slashing", module)
+
+	module, ok = authz.ParamsUpdateModule(&govv1.MsgUpdateParams{})
+	require.True(t, ok)
+	require.Equal(t, "gov", module)
+
+	_, ok = authz.ParamsUpdateModule(&banktypes.MsgSend{})
+	require.False(t, ok)
+}
+
+func TestParamsUpdateAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		authz  *authz.ParamsUpdateAuthorization
+		expErr string
+	}{
+		{"valid", authz.NewParamsUpdateAuthorization([]string{"slashing", "bank"}, authz.ModuleParamsFields{Module: "slashing", Fields: []string{"signed_blocks_window"}}), ""},
+		{"no modules", authz.NewParamsUpdateAuthorization(nil), "allowed modules cannot be empty"},
+		{"empty module", authz.NewParamsUpdateAuthorization([]string{""}), "allowed module cannot be empty"},
+		{"duplicate module", authz.NewParamsUpdateAuthorization([]string{"bank", "bank"}), "duplicate allowed module bank"},
+		{"fields of module not allowed", authz.NewParamsUpdateAuthorization([]string{"bank"}, authz.ModuleParamsFields{Module: "slashing", Fields: []string{"signed_blocks_window"}}), "allowed fields of module slashing which is not allowed"},
+		{
+			"duplicate fields of module",
+			authz.NewParamsUpdateAuthorization([]string{"slashing"},
+				authz.ModuleParamsFields{Module: "slashing", Fields: []string{"signed_blocks_window"}},
+				authz.ModuleParamsFields{Module: "slashing", Fields: []string{"downtime_jail_duration"}},
+			),
+			"duplicate allowed fields of module slashing",
+		},
+		{"no fields", authz.NewParamsUpdateAuthorization([]string{"slashing"}, authz.ModuleParamsFields{Module: "slashing"}), "allowed fields of module slashing cannot be empty"},
+		{"duplicate field", authz.NewParamsUpdateAuthorization([]string{"slashing"}, authz.ModuleParamsFields{Module: "slashing", Fields: []string{"signed_blocks_window", "signed_blocks_window"}}), "duplicate allowed field signed_blocks_window of module slashing"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authz.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParamsUpdateAuthorizationAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	slashingParams := slashingtypes.DefaultParams()
+	resolver := authz.ModuleParamsResolver{
+		slashingtypes.ModuleName: func(sdk.Context) (proto.Message, error) { return &slashingParams, nil },
+	}
+	decoratedCtx := authz.ParamsUpdateAcceptContextDecorator(resolver)(ctx)
+
+	authority := sdk.AccAddress("multisig").String()
+	authorization := authz.NewParamsUpdateAuthorization(
+		[]string{slashingtypes.ModuleName, banktypes.ModuleName},
+		authz.ModuleParamsFields{Module: slashingtypes.ModuleName, Fields: []string{"signed_blocks_window", "min_signed_per_window"}},
+	)
+	require.Equal(t, "/cosmos.authz.v1beta1.ParamsUpdateAuthorization", authorization.MsgTypeURL())
+
+	windowChanged := slashingParams
+	windowChanged.SignedBlocksWindow *= 2
+	jailChanged := windowChanged
+	jailChanged.DowntimeJailDuration *= 2
+
+	testCases := []struct {
+		name   string
+		ctx    sdk.Context
+		msg    sdk.Msg
+		expErr error
+	}{
+		{
+			"allowed field changed",
+			decoratedCtx,
+			&slashingtypes.MsgUpdateParams{Authority: authority, Params: windowChanged},
+			nil,
+		},
+		{
+			"nothing changed",
+			decoratedCtx,
+			&slashingtypes.MsgUpdateParams{Authority: authority, Params: slashingParams},
+			nil,
+		},
+		{
+			"disallowed field changed",
+			decoratedCtx,
+			&slashingtypes.MsgUpdateParams{Authority: authority, Params: jailChanged},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"unrestricted module",
+			ctx,
+			&banktypes.MsgUpdateParams{Authority: authority, Params: banktypes.NewParams(false)},
+			nil,
+		},
+		{
+			"module not allowed",
+			decoratedCtx,
+			&govv1.MsgUpdateParams{Authority: authority, Params: govv1.DefaultParams()},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"not a params update",
+			decoratedCtx,
+			&banktypes.MsgSend{FromAddress: authority},
+			sdkerrors.ErrInvalidType,
+		},
+		{
+			"no params resolver",
+			ctx,
+			&slashingtypes.MsgUpdateParams{Authority: authority, Params: windowChanged},
+			sdkerrors.ErrLogic,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := authorization.Accept(tc.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			require.Nil(t, resp.Updated)
+		})
+	}
+}
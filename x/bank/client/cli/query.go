@@ -1,14 +1,19 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/denomutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -16,6 +21,7 @@ import (
 const (
 	FlagDenom        = "denom"
 	FlagResolveDenom = "resolve-denom"
+	FlagDenomPrefix  = "denom-prefix"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -36,6 +42,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQuerySendEnabled(),
+		GetCmdQueryTransferVolume(),
+		GetCmdQueryHolds(),
+		GetCmdQueryBalanceAtHeight(),
 	)
 
 	return cmd
@@ -70,7 +79,7 @@ Example:
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := client.ReadAccAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -88,14 +97,30 @@ Example:
 					return err
 				}
 
-				params := types.NewQueryAllBalancesRequest(addr, pageReq, resolveDenom)
+				// Denoms are resolved client side, so that amounts are converted
+				// to the display denom along with the denom itself.
+				params := types.NewQueryAllBalancesRequest(addr, pageReq, false)
 
 				res, err := queryClient.AllBalances(ctx, params)
 				if err != nil {
 					return err
 				}
 
-				return clientCtx.PrintProto(res)
+				if !resolveDenom {
+					return clientCtx.PrintProto(res)
+				}
+
+				resolved, err := resolveBalances(cmd, queryClient, res)
+				if err != nil {
+					return err
+				}
+
+				bz, err := json.Marshal(resolved)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintRaw(bz)
 			}
 
 			params := types.NewQueryBalanceRequest(addr, denom)
@@ -110,13 +135,55 @@ Example:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
-	cmd.Flags().Bool(FlagResolveDenom, false, "Resolve denom to human-readable denom from metadata")
+	cmd.Flags().Bool(FlagResolveDenom, false, "Resolve denoms and amounts to their human-readable display denom from metadata")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all balances")
 
 	return cmd
 }
 
+// resolvedBalances is the output of the balances command when denoms are
+// resolved to their display denom.
+type resolvedBalances struct {
+	Balances   []resolvedCoin      `json:"balances" yaml:"balances"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// resolvedCoin is a balance in its display denom, with its exact decimal amount.
+type resolvedCoin struct {
+	Denom  string `json:"denom" yaml:"denom"`
+	Amount string `json:"amount" yaml:"amount"`
+}
+
+// resolveBalances converts the balances to their display denom using the denom
+// metadata. Balances of denoms without metadata are kept as is.
+func resolveBalances(cmd *cobra.Command, queryClient types.QueryClient, res *types.QueryAllBalancesResponse) (resolvedBalances, error) {
+	resolved := resolvedBalances{
+		Balances:   make([]resolvedCoin, len(res.Balances)),
+		Pagination: res.Pagination,
+	}
+
+	for i, coin := range res.Balances {
+		resolved.Balances[i] = resolvedCoin{Denom: coin.Denom, Amount: coin.Amount.String()}
+
+		metadataRes, err := queryClient.DenomMetadata(cmd.Context(), &types.QueryDenomMetadataRequest{Denom: coin.Denom})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return resolvedBalances{}, err
+		}
+
+		amount, denom, err := denomutil.ToDisplayUnits(coin, metadataRes.Metadata)
+		if err != nil {
+			return resolvedBalances{}, fmt.Errorf("failed to resolve %s: %w", coin.Denom, err)
+		}
+		resolved.Balances[i] = resolvedCoin{Denom: denom, Amount: amount}
+	}
+
+	return resolved, nil
+}
+
 func GetSpendableBalancesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "spendable-balances [address]",
@@ -136,7 +203,7 @@ func GetSpendableBalancesCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := client.ReadAccAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -245,8 +312,11 @@ Example:
 
 To query for the total supply of a specific coin denomination use:
   $ %s query %s total --denom=[denom]
+
+To query for the total supply of the coin denominations starting with a prefix use:
+  $ %s query %s total --denom-prefix=ibc/
 `,
-				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -268,6 +338,24 @@ To query for the total supply of a specific coin denomination use:
 				return err
 			}
 
+			denomPrefix, err := cmd.Flags().GetString(FlagDenomPrefix)
+			if err != nil {
+				return err
+			}
+
+			if denom != "" && denomPrefix != "" {
+				return fmt.Errorf("--%s and --%s cannot be used together", FlagDenom, FlagDenomPrefix)
+			}
+
+			if denomPrefix != "" {
+				res, err := queryClient.SupplyByDenomPrefix(ctx, &types.QuerySupplyByDenomPrefixRequest{Prefix: denomPrefix, Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
 			if denom == "" {
 				res, err := queryClient.TotalSupply(ctx, &types.QueryTotalSupplyRequest{Pagination: pageReq})
 				if err != nil {
@@ -287,6 +375,7 @@ To query for the total supply of a specific coin denomination use:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.Flags().String(FlagDenomPrefix, "", "The prefix of the balance denominations to query for")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all supply totals")
 
@@ -347,3 +436,117 @@ Getting all entries:
 
 	return cmd
 }
+
+func GetCmdQueryTransferVolume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-volume [denom] [last-n-blocks]",
+		Short: "Query the volume of a denom transferred between accounts over the last blocks",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query %s transfer-volume stake 100`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			lastNBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid last-n-blocks %s: %w", args[1], err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TransferVolume(cmd.Context(), &types.QueryTransferVolumeRequest{
+				Denom:       args[0],
+				LastNBlocks: lastNBlocks,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryHolds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holds [address]",
+		Short: "Query the coins of an account placed on hold by modules",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query %s holds [address]`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := client.ReadAccAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Holds(cmd.Context(), &types.QueryHoldsRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryBalanceAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-at-height [address] [denom] [height]",
+		Short: "Query the balance of a denom of an account at a past height, failing if the height is pruned",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query %s balance-at-height [address] stake 1000`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[2], err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := client.ReadAccAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BalanceAtHeight(cmd.Context(), &types.QueryBalanceAtHeightRequest{
+				Address: addr.String(),
+				Denom:   args[1],
+				Height:  height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -47,6 +47,23 @@ func (s *CLITestSuite) TestGetBalancesCmd() {
 			&types.QueryAllBalancesResponse{},
 			false,
 		},
+		{
+			"valid query with resolve denom",
+			func() client.Context {
+				bz, _ := s.encCfg.Codec.Marshal(&types.QueryAllBalancesResponse{})
+				c := clitestutil.NewMockCometRPC(abci.ResponseQuery{
+					Value: bz,
+				})
+				return s.baseCtx.WithClient(c)
+			},
+			[]string{
+				accounts[0].Address.String(),
+				fmt.Sprintf("--%s", cli.FlagResolveDenom),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			&types.QueryAllBalancesResponse{},
+			false,
+		},
 		{
 			"valid query with denom",
 			func() client.Context {
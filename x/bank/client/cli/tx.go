@@ -50,7 +50,7 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 				return err
 			}
 
-			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			toAddr, err := client.ReadAccAddress(cmd, args[1])
 			if err != nil {
 				return err
 			}
@@ -118,7 +118,7 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 			var output []types.Output
 			for _, arg := range args[1 : len(args)-1] {
-				toAddr, err := sdk.AccAddressFromBech32(arg)
+				toAddr, err := client.ReadAccAddress(cmd, arg)
 				if err != nil {
 					return err
 				}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 )
 
@@ -101,6 +102,53 @@ func (s *CLITestSuite) TestSendTxCmd() {
 	}
 }
 
+func (s *CLITestSuite) TestSendTxCmdAddressValidation() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	cmd := cli.NewSendTxCmd()
+	cmd.SetOutput(io.Discard)
+
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", accounts[0].Address)
+	s.Require().NoError(err)
+	invalidChecksum := osmoAddr[:len(osmoAddr)-6] + "qqqqqq"
+
+	testCases := []struct {
+		name         string
+		to           string
+		expectErrMsg string
+	}{
+		{
+			"address of another chain",
+			osmoAddr,
+			fmt.Sprintf("argument 2: invalid Bech32 prefix; expected cosmos, got osmo: %s is not an account address of this chain (did you mean %s?)",
+				osmoAddr, accounts[0].Address),
+		},
+		{
+			"invalid checksum",
+			invalidChecksum,
+			fmt.Sprintf("argument 2: %s is not a valid bech32 account address: decoding bech32 failed: invalid checksum", invalidChecksum),
+		},
+		{
+			"validator operator address",
+			sdk.ValAddress(accounts[0].Address).String(),
+			fmt.Sprintf("is a validator operator address, not an account address (did you mean %s?)", accounts[0].Address),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			args := []string{
+				accounts[0].Address.String(), tc.to, "10stake",
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+			}
+
+			_, err := clitestutil.ExecTestCLICmd(s.baseCtx, cmd, args)
+			s.Require().ErrorContains(err, tc.expectErrMsg)
+		})
+	}
+}
+
 func (s *CLITestSuite) TestMultiSendTxCmd() {
 	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 3)
 
@@ -0,0 +1,31 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	"github.com/cosmos/cosmos-sdk/testutil/conformance"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenesisConformance(t *testing.T) {
+	conformance.RunGenesisConformance(t, conformance.GenesisConfig{
+		ModuleName: types.ModuleName,
+		Setup: conformance.AppConfigGenesisSetup(configurator.NewAppConfig(
+			configurator.AuthModule(),
+			configurator.BankModule(),
+			configurator.StakingModule(),
+			configurator.TxModule(),
+			configurator.ConsensusModule(),
+			configurator.ParamsModule(),
+		), types.ModuleName),
+		GenesisType: &types.GenesisState{},
+		Normalize: func(genesis proto.Message) {
+			// balances are stored, and thus exported, by address
+			gs := genesis.(*types.GenesisState)
+			gs.Balances = types.SanitizeGenesisBalances(gs.Balances)
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	bankKey       = storetypes.NewKVStoreKey(banktypes.StoreKey)
+	denomIndexKey = storetypes.NewKVStoreKey(banktypes.DenomIndexStoreKey)
+)
+
+func loadDenomIndexStores(t *testing.T, db dbm.DB) *rootmulti.Store {
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(denomIndexKey, storetypes.StoreTypeDerived, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	return ms
+}
+
+func storeContents(store storetypes.KVStore) map[string]string {
+	contents := make(map[string]string)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		contents[string(it.Key())] = string(it.Value())
+	}
+	return contents
+}
+
+func TestDenomIndexDerivedStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := loadDenomIndexStores(t, db)
+
+	k := keeper.NewBaseKeeper(
+		moduletestutil.MakeTestEncodingConfig().Codec,
+		runtime.NewKVStoreService(bankKey),
+		banktestutil.NewMockAccountKeeper(gomock.NewController(t)),
+		map[string]bool{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		log.NewNopLogger(),
+	).WithDenomIndexStoreService(runtime.NewKVStoreService(denomIndexKey))
+
+	ctx := sdk.NewContext(ms, cmtproto.Header{}, false, log.NewNopLogger())
+	for i, addr := range accAddrs {
+		require.NoError(t, k.Balances.Set(ctx, collections.Join(addr, fooDenom), math.NewInt(int64(i+1))))
+		if i%2 == 0 {
+			require.NoError(t, k.Balances.Set(ctx, collections.Join(addr, barDenom), math.NewInt(int64(i+1))))
+		}
+	}
+	require.NoError(t, k.Balances.Remove(ctx, collections.Join(accAddrs[0], fooDenom)))
+	ms.Commit()
+
+	// the index is kept in the derived store only
+	index := storeContents(ms.GetKVStore(denomIndexKey))
+	require.Len(t, index, len(accAddrs)-1+(len(accAddrs)+1)/2)
+	bankStore := ms.GetKVStore(bankKey)
+	it := storetypes.KVStorePrefixIterator(bankStore, banktypes.DenomAddressPrefix)
+	require.False(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	owners, err := k.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{Denom: barDenom})
+	require.NoError(t, err)
+	require.Len(t, owners.DenomOwners, (len(accAddrs)+1)/2)
+
+	// wipe the derived store, which is then stale and rebuilt to identical
+	// contents from the balances
+	wipe, err := db.Iterator([]byte("s/k:"+banktypes.DenomIndexStoreKey+"/"), []byte("s/k:"+banktypes.DenomIndexStoreKey+"0"))
+	require.NoError(t, err)
+	var wiped [][]byte
+	for ; wipe.Valid(); wipe.Next() {
+		wiped = append(wiped, wipe.Key())
+	}
+	require.NoError(t, wipe.Close())
+	require.NotEmpty(t, wiped)
+	for _, key := range wiped {
+		require.NoError(t, db.Delete(key))
+	}
+
+	ms = loadDenomIndexStores(t, db)
+	require.Empty(t, storeContents(ms.GetKVStore(denomIndexKey)))
+	require.Equal(t, []storetypes.StoreKey{denomIndexKey}, ms.StaleDerivedStores())
+
+	require.NoError(t, ms.RebuildDerivedStores(ms.StaleDerivedStores(), func(cms storetypes.CacheMultiStore) error {
+		return k.RebuildDenomIndex(sdk.NewContext(cms, cmtproto.Header{}, false, log.NewNopLogger()))
+	}))
+	require.Empty(t, ms.StaleDerivedStores())
+	require.Equal(t, index, storeContents(ms.GetKVStore(denomIndexKey)))
+}
+
+func TestDeleteBankStoreDenomIndex(t *testing.T) {
+	ms := loadDenomIndexStores(t, dbm.NewMemDB())
+	ctx := sdk.NewContext(ms, cmtproto.Header{}, false, log.NewNopLogger())
+
+	newKeeper := func() keeper.BaseKeeper {
+		return keeper.NewBaseKeeper(
+			moduletestutil.MakeTestEncodingConfig().Codec,
+			runtime.NewKVStoreService(bankKey),
+			banktestutil.NewMockAccountKeeper(gomock.NewController(t)),
+			map[string]bool{},
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			log.NewNopLogger(),
+		)
+	}
+
+	// the index is written to the bank store by a keeper without a denom index
+	// store, which keeps it on deletion
+	k := newKeeper()
+	for i, addr := range accAddrs {
+		require.NoError(t, k.Balances.Set(ctx, collections.Join(addr, fooDenom), math.NewInt(int64(i+1))))
+	}
+	require.NoError(t, k.DeleteBankStoreDenomIndex(ctx))
+	bankStore := ms.GetKVStore(bankKey)
+	it := storetypes.KVStorePrefixIterator(bankStore, banktypes.DenomAddressPrefix)
+	require.True(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	// once the index is moved to a derived store, the entries left in the bank
+	// store are deleted, and the balances are kept
+	k = newKeeper().WithDenomIndexStoreService(runtime.NewKVStoreService(denomIndexKey))
+	require.NoError(t, k.DeleteBankStoreDenomIndex(ctx))
+	it = storetypes.KVStorePrefixIterator(bankStore, banktypes.DenomAddressPrefix)
+	require.False(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	for i, addr := range accAddrs {
+		require.Equal(t, math.NewInt(int64(i+1)), k.GetBalance(ctx, addr, fooDenom).Amount)
+	}
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	"gotest.tools/v3/golden"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// formatEvents renders events one per line as their type followed by their
+// attributes in order.
+func formatEvents(events sdk.Events) string {
+	var sb strings.Builder
+	for _, event := range events {
+		sb.WriteString(event.Type)
+		for _, attr := range event.Attributes {
+			fmt.Fprintf(&sb, " %s=%s", attr.Key, attr.Value)
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// The golden files below pin the exact events emitted by the transfers, run
+// `go test ./keeper -run TestKeeperTestSuite/TestEventSchema -update` from
+// x/bank to update them.
+
+func (suite *KeeperTestSuite) TestEventSchemaSend() {
+	require := suite.Require()
+	coins := sdk.NewCoins(newFooCoin(50), newBarCoin(20))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], coins))
+
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithEventManager(sdk.NewEventManager())
+	suite.ctx = ctx
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], coins))
+
+	golden.Assert(suite.T(), formatEvents(ctx.EventManager().Events()), "events_send.golden")
+}
+
+func (suite *KeeperTestSuite) TestEventSchemaMultiSend() {
+	require := suite.Require()
+	coins := sdk.NewCoins(newFooCoin(50), newBarCoin(20))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], coins))
+
+	input := banktypes.NewInput(accAddrs[0], coins)
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(30))),
+		banktypes.NewOutput(accAddrs[2], sdk.NewCoins(newFooCoin(20), newBarCoin(10))),
+		banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newBarCoin(10))),
+	}
+
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithEventManager(sdk.NewEventManager())
+	suite.ctx = ctx
+	suite.mockInputOutputCoins([]sdk.AccountI{authtypes.NewBaseAccountWithAddress(accAddrs[0])}, []sdk.AccAddress{accAddrs[1], accAddrs[2], accAddrs[1]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+
+	golden.Assert(suite.T(), formatEvents(ctx.EventManager().Events()), "events_multi_send.golden")
+}
+
+func (suite *KeeperTestSuite) TestEventSchemaSendFromModuleToAccount() {
+	require := suite.Require()
+	coins := sdk.NewCoins(newFooCoin(50))
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, authtypes.Minter, coins))
+
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithEventManager(sdk.NewEventManager())
+	suite.ctx = ctx
+	suite.mockSendCoinsFromModuleToAccount(minterAcc, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, accAddrs[0], coins))
+
+	golden.Assert(suite.T(), formatEvents(ctx.EventManager().Events()), "events_send_from_module_to_account.golden")
+}
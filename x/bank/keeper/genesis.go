@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
 
 	"cosmossdk.io/collections"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -49,6 +53,12 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, hold := range genState.Holds {
+		if err := k.addHold(ctx, hold.GetAddress(), hold.Id, hold.Amount); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -65,5 +75,112 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.Holds = k.GetAllHolds(ctx)
 	return rv
 }
+
+// ExportGenesisTo writes the bank module's genesis state as JSON to w. Unlike
+// ExportGenesis, the account balances are written as they are read from the
+// store instead of being collected in memory first.
+func (k BaseKeeper) ExportGenesisTo(ctx context.Context, cdc codec.JSONCodec, w io.Writer) error {
+	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		return fmt.Errorf("unable to fetch total supply %w", err)
+	}
+
+	gs := types.NewGenesisState(
+		k.GetParams(ctx),
+		nil,
+		totalSupply,
+		k.GetAllDenomMetaData(ctx),
+		k.GetAllSendEnabledEntries(ctx),
+	)
+	gs.Holds = k.GetAllHolds(ctx)
+
+	bz, err := cdc.MarshalJSON(gs)
+	if err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return err
+	}
+	delete(fields, "balances")
+
+	if _, err := io.WriteString(w, `{"balances":[`); err != nil {
+		return err
+	}
+
+	// balances are iterated by address, so that the coins of an account are
+	// written once the iteration moves to the next address
+	var (
+		balance *types.Balance
+		count   int
+	)
+	writeBalance := func() error {
+		if count > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		count++
+
+		bz, err := cdc.MarshalJSON(balance)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(bz)
+		return err
+	}
+
+	k.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if balance != nil && balance.Address == addr.String() {
+			balance.Coins = balance.Coins.Add(coin)
+			return false
+		}
+
+		if balance != nil {
+			if err = writeBalance(); err != nil {
+				return true
+			}
+		}
+
+		balance = &types.Balance{Address: addr.String(), Coins: sdk.NewCoins(coin)}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if balance != nil {
+		if err := writeBalance(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, ",%s:%s", name, fields[key]); err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "}")
+	return err
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -46,6 +48,11 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expTotalSupply, exportGenesis.Supply)
 	suite.Require().Subset(exportGenesis.Balances, expectedBalances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
+
+	// the streamed genesis state matches the exported one
+	var buf bytes.Buffer
+	suite.Require().NoError(suite.bankKeeper.ExportGenesisTo(ctx, suite.encCfg.Codec, &buf))
+	suite.Require().JSONEq(string(suite.encCfg.Codec.MustMarshalJSON(exportGenesis)), buf.String())
 }
 
 func (suite *KeeperTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
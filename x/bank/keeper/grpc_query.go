@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -140,6 +142,24 @@ func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupply
 	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
 
+// SupplyByDenomPrefix implements the Query/SupplyByDenomPrefix gRPC method
+func (k BaseKeeper) SupplyByDenomPrefix(ctx context.Context, req *types.QuerySupplyByDenomPrefixRequest) (*types.QuerySupplyByDenomPrefixResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Prefix == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom prefix cannot be empty")
+	}
+
+	supply, pageRes, err := k.GetPaginatedSupplyWithPrefix(ctx, req.Prefix, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyByDenomPrefixResponse{Supply: supply, Pagination: pageRes}, nil
+}
+
 // SupplyOf implements the Query/SupplyOf gRPC method
 func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
 	if req == nil {
@@ -279,3 +299,89 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 
 	return resp, nil
 }
+
+// TransferVolume implements the Query/TransferVolume gRPC method
+func (k BaseKeeper) TransferVolume(ctx context.Context, req *types.QueryTransferVolumeRequest) (*types.QueryTransferVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.LastNBlocks == 0 || req.LastNBlocks > types.TransferVolumeWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "last n blocks must be between 1 and %d", types.TransferVolumeWindow)
+	}
+
+	volume, tracked, err := k.GetTransferVolume(ctx, req.Denom, req.LastNBlocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransferVolumeResponse{Volume: volume, TrackedBlocks: tracked}, nil
+}
+
+// Holds implements the Query/Holds gRPC method
+func (k BaseKeeper) Holds(ctx context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	return &types.QueryHoldsResponse{Holds: k.GetHolds(ctx, addr), Total: k.GetHeldCoins(ctx, addr)}, nil
+}
+
+// BalanceAtHeight implements the Query/BalanceAtHeight gRPC method
+func (k BaseKeeper) BalanceAtHeight(ctx context.Context, req *types.QueryBalanceAtHeightRequest) (*types.QueryBalanceAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	querier := sdkCtx.VersionQuerier()
+	if querier == nil {
+		return nil, status.Error(codes.Unavailable, "committed versions are not queryable")
+	}
+
+	if latest := querier.LatestVersion(); req.Height > latest {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "height %d is in the future; latest height is %d", req.Height, latest)
+	}
+
+	// the versions before the earliest one are pruned, reading them would fail
+	// with an opaque store error
+	if earliest := querier.EarliestVersion(); req.Height < earliest {
+		return nil, errorsmod.Wrapf(types.ErrHeightPruned, "height %d is pruned; earliest height is %d", req.Height, earliest)
+	}
+
+	ms, err := querier.CacheMultiStoreWithVersion(req.Height)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "failed to load state at height %d: %s", req.Height, err)
+	}
+
+	balance := k.GetBalance(sdkCtx.WithMultiStore(ms).WithBlockHeight(req.Height), address, req.Denom)
+
+	resp := &types.QueryBalanceAtHeightResponse{Balance: &balance, Height: req.Height}
+	if blockTime, ok := querier.VersionTime(req.Height); ok {
+		resp.BlockTime = &blockTime
+	}
+
+	return resp, nil
+}
@@ -2,16 +2,34 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"crypto/sha256"
 	"fmt"
+	"math/rand"
+	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -46,6 +64,77 @@ func (suite *KeeperTestSuite) TestQueryBalance() {
 	suite.True(res.Balance.IsEqual(newFooCoin(50)))
 }
 
+func (suite *KeeperTestSuite) TestQueryBalanceAtHeight() {
+	require := suite.Require()
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	// versions are pruned synchronously once the pruning worker is closed
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(ms.LoadLatestVersion())
+	require.NoError(ms.Close())
+
+	bankKeeper := keeper.NewBaseKeeper(
+		suite.encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		suite.authKeeper,
+		map[string]bool{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		log.NewNopLogger(),
+	)
+
+	blockTime := func(height int64) time.Time { return time.Unix(height*5, 0).UTC() }
+	for height := int64(1); height <= 12; height++ {
+		header := cmtproto.Header{Height: height, Time: blockTime(height)}
+		cms := ms.CacheMultiStore()
+		ctx := sdk.NewContext(cms, header, false, log.NewNopLogger())
+		require.NoError(bankKeeper.Balances.Set(ctx, collections.Join(addr, fooDenom), math.NewInt(height*10)))
+		cms.Write()
+		ms.SetCommitHeader(header)
+		ms.Commit()
+	}
+	require.Equal(int64(8), ms.EarliestVersion())
+
+	newQueryClient := func(ctx sdk.Context) types.QueryClient {
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.encCfg.InterfaceRegistry)
+		types.RegisterQueryServer(queryHelper, bankKeeper)
+		return types.NewQueryClient(queryHelper)
+	}
+	ctx := sdk.NewContext(ms.CacheMultiStore(), cmtproto.Header{Height: 12, Time: blockTime(12)}, true, log.NewNopLogger())
+	queryClient := newQueryClient(ctx.WithVersionQuerier(ms))
+
+	_, err := queryClient.BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{})
+	require.Error(err)
+
+	_, err = queryClient.BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom})
+	require.ErrorContains(err, "height must be positive")
+
+	_, err = queryClient.BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 13})
+	require.ErrorIs(err, sdkerrors.ErrInvalidHeight)
+
+	// the pruned heights are reported along with the earliest retrievable one
+	_, err = queryClient.BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 5})
+	require.ErrorIs(err, types.ErrHeightPruned)
+	require.ErrorContains(err, "height 5 is pruned; earliest height is 8")
+
+	res, err := queryClient.BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 9})
+	require.NoError(err)
+	require.Equal(newFooCoin(90), *res.Balance)
+	require.Equal(int64(9), res.Height)
+	require.NotNil(res.BlockTime)
+	require.Equal(blockTime(9), *res.BlockTime)
+
+	res, err = queryClient.BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: barDenom, Height: 8})
+	require.NoError(err)
+	require.True(res.Balance.IsZero())
+
+	// contexts not created for queries cannot read other versions
+	_, err = newQueryClient(ctx).BalanceAtHeight(gocontext.Background(), &types.QueryBalanceAtHeightRequest{Address: addr.String(), Denom: fooDenom, Height: 9})
+	require.Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryAllBalances() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 	_, _, addr := testdata.KeyTestPubAddr()
@@ -246,6 +335,129 @@ func (suite *KeeperTestSuite) TestQueryTotalSupply() {
 	suite.Require().Equal(res.Supply, expectedTotalSupply)
 }
 
+// setSupplies sets the supply of 1,000 denoms, half of them with the ibc/
+// prefix, in a random order, and returns the sorted supplies of the ibc/
+// denoms and of all the denoms.
+func (suite *KeeperTestSuite) setSupplies() (ibcSupply, totalSupply sdk.Coins) {
+	ctx := suite.ctx
+	r := rand.New(rand.NewSource(1))
+
+	var coins []sdk.Coin
+	for i := 0; i < 500; i++ {
+		coins = append(coins,
+			sdk.NewInt64Coin(fmt.Sprintf("ibc/%X", sha256.Sum256([]byte{byte(i), byte(i >> 8)})), int64(i+1)),
+			sdk.NewInt64Coin(fmt.Sprintf("denom%d", r.Intn(1_000_000_000)), int64(i+1)),
+		)
+	}
+	// denoms sharing a prefix with ibc/ without matching it
+	coins[1] = sdk.NewInt64Coin("ibc", 1)
+	coins[3] = sdk.NewInt64Coin("ibcx/a", 1)
+
+	r.Shuffle(len(coins), func(i, j int) { coins[i], coins[j] = coins[j], coins[i] })
+	for _, coin := range coins {
+		suite.Require().NoError(suite.bankKeeper.Supply.Set(ctx, coin.Denom, coin.Amount))
+		totalSupply = totalSupply.Add(coin)
+		if strings.HasPrefix(coin.Denom, "ibc/") {
+			ibcSupply = ibcSupply.Add(coin)
+		}
+	}
+
+	suite.Require().Len(totalSupply, 1000)
+	suite.Require().Len(ibcSupply, 500)
+	return ibcSupply, totalSupply
+}
+
+// collectPages stitches the pages of a supply query paginated by key,
+// checking that the supplies of every page are sorted by denom and follow the
+// supplies of the previous pages.
+func (suite *KeeperTestSuite) collectPages(limit uint64, queryPage func(*query.PageRequest) (sdk.Coins, *query.PageResponse), afterFirstPage func()) sdk.Coins {
+	var (
+		supply sdk.Coins
+		key    []byte
+	)
+	for {
+		page, pageRes := queryPage(&query.PageRequest{Key: key, Limit: limit})
+		suite.Require().LessOrEqual(uint64(len(page)), limit)
+		for _, coin := range page {
+			if len(supply) > 0 {
+				suite.Require().Less(supply[len(supply)-1].Denom, coin.Denom)
+			}
+			supply = append(supply, coin)
+		}
+
+		if key == nil && afterFirstPage != nil {
+			afterFirstPage()
+		}
+		if pageRes.NextKey == nil {
+			return supply
+		}
+		key = pageRes.NextKey
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTotalSupplyPagination() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	_, totalSupply := suite.setSupplies()
+
+	queryPage := func(pageReq *query.PageRequest) (sdk.Coins, *query.PageResponse) {
+		res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
+		suite.Require().NoError(err)
+		return res.Supply, res.Pagination
+	}
+
+	for _, limit := range []uint64{1, 7, 100, 999, 1000, 1001} {
+		suite.Require().Equal(totalSupply, suite.collectPages(limit, queryPage, nil), "limit %d", limit)
+	}
+
+	// a denom added before the next key of the first page is not returned, one
+	// added after it is, and no denom is skipped or duplicated
+	page, _ := queryPage(&query.PageRequest{Limit: 100})
+	before := sdk.NewInt64Coin(page[50].Denom+"0", 1)
+	after := sdk.NewInt64Coin("zzz", 1)
+	supply := suite.collectPages(100, queryPage, func() {
+		suite.Require().NoError(suite.bankKeeper.Supply.Set(ctx, before.Denom, before.Amount))
+		suite.Require().NoError(suite.bankKeeper.Supply.Set(ctx, after.Denom, after.Amount))
+	})
+	suite.Require().Equal(totalSupply.Add(after), supply)
+}
+
+func (suite *KeeperTestSuite) TestQuerySupplyByDenomPrefix() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	ibcSupply, _ := suite.setSupplies()
+
+	_, err := queryClient.SupplyByDenomPrefix(gocontext.Background(), &types.QuerySupplyByDenomPrefixRequest{})
+	suite.Require().ErrorContains(err, "denom prefix cannot be empty")
+
+	queryPage := func(pageReq *query.PageRequest) (sdk.Coins, *query.PageResponse) {
+		res, err := queryClient.SupplyByDenomPrefix(gocontext.Background(), &types.QuerySupplyByDenomPrefixRequest{Prefix: "ibc/", Pagination: pageReq})
+		suite.Require().NoError(err)
+		return res.Supply, res.Pagination
+	}
+
+	for _, limit := range []uint64{1, 7, 100, 499, 500, 501} {
+		suite.Require().Equal(ibcSupply, suite.collectPages(limit, queryPage, nil), "limit %d", limit)
+	}
+
+	res, err := queryClient.SupplyByDenomPrefix(gocontext.Background(), &types.QuerySupplyByDenomPrefixRequest{
+		Prefix:     "ibc/",
+		Pagination: &query.PageRequest{Offset: 490, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcSupply[490:], res.Supply)
+	suite.Require().Equal(uint64(500), res.Pagination.Total)
+
+	res, err = queryClient.SupplyByDenomPrefix(gocontext.Background(), &types.QuerySupplyByDenomPrefixRequest{Prefix: "nothing"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Supply)
+
+	var iterated sdk.Coins
+	suite.bankKeeper.IterateSupplyWithPrefix(ctx, "ibc/", func(coin sdk.Coin) bool {
+		iterated = append(iterated, coin)
+		return false
+	})
+	suite.Require().Equal(ibcSupply, iterated)
+}
+
 func (suite *KeeperTestSuite) TestQueryTotalSupplyOf() {
 	ctx, queryClient := suite.ctx, suite.queryClient
 
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// AddHold places coins of an account on hold under the given id. The coins
+// remain owned by the account but can no longer be spent, sent or delegated
+// until the hold is released. Holds placed under an existing id stack onto it.
+// An error is returned if the account cannot spend the coins.
+func (k BaseKeeper) AddHold(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, holdID string) error {
+	if holdID == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "hold id cannot be empty")
+	}
+
+	if !amt.IsValid() || amt.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	spendable := k.SpendableCoins(ctx, addr)
+	if !spendable.IsAllGTE(amt) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, amt)
+	}
+
+	if err := k.addHold(ctx, addr, holdID, amt); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHold,
+			sdk.NewAttribute(types.AttributeKeyHolder, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyHoldID, holdID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return nil
+}
+
+// ReleaseHold releases all the coins of an account placed on hold under the
+// given id.
+func (k BaseKeeper) ReleaseHold(ctx context.Context, addr sdk.AccAddress, holdID string) error {
+	hold, err := k.AccountHolds.Get(ctx, collections.Join(addr, holdID))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(types.ErrHoldNotFound, "hold %s of address %s", holdID, addr)
+		}
+		return err
+	}
+
+	if err := k.AccountHolds.Remove(ctx, collections.Join(addr, holdID)); err != nil {
+		return err
+	}
+
+	for _, coin := range hold.Amount {
+		held, err := k.getHeldAmount(ctx, addr, coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		held = held.Sub(coin.Amount)
+		if held.IsZero() {
+			err = k.HeldBalances.Remove(ctx, collections.Join(addr, coin.Denom))
+		} else {
+			err = k.HeldBalances.Set(ctx, collections.Join(addr, coin.Denom), held)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseHold,
+			sdk.NewAttribute(types.AttributeKeyHolder, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyHoldID, holdID),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, hold.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetHeldCoins returns the coins of an account placed on hold, summed over its
+// holds.
+func (k BaseViewKeeper) GetHeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+	err := k.HeldBalances.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(key collections.Pair[sdk.AccAddress, string], value math.Int) bool {
+		held = held.Add(sdk.NewCoin(key.K2(), value))
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return held
+}
+
+// GetHolds returns the holds placed on the coins of an account.
+func (k BaseViewKeeper) GetHolds(ctx context.Context, addr sdk.AccAddress) []types.Hold {
+	holds := make([]types.Hold, 0)
+	err := k.AccountHolds.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(_ collections.Pair[sdk.AccAddress, string], hold types.Hold) bool {
+		holds = append(holds, hold)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return holds
+}
+
+// GetAllHolds returns the holds placed on the coins of all accounts.
+func (k BaseViewKeeper) GetAllHolds(ctx context.Context) []types.Hold {
+	holds := make([]types.Hold, 0)
+	err := k.AccountHolds.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], hold types.Hold) bool {
+		holds = append(holds, hold)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return holds
+}
+
+// getHeldAmount returns the amount of a denom placed on hold for an account,
+// which is zero if none is.
+func (k BaseViewKeeper) getHeldAmount(ctx context.Context, addr sdk.AccAddress, denom string) (math.Int, error) {
+	held, err := k.HeldBalances.Get(ctx, collections.Join(addr, denom))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return math.ZeroInt(), nil
+	case err != nil:
+		return math.Int{}, err
+	}
+
+	return held, nil
+}
+
+// addHold stacks coins onto the hold of an account with the given id, without
+// checking the spendable balance of the account.
+func (k BaseKeeper) addHold(ctx context.Context, addr sdk.AccAddress, holdID string, amt sdk.Coins) error {
+	hold, err := k.AccountHolds.Get(ctx, collections.Join(addr, holdID))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		hold = types.NewHold(addr, holdID, sdk.NewCoins())
+	case err != nil:
+		return err
+	}
+
+	hold.Amount = hold.Amount.Add(amt...)
+	if err := k.AccountHolds.Set(ctx, collections.Join(addr, holdID), hold); err != nil {
+		return err
+	}
+
+	for _, coin := range amt {
+		held, err := k.getHeldAmount(ctx, addr, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if err := k.HeldBalances.Set(ctx, collections.Join(addr, coin.Denom), held.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"time"
+
+	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestHolds() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	queryClient := suite.mockQueryClient(ctx)
+
+	origCoins := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], origCoins))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+
+	// holds under an existing id stack onto it
+	require.NoError(suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(20)), "order-1"))
+	require.NoError(suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(10), newBarCoin(5)), "order-1"))
+	require.NoError(suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(30)), "order-2"))
+
+	res, err := queryClient.Holds(ctx, &banktypes.QueryHoldsRequest{Address: accAddrs[0].String()})
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{
+		banktypes.NewHold(accAddrs[0], "order-1", sdk.NewCoins(newFooCoin(30), newBarCoin(5))),
+		banktypes.NewHold(accAddrs[0], "order-2", sdk.NewCoins(newFooCoin(30))),
+	}, res.Holds)
+	require.Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(5)), res.Total)
+
+	// held coins remain owned by the account but cannot be spent
+	require.Equal(origCoins, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(95)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	require.Equal(newFooCoin(40), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], fooDenom))
+
+	err = suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(41)), "order-3")
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[1]).Return(true).AnyTimes()
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(41)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(40))))
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	err = suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(1)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// releasing a hold releases exactly the coins placed under its id
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "order-1"))
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetHeldCoins(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(100)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	err = suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "order-1")
+	require.ErrorIs(err, banktypes.ErrHoldNotFound)
+
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "order-2"))
+	require.Empty(suite.bankKeeper.GetHolds(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(100)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	// invalid holds
+	require.Error(suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(1)), ""))
+	require.Error(suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(), "order-4"))
+}
+
+func (suite *KeeperTestSuite) TestHoldsVestingAccount() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	now := cmttime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	vacc := vesting.NewContinuousVestingAccount(acc0, origCoins, now.Unix(), endTime.Unix())
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], origCoins))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(vacc).AnyTimes()
+
+	// half of the coins are vested, only vested coins can be placed on hold
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	err := suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 51)), "order-1")
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), "order-1"))
+
+	// both the vesting and the held coins are locked
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), suite.bankKeeper.LockedCoins(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[1]).Return(true).AnyTimes()
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 21)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// vesting coins can be delegated, held coins cannot
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	suite.authKeeper.EXPECT().SetAccount(gomock.Any(), vacc).AnyTimes()
+	err = suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 71)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	// the held coins are still locked once all coins are vested
+	ctx = ctx.WithBlockTime(endTime)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), suite.bankKeeper.LockedCoins(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "order-1"))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestHoldsStoreError() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0])).AnyTimes()
+	require.NoError(suite.bankKeeper.AddHold(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(10)), "order-1"))
+
+	// a held balance which cannot be decoded is not read as zero
+	key, err := collections.EncodeKeyWithPrefix(banktypes.HeldBalancesPrefix, suite.bankKeeper.HeldBalances.KeyCodec(), collections.Join(accAddrs[0], fooDenom))
+	require.NoError(err)
+	ctx.KVStore(suite.storeKey).Set(key, []byte("invalid"))
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	err = suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(10)))
+	require.Error(err)
+	require.NotErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	require.Error(suite.bankKeeper.ReleaseHold(ctx, accAddrs[0], "order-1"))
+}
+
+func (suite *KeeperTestSuite) TestHoldsGenesis() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	genState := suite.bankKeeper.ExportGenesis(ctx)
+	require.Empty(genState.Holds)
+
+	balances := sdk.NewCoins(newFooCoin(100))
+	genState.Balances = append(genState.Balances, banktypes.Balance{Address: accAddrs[0].String(), Coins: balances})
+	genState.Supply = genState.Supply.Add(balances...)
+	genState.Holds = []banktypes.Hold{
+		banktypes.NewHold(accAddrs[0], "order-1", sdk.NewCoins(newFooCoin(10))),
+		banktypes.NewHold(accAddrs[0], "order-2", sdk.NewCoins(newFooCoin(20))),
+	}
+	require.NoError(genState.Validate())
+
+	suite.SetupTest()
+	ctx = sdk.UnwrapSDKContext(suite.ctx)
+	suite.bankKeeper.InitGenesis(ctx, genState)
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetHeldCoins(ctx, accAddrs[0]))
+	require.Equal(genState.Holds, suite.bankKeeper.ExportGenesis(ctx).Holds)
+}
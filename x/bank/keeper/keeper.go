@@ -3,14 +3,18 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"io"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -25,14 +29,20 @@ var _ Keeper = (*BaseKeeper)(nil)
 type Keeper interface {
 	SendKeeper
 	WithMintCoinsRestriction(MintingRestrictionFn) BaseKeeper
+	FoldTransferVolume(ctx context.Context) error
+	RebuildDenomIndex(ctx context.Context) error
+	DeleteBankStoreDenomIndex(ctx context.Context) error
 
 	InitGenesis(context.Context, *types.GenesisState)
 	ExportGenesis(context.Context) *types.GenesisState
+	ExportGenesisTo(context.Context, codec.JSONCodec, io.Writer) error
 
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 	HasSupply(ctx context.Context, denom string) bool
 	GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	GetPaginatedSupplyWithPrefix(ctx context.Context, prefix string, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
+	IterateSupplyWithPrefix(ctx context.Context, prefix string, cb func(sdk.Coin) bool)
 	GetDenomMetaData(ctx context.Context, denom string) (types.Metadata, bool)
 	HasDenomMetaData(ctx context.Context, denom string) bool
 	SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata)
@@ -50,6 +60,9 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	AddHold(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, holdID string) error
+	ReleaseHold(ctx context.Context, addr sdk.AccAddress, holdID string) error
+
 	types.QueryServer
 }
 
@@ -66,9 +79,25 @@ type BaseKeeper struct {
 
 type MintingRestrictionFn func(ctx context.Context, coins sdk.Coins) error
 
-// GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
+// GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination.
+// The supplies are ordered by the bytes of their denom.
 func (k BaseKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
-	results, pageResp, err := query.CollectionPaginate[string, math.Int](ctx, k.Supply, pagination)
+	return k.GetPaginatedSupplyWithPrefix(ctx, "", pagination)
+}
+
+// GetPaginatedSupplyWithPrefix queries for the supply of the denoms starting
+// with the given prefix, with a given pagination. Only the matching denoms are
+// iterated over, and the next key of the page response is relative to the
+// prefix.
+func (k BaseKeeper) GetPaginatedSupplyWithPrefix(ctx context.Context, prefix string, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	var opts []func(o *query.CollectionsPaginateOptions[string])
+	if prefix != "" {
+		opts = append(opts, func(o *query.CollectionsPaginateOptions[string]) {
+			o.Prefix = &prefix
+		})
+	}
+
+	results, pageResp, err := query.CollectionFilteredPaginate[string, math.Int](ctx, k.Supply, pagination, nil, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -132,6 +161,60 @@ func (k BaseKeeper) WithMintCoinsRestriction(check MintingRestrictionFn) BaseKee
 	return k
 }
 
+// WithTransientStoreService sets the transient store the transfer volume of the
+// current block is accumulated in. The transfer volume is not tracked by
+// keepers without transient store, regardless of the track_transfer_volume param.
+func (k BaseKeeper) WithTransientStoreService(transientStoreService store.TransientStoreService) BaseKeeper {
+	sb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
+	k.transientStoreService = transientStoreService
+	k.BlockTransferVolume = collections.NewMap(sb, types.BlockTransferVolumePrefix, "block_transfer_volume", collections.StringKey, sdk.IntValue)
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// WithDenomIndexStoreService moves the index of the balances by denom to the
+// given store, meant to be a derived store, so that it is no longer part of the
+// app hash. The index is then rebuilt from the balances with RebuildDenomIndex
+// whenever the store is stale. Apps enabling it on an existing chain must
+// delete the index entries left in the bank store with DeleteBankStoreDenomIndex
+// in an upgrade handler.
+func (k BaseKeeper) WithDenomIndexStoreService(storeService store.KVStoreService) BaseKeeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k.denomIndexStoreService = storeService
+	k.Balances = newBalances(collections.NewSchemaBuilder(k.storeService), newBalancesIndexes(sb))
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// DeleteBankStoreDenomIndex deletes the index of the balances by denom left in
+// the bank store, if the index is kept in a derived store, i.e. once it was
+// moved with WithDenomIndexStoreService.
+func (k BaseKeeper) DeleteBankStoreDenomIndex(ctx context.Context) error {
+	if k.denomIndexStoreService == nil {
+		return nil
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.DenomAddressPrefix)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return nil
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -151,15 +234,19 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
-		if balance.IsLT(coin) {
+		// coins placed on hold cannot be delegated
+		held, err := k.getHeldAmount(ctx, delegatorAddr, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if available := balance.SubAmount(held); available.IsLT(coin) {
 			return errorsmod.Wrapf(
-				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", available, amt,
 			)
 		}
 
 		balances = balances.Add(balance)
-		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
-		if err != nil {
+		if err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin)); err != nil {
 			return err
 		}
 	}
@@ -281,7 +368,7 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+	return k.sendCoins(ctx, senderAddr, recipientAddr, amt)
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
@@ -299,7 +386,7 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
@@ -312,7 +399,7 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // DelegateCoinsFromAccountToModule delegates coins and transfers them from a
@@ -478,3 +565,13 @@ func (k BaseViewKeeper) IterateTotalSupply(ctx context.Context, cb func(sdk.Coin
 		return cb(sdk.NewCoin(s, m))
 	})
 }
+
+// IterateSupplyWithPrefix iterates over the supply of the denoms starting with
+// the given prefix, in the order of their bytes, calling the given cb
+// (callback) function with the supply of each coin.
+// The iteration stops if the callback returns true.
+func (k BaseViewKeeper) IterateSupplyWithPrefix(ctx context.Context, prefix string, cb func(sdk.Coin) bool) {
+	_ = k.Supply.Walk(ctx, new(collections.Range[string]).Prefix(prefix), func(s string, m math.Int) bool {
+		return cb(sdk.NewCoin(s, m))
+	})
+}
@@ -111,6 +111,7 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx        context.Context
+	storeKey   *storetypes.KVStoreKey
 	bankKeeper keeper.BaseKeeper
 	authKeeper *banktestutil.MockAccountKeeper
 
@@ -126,7 +127,8 @@ func TestKeeperTestSuite(t *testing.T) {
 
 func (suite *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, tkey)
 	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: cmttime.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
@@ -137,6 +139,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	authKeeper := banktestutil.NewMockAccountKeeper(ctrl)
 
 	suite.ctx = ctx
+	suite.storeKey = key
 	suite.authKeeper = authKeeper
 	suite.bankKeeper = keeper.NewBaseKeeper(
 		encCfg.Codec,
@@ -145,7 +148,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		map[string]bool{accAddrs[4].String(): true},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		log.NewNopLogger(),
-	)
+	).WithTransientStoreService(runtime.NewTransientStoreService(tkey))
 
 	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 
@@ -828,9 +831,17 @@ func (suite *KeeperTestSuite) TestMsgMultiSendEvents() {
 		event2.Attributes,
 		abci.EventAttribute{Key: banktypes.AttributeKeyRecipient, Value: accAddrs[2].String()},
 	)
+	event2.Attributes = append(
+		event2.Attributes,
+		abci.EventAttribute{Key: banktypes.AttributeKeySender, Value: accAddrs[0].String()},
+	)
 	event2.Attributes = append(
 		event2.Attributes,
 		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins.String()})
+	event2.Attributes = append(
+		event2.Attributes,
+		abci.EventAttribute{Key: banktypes.AttributeKeyOutputIndex, Value: "0"},
+	)
 	event3 := sdk.Event{
 		Type:       banktypes.EventTypeTransfer,
 		Attributes: []abci.EventAttribute{},
@@ -839,10 +850,18 @@ func (suite *KeeperTestSuite) TestMsgMultiSendEvents() {
 		event3.Attributes,
 		abci.EventAttribute{Key: banktypes.AttributeKeyRecipient, Value: accAddrs[3].String()},
 	)
+	event3.Attributes = append(
+		event3.Attributes,
+		abci.EventAttribute{Key: banktypes.AttributeKeySender, Value: accAddrs[0].String()},
+	)
 	event3.Attributes = append(
 		event3.Attributes,
 		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins2.String()},
 	)
+	event3.Attributes = append(
+		event3.Attributes,
+		abci.EventAttribute{Key: banktypes.AttributeKeyOutputIndex, Value: "1"},
+	)
 	// events are shifted due to the funding account events
 	require.Equal(abci.Event(event1), events[25])
 	require.Equal(abci.Event(event2), events[27])
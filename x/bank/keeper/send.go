@@ -3,10 +3,12 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -63,6 +65,11 @@ type BaseSendKeeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// the transient store accumulating the transfer volume of the current block,
+	// the transfer volume is not tracked when nil
+	transientStoreService store.TransientStoreService
+	BlockTransferVolume   collections.Map[string, math.Int]
 }
 
 func NewBaseSendKeeper(
@@ -110,7 +117,9 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled) //nolint:staticcheck // SA1019: params.SendEnabled is deprecated
 
 		// override params without SendEnabled
+		trackTransferVolume := params.TrackTransferVolume
 		params = types.NewParams(params.DefaultSendEnabled)
+		params.TrackTransferVolume = trackTransferVolume
 	}
 	return k.Params.Set(ctx, params)
 }
@@ -143,7 +152,8 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		),
 	)
 
-	for _, out := range outputs {
+	// the events are emitted following InputOutputCoinsEventSchema
+	for i, out := range outputs {
 		outAddress, err := sdk.AccAddressFromBech32(out.Address)
 		if err != nil {
 			return err
@@ -157,7 +167,9 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			sdk.NewEvent(
 				types.EventTypeTransfer,
 				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeySender, input.Address),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+				sdk.NewAttribute(types.AttributeKeyOutputIndex, strconv.Itoa(i)),
 			),
 		)
 
@@ -172,12 +184,22 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		}
 	}
 
-	return nil
+	return k.trackTransferVolume(ctx, input.Coins)
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.sendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	return k.trackTransferVolume(ctx, amt)
+}
+
+// sendCoins transfers amt coins from a sending account to a receiving account,
+// without tracking the transfer volume.
+func (k BaseSendKeeper) sendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// trackTransferVolume adds amt to the transfer volume of the current block when
+// the transfer volume is tracked.
+func (k BaseSendKeeper) trackTransferVolume(ctx context.Context, amt sdk.Coins) error {
+	if k.transientStoreService == nil || !k.GetParams(ctx).TrackTransferVolume {
+		return nil
+	}
+
+	for _, coin := range amt {
+		volume, err := k.BlockTransferVolume.Get(ctx, coin.Denom)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if volume.IsNil() {
+			volume = math.ZeroInt()
+		}
+
+		if err := k.BlockTransferVolume.Set(ctx, coin.Denom, volume.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FoldTransferVolume moves the transfer volume of the current block into its
+// slot of the transfer volume window, the block height modulo the size of the
+// window, overwriting the volume of the block the slot was last written at. It
+// is a no-op when the transfer volume is not tracked, leaving the slots of the
+// blocks it skips to their previous heights.
+func (k BaseKeeper) FoldTransferVolume(ctx context.Context) error {
+	if k.transientStoreService == nil || !k.GetParams(ctx).TrackTransferVolume {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	slot := uint64(height) % types.TransferVolumeWindow
+
+	// the keys are collected first, as the store cannot be written while iterated
+	var stale []collections.Pair[uint64, string]
+	err := k.TransferVolumes.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](slot), func(key collections.Pair[uint64, string], _ math.Int) bool {
+		stale = append(stale, key)
+		return false
+	})
+	// an empty range yields an invalid iterator
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+	for _, key := range stale {
+		if err := k.TransferVolumes.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	var denoms []string
+	err = k.BlockTransferVolume.Walk(ctx, nil, func(denom string, _ math.Int) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+	for _, denom := range denoms {
+		volume, err := k.BlockTransferVolume.Get(ctx, denom)
+		if err != nil {
+			return err
+		}
+		if err := k.TransferVolumes.Set(ctx, collections.Join(slot, denom), volume); err != nil {
+			return err
+		}
+		// removed as well, as the transient store is only reset on commit
+		if err := k.BlockTransferVolume.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return k.TransferVolumeHeights.Set(ctx, slot, height)
+}
+
+// GetTransferVolume returns the volume of denom transferred between accounts
+// over the last n blocks up to the current one, along with the number of these
+// blocks the volume was tracked for. n must not exceed the size of the transfer
+// volume window.
+func (k BaseViewKeeper) GetTransferVolume(ctx context.Context, denom string, n uint64) (sdk.Coin, uint64, error) {
+	volume := sdk.NewCoin(denom, math.ZeroInt())
+	tracked := uint64(0)
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for i := uint64(0); i < n && int64(i) < height; i++ {
+		h := height - int64(i)
+		slot := uint64(h) % types.TransferVolumeWindow
+
+		slotHeight, err := k.TransferVolumeHeights.Get(ctx, slot)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return sdk.Coin{}, 0, err
+		}
+		// the slot was not written at h, or already overwritten by a later block
+		if slotHeight != h {
+			continue
+		}
+		tracked++
+
+		amount, err := k.TransferVolumes.Get(ctx, collections.Join(slot, denom))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return sdk.Coin{}, 0, err
+		}
+		volume.Amount = volume.Amount.Add(amount)
+	}
+
+	return volume, tracked, nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferVolume() {
+	require := suite.Require()
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(1e9), newBarCoin(1e9))))
+
+	// sends i foo coins at height i, and folds the volume of the block
+	runBlock := func(height int64) {
+		ctx := ctx.WithBlockHeight(height)
+		suite.mockSendCoins(ctx, acc0, accAddrs[1])
+		require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(height))))
+		require.NoError(suite.bankKeeper.FoldTransferVolume(ctx))
+	}
+	transferVolume := func(height int64, denom string, n uint64) (sdk.Coin, uint64) {
+		volume, tracked, err := suite.bankKeeper.GetTransferVolume(ctx.WithBlockHeight(height), denom, n)
+		require.NoError(err)
+		return volume, tracked
+	}
+
+	// the volume is not tracked by default
+	runBlock(1)
+	volume, tracked := transferVolume(1, fooDenom, 1)
+	require.Equal(newFooCoin(0), volume)
+	require.Zero(tracked)
+
+	params := suite.bankKeeper.GetParams(ctx)
+	params.TrackTransferVolume = true
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	window := int64(banktypes.TransferVolumeWindow)
+	for height := int64(2); height <= window+5; height++ {
+		runBlock(height)
+	}
+
+	// the last 10 blocks wrap around the end of the window
+	volume, tracked = transferVolume(window+5, fooDenom, 10)
+	require.Equal(newFooCoin(10*window+5), volume) // window-4 + ... + window+5
+	require.Equal(uint64(10), tracked)
+
+	// the whole window, whose slots 2 to 5 were overwritten by the last blocks
+	volume, tracked = transferVolume(window+5, fooDenom, uint64(window))
+	require.Equal(newFooCoin((6+window+5)*window/2), volume)
+	require.Equal(uint64(window), tracked)
+	volume, _ = transferVolume(window+5, barDenom, uint64(window))
+	require.Equal(newBarCoin(0), volume)
+
+	// at an earlier height, the overwritten slots are not counted anymore
+	volume, tracked = transferVolume(window, fooDenom, 10)
+	require.Equal(newFooCoin(10*window-45), volume)
+	require.Equal(uint64(10), tracked)
+	volume, tracked = transferVolume(5, fooDenom, 5)
+	require.Equal(newFooCoin(0), volume)
+	require.Zero(tracked)
+
+	// mint, burn and transfers from or to module accounts are not tracked
+	ctx = ctx.WithBlockHeight(window + 6)
+	suite.ctx = ctx
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.mockSendCoinsFromAccountToModule(acc0, burnerAcc)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddrs[0], authtypes.Burner, sdk.NewCoins(newFooCoin(100))))
+	suite.mockBurnCoins(burnerAcc)
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, authtypes.Burner, sdk.NewCoins(newFooCoin(100))))
+	require.NoError(suite.bankKeeper.FoldTransferVolume(ctx))
+
+	volume, tracked = transferVolume(window+6, fooDenom, 1)
+	require.Equal(newFooCoin(0), volume)
+	require.Equal(uint64(1), tracked)
+
+	// blocks where the volume is not tracked are skipped
+	params.TrackTransferVolume = false
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	runBlock(window + 7)
+	volume, tracked = transferVolume(window+7, fooDenom, 3)
+	require.Equal(newFooCoin(window+5), volume)
+	require.Equal(uint64(2), tracked)
+}
+
+func (suite *KeeperTestSuite) TestQueryTransferVolume() {
+	require := suite.Require()
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+
+	params := suite.bankKeeper.GetParams(ctx)
+	params.TrackTransferVolume = true
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	ctx = ctx.WithBlockHeight(1)
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[1], accAddrs[2]})
+	input := banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(30)))
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(10))),
+		banktypes.NewOutput(accAddrs[2], sdk.NewCoins(newFooCoin(20))),
+	}
+	require.NoError(suite.bankKeeper.InputOutputCoins(suite.ctx, input, outputs))
+	require.NoError(suite.bankKeeper.FoldTransferVolume(ctx))
+
+	queryClient := suite.mockQueryClient(ctx)
+	res, err := queryClient.TransferVolume(ctx, &banktypes.QueryTransferVolumeRequest{Denom: fooDenom, LastNBlocks: 100})
+	require.NoError(err)
+	require.Equal(newFooCoin(30), res.Volume)
+	require.Equal(uint64(1), res.TrackedBlocks)
+
+	for _, req := range []*banktypes.QueryTransferVolumeRequest{
+		{Denom: "", LastNBlocks: 1},
+		{Denom: fooDenom, LastNBlocks: 0},
+		{Denom: fooDenom, LastNBlocks: banktypes.TransferVolumeWindow + 1},
+	} {
+		_, err := queryClient.TransferVolume(ctx, req)
+		require.Equal(codes.InvalidArgument, status.Code(err), req)
+	}
+}
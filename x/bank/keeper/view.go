@@ -50,6 +50,11 @@ func newBalancesIndexes(sb *collections.SchemaBuilder) BalancesIndexes {
 	}
 }
 
+// newBalances returns the balances of the accounts, indexed with indexes.
+func newBalances(sb *collections.SchemaBuilder, indexes BalancesIndexes) *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes] {
+	return collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.NewBalanceCompatValueCodec(), indexes)
+}
+
 type BalancesIndexes struct {
 	Denom *indexes.ReversePair[sdk.AccAddress, string, math.Int]
 }
@@ -71,6 +76,16 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+
+	TransferVolumes       collections.Map[collections.Pair[uint64, string], math.Int]
+	TransferVolumeHeights collections.Map[uint64, int64]
+
+	AccountHolds collections.Map[collections.Pair[sdk.AccAddress, string], types.Hold]
+	HeldBalances collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+
+	// the derived store the index of the balances by denom is kept in, the
+	// index being part of the bank store when nil
+	denomIndexStoreService store.KVStoreService
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -84,8 +99,14 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
 		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.NewBalanceCompatValueCodec(), newBalancesIndexes(sb)),
+		Balances:      newBalances(sb, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		TransferVolumes:       collections.NewMap(sb, types.TransferVolumePrefix, "transfer_volume", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
+		TransferVolumeHeights: collections.NewMap(sb, types.TransferVolumeHeightPrefix, "transfer_volume_height", collections.Uint64Key, collections.Int64Value),
+
+		AccountHolds: collections.NewMap(sb, types.HoldsPrefix, "holds", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.Hold](cdc)),
+		HeldBalances: collections.NewMap(sb, types.HeldBalancesPrefix, "held_balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), sdk.IntValue),
 	}
 
 	schema, err := sb.Build()
@@ -96,6 +117,25 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 	return k
 }
 
+// RebuildDenomIndex writes the index of the balances by denom from the
+// balances, if the index is kept in a derived store.
+func (k BaseViewKeeper) RebuildDenomIndex(ctx context.Context) error {
+	if k.denomIndexStoreService == nil {
+		return nil
+	}
+
+	var indexErr error
+	err := k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], balance math.Int) bool {
+		indexErr = k.Balances.Indexes.Denom.Reference(ctx, key, balance, nil)
+		return indexErr != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return indexErr
+}
+
 // HasBalance returns whether or not an account has at least amt balance.
 func (k BaseViewKeeper) HasBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coin) bool {
 	return k.GetBalance(ctx, addr, amt.Denom).IsGTE(amt)
@@ -172,26 +212,28 @@ func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccA
 	err := k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], value math.Int) bool {
 		return cb(key.K1(), sdk.NewCoin(key.K2(), value))
 	})
-	if err != nil {
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
 		panic(err)
 	}
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address: the coins placed on hold, plus for vesting accounts the
+// locked coins of the concrete vesting account type. For standard accounts
+// without holds, the result will always be no coins.
 func (k BaseViewKeeper) LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := k.GetHeldCoins(ctx, addr)
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
 			sdkCtx := sdk.UnwrapSDKContext(ctx)
-			return vacc.LockedCoins(sdkCtx.BlockTime())
+			return vacc.LockedCoins(sdkCtx.BlockTime()).Add(held...)
 		}
 	}
 
-	return sdk.NewCoins()
+	return held
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
@@ -74,9 +74,11 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"holds": [],
 	"params": {
 		"default_send_enabled": false,
-		"send_enabled": []
+		"send_enabled": [],
+		"track_transfer_volume": false
 	},
 	"send_enabled": [],
 	"supply": [
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
@@ -107,7 +108,13 @@ type AppModule struct {
 	legacySubspace exported.Subspace
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+
+	_ module.HasDerivedStores    = AppModule{}
+	_ module.HasStreamingGenesis = AppModule{}
+)
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
@@ -174,9 +181,27 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// ExportGenesisTo writes the exported genesis state as JSON to w, streaming
+// the account balances from the store.
+func (am AppModule) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return am.keeper.ExportGenesisTo(ctx, cdc, w)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock returns the end blocker for the bank module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	c := sdk.UnwrapSDKContext(ctx)
+	return EndBlocker(c, am.keeper)
+}
+
+// RebuildDerivedStores rebuilds the index of the balances by denom when it is
+// kept in a derived store.
+func (am AppModule) RebuildDerivedStores(ctx context.Context) error {
+	return am.keeper.RebuildDenomIndex(ctx)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the bank module.
@@ -210,10 +235,11 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
-	Config       *modulev1.Module
-	Cdc          codec.Codec
-	StoreService corestore.KVStoreService
-	Logger       log.Logger
+	Config           *modulev1.Module
+	Cdc              codec.Codec
+	StoreService     corestore.KVStoreService
+	TransientService corestore.TransientStoreService
+	Logger           log.Logger
 
 	AccountKeeper types.AccountKeeper
 
@@ -257,7 +283,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		blockedAddresses,
 		authority.String(),
 		in.Logger,
-	)
+	).WithTransientStoreService(in.TransientService)
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper, in.LegacySubspace)
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
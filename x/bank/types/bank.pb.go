@@ -37,6 +37,9 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// track_transfer_volume enables the aggregation of the transfer volume per denom
+	// over the last blocks, exposed by Query/TransferVolume.
+	TrackTransferVolume bool `protobuf:"varint,3,opt,name=track_transfer_volume,json=trackTransferVolume,proto3" json:"track_transfer_volume,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +90,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetTrackTransferVolume() bool {
+	if m != nil {
+		return m.TrackTransferVolume
+	}
+	return false
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -450,6 +460,51 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// Hold defines coins of an account placed on hold by a module under an id. Held
+// coins remain owned by the account but cannot be spent, sent or delegated
+// until the module releases the hold.
+type Hold struct {
+	// address is the address of the account the coins are held for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// id is the identifier of the hold, chosen by the module which placed it.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// amount defines the coins placed on hold.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Hold) Reset()         { *m = Hold{} }
+func (m *Hold) String() string { return proto.CompactTextString(m) }
+func (*Hold) ProtoMessage()    {}
+func (*Hold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *Hold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hold.Merge(m, src)
+}
+func (m *Hold) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hold) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hold proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -458,55 +513,60 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*Hold)(nil), "cosmos.bank.v1beta1.Hold")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0xcd, 0x47, 0x2f, 0x65, 0xe0, 0x88, 0xe0, 0x5a, 0x84, 0x13, 0x65, 0x40, 0x69,
-	0xa4, 0x26, 0xb4, 0x6c, 0x59, 0x10, 0x29, 0x5f, 0x19, 0x10, 0xc8, 0x55, 0x85, 0xc4, 0x12, 0x5d,
-	0xe2, 0x23, 0x39, 0xd5, 0xbe, 0xb3, 0x7c, 0xe7, 0xd2, 0xac, 0x4c, 0xa8, 0x13, 0x33, 0x53, 0xc5,
-	0x84, 0x10, 0x43, 0x86, 0xee, 0xac, 0x55, 0xa7, 0x8a, 0x89, 0xa9, 0xa0, 0x74, 0x48, 0xff, 0x0c,
-	0xe4, 0x3b, 0x3b, 0x4d, 0xa5, 0xc2, 0x88, 0xc4, 0x92, 0xbc, 0xf7, 0x7e, 0x3f, 0xbf, 0xf7, 0x7b,
-	0x1f, 0x36, 0xb4, 0xfa, 0x42, 0x7a, 0x42, 0x36, 0x7b, 0x84, 0xef, 0x34, 0x77, 0xd7, 0x7b, 0x54,
-	0x91, 0x75, 0xed, 0x34, 0xfc, 0x40, 0x28, 0x81, 0x6e, 0x18, 0xbc, 0xa1, 0x43, 0x31, 0xbe, 0x52,
-	0x1a, 0x88, 0x81, 0xd0, 0x78, 0x33, 0xb2, 0x0c, 0x75, 0x65, 0xd9, 0x50, 0xbb, 0x06, 0x88, 0x9f,
-	0x33, 0xd0, 0x45, 0x15, 0x49, 0x67, 0x55, 0xfa, 0x82, 0xf1, 0x18, 0xbf, 0x15, 0xe3, 0x9e, 0x1c,
-	0x34, 0x77, 0xd7, 0xa3, 0xbf, 0x18, 0xb8, 0x4e, 0x3c, 0xc6, 0x45, 0x53, 0xff, 0x9a, 0x50, 0xf5,
-	0x13, 0x80, 0xb9, 0x97, 0x24, 0x20, 0x9e, 0x44, 0x4f, 0xe1, 0x92, 0xa4, 0xdc, 0xe9, 0x52, 0x4e,
-	0x7a, 0x2e, 0x75, 0x30, 0xa8, 0x64, 0x6a, 0xc5, 0x8d, 0x4a, 0xe3, 0x0a, 0xcd, 0x8d, 0x2d, 0xca,
-	0x9d, 0xc7, 0x86, 0xd7, 0x4e, 0x63, 0x60, 0x17, 0xe5, 0x45, 0x00, 0xdd, 0x83, 0x25, 0x87, 0xbe,
-	0x21, 0xa1, 0xab, 0xba, 0x97, 0x12, 0xa6, 0x2b, 0xa0, 0x56, 0xb0, 0x51, 0x8c, 0xcd, 0xa5, 0x68,
-	0xdd, 0xd9, 0x9f, 0x8e, 0xeb, 0xd8, 0x14, 0x5a, 0x93, 0xce, 0x4e, 0x73, 0xcf, 0x8c, 0xd0, 0x28,
-	0xab, 0x6e, 0xc2, 0xe2, 0x1c, 0x1b, 0x95, 0x60, 0xd6, 0xa1, 0x5c, 0x78, 0x18, 0x54, 0x40, 0x6d,
-	0xd1, 0x36, 0x0e, 0xc2, 0x30, 0x7f, 0xb9, 0x50, 0xe2, 0xb6, 0x16, 0xce, 0x0f, 0xca, 0xa0, 0x7a,
-	0x0c, 0x60, 0xb6, 0xc3, 0xfd, 0x50, 0xa1, 0x0d, 0x98, 0x27, 0x8e, 0x13, 0x50, 0x29, 0x4d, 0x86,
-	0x36, 0xfe, 0x7e, 0xb8, 0x56, 0x8a, 0xdb, 0x7c, 0x68, 0x90, 0x2d, 0x15, 0x30, 0x3e, 0xb0, 0x13,
-	0x22, 0x7a, 0x0b, 0xb3, 0xd1, 0x84, 0x25, 0x4e, 0xeb, 0xa9, 0x2c, 0x5f, 0x4c, 0x45, 0xd2, 0xd9,
-	0x54, 0x36, 0x05, 0xe3, 0xed, 0x27, 0x47, 0xa7, 0xe5, 0xd4, 0x97, 0x9f, 0xe5, 0xda, 0x80, 0xa9,
-	0x61, 0xd8, 0x6b, 0xf4, 0x85, 0x17, 0xaf, 0xaf, 0x39, 0xd7, 0xa0, 0x1a, 0xf9, 0x54, 0xea, 0x07,
-	0xe4, 0xc7, 0xe9, 0xb8, 0xbe, 0xe4, 0xd2, 0x01, 0xe9, 0x8f, 0xba, 0xba, 0xc6, 0xe7, 0xe9, 0xb8,
-	0x0e, 0x6c, 0x53, 0xaf, 0x55, 0x7a, 0x7f, 0x50, 0x4e, 0x9d, 0x1f, 0x94, 0x53, 0xef, 0xa6, 0xe3,
-	0x7a, 0x22, 0xa7, 0xfa, 0x0d, 0xc0, 0xdc, 0x8b, 0x50, 0xfd, 0x77, 0xdd, 0x14, 0x92, 0x6e, 0xaa,
-	0x5f, 0x01, 0xcc, 0x6d, 0x85, 0xbe, 0xef, 0x8e, 0x22, 0x35, 0x4a, 0x28, 0xe2, 0xc6, 0x17, 0xf7,
-	0x2f, 0xd4, 0xe8, 0x7a, 0xad, 0xd5, 0x58, 0x0d, 0x38, 0x3e, 0x5c, 0xbb, 0x7d, 0xe5, 0x99, 0x6b,
-	0x81, 0x1d, 0x0c, 0xaa, 0xaf, 0xe0, 0xe2, 0xa3, 0xe8, 0xcc, 0xb6, 0x39, 0x53, 0x7f, 0x38, 0xc0,
-	0x15, 0x58, 0xa0, 0x7b, 0xbe, 0xe0, 0x94, 0x2b, 0x7d, 0x81, 0xd7, 0xec, 0x99, 0x1f, 0x1d, 0x27,
-	0x71, 0x19, 0x91, 0x54, 0xe2, 0x4c, 0x25, 0x53, 0x5b, 0xb4, 0x13, 0xb7, 0xba, 0x9f, 0x86, 0x85,
-	0xe7, 0x54, 0x11, 0x87, 0x28, 0x82, 0x2a, 0xb0, 0xe8, 0x50, 0xd9, 0x0f, 0x98, 0xaf, 0x98, 0xe0,
-	0x71, 0xfa, 0xf9, 0x10, 0x7a, 0x10, 0x31, 0xb8, 0xf0, 0xba, 0x21, 0x67, 0x2a, 0xd9, 0x9f, 0x75,
-	0xe5, 0x3b, 0x3a, 0xd3, 0x6b, 0x43, 0x27, 0x31, 0x25, 0x42, 0x70, 0x21, 0x9a, 0x2b, 0xce, 0xe8,
-	0xdc, 0xda, 0x8e, 0xd4, 0x39, 0x4c, 0xfa, 0x2e, 0x19, 0xe1, 0x05, 0x1d, 0x4e, 0xdc, 0x88, 0xcd,
-	0x89, 0x47, 0x71, 0xd6, 0xb0, 0x23, 0x1b, 0xdd, 0x84, 0x39, 0x39, 0xf2, 0x7a, 0xc2, 0xc5, 0x39,
-	0x1d, 0x8d, 0x3d, 0xb4, 0x0c, 0x33, 0x61, 0xc0, 0x70, 0x5e, 0x1f, 0x61, 0x7e, 0x72, 0x5a, 0xce,
-	0x6c, 0xdb, 0x1d, 0x3b, 0x8a, 0xa1, 0xbb, 0xb0, 0x10, 0x06, 0xac, 0x3b, 0x24, 0x72, 0x88, 0x0b,
-	0x1a, 0x2f, 0x4e, 0x4e, 0xcb, 0xf9, 0x6d, 0xbb, 0xf3, 0x8c, 0xc8, 0xa1, 0x9d, 0x0f, 0x03, 0x16,
-	0x19, 0xed, 0xcd, 0xa3, 0x89, 0x05, 0x4e, 0x26, 0x16, 0xf8, 0x35, 0xb1, 0xc0, 0x87, 0x33, 0x2b,
-	0x75, 0x72, 0x66, 0xa5, 0x7e, 0x9c, 0x59, 0xa9, 0xd7, 0xab, 0x7f, 0xdd, 0x78, 0xfc, 0xb9, 0xd0,
-	0x8b, 0xef, 0xe5, 0xf4, 0x97, 0xed, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x47, 0xd8,
-	0x49, 0x8d, 0x05, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x3b, 0x35,
+	0x18, 0x8e, 0xf3, 0x1d, 0xe7, 0x07, 0x12, 0xfe, 0x05, 0x70, 0x8b, 0xb8, 0x44, 0x37, 0xa0, 0x34,
+	0x52, 0x13, 0x5a, 0xb6, 0x2c, 0x88, 0x94, 0x8f, 0x66, 0x40, 0xa0, 0x2b, 0x05, 0x89, 0xe5, 0xe4,
+	0xe4, 0xdc, 0xc4, 0xea, 0x9d, 0x7d, 0x3a, 0xfb, 0x4a, 0xb3, 0x32, 0xa1, 0x4e, 0xcc, 0x4c, 0x1d,
+	0x11, 0x62, 0xc8, 0xd0, 0x9d, 0xb5, 0xea, 0x42, 0xc5, 0x80, 0x98, 0x0a, 0x4a, 0x87, 0xf4, 0xcf,
+	0x40, 0x67, 0xdf, 0x25, 0xa9, 0x54, 0x18, 0x18, 0x2a, 0xb1, 0x24, 0xef, 0xfb, 0x3e, 0xaf, 0xfd,
+	0x3c, 0x7e, 0xfd, 0xf8, 0xa0, 0x35, 0x16, 0x32, 0x10, 0xb2, 0x37, 0x22, 0xfc, 0xb4, 0x77, 0xb6,
+	0x37, 0xa2, 0x8a, 0xec, 0xe9, 0xa4, 0x1b, 0x46, 0x42, 0x09, 0xf4, 0xd2, 0xe0, 0x5d, 0x5d, 0x4a,
+	0xf1, 0xed, 0xc6, 0x44, 0x4c, 0x84, 0xc6, 0x7b, 0x49, 0x64, 0x5a, 0xb7, 0xb7, 0x4c, 0xab, 0x6b,
+	0x80, 0x74, 0x9d, 0x81, 0xd6, 0x2c, 0x92, 0xae, 0x58, 0xc6, 0x82, 0xf1, 0x14, 0x7f, 0x33, 0xc5,
+	0x03, 0x39, 0xe9, 0x9d, 0xed, 0x25, 0x7f, 0x29, 0xf0, 0x1a, 0x09, 0x18, 0x17, 0x3d, 0xfd, 0x6b,
+	0x4a, 0xf6, 0xef, 0x00, 0x96, 0x3f, 0x27, 0x11, 0x09, 0x24, 0xfa, 0x04, 0xbe, 0x90, 0x94, 0x7b,
+	0x2e, 0xe5, 0x64, 0xe4, 0x53, 0x0f, 0x83, 0x56, 0xa1, 0x5d, 0xdf, 0x6f, 0x75, 0x9f, 0xd0, 0xdc,
+	0x3d, 0xa2, 0xdc, 0xfb, 0xc8, 0xf4, 0x0d, 0xf2, 0x18, 0x38, 0x75, 0xb9, 0x2e, 0xa0, 0x77, 0x61,
+	0xc3, 0xa3, 0x27, 0x24, 0xf6, 0x95, 0xfb, 0x68, 0xc3, 0x7c, 0x0b, 0xb4, 0xab, 0x0e, 0x4a, 0xb1,
+	0x8d, 0x2d, 0xd0, 0x3e, 0x7c, 0x5d, 0x45, 0x64, 0x7c, 0xea, 0xaa, 0x88, 0x70, 0x79, 0x42, 0x23,
+	0xf7, 0x4c, 0xf8, 0x71, 0x40, 0x71, 0x41, 0x2f, 0x79, 0xa9, 0xc1, 0x2f, 0x52, 0xec, 0x4b, 0x0d,
+	0xf5, 0xdf, 0xbe, 0x58, 0xce, 0x3b, 0xd8, 0x88, 0xdb, 0x95, 0xde, 0x69, 0xef, 0xdc, 0x8c, 0xdd,
+	0x9c, 0xc6, 0x3e, 0x80, 0xf5, 0x4d, 0x86, 0x06, 0x2c, 0x79, 0x94, 0x8b, 0x00, 0x83, 0x16, 0x68,
+	0xd7, 0x1c, 0x93, 0x20, 0x0c, 0x2b, 0x8f, 0xc5, 0x65, 0x69, 0xbf, 0xf8, 0x70, 0xd9, 0x04, 0xf6,
+	0x0d, 0x80, 0xa5, 0x21, 0x0f, 0x63, 0x85, 0xf6, 0x61, 0x85, 0x78, 0x5e, 0x44, 0xa5, 0x34, 0x3b,
+	0x0c, 0xf0, 0x6f, 0x57, 0xbb, 0x8d, 0x74, 0x34, 0x1f, 0x18, 0xe4, 0x48, 0x45, 0x8c, 0x4f, 0x9c,
+	0xac, 0x11, 0x7d, 0x03, 0x4b, 0xc9, 0xad, 0x48, 0x9c, 0xd7, 0x93, 0xdc, 0x5a, 0x4f, 0x52, 0xd2,
+	0xd5, 0x24, 0x0f, 0x04, 0xe3, 0x83, 0x8f, 0xaf, 0xef, 0x9a, 0xb9, 0x9f, 0xfe, 0x6c, 0xb6, 0x27,
+	0x4c, 0x4d, 0xe3, 0x51, 0x77, 0x2c, 0x82, 0xf4, 0xca, 0x7b, 0x1b, 0x07, 0x54, 0xb3, 0x90, 0x4a,
+	0xbd, 0x40, 0xfe, 0xb0, 0x9c, 0x77, 0x5e, 0xf8, 0x74, 0x42, 0xc6, 0x33, 0x57, 0x73, 0xfc, 0xb8,
+	0x9c, 0x77, 0x80, 0x63, 0xf8, 0xfa, 0x8d, 0xef, 0x2e, 0x9b, 0xb9, 0x87, 0xcb, 0x66, 0xee, 0xdb,
+	0xe5, 0xbc, 0x93, 0xc9, 0xb1, 0x7f, 0x01, 0xb0, 0xfc, 0x59, 0xac, 0xfe, 0x77, 0xa7, 0xa9, 0x66,
+	0xa7, 0xb1, 0x7f, 0x06, 0xb0, 0x7c, 0x14, 0x87, 0xa1, 0x3f, 0x4b, 0xd4, 0x28, 0xa1, 0x88, 0x8f,
+	0xc1, 0xb3, 0xa9, 0xd1, 0x7c, 0xfd, 0x9d, 0x54, 0x0d, 0xb8, 0xb9, 0xda, 0x7d, 0xeb, 0xc9, 0xa7,
+	0xa1, 0x05, 0x0e, 0x31, 0xb0, 0xbf, 0x82, 0xb5, 0x0f, 0x13, 0x9b, 0x1d, 0x73, 0xa6, 0xfe, 0xc1,
+	0x80, 0xdb, 0xb0, 0x4a, 0xcf, 0x43, 0xc1, 0x29, 0x57, 0xda, 0x81, 0xaf, 0x38, 0xab, 0x3c, 0x31,
+	0x27, 0xf1, 0x19, 0x91, 0x54, 0xe2, 0x42, 0xab, 0xd0, 0xae, 0x39, 0x59, 0x6a, 0x5f, 0xe4, 0x61,
+	0xf5, 0x53, 0xaa, 0x88, 0x47, 0x14, 0x41, 0x2d, 0x58, 0xf7, 0xa8, 0x1c, 0x47, 0x2c, 0x54, 0x4c,
+	0xf0, 0x74, 0xfb, 0xcd, 0x12, 0x7a, 0x3f, 0xe9, 0xe0, 0x22, 0x70, 0x63, 0xce, 0x54, 0x76, 0x7f,
+	0xd6, 0x93, 0xef, 0x7a, 0xa5, 0xd7, 0x81, 0x5e, 0x16, 0x4a, 0x84, 0x60, 0x31, 0x99, 0xab, 0x7e,
+	0x8d, 0x35, 0x47, 0xc7, 0x89, 0x3a, 0x8f, 0xc9, 0xd0, 0x27, 0x33, 0x5c, 0xd4, 0xe5, 0x2c, 0x4d,
+	0xba, 0x39, 0x09, 0x28, 0x2e, 0x99, 0xee, 0x24, 0x46, 0x6f, 0xc0, 0xb2, 0x9c, 0x05, 0x23, 0xe1,
+	0xe3, 0xb2, 0xae, 0xa6, 0x19, 0xda, 0x82, 0x85, 0x38, 0x62, 0xb8, 0xa2, 0x4d, 0x58, 0x59, 0xdc,
+	0x35, 0x0b, 0xc7, 0xce, 0xd0, 0x49, 0x6a, 0xe8, 0x1d, 0x58, 0x8d, 0x23, 0xe6, 0x4e, 0x89, 0x9c,
+	0xe2, 0xaa, 0xc6, 0xeb, 0x8b, 0xbb, 0x66, 0xe5, 0xd8, 0x19, 0x1e, 0x12, 0x39, 0x75, 0x2a, 0x71,
+	0xc4, 0x92, 0xc0, 0xfe, 0x15, 0xc0, 0xe2, 0xa1, 0xf0, 0xbd, 0xff, 0x64, 0xea, 0x57, 0x61, 0x9e,
+	0x99, 0xb7, 0x5f, 0x73, 0xf2, 0xcc, 0x43, 0x33, 0x58, 0x26, 0x81, 0x88, 0xb9, 0xc2, 0x85, 0xe7,
+	0xf2, 0x55, 0x4a, 0xb8, 0xb6, 0xf9, 0xe0, 0xe0, 0x7a, 0x61, 0x81, 0xdb, 0x85, 0x05, 0xfe, 0x5a,
+	0x58, 0xe0, 0xfb, 0x7b, 0x2b, 0x77, 0x7b, 0x6f, 0xe5, 0xfe, 0xb8, 0xb7, 0x72, 0x5f, 0xef, 0xfc,
+	0x2b, 0x57, 0xfa, 0x01, 0xd4, 0x94, 0xa3, 0xb2, 0xfe, 0xbe, 0xbf, 0xf7, 0xf7, 0x00, 0x0f, 0x1d,
+	0xdf, 0xfe, 0x93, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -585,6 +645,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TrackTransferVolume {
+		i--
+		if m.TrackTransferVolume {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -907,6 +977,57 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Hold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -933,6 +1054,9 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.TrackTransferVolume {
+		n += 2
+	}
 	return n
 }
 
@@ -1070,6 +1194,29 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *Hold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1159,6 +1306,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackTransferVolume", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackTransferVolume = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2039,6 +2206,154 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Hold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
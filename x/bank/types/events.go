@@ -8,8 +8,9 @@ import (
 const (
 	EventTypeTransfer = "transfer"
 
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySender    = sdk.AttributeKeySender
+	AttributeKeyRecipient   = "recipient"
+	AttributeKeySender      = sdk.AttributeKeySender
+	AttributeKeyOutputIndex = "output_index"
 
 	// supply and balance tracking events name and attributes
 	EventTypeCoinSpent    = "coin_spent"
@@ -21,8 +22,24 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// hold events name and attributes
+	EventTypeHold        = "hold"
+	EventTypeReleaseHold = "release_hold"
+
+	AttributeKeyHolder = "holder"
+	AttributeKeyHoldID = "hold_id"
 )
 
+// InputOutputCoinsEventSchema describes the events emitted by InputOutputCoins,
+// as by MsgMultiSend, in the order they are emitted. Once the message is
+// executed, every event also carries the msg_index attribute of the message.
+const InputOutputCoinsEventSchema = `coin_spent    spender=<input address> amount=<input coins>
+message       sender=<input address>
+for each output, in the order of the outputs:
+coin_received receiver=<output address> amount=<output coins>
+transfer      recipient=<output address> sender=<input address> amount=<output coins> output_index=<index of the output>`
+
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
 func NewCoinSpentEvent(spender sdk.AccAddress, amount sdk.Coins) sdk.Event {
 	return sdk.NewEvent(
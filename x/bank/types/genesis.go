@@ -62,6 +62,36 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenHolds := make(map[string]bool)
+	heldCoins := make(map[string]sdk.Coins)
+	for _, hold := range gs.Holds {
+		key := hold.Address + "/" + hold.Id
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold %s for address %s", hold.Id, hold.Address)
+		}
+
+		if err := hold.Validate(); err != nil {
+			return err
+		}
+
+		seenHolds[key] = true
+		heldCoins[hold.Address] = heldCoins[hold.Address].Add(hold.Amount...)
+	}
+
+	for _, balance := range gs.Balances {
+		if held, ok := heldCoins[balance.Address]; ok && !balance.Coins.IsAllGTE(held) {
+			return fmt.Errorf("held coins %s of address %s exceed its balance %s", held, balance.Address, balance.Coins)
+		}
+		delete(heldCoins, balance.Address)
+	}
+
+	// holds of addresses without balance
+	for _, hold := range gs.Holds {
+		if held, ok := heldCoins[hold.Address]; ok {
+			return fmt.Errorf("held coins %s of address %s exceed its balance", held, hold.Address)
+		}
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -142,6 +142,78 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid holds",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 10)},
+					},
+				},
+				Holds: []Hold{
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order-1", sdk.Coins{sdk.NewInt64Coin("uatom", 4)}},
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order-2", sdk.Coins{sdk.NewInt64Coin("uatom", 6)}},
+				},
+			},
+			false,
+		},
+		{
+			"dup holds",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 10)},
+					},
+				},
+				Holds: []Hold{
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order-1", sdk.Coins{sdk.NewInt64Coin("uatom", 4)}},
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order-1", sdk.Coins{sdk.NewInt64Coin("uatom", 4)}},
+				},
+			},
+			true,
+		},
+		{
+			"invalid hold",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 10)},
+					},
+				},
+				Holds: []Hold{
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "", sdk.Coins{sdk.NewInt64Coin("uatom", 4)}},
+				},
+			},
+			true,
+		},
+		{
+			"holds exceeding balance",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 10)},
+					},
+				},
+				Holds: []Hold{
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order-1", sdk.Coins{sdk.NewInt64Coin("uatom", 4)}},
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order-2", sdk.Coins{sdk.NewInt64Coin("uatom", 7)}},
+				},
+			},
+			true,
+		},
+		{
+			"holds without balance",
+			GenesisState{
+				Holds: []Hold{
+					{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "order-1", sdk.Coins{sdk.NewInt64Coin("uatom", 4)}},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
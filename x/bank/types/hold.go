@@ -0,0 +1,43 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHold creates a new Hold instance.
+func NewHold(addr sdk.AccAddress, id string, amount sdk.Coins) Hold {
+	return Hold{
+		Address: addr.String(),
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+// GetAddress returns the account address of the Hold.
+func (h Hold) GetAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(h.Address)
+}
+
+// Validate checks that the address is valid, the id is not empty and the
+// amount is valid and positive.
+func (h Hold) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+		return err
+	}
+
+	if h.Id == "" {
+		return errors.New("hold id cannot be empty")
+	}
+
+	if err := h.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if h.Amount.Empty() {
+		return errors.New("hold amount cannot be empty")
+	}
+
+	return nil
+}
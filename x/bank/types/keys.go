@@ -16,6 +16,16 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_" + ModuleName
+
+	// DenomIndexStoreKey defines the derived store key of the index of the
+	// balances by denom
+	DenomIndexStoreKey = "denom_index_" + ModuleName
+
+	// TransferVolumeWindow is the number of blocks the transfer volume is kept for.
+	TransferVolumeWindow = 1000
 )
 
 // KVStore keys
@@ -31,6 +41,26 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// TransferVolumePrefix is the prefix for the transfer volume per slot of the
+	// transfer volume window and denom.
+	TransferVolumePrefix = collections.NewPrefix(6)
+	// TransferVolumeHeightPrefix is the prefix for the height a slot of the
+	// transfer volume window was last written at.
+	TransferVolumeHeightPrefix = collections.NewPrefix(7)
+
+	// HoldsPrefix is the prefix for the holds of an account by hold id.
+	HoldsPrefix = collections.NewPrefix(8)
+	// HeldBalancesPrefix is the prefix for the total amount of a denom placed
+	// on hold for an account.
+	HeldBalancesPrefix = collections.NewPrefix(9)
+)
+
+// Transient store keys
+var (
+	// BlockTransferVolumePrefix is the prefix for the transfer volume per denom
+	// of the current block.
+	BlockTransferVolumePrefix = collections.NewPrefix(0)
 )
 
 // NewBalanceCompatValueCodec is a codec for encoding Balances in a backwards compatible way
@@ -40,6 +40,10 @@ func (m Metadata) Validate() error {
 	seenUnits := make(map[string]bool)
 
 	for i, denomUnit := range m.DenomUnits {
+		if denomUnit == nil {
+			return fmt.Errorf("metadata's denomination unit %d is nil", i)
+		}
+
 		// The first denomination unit MUST be the base
 		if i == 0 {
 			// validate denomination and exponent
@@ -213,6 +213,21 @@ func TestMetadataValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"nil denom unit",
+			types.Metadata{
+				Name:        "Cosmos Hub Atom",
+				Symbol:      "ATOM",
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					nil,
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,38 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CircuitBreaker is an interface that defines the methods for a circuit breaker.
+type CircuitBreaker interface {
+	// CheckExecution returns an error when a Msg of the given type URL may not
+	// be executed. Executions are counted against any per-block limit when the
+	// Msg's are dispatched, not by the ante handler.
+	CheckExecution(ctx sdk.Context, typeURL string) error
+}
+
+// CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitBreaker
+}
+
+// NewCircuitBreakerDecorator returns a new CircuitBreakerDecorator.
+func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+// AnteHandle rejects the transaction if any of its messages is disabled or has
+// exhausted its per-block execution limit.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// loop through all the messages and check if the message type is allowed
+	for _, msg := range tx.GetMsgs() {
+		if err := cbd.circuitKeeper.CheckExecution(ctx, sdk.MsgTypeURL(msg)); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
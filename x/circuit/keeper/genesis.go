@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, accounts := range genState.AccountPermissions {
+		add, err := k.addressCodec.StringToBytes(accounts.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		// Set the permissions for the account
+		if err := k.SetPermissions(ctx, add, accounts.Permissions); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, url := range genState.DisabledTypeUrls {
+		k.DisableMsg(ctx, url)
+	}
+
+	for _, m := range genState.RateLimitedMsgs {
+		k.LimitMsg(ctx, m.MsgTypeUrl, m.LimitPerBlock)
+	}
+
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, c := range genState.TripCounters {
+		add, err := k.addressCodec.StringToBytes(c.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetTripCounter(ctx, add, c.Counter); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, route := range genState.DisabledQueryRoutes {
+		k.DisableQueryRoute(ctx, route)
+	}
+	k.RefreshDisabledQueryRoutes(ctx)
+}
+
+// ExportGenesis exports the circuit module's state to a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var (
+		permissions     []*types.GenesisAccountPermissions
+		disabledMsgs    []string
+		rateLimitedMsgs []*types.DisabledMsg
+		tripCounters    []types.GenesisTripCounter
+		queryRoutes     []string
+	)
+
+	k.IteratePermissions(ctx, func(address []byte, perm types.Permissions) bool {
+		add, err := k.addressCodec.BytesToString(address)
+		if err != nil {
+			panic(err)
+		}
+
+		permissions = append(permissions, &types.GenesisAccountPermissions{
+			Address:     add,
+			Permissions: &perm,
+		})
+		return false
+	})
+
+	k.IterateDisableList(ctx, func(msgURL string, limit uint64) bool {
+		if limit == 0 {
+			disabledMsgs = append(disabledMsgs, msgURL)
+		} else {
+			rateLimitedMsgs = append(rateLimitedMsgs, &types.DisabledMsg{MsgTypeUrl: msgURL, LimitPerBlock: limit})
+		}
+		return false
+	})
+
+	k.IterateTripCounters(ctx, func(address []byte, counter types.TripCounter) bool {
+		add, err := k.addressCodec.BytesToString(address)
+		if err != nil {
+			panic(err)
+		}
+
+		tripCounters = append(tripCounters, types.GenesisTripCounter{Address: add, Counter: counter})
+		return false
+	})
+
+	k.IterateDisabledQueryRoutes(ctx, func(route string) bool {
+		queryRoutes = append(queryRoutes, route)
+		return false
+	})
+
+	return types.NewGenesisState(permissions, disabledMsgs, rateLimitedMsgs, k.GetParams(ctx), tripCounters, queryRoutes)
+}
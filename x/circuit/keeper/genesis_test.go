@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// TestGenesisConformance checks, like the genesis conformance kit of the SDK
+// (testutil/conformance), that the default genesis and random valid genesis
+// states are exported unchanged, modulo the order of the entries which are
+// exported in store order, and that exporting a second time after initializing
+// a new state with the exported genesis yields the same genesis.
+func TestGenesisConformance(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	t.Run("default genesis", func(t *testing.T) {
+		genesis := types.DefaultGenesisState()
+		require.NoError(t, genesis.Validate())
+		checkGenesisRoundTrip(t, cdc, genesis)
+	})
+
+	t.Run("fuzzed genesis", func(t *testing.T) {
+		r := rand.New(rand.NewSource(0))
+		for run := 0; run < 10; run++ {
+			genesis := randomGenesis(r)
+			require.NoError(t, genesis.Validate(), "run %d", run)
+			checkGenesisRoundTrip(t, cdc, genesis)
+		}
+	})
+}
+
+// checkGenesisRoundTrip runs init, export, init, export on new states, and
+// compares the exported genesis states to the normalized initial one.
+func checkGenesisRoundTrip(t *testing.T, cdc codec.Codec, genesis *types.GenesisState) {
+	t.Helper()
+
+	exportGenesis := func(genesis *types.GenesisState) *types.GenesisState {
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+		ctx := testutil.DefaultContextWithDB(t, key, tkey).Ctx
+		k := keeper.NewKeeper(cdc, key, tkey, authtypes.NewModuleAddress(govtypes.ModuleName).String(), addresscodec.NewBech32Codec("cosmos"))
+
+		require.NotPanics(t, func() {
+			k.InitGenesis(ctx, genesis)
+		}, "init genesis panicked on %s", cdc.MustMarshalJSON(genesis))
+
+		return k.ExportGenesis(ctx)
+	}
+
+	exported := exportGenesis(genesis)
+	reexported := exportGenesis(exported)
+
+	require.JSONEq(t, string(normalizeGenesis(t, cdc, genesis)), string(cdc.MustMarshalJSON(exported)))
+	require.JSONEq(t, string(cdc.MustMarshalJSON(exported)), string(cdc.MustMarshalJSON(reexported)))
+}
+
+// normalizeGenesis returns the JSON encoding of a copy of genesis with its
+// entries sorted by store key, which is the order they are exported in.
+func normalizeGenesis(t *testing.T, cdc codec.Codec, genesis *types.GenesisState) json.RawMessage {
+	t.Helper()
+
+	var normalized types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(cdc.MustMarshalJSON(genesis), &normalized))
+
+	addrBytes := func(addr string) []byte {
+		return sdk.MustAccAddressFromBech32(addr)
+	}
+	sort.Slice(normalized.AccountPermissions, func(i, j int) bool {
+		return bytes.Compare(addrBytes(normalized.AccountPermissions[i].Address), addrBytes(normalized.AccountPermissions[j].Address)) < 0
+	})
+	sort.Strings(normalized.DisabledTypeUrls)
+	sort.Slice(normalized.RateLimitedMsgs, func(i, j int) bool {
+		return normalized.RateLimitedMsgs[i].MsgTypeUrl < normalized.RateLimitedMsgs[j].MsgTypeUrl
+	})
+	sort.Slice(normalized.TripCounters, func(i, j int) bool {
+		return bytes.Compare(addrBytes(normalized.TripCounters[i].Address), addrBytes(normalized.TripCounters[j].Address)) < 0
+	})
+	sort.Strings(normalized.DisabledQueryRoutes)
+
+	return cdc.MustMarshalJSON(&normalized)
+}
+
+// randomGenesis returns a random valid genesis state. Trip counters are never
+// empty, as empty ones are not stored.
+func randomGenesis(r *rand.Rand) *types.GenesisState {
+	randAddr := func() string {
+		bz := make([]byte, 20)
+		r.Read(bz)
+		return sdk.AccAddress(bz).String()
+	}
+
+	// type URLs are unique across the disabled and rate limited msgs
+	typeURLs := r.Perm(20)
+
+	genesis := &types.GenesisState{
+		Params: types.NewParams(uint64(r.Intn(10)), 1+uint64(r.Intn(100))),
+	}
+
+	for i := r.Intn(5); i > 0; i-- {
+		perms := &types.Permissions{Level: types.Permissions_Level(r.Intn(len(types.Permissions_Level_name)))}
+		if perms.Level == types.Permissions_LEVEL_SOME_MSGS {
+			for j := 1 + r.Intn(3); j > 0; j-- {
+				perms.LimitTypeUrls = append(perms.LimitTypeUrls, fmt.Sprintf("/test.v1.Msg%d", r.Intn(100)))
+			}
+		}
+		genesis.AccountPermissions = append(genesis.AccountPermissions, &types.GenesisAccountPermissions{
+			Address:     randAddr(),
+			Permissions: perms,
+		})
+	}
+
+	for i := r.Intn(5); i > 0; i-- {
+		genesis.DisabledTypeUrls = append(genesis.DisabledTypeUrls, fmt.Sprintf("/test.v1.Msg%d", typeURLs[0]))
+		typeURLs = typeURLs[1:]
+	}
+
+	for i := r.Intn(5); i > 0; i-- {
+		genesis.RateLimitedMsgs = append(genesis.RateLimitedMsgs, &types.DisabledMsg{
+			MsgTypeUrl:    fmt.Sprintf("/test.v1.Msg%d", typeURLs[0]),
+			LimitPerBlock: 1 + uint64(r.Intn(100)),
+		})
+		typeURLs = typeURLs[1:]
+	}
+
+	for i := r.Intn(5); i > 0; i-- {
+		var counter types.TripCounter
+		height := int64(0)
+		for j := 1 + r.Intn(3); j > 0; j-- {
+			height += int64(r.Intn(100))
+			counter.TripHeights = append(counter.TripHeights, height)
+		}
+		genesis.TripCounters = append(genesis.TripCounters, types.GenesisTripCounter{Address: randAddr(), Counter: counter})
+	}
+
+	for _, i := range r.Perm(10)[:r.Intn(5)] {
+		genesis.DisabledQueryRoutes = append(genesis.DisabledQueryRoutes, fmt.Sprintf("/test.v1.Query/Method%d", i))
+	}
+
+	return genesis
+}
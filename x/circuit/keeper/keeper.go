@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper defines the circuit module's keeper.
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+
+	// authority is the module authority (usually the x/gov module account), which
+	// holds every permission without needing an entry in the permissions store.
+	authority []byte
+
+	addressCodec address.Codec
+
+	// queryRoutes is the snapshot of the disabled query routes, shared by the
+	// copies of the keeper.
+	queryRoutes *disabledQueryRoutes
+}
+
+// NewKeeper constructs a new Circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey, tStoreKey storetypes.StoreKey, authority string, addressCodec address.Codec) Keeper {
+	auth, err := addressCodec.StringToBytes(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		tStoreKey:    tStoreKey,
+		authority:    auth,
+		addressCodec: addressCodec,
+		queryRoutes:  &disabledQueryRoutes{},
+	}
+}
+
+// GetAuthority returns the module authority.
+func (k Keeper) GetAuthority() []byte {
+	return k.authority
+}
+
+// GetPermissions returns the circuit breaker permissions of an account, or nil
+// if the account has none.
+func (k Keeper) GetPermissions(ctx sdk.Context, address []byte) (*types.Permissions, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.CreateAddressPrefix(address))
+	if bz == nil {
+		return nil, nil
+	}
+
+	perms := &types.Permissions{}
+	if err := k.cdc.Unmarshal(bz, perms); err != nil {
+		return nil, err
+	}
+
+	return perms, nil
+}
+
+// SetPermissions sets the circuit breaker permissions of an account.
+func (k Keeper) SetPermissions(ctx sdk.Context, address []byte, perms *types.Permissions) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.Marshal(perms)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.CreateAddressPrefix(address), bz)
+	return nil
+}
+
+// GetParams returns the parameters of the circuit module.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the circuit module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetTripCounter returns the recent circuit breaker trips of an account.
+func (k Keeper) GetTripCounter(ctx sdk.Context, address []byte) (types.TripCounter, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	var counter types.TripCounter
+	bz := store.Get(types.CreateTripCounterPrefix(address))
+	if bz == nil {
+		return counter, nil
+	}
+
+	err := k.cdc.Unmarshal(bz, &counter)
+	return counter, err
+}
+
+// SetTripCounter sets the recent circuit breaker trips of an account. An empty
+// counter is removed from the store.
+func (k Keeper) SetTripCounter(ctx sdk.Context, address []byte, counter types.TripCounter) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if len(counter.TripHeights) == 0 {
+		store.Delete(types.CreateTripCounterPrefix(address))
+		return nil
+	}
+
+	bz, err := k.cdc.Marshal(&counter)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.CreateTripCounterPrefix(address), bz)
+	return nil
+}
+
+// RecordTrip counts a circuit breaker trip of an account against the trip rate
+// limit. It returns an error, and emits a circuit_rate_limited event, when the
+// account already made the maximum number of trips within the trip window.
+func (k Keeper) RecordTrip(ctx sdk.Context, address []byte) error {
+	params := k.GetParams(ctx)
+	if params.MaxTripsPerWindow == 0 {
+		return nil
+	}
+
+	counter, err := k.GetTripCounter(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	// drop the trips which are out of the window
+	height := ctx.BlockHeight()
+	heights := counter.TripHeights
+	for len(heights) > 0 && heights[0] <= height-int64(params.TripWindow) {
+		heights = heights[1:]
+	}
+
+	if uint64(len(heights)) >= params.MaxTripsPerWindow {
+		account, err := k.addressCodec.BytesToString(address)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCircuitRateLimited,
+				sdk.NewAttribute(types.AttributeKeyAuthority, account),
+				sdk.NewAttribute(types.AttributeKeyTrips, strconv.Itoa(len(heights))),
+				sdk.NewAttribute(types.AttributeKeyTripWindow, strconv.FormatUint(params.TripWindow, 10)),
+			),
+		)
+
+		return errorsmod.Wrapf(types.ErrTripRateLimited, "account %s is limited to %d trips per %d blocks", account, params.MaxTripsPerWindow, params.TripWindow)
+	}
+
+	counter.TripHeights = append(heights, height)
+	return k.SetTripCounter(ctx, address, counter)
+}
+
+// IsAllowed returns true when processing of the given Msg type URL is allowed,
+// i.e. the Msg is not disabled or, when rate limited, its per-block execution
+// limit has not been reached yet. It does not count an execution.
+func (k Keeper) IsAllowed(ctx sdk.Context, msgURL string) bool {
+	return k.CheckExecution(ctx, msgURL) == nil
+}
+
+// CheckExecution returns an error when the given Msg type URL may not be
+// executed, without counting an execution against the per-block limit. It is
+// used by the ante handler to reject txs before their Msg's are dispatched.
+func (k Keeper) CheckExecution(ctx sdk.Context, msgURL string) error {
+	_, _, err := k.checkExecution(ctx, msgURL)
+	return err
+}
+
+// AllowExecution returns an error when the given Msg type URL may not be
+// executed. For rate limited Msg's, an allowed execution is counted against
+// the per-block limit. It implements the baseapp.CircuitBreaker interface,
+// called once for every dispatched Msg, including the ones nested in other
+// Msg's such as authz MsgExec or executed by gov and group proposals.
+func (k Keeper) AllowExecution(ctx sdk.Context, msgURL string) error {
+	limited, count, err := k.checkExecution(ctx, msgURL)
+	if err != nil || !limited {
+		return err
+	}
+
+	k.setExecutionCount(ctx, msgURL, count+1)
+	return nil
+}
+
+// checkExecution returns an error when the given Msg type URL may not be
+// executed and, for rate limited Msg's, their execution count in the block.
+func (k Keeper) checkExecution(ctx sdk.Context, msgURL string) (limited bool, count uint64, err error) {
+	limit, disabled := k.GetDisabledMsg(ctx, msgURL)
+	if !disabled {
+		return false, 0, nil
+	}
+
+	if limit == 0 {
+		return false, 0, errorsmod.Wrapf(types.ErrMsgDisabled, "tx type %s not allowed", msgURL)
+	}
+
+	count = k.getExecutionCount(ctx, msgURL)
+	if count >= limit {
+		return false, 0, errorsmod.Wrapf(types.ErrMsgLimitExhausted, "tx type %s is limited to %d executions per block", msgURL, limit)
+	}
+
+	return true, count, nil
+}
+
+// GetDisabledMsg returns whether the Msg type URL is in the disable list and
+// its per-block execution limit (0 when processing is fully disabled).
+func (k Keeper) GetDisabledMsg(ctx sdk.Context, msgURL string) (limit uint64, disabled bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.CreateDisableMsgPrefix(msgURL))
+	if bz == nil {
+		return 0, false
+	}
+
+	return decodeLimit(bz), true
+}
+
+// DisableMsg adds the Msg type URL to the disable list, fully disabling its
+// processing.
+func (k Keeper) DisableMsg(ctx sdk.Context, msgURL string) {
+	k.LimitMsg(ctx, msgURL, 0)
+}
+
+// LimitMsg adds the Msg type URL to the disable list, allowing at most limit
+// executions of it per block. A zero limit fully disables its processing.
+func (k Keeper) LimitMsg(ctx sdk.Context, msgURL string, limit uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateDisableMsgPrefix(msgURL), encodeLimit(limit))
+}
+
+// EnableMsg removes the Msg type URL from the disable list, clearing any
+// per-block execution limit.
+func (k Keeper) EnableMsg(ctx sdk.Context, msgURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateDisableMsgPrefix(msgURL))
+}
+
+// IteratePermissions iterates over all accounts with circuit breaker
+// permissions, stopping when the callback returns true.
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(address []byte, perms types.Permissions) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := storetypes.KVStorePrefixIterator(store, types.AccountPermissionPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var perms types.Permissions
+		k.cdc.MustUnmarshal(iter.Value(), &perms)
+
+		if cb(iter.Key()[len(types.AccountPermissionPrefix):], perms) {
+			break
+		}
+	}
+}
+
+// IterateDisableList iterates over all disabled Msg type URLs along with their
+// per-block execution limit, stopping when the callback returns true.
+func (k Keeper) IterateDisableList(ctx sdk.Context, cb func(msgURL string, limit uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := storetypes.KVStorePrefixIterator(store, types.DisableListPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(iter.Key()[len(types.DisableListPrefix):]), decodeLimit(iter.Value())) {
+			break
+		}
+	}
+}
+
+// IterateTripCounters iterates over the trip counters of all accounts, stopping
+// when the callback returns true.
+func (k Keeper) IterateTripCounters(ctx sdk.Context, cb func(address []byte, counter types.TripCounter) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := storetypes.KVStorePrefixIterator(store, types.TripCounterPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var counter types.TripCounter
+		k.cdc.MustUnmarshal(iter.Value(), &counter)
+
+		if cb(iter.Key()[len(types.TripCounterPrefix):], counter) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getExecutionCount(ctx sdk.Context, msgURL string) uint64 {
+	store := ctx.TransientStore(k.tStoreKey)
+
+	bz := store.Get(types.CreateExecutionCountPrefix(msgURL))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setExecutionCount(ctx sdk.Context, msgURL string, count uint64) {
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set(types.CreateExecutionCountPrefix(msgURL), sdk.Uint64ToBigEndian(count))
+}
+
+// encodeLimit encodes a per-block execution limit as stored in the disable
+// list. Fully disabled Msg's keep the empty value used before rate limiting.
+func encodeLimit(limit uint64) []byte {
+	if limit == 0 {
+		return []byte{}
+	}
+
+	return sdk.Uint64ToBigEndian(limit)
+}
+
+func decodeLimit(bz []byte) uint64 {
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,400 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	msgSendURL        = "/cosmos.bank.v1beta1.MsgSend"
+	balanceQueryRoute = "/cosmos.bank.v1beta1.Query/Balance"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	testCtx   testutil.TestContext
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	authority string
+	addrs     []sdk.AccAddress
+	encCfg    moduletestutil.TestEncodingConfig
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	s.testCtx = testutil.DefaultContextWithDB(s.T(), key, tkey)
+	s.ctx = s.testCtx.Ctx
+
+	s.encCfg = moduletestutil.MakeTestEncodingConfig()
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.keeper = keeper.NewKeeper(s.encCfg.Codec, key, tkey, s.authority, ac)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.addrs = []sdk.AccAddress{
+		sdk.AccAddress("addr1_______________"),
+		sdk.AccAddress("addr2_______________"),
+	}
+}
+
+// nextBlock commits the current state and returns a context for the next
+// block, with a fresh transient store.
+func (s *KeeperTestSuite) nextBlock() {
+	s.testCtx.CMS.Commit()
+	s.ctx = sdk.NewContext(s.testCtx.CMS, cmtproto.Header{Height: s.ctx.BlockHeight() + 1}, false, log.NewNopLogger())
+}
+
+func (s *KeeperTestSuite) TestAuthorizeCircuitBreaker() {
+	perms := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}}
+
+	// an account without permissions cannot authorize others
+	_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     s.addrs[0].String(),
+		Grantee:     s.addrs[1].String(),
+		Permissions: perms,
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     s.authority,
+		Grantee:     s.addrs[0].String(),
+		Permissions: perms,
+	})
+	s.Require().NoError(err)
+
+	got, err := s.keeper.GetPermissions(s.ctx, s.addrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(perms, got)
+
+	// LEVEL_SOME_MSGS permissions need at least one msg type url
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     s.authority,
+		Grantee:     s.addrs[1].String(),
+		Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS},
+	})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     s.authority,
+		Grantee:     s.addrs[0].String(),
+		Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}},
+	})
+	s.Require().NoError(err)
+
+	// the grantee cannot trip messages outside of its permissions
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:   s.addrs[0].String(),
+		MsgTypeUrls: []string{"/cosmos.staking.v1beta1.MsgDelegate"},
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:   s.addrs[0].String(),
+		MsgTypeUrls: []string{msgSendURL},
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.keeper.IsAllowed(s.ctx, msgSendURL))
+	s.Require().ErrorIs(s.keeper.AllowExecution(s.ctx, msgSendURL), types.ErrMsgDisabled)
+
+	// tripping twice is an error
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:   s.addrs[0].String(),
+		MsgTypeUrls: []string{msgSendURL},
+	})
+	s.Require().ErrorIs(err, types.ErrMsgDisabled)
+
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+		Authority:   s.addrs[0].String(),
+		MsgTypeUrls: []string{msgSendURL},
+	})
+	s.Require().NoError(err)
+	s.Require().True(s.keeper.IsAllowed(s.ctx, msgSendURL))
+
+	// resetting an enabled message is an error
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+		Authority:   s.addrs[0].String(),
+		MsgTypeUrls: []string{msgSendURL},
+	})
+	s.Require().ErrorIs(err, types.ErrMsgEnabled)
+}
+
+func (s *KeeperTestSuite) TestRateLimitedMsg() {
+	const limit = 3
+
+	_, err := s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:     s.authority,
+		MsgTypeUrls:   []string{msgSendURL},
+		LimitPerBlock: limit,
+	})
+	s.Require().NoError(err)
+
+	gotLimit, disabled := s.keeper.GetDisabledMsg(s.ctx, msgSendURL)
+	s.Require().True(disabled)
+	s.Require().Equal(uint64(limit), gotLimit)
+
+	// checking an execution does not count it
+	s.Require().NoError(s.keeper.CheckExecution(s.ctx, msgSendURL))
+	s.Require().NoError(s.keeper.CheckExecution(s.ctx, msgSendURL))
+
+	for i := 0; i < limit; i++ {
+		s.Require().True(s.keeper.IsAllowed(s.ctx, msgSendURL))
+		s.Require().NoError(s.keeper.AllowExecution(s.ctx, msgSendURL))
+	}
+	s.Require().False(s.keeper.IsAllowed(s.ctx, msgSendURL))
+	s.Require().ErrorIs(s.keeper.CheckExecution(s.ctx, msgSendURL), types.ErrMsgLimitExhausted)
+	s.Require().ErrorIs(s.keeper.AllowExecution(s.ctx, msgSendURL), types.ErrMsgLimitExhausted)
+
+	// the execution count is reset at the next block
+	s.nextBlock()
+	s.Require().NoError(s.keeper.AllowExecution(s.ctx, msgSendURL))
+
+	res, err := keeper.NewQueryServer(s.keeper).DisabledList(s.ctx, &types.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{msgSendURL}, res.DisabledList)
+	s.Require().Equal([]*types.DisabledMsg{{MsgTypeUrl: msgSendURL, LimitPerBlock: limit}}, res.DisabledMsgs)
+
+	// resetting clears the limit
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+		Authority:   s.authority,
+		MsgTypeUrls: []string{msgSendURL},
+	})
+	s.Require().NoError(err)
+	_, disabled = s.keeper.GetDisabledMsg(s.ctx, msgSendURL)
+	s.Require().False(disabled)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := &types.GenesisState{
+		AccountPermissions: []*types.GenesisAccountPermissions{
+			{
+				Address:     s.addrs[0].String(),
+				Permissions: &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS},
+			},
+		},
+		DisabledTypeUrls: []string{"/cosmos.staking.v1beta1.MsgDelegate"},
+		RateLimitedMsgs:  []*types.DisabledMsg{{MsgTypeUrl: msgSendURL, LimitPerBlock: 5}},
+		Params:           types.DefaultParams(),
+		TripCounters: []types.GenesisTripCounter{
+			{Address: s.addrs[0].String(), Counter: types.TripCounter{TripHeights: []int64{3, 7}}},
+		},
+		DisabledQueryRoutes: []string{balanceQueryRoute},
+	}
+	s.Require().NoError(gs.Validate())
+
+	s.keeper.InitGenesis(s.ctx, gs)
+	s.Require().Equal(gs, s.keeper.ExportGenesis(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestTripRateLimit() {
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams(2, 10)))
+
+	_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     s.authority,
+		Grantee:     s.addrs[0].String(),
+		Permissions: &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS},
+	})
+	s.Require().NoError(err)
+
+	trip := func(authority string) error {
+		_, err := s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+			Authority:   authority,
+			MsgTypeUrls: []string{msgSendURL},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = s.msgServer.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+			Authority:   authority,
+			MsgTypeUrls: []string{msgSendURL},
+		})
+		return err
+	}
+
+	s.ctx = s.ctx.WithBlockHeight(1)
+	s.Require().NoError(trip(s.addrs[0].String()))
+	s.ctx = s.ctx.WithBlockHeight(5)
+	s.Require().NoError(trip(s.addrs[0].String()))
+
+	s.ctx = s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	s.Require().ErrorIs(trip(s.addrs[0].String()), types.ErrTripRateLimited)
+	s.Require().Len(s.ctx.EventManager().Events(), 1)
+	s.Require().Equal(types.EventTypeCircuitRateLimited, s.ctx.EventManager().Events()[0].Type)
+
+	// the module authority is exempt
+	s.Require().NoError(trip(s.authority))
+
+	// the trip at height 1 is out of the window at height 11
+	s.ctx = s.ctx.WithBlockHeight(11)
+	s.Require().NoError(trip(s.addrs[0].String()))
+
+	counter, err := s.keeper.GetTripCounter(s.ctx, s.addrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{5, 11}, counter.TripHeights)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(5, 100)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, types.NewMsgUpdateParams(s.addrs[0].String(), params))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, types.NewMsgUpdateParams(s.authority, types.NewParams(5, 0)))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, types.NewMsgUpdateParams(s.authority, params))
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestDisableQueryRoutes() {
+	_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     s.authority,
+		Grantee:     s.addrs[0].String(),
+		Permissions: &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS},
+	})
+	s.Require().NoError(err)
+
+	// only super admins can disable query routes
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:   s.addrs[0].String(),
+		QueryRoutes: []string{balanceQueryRoute},
+	})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:   s.authority,
+		QueryRoutes: []string{"cosmos.bank.v1beta1.Query"},
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:   s.authority,
+		QueryRoutes: []string{balanceQueryRoute},
+	})
+	s.Require().NoError(err)
+	s.Require().True(s.keeper.IsQueryRouteDisabled(s.ctx, balanceQueryRoute))
+
+	interceptor := s.keeper.UnaryServerInterceptor()
+	query := func(route string) error {
+		handler := func(context.Context, interface{}) (interface{}, error) { return nil, nil }
+		_, err := interceptor(s.ctx, nil, &grpc.UnaryServerInfo{FullMethod: route}, handler)
+		return err
+	}
+
+	// the route is served until the snapshot is refreshed at the end of the block
+	s.Require().NoError(query(balanceQueryRoute))
+	s.keeper.RefreshDisabledQueryRoutes(s.ctx)
+	s.Require().Equal(codes.Unavailable, status.Code(query(balanceQueryRoute)))
+	s.Require().NoError(query("/cosmos.bank.v1beta1.Query/AllBalances"))
+
+	// msg handling is unaffected
+	s.Require().True(s.keeper.IsAllowed(s.ctx, msgSendURL))
+	s.Require().NoError(s.keeper.AllowExecution(s.ctx, msgSendURL))
+
+	res, err := keeper.NewQueryServer(s.keeper).DisabledList(s.ctx, &types.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.DisabledList)
+	s.Require().Equal([]string{balanceQueryRoute}, res.DisabledQueryRoutes)
+
+	// resetting the query route does not reset the disabled msgs
+	s.keeper.DisableMsg(s.ctx, msgSendURL)
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+		Authority:   s.authority,
+		QueryRoutes: []string{balanceQueryRoute},
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.keeper.IsAllowed(s.ctx, msgSendURL))
+
+	s.keeper.RefreshDisabledQueryRoutes(s.ctx)
+	s.Require().NoError(query(balanceQueryRoute))
+}
+
+func (s *KeeperTestSuite) TestCheckMsgs() {
+	const (
+		msgMultiSendURL = "/cosmos.bank.v1beta1.MsgMultiSend"
+		msgExecURL      = "/cosmos.authz.v1beta1.MsgExec"
+	)
+
+	queryServer := keeper.NewQueryServer(s.keeper)
+	s.keeper.DisableMsg(s.ctx, msgSendURL)
+	s.keeper.LimitMsg(s.ctx, msgMultiSendURL, 2)
+
+	_, err := queryServer.CheckMsgs(s.ctx, &types.QueryCheckMsgsRequest{})
+	s.Require().ErrorContains(err, "msg type urls or tx bytes must be provided")
+
+	res, err := queryServer.CheckMsgs(s.ctx, &types.QueryCheckMsgsRequest{
+		MsgTypeUrls: []string{msgSendURL, msgMultiSendURL, msgExecURL, msgSendURL},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.MsgStatus{
+		{MsgTypeUrl: msgSendURL, Allowed: false, DisabledEntry: msgSendURL},
+		{MsgTypeUrl: msgMultiSendURL, Allowed: true, DisabledEntry: msgMultiSendURL, LimitPerBlock: 2},
+		{MsgTypeUrl: msgExecURL, Allowed: true},
+	}, res.Statuses)
+
+	// the msgs of the tx are extracted, including the ones nested in authz MsgExec's
+	banktypes.RegisterInterfaces(s.encCfg.InterfaceRegistry)
+	authz.RegisterInterfaces(s.encCfg.InterfaceRegistry)
+
+	send := &banktypes.MsgSend{FromAddress: s.addrs[1].String(), ToAddress: s.addrs[0].String()}
+	multiSend := &banktypes.MsgMultiSend{}
+	innerExec := authz.NewMsgExec(s.addrs[0], []sdk.Msg{send})
+	exec := authz.NewMsgExec(s.addrs[0], []sdk.Msg{&innerExec})
+
+	txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(multiSend, &exec))
+	txBytes, err := s.encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	res, err = queryServer.CheckMsgs(s.ctx, &types.QueryCheckMsgsRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.MsgStatus{
+		{MsgTypeUrl: msgMultiSendURL, Allowed: true, DisabledEntry: msgMultiSendURL, LimitPerBlock: 2},
+		{MsgTypeUrl: msgExecURL, Allowed: true},
+		{MsgTypeUrl: msgSendURL, Allowed: false, DisabledEntry: msgSendURL},
+	}, res.Statuses)
+
+	// the given msg type urls are checked first
+	res, err = queryServer.CheckMsgs(s.ctx, &types.QueryCheckMsgsRequest{
+		MsgTypeUrls: []string{msgSendURL},
+		TxBytes:     txBytes,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Statuses, 3)
+	s.Require().Equal(msgSendURL, res.Statuses[0].MsgTypeUrl)
+
+	_, err = queryServer.CheckMsgs(s.ctx, &types.QueryCheckMsgsRequest{TxBytes: []byte("invalid")})
+	s.Require().ErrorIs(err, sdkerrors.ErrTxDecode)
+}
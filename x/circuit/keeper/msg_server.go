@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AuthorizeCircuitBreaker implements the Msg/AuthorizeCircuitBreaker Msg service.
+func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := srv.addressCodec.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+
+	// only the module authority or a super admin can authorize accounts
+	if !bytes.Equal(granter, srv.GetAuthority()) {
+		perms, err := srv.GetPermissions(ctx, granter)
+		if err != nil {
+			return nil, err
+		}
+
+		if perms == nil || perms.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+			return nil, errorsmod.Wrap(types.ErrUnauthorized, "only super admins can authorize accounts")
+		}
+	}
+
+	grantee, err := srv.addressCodec.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+
+	if msg.Permissions == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "permissions cannot be nil")
+	}
+
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := srv.SetPermissions(ctx, grantee, msg.Permissions); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyPermission, msg.Permissions.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{Success: true}, nil
+}
+
+// TripCircuitBreaker implements the Msg/TripCircuitBreaker Msg service.
+func (srv msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(msg.MsgTypeUrls) == 0 && len(msg.QueryRoutes) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls and query routes cannot both be empty")
+	}
+
+	perms, err := srv.authorityPermissions(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	// the module authority and super admins are not subject to the trip rate
+	// limit
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+		address, err := srv.addressCodec.StringToBytes(msg.Authority)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+		}
+
+		if err := srv.RecordTrip(ctx, address); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if _, disabled := srv.GetDisabledMsg(ctx, msgTypeURL); disabled {
+			return nil, errorsmod.Wrapf(types.ErrMsgDisabled, "message %s is already disabled", msgTypeURL)
+		}
+
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, errorsmod.Wrapf(types.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", msgTypeURL)
+		}
+
+		srv.LimitMsg(ctx, msgTypeURL, msg.LimitPerBlock)
+	}
+
+	for _, route := range msg.QueryRoutes {
+		if err := srv.checkQueryRoutePermission(perms, route); err != nil {
+			return nil, err
+		}
+
+		if srv.IsQueryRouteDisabled(ctx, route) {
+			return nil, errorsmod.Wrapf(types.ErrQueryDisabled, "query route %s is already disabled", route)
+		}
+
+		srv.DisableQueryRoute(ctx, route)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTripCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgURLs, strings.Join(msg.MsgTypeUrls, ",")),
+			sdk.NewAttribute(types.AttributeKeyLimitPerBlock, strconv.FormatUint(msg.LimitPerBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyQueryRoutes, strings.Join(msg.QueryRoutes, ",")),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{Success: true}, nil
+}
+
+// ResetCircuitBreaker implements the Msg/ResetCircuitBreaker Msg service.
+func (srv msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perms, err := srv.authorityPermissions(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := msg.MsgTypeUrls
+	if len(msgTypeURLs) == 0 && len(msg.QueryRoutes) == 0 {
+		// reset all the disabled type URLs the account is authorized to trip
+		srv.IterateDisableList(ctx, func(msgURL string, _ uint64) bool {
+			if perms.CanTrip(msgURL) {
+				msgTypeURLs = append(msgTypeURLs, msgURL)
+			}
+			return false
+		})
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if _, disabled := srv.GetDisabledMsg(ctx, msgTypeURL); !disabled {
+			return nil, errorsmod.Wrapf(types.ErrMsgEnabled, "message %s is not disabled", msgTypeURL)
+		}
+
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, errorsmod.Wrapf(types.ErrUnauthorized, "account does not have permission to reset circuit breaker for message %s", msgTypeURL)
+		}
+
+		srv.EnableMsg(ctx, msgTypeURL)
+	}
+
+	for _, route := range msg.QueryRoutes {
+		if err := srv.checkQueryRoutePermission(perms, route); err != nil {
+			return nil, err
+		}
+
+		if !srv.IsQueryRouteDisabled(ctx, route) {
+			return nil, errorsmod.Wrapf(types.ErrQueryEnabled, "query route %s is not disabled", route)
+		}
+
+		srv.EnableQueryRoute(ctx, route)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgURLs, strings.Join(msgTypeURLs, ",")),
+			sdk.NewAttribute(types.AttributeKeyQueryRoutes, strings.Join(msg.QueryRoutes, ",")),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (srv msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if !bytes.Equal(authority, srv.GetAuthority()) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the module authority can update params")
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := srv.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkQueryRoutePermission returns an error when the query route is invalid
+// or the permissions do not allow disabling query routes, which requires super
+// admin permissions.
+func (srv msgServer) checkQueryRoutePermission(perms *types.Permissions, route string) error {
+	if err := types.ValidateQueryRoute(route); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "only super admins can disable or enable query route %s", route)
+	}
+
+	return nil
+}
+
+// authorityPermissions returns the permissions of the signer of a trip or
+// reset message. The module authority holds super admin permissions.
+func (srv msgServer) authorityPermissions(ctx sdk.Context, authority string) (*types.Permissions, error) {
+	address, err := srv.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if bytes.Equal(address, srv.GetAuthority()) {
+		return &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}, nil
+	}
+
+	perms, err := srv.GetPermissions(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if perms == nil {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "account %s has no circuit breaker permissions", authority)
+	}
+
+	return perms, nil
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+// QueryServer implements the circuit module's gRPC query service.
+type QueryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the circuit QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper Keeper) types.QueryServer {
+	return QueryServer{keeper: keeper}
+}
+
+// Account returns account permissions.
+func (qs QueryServer) Account(c context.Context, req *types.QueryAccountRequest) (*types.AccountResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	add, err := qs.keeper.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	perms, err := qs.keeper.GetPermissions(ctx, add)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountResponse{Permission: perms}, nil
+}
+
+// Accounts returns the permissions of all accounts.
+func (qs QueryServer) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.AccountsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(qs.keeper.storeKey), types.AccountPermissionPrefix)
+
+	var accounts []*types.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		perms := &types.Permissions{}
+		if err := qs.keeper.cdc.Unmarshal(value, perms); err != nil {
+			return err
+		}
+
+		address, err := qs.keeper.addressCodec.BytesToString(key)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, &types.GenesisAccountPermissions{Address: address, Permissions: perms})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the disabled Msg type URLs along with their per-block
+// execution limits.
+func (qs QueryServer) DisabledList(c context.Context, req *types.QueryDisabledListRequest) (*types.DisabledListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.DisabledListResponse{}
+	qs.keeper.IterateDisableList(ctx, func(msgURL string, limit uint64) bool {
+		res.DisabledList = append(res.DisabledList, msgURL)
+		res.DisabledMsgs = append(res.DisabledMsgs, &types.DisabledMsg{MsgTypeUrl: msgURL, LimitPerBlock: limit})
+		return false
+	})
+
+	qs.keeper.IterateDisabledQueryRoutes(ctx, func(route string) bool {
+		res.DisabledQueryRoutes = append(res.DisabledQueryRoutes, route)
+		return false
+	})
+
+	return res, nil
+}
+
+// CheckMsgs returns the circuit breaker statuses of the given Msg type URLs,
+// and of the Msgs of the given tx, including the ones nested in authz MsgExec's.
+func (qs QueryServer) CheckMsgs(c context.Context, req *types.QueryCheckMsgsRequest) (*types.CheckMsgsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	msgURLs := append([]string{}, req.MsgTypeUrls...)
+	if len(req.TxBytes) > 0 {
+		txMsgURLs, err := qs.keeper.txMsgTypeURLs(req.TxBytes)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "invalid tx bytes: %s", err)
+		}
+
+		msgURLs = append(msgURLs, txMsgURLs...)
+	}
+
+	if len(msgURLs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls or tx bytes must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.CheckMsgsResponse{}
+	seen := make(map[string]bool, len(msgURLs))
+	for _, msgURL := range msgURLs {
+		if seen[msgURL] {
+			continue
+		}
+		seen[msgURL] = true
+
+		status := &types.MsgStatus{MsgTypeUrl: msgURL, Allowed: qs.keeper.IsAllowed(ctx, msgURL)}
+		if limit, disabled := qs.keeper.GetDisabledMsg(ctx, msgURL); disabled {
+			status.DisabledEntry = msgURL
+			status.LimitPerBlock = limit
+		}
+
+		res.Statuses = append(res.Statuses, status)
+	}
+
+	return res, nil
+}
+
+// nestedMsgs is implemented by the Msgs executing other Msgs right away, such
+// as the authz MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// txMsgTypeURLs decodes the tx bytes and returns the type URLs of its Msgs,
+// followed by the ones of their nested Msgs.
+func (k Keeper) txMsgTypeURLs(txBytes []byte) ([]string, error) {
+	var raw tx.TxRaw
+	if err := k.cdc.Unmarshal(txBytes, &raw); err != nil {
+		return nil, err
+	}
+
+	var body tx.TxBody
+	if err := k.cdc.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, err
+	}
+
+	msgs, err := tx.GetMsgs(body.Messages, "tx body")
+	if err != nil {
+		return nil, err
+	}
+
+	var msgURLs []string
+	for len(msgs) > 0 {
+		msg := msgs[0]
+		msgs = msgs[1:]
+
+		msgURLs = append(msgURLs, sdk.MsgTypeURL(msg))
+		if nested, ok := msg.(nestedMsgs); ok {
+			inner, err := nested.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+
+			msgs = append(msgs, inner...)
+		}
+	}
+
+	return msgURLs, nil
+}
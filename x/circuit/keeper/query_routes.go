@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// disabledQueryRoutes is a snapshot of the disabled gRPC query routes, read by
+// the gRPC interceptor so that queries do not read the store. It is refreshed
+// every block.
+type disabledQueryRoutes struct {
+	mu     sync.RWMutex
+	routes map[string]struct{}
+}
+
+func (d *disabledQueryRoutes) has(route string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	_, ok := d.routes[route]
+	return ok
+}
+
+func (d *disabledQueryRoutes) set(routes map[string]struct{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.routes = routes
+}
+
+// IsQueryRouteDisabled returns true when the gRPC query route is in the
+// disabled query routes.
+func (k Keeper) IsQueryRouteDisabled(ctx sdk.Context, route string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateDisableQueryRoutePrefix(route))
+}
+
+// DisableQueryRoute adds the gRPC query route to the disabled query routes.
+// The route stops being served once the snapshot read by the interceptor is
+// refreshed, at the end of the block.
+func (k Keeper) DisableQueryRoute(ctx sdk.Context, route string) {
+	ctx.KVStore(k.storeKey).Set(types.CreateDisableQueryRoutePrefix(route), []byte{})
+}
+
+// EnableQueryRoute removes the gRPC query route from the disabled query
+// routes.
+func (k Keeper) EnableQueryRoute(ctx sdk.Context, route string) {
+	ctx.KVStore(k.storeKey).Delete(types.CreateDisableQueryRoutePrefix(route))
+}
+
+// IterateDisabledQueryRoutes iterates over all disabled gRPC query routes,
+// stopping when the callback returns true.
+func (k Keeper) IterateDisabledQueryRoutes(ctx sdk.Context, cb func(route string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := storetypes.KVStorePrefixIterator(store, types.DisabledQueryRoutePrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(iter.Key()[len(types.DisabledQueryRoutePrefix):])) {
+			break
+		}
+	}
+}
+
+// RefreshDisabledQueryRoutes updates the snapshot of the disabled query routes
+// read by the gRPC interceptor from the store.
+func (k Keeper) RefreshDisabledQueryRoutes(ctx sdk.Context) {
+	routes := make(map[string]struct{})
+	k.IterateDisabledQueryRoutes(ctx, func(route string) bool {
+		routes[route] = struct{}{}
+		return false
+	})
+
+	k.queryRoutes.set(routes)
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor rejecting the queries to
+// disabled query routes with codes.Unavailable. It is meant to be installed on
+// the gRPC server of the node.
+func (k Keeper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if k.queryRoutes.has(info.FullMethod) {
+			return nil, status.Errorf(codes.Unavailable, "query route %s is disabled by the circuit breaker", info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
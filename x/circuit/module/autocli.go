@@ -0,0 +1,34 @@
+package module
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	circuitv1 "cosmossdk.io/api/cosmos/circuit/v1"
+
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: circuitv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CheckMsgs",
+					Use:       "check [msg-type-urls...]",
+					Short:     "Query whether Msg type URLs, or the Msgs of an encoded tx, are allowed by the circuit breaker.",
+					Long: "Query whether the given Msg type URLs are allowed by the circuit breaker. " +
+						"Alternatively, the --tx-bytes flag takes an encoded tx whose Msgs are checked, including the ones nested in authz MsgExec's.",
+					Example: fmt.Sprintf(`%s query %s check /cosmos.bank.v1beta1.MsgSend /cosmos.staking.v1beta1.MsgDelegate`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_urls", Varargs: true},
+					},
+				},
+			},
+		},
+	}
+}
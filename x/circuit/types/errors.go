@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/circuit module sentinel errors
+var (
+	ErrUnauthorized      = errors.Register(ModuleName, 2, "unauthorized")
+	ErrMsgDisabled       = errors.Register(ModuleName, 3, "msg disabled")
+	ErrMsgEnabled        = errors.Register(ModuleName, 4, "msg enabled")
+	ErrMsgLimitExhausted = errors.Register(ModuleName, 5, "msg per-block execution limit reached")
+	ErrTripRateLimited   = errors.Register(ModuleName, 6, "circuit breaker trip rate limit reached")
+	ErrQueryDisabled     = errors.Register(ModuleName, 7, "query route disabled")
+	ErrQueryEnabled      = errors.Register(ModuleName, 8, "query route enabled")
+)
@@ -0,0 +1,19 @@
+package types
+
+// circuit module event types and attributes
+const (
+	EventTypeAuthorizeCircuitBreaker = "authorize_circuit_breaker"
+	EventTypeTripCircuitBreaker      = "trip_circuit_breaker"
+	EventTypeResetCircuitBreaker     = "reset_circuit_breaker"
+	EventTypeCircuitRateLimited      = "circuit_rate_limited"
+
+	AttributeKeyGranter       = "granter"
+	AttributeKeyGrantee       = "grantee"
+	AttributeKeyPermission    = "permission"
+	AttributeKeyAuthority     = "authority"
+	AttributeKeyMsgURLs       = "msg_url"
+	AttributeKeyQueryRoutes   = "query_routes"
+	AttributeKeyLimitPerBlock = "limit_per_block"
+	AttributeKeyTrips         = "trips"
+	AttributeKeyTripWindow    = "trip_window"
+)
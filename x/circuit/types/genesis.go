@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewGenesisState creates a new genesis state for the circuit module.
+func NewGenesisState(
+	accounts []*GenesisAccountPermissions, disabledTypeURLs []string, rateLimitedMsgs []*DisabledMsg,
+	params Params, tripCounters []GenesisTripCounter, disabledQueryRoutes []string,
+) *GenesisState {
+	return &GenesisState{
+		AccountPermissions:  accounts,
+		DisabledTypeUrls:    disabledTypeURLs,
+		RateLimitedMsgs:     rateLimitedMsgs,
+		Params:              params,
+		TripCounters:        tripCounters,
+		DisabledQueryRoutes: disabledQueryRoutes,
+	}
+}
+
+// DefaultGenesisState returns the circuit module's default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs *GenesisState) Validate() error {
+	for _, a := range gs.AccountPermissions {
+		if a.Address == "" {
+			return fmt.Errorf("invalid empty account address")
+		}
+		if a.Permissions == nil {
+			return fmt.Errorf("nil permissions for account %s", a.Address)
+		}
+		if err := a.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions for account %s: %w", a.Address, err)
+		}
+	}
+
+	seen := make(map[string]bool, len(gs.DisabledTypeUrls)+len(gs.RateLimitedMsgs))
+	for _, url := range gs.DisabledTypeUrls {
+		if url == "" {
+			return fmt.Errorf("invalid empty disabled type url")
+		}
+		if seen[url] {
+			return fmt.Errorf("duplicate disabled type url %s", url)
+		}
+		seen[url] = true
+	}
+
+	for _, m := range gs.RateLimitedMsgs {
+		if m.MsgTypeUrl == "" {
+			return fmt.Errorf("invalid empty rate limited type url")
+		}
+		if m.LimitPerBlock == 0 {
+			return fmt.Errorf("rate limited type url %s must have a non-zero limit per block", m.MsgTypeUrl)
+		}
+		if seen[m.MsgTypeUrl] {
+			return fmt.Errorf("duplicate disabled type url %s", m.MsgTypeUrl)
+		}
+		seen[m.MsgTypeUrl] = true
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenCounters := make(map[string]bool, len(gs.TripCounters))
+	for _, c := range gs.TripCounters {
+		if c.Address == "" {
+			return fmt.Errorf("invalid empty trip counter address")
+		}
+		if seenCounters[c.Address] {
+			return fmt.Errorf("duplicate trip counter for account %s", c.Address)
+		}
+		seenCounters[c.Address] = true
+
+		for i, height := range c.Counter.TripHeights {
+			if height < 0 || (i > 0 && height < c.Counter.TripHeights[i-1]) {
+				return fmt.Errorf("invalid trip heights for account %s", c.Address)
+			}
+		}
+	}
+
+	seenRoutes := make(map[string]bool, len(gs.DisabledQueryRoutes))
+	for _, route := range gs.DisabledQueryRoutes {
+		if err := ValidateQueryRoute(route); err != nil {
+			return err
+		}
+		if seenRoutes[route] {
+			return fmt.Errorf("duplicate disabled query route %s", route)
+		}
+		seenRoutes[route] = true
+	}
+
+	return nil
+}
+
+// ValidateQueryRoute validates a gRPC query route, i.e. a full gRPC method
+// name such as /cosmos.bank.v1beta1.Query/Balance.
+func ValidateQueryRoute(route string) error {
+	if !strings.HasPrefix(route, "/") || !strings.Contains(route[1:], "/") {
+		return fmt.Errorf("invalid query route %q", route)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_" + ModuleName
+)
+
+// KVStore keys
+var (
+	AccountPermissionPrefix = []byte{0x01}
+	DisableListPrefix       = []byte{0x02}
+
+	// ExecutionCountPrefix is the transient store prefix counting the executions
+	// of rate limited Msg's in the current block.
+	ExecutionCountPrefix = []byte{0x03}
+
+	ParamsKey                = []byte{0x04}
+	TripCounterPrefix        = []byte{0x05}
+	DisabledQueryRoutePrefix = []byte{0x06}
+)
+
+// CreateAddressPrefix returns the store key for the permissions of an account.
+func CreateAddressPrefix(account []byte) []byte {
+	key := make([]byte, len(AccountPermissionPrefix)+len(account))
+	copy(key, AccountPermissionPrefix)
+	copy(key[len(AccountPermissionPrefix):], account)
+	return key
+}
+
+// CreateDisableMsgPrefix returns the store key for a disabled Msg type URL.
+func CreateDisableMsgPrefix(msgURL string) []byte {
+	return append(append([]byte{}, DisableListPrefix...), []byte(msgURL)...)
+}
+
+// CreateTripCounterPrefix returns the store key for the trip counter of an
+// account.
+func CreateTripCounterPrefix(account []byte) []byte {
+	return append(append([]byte{}, TripCounterPrefix...), account...)
+}
+
+// CreateDisableQueryRoutePrefix returns the store key for a disabled gRPC
+// query route.
+func CreateDisableQueryRoutePrefix(route string) []byte {
+	return append(append([]byte{}, DisabledQueryRoutePrefix...), []byte(route)...)
+}
+
+// CreateExecutionCountPrefix returns the transient store key counting the
+// executions of a Msg type URL in the current block.
+func CreateExecutionCountPrefix(msgURL string) []byte {
+	return append(append([]byte{}, ExecutionCountPrefix...), []byte(msgURL)...)
+}
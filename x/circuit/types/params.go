@@ -0,0 +1,31 @@
+package types
+
+import "fmt"
+
+// Default parameter values
+const (
+	DefaultMaxTripsPerWindow uint64 = 10
+	DefaultTripWindow        uint64 = 1000
+)
+
+// NewParams creates a new Params instance.
+func NewParams(maxTripsPerWindow, tripWindow uint64) Params {
+	return Params{
+		MaxTripsPerWindow: maxTripsPerWindow,
+		TripWindow:        tripWindow,
+	}
+}
+
+// DefaultParams returns the default parameters of the circuit module.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxTripsPerWindow, DefaultTripWindow)
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if p.MaxTripsPerWindow > 0 && p.TripWindow == 0 {
+		return fmt.Errorf("trip window must be positive when max trips per window is set")
+	}
+
+	return nil
+}
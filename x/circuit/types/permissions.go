@@ -0,0 +1,37 @@
+package types
+
+import "fmt"
+
+// Validate performs basic validation of the permissions.
+func (p *Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return fmt.Errorf("unknown permission level %d", p.Level)
+	}
+
+	if p.Level == Permissions_LEVEL_SOME_MSGS {
+		if len(p.LimitTypeUrls) == 0 {
+			return fmt.Errorf("limit_type_urls must be provided with %s", p.Level)
+		}
+	} else if len(p.LimitTypeUrls) != 0 {
+		return fmt.Errorf("limit_type_urls can only be used with %s", Permissions_LEVEL_SOME_MSGS)
+	}
+
+	return nil
+}
+
+// CanTrip returns true if the permissions allow tripping or resetting the
+// circuit breaker for the given Msg type URL.
+func (p *Permissions) CanTrip(msgURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_ALL_MSGS, Permissions_LEVEL_SUPER_ADMIN:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitTypeUrls {
+			if url == msgURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -6,7 +6,6 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -260,6 +259,11 @@ var xxx_messageInfo_QueryDisabledListRequest proto.InternalMessageInfo
 // DisabledListResponse is the response type for the Query/DisabledList RPC method.
 type DisabledListResponse struct {
 	DisabledList []string `protobuf:"bytes,1,rep,name=disabled_list,json=disabledList,proto3" json:"disabled_list,omitempty"`
+	// disabled_msgs lists the disabled Msg type URLs together with their
+	// per-block execution limit (0 when fully disabled).
+	DisabledMsgs []*DisabledMsg `protobuf:"bytes,2,rep,name=disabled_msgs,json=disabledMsgs,proto3" json:"disabled_msgs,omitempty"`
+	// disabled_query_routes lists the gRPC query routes which are not served.
+	DisabledQueryRoutes []string `protobuf:"bytes,3,rep,name=disabled_query_routes,json=disabledQueryRoutes,proto3" json:"disabled_query_routes,omitempty"`
 }
 
 func (m *DisabledListResponse) Reset()         { *m = DisabledListResponse{} }
@@ -302,6 +306,198 @@ func (m *DisabledListResponse) GetDisabledList() []string {
 	return nil
 }
 
+func (m *DisabledListResponse) GetDisabledMsgs() []*DisabledMsg {
+	if m != nil {
+		return m.DisabledMsgs
+	}
+	return nil
+}
+
+func (m *DisabledListResponse) GetDisabledQueryRoutes() []string {
+	if m != nil {
+		return m.DisabledQueryRoutes
+	}
+	return nil
+}
+
+// QueryCheckMsgsRequest is the request type for the Query/CheckMsgs RPC method.
+type QueryCheckMsgsRequest struct {
+	// msg_type_urls are the Msg type URLs to check.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// tx_bytes is an alternative to msg_type_urls: the encoded tx whose Msg type
+	// URLs are checked, including the ones of the Msgs nested in authz MsgExec's.
+	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *QueryCheckMsgsRequest) Reset()         { *m = QueryCheckMsgsRequest{} }
+func (m *QueryCheckMsgsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckMsgsRequest) ProtoMessage()    {}
+func (*QueryCheckMsgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{6}
+}
+func (m *QueryCheckMsgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckMsgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckMsgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckMsgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckMsgsRequest.Merge(m, src)
+}
+func (m *QueryCheckMsgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckMsgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckMsgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckMsgsRequest proto.InternalMessageInfo
+
+func (m *QueryCheckMsgsRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *QueryCheckMsgsRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// CheckMsgsResponse is the response type for the Query/CheckMsgs RPC method.
+type CheckMsgsResponse struct {
+	// statuses are the circuit breaker statuses of the checked Msg type URLs, in
+	// the order they were given or found in the tx, without duplicates.
+	Statuses []*MsgStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (m *CheckMsgsResponse) Reset()         { *m = CheckMsgsResponse{} }
+func (m *CheckMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckMsgsResponse) ProtoMessage()    {}
+func (*CheckMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{7}
+}
+func (m *CheckMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckMsgsResponse.Merge(m, src)
+}
+func (m *CheckMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckMsgsResponse proto.InternalMessageInfo
+
+func (m *CheckMsgsResponse) GetStatuses() []*MsgStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// MsgStatus is the circuit breaker status of a Msg type URL.
+type MsgStatus struct {
+	// msg_type_url is the type URL of the Msg.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// allowed is false when processing of the Msg is fully disabled.
+	Allowed bool `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// disabled_entry is the disable list entry matching the Msg type URL, or
+	// empty when the Msg is not in the disable list.
+	DisabledEntry string `protobuf:"bytes,3,opt,name=disabled_entry,json=disabledEntry,proto3" json:"disabled_entry,omitempty"`
+	// limit_per_block is the maximum number of executions of the Msg allowed per
+	// block when the Msg is rate limited, and 0 otherwise.
+	LimitPerBlock uint64 `protobuf:"varint,4,opt,name=limit_per_block,json=limitPerBlock,proto3" json:"limit_per_block,omitempty"`
+}
+
+func (m *MsgStatus) Reset()         { *m = MsgStatus{} }
+func (m *MsgStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgStatus) ProtoMessage()    {}
+func (*MsgStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{8}
+}
+func (m *MsgStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStatus.Merge(m, src)
+}
+func (m *MsgStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStatus proto.InternalMessageInfo
+
+func (m *MsgStatus) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgStatus) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *MsgStatus) GetDisabledEntry() string {
+	if m != nil {
+		return m.DisabledEntry
+	}
+	return ""
+}
+
+func (m *MsgStatus) GetLimitPerBlock() uint64 {
+	if m != nil {
+		return m.LimitPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.circuit.v1.QueryAccountRequest")
 	proto.RegisterType((*AccountResponse)(nil), "cosmos.circuit.v1.AccountResponse")
@@ -309,46 +505,63 @@ func init() {
 	proto.RegisterType((*AccountsResponse)(nil), "cosmos.circuit.v1.AccountsResponse")
 	proto.RegisterType((*QueryDisabledListRequest)(nil), "cosmos.circuit.v1.QueryDisabledListRequest")
 	proto.RegisterType((*DisabledListResponse)(nil), "cosmos.circuit.v1.DisabledListResponse")
+	proto.RegisterType((*QueryCheckMsgsRequest)(nil), "cosmos.circuit.v1.QueryCheckMsgsRequest")
+	proto.RegisterType((*CheckMsgsResponse)(nil), "cosmos.circuit.v1.CheckMsgsResponse")
+	proto.RegisterType((*MsgStatus)(nil), "cosmos.circuit.v1.MsgStatus")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/query.proto", fileDescriptor_87c65073a3d3c1e1) }
 
 var fileDescriptor_87c65073a3d3c1e1 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x2d, 0xb5, 0xed, 0x6b, 0x45, 0x1d, 0x0b, 0x86, 0x6d, 0xbb, 0xd6, 0x2d, 0x36,
-	0xa1, 0x96, 0x1d, 0x12, 0xc1, 0x8b, 0x20, 0x28, 0x62, 0x3d, 0x78, 0x68, 0x73, 0xf4, 0xa0, 0x4c,
-	0x76, 0x87, 0x65, 0x30, 0xd9, 0xd9, 0xee, 0xdb, 0x04, 0x8b, 0x08, 0xd2, 0x93, 0xde, 0x44, 0xff,
-	0x06, 0xef, 0x1e, 0xfc, 0x23, 0x3c, 0x16, 0xbc, 0x78, 0x94, 0x44, 0xf0, 0xdf, 0x90, 0xec, 0xce,
-	0x6c, 0x36, 0x76, 0x6a, 0x8f, 0x33, 0xef, 0x7d, 0xbf, 0xf3, 0x79, 0x3f, 0x76, 0x61, 0x33, 0x50,
-	0xd8, 0x57, 0xc8, 0x02, 0x99, 0x06, 0x03, 0x99, 0xb1, 0x61, 0x8b, 0x1d, 0x0d, 0x44, 0x7a, 0xec,
-	0x27, 0xa9, 0xca, 0x14, 0xbd, 0x56, 0x84, 0x7d, 0x1d, 0xf6, 0x87, 0x2d, 0x67, 0x57, 0x2b, 0xba,
-	0x1c, 0x45, 0x91, 0xcb, 0x86, 0xad, 0xae, 0xc8, 0x78, 0x8b, 0x25, 0x3c, 0x92, 0x31, 0xcf, 0xa4,
-	0x8a, 0x0b, 0xb9, 0x73, 0x43, 0xe7, 0xf6, 0x31, 0x9a, 0x38, 0xf7, 0x31, 0xd2, 0x01, 0xcb, 0xb3,
-	0xd9, 0x71, 0x22, 0x50, 0x87, 0x37, 0x22, 0xa5, 0xa2, 0x9e, 0x60, 0x3c, 0x91, 0x8c, 0xc7, 0xb1,
-	0xca, 0x72, 0x53, 0x13, 0x5d, 0xd7, 0x62, 0xf3, 0x78, 0x95, 0xd8, 0x63, 0x70, 0xfd, 0x70, 0x72,
-	0x7c, 0x18, 0x04, 0x6a, 0x10, 0x67, 0x1d, 0x71, 0x34, 0x10, 0x98, 0xd1, 0x3a, 0x2c, 0xf2, 0x30,
-	0x4c, 0x05, 0x62, 0x9d, 0x6c, 0x91, 0xe6, 0x72, 0xc7, 0x1c, 0xbd, 0x43, 0xb8, 0x52, 0xe6, 0x62,
-	0xa2, 0x62, 0x14, 0xf4, 0x01, 0x40, 0x22, 0xd2, 0xbe, 0x44, 0x94, 0x2a, 0xce, 0xf3, 0x57, 0xda,
-	0xae, 0x7f, 0xa6, 0x15, 0xfe, 0x41, 0x99, 0x84, 0x9d, 0x8a, 0xc2, 0x7b, 0x01, 0x6b, 0x55, 0x06,
-	0x34, 0x10, 0x4f, 0x00, 0xa6, 0x2d, 0xd2, 0xbe, 0x3b, 0xc6, 0x77, 0xd2, 0x4f, 0xbf, 0xa8, 0x44,
-	0xf7, 0xd3, 0x3f, 0xe0, 0x91, 0xd0, 0xda, 0x4e, 0x45, 0xe9, 0x7d, 0x21, 0x70, 0x75, 0xea, 0xad,
-	0xa1, 0x9f, 0xc2, 0x12, 0xd7, 0x77, 0x75, 0xb2, 0x35, 0xdf, 0x5c, 0x69, 0xef, 0x59, 0x90, 0xf7,
-	0x45, 0x2c, 0x50, 0xa2, 0x56, 0x57, 0x0b, 0x28, 0xd5, 0x74, 0x7f, 0x06, 0x73, 0x2e, 0xc7, 0x6c,
-	0x5c, 0x88, 0x59, 0x60, 0xcc, 0x70, 0x3a, 0x50, 0xcf, 0xfb, 0xf0, 0x58, 0x22, 0xef, 0xf6, 0x44,
-	0xf8, 0x4c, 0xa2, 0x19, 0x88, 0x77, 0x1f, 0xd6, 0x66, 0xaf, 0x75, 0x19, 0xdb, 0x70, 0x39, 0xd4,
-	0xf7, 0x2f, 0x7b, 0x12, 0xb3, 0xbc, 0x96, 0xe5, 0xce, 0x6a, 0x58, 0x49, 0x6e, 0x7f, 0x9b, 0x87,
-	0x85, 0xdc, 0x99, 0x7e, 0x20, 0xb0, 0xa8, 0x8b, 0xa1, 0x3b, 0x96, 0x7a, 0x2d, 0xbb, 0xe0, 0x78,
-	0x96, 0xbc, 0x7f, 0x56, 0xc0, 0x6b, 0xbf, 0xff, 0xf3, 0x75, 0x97, 0x9c, 0xfc, 0xf8, 0xfd, 0x79,
-	0xae, 0x41, 0x6f, 0xb3, 0xb3, 0xeb, 0x6a, 0xba, 0xc5, 0xde, 0xe8, 0x45, 0x7a, 0x4b, 0x4f, 0x08,
-	0x2c, 0x99, 0xb1, 0xd0, 0xc6, 0x05, 0x30, 0x66, 0x29, 0x9c, 0xed, 0xf3, 0x69, 0xca, 0xe1, 0x7a,
-	0xcd, 0x29, 0xce, 0x26, 0x5d, 0xff, 0x0f, 0x0e, 0xfd, 0x44, 0x60, 0xb5, 0xda, 0x58, 0x7a, 0xe7,
-	0x3c, 0x10, 0xcb, 0x54, 0x1c, 0x1b, 0xb5, 0x6d, 0x4c, 0xde, 0xde, 0x14, 0xe8, 0x16, 0xbd, 0x69,
-	0x01, 0xd2, 0xf3, 0xca, 0x67, 0xe8, 0x2c, 0xbc, 0x9b, 0x64, 0x3f, 0xba, 0xf7, 0x7d, 0xe4, 0x92,
-	0xd3, 0x91, 0x4b, 0x7e, 0x8d, 0x5c, 0xf2, 0x71, 0xec, 0xd6, 0x4e, 0xc7, 0x6e, 0xed, 0xe7, 0xd8,
-	0xad, 0x3d, 0xdf, 0x28, 0x0c, 0x30, 0x7c, 0xe5, 0x4b, 0xc5, 0x5e, 0x97, 0x46, 0xf9, 0x4f, 0xa1,
-	0x7b, 0x29, 0xff, 0xb4, 0xef, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xab, 0xa8, 0x92, 0x5a, 0xad,
-	0x04, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x31, 0x61, 0x97, 0xe4, 0x11, 0x96, 0x65, 0x00, 0xc9, 0x1b, 0x20, 0x64, 0xcd, 0x42,
+	0x22, 0x16, 0xd9, 0x4a, 0x56, 0x5a, 0xed, 0x69, 0x25, 0x60, 0xb7, 0xf4, 0xd0, 0x48, 0xe0, 0xfe,
+	0x38, 0xf4, 0xd0, 0xc8, 0x71, 0x46, 0xae, 0x85, 0xe3, 0x31, 0x7e, 0x13, 0x4a, 0x54, 0xf5, 0xc2,
+	0xa5, 0xed, 0xad, 0x6a, 0xa5, 0xfe, 0x07, 0xbd, 0xf7, 0x50, 0xa9, 0xff, 0x42, 0x8f, 0x48, 0xbd,
+	0xf4, 0x58, 0x41, 0xa5, 0xfe, 0x1b, 0x95, 0x27, 0x63, 0xc7, 0x80, 0x03, 0xc7, 0x79, 0xf3, 0xbe,
+	0x6f, 0x3e, 0xef, 0x97, 0x0d, 0xcb, 0x36, 0xc3, 0x2e, 0x43, 0xc3, 0x76, 0x43, 0xbb, 0xe7, 0x72,
+	0xe3, 0xa8, 0x6e, 0x1c, 0xf6, 0x68, 0xd8, 0xd7, 0x83, 0x90, 0x71, 0x46, 0x66, 0x07, 0xd7, 0xba,
+	0xbc, 0xd6, 0x8f, 0xea, 0xa5, 0x0d, 0xa9, 0x68, 0x5b, 0x48, 0x07, 0xbe, 0xc6, 0x51, 0xbd, 0x4d,
+	0xb9, 0x55, 0x37, 0x02, 0xcb, 0x71, 0x7d, 0x8b, 0xbb, 0xcc, 0x1f, 0xc8, 0x4b, 0x19, 0xd1, 0x79,
+	0x3f, 0xa0, 0x28, 0xaf, 0x97, 0x1c, 0xc6, 0x1c, 0x8f, 0x1a, 0x56, 0xe0, 0x1a, 0x96, 0xef, 0x33,
+	0x2e, 0xb4, 0xf1, 0xed, 0xa2, 0x14, 0xc7, 0x6f, 0xa4, 0xc1, 0x34, 0x03, 0xe6, 0xf6, 0xa3, 0xe3,
+	0x96, 0x6d, 0xb3, 0x9e, 0xcf, 0x4d, 0x7a, 0xd8, 0xa3, 0xc8, 0x89, 0x0a, 0x93, 0x56, 0xa7, 0x13,
+	0x52, 0x44, 0x55, 0xa9, 0x28, 0xb5, 0x82, 0x19, 0x1f, 0xb5, 0x7d, 0x98, 0x49, 0x7c, 0x31, 0x60,
+	0x3e, 0x52, 0xf2, 0x2f, 0x40, 0x40, 0xc3, 0xae, 0x8b, 0xe8, 0x32, 0x5f, 0xf8, 0x4f, 0x35, 0xca,
+	0xfa, 0x95, 0x8c, 0xf5, 0xbd, 0xc4, 0x09, 0xcd, 0x94, 0x42, 0x7b, 0x04, 0xf3, 0x69, 0x06, 0x8c,
+	0x21, 0x6e, 0x01, 0x0c, 0x2b, 0x21, 0xe3, 0xae, 0xc7, 0x71, 0xa3, 0xb2, 0xe9, 0x83, 0x4c, 0x64,
+	0xd9, 0xf4, 0x3d, 0xcb, 0xa1, 0x52, 0x6b, 0xa6, 0x94, 0xda, 0x3b, 0x05, 0x7e, 0x1d, 0xc6, 0x96,
+	0xd0, 0xb7, 0x21, 0x6f, 0x49, 0x9b, 0xaa, 0x54, 0x72, 0xb5, 0xa9, 0xc6, 0x66, 0x06, 0xf2, 0x2e,
+	0xf5, 0x29, 0xba, 0x28, 0xd5, 0xe9, 0x04, 0x12, 0x35, 0xd9, 0xbd, 0x80, 0x39, 0x2e, 0x30, 0xab,
+	0x37, 0x62, 0x0e, 0x30, 0x2e, 0x70, 0x96, 0x40, 0x15, 0x75, 0xf8, 0xcf, 0x45, 0xab, 0xed, 0xd1,
+	0xce, 0x1d, 0x17, 0xe3, 0x86, 0x68, 0x1f, 0x14, 0x98, 0xbf, 0x68, 0x97, 0x79, 0xac, 0xc2, 0x74,
+	0x47, 0xda, 0x5b, 0x9e, 0x8b, 0x5c, 0x24, 0x53, 0x30, 0x8b, 0x9d, 0x94, 0x33, 0xd9, 0x49, 0x39,
+	0x75, 0xd1, 0x41, 0x75, 0xbc, 0x92, 0x1b, 0xd1, 0xa4, 0xf8, 0x91, 0x26, 0x3a, 0xc3, 0x20, 0x4d,
+	0x74, 0x90, 0x34, 0x60, 0x21, 0x09, 0x22, 0x32, 0x6a, 0x85, 0xac, 0xc7, 0x29, 0xaa, 0x39, 0xf1,
+	0xe2, 0x5c, 0x7c, 0x29, 0x72, 0x30, 0xc5, 0x95, 0xf6, 0x00, 0x16, 0xc4, 0x71, 0xe7, 0x31, 0xb5,
+	0x0f, 0xa2, 0x28, 0x71, 0x6f, 0x35, 0x98, 0xee, 0xa2, 0xd3, 0x8a, 0xa6, 0xb8, 0xd5, 0x0b, 0x3d,
+	0x94, 0xd8, 0x53, 0x5d, 0x74, 0xee, 0xf5, 0x03, 0x7a, 0x3f, 0xf4, 0x90, 0xfc, 0x06, 0x79, 0x7e,
+	0xdc, 0x6a, 0xf7, 0xa3, 0x37, 0xa2, 0xb2, 0x16, 0xcd, 0x49, 0x7e, 0xbc, 0x1d, 0x1d, 0xb5, 0x26,
+	0xcc, 0xa6, 0x42, 0xca, 0x52, 0xfc, 0x03, 0x79, 0xe4, 0x16, 0xef, 0x21, 0x8d, 0x5b, 0xba, 0x94,
+	0x91, 0x60, 0x13, 0x9d, 0xbb, 0xc2, 0xcb, 0x4c, 0xbc, 0xb5, 0xb7, 0x0a, 0x14, 0x12, 0x3b, 0xa9,
+	0x40, 0x31, 0xcd, 0x26, 0x37, 0x00, 0x86, 0x68, 0x62, 0x3d, 0x3c, 0x8f, 0x3d, 0xa1, 0x1d, 0x01,
+	0x96, 0x37, 0xe3, 0x23, 0x59, 0x83, 0x5f, 0x92, 0x22, 0x51, 0x9f, 0x87, 0x7d, 0x35, 0x27, 0xd4,
+	0x49, 0xfd, 0xff, 0x8f, 0x8c, 0x64, 0x1d, 0x66, 0x3c, 0xb7, 0xeb, 0xf2, 0x56, 0x40, 0xc3, 0x56,
+	0xdb, 0x63, 0xf6, 0x81, 0x3a, 0x51, 0x51, 0x6a, 0x13, 0xe6, 0xb4, 0x30, 0xef, 0xd1, 0x70, 0x3b,
+	0x32, 0x36, 0x3e, 0x4e, 0xc0, 0x4f, 0xa2, 0x80, 0xe4, 0xa5, 0x02, 0x93, 0x72, 0x0c, 0xc9, 0x7a,
+	0x46, 0x5a, 0x19, 0x5b, 0x5c, 0xd2, 0x32, 0xfc, 0x2e, 0x2d, 0xaf, 0xd6, 0x78, 0xf1, 0xfd, 0xfd,
+	0x86, 0x72, 0xf2, 0xf9, 0xdb, 0x9b, 0xf1, 0x2a, 0x59, 0x33, 0xae, 0x7e, 0x68, 0xe2, 0x39, 0x37,
+	0x9e, 0xca, 0x4f, 0xc0, 0x33, 0x72, 0xa2, 0x40, 0x7e, 0x2b, 0x1e, 0xff, 0xea, 0x0d, 0x30, 0x71,
+	0xcb, 0x4b, 0xab, 0xa3, 0x69, 0x92, 0x1e, 0x6a, 0xb5, 0x21, 0xce, 0x32, 0x59, 0xbc, 0x06, 0x87,
+	0xbc, 0x56, 0xa0, 0x98, 0xde, 0x08, 0xf2, 0xe7, 0x28, 0x90, 0x8c, 0x7d, 0x2a, 0x55, 0xaf, 0x19,
+	0xfd, 0xf4, 0x7e, 0x69, 0x9b, 0x43, 0xa0, 0xdf, 0xc9, 0x4a, 0x06, 0x90, 0x6c, 0xac, 0x58, 0x3e,
+	0xf2, 0x5c, 0x81, 0x42, 0x32, 0x98, 0xa4, 0x36, 0x8a, 0xe8, 0xf2, 0x3a, 0x94, 0xfe, 0xc8, 0xf0,
+	0xbc, 0x32, 0xe0, 0xda, 0xc6, 0x90, 0x65, 0x85, 0x2c, 0x67, 0xb0, 0xd8, 0x91, 0x44, 0x6c, 0xf8,
+	0xf6, 0xdf, 0x9f, 0xce, 0xca, 0xca, 0xe9, 0x59, 0x59, 0xf9, 0x7a, 0x56, 0x56, 0x5e, 0x9d, 0x97,
+	0xc7, 0x4e, 0xcf, 0xcb, 0x63, 0x5f, 0xce, 0xcb, 0x63, 0x0f, 0x97, 0x06, 0x3a, 0xec, 0x1c, 0xe8,
+	0x2e, 0x33, 0x8e, 0x13, 0xbd, 0xf8, 0xa3, 0xb4, 0x7f, 0x16, 0xff, 0x85, 0xbf, 0x7e, 0x0c, 0x00,
+	0x90, 0xe4, 0x9f, 0x8e, 0xd1, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -369,6 +582,10 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// CheckMsgs returns whether the given Msg type URLs, or the Msgs of the given
+	// tx including the ones nested in authz MsgExec's, are allowed by the circuit
+	// breaker.
+	CheckMsgs(ctx context.Context, in *QueryCheckMsgsRequest, opts ...grpc.CallOption) (*CheckMsgsResponse, error)
 }
 
 type queryClient struct {
@@ -406,6 +623,15 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) CheckMsgs(ctx context.Context, in *QueryCheckMsgsRequest, opts ...grpc.CallOption) (*CheckMsgsResponse, error) {
+	out := new(CheckMsgsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/CheckMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account returns account permissions.
@@ -414,6 +640,10 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// CheckMsgs returns whether the given Msg type URLs, or the Msgs of the given
+	// tx including the ones nested in authz MsgExec's, are allowed by the circuit
+	// breaker.
+	CheckMsgs(context.Context, *QueryCheckMsgsRequest) (*CheckMsgsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -429,6 +659,9 @@ func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccount
 func (*UnimplementedQueryServer) DisabledList(ctx context.Context, req *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (*UnimplementedQueryServer) CheckMsgs(ctx context.Context, req *QueryCheckMsgsRequest) (*CheckMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMsgs not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -488,6 +721,24 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CheckMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/CheckMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckMsgs(ctx, req.(*QueryCheckMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -504,6 +755,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "CheckMsgs",
+			Handler:    _Query_CheckMsgs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -701,6 +956,29 @@ func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DisabledQueryRoutes) > 0 {
+		for iNdEx := len(m.DisabledQueryRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledQueryRoutes[iNdEx])
+			copy(dAtA[i:], m.DisabledQueryRoutes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledQueryRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DisabledMsgs) > 0 {
+		for iNdEx := len(m.DisabledMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisabledMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.DisabledList) > 0 {
 		for iNdEx := len(m.DisabledList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DisabledList[iNdEx])
@@ -713,6 +991,134 @@ func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCheckMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LimitPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LimitPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DisabledEntry) > 0 {
+		i -= len(m.DisabledEntry)
+		copy(dAtA[i:], m.DisabledEntry)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DisabledEntry)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -803,14 +1209,83 @@ func (m *DisabledListResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.DisabledMsgs) > 0 {
+		for _, e := range m.DisabledMsgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DisabledQueryRoutes) > 0 {
+		for _, s := range m.DisabledQueryRoutes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryCheckMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.DisabledEntry)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LimitPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LimitPerBlock))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1297,6 +1772,425 @@ func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.DisabledList = append(m.DisabledList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMsgs = append(m.DisabledMsgs, &DisabledMsg{})
+			if err := m.DisabledMsgs[len(m.DisabledMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledQueryRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledQueryRoutes = append(m.DisabledQueryRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &MsgStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledEntry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledEntry = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPerBlock", wireType)
+			}
+			m.LimitPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
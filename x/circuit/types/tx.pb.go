@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -150,6 +151,14 @@ type MsgTripCircuitBreaker struct {
 	// authority does not have permissions to trip the specified msg type URLs
 	// (or all URLs), the operation will fail.
 	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// limit_per_block optionally throttles the Msg type URLs instead of fully
+	// disabling them: at most limit_per_block executions of each Msg are
+	// allowed per block. A value of 0 fully disables processing.
+	LimitPerBlock uint64 `protobuf:"varint,3,opt,name=limit_per_block,json=limitPerBlock,proto3" json:"limit_per_block,omitempty"`
+	// query_routes specifies a list of gRPC query routes, e.g.
+	// "/cosmos.bank.v1beta1.Query/AllBalances", to stop serving. Only the module
+	// authority and LEVEL_SUPER_ADMIN accounts can disable query routes.
+	QueryRoutes []string `protobuf:"bytes,4,rep,name=query_routes,json=queryRoutes,proto3" json:"query_routes,omitempty"`
 }
 
 func (m *MsgTripCircuitBreaker) Reset()         { *m = MsgTripCircuitBreaker{} }
@@ -199,6 +208,20 @@ func (m *MsgTripCircuitBreaker) GetMsgTypeUrls() []string {
 	return nil
 }
 
+func (m *MsgTripCircuitBreaker) GetLimitPerBlock() uint64 {
+	if m != nil {
+		return m.LimitPerBlock
+	}
+	return 0
+}
+
+func (m *MsgTripCircuitBreaker) GetQueryRoutes() []string {
+	if m != nil {
+		return m.QueryRoutes
+	}
+	return nil
+}
+
 // MsgTripCircuitBreaker defines the Msg/TripCircuitBreaker response type.
 type MsgTripCircuitBreakerResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -249,9 +272,11 @@ type MsgResetCircuitBreaker struct {
 	// authority is the account authorized to trip or reset the circuit breaker.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// msg_type_urls specifies a list of Msg type URLs to resume processing. If
-	// it is left empty all Msg processing for type URLs that the account is
-	// authorized to trip will resume.
+	// it is left empty, along with query_routes, all Msg processing for type
+	// URLs that the account is authorized to trip will resume.
 	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// query_routes specifies a list of gRPC query routes to resume serving.
+	QueryRoutes []string `protobuf:"bytes,4,rep,name=query_routes,json=queryRoutes,proto3" json:"query_routes,omitempty"`
 }
 
 func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
@@ -301,6 +326,13 @@ func (m *MsgResetCircuitBreaker) GetMsgTypeUrls() []string {
 	return nil
 }
 
+func (m *MsgResetCircuitBreaker) GetQueryRoutes() []string {
+	if m != nil {
+		return m.QueryRoutes
+	}
+	return nil
+}
+
 // MsgResetCircuitBreakerResponse defines the Msg/ResetCircuitBreaker response type.
 type MsgResetCircuitBreakerResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -346,6 +378,102 @@ func (m *MsgResetCircuitBreakerResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/circuit parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAuthorizeCircuitBreaker)(nil), "cosmos.circuit.v1.MsgAuthorizeCircuitBreaker")
 	proto.RegisterType((*MsgAuthorizeCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgAuthorizeCircuitBreakerResponse")
@@ -353,39 +481,49 @@ func init() {
 	proto.RegisterType((*MsgTripCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgTripCircuitBreakerResponse")
 	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "cosmos.circuit.v1.MsgResetCircuitBreaker")
 	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.circuit.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.circuit.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/tx.proto", fileDescriptor_a02145e57a6fbb1d) }
 
 var fileDescriptor_a02145e57a6fbb1d = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0x87, 0x19, 0x1a, 0xff, 0x30, 0xa8, 0x89, 0x35, 0x7a, 0x9b, 0xc9, 0xbd, 0x13, 0xd2, 0x55,
-	0xbd, 0x89, 0xad, 0x60, 0x34, 0x91, 0x85, 0x51, 0x5c, 0x37, 0x31, 0x0d, 0x6e, 0xdc, 0x10, 0xac,
-	0x93, 0x71, 0x84, 0x32, 0xcd, 0x9c, 0x96, 0x80, 0x1b, 0x8d, 0x4f, 0xe0, 0x23, 0xf8, 0x08, 0x3c,
-	0x86, 0x4b, 0x96, 0x2e, 0x0d, 0x2c, 0x78, 0x01, 0x1f, 0xc0, 0x94, 0x52, 0x4a, 0x64, 0x08, 0x98,
-	0xbb, 0x1c, 0xce, 0x37, 0xe7, 0xf7, 0x0d, 0xa7, 0x07, 0x93, 0x50, 0x42, 0x24, 0xc1, 0x0b, 0x85,
-	0x0a, 0x53, 0x91, 0x78, 0xe3, 0xa6, 0x97, 0x4c, 0xdc, 0x58, 0xc9, 0x44, 0x9a, 0x77, 0xf3, 0x9a,
-	0xbb, 0xa9, 0xb9, 0xe3, 0x26, 0x39, 0xdb, 0xe0, 0x11, 0xf0, 0x0c, 0x8d, 0x80, 0xe7, 0x2c, 0xb9,
-	0xd0, 0xf4, 0x99, 0xc6, 0x0c, 0xf2, 0xb2, 0xfd, 0x03, 0x61, 0xe2, 0x03, 0x7f, 0x95, 0x26, 0x1f,
-	0xa5, 0x12, 0x9f, 0xd9, 0xeb, 0x1c, 0xeb, 0x28, 0xd6, 0x1f, 0x30, 0x65, 0x5a, 0xf8, 0x06, 0x57,
-	0xfd, 0x51, 0xc2, 0x94, 0x85, 0x1a, 0xc8, 0xa9, 0x05, 0xc5, 0xb1, 0xac, 0x30, 0xab, 0xba, 0x5b,
-	0x61, 0xe6, 0x4b, 0x5c, 0x8f, 0x99, 0x8a, 0x04, 0x80, 0x90, 0x23, 0xb0, 0x8c, 0x06, 0x72, 0xea,
-	0x2d, 0xea, 0xee, 0x39, 0xbb, 0x6f, 0x4a, 0x2a, 0xd8, 0xbd, 0xd2, 0xbe, 0xf5, 0x6d, 0x35, 0xbb,
-	0x2c, 0x92, 0xec, 0x17, 0xd8, 0x3e, 0x6c, 0x18, 0x30, 0x88, 0xe5, 0x08, 0x58, 0xe6, 0x03, 0x69,
-	0x18, 0x32, 0x80, 0xb5, 0xe9, 0xcd, 0xa0, 0x38, 0xda, 0x02, 0xdf, 0xf7, 0x81, 0x77, 0x95, 0x88,
-	0xff, 0x79, 0xdc, 0x39, 0xae, 0xf5, 0xf3, 0xae, 0xc9, 0x74, 0xf3, 0xbc, 0xf2, 0x07, 0xd3, 0xc6,
-	0xb7, 0x23, 0xe0, 0xbd, 0xec, 0xcf, 0xea, 0xa5, 0x6a, 0x08, 0x56, 0xb5, 0x61, 0x38, 0xb5, 0xa0,
-	0x1e, 0x01, 0xef, 0x4e, 0x63, 0xf6, 0x56, 0x0d, 0xa1, 0x7d, 0x27, 0x13, 0x2d, 0xef, 0xd8, 0xcf,
-	0xf1, 0x85, 0x36, 0xea, 0x04, 0xcb, 0x4f, 0xf8, 0x81, 0x0f, 0x3c, 0x60, 0xc0, 0x92, 0xab, 0x69,
-	0x1a, 0xc7, 0x35, 0xdb, 0x98, 0xea, 0xb3, 0x8e, 0x7b, 0xb6, 0xfe, 0x54, 0xb1, 0xe1, 0x03, 0x37,
-	0xbf, 0xe0, 0xb3, 0x43, 0x1f, 0xcd, 0x23, 0xcd, 0xac, 0x0f, 0x4f, 0x90, 0x3c, 0xfd, 0x2f, 0x7c,
-	0xab, 0x18, 0x63, 0x53, 0x33, 0x53, 0x47, 0xdf, 0x6c, 0x9f, 0x24, 0x8f, 0x4f, 0x25, 0xb7, 0x89,
-	0x80, 0xef, 0xe9, 0xe6, 0xf3, 0x50, 0xdf, 0x48, 0x83, 0x92, 0xe6, 0xc9, 0x68, 0x11, 0x4a, 0xae,
-	0x7d, 0x5d, 0xcd, 0x2e, 0x51, 0xe7, 0xd9, 0xcf, 0x05, 0x45, 0xf3, 0x05, 0x45, 0xbf, 0x17, 0x14,
-	0x7d, 0x5f, 0xd2, 0xca, 0x7c, 0x49, 0x2b, 0xbf, 0x96, 0xb4, 0xf2, 0xee, 0x3c, 0x6f, 0x09, 0x1f,
-	0x06, 0xae, 0x90, 0xde, 0x64, 0xbb, 0xe8, 0xeb, 0x2d, 0x7f, 0x7f, 0x7d, 0xbd, 0xe6, 0x4f, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x89, 0x07, 0x74, 0x02, 0x4f, 0x04, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x8f, 0xd2, 0x5c,
+	0x14, 0xc6, 0xe9, 0xc0, 0x3b, 0xaf, 0x1c, 0x18, 0x27, 0xd6, 0x3f, 0x74, 0x9a, 0x99, 0x8a, 0x5d,
+	0x18, 0x24, 0xb1, 0x08, 0x46, 0x8d, 0x2c, 0x8c, 0xe2, 0x9a, 0x84, 0x34, 0x33, 0x1b, 0x17, 0x92,
+	0x4e, 0xe7, 0xe6, 0xda, 0x40, 0xb9, 0xf5, 0x9e, 0x76, 0x02, 0x6e, 0x34, 0xee, 0x4d, 0xfc, 0x08,
+	0x7e, 0x84, 0x59, 0xf8, 0x21, 0x66, 0x39, 0x0b, 0x17, 0xae, 0x8c, 0x81, 0xc5, 0x7c, 0x0d, 0xd3,
+	0x5b, 0x0a, 0x38, 0x5c, 0x32, 0xa8, 0x3b, 0xee, 0x39, 0x3f, 0x9e, 0xf3, 0x3c, 0x37, 0xa7, 0x17,
+	0x74, 0x97, 0xa1, 0xcf, 0xb0, 0xe6, 0x7a, 0xdc, 0x8d, 0xbc, 0xb0, 0x76, 0x5c, 0xaf, 0x85, 0x43,
+	0x2b, 0xe0, 0x2c, 0x64, 0xea, 0xb5, 0xa4, 0x67, 0x4d, 0x7b, 0xd6, 0x71, 0x5d, 0xbf, 0x41, 0x19,
+	0x65, 0xa2, 0x5b, 0x8b, 0x7f, 0x25, 0xa0, 0x5e, 0x9a, 0x8a, 0xf8, 0x48, 0x63, 0x01, 0x1f, 0xe9,
+	0xb4, 0xb1, 0x27, 0x51, 0x1f, 0x05, 0x04, 0x93, 0xb6, 0xf9, 0x45, 0x01, 0xbd, 0x8d, 0xf4, 0x45,
+	0x14, 0xbe, 0x61, 0xdc, 0x7b, 0x47, 0x5e, 0x26, 0x58, 0x8b, 0x13, 0xa7, 0x47, 0xb8, 0xaa, 0xc1,
+	0xff, 0x94, 0x3b, 0x83, 0x90, 0x70, 0x4d, 0x29, 0x2b, 0x95, 0xbc, 0x9d, 0x1e, 0xe7, 0x1d, 0xa2,
+	0x6d, 0x2c, 0x76, 0x88, 0xfa, 0x1c, 0x0a, 0x01, 0xe1, 0xbe, 0x87, 0xe8, 0xb1, 0x01, 0x6a, 0xd9,
+	0xb2, 0x52, 0x29, 0x34, 0x0c, 0x6b, 0x29, 0x89, 0xd5, 0x99, 0x53, 0xf6, 0xe2, 0x5f, 0x9a, 0xc5,
+	0x8f, 0xe7, 0x27, 0xd5, 0x74, 0x92, 0xf9, 0x0c, 0xcc, 0xd5, 0x0e, 0x6d, 0x82, 0x01, 0x1b, 0x20,
+	0x89, 0xfd, 0x60, 0xe4, 0xba, 0x04, 0x51, 0x38, 0xbd, 0x62, 0xa7, 0x47, 0xf3, 0xab, 0x02, 0x37,
+	0xdb, 0x48, 0xf7, 0xb9, 0x17, 0x5c, 0x48, 0xb7, 0x0b, 0x79, 0x27, 0x91, 0x0d, 0x47, 0xd3, 0x7c,
+	0xf3, 0x82, 0x6a, 0xc2, 0x96, 0x8f, 0xb4, 0x1b, 0xdf, 0x56, 0x37, 0xe2, 0x7d, 0xd4, 0x36, 0xca,
+	0xd9, 0x4a, 0xde, 0x2e, 0xf8, 0x48, 0xf7, 0x47, 0x01, 0x39, 0xe0, 0x7d, 0x54, 0xef, 0xc2, 0x76,
+	0xdf, 0xf3, 0xbd, 0xb0, 0x1b, 0x10, 0xde, 0x3d, 0xec, 0x33, 0xb7, 0x27, 0xf2, 0xe6, 0xec, 0x2d,
+	0x51, 0xee, 0x10, 0xde, 0x8a, 0x8b, 0xea, 0x1d, 0x28, 0xbe, 0x8d, 0x08, 0x1f, 0x75, 0x39, 0x8b,
+	0x42, 0x82, 0x5a, 0x2e, 0x91, 0x12, 0x35, 0x5b, 0x94, 0x9a, 0x57, 0xe3, 0xd0, 0xf3, 0xf1, 0xe6,
+	0x53, 0xd8, 0x93, 0xba, 0x5e, 0x23, 0xf1, 0x27, 0x05, 0x6e, 0xb5, 0x91, 0xda, 0x04, 0x49, 0xf8,
+	0x6f, 0x91, 0xb3, 0xcb, 0x91, 0xff, 0x22, 0x4a, 0x13, 0x0c, 0xb9, 0x9d, 0x35, 0xb2, 0x0c, 0x61,
+	0xbb, 0x8d, 0xf4, 0x20, 0x38, 0x72, 0x42, 0xd2, 0x71, 0xb8, 0xe3, 0xe3, 0x25, 0x19, 0x9e, 0xc0,
+	0x66, 0x20, 0x38, 0xb1, 0x97, 0x85, 0xc6, 0x8e, 0x6c, 0xf3, 0x04, 0xd0, 0xca, 0x9d, 0xfe, 0xb8,
+	0x9d, 0xb1, 0xa7, 0xf8, 0x92, 0xeb, 0x1d, 0x28, 0x5d, 0x98, 0x9c, 0xda, 0x6d, 0x7c, 0xcb, 0x42,
+	0xb6, 0x8d, 0x54, 0x7d, 0x0f, 0xa5, 0x55, 0x5f, 0xce, 0x7d, 0xc9, 0xd8, 0xd5, 0x6b, 0xac, 0x3f,
+	0xfa, 0x23, 0x7c, 0x76, 0x6f, 0x01, 0xa8, 0x92, 0xbd, 0xae, 0xc8, 0xc5, 0x96, 0x49, 0xfd, 0xc1,
+	0xba, 0xe4, 0x6c, 0x22, 0xc2, 0x75, 0xd9, 0x5e, 0xdd, 0x93, 0x0b, 0x49, 0x50, 0xbd, 0xbe, 0x36,
+	0x3a, 0x1b, 0xfa, 0x1a, 0x8a, 0xbf, 0x6d, 0x80, 0x29, 0x97, 0x58, 0x64, 0xf4, 0xea, 0xe5, 0x4c,
+	0xaa, 0xaf, 0xff, 0xf7, 0xe1, 0xfc, 0xa4, 0xaa, 0xb4, 0x1e, 0x9f, 0x8e, 0x0d, 0xe5, 0x6c, 0x6c,
+	0x28, 0x3f, 0xc7, 0x86, 0xf2, 0x79, 0x62, 0x64, 0xce, 0x26, 0x46, 0xe6, 0xfb, 0xc4, 0xc8, 0xbc,
+	0xda, 0x4d, 0xb4, 0xf0, 0xa8, 0x67, 0x79, 0xac, 0x36, 0x9c, 0xbd, 0xa6, 0xe2, 0x29, 0x3d, 0xdc,
+	0x14, 0x6f, 0xe9, 0xc3, 0x5f, 0x03, 0x00, 0x25, 0x3b, 0xab, 0xd8, 0xca, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -408,6 +546,9 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// UpdateParams defines a governance operation for updating the x/circuit
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -445,6 +586,15 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AuthorizeCircuitBreaker allows a super-admin to grant (or revoke) another
@@ -455,6 +605,9 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// UpdateParams defines a governance operation for updating the x/circuit
+	// module parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -470,6 +623,9 @@ func (*UnimplementedMsgServer) TripCircuitBreaker(ctx context.Context, req *MsgT
 func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -529,6 +685,24 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -545,6 +719,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
@@ -652,6 +830,20 @@ func (m *MsgTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueryRoutes) > 0 {
+		for iNdEx := len(m.QueryRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryRoutes[iNdEx])
+			copy(dAtA[i:], m.QueryRoutes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.QueryRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LimitPerBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LimitPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.MsgTypeUrls) > 0 {
 		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MsgTypeUrls[iNdEx])
@@ -724,6 +916,15 @@ func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueryRoutes) > 0 {
+		for iNdEx := len(m.QueryRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueryRoutes[iNdEx])
+			copy(dAtA[i:], m.QueryRoutes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.QueryRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MsgTypeUrls) > 0 {
 		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.MsgTypeUrls[iNdEx])
@@ -776,6 +977,69 @@ func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -836,6 +1100,15 @@ func (m *MsgTripCircuitBreaker) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.LimitPerBlock != 0 {
+		n += 1 + sovTx(uint64(m.LimitPerBlock))
+	}
+	if len(m.QueryRoutes) > 0 {
+		for _, s := range m.QueryRoutes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -867,6 +1140,12 @@ func (m *MsgResetCircuitBreaker) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.QueryRoutes) > 0 {
+		for _, s := range m.QueryRoutes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -882,6 +1161,30 @@ func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1201,6 +1504,57 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPerBlock", wireType)
+			}
+			m.LimitPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryRoutes = append(m.QueryRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1385,6 +1739,38 @@ func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryRoutes = append(m.QueryRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1476,6 +1862,171 @@ func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
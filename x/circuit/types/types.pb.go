@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -122,6 +123,221 @@ func (m *Permissions) GetLimitTypeUrls() []string {
 	return nil
 }
 
+// DisabledMsg is a Msg type URL whose processing is paused or throttled by the
+// circuit breaker.
+type DisabledMsg struct {
+	// msg_type_url is the type URL of the disabled Msg.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// limit_per_block is the maximum number of executions of the Msg allowed per
+	// block. A value of 0 means that processing of the Msg is fully disabled.
+	LimitPerBlock uint64 `protobuf:"varint,2,opt,name=limit_per_block,json=limitPerBlock,proto3" json:"limit_per_block,omitempty"`
+}
+
+func (m *DisabledMsg) Reset()         { *m = DisabledMsg{} }
+func (m *DisabledMsg) String() string { return proto.CompactTextString(m) }
+func (*DisabledMsg) ProtoMessage()    {}
+func (*DisabledMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{1}
+}
+func (m *DisabledMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisabledMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisabledMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisabledMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisabledMsg.Merge(m, src)
+}
+func (m *DisabledMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisabledMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisabledMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisabledMsg proto.InternalMessageInfo
+
+func (m *DisabledMsg) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *DisabledMsg) GetLimitPerBlock() uint64 {
+	if m != nil {
+		return m.LimitPerBlock
+	}
+	return 0
+}
+
+// Params defines the parameters of the circuit module.
+type Params struct {
+	// max_trips_per_window is the maximum number of circuit breaker trips an
+	// account can make within trip_window blocks. A value of 0 disables the
+	// limit. The module authority and LEVEL_SUPER_ADMIN accounts are exempt.
+	MaxTripsPerWindow uint64 `protobuf:"varint,1,opt,name=max_trips_per_window,json=maxTripsPerWindow,proto3" json:"max_trips_per_window,omitempty"`
+	// trip_window is the length, in blocks, of the sliding window over which the
+	// trips of an account are counted.
+	TripWindow uint64 `protobuf:"varint,2,opt,name=trip_window,json=tripWindow,proto3" json:"trip_window,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxTripsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxTripsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetTripWindow() uint64 {
+	if m != nil {
+		return m.TripWindow
+	}
+	return 0
+}
+
+// TripCounter records the recent circuit breaker trips of an account.
+type TripCounter struct {
+	// trip_heights are the block heights of the trips made by the account within
+	// the current window, in increasing order.
+	TripHeights []int64 `protobuf:"varint,1,rep,packed,name=trip_heights,json=tripHeights,proto3" json:"trip_heights,omitempty"`
+}
+
+func (m *TripCounter) Reset()         { *m = TripCounter{} }
+func (m *TripCounter) String() string { return proto.CompactTextString(m) }
+func (*TripCounter) ProtoMessage()    {}
+func (*TripCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{3}
+}
+func (m *TripCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TripCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripCounter.Merge(m, src)
+}
+func (m *TripCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripCounter proto.InternalMessageInfo
+
+func (m *TripCounter) GetTripHeights() []int64 {
+	if m != nil {
+		return m.TripHeights
+	}
+	return nil
+}
+
+// GenesisTripCounter is the trip counter of an account in genesis.
+type GenesisTripCounter struct {
+	Address string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Counter TripCounter `protobuf:"bytes,2,opt,name=counter,proto3" json:"counter"`
+}
+
+func (m *GenesisTripCounter) Reset()         { *m = GenesisTripCounter{} }
+func (m *GenesisTripCounter) String() string { return proto.CompactTextString(m) }
+func (*GenesisTripCounter) ProtoMessage()    {}
+func (*GenesisTripCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{4}
+}
+func (m *GenesisTripCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisTripCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisTripCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisTripCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisTripCounter.Merge(m, src)
+}
+func (m *GenesisTripCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisTripCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisTripCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisTripCounter proto.InternalMessageInfo
+
+func (m *GenesisTripCounter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisTripCounter) GetCounter() TripCounter {
+	if m != nil {
+		return m.Counter
+	}
+	return TripCounter{}
+}
+
 // GenesisAccountPermissions is the account permissions for the circuit breaker in genesis
 type GenesisAccountPermissions struct {
 	Address     string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -132,7 +348,7 @@ func (m *GenesisAccountPermissions) Reset()         { *m = GenesisAccountPermiss
 func (m *GenesisAccountPermissions) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccountPermissions) ProtoMessage()    {}
 func (*GenesisAccountPermissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f5fe523f8a09dbc, []int{1}
+	return fileDescriptor_1f5fe523f8a09dbc, []int{5}
 }
 func (m *GenesisAccountPermissions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,13 +395,23 @@ func (m *GenesisAccountPermissions) GetPermissions() *Permissions {
 type GenesisState struct {
 	AccountPermissions []*GenesisAccountPermissions `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
 	DisabledTypeUrls   []string                     `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+	// rate_limited_msgs are the Msg type URLs whose processing is throttled to
+	// a non-zero number of executions per block.
+	RateLimitedMsgs []*DisabledMsg `protobuf:"bytes,3,rep,name=rate_limited_msgs,json=rateLimitedMsgs,proto3" json:"rate_limited_msgs,omitempty"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// trip_counters are the in-flight trip counters of the accounts subject to
+	// trip rate limiting.
+	TripCounters []GenesisTripCounter `protobuf:"bytes,5,rep,name=trip_counters,json=tripCounters,proto3" json:"trip_counters"`
+	// disabled_query_routes are the gRPC query routes which are not served.
+	DisabledQueryRoutes []string `protobuf:"bytes,6,rep,name=disabled_query_routes,json=disabledQueryRoutes,proto3" json:"disabled_query_routes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+	return fileDescriptor_1f5fe523f8a09dbc, []int{6}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,9 +454,41 @@ func (m *GenesisState) GetDisabledTypeUrls() []string {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimitedMsgs() []*DisabledMsg {
+	if m != nil {
+		return m.RateLimitedMsgs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTripCounters() []GenesisTripCounter {
+	if m != nil {
+		return m.TripCounters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisabledQueryRoutes() []string {
+	if m != nil {
+		return m.DisabledQueryRoutes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.circuit.v1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
 	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1.Permissions")
+	proto.RegisterType((*DisabledMsg)(nil), "cosmos.circuit.v1.DisabledMsg")
+	proto.RegisterType((*Params)(nil), "cosmos.circuit.v1.Params")
+	proto.RegisterType((*TripCounter)(nil), "cosmos.circuit.v1.TripCounter")
+	proto.RegisterType((*GenesisTripCounter)(nil), "cosmos.circuit.v1.GenesisTripCounter")
 	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1.GenesisAccountPermissions")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1.GenesisState")
 }
@@ -238,32 +496,48 @@ func init() {
 func init() { proto.RegisterFile("cosmos/circuit/v1/types.proto", fileDescriptor_1f5fe523f8a09dbc) }
 
 var fileDescriptor_1f5fe523f8a09dbc = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x4f, 0xea, 0x40,
-	0x18, 0xec, 0x42, 0x78, 0x2f, 0x6c, 0xdf, 0x83, 0xb2, 0x44, 0x53, 0x8d, 0x36, 0x4d, 0x63, 0x4c,
-	0x0f, 0xa4, 0x0d, 0x98, 0x78, 0xf0, 0x24, 0x4a, 0x25, 0x24, 0xa5, 0x90, 0x56, 0x3c, 0x98, 0x98,
-	0xa6, 0xb4, 0x7b, 0xd8, 0x58, 0x68, 0xd3, 0x2d, 0x28, 0xff, 0xc2, 0xbb, 0x7f, 0xc8, 0x23, 0x27,
-	0xe3, 0xd1, 0xc0, 0x1f, 0x31, 0xb4, 0xa0, 0x4d, 0x50, 0x8f, 0xfb, 0xcd, 0xcc, 0x7e, 0xdf, 0x4c,
-	0x06, 0x1e, 0xba, 0x01, 0x1d, 0x05, 0x54, 0x75, 0x49, 0xe4, 0x4e, 0x48, 0xac, 0x4e, 0xeb, 0x6a,
-	0x3c, 0x0b, 0x31, 0x55, 0xc2, 0x28, 0x88, 0x03, 0x54, 0x49, 0x61, 0x65, 0x0d, 0x2b, 0xd3, 0xba,
-	0xf4, 0x0a, 0x20, 0xdb, 0xc7, 0xd1, 0x88, 0x50, 0x4a, 0x82, 0x31, 0x45, 0x67, 0xb0, 0xe0, 0xe3,
-	0x29, 0xf6, 0x79, 0x20, 0x02, 0xb9, 0xd4, 0x38, 0x52, 0xb6, 0x24, 0x4a, 0x86, 0xae, 0xe8, 0x2b,
-	0xae, 0x99, 0x4a, 0xd0, 0x31, 0x2c, 0xfb, 0x64, 0x44, 0x62, 0x7b, 0xb5, 0xd3, 0x9e, 0x44, 0x3e,
-	0xe5, 0x73, 0x62, 0x5e, 0x2e, 0x9a, 0xff, 0x93, 0xf1, 0xf5, 0x2c, 0xc4, 0x83, 0xc8, 0xa7, 0x92,
-	0x0b, 0x0b, 0x89, 0x0e, 0xed, 0xc3, 0x5d, 0x5d, 0xbb, 0xd1, 0x74, 0xdb, 0xe8, 0x19, 0x9a, 0x3d,
-	0x30, 0xac, 0xbe, 0x76, 0xd9, 0xb9, 0xea, 0x68, 0x2d, 0x8e, 0x41, 0x55, 0x58, 0x4e, 0x31, 0xab,
-	0xd7, 0xd5, 0xec, 0xae, 0xd5, 0xb6, 0x38, 0x80, 0x10, 0x2c, 0xa5, 0xc3, 0xa6, 0xae, 0xa7, 0xb3,
-	0x1c, 0xda, 0x81, 0x95, 0x35, 0x71, 0xd0, 0xd7, 0x4c, 0xbb, 0xd9, 0xea, 0x76, 0x0c, 0x2e, 0x2f,
-	0x3d, 0xc0, 0xbd, 0x36, 0x1e, 0x63, 0x4a, 0x68, 0xd3, 0x75, 0x83, 0xc9, 0x38, 0xce, 0xba, 0xe4,
-	0xe1, 0x5f, 0xc7, 0xf3, 0x22, 0x4c, 0x69, 0xe2, 0xb3, 0x68, 0x6e, 0x9e, 0xe8, 0x1c, 0xb2, 0xe1,
-	0x17, 0x91, 0xcf, 0x89, 0x40, 0x66, 0x1b, 0xc2, 0xef, 0x29, 0x98, 0x59, 0x89, 0xf4, 0x0c, 0xe0,
-	0xbf, 0xf5, 0x66, 0x2b, 0x76, 0x62, 0x8c, 0xee, 0x60, 0xd5, 0x49, 0x4f, 0xb0, 0xb3, 0x5f, 0x03,
-	0x31, 0x2f, 0xb3, 0x8d, 0xda, 0x37, 0x5f, 0xff, 0x78, 0xb7, 0x89, 0x9c, 0x6d, 0x2f, 0x35, 0x88,
-	0x3c, 0x42, 0x9d, 0xa1, 0x8f, 0xbd, 0xad, 0xe0, 0xb9, 0x0d, 0xb2, 0xc9, 0xfe, 0xe2, 0xf4, 0x65,
-	0x21, 0x80, 0xf9, 0x42, 0x00, 0xef, 0x0b, 0x01, 0x3c, 0x2d, 0x05, 0x66, 0xbe, 0x14, 0x98, 0xb7,
-	0xa5, 0xc0, 0xdc, 0x1e, 0xa4, 0x87, 0x50, 0xef, 0x5e, 0x21, 0x81, 0xfa, 0xf8, 0xd9, 0xa1, 0xa4,
-	0x40, 0xc3, 0x3f, 0x49, 0x83, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xff, 0xdc, 0xa4,
-	0x62, 0x02, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x71, 0x08, 0xe2, 0x3a, 0x40, 0x32, 0xc0, 0x27, 0x83, 0xbe, 0x9a, 0xd4, 0x6a, 0xab,
+	0x2c, 0x90, 0x53, 0x52, 0xa9, 0x95, 0xba, 0xa8, 0xca, 0x8f, 0x4b, 0xa9, 0x92, 0xe0, 0x3a, 0x50,
+	0x24, 0xa4, 0xca, 0x32, 0xf6, 0xc8, 0x58, 0xd8, 0xb1, 0x3b, 0xd7, 0xe1, 0xe7, 0x2d, 0xfa, 0x14,
+	0x7d, 0x16, 0x96, 0xac, 0xaa, 0xae, 0xaa, 0x0a, 0x5e, 0xa4, 0xf2, 0xd8, 0x01, 0x4b, 0x21, 0xdd,
+	0xd9, 0xf7, 0x9c, 0x73, 0xef, 0x99, 0x3b, 0x47, 0x03, 0x4f, 0x9c, 0x08, 0xc3, 0x08, 0x5b, 0x8e,
+	0xcf, 0x9c, 0xa1, 0x9f, 0xb4, 0xce, 0x37, 0x5a, 0xc9, 0x55, 0x4c, 0x51, 0x8b, 0x59, 0x94, 0x44,
+	0xa4, 0x9e, 0xc1, 0x5a, 0x0e, 0x6b, 0xe7, 0x1b, 0xab, 0x4b, 0x5e, 0xe4, 0x45, 0x1c, 0x6d, 0xa5,
+	0x5f, 0x19, 0x51, 0xfd, 0x29, 0x80, 0x64, 0x50, 0x16, 0xfa, 0x88, 0x7e, 0x34, 0x40, 0xf2, 0x16,
+	0xa6, 0x03, 0x7a, 0x4e, 0x03, 0x59, 0x68, 0x08, 0xcd, 0xf9, 0xf6, 0x33, 0x6d, 0xac, 0x91, 0x56,
+	0xa0, 0x6b, 0x9d, 0x94, 0x6b, 0x66, 0x12, 0xf2, 0x02, 0x16, 0x02, 0x3f, 0xf4, 0x13, 0x2b, 0x75,
+	0x62, 0x0d, 0x59, 0x80, 0xf2, 0x54, 0x43, 0x6c, 0xce, 0x9a, 0x73, 0xbc, 0x7c, 0x70, 0x15, 0xd3,
+	0x43, 0x16, 0xa0, 0xea, 0xc0, 0x34, 0xd7, 0x91, 0x55, 0xf8, 0xaf, 0xa3, 0x7f, 0xd1, 0x3b, 0x56,
+	0x6f, 0xbf, 0xa7, 0x5b, 0x87, 0xbd, 0xbe, 0xa1, 0x6f, 0xef, 0x7d, 0xd8, 0xd3, 0x77, 0x6a, 0x25,
+	0xb2, 0x08, 0x0b, 0x19, 0xd6, 0xdf, 0xef, 0xea, 0x56, 0xb7, 0xbf, 0xdb, 0xaf, 0x09, 0x84, 0xc0,
+	0x7c, 0x56, 0xdc, 0xec, 0x74, 0xb2, 0xda, 0x14, 0x59, 0x86, 0x7a, 0x4e, 0x3c, 0x34, 0x74, 0xd3,
+	0xda, 0xdc, 0xe9, 0xee, 0xf5, 0x6a, 0xa2, 0x7a, 0x04, 0xd2, 0x8e, 0x8f, 0xf6, 0x49, 0x40, 0xdd,
+	0x2e, 0x7a, 0xa4, 0x01, 0xd5, 0x10, 0xbd, 0x7b, 0x67, 0xfc, 0x78, 0xb3, 0x26, 0x84, 0xe8, 0xe5,
+	0xb6, 0x1e, 0xdc, 0xc7, 0x94, 0x59, 0x27, 0x41, 0xe4, 0x9c, 0xc9, 0x53, 0x0d, 0xa1, 0x59, 0xce,
+	0xdd, 0x1b, 0x94, 0x6d, 0xa5, 0x45, 0xf5, 0x18, 0x2a, 0x86, 0xcd, 0xec, 0x10, 0x49, 0x0b, 0x96,
+	0x42, 0xfb, 0xd2, 0x4a, 0x98, 0x1f, 0x23, 0x57, 0x5d, 0xf8, 0x03, 0x37, 0xba, 0xe0, 0xbd, 0xcb,
+	0x66, 0x3d, 0xb4, 0x2f, 0x0f, 0x52, 0xc8, 0xa0, 0xec, 0x88, 0x03, 0x64, 0x0d, 0xa4, 0x94, 0x3c,
+	0xe2, 0x65, 0xed, 0x21, 0x2d, 0x65, 0x04, 0xf5, 0x25, 0x48, 0xa9, 0x64, 0x3b, 0x1a, 0x0e, 0x12,
+	0xca, 0xc8, 0x53, 0xa8, 0x72, 0xfe, 0x29, 0xf5, 0xbd, 0xd3, 0x04, 0x65, 0xa1, 0x21, 0x36, 0x45,
+	0x93, 0xf7, 0xf8, 0x98, 0x95, 0xd4, 0x01, 0x90, 0x5d, 0x3a, 0xa0, 0xe8, 0x63, 0x51, 0x28, 0xc3,
+	0x8c, 0xed, 0xba, 0x8c, 0x22, 0xe6, 0x07, 0x1d, 0xfd, 0x92, 0x77, 0x30, 0xe3, 0x64, 0x24, 0x3e,
+	0x5e, 0x6a, 0x2b, 0x8f, 0xdc, 0x70, 0xa1, 0xd5, 0x56, 0xf9, 0xfa, 0xf7, 0x5a, 0xc9, 0x1c, 0x89,
+	0xd4, 0x0b, 0x58, 0xc9, 0xe7, 0x6d, 0x3a, 0xbc, 0x56, 0x0c, 0xcf, 0xe4, 0xb1, 0xef, 0x41, 0x8a,
+	0x1f, 0x88, 0xff, 0x18, 0x5d, 0x68, 0x67, 0x16, 0x25, 0xea, 0x0f, 0x11, 0xaa, 0xf9, 0xe4, 0x7e,
+	0x62, 0x27, 0x94, 0x7c, 0x85, 0x45, 0x3b, 0xb3, 0x60, 0x15, 0x5b, 0xa7, 0x3b, 0x92, 0xda, 0xeb,
+	0x8f, 0xb4, 0x9e, 0xe8, 0xdb, 0x24, 0xf6, 0xf8, 0x59, 0xd6, 0x81, 0xb8, 0x79, 0x7e, 0xc6, 0xf2,
+	0x5c, 0x1b, 0x21, 0xa3, 0x48, 0x93, 0x4f, 0x50, 0x67, 0x76, 0x42, 0x2d, 0x1e, 0x15, 0xea, 0x5a,
+	0x21, 0x7a, 0x28, 0x8b, 0x0d, 0x71, 0xc2, 0x29, 0x0b, 0xc9, 0x34, 0x17, 0x52, 0x61, 0x27, 0xd3,
+	0x75, 0xd1, 0x43, 0xf2, 0x06, 0x2a, 0x31, 0x0f, 0x98, 0x5c, 0xe6, 0x6b, 0x5a, 0x79, 0x6c, 0x4d,
+	0x9c, 0x90, 0x5f, 0x4e, 0x4e, 0x27, 0x06, 0xcc, 0xf1, 0xb8, 0xe4, 0x77, 0x85, 0xf2, 0x34, 0x37,
+	0xf0, 0x7c, 0xf2, 0x2e, 0xc6, 0x2f, 0xba, 0x9a, 0x3c, 0x94, 0x90, 0xb4, 0x61, 0xf9, 0x7e, 0x09,
+	0xdf, 0x86, 0x94, 0x5d, 0x59, 0x2c, 0x1a, 0x26, 0x14, 0xe5, 0x0a, 0xdf, 0xc3, 0xe2, 0x08, 0xfc,
+	0x9c, 0x62, 0x26, 0x87, 0xb6, 0x5e, 0x5f, 0xdf, 0x2a, 0xc2, 0xcd, 0xad, 0x22, 0xfc, 0xb9, 0x55,
+	0x84, 0xef, 0x77, 0x4a, 0xe9, 0xe6, 0x4e, 0x29, 0xfd, 0xba, 0x53, 0x4a, 0xc7, 0xff, 0x67, 0x3e,
+	0xd0, 0x3d, 0xd3, 0xfc, 0xa8, 0x75, 0x79, 0xff, 0x76, 0xf1, 0x87, 0xeb, 0xa4, 0xc2, 0x1f, 0xa4,
+	0x57, 0x7f, 0x07, 0x00, 0xc4, 0x1c, 0x8e, 0xc1, 0xda, 0x04, 0x00, 0x00,
 }
 
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -303,6 +577,156 @@ func (m *Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DisabledMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisabledMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisabledMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LimitPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LimitPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TripWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TripWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTripsPerWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTripsPerWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TripCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TripHeights) > 0 {
+		dAtA2 := make([]byte, len(m.TripHeights)*10)
+		var j1 int
+		for _, num1 := range m.TripHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisTripCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisTripCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisTripCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisAccountPermissions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -365,19 +789,19 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.DisabledTypeUrls) > 0 {
-		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DisabledTypeUrls[iNdEx])
-			copy(dAtA[i:], m.DisabledTypeUrls[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledTypeUrls[iNdEx])))
+	if len(m.DisabledQueryRoutes) > 0 {
+		for iNdEx := len(m.DisabledQueryRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledQueryRoutes[iNdEx])
+			copy(dAtA[i:], m.DisabledQueryRoutes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledQueryRoutes[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.AccountPermissions) > 0 {
-		for iNdEx := len(m.AccountPermissions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TripCounters) > 0 {
+		for iNdEx := len(m.TripCounters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AccountPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TripCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -385,11 +809,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
 		}
 	}
-	return len(dAtA) - i, nil
-}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RateLimitedMsgs) > 0 {
+		for iNdEx := len(m.RateLimitedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DisabledTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccountPermissions) > 0 {
+		for iNdEx := len(m.AccountPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
 
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
@@ -417,54 +888,552 @@ func (m *Permissions) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *DisabledMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LimitPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.LimitPerBlock))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTripsPerWindow != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTripsPerWindow))
+	}
+	if m.TripWindow != 0 {
+		n += 1 + sovTypes(uint64(m.TripWindow))
+	}
+	return n
+}
+
+func (m *TripCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TripHeights) > 0 {
+		l = 0
+		for _, e := range m.TripHeights {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GenesisTripCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Counter.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GenesisAccountPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountPermissions) > 0 {
+		for _, e := range m.AccountPermissions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledTypeUrls) > 0 {
+		for _, s := range m.DisabledTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RateLimitedMsgs) > 0 {
+		for _, e := range m.RateLimitedMsgs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.TripCounters) > 0 {
+		for _, e := range m.TripCounters {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledQueryRoutes) > 0 {
+		for _, s := range m.DisabledQueryRoutes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Permissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= Permissions_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitTypeUrls = append(m.LimitTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisabledMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisabledMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisabledMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPerBlock", wireType)
+			}
+			m.LimitPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTripsPerWindow", wireType)
+			}
+			m.MaxTripsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTripsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripWindow", wireType)
+			}
+			m.TripWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TripWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GenesisAccountPermissions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AccountPermissions) > 0 {
-		for _, e := range m.AccountPermissions {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+func (m *TripCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.DisabledTypeUrls) > 0 {
-		for _, s := range m.DisabledTypeUrls {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TripCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TripCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TripHeights = append(m.TripHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TripHeights) == 0 {
+					m.TripHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TripHeights = append(m.TripHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripHeights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Permissions) Unmarshal(dAtA []byte) error {
+func (m *GenesisTripCounter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -487,17 +1456,17 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisTripCounter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisTripCounter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Level = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -507,16 +1476,29 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= Permissions_Level(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitTypeUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -526,23 +1508,24 @@ func (m *Permissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitTypeUrls = append(m.LimitTypeUrls, string(dAtA[iNdEx:postIndex]))
+			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -778,6 +1761,139 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitedMsgs = append(m.RateLimitedMsgs, &DisabledMsg{})
+			if err := m.RateLimitedMsgs[len(m.RateLimitedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TripCounters = append(m.TripCounters, GenesisTripCounter{})
+			if err := m.TripCounters[len(m.TripCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledQueryRoutes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledQueryRoutes = append(m.DisabledQueryRoutes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11,6 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
+// FlagHalt defines whether MsgVerifyAllInvariants halts the chain when an invariant is broken.
+const FlagHalt = "halt"
+
 // NewTxCmd returns a root CLI command handler for all x/crisis transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -21,7 +24,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewMsgVerifyInvariantTxCmd())
+	txCmd.AddCommand(
+		NewMsgVerifyInvariantTxCmd(),
+		NewMsgVerifyAllInvariantsTxCmd(),
+	)
 
 	return txCmd
 }
@@ -58,3 +64,35 @@ func NewMsgVerifyInvariantTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewMsgVerifyAllInvariantsTxCmd returns a CLI command handler for creating a
+// MsgVerifyAllInvariants transaction.
+func NewMsgVerifyAllInvariantsTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-all-invariants",
+		Short: "Verify all the registered invariants and report their results",
+		Long: `Verify all the registered invariants and report, for each of them, whether it is broken,
+its message, duration and gas consumption. With --halt, the chain halts when an invariant is broken.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			halt, err := cmd.Flags().GetBool(FlagHalt)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVerifyAllInvariants(clientCtx.GetFromAddress(), halt)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagHalt, false, "Halt the chain when an invariant is broken")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	*Keeper
+}
+
+// NewQuerier returns a new Querier instance.
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Invariants implements the Query/Invariants gRPC method.
+func (k Querier) Invariants(_ context.Context, _ *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	invariants := make([]types.ModuleInvariants, 0)
+	index := make(map[string]int)
+	for _, invarRoute := range k.Routes() {
+		i, ok := index[invarRoute.ModuleName]
+		if !ok {
+			i = len(invariants)
+			index[invarRoute.ModuleName] = i
+			invariants = append(invariants, types.ModuleInvariants{ModuleName: invarRoute.ModuleName})
+		}
+
+		invariants[i].Routes = append(invariants[i].Routes, invarRoute.Route)
+	}
+
+	return &types.QueryInvariantsResponse{Invariants: invariants}, nil
+}
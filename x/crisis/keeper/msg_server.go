@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -70,6 +73,76 @@ func (k *Keeper) VerifyInvariant(goCtx context.Context, msg *types.MsgVerifyInva
 	return &types.MsgVerifyInvariantResponse{}, nil
 }
 
+// VerifyAllInvariants implements MsgServer.VerifyAllInvariants method.
+// It defines a method to verify all the registered invariants, reporting the result,
+// duration and gas consumption of each of them. The constant fee is charged once.
+func (k *Keeper) VerifyAllInvariants(goCtx context.Context, msg *types.MsgVerifyAllInvariants) (*types.MsgVerifyAllInvariantsResponse, error) {
+	if msg.Sender == "" {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap("empty address string is not allowed")
+	}
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	constantFee := sdk.NewCoins(k.GetConstantFee(ctx))
+
+	if err := k.SendCoinsFromAccountToFeeCollector(ctx, sender, constantFee); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.InvariantResult, 0, len(k.Routes()))
+	for _, invarRoute := range k.Routes() {
+		// use a cached context with its own gas meter to avoid gas costs during invariants,
+		// while still measuring the gas each of them consumes
+		gasMeter := storetypes.NewInfiniteGasMeter()
+		cacheCtx, _ := ctx.WithGasMeter(gasMeter).CacheContext()
+
+		start := time.Now()
+		res, stop := invarRoute.Invar(cacheCtx)
+
+		// wall-clock time is only reported outside of block execution, as it would otherwise
+		// make the transaction result non-deterministic
+		var duration time.Duration
+		if ctx.IsCheckTx() {
+			duration = time.Since(start)
+		}
+
+		if stop && msg.Halt {
+			// Currently, because the chain halts here, this transaction will never be included in the
+			// blockchain thus the constant fee will have never been deducted. Thus no refund is required.
+
+			// TODO replace with circuit breaker
+			panic(res)
+		}
+
+		results = append(results, types.InvariantResult{
+			InvariantModuleName: invarRoute.ModuleName,
+			InvariantRoute:      invarRoute.Route,
+			Broken:              stop,
+			Message:             res,
+			Duration:            duration,
+			GasUsed:             gasMeter.GasConsumed(),
+		})
+	}
+
+	events := make(sdk.Events, 0, len(results))
+	for _, result := range results {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeInvariantResult,
+			sdk.NewAttribute(types.AttributeKeyRoute, result.InvariantModuleName+"/"+result.InvariantRoute),
+			sdk.NewAttribute(types.AttributeKeyBroken, strconv.FormatBool(result.Broken)),
+			sdk.NewAttribute(types.AttributeKeyMessage, result.Message),
+			sdk.NewAttribute(types.AttributeKeyDuration, result.Duration.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(result.GasUsed, 10)),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgVerifyAllInvariantsResponse{Results: results}, nil
+}
+
 // UpdateParams implements MsgServer.UpdateParams method.
 // It defines a method to update the x/crisis module parameters.
 func (k *Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -119,6 +119,82 @@ func (s *KeeperTestSuite) TestMsgVerifyInvariant() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgVerifyAllInvariants() {
+	constantFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	err := s.keeper.SetConstantFee(s.ctx, constantFee)
+	s.Require().NoError(err)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	sender := testutil.CreateKeyringAccounts(s.T(), kr, 1)[0]
+
+	s.keeper.RegisterRoute("bank", "total-supply", crisistestutil.PassingInvariant)
+	s.keeper.RegisterRoute("testutil", "broken", crisistestutil.BrokenInvariant)
+
+	// invalid senders are rejected before charging the fee
+	_, err = s.keeper.VerifyAllInvariants(s.ctx, &types.MsgVerifyAllInvariants{Sender: ""})
+	s.Require().ErrorContains(err, "empty address string is not allowed")
+	_, err = s.keeper.VerifyAllInvariants(s.ctx, &types.MsgVerifyAllInvariants{Sender: "invalid address"})
+	s.Require().ErrorContains(err, "decoding bech32 failed")
+
+	// the constant fee is charged once for all the invariants
+	s.supplyKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sender.Address, gomock.Any(), sdk.NewCoins(constantFee)).Return(nil).Times(1)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	res, err := s.keeper.VerifyAllInvariants(ctx, types.NewMsgVerifyAllInvariants(sender.Address, false))
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 2)
+
+	s.Require().Equal("bank", res.Results[0].InvariantModuleName)
+	s.Require().Equal("total-supply", res.Results[0].InvariantRoute)
+	s.Require().False(res.Results[0].Broken)
+	s.Require().Equal("testutil", res.Results[1].InvariantModuleName)
+	s.Require().Equal("broken", res.Results[1].InvariantRoute)
+	s.Require().True(res.Results[1].Broken)
+	s.Require().Contains(res.Results[1].Message, "invariant deliberately broken")
+
+	for _, result := range res.Results {
+		s.Require().Equal(uint64(crisistestutil.InvariantGas), result.GasUsed)
+		// the duration is not measured when delivering the message
+		s.Require().Zero(result.Duration)
+	}
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 2)
+	s.Require().Equal(types.EventTypeInvariantResult, events[1].Type)
+	attr, ok := events[1].GetAttribute(types.AttributeKeyRoute)
+	s.Require().True(ok)
+	s.Require().Equal("testutil/broken", attr.Value)
+	attr, ok = events[1].GetAttribute(types.AttributeKeyBroken)
+	s.Require().True(ok)
+	s.Require().Equal("true", attr.Value)
+
+	// the duration is measured when checking or simulating the message
+	s.supplyKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	res, err = s.keeper.VerifyAllInvariants(s.ctx.WithIsCheckTx(true), types.NewMsgVerifyAllInvariants(sender.Address, false))
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 2)
+
+	// with halt, a broken invariant halts the chain
+	s.supplyKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.Require().Panics(func() {
+		_, _ = s.keeper.VerifyAllInvariants(s.ctx, types.NewMsgVerifyAllInvariants(sender.Address, true))
+	})
+}
+
+func (s *KeeperTestSuite) TestQueryInvariants() {
+	s.keeper.RegisterRoute("bank", "total-supply", crisistestutil.PassingInvariant)
+	s.keeper.RegisterRoute("testutil", "broken", crisistestutil.BrokenInvariant)
+	s.keeper.RegisterRoute("bank", "nonnegative-outstanding", crisistestutil.PassingInvariant)
+
+	res, err := keeper.NewQuerier(s.keeper).Invariants(s.ctx, &types.QueryInvariantsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ModuleInvariants{
+		{ModuleName: "bank", Routes: []string{"total-supply", "nonnegative-outstanding"}},
+		{ModuleName: "testutil", Routes: []string{"broken"}},
+	}, res.Invariants)
+}
+
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	// default params
 	constantFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
@@ -0,0 +1,20 @@
+package testutil
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvariantGas is the gas consumed by the invariants of this package.
+const InvariantGas = 1000
+
+// PassingInvariant is an invariant which always holds. It consumes InvariantGas.
+func PassingInvariant(ctx sdk.Context) (string, bool) {
+	ctx.GasMeter().ConsumeGas(InvariantGas, "passing invariant")
+	return sdk.FormatInvariant("testutil", "passing", "invariant holds"), false
+}
+
+// BrokenInvariant is an invariant which is deliberately always broken. It consumes InvariantGas.
+func BrokenInvariant(ctx sdk.Context) (string, bool) {
+	ctx.GasMeter().ConsumeGas(InvariantGas, "broken invariant")
+	return sdk.FormatInvariant("testutil", "broken", "invariant deliberately broken"), true
+}
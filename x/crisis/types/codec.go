@@ -17,6 +17,7 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVerifyInvariant{}, "cosmos-sdk/MsgVerifyInvariant")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/crisis/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgVerifyAllInvariants{}, "cosmos-sdk/MsgVerifyAllInvariants")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -24,6 +25,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgVerifyInvariant{},
 		&MsgUpdateParams{},
+		&MsgVerifyAllInvariants{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -2,7 +2,12 @@ package types
 
 // crisis module event types
 const (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant       = "invariant"
+	EventTypeInvariantResult = "invariant_result"
 
-	AttributeKeyRoute = "route"
+	AttributeKeyRoute    = "route"
+	AttributeKeyBroken   = "broken"
+	AttributeKeyMessage  = "message"
+	AttributeKeyDuration = "duration"
+	AttributeKeyGasUsed  = "gas_used"
 )
@@ -7,8 +7,8 @@ import (
 
 // ensure Msg interface compliance at compile time
 var (
-	_, _ sdk.Msg            = &MsgVerifyInvariant{}, &MsgUpdateParams{}
-	_, _ legacytx.LegacyMsg = &MsgVerifyInvariant{}, &MsgUpdateParams{}
+	_, _, _ sdk.Msg            = &MsgVerifyInvariant{}, &MsgUpdateParams{}, &MsgVerifyAllInvariants{}
+	_, _, _ legacytx.LegacyMsg = &MsgVerifyInvariant{}, &MsgUpdateParams{}, &MsgVerifyAllInvariants{}
 )
 
 // NewMsgVerifyInvariant creates a new MsgVerifyInvariant object
@@ -50,3 +50,24 @@ func (msg MsgUpdateParams) GetSignBytes() []byte {
 	bz := aminoCdc.MustMarshalJSON(&msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// NewMsgVerifyAllInvariants creates a new MsgVerifyAllInvariants object
+func NewMsgVerifyAllInvariants(sender sdk.AccAddress, halt bool) *MsgVerifyAllInvariants {
+	return &MsgVerifyAllInvariants{
+		Sender: sender.String(),
+		Halt:   halt,
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgVerifyAllInvariants) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgVerifyAllInvariants
+func (msg MsgVerifyAllInvariants) GetSignBytes() []byte {
+	bz := aminoCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
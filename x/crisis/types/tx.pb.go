@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -209,49 +213,259 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgVerifyAllInvariants represents a message to verify all the registered invariants.
+type MsgVerifyAllInvariants struct {
+	// sender is the account address of private key to send coins to fee collector account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// halt defines whether the chain halts when an invariant is broken, as for MsgVerifyInvariant.
+	// Otherwise, the results of the invariants are returned and emitted as events.
+	Halt bool `protobuf:"varint,2,opt,name=halt,proto3" json:"halt,omitempty"`
+}
+
+func (m *MsgVerifyAllInvariants) Reset()         { *m = MsgVerifyAllInvariants{} }
+func (m *MsgVerifyAllInvariants) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyAllInvariants) ProtoMessage()    {}
+func (*MsgVerifyAllInvariants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61276163172fe867, []int{4}
+}
+func (m *MsgVerifyAllInvariants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyAllInvariants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyAllInvariants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyAllInvariants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyAllInvariants.Merge(m, src)
+}
+func (m *MsgVerifyAllInvariants) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyAllInvariants) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyAllInvariants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyAllInvariants proto.InternalMessageInfo
+
+func (m *MsgVerifyAllInvariants) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgVerifyAllInvariants) GetHalt() bool {
+	if m != nil {
+		return m.Halt
+	}
+	return false
+}
+
+// MsgVerifyAllInvariantsResponse defines the Msg/VerifyAllInvariants response type.
+type MsgVerifyAllInvariantsResponse struct {
+	// results are the results of the invariants, in registration order.
+	Results []InvariantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgVerifyAllInvariantsResponse) Reset()         { *m = MsgVerifyAllInvariantsResponse{} }
+func (m *MsgVerifyAllInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifyAllInvariantsResponse) ProtoMessage()    {}
+func (*MsgVerifyAllInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61276163172fe867, []int{5}
+}
+func (m *MsgVerifyAllInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifyAllInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifyAllInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifyAllInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifyAllInvariantsResponse.Merge(m, src)
+}
+func (m *MsgVerifyAllInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifyAllInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifyAllInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifyAllInvariantsResponse proto.InternalMessageInfo
+
+func (m *MsgVerifyAllInvariantsResponse) GetResults() []InvariantResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// InvariantResult is the result of the verification of an invariant.
+type InvariantResult struct {
+	// invariant_module_name is the name of the module of the invariant.
+	InvariantModuleName string `protobuf:"bytes,1,opt,name=invariant_module_name,json=invariantModuleName,proto3" json:"invariant_module_name,omitempty"`
+	// invariant_route is the route of the invariant.
+	InvariantRoute string `protobuf:"bytes,2,opt,name=invariant_route,json=invariantRoute,proto3" json:"invariant_route,omitempty"`
+	// broken defines whether the invariant is broken.
+	Broken bool `protobuf:"varint,3,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message is the message returned by the invariant.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// duration is the time the invariant took to run. It is only measured when the message is checked
+	// or simulated, and zero when delivered, as it would make the transaction result non-deterministic.
+	Duration time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration"`
+	// gas_used is the gas the invariant consumed.
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61276163172fe867, []int{6}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetInvariantModuleName() string {
+	if m != nil {
+		return m.InvariantModuleName
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetInvariantRoute() string {
+	if m != nil {
+		return m.InvariantRoute
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *InvariantResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgVerifyInvariant)(nil), "cosmos.crisis.v1beta1.MsgVerifyInvariant")
 	proto.RegisterType((*MsgVerifyInvariantResponse)(nil), "cosmos.crisis.v1beta1.MsgVerifyInvariantResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.crisis.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.crisis.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgVerifyAllInvariants)(nil), "cosmos.crisis.v1beta1.MsgVerifyAllInvariants")
+	proto.RegisterType((*MsgVerifyAllInvariantsResponse)(nil), "cosmos.crisis.v1beta1.MsgVerifyAllInvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "cosmos.crisis.v1beta1.InvariantResult")
 }
 
 func init() { proto.RegisterFile("cosmos/crisis/v1beta1/tx.proto", fileDescriptor_61276163172fe867) }
 
 var fileDescriptor_61276163172fe867 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0xb5, 0x58, 0xc8, 0xb4, 0x18, 0x5c, 0x5b, 0x9a, 0x2c, 0xba, 0x91, 0x08, 0xfe, 0x88,
-	0x74, 0xd7, 0x44, 0xec, 0xa1, 0x37, 0x23, 0x2a, 0x1e, 0x22, 0xb2, 0xa2, 0x07, 0x2f, 0x61, 0xb2,
-	0x3b, 0xd9, 0x0e, 0xba, 0x33, 0x61, 0xde, 0x24, 0x34, 0x37, 0xf1, 0x24, 0x9e, 0xfc, 0x13, 0x7a,
-	0xf4, 0x98, 0x83, 0x7f, 0x44, 0xf1, 0x54, 0x3c, 0x89, 0x07, 0x91, 0x04, 0x89, 0x7f, 0x86, 0xec,
-	0xce, 0x4c, 0x52, 0x93, 0x8a, 0xb9, 0x24, 0xcb, 0xfb, 0xbe, 0xf7, 0xde, 0xf7, 0x7d, 0x33, 0x83,
-	0xbc, 0x88, 0x43, 0xca, 0x21, 0x88, 0x04, 0x05, 0x0a, 0xc1, 0xa0, 0xde, 0x21, 0x12, 0xd7, 0x03,
-	0x79, 0xe8, 0xf7, 0x04, 0x97, 0xdc, 0xd9, 0x56, 0xb8, 0xaf, 0x70, 0x5f, 0xe3, 0xee, 0x56, 0xc2,
-	0x13, 0x9e, 0x33, 0x82, 0xec, 0x4b, 0x91, 0xdd, 0xb2, 0x22, 0xb7, 0x15, 0xa0, 0x3b, 0x15, 0xb4,
-	0xa3, 0xf7, 0xa4, 0x90, 0x04, 0x83, 0x7a, 0xf6, 0xa7, 0x81, 0x8b, 0x38, 0xa5, 0x8c, 0x07, 0xf9,
-	0xaf, 0x2e, 0x19, 0x4d, 0x1d, 0x0c, 0x64, 0xa6, 0x28, 0xe2, 0x94, 0x29, 0xbc, 0xfa, 0xdd, 0x46,
-	0x4e, 0x0b, 0x92, 0x97, 0x44, 0xd0, 0xee, 0xf0, 0x09, 0x1b, 0x60, 0x41, 0x31, 0x93, 0xce, 0x1d,
-	0xb4, 0x0e, 0x84, 0xc5, 0x44, 0x94, 0xec, 0xab, 0xf6, 0xcd, 0x42, 0xb3, 0xf4, 0xf5, 0xf3, 0xee,
-	0x96, 0x16, 0x71, 0x3f, 0x8e, 0x05, 0x01, 0x78, 0x2e, 0x05, 0x65, 0x49, 0xa8, 0x79, 0x4e, 0x03,
-	0x6d, 0x53, 0xd3, 0xde, 0x4e, 0x79, 0xdc, 0x7f, 0x43, 0xda, 0x0c, 0xa7, 0xa4, 0x74, 0x2e, 0x1b,
-	0x10, 0x5e, 0x9a, 0x81, 0xad, 0x1c, 0x7b, 0x8a, 0x53, 0xe2, 0xdc, 0x40, 0xc5, 0x79, 0x8f, 0xe0,
-	0x7d, 0x49, 0x4a, 0x6b, 0x39, 0xfb, 0xc2, 0xac, 0x1c, 0x66, 0xd5, 0xfd, 0x7b, 0xef, 0x8f, 0x2a,
-	0xd6, 0xef, 0xa3, 0x8a, 0xf5, 0x6e, 0x3a, 0xaa, 0xe9, 0x8d, 0x1f, 0xa6, 0xa3, 0xda, 0x15, 0x25,
-	0x69, 0x17, 0xe2, 0xd7, 0xc1, 0xb2, 0x8b, 0xea, 0x65, 0xe4, 0x2e, 0x57, 0x43, 0x02, 0x3d, 0xce,
-	0x80, 0x54, 0xbf, 0xd8, 0xa8, 0xd8, 0x82, 0xe4, 0x45, 0x2f, 0xc6, 0x92, 0x3c, 0xc3, 0x02, 0xa7,
-	0xe0, 0xec, 0xa1, 0x02, 0xee, 0xcb, 0x03, 0x2e, 0xa8, 0x1c, 0xfe, 0xd7, 0xfa, 0x9c, 0xea, 0x3c,
-	0x46, 0x9b, 0x11, 0x67, 0x20, 0x33, 0x23, 0x5d, 0xa2, 0x4c, 0x6f, 0x34, 0xca, 0xbe, 0xee, 0xcb,
-	0xd2, 0x37, 0xe7, 0xed, 0x3f, 0xe0, 0x94, 0x35, 0x0b, 0xc7, 0x3f, 0x2a, 0xd6, 0xa7, 0xe9, 0xa8,
-	0x66, 0x87, 0x1b, 0xa6, 0xf3, 0x11, 0x21, 0xfb, 0x7b, 0x99, 0xc3, 0xf9, 0xe0, 0xcc, 0xe4, 0xb5,
-	0x53, 0x26, 0x0f, 0xcd, 0xe5, 0x5a, 0x10, 0x5e, 0x2d, 0xa3, 0x9d, 0x85, 0x92, 0xf1, 0xd9, 0xf8,
-	0x65, 0xa3, 0xb5, 0x16, 0x24, 0x0e, 0x47, 0xc5, 0xc5, 0x63, 0xbe, 0xe5, 0x9f, 0x79, 0x25, 0xfd,
-	0xe5, 0xd4, 0xdc, 0xfa, 0xca, 0x54, 0xb3, 0xd8, 0xe9, 0xa2, 0xcd, 0xbf, 0xc2, 0xbd, 0xfe, 0xef,
-	0x11, 0xa7, 0x79, 0xae, 0xbf, 0x1a, 0xcf, 0xec, 0x71, 0xcf, 0xbf, 0xcd, 0x72, 0x6c, 0x3e, 0x3c,
-	0x1e, 0x7b, 0xf6, 0xc9, 0xd8, 0xb3, 0x7f, 0x8e, 0x3d, 0xfb, 0xe3, 0xc4, 0xb3, 0x4e, 0x26, 0x9e,
-	0xf5, 0x6d, 0xe2, 0x59, 0xaf, 0x6e, 0x27, 0x54, 0x1e, 0xf4, 0x3b, 0x7e, 0xc4, 0xd3, 0xc0, 0xbc,
-	0xd1, 0x33, 0x32, 0x95, 0xc3, 0x1e, 0x81, 0xce, 0x7a, 0xfe, 0x30, 0xee, 0xfe, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0x2a, 0xe4, 0x45, 0xe4, 0xce, 0x03, 0x00, 0x00,
+	// 707 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x4f, 0x13, 0x5d,
+	0x14, 0xee, 0x14, 0x28, 0xe5, 0xc2, 0xfb, 0x36, 0xef, 0xe5, 0x6b, 0x3a, 0x79, 0x9d, 0x62, 0x4d,
+	0x10, 0x31, 0xcc, 0xd8, 0x1a, 0x58, 0xb0, 0xa3, 0xa2, 0xc6, 0x98, 0x1a, 0x33, 0x06, 0x17, 0x6e,
+	0x9a, 0xdb, 0xce, 0xed, 0x30, 0x61, 0x66, 0x6e, 0x33, 0xe7, 0x0e, 0x81, 0xb8, 0x51, 0x57, 0xc6,
+	0x95, 0xee, 0x5c, 0xb2, 0x74, 0xc9, 0xc2, 0x1f, 0x41, 0x5c, 0x11, 0x57, 0xc6, 0x85, 0x9a, 0xb2,
+	0xc0, 0x9f, 0x61, 0xe6, 0xe3, 0x0e, 0xa5, 0x94, 0x00, 0x6e, 0xda, 0x7b, 0xcf, 0xf3, 0x9c, 0x7b,
+	0xce, 0x73, 0x3e, 0x32, 0x48, 0x6d, 0x31, 0x70, 0x19, 0xe8, 0x2d, 0xdf, 0x06, 0x1b, 0xf4, 0xed,
+	0x4a, 0x93, 0x72, 0x52, 0xd1, 0xf9, 0x8e, 0xd6, 0xf1, 0x19, 0x67, 0x78, 0x3a, 0xc6, 0xb5, 0x18,
+	0xd7, 0x12, 0x5c, 0x99, 0xb2, 0x98, 0xc5, 0x22, 0x86, 0x1e, 0x9e, 0x62, 0xb2, 0x52, 0x8c, 0xc9,
+	0x8d, 0x18, 0x48, 0x3c, 0x63, 0x68, 0x36, 0x89, 0xe3, 0x82, 0xa5, 0x6f, 0x57, 0xc2, 0xbf, 0x04,
+	0xf8, 0x8f, 0xb8, 0xb6, 0xc7, 0xf4, 0xe8, 0x37, 0x31, 0x89, 0x9c, 0x9a, 0x04, 0x68, 0x9a, 0x51,
+	0x8b, 0xd9, 0x9e, 0xc0, 0x2d, 0xc6, 0x2c, 0x87, 0xea, 0xd1, 0xad, 0x19, 0xb4, 0x75, 0x33, 0xf0,
+	0x09, 0xb7, 0x59, 0x82, 0x97, 0xbf, 0x4b, 0x08, 0xd7, 0xc1, 0x7a, 0x4e, 0x7d, 0xbb, 0xbd, 0xfb,
+	0xc8, 0xdb, 0x26, 0xbe, 0x4d, 0x3c, 0x8e, 0xef, 0xa0, 0x1c, 0x50, 0xcf, 0xa4, 0xbe, 0x2c, 0xcd,
+	0x49, 0x0b, 0x63, 0x35, 0xf9, 0xeb, 0xe7, 0xa5, 0xa9, 0x24, 0xc9, 0x35, 0xd3, 0xf4, 0x29, 0xc0,
+	0x33, 0xee, 0xdb, 0x9e, 0x65, 0x24, 0x3c, 0x5c, 0x45, 0xd3, 0xb6, 0x70, 0x6f, 0xb8, 0xcc, 0x0c,
+	0x1c, 0xda, 0xf0, 0x88, 0x4b, 0xe5, 0x6c, 0xf8, 0x80, 0x31, 0x99, 0x82, 0xf5, 0x08, 0x7b, 0x42,
+	0x5c, 0x8a, 0x6f, 0xa2, 0xc2, 0x89, 0x8f, 0xcf, 0x02, 0x4e, 0xe5, 0xa1, 0x88, 0xfd, 0x6f, 0x6a,
+	0x36, 0x42, 0xeb, 0xea, 0xf2, 0xdb, 0xbd, 0x52, 0xe6, 0xf7, 0x5e, 0x29, 0xf3, 0xe6, 0x78, 0x7f,
+	0x31, 0x89, 0xf8, 0xee, 0x78, 0x7f, 0xf1, 0x5a, 0x9c, 0xd2, 0x12, 0x98, 0x5b, 0xfa, 0x59, 0x15,
+	0xe5, 0xff, 0x91, 0x72, 0xd6, 0x6a, 0x50, 0xe8, 0x30, 0x0f, 0x68, 0xf9, 0x8b, 0x84, 0x0a, 0x75,
+	0xb0, 0x36, 0x3a, 0x26, 0xe1, 0xf4, 0x29, 0xf1, 0x89, 0x0b, 0x78, 0x05, 0x8d, 0x91, 0x80, 0x6f,
+	0x32, 0xdf, 0xe6, 0xbb, 0x17, 0x4a, 0x3f, 0xa1, 0xe2, 0x87, 0x68, 0xa2, 0xc5, 0x3c, 0xe0, 0xa1,
+	0x90, 0x36, 0x8d, 0x45, 0x8f, 0x57, 0x8b, 0x5a, 0xe2, 0x17, 0x76, 0x47, 0xcc, 0x83, 0x76, 0x8f,
+	0xd9, 0x5e, 0x6d, 0xec, 0xe0, 0x47, 0x29, 0xf3, 0xe9, 0x78, 0x7f, 0x51, 0x32, 0xc6, 0x85, 0xe7,
+	0x03, 0x4a, 0x57, 0x57, 0x42, 0x85, 0x27, 0x0f, 0x87, 0x22, 0x6f, 0xf4, 0x88, 0xdc, 0x11, 0xc3,
+	0xd7, 0x97, 0x78, 0xb9, 0x88, 0x66, 0xfb, 0x4c, 0xa9, 0xce, 0x0f, 0x12, 0x9a, 0x49, 0xcb, 0xb0,
+	0xe6, 0x38, 0x69, 0x25, 0xe0, 0x2f, 0xda, 0x8c, 0xd1, 0xf0, 0x26, 0x71, 0x78, 0x24, 0x30, 0x6f,
+	0x44, 0xe7, 0xd5, 0x4a, 0x5f, 0x57, 0xae, 0x0f, 0xea, 0xca, 0xa9, 0xc0, 0x65, 0x17, 0xa9, 0x83,
+	0x11, 0x91, 0x35, 0x7e, 0x8c, 0x46, 0x7d, 0x0a, 0x81, 0xc3, 0x41, 0x96, 0xe6, 0x86, 0x16, 0xc6,
+	0xab, 0xf3, 0xda, 0xc0, 0xf5, 0xd2, 0x7a, 0x1b, 0x1b, 0x38, 0xbc, 0xb7, 0xb2, 0xe2, 0x85, 0xf2,
+	0xeb, 0x2c, 0x2a, 0xf4, 0xf1, 0xce, 0x1f, 0x58, 0xe9, 0x4a, 0x03, 0x9b, 0x1d, 0x34, 0xb0, 0x78,
+	0x06, 0xe5, 0x9a, 0x3e, 0xdb, 0xa2, 0x5e, 0x34, 0xd0, 0x79, 0x23, 0xb9, 0x61, 0x19, 0x8d, 0xba,
+	0x14, 0x80, 0x58, 0x54, 0x1e, 0x8e, 0x1c, 0xc5, 0x15, 0xaf, 0xa3, 0xbc, 0x58, 0x4d, 0x79, 0x24,
+	0x99, 0x9e, 0x78, 0x77, 0x35, 0xb1, 0xbb, 0xda, 0x7a, 0x42, 0xa8, 0xfd, 0x13, 0x6a, 0xfc, 0xf8,
+	0xb3, 0x24, 0xc5, 0x3a, 0x53, 0x4f, 0x5c, 0x44, 0x79, 0x8b, 0x40, 0x23, 0x00, 0x6a, 0xca, 0xb9,
+	0x39, 0x69, 0x61, 0xd8, 0x18, 0xb5, 0x08, 0x6c, 0x00, 0x35, 0xab, 0xdd, 0x2c, 0x1a, 0xaa, 0x83,
+	0x85, 0x19, 0x2a, 0xf4, 0x6f, 0xfb, 0xad, 0x73, 0x4a, 0x7b, 0x76, 0x79, 0x94, 0xca, 0xa5, 0xa9,
+	0x69, 0x27, 0xdb, 0x68, 0xe2, 0xd4, 0x8e, 0xcd, 0x9f, 0xff, 0x44, 0x2f, 0x4f, 0xd1, 0x2e, 0xc7,
+	0x4b, 0xe3, 0xbc, 0x44, 0x93, 0x83, 0x66, 0x7c, 0xe9, 0xa2, 0x8c, 0x4f, 0xd1, 0x95, 0xe5, 0x2b,
+	0xd1, 0x45, 0x70, 0x65, 0xe4, 0x55, 0xd8, 0x89, 0xda, 0xfd, 0x83, 0xae, 0x2a, 0x1d, 0x76, 0x55,
+	0xe9, 0x57, 0x57, 0x95, 0xde, 0x1f, 0xa9, 0x99, 0xc3, 0x23, 0x35, 0xf3, 0xed, 0x48, 0xcd, 0xbc,
+	0xb8, 0x6d, 0xd9, 0x7c, 0x33, 0x68, 0x6a, 0x2d, 0xe6, 0xea, 0xe2, 0x3b, 0x32, 0x60, 0xaf, 0xf9,
+	0x6e, 0x87, 0x42, 0x33, 0x17, 0xb5, 0xfc, 0xee, 0x9f, 0x01, 0x00, 0xba, 0x28, 0x24, 0x8b, 0x72,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +487,8 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// VerifyAllInvariants defines a method to verify all the registered invariants.
+	VerifyAllInvariants(ctx context.Context, in *MsgVerifyAllInvariants, opts ...grpc.CallOption) (*MsgVerifyAllInvariantsResponse, error)
 }
 
 type msgClient struct {
@@ -301,6 +517,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) VerifyAllInvariants(ctx context.Context, in *MsgVerifyAllInvariants, opts ...grpc.CallOption) (*MsgVerifyAllInvariantsResponse, error) {
+	out := new(MsgVerifyAllInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Msg/VerifyAllInvariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// VerifyInvariant defines a method to verify a particular invariant.
@@ -310,6 +535,8 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// VerifyAllInvariants defines a method to verify all the registered invariants.
+	VerifyAllInvariants(context.Context, *MsgVerifyAllInvariants) (*MsgVerifyAllInvariantsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -322,6 +549,9 @@ func (*UnimplementedMsgServer) VerifyInvariant(ctx context.Context, req *MsgVeri
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) VerifyAllInvariants(ctx context.Context, req *MsgVerifyAllInvariants) (*MsgVerifyAllInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAllInvariants not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -363,6 +593,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VerifyAllInvariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyAllInvariants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyAllInvariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Msg/VerifyAllInvariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyAllInvariants(ctx, req.(*MsgVerifyAllInvariants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.crisis.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -375,6 +623,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "VerifyAllInvariants",
+			Handler:    _Msg_VerifyAllInvariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/crisis/v1beta1/tx.proto",
@@ -510,6 +762,150 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVerifyAllInvariants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyAllInvariants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyAllInvariants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halt {
+		i--
+		if m.Halt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVerifyAllInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVerifyAllInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifyAllInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvariantRoute) > 0 {
+		i -= len(m.InvariantRoute)
+		copy(dAtA[i:], m.InvariantRoute)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InvariantRoute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InvariantModuleName) > 0 {
+		i -= len(m.InvariantModuleName)
+		copy(dAtA[i:], m.InvariantModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InvariantModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -575,17 +971,77 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgVerifyAllInvariants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Halt {
+		n += 2
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgVerifyAllInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
-func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvariantModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InvariantRoute)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -942,6 +1398,410 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVerifyAllInvariants) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyAllInvariants: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyAllInvariants: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifyAllInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyAllInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyAllInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, InvariantResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31,3 +31,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 	return nil
 }
+
+// EndBlocker withdraws the accumulated commission of the validators which
+// opted in to automatic commission withdrawal and reached their threshold.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.ProcessAutoCommissionWithdrawals(ctx)
+}
@@ -31,6 +31,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryValidatorOutstandingRewards(),
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
+		GetCmdQueryValidatorHistoricalRewards(),
 		GetCmdQueryDelegatorRewards(ac),
 		GetCmdQueryCommunityPool(),
 	)
@@ -86,7 +87,7 @@ $ %s query distribution validator-distribution-info %s1lwjmdnks33xwnmfayc64ycprw
 				return err
 			}
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -133,7 +134,7 @@ $ %s query distribution validator-outstanding-rewards %s1lwjmdnks33xwnmfayc64ycp
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -178,7 +179,7 @@ $ %s query distribution commission %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -223,7 +224,7 @@ $ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -265,6 +266,61 @@ $ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorHistoricalRewards implements the query validator
+// historical rewards command.
+func GetCmdQueryValidatorHistoricalRewards() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "historical-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query distribution validator historical rewards records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the historical rewards records of a validator, with their reference counts.
+
+Example:
+$ %s query distribution historical-rewards %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := client.ReadValAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorHistoricalRewards(
+				cmd.Context(),
+				&types.QueryValidatorHistoricalRewardsRequest{
+					ValidatorAddress: validatorAddr.String(),
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator historical rewards")
+	return cmd
+}
+
 // GetCmdQueryDelegatorRewards implements the query delegator rewards command.
 func GetCmdQueryDelegatorRewards(ac address.Codec) *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -299,7 +355,7 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 			// query for rewards from a particular delegation
 			ctx := cmd.Context()
 			if len(args) == 2 {
-				validatorAddr, err := sdk.ValAddressFromBech32(args[1])
+				validatorAddr, err := client.ReadValAddress(cmd, args[1])
 				if err != nil {
 					return err
 				}
@@ -87,14 +87,17 @@ func (s *CLITestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false}`,
+			`{"community_tax":"0.000000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":false,"signing_performance_reward_share":"0.000000000000000000","signing_performance_window":"0","max_withdraw_per_msg":0}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
-			`base_proposer_reward: "0"
-bonus_proposer_reward: "0"
-community_tax: "0"
+			`base_proposer_reward: "0.000000000000000000"
+bonus_proposer_reward: "0.000000000000000000"
+community_tax: "0.000000000000000000"
+max_withdraw_per_msg: 0
+signing_performance_reward_share: "0.000000000000000000"
+signing_performance_window: "0"
 withdraw_addr_enabled: false`,
 		},
 	}
@@ -343,6 +346,63 @@ func (s *CLITestSuite) TestGetCmdQueryValidatorSlashes() {
 	}
 }
 
+func (s *CLITestSuite) TestGetCmdQueryValidatorHistoricalRewards() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{
+			"invalid validator address",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				"foo",
+			},
+			true,
+			"",
+		},
+		{
+			"json output",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				sdk.ValAddress(val[0].Address).String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+			"{\"records\":[],\"pagination\":null}",
+		},
+		{
+			"text output",
+			[]string{
+				fmt.Sprintf("--%s=text", flags.FlagOutput),
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				sdk.ValAddress(val[0].Address).String(),
+			},
+			false,
+			"pagination: null\nrecords: []",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorHistoricalRewards()
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestGetCmdQueryDelegatorRewards() {
 	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 	addr := val[0].Address
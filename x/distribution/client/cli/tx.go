@@ -100,7 +100,7 @@ $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 			delAddr := clientCtx.GetFromAddress()
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -160,7 +160,7 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 			// build multi-message transaction
 			msgs := make([]sdk.Msg, 0, len(validators))
 			for _, valAddr := range validators {
-				val, err := sdk.ValAddressFromBech32(valAddr)
+				val, err := client.ReadValAddress(cmd, valAddr)
 				if err != nil {
 					return err
 				}
@@ -276,7 +276,7 @@ func NewDepositValidatorRewardsPoolCmd() *cobra.Command {
 
 			depositorAddr := clientCtx.GetFromAddress()
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
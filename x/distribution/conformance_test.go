@@ -0,0 +1,17 @@
+package distribution_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/conformance"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestGenesisConformance(t *testing.T) {
+	conformance.RunGenesisConformance(t, conformance.GenesisConfig{
+		ModuleName:  types.ModuleName,
+		Setup:       conformance.AppConfigGenesisSetup(testutil.AppConfig, types.ModuleName),
+		GenesisType: &types.GenesisState{},
+	})
+}
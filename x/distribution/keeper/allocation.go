@@ -42,34 +42,47 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 
 	// calculate fraction allocated to validators
 	remaining := feesCollected
-	communityTax, err := k.GetCommunityTax(ctx)
+	params, err := k.GetParams(ctx)
 	if err != nil {
 		return err
 	}
 
-	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
+	voteMultiplier := math.LegacyOneDec().Sub(params.CommunityTax)
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
-	// allocate tokens proportionally to voting power
+	// compute the rewards proportionally to voting power
+	//
+	// TODO: Consider micro-slashing for missing votes.
+	//
+	// Ref: https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
+	rewards := make([]sdk.DecCoins, len(bondedVotes))
+	for i, vote := range bondedVotes {
+		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
+		rewards[i] = feeMultiplier.MulDecTruncate(powerFraction)
+	}
+
+	// scale a portion of the rewards by the signature inclusion consistency
+	if params.SigningPerformanceEnabled() {
+		rewards, err = k.scaleRewardsBySigningPerformance(ctx, params, bondedVotes, rewards)
+		if err != nil {
+			return err
+		}
+	}
+
+	// allocate the rewards
 	//
 	// TODO: Consider parallelizing later
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
-	for _, vote := range bondedVotes {
+	for i, vote := range bondedVotes {
 		validator := k.stakingKeeper.ValidatorByConsAddr(sdkCtx, vote.Validator.Address)
 
-		// TODO: Consider micro-slashing for missing votes.
-		//
-		// Ref: https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
-		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
-		reward := feeMultiplier.MulDecTruncate(powerFraction)
-
-		err := k.AllocateTokensToValidator(ctx, validator, reward)
+		err := k.AllocateTokensToValidator(ctx, validator, rewards[i])
 		if err != nil {
 			return err
 		}
 
-		remaining = remaining.Sub(reward)
+		remaining = remaining.Sub(rewards[i])
 	}
 
 	// allocate community funding
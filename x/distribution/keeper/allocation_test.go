@@ -12,6 +12,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -335,3 +336,161 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+func TestAllocateTokensBySigningPerformance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// scale 10% of the rewards by the signature inclusion over the last 4 blocks
+	params := disttypes.DefaultParams()
+	params.SigningPerformanceRewardShare = math.LegacyNewDecWithPrec(1, 1)
+	params.SigningPerformanceWindow = 4
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	require.NoError(t, distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool()))
+
+	// create three validators with equal power and no commission
+	consPks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+	valAddrs := make([]sdk.ValAddress, len(consPks))
+	for i, pk := range consPks {
+		valAddrs[i] = sdk.ValAddress(pk.Address())
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+	}
+
+	// the first validator signs every block, the second every other block and
+	// the third none
+	signed := func(val, block int) bool {
+		switch val {
+		case 0:
+			return true
+		case 1:
+			return block%2 == 0
+		default:
+			return false
+		}
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).AnyTimes()
+
+	blocks := 6
+	for block := 0; block < blocks; block++ {
+		votes := make([]abci.VoteInfo, len(consPks))
+		for i, pk := range consPks {
+			votes[i] = abci.VoteInfo{
+				Validator:       abci.Validator{Address: pk.Address(), Power: 100},
+				SignedLastBlock: signed(i, block),
+			}
+		}
+		require.NoError(t, distrKeeper.AllocateTokens(ctx, 300, votes))
+
+		// the scaled allocation sums to the collected fees
+		total := sdk.DecCoins{}
+		for _, valAddr := range valAddrs {
+			outstanding, err := distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+			require.NoError(t, err)
+			total = total.Add(outstanding.Rewards...)
+		}
+		feePool, err := distrKeeper.GetFeePool(ctx)
+		require.NoError(t, err)
+		total = total.Add(feePool.CommunityPool...)
+		require.Equal(t, sdk.NewDecCoinsFromCoins(fees...).MulDec(math.LegacyNewDec(int64(block+1))), total)
+	}
+
+	rewards := make([]math.LegacyDec, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		outstanding, err := distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+		require.NoError(t, err)
+		rewards[i] = outstanding.Rewards.AmountOf(sdk.DefaultBondDenom)
+	}
+	require.True(t, rewards[0].GT(rewards[1]))
+	require.True(t, rewards[1].GT(rewards[2]))
+
+	// the validator which signed no block only receives the unscaled portion,
+	// 90% of a third of 98% of the fees per block
+	reward := math.LegacyNewDec(980).MulTruncate(math.LegacyOneDec().QuoTruncate(math.LegacyNewDec(3)))
+	unscaled := reward.Sub(reward.MulTruncate(params.SigningPerformanceRewardShare))
+	require.Equal(t, unscaled.MulInt64(int64(blocks)), rewards[2])
+
+	// the windows only hold the last 4 blocks
+	window, err := distrKeeper.GetValidatorSignatureWindow(ctx, sdk.GetConsAddress(valConsPk1))
+	require.NoError(t, err)
+	require.Equal(t, int64(blocks), window.Index)
+	require.Equal(t, int64(2), window.MissedCount)
+}
+
+func TestAllocateTokensSigningPerformanceDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.SetParams(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool()))
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val0.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}}}
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	// a validator which didn't sign gets its whole share and no window is kept
+	outstanding, err := distrKeeper.GetValidatorOutstandingRewards(ctx, sdk.ValAddress(valConsAddr0))
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)}}, outstanding.Rewards)
+
+	window, err := distrKeeper.GetValidatorSignatureWindow(ctx, sdk.GetConsAddress(valConsPk0))
+	require.NoError(t, err)
+	require.Nil(t, window)
+}
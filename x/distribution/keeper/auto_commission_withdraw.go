@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// MaxAutoCommissionWithdrawalsPerBlock is the maximum number of automatic
+// commission withdrawal settings processed by the end blocker in a block.
+// Settings are processed round-robin, so that all of them are eventually
+// visited however many validators opted in.
+const MaxAutoCommissionWithdrawalsPerBlock = 10
+
+// GetAutoCommissionWithdraw returns the automatic commission withdrawal
+// setting of a validator, or a disabled setting if none is stored.
+func (k Keeper) GetAutoCommissionWithdraw(ctx context.Context, valAddr sdk.ValAddress) (types.AutoCommissionWithdraw, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := store.Get(types.GetAutoCommissionWithdrawKey(valAddr))
+	if b == nil || err != nil {
+		return types.AutoCommissionWithdraw{Threshold: sdk.Coins{}}, err
+	}
+
+	var setting types.AutoCommissionWithdraw
+	if err := k.cdc.Unmarshal(b, &setting); err != nil {
+		return types.AutoCommissionWithdraw{}, err
+	}
+
+	return setting, nil
+}
+
+// SetAutoCommissionWithdraw sets the automatic commission withdrawal setting
+// of a validator. A disabled setting is deleted.
+func (k Keeper) SetAutoCommissionWithdraw(ctx context.Context, valAddr sdk.ValAddress, setting types.AutoCommissionWithdraw) error {
+	if !setting.Enabled {
+		return k.DeleteAutoCommissionWithdraw(ctx, valAddr)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := k.cdc.Marshal(&setting)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetAutoCommissionWithdrawKey(valAddr), b)
+}
+
+// DeleteAutoCommissionWithdraw deletes the automatic commission withdrawal setting of a validator.
+func (k Keeper) DeleteAutoCommissionWithdraw(ctx context.Context, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetAutoCommissionWithdrawKey(valAddr))
+}
+
+// IterateAutoCommissionWithdraws iterates over the automatic commission
+// withdrawal settings of all validators.
+func (k Keeper) IterateAutoCommissionWithdraws(ctx context.Context, handler func(valAddr sdk.ValAddress, setting types.AutoCommissionWithdraw) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.AutoCommissionWithdrawPrefix, storetypes.PrefixEndBytes(types.AutoCommissionWithdrawPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var setting types.AutoCommissionWithdraw
+		if err := k.cdc.Unmarshal(iter.Value(), &setting); err != nil {
+			return err
+		}
+		if handler(types.GetAutoCommissionWithdrawAddress(iter.Key()), setting) {
+			break
+		}
+	}
+
+	return nil
+}
+
+type autoCommissionWithdrawEntry struct {
+	key     []byte
+	setting types.AutoCommissionWithdraw
+}
+
+// ProcessAutoCommissionWithdrawals withdraws the accumulated commission of the
+// validators whose truncated accumulated commission reached the threshold of
+// their automatic commission withdrawal setting in any of its denoms. At most
+// MaxAutoCommissionWithdrawalsPerBlock settings are visited, starting from
+// where the previous call stopped. A failing withdrawal is logged and skipped
+// without affecting the other ones.
+func (k Keeper) ProcessAutoCommissionWithdrawals(ctx context.Context) error {
+	entries, err := k.nextAutoCommissionWithdraws(ctx, MaxAutoCommissionWithdrawalsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, entry := range entries {
+		if !entry.setting.Enabled {
+			continue
+		}
+
+		valAddr := types.GetAutoCommissionWithdrawAddress(entry.key)
+		accumCommission, err := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		commission, _ := accumCommission.Commission.TruncateDecimal()
+		if !commission.IsAnyGTE(entry.setting.Threshold) {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if _, err := k.WithdrawValidatorCommission(cacheCtx, valAddr); err != nil {
+			k.Logger(ctx).Error("failed to automatically withdraw validator commission", "validator", valAddr.String(), "err", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// nextAutoCommissionWithdraws returns up to limit automatic commission
+// withdrawal settings, starting from the stored cursor and wrapping around the
+// end of the store. The cursor is moved to the first setting which was not
+// returned, or cleared if all of them were.
+func (k Keeper) nextAutoCommissionWithdraws(ctx context.Context, limit int) ([]autoCommissionWithdrawEntry, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	cursor, err := store.Get(types.AutoCommissionWithdrawCursorKey)
+	if err != nil {
+		return nil, err
+	}
+
+	start := types.AutoCommissionWithdrawPrefix
+	end := storetypes.PrefixEndBytes(types.AutoCommissionWithdrawPrefix)
+	if cursor != nil && bytes.Compare(cursor, start) > 0 && bytes.Compare(cursor, end) < 0 {
+		start = cursor
+	}
+
+	// collect one more entry than the limit to know where to resume from
+	var entries []autoCommissionWithdrawEntry
+	collect := func(start, end []byte) error {
+		iter, err := store.Iterator(start, end)
+		if err != nil {
+			return err
+		}
+		defer iter.Close()
+
+		for ; iter.Valid() && len(entries) <= limit; iter.Next() {
+			var setting types.AutoCommissionWithdraw
+			if err := k.cdc.Unmarshal(iter.Value(), &setting); err != nil {
+				return err
+			}
+			entries = append(entries, autoCommissionWithdrawEntry{key: iter.Key(), setting: setting})
+		}
+
+		return nil
+	}
+
+	if err := collect(start, end); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(start, types.AutoCommissionWithdrawPrefix) {
+		if err := collect(types.AutoCommissionWithdrawPrefix, start); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(entries) <= limit {
+		return entries, store.Delete(types.AutoCommissionWithdrawCursorKey)
+	}
+
+	if err := store.Set(types.AutoCommissionWithdrawCursorKey, entries[limit].key); err != nil {
+		return nil, err
+	}
+
+	return entries[:limit], nil
+}
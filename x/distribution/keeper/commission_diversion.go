@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetValidatorCommissionDiversion returns the fraction of the next commission
+// withdrawal of a validator which is routed to the community pool, or zero if
+// none is set.
+func (k Keeper) GetValidatorCommissionDiversion(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := store.Get(types.GetValidatorCommissionDiversionKey(valAddr))
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if b == nil {
+		return math.LegacyZeroDec(), nil
+	}
+
+	var fraction math.LegacyDec
+	if err := fraction.Unmarshal(b); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return fraction, nil
+}
+
+// SetValidatorCommissionDiversion sets the fraction of the next commission
+// withdrawal of a validator which is routed to the community pool. It is used
+// by x/gov to penalize bonded validators which did not vote on a proposal. A
+// fraction lower than the one already set is ignored, so that penalties are not
+// compounded before the validator withdraws its commission.
+func (k Keeper) SetValidatorCommissionDiversion(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	if fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		return types.ErrInvalidCommissionDiversion.Wrapf("%s", fraction)
+	}
+
+	current, err := k.GetValidatorCommissionDiversion(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if fraction.LTE(current) {
+		return nil
+	}
+
+	b, err := fraction.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetValidatorCommissionDiversionKey(valAddr), b)
+}
+
+// DeleteValidatorCommissionDiversion deletes the commission diversion of a validator.
+func (k Keeper) DeleteValidatorCommissionDiversion(ctx context.Context, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetValidatorCommissionDiversionKey(valAddr))
+}
+
+// divertValidatorCommission routes the commission diversion fraction of the
+// withdrawn commission of a validator to the community pool and clears the
+// diversion. It returns the remaining commission to send to the validator.
+func (k Keeper) divertValidatorCommission(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins) (sdk.Coins, error) {
+	fraction, err := k.GetValidatorCommissionDiversion(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !fraction.IsPositive() {
+		return commission, nil
+	}
+
+	diverted, _ := sdk.NewDecCoinsFromCoins(commission...).MulDecTruncate(fraction).TruncateDecimal()
+	if err := k.DeleteValidatorCommissionDiversion(ctx, valAddr); err != nil {
+		return nil, err
+	}
+	if diverted.IsZero() {
+		return commission, nil
+	}
+
+	// the diverted coins are already held in the distribution module account
+	feePool, err := k.GetFeePool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(diverted...)...)
+	if err := k.SetFeePool(ctx, feePool); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommissionDiverted,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, diverted.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return commission.Sub(diverted...), nil
+}
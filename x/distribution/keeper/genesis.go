@@ -88,6 +88,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, rec := range data.ValidatorAutoCommissionWithdraws {
+		valAddr, err := sdk.ValAddressFromBech32(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetAutoCommissionWithdraw(ctx, valAddr, rec.Setting); err != nil {
+			panic(err)
+		}
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -204,5 +213,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoWithdraws := make([]types.ValidatorAutoCommissionWithdrawRecord, 0)
+	err = k.IterateAutoCommissionWithdraws(ctx,
+		func(val sdk.ValAddress, setting types.AutoCommissionWithdraw) (stop bool) {
+			autoWithdraws = append(autoWithdraws, types.ValidatorAutoCommissionWithdrawRecord{
+				ValidatorAddress: val.String(),
+				Setting:          setting,
+			})
+			return false
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState.ValidatorAutoCommissionWithdraws = autoWithdraws
+	return genState
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -342,3 +343,67 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// AutoCommissionWithdraw queries the automatic commission withdrawal setting of a validator
+func (k Querier) AutoCommissionWithdraw(c context.Context, req *types.QueryAutoCommissionWithdrawRequest) (*types.QueryAutoCommissionWithdrawResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	setting, err := k.GetAutoCommissionWithdraw(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCommissionWithdrawResponse{Setting: setting}, nil
+}
+
+// ValidatorHistoricalRewards queries the historical rewards records of a validator
+func (k Querier) ValidatorHistoricalRewards(c context.Context, req *types.QueryValidatorHistoricalRewardsRequest) (*types.QueryValidatorHistoricalRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(c))
+	historicalStore := prefix.NewStore(store, types.GetValidatorHistoricalRewardsPrefix(valAddr))
+
+	records := []types.ValidatorHistoricalRewardsRecord{}
+	pageRes, err := query.Paginate(historicalStore, req.Pagination, func(key, value []byte) error {
+		var rewards types.ValidatorHistoricalRewards
+		if err := k.cdc.Unmarshal(value, &rewards); err != nil {
+			return err
+		}
+
+		records = append(records, types.ValidatorHistoricalRewardsRecord{
+			ValidatorAddress: req.ValidatorAddress,
+			Period:           binary.LittleEndian.Uint64(key),
+			Rewards:          rewards,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorHistoricalRewardsResponse{Records: records, Pagination: pageRes}, nil
+}
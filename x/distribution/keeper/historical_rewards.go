@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// validatorHistoricalRewardsReferences returns the number of references to
+// each historical rewards period of a validator: one per delegator starting
+// info (previous period), one per slash event (period of the slash) and one
+// for the current rewards (previous period).
+func (k Keeper) validatorHistoricalRewardsReferences(ctx context.Context, val sdk.ValAddress) (map[uint64]uint32, error) {
+	references := make(map[uint64]uint32)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	startingInfos := storetypes.KVStorePrefixIterator(store, types.GetDelegatorStartingInfoPrefix(val))
+	defer startingInfos.Close()
+	for ; startingInfos.Valid(); startingInfos.Next() {
+		var info types.DelegatorStartingInfo
+		if err := k.cdc.Unmarshal(startingInfos.Value(), &info); err != nil {
+			return nil, err
+		}
+		references[info.PreviousPeriod]++
+	}
+
+	slashEvents := storetypes.KVStorePrefixIterator(store, types.GetValidatorSlashEventPrefix(val))
+	defer slashEvents.Close()
+	for ; slashEvents.Valid(); slashEvents.Next() {
+		var event types.ValidatorSlashEvent
+		if err := k.cdc.Unmarshal(slashEvents.Value(), &event); err != nil {
+			return nil, err
+		}
+		references[event.ValidatorPeriod]++
+	}
+
+	current, err := k.GetValidatorCurrentRewards(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	// the current rewards of an uninitialized or removed validator are unset
+	if current.Period > 0 {
+		references[current.Period-1]++
+	}
+
+	return references, nil
+}
+
+// PruneOrphanedHistoricalRewards removes the historical rewards records of a
+// validator which are not referenced by any of its delegations, slash events
+// or its current rewards, whatever their stored reference count. Such records
+// are never read, they are left over when a reference count got out of sync
+// with the references. It returns the number of records removed.
+//
+// It can be called from an upgrade handler, or through
+// MsgPruneHistoricalRewards.
+func (k Keeper) PruneOrphanedHistoricalRewards(ctx context.Context, val sdk.ValAddress) (uint64, error) {
+	references, err := k.validatorHistoricalRewardsReferences(ctx, val)
+	if err != nil {
+		return 0, err
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.GetValidatorHistoricalRewardsPrefix(val))
+
+	var orphaned [][]byte
+	for ; iter.Valid(); iter.Next() {
+		_, period := types.GetValidatorHistoricalRewardsAddressPeriod(iter.Key())
+		if references[period] == 0 {
+			orphaned = append(orphaned, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, key := range orphaned {
+		store.Delete(key)
+	}
+
+	if len(orphaned) > 0 {
+		k.Logger(ctx).Info("pruned orphaned historical rewards", "validator", val.String(), "records", len(orphaned))
+	}
+
+	return uint64(len(orphaned)), nil
+}
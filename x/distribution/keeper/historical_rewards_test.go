@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestPruneOrphanedHistoricalRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().StringToBytes(gomock.Any()).DoAndReturn(func(addr string) ([]byte, error) {
+		return sdk.AccAddressFromBech32(addr)
+	}).AnyTimes()
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authority,
+	)
+	querier := keeper.NewQuerier(distrKeeper)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+
+	// create validator with a self-delegation
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).AnyTimes()
+	stakingKeeper.EXPECT().GetAllSDKDelegations(gomock.Any()).Return([]stakingtypes.Delegation{del}).AnyTimes()
+
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	queryRecords := func() map[uint64]uint32 {
+		res, err := querier.ValidatorHistoricalRewards(ctx, &disttypes.QueryValidatorHistoricalRewardsRequest{ValidatorAddress: valAddr.String()})
+		require.NoError(t, err)
+
+		records := make(map[uint64]uint32)
+		for _, record := range res.Records {
+			require.Equal(t, valAddr.String(), record.ValidatorAddress)
+			records[record.Period] = record.Rewards.ReferenceCount
+		}
+		return records
+	}
+
+	// the period ended by the self-delegation is referenced by the current
+	// rewards and the delegation
+	require.Equal(t, map[uint64]uint32{1: 2}, queryRecords())
+
+	// create a second delegation
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	addr2 := sdk.AccAddress(valConsAddr1)
+	_, del2, err := distrtestutil.Delegate(ctx, distrKeeper, addr2, &val, math.NewInt(100), nil)
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr2, valAddr).Return(del2).AnyTimes()
+	require.NoError(t, distrKeeper.Hooks().AfterDelegationModified(ctx, addr2, valAddr))
+
+	require.Equal(t, map[uint64]uint32{1: 1, 2: 2}, queryRecords())
+
+	// clear the second delegation, its record is reclaimed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationSharesModified(ctx, addr2, valAddr))
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationRemoved(ctx, addr2, valAddr))
+
+	require.Equal(t, map[uint64]uint32{1: 1, 3: 1}, queryRecords())
+	_, broken := keeper.HistoricalReferenceCountInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+
+	// nothing to prune
+	pruned, err := distrKeeper.PruneOrphanedHistoricalRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	// a record left over with a dangling reference count breaks the invariant
+	err = distrKeeper.SetValidatorHistoricalRewards(ctx, valAddr, 2, disttypes.NewValidatorHistoricalRewards(sdk.DecCoins{}, 1))
+	require.NoError(t, err)
+	_, broken = keeper.HistoricalReferenceCountInvariant(distrKeeper)(ctx)
+	require.True(t, broken)
+
+	_, err = msgServer.PruneHistoricalRewards(ctx, &disttypes.MsgPruneHistoricalRewards{
+		Authority:        addr.String(),
+		ValidatorAddress: valAddr.String(),
+	})
+	require.ErrorContains(t, err, "invalid authority")
+
+	res, err := msgServer.PruneHistoricalRewards(ctx, &disttypes.MsgPruneHistoricalRewards{
+		Authority:        authority,
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Pruned)
+
+	require.Equal(t, map[uint64]uint32{1: 1, 3: 1}, queryRecords())
+	_, broken = keeper.HistoricalReferenceCountInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+}
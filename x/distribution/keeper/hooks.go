@@ -3,6 +3,7 @@ package keeper
 import (
 	sdkmath "cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -27,7 +28,7 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) er
 }
 
 // AfterValidatorRemoved performs clean up after a validator is removed
-func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	// fetch outstanding
 	outstanding, err := h.k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
 	if err != nil {
@@ -113,7 +114,20 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		return err
 	}
 
-	return nil
+	// clear commission diversion
+	err = h.k.DeleteValidatorCommissionDiversion(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	// clear automatic commission withdrawal setting
+	err = h.k.DeleteAutoCommissionWithdraw(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	// clear signature inclusion window
+	return h.k.DeleteValidatorSignatureWindow(ctx, consAddr)
 }
 
 // increment period
@@ -165,3 +179,20 @@ func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate adds the rotation fee to the community pool. The
+// fee is sent to the distribution module account by the x/staking
+// MsgRotateConsPubKey handler.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, _ sdk.ValAddress, _, _ cryptotypes.PubKey, rotationFee sdk.Coin) error {
+	if rotationFee.IsNil() || rotationFee.IsZero() {
+		return nil
+	}
+
+	feePool, err := h.k.GetFeePool(ctx)
+	if err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(rotationFee)...)
+	return h.k.SetFeePool(ctx, feePool)
+}
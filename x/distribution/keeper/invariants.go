@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -16,6 +17,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		CanWithdrawInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "reference-count",
 		ReferenceCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "historical-reference-count",
+		HistoricalReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
 }
@@ -35,6 +38,10 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
+		res, stop = HistoricalReferenceCountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
 		return ModuleAccountInvariant(k)(ctx)
 	}
 }
@@ -136,6 +143,68 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// HistoricalReferenceCountInvariant checks that the reference count of each
+// historical rewards record equals the number of delegations, slash events and
+// current rewards referencing it, and that every referenced record exists
+func HistoricalReferenceCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		type historicalPeriod struct {
+			val    string
+			period uint64
+		}
+
+		// one reference per delegation (previous period), one per slash
+		// (period of the slash) and one per validator (last tracked period)
+		expected := make(map[historicalPeriod]uint32)
+		for _, del := range k.stakingKeeper.GetAllSDKDelegations(ctx) {
+			info, err := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+			if err != nil {
+				panic(err)
+			}
+			expected[historicalPeriod{del.GetValidatorAddr().String(), info.PreviousPeriod}]++
+		}
+		k.IterateValidatorSlashEvents(ctx, func(val sdk.ValAddress, _ uint64, event types.ValidatorSlashEvent) (stop bool) {
+			expected[historicalPeriod{val.String(), event.ValidatorPeriod}]++
+			return false
+		})
+		k.IterateValidatorCurrentRewards(ctx, func(val sdk.ValAddress, rewards types.ValidatorCurrentRewards) (stop bool) {
+			expected[historicalPeriod{val.String(), rewards.Period - 1}]++
+			return false
+		})
+
+		var msg string
+		var count int
+		k.IterateValidatorHistoricalRewards(ctx, func(val sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool) {
+			key := historicalPeriod{val.String(), period}
+			if rewards.ReferenceCount != expected[key] {
+				count++
+				msg += fmt.Sprintf("\t%v period %d has reference count %d, expected %d\n", val, period, rewards.ReferenceCount, expected[key])
+			}
+			delete(expected, key)
+			return false
+		})
+
+		missing := make([]historicalPeriod, 0, len(expected))
+		for key := range expected {
+			missing = append(missing, key)
+		}
+		sort.Slice(missing, func(i, j int) bool {
+			if missing[i].val != missing[j].val {
+				return missing[i].val < missing[j].val
+			}
+			return missing[i].period < missing[j].period
+		})
+		for _, key := range missing {
+			count++
+			msg += fmt.Sprintf("\t%v period %d is missing, expected reference count %d\n", key.val, key.period, expected[key])
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "historical reference count",
+			fmt.Sprintf("found %d historical rewards records with invalid reference counts\n%s", count, msg)), broken
+	}
+}
+
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
 // is consistent with the sum of validator outstanding rewards
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
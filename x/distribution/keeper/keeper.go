@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -115,6 +116,52 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator. It fails without withdrawing anything when the delegator has
+// more delegations than the max_withdraw_per_msg param allows.
+func (k Keeper) WithdrawAllDelegationRewards(ctx context.Context, delAddr sdk.AccAddress) ([]types.ValidatorRewardWithdrawal, sdk.Coins, error) {
+	maxWithdraw, err := k.GetMaxWithdrawPerMsg(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(sdkCtx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	if maxWithdraw != 0 && len(valAddrs) > int(maxWithdraw) {
+		return nil, nil, errorsmod.Wrapf(types.ErrTooManyWithdrawals, "%d delegations, max %d", len(valAddrs), maxWithdraw)
+	}
+
+	withdrawals := make([]types.ValidatorRewardWithdrawal, 0, len(valAddrs))
+	total := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		withdrawals = append(withdrawals, types.ValidatorRewardWithdrawal{
+			ValidatorAddress: valAddr.String(),
+			Amount:           rewards,
+		})
+		total = total.Add(rewards...)
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawAllRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return withdrawals, total, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -141,6 +188,11 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 		return nil, err
 	}
 
+	commission, err = k.divertValidatorCommission(ctx, valAddr, commission)
+	if err != nil {
+		return nil, err
+	}
+
 	if !commission.IsZero() {
 		accAddr := sdk.AccAddress(valAddr)
 		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, accAddr)
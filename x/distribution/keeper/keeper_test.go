@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -21,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -126,6 +129,294 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestWithdrawValidatorCommissionDiversion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(1)
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.SetFeePool(ctx, types.InitialFeePool()))
+
+	// invalid fractions are rejected
+	require.ErrorIs(t, distrKeeper.SetValidatorCommissionDiversion(ctx, valAddr, math.LegacyNewDec(-1)), types.ErrInvalidCommissionDiversion)
+	require.ErrorIs(t, distrKeeper.SetValidatorCommissionDiversion(ctx, valAddr, math.LegacyNewDec(2)), types.ErrInvalidCommissionDiversion)
+
+	// a lower fraction does not override a higher one
+	require.NoError(t, distrKeeper.SetValidatorCommissionDiversion(ctx, valAddr, math.LegacyNewDecWithPrec(1, 1)))
+	require.NoError(t, distrKeeper.SetValidatorCommissionDiversion(ctx, valAddr, math.LegacyNewDecWithPrec(5, 2)))
+	fraction, err := distrKeeper.GetValidatorCommissionDiversion(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), fraction)
+
+	valCommission := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", math.LegacyNewDec(25)),
+		sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(101).Quo(math.LegacyNewDec(2))),
+	}
+	require.NoError(t, distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission}))
+	distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: valCommission})
+
+	// 10% of the truncated commission (25mytoken, 50stake) is diverted, truncated: 2mytoken, 5stake
+	coins := sdk.NewCoins(sdk.NewCoin("mytoken", math.NewInt(23)), sdk.NewCoin("stake", math.NewInt(45)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[0], coins).Return(nil)
+
+	withdrawn, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, coins, withdrawn)
+
+	feePool, err := distrKeeper.GetFeePool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewCoin("mytoken", math.NewInt(2)), sdk.NewCoin("stake", math.NewInt(5))), feePool.CommunityPool)
+
+	// the diversion only applies to the next withdrawal
+	fraction, err = distrKeeper.GetValidatorCommissionDiversion(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, fraction.IsZero())
+}
+
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+	delAddr := simtestutil.CreateIncrementalAccounts(1)[0]
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.SetFeePool(ctx, types.InitialFeePool()))
+	require.NoError(t, distrKeeper.SetParams(ctx, types.DefaultParams()))
+
+	// the delegator delegates to three validators, all of its shares
+	var delegations []stakingtypes.Delegation
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2} {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+
+		del := stakingtypes.NewDelegation(delAddr, val.GetOperator(), val.DelegatorShares)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, val.GetOperator()).Return(del).AnyTimes()
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, delAddr, val.GetOperator()))
+
+		delegations = append(delegations, del)
+	}
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), delAddr, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+			for i, del := range delegations {
+				if fn(int64(i), del) {
+					return
+				}
+			}
+		},
+	).AnyTimes()
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// only the first and last validators earn rewards
+	val0 := stakingKeeper.Validator(ctx, delegations[0].GetValidatorAddr())
+	distrKeeper.AllocateTokensToValidator(ctx, val0, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(10))})
+	val2 := stakingKeeper.Validator(ctx, delegations[2].GetValidatorAddr())
+	distrKeeper.AllocateTokensToValidator(ctx, val2, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(30))})
+
+	// the cap is exceeded, nothing is withdrawn
+	params := types.DefaultParams()
+	params.MaxWithdrawPerMsg = 2
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	_, _, err := distrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.ErrorIs(t, err, types.ErrTooManyWithdrawals)
+
+	params.MaxWithdrawPerMsg = 3
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))).Return(nil)
+
+	withdrawals, total, err := distrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)), total)
+	require.Equal(t, []types.ValidatorRewardWithdrawal{
+		{ValidatorAddress: delegations[0].ValidatorAddress, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))},
+		{ValidatorAddress: delegations[1].ValidatorAddress, Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}},
+		{ValidatorAddress: delegations[2].ValidatorAddress, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))},
+	}, withdrawals)
+
+	// one withdraw_rewards event per delegation, followed by the summary
+	events := ctx.EventManager().Events()
+	var withdrawEvents int
+	for _, event := range events {
+		if event.Type == types.EventTypeWithdrawRewards {
+			withdrawEvents++
+		}
+	}
+	require.Equal(t, 3, withdrawEvents)
+	require.Equal(t, types.EventTypeWithdrawAllRewards, events[len(events)-1].Type)
+}
+
+func TestProcessAutoCommissionWithdrawals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	threshold := sdk.NewCoins(sdk.NewCoin("mytoken", math.NewInt(5)), sdk.NewCoin("stake", math.NewInt(10)))
+	commissions := []sdk.DecCoins{
+		// the stake commission is exactly at the threshold
+		sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(10))),
+		// the commission truncates to just below the threshold in every denom
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("mytoken", math.LegacyNewDecWithPrec(49, 1)), sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(999, 2))),
+		// the commission is above the threshold, but the setting is disabled
+		sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(100))),
+		// the commission is above the threshold, but no setting is stored
+		sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(100))),
+	}
+	for i, commission := range commissions {
+		valAddr := sdk.ValAddress(addrs[i])
+		require.NoError(t, distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+		require.NoError(t, distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+	}
+
+	require.NoError(t, distrKeeper.SetAutoCommissionWithdraw(ctx, sdk.ValAddress(addrs[0]), types.AutoCommissionWithdraw{Threshold: threshold, Enabled: true}))
+	require.NoError(t, distrKeeper.SetAutoCommissionWithdraw(ctx, sdk.ValAddress(addrs[1]), types.AutoCommissionWithdraw{Threshold: threshold, Enabled: true}))
+	require.NoError(t, distrKeeper.SetAutoCommissionWithdraw(ctx, sdk.ValAddress(addrs[2]), types.AutoCommissionWithdraw{Threshold: threshold, Enabled: true}))
+	require.NoError(t, distrKeeper.SetAutoCommissionWithdraw(ctx, sdk.ValAddress(addrs[2]), types.AutoCommissionWithdraw{Threshold: threshold, Enabled: false}))
+
+	// disabling the setting deletes it
+	setting, err := distrKeeper.GetAutoCommissionWithdraw(ctx, sdk.ValAddress(addrs[2]))
+	require.NoError(t, err)
+	require.False(t, setting.Enabled)
+
+	// only the commission of the first validator is withdrawn
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[0], sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10)))).Return(nil)
+
+	require.NoError(t, distrKeeper.ProcessAutoCommissionWithdrawals(ctx))
+
+	for i, commission := range commissions {
+		remaining, err := distrKeeper.GetValidatorAccumulatedCommission(ctx, sdk.ValAddress(addrs[i]))
+		require.NoError(t, err)
+		if i == 0 {
+			require.True(t, remaining.Commission.IsZero())
+			continue
+		}
+		require.Equal(t, commission, remaining.Commission)
+	}
+}
+
+func TestProcessAutoCommissionWithdrawalsBound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	numVals := keeper.MaxAutoCommissionWithdrawalsPerBlock + 2
+	addrs := simtestutil.CreateIncrementalAccounts(numVals)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	threshold := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10)))
+	commission := sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(10)))
+	setCommissions := func() {
+		for _, addr := range addrs {
+			valAddr := sdk.ValAddress(addr)
+			require.NoError(t, distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+			require.NoError(t, distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+		}
+	}
+	setCommissions()
+	for _, addr := range addrs {
+		require.NoError(t, distrKeeper.SetAutoCommissionWithdraw(ctx, sdk.ValAddress(addr), types.AutoCommissionWithdraw{Threshold: threshold, Enabled: true}))
+	}
+
+	var withdrawn []string
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", gomock.Any(), sdk.Coins(threshold)).
+		DoAndReturn(func(_ context.Context, _ string, addr sdk.AccAddress, _ sdk.Coins) error {
+			withdrawn = append(withdrawn, addr.String())
+			return nil
+		}).AnyTimes()
+
+	// the first block only processes the bounded number of settings
+	require.NoError(t, distrKeeper.ProcessAutoCommissionWithdrawals(ctx))
+	require.Len(t, withdrawn, keeper.MaxAutoCommissionWithdrawalsPerBlock)
+
+	// the next block resumes with the remaining settings, then wraps around
+	// to the ones which were already processed
+	setCommissions()
+	require.NoError(t, distrKeeper.ProcessAutoCommissionWithdrawals(ctx))
+	require.Len(t, withdrawn, 2*keeper.MaxAutoCommissionWithdrawalsPerBlock)
+
+	seen := make(map[string]bool)
+	for _, addr := range withdrawn[:numVals] {
+		seen[addr] = true
+	}
+	require.Len(t, seen, numVals)
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -75,6 +75,32 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+func (k msgServer) WithdrawAllDelegatorRewards(ctx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	rewards, amount, err := k.WithdrawAllDelegationRewards(ctx, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgWithdrawAllDelegatorRewardsResponse{Rewards: rewards}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
@@ -205,6 +231,52 @@ func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.M
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) SetAutoCommissionWithdraw(ctx context.Context, msg *types.MsgSetAutoCommissionWithdraw) (*types.MsgSetAutoCommissionWithdrawResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Enabled {
+		if err := validateAmount(msg.Threshold); err != nil {
+			return nil, err
+		}
+		if msg.Threshold.IsZero() {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, "threshold cannot be empty")
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.stakingKeeper.Validator(sdkCtx, valAddr) == nil {
+		return nil, errors.Wrapf(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	setting := types.AutoCommissionWithdraw{Threshold: msg.Threshold, Enabled: msg.Enabled}
+	if err := k.Keeper.SetAutoCommissionWithdraw(ctx, valAddr, setting); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCommissionWithdrawResponse{}, nil
+}
+
+func (k msgServer) PruneHistoricalRewards(ctx context.Context, msg *types.MsgPruneHistoricalRewards) (*types.MsgPruneHistoricalRewardsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	pruned, err := k.PruneOrphanedHistoricalRewards(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPruneHistoricalRewardsResponse{Pruned: pruned}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
@@ -51,3 +51,15 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx context.Context) (enabled bool, err e
 
 	return params.WithdrawAddrEnabled, nil
 }
+
+// GetMaxWithdrawPerMsg returns the maximum number of delegations whose rewards
+// can be withdrawn by a single MsgWithdrawAllDelegatorRewards, zero meaning no
+// limit.
+func (k Keeper) GetMaxWithdrawPerMsg(ctx context.Context) (uint32, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.MaxWithdrawPerMsg, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetValidatorSignatureWindow returns the signature inclusion window of a
+// validator, or nil if none is stored.
+func (k Keeper) GetValidatorSignatureWindow(ctx context.Context, consAddr sdk.ConsAddress) (*types.ValidatorSignatureWindow, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := store.Get(types.GetValidatorSignatureWindowKey(consAddr))
+	if b == nil || err != nil {
+		return nil, err
+	}
+
+	var window types.ValidatorSignatureWindow
+	if err := k.cdc.Unmarshal(b, &window); err != nil {
+		return nil, err
+	}
+
+	return &window, nil
+}
+
+// SetValidatorSignatureWindow sets the signature inclusion window of a validator.
+func (k Keeper) SetValidatorSignatureWindow(ctx context.Context, consAddr sdk.ConsAddress, window types.ValidatorSignatureWindow) error {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := k.cdc.Marshal(&window)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetValidatorSignatureWindowKey(consAddr), b)
+}
+
+// DeleteValidatorSignatureWindow deletes the signature inclusion window of a validator.
+func (k Keeper) DeleteValidatorSignatureWindow(ctx context.Context, consAddr sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetValidatorSignatureWindowKey(consAddr))
+}
+
+// recordValidatorSignature records in the signature inclusion window of the
+// vote's validator whether the commit included its signature, and returns the
+// ratio of the recorded blocks of the window whose commit included it. The
+// window is restarted when its size param changed.
+func (k Keeper) recordValidatorSignature(ctx context.Context, vote abci.VoteInfo, size int64) (math.LegacyDec, error) {
+	consAddr := sdk.ConsAddress(vote.Validator.Address)
+	window, err := k.GetValidatorSignatureWindow(ctx, consAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if window == nil || window.Window != size {
+		window = &types.ValidatorSignatureWindow{
+			Window: size,
+			Missed: make([]byte, (size+7)/8),
+		}
+	}
+
+	pos := window.Index % size
+	mask := byte(1) << (pos % 8)
+	if window.Missed[pos/8]&mask != 0 {
+		window.MissedCount--
+	}
+
+	if vote.SignedLastBlock {
+		window.Missed[pos/8] &^= mask
+	} else {
+		window.Missed[pos/8] |= mask
+		window.MissedCount++
+	}
+	window.Index++
+
+	if err := k.SetValidatorSignatureWindow(ctx, consAddr, *window); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	recorded := window.Index
+	if recorded > size {
+		recorded = size
+	}
+
+	return math.LegacyNewDec(recorded - window.MissedCount).QuoTruncate(math.LegacyNewDec(recorded)), nil
+}
+
+// scaleRewardsBySigningPerformance redistributes the signing performance reward
+// share of the rewards of the validators proportionally to their voting power
+// weighted by their signature inclusion consistency. The total of the scaled
+// rewards is never larger than the total of the rewards, the truncation dust
+// being left to the caller.
+func (k Keeper) scaleRewardsBySigningPerformance(ctx context.Context, params types.Params, bondedVotes []abci.VoteInfo, rewards []sdk.DecCoins) ([]sdk.DecCoins, error) {
+	var (
+		share       = params.SigningPerformanceRewardShare
+		pool        = sdk.DecCoins{}
+		portions    = make([]sdk.DecCoins, len(bondedVotes))
+		weights     = make([]math.LegacyDec, len(bondedVotes))
+		totalWeight = math.LegacyZeroDec()
+	)
+
+	for i, vote := range bondedVotes {
+		consistency, err := k.recordValidatorSignature(ctx, vote, params.SigningPerformanceWindow)
+		if err != nil {
+			return nil, err
+		}
+
+		portions[i] = rewards[i].MulDecTruncate(share)
+		pool = pool.Add(portions[i]...)
+		weights[i] = math.LegacyNewDec(vote.Validator.Power).Mul(consistency)
+		totalWeight = totalWeight.Add(weights[i])
+	}
+
+	// no validator signed any block of its window, keep the rewards unscaled
+	if !totalWeight.IsPositive() {
+		return rewards, nil
+	}
+
+	scaled := make([]sdk.DecCoins, len(rewards))
+	for i := range rewards {
+		scaled[i] = rewards[i].Sub(portions[i]).Add(pool.MulDecTruncate(weights[i].QuoTruncate(totalWeight))...)
+	}
+
+	return scaled, nil
+}
@@ -51,10 +51,14 @@ func TestMigrateJSON(t *testing.T) {
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
+		"max_withdraw_per_msg": 50,
+		"signing_performance_reward_share": "0.000000000000000000",
+		"signing_performance_window": "100",
 		"withdraw_addr_enabled": true
 	},
 	"previous_proposer": "",
 	"validator_accumulated_commissions": [],
+	"validator_auto_commission_withdraws": [],
 	"validator_current_rewards": [],
 	"validator_historical_rewards": [],
 	"validator_slash_events": []
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,18 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// signing_performance_reward_share is the portion of the validators rewards
+	// which is allocated proportionally to the voting power weighted by the
+	// signature inclusion consistency of the validators over the last
+	// signing_performance_window blocks. Zero disables this allocation mode.
+	SigningPerformanceRewardShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=signing_performance_reward_share,json=signingPerformanceRewardShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signing_performance_reward_share"`
+	// signing_performance_window is the number of blocks over which the
+	// signature inclusion consistency of the validators is measured.
+	SigningPerformanceWindow int64 `protobuf:"varint,6,opt,name=signing_performance_window,json=signingPerformanceWindow,proto3" json:"signing_performance_window,omitempty"`
+	// max_withdraw_per_msg is the maximum number of delegations a delegator can
+	// withdraw the rewards of with a single MsgWithdrawAllDelegatorRewards. Zero
+	// means no limit.
+	MaxWithdrawPerMsg uint32 `protobuf:"varint,7,opt,name=max_withdraw_per_msg,json=maxWithdrawPerMsg,proto3" json:"max_withdraw_per_msg,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +92,97 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetSigningPerformanceWindow() int64 {
+	if m != nil {
+		return m.SigningPerformanceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxWithdrawPerMsg() uint32 {
+	if m != nil {
+		return m.MaxWithdrawPerMsg
+	}
+	return 0
+}
+
+// ValidatorSignatureWindow is the rolling window of the inclusion of the
+// signatures of a validator in the last commits, used to measure its signature
+// inclusion consistency.
+type ValidatorSignatureWindow struct {
+	// window is the size of the window.
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// index is the number of blocks recorded since the window was created.
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// missed is a bit array of the blocks of the window whose commit didn't
+	// include the validator signature, a block is at the bit index%window.
+	Missed []byte `protobuf:"bytes,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	// missed_count is the number of blocks of the window whose commit didn't
+	// include the validator signature.
+	MissedCount int64 `protobuf:"varint,4,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+}
+
+func (m *ValidatorSignatureWindow) Reset()         { *m = ValidatorSignatureWindow{} }
+func (m *ValidatorSignatureWindow) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSignatureWindow) ProtoMessage()    {}
+func (*ValidatorSignatureWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *ValidatorSignatureWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSignatureWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSignatureWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSignatureWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSignatureWindow.Merge(m, src)
+}
+func (m *ValidatorSignatureWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSignatureWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSignatureWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSignatureWindow proto.InternalMessageInfo
+
+func (m *ValidatorSignatureWindow) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ValidatorSignatureWindow) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorSignatureWindow) GetMissed() []byte {
+	if m != nil {
+		return m.Missed
+	}
+	return nil
+}
+
+func (m *ValidatorSignatureWindow) GetMissedCount() int64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -101,7 +205,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{2}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,7 +260,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{3}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +313,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,7 +359,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +408,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +453,7 @@ func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
 func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvents) ProtoMessage()    {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +498,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,7 +555,7 @@ func (m *CommunityPoolSpendProposal) Reset()         { *m = CommunityPoolSpendPr
 func (m *CommunityPoolSpendProposal) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposal) ProtoMessage()    {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +600,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +654,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +697,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -622,8 +726,65 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCommissionWithdraw is the automatic commission withdrawal setting of a
+// validator. Once enabled, the accumulated commission of the validator is
+// withdrawn to its withdraw address at the end of the block it reaches the
+// threshold in any of the threshold denoms.
+type AutoCommissionWithdraw struct {
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+	Enabled   bool                                     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *AutoCommissionWithdraw) Reset()         { *m = AutoCommissionWithdraw{} }
+func (m *AutoCommissionWithdraw) String() string { return proto.CompactTextString(m) }
+func (*AutoCommissionWithdraw) ProtoMessage()    {}
+func (*AutoCommissionWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *AutoCommissionWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCommissionWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCommissionWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCommissionWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCommissionWithdraw.Merge(m, src)
+}
+func (m *AutoCommissionWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCommissionWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCommissionWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCommissionWithdraw proto.InternalMessageInfo
+
+func (m *AutoCommissionWithdraw) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
+	}
+	return nil
+}
+
+func (m *AutoCommissionWithdraw) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*ValidatorSignatureWindow)(nil), "cosmos.distribution.v1beta1.ValidatorSignatureWindow")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
@@ -635,6 +796,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCommissionWithdraw)(nil), "cosmos.distribution.v1beta1.AutoCommissionWithdraw")
 }
 
 func init() {
@@ -642,70 +804,82 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x1d, 0x56, 0x2a,
-	0x84, 0x40, 0x6c, 0x52, 0x2e, 0x28, 0xe2, 0x12, 0xdb, 0xad, 0xe0, 0x42, 0xa3, 0x0d, 0xa2, 0x88,
-	0xcb, 0x6a, 0xbc, 0x3b, 0xb1, 0x47, 0xdd, 0x9d, 0x59, 0x66, 0x66, 0x9d, 0xe4, 0xc0, 0x3d, 0x70,
-	0x00, 0x6e, 0xa0, 0x9e, 0x2a, 0xb8, 0x54, 0x9c, 0x72, 0xc8, 0x8d, 0x7f, 0xa0, 0xe2, 0x54, 0x15,
-	0x09, 0x21, 0x0e, 0x29, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x84, 0x52,
-	0xa9, 0xb5, 0xb8, 0x24, 0x9e, 0xef, 0xcd, 0xbe, 0xef, 0x7d, 0xef, 0xbd, 0x79, 0x33, 0xb0, 0xe6,
-	0x73, 0x19, 0x71, 0x59, 0x0f, 0xa8, 0x54, 0x82, 0xb6, 0x13, 0x45, 0x39, 0xab, 0xf7, 0x56, 0xda,
-	0x44, 0xe1, 0x95, 0x13, 0x60, 0x2d, 0x16, 0x5c, 0x71, 0x74, 0xd5, 0xec, 0xaf, 0x9d, 0x30, 0xd9,
-	0xfd, 0xe5, 0x99, 0x0e, 0xef, 0xf0, 0x74, 0x5f, 0x5d, 0xff, 0x32, 0x9f, 0x94, 0x2b, 0x96, 0xa2,
-	0x8d, 0x25, 0xc9, 0x5c, 0xfb, 0x9c, 0x5a, 0x97, 0xe5, 0x79, 0x63, 0xf7, 0xcc, 0x87, 0xd6, 0xbf,
-	0x31, 0x5d, 0xc6, 0x11, 0x65, 0xbc, 0x9e, 0xfe, 0x35, 0x90, 0xf3, 0xe3, 0x08, 0x2c, 0xae, 0x63,
-	0x81, 0x23, 0x89, 0x36, 0xe1, 0x25, 0x9f, 0x47, 0x51, 0xc2, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e,
-	0x81, 0x05, 0xb0, 0x38, 0xd1, 0x58, 0x7b, 0x78, 0x50, 0x2d, 0xfc, 0x76, 0x50, 0x7d, 0xb5, 0x43,
-	0x55, 0x37, 0x69, 0xd7, 0x7c, 0x1e, 0x59, 0xaf, 0xf6, 0xdf, 0xb2, 0x0c, 0xee, 0xd6, 0xd5, 0x4e,
-	0x4c, 0x64, 0xad, 0x45, 0xfc, 0xc7, 0xfb, 0xcb, 0xd0, 0x92, 0xb6, 0x88, 0xff, 0xe0, 0x78, 0x6f,
-	0x09, 0xb8, 0x17, 0x33, 0xbf, 0x1f, 0xe2, 0x6d, 0x94, 0xc0, 0x19, 0x1d, 0xbb, 0x0e, 0x30, 0xe6,
-	0x92, 0x08, 0x4f, 0x90, 0x2d, 0x2c, 0x82, 0xd2, 0xb9, 0x94, 0xae, 0xf9, 0xdc, 0x74, 0x25, 0xe0,
-	0x22, 0x4d, 0xb0, 0x6e, 0xfd, 0xbb, 0xa9, 0x7b, 0xb4, 0x05, 0x67, 0xdb, 0x9c, 0x25, 0xf2, 0x1f,
-	0xbc, 0x23, 0x2f, 0x8e, 0x77, 0x3a, 0x65, 0x38, 0x45, 0x7c, 0x03, 0xce, 0x6e, 0x51, 0xd5, 0x0d,
-	0x04, 0xde, 0xf2, 0x70, 0x10, 0x08, 0x8f, 0x30, 0xdc, 0x0e, 0x49, 0x50, 0x1a, 0x5d, 0x00, 0x8b,
-	0xe7, 0xdd, 0xe9, 0xbe, 0x71, 0x2d, 0x08, 0xc4, 0x4d, 0x63, 0x5a, 0xbd, 0xfe, 0xc5, 0xf1, 0xde,
-	0xd2, 0xc2, 0x00, 0xf1, 0xf6, 0xc9, 0x96, 0x32, 0x25, 0x73, 0x7e, 0x01, 0xb0, 0xfc, 0x11, 0x0e,
-	0x69, 0x80, 0x15, 0x17, 0xef, 0x51, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb1, 0x44, 0x5f, 0x02,
-	0x78, 0xc5, 0x4f, 0xa2, 0x24, 0xc4, 0x8a, 0xf6, 0x88, 0xd5, 0xeb, 0x09, 0xac, 0x28, 0x2f, 0x81,
-	0x85, 0x91, 0xc5, 0x0b, 0x37, 0xae, 0xd9, 0x86, 0xad, 0xe9, 0x84, 0xf5, 0x1b, 0x4f, 0x2b, 0x6a,
-	0x72, 0xca, 0x1a, 0xef, 0xe8, 0x9c, 0xfc, 0xf0, 0xa4, 0xfa, 0xc6, 0xb3, 0xe5, 0x44, 0x7f, 0x23,
-	0x4d, 0xc5, 0x67, 0x73, 0x5a, 0x13, 0x8c, 0xab, 0x49, 0xd1, 0x6b, 0x70, 0x4a, 0x90, 0x4d, 0x22,
-	0x08, 0xf3, 0x89, 0xe7, 0xf3, 0x84, 0xa9, 0xb4, 0xea, 0x97, 0xdc, 0xc9, 0x0c, 0x6e, 0x6a, 0xd4,
-	0xf9, 0x1e, 0xc0, 0x2b, 0x99, 0xb0, 0x66, 0x22, 0x04, 0x61, 0xaa, 0xaf, 0x2a, 0x86, 0xe3, 0x46,
-	0x89, 0x1c, 0xb2, 0x88, 0x3e, 0x0d, 0x9a, 0x83, 0xc5, 0x98, 0x08, 0xca, 0x4d, 0x8f, 0x8e, 0xba,
-	0x76, 0xe5, 0x7c, 0x0b, 0x60, 0x25, 0x8b, 0x72, 0xcd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44,
-	0xa5, 0xa4, 0x9c, 0xa1, 0x1e, 0x84, 0x7e, 0xb6, 0x1a, 0x72, 0xbc, 0x03, 0x4c, 0xce, 0x57, 0x00,
-	0x5e, 0xcd, 0x42, 0xbb, 0x9d, 0x28, 0xa9, 0x30, 0x0b, 0x28, 0xeb, 0xfc, 0x6f, 0x49, 0x74, 0xee,
-	0x01, 0x38, 0x9d, 0x45, 0xb4, 0x11, 0x62, 0xd9, 0xbd, 0xd9, 0x23, 0x4c, 0xa1, 0xd7, 0xe1, 0x4b,
-	0xbd, 0x3e, 0xec, 0xd9, 0x34, 0x83, 0x34, 0xcd, 0x53, 0x19, 0xbe, 0x9e, 0xc2, 0xe8, 0x63, 0x78,
-	0x7e, 0x53, 0x60, 0x5f, 0x9f, 0x00, 0x3b, 0x2d, 0xde, 0x7d, 0x9e, 0x53, 0xeb, 0x66, 0xde, 0x9c,
-	0xcf, 0x01, 0x9c, 0x39, 0x23, 0x38, 0x89, 0x3e, 0x85, 0x73, 0x79, 0x74, 0x52, 0x1b, 0x3c, 0x92,
-	0x5a, 0x6c, 0xda, 0xde, 0xaa, 0x3d, 0x65, 0x82, 0xd7, 0xce, 0x70, 0xd9, 0x98, 0xd0, 0x21, 0x9b,
-	0xdc, 0xcc, 0xf4, 0xce, 0xa0, 0x74, 0x76, 0x01, 0x1c, 0xbf, 0x45, 0xc8, 0x3a, 0xe7, 0x21, 0xfa,
-	0x0c, 0x4e, 0xe6, 0x33, 0x39, 0xe6, 0x3c, 0x1c, 0x72, 0xb5, 0xf2, 0x1b, 0x40, 0xd3, 0x3b, 0xdf,
-	0x9c, 0x83, 0xe5, 0xe6, 0x20, 0xb2, 0x11, 0x13, 0x16, 0x98, 0xf9, 0x86, 0x43, 0x34, 0x03, 0xc7,
-	0x14, 0x55, 0x21, 0x31, 0x37, 0x85, 0x6b, 0x16, 0x68, 0x01, 0x5e, 0x08, 0x88, 0xf4, 0x05, 0x8d,
-	0xf3, 0x42, 0xb9, 0x83, 0x10, 0xba, 0x06, 0x27, 0x04, 0xf1, 0x69, 0x4c, 0x09, 0x53, 0x66, 0xfc,
-	0xba, 0x39, 0x80, 0x76, 0x60, 0x11, 0x47, 0xe9, 0x6c, 0x18, 0x4d, 0xb5, 0xce, 0x9f, 0xa9, 0x35,
-	0x15, 0x7a, 0xcb, 0x0a, 0x5d, 0x7c, 0x06, 0xa1, 0xa9, 0xca, 0x7b, 0xc7, 0x7b, 0x4b, 0x17, 0x43,
-	0xd2, 0xc1, 0xfe, 0x8e, 0xe7, 0xe7, 0xb2, 0x2d, 0xe1, 0xea, 0xe2, 0xee, 0xfd, 0x6a, 0xe1, 0xcf,
-	0xfb, 0xd5, 0xc2, 0x4f, 0xfb, 0xcb, 0x65, 0xcb, 0xda, 0xe1, 0xbd, 0x01, 0x52, 0xa6, 0x74, 0xcc,
-	0xc0, 0x79, 0x02, 0xe0, 0x6c, 0x8b, 0x68, 0x4f, 0xba, 0x7a, 0x0a, 0x0b, 0x45, 0x59, 0xe7, 0x7d,
-	0xb6, 0x99, 0xce, 0xb8, 0x58, 0x90, 0x1e, 0xe5, 0xfa, 0xaa, 0x19, 0x6c, 0xe7, 0xc9, 0x3e, 0x6c,
-	0xbb, 0xf9, 0x0e, 0x1c, 0x93, 0x0a, 0xdf, 0x25, 0xb6, 0x95, 0x5f, 0xc0, 0x3d, 0x6b, 0xfc, 0xa1,
-	0x16, 0x2c, 0x76, 0x09, 0xed, 0x74, 0x4d, 0x6e, 0x47, 0x1b, 0x6f, 0xfe, 0x75, 0x50, 0x9d, 0xf2,
-	0x05, 0xd1, 0x23, 0x98, 0x79, 0xc6, 0xf4, 0xdd, 0xf1, 0xde, 0xd2, 0x69, 0xcc, 0xe6, 0xc2, 0x2c,
-	0x9c, 0x3f, 0x00, 0x9c, 0xb7, 0x0a, 0x29, 0x67, 0x99, 0x56, 0x7b, 0xa9, 0x7d, 0x00, 0x2f, 0xe7,
-	0xe7, 0x42, 0xdf, 0x6a, 0x44, 0x4a, 0xfb, 0x60, 0x78, 0xe5, 0xf1, 0xfe, 0xf2, 0xcb, 0x36, 0xb4,
-	0x7c, 0x3a, 0x9a, 0x2d, 0x1b, 0x4a, 0xe8, 0x21, 0x94, 0x9f, 0x78, 0x8b, 0x23, 0x06, 0x8b, 0xd9,
-	0x33, 0x60, 0x98, 0x0d, 0x6e, 0x59, 0x56, 0x47, 0x75, 0xa5, 0x9d, 0x9f, 0x01, 0xbc, 0xfe, 0xef,
-	0xfd, 0x7d, 0x87, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35, 0xa4, 0x56, 0x9f, 0x1b, 0x68, 0x75,
-	0x6d, 0xb2, 0x2b, 0x54, 0x82, 0xe3, 0x81, 0x21, 0x2e, 0x8d, 0xa5, 0x86, 0xfe, 0x72, 0xd5, 0xd9,
-	0xfd, 0xcf, 0xee, 0x6c, 0xdc, 0x7e, 0x70, 0x58, 0x01, 0x0f, 0x0f, 0x2b, 0xe0, 0xd1, 0x61, 0x05,
-	0xfc, 0x7e, 0x58, 0x01, 0x5f, 0x1f, 0x55, 0x0a, 0x8f, 0x8e, 0x2a, 0x85, 0x5f, 0x8f, 0x2a, 0x85,
-	0x4f, 0x56, 0x9e, 0x9a, 0xb3, 0x53, 0xef, 0x8c, 0x34, 0x85, 0xed, 0x62, 0xfa, 0x56, 0x7c, 0xfb,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcc, 0x9a, 0x24, 0xde, 0x0a, 0x00, 0x00,
+	// 1192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x8f, 0x14, 0x45,
+	0x14, 0x9f, 0xda, 0x3f, 0xb3, 0x6c, 0xb1, 0x80, 0x14, 0xb3, 0xcb, 0x30, 0xc0, 0xcc, 0xd0, 0x09,
+	0x3a, 0xae, 0xee, 0x8c, 0xe0, 0xc5, 0x6c, 0xb8, 0xec, 0x1f, 0x88, 0x1e, 0x94, 0x49, 0xaf, 0x11,
+	0xe3, 0xa5, 0x53, 0xd3, 0x5d, 0xdb, 0x53, 0x61, 0xba, 0xaa, 0xad, 0xaa, 0x9e, 0x99, 0x3d, 0x18,
+	0x0f, 0x5e, 0x90, 0x83, 0x7a, 0xd3, 0x70, 0x22, 0x7a, 0x21, 0x9e, 0x36, 0x91, 0xc4, 0xaf, 0x40,
+	0x3c, 0x11, 0x4c, 0x8c, 0xf1, 0x00, 0xba, 0x1c, 0xd6, 0xf8, 0x29, 0x4c, 0x75, 0xd5, 0x74, 0x0f,
+	0xb0, 0x22, 0x09, 0xac, 0x5e, 0x60, 0xde, 0x7b, 0xd5, 0xef, 0xf7, 0x7b, 0xf5, 0x5e, 0xbd, 0xf7,
+	0x16, 0x36, 0x7d, 0x2e, 0x23, 0x2e, 0x5b, 0x01, 0x95, 0x4a, 0xd0, 0x4e, 0xa2, 0x28, 0x67, 0xad,
+	0xfe, 0xb9, 0x0e, 0x51, 0xf8, 0xdc, 0x23, 0xca, 0x66, 0x2c, 0xb8, 0xe2, 0xe8, 0xa4, 0x39, 0xdf,
+	0x7c, 0xc4, 0x64, 0xcf, 0x57, 0x4a, 0x21, 0x0f, 0x79, 0x7a, 0xae, 0xa5, 0x7f, 0x99, 0x4f, 0x2a,
+	0x55, 0x0b, 0xd1, 0xc1, 0x92, 0x64, 0xae, 0x7d, 0x4e, 0xad, 0xcb, 0xca, 0x09, 0x63, 0xf7, 0xcc,
+	0x87, 0xd6, 0xbf, 0x31, 0x1d, 0xc5, 0x11, 0x65, 0xbc, 0x95, 0xfe, 0x6b, 0x54, 0xce, 0x8f, 0xd3,
+	0xb0, 0xd8, 0xc6, 0x02, 0x47, 0x12, 0x6d, 0xc2, 0x43, 0x3e, 0x8f, 0xa2, 0x84, 0x51, 0xb5, 0xe5,
+	0x29, 0x3c, 0x2c, 0x83, 0x3a, 0x68, 0xcc, 0xae, 0xae, 0xdc, 0xb9, 0x5f, 0x2b, 0xfc, 0x76, 0xbf,
+	0xf6, 0x72, 0x48, 0x55, 0x37, 0xe9, 0x34, 0x7d, 0x1e, 0x59, 0xaf, 0xf6, 0xbf, 0x25, 0x19, 0x5c,
+	0x6d, 0xa9, 0xad, 0x98, 0xc8, 0xe6, 0x3a, 0xf1, 0xef, 0xdd, 0x5e, 0x82, 0x16, 0x74, 0x9d, 0xf8,
+	0xb7, 0x76, 0xb7, 0x17, 0x81, 0x3b, 0x97, 0xf9, 0x7d, 0x1f, 0x0f, 0x51, 0x02, 0x4b, 0x9a, 0xbb,
+	0x26, 0x18, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x00, 0x8b, 0xa0, 0x3c, 0x91, 0xc2, 0xad, 0x3d, 0x37,
+	0x5c, 0x19, 0xb8, 0x48, 0x03, 0xb4, 0xad, 0x7f, 0x37, 0x75, 0x8f, 0x06, 0x70, 0xbe, 0xc3, 0x59,
+	0x22, 0x9f, 0xc0, 0x9d, 0x7c, 0x71, 0xb8, 0xc7, 0x52, 0x84, 0xc7, 0x80, 0xcf, 0xc3, 0xf9, 0x01,
+	0x55, 0xdd, 0x40, 0xe0, 0x81, 0x87, 0x83, 0x40, 0x78, 0x84, 0xe1, 0x4e, 0x8f, 0x04, 0xe5, 0xa9,
+	0x3a, 0x68, 0x1c, 0x70, 0x8f, 0x8d, 0x8c, 0x2b, 0x41, 0x20, 0x2e, 0x1a, 0x13, 0xba, 0x0e, 0x60,
+	0x5d, 0xd2, 0x90, 0x51, 0x16, 0x7a, 0x31, 0x11, 0x9b, 0x5c, 0x44, 0x98, 0xf9, 0xc4, 0x52, 0xf6,
+	0x64, 0x17, 0x0b, 0x52, 0x9e, 0x7e, 0x51, 0xf9, 0x39, 0x6d, 0xa1, 0xda, 0x39, 0x92, 0xe1, 0xbe,
+	0xa1, 0x71, 0xd0, 0x05, 0x58, 0xd9, 0x8b, 0xcb, 0x80, 0xb2, 0x80, 0x0f, 0xca, 0xc5, 0x3a, 0x68,
+	0x4c, 0xba, 0xe5, 0x27, 0x5d, 0x5c, 0x49, 0xed, 0xa8, 0x05, 0x4b, 0x11, 0x1e, 0x7a, 0xd9, 0x15,
+	0xc4, 0x44, 0x78, 0x91, 0x0c, 0xcb, 0x33, 0x75, 0xd0, 0x38, 0xe4, 0x1e, 0x8d, 0xf0, 0xf0, 0x8a,
+	0x35, 0xb5, 0x89, 0x78, 0x57, 0x86, 0xcb, 0x67, 0xaf, 0xef, 0x6e, 0x2f, 0xd6, 0xc7, 0xb8, 0x0f,
+	0x1f, 0x7d, 0x4e, 0xa6, 0x5c, 0x9d, 0xcf, 0x00, 0x2c, 0x7f, 0x80, 0x7b, 0x34, 0xc0, 0x8a, 0x8b,
+	0x0d, 0x1a, 0x32, 0xac, 0x12, 0x31, 0x02, 0x5d, 0x80, 0x45, 0x4b, 0x0f, 0xa4, 0xf4, 0xac, 0x84,
+	0x4a, 0x70, 0x9a, 0xb2, 0x80, 0x0c, 0xd3, 0x62, 0x9b, 0x74, 0x8d, 0xa0, 0x4f, 0x47, 0x54, 0x4a,
+	0x62, 0x6a, 0x61, 0xce, 0xb5, 0x12, 0x3a, 0x03, 0xe7, 0xcc, 0x2f, 0xcf, 0xe7, 0x09, 0x53, 0x69,
+	0xc2, 0x26, 0xdd, 0x83, 0x46, 0xb7, 0xa6, 0x55, 0xce, 0x2f, 0x00, 0x56, 0x32, 0x16, 0x6f, 0x53,
+	0xa9, 0xb8, 0xa0, 0x3e, 0xee, 0x99, 0xeb, 0x93, 0xe8, 0x0b, 0x00, 0x8f, 0xfb, 0x49, 0x94, 0xf4,
+	0xb0, 0xa2, 0xfd, 0x2c, 0x7d, 0x02, 0x2b, 0xca, 0xcb, 0xa0, 0x3e, 0xd9, 0x38, 0x78, 0xfe, 0x94,
+	0x6d, 0x19, 0x4d, 0x5d, 0xb2, 0xa3, 0xa7, 0xaf, 0x53, 0xb3, 0xc6, 0x29, 0x5b, 0x7d, 0x4b, 0x27,
+	0xf7, 0xfb, 0x07, 0xb5, 0xd7, 0x9e, 0x2d, 0xb9, 0xfa, 0x1b, 0x69, 0x72, 0x3a, 0x9f, 0xc3, 0x1a,
+	0x32, 0xae, 0x06, 0x45, 0xaf, 0xc0, 0x23, 0x82, 0x6c, 0x12, 0x41, 0x74, 0x06, 0x4d, 0x54, 0x13,
+	0x69, 0x22, 0x0e, 0x67, 0x6a, 0x13, 0xd8, 0x77, 0x00, 0x1e, 0xcf, 0x02, 0x5b, 0x4b, 0x84, 0x20,
+	0x4c, 0x8d, 0xa2, 0x8a, 0xe1, 0x8c, 0x89, 0x44, 0xee, 0x73, 0x10, 0x23, 0x18, 0x9d, 0xa1, 0x98,
+	0x08, 0xca, 0x4d, 0x97, 0x98, 0x72, 0xad, 0xe4, 0x7c, 0x03, 0x60, 0x35, 0x63, 0xb9, 0xe2, 0xdb,
+	0x98, 0x75, 0x72, 0x22, 0x9d, 0x25, 0xca, 0x19, 0xea, 0x43, 0xe8, 0x67, 0xd2, 0x3e, 0xf3, 0x1d,
+	0x43, 0x72, 0xbe, 0x04, 0xf0, 0x64, 0x46, 0xed, 0x72, 0xa2, 0xa4, 0xc2, 0x2c, 0xa0, 0x2c, 0xfc,
+	0xdf, 0x2e, 0xd1, 0xb9, 0x01, 0xe0, 0xb1, 0xfc, 0xc5, 0xf4, 0xb0, 0xec, 0x5e, 0xec, 0x13, 0xa6,
+	0xd0, 0xab, 0xf0, 0xa5, 0xfe, 0x48, 0xed, 0xd9, 0x6b, 0x06, 0xe9, 0x35, 0x1f, 0xc9, 0xf4, 0xed,
+	0x54, 0x8d, 0x3e, 0x84, 0x07, 0x36, 0x05, 0xf6, 0xf5, 0x3b, 0xb4, 0xfd, 0xfa, 0xc2, 0xf3, 0xb4,
+	0x1f, 0x37, 0xf3, 0xe6, 0x7c, 0x0e, 0x60, 0x69, 0x0f, 0x72, 0x12, 0x7d, 0x0c, 0x17, 0x72, 0x76,
+	0x52, 0x1b, 0x3c, 0x92, 0x5a, 0xec, 0xb5, 0xbd, 0xd1, 0x7c, 0xca, 0x0c, 0x6d, 0xee, 0xe1, 0x72,
+	0x75, 0x56, 0x53, 0x36, 0x77, 0x53, 0xea, 0xef, 0x01, 0xe9, 0x5c, 0x03, 0x70, 0xe6, 0x12, 0x21,
+	0x6d, 0xce, 0x7b, 0xe8, 0x13, 0x78, 0x38, 0x9f, 0x8a, 0x31, 0xe7, 0xbd, 0x7d, 0xce, 0x56, 0x3e,
+	0x83, 0x35, 0xbc, 0xf3, 0xf5, 0x04, 0xac, 0xac, 0x8d, 0x6b, 0x36, 0x62, 0xc2, 0x02, 0x33, 0x61,
+	0x70, 0x4f, 0xf7, 0x33, 0x45, 0x55, 0x8f, 0x98, 0x59, 0xed, 0x1a, 0x01, 0xd5, 0xe1, 0xc1, 0x80,
+	0x48, 0x5f, 0xd0, 0x38, 0x4f, 0x94, 0x3b, 0xae, 0x42, 0xa7, 0xe0, 0xac, 0x20, 0x3e, 0x8d, 0x29,
+	0x61, 0xca, 0x0c, 0x40, 0x37, 0x57, 0xa0, 0x2d, 0x58, 0xc4, 0x91, 0xed, 0x78, 0x3a, 0xd6, 0x13,
+	0x7b, 0xc6, 0x9a, 0x06, 0x7a, 0xc9, 0x06, 0xda, 0x78, 0x86, 0x40, 0xd3, 0x28, 0x6f, 0xec, 0x6e,
+	0x2f, 0xce, 0xf5, 0x48, 0x88, 0xfd, 0x2d, 0xcf, 0xcf, 0xc3, 0xb6, 0x80, 0xcb, 0x8d, 0x6b, 0x37,
+	0x6b, 0x85, 0x3f, 0x6f, 0xd6, 0x0a, 0x3f, 0xdd, 0x5e, 0xaa, 0x58, 0xd4, 0x90, 0xf7, 0xc7, 0x40,
+	0x99, 0xd2, 0x9c, 0x81, 0xf3, 0x00, 0xc0, 0xf9, 0x75, 0xa2, 0x3d, 0xe9, 0xec, 0x29, 0x2c, 0x14,
+	0x65, 0xe1, 0x3b, 0x6c, 0x33, 0xed, 0x71, 0xb1, 0x20, 0x7d, 0xca, 0xf5, 0xb0, 0x1f, 0x2f, 0xe7,
+	0xc3, 0x23, 0xb5, 0xad, 0xe6, 0x2b, 0x70, 0x5a, 0x2a, 0x7c, 0x95, 0xd8, 0x52, 0x7e, 0x01, 0x93,
+	0xd4, 0xf8, 0x43, 0xeb, 0xb0, 0xd8, 0x25, 0x34, 0xec, 0x9a, 0xbb, 0x9d, 0x5a, 0x7d, 0xfd, 0xaf,
+	0xfb, 0xb5, 0x23, 0xbe, 0x20, 0xba, 0x05, 0x33, 0xcf, 0x98, 0xbe, 0xdd, 0xdd, 0x5e, 0x7c, 0x5c,
+	0x67, 0xef, 0xc2, 0x08, 0xce, 0x1f, 0x00, 0x9e, 0xb0, 0x11, 0x52, 0xce, 0xb2, 0x58, 0xed, 0x5a,
+	0xf1, 0x1e, 0x3c, 0x9a, 0xbf, 0x0b, 0xbd, 0x57, 0x10, 0x29, 0xed, 0xca, 0x76, 0xe6, 0xde, 0xed,
+	0xa5, 0xd3, 0x96, 0x5a, 0xde, 0x1d, 0xcd, 0x91, 0x0d, 0x25, 0x74, 0x13, 0xca, 0x5f, 0xbc, 0xd5,
+	0x23, 0x06, 0x8b, 0xd9, 0x22, 0xb6, 0x9f, 0x05, 0x6e, 0x51, 0x96, 0xa7, 0x74, 0xa6, 0x9d, 0x9f,
+	0x01, 0x3c, 0xfb, 0xcf, 0xf5, 0xad, 0x37, 0x83, 0x75, 0x12, 0x73, 0x49, 0xd5, 0x3e, 0x95, 0xfa,
+	0xc2, 0x58, 0xa9, 0x6b, 0x93, 0x95, 0x50, 0x19, 0xce, 0x04, 0x06, 0xd8, 0xac, 0x59, 0xee, 0x48,
+	0x5c, 0x76, 0xae, 0xfd, 0x6b, 0x75, 0x3a, 0x3f, 0x00, 0xb8, 0xb0, 0x92, 0x28, 0x9e, 0x8f, 0xa1,
+	0xd1, 0x8e, 0x83, 0x3e, 0x85, 0xb3, 0xaa, 0x2b, 0x88, 0xec, 0xf2, 0x5e, 0x60, 0x5b, 0xc9, 0x7f,
+	0xf0, 0xbc, 0x72, 0x4c, 0x1d, 0xd9, 0x68, 0x01, 0x9d, 0x48, 0x17, 0xd0, 0x91, 0xb8, 0x7a, 0xf9,
+	0xd6, 0x4e, 0x15, 0xdc, 0xd9, 0xa9, 0x82, 0xbb, 0x3b, 0x55, 0xf0, 0xfb, 0x4e, 0x15, 0x7c, 0xf5,
+	0xb0, 0x5a, 0xb8, 0xfb, 0xb0, 0x5a, 0xf8, 0xf5, 0x61, 0xb5, 0xf0, 0xd1, 0xb9, 0xa7, 0x52, 0x78,
+	0x6c, 0x47, 0x4b, 0x19, 0x75, 0x8a, 0xe9, 0xdf, 0x18, 0x6f, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff,
+	0x10, 0x6d, 0x15, 0xeb, 0x16, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -739,6 +913,48 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.SigningPerformanceRewardShare.Equal(that1.SigningPerformanceRewardShare) {
+		return false
+	}
+	if this.SigningPerformanceWindow != that1.SigningPerformanceWindow {
+		return false
+	}
+	if this.MaxWithdrawPerMsg != that1.MaxWithdrawPerMsg {
+		return false
+	}
+	return true
+}
+func (this *ValidatorSignatureWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorSignatureWindow)
+	if !ok {
+		that2, ok := that.(ValidatorSignatureWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if !bytes.Equal(this.Missed, that1.Missed) {
+		return false
+	}
+	if this.MissedCount != that1.MissedCount {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1046,6 +1262,38 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *AutoCommissionWithdraw) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCommissionWithdraw)
+	if !ok {
+		that2, ok := that.(AutoCommissionWithdraw)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Threshold) != len(that1.Threshold) {
+		return false
+	}
+	for i := range this.Threshold {
+		if !this.Threshold[i].Equal(&that1.Threshold[i]) {
+			return false
+		}
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1066,6 +1314,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxWithdrawPerMsg != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxWithdrawPerMsg))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SigningPerformanceWindow != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.SigningPerformanceWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SigningPerformanceRewardShare.Size()
+		i -= size
+		if _, err := m.SigningPerformanceRewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1109,6 +1377,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSignatureWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSignatureWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSignatureWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Missed) > 0 {
+		i -= len(m.Missed)
+		copy(dAtA[i:], m.Missed)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Missed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1582,6 +1895,53 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCommissionWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCommissionWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCommissionWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1608,23 +1968,53 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.SigningPerformanceRewardShare.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.SigningPerformanceWindow != 0 {
+		n += 1 + sovDistribution(uint64(m.SigningPerformanceWindow))
+	}
+	if m.MaxWithdrawPerMsg != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxWithdrawPerMsg))
+	}
 	return n
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
+func (m *ValidatorSignatureWindow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
+	if m.Window != 0 {
+		n += 1 + sovDistribution(uint64(m.Window))
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	if m.Index != 0 {
+		n += 1 + sovDistribution(uint64(m.Index))
+	}
+	l = len(m.Missed)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovDistribution(uint64(m.MissedCount))
+	}
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
 	}
 	return n
 }
@@ -1813,6 +2203,24 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoCommissionWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1970,6 +2378,219 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningPerformanceRewardShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningPerformanceRewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningPerformanceWindow", wireType)
+			}
+			m.SigningPerformanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigningPerformanceWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawPerMsg", wireType)
+			}
+			m.MaxWithdrawPerMsg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawPerMsg |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSignatureWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSignatureWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSignatureWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Missed = append(m.Missed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Missed == nil {
+				m.Missed = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3264,6 +3885,110 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCommissionWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCommissionWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCommissionWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,8 +6,10 @@ const (
 	EventTypeRewards            = "rewards"
 	EventTypeCommission         = "commission"
 	EventTypeWithdrawRewards    = "withdraw_rewards"
+	EventTypeWithdrawAllRewards = "withdraw_all_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeCommissionDiverted = "commission_diverted"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,32 +12,34 @@ func NewGenesisState(
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                          params,
-		FeePool:                         fp,
-		DelegatorWithdrawInfos:          dwis,
-		PreviousProposer:                pp.String(),
-		OutstandingRewards:              r,
-		ValidatorAccumulatedCommissions: acc,
-		ValidatorHistoricalRewards:      historical,
-		ValidatorCurrentRewards:         cur,
-		DelegatorStartingInfos:          dels,
-		ValidatorSlashEvents:            slashes,
+		Params:                           params,
+		FeePool:                          fp,
+		DelegatorWithdrawInfos:           dwis,
+		PreviousProposer:                 pp.String(),
+		OutstandingRewards:               r,
+		ValidatorAccumulatedCommissions:  acc,
+		ValidatorHistoricalRewards:       historical,
+		ValidatorCurrentRewards:          cur,
+		DelegatorStartingInfos:           dels,
+		ValidatorSlashEvents:             slashes,
+		ValidatorAutoCommissionWithdraws: []ValidatorAutoCommissionWithdrawRecord{},
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		FeePool:                         InitialFeePool(),
-		Params:                          DefaultParams(),
-		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
-		PreviousProposer:                "",
-		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
-		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
-		ValidatorHistoricalRewards:      []ValidatorHistoricalRewardsRecord{},
-		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
-		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
-		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FeePool:                          InitialFeePool(),
+		Params:                           DefaultParams(),
+		DelegatorWithdrawInfos:           []DelegatorWithdrawInfo{},
+		PreviousProposer:                 "",
+		OutstandingRewards:               []ValidatorOutstandingRewardsRecord{},
+		ValidatorAccumulatedCommissions:  []ValidatorAccumulatedCommissionRecord{},
+		ValidatorHistoricalRewards:       []ValidatorHistoricalRewardsRecord{},
+		ValidatorCurrentRewards:          []ValidatorCurrentRewardsRecord{},
+		DelegatorStartingInfos:           []DelegatorStartingInfoRecord{},
+		ValidatorSlashEvents:             []ValidatorSlashEventRecord{},
+		ValidatorAutoCommissionWithdraws: []ValidatorAutoCommissionWithdrawRecord{},
 	}
 }
 
@@ -44,5 +48,74 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
-	return gs.FeePool.ValidateGenesis()
+	if err := gs.FeePool.ValidateGenesis(); err != nil {
+		return err
+	}
+
+	if gs.PreviousProposer != "" {
+		if _, err := sdk.ConsAddressFromBech32(gs.PreviousProposer); err != nil {
+			return fmt.Errorf("invalid previous proposer address: %w", err)
+		}
+	}
+	for _, dwi := range gs.DelegatorWithdrawInfos {
+		if _, err := sdk.AccAddressFromBech32(dwi.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator withdraw info delegator address: %w", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(dwi.WithdrawAddress); err != nil {
+			return fmt.Errorf("invalid delegator withdraw info withdraw address: %w", err)
+		}
+	}
+	for _, rew := range gs.OutstandingRewards {
+		if err := validateGenesisValAddr("outstanding rewards", rew.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+	for _, acc := range gs.ValidatorAccumulatedCommissions {
+		if err := validateGenesisValAddr("accumulated commission", acc.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+	for _, his := range gs.ValidatorHistoricalRewards {
+		if err := validateGenesisValAddr("historical rewards", his.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+	for _, cur := range gs.ValidatorCurrentRewards {
+		if err := validateGenesisValAddr("current rewards", cur.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+	for _, del := range gs.DelegatorStartingInfos {
+		if _, err := sdk.AccAddressFromBech32(del.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator starting info delegator address: %w", err)
+		}
+		if err := validateGenesisValAddr("delegator starting info", del.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+	for _, evt := range gs.ValidatorSlashEvents {
+		if err := validateGenesisValAddr("slash event", evt.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+	for _, rec := range gs.ValidatorAutoCommissionWithdraws {
+		if err := validateGenesisValAddr("auto commission withdraw", rec.ValidatorAddress); err != nil {
+			return err
+		}
+		if err := rec.Setting.Threshold.Validate(); err != nil {
+			return fmt.Errorf("invalid auto commission withdraw threshold: %w", err)
+		}
+		if rec.Setting.Enabled && rec.Setting.Threshold.IsZero() {
+			return fmt.Errorf("empty auto commission withdraw threshold for validator %s", rec.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisValAddr(record, valAddr string) error {
+	if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+		return fmt.Errorf("invalid %s validator address: %w", record, err)
+	}
+	return nil
 }
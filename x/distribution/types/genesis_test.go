@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestValidateGenesisAddresses(t *testing.T) {
+	delAddr := sdk.AccAddress([]byte("delegator___________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+
+	tests := []struct {
+		name    string
+		mutate  func(*types.GenesisState)
+		wantErr bool
+	}{
+		{"default", func(*types.GenesisState) {}, false},
+		{"valid addresses", func(gs *types.GenesisState) {
+			gs.PreviousProposer = sdk.ConsAddress(valAddr).String()
+			gs.DelegatorWithdrawInfos = []types.DelegatorWithdrawInfo{{DelegatorAddress: delAddr.String(), WithdrawAddress: delAddr.String()}}
+			gs.DelegatorStartingInfos = []types.DelegatorStartingInfoRecord{{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()}}
+			gs.ValidatorCurrentRewards = []types.ValidatorCurrentRewardsRecord{{ValidatorAddress: valAddr.String()}}
+		}, false},
+		{"invalid previous proposer", func(gs *types.GenesisState) {
+			gs.PreviousProposer = "invalid"
+		}, true},
+		{"invalid withdraw address", func(gs *types.GenesisState) {
+			gs.DelegatorWithdrawInfos = []types.DelegatorWithdrawInfo{{DelegatorAddress: delAddr.String(), WithdrawAddress: "invalid"}}
+		}, true},
+		{"invalid starting info delegator", func(gs *types.GenesisState) {
+			gs.DelegatorStartingInfos = []types.DelegatorStartingInfoRecord{{DelegatorAddress: "invalid", ValidatorAddress: valAddr.String()}}
+		}, true},
+		{"invalid outstanding rewards validator", func(gs *types.GenesisState) {
+			gs.OutstandingRewards = []types.ValidatorOutstandingRewardsRecord{{ValidatorAddress: delAddr.String()}}
+		}, true},
+		{"invalid slash event validator", func(gs *types.GenesisState) {
+			gs.ValidatorSlashEvents = []types.ValidatorSlashEventRecord{{ValidatorAddress: ""}}
+		}, true},
+		{"invalid auto commission withdraw validator", func(gs *types.GenesisState) {
+			gs.ValidatorAutoCommissionWithdraws = []types.ValidatorAutoCommissionWithdrawRecord{{ValidatorAddress: delAddr.String()}}
+		}, true},
+		{"empty auto commission withdraw threshold", func(gs *types.GenesisState) {
+			gs.ValidatorAutoCommissionWithdraws = []types.ValidatorAutoCommissionWithdrawRecord{{ValidatorAddress: valAddr.String(), Setting: types.AutoCommissionWithdraw{Enabled: true}}}
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			tt.mutate(gs)
+			err := types.ValidateGenesis(gs)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
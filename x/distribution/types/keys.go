@@ -41,6 +41,14 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<consAddrLen (1 Byte)><consAddr_Bytes>: ValidatorSignatureWindow
+//
+// - 0x0b<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCommissionDiversion
+//
+// - 0x0c<valAddrLen (1 Byte)><valAddr_Bytes>: AutoCommissionWithdraw
+//
+// - 0x0d: sdk.ValAddress
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +62,12 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	ValidatorSignatureWindowPrefix     = []byte{0x0a} // key for validator signature inclusion window
+	ValidatorCommissionDiversionPrefix = []byte{0x0b} // key for the fraction of the next validator commission withdrawal routed to the community pool
+
+	AutoCommissionWithdrawPrefix    = []byte{0x0c} // key for validator automatic commission withdrawal settings
+	AutoCommissionWithdrawCursorKey = []byte{0x0d} // key for the validator the next automatic commission withdrawal pass starts from
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -168,6 +182,12 @@ func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
 }
 
+// GetDelegatorStartingInfoPrefix creates the prefix key for the starting infos
+// of a validator's delegators.
+func GetDelegatorStartingInfoPrefix(v sdk.ValAddress) []byte {
+	return append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
 // GetValidatorHistoricalRewardsPrefix creates the prefix key for a validator's historical rewards.
 func GetValidatorHistoricalRewardsPrefix(v sdk.ValAddress) []byte {
 	return append(ValidatorHistoricalRewardsPrefix, address.MustLengthPrefix(v.Bytes())...)
@@ -214,3 +234,31 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetValidatorSignatureWindowKey creates the key for a validator's signature inclusion window.
+func GetValidatorSignatureWindowKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorSignatureWindowPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetValidatorCommissionDiversionKey creates the key for a validator's commission diversion.
+func GetValidatorCommissionDiversionKey(v sdk.ValAddress) []byte {
+	return append(ValidatorCommissionDiversionPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetAutoCommissionWithdrawKey creates the key for a validator's automatic commission withdrawal setting.
+func GetAutoCommissionWithdrawKey(v sdk.ValAddress) []byte {
+	return append(AutoCommissionWithdrawPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetAutoCommissionWithdrawAddress creates an address from a validator's automatic commission withdrawal key.
+func GetAutoCommissionWithdrawAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0c<valAddrLen (1 Byte)><valAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ValAddress(addr)
+}
@@ -9,17 +9,23 @@ import (
 var (
 	_ sdk.Msg = (*MsgSetWithdrawAddress)(nil)
 	_ sdk.Msg = (*MsgWithdrawDelegatorReward)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
 	_ sdk.Msg = (*MsgWithdrawValidatorCommission)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgSetAutoCommissionWithdraw)(nil)
+	_ sdk.Msg = (*MsgPruneHistoricalRewards)(nil)
 
 	_ legacytx.LegacyMsg = (*MsgSetWithdrawAddress)(nil)
 	_ legacytx.LegacyMsg = (*MsgWithdrawDelegatorReward)(nil)
+	_ legacytx.LegacyMsg = (*MsgWithdrawAllDelegatorRewards)(nil)
 	_ legacytx.LegacyMsg = (*MsgWithdrawValidatorCommission)(nil)
 	_ legacytx.LegacyMsg = (*MsgUpdateParams)(nil)
 	_ legacytx.LegacyMsg = (*MsgCommunityPoolSpend)(nil)
 	_ legacytx.LegacyMsg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ legacytx.LegacyMsg = (*MsgSetAutoCommissionWithdraw)(nil)
+	_ legacytx.LegacyMsg = (*MsgPruneHistoricalRewards)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -60,6 +66,24 @@ func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+	}
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr.String(),
@@ -152,3 +176,41 @@ func (msg MsgDepositValidatorRewardsPool) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// NewMsgSetAutoCommissionWithdraw returns a new MsgSetAutoCommissionWithdraw
+// for the given validator, threshold and enabled flag.
+func NewMsgSetAutoCommissionWithdraw(valAddr sdk.ValAddress, threshold sdk.Coins, enabled bool) *MsgSetAutoCommissionWithdraw {
+	return &MsgSetAutoCommissionWithdraw{
+		ValidatorAddress: valAddr.String(),
+		Threshold:        threshold,
+		Enabled:          enabled,
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoCommissionWithdraw) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCommissionWithdraw message
+// that the expected signer needs to sign.
+func (msg MsgSetAutoCommissionWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgPruneHistoricalRewards) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgPruneHistoricalRewards message
+// that the expected signer needs to sign.
+func (msg MsgPruneHistoricalRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -13,6 +13,10 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+		// disabled by default
+		SigningPerformanceRewardShare: math.LegacyZeroDec(),
+		SigningPerformanceWindow:      100,
+		MaxWithdrawPerMsg:             50,
 	}
 }
 
@@ -24,6 +28,37 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	if err := validateSigningPerformanceRewardShare(p.SigningPerformanceRewardShare); err != nil {
+		return err
+	}
+
+	if p.SigningPerformanceWindow < 0 {
+		return fmt.Errorf("signing performance window must be non-negative: %d", p.SigningPerformanceWindow)
+	}
+
+	if p.SigningPerformanceEnabled() && p.SigningPerformanceWindow == 0 {
+		return fmt.Errorf("signing performance window must be positive when the signing performance reward share is set")
+	}
+
+	return nil
+}
+
+// SigningPerformanceEnabled returns true if a portion of the validators rewards
+// is allocated according to their signature inclusion consistency.
+func (p Params) SigningPerformanceEnabled() bool {
+	return !p.SigningPerformanceRewardShare.IsNil() && p.SigningPerformanceRewardShare.IsPositive()
+}
+
+func validateSigningPerformanceRewardShare(v math.LegacyDec) error {
+	// params stored before the introduction of the signing performance
+	// allocation mode have no reward share, which disables it
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("signing performance reward share should be non-negative and less than one: %s", v)
+	}
+
 	return nil
 }
 
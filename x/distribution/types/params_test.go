@@ -47,3 +47,33 @@ func TestParams_ValidateBasic(t *testing.T) {
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
+
+func TestParams_ValidateBasicSigningPerformance(t *testing.T) {
+	toDec := sdk.MustNewDecFromStr
+
+	tests := []struct {
+		name    string
+		share   sdkmath.LegacyDec
+		window  int64
+		wantErr bool
+	}{
+		{"unset share", sdkmath.LegacyDec{}, 0, false},
+		{"disabled", toDec("0"), 0, false},
+		{"enabled", toDec("0.1"), 100, false},
+		{"whole rewards", toDec("1"), 100, false},
+		{"negative share", toDec("-0.1"), 100, true},
+		{"share greater than 1", toDec("1.1"), 100, true},
+		{"negative window", toDec("0"), -1, true},
+		{"enabled with zero window", toDec("0.1"), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			p.SigningPerformanceRewardShare = tt.share
+			p.SigningPerformanceWindow = tt.window
+			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
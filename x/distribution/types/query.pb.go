@@ -952,6 +952,221 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAutoCommissionWithdrawRequest is the request type for the
+// Query/AutoCommissionWithdraw RPC method
+type QueryAutoCommissionWithdrawRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryAutoCommissionWithdrawRequest) Reset()         { *m = QueryAutoCommissionWithdrawRequest{} }
+func (m *QueryAutoCommissionWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCommissionWithdrawRequest) ProtoMessage()    {}
+func (*QueryAutoCommissionWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryAutoCommissionWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCommissionWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCommissionWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCommissionWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCommissionWithdrawRequest.Merge(m, src)
+}
+func (m *QueryAutoCommissionWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCommissionWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCommissionWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCommissionWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryAutoCommissionWithdrawRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryAutoCommissionWithdrawResponse is the response type for the
+// Query/AutoCommissionWithdraw RPC method
+type QueryAutoCommissionWithdrawResponse struct {
+	// setting is the automatic commission withdrawal setting of the validator,
+	// disabled if the validator never set one.
+	Setting AutoCommissionWithdraw `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting"`
+}
+
+func (m *QueryAutoCommissionWithdrawResponse) Reset()         { *m = QueryAutoCommissionWithdrawResponse{} }
+func (m *QueryAutoCommissionWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCommissionWithdrawResponse) ProtoMessage()    {}
+func (*QueryAutoCommissionWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryAutoCommissionWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCommissionWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCommissionWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCommissionWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCommissionWithdrawResponse.Merge(m, src)
+}
+func (m *QueryAutoCommissionWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCommissionWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCommissionWithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCommissionWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCommissionWithdrawResponse) GetSetting() AutoCommissionWithdraw {
+	if m != nil {
+		return m.Setting
+	}
+	return AutoCommissionWithdraw{}
+}
+
+// QueryValidatorHistoricalRewardsRequest is the request type for the
+// Query/ValidatorHistoricalRewards RPC method
+//
+// Since: cosmos-sdk 0.48
+type QueryValidatorHistoricalRewardsRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) Reset() {
+	*m = QueryValidatorHistoricalRewardsRequest{}
+}
+func (m *QueryValidatorHistoricalRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorHistoricalRewardsRequest) ProtoMessage()    {}
+func (*QueryValidatorHistoricalRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorHistoricalRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsRequest.Merge(m, src)
+}
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorHistoricalRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorHistoricalRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorHistoricalRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorHistoricalRewardsResponse is the response type for the
+// Query/ValidatorHistoricalRewards RPC method
+//
+// Since: cosmos-sdk 0.48
+type QueryValidatorHistoricalRewardsResponse struct {
+	// records defines the historical rewards records of the validator.
+	Records []ValidatorHistoricalRewardsRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) Reset() {
+	*m = QueryValidatorHistoricalRewardsResponse{}
+}
+func (m *QueryValidatorHistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorHistoricalRewardsResponse) ProtoMessage()    {}
+func (*QueryValidatorHistoricalRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorHistoricalRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsResponse.Merge(m, src)
+}
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorHistoricalRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorHistoricalRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorHistoricalRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorHistoricalRewardsResponse) GetRecords() []ValidatorHistoricalRewardsRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -973,6 +1188,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoCommissionWithdrawRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCommissionWithdrawRequest")
+	proto.RegisterType((*QueryAutoCommissionWithdrawResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCommissionWithdrawResponse")
+	proto.RegisterType((*QueryValidatorHistoricalRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorHistoricalRewardsRequest")
+	proto.RegisterType((*QueryValidatorHistoricalRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorHistoricalRewardsResponse")
 }
 
 func init() {
@@ -980,87 +1199,98 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0xb8, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa4, 0x4e, 0x70,
-	0x68, 0x13, 0x35, 0x8a, 0xb7, 0x49, 0xa4, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0xa2, 0x46, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x36, 0x0b, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x91, 0x23, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0xc0,
-	0xa1, 0xc7, 0xaa, 0x48, 0x88, 0x13, 0xa0, 0x04, 0x89, 0x72, 0x00, 0x71, 0xe3, 0x8a, 0x76, 0x66,
-	0xd6, 0xde, 0xb5, 0xd7, 0xeb, 0x7f, 0xf2, 0x25, 0xb1, 0x66, 0xe7, 0x7d, 0xdf, 0xfb, 0xde, 0x7b,
-	0x33, 0xfb, 0xd9, 0x30, 0x9b, 0x27, 0xb4, 0x48, 0xa8, 0xac, 0x1a, 0xd4, 0xb6, 0x8c, 0x5c, 0xd9,
-	0x36, 0x88, 0x29, 0x1f, 0x2c, 0xe6, 0x34, 0x5b, 0x59, 0x94, 0xf7, 0xcb, 0x9a, 0x75, 0x98, 0x2c,
-	0x59, 0xc4, 0x26, 0x78, 0x82, 0x6f, 0x4c, 0x7a, 0x37, 0x26, 0xc5, 0x46, 0xe9, 0xa2, 0x40, 0xc9,
-	0x29, 0x54, 0xe3, 0x51, 0x55, 0x8c, 0x92, 0xa2, 0x1b, 0xa6, 0xc2, 0x76, 0x33, 0x20, 0x69, 0x4c,
-	0x27, 0x3a, 0x61, 0x1f, 0x65, 0xe7, 0x93, 0x58, 0x9d, 0xd4, 0x09, 0xd1, 0x0b, 0x9a, 0xac, 0x94,
-	0x0c, 0x59, 0x31, 0x4d, 0x62, 0xb3, 0x10, 0x2a, 0x9e, 0xc6, 0xbd, 0xf8, 0x2e, 0x72, 0x9e, 0x18,
-	0x2e, 0x66, 0x32, 0x4c, 0x85, 0x2f, 0x63, 0xbe, 0x7f, 0x9c, 0xef, 0xcf, 0xf2, 0x34, 0x84, 0x32,
-	0xfe, 0x28, 0xaa, 0x14, 0x0d, 0x93, 0xc8, 0xec, 0x2f, 0x5f, 0x4a, 0x8c, 0x01, 0xbe, 0xe5, 0x68,
-	0xba, 0xa9, 0x58, 0x4a, 0x91, 0x66, 0xb4, 0xfd, 0xb2, 0x46, 0xed, 0xc4, 0x5d, 0x38, 0xeb, 0x5b,
-	0xa5, 0x25, 0x62, 0x52, 0x0d, 0x6f, 0xc2, 0x50, 0x89, 0xad, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xbd,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x07, 0xa7, 0x86, 0x9f, 0xfc, 0x32, 0x35, 0xf0, 0xd5, 0x1f,
-	0xdf, 0x5c, 0x44, 0x19, 0x11, 0x9d, 0xa8, 0xc0, 0x79, 0x06, 0xff, 0x8e, 0x52, 0x30, 0x54, 0xc5,
-	0x26, 0xd6, 0xba, 0x27, 0xfe, 0x2d, 0x73, 0x97, 0x88, 0x3c, 0xf0, 0x36, 0x44, 0x0f, 0xdc, 0x3d,
-	0x59, 0x45, 0x55, 0x2d, 0x8d, 0x72, 0xee, 0xe1, 0xd4, 0x4b, 0xcf, 0x1e, 0x2f, 0x9c, 0x13, 0xf4,
-	0x55, 0x9c, 0x35, 0xbe, 0xe5, 0xb6, 0x6d, 0x19, 0xa6, 0x9e, 0x19, 0x3d, 0xa8, 0x5b, 0x4f, 0xfc,
-	0x1d, 0x81, 0x0b, 0xad, 0x98, 0x85, 0xd6, 0x2d, 0x18, 0x25, 0x25, 0xcd, 0xea, 0x8e, 0x79, 0xc4,
-	0x0d, 0x15, 0xcb, 0xf8, 0x01, 0x82, 0x28, 0xd5, 0x0a, 0xbb, 0xd9, 0x1c, 0x31, 0xd5, 0xac, 0xa5,
-	0x55, 0x14, 0x4b, 0xa5, 0xb1, 0xc8, 0xf4, 0x89, 0xb9, 0xd3, 0x4b, 0x93, 0x6e, 0x15, 0x9d, 0x09,
-	0xa8, 0x56, 0x6f, 0x5d, 0xcb, 0xa7, 0x89, 0x61, 0xa6, 0xae, 0x38, 0xe5, 0xfb, 0xfa, 0xd7, 0xa9,
-	0x79, 0xdd, 0xb0, 0xf7, 0xca, 0xb9, 0x64, 0x9e, 0x14, 0x45, 0x53, 0xc5, 0xbf, 0x05, 0xaa, 0x7e,
-	0x28, 0xdb, 0x87, 0x25, 0x8d, 0xba, 0x31, 0x94, 0x57, 0x7b, 0xc4, 0x21, 0x4c, 0x11, 0x53, 0xcd,
-	0x70, 0x3a, 0xbc, 0x0f, 0x90, 0x27, 0xc5, 0xa2, 0x41, 0xa9, 0x41, 0xcc, 0xd8, 0x89, 0x36, 0xc8,
-	0x97, 0xbb, 0x20, 0xcf, 0x78, 0x48, 0x12, 0x87, 0x30, 0xeb, 0xaf, 0xf7, 0x8d, 0xb2, 0x4d, 0x6d,
-	0xc5, 0x54, 0x9d, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x4f, 0x10, 0xcc, 0xb5, 0xe6, 0x16, 0xdd,
-	0xbe, 0x0b, 0xa7, 0xdc, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79, 0x77,
-	0x31, 0x13, 0xfb, 0x30, 0xe5, 0x4f, 0x25, 0x5d, 0x2d, 0x51, 0xbf, 0xe4, 0x3f, 0x42, 0x30, 0xdd,
-	0x9c, 0x53, 0xc8, 0xde, 0xf5, 0x4d, 0x04, 0x57, 0xbe, 0xda, 0x9e, 0xf2, 0xb5, 0x7c, 0xbe, 0x5c,
-	0x2c, 0x17, 0x14, 0x5b, 0x53, 0x6b, 0xc0, 0x5e, 0xf1, 0xde, 0x31, 0x78, 0x14, 0x81, 0x49, 0x7f,
-	0x32, 0xb7, 0x0b, 0x0a, 0xdd, 0xd3, 0xfa, 0xd5, 0x7c, 0x3c, 0x0b, 0x23, 0xd4, 0x56, 0x2c, 0xdb,
-	0x30, 0xf5, 0xec, 0x9e, 0x66, 0xe8, 0x7b, 0x76, 0x2c, 0x32, 0x8d, 0xe6, 0x06, 0x33, 0x2f, 0xb8,
-	0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x81, 0x33, 0x1a, 0x6b, 0x9f, 0xbb, 0xed, 0x04, 0xdb, 0xf6, 0x7f,
-	0xbe, 0x28, 0x36, 0x6d, 0x02, 0xd4, 0xae, 0xfa, 0xd8, 0x20, 0x2b, 0xd3, 0x05, 0xdf, 0xc1, 0xe1,
-	0x6f, 0x93, 0xda, 0xcd, 0xa7, 0x6b, 0x42, 0x59, 0xc6, 0x13, 0xb9, 0x32, 0xf8, 0xf0, 0xcb, 0xa9,
-	0x81, 0xc4, 0x77, 0x08, 0xce, 0x35, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x8a, 0xf2, 0xa5, 0x18,
-	0x62, 0xa7, 0xf4, 0x52, 0x7b, 0x3d, 0x61, 0x38, 0x1b, 0x07, 0x9a, 0x69, 0xfb, 0xa6, 0x50, 0x60,
-	0xe1, 0x37, 0x7d, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x8e, 0xc4, 0xf7,
-	0xae, 0x82, 0x75, 0xad, 0xa0, 0xe9, 0x6c, 0xad, 0xee, 0x30, 0x6f, 0x40, 0x54, 0xe5, 0xcf, 0x1a,
-	0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x4c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31, 0x70,
-	0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xca, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82, 0x78,
-	0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x21, 0x51,
-	0x97, 0xd3, 0x1d, 0x62, 0x2b, 0x85, 0xbe, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0xcc, 0x84, 0xf2,
-	0x8a, 0x82, 0xbc, 0x5f, 0x5f, 0x90, 0xcb, 0xa1, 0x63, 0x59, 0x43, 0x5b, 0x77, 0xb9, 0x39, 0x62,
-	0xd0, 0x15, 0x89, 0x0b, 0x70, 0xd2, 0x76, 0x48, 0xfb, 0xfc, 0x52, 0xe4, 0x24, 0x09, 0x4b, 0x5c,
-	0xc8, 0xd5, 0xcc, 0xaa, 0x23, 0xd4, 0xbf, 0x32, 0x6f, 0x89, 0x0b, 0x39, 0x90, 0x53, 0x94, 0x38,
-	0x0e, 0x50, 0x1d, 0x5a, 0x5e, 0xe5, 0xe1, 0x8c, 0x67, 0xc5, 0x83, 0x56, 0x81, 0x97, 0xfd, 0x68,
-	0x3b, 0x86, 0xbd, 0xa7, 0x5a, 0x4a, 0x45, 0x10, 0xf7, 0x4d, 0xc6, 0x81, 0x30, 0x6f, 0xcd, 0x89,
-	0x85, 0x96, 0x34, 0x8c, 0x56, 0xc4, 0xa3, 0xb6, 0x89, 0x47, 0x2a, 0x7e, 0x30, 0x0f, 0xef, 0x04,
-	0x8c, 0x33, 0x5e, 0xe7, 0x6d, 0x53, 0x36, 0x0d, 0xfb, 0xf0, 0x26, 0x21, 0x05, 0xd7, 0xb0, 0x3e,
-	0x44, 0x20, 0x05, 0x3d, 0x15, 0xa9, 0x7c, 0x00, 0x83, 0x25, 0x42, 0x0a, 0x7d, 0x3e, 0xc7, 0x8c,
-	0x63, 0xe9, 0x87, 0x28, 0x9c, 0x64, 0xa9, 0xe0, 0x2f, 0x10, 0x0c, 0x71, 0x13, 0x8c, 0xe5, 0xd0,
-	0x93, 0xd2, 0xe8, 0xc0, 0xa5, 0x4b, 0xed, 0x07, 0x70, 0x8d, 0x89, 0xf9, 0x07, 0x3f, 0xfe, 0xfe,
-	0x79, 0xe4, 0x3c, 0x9e, 0x91, 0xc3, 0xbe, 0x30, 0x70, 0x07, 0x8e, 0xff, 0x44, 0x30, 0xde, 0xd4,
-	0x03, 0xe3, 0x54, 0x6b, 0xf2, 0x56, 0xd6, 0x5d, 0x4a, 0xf7, 0x84, 0x21, 0x34, 0xa5, 0x99, 0xa6,
-	0xab, 0x78, 0x35, 0x54, 0x53, 0xed, 0x7c, 0xc8, 0xf7, 0x1a, 0x5e, 0x17, 0xf7, 0xf1, 0xc7, 0x11,
-	0x98, 0x08, 0x31, 0x6c, 0x78, 0xbd, 0x83, 0x4c, 0x9b, 0xda, 0x57, 0x69, 0xa3, 0x47, 0x14, 0xa1,
-	0x78, 0x87, 0x29, 0xbe, 0x85, 0x6f, 0xf4, 0xa0, 0x58, 0x26, 0x35, 0x7c, 0xf7, 0xbb, 0x06, 0x3e,
-	0x42, 0x70, 0x36, 0xc0, 0x0a, 0xe2, 0xd7, 0x3a, 0xc8, 0xbb, 0xc1, 0xb5, 0x4a, 0x57, 0xbb, 0x8c,
-	0x16, 0x6a, 0xb7, 0x99, 0xda, 0x6b, 0x78, 0xb3, 0x17, 0xb5, 0x35, 0x9f, 0x89, 0x7f, 0x42, 0x30,
-	0x5a, 0xef, 0xaa, 0xf0, 0xab, 0x1d, 0xe4, 0xe8, 0xb7, 0xa5, 0xd2, 0x4a, 0x37, 0xa1, 0x42, 0xdb,
-	0x75, 0xa6, 0x6d, 0x03, 0xa7, 0x7b, 0xd1, 0xe6, 0x5a, 0xb7, 0xbf, 0x10, 0x44, 0x1b, 0x9c, 0x0a,
-	0x6e, 0x23, 0xbd, 0x66, 0x0e, 0x4d, 0x5a, 0xed, 0x2a, 0x56, 0x68, 0xcb, 0x32, 0x6d, 0xef, 0xe2,
-	0x9d, 0x50, 0x6d, 0xd5, 0x97, 0x08, 0x95, 0xef, 0x35, 0xbc, 0x83, 0xee, 0xcb, 0x62, 0x32, 0x03,
-	0xcf, 0xec, 0x73, 0x04, 0x2f, 0x06, 0xbb, 0x11, 0xfc, 0x7a, 0x27, 0x89, 0x07, 0xf8, 0x27, 0xe9,
-	0x8d, 0xee, 0x01, 0x3a, 0x6a, 0x6d, 0x7b, 0xf2, 0xd9, 0xc1, 0x0c, 0xb0, 0x04, 0xed, 0x1c, 0xcc,
-	0xe6, 0xee, 0xa5, 0x9d, 0x83, 0x19, 0xe2, 0x43, 0xda, 0x3c, 0x98, 0x2d, 0x14, 0xd6, 0x66, 0x1b,
-	0xff, 0x8b, 0x20, 0xd6, 0xcc, 0x30, 0xe0, 0xb5, 0x0e, 0x72, 0x0d, 0x76, 0x39, 0x52, 0xaa, 0x17,
-	0x08, 0xa1, 0xf9, 0x0e, 0xd3, 0xbc, 0x8d, 0xb7, 0x7a, 0xd1, 0x5c, 0xef, 0x78, 0xf0, 0xb7, 0x08,
-	0xce, 0xf8, 0x4c, 0x09, 0xbe, 0xdc, 0x3a, 0xd7, 0x20, 0x8f, 0x23, 0xbd, 0xd2, 0x71, 0x9c, 0x10,
-	0xb6, 0xcc, 0x84, 0x2d, 0xe0, 0xf9, 0x50, 0x61, 0x79, 0x37, 0x36, 0xeb, 0xd8, 0x98, 0xd4, 0xf5,
-	0x27, 0x47, 0x71, 0xf4, 0xf4, 0x28, 0x8e, 0x7e, 0x3b, 0x8a, 0xa3, 0x4f, 0x8f, 0xe3, 0x03, 0x4f,
-	0x8f, 0xe3, 0x03, 0x3f, 0x1f, 0xc7, 0x07, 0xde, 0x5b, 0x0c, 0x35, 0x46, 0x1f, 0xf9, 0xd1, 0x99,
-	0x4f, 0xca, 0x0d, 0xb1, 0x1f, 0x1b, 0x97, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x2b, 0x1c,
-	0xf2, 0x92, 0x15, 0x00, 0x00,
+	// 1453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6c, 0x13, 0xc7,
+	0x17, 0xcf, 0x98, 0x00, 0x7f, 0x1e, 0x7f, 0x9a, 0x64, 0xa0, 0xc8, 0x2c, 0xe0, 0xa4, 0x9b, 0x42,
+	0x52, 0x50, 0xbc, 0x40, 0x24, 0x4a, 0xa1, 0xa8, 0x8d, 0xf3, 0x51, 0x2a, 0x50, 0x00, 0x43, 0xa1,
+	0x5f, 0xc8, 0x5a, 0xdb, 0x8b, 0xb3, 0xad, 0xbd, 0xe3, 0xec, 0x8c, 0xe3, 0x46, 0x08, 0x55, 0xa2,
+	0x17, 0xca, 0xa9, 0x6a, 0x2f, 0x3d, 0xf6, 0x58, 0xf5, 0xd4, 0x03, 0x3d, 0xb7, 0xea, 0x89, 0x23,
+	0xa2, 0x12, 0xea, 0xa9, 0xad, 0x92, 0x4a, 0xa5, 0x87, 0x56, 0xbd, 0x55, 0x3d, 0xb5, 0xda, 0x99,
+	0x59, 0x7b, 0xd7, 0xde, 0x5d, 0xaf, 0x6d, 0x7c, 0x01, 0x6b, 0x76, 0xde, 0xef, 0xbd, 0xdf, 0xfb,
+	0x98, 0xf7, 0x9e, 0x02, 0x53, 0x05, 0x42, 0x2b, 0x84, 0x6a, 0x45, 0x93, 0x32, 0xdb, 0xcc, 0xd7,
+	0x98, 0x49, 0x2c, 0x6d, 0xed, 0x78, 0xde, 0x60, 0xfa, 0x71, 0x6d, 0xb5, 0x66, 0xd8, 0xeb, 0xe9,
+	0xaa, 0x4d, 0x18, 0xc1, 0xfb, 0xc5, 0xc5, 0xb4, 0xf7, 0x62, 0x5a, 0x5e, 0x54, 0x8e, 0x48, 0x94,
+	0xbc, 0x4e, 0x0d, 0x21, 0xd5, 0xc0, 0xa8, 0xea, 0x25, 0xd3, 0xd2, 0xf9, 0x6d, 0x0e, 0xa4, 0xec,
+	0x29, 0x91, 0x12, 0xe1, 0x3f, 0x35, 0xe7, 0x97, 0x3c, 0x3d, 0x50, 0x22, 0xa4, 0x54, 0x36, 0x34,
+	0xbd, 0x6a, 0x6a, 0xba, 0x65, 0x11, 0xc6, 0x45, 0xa8, 0xfc, 0x9a, 0xf2, 0xe2, 0xbb, 0xc8, 0x05,
+	0x62, 0xba, 0x98, 0xe9, 0x28, 0x16, 0x3e, 0x8b, 0xc5, 0xfd, 0x17, 0xa2, 0xee, 0x97, 0x0c, 0xcb,
+	0xa0, 0xa6, 0xab, 0x7a, 0x9f, 0xb8, 0x9a, 0x13, 0x16, 0x4b, 0x27, 0x88, 0x4f, 0x63, 0x7a, 0xc5,
+	0xb4, 0x88, 0xc6, 0xff, 0x15, 0x47, 0xea, 0x1e, 0xc0, 0x97, 0x1d, 0xfa, 0x97, 0x74, 0x5b, 0xaf,
+	0xd0, 0xac, 0xb1, 0x5a, 0x33, 0x28, 0x53, 0x6f, 0xc0, 0x6e, 0xdf, 0x29, 0xad, 0x12, 0x8b, 0x1a,
+	0x78, 0x09, 0xb6, 0x55, 0xf9, 0x49, 0x12, 0x4d, 0xa0, 0xe9, 0x9d, 0x27, 0x26, 0xd3, 0x11, 0x3e,
+	0x4e, 0x0b, 0xe1, 0xcc, 0x8e, 0x07, 0x3f, 0x8d, 0x0f, 0x7d, 0xf9, 0xdb, 0xd7, 0x47, 0x50, 0x56,
+	0x4a, 0xab, 0x75, 0x38, 0xc4, 0xe1, 0xaf, 0xe9, 0x65, 0xb3, 0xa8, 0x33, 0x62, 0x2f, 0x78, 0xe4,
+	0x5f, 0xb7, 0x6e, 0x12, 0x69, 0x07, 0x5e, 0x86, 0xb1, 0x35, 0xf7, 0x4e, 0x4e, 0x2f, 0x16, 0x6d,
+	0x83, 0x0a, 0xdd, 0x3b, 0x32, 0xcf, 0x3d, 0xba, 0x3f, 0x73, 0x50, 0xaa, 0x6f, 0xe0, 0xcc, 0x89,
+	0x2b, 0x57, 0x98, 0x6d, 0x5a, 0xa5, 0xec, 0xe8, 0x5a, 0xcb, 0xb9, 0xfa, 0x67, 0x02, 0x0e, 0x77,
+	0xd2, 0x2c, 0xb9, 0x5e, 0x80, 0x51, 0x52, 0x35, 0xec, 0xde, 0x34, 0x8f, 0xb8, 0xa2, 0xf2, 0x18,
+	0xdf, 0x41, 0x30, 0x46, 0x8d, 0xf2, 0xcd, 0x5c, 0x9e, 0x58, 0xc5, 0x9c, 0x6d, 0xd4, 0x75, 0xbb,
+	0x48, 0x93, 0x89, 0x89, 0x2d, 0xd3, 0x3b, 0x4f, 0x1c, 0x70, 0xbd, 0xe8, 0x24, 0x4b, 0xc3, 0x7b,
+	0x0b, 0x46, 0x61, 0x9e, 0x98, 0x56, 0xe6, 0x94, 0xe3, 0xbe, 0xaf, 0x7e, 0x1e, 0x3f, 0x5a, 0x32,
+	0xd9, 0x4a, 0x2d, 0x9f, 0x2e, 0x90, 0x8a, 0x0c, 0xaa, 0xfc, 0x6f, 0x86, 0x16, 0xdf, 0xd7, 0xd8,
+	0x7a, 0xd5, 0xa0, 0xae, 0x0c, 0x15, 0xde, 0x1e, 0x71, 0x14, 0x66, 0x88, 0x55, 0xcc, 0x0a, 0x75,
+	0x78, 0x15, 0xa0, 0x40, 0x2a, 0x15, 0x93, 0x52, 0x93, 0x58, 0xc9, 0x2d, 0x31, 0x94, 0xcf, 0xf6,
+	0xa0, 0x3c, 0xeb, 0x51, 0xa2, 0xae, 0xc3, 0x94, 0xdf, 0xdf, 0x17, 0x6b, 0x8c, 0x32, 0xdd, 0x2a,
+	0x3a, 0x5e, 0x12, 0x66, 0x0d, 0x2a, 0xd6, 0x1f, 0x23, 0x98, 0xee, 0xac, 0x5b, 0x46, 0xfb, 0x06,
+	0x6c, 0x77, 0x83, 0x22, 0x52, 0xfb, 0x54, 0x64, 0x6a, 0x47, 0x40, 0x7a, 0xf3, 0xdd, 0xc5, 0x54,
+	0x57, 0x61, 0xdc, 0x6f, 0xca, 0x7c, 0xc3, 0x45, 0x83, 0xa2, 0x7f, 0x0f, 0xc1, 0x44, 0xb8, 0x4e,
+	0x49, 0xfb, 0xa6, 0x2f, 0x23, 0x04, 0xf3, 0x33, 0xf1, 0x98, 0xcf, 0x15, 0x0a, 0xb5, 0x4a, 0xad,
+	0xac, 0x33, 0xa3, 0xd8, 0x04, 0xf6, 0x92, 0xf7, 0xa6, 0xc1, 0xbd, 0x04, 0x1c, 0xf0, 0x1b, 0x73,
+	0xa5, 0xac, 0xd3, 0x15, 0x63, 0x50, 0xc1, 0xc7, 0x53, 0x30, 0x42, 0x99, 0x6e, 0x33, 0xd3, 0x2a,
+	0xe5, 0x56, 0x0c, 0xb3, 0xb4, 0xc2, 0x92, 0x89, 0x09, 0x34, 0x3d, 0x9c, 0x7d, 0xc6, 0x3d, 0x3e,
+	0xc7, 0x4f, 0xf1, 0x24, 0xec, 0x32, 0x78, 0xf8, 0xdc, 0x6b, 0x5b, 0xf8, 0xb5, 0xff, 0x8b, 0x43,
+	0x79, 0x69, 0x09, 0xa0, 0xd9, 0x15, 0x92, 0xc3, 0xdc, 0x4d, 0x87, 0x7d, 0x85, 0x23, 0x1a, 0x4f,
+	0xf3, 0xe5, 0x2b, 0x19, 0x92, 0x59, 0xd6, 0x23, 0x79, 0x7a, 0xf8, 0xee, 0x17, 0xe3, 0x43, 0xea,
+	0xb7, 0x08, 0x0e, 0x86, 0x38, 0x43, 0x86, 0xe5, 0x0d, 0xd8, 0x4e, 0xc5, 0x51, 0x12, 0xf1, 0x2a,
+	0x3d, 0x16, 0x2f, 0x26, 0x1c, 0x67, 0x71, 0xcd, 0xb0, 0x98, 0x2f, 0x0b, 0x25, 0x16, 0x7e, 0xcd,
+	0x47, 0x23, 0xc1, 0x69, 0x4c, 0x75, 0xa4, 0x21, 0x6c, 0xf2, 0xf2, 0x50, 0xbf, 0x73, 0x19, 0x2c,
+	0x18, 0x65, 0xa3, 0xc4, 0xcf, 0x5a, 0x8a, 0x79, 0x11, 0xc6, 0x8a, 0xe2, 0x5b, 0x5b, 0x3c, 0x93,
+	0x8f, 0xee, 0xcf, 0xec, 0x91, 0x4a, 0x5b, 0xc2, 0xd8, 0x10, 0x71, 0xc3, 0x18, 0x98, 0x16, 0x89,
+	0x9e, 0xd3, 0xe2, 0xf4, 0xff, 0x9c, 0x00, 0x3c, 0x71, 0x82, 0xf0, 0x29, 0x82, 0x54, 0x18, 0x05,
+	0x19, 0x85, 0xaa, 0xf7, 0x4d, 0x18, 0xe4, 0x43, 0xdd, 0x78, 0x26, 0x6a, 0xa0, 0xb6, 0xd8, 0x74,
+	0x95, 0x30, 0xbd, 0x3c, 0x10, 0xdf, 0x7a, 0x7c, 0xf1, 0x17, 0x82, 0xc9, 0x48, 0xbd, 0xd2, 0x21,
+	0xef, 0xb4, 0x3a, 0xe4, 0x64, 0x64, 0x5a, 0x36, 0xd1, 0x16, 0x5c, 0xdd, 0x02, 0x31, 0xe8, 0x89,
+	0xc4, 0x65, 0xd8, 0xca, 0x1c, 0xa5, 0x03, 0x6e, 0x8a, 0x42, 0x89, 0x6a, 0xcb, 0x07, 0xb9, 0x61,
+	0x59, 0x23, 0x85, 0x06, 0xe7, 0xe6, 0x0b, 0xf2, 0x41, 0x0e, 0xd4, 0x29, 0x5d, 0x9c, 0x02, 0x68,
+	0x24, 0xad, 0xf0, 0xf2, 0x8e, 0xac, 0xe7, 0xc4, 0x83, 0x56, 0x87, 0xe7, 0xfd, 0x68, 0xd7, 0x4d,
+	0xb6, 0x52, 0xb4, 0xf5, 0xba, 0x54, 0x3c, 0x30, 0x1a, 0x6b, 0x72, 0x78, 0x0b, 0x57, 0x2c, 0xb9,
+	0xcc, 0xc3, 0x68, 0x5d, 0x7e, 0x8a, 0xad, 0x78, 0xa4, 0xee, 0x07, 0xf3, 0xe8, 0xdd, 0x0f, 0xfb,
+	0xb8, 0x5e, 0xa7, 0xdb, 0xd4, 0x2c, 0x93, 0xad, 0x5f, 0x22, 0xa4, 0xec, 0x0e, 0xac, 0x77, 0x11,
+	0x28, 0x41, 0x5f, 0xa5, 0x29, 0xef, 0xc1, 0x70, 0x95, 0x90, 0xf2, 0x80, 0xeb, 0x98, 0xeb, 0x50,
+	0x99, 0x2c, 0xe2, 0xb9, 0x1a, 0x23, 0xcd, 0xce, 0xe8, 0x3a, 0x69, 0x50, 0xed, 0xfe, 0x43, 0x59,
+	0xc2, 0x61, 0x5a, 0xa5, 0x23, 0xde, 0x84, 0xed, 0xd4, 0x60, 0x4e, 0xff, 0x93, 0xdd, 0x7e, 0x36,
+	0xb2, 0x84, 0x83, 0xd1, 0xfc, 0xcd, 0x45, 0xc0, 0x39, 0x3d, 0xa1, 0x65, 0xb4, 0x3e, 0x67, 0x52,
+	0x46, 0x6c, 0xb3, 0xd0, 0xf6, 0x80, 0x3d, 0xed, 0x66, 0xbf, 0x14, 0xd0, 0xd7, 0x7a, 0x68, 0xcf,
+	0xea, 0x63, 0xd4, 0x3a, 0xad, 0x06, 0x50, 0x90, 0x8e, 0xcc, 0x3b, 0x6f, 0x61, 0x81, 0x34, 0xdf,
+	0xc2, 0xb3, 0xf1, 0x5a, 0x74, 0x00, 0xa2, 0x83, 0xd2, 0xf2, 0x24, 0x72, 0xe0, 0xa7, 0xd6, 0xaf,
+	0x4f, 0x7c, 0xff, 0x2c, 0x6c, 0xe5, 0xc4, 0xf0, 0xe7, 0x08, 0xb6, 0x89, 0xbd, 0x0c, 0x6b, 0x91,
+	0x06, 0xb7, 0x2f, 0x85, 0xca, 0xb1, 0xf8, 0x02, 0xc2, 0x06, 0xf5, 0xe8, 0x9d, 0x1f, 0x7e, 0xfd,
+	0x2c, 0x71, 0x08, 0x4f, 0x6a, 0x51, 0xeb, 0xab, 0x58, 0x0a, 0xf1, 0xef, 0x08, 0xf6, 0x85, 0xae,
+	0x65, 0x38, 0xd3, 0x59, 0x79, 0xa7, 0x6d, 0x52, 0x99, 0xef, 0x0b, 0x43, 0x72, 0x9a, 0xe7, 0x9c,
+	0xce, 0xe2, 0x33, 0x91, 0x9c, 0x9a, 0x4f, 0xb6, 0x76, 0xab, 0x2d, 0xd7, 0x6f, 0xe3, 0x8f, 0x12,
+	0xb0, 0x3f, 0x62, 0x87, 0xc0, 0x0b, 0x5d, 0x58, 0x1a, 0xba, 0x51, 0x29, 0x8b, 0x7d, 0xa2, 0x48,
+	0xc6, 0xd7, 0x39, 0xe3, 0xcb, 0xf8, 0x62, 0x1f, 0x8c, 0x35, 0xd2, 0xc4, 0x77, 0xd7, 0x5f, 0xbc,
+	0x81, 0x60, 0x77, 0xc0, 0x76, 0x82, 0x5f, 0xee, 0xc2, 0xee, 0xb6, 0x45, 0x4a, 0x39, 0xdb, 0xa3,
+	0xb4, 0x64, 0xbb, 0xcc, 0xd9, 0x9e, 0xc3, 0x4b, 0xfd, 0xb0, 0x6d, 0xae, 0x3e, 0xf8, 0x31, 0x82,
+	0xd1, 0xd6, 0x41, 0x1f, 0xbf, 0xd4, 0x85, 0x8d, 0xfe, 0x4d, 0x49, 0x39, 0xdd, 0x8b, 0xa8, 0xe4,
+	0x76, 0x9e, 0x73, 0x5b, 0xc4, 0xf3, 0xfd, 0x70, 0x73, 0xb7, 0x89, 0x3f, 0x10, 0x8c, 0xb5, 0x0d,
+	0xcf, 0x38, 0x86, 0x79, 0x61, 0x4b, 0x83, 0x72, 0xa6, 0x27, 0x59, 0xc9, 0x2d, 0xc7, 0xb9, 0xbd,
+	0x85, 0xaf, 0x47, 0x72, 0x6b, 0xcc, 0x35, 0x54, 0xbb, 0xd5, 0x36, 0x16, 0xdd, 0xd6, 0x64, 0x66,
+	0x06, 0xd6, 0xec, 0x13, 0x04, 0x7b, 0x83, 0x07, 0x64, 0xfc, 0x4a, 0x37, 0x86, 0x07, 0x8c, 0xf4,
+	0xca, 0xab, 0xbd, 0x03, 0x74, 0x15, 0xda, 0x78, 0xf4, 0x79, 0x61, 0x06, 0x4c, 0xa9, 0x71, 0x0a,
+	0x33, 0x7c, 0xa0, 0x8e, 0x53, 0x98, 0x11, 0xa3, 0x71, 0xcc, 0xc2, 0xec, 0xc0, 0xb0, 0x99, 0xdb,
+	0xf8, 0x6f, 0x04, 0xc9, 0xb0, 0x19, 0x16, 0xcf, 0x75, 0x61, 0x6b, 0xf0, 0xe0, 0xad, 0x64, 0xfa,
+	0x81, 0x90, 0x9c, 0xaf, 0x72, 0xce, 0xcb, 0xf8, 0x42, 0x3f, 0x9c, 0x5b, 0x87, 0x70, 0xfc, 0x0d,
+	0x82, 0x5d, 0xbe, 0x39, 0x19, 0x9f, 0xec, 0x6c, 0x6b, 0xd0, 0xd8, 0xad, 0xbc, 0xd8, 0xb5, 0x9c,
+	0x24, 0x36, 0xcb, 0x89, 0xcd, 0xe0, 0xa3, 0x91, 0xc4, 0x0a, 0xae, 0x6c, 0xce, 0x99, 0xac, 0xf1,
+	0x3f, 0x08, 0xf6, 0x06, 0x4f, 0xa4, 0x71, 0x2a, 0x30, 0x72, 0x1e, 0x8f, 0x53, 0x81, 0xd1, 0xa3,
+	0xb5, 0xfa, 0x2e, 0xa7, 0x74, 0x0d, 0x5f, 0xed, 0xe7, 0x71, 0xd5, 0x6b, 0x8c, 0xe4, 0x9a, 0xdd,
+	0x23, 0xe7, 0xc6, 0x0e, 0xff, 0x8b, 0x40, 0x09, 0x1f, 0x22, 0x71, 0x37, 0xa3, 0x4d, 0xd8, 0x5c,
+	0xae, 0x2c, 0xf4, 0x07, 0x22, 0xfd, 0x70, 0x8d, 0xfb, 0xe1, 0x12, 0x5e, 0xee, 0xc7, 0x0f, 0x2b,
+	0x0d, 0x78, 0x77, 0x5a, 0xc8, 0x9c, 0x7f, 0xb0, 0x91, 0x42, 0x0f, 0x37, 0x52, 0xe8, 0x97, 0x8d,
+	0x14, 0xfa, 0x64, 0x33, 0x35, 0xf4, 0x70, 0x33, 0x35, 0xf4, 0xe3, 0x66, 0x6a, 0xe8, 0xed, 0xe3,
+	0x91, 0x9b, 0xda, 0x07, 0x7e, 0x03, 0xf8, 0xe2, 0x96, 0xdf, 0xc6, 0xff, 0xfa, 0x31, 0xfb, 0x5f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0x16, 0x4a, 0xb4, 0x4e, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1096,6 +1326,14 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCommissionWithdraw queries the automatic commission withdrawal setting
+	// of a validator.
+	AutoCommissionWithdraw(ctx context.Context, in *QueryAutoCommissionWithdrawRequest, opts ...grpc.CallOption) (*QueryAutoCommissionWithdrawResponse, error)
+	// ValidatorHistoricalRewards queries the historical rewards records of a
+	// validator, with their reference counts.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -1196,6 +1434,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCommissionWithdraw(ctx context.Context, in *QueryAutoCommissionWithdrawRequest, opts ...grpc.CallOption) (*QueryAutoCommissionWithdrawResponse, error) {
+	out := new(QueryAutoCommissionWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCommissionWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error) {
+	out := new(QueryValidatorHistoricalRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorHistoricalRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1219,6 +1475,14 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCommissionWithdraw queries the automatic commission withdrawal setting
+	// of a validator.
+	AutoCommissionWithdraw(context.Context, *QueryAutoCommissionWithdrawRequest) (*QueryAutoCommissionWithdrawResponse, error)
+	// ValidatorHistoricalRewards queries the historical rewards records of a
+	// validator, with their reference counts.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorHistoricalRewards(context.Context, *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1255,6 +1519,12 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoCommissionWithdraw(ctx context.Context, req *QueryAutoCommissionWithdrawRequest) (*QueryAutoCommissionWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCommissionWithdraw not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorHistoricalRewards(ctx context.Context, req *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorHistoricalRewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1440,6 +1710,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCommissionWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCommissionWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCommissionWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCommissionWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCommissionWithdraw(ctx, req.(*QueryAutoCommissionWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorHistoricalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorHistoricalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorHistoricalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ValidatorHistoricalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorHistoricalRewards(ctx, req.(*QueryValidatorHistoricalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1484,6 +1790,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCommissionWithdraw",
+			Handler:    _Query_AutoCommissionWithdraw_Handler,
+		},
+		{
+			MethodName: "ValidatorHistoricalRewards",
+			Handler:    _Query_ValidatorHistoricalRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2197,69 +2511,223 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoCommissionWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAutoCommissionWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAutoCommissionWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoCommissionWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCommissionWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCommissionWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.SelfBondRewards) > 0 {
-		for _, e := range m.SelfBondRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Setting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.Commission) > 0 {
-		for _, e := range m.Commission {
-			l = e.Size()
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for _, e := range m.SelfBondRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
@@ -2500,6 +2968,66 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCommissionWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCommissionWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Setting.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorHistoricalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorHistoricalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4337,6 +4865,409 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCommissionWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCommissionWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCommissionWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCommissionWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCommissionWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCommissionWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Setting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ValidatorHistoricalRewardsRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,8 +26,9 @@ func GetQueryCmd() *cobra.Command {
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
 $ %s query %s --page=2 --limit=50
+$ %s query %s stats
 `,
-				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
@@ -38,6 +39,37 @@ $ %s query %s --page=2 --limit=50
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
+	cmd.AddCommand(GetCmdQueryEvidenceStats())
+
+	return cmd
+}
+
+// GetCmdQueryEvidenceStats returns the command querying the statistics of the
+// evidence rejected by the evidence module.
+func GetCmdQueryEvidenceStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stats",
+		Short:   "Query the number of pieces of rejected evidence by reason and the most recently rejected ones",
+		Example: fmt.Sprintf("$ %s query %s stats", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EvidenceStats(cmd.Context(), &types.QueryEvidenceStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
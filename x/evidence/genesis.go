@@ -30,6 +30,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 
 		k.SetEvidence(ctx, evi)
 	}
+
+	k.SetRejectionCounts(ctx, gs.RejectionCounts)
+	k.SetRecentRejectedEvidence(ctx, gs.RejectedEvidenceSeq, gs.RecentRejections)
 }
 
 // ExportGenesis returns the evidence module's exported genesis.
@@ -48,6 +51,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		evidence[i] = any
 	}
 	return &types.GenesisState{
-		Evidence: evidence,
+		Evidence:            evidence,
+		RejectionCounts:     k.GetRejectionCounts(ctx),
+		RejectedEvidenceSeq: k.GetRejectedEvidenceSeq(ctx),
+		RecentRejections:    k.GetRecentRejectedEvidence(ctx),
 	}
 }
@@ -96,6 +96,50 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				suite.Empty(suite.keeper.GetAllEvidence(suite.ctx))
 			},
 		},
+		{
+			"valid rejections",
+			func() {
+				genesisState = types.NewGenesisState(nil)
+				genesisState.RejectionCounts = []types.RejectionCount{
+					{Reason: types.RejectionReasonTooOld, Count: 120},
+					{Reason: types.RejectionReasonValidatorNotFound, Count: 30},
+				}
+				genesisState.RejectedEvidenceSeq = 150
+				genesisState.RecentRejections = make([]types.RejectedEvidence, types.MaxRecentRejectedEvidence)
+				for i := range genesisState.RecentRejections {
+					genesisState.RecentRejections[i] = types.RejectedEvidence{
+						Hash:            fmt.Sprintf("%064X", i),
+						Route:           types.RouteEquivocation,
+						Height:          int64(150 - i),
+						Reason:          types.RejectionReasonTooOld,
+						RejectionHeight: int64(200 - i),
+					}
+				}
+			},
+			true,
+			func() {
+				suite.Equal(genesisState.RejectionCounts, suite.keeper.GetRejectionCounts(suite.ctx))
+				suite.Equal(uint64(150), suite.keeper.GetRejectedEvidenceSeq(suite.ctx))
+				suite.Equal(genesisState.RecentRejections, suite.keeper.GetRecentRejectedEvidence(suite.ctx))
+
+				exportedGenesis := evidence.ExportGenesis(suite.ctx, suite.keeper)
+				suite.Equal(genesisState.RejectionCounts, exportedGenesis.RejectionCounts)
+				suite.Equal(genesisState.RejectedEvidenceSeq, exportedGenesis.RejectedEvidenceSeq)
+				suite.Equal(genesisState.RecentRejections, exportedGenesis.RecentRejections)
+			},
+		},
+		{
+			"invalid rejected evidence sequence",
+			func() {
+				genesisState = types.NewGenesisState(nil)
+				genesisState.RejectionCounts = []types.RejectionCount{{Reason: types.RejectionReasonTooOld, Count: 10}}
+				genesisState.RejectedEvidenceSeq = 5
+			},
+			false,
+			func() {
+				suite.Empty(suite.keeper.GetRejectionCounts(suite.ctx))
+			},
+		},
 	}
 
 	for _, tc := range testCases {
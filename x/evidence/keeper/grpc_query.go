@@ -90,3 +90,16 @@ func (k Keeper) AllEvidence(c context.Context, req *types.QueryAllEvidenceReques
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// EvidenceStats implements the Query/EvidenceStats gRPC method
+func (k Keeper) EvidenceStats(c context.Context, req *types.QueryEvidenceStatsRequest) (*types.QueryEvidenceStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEvidenceStatsResponse{
+		RejectionCounts:  k.GetRejectionCounts(ctx),
+		RecentRejections: k.GetRecentRejectedEvidence(ctx),
+	}, nil
+}
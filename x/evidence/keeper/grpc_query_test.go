@@ -145,3 +145,10 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEvidenceStats() {
+	res, err := suite.queryClient.EvidenceStats(suite.ctx, &types.QueryEvidenceStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RejectionCounts)
+	suite.Require().Empty(res.RecentRejections)
+}
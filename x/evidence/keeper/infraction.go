@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/x/evidence/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -21,16 +23,23 @@ import (
 // - the signing info does not exist (will panic)
 // - is already tombstoned
 //
+// Invalid evidence is ignored, and recorded in the rejection statistics of the
+// keeper unless the signing info does not exist.
+//
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
 func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
 	logger := k.Logger(ctx)
-	consAddr := evidence.GetConsensusAddress()
+
+	// the infraction may have been committed with a consensus pubkey the
+	// validator has rotated since, attribute it to the current one
+	consAddr := k.stakingKeeper.ValidatorIdentifier(ctx, evidence.GetConsensusAddress())
 
 	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
 	if validator == nil || validator.IsUnbonded() {
 		// Defensive: Simulation doesn't take unbonding periods into account, and
 		// CometBFT might break this assumption at some point.
+		k.rejectEvidence(ctx, evidence, types.RejectionReasonValidatorNotFound)
 		return
 	}
 
@@ -46,32 +55,26 @@ func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 			// getting this coordination right, it is easier to relax the
 			// constraints and ignore evidence that cannot be handled.
 			logger.Error(fmt.Sprintf("ignore evidence; expected public key for validator %s not found", consAddr))
+			k.rejectEvidence(ctx, evidence, types.RejectionReasonValidatorNotFound)
 			return
 		}
 	}
 
-	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
-	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
-	ageBlocks := ctx.BlockHeader().Height - infractionHeight
-
-	// Reject evidence if the double-sign is too old. Evidence is considered stale
-	// if the difference in time and number of blocks is greater than the allowed
-	// parameters defined.
-	cp := ctx.ConsensusParams()
-	if cp.Evidence != nil {
-		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
-			logger.Info(
-				"ignored equivocation; evidence too old",
-				"validator", consAddr,
-				"infraction_height", infractionHeight,
-				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
-				"infraction_time", infractionTime,
-				"max_age_duration", cp.Evidence.MaxAgeDuration,
-			)
-			return
-		}
+
+	// Reject evidence if the double-sign is too old.
+	if cp := ctx.ConsensusParams(); cp.Evidence != nil && isEvidenceTooOld(ctx, cp.Evidence, infractionHeight, infractionTime) {
+		logger.Info(
+			"ignored equivocation; evidence too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
+			"infraction_time", infractionTime,
+			"max_age_duration", cp.Evidence.MaxAgeDuration,
+		)
+		k.rejectEvidence(ctx, evidence, types.RejectionReasonTooOld)
+		return
 	}
 
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
@@ -86,6 +89,7 @@ func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 			"infraction_height", infractionHeight,
 			"infraction_time", infractionTime,
 		)
+		k.rejectEvidence(ctx, evidence, types.RejectionReasonAlreadyTombstoned)
 		return
 	}
 
@@ -119,10 +123,24 @@ func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
-		k.slashingKeeper.Jail(ctx, consAddr)
+		k.slashingKeeper.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, fmt.Sprintf(
+			"double-signed at height %d", infractionHeight,
+		))
 	}
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
 	k.SetEvidence(ctx, evidence)
 }
+
+// isEvidenceTooOld returns whether evidence of an infraction committed at the
+// given height and time is stale. As in CometBFT, evidence is stale only once
+// it is older than both max_age_num_blocks blocks and max_age_duration, so
+// that it remains valid for the whole unbonding period whatever the block
+// times are.
+func isEvidenceTooOld(ctx sdk.Context, params *cmtproto.EvidenceParams, infractionHeight int64, infractionTime time.Time) bool {
+	ageBlocks := ctx.BlockHeight() - infractionHeight
+	ageDuration := ctx.BlockTime().Sub(infractionTime)
+
+	return ageBlocks > params.MaxAgeNumBlocks && ageDuration > params.MaxAgeDuration
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rejectEvidence records that the given evidence was ignored for the given
+// reason: the rejection counter of the reason is incremented, a summary of the
+// evidence is written to the ring buffer of recently rejected evidence and an
+// evidence_rejected event is emitted.
+func (k Keeper) rejectEvidence(ctx sdk.Context, evidence exported.Evidence, reason types.RejectionReason) {
+	store := ctx.KVStore(k.storeKey)
+	hash := strings.ToUpper(hex.EncodeToString(evidence.Hash()))
+
+	countStore := prefix.NewStore(store, types.KeyPrefixRejectionCount)
+	countKey := sdk.Uint64ToBigEndian(uint64(reason))
+	var count uint64
+	if bz := countStore.Get(countKey); bz != nil {
+		count = binary.BigEndian.Uint64(bz)
+	}
+	countStore.Set(countKey, sdk.Uint64ToBigEndian(count+1))
+
+	var seq uint64
+	if bz := store.Get(types.KeyRejectedEvidenceSeq); bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+	rejected := types.RejectedEvidence{
+		Hash:            hash,
+		Route:           evidence.Route(),
+		Height:          evidence.GetHeight(),
+		Reason:          reason,
+		RejectionHeight: ctx.BlockHeight(),
+	}
+	prefix.NewStore(store, types.KeyPrefixRejectedEvidence).Set(
+		sdk.Uint64ToBigEndian(seq%types.MaxRecentRejectedEvidence), k.cdc.MustMarshal(&rejected),
+	)
+	store.Set(types.KeyRejectedEvidenceSeq, sdk.Uint64ToBigEndian(seq+1))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEvidenceRejected,
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, hash),
+			sdk.NewAttribute(types.AttributeKeyEvidenceHeight, fmt.Sprintf("%d", evidence.GetHeight())),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.String()),
+		),
+	)
+}
+
+// GetRejectionCounts returns the number of pieces of evidence rejected for each
+// reason, omitting the reasons without rejections.
+func (k Keeper) GetRejectionCounts(ctx sdk.Context) []types.RejectionCount {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRejectionCount).Iterator(nil, nil)
+	defer iter.Close()
+
+	var counts []types.RejectionCount
+	for ; iter.Valid(); iter.Next() {
+		counts = append(counts, types.RejectionCount{
+			Reason: types.RejectionReason(binary.BigEndian.Uint64(iter.Key())),
+			Count:  binary.BigEndian.Uint64(iter.Value()),
+		})
+	}
+
+	return counts
+}
+
+// GetRecentRejectedEvidence returns the summaries of the last
+// MaxRecentRejectedEvidence pieces of rejected evidence, newest first.
+func (k Keeper) GetRecentRejectedEvidence(ctx sdk.Context) []types.RejectedEvidence {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRejectedEvidenceSeq)
+	if bz == nil {
+		return nil
+	}
+
+	seq := binary.BigEndian.Uint64(bz)
+	n := seq
+	if n > types.MaxRecentRejectedEvidence {
+		n = types.MaxRecentRejectedEvidence
+	}
+
+	rejectedStore := prefix.NewStore(store, types.KeyPrefixRejectedEvidence)
+	rejected := make([]types.RejectedEvidence, n)
+	for i := uint64(0); i < n; i++ {
+		k.cdc.MustUnmarshal(rejectedStore.Get(sdk.Uint64ToBigEndian((seq-1-i)%types.MaxRecentRejectedEvidence)), &rejected[i])
+	}
+
+	return rejected
+}
+
+// GetRejectedEvidenceSeq returns the total number of pieces of evidence
+// rejected, which positions the recently rejected evidence in the ring buffer.
+func (k Keeper) GetRejectedEvidenceSeq(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyRejectedEvidenceSeq)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetRejectionCounts sets the number of pieces of evidence rejected for each
+// reason.
+func (k Keeper) SetRejectionCounts(ctx sdk.Context, counts []types.RejectionCount) {
+	countStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRejectionCount)
+	for _, count := range counts {
+		countStore.Set(sdk.Uint64ToBigEndian(uint64(count.Reason)), sdk.Uint64ToBigEndian(count.Count))
+	}
+}
+
+// SetRecentRejectedEvidence sets the total number of pieces of evidence
+// rejected and the summaries of the most recently rejected ones, newest first,
+// as returned by GetRejectedEvidenceSeq and GetRecentRejectedEvidence.
+func (k Keeper) SetRecentRejectedEvidence(ctx sdk.Context, seq uint64, rejected []types.RejectedEvidence) {
+	store := ctx.KVStore(k.storeKey)
+	rejectedStore := prefix.NewStore(store, types.KeyPrefixRejectedEvidence)
+	for i := range rejected {
+		rejectedStore.Set(sdk.Uint64ToBigEndian((seq-1-uint64(i))%types.MaxRecentRejectedEvidence), k.cdc.MustMarshal(&rejected[i]))
+	}
+
+	if seq > 0 {
+		store.Set(types.KeyRejectedEvidenceSeq, sdk.Uint64ToBigEndian(seq))
+	}
+}
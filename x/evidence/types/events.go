@@ -2,7 +2,10 @@ package types
 
 // evidence module events
 const (
-	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSubmitEvidence   = "submit_evidence"
+	EventTypeEvidenceRejected = "evidence_rejected"
 
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyEvidenceHash   = "evidence_hash"
+	AttributeKeyEvidenceHeight = "evidence_height"
+	AttributeKeyReason         = "reason"
 )
@@ -29,6 +29,48 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RejectionReason enumerates the reasons why evidence of misbehavior is
+// ignored by the evidence module.
+//
+// Since: cosmos-sdk 0.48
+type RejectionReason int32
+
+const (
+	// REJECTION_REASON_UNSPECIFIED defines an empty rejection reason.
+	RejectionReasonUnspecified RejectionReason = 0
+	// REJECTION_REASON_TOO_OLD defines evidence older than both the
+	// max_age_num_blocks and max_age_duration consensus parameters.
+	RejectionReasonTooOld RejectionReason = 1
+	// REJECTION_REASON_VALIDATOR_NOT_FOUND defines evidence against a validator
+	// which does not exist, is unbonded, or has no known consensus public key.
+	RejectionReasonValidatorNotFound RejectionReason = 2
+	// REJECTION_REASON_ALREADY_TOMBSTONED defines evidence against a validator
+	// which is already tombstoned.
+	RejectionReasonAlreadyTombstoned RejectionReason = 3
+)
+
+var RejectionReason_name = map[int32]string{
+	0: "REJECTION_REASON_UNSPECIFIED",
+	1: "REJECTION_REASON_TOO_OLD",
+	2: "REJECTION_REASON_VALIDATOR_NOT_FOUND",
+	3: "REJECTION_REASON_ALREADY_TOMBSTONED",
+}
+
+var RejectionReason_value = map[string]int32{
+	"REJECTION_REASON_UNSPECIFIED":         0,
+	"REJECTION_REASON_TOO_OLD":             1,
+	"REJECTION_REASON_VALIDATOR_NOT_FOUND": 2,
+	"REJECTION_REASON_ALREADY_TOMBSTONED":  3,
+}
+
+func (x RejectionReason) String() string {
+	return proto.EnumName(RejectionReason_name, int32(x))
+}
+
+func (RejectionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{0}
+}
+
 // Equivocation implements the Evidence interface and defines evidence of double
 // signing misbehavior.
 type Equivocation struct {
@@ -75,8 +117,153 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// RejectedEvidence summarizes evidence of misbehavior ignored by the evidence
+// module.
+//
+// Since: cosmos-sdk 0.48
+type RejectedEvidence struct {
+	// hash is the HEX encoded hash of the evidence.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// route is the route of the evidence type, e.g. "equivocation".
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// height is the height of the infraction.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// reason is the reason why the evidence was rejected.
+	Reason RejectionReason `protobuf:"varint,4,opt,name=reason,proto3,enum=cosmos.evidence.v1beta1.RejectionReason" json:"reason,omitempty"`
+	// rejection_height is the block height at which the evidence was rejected.
+	RejectionHeight int64 `protobuf:"varint,5,opt,name=rejection_height,json=rejectionHeight,proto3" json:"rejection_height,omitempty"`
+}
+
+func (m *RejectedEvidence) Reset()         { *m = RejectedEvidence{} }
+func (m *RejectedEvidence) String() string { return proto.CompactTextString(m) }
+func (*RejectedEvidence) ProtoMessage()    {}
+func (*RejectedEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *RejectedEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectedEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RejectedEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedEvidence.Merge(m, src)
+}
+func (m *RejectedEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedEvidence proto.InternalMessageInfo
+
+func (m *RejectedEvidence) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *RejectedEvidence) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *RejectedEvidence) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RejectedEvidence) GetReason() RejectionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RejectionReasonUnspecified
+}
+
+func (m *RejectedEvidence) GetRejectionHeight() int64 {
+	if m != nil {
+		return m.RejectionHeight
+	}
+	return 0
+}
+
+// RejectionCount is the number of pieces of evidence rejected for a reason.
+//
+// Since: cosmos-sdk 0.48
+type RejectionCount struct {
+	// reason is the rejection reason.
+	Reason RejectionReason `protobuf:"varint,1,opt,name=reason,proto3,enum=cosmos.evidence.v1beta1.RejectionReason" json:"reason,omitempty"`
+	// count is the number of pieces of evidence rejected for this reason.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *RejectionCount) Reset()         { *m = RejectionCount{} }
+func (m *RejectionCount) String() string { return proto.CompactTextString(m) }
+func (*RejectionCount) ProtoMessage()    {}
+func (*RejectionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *RejectionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RejectionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectionCount.Merge(m, src)
+}
+func (m *RejectionCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectionCount proto.InternalMessageInfo
+
+func (m *RejectionCount) GetReason() RejectionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RejectionReasonUnspecified
+}
+
+func (m *RejectionCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evidence.v1beta1.RejectionReason", RejectionReason_name, RejectionReason_value)
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*RejectedEvidence)(nil), "cosmos.evidence.v1beta1.RejectedEvidence")
+	proto.RegisterType((*RejectionCount)(nil), "cosmos.evidence.v1beta1.RejectionCount")
 }
 
 func init() {
@@ -84,31 +271,113 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x51, 0x4f, 0xd3, 0x50,
+	0x18, 0xed, 0x65, 0x83, 0x84, 0xab, 0x42, 0x69, 0xa6, 0x8e, 0x46, 0xbb, 0x06, 0x89, 0x99, 0x24,
+	0xb4, 0x01, 0x1f, 0x4c, 0x34, 0x26, 0x74, 0xac, 0xc4, 0x19, 0x68, 0xcd, 0x5d, 0x21, 0xd1, 0x97,
+	0xa6, 0x6b, 0x2f, 0x5b, 0x65, 0xeb, 0x9d, 0xbd, 0x77, 0x28, 0xff, 0x80, 0xec, 0x89, 0x3f, 0x40,
+	0x42, 0xe2, 0x0b, 0x8f, 0x98, 0xf8, 0x07, 0x7c, 0xe3, 0x91, 0xf8, 0xe4, 0x93, 0x9a, 0xf1, 0x00,
+	0x3f, 0xc3, 0xec, 0xb6, 0x4c, 0xdc, 0xf4, 0xc5, 0x97, 0xe5, 0x9e, 0x73, 0xbf, 0x73, 0xce, 0xf7,
+	0x7d, 0x37, 0x2b, 0x7c, 0xe8, 0x13, 0xda, 0x22, 0x54, 0xc7, 0xbb, 0x61, 0x80, 0x23, 0x1f, 0xeb,
+	0xbb, 0x4b, 0x35, 0xcc, 0xbc, 0xa5, 0x01, 0xa1, 0xb5, 0x63, 0xc2, 0x88, 0x74, 0x37, 0xa9, 0xd3,
+	0x06, 0x74, 0x5a, 0x27, 0xcf, 0x78, 0xad, 0x30, 0x22, 0x3a, 0xff, 0x4d, 0x6a, 0xe5, 0x5c, 0x9d,
+	0xd4, 0x09, 0x3f, 0xea, 0xfd, 0x53, 0xca, 0x16, 0xea, 0x84, 0xd4, 0x9b, 0x58, 0xe7, 0xa8, 0xd6,
+	0xd9, 0xd6, 0x59, 0xd8, 0xc2, 0x94, 0x79, 0xad, 0x76, 0x5a, 0x30, 0x9b, 0x44, 0xb8, 0x89, 0x32,
+	0xcd, 0xe3, 0x60, 0xee, 0x12, 0xc0, 0x9b, 0xe6, 0xbb, 0x4e, 0xb8, 0x4b, 0x7c, 0x8f, 0x85, 0x24,
+	0x92, 0xee, 0xc0, 0x89, 0x06, 0x0e, 0xeb, 0x0d, 0x96, 0x07, 0x2a, 0x28, 0x66, 0x50, 0x8a, 0xa4,
+	0xe7, 0x30, 0xdb, 0xb7, 0xcd, 0x8f, 0xa9, 0xa0, 0x78, 0x63, 0x59, 0xd6, 0x92, 0x4c, 0xed, 0x2a,
+	0x53, 0x73, 0xae, 0x32, 0x4b, 0xb7, 0x4e, 0xbf, 0x17, 0x84, 0x83, 0x1f, 0x05, 0x70, 0x7c, 0x71,
+	0xb2, 0x00, 0x10, 0x97, 0x49, 0x39, 0x38, 0xde, 0x26, 0xef, 0x71, 0x9c, 0xcf, 0x70, 0xd7, 0x04,
+	0x48, 0x26, 0x9c, 0xf1, 0x49, 0x44, 0x71, 0x44, 0x3b, 0xd4, 0xf5, 0x82, 0x20, 0xc6, 0x94, 0xe6,
+	0xb3, 0x2a, 0x28, 0x4e, 0x96, 0xf2, 0x5f, 0x3f, 0x2f, 0xe6, 0xd2, 0x56, 0x8d, 0xe4, 0xa6, 0xca,
+	0xe2, 0x30, 0xaa, 0x23, 0x71, 0x20, 0x49, 0xf9, 0xa7, 0xf3, 0xfb, 0x47, 0x05, 0xe1, 0xf2, 0xa8,
+	0x20, 0x74, 0x2f, 0x4e, 0x16, 0xd2, 0x7d, 0x2e, 0xd2, 0x60, 0x47, 0xbf, 0x3e, 0xd9, 0xdc, 0x17,
+	0x00, 0x45, 0x84, 0xdf, 0x62, 0x9f, 0xe1, 0xc0, 0x4c, 0x97, 0x2d, 0x49, 0x30, 0xdb, 0xf0, 0x68,
+	0x83, 0x0f, 0x3b, 0x89, 0xf8, 0xb9, 0xdf, 0x6b, 0x4c, 0x3a, 0x2c, 0x99, 0x75, 0x12, 0x25, 0xe0,
+	0xda, 0x62, 0x32, 0x7f, 0x2c, 0x66, 0x05, 0x4e, 0xc4, 0xd8, 0xa3, 0x24, 0xe2, 0x8d, 0x4f, 0x2d,
+	0x17, 0xb5, 0x7f, 0x3c, 0xa8, 0x96, 0x84, 0x87, 0x24, 0x42, 0xbc, 0x1e, 0xa5, 0x3a, 0xe9, 0x11,
+	0x14, 0xe3, 0xab, 0x2b, 0x37, 0xcd, 0x18, 0xe7, 0x19, 0xd3, 0x03, 0xfe, 0x05, 0xa7, 0xe7, 0x1a,
+	0x70, 0x6a, 0xe0, 0xb2, 0x4a, 0x3a, 0xd1, 0xf5, 0x78, 0xf0, 0x9f, 0xf1, 0x39, 0x38, 0xee, 0xf7,
+	0xad, 0xf8, 0xb8, 0x59, 0x94, 0x80, 0x85, 0x4f, 0x63, 0x70, 0x7a, 0x48, 0x21, 0xad, 0xc0, 0x7b,
+	0xc8, 0x7c, 0x69, 0xae, 0x3a, 0x15, 0xdb, 0x72, 0x91, 0x69, 0x54, 0x6d, 0xcb, 0xdd, 0xb4, 0xaa,
+	0xaf, 0xcc, 0xd5, 0xca, 0x5a, 0xc5, 0x2c, 0x8b, 0x82, 0xac, 0x74, 0x0f, 0x55, 0x79, 0x48, 0xb6,
+	0x19, 0xd1, 0x36, 0xf6, 0xc3, 0xed, 0x10, 0x07, 0xd2, 0x13, 0x98, 0x1f, 0x71, 0x70, 0x6c, 0xdb,
+	0xb5, 0xd7, 0xcb, 0x22, 0x90, 0x67, 0xbb, 0x87, 0xea, 0xed, 0x21, 0xb5, 0x43, 0x88, 0xdd, 0x0c,
+	0x24, 0x0b, 0xce, 0x8f, 0x08, 0xb7, 0x8c, 0xf5, 0x4a, 0xd9, 0x70, 0x6c, 0xe4, 0x5a, 0xb6, 0xe3,
+	0xae, 0xd9, 0x9b, 0x56, 0x59, 0x1c, 0x93, 0xe7, 0xbb, 0x87, 0xaa, 0x3a, 0x64, 0xb2, 0xe5, 0x35,
+	0xc3, 0xc0, 0x63, 0x24, 0xb6, 0x08, 0x5b, 0x23, 0x9d, 0x28, 0x90, 0x36, 0xe0, 0x83, 0x11, 0x3f,
+	0x63, 0x1d, 0x99, 0x46, 0xf9, 0xb5, 0xeb, 0xd8, 0x1b, 0xa5, 0xaa, 0x63, 0x5b, 0x66, 0x59, 0xcc,
+	0xfc, 0xd5, 0xce, 0x68, 0xc6, 0xd8, 0x0b, 0xf6, 0x1c, 0xd2, 0xaa, 0x51, 0x46, 0x22, 0x1c, 0xc8,
+	0xd9, 0xfd, 0x8f, 0x8a, 0x50, 0x7a, 0x76, 0xdc, 0x53, 0xc0, 0x69, 0x4f, 0x01, 0x67, 0x3d, 0x05,
+	0xfc, 0xec, 0x29, 0xe0, 0xe0, 0x5c, 0x11, 0xce, 0xce, 0x15, 0xe1, 0xdb, 0xb9, 0x22, 0xbc, 0xb9,
+	0x9f, 0x3c, 0x0c, 0x0d, 0x76, 0xb4, 0x90, 0xe8, 0x1f, 0x7e, 0x7f, 0x18, 0xd8, 0x5e, 0x1b, 0xd3,
+	0xda, 0x04, 0xff, 0x2b, 0x3d, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x25, 0xa4, 0x00, 0x38,
+	0x04, 0x00, 0x00,
+}
+
+func (this *RejectedEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RejectedEvidence)
+	if !ok {
+		that2, ok := that.(RejectedEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Route != that1.Route {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.RejectionHeight != that1.RejectionHeight {
+		return false
+	}
+	return true
 }
+func (this *RejectionCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*RejectionCount)
+	if !ok {
+		that2, ok := that.(RejectionCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +426,91 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RejectedEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectedEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectedEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectionHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.RejectionHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RejectionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +543,47 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *RejectedEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvidence(uint64(m.Reason))
+	}
+	if m.RejectionHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.RejectionHeight))
+	}
+	return n
+}
+
+func (m *RejectionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovEvidence(uint64(m.Reason))
+	}
+	if m.Count != 0 {
+		n += 1 + sovEvidence(uint64(m.Count))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +743,265 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RejectedEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectedEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectedEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RejectionReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionHeight", wireType)
+			}
+			m.RejectionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejectionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= RejectionReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,6 +17,7 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		ValidatorIdentifier(sdk.Context, sdk.ConsAddress) sdk.ConsAddress
 		GetParams(ctx sdk.Context) (params stakingtypes.Params)
 	}
 
@@ -31,6 +32,7 @@ type (
 		SlashWithInfractionReason(sdk.Context, sdk.ConsAddress, sdkmath.LegacyDec, int64, int64, stakingtypes.Infraction)
 		SlashFractionDoubleSign(sdk.Context) sdkmath.LegacyDec
 		Jail(sdk.Context, sdk.ConsAddress)
+		JailWithReason(sdk.Context, sdk.ConsAddress, stakingtypes.JailReason, string)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
 	}
 
@@ -50,6 +50,25 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// every rejection increments both the counter of its reason and the
+	// sequence of rejected evidence
+	var total uint64
+	seen := make(map[RejectionReason]bool, len(gs.RejectionCounts))
+	for _, count := range gs.RejectionCounts {
+		if seen[count.Reason] {
+			return fmt.Errorf("duplicate rejection count for reason %s", count.Reason)
+		}
+		seen[count.Reason] = true
+		total += count.Count
+	}
+	if total != gs.RejectedEvidenceSeq {
+		return fmt.Errorf("rejected evidence sequence %d does not match the total rejection count %d", gs.RejectedEvidenceSeq, total)
+	}
+
+	if n := uint64(len(gs.RecentRejections)); n > MaxRecentRejectedEvidence || n > gs.RejectedEvidenceSeq {
+		return fmt.Errorf("too many recent rejections: %d, rejected evidence sequence is %d", n, gs.RejectedEvidenceSeq)
+	}
+
 	return nil
 }
 
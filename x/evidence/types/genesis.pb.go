@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +29,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// evidence defines all the evidence at genesis.
 	Evidence []*types.Any `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	// rejection_counts defines the number of pieces of evidence rejected for
+	// each reason.
+	//
+	// Since: cosmos-sdk 0.48
+	RejectionCounts []RejectionCount `protobuf:"bytes,2,rep,name=rejection_counts,json=rejectionCounts,proto3" json:"rejection_counts"`
+	// rejected_evidence_seq defines the total number of pieces of evidence
+	// rejected, which positions the recent rejections in the ring buffer.
+	//
+	// Since: cosmos-sdk 0.48
+	RejectedEvidenceSeq uint64 `protobuf:"varint,3,opt,name=rejected_evidence_seq,json=rejectedEvidenceSeq,proto3" json:"rejected_evidence_seq,omitempty"`
+	// recent_rejections defines the most recently rejected evidence, newest
+	// first.
+	//
+	// Since: cosmos-sdk 0.48
+	RecentRejections []RejectedEvidence `protobuf:"bytes,4,rep,name=recent_rejections,json=recentRejections,proto3" json:"recent_rejections"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +86,27 @@ func (m *GenesisState) GetEvidence() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetRejectionCounts() []RejectionCount {
+	if m != nil {
+		return m.RejectionCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRejectedEvidenceSeq() uint64 {
+	if m != nil {
+		return m.RejectedEvidenceSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRecentRejections() []RejectedEvidence {
+	if m != nil {
+		return m.RecentRejections
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evidence.v1beta1.GenesisState")
 }
@@ -78,19 +116,28 @@ func init() {
 }
 
 var fileDescriptor_c610c52c26e0e202 = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x92, 0x4c, 0xcf, 0xcf,
-	0x4f, 0xcf, 0x49, 0xd5, 0x07, 0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x51,
-	0x72, 0xe0, 0xe2, 0x71, 0x87, 0x18, 0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc0, 0xc5, 0x01,
-	0xd3, 0x2e, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa2, 0x07, 0xd1, 0xad, 0x07, 0xd3, 0xad,
-	0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5, 0x64, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xb2, 0x10, 0xf7, 0x14, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0x57, 0x20, 0x9c,
-	0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0x25, 0x11, 0x0c, 0xde, 0x00, 0x00, 0x00,
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x86, 0x5b, 0x20, 0x46, 0xab, 0x89, 0x50, 0x31, 0x56, 0x12, 0x2b, 0x31, 0x51, 0xd1, 0xc3,
+	0xae, 0xe0, 0xc1, 0xb3, 0x18, 0xe3, 0xbd, 0xdc, 0x4c, 0x4c, 0x53, 0xca, 0xd8, 0x54, 0x65, 0x07,
+	0xba, 0x0b, 0x91, 0xb7, 0xf0, 0x31, 0x3c, 0x7a, 0xf2, 0x19, 0x38, 0x72, 0xf4, 0x64, 0x0c, 0x1c,
+	0x7c, 0x0d, 0xc3, 0x6e, 0xb7, 0xe8, 0x81, 0x78, 0x69, 0x26, 0x33, 0xdf, 0xfc, 0xf3, 0xb5, 0xb5,
+	0x0e, 0x43, 0xe4, 0x5d, 0xe4, 0x14, 0x86, 0x71, 0x07, 0x58, 0x08, 0x74, 0x58, 0x6f, 0x83, 0x08,
+	0xea, 0x34, 0x02, 0x06, 0x3c, 0xe6, 0xa4, 0x97, 0xa0, 0x40, 0x7b, 0x47, 0x61, 0x44, 0x63, 0x24,
+	0xc5, 0x2a, 0xbb, 0x11, 0x62, 0xf4, 0x04, 0x54, 0x62, 0xed, 0xc1, 0x3d, 0x0d, 0xd8, 0x48, 0xed,
+	0x54, 0xca, 0x11, 0x46, 0x28, 0x4b, 0x3a, 0xaf, 0xd2, 0x6e, 0x29, 0xe8, 0xc6, 0x0c, 0xa9, 0x7c,
+	0xa6, 0xad, 0xa3, 0x65, 0x0e, 0xd9, 0x35, 0xc9, 0x1d, 0xbc, 0xe7, 0xac, 0x8d, 0x1b, 0xa5, 0xd5,
+	0x12, 0x81, 0x00, 0xfb, 0xcc, 0x5a, 0xd5, 0x88, 0x63, 0x56, 0xf3, 0xb5, 0xf5, 0x46, 0x99, 0x28,
+	0x1f, 0xa2, 0x7d, 0xc8, 0x25, 0x1b, 0x79, 0x19, 0x65, 0xdf, 0x59, 0xc5, 0x04, 0x1e, 0x20, 0x14,
+	0x31, 0x32, 0x3f, 0xc4, 0x01, 0x13, 0xdc, 0xc9, 0xc9, 0xcd, 0x63, 0xb2, 0xe4, 0x15, 0x89, 0xa7,
+	0x17, 0xae, 0xe6, 0x7c, 0x73, 0x6d, 0xfc, 0xb9, 0x6f, 0xbc, 0x7e, 0xbf, 0x9d, 0x9a, 0xde, 0x66,
+	0xf2, 0x67, 0xc4, 0xed, 0x86, 0xb5, 0xad, 0x5a, 0xd0, 0xf1, 0x75, 0x8e, 0xcf, 0xa1, 0xef, 0xe4,
+	0xab, 0x66, 0xad, 0xe0, 0x6d, 0xe9, 0xe1, 0x75, 0x3a, 0x6b, 0x41, 0xdf, 0x0e, 0xac, 0x52, 0x02,
+	0x21, 0x30, 0xe1, 0x67, 0x69, 0xdc, 0x29, 0x48, 0xa7, 0x93, 0x7f, 0x9c, 0x16, 0x41, 0xbf, 0xad,
+	0x8a, 0x2a, 0x2e, 0xd3, 0xe6, 0xcd, 0x8b, 0xf1, 0xd4, 0x35, 0x27, 0x53, 0xd7, 0xfc, 0x9a, 0xba,
+	0xe6, 0xcb, 0xcc, 0x35, 0x26, 0x33, 0xd7, 0xf8, 0x98, 0xb9, 0xc6, 0xed, 0x9e, 0x3a, 0xc0, 0x3b,
+	0x8f, 0x24, 0x46, 0xfa, 0xbc, 0xf8, 0x05, 0x62, 0xd4, 0x03, 0xde, 0x5e, 0x91, 0x9f, 0xf1, 0xfc,
+	0x67, 0x00, 0x81, 0xc0, 0x50, 0xc8, 0x26, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +160,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecentRejections) > 0 {
+		for iNdEx := len(m.RecentRejections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RejectedEvidenceSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RejectedEvidenceSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RejectionCounts) > 0 {
+		for iNdEx := len(m.RejectionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RejectionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Evidence) > 0 {
 		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -153,6 +233,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RejectionCounts) > 0 {
+		for _, e := range m.RejectionCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RejectedEvidenceSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.RejectedEvidenceSeq))
+	}
+	if len(m.RecentRejections) > 0 {
+		for _, e := range m.RecentRejections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -225,6 +320,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionCounts = append(m.RejectionCounts, RejectionCount{})
+			if err := m.RejectionCounts[len(m.RejectionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedEvidenceSeq", wireType)
+			}
+			m.RejectedEvidenceSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectedEvidenceSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentRejections = append(m.RecentRejections, RejectedEvidence{})
+			if err := m.RecentRejections[len(m.RecentRejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
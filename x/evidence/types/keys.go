@@ -9,9 +9,16 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// MaxRecentRejectedEvidence defines the number of most recently rejected
+	// evidence summaries kept in state.
+	MaxRecentRejectedEvidence = 100
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = []byte{0x00}
+	KeyPrefixEvidence         = []byte{0x00}
+	KeyPrefixRejectionCount   = []byte{0x01}
+	KeyRejectedEvidenceSeq    = []byte{0x02}
+	KeyPrefixRejectedEvidence = []byte{0x03}
 )
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -238,11 +240,113 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEvidenceStatsRequest is the request type for the Query/EvidenceStats RPC
+// method.
+//
+// Since: cosmos-sdk 0.48
+type QueryEvidenceStatsRequest struct {
+}
+
+func (m *QueryEvidenceStatsRequest) Reset()         { *m = QueryEvidenceStatsRequest{} }
+func (m *QueryEvidenceStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceStatsRequest) ProtoMessage()    {}
+func (*QueryEvidenceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryEvidenceStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceStatsRequest.Merge(m, src)
+}
+func (m *QueryEvidenceStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceStatsRequest proto.InternalMessageInfo
+
+// QueryEvidenceStatsResponse is the response type for the Query/EvidenceStats
+// RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryEvidenceStatsResponse struct {
+	// rejection_counts returns the number of pieces of rejected evidence for each
+	// reason, omitting reasons without rejections.
+	RejectionCounts []RejectionCount `protobuf:"bytes,1,rep,name=rejection_counts,json=rejectionCounts,proto3" json:"rejection_counts"`
+	// recent_rejections returns the most recently rejected evidence, newest
+	// first.
+	RecentRejections []RejectedEvidence `protobuf:"bytes,2,rep,name=recent_rejections,json=recentRejections,proto3" json:"recent_rejections"`
+}
+
+func (m *QueryEvidenceStatsResponse) Reset()         { *m = QueryEvidenceStatsResponse{} }
+func (m *QueryEvidenceStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceStatsResponse) ProtoMessage()    {}
+func (*QueryEvidenceStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryEvidenceStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceStatsResponse.Merge(m, src)
+}
+func (m *QueryEvidenceStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceStatsResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceStatsResponse) GetRejectionCounts() []RejectionCount {
+	if m != nil {
+		return m.RejectionCounts
+	}
+	return nil
+}
+
+func (m *QueryEvidenceStatsResponse) GetRecentRejections() []RejectedEvidence {
+	if m != nil {
+		return m.RecentRejections
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryEvidenceStatsRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceStatsRequest")
+	proto.RegisterType((*QueryEvidenceStatsResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceStatsResponse")
 }
 
 func init() {
@@ -250,34 +354,44 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6e, 0x1a, 0x31,
-	0x18, 0x85, 0xf1, 0xf4, 0x22, 0x6a, 0xe8, 0xc6, 0xa2, 0x82, 0x8e, 0xda, 0x11, 0x1d, 0xa4, 0x42,
-	0x91, 0x6a, 0x03, 0x5d, 0x74, 0x0d, 0x52, 0x6f, 0xbb, 0x76, 0xba, 0xeb, 0xa6, 0xf2, 0x80, 0x3b,
-	0x8c, 0x4a, 0xed, 0x01, 0x0f, 0xa8, 0xa8, 0xea, 0xa6, 0x4f, 0x50, 0x29, 0xca, 0x2a, 0xca, 0xe3,
-	0x44, 0xca, 0x12, 0x29, 0x9b, 0x2c, 0x23, 0xc8, 0x83, 0x44, 0xd8, 0x1e, 0x20, 0x5c, 0x42, 0xb2,
-	0x83, 0xf9, 0xcf, 0x39, 0xff, 0xa7, 0xf3, 0x1b, 0x96, 0xda, 0x42, 0xfe, 0x12, 0x92, 0xb0, 0x51,
-	0xd8, 0x61, 0xbc, 0xcd, 0xc8, 0xa8, 0xee, 0xb3, 0x98, 0xd6, 0x49, 0x7f, 0xc8, 0x06, 0x63, 0x1c,
-	0x0d, 0x44, 0x2c, 0x50, 0x5e, 0x8b, 0x70, 0x22, 0xc2, 0x46, 0x64, 0x57, 0x8d, 0xdb, 0xa7, 0x92,
-	0x69, 0xc7, 0xc2, 0x1f, 0xd1, 0x20, 0xe4, 0x34, 0x0e, 0x05, 0xd7, 0x21, 0xf6, 0xd3, 0x40, 0x88,
-	0xa0, 0xc7, 0x88, 0xfa, 0xe7, 0x0f, 0x7f, 0x10, 0xca, 0x4d, 0xbe, 0xfd, 0xcc, 0x8c, 0x68, 0x14,
-	0x12, 0xca, 0xb9, 0x88, 0x95, 0x4f, 0xea, 0xa9, 0xfb, 0x15, 0xe6, 0xbe, 0xcc, 0xa3, 0xdf, 0x99,
-	0xed, 0x1e, 0xeb, 0x0f, 0x99, 0x8c, 0x51, 0x19, 0x3e, 0x4e, 0x80, 0xbe, 0x77, 0xa9, 0xec, 0x16,
-	0x40, 0x11, 0x54, 0xb2, 0x2d, 0xab, 0x00, 0xbc, 0x6c, 0x32, 0xf8, 0x48, 0x65, 0x17, 0x21, 0x78,
-	0x5f, 0xcd, 0xad, 0x22, 0xa8, 0x3c, 0xf2, 0xd4, 0x6f, 0xf7, 0x13, 0x7c, 0xb2, 0x16, 0x2a, 0x23,
-	0xc1, 0x25, 0x43, 0x35, 0x98, 0x4e, 0xcc, 0x2a, 0x30, 0xd3, 0xc8, 0x61, 0x8d, 0x87, 0x13, 0x72,
-	0xdc, 0xe4, 0x63, 0x6f, 0xa1, 0x72, 0x29, 0xcc, 0xab, 0xa8, 0x66, 0xaf, 0xb7, 0x8e, 0xf8, 0x1e,
-	0xc2, 0x65, 0x0f, 0x26, 0xee, 0x25, 0x36, 0x6d, 0xce, 0x4b, 0xc3, 0xba, 0x66, 0x53, 0x1a, 0xfe,
-	0x4c, 0x83, 0xc4, 0xeb, 0xad, 0x38, 0xdd, 0x43, 0x00, 0x0b, 0x9b, 0x3b, 0xb6, 0x12, 0xdf, 0xdb,
-	0x4f, 0x8c, 0x3e, 0x5c, 0xc3, 0xb2, 0x14, 0x56, 0x79, 0x2f, 0x96, 0x5e, 0xb7, 0xca, 0xd5, 0x38,
-	0xb1, 0xe0, 0x03, 0xc5, 0x85, 0x8e, 0x00, 0x4c, 0x27, 0x64, 0xe8, 0x35, 0xde, 0xf1, 0x60, 0xf0,
-	0xb6, 0x43, 0xda, 0xf8, 0xb6, 0x72, 0x4d, 0xe0, 0xd6, 0xfe, 0x9d, 0x5d, 0x1e, 0x58, 0x55, 0x54,
-	0x21, 0xbb, 0x1e, 0xef, 0xe2, 0xc3, 0x9f, 0xf9, 0xb1, 0xff, 0xa2, 0x63, 0x00, 0x33, 0x2b, 0xd5,
-	0xa1, 0xda, 0xcd, 0x1b, 0x37, 0x2f, 0x69, 0xd7, 0xef, 0xe0, 0x30, 0x98, 0xaf, 0x14, 0x66, 0x09,
-	0xbd, 0xd8, 0x8b, 0xd9, 0x7a, 0x7b, 0x3a, 0x75, 0xc0, 0x64, 0xea, 0x80, 0x8b, 0xa9, 0x03, 0xfe,
-	0xcf, 0x9c, 0xd4, 0x64, 0xe6, 0xa4, 0xce, 0x67, 0x4e, 0xea, 0xdb, 0x73, 0xed, 0x95, 0x9d, 0x9f,
-	0x38, 0x14, 0xe4, 0xf7, 0x32, 0x23, 0x1e, 0x47, 0x4c, 0xfa, 0x0f, 0xd5, 0x85, 0xdf, 0x5c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x9c, 0x7a, 0xc6, 0xc7, 0x03, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0x13, 0x3d,
+	0x14, 0xc5, 0xe3, 0xf4, 0xfb, 0x50, 0xeb, 0xb6, 0xa2, 0xb5, 0x82, 0x9a, 0x0e, 0x30, 0x84, 0xa9,
+	0x94, 0xa6, 0x91, 0xb0, 0x93, 0x74, 0xc1, 0xba, 0x41, 0xfc, 0xdb, 0xc1, 0x74, 0x87, 0x84, 0x22,
+	0x27, 0x31, 0x93, 0x81, 0xd4, 0x4e, 0x63, 0xa7, 0x22, 0x42, 0x6c, 0x78, 0x02, 0x24, 0xc4, 0x0a,
+	0x21, 0xb1, 0x64, 0xc9, 0x63, 0x74, 0x59, 0x89, 0x0d, 0xab, 0x0a, 0x25, 0x48, 0xf0, 0x18, 0x68,
+	0x3c, 0x9e, 0x69, 0x26, 0x4d, 0x98, 0x76, 0x13, 0x39, 0xbe, 0xe7, 0x1c, 0xff, 0x7c, 0x7d, 0x07,
+	0x6e, 0xb5, 0x84, 0x3c, 0x10, 0x92, 0xb0, 0x23, 0xbf, 0xcd, 0x78, 0x8b, 0x91, 0xa3, 0x6a, 0x93,
+	0x29, 0x5a, 0x25, 0x87, 0x03, 0xd6, 0x1f, 0xe2, 0x5e, 0x5f, 0x28, 0x81, 0x36, 0x42, 0x11, 0x8e,
+	0x44, 0xd8, 0x88, 0xac, 0xb2, 0x71, 0x37, 0xa9, 0x64, 0xa1, 0x23, 0xf6, 0xf7, 0xa8, 0xe7, 0x73,
+	0xaa, 0x7c, 0xc1, 0xc3, 0x10, 0x6b, 0xd3, 0x13, 0xc2, 0xeb, 0x32, 0xa2, 0xff, 0x35, 0x07, 0x2f,
+	0x08, 0xe5, 0x26, 0xdf, 0xba, 0x61, 0x4a, 0xb4, 0xe7, 0x13, 0xca, 0xb9, 0x50, 0xda, 0x27, 0x4d,
+	0x35, 0xe7, 0x09, 0x4f, 0xe8, 0x25, 0x09, 0x56, 0x66, 0x77, 0x9d, 0x1e, 0xf8, 0x5c, 0x10, 0xfd,
+	0x6b, 0xb6, 0x8a, 0xf3, 0xee, 0x12, 0x73, 0x6b, 0x9d, 0xb3, 0x0f, 0x73, 0x4f, 0x03, 0xd6, 0xfb,
+	0x66, 0xdb, 0x65, 0x87, 0x03, 0x26, 0x15, 0xda, 0x86, 0xab, 0x91, 0xb2, 0xd1, 0xa1, 0xb2, 0x93,
+	0x07, 0x05, 0x50, 0x5a, 0xa9, 0x67, 0xf3, 0xc0, 0x5d, 0x89, 0x0a, 0x8f, 0xa8, 0xec, 0x20, 0x04,
+	0xff, 0xd3, 0xf5, 0x6c, 0x01, 0x94, 0x96, 0x5c, 0xbd, 0x76, 0x1e, 0xc3, 0x6b, 0x53, 0xa1, 0xb2,
+	0x27, 0xb8, 0x64, 0xa8, 0x02, 0x17, 0x23, 0xb3, 0x0e, 0x5c, 0xae, 0xe5, 0x70, 0x78, 0x5f, 0x1c,
+	0xb5, 0x02, 0xef, 0xf1, 0xa1, 0x1b, 0xab, 0x1c, 0x0a, 0x37, 0x74, 0xd4, 0x5e, 0xb7, 0x3b, 0x8d,
+	0xf8, 0x00, 0xc2, 0xb3, 0xc6, 0x9a, 0xb8, 0x22, 0x36, 0xcf, 0x13, 0xbc, 0x02, 0x0e, 0xdf, 0xcd,
+	0xdc, 0x1c, 0x3f, 0xa1, 0x5e, 0xe4, 0x75, 0x27, 0x9c, 0xce, 0x47, 0x00, 0xf3, 0xe7, 0xcf, 0x98,
+	0x49, 0xbc, 0x90, 0x4e, 0x8c, 0x1e, 0x26, 0xb0, 0xb2, 0x1a, 0x6b, 0x3b, 0x15, 0x2b, 0x3c, 0x2e,
+	0xc1, 0x75, 0x1d, 0x6e, 0x26, 0xba, 0xb8, 0xaf, 0xa8, 0x92, 0xe6, 0x02, 0xce, 0x29, 0x80, 0xd6,
+	0xac, 0xaa, 0xc1, 0x7e, 0x0e, 0xd7, 0xfa, 0xec, 0x25, 0x6b, 0x05, 0x41, 0x8d, 0x96, 0x18, 0x70,
+	0x25, 0x0d, 0x7e, 0x8c, 0x32, 0x3d, 0xc0, 0xd8, 0x8d, 0x0c, 0xf7, 0x02, 0x7d, 0x7d, 0xe9, 0xf8,
+	0xf4, 0x56, 0xe6, 0xeb, 0xef, 0x6f, 0x65, 0xe0, 0x5e, 0xed, 0x27, 0x4a, 0x12, 0x51, 0xb8, 0xde,
+	0x67, 0x2d, 0xc6, 0x55, 0x23, 0xae, 0xc8, 0x7c, 0x56, 0xe7, 0xef, 0xa4, 0xe4, 0xb3, 0x76, 0x44,
+	0x3c, 0x79, 0xc2, 0x5a, 0x18, 0x17, 0x23, 0xc8, 0xda, 0x9f, 0x05, 0xf8, 0xbf, 0xbe, 0x20, 0xfa,
+	0x04, 0xe0, 0x62, 0xe4, 0x41, 0x77, 0xe6, 0xc6, 0xcf, 0x1a, 0x63, 0x0b, 0x5f, 0x54, 0x1e, 0xf6,
+	0xcd, 0xa9, 0xbc, 0xfb, 0xfe, 0xeb, 0x43, 0xb6, 0x8c, 0x4a, 0x24, 0xed, 0xfb, 0x21, 0x6f, 0x82,
+	0x51, 0x7f, 0x8b, 0x3e, 0x03, 0xb8, 0x3c, 0x31, 0x38, 0xa8, 0xf2, 0xef, 0x13, 0xcf, 0xcf, 0xb1,
+	0x55, 0xbd, 0x84, 0xc3, 0x60, 0xee, 0x68, 0xcc, 0x2d, 0x74, 0x3b, 0x15, 0x13, 0x7d, 0x01, 0x70,
+	0x35, 0x31, 0x23, 0xa8, 0x76, 0xb1, 0x9e, 0x4c, 0x8e, 0x9b, 0xb5, 0x7b, 0x29, 0x8f, 0xa1, 0x2c,
+	0x6a, 0xca, 0x02, 0xb2, 0xe7, 0x52, 0xca, 0x40, 0x5f, 0xbf, 0x7b, 0x3c, 0xb2, 0xc1, 0xc9, 0xc8,
+	0x06, 0x3f, 0x47, 0x36, 0x78, 0x3f, 0xb6, 0x33, 0x27, 0x63, 0x3b, 0xf3, 0x63, 0x6c, 0x67, 0x9e,
+	0xdd, 0x0c, 0x8d, 0xb2, 0xfd, 0x0a, 0xfb, 0x82, 0xbc, 0x3e, 0x0b, 0x50, 0xc3, 0x1e, 0x93, 0xcd,
+	0x2b, 0xfa, 0x13, 0xdc, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x47, 0x97, 0xa4, 0xa8, 0xb9, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +410,12 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceStats queries the number of pieces of evidence rejected by the
+	// evidence module for each reason, along with the most recently rejected
+	// ones.
+	//
+	// Since: cosmos-sdk 0.48
+	EvidenceStats(ctx context.Context, in *QueryEvidenceStatsRequest, opts ...grpc.CallOption) (*QueryEvidenceStatsResponse, error)
 }
 
 type queryClient struct {
@@ -324,12 +444,27 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceStats(ctx context.Context, in *QueryEvidenceStatsRequest, opts ...grpc.CallOption) (*QueryEvidenceStatsResponse, error) {
+	out := new(QueryEvidenceStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/EvidenceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceStats queries the number of pieces of evidence rejected by the
+	// evidence module for each reason, along with the most recently rejected
+	// ones.
+	//
+	// Since: cosmos-sdk 0.48
+	EvidenceStats(context.Context, *QueryEvidenceStatsRequest) (*QueryEvidenceStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -342,6 +477,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) EvidenceStats(ctx context.Context, req *QueryEvidenceStatsRequest) (*QueryEvidenceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -383,6 +521,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/EvidenceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceStats(ctx, req.(*QueryEvidenceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -395,6 +551,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceStats",
+			Handler:    _Query_EvidenceStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -556,6 +716,80 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEvidenceStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecentRejections) > 0 {
+		for iNdEx := len(m.RecentRejections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RejectionCounts) > 0 {
+		for iNdEx := len(m.RejectionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RejectionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -629,6 +863,36 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEvidenceStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEvidenceStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RejectionCounts) > 0 {
+		for _, e := range m.RejectionCounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecentRejections) > 0 {
+		for _, e := range m.RecentRejections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1043,6 +1307,174 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEvidenceStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionCounts = append(m.RejectionCounts, RejectionCount{})
+			if err := m.RejectionCounts[len(m.RejectionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentRejections = append(m.RecentRejections, RejectedEvidence{})
+			if err := m.RecentRejections[len(m.RecentRejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
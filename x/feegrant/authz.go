@@ -0,0 +1,113 @@
+package feegrant
+
+import (
+	"context"
+	"path"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerGranteePattern is the gas consumed for each pattern of
+// allowed_grantees matched against the grantee of an allowance.
+const gasCostPerGranteePattern = uint64(10)
+
+var _ authz.Authorization = &GrantAllowanceAuthorization{}
+
+// NewGrantAllowanceAuthorization creates a new GrantAllowanceAuthorization
+// object.
+func NewGrantAllowanceAuthorization(maxSpendLimit sdk.Coins, allowedGrantees ...string) *GrantAllowanceAuthorization {
+	return &GrantAllowanceAuthorization{
+		MaxSpendLimit:   maxSpendLimit,
+		AllowedGrantees: allowedGrantees,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a GrantAllowanceAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgGrantAllowance{})
+}
+
+// Accept implements Authorization.Accept.
+func (a GrantAllowanceAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mGrant, ok := msg.(*MsgGrantAllowance)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	allowance, err := mGrant.GetFeeAllowanceI()
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	spendLimit, err := allowanceSpendLimit(allowance)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if spendLimit.Empty() {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("cannot grant an allowance without spend limit")
+	}
+	if !spendLimit.IsAllLTE(a.MaxSpendLimit) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("spend limit %s exceeds the max spend limit %s", spendLimit, a.MaxSpendLimit)
+	}
+
+	if len(a.AllowedGrantees) == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, pattern := range a.AllowedGrantees {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerGranteePattern, "grant allowance authorization")
+		if matched, _ := path.Match(pattern, mGrant.Grantee); matched {
+			return authz.AcceptResponse{Accept: true}, nil
+		}
+	}
+
+	return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot grant an allowance to %s", mGrant.Grantee)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GrantAllowanceAuthorization) ValidateBasic() error {
+	if a.MaxSpendLimit.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap("max spend limit cannot be empty")
+	}
+	if !a.MaxSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid max spend limit %s", a.MaxSpendLimit)
+	}
+
+	found := make(map[string]bool, len(a.AllowedGrantees))
+	for _, pattern := range a.AllowedGrantees {
+		if found[pattern] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate grantee pattern %s", pattern)
+		}
+		found[pattern] = true
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid grantee pattern %s: %s", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// allowanceSpendLimit returns the total amount of coins an allowance lets the
+// grantee spend, which is empty if it is unlimited.
+func allowanceSpendLimit(allowance FeeAllowanceI) (sdk.Coins, error) {
+	switch a := allowance.(type) {
+	case *BasicAllowance:
+		return a.SpendLimit, nil
+	case *PeriodicAllowance:
+		return a.Basic.SpendLimit, nil
+	case *AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		return allowanceSpendLimit(inner)
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unknown allowance type %T", allowance)
+	}
+}
@@ -0,0 +1,411 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feegrant/v1beta1/authz.proto
+
+package feegrant
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GrantAllowanceAuthorization allows the grantee to grant fee allowances from
+// the granter's funds with MsgGrantAllowance, e.g. on behalf of a group policy
+// or module account which cannot sign transactions.
+//
+// Since: cosmos-sdk 0.48
+type GrantAllowanceAuthorization struct {
+	// max_spend_limit is the maximum spend limit of the granted allowances.
+	// Allowances without spend limit, or with a spend limit exceeding it in any
+	// denom, are rejected.
+	MaxSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_spend_limit,json=maxSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_spend_limit"`
+	// allowed_grantees specifies an optional list of patterns matching the
+	// addresses allowances can be granted to, with the syntax of Go's
+	// path.Match, e.g. "cosmos1*". If omitted, any grantee is allowed.
+	AllowedGrantees []string `protobuf:"bytes,2,rep,name=allowed_grantees,json=allowedGrantees,proto3" json:"allowed_grantees,omitempty"`
+}
+
+func (m *GrantAllowanceAuthorization) Reset()         { *m = GrantAllowanceAuthorization{} }
+func (m *GrantAllowanceAuthorization) String() string { return proto.CompactTextString(m) }
+func (*GrantAllowanceAuthorization) ProtoMessage()    {}
+func (*GrantAllowanceAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1f6f000d07a3b07, []int{0}
+}
+func (m *GrantAllowanceAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantAllowanceAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantAllowanceAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantAllowanceAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantAllowanceAuthorization.Merge(m, src)
+}
+func (m *GrantAllowanceAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantAllowanceAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantAllowanceAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantAllowanceAuthorization proto.InternalMessageInfo
+
+func (m *GrantAllowanceAuthorization) GetMaxSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxSpendLimit
+	}
+	return nil
+}
+
+func (m *GrantAllowanceAuthorization) GetAllowedGrantees() []string {
+	if m != nil {
+		return m.AllowedGrantees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GrantAllowanceAuthorization)(nil), "cosmos.feegrant.v1beta1.GrantAllowanceAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feegrant/v1beta1/authz.proto", fileDescriptor_e1f6f000d07a3b07)
+}
+
+var fileDescriptor_e1f6f000d07a3b07 = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
+	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
+	0x12, 0x87, 0x28, 0xd2, 0x83, 0x29, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
+	0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05,
+	0x15, 0x95, 0x84, 0x98, 0x10, 0x0f, 0x91, 0x80, 0x1a, 0x07, 0x91, 0x92, 0x83, 0xba, 0x20, 0x29,
+	0xb1, 0x38, 0x15, 0x6e, 0x7b, 0x72, 0x7e, 0x66, 0x1e, 0x44, 0x5e, 0x69, 0x39, 0x13, 0x97, 0xb4,
+	0x3b, 0xc8, 0x56, 0xc7, 0x9c, 0x9c, 0xfc, 0xf2, 0xc4, 0xbc, 0xe4, 0x54, 0xc7, 0xd2, 0x92, 0x8c,
+	0xfc, 0xa2, 0xcc, 0xaa, 0xc4, 0x92, 0xcc, 0xfc, 0x3c, 0xa1, 0x4e, 0x46, 0x2e, 0xfe, 0xdc, 0xc4,
+	0x8a, 0xf8, 0xe2, 0x82, 0xd4, 0xbc, 0x94, 0xf8, 0x9c, 0xcc, 0xdc, 0xcc, 0x12, 0x09, 0x46, 0x05,
+	0x66, 0x0d, 0x6e, 0x23, 0x49, 0x3d, 0xa8, 0x45, 0x20, 0xa3, 0x61, 0x6e, 0xd6, 0x73, 0xce, 0xcf,
+	0xcc, 0x73, 0x72, 0x3b, 0x71, 0x4f, 0x9e, 0x61, 0xd5, 0x7d, 0x79, 0x8d, 0xf4, 0xcc, 0x92, 0x8c,
+	0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xa8, 0xab, 0xa0, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x49,
+	0x65, 0x41, 0x6a, 0x31, 0x58, 0x43, 0xf1, 0xac, 0xe7, 0x1b, 0xb4, 0x78, 0x72, 0x52, 0xd3, 0x13,
+	0x93, 0x2b, 0xe3, 0x41, 0x8e, 0x2b, 0x5e, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x6f, 0x6e, 0x62,
+	0x45, 0x30, 0xc8, 0x62, 0x1f, 0x90, 0xbd, 0x42, 0x9a, 0x5c, 0x02, 0x89, 0x20, 0x57, 0xa6, 0xa6,
+	0xc4, 0x83, 0x03, 0x2a, 0x35, 0xb5, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0x33, 0x88, 0x1f, 0x2a,
+	0xee, 0x0e, 0x15, 0xb6, 0x0a, 0x3c, 0xb5, 0x45, 0x57, 0x09, 0xea, 0x3e, 0x48, 0x58, 0xc3, 0x1c,
+	0x88, 0xe2, 0xbd, 0xae, 0xe7, 0x1b, 0xb4, 0xd4, 0x90, 0x9c, 0x84, 0x27, 0x24, 0x9c, 0x0c, 0x4f,
+	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
+	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x0a, 0x1a, 0x81, 0xc5, 0x29, 0xd9, 0x7a,
+	0x99, 0xf9, 0xfa, 0x15, 0xf0, 0xd8, 0x4e, 0x62, 0x03, 0x87, 0xb1, 0x31, 0x20, 0x00, 0x00, 0xff,
+	0xff, 0x52, 0x83, 0x8d, 0x4c, 0x07, 0x02, 0x00, 0x00,
+}
+
+func (m *GrantAllowanceAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantAllowanceAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantAllowanceAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedGrantees) > 0 {
+		for iNdEx := len(m.AllowedGrantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedGrantees[iNdEx])
+			copy(dAtA[i:], m.AllowedGrantees[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedGrantees[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MaxSpendLimit) > 0 {
+		for iNdEx := len(m.MaxSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GrantAllowanceAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxSpendLimit) > 0 {
+		for _, e := range m.MaxSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedGrantees) > 0 {
+		for _, s := range m.AllowedGrantees {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GrantAllowanceAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantAllowanceAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantAllowanceAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSpendLimit = append(m.MaxSpendLimit, types.Coin{})
+			if err := m.MaxSpendLimit[len(m.MaxSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedGrantees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedGrantees = append(m.AllowedGrantees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
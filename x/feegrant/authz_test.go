@@ -0,0 +1,126 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGrantAllowanceAuthorizationAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	other := sdk.AccAddress("other_______________")
+
+	maxLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	bigAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 101))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: smallAtom},
+		PeriodSpendLimit: smallAtom,
+	}
+	filtered, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: bigAtom}, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		authorization *feegrant.GrantAllowanceAuthorization
+		allowance     feegrant.FeeAllowanceI
+		grantee       sdk.AccAddress
+		accept        bool
+	}{
+		"basic allowance within limit": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit),
+			allowance:     &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			grantee:       grantee,
+			accept:        true,
+		},
+		"basic allowance at limit": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit),
+			allowance:     &feegrant.BasicAllowance{SpendLimit: maxLimit},
+			grantee:       grantee,
+			accept:        true,
+		},
+		"basic allowance over limit": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit),
+			allowance:     &feegrant.BasicAllowance{SpendLimit: bigAtom},
+			grantee:       grantee,
+		},
+		"unlimited allowance": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit),
+			allowance:     &feegrant.BasicAllowance{},
+			grantee:       grantee,
+		},
+		"denom not in max spend limit": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit),
+			allowance:     &feegrant.BasicAllowance{SpendLimit: eth},
+			grantee:       grantee,
+		},
+		"periodic allowance within limit": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit),
+			allowance:     periodic,
+			grantee:       grantee,
+			accept:        true,
+		},
+		"filtered allowance over limit": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit),
+			allowance:     filtered,
+			grantee:       grantee,
+		},
+		"grantee matches pattern": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit, other.String(), "cosmos1*"),
+			allowance:     &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			grantee:       grantee,
+			accept:        true,
+		},
+		"grantee does not match pattern": {
+			authorization: feegrant.NewGrantAllowanceAuthorization(maxLimit, other.String()),
+			allowance:     &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			grantee:       grantee,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := feegrant.NewMsgGrantAllowance(tc.allowance, granter, tc.grantee)
+			require.NoError(t, err)
+
+			resp, err := tc.authorization.Accept(ctx, msg)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			require.Nil(t, resp.Updated)
+		})
+	}
+
+	_, err = feegrant.NewGrantAllowanceAuthorization(maxLimit).Accept(ctx, &banktypes.MsgSend{})
+	require.Error(t, err)
+}
+
+func TestGrantAllowanceAuthorizationValidateBasic(t *testing.T) {
+	maxLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	require.Equal(t, sdk.MsgTypeURL(&feegrant.MsgGrantAllowance{}), feegrant.NewGrantAllowanceAuthorization(maxLimit).MsgTypeURL())
+
+	require.NoError(t, feegrant.NewGrantAllowanceAuthorization(maxLimit).ValidateBasic())
+	require.NoError(t, feegrant.NewGrantAllowanceAuthorization(maxLimit, "cosmos1*", "cosmos1abc").ValidateBasic())
+	require.Error(t, feegrant.NewGrantAllowanceAuthorization(nil).ValidateBasic())
+	require.Error(t, feegrant.NewGrantAllowanceAuthorization(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}).ValidateBasic())
+	require.Error(t, feegrant.NewGrantAllowanceAuthorization(maxLimit, "cosmos1*", "cosmos1*").ValidateBasic())
+	require.Error(t, feegrant.NewGrantAllowanceAuthorization(maxLimit, "cosmos1[").ValidateBasic())
+}
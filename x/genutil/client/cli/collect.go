@@ -14,7 +14,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const flagGenTxDir = "gentx-dir"
+const (
+	flagGenTxDir = "gentx-dir"
+	flagStrict   = "strict"
+)
 
 // CollectGenTxsCmd - return the cobra command to collect genesis transactions
 func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeHome string, validator types.MessageValidator) *cobra.Command {
@@ -49,7 +52,17 @@ func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeH
 			toPrint := newPrintInfo(config.Moniker, appGenesis.ChainID, nodeID, genTxsDir, json.RawMessage(""))
 			initCfg := types.NewInitConfig(appGenesis.ChainID, genTxsDir, nodeID, valPubKey)
 
-			appMessage, err := genutil.GenAppStateFromConfig(cdc, clientCtx.TxConfig, config, initCfg, appGenesis, genBalIterator, validator)
+			var txValidators []types.GenTxValidator
+			if strict, _ := cmd.Flags().GetBool(flagStrict); strict {
+				txValidator, err := genutil.NewStrictGenTxValidator(cdc, clientCtx.TxConfig, appGenesis, genBalIterator)
+				if err != nil {
+					return errors.Wrap(err, "failed to create strict gentx validator")
+				}
+
+				txValidators = append(txValidators, txValidator)
+			}
+
+			appMessage, err := genutil.GenAppStateFromConfig(cdc, clientCtx.TxConfig, config, initCfg, appGenesis, genBalIterator, validator, txValidators...)
 			if err != nil {
 				return errors.Wrap(err, "failed to get genesis app state from config")
 			}
@@ -62,6 +75,7 @@ func CollectGenTxsCmd(genBalIterator types.GenesisBalancesIterator, defaultNodeH
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flagGenTxDir, "", "override default \"gentx\" directory from which collect and execute genesis transactions; default [--home]/config/gentx/")
+	cmd.Flags().Bool(flagStrict, false, "validate the signatures, fees and staking parameters of the gentxs against the genesis, as done when they are delivered")
 
 	return cmd
 }
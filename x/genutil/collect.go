@@ -23,11 +23,11 @@ import (
 // GenAppStateFromConfig gets the genesis app state from the config
 func GenAppStateFromConfig(cdc codec.JSONCodec, txEncodingConfig client.TxEncodingConfig,
 	config *cfg.Config, initCfg types.InitConfig, genesis *types.AppGenesis, genBalIterator types.GenesisBalancesIterator,
-	validator types.MessageValidator,
+	validator types.MessageValidator, txValidators ...types.GenTxValidator,
 ) (appState json.RawMessage, err error) {
 	// process genesis transactions, else create default genesis.json
 	appGenTxs, persistentPeers, err := CollectTxs(
-		cdc, txEncodingConfig.TxJSONDecoder(), config.Moniker, initCfg.GenTxsDir, genesis, genBalIterator, validator)
+		cdc, txEncodingConfig.TxJSONDecoder(), config.Moniker, initCfg.GenTxsDir, genesis, genBalIterator, validator, txValidators...)
 	if err != nil {
 		return appState, err
 	}
@@ -64,9 +64,11 @@ func GenAppStateFromConfig(cdc codec.JSONCodec, txEncodingConfig client.TxEncodi
 
 // CollectTxs processes and validates application's genesis Txs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
+// When txValidators are provided, the gentxs failing validation are reported
+// per file instead of failing on the first one.
 func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTxsDir string,
 	genesis *types.AppGenesis, genBalIterator types.GenesisBalancesIterator,
-	validator types.MessageValidator,
+	validator types.MessageValidator, txValidators ...types.GenTxValidator,
 ) (appGenTxs []sdk.Tx, persistentPeers string, err error) {
 	// prepare a map of all balances in genesis state to then validate
 	// against the validators addresses
@@ -94,6 +96,9 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 	// addresses and IPs (and port) validator server info
 	var addressesIPs []string
 
+	// invalid gentxs and their validation error, by file
+	var invalidGenTxs []string
+
 	for _, fo := range fos {
 		if fo.IsDir() {
 			continue
@@ -108,9 +113,14 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 			return appGenTxs, persistentPeers, err
 		}
 
-		genTx, err := types.ValidateAndGetGenTx(jsonRawTx, txJSONDecoder, validator)
+		genTx, err := types.ValidateAndGetGenTx(jsonRawTx, txJSONDecoder, validator, txValidators...)
 		if err != nil {
-			return appGenTxs, persistentPeers, err
+			if len(txValidators) == 0 {
+				return appGenTxs, persistentPeers, err
+			}
+
+			invalidGenTxs = append(invalidGenTxs, fmt.Sprintf("%s: %s", fo.Name(), err))
+			continue
 		}
 
 		appGenTxs = append(appGenTxs, genTx)
@@ -171,6 +181,10 @@ func CollectTxs(cdc codec.JSONCodec, txJSONDecoder sdk.TxDecoder, moniker, genTx
 		}
 	}
 
+	if len(invalidGenTxs) > 0 {
+		return appGenTxs, persistentPeers, fmt.Errorf("%d invalid gentx(s):\n%s", len(invalidGenTxs), strings.Join(invalidGenTxs, "\n"))
+	}
+
 	sort.Strings(addressesIPs)
 	persistentPeers = strings.Join(addressesIPs, ",")
 
@@ -2,19 +2,33 @@ package genutil_test
 
 import (
 	"encoding/json"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type doNothingUnmarshalJSON struct {
@@ -63,3 +77,84 @@ func TestCollectTxsHandlesDirectories(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCollectTxsStrict(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, staking.AppModuleBasic{}, genutil.AppModuleBasic{})
+	txConfig := encCfg.TxConfig
+	chainID := "strict-chain"
+
+	privs := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+
+	var (
+		genAccounts authtypes.GenesisAccounts
+		balances    []banktypes.Balance
+	)
+	for _, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, uint64(len(genAccounts)), 0))
+		balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))})
+	}
+
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		authtypes.ModuleName:    encCfg.Codec.MustMarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), genAccounts)),
+		banktypes.ModuleName:    encCfg.Codec.MustMarshalJSON(banktypes.NewGenesisState(banktypes.DefaultParams(), balances, nil, nil, nil)),
+		stakingtypes.ModuleName: encCfg.Codec.MustMarshalJSON(stakingtypes.DefaultGenesisState()),
+	})
+	require.NoError(t, err)
+	genesis := &types.AppGenesis{ChainID: chainID, AppState: appState}
+
+	writeGenTx := func(dir, name string, priv *secp256k1.PrivKey, chainID string, amount int64) {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(priv.PubKey().Address()), ed25519.GenPrivKey().PubKey(),
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, amount), stakingtypes.NewDescription(name, "", "", "", ""),
+			stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+			math.OneInt(),
+		)
+		require.NoError(t, err)
+
+		tx, err := simtestutil.GenSignedMockTx(rand.New(rand.NewSource(1)), txConfig, []sdk.Msg{msg}, nil,
+			simtestutil.DefaultGenTxGas, chainID, []uint64{0}, []uint64{0}, priv)
+		require.NoError(t, err)
+
+		bz, err := txConfig.TxJSONEncoder()(tx)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name+".json"), bz, 0o600))
+	}
+
+	strictValidator, err := genutil.NewStrictGenTxValidator(encCfg.Codec, txConfig, genesis, banktypes.GenesisBalancesIterator{})
+	require.NoError(t, err)
+
+	collect := func(dir string, txValidators ...types.GenTxValidator) ([]sdk.Tx, error) {
+		genTxs, _, err := genutil.CollectTxs(encCfg.Codec, txConfig.TxJSONDecoder(), "foo", dir, genesis,
+			banktypes.GenesisBalancesIterator{}, types.DefaultMessageValidator, txValidators...)
+		return genTxs, err
+	}
+
+	// a valid gentx is collected in strict mode
+	validDir := t.TempDir()
+	writeGenTx(validDir, "valid", privs[0], chainID, 50)
+	genTxs, err := collect(validDir, strictValidator)
+	require.NoError(t, err)
+	require.Len(t, genTxs, 1)
+
+	// a gentx signed for another chain is only rejected in strict mode
+	wrongChainDir := t.TempDir()
+	writeGenTx(wrongChainDir, "valid", privs[0], chainID, 50)
+	writeGenTx(wrongChainDir, "wrong-chain-id", privs[1], "other-chain", 50)
+	genTxs, err = collect(wrongChainDir)
+	require.NoError(t, err)
+	require.Len(t, genTxs, 2)
+	_, err = collect(wrongChainDir, strictValidator)
+	require.ErrorContains(t, err, "1 invalid gentx(s)")
+	require.ErrorContains(t, err, "wrong-chain-id.json: signature verification failed")
+
+	// every invalid gentx is reported with its file in strict mode
+	invalidDir := t.TempDir()
+	writeGenTx(invalidDir, "valid", privs[0], chainID, 50)
+	writeGenTx(invalidDir, "wrong-chain-id", privs[1], "other-chain", 50)
+	writeGenTx(invalidDir, "over-delegation", privs[2], chainID, 150)
+	_, err = collect(invalidDir, strictValidator)
+	require.ErrorContains(t, err, "2 invalid gentx(s)")
+	require.ErrorContains(t, err, "wrong-chain-id.json: signature verification failed")
+	require.ErrorContains(t, err, "over-delegation.json: insufficient funds")
+}
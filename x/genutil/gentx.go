@@ -1,15 +1,24 @@
 package genutil
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/core/genesis"
+	storetypes "cosmossdk.io/store/types"
+	txsigning "cosmossdk.io/x/tx/signing"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -85,6 +94,181 @@ func ValidateAccountInGenesis(
 	return nil
 }
 
+// NewStrictGenTxValidator returns a GenTxValidator running against the given
+// genesis the checks a gentx is subject to when it is delivered at InitChain:
+// ValidateBasic of all its messages, the memo length, the pubkey types and
+// signatures of its signers against the genesis accounts and chain-id, the
+// bond denom, minimum self delegation and commission rate of its validators
+// against the staking genesis, and the funds of the delegators and fee payer
+// against their genesis balances.
+func NewStrictGenTxValidator(
+	cdc codec.Codec, txConfig client.TxConfig, genesis *types.AppGenesis, genBalIterator types.GenesisBalancesIterator,
+) (types.GenTxValidator, error) {
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genesis.AppState, &appState); err != nil {
+		return nil, err
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	genAccounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[string]authtypes.GenesisAccount, len(genAccounts))
+	for _, acc := range genAccounts {
+		accounts[acc.GetAddress().String()] = acc
+	}
+
+	balances := make(map[string]sdk.Coins)
+	genBalIterator.IterateGenesisBalances(cdc, appState,
+		func(balance bankexported.GenesisBalance) (stop bool) {
+			balances[balance.GetAddress().String()] = balance.GetCoins()
+			return false
+		},
+	)
+
+	stakingParams := stakingtypes.GetGenesisStateFromAppState(cdc, appState).Params
+
+	return func(tx sdk.Tx) error {
+		spent := make(map[string]sdk.Coins)
+
+		for _, msg := range tx.GetMsgs() {
+			if m, ok := msg.(sdk.HasValidateBasic); ok {
+				if err := m.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			createValMsg, ok := msg.(*stakingtypes.MsgCreateValidator)
+			if !ok {
+				continue
+			}
+
+			if createValMsg.Value.Denom != stakingParams.BondDenom {
+				return fmt.Errorf("invalid self delegation denom %s, expected %s", createValMsg.Value.Denom, stakingParams.BondDenom)
+			}
+
+			if createValMsg.Value.Amount.LT(createValMsg.MinSelfDelegation) {
+				return fmt.Errorf("self delegation %s is below the minimum self delegation %s", createValMsg.Value.Amount, createValMsg.MinSelfDelegation)
+			}
+
+			if createValMsg.Commission.Rate.LT(stakingParams.MinCommissionRate) {
+				return fmt.Errorf("commission rate %s is below the minimum commission rate %s", createValMsg.Commission.Rate, stakingParams.MinCommissionRate)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(createValMsg.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+
+			delAddr := sdk.AccAddress(valAddr).String()
+			spent[delAddr] = spent[delAddr].Add(createValMsg.Value)
+		}
+
+		if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+			if memoLen := uint64(len(memoTx.GetMemo())); memoLen > authGenState.Params.MaxMemoCharacters {
+				return fmt.Errorf("memo of %d characters is longer than the maximum of %d", memoLen, authGenState.Params.MaxMemoCharacters)
+			}
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return fmt.Errorf("expected FeeTx, got %T", tx)
+		}
+
+		payer := feeTx.FeePayer().String()
+		spent[payer] = spent[payer].Add(feeTx.GetFee()...)
+
+		spenders := make([]string, 0, len(spent))
+		for addr := range spent {
+			spenders = append(spenders, addr)
+		}
+		sort.Strings(spenders)
+
+		for _, addr := range spenders {
+			if !balances[addr].IsAllGTE(spent[addr]) {
+				return fmt.Errorf("insufficient funds for %s: genesis balance %s is less than %s", addr, balances[addr], spent[addr])
+			}
+		}
+
+		return verifyGenTxSignatures(tx, txConfig, genesis.ChainID, accounts, authGenState.Params)
+	}, nil
+}
+
+// verifyGenTxSignatures verifies the signatures of tx as the signature
+// verification ante handler does at genesis, i.e. with an account number of 0.
+func verifyGenTxSignatures(
+	tx sdk.Tx, txConfig client.TxConfig, chainID string, accounts map[string]authtypes.GenesisAccount, params authtypes.Params,
+) error {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return fmt.Errorf("expected SigVerifiableTx, got %T", tx)
+	}
+
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected V2AdaptableTx, got %T", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) != len(signers) {
+		return fmt.Errorf("invalid number of signatures; expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc, ok := accounts[signers[i].String()]
+		if !ok {
+			return fmt.Errorf("signer %s is not a genesis account", signers[i])
+		}
+
+		pubKey := sig.PubKey
+		if pubKey == nil || !signers[i].Equals(sdk.AccAddress(pubKey.Address())) {
+			return fmt.Errorf("invalid pubkey for signer %s", signers[i])
+		}
+
+		if acc.GetPubKey() != nil && !acc.GetPubKey().Equals(pubKey) {
+			return fmt.Errorf("pubkey of signer %s does not match its genesis account", signers[i])
+		}
+
+		if err := ante.DefaultSigVerificationGasConsumer(storetypes.NewInfiniteGasMeter(), sig, params); err != nil {
+			return err
+		}
+
+		if sig.Sequence != acc.GetSequence() {
+			return fmt.Errorf("account sequence mismatch for %s, expected %d, got %d", signers[i], acc.GetSequence(), sig.Sequence)
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return err
+		}
+
+		signerData := txsigning.SignerData{
+			Address:       signers[i].String(),
+			ChainID:       chainID,
+			AccountNumber: 0,
+			Sequence:      acc.GetSequence(),
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
+		}
+
+		err = authsigning.VerifySignature(context.Background(), pubKey, signerData, sig.Data, txConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+		if err != nil {
+			return fmt.Errorf("signature verification failed for %s; please verify chain-id (%s): %w", signers[i], chainID, err)
+		}
+	}
+
+	return nil
+}
+
 // DeliverGenTxs iterates over all genesis txs, decodes each into a Tx and
 // invokes the provided deliverTxfn with the decoded Tx. It returns the result
 // of the staking module's ApplyAndReturnValidatorSetUpdates.
@@ -19,7 +19,10 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -124,6 +127,12 @@ func (am AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMe
 	return am.DefaultGenesis(cdc)
 }
 
+// Dependencies implements module.HasDependencies: the genesis transactions are
+// delivered once the accounts, balances and staking state are initialized.
+func (AppModule) Dependencies() []string {
+	return []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName}
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
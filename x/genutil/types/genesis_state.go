@@ -102,6 +102,10 @@ func ValidateGenesis(genesisState *GenesisState, txJSONDecoder sdk.TxDecoder, va
 
 type MessageValidator func([]sdk.Msg) error
 
+// GenTxValidator performs additional, possibly stateful, validation of a
+// decoded genesis transaction.
+type GenTxValidator func(sdk.Tx) error
+
 func DefaultMessageValidator(msgs []sdk.Msg) error {
 	if len(msgs) != 1 {
 		return fmt.Errorf("unexpected number of GenTx messages; got: %d, expected: 1", len(msgs))
@@ -120,12 +124,23 @@ func DefaultMessageValidator(msgs []sdk.Msg) error {
 }
 
 // ValidateAndGetGenTx validates the genesis transaction and returns GenTx if valid
-// it cannot verify the signature as it is stateless validation
-func ValidateAndGetGenTx(genTx json.RawMessage, txJSONDecoder sdk.TxDecoder, validator MessageValidator) (sdk.Tx, error) {
+// it cannot verify the signature as it is stateless validation, unless a
+// stateful txValidator is provided
+func ValidateAndGetGenTx(genTx json.RawMessage, txJSONDecoder sdk.TxDecoder, validator MessageValidator, txValidators ...GenTxValidator) (sdk.Tx, error) {
 	tx, err := txJSONDecoder(genTx)
 	if err != nil {
 		return tx, fmt.Errorf("failed to decode gentx: %s, error: %s", genTx, err)
 	}
 
-	return tx, validator(tx.GetMsgs())
+	if err := validator(tx.GetMsgs()); err != nil {
+		return tx, err
+	}
+
+	for _, txValidator := range txValidators {
+		if err := txValidator(tx); err != nil {
+			return tx, err
+		}
+	}
+
+	return tx, nil
 }
@@ -16,6 +16,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
+
+	// delete the records of the settled deposits whose retention ended.
+	keeper.PruneDepositHistory(ctx)
+
 	// delete dead proposals from store and returns theirs deposits.
 	// A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
@@ -127,10 +131,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			// When expedited proposal fails, it is converted
 			// to a regular proposal. As a result, the voting period is extended, and,
 			// once the regular voting period expires again, the tally is repeated
-			// according to the regular proposal rules.
+			// according to the regular proposal rules of its track.
 			proposal.Expedited = false
-			params := keeper.GetParams(ctx)
-			endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+			track := proposal.ResolvedTrack(keeper.GetParams(ctx))
+			endTime := proposal.VotingStartTime.Add(*track.VotingPeriod)
 			proposal.VotingEndTime = &endTime
 
 			keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
@@ -155,6 +159,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			"proposal", proposal.Id,
 			"status", proposal.Status.String(),
 			"expedited", proposal.Expedited,
+			"track", proposal.GetTrack().GetName(),
 			"title", proposal.Title,
 			"results", logMsg,
 		)
@@ -1,6 +1,7 @@
 package gov_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -399,6 +400,126 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	}
 }
 
+func TestSettledDepositsEndblocker(t *testing.T) {
+	testcases := []struct {
+		name      string
+		option    v1.VoteOption
+		eventType string
+		burned    bool
+	}{
+		{
+			name:      "passed proposal refunds deposits",
+			option:    v1.OptionYes,
+			eventType: types.EventTypeDepositRefund,
+		},
+		{
+			name:      "vetoed proposal burns deposits",
+			option:    v1.OptionNoWithVeto,
+			eventType: types.EventTypeDepositBurn,
+			burned:    true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+			// deposits are settled in depositor address order
+			SortAddresses(addrs)
+
+			params := suite.GovKeeper.GetParams(ctx)
+			params.DepositHistoryRetention = 5
+			require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			suite.StakingKeeper.EndBlocker(ctx)
+
+			proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], false)
+			require.NoError(t, err)
+
+			// the proposal is crowdfunded by two depositors
+			deposits := []sdk.Coins{
+				{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))},
+				{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 1))},
+			}
+			for i, deposit := range deposits {
+				_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[i], proposal.Id, deposit))
+				require.NoError(t, err)
+			}
+
+			err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(tc.option), "")
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Height = 10
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			// an event is emitted for each depositor
+			var events sdk.Events
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeDepositRefund || event.Type == types.EventTypeDepositBurn {
+					events = append(events, event)
+				}
+			}
+			require.Len(t, events, len(deposits))
+			for i, event := range events {
+				require.Equal(t, tc.eventType, event.Type)
+				require.Equal(t, []abci.EventAttribute{
+					{Key: types.AttributeKeyDepositor, Value: addrs[i].String()},
+					{Key: sdk.AttributeKeyAmount, Value: deposits[i].String()},
+					{Key: types.AttributeKeyProposalID, Value: fmt.Sprintf("%d", proposal.Id)},
+				}, event.Attributes)
+			}
+
+			queryDeposits := func(includeRefunded bool) *v1.QueryDepositsResponse {
+				res, err := suite.GovKeeper.Deposits(ctx, &v1.QueryDepositsRequest{ProposalId: proposal.Id, IncludeRefunded: includeRefunded})
+				require.NoError(t, err)
+				return res
+			}
+
+			res := queryDeposits(false)
+			require.Empty(t, res.Deposits)
+			require.Empty(t, res.SettledDeposits)
+
+			res = queryDeposits(true)
+			require.Empty(t, res.Deposits)
+			require.Len(t, res.SettledDeposits, len(deposits))
+			for i, record := range res.SettledDeposits {
+				require.Equal(t, v1.SettledDeposit{
+					ProposalId: proposal.Id,
+					Depositor:  addrs[i].String(),
+					Amount:     deposits[i],
+					Burned:     tc.burned,
+					Height:     10,
+				}, *record)
+			}
+
+			// the records are retained for deposit_history_retention blocks
+			ctx = ctx.WithBlockHeight(14)
+			gov.EndBlocker(ctx, suite.GovKeeper)
+			require.Len(t, queryDeposits(true).SettledDeposits, len(deposits))
+
+			ctx = ctx.WithBlockHeight(15)
+			gov.EndBlocker(ctx, suite.GovKeeper)
+			require.Empty(t, queryDeposits(true).SettledDeposits)
+			_, found := suite.GovKeeper.GetSettledDeposit(ctx, proposal.Id, addrs[0])
+			require.False(t, found)
+		})
+	}
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	suite := createTestSuite(t)
 	app := suite.App
@@ -657,6 +778,132 @@ func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	}
 }
 
+func TestProposalTrackDepositAndVotingPeriods(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	// the text track doubles the minimum deposit and the voting period
+	params := suite.GovKeeper.GetParams(ctx)
+	trackMinDeposit := sdk.NewCoins(params.MinDeposit...).Add(params.MinDeposit...)
+	trackVotingPeriod := 2 * *params.VotingPeriod
+	params.ProposalTracks = append(params.ProposalTracks,
+		v1.NewProposalTrack("text", []string{sdk.MsgTypeURL(mkTestLegacyContent(t))}, trackMinDeposit, &trackVotingPeriod, "", ""))
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	// proposals of the text track cannot be expedited, their voting period not
+	// being shorter than the one of the default track
+	_, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "Proposal", "description of proposal", addrs[0], true)
+	require.NoError(t, err)
+	params.ProposalTracks[1].VotingPeriod = params.ExpeditedVotingPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+	_, err = suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "Proposal", "description of proposal", addrs[0], true)
+	require.ErrorIs(t, err, types.ErrInvalidProposalTrack)
+	params.ProposalTracks[1].VotingPeriod = &trackVotingPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, params.MinDeposit, addrs[0].String(), "", "Proposal", "description of proposal", false)
+	require.NoError(t, err)
+
+	res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	proposalID := res.ProposalId
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, "text", proposal.Track.Name)
+
+	// the minimum deposit of the params is not enough to activate the voting period
+	require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+	newDepositMsg := v1.NewMsgDeposit(addrs[1], proposalID, params.MinDeposit)
+	res1, err := govMsgSvr.Deposit(ctx, newDepositMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res1)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(trackVotingPeriod), *proposal.VotingEndTime)
+
+	// the proposal is still in voting period at the end of the voting period of the params
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingStartTime.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+	// and is tallied at the end of the voting period of its track
+	newHeader.Time = proposal.VotingStartTime.Add(trackVotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusRejected, proposal.Status)
+}
+
+func TestBundledProposalTracks(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the fast text track shortens the voting period but raises the threshold,
+	// the bank track raises the minimum deposit and the voting period
+	params := suite.GovKeeper.GetParams(ctx)
+	fastVotingPeriod := time.Hour
+	bankMinDeposit := sdk.NewCoins(params.MinDeposit...).Add(params.MinDeposit...)
+	bankVotingPeriod := 2 * *params.VotingPeriod
+	msgSend := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))))
+	params.ProposalTracks = append(params.ProposalTracks,
+		v1.NewProposalTrack("fast-text", []string{sdk.MsgTypeURL(mkTestLegacyContent(t))}, nil, &fastVotingPeriod, "", "0.9"),
+		v1.NewProposalTrack("bank", []string{sdk.MsgTypeURL(msgSend)}, bankMinDeposit, &bankVotingPeriod, "", ""))
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	// bundling a message of the fast track does not shorten the voting period
+	// nor lower the minimum deposit of the bank track, and the threshold of the
+	// fast track still applies
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t), msgSend}, "", "Proposal", "description of proposal", addrs[0], false)
+	require.NoError(t, err)
+	require.Equal(t, "fast-text+bank", proposal.Track.Name)
+	require.Equal(t, bankVotingPeriod, *proposal.Track.VotingPeriod)
+	require.Equal(t, bankMinDeposit, proposal.Track.MinDeposit)
+	require.Equal(t, "0.9", proposal.Track.Threshold)
+	require.Equal(t, params.Quorum, proposal.Track.Quorum)
+
+	// the track resolved on submission keeps governing the proposal when the
+	// tracks are updated
+	params.ProposalTracks = params.ProposalTracks[:1]
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], params.MinDeposit)
+	require.NoError(t, err)
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[0], params.MinDeposit)
+	require.NoError(t, err)
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(bankVotingPeriod), *proposal.VotingEndTime)
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -38,7 +38,9 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryProposalTrack(),
 		GetCmdConstitution(),
+		GetCmdQueryValidatorGovParticipation(),
 	)
 
 	return govQueryCmd
@@ -400,10 +402,14 @@ func GetCmdQueryDeposits() *cobra.Command {
 			fmt.Sprintf(`Query details for all deposits on a proposal.
 You can find the proposal-id by running "%s query gov proposals".
 
+With --include-refunded, the retained records of the refunded and burned deposits
+of a settled proposal are returned.
+
 Example:
 $ %s query gov deposits 1
+$ %s query gov deposits 1 --include-refunded
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -434,9 +440,14 @@ $ %s query gov deposits 1
 				return err
 			}
 
+			includeRefunded, err := cmd.Flags().GetBool(flagRefunded)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Deposits(
 				ctx,
-				&v1.QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq},
+				&v1.QueryDepositsRequest{ProposalId: proposalID, Pagination: pageReq, IncludeRefunded: includeRefunded},
 			)
 			if err != nil {
 				return err
@@ -446,6 +457,7 @@ $ %s query gov deposits 1
 		},
 	}
 
+	cmd.Flags().Bool(flagRefunded, false, "Return the retained records of the refunded and burned deposits of a settled proposal")
 	flags.AddPaginationFlagsToCmd(cmd, "deposits")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -509,6 +521,53 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryProposalTrack implements the query proposal track command.
+func GetCmdQueryProposalTrack() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "track [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the track of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposal track resolved from the messages of a proposal on submission,
+with the deposit, voting period and tally parameters which apply to it. You can find
+the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s query gov track 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ProposalTrack(
+				cmd.Context(),
+				&v1.QueryProposalTrackRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Track)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
@@ -675,3 +734,49 @@ func GetCmdConstitution() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryValidatorGovParticipation implements the query validator governance participation command.
+func GetCmdQueryValidatorGovParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-participation [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the participation of a validator in the concluded proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of concluded proposals tallied while a validator was bonded, and
+the ones it did not vote on. Use --window to only count the most recent proposals.
+
+Example:
+$ %s query gov validator-participation cosmosvaloper1... --window 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			window, err := cmd.Flags().GetUint64(flagWindow)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorGovParticipation(
+				cmd.Context(),
+				&v1.QueryValidatorGovParticipationRequest{ValidatorAddr: args[0], Window: window},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagWindow, 0, "The number of most recent proposals to count over, all if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
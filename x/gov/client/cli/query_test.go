@@ -243,6 +243,14 @@ func (s *CLITestSuite) TestCmdQueryDeposits() {
 			},
 			"1 --output=json",
 		},
+		{
+			"get deposits including refunded",
+			[]string{
+				"1",
+				"--include-refunded",
+			},
+			"1 --include-refunded",
+		},
 	}
 
 	for _, tc := range testCases {
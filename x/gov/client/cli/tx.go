@@ -25,6 +25,8 @@ const (
 	flagVoter     = "voter"
 	flagDepositor = "depositor"
 	flagStatus    = "status"
+	flagWindow    = "window"
+	flagRefunded  = "include-refunded"
 	FlagMetadata  = "metadata"
 	FlagSummary   = "summary"
 	// Deprecated: only used for v1beta1 legacy proposals.
@@ -0,0 +1,26 @@
+package gov_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	"github.com/cosmos/cosmos-sdk/testutil/conformance"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestGenesisConformance(t *testing.T) {
+	conformance.RunGenesisConformance(t, conformance.GenesisConfig{
+		ModuleName: types.ModuleName,
+		Setup: conformance.AppConfigGenesisSetup(configurator.NewAppConfig(
+			configurator.ParamsModule(),
+			configurator.AuthModule(),
+			configurator.StakingModule(),
+			configurator.BankModule(),
+			configurator.GovModule(),
+			configurator.ConsensusModule(),
+			configurator.DistributionModule(),
+		), types.ModuleName),
+		GenesisType: &v1.GenesisState{},
+	})
+}
@@ -31,6 +31,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, *vote)
 	}
 
+	for _, entry := range data.DepositHistory {
+		k.SetSettledDeposit(ctx, *entry.SettledDeposit, entry.ExpiryHeight)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -70,6 +74,13 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		proposalsVotes = append(proposalsVotes, votes...)
 	}
 
+	var depositHistory []*v1.DepositHistoryEntry
+	k.IterateDepositHistory(ctx, func(record v1.SettledDeposit, expiryHeight int64) bool {
+		record := record
+		depositHistory = append(depositHistory, &v1.DepositHistoryEntry{SettledDeposit: &record, ExpiryHeight: expiryHeight})
+		return false
+	})
+
 	return &v1.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
@@ -77,5 +88,6 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 		Proposals:          proposals,
 		Params:             &params,
 		Constitution:       constitution,
+		DepositHistory:     depositHistory,
 	}
 }
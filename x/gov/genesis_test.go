@@ -36,3 +36,38 @@ func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	genState := gov.ExportGenesis(ctx, suite.GovKeeper)
 	require.Equal(t, genState, v1.DefaultGenesisState())
 }
+
+func TestImportExportDepositHistory(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+
+	depositor := sdk.AccAddress("addr1_______________")
+	record := v1.SettledDeposit{
+		ProposalId: 1,
+		Depositor:  depositor.String(),
+		Amount:     sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1234))),
+		Burned:     true,
+		Height:     5,
+	}
+
+	genState := v1.DefaultGenesisState()
+	genState.DepositHistory = []*v1.DepositHistoryEntry{{SettledDeposit: &record, ExpiryHeight: 15}}
+	require.NoError(t, v1.ValidateGenesis(genState))
+
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, genState)
+	settled, found := suite.GovKeeper.GetSettledDeposit(ctx, 1, depositor)
+	require.True(t, found)
+	require.Equal(t, record, settled)
+	require.Equal(t, genState, gov.ExportGenesis(ctx, suite.GovKeeper))
+
+	// the record is pruned at its imported expiry height
+	suite.GovKeeper.PruneDepositHistory(ctx.WithBlockHeight(14))
+	_, found = suite.GovKeeper.GetSettledDeposit(ctx, 1, depositor)
+	require.True(t, found)
+
+	suite.GovKeeper.PruneDepositHistory(ctx.WithBlockHeight(15))
+	_, found = suite.GovKeeper.GetSettledDeposit(ctx, 1, depositor)
+	require.False(t, found)
+	require.Empty(t, gov.ExportGenesis(ctx, suite.GovKeeper).DepositHistory)
+}
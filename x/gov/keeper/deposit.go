@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"golang.org/x/exp/slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -61,6 +62,7 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits v
 // DeleteAndBurnDeposits deletes and burns all the deposits on a specific proposal.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
+	retention := keeper.GetParams(ctx).DepositHistoryRetention
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
 		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
@@ -74,6 +76,7 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 		}
 
 		store.Delete(types.DepositKey(proposalID, depositor))
+		keeper.settleDeposit(ctx, deposit, depositor, true, retention)
 		return false
 	})
 }
@@ -128,6 +131,11 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return false, errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	params := keeper.GetParams(ctx)
+	if err := validateDepositDenoms(params, depositAmount); err != nil {
+		return false, err
+	}
+
 	// update the governance module's account coins pool
 	err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
@@ -140,7 +148,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	minDepositAmount := proposal.GetMinDepositFromParams(keeper.GetParams(ctx))
+	minDepositAmount := proposal.GetMinDepositFromParams(params)
 
 	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDepositAmount) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
@@ -254,6 +262,7 @@ func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddre
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
+	retention := keeper.GetParams(ctx).DepositHistoryRetention
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
 		depositor, err := keeper.authKeeper.StringToBytes(deposit.Depositor)
@@ -267,14 +276,111 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 		}
 
 		store.Delete(types.DepositKey(proposalID, depositor))
+		keeper.settleDeposit(ctx, deposit, depositor, false, retention)
 		return false
 	})
 }
 
+// settleDeposit emits the refund or burn event of a deposit of a settled proposal
+// and records it for retention blocks, when retention is not zero.
+func (keeper Keeper) settleDeposit(ctx sdk.Context, deposit v1.Deposit, depositor sdk.AccAddress, burned bool, retention uint64) {
+	eventType := types.EventTypeDepositRefund
+	if burned {
+		eventType = types.EventTypeDepositBurn
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoins(deposit.Amount...).String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", deposit.ProposalId)),
+		),
+	)
+
+	if retention == 0 {
+		return
+	}
+
+	record := v1.SettledDeposit{
+		ProposalId: deposit.ProposalId,
+		Depositor:  deposit.Depositor,
+		Amount:     deposit.Amount,
+		Burned:     burned,
+		Height:     ctx.BlockHeight(),
+	}
+
+	keeper.SetSettledDeposit(ctx, record, ctx.BlockHeight()+int64(retention))
+}
+
+// SetSettledDeposit records a settled deposit in the deposit history until the
+// given expiry height.
+func (keeper Keeper) SetSettledDeposit(ctx sdk.Context, record v1.SettledDeposit, expiryHeight int64) {
+	depositor, err := keeper.authKeeper.StringToBytes(record.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.SettledDepositKey(record.ProposalId, depositor), keeper.cdc.MustMarshal(&record))
+	store.Set(types.DepositHistoryQueueKey(expiryHeight, record.ProposalId, depositor), []byte{})
+}
+
+// IterateDepositHistory iterates over the records of the settled deposits by
+// expiry height, and performs a callback function.
+func (keeper Keeper) IterateDepositHistory(ctx sdk.Context, cb func(record v1.SettledDeposit, expiryHeight int64) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DepositHistoryQueueKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		expiryHeight, recordKey := types.SplitDepositHistoryQueueKey(iterator.Key())
+
+		var record v1.SettledDeposit
+		keeper.cdc.MustUnmarshal(store.Get(recordKey), &record)
+
+		if cb(record, expiryHeight) {
+			break
+		}
+	}
+}
+
+// GetSettledDeposit gets the record of a settled deposit of a specific depositor on a specific proposal
+func (keeper Keeper) GetSettledDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) (record v1.SettledDeposit, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.SettledDepositKey(proposalID, depositorAddr))
+	if bz == nil {
+		return record, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// PruneDepositHistory deletes the records of the settled deposits whose retention
+// ended at or before the current block height.
+func (keeper Keeper) PruneDepositHistory(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.DepositHistoryQueueKeyPrefix, storetypes.PrefixEndBytes(types.DepositHistoryQueueByHeightKey(ctx.BlockHeight())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		_, recordKey := types.SplitDepositHistoryQueueKey(key)
+		store.Delete(recordKey)
+		store.Delete(key)
+	}
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
+// the deposit parameters of the proposal track. Returns nil on success, error otherwise.
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, track v1.ProposalTrack, expedited bool) error {
 	params := keeper.GetParams(ctx)
 	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
 	if err != nil {
@@ -284,18 +390,30 @@ func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.
 		return nil
 	}
 
-	var minDepositCoins sdk.Coins
-	if expedited {
-		minDepositCoins = params.ExpeditedMinDeposit
-	} else {
-		minDepositCoins = params.MinDeposit
-	}
+	minDeposit := v1.Proposal{Track: &track, Expedited: expedited}.GetMinDepositFromParams(params)
 
-	for i := range minDepositCoins {
-		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
+	minDepositCoins := make(sdk.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		minDepositCoins[i] = sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(minInitialDepositRatio).RoundInt())
 	}
 	if !initialDeposit.IsAllGTE(minDepositCoins) {
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
 	}
 	return nil
 }
+
+// validateDepositDenoms returns an error if a deposit contains a denom which is
+// not in the deposit denoms allowlist, when the allowlist is set.
+func validateDepositDenoms(params v1.Params, deposit sdk.Coins) error {
+	if len(params.MinDepositDenomsAllowlist) == 0 {
+		return nil
+	}
+
+	for _, coin := range deposit {
+		if !slices.Contains(params.MinDepositDenomsAllowlist, coin.Denom) {
+			return errors.Wrapf(types.ErrInvalidDepositDenom, "%s, allowed denoms: %v", coin.Denom, params.MinDepositDenomsAllowlist)
+		}
+	}
+
+	return nil
+}
@@ -252,7 +252,7 @@ func TestValidateInitialDeposit(t *testing.T) {
 
 			govKeeper.SetParams(ctx, params)
 
-			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit, tc.expedited)
+			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit, params.ProposalTrack(v1.DefaultProposalTrackName), tc.expedited)
 
 			if tc.expectError {
 				require.Error(t, err)
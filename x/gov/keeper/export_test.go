@@ -1,9 +1,18 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
 
 // ValidateInitialDeposit is a helper function used only in deposit tests which returns the same
 // functionality of validateInitialDeposit private function.
-func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
-	return k.validateInitialDeposit(ctx, initialDeposit, expedited)
+func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, track v1.ProposalTrack, expedited bool) error {
+	return k.validateInitialDeposit(ctx, initialDeposit, track, expedited)
+}
+
+// RecordValidatorParticipation is a helper function used only in participation tests which returns the same
+// functionality of recordValidatorParticipation private function.
+func (k Keeper) RecordValidatorParticipation(ctx sdk.Context, proposalID uint64, validators []v1.ValidatorGovInfo) {
+	k.recordValidatorParticipation(ctx, proposalID, validators)
 }
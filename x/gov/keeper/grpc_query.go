@@ -238,6 +238,18 @@ func (q Keeper) Deposits(c context.Context, req *v1.QueryDepositsRequest) (*v1.Q
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(q.storeKey)
+	if req.IncludeRefunded {
+		historyStore := prefix.NewStore(store, types.DepositHistoryKey(req.ProposalId))
+		iterator := historyStore.Iterator(nil, nil)
+		settled := iterator.Valid()
+		iterator.Close()
+
+		// the deposits of a proposal are settled all at once
+		if settled {
+			return q.settledDeposits(historyStore, req.Pagination)
+		}
+	}
+
 	depositStore := prefix.NewStore(store, types.DepositsKey(req.ProposalId))
 
 	pageRes, err := query.Paginate(depositStore, req.Pagination, func(key, value []byte) error {
@@ -256,6 +268,26 @@ func (q Keeper) Deposits(c context.Context, req *v1.QueryDepositsRequest) (*v1.Q
 	return &v1.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
+// settledDeposits returns the retained records of the settled deposits of a proposal
+func (q Keeper) settledDeposits(historyStore prefix.Store, pageReq *query.PageRequest) (*v1.QueryDepositsResponse, error) {
+	var settledDeposits []*v1.SettledDeposit
+
+	pageRes, err := query.Paginate(historyStore, pageReq, func(key, value []byte) error {
+		var record v1.SettledDeposit
+		if err := q.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		settledDeposits = append(settledDeposits, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDepositsResponse{SettledDeposits: settledDeposits, Pagination: pageRes}, nil
+}
+
 // TallyResult queries the tally of a proposal vote
 func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest) (*v1.QueryTallyResultResponse, error) {
 	if req == nil {
@@ -284,12 +316,65 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = q.Tally(ctx, proposal)
+		_, _, tallyResult, _ = q.tally(ctx, proposal)
 	}
 
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ValidatorGovParticipation queries the participation of a validator in the concluded proposals
+func (q Keeper) ValidatorGovParticipation(c context.Context, req *v1.QueryValidatorGovParticipationRequest) (*v1.QueryValidatorGovParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &v1.QueryValidatorGovParticipationResponse{}
+	q.IterateValidatorParticipation(ctx, valAddr, func(proposalID uint64, voted bool) bool {
+		res.TalliedProposals++
+		if !voted {
+			res.NonVotedProposals++
+			res.NonVotedProposalIds = append(res.NonVotedProposalIds, proposalID)
+		}
+
+		return req.Window != 0 && res.TalliedProposals >= req.Window
+	})
+
+	return res, nil
+}
+
+// ProposalTrack queries the proposal track resolved for a proposal, with the parameters which apply to it
+func (q Keeper) ProposalTrack(c context.Context, req *v1.QueryProposalTrackRequest) (*v1.QueryProposalTrackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	track := proposal.ResolvedTrack(q.GetParams(ctx))
+
+	return &v1.QueryProposalTrackResponse{Track: &track}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/math"
@@ -678,6 +679,33 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			},
 			true,
 		},
+		{
+			"request after re-voting with a rationale",
+			func() {
+				metadata := `{"rationale_uri":"ipfs://rationale","rationale_hash":"` + strings.Repeat("ab", 32) + `"}`
+				accAddr1, err := address.NewBech32Codec("cosmos").StringToBytes(votes[0].Voter)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, accAddr1, votes[0].Options, metadata))
+
+				votes[0] = &v1.Vote{
+					ProposalId:    proposal.Id,
+					Voter:         addrs[0].String(),
+					Options:       votes[0].Options,
+					Metadata:      metadata,
+					RationaleUri:  "ipfs://rationale",
+					RationaleHash: strings.Repeat("ab", 32),
+				}
+
+				req = &v1.QueryVotesRequest{
+					ProposalId: proposal.Id,
+				}
+
+				expRes = &v1.QueryVotesResponse{
+					Votes: votes,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1506,6 +1534,80 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryProposalTrack() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	votingPeriod := time.Hour
+	params := suite.govKeeper.GetParams(ctx)
+	params.ProposalTracks = append(params.ProposalTracks, v1.NewProposalTrack("text", []string{"/cosmos.gov.v1.MsgExecLegacyContent"}, nil, &votingPeriod, "", "0.6"))
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+
+	var (
+		req      *v1.QueryProposalTrackRequest
+		expTrack v1.ProposalTrack
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QueryProposalTrackRequest{}
+			},
+			false,
+		},
+		{
+			"non existing proposal request",
+			func() {
+				req = &v1.QueryProposalTrackRequest{ProposalId: 2}
+			},
+			false,
+		},
+		{
+			"valid request with proposal of the text track, updated since its submission",
+			func() {
+				expTrack = v1.NewProposalTrack("text", []string{"/cosmos.gov.v1.MsgExecLegacyContent"}, params.MinDeposit, &votingPeriod, params.Quorum, "0.7")
+				proposal := v1.Proposal{Id: 1, Status: v1.StatusVotingPeriod, Track: &expTrack}
+				suite.govKeeper.SetProposal(ctx, proposal)
+
+				req = &v1.QueryProposalTrackRequest{ProposalId: proposal.Id}
+			},
+			true,
+		},
+		{
+			"valid request with proposal submitted before tracks",
+			func() {
+				proposal := v1.Proposal{Id: 1, Status: v1.StatusVotingPeriod}
+				suite.govKeeper.SetProposal(ctx, proposal)
+
+				req = &v1.QueryProposalTrackRequest{ProposalId: proposal.Id}
+				expTrack = v1.NewProposalTrack("", nil, params.MinDeposit, params.VotingPeriod, params.Quorum, params.Threshold)
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			trackRes, err := queryClient.ProposalTrack(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTrack.String(), trackRes.Track.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(trackRes)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	ctx, queryClient := suite.ctx, suite.legacyQueryClient
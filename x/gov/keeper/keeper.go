@@ -233,3 +233,13 @@ func (k Keeper) assertMetadataLength(metadata string) error {
 	}
 	return nil
 }
+
+// assertVoteMetadataLength returns an error if given vote metadata length is
+// greater than the MaxVoteMetadataLen param, when it is set.
+func (k Keeper) assertVoteMetadataLength(ctx sdk.Context, metadata string) error {
+	maxLen := k.GetParams(ctx).MaxVoteMetadataLen
+	if maxLen != 0 && uint64(len(metadata)) > maxLen {
+		return types.ErrMetadataTooLong.Wrapf("got vote metadata with length %d, max %d", len(metadata), maxLen)
+	}
+	return nil
+}
@@ -64,7 +64,8 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	initialDeposit := msg.GetInitialDeposit()
 
-	if err := k.validateInitialDeposit(ctx, initialDeposit, msg.Expedited); err != nil {
+	track := k.GetParams(ctx).ResolveProposalTrack(msgTypeURLs(proposalMsgs))
+	if err := k.validateInitialDeposit(ctx, initialDeposit, track, msg.Expedited); err != nil {
 		return nil, err
 	}
 
@@ -170,6 +171,10 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertVoteMetadataLength(ctx, msg.Metadata); err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
 	if err != nil {
 		return nil, err
@@ -223,6 +228,10 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertVoteMetadataLength(ctx, msg.Metadata); err != nil {
+		return nil, err
+	}
+
 	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -701,6 +703,81 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVoteMetadata() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	proposer := suite.addrs[0]
+
+	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+	}
+
+	msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, minDeposit, proposer.String(), "", "Proposal", "description of proposal", false)
+	suite.Require().NoError(err)
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	proposalID := res.ProposalId
+
+	hash := strings.Repeat("ab", 32)
+	rationale := func(uri, hash string) string {
+		return fmt.Sprintf(`{"rationale_uri":%q,"rationale_hash":%q}`, uri, hash)
+	}
+
+	// the max vote metadata length param is enforced even though it is lower
+	// than the module configured metadata length
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.MaxVoteMetadataLen = 20
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, proposalID, v1.OptionYes, strings.Repeat("a", 21)))
+	suite.Require().ErrorIs(err, types.ErrMetadataTooLong)
+	_, err = suite.msgSrvr.VoteWeighted(suite.ctx, v1.NewMsgVoteWeighted(proposer, proposalID, v1.NewNonSplitVoteOption(v1.OptionYes), strings.Repeat("a", 21)))
+	suite.Require().ErrorIs(err, types.ErrMetadataTooLong)
+	_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, proposalID, v1.OptionYes, strings.Repeat("a", 20)))
+	suite.Require().NoError(err)
+
+	params.MaxVoteMetadataLen = v1.DefaultMaxVoteMetadataLen
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	invalid := map[string]string{
+		"missing hash":      `{"rationale_uri":"ipfs://rationale"}`,
+		"relative uri":      rationale("rationale.md", hash),
+		"non hex hash":      rationale("ipfs://rationale", strings.Repeat("zz", 32)),
+		"wrong hash length": rationale("ipfs://rationale", "abcd"),
+	}
+	for name, metadata := range invalid {
+		suite.Run(name, func() {
+			_, err := suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, proposalID, v1.OptionYes, metadata))
+			suite.Require().ErrorIs(err, types.ErrInvalidVoteRationale)
+		})
+	}
+
+	_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, proposalID, v1.OptionYes, rationale("ipfs://first", hash)))
+	suite.Require().NoError(err)
+	vote, found := suite.govKeeper.GetVote(suite.ctx, proposalID, proposer)
+	suite.Require().True(found)
+	suite.Require().Equal("ipfs://first", vote.RationaleUri)
+	suite.Require().Equal(hash, vote.RationaleHash)
+
+	// re-voting replaces the prior rationale
+	_, err = suite.msgSrvr.VoteWeighted(suite.ctx, v1.NewMsgVoteWeighted(proposer, proposalID, v1.NewNonSplitVoteOption(v1.OptionNo), rationale("https://second", hash)))
+	suite.Require().NoError(err)
+	vote, found = suite.govKeeper.GetVote(suite.ctx, proposalID, proposer)
+	suite.Require().True(found)
+	suite.Require().Equal("https://second", vote.RationaleUri)
+
+	// free-form metadata clears it
+	_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, proposalID, v1.OptionNo, "no rationale"))
+	suite.Require().NoError(err)
+	vote, found = suite.govKeeper.GetVote(suite.ctx, proposalID, proposer)
+	suite.Require().True(found)
+	suite.Require().Empty(vote.RationaleUri)
+	suite.Require().Empty(vote.RationaleHash)
+}
+
 func (suite *KeeperTestSuite) TestDepositReq() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
@@ -784,6 +861,40 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 }
 
 // legacy msg server tests
+func (suite *KeeperTestSuite) TestDepositDenomsAllowlist() {
+	suite.reset()
+	proposer := suite.addrs[0]
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.MinDepositDenomsAllowlist = []string{sdk.DefaultBondDenom}
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	// an initial deposit in a disallowed denom is rejected
+	initialDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin(ibcDenom, sdkmath.NewInt(100)))
+	msg, err := v1.NewMsgSubmitProposal(TestProposal, initialDeposit, proposer.String(), "", "Proposal", "description of proposal", false)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidDepositDenom)
+
+	initialDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	msg, err = v1.NewMsgSubmitProposal(TestProposal, initialDeposit, proposer.String(), "", "Proposal", "description of proposal", false)
+	suite.Require().NoError(err)
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// a top-up in a disallowed denom is rejected without changing the deposit
+	_, err = suite.msgSrvr.Deposit(suite.ctx, v1.NewMsgDeposit(proposer, res.ProposalId, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1)))))
+	suite.Require().ErrorIs(err, types.ErrInvalidDepositDenom)
+	proposal, found := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+	suite.Require().True(found)
+	suite.Require().Equal(initialDeposit, sdk.NewCoins(proposal.TotalDeposit...))
+
+	// a top-up in an allowed denom is accepted
+	_, err = suite.msgSrvr.Deposit(suite.ctx, v1.NewMsgDeposit(proposer, res.ProposalId, initialDeposit))
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	proposer := simtestutil.AddTestAddrsIncremental(suite.bankKeeper, suite.stakingKeeper, suite.ctx, 1, sdkmath.NewInt(50000000))[0]
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)))
@@ -1610,6 +1721,47 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "invalid deposit denoms allowlist",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MinDepositDenomsAllowlist = []string{sdk.DefaultBondDenom, "1invalid"}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid deposit denoms allowlist",
+		},
+		{
+			name: "deposit denoms allowlist without the min deposit denom",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MinDepositDenomsAllowlist = []string{"uatom"}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "is not in the deposit denoms allowlist",
+		},
+		{
+			name: "valid deposit denoms allowlist",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MinDepositDenomsAllowlist = []string{sdk.DefaultBondDenom, "uatom"}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
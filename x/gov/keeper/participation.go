@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// SetValidatorParticipation records whether a validator bonded at the tally of a proposal voted on it
+func (keeper Keeper) SetValidatorParticipation(ctx sdk.Context, valAddr sdk.ValAddress, proposalID uint64, voted bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := []byte{0x00}
+	if voted {
+		bz = []byte{0x01}
+	}
+
+	store.Set(types.ValidatorParticipationKey(valAddr, proposalID), bz)
+}
+
+// IterateValidatorParticipation iterates over the participation of a validator in the proposals tallied
+// while it was bonded, most recent proposal first, and performs a callback function
+func (keeper Keeper) IterateValidatorParticipation(ctx sdk.Context, valAddr sdk.ValAddress, cb func(proposalID uint64, voted bool) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.ValidatorParticipationsKey(valAddr)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID := types.GetProposalIDFromBytes(iterator.Key()[len(prefix):])
+		if cb(proposalID, iterator.Value()[0] == 0x01) {
+			break
+		}
+	}
+}
+
+// recordValidatorParticipation records the participation of the bonded validators in a concluded
+// proposal. A validator which did not vote is reported with an event and, if the non voting commission
+// diversion param is positive, a fraction of its next commission withdrawal is routed to the community
+// pool.
+func (keeper Keeper) recordValidatorParticipation(ctx sdk.Context, proposalID uint64, validators []v1.ValidatorGovInfo) {
+	diversion := sdkmath.LegacyZeroDec()
+	if params := keeper.GetParams(ctx); params.NonVotingCommissionDiversion != "" {
+		diversion = sdkmath.LegacyMustNewDecFromStr(params.NonVotingCommissionDiversion)
+	}
+
+	for _, val := range validators {
+		voted := len(val.Vote) != 0
+		keeper.SetValidatorParticipation(ctx, val.Address, proposalID, voted)
+		if voted {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorNoVote,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+				sdk.NewAttribute(types.AttributeKeyValidator, val.Address.String()),
+			),
+		)
+
+		if diversion.IsPositive() {
+			if err := keeper.distrkeeper.SetValidatorCommissionDiversion(ctx, val.Address, diversion); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestValidatorParticipation(t *testing.T) {
+	govKeeper, _, _, _, distrKeeper, _, ctx := setupGovKeeper(t)
+	voter := sdk.ValAddress("voter_______________")
+	nonVoter := sdk.ValAddress("non_voter___________")
+
+	validators := func(nonVoterVotes bool) []v1.ValidatorGovInfo {
+		vals := []v1.ValidatorGovInfo{
+			v1.NewValidatorGovInfo(voter, math.NewInt(10), math.LegacyNewDec(10), math.LegacyZeroDec(), v1.NewNonSplitVoteOption(v1.OptionYes)),
+			v1.NewValidatorGovInfo(nonVoter, math.NewInt(5), math.LegacyNewDec(5), math.LegacyZeroDec(), v1.WeightedVoteOptions{}),
+		}
+		if nonVoterVotes {
+			vals[1].Vote = v1.NewNonSplitVoteOption(v1.OptionNo)
+		}
+		return vals
+	}
+
+	// without diversion, the participation is only recorded and reported
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	govKeeper.RecordValidatorParticipation(ctx, 1, validators(false))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeValidatorNoVote, events[0].Type)
+	attr, ok := events[0].GetAttribute(types.AttributeKeyValidator)
+	require.True(t, ok)
+	require.Equal(t, nonVoter.String(), attr.Value)
+
+	// with diversion, the next commission withdrawal of the non voting validator is diverted
+	params := govKeeper.GetParams(ctx)
+	params.NonVotingCommissionDiversion = "0.1"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	distrKeeper.EXPECT().SetValidatorCommissionDiversion(ctx, nonVoter, math.LegacyNewDecWithPrec(1, 1)).Return(nil).Times(1)
+	govKeeper.RecordValidatorParticipation(ctx, 2, validators(false))
+
+	govKeeper.RecordValidatorParticipation(ctx, 3, validators(true))
+
+	testCases := []struct {
+		name   string
+		req    *v1.QueryValidatorGovParticipationRequest
+		expErr bool
+		expRes *v1.QueryValidatorGovParticipationResponse
+	}{
+		{
+			name:   "empty validator address",
+			req:    &v1.QueryValidatorGovParticipationRequest{},
+			expErr: true,
+		},
+		{
+			name:   "invalid validator address",
+			req:    &v1.QueryValidatorGovParticipationRequest{ValidatorAddr: "invalid"},
+			expErr: true,
+		},
+		{
+			name:   "voting validator",
+			req:    &v1.QueryValidatorGovParticipationRequest{ValidatorAddr: voter.String()},
+			expRes: &v1.QueryValidatorGovParticipationResponse{TalliedProposals: 3},
+		},
+		{
+			name: "non voting validator",
+			req:  &v1.QueryValidatorGovParticipationRequest{ValidatorAddr: nonVoter.String()},
+			expRes: &v1.QueryValidatorGovParticipationResponse{
+				TalliedProposals:    3,
+				NonVotedProposals:   2,
+				NonVotedProposalIds: []uint64{2, 1},
+			},
+		},
+		{
+			name: "non voting validator over a window",
+			req:  &v1.QueryValidatorGovParticipationRequest{ValidatorAddr: nonVoter.String(), Window: 2},
+			expRes: &v1.QueryValidatorGovParticipationResponse{
+				TalliedProposals:    2,
+				NonVotedProposals:   1,
+				NonVotedProposalIds: []uint64{2},
+			},
+		},
+		{
+			name:   "unknown validator",
+			req:    &v1.QueryValidatorGovParticipationRequest{ValidatorAddr: sdk.ValAddress("unknown_____________").String()},
+			expRes: &v1.QueryValidatorGovParticipationResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := govKeeper.ValidatorGovParticipation(ctx, tc.req)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
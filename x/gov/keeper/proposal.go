@@ -86,13 +86,24 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 		return v1.Proposal{}, err
 	}
 
+	params := keeper.GetParams(ctx)
+
+	// the proposal is governed by the parameters of the track resolved from its messages
+	track := params.ResolveProposalTrack(msgTypeURLs(messages))
+	if expedited && *track.VotingPeriod <= *params.ExpeditedVotingPeriod {
+		return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalTrack,
+			"proposals of track %s cannot be expedited: voting period %s is not longer than the expedited voting period %s",
+			track.Name, track.VotingPeriod, params.ExpeditedVotingPeriod)
+	}
+
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+	depositPeriod := params.MaxDepositPeriod
 
 	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Track = &track
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -112,6 +123,16 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// msgTypeURLs returns the type URLs of the messages.
+func msgTypeURLs(msgs []sdk.Msg) []string {
+	typeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		typeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	return typeURLs
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
@@ -322,7 +343,7 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal)
 	if proposal.Expedited {
 		votingPeriod = keeper.GetParams(ctx).ExpeditedVotingPeriod
 	} else {
-		votingPeriod = keeper.GetParams(ctx).VotingPeriod
+		votingPeriod = proposal.ResolvedTrack(keeper.GetParams(ctx)).VotingPeriod
 	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
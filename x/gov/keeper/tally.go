@@ -10,8 +10,21 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters. When the proposal concludes, it records the participation of the bonded validators.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult) {
+	passes, burnDeposits, tallyResults, validators := keeper.tally(ctx, proposal)
+
+	// an expedited proposal which fails is converted to a regular proposal and tallied again later
+	if !(proposal.Expedited && !passes) {
+		keeper.recordValidatorParticipation(ctx, proposal.Id, validators)
+	}
+
+	return passes, burnDeposits, tallyResults
+}
+
+// tally computes the tally of a proposal, and returns the bonded validators by decreasing power along
+// with their votes.
+func (keeper Keeper) tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, validators []v1.ValidatorGovInfo) {
 	results := make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
@@ -20,9 +33,11 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnD
 
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
+	var bondedValidators []string
 
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		bondedValidators = append(bondedValidators, validator.GetOperator().String())
 		currValidators[validator.GetOperator().String()] = v1.NewValidatorGovInfo(
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
@@ -92,47 +107,53 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnD
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
+	validators = make([]v1.ValidatorGovInfo, len(bondedValidators))
+	for i, valAddrStr := range bondedValidators {
+		validators[i] = currValidators[valAddrStr]
+	}
+
 	params := keeper.GetParams(ctx)
+	track := proposal.ResolvedTrack(params)
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
 	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+		return false, false, tallyResults, validators
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
-	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
+	quorum, _ := math.LegacyNewDecFromStr(track.Quorum)
 	if percentVoting.LT(quorum) {
-		return false, params.BurnVoteQuorum, tallyResults
+		return false, params.BurnVoteQuorum, tallyResults, validators
 	}
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults
+		return false, false, tallyResults, validators
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, params.BurnVoteVeto, tallyResults
+		return false, params.BurnVoteVeto, tallyResults, validators
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	// If more than the track threshold (1/2 by default) of non-abstaining voters vote Yes, proposal passes
 	// For expedited 2/3
 	var thresholdStr string
 	if proposal.Expedited {
 		thresholdStr = params.GetExpeditedThreshold()
 	} else {
-		thresholdStr = params.GetThreshold()
+		thresholdStr = track.GetThreshold()
 	}
 
 	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
+		return true, false, tallyResults, validators
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return false, false, tallyResults, validators
 }
@@ -30,7 +30,15 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		}
 	}
 
+	rationale, err := v1.ParseVoteRationale(metadata)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidVoteRationale, err.Error())
+	}
+
+	// a re-vote replaces the rationale of the previous vote
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
+	vote.RationaleUri = rationale.URI
+	vote.RationaleHash = rationale.Hash
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
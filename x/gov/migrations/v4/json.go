@@ -30,6 +30,9 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 	)
+	params.NonVotingCommissionDiversion = defaultParams.NonVotingCommissionDiversion
+	params.MaxVoteMetadataLen = defaultParams.MaxVoteMetadataLen
+	params.ProposalTracks = defaultParams.ProposalTracks
 
 	return &v1.GenesisState{
 		StartingProposalId: oldState.StartingProposalId,
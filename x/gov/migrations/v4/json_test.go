@@ -63,6 +63,7 @@ func TestMigrateJSON(t *testing.T) {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
+		"deposit_history_retention": "0",
 		"expedited_min_deposit": [
 			{
 				"amount": "50000000",
@@ -72,15 +73,28 @@ func TestMigrateJSON(t *testing.T) {
 		"expedited_threshold": "0.667000000000000000",
 		"expedited_voting_period": "86400s",
 		"max_deposit_period": "172800s",
+		"max_vote_metadata_len": "255",
 		"min_deposit": [
 			{
 				"amount": "10000000",
 				"denom": "stake"
 			}
 		],
+		"min_deposit_denoms_allowlist": [],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"non_voting_commission_diversion": "0.000000000000000000",
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "0.500000000000000000",
+		"proposal_tracks": [
+			{
+				"min_deposit": [],
+				"msg_type_urls": [],
+				"name": "default",
+				"quorum": "",
+				"threshold": "",
+				"voting_period": null
+			}
+		],
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
@@ -43,6 +43,8 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 	)
+	params.NonVotingCommissionDiversion = defaultParams.NonVotingCommissionDiversion
+	params.MaxVoteMetadataLen = defaultParams.MaxVoteMetadataLen
 
 	bz, err := cdc.Marshal(&params)
 	if err != nil {
@@ -106,6 +106,7 @@ func TestMigrateStore(t *testing.T) {
 	require.Equal(t, legacySubspace.tp.Threshold, params.Threshold)
 	require.Equal(t, legacySubspace.tp.VetoThreshold, params.VetoThreshold)
 	require.Equal(t, sdk.ZeroDec().String(), params.MinInitialDepositRatio)
+	require.Empty(t, params.MinDepositDenomsAllowlist)
 
 	// Check proposals' status
 	var migratedProp1 v1.Proposal
@@ -96,7 +96,7 @@ func GenVeto(r *rand.Rand) sdkmath.LegacyDec {
 
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
-	startingProposalID := uint64(simState.Rand.Intn(100))
+	startingProposalID := uint64(1 + simState.Rand.Intn(100))
 
 	var minDeposit sdk.Coins
 	simState.AppParams.GetOrGenerate(
@@ -293,7 +293,7 @@ func simulateMsgSubmitProposal(
 		whoVotes = whoVotes[:numVotes]
 		votingPeriod := k.GetParams(ctx).VotingPeriod
 
-		fops := make([]simtypes.FutureOperation, numVotes+1)
+		fops := make([]simtypes.FutureOperation, numVotes)
 		for i := 0; i < numVotes; i++ {
 			whenVote := ctx.BlockHeader().Time.Add(time.Duration(r.Int63n(int64(votingPeriod.Seconds()))) * time.Second)
 			fops[i] = simtypes.FutureOperation{
@@ -398,6 +398,13 @@ func operationSimulateMsgVote(
 			}
 		default:
 			proposalID = uint64(proposalIDInt)
+
+			// the scheduled votes are for a proposal which may not have reached
+			// the voting period or may be over
+			proposal, ok := k.GetProposal(ctx, proposalID)
+			if !ok || proposal.Status != v1.StatusVotingPeriod {
+				return simtypes.NoOpMsg(types.ModuleName, TypeMsgVote, "proposal not in voting period"), nil, nil
+			}
 		}
 
 		option := randomVotingOption(r)
@@ -461,6 +468,13 @@ func operationSimulateMsgVoteWeighted(
 			}
 		default:
 			proposalID = uint64(proposalIDInt)
+
+			// the scheduled votes are for a proposal which may not have reached
+			// the voting period or may be over
+			proposal, ok := k.GetProposal(ctx, proposalID)
+			if !ok || proposal.Status != v1.StatusVotingPeriod {
+				return simtypes.NoOpMsg(types.ModuleName, TypeMsgVoteWeighted, "proposal not in voting period"), nil, nil
+			}
 		}
 
 		options := randomWeightedVotingOptions(r)
@@ -38,4 +38,5 @@ type StakingKeeper interface {
 // DistributionKeeper defines the expected distribution keeper
 type DistributionKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	SetValidatorCommissionDiversion(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
 }
@@ -159,6 +159,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddHold mocks base method.
+func (m *MockBankKeeper) AddHold(ctx context.Context, addr types.AccAddress, amt types.Coins, holdID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHold", ctx, addr, amt, holdID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHold indicates an expected call of AddHold.
+func (mr *MockBankKeeperMockRecorder) AddHold(ctx, addr, amt, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHold", reflect.TypeOf((*MockBankKeeper)(nil).AddHold), ctx, addr, amt, holdID)
+}
+
 // AllBalances mocks base method.
 func (m *MockBankKeeper) AllBalances(arg0 context.Context, arg1 *types0.QueryAllBalancesRequest) (*types0.QueryAllBalancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +259,20 @@ func (mr *MockBankKeeperMockRecorder) DelegateCoinsFromAccountToModule(ctx, send
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).DelegateCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
 }
 
+// DeleteBankStoreDenomIndex mocks base method.
+func (m *MockBankKeeper) DeleteBankStoreDenomIndex(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBankStoreDenomIndex", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBankStoreDenomIndex indicates an expected call of DeleteBankStoreDenomIndex.
+func (mr *MockBankKeeperMockRecorder) DeleteBankStoreDenomIndex(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBankStoreDenomIndex", reflect.TypeOf((*MockBankKeeper)(nil).DeleteBankStoreDenomIndex), ctx)
+}
+
 // DeleteSendEnabled mocks base method.
 func (m *MockBankKeeper) DeleteSendEnabled(ctx context.Context, denoms ...string) {
 	m.ctrl.T.Helper()
@@ -321,6 +349,20 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// FoldTransferVolume mocks base method.
+func (m *MockBankKeeper) FoldTransferVolume(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FoldTransferVolume", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FoldTransferVolume indicates an expected call of FoldTransferVolume.
+func (mr *MockBankKeeperMockRecorder) FoldTransferVolume(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FoldTransferVolume", reflect.TypeOf((*MockBankKeeper)(nil).FoldTransferVolume), ctx)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx context.Context) []types0.Balance {
 	m.ctrl.T.Helper()
@@ -434,6 +476,22 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetPaginatedSupplyWithPrefix mocks base method.
+func (m *MockBankKeeper) GetPaginatedSupplyWithPrefix(ctx context.Context, prefix string, pagination *query.PageRequest) (types.Coins, *query.PageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaginatedSupplyWithPrefix", ctx, prefix, pagination)
+	ret0, _ := ret[0].(types.Coins)
+	ret1, _ := ret[1].(*query.PageResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPaginatedSupplyWithPrefix indicates an expected call of GetPaginatedSupplyWithPrefix.
+func (mr *MockBankKeeperMockRecorder) GetPaginatedSupplyWithPrefix(ctx, prefix, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaginatedSupplyWithPrefix", reflect.TypeOf((*MockBankKeeper)(nil).GetPaginatedSupplyWithPrefix), ctx, prefix, pagination)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (types.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -535,6 +593,21 @@ func (mr *MockBankKeeperMockRecorder) HasSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSupply", reflect.TypeOf((*MockBankKeeper)(nil).HasSupply), ctx, denom)
 }
 
+// Holds mocks base method.
+func (m *MockBankKeeper) Holds(arg0 context.Context, arg1 *types0.QueryHoldsRequest) (*types0.QueryHoldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Holds", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryHoldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Holds indicates an expected call of Holds.
+func (mr *MockBankKeeperMockRecorder) Holds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Holds", reflect.TypeOf((*MockBankKeeper)(nil).Holds), arg0, arg1)
+}
+
 // InitGenesis mocks base method.
 func (m *MockBankKeeper) InitGenesis(arg0 context.Context, arg1 *types0.GenesisState) {
 	m.ctrl.T.Helper()
@@ -656,6 +729,18 @@ func (mr *MockBankKeeperMockRecorder) IterateSendEnabledEntries(ctx, cb interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).IterateSendEnabledEntries), ctx, cb)
 }
 
+// IterateSupplyWithPrefix mocks base method.
+func (m *MockBankKeeper) IterateSupplyWithPrefix(ctx context.Context, prefix string, cb func(types.Coin) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateSupplyWithPrefix", ctx, prefix, cb)
+}
+
+// IterateSupplyWithPrefix indicates an expected call of IterateSupplyWithPrefix.
+func (mr *MockBankKeeperMockRecorder) IterateSupplyWithPrefix(ctx, prefix, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateSupplyWithPrefix", reflect.TypeOf((*MockBankKeeper)(nil).IterateSupplyWithPrefix), ctx, prefix, cb)
+}
+
 // IterateTotalSupply mocks base method.
 func (m *MockBankKeeper) IterateTotalSupply(ctx context.Context, cb func(types.Coin) bool) {
 	m.ctrl.T.Helper()
@@ -711,6 +796,34 @@ func (mr *MockBankKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockBankKeeper)(nil).Params), arg0, arg1)
 }
 
+// RebuildDenomIndex mocks base method.
+func (m *MockBankKeeper) RebuildDenomIndex(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildDenomIndex", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildDenomIndex indicates an expected call of RebuildDenomIndex.
+func (mr *MockBankKeeperMockRecorder) RebuildDenomIndex(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildDenomIndex", reflect.TypeOf((*MockBankKeeper)(nil).RebuildDenomIndex), ctx)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockBankKeeper) ReleaseHold(ctx context.Context, addr types.AccAddress, holdID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", ctx, addr, holdID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockBankKeeperMockRecorder) ReleaseHold(ctx, addr, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockBankKeeper)(nil).ReleaseHold), ctx, addr, holdID)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -890,6 +1003,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// SupplyByDenomPrefix mocks base method.
+func (m *MockBankKeeper) SupplyByDenomPrefix(arg0 context.Context, arg1 *types0.QuerySupplyByDenomPrefixRequest) (*types0.QuerySupplyByDenomPrefixResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupplyByDenomPrefix", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QuerySupplyByDenomPrefixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SupplyByDenomPrefix indicates an expected call of SupplyByDenomPrefix.
+func (mr *MockBankKeeperMockRecorder) SupplyByDenomPrefix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyByDenomPrefix", reflect.TypeOf((*MockBankKeeper)(nil).SupplyByDenomPrefix), arg0, arg1)
+}
+
 // SupplyOf mocks base method.
 func (m *MockBankKeeper) SupplyOf(arg0 context.Context, arg1 *types0.QuerySupplyOfRequest) (*types0.QuerySupplyOfResponse, error) {
 	m.ctrl.T.Helper()
@@ -920,6 +1048,21 @@ func (mr *MockBankKeeperMockRecorder) TotalSupply(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalSupply", reflect.TypeOf((*MockBankKeeper)(nil).TotalSupply), arg0, arg1)
 }
 
+// TransferVolume mocks base method.
+func (m *MockBankKeeper) TransferVolume(arg0 context.Context, arg1 *types0.QueryTransferVolumeRequest) (*types0.QueryTransferVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferVolume", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryTransferVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferVolume indicates an expected call of TransferVolume.
+func (mr *MockBankKeeperMockRecorder) TransferVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferVolume", reflect.TypeOf((*MockBankKeeper)(nil).TransferVolume), arg0, arg1)
+}
+
 // UndelegateCoins mocks base method.
 func (m *MockBankKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -1101,3 +1244,17 @@ func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sen
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
 }
+
+// SetValidatorCommissionDiversion mocks base method.
+func (m *MockDistributionKeeper) SetValidatorCommissionDiversion(ctx context.Context, valAddr types.ValAddress, fraction math.LegacyDec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetValidatorCommissionDiversion", ctx, valAddr, fraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetValidatorCommissionDiversion indicates an expected call of SetValidatorCommissionDiversion.
+func (mr *MockDistributionKeeperMockRecorder) SetValidatorCommissionDiversion(ctx, valAddr, fraction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValidatorCommissionDiversion", reflect.TypeOf((*MockDistributionKeeper)(nil).SetValidatorCommissionDiversion), ctx, valAddr, fraction)
+}
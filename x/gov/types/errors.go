@@ -27,4 +27,7 @@ var (
 	ErrNoDeposits              = errors.Register(ModuleName, 19, "no deposits found")
 	ErrVotingPeriodEnded       = errors.Register(ModuleName, 20, "voting period already ended")
 	ErrInvalidProposal         = errors.Register(ModuleName, 21, "invalid proposal")
+	ErrInvalidVoteRationale    = errors.Register(ModuleName, 22, "invalid vote rationale")
+	ErrInvalidDepositDenom     = errors.Register(ModuleName, 23, "deposit denom not allowed")
+	ErrInvalidProposalTrack    = errors.Register(ModuleName, 24, "invalid proposal track")
 )
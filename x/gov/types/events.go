@@ -4,16 +4,21 @@ package types
 const (
 	EventTypeSubmitProposal   = "submit_proposal"
 	EventTypeProposalDeposit  = "proposal_deposit"
+	EventTypeDepositRefund    = "proposal_deposit_refund"
+	EventTypeDepositBurn      = "proposal_deposit_burn"
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeValidatorNoVote  = "validator_no_vote"
 
 	AttributeKeyProposalResult              = "proposal_result"
 	AttributeKeyOption                      = "option"
 	AttributeKeyProposalID                  = "proposal_id"
+	AttributeKeyDepositor                   = "depositor"
 	AttributeKeyProposalMessages            = "proposal_messages" // Msg type_urls in the proposal
 	AttributeKeyVotingPeriodStart           = "voting_period_start"
+	AttributeKeyValidator                   = "validator"
 	AttributeKeyProposalLog                 = "proposal_log"                // log of proposal execution
 	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
 	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
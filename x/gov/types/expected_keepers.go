@@ -33,6 +33,7 @@ type StakingKeeper interface {
 // DistributionKeeper defines the expected distribution keeper (noalias)
 type DistributionKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	SetValidatorCommissionDiversion(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -35,9 +35,15 @@ const (
 //
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
+// - 0x11<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: SettledDeposit
+//
+// - 0x12<expiryHeight_Bytes><proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: []byte{}
+//
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x40<valAddrLen (1 Byte)><valAddr_Bytes><proposalID_Bytes>: []byte{0x01} if the validator voted on the proposal, []byte{0x00} otherwise
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -45,13 +51,17 @@ var (
 	ProposalIDKey                 = []byte{0x03}
 	VotingPeriodProposalKeyPrefix = []byte{0x04}
 
-	DepositsKeyPrefix = []byte{0x10}
+	DepositsKeyPrefix            = []byte{0x10}
+	DepositHistoryKeyPrefix      = []byte{0x11}
+	DepositHistoryQueueKeyPrefix = []byte{0x12}
 
 	VotesKeyPrefix = []byte{0x20}
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
 
+	ValidatorParticipationKeyPrefix = []byte{0x40}
+
 	// KeyConstitution is the key string used to store the chain's constitution
 	KeyConstitution = []byte("constitution")
 )
@@ -110,6 +120,35 @@ func DepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
 	return append(DepositsKey(proposalID), address.MustLengthPrefix(depositorAddr.Bytes())...)
 }
 
+// DepositHistoryKey gets the first part of the settled deposits key based on the proposalID
+func DepositHistoryKey(proposalID uint64) []byte {
+	return append(DepositHistoryKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// SettledDepositKey key of the record of a specific settled deposit from the store
+func SettledDepositKey(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
+	return append(DepositHistoryKey(proposalID), address.MustLengthPrefix(depositorAddr.Bytes())...)
+}
+
+// DepositHistoryQueueByHeightKey gets the deposit history queue key by expiry height
+func DepositHistoryQueueByHeightKey(height int64) []byte {
+	return append(DepositHistoryQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// DepositHistoryQueueKey returns the key of a settled deposit record in the deposit history queue
+func DepositHistoryQueueKey(expiryHeight int64, proposalID uint64, depositorAddr sdk.AccAddress) []byte {
+	return append(DepositHistoryQueueByHeightKey(expiryHeight), SettledDepositKey(proposalID, depositorAddr)[1:]...)
+}
+
+// SplitDepositHistoryQueueKey split the deposit history queue key and returns the expiry height
+// and the key of the settled deposit record
+func SplitDepositHistoryQueueKey(key []byte) (expiryHeight int64, settledDepositKey []byte) {
+	kv.AssertKeyAtLeastLength(key, 1+8+8+1)
+	expiryHeight = int64(sdk.BigEndianToUint64(key[1:9]))
+	settledDepositKey = append([]byte{}, DepositHistoryKeyPrefix...)
+	return expiryHeight, append(settledDepositKey, key[9:]...)
+}
+
 // VotesKey gets the first part of the votes key based on the proposalID
 func VotesKey(proposalID uint64) []byte {
 	return append(VotesKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -120,6 +159,16 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// ValidatorParticipationsKey gets the first part of the participation key based on the validator address
+func ValidatorParticipationsKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorParticipationKeyPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// ValidatorParticipationKey key of the participation of a validator in a specific proposal
+func ValidatorParticipationKey(valAddr sdk.ValAddress, proposalID uint64) []byte {
+	return append(ValidatorParticipationsKey(valAddr), GetProposalIDBytes(proposalID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
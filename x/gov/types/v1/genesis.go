@@ -2,6 +2,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -33,6 +34,29 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	if data.Params == nil {
+		return errors.New("params cannot be nil")
+	}
+
+	settled := make(map[string]bool, len(data.DepositHistory))
+	for _, entry := range data.DepositHistory {
+		record := entry.SettledDeposit
+		if record == nil {
+			return errors.New("deposit history entry cannot have a nil settled deposit")
+		}
+
+		key := fmt.Sprintf("%d/%s", record.ProposalId, record.Depositor)
+		if settled[key] {
+			return fmt.Errorf("duplicate settled deposit of %s on proposal %d", record.Depositor, record.ProposalId)
+		}
+		settled[key] = true
+
+		if entry.ExpiryHeight <= record.Height {
+			return fmt.Errorf("settled deposit of %s on proposal %d expires at height %d, before it was settled at height %d",
+				record.Depositor, record.ProposalId, entry.ExpiryHeight, record.Height)
+		}
+	}
+
 	return data.Params.ValidateBasic()
 }
 
@@ -52,6 +52,10 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// deposit_history defines the retained records of the settled deposits.
+	//
+	// Since: cosmos-sdk 0.48
+	DepositHistory []*DepositHistoryEntry `protobuf:"bytes,10,rep,name=deposit_history,json=depositHistory,proto3" json:"deposit_history,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,38 +157,109 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetDepositHistory() []*DepositHistoryEntry {
+	if m != nil {
+		return m.DepositHistory
+	}
+	return nil
+}
+
+// DepositHistoryEntry defines the record of a settled deposit, with the block
+// height at which it is pruned from the deposit history.
+//
+// Since: cosmos-sdk 0.48
+type DepositHistoryEntry struct {
+	// settled_deposit is the record of the settled deposit.
+	SettledDeposit *SettledDeposit `protobuf:"bytes,1,opt,name=settled_deposit,json=settledDeposit,proto3" json:"settled_deposit,omitempty"`
+	// expiry_height is the block height at which the record is pruned.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *DepositHistoryEntry) Reset()         { *m = DepositHistoryEntry{} }
+func (m *DepositHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*DepositHistoryEntry) ProtoMessage()    {}
+func (*DepositHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7cfd15e3ded621, []int{1}
+}
+func (m *DepositHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositHistoryEntry.Merge(m, src)
+}
+func (m *DepositHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositHistoryEntry proto.InternalMessageInfo
+
+func (m *DepositHistoryEntry) GetSettledDeposit() *SettledDeposit {
+	if m != nil {
+		return m.SettledDeposit
+	}
+	return nil
+}
+
+func (m *DepositHistoryEntry) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
+	proto.RegisterType((*DepositHistoryEntry)(nil), "cosmos.gov.v1.DepositHistoryEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0x30, 0x94, 0xff, 0x62, 0xfc, 0xa0, 0x01, 0xd3, 0x34, 0xac, 0x6a,
-	0x0c, 0xad, 0x60, 0x7c, 0x00, 0x09, 0x86, 0xb8, 0x23, 0xd5, 0xb8, 0x70, 0x43, 0x0a, 0x6d, 0x6a,
-	0x23, 0xf4, 0x36, 0x9d, 0x61, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8,
-	0x31, 0xcc, 0xb4, 0x02, 0xd5, 0xd5, 0x24, 0xf7, 0xfc, 0xce, 0x99, 0x93, 0x9b, 0x8b, 0x5b, 0x53,
-	0xa0, 0x73, 0xa0, 0x96, 0x0f, 0xdc, 0xe2, 0x5d, 0xcb, 0xf7, 0x42, 0x8f, 0x06, 0xd4, 0x8c, 0x62,
-	0x60, 0x40, 0xea, 0x52, 0x34, 0x7d, 0xe0, 0x26, 0xef, 0x36, 0x1b, 0x19, 0x16, 0xb8, 0xe4, 0xda,
-	0x5f, 0x05, 0xac, 0x0c, 0xa5, 0xf3, 0x9e, 0x39, 0xcc, 0x23, 0x97, 0xf8, 0x98, 0x32, 0x27, 0x66,
-	0x41, 0xe8, 0x8f, 0xa3, 0x18, 0x22, 0xa0, 0xce, 0x6c, 0x1c, 0xb8, 0x2a, 0xd2, 0x91, 0x51, 0xb4,
-	0x49, 0xaa, 0x8d, 0x12, 0xe9, 0xce, 0x25, 0x3d, 0x5c, 0x71, 0xbd, 0x08, 0x68, 0xc0, 0xa8, 0x9a,
-	0xd7, 0x0b, 0x46, 0xad, 0x77, 0x6a, 0x1e, 0xfc, 0x6e, 0x0e, 0xa4, 0x6c, 0xff, 0x70, 0xe4, 0x1c,
-	0x97, 0x38, 0x30, 0x8f, 0xaa, 0x05, 0x61, 0x38, 0xca, 0x18, 0x1e, 0x81, 0x79, 0xb6, 0x24, 0xc8,
-	0x35, 0xae, 0xa6, 0x3d, 0xa8, 0x5a, 0x14, 0x78, 0x23, 0x83, 0xa7, 0x65, 0xec, 0x1d, 0x49, 0x86,
-	0xf8, 0x7f, 0xf2, 0xdb, 0x38, 0x72, 0x62, 0x67, 0x4e, 0xd5, 0x92, 0x8e, 0x8c, 0x5a, 0xef, 0xec,
-	0xef, 0x6e, 0x23, 0xc1, 0xf4, 0xf3, 0x2a, 0xb2, 0xeb, 0xee, 0xfe, 0x88, 0x0c, 0x70, 0x9d, 0x83,
-	0x5c, 0x87, 0xcc, 0x29, 0x8b, 0x9c, 0xd6, 0xef, 0xca, 0xdb, 0xb5, 0xec, 0x62, 0x14, 0xbe, 0x37,
-	0x21, 0x37, 0x58, 0x61, 0xce, 0x6c, 0xb6, 0x4c, 0x43, 0xfe, 0x89, 0x90, 0x66, 0x26, 0xe4, 0x61,
-	0x8b, 0xec, 0x65, 0xd4, 0xd8, 0x6e, 0x40, 0x3a, 0xb8, 0x9c, 0x98, 0x2b, 0xc2, 0x7c, 0x92, 0xdd,
-	0x82, 0x10, 0xed, 0x04, 0x22, 0x6d, 0xac, 0x4c, 0x21, 0xa4, 0x2c, 0x60, 0x0b, 0x16, 0x40, 0xa8,
-	0x56, 0x75, 0x64, 0x54, 0xed, 0x83, 0x59, 0xff, 0xf6, 0x7d, 0xad, 0xa1, 0xd5, 0x5a, 0x43, 0x9f,
-	0x6b, 0x0d, 0xbd, 0x6d, 0xb4, 0xdc, 0x6a, 0xa3, 0xe5, 0x3e, 0x36, 0x5a, 0xee, 0xe9, 0xc2, 0x0f,
-	0xd8, 0xf3, 0x62, 0x62, 0x4e, 0x61, 0x6e, 0x25, 0xb7, 0x23, 0x9f, 0x0e, 0x75, 0x5f, 0xac, 0x57,
-	0x71, 0x48, 0x6c, 0x19, 0x79, 0xd4, 0xe2, 0xdd, 0x49, 0x59, 0xdc, 0xd2, 0xd5, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x69, 0x07, 0x35, 0x92, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0xb6, 0x2b, 0xab, 0x9b, 0x76, 0x92, 0x07, 0x2c, 0xda, 0x20, 0x8a, 0xca, 0x25,
+	0x08, 0x2d, 0xa1, 0x45, 0x3c, 0x00, 0xd3, 0xc6, 0x86, 0xb8, 0x4c, 0x1e, 0xe2, 0xc0, 0x25, 0xca,
+	0x1a, 0x2b, 0xb5, 0x68, 0xe3, 0x28, 0xfe, 0xcf, 0x5a, 0xae, 0x3c, 0x01, 0x8f, 0xb5, 0xe3, 0x8e,
+	0x1c, 0x51, 0xfb, 0x22, 0xa8, 0x76, 0x42, 0xdb, 0xac, 0xa7, 0x28, 0xdf, 0xff, 0xf7, 0x7d, 0xfe,
+	0xeb, 0x93, 0x8d, 0x4f, 0x26, 0x42, 0xce, 0x85, 0x0c, 0x12, 0xa1, 0x02, 0x35, 0x0a, 0x12, 0x96,
+	0x32, 0xc9, 0xa5, 0x9f, 0xe5, 0x02, 0x04, 0xe9, 0x9b, 0xa1, 0x9f, 0x08, 0xe5, 0xab, 0xd1, 0xf1,
+	0x51, 0x8d, 0x15, 0xca, 0x70, 0xc3, 0x87, 0x36, 0xb6, 0x2e, 0x8d, 0xf3, 0x06, 0x22, 0x60, 0xe4,
+	0x3d, 0x7e, 0x2e, 0x21, 0xca, 0x81, 0xa7, 0x49, 0x98, 0xe5, 0x22, 0x13, 0x32, 0x9a, 0x85, 0x3c,
+	0xb6, 0x91, 0x8b, 0xbc, 0x36, 0x25, 0xd5, 0xec, 0xba, 0x1c, 0x7d, 0x89, 0xc9, 0x18, 0xef, 0xc7,
+	0x2c, 0x13, 0x92, 0x83, 0xb4, 0x9b, 0x6e, 0xcb, 0xeb, 0x8d, 0x5f, 0xfa, 0x5b, 0xa7, 0xfb, 0xe7,
+	0x66, 0x4c, 0xff, 0x73, 0xe4, 0x2d, 0xde, 0x53, 0x02, 0x98, 0xb4, 0x5b, 0xda, 0x70, 0x58, 0x33,
+	0x7c, 0x17, 0xc0, 0xa8, 0x21, 0xc8, 0x47, 0xdc, 0xad, 0xf6, 0x90, 0x76, 0x5b, 0xe3, 0x47, 0x35,
+	0xbc, 0x5a, 0x86, 0xae, 0x49, 0x72, 0x89, 0x07, 0xe5, 0x69, 0x61, 0x16, 0xe5, 0xd1, 0x5c, 0xda,
+	0x7b, 0x2e, 0xf2, 0x7a, 0xe3, 0x57, 0xbb, 0x77, 0xbb, 0xd6, 0xcc, 0x59, 0xd3, 0x46, 0xb4, 0x1f,
+	0x6f, 0x4a, 0xe4, 0x1c, 0xf7, 0x95, 0x30, 0x75, 0x98, 0x9c, 0x8e, 0xce, 0x39, 0x79, 0xba, 0xf2,
+	0xaa, 0x96, 0x75, 0x8c, 0xa5, 0x36, 0x14, 0xf2, 0x09, 0x5b, 0x10, 0xcd, 0x66, 0x45, 0x15, 0xf2,
+	0x4c, 0x87, 0x1c, 0xd7, 0x42, 0xbe, 0xad, 0x90, 0x8d, 0x8c, 0x1e, 0xac, 0x05, 0x72, 0x8a, 0x3b,
+	0xa5, 0x79, 0x5f, 0x9b, 0x5f, 0xd4, 0x5b, 0xd0, 0x43, 0x5a, 0x42, 0x64, 0x88, 0xad, 0x89, 0x48,
+	0x25, 0x70, 0xb8, 0x03, 0x2e, 0x52, 0xbb, 0xeb, 0x22, 0xaf, 0x4b, 0xb7, 0x34, 0xf2, 0x15, 0x1f,
+	0x54, 0x25, 0x4d, 0xb9, 0x04, 0x91, 0x17, 0x36, 0xd6, 0x0d, 0x0f, 0x77, 0xb7, 0x74, 0x65, 0xa0,
+	0x8b, 0x14, 0xf2, 0x82, 0x0e, 0xe2, 0x2d, 0x71, 0xf8, 0x0b, 0xe1, 0xc3, 0x1d, 0x1c, 0xf9, 0x8c,
+	0x0f, 0x24, 0x03, 0x98, 0xb1, 0x38, 0x2c, 0x1d, 0xfa, 0x32, 0xf5, 0xc6, 0xaf, 0x6b, 0x87, 0xdc,
+	0x18, 0xaa, 0xba, 0x2d, 0x03, 0xb9, 0xf5, 0x4f, 0xde, 0xe0, 0x3e, 0xbb, 0xcf, 0x78, 0x5e, 0x84,
+	0x53, 0xc6, 0x93, 0x29, 0xd8, 0x4d, 0x17, 0x79, 0x2d, 0x6a, 0x19, 0xf1, 0x4a, 0x6b, 0x67, 0x17,
+	0x0f, 0x0b, 0x07, 0x3d, 0x2e, 0x1c, 0xf4, 0x77, 0xe1, 0xa0, 0xdf, 0x4b, 0xa7, 0xf1, 0xb8, 0x74,
+	0x1a, 0x7f, 0x96, 0x4e, 0xe3, 0xc7, 0xbb, 0x84, 0xc3, 0xf4, 0xee, 0xd6, 0x9f, 0x88, 0x79, 0x50,
+	0xbe, 0x06, 0xf3, 0x39, 0x95, 0xf1, 0xcf, 0xe0, 0x5e, 0x3f, 0x0d, 0x28, 0x32, 0x26, 0x03, 0x35,
+	0xba, 0xed, 0xe8, 0xd7, 0xf1, 0xe1, 0xdf, 0x00, 0x4a, 0x45, 0x51, 0x6f, 0x64, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +282,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DepositHistory) > 0 {
+		for iNdEx := len(m.DepositHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -312,6 +401,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DepositHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SettledDeposit != nil {
+		{
+			size, err := m.SettledDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -370,6 +499,28 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.DepositHistory) > 0 {
+		for _, e := range m.DepositHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettledDeposit != nil {
+		l = m.SettledDeposit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExpiryHeight))
+	}
 	return n
 }
 
@@ -705,6 +856,145 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositHistory = append(m.DepositHistory, &DepositHistoryEntry{})
+			if err := m.DepositHistory[len(m.DepositHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettledDeposit == nil {
+				m.SettledDeposit = &SettledDeposit{}
+			}
+			if err := m.SettledDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
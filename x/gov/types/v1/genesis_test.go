@@ -32,6 +32,13 @@ func TestValidateGenesis(t *testing.T) {
 				return v1.NewGenesisState(v1.DefaultStartingProposalID, params)
 			},
 		},
+		{
+			name: "nil params",
+			genesisState: func() *v1.GenesisState {
+				return &v1.GenesisState{StartingProposalId: v1.DefaultStartingProposalID}
+			},
+			expErr: true,
+		},
 		{
 			name: "invalid StartingProposalId",
 			genesisState: func() *v1.GenesisState {
@@ -82,6 +89,53 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid deposit history",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.DepositHistory = []*v1.DepositHistoryEntry{
+					{SettledDeposit: &v1.SettledDeposit{ProposalId: 1, Depositor: "depositor1", Height: 5}, ExpiryHeight: 15},
+					{SettledDeposit: &v1.SettledDeposit{ProposalId: 1, Depositor: "depositor2", Height: 5}, ExpiryHeight: 15},
+				}
+
+				return genState
+			},
+		},
+		{
+			name: "nil settled deposit",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.DepositHistory = []*v1.DepositHistoryEntry{{ExpiryHeight: 15}}
+
+				return genState
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate settled deposit",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.DepositHistory = []*v1.DepositHistoryEntry{
+					{SettledDeposit: &v1.SettledDeposit{ProposalId: 1, Depositor: "depositor1", Height: 5}, ExpiryHeight: 15},
+					{SettledDeposit: &v1.SettledDeposit{ProposalId: 1, Depositor: "depositor1", Height: 5}, ExpiryHeight: 15},
+				}
+
+				return genState
+			},
+			expErr: true,
+		},
+		{
+			name: "settled deposit expiring before it was settled",
+			genesisState: func() *v1.GenesisState {
+				genState := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				genState.DepositHistory = []*v1.DepositHistoryEntry{
+					{SettledDeposit: &v1.SettledDeposit{ProposalId: 1, Depositor: "depositor1", Height: 5}, ExpiryHeight: 5},
+				}
+
+				return genState
+			},
+			expErr: true,
+		},
 		{
 			name: "invalid veto threshold",
 			genesisState: func() *v1.GenesisState {
@@ -241,6 +241,91 @@ func (m *Deposit) GetAmount() []types.Coin {
 	return nil
 }
 
+// SettledDeposit is the record of a deposit which was refunded or burned when
+// its proposal was settled. It is retained for deposit_history_retention blocks.
+//
+// Since: cosmos-sdk 0.48
+type SettledDeposit struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// depositor defines the address of the depositor.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the amount refunded to or burned from the depositor.
+	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	// burned is true when the deposit was burned, false when it was refunded.
+	Burned bool `protobuf:"varint,4,opt,name=burned,proto3" json:"burned,omitempty"`
+	// height is the block height at which the deposit was settled.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SettledDeposit) Reset()         { *m = SettledDeposit{} }
+func (m *SettledDeposit) String() string { return proto.CompactTextString(m) }
+func (*SettledDeposit) ProtoMessage()    {}
+func (*SettledDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
+}
+func (m *SettledDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettledDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettledDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettledDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledDeposit.Merge(m, src)
+}
+func (m *SettledDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettledDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledDeposit proto.InternalMessageInfo
+
+func (m *SettledDeposit) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *SettledDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *SettledDeposit) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SettledDeposit) GetBurned() bool {
+	if m != nil {
+		return m.Burned
+	}
+	return false
+}
+
+func (m *SettledDeposit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	// id defines the unique id of the proposal.
@@ -281,13 +366,19 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// track is the proposal track resolved from the messages of the proposal on submission,
+	// whose parameters apply to the proposal. It is unset for the proposals submitted before
+	// proposal tracks were introduced, which are governed by the params themselves.
+	//
+	// Since: cosmos-sdk 0.48
+	Track *ProposalTrack `protobuf:"bytes,15,opt,name=track,proto3" json:"track,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,6 +505,13 @@ func (m *Proposal) GetExpedited() bool {
 	return false
 }
 
+func (m *Proposal) GetTrack() *ProposalTrack {
+	if m != nil {
+		return m.Track
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -430,7 +528,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,13 +596,20 @@ type Vote struct {
 	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any  arbitrary metadata to attached to the vote.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// rationale_uri is the URI of the voter's rationale, taken from the vote
+	// metadata when it is a JSON object with the rationale_uri and rationale_hash
+	// fields.
+	RationaleUri string `protobuf:"bytes,6,opt,name=rationale_uri,json=rationaleUri,proto3" json:"rationale_uri,omitempty"`
+	// rationale_hash is the hex encoded SHA-256 hash of the document at
+	// rationale_uri.
+	RationaleHash string `protobuf:"bytes,7,opt,name=rationale_hash,json=rationaleHash,proto3" json:"rationale_hash,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,6 +666,20 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+func (m *Vote) GetRationaleUri() string {
+	if m != nil {
+		return m.RationaleUri
+	}
+	return ""
+}
+
+func (m *Vote) GetRationaleHash() string {
+	if m != nil {
+		return m.RationaleHash
+	}
+	return ""
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 //
 // Deprecated: Do not use.
@@ -576,7 +695,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +750,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +804,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,6 +854,103 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+// ProposalTrack defines the deposit, voting period and tally parameters of the proposals
+// whose messages it matches. Its unset parameters are the ones of the params.
+//
+// Since: cosmos-sdk 0.48
+type ProposalTrack struct {
+	// name is the unique name of the track.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_urls are the type URLs of the messages matched by the track, empty meaning
+	// that the track matches the messages which no other track lists, and the proposals
+	// without messages.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// Minimum deposit for a proposal of the track to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,3,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Duration of the voting period of a proposal of the track.
+	VotingPeriod *time.Duration `protobuf:"bytes,4,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for a proposal of the track to pass.
+	Threshold string `protobuf:"bytes,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *ProposalTrack) Reset()         { *m = ProposalTrack{} }
+func (m *ProposalTrack) String() string { return proto.CompactTextString(m) }
+func (*ProposalTrack) ProtoMessage()    {}
+func (*ProposalTrack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *ProposalTrack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTrack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTrack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTrack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTrack.Merge(m, src)
+}
+func (m *ProposalTrack) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTrack) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTrack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTrack proto.InternalMessageInfo
+
+func (m *ProposalTrack) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProposalTrack) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *ProposalTrack) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *ProposalTrack) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *ProposalTrack) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *ProposalTrack) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
 // Params defines the parameters for the x/gov module.
 //
 // Since: cosmos-sdk 0.47
@@ -781,13 +997,31 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// The fraction of the next commission withdrawal of a bonded validator which is routed to the community pool
+	// when it did not vote on a concluded proposal. Default value: 0.
+	NonVotingCommissionDiversion string `protobuf:"bytes,16,opt,name=non_voting_commission_diversion,json=nonVotingCommissionDiversion,proto3" json:"non_voting_commission_diversion,omitempty"`
+	// The maximum length of the metadata of a vote, zero meaning that only the
+	// module configured metadata length applies. Default value: 255.
+	MaxVoteMetadataLen uint64 `protobuf:"varint,17,opt,name=max_vote_metadata_len,json=maxVoteMetadataLen,proto3" json:"max_vote_metadata_len,omitempty"`
+	// The denoms in which deposits are accepted, empty meaning that deposits are accepted in any denom.
+	// When set, it must include the denoms of min_deposit and expedited_min_deposit. Default value: [].
+	MinDepositDenomsAllowlist []string `protobuf:"bytes,18,rep,name=min_deposit_denoms_allowlist,json=minDepositDenomsAllowlist,proto3" json:"min_deposit_denoms_allowlist,omitempty"`
+	// The number of blocks for which a record of the refunded and burned deposits of a settled proposal is retained,
+	// zero meaning that no record is retained. Default value: 0.
+	DepositHistoryRetention uint64 `protobuf:"varint,19,opt,name=deposit_history_retention,json=depositHistoryRetention,proto3" json:"deposit_history_retention,omitempty"`
+	// The proposal tracks, resolved from the messages of a proposal on submission. When a proposal
+	// matches several tracks, the most restrictive one applies. The proposals matching no track are
+	// governed by the params themselves. Default value: a single track matching every proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalTracks []ProposalTrack `protobuf:"bytes,20,rep,name=proposal_tracks,json=proposalTracks,proto3" json:"proposal_tracks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,111 +1155,167 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetNonVotingCommissionDiversion() string {
+	if m != nil {
+		return m.NonVotingCommissionDiversion
+	}
+	return ""
+}
+
+func (m *Params) GetMaxVoteMetadataLen() uint64 {
+	if m != nil {
+		return m.MaxVoteMetadataLen
+	}
+	return 0
+}
+
+func (m *Params) GetMinDepositDenomsAllowlist() []string {
+	if m != nil {
+		return m.MinDepositDenomsAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetDepositHistoryRetention() uint64 {
+	if m != nil {
+		return m.DepositHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetProposalTracks() []ProposalTrack {
+	if m != nil {
+		return m.ProposalTracks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
+	proto.RegisterType((*SettledDeposit)(nil), "cosmos.gov.v1.SettledDeposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*ProposalTrack)(nil), "cosmos.gov.v1.ProposalTrack")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x75, 0xd7, 0xd1, 0xc5, 0xca, 0xd8, 0x89, 0x69, 0x27, 0x96, 0x1c, 0x21, 0x08, 0xfc,
-	0xe7, 0x22, 0xfd, 0x4e, 0x9a, 0x2e, 0x9a, 0x02, 0x85, 0x64, 0x31, 0x0d, 0x8d, 0xc4, 0x52, 0x29,
-	0x45, 0x4e, 0xba, 0x21, 0x28, 0x71, 0x22, 0x13, 0x15, 0x39, 0x2a, 0x39, 0x52, 0xac, 0x47, 0xe8,
-	0x2e, 0xcb, 0xae, 0x8a, 0x2e, 0xbb, 0xec, 0x22, 0xe8, 0x33, 0x64, 0x55, 0x04, 0xe9, 0xa2, 0xdd,
-	0x34, 0x29, 0x92, 0x45, 0x81, 0x3c, 0x45, 0xc1, 0xe1, 0x50, 0x94, 0x64, 0xb5, 0xb6, 0xb3, 0xb1,
-	0xc5, 0x73, 0xbe, 0xef, 0x9b, 0x33, 0xe7, 0x32, 0x43, 0xc2, 0x5a, 0x97, 0x38, 0x26, 0x71, 0xca,
-	0x3d, 0x32, 0x2a, 0x8f, 0x76, 0xdc, 0x7f, 0xa5, 0x81, 0x4d, 0x28, 0x41, 0x19, 0xcf, 0x51, 0x72,
-	0x2d, 0xa3, 0x9d, 0x8d, 0x3c, 0xc7, 0x75, 0x34, 0x07, 0x97, 0x47, 0x3b, 0x1d, 0x4c, 0xb5, 0x9d,
-	0x72, 0x97, 0x18, 0x96, 0x07, 0xdf, 0x58, 0xed, 0x91, 0x1e, 0x61, 0x3f, 0xcb, 0xee, 0x2f, 0x6e,
-	0x2d, 0xf4, 0x08, 0xe9, 0xf5, 0x71, 0x99, 0x3d, 0x75, 0x86, 0x4f, 0xcb, 0xd4, 0x30, 0xb1, 0x43,
-	0x35, 0x73, 0xc0, 0x01, 0xeb, 0xf3, 0x00, 0xcd, 0x1a, 0x73, 0x57, 0x7e, 0xde, 0xa5, 0x0f, 0x6d,
-	0x8d, 0x1a, 0xc4, 0x5f, 0x71, 0xdd, 0x8b, 0x48, 0xf5, 0x16, 0xe5, 0xd1, 0x7a, 0xae, 0x73, 0x9a,
-	0x69, 0x58, 0xa4, 0xcc, 0xfe, 0x7a, 0xa6, 0x22, 0x01, 0x74, 0x80, 0x8d, 0xde, 0x21, 0xc5, 0x7a,
-	0x9b, 0x50, 0x5c, 0x1f, 0xb8, 0x4a, 0x68, 0x07, 0x62, 0x84, 0xfd, 0x12, 0x85, 0x2d, 0x61, 0x3b,
-	0x7b, 0x6b, 0xbd, 0x34, 0xb3, 0xeb, 0x52, 0x00, 0x55, 0x38, 0x10, 0x5d, 0x85, 0xd8, 0x33, 0x26,
-	0x24, 0x86, 0xb6, 0x84, 0xed, 0x64, 0x35, 0xfb, 0xfa, 0xc5, 0x4d, 0xe0, 0xac, 0x1a, 0xee, 0x2a,
-	0xdc, 0x5b, 0xfc, 0x51, 0x80, 0x78, 0x0d, 0x0f, 0x88, 0x63, 0x50, 0x54, 0x80, 0xd4, 0xc0, 0x26,
-	0x03, 0xe2, 0x68, 0x7d, 0xd5, 0xd0, 0xd9, 0x5a, 0x11, 0x05, 0x7c, 0x93, 0xac, 0xa3, 0x4f, 0x21,
-	0xa9, 0x7b, 0x58, 0x62, 0x73, 0x5d, 0xf1, 0xf5, 0x8b, 0x9b, 0xab, 0x5c, 0xb7, 0xa2, 0xeb, 0x36,
-	0x76, 0x9c, 0x26, 0xb5, 0x0d, 0xab, 0xa7, 0x04, 0x50, 0xf4, 0x39, 0xc4, 0x34, 0x93, 0x0c, 0x2d,
-	0x2a, 0x86, 0xb7, 0xc2, 0xdb, 0xa9, 0x20, 0x7e, 0xb7, 0x4c, 0x25, 0x5e, 0xa6, 0xd2, 0x2e, 0x31,
-	0xac, 0x6a, 0xf2, 0xe5, 0x9b, 0xc2, 0xd2, 0x4f, 0x7f, 0xff, 0x7c, 0x4d, 0x50, 0x38, 0xa7, 0xf8,
-	0x36, 0x0a, 0x89, 0x06, 0x0f, 0x02, 0x65, 0x21, 0x34, 0x09, 0x2d, 0x64, 0xe8, 0xe8, 0xff, 0x90,
-	0x30, 0xb1, 0xe3, 0x68, 0x3d, 0xec, 0x88, 0x21, 0x26, 0xbe, 0x5a, 0xf2, 0x2a, 0x52, 0xf2, 0x2b,
-	0x52, 0xaa, 0x58, 0x63, 0x65, 0x82, 0x42, 0x77, 0x20, 0xe6, 0x50, 0x8d, 0x0e, 0x1d, 0x31, 0xcc,
-	0x92, 0xb9, 0x39, 0x97, 0x4c, 0x7f, 0xa9, 0x26, 0x03, 0x29, 0x1c, 0x8c, 0xee, 0x03, 0x7a, 0x6a,
-	0x58, 0x5a, 0x5f, 0xa5, 0x5a, 0xbf, 0x3f, 0x56, 0x6d, 0xec, 0x0c, 0xfb, 0x54, 0x8c, 0x6c, 0x09,
-	0xdb, 0xa9, 0x5b, 0x1b, 0x73, 0x12, 0x2d, 0x17, 0xa2, 0x30, 0x84, 0x92, 0x63, 0xac, 0x29, 0x0b,
-	0xaa, 0x40, 0xca, 0x19, 0x76, 0x4c, 0x83, 0xaa, 0x6e, 0x9b, 0x89, 0x51, 0x2e, 0x31, 0x1f, 0x75,
-	0xcb, 0xef, 0xc1, 0x6a, 0xe4, 0xf9, 0xdb, 0x82, 0xa0, 0x80, 0x47, 0x72, 0xcd, 0x68, 0x0f, 0x72,
-	0x3c, 0xbb, 0x2a, 0xb6, 0x74, 0x4f, 0x27, 0x76, 0x4a, 0x9d, 0x2c, 0x67, 0x4a, 0x96, 0xce, 0xb4,
-	0x64, 0xc8, 0x50, 0x42, 0xb5, 0xbe, 0xca, 0xed, 0x62, 0xfc, 0x0c, 0x35, 0x4a, 0x33, 0xaa, 0xdf,
-	0x40, 0x0f, 0xe0, 0xdc, 0x88, 0x50, 0xc3, 0xea, 0xa9, 0x0e, 0xd5, 0x6c, 0xbe, 0xbf, 0xc4, 0x29,
-	0xe3, 0x5a, 0xf6, 0xa8, 0x4d, 0x97, 0xc9, 0x02, 0xbb, 0x0f, 0xdc, 0x14, 0xec, 0x31, 0x79, 0x4a,
-	0xad, 0x8c, 0x47, 0xf4, 0xb7, 0xb8, 0xe1, 0x36, 0x09, 0xd5, 0x74, 0x8d, 0x6a, 0x22, 0xb8, 0x6d,
-	0xab, 0x4c, 0x9e, 0xd1, 0x2a, 0x44, 0xa9, 0x41, 0xfb, 0x58, 0x4c, 0x31, 0x87, 0xf7, 0x80, 0x44,
-	0x88, 0x3b, 0x43, 0xd3, 0xd4, 0xec, 0xb1, 0x98, 0x66, 0x76, 0xff, 0x11, 0x7d, 0x02, 0x09, 0x6f,
-	0x22, 0xb0, 0x2d, 0x66, 0x4e, 0x18, 0x81, 0x09, 0x12, 0x5d, 0x82, 0x24, 0x3e, 0x1a, 0x60, 0xdd,
-	0xa0, 0x58, 0x17, 0xb3, 0x5b, 0xc2, 0x76, 0x42, 0x09, 0x0c, 0xc5, 0xdf, 0x05, 0x48, 0x4d, 0x77,
-	0xc8, 0x75, 0x48, 0x8e, 0xb1, 0xa3, 0x76, 0xd9, 0xc8, 0x08, 0xc7, 0xe6, 0x57, 0xb6, 0xa8, 0x92,
-	0x18, 0x63, 0x67, 0xd7, 0xf5, 0xa3, 0xdb, 0x90, 0xd1, 0x3a, 0x0e, 0xd5, 0x0c, 0x8b, 0x13, 0x42,
-	0x0b, 0x09, 0x69, 0x0e, 0xf2, 0x48, 0xff, 0x83, 0x84, 0x45, 0x38, 0x3e, 0xbc, 0x10, 0x1f, 0xb7,
-	0x88, 0x07, 0xbd, 0x0b, 0xc8, 0x22, 0xea, 0x33, 0x83, 0x1e, 0xaa, 0x23, 0x4c, 0x7d, 0x52, 0x64,
-	0x21, 0x69, 0xd9, 0x22, 0x07, 0x06, 0x3d, 0x6c, 0x63, 0xea, 0x91, 0x8b, 0xbf, 0x08, 0x10, 0x71,
-	0x4f, 0xa7, 0x93, 0xcf, 0x96, 0x12, 0x44, 0x47, 0x84, 0xe2, 0x93, 0xcf, 0x15, 0x0f, 0x86, 0xee,
-	0x42, 0xdc, 0x3b, 0xea, 0x1c, 0x31, 0xc2, 0x1a, 0xf6, 0xf2, 0xdc, 0x10, 0x1e, 0x3f, 0x47, 0x15,
-	0x9f, 0x31, 0xd3, 0x10, 0xd1, 0xd9, 0x86, 0xd8, 0x8b, 0x24, 0xc2, 0xb9, 0x48, 0xf1, 0x4f, 0x01,
-	0x32, 0xbc, 0xad, 0x1b, 0x9a, 0xad, 0x99, 0x0e, 0x7a, 0x02, 0x29, 0xd3, 0xb0, 0x26, 0x53, 0x22,
-	0x9c, 0x34, 0x25, 0x9b, 0xee, 0x94, 0x7c, 0x78, 0x53, 0x38, 0x3f, 0xc5, 0xba, 0x41, 0x4c, 0x83,
-	0x62, 0x73, 0x40, 0xc7, 0x0a, 0x98, 0x86, 0xe5, 0xcf, 0x8d, 0x09, 0xc8, 0xd4, 0x8e, 0x7c, 0x90,
-	0x3a, 0xc0, 0xb6, 0x41, 0x74, 0x96, 0x08, 0x77, 0x85, 0xf9, 0x66, 0xaf, 0xf1, 0x0b, 0xa6, 0x7a,
-	0xe5, 0xc3, 0x9b, 0xc2, 0xa5, 0xe3, 0xc4, 0x60, 0x91, 0xef, 0xdd, 0x59, 0xc8, 0x99, 0xda, 0x91,
-	0xbf, 0x13, 0xe6, 0xff, 0x2c, 0x24, 0x0a, 0xc5, 0xc7, 0x90, 0x6e, 0xb3, 0x19, 0xe1, 0xbb, 0xab,
-	0x01, 0x9f, 0x19, 0x7f, 0x75, 0xe1, 0xa4, 0xd5, 0x23, 0x4c, 0x3d, 0xed, 0xb1, 0xa6, 0x94, 0x7f,
-	0xf0, 0x9b, 0x99, 0x2b, 0x5f, 0x85, 0xd8, 0xb7, 0x43, 0x62, 0x0f, 0xcd, 0x05, 0x9d, 0xcc, 0x6e,
-	0x22, 0xcf, 0x8b, 0x6e, 0x40, 0x92, 0x1e, 0xda, 0xd8, 0x39, 0x24, 0x7d, 0xfd, 0x5f, 0x2e, 0xad,
-	0x00, 0x80, 0xee, 0x40, 0x96, 0x75, 0x63, 0x40, 0x09, 0x2f, 0xa4, 0x64, 0x5c, 0x54, 0xcb, 0x07,
-	0xb1, 0x00, 0x7f, 0x8b, 0x43, 0x8c, 0xc7, 0x26, 0x9d, 0xb1, 0xa6, 0x53, 0x27, 0xdf, 0x74, 0xfd,
-	0x1e, 0x7e, 0x5c, 0xfd, 0x22, 0x8b, 0xeb, 0x73, 0xbc, 0x16, 0xe1, 0x8f, 0xa8, 0xc5, 0x54, 0xde,
-	0x23, 0xa7, 0xcf, 0x7b, 0xf4, 0xec, 0x79, 0x8f, 0x9d, 0x22, 0xef, 0x48, 0x86, 0x75, 0x37, 0xd1,
-	0x86, 0x65, 0x50, 0x23, 0xb8, 0x6a, 0x54, 0x16, 0xbe, 0x18, 0x5f, 0xa8, 0x70, 0xc1, 0x34, 0x2c,
-	0xd9, 0xc3, 0xf3, 0xf4, 0x28, 0x2e, 0x1a, 0x55, 0xe1, 0xfc, 0xe4, 0x24, 0xe9, 0x6a, 0x56, 0x17,
-	0xf7, 0xb9, 0x4c, 0x62, 0xa1, 0xcc, 0x8a, 0x0f, 0xde, 0x65, 0x58, 0x4f, 0x63, 0x0f, 0x56, 0xe7,
-	0x35, 0x74, 0xec, 0x50, 0x76, 0xbf, 0xfc, 0xd7, 0xd9, 0x83, 0x66, 0xc5, 0x6a, 0xd8, 0xa1, 0xe8,
-	0x00, 0xd6, 0x26, 0x27, 0xb9, 0x3a, 0x5b, 0x37, 0x38, 0x5d, 0xdd, 0xce, 0x4f, 0xf8, 0xed, 0xe9,
-	0x02, 0x7e, 0x01, 0x2b, 0x81, 0x70, 0x90, 0xef, 0xd4, 0xc2, 0x6d, 0xa2, 0x09, 0x34, 0x48, 0xfa,
-	0x63, 0x08, 0x94, 0xd5, 0xe9, 0x3e, 0x4f, 0x9f, 0xa1, 0xcf, 0x83, 0x18, 0x1e, 0x06, 0x0d, 0xbf,
-	0x0d, 0xb9, 0xce, 0xd0, 0xb6, 0xdc, 0xed, 0x62, 0x95, 0x77, 0x59, 0x86, 0xdd, 0x6a, 0x59, 0xd7,
-	0xee, 0x1e, 0xb9, 0x5f, 0x79, 0xdd, 0x55, 0x81, 0x4d, 0x86, 0x9c, 0xa4, 0x7b, 0x32, 0x24, 0x36,
-	0x76, 0xd9, 0xfc, 0x32, 0xdc, 0x70, 0x41, 0xfe, 0x9b, 0x97, 0x3f, 0x0d, 0x1e, 0x02, 0x5d, 0x81,
-	0x6c, 0xb0, 0x98, 0xdb, 0x56, 0xe2, 0x32, 0xe3, 0xa4, 0xfd, 0xa5, 0xdc, 0xeb, 0xe6, 0xda, 0x77,
-	0x02, 0xc0, 0xd4, 0x2b, 0xf3, 0x45, 0x58, 0x6b, 0xd7, 0x5b, 0x92, 0x5a, 0x6f, 0xb4, 0xe4, 0xfa,
-	0xbe, 0xfa, 0x68, 0xbf, 0xd9, 0x90, 0x76, 0xe5, 0x7b, 0xb2, 0x54, 0xcb, 0x2d, 0xa1, 0x15, 0x58,
-	0x9e, 0x76, 0x3e, 0x91, 0x9a, 0x39, 0x01, 0xad, 0xc1, 0xca, 0xb4, 0xb1, 0x52, 0x6d, 0xb6, 0x2a,
-	0xf2, 0x7e, 0x2e, 0x84, 0x10, 0x64, 0xa7, 0x1d, 0xfb, 0xf5, 0x5c, 0x18, 0x5d, 0x02, 0x71, 0xd6,
-	0xa6, 0x1e, 0xc8, 0xad, 0xfb, 0x6a, 0x5b, 0x6a, 0xd5, 0x73, 0x91, 0x6b, 0xbf, 0x0a, 0x90, 0x9d,
-	0x7d, 0x8d, 0x44, 0x05, 0xb8, 0xd8, 0x50, 0xea, 0x8d, 0x7a, 0xb3, 0xf2, 0x40, 0x6d, 0xb6, 0x2a,
-	0xad, 0x47, 0xcd, 0xb9, 0x98, 0x8a, 0x90, 0x9f, 0x07, 0xd4, 0xa4, 0x46, 0xbd, 0x29, 0xb7, 0xd4,
-	0x86, 0xa4, 0xc8, 0xf5, 0x5a, 0x4e, 0x40, 0x97, 0x61, 0x73, 0x1e, 0xd3, 0xae, 0xb7, 0xe4, 0xfd,
-	0x2f, 0x7d, 0x48, 0x08, 0x6d, 0xc0, 0x85, 0x79, 0x48, 0xa3, 0xd2, 0x6c, 0x4a, 0x35, 0x2f, 0xe8,
-	0x79, 0x9f, 0x22, 0xed, 0x49, 0xbb, 0x2d, 0xa9, 0x96, 0x8b, 0x2c, 0x62, 0xde, 0xab, 0xc8, 0x0f,
-	0xa4, 0x5a, 0x2e, 0x5a, 0x95, 0x5e, 0xbe, 0xcb, 0x0b, 0xaf, 0xde, 0xe5, 0x85, 0xbf, 0xde, 0xe5,
-	0x85, 0xe7, 0xef, 0xf3, 0x4b, 0xaf, 0xde, 0xe7, 0x97, 0xfe, 0x78, 0x9f, 0x5f, 0xfa, 0xfa, 0x7a,
-	0xcf, 0xa0, 0x87, 0xc3, 0x4e, 0xa9, 0x4b, 0x4c, 0xfe, 0x71, 0xc3, 0xff, 0xdd, 0x74, 0xf4, 0x6f,
-	0xca, 0x47, 0xec, 0x83, 0x8d, 0x8e, 0x07, 0xd8, 0x71, 0xbf, 0xc6, 0x62, 0x6c, 0x02, 0x6e, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xba, 0xe0, 0x97, 0xe3, 0xce, 0x0d, 0x00, 0x00,
+	// 1689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0xf2, 0x97, 0xc8, 0x47, 0x91, 0xa2, 0x47, 0xb2, 0xb5, 0x52, 0x64, 0x4a, 0x61, 0xd3,
+	0x40, 0x75, 0x62, 0xb2, 0x72, 0x9a, 0x1e, 0x92, 0x02, 0x01, 0x25, 0x32, 0x15, 0x0d, 0x5b, 0x64,
+	0x97, 0x94, 0x9c, 0xf4, 0xb2, 0x58, 0x71, 0x27, 0xe4, 0x20, 0xbb, 0x3b, 0xec, 0xce, 0x50, 0x16,
+	0xaf, 0xbd, 0xf5, 0x16, 0xa0, 0x97, 0x9e, 0x8a, 0x1e, 0x8b, 0xa2, 0x87, 0x1e, 0xf2, 0x47, 0xe4,
+	0x54, 0x04, 0xb9, 0xb4, 0x97, 0xba, 0x85, 0x7d, 0x28, 0x90, 0xbf, 0xa2, 0x98, 0x1f, 0xcb, 0xa5,
+	0x28, 0x1a, 0x92, 0xdc, 0x4b, 0x2e, 0xd2, 0xee, 0x7b, 0xdf, 0xf7, 0xe6, 0xcd, 0x9b, 0xef, 0xcd,
+	0xcc, 0x12, 0x36, 0xfa, 0x94, 0xf9, 0x94, 0xd5, 0x06, 0xf4, 0xbc, 0x76, 0xbe, 0x2f, 0xfe, 0x55,
+	0x47, 0x21, 0xe5, 0x14, 0x15, 0x94, 0xa3, 0x2a, 0x2c, 0xe7, 0xfb, 0x5b, 0x65, 0x8d, 0x3b, 0x73,
+	0x18, 0xae, 0x9d, 0xef, 0x9f, 0x61, 0xee, 0xec, 0xd7, 0xfa, 0x94, 0x04, 0x0a, 0xbe, 0xb5, 0x3e,
+	0xa0, 0x03, 0x2a, 0x1f, 0x6b, 0xe2, 0x49, 0x5b, 0x77, 0x06, 0x94, 0x0e, 0x3c, 0x5c, 0x93, 0x6f,
+	0x67, 0xe3, 0x2f, 0x6a, 0x9c, 0xf8, 0x98, 0x71, 0xc7, 0x1f, 0x69, 0xc0, 0xe6, 0x3c, 0xc0, 0x09,
+	0x26, 0xda, 0x55, 0x9e, 0x77, 0xb9, 0xe3, 0xd0, 0xe1, 0x84, 0x46, 0x23, 0x6e, 0xaa, 0x8c, 0x6c,
+	0x35, 0xa8, 0xce, 0x56, 0xb9, 0xee, 0x38, 0x3e, 0x09, 0x68, 0x4d, 0xfe, 0x55, 0xa6, 0x0a, 0x05,
+	0xf4, 0x0c, 0x93, 0xc1, 0x90, 0x63, 0xf7, 0x94, 0x72, 0xdc, 0x1e, 0x89, 0x48, 0x68, 0x1f, 0x32,
+	0x54, 0x3e, 0x99, 0xc6, 0xae, 0xb1, 0x57, 0x7c, 0xb4, 0x59, 0xbd, 0x34, 0xeb, 0x6a, 0x0c, 0xb5,
+	0x34, 0x10, 0xbd, 0x0b, 0x99, 0xe7, 0x32, 0x90, 0x99, 0xd8, 0x35, 0xf6, 0x72, 0x07, 0xc5, 0xef,
+	0xbe, 0x7e, 0x08, 0x9a, 0xd5, 0xc0, 0x7d, 0x4b, 0x7b, 0x2b, 0x7f, 0x32, 0x60, 0xb9, 0x81, 0x47,
+	0x94, 0x11, 0x8e, 0x76, 0x20, 0x3f, 0x0a, 0xe9, 0x88, 0x32, 0xc7, 0xb3, 0x89, 0x2b, 0xc7, 0x4a,
+	0x59, 0x10, 0x99, 0x5a, 0x2e, 0xfa, 0x39, 0xe4, 0x5c, 0x85, 0xa5, 0xa1, 0x8e, 0x6b, 0x7e, 0xf7,
+	0xf5, 0xc3, 0x75, 0x1d, 0xb7, 0xee, 0xba, 0x21, 0x66, 0xac, 0xcb, 0x43, 0x12, 0x0c, 0xac, 0x18,
+	0x8a, 0x7e, 0x01, 0x19, 0xc7, 0xa7, 0xe3, 0x80, 0x9b, 0xc9, 0xdd, 0xe4, 0x5e, 0x3e, 0xce, 0x5f,
+	0x2c, 0x53, 0x55, 0x2f, 0x53, 0xf5, 0x90, 0x92, 0xe0, 0x20, 0xf7, 0xcd, 0x8b, 0x9d, 0xa5, 0x3f,
+	0xff, 0xf7, 0x6f, 0x0f, 0x0c, 0x4b, 0x73, 0x2a, 0xff, 0x30, 0xa0, 0xd8, 0xc5, 0x9c, 0x7b, 0xd8,
+	0xfd, 0x61, 0x67, 0x8a, 0xee, 0x41, 0xe6, 0x6c, 0x1c, 0x06, 0xd8, 0x35, 0x53, 0xbb, 0xc6, 0x5e,
+	0xd6, 0xd2, 0x6f, 0xc2, 0x3e, 0x54, 0x8b, 0x91, 0xde, 0x35, 0xf6, 0x92, 0x96, 0x7e, 0xab, 0xfc,
+	0x3e, 0x03, 0xd9, 0x8e, 0x4e, 0x1a, 0x15, 0x21, 0x31, 0x9d, 0x4a, 0x82, 0xb8, 0xe8, 0xa7, 0x90,
+	0xf5, 0x31, 0x63, 0xce, 0x00, 0x33, 0x33, 0x21, 0x93, 0x59, 0xaf, 0x2a, 0xad, 0x55, 0x23, 0xad,
+	0x55, 0xeb, 0xc1, 0xc4, 0x9a, 0xa2, 0xd0, 0x87, 0x90, 0x61, 0xdc, 0xe1, 0x63, 0x66, 0x26, 0xa5,
+	0x4c, 0xee, 0xcf, 0xc9, 0x24, 0x1a, 0xaa, 0x2b, 0x41, 0x96, 0x06, 0xa3, 0x23, 0x40, 0x5f, 0x90,
+	0xc0, 0xf1, 0x6c, 0xee, 0x78, 0xde, 0xc4, 0x0e, 0x31, 0x1b, 0x7b, 0x5c, 0xce, 0x20, 0xff, 0x68,
+	0x6b, 0x2e, 0x44, 0x4f, 0x40, 0x2c, 0x89, 0xb0, 0x4a, 0x92, 0x35, 0x63, 0x41, 0x75, 0xc8, 0xb3,
+	0xf1, 0x99, 0x4f, 0xb8, 0x2d, 0x1a, 0xc8, 0x4c, 0xeb, 0x10, 0xf3, 0x59, 0xf7, 0xa2, 0xee, 0x3a,
+	0x48, 0x7d, 0xf5, 0xef, 0x1d, 0xc3, 0x02, 0x45, 0x12, 0x66, 0xf4, 0x18, 0x4a, 0x7a, 0x35, 0x6c,
+	0x1c, 0xb8, 0x2a, 0x4e, 0xe6, 0x86, 0x71, 0x8a, 0x9a, 0xd9, 0x0c, 0x5c, 0x19, 0xab, 0x05, 0x05,
+	0x4e, 0xb9, 0xe3, 0xd9, 0xda, 0x6e, 0x2e, 0xdf, 0x62, 0x4d, 0x57, 0x24, 0x35, 0x12, 0xdc, 0x13,
+	0xb8, 0x73, 0x4e, 0x39, 0x09, 0x06, 0x36, 0xe3, 0x4e, 0xa8, 0xe7, 0x97, 0xbd, 0x61, 0x5e, 0xab,
+	0x8a, 0xda, 0x15, 0x4c, 0x99, 0xd8, 0x11, 0x68, 0x53, 0x3c, 0xc7, 0xdc, 0x0d, 0x63, 0x15, 0x14,
+	0x31, 0x9a, 0xe2, 0x96, 0x10, 0x09, 0x77, 0x5c, 0x87, 0x3b, 0x26, 0x08, 0x99, 0x5b, 0xd3, 0x77,
+	0xb4, 0x0e, 0x69, 0x4e, 0xb8, 0x87, 0xcd, 0xbc, 0x74, 0xa8, 0x17, 0x64, 0xc2, 0x32, 0x1b, 0xfb,
+	0xbe, 0x13, 0x4e, 0xcc, 0x15, 0x69, 0x8f, 0x5e, 0xd1, 0xcf, 0x20, 0xab, 0x3a, 0x08, 0x87, 0x66,
+	0xe1, 0x9a, 0x96, 0x99, 0x22, 0xd1, 0x36, 0xe4, 0xf0, 0xc5, 0x08, 0xbb, 0x84, 0x63, 0xd7, 0x2c,
+	0x4a, 0xd9, 0xc7, 0x06, 0xf4, 0x08, 0xd2, 0x3c, 0x74, 0xfa, 0x5f, 0x9a, 0xab, 0x72, 0x7e, 0xdb,
+	0xaf, 0x51, 0x64, 0x4f, 0x60, 0x2c, 0x05, 0x15, 0xfd, 0x9e, 0x9f, 0x55, 0xd5, 0x7b, 0x90, 0x9b,
+	0x60, 0x66, 0xf7, 0x65, 0x5b, 0x1a, 0x57, 0x76, 0xb3, 0x56, 0xc0, 0xad, 0xec, 0x04, 0xb3, 0x43,
+	0xd9, 0x82, 0x1f, 0x40, 0xc1, 0x39, 0x63, 0xdc, 0x21, 0x81, 0x26, 0x24, 0x16, 0x12, 0x56, 0x34,
+	0x48, 0x91, 0x7e, 0x02, 0xd9, 0x80, 0x6a, 0x7c, 0x72, 0x21, 0x7e, 0x39, 0xa0, 0x0a, 0xfa, 0x31,
+	0xa0, 0x80, 0xda, 0xcf, 0x09, 0x1f, 0xda, 0xe7, 0x98, 0x47, 0xa4, 0xd4, 0x42, 0xd2, 0x6a, 0x40,
+	0x9f, 0x11, 0x3e, 0x3c, 0xc5, 0x5c, 0x91, 0x2b, 0xbf, 0x4d, 0x40, 0x4a, 0xec, 0xd5, 0xd7, 0xef,
+	0x5f, 0x55, 0x48, 0x9f, 0x53, 0x8e, 0xaf, 0xdf, 0xbb, 0x14, 0x0c, 0x7d, 0x0c, 0xcb, 0x6a, 0xe3,
+	0x67, 0x66, 0x4a, 0x8a, 0xfc, 0xed, 0xb9, 0x4a, 0x5f, 0x3d, 0x55, 0xac, 0x88, 0x71, 0x49, 0x44,
+	0xe9, 0x39, 0x11, 0xfd, 0x08, 0x0a, 0xea, 0x38, 0x73, 0x3c, 0x6c, 0x8f, 0x43, 0x22, 0x9b, 0x31,
+	0x67, 0xad, 0x4c, 0x8d, 0x27, 0x21, 0x41, 0x3f, 0x86, 0x62, 0x0c, 0x1a, 0x3a, 0x6c, 0x68, 0x2e,
+	0x4b, 0x54, 0x4c, 0x3d, 0x72, 0xd8, 0xf0, 0x71, 0x2a, 0x9b, 0x2c, 0xa5, 0x2a, 0xff, 0x32, 0xa0,
+	0xa0, 0xdb, 0xaa, 0xe3, 0x84, 0x8e, 0xcf, 0xd0, 0xe7, 0x90, 0xf7, 0x49, 0x30, 0xed, 0x52, 0xe3,
+	0xba, 0x2e, 0xbd, 0x2f, 0xba, 0xf4, 0xfb, 0x17, 0x3b, 0x77, 0x67, 0x58, 0xef, 0x53, 0x9f, 0x70,
+	0xec, 0x8f, 0xf8, 0xc4, 0x02, 0x9f, 0x04, 0x51, 0xdf, 0xfa, 0x80, 0x7c, 0xe7, 0x22, 0x02, 0xd9,
+	0x23, 0x1c, 0x12, 0xea, 0xca, 0xa2, 0x8a, 0x11, 0xe6, 0x9b, 0xad, 0xa1, 0x8f, 0xee, 0x83, 0x77,
+	0xbe, 0x7f, 0xb1, 0xb3, 0x7d, 0x95, 0x18, 0x0f, 0xf2, 0x07, 0xd1, 0x8b, 0x25, 0xdf, 0xb9, 0x88,
+	0x66, 0x22, 0xfd, 0x1f, 0x25, 0x4c, 0xa3, 0xf2, 0x19, 0xac, 0x9c, 0xca, 0x1e, 0xd5, 0xb3, 0x6b,
+	0x80, 0xee, 0xd9, 0x68, 0x74, 0xe3, 0xba, 0xd1, 0x53, 0x32, 0xfa, 0x8a, 0x62, 0xcd, 0x44, 0xfe,
+	0x63, 0xd4, 0x18, 0x3a, 0xf2, 0xbb, 0x90, 0xf9, 0xcd, 0x98, 0x86, 0x63, 0xdf, 0x34, 0x16, 0x9f,
+	0xf1, 0xca, 0x8b, 0xde, 0x87, 0x1c, 0x1f, 0x86, 0x98, 0x0d, 0xa9, 0xe7, 0xbe, 0xe6, 0x3a, 0x10,
+	0x03, 0xd0, 0x87, 0x50, 0x94, 0xca, 0x8e, 0x29, 0xc9, 0x85, 0x94, 0x82, 0x40, 0xf5, 0x22, 0x90,
+	0x4c, 0xf0, 0x2f, 0x09, 0x28, 0x5c, 0x6a, 0x69, 0x84, 0x20, 0x15, 0x38, 0x3e, 0x56, 0x09, 0x5a,
+	0xf2, 0x19, 0x55, 0xa0, 0xe0, 0xb3, 0x81, 0xcd, 0x27, 0x23, 0xa1, 0x28, 0x4f, 0x9d, 0x6e, 0x39,
+	0x2b, 0xef, 0xb3, 0x41, 0x6f, 0x32, 0xc2, 0x27, 0xa1, 0xc7, 0x50, 0xf3, 0xb2, 0x24, 0x6e, 0x73,
+	0x18, 0xcf, 0x2e, 0xff, 0x95, 0xda, 0xa7, 0xde, 0xa0, 0xf6, 0x33, 0x75, 0x4e, 0xdf, 0xbc, 0xce,
+	0x99, 0x6b, 0xea, 0x5c, 0xf9, 0x2b, 0x40, 0x46, 0x2f, 0x64, 0xf3, 0x96, 0x0d, 0xf0, 0x9a, 0xd9,
+	0x3e, 0x7d, 0x33, 0xb1, 0xa7, 0x16, 0x8b, 0xf9, 0x6a, 0xf1, 0x92, 0xff, 0x5f, 0xf1, 0x52, 0x37,
+	0x2f, 0x5e, 0xfa, 0xf6, 0x22, 0xcd, 0xdc, 0x40, 0xa4, 0xa8, 0x05, 0x9b, 0xa2, 0xd0, 0x24, 0x20,
+	0x9c, 0xc4, 0xf7, 0x02, 0x5b, 0xa6, 0x6f, 0x2e, 0x2f, 0x8c, 0x70, 0xcf, 0x27, 0x41, 0x4b, 0xe1,
+	0x75, 0x79, 0x2c, 0x81, 0x46, 0x07, 0x70, 0x77, 0xba, 0x85, 0xf7, 0x9d, 0xa0, 0x8f, 0x3d, 0x1d,
+	0x26, 0xbb, 0x30, 0xcc, 0x5a, 0x04, 0x3e, 0x94, 0x58, 0x15, 0xe3, 0x31, 0xac, 0xcf, 0xc7, 0x70,
+	0x31, 0xe3, 0x66, 0xee, 0x9a, 0x4d, 0x1f, 0x5d, 0x0e, 0xd6, 0xc0, 0x8c, 0xa3, 0x67, 0xb0, 0x31,
+	0x3d, 0x76, 0xed, 0xcb, 0xeb, 0x06, 0x37, 0x5b, 0xb7, 0xbb, 0x53, 0xfe, 0xe9, 0xec, 0x02, 0x7e,
+	0x02, 0x6b, 0x71, 0xe0, 0xb8, 0xde, 0xf9, 0x85, 0xd3, 0x44, 0x53, 0x68, 0x5c, 0xf4, 0xcf, 0x20,
+	0x8e, 0x6c, 0xcf, 0xea, 0x7c, 0xe5, 0x16, 0x3a, 0x8f, 0x73, 0x78, 0x1a, 0x0b, 0x7e, 0x0f, 0x4a,
+	0xe2, 0x86, 0x2d, 0xa6, 0x8b, 0x6d, 0xad, 0xb2, 0x82, 0xbc, 0x82, 0x14, 0x85, 0x5d, 0x9c, 0x75,
+	0xbf, 0x52, 0xea, 0xaa, 0xc3, 0x7d, 0x89, 0x9c, 0x96, 0x7b, 0xda, 0x24, 0x21, 0x16, 0x6c, 0x7d,
+	0x73, 0xd9, 0x12, 0xa0, 0x68, 0x07, 0x8b, 0xba, 0x41, 0x21, 0xd0, 0x3b, 0x50, 0x8c, 0x07, 0x13,
+	0xb2, 0x92, 0x77, 0x9a, 0xac, 0xb5, 0x12, 0x0d, 0x25, 0xce, 0x79, 0x74, 0x02, 0x3b, 0x01, 0x0d,
+	0xa2, 0x05, 0xe8, 0x53, 0xdf, 0x27, 0x8c, 0x11, 0x1a, 0xd8, 0x2e, 0x39, 0xc7, 0xa1, 0x78, 0x32,
+	0x4b, 0x0b, 0x2b, 0xb7, 0x1d, 0xd0, 0x40, 0xd5, 0xfd, 0x70, 0x4a, 0x6a, 0x44, 0x1c, 0xb4, 0x0f,
+	0x77, 0x45, 0x6b, 0xcb, 0xb1, 0xa3, 0xb3, 0xd9, 0xf6, 0x70, 0x60, 0xde, 0x91, 0x57, 0x07, 0xd1,
+	0xf7, 0x22, 0x85, 0xa7, 0xda, 0xf5, 0x04, 0x07, 0xe8, 0x13, 0xd8, 0x9e, 0x29, 0xb6, 0xed, 0xe2,
+	0x80, 0xfa, 0xcc, 0x76, 0x3c, 0x8f, 0x3e, 0xf7, 0x08, 0xe3, 0x26, 0x92, 0xbb, 0xee, 0x66, 0xbc,
+	0x7f, 0x34, 0x24, 0xa2, 0x1e, 0x01, 0xd0, 0x47, 0xb0, 0x19, 0x91, 0x87, 0x84, 0x71, 0x1a, 0x8a,
+	0x6f, 0x03, 0x8e, 0x03, 0xf9, 0x21, 0xba, 0x26, 0xc7, 0xdd, 0xd0, 0x80, 0x23, 0xe5, 0xb7, 0x22,
+	0x37, 0xea, 0xc0, 0xea, 0xb4, 0xd4, 0xf2, 0x56, 0xc7, 0xcc, 0xf5, 0xdd, 0xe4, 0x75, 0x37, 0xc0,
+	0xd9, 0x05, 0x2f, 0x8e, 0x66, 0x3d, 0xec, 0xc1, 0xef, 0x0c, 0x80, 0x99, 0x4f, 0xe2, 0xb7, 0x60,
+	0xe3, 0xb4, 0xdd, 0x6b, 0xda, 0xed, 0x4e, 0xaf, 0xd5, 0x3e, 0xb6, 0x4f, 0x8e, 0xbb, 0x9d, 0xe6,
+	0x61, 0xeb, 0xd3, 0x56, 0xb3, 0x51, 0x5a, 0x42, 0x6b, 0xb0, 0x3a, 0xeb, 0xfc, 0xbc, 0xd9, 0x2d,
+	0x19, 0x68, 0x03, 0xd6, 0x66, 0x8d, 0xf5, 0x83, 0x6e, 0xaf, 0xde, 0x3a, 0x2e, 0x25, 0x10, 0x82,
+	0xe2, 0xac, 0xe3, 0xb8, 0x5d, 0x4a, 0xa2, 0x6d, 0x30, 0x2f, 0xdb, 0xec, 0x67, 0xad, 0xde, 0x91,
+	0x7d, 0xda, 0xec, 0xb5, 0x4b, 0xa9, 0x07, 0x7f, 0x37, 0xa0, 0x78, 0xf9, 0x63, 0x0a, 0xed, 0xc0,
+	0x5b, 0x1d, 0xab, 0xdd, 0x69, 0x77, 0xeb, 0x4f, 0xec, 0x6e, 0xaf, 0xde, 0x3b, 0xe9, 0xce, 0xe5,
+	0x54, 0x81, 0xf2, 0x3c, 0xa0, 0xd1, 0xec, 0xb4, 0xbb, 0xad, 0x9e, 0xdd, 0x69, 0x5a, 0xad, 0x76,
+	0xa3, 0x64, 0xa0, 0xb7, 0xe1, 0xfe, 0x3c, 0xe6, 0xb4, 0xdd, 0x6b, 0x1d, 0xff, 0x32, 0x82, 0x24,
+	0xd0, 0x16, 0xdc, 0x9b, 0x87, 0x74, 0xea, 0xdd, 0x6e, 0xb3, 0xa1, 0x92, 0x9e, 0xf7, 0x59, 0xcd,
+	0xc7, 0xcd, 0xc3, 0x5e, 0xb3, 0x51, 0x4a, 0x2d, 0x62, 0x7e, 0x5a, 0x6f, 0x3d, 0x69, 0x36, 0x4a,
+	0xe9, 0x83, 0xe6, 0x37, 0x2f, 0xcb, 0xc6, 0xb7, 0x2f, 0xcb, 0xc6, 0x7f, 0x5e, 0x96, 0x8d, 0xaf,
+	0x5e, 0x95, 0x97, 0xbe, 0x7d, 0x55, 0x5e, 0xfa, 0xe7, 0xab, 0xf2, 0xd2, 0xaf, 0xdf, 0x1b, 0x10,
+	0x3e, 0x1c, 0x9f, 0x55, 0xfb, 0xd4, 0xd7, 0x3f, 0x5e, 0xe8, 0x7f, 0x0f, 0x99, 0xfb, 0x65, 0xed,
+	0x42, 0xfe, 0x20, 0x23, 0x4e, 0x72, 0x26, 0x7e, 0x6d, 0xc9, 0xc8, 0xad, 0xe5, 0x83, 0xff, 0x0d,
+	0x00, 0x9e, 0xf3, 0xe0, 0x5a, 0xae, 0x11, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1112,6 +1402,70 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SettledDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettledDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettledDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Burned {
+		i--
+		if m.Burned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1132,6 +1486,18 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Track != nil {
+		{
+			size, err := m.Track.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1171,22 +1537,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1205,24 +1571,24 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
 		if err4 != nil {
 			return 0, err4
 		}
 		i -= n4
 		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x32
+	}
+	if m.SubmitTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.FinalTallyResult != nil {
 		{
@@ -1334,6 +1700,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RationaleHash) > 0 {
+		i -= len(m.RationaleHash)
+		copy(dAtA[i:], m.RationaleHash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.RationaleHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RationaleUri) > 0 {
+		i -= len(m.RationaleUri)
+		copy(dAtA[i:], m.RationaleUri)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.RationaleUri)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1391,12 +1771,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1438,12 +1818,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1494,6 +1874,83 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTrack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTrack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTrack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VotingPeriod != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1514,6 +1971,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalTracks) > 0 {
+		for iNdEx := len(m.ProposalTracks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTracks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.DepositHistoryRetention != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DepositHistoryRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.MinDepositDenomsAllowlist) > 0 {
+		for iNdEx := len(m.MinDepositDenomsAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MinDepositDenomsAllowlist[iNdEx])
+			copy(dAtA[i:], m.MinDepositDenomsAllowlist[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositDenomsAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.MaxVoteMetadataLen != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxVoteMetadataLen))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.NonVotingCommissionDiversion) > 0 {
+		i -= len(m.NonVotingCommissionDiversion)
+		copy(dAtA[i:], m.NonVotingCommissionDiversion)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NonVotingCommissionDiversion)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1566,12 +2073,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1618,22 +2125,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1703,6 +2210,34 @@ func (m *Deposit) Size() (n int) {
 	return n
 }
 
+func (m *SettledDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Burned {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *Proposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1766,6 +2301,10 @@ func (m *Proposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.Track != nil {
+		l = m.Track.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1817,6 +2356,14 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.RationaleUri)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.RationaleHash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1873,6 +2420,43 @@ func (m *TallyParams) Size() (n int) {
 	return n
 }
 
+func (m *ProposalTrack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1940,6 +2524,28 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	l = len(m.NonVotingCommissionDiversion)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxVoteMetadataLen != 0 {
+		n += 2 + sovGov(uint64(m.MaxVoteMetadataLen))
+	}
+	if len(m.MinDepositDenomsAllowlist) > 0 {
+		for _, s := range m.MinDepositDenomsAllowlist {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.DepositHistoryRetention != 0 {
+		n += 2 + sovGov(uint64(m.DepositHistoryRetention))
+	}
+	if len(m.ProposalTracks) > 0 {
+		for _, e := range m.ProposalTracks {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2185,7 +2791,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *SettledDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2208,17 +2814,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettledDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettledDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.Id = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2228,16 +2834,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2247,48 +2853,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2315,14 +2900,209 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalTallyResult == nil {
-				m.FinalTallyResult = &TallyResult{}
-			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burned = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
 			}
@@ -2648,6 +3428,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Track", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Track == nil {
+				m.Track = &ProposalTrack{}
+			}
+			if err := m.Track.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2929,7 +3745,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceRegistryOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2993,16 +3809,80 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RationaleUri", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RationaleUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RationaleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RationaleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -3366,6 +4246,254 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalTrack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTrack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTrack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3855,6 +4983,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVotingCommissionDiversion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonVotingCommissionDiversion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteMetadataLen", wireType)
+			}
+			m.MaxVoteMetadataLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteMetadataLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositDenomsAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDepositDenomsAllowlist = append(m.MinDepositDenomsAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositHistoryRetention", wireType)
+			}
+			m.DepositHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTracks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTracks = append(m.ProposalTracks, ProposalTrack{})
+			if err := m.ProposalTracks[len(m.ProposalTracks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
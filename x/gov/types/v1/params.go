@@ -18,18 +18,20 @@ const (
 
 // Default governance params
 var (
-	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
-	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
-	DefaultQuorum                    = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
-	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
-	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
-	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
-	DefaultProposalCancelDestAddress = ""
-	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositTokens             = sdkmath.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens    = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
+	DefaultQuorum                       = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultThreshold                    = sdkmath.LegacyNewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold           = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultVetoThreshold                = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio       = sdkmath.LegacyZeroDec()
+	DefaultProposalCancelRatio          = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultProposalCancelDestAddress    = ""
+	DefaultBurnProposalPrevote          = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom               = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                 = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultNonVotingCommissionDiversion = sdkmath.LegacyZeroDec()
+	DefaultMaxVoteMetadataLen           = uint64(255)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -82,7 +84,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -99,6 +101,11 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 	)
+	params.NonVotingCommissionDiversion = DefaultNonVotingCommissionDiversion.String()
+	params.MaxVoteMetadataLen = DefaultMaxVoteMetadataLen
+	params.ProposalTracks = DefaultProposalTracks()
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -208,6 +215,20 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("burn rate of cancel proposal is too large: %s", proposalCancelRate)
 	}
 
+	// an empty diversion, as in params stored before its introduction, is equivalent to zero
+	if len(p.NonVotingCommissionDiversion) != 0 {
+		diversion, err := sdkmath.LegacyNewDecFromStr(p.NonVotingCommissionDiversion)
+		if err != nil {
+			return fmt.Errorf("invalid non voting commission diversion: %w", err)
+		}
+		if diversion.IsNegative() {
+			return fmt.Errorf("non voting commission diversion must be positive: %s", diversion)
+		}
+		if diversion.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("non voting commission diversion too large: %s", diversion)
+		}
+	}
+
 	if len(p.ProposalCancelDest) != 0 {
 		_, err := sdk.AccAddressFromBech32(p.ProposalCancelDest)
 		if err != nil {
@@ -215,5 +236,41 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	trackNames := make(map[string]bool, len(p.ProposalTracks))
+	for _, track := range p.ProposalTracks {
+		if err := track.ValidateBasic(); err != nil {
+			return err
+		}
+		if trackNames[track.Name] {
+			return fmt.Errorf("duplicate proposal track name: %s", track.Name)
+		}
+		trackNames[track.Name] = true
+	}
+
+	if len(p.MinDepositDenomsAllowlist) != 0 {
+		allowed := make(map[string]bool, len(p.MinDepositDenomsAllowlist))
+		for _, denom := range p.MinDepositDenomsAllowlist {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid deposit denoms allowlist: %w", err)
+			}
+			if allowed[denom] {
+				return fmt.Errorf("duplicate denom in deposit denoms allowlist: %s", denom)
+			}
+			allowed[denom] = true
+		}
+
+		minDeposits := [][]sdk.Coin{p.MinDeposit, p.ExpeditedMinDeposit}
+		for _, track := range p.ProposalTracks {
+			minDeposits = append(minDeposits, track.MinDeposit)
+		}
+		for _, minDeposit := range minDeposits {
+			for _, coin := range minDeposit {
+				if !allowed[coin.Denom] {
+					return fmt.Errorf("minimum deposit denom %s is not in the deposit denoms allowlist", coin.Denom)
+				}
+			}
+		}
+	}
+
 	return nil
 }
@@ -54,13 +54,23 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 }
 
 // GetMinDepositFromParams returns min expedited deposit from the gov params if
-// the proposal is expedited. Otherwise, returns the regular min deposit from
-// gov params.
+// the proposal is expedited. Otherwise, returns the min deposit of the proposal
+// track from gov params.
 func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	if p.Expedited {
 		return params.ExpeditedMinDeposit
 	}
-	return params.MinDeposit
+	return p.ResolvedTrack(params).MinDeposit
+}
+
+// ResolvedTrack returns the proposal track resolved on submission, whose
+// parameters apply to the proposal. The proposals submitted before tracks were
+// introduced are governed by the given params.
+func (p Proposal) ResolvedTrack(params Params) ProposalTrack {
+	if p.Track != nil {
+		return *p.Track
+	}
+	return params.ProposalTrack("")
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
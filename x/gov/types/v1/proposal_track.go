@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"golang.org/x/exp/slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultProposalTrackName is the name of the default proposal track, which
+// matches every proposal and is governed by the params themselves.
+const DefaultProposalTrackName = "default"
+
+// NewProposalTrack creates a new ProposalTrack instance matching the messages
+// with the given type URLs, every message if there are none. Its unset
+// parameters are the ones of the params.
+func NewProposalTrack(name string, msgTypeURLs []string, minDeposit sdk.Coins, votingPeriod *time.Duration, quorum, threshold string) ProposalTrack {
+	return ProposalTrack{
+		Name:         name,
+		MsgTypeUrls:  msgTypeURLs,
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Quorum:       quorum,
+		Threshold:    threshold,
+	}
+}
+
+// DefaultProposalTracks returns the default proposal tracks, a single track
+// matching every proposal with the parameters of the params.
+func DefaultProposalTracks() []ProposalTrack {
+	return []ProposalTrack{NewProposalTrack(DefaultProposalTrackName, nil, nil, nil, "", "")}
+}
+
+// ValidateBasic performs basic validation on a proposal track.
+func (t ProposalTrack) ValidateBasic() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("proposal track name cannot be blank")
+	}
+
+	seen := make(map[string]bool, len(t.MsgTypeUrls))
+	for _, typeURL := range t.MsgTypeUrls {
+		if strings.TrimSpace(typeURL) == "" {
+			return fmt.Errorf("proposal track %s: msg type url cannot be blank", t.Name)
+		}
+		if seen[typeURL] {
+			return fmt.Errorf("proposal track %s: duplicate msg type url %s", t.Name, typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	if len(t.MinDeposit) != 0 {
+		if minDeposit := sdk.Coins(t.MinDeposit); !minDeposit.IsValid() {
+			return fmt.Errorf("proposal track %s: invalid minimum deposit: %s", t.Name, minDeposit)
+		}
+	}
+
+	if t.VotingPeriod != nil && t.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("proposal track %s: voting period must be positive: %s", t.Name, t.VotingPeriod)
+	}
+
+	if len(t.Quorum) != 0 {
+		quorum, err := sdkmath.LegacyNewDecFromStr(t.Quorum)
+		if err != nil {
+			return fmt.Errorf("proposal track %s: invalid quorum string: %w", t.Name, err)
+		}
+		if quorum.IsNegative() {
+			return fmt.Errorf("proposal track %s: quorum cannot be negative: %s", t.Name, quorum)
+		}
+		if quorum.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("proposal track %s: quorum too large: %s", t.Name, quorum)
+		}
+	}
+
+	if len(t.Threshold) != 0 {
+		threshold, err := sdkmath.LegacyNewDecFromStr(t.Threshold)
+		if err != nil {
+			return fmt.Errorf("proposal track %s: invalid threshold string: %w", t.Name, err)
+		}
+		if !threshold.IsPositive() {
+			return fmt.Errorf("proposal track %s: vote threshold must be positive: %s", t.Name, threshold)
+		}
+		if threshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("proposal track %s: vote threshold too large: %s", t.Name, threshold)
+		}
+	}
+
+	return nil
+}
+
+// matchesAll returns true if the track matches every message, having no msg
+// type URLs.
+func (t ProposalTrack) matchesAll() bool {
+	return len(t.MsgTypeUrls) == 0
+}
+
+// combine returns a track whose every parameter is the most restrictive one of
+// the two tracks, both having their parameters set: the highest threshold and
+// quorum, the longest voting period and, for every denom, the highest minimum
+// deposit. The returned track matches the msg type URLs of both tracks.
+func (t ProposalTrack) combine(other ProposalTrack) ProposalTrack {
+	threshold, _ := sdkmath.LegacyNewDecFromStr(t.Threshold)
+	otherThreshold, _ := sdkmath.LegacyNewDecFromStr(other.Threshold)
+	if otherThreshold.GT(threshold) {
+		t.Threshold = other.Threshold
+	}
+
+	quorum, _ := sdkmath.LegacyNewDecFromStr(t.Quorum)
+	otherQuorum, _ := sdkmath.LegacyNewDecFromStr(other.Quorum)
+	if otherQuorum.GT(quorum) {
+		t.Quorum = other.Quorum
+	}
+
+	if t.VotingPeriod == nil || (other.VotingPeriod != nil && *other.VotingPeriod > *t.VotingPeriod) {
+		t.VotingPeriod = other.VotingPeriod
+	}
+
+	t.MinDeposit = sdk.NewCoins(t.MinDeposit...).Max(sdk.NewCoins(other.MinDeposit...))
+
+	msgTypeURLs := slices.Clone(t.MsgTypeUrls)
+	for _, typeURL := range other.MsgTypeUrls {
+		if !slices.Contains(msgTypeURLs, typeURL) {
+			msgTypeURLs = append(msgTypeURLs, typeURL)
+		}
+	}
+	t.MsgTypeUrls = msgTypeURLs
+
+	return t
+}
+
+// ProposalTrack returns the proposal track with the given name, its unset
+// parameters set to the ones of the params. When no track has the name, e.g.
+// for the proposals submitted before tracks were introduced or whose track was
+// removed since, the params themselves are returned as a track with an empty
+// name.
+func (p Params) ProposalTrack(name string) ProposalTrack {
+	var track ProposalTrack
+	if len(name) != 0 {
+		for _, t := range p.ProposalTracks {
+			if t.Name == name {
+				track = t
+				break
+			}
+		}
+	}
+
+	return p.withProposalTrackDefaults(track)
+}
+
+// ResolveProposalTrack returns the proposal track of a proposal with messages of
+// the given type URLs, its unset parameters set to the ones of the params.
+//
+// A message is matched by the tracks listing its type URL or, if there are none,
+// by the tracks without msg type URLs, and is governed by the params themselves
+// if it is matched by no track. A proposal without messages is matched by the
+// tracks without msg type URLs.
+//
+// A proposal whose messages match a single track gets that track. Otherwise the
+// parameters of the matched tracks, and of the params if a message is matched by
+// no track, are resolved field by field, so that bundling messages cannot lower
+// any parameter: the returned track has the highest threshold and quorum, the
+// longest voting period and the highest minimum deposit of every denom. It is
+// named after the matched tracks, joined with "+" in the order they are listed
+// in the params.
+func (p Params) ResolveProposalTrack(msgTypeURLs []string) ProposalTrack {
+	// a proposal without messages is matched as a message with the blank type
+	// URL, which no track lists
+	if len(msgTypeURLs) == 0 {
+		msgTypeURLs = []string{""}
+	}
+
+	matched := make([]bool, len(p.ProposalTracks))
+	matchedByNone := false
+	for _, typeURL := range msgTypeURLs {
+		listed := false
+		for i, track := range p.ProposalTracks {
+			if slices.Contains(track.MsgTypeUrls, typeURL) {
+				matched[i], listed = true, true
+			}
+		}
+		if listed {
+			continue
+		}
+
+		matchedAll := false
+		for i, track := range p.ProposalTracks {
+			if track.matchesAll() {
+				matched[i], matchedAll = true, true
+			}
+		}
+		if !matchedAll {
+			matchedByNone = true
+		}
+	}
+
+	var (
+		resolved ProposalTrack
+		names    []string
+		found    bool
+	)
+	resolve := func(track ProposalTrack) {
+		track = p.withProposalTrackDefaults(track)
+		if !found {
+			resolved, found = track, true
+			return
+		}
+		resolved = resolved.combine(track)
+	}
+
+	for i, track := range p.ProposalTracks {
+		if matched[i] {
+			names = append(names, track.Name)
+			resolve(track)
+		}
+	}
+	if matchedByNone {
+		resolve(ProposalTrack{})
+	}
+	resolved.Name = strings.Join(names, "+")
+
+	return resolved
+}
+
+// withProposalTrackDefaults sets the unset parameters of the track to the ones
+// of the params.
+func (p Params) withProposalTrackDefaults(track ProposalTrack) ProposalTrack {
+	if len(track.MinDeposit) == 0 {
+		track.MinDeposit = p.MinDeposit
+	}
+	if track.VotingPeriod == nil {
+		track.VotingPeriod = p.VotingPeriod
+	}
+	if len(track.Quorum) == 0 {
+		track.Quorum = p.Quorum
+	}
+	if len(track.Threshold) == 0 {
+		track.Threshold = p.Threshold
+	}
+
+	return track
+}
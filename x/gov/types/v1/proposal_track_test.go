@@ -0,0 +1,279 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+const (
+	msgSendURL          = "/cosmos.bank.v1beta1.MsgSend"
+	msgExecLegacyURL    = "/cosmos.gov.v1.MsgExecLegacyContent"
+	msgSoftwareUpgrade  = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+	msgTripCircuitBreak = "/cosmos.circuit.v1.MsgTripCircuitBreaker"
+	msgUpdateParams     = "/cosmos.staking.v1beta1.MsgUpdateParams"
+)
+
+func durationPtr(d time.Duration) *time.Duration { return &d }
+
+func trackParams(tracks ...v1.ProposalTrack) v1.Params {
+	params := v1.DefaultParams()
+	params.ProposalTracks = tracks
+	return params
+}
+
+func TestParams_ResolveProposalTrack(t *testing.T) {
+	defaultParams := v1.DefaultParams()
+	defaultTrack := v1.NewProposalTrack(v1.DefaultProposalTrackName, nil, nil, nil, "", "")
+	text := v1.NewProposalTrack("text", []string{msgExecLegacyURL}, nil, nil, "0.2", "0.4")
+	upgrade := v1.NewProposalTrack("upgrade", []string{msgSoftwareUpgrade}, nil, durationPtr(7*24*time.Hour), "0.4", "0.75")
+	emergency := v1.NewProposalTrack("emergency", []string{msgTripCircuitBreak}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), durationPtr(time.Hour), "0.1", "0.667")
+	paramChange := v1.NewProposalTrack("params", []string{msgUpdateParams}, sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), durationPtr(3*24*time.Hour), "0.3", "0.6")
+	bank := v1.NewProposalTrack("bank", []string{msgSendURL}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20000000)), nil, "", "")
+
+	testCases := []struct {
+		name        string
+		tracks      []v1.ProposalTrack
+		msgTypeURLs []string
+		// expTrack is the name of the single track governing the proposal, or
+		// the expected combination of tracks when expCombined is set
+		expTrack    string
+		expCombined *v1.ProposalTrack
+	}{
+		{
+			name:        "default track matches every message",
+			tracks:      []v1.ProposalTrack{defaultTrack},
+			msgTypeURLs: []string{msgSendURL, msgSoftwareUpgrade},
+			expTrack:    v1.DefaultProposalTrackName,
+		},
+		{
+			name:        "default track matches proposals without messages",
+			tracks:      []v1.ProposalTrack{text, defaultTrack},
+			msgTypeURLs: nil,
+			expTrack:    v1.DefaultProposalTrackName,
+		},
+		{
+			name:        "no track, the params govern",
+			tracks:      nil,
+			msgTypeURLs: []string{msgSendURL},
+			expTrack:    "",
+		},
+		{
+			name:        "listing track takes precedence over matching all",
+			tracks:      []v1.ProposalTrack{defaultTrack, text},
+			msgTypeURLs: []string{msgExecLegacyURL},
+			expTrack:    "text",
+		},
+		{
+			name:        "less restrictive listing track takes precedence over matching all",
+			tracks:      []v1.ProposalTrack{defaultTrack, emergency},
+			msgTypeURLs: []string{msgTripCircuitBreak},
+			expTrack:    "emergency",
+		},
+		{
+			name:        "message listed by no track without default track, the params govern",
+			tracks:      []v1.ProposalTrack{text},
+			msgTypeURLs: []string{msgSendURL},
+			expTrack:    "",
+		},
+		{
+			name:        "mixed, the most restrictive parameters of the tracks apply",
+			tracks:      []v1.ProposalTrack{defaultTrack, text, upgrade},
+			msgTypeURLs: []string{msgExecLegacyURL, msgSoftwareUpgrade},
+			expCombined: &v1.ProposalTrack{Name: "text+upgrade", MsgTypeUrls: []string{msgExecLegacyURL, msgSoftwareUpgrade}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: upgrade.VotingPeriod, Quorum: "0.4", Threshold: "0.75"},
+		},
+		{
+			name:        "mixed, messages order does not matter",
+			tracks:      []v1.ProposalTrack{defaultTrack, text, upgrade},
+			msgTypeURLs: []string{msgSoftwareUpgrade, msgExecLegacyURL},
+			expCombined: &v1.ProposalTrack{Name: "text+upgrade", MsgTypeUrls: []string{msgExecLegacyURL, msgSoftwareUpgrade}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: upgrade.VotingPeriod, Quorum: "0.4", Threshold: "0.75"},
+		},
+		{
+			name:        "mixed with unlisted message, the default track parameters are not lowered",
+			tracks:      []v1.ProposalTrack{defaultTrack, text},
+			msgTypeURLs: []string{msgExecLegacyURL, msgSendURL},
+			expCombined: &v1.ProposalTrack{Name: "default+text", MsgTypeUrls: []string{msgExecLegacyURL}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: defaultParams.VotingPeriod, Quorum: defaultParams.Quorum, Threshold: defaultParams.Threshold},
+		},
+		{
+			name:        "mixed with unlisted message, more restrictive track parameters apply",
+			tracks:      []v1.ProposalTrack{defaultTrack, upgrade},
+			msgTypeURLs: []string{msgSendURL, msgSoftwareUpgrade},
+			expCombined: &v1.ProposalTrack{Name: "default+upgrade", MsgTypeUrls: []string{msgSoftwareUpgrade}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: upgrade.VotingPeriod, Quorum: "0.4", Threshold: "0.75"},
+		},
+		{
+			name:        "mixed with message listed by no track, the params are not lowered",
+			tracks:      []v1.ProposalTrack{emergency},
+			msgTypeURLs: []string{msgSendURL, msgTripCircuitBreak},
+			expCombined: &v1.ProposalTrack{Name: "emergency", MsgTypeUrls: []string{msgTripCircuitBreak}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: defaultParams.VotingPeriod, Quorum: defaultParams.Quorum, Threshold: "0.667"},
+		},
+		{
+			name:        "emergency bundled with regular messages, the voting period is not shortened",
+			tracks:      []v1.ProposalTrack{defaultTrack, emergency},
+			msgTypeURLs: []string{msgTripCircuitBreak, msgSendURL},
+			expCombined: &v1.ProposalTrack{Name: "default+emergency", MsgTypeUrls: []string{msgTripCircuitBreak}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: defaultParams.VotingPeriod, Quorum: defaultParams.Quorum, Threshold: "0.667"},
+		},
+		{
+			name:        "emergency bundled with a param change, every parameter is the most restrictive one",
+			tracks:      []v1.ProposalTrack{defaultTrack, emergency, paramChange},
+			msgTypeURLs: []string{msgTripCircuitBreak, msgUpdateParams},
+			expCombined: &v1.ProposalTrack{
+				Name:         "emergency+params",
+				MsgTypeUrls:  []string{msgTripCircuitBreak, msgUpdateParams},
+				MinDeposit:   sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+				VotingPeriod: paramChange.VotingPeriod,
+				Quorum:       "0.3",
+				Threshold:    "0.667",
+			},
+		},
+		{
+			name:        "same thresholds and quorums, higher minimum deposit applies",
+			tracks:      []v1.ProposalTrack{defaultTrack, bank},
+			msgTypeURLs: []string{msgExecLegacyURL, msgSendURL},
+			expCombined: &v1.ProposalTrack{Name: "default+bank", MsgTypeUrls: []string{msgSendURL}, MinDeposit: bank.MinDeposit, VotingPeriod: defaultParams.VotingPeriod, Quorum: defaultParams.Quorum, Threshold: defaultParams.Threshold},
+		},
+		{
+			name:        "message listed by several tracks, the parameters of both apply",
+			tracks:      []v1.ProposalTrack{text, v1.NewProposalTrack("strict-text", []string{msgExecLegacyURL}, nil, nil, "", "0.9")},
+			msgTypeURLs: []string{msgExecLegacyURL},
+			expCombined: &v1.ProposalTrack{Name: "text+strict-text", MsgTypeUrls: []string{msgExecLegacyURL}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: defaultParams.VotingPeriod, Quorum: defaultParams.Quorum, Threshold: "0.9"},
+		},
+		{
+			name:        "tie, tracks named in params order",
+			tracks:      []v1.ProposalTrack{v1.NewProposalTrack("first", []string{msgSendURL}, nil, nil, "", ""), v1.NewProposalTrack("second", []string{msgExecLegacyURL}, nil, nil, "", "")},
+			msgTypeURLs: []string{msgExecLegacyURL, msgSendURL},
+			expCombined: &v1.ProposalTrack{Name: "first+second", MsgTypeUrls: []string{msgSendURL, msgExecLegacyURL}, MinDeposit: defaultParams.MinDeposit, VotingPeriod: defaultParams.VotingPeriod, Quorum: defaultParams.Quorum, Threshold: defaultParams.Threshold},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := trackParams(tc.tracks...)
+			track := params.ResolveProposalTrack(tc.msgTypeURLs)
+			if tc.expCombined != nil {
+				require.Equal(t, tc.expCombined.String(), track.String())
+				return
+			}
+			require.Equal(t, tc.expTrack, track.Name)
+			require.Equal(t, params.ProposalTrack(tc.expTrack), track)
+		})
+	}
+}
+
+func TestParams_ProposalTrack(t *testing.T) {
+	emergency := v1.NewProposalTrack("emergency", []string{msgTripCircuitBreak}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), durationPtr(time.Hour), "0.1", "")
+	params := trackParams(v1.NewProposalTrack(v1.DefaultProposalTrackName, nil, nil, nil, "", ""), emergency)
+
+	// the unset parameters of a track are the ones of the params
+	track := params.ProposalTrack("emergency")
+	require.Equal(t, "emergency", track.Name)
+	require.Equal(t, []string{msgTripCircuitBreak}, track.MsgTypeUrls)
+	require.Equal(t, emergency.MinDeposit, track.MinDeposit)
+	require.Equal(t, time.Hour, *track.VotingPeriod)
+	require.Equal(t, "0.1", track.Quorum)
+	require.Equal(t, params.Threshold, track.Threshold)
+
+	track = params.ProposalTrack(v1.DefaultProposalTrackName)
+	require.Equal(t, v1.NewProposalTrack(v1.DefaultProposalTrackName, nil, params.MinDeposit, params.VotingPeriod, params.Quorum, params.Threshold), track)
+
+	// unknown tracks, e.g. removed since the proposal was submitted, fall back to the params
+	for _, name := range []string{"", "removed"} {
+		track = params.ProposalTrack(name)
+		require.Equal(t, v1.NewProposalTrack("", nil, params.MinDeposit, params.VotingPeriod, params.Quorum, params.Threshold), track)
+	}
+}
+
+func TestParams_ValidateProposalTracks(t *testing.T) {
+	testCases := []struct {
+		name   string
+		tracks []v1.ProposalTrack
+		expErr string
+	}{
+		{
+			name:   "default",
+			tracks: v1.DefaultProposalTracks(),
+		},
+		{
+			name: "no track",
+		},
+		{
+			name: "valid tracks",
+			tracks: []v1.ProposalTrack{
+				v1.NewProposalTrack(v1.DefaultProposalTrackName, nil, nil, nil, "", ""),
+				v1.NewProposalTrack("emergency", []string{msgTripCircuitBreak}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), durationPtr(time.Hour), "0", "1"),
+			},
+		},
+		{
+			name:   "blank name",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack(" ", nil, nil, nil, "", "")},
+			expErr: "proposal track name cannot be blank",
+		},
+		{
+			name: "duplicate name",
+			tracks: []v1.ProposalTrack{
+				v1.NewProposalTrack("text", []string{msgExecLegacyURL}, nil, nil, "", ""),
+				v1.NewProposalTrack("text", []string{msgSendURL}, nil, nil, "", ""),
+			},
+			expErr: "duplicate proposal track name: text",
+		},
+		{
+			name:   "blank msg type url",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", []string{""}, nil, nil, "", "")},
+			expErr: "msg type url cannot be blank",
+		},
+		{
+			name:   "duplicate msg type url",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", []string{msgExecLegacyURL, msgExecLegacyURL}, nil, nil, "", "")},
+			expErr: "duplicate msg type url",
+		},
+		{
+			name:   "invalid min deposit",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", nil, []sdk.Coin{{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}}, nil, "", "")},
+			expErr: "invalid minimum deposit",
+		},
+		{
+			name:   "zero voting period",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", nil, nil, durationPtr(0), "", "")},
+			expErr: "voting period must be positive",
+		},
+		{
+			name:   "invalid quorum",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", nil, nil, nil, "abc", "")},
+			expErr: "invalid quorum string",
+		},
+		{
+			name:   "quorum too large",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", nil, nil, nil, "1.1", "")},
+			expErr: "quorum too large",
+		},
+		{
+			name:   "zero threshold",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", nil, nil, nil, "", "0")},
+			expErr: "vote threshold must be positive",
+		},
+		{
+			name:   "threshold too large",
+			tracks: []v1.ProposalTrack{v1.NewProposalTrack("text", nil, nil, nil, "", "1.5")},
+			expErr: "vote threshold too large",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := trackParams(tc.tracks...).ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the minimum deposits of the tracks must be in the deposit denoms allowlist
+	params := trackParams(v1.NewProposalTrack("text", nil, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), nil, "", ""))
+	params.MinDepositDenomsAllowlist = []string{sdk.DefaultBondDenom}
+	require.ErrorContains(t, params.ValidateBasic(), "minimum deposit denom atom is not in the deposit denoms allowlist")
+}
@@ -779,6 +779,12 @@ type QueryDepositsRequest struct {
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_refunded returns the retained records of the refunded and burned
+	// deposits of a settled proposal in settled_deposits. As the deposits of a
+	// proposal are settled all at once, the pagination then applies to them.
+	//
+	// Since: cosmos-sdk 0.48
+	IncludeRefunded bool `protobuf:"varint,3,opt,name=include_refunded,json=includeRefunded,proto3" json:"include_refunded,omitempty"`
 }
 
 func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
@@ -828,12 +834,24 @@ func (m *QueryDepositsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryDepositsRequest) GetIncludeRefunded() bool {
+	if m != nil {
+		return m.IncludeRefunded
+	}
+	return false
+}
+
 // QueryDepositsResponse is the response type for the Query/Deposits RPC method.
 type QueryDepositsResponse struct {
 	// deposits defines the requested deposits.
 	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// settled_deposits defines the retained records of the refunded and burned
+	// deposits, when include_refunded is set.
+	//
+	// Since: cosmos-sdk 0.48
+	SettledDeposits []*SettledDeposit `protobuf:"bytes,3,rep,name=settled_deposits,json=settledDeposits,proto3" json:"settled_deposits,omitempty"`
 }
 
 func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
@@ -883,6 +901,13 @@ func (m *QueryDepositsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryDepositsResponse) GetSettledDeposits() []*SettledDeposit {
+	if m != nil {
+		return m.SettledDeposits
+	}
+	return nil
+}
+
 // QueryTallyResultRequest is the request type for the Query/Tally RPC method.
 type QueryTallyResultRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -975,6 +1000,222 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryValidatorGovParticipationRequest is the request type for the Query/ValidatorGovParticipation RPC method.
+type QueryValidatorGovParticipationRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// window defines the number of most recent proposals tallied while the validator was bonded
+	// to count over. If zero, all of them are counted.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryValidatorGovParticipationRequest) Reset()         { *m = QueryValidatorGovParticipationRequest{} }
+func (m *QueryValidatorGovParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorGovParticipationRequest) ProtoMessage()    {}
+func (*QueryValidatorGovParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorGovParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorGovParticipationRequest.Merge(m, src)
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorGovParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorGovParticipationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorGovParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorGovParticipationRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *QueryValidatorGovParticipationRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryValidatorGovParticipationResponse is the response type for the Query/ValidatorGovParticipation RPC method.
+type QueryValidatorGovParticipationResponse struct {
+	// tallied_proposals is the number of proposals of the window tallied while the validator was bonded.
+	TalliedProposals uint64 `protobuf:"varint,1,opt,name=tallied_proposals,json=talliedProposals,proto3" json:"tallied_proposals,omitempty"`
+	// non_voted_proposals is the number of proposals of the window the validator did not vote on.
+	NonVotedProposals uint64 `protobuf:"varint,2,opt,name=non_voted_proposals,json=nonVotedProposals,proto3" json:"non_voted_proposals,omitempty"`
+	// non_voted_proposal_ids are the ids of the proposals of the window the validator did not vote on,
+	// most recent first.
+	NonVotedProposalIds []uint64 `protobuf:"varint,3,rep,packed,name=non_voted_proposal_ids,json=nonVotedProposalIds,proto3" json:"non_voted_proposal_ids,omitempty"`
+}
+
+func (m *QueryValidatorGovParticipationResponse) Reset() {
+	*m = QueryValidatorGovParticipationResponse{}
+}
+func (m *QueryValidatorGovParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorGovParticipationResponse) ProtoMessage()    {}
+func (*QueryValidatorGovParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorGovParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorGovParticipationResponse.Merge(m, src)
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorGovParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorGovParticipationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorGovParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorGovParticipationResponse) GetTalliedProposals() uint64 {
+	if m != nil {
+		return m.TalliedProposals
+	}
+	return 0
+}
+
+func (m *QueryValidatorGovParticipationResponse) GetNonVotedProposals() uint64 {
+	if m != nil {
+		return m.NonVotedProposals
+	}
+	return 0
+}
+
+func (m *QueryValidatorGovParticipationResponse) GetNonVotedProposalIds() []uint64 {
+	if m != nil {
+		return m.NonVotedProposalIds
+	}
+	return nil
+}
+
+// QueryProposalTrackRequest is the request type for the Query/ProposalTrack RPC method.
+type QueryProposalTrackRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalTrackRequest) Reset()         { *m = QueryProposalTrackRequest{} }
+func (m *QueryProposalTrackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTrackRequest) ProtoMessage()    {}
+func (*QueryProposalTrackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{20}
+}
+func (m *QueryProposalTrackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTrackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTrackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTrackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTrackRequest.Merge(m, src)
+}
+func (m *QueryProposalTrackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTrackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTrackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTrackRequest proto.InternalMessageInfo
+
+func (m *QueryProposalTrackRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalTrackResponse is the response type for the Query/ProposalTrack RPC method.
+type QueryProposalTrackResponse struct {
+	// track is the track of the proposal, its unset parameters being set to the ones of the
+	// params. Its name is empty when the proposal is governed by the params themselves.
+	Track *ProposalTrack `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
+}
+
+func (m *QueryProposalTrackResponse) Reset()         { *m = QueryProposalTrackResponse{} }
+func (m *QueryProposalTrackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTrackResponse) ProtoMessage()    {}
+func (*QueryProposalTrackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{21}
+}
+func (m *QueryProposalTrackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTrackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTrackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTrackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTrackResponse.Merge(m, src)
+}
+func (m *QueryProposalTrackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTrackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTrackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTrackResponse proto.InternalMessageInfo
+
+func (m *QueryProposalTrackResponse) GetTrack() *ProposalTrack {
+	if m != nil {
+		return m.Track
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -994,76 +1235,98 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryValidatorGovParticipationRequest)(nil), "cosmos.gov.v1.QueryValidatorGovParticipationRequest")
+	proto.RegisterType((*QueryValidatorGovParticipationResponse)(nil), "cosmos.gov.v1.QueryValidatorGovParticipationResponse")
+	proto.RegisterType((*QueryProposalTrackRequest)(nil), "cosmos.gov.v1.QueryProposalTrackRequest")
+	proto.RegisterType((*QueryProposalTrackResponse)(nil), "cosmos.gov.v1.QueryProposalTrackResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x97, 0x66, 0x4f, 0x2e, 0xc0, 0xe9, 0x25, 0xae, 0xdb, 0x6e, 0x83, 0x43, 0x93,
-	0x40, 0x89, 0xcd, 0xa6, 0x37, 0x09, 0x8a, 0x50, 0xd3, 0x92, 0x82, 0xc4, 0x43, 0x70, 0x2b, 0x1e,
-	0x78, 0x89, 0x9c, 0xac, 0x65, 0x2c, 0x36, 0x1e, 0x77, 0x67, 0x76, 0x45, 0x48, 0x23, 0xa4, 0x4a,
-	0x5c, 0x9e, 0x00, 0x89, 0x0a, 0xf8, 0x21, 0xfc, 0x08, 0x1e, 0x2b, 0x78, 0xe1, 0x11, 0x25, 0xfc,
-	0x10, 0xe4, 0x99, 0x63, 0xaf, 0xed, 0x78, 0x6f, 0x55, 0xd5, 0xa7, 0x95, 0x67, 0xbe, 0xf3, 0x9d,
-	0xef, 0x9c, 0x39, 0x73, 0xce, 0x2c, 0x9c, 0xdf, 0x65, 0x7c, 0x8f, 0x71, 0xdb, 0x67, 0x1d, 0xbb,
-	0x53, 0xb7, 0x1f, 0xb5, 0xbd, 0xd6, 0xbe, 0x15, 0xb5, 0x98, 0x60, 0x38, 0xa7, 0xb6, 0x2c, 0x9f,
-	0x75, 0xac, 0x4e, 0xdd, 0x78, 0x8b, 0x90, 0x3b, 0x2e, 0xf7, 0x14, 0xce, 0xee, 0xd4, 0x77, 0x3c,
-	0xe1, 0xd6, 0xed, 0xc8, 0xf5, 0x83, 0xd0, 0x15, 0x01, 0x0b, 0x95, 0xa9, 0x71, 0xd1, 0x67, 0xcc,
-	0x6f, 0x7a, 0xb6, 0x1b, 0x05, 0xb6, 0x1b, 0x86, 0x4c, 0xc8, 0x4d, 0x4e, 0xbb, 0x0b, 0x79, 0x9f,
-	0x31, 0xbf, 0xda, 0x20, 0x31, 0xdb, 0xf2, 0xcb, 0x26, 0xf7, 0xf2, 0xc3, 0x34, 0x40, 0xff, 0x34,
-	0xf6, 0x79, 0x97, 0x85, 0x5c, 0x04, 0xa2, 0x1d, 0xf3, 0x39, 0xde, 0xa3, 0xb6, 0xc7, 0x85, 0xf9,
-	0x01, 0x9c, 0x2f, 0xd9, 0xe3, 0x11, 0x0b, 0xb9, 0x87, 0x26, 0xcc, 0xee, 0x66, 0xd6, 0x75, 0x6d,
-	0x51, 0x5b, 0xad, 0x3a, 0xb9, 0x35, 0xf3, 0x16, 0x9c, 0x91, 0x04, 0x5b, 0x2d, 0x16, 0x31, 0xee,
-	0x36, 0x89, 0x18, 0x2f, 0xc3, 0x4c, 0x44, 0x4b, 0xdb, 0x41, 0x43, 0x9a, 0x4e, 0x38, 0x90, 0x2c,
-	0x7d, 0xdc, 0x30, 0x3f, 0x81, 0xb3, 0x05, 0x43, 0xf2, 0x7a, 0x0d, 0xa6, 0x13, 0x98, 0x34, 0x9b,
-	0x59, 0x5f, 0xb0, 0x72, 0xe9, 0xb4, 0x52, 0x93, 0x14, 0x68, 0xfe, 0x54, 0x29, 0xd0, 0xf1, 0x44,
-	0xc8, 0x26, 0xbc, 0x92, 0x0a, 0xe1, 0xc2, 0x15, 0x6d, 0x2e, 0x59, 0xe7, 0xd7, 0x2f, 0xf5, 0x60,
-	0x7d, 0x20, 0x41, 0xce, 0x7c, 0x94, 0xfb, 0x46, 0x0b, 0x26, 0x3b, 0x4c, 0x78, 0x2d, 0xbd, 0x12,
-	0x67, 0x61, 0x43, 0xff, 0xeb, 0x8f, 0xb5, 0x33, 0x44, 0x70, 0xa7, 0xd1, 0x68, 0x79, 0x9c, 0x3f,
-	0x10, 0xad, 0x20, 0xf4, 0x1d, 0x05, 0xc3, 0x9b, 0x50, 0x6d, 0x78, 0x11, 0xe3, 0x81, 0x60, 0x2d,
-	0x7d, 0x7c, 0x80, 0x4d, 0x17, 0x8a, 0x9b, 0x00, 0xdd, 0x9a, 0xd0, 0x27, 0x64, 0x02, 0x96, 0x13,
-	0xa9, 0x71, 0x01, 0x59, 0xaa, 0xd0, 0xa8, 0x80, 0xac, 0x2d, 0xd7, 0xf7, 0x28, 0x56, 0x27, 0x63,
-	0x69, 0xfe, 0xae, 0xc1, 0xb9, 0x62, 0x46, 0x28, 0xc3, 0x37, 0xa0, 0x9a, 0x04, 0x17, 0x27, 0x63,
-	0xbc, 0x5f, 0x8a, 0xbb, 0x48, 0xbc, 0x9f, 0x53, 0x56, 0x91, 0xca, 0x56, 0x06, 0x2a, 0x53, 0x3e,
-	0x73, 0xd2, 0x76, 0xe1, 0x55, 0xa9, 0xec, 0x33, 0x26, 0xbc, 0x61, 0xeb, 0x65, 0xd4, 0xfc, 0x9b,
-	0xb7, 0xe1, 0xb5, 0x8c, 0x13, 0x8a, 0x7c, 0x05, 0x26, 0xe2, 0x5d, 0xaa, 0xab, 0xd3, 0x85, 0xa0,
-	0x25, 0x54, 0x02, 0xcc, 0xc7, 0x19, 0x6b, 0x3e, 0xb4, 0xc6, 0xcd, 0x92, 0x0c, 0x3d, 0xcf, 0xd9,
-	0xfd, 0xa0, 0x01, 0x66, 0xdd, 0x93, 0xfa, 0x37, 0x55, 0x0a, 0x92, 0x33, 0x2b, 0x95, 0xaf, 0x10,
-	0x2f, 0xee, 0xac, 0x6e, 0x90, 0x92, 0x2d, 0xb7, 0xe5, 0xee, 0xe5, 0x32, 0x21, 0x17, 0xb6, 0xc5,
-	0x7e, 0xe4, 0x51, 0x63, 0x00, 0xb5, 0xf4, 0x70, 0x3f, 0xf2, 0xcc, 0x5f, 0x2b, 0x70, 0x3a, 0x67,
-	0x47, 0x21, 0xdc, 0x83, 0xb9, 0x0e, 0x13, 0x41, 0xe8, 0x6f, 0x2b, 0x30, 0x9d, 0xc4, 0x85, 0x93,
-	0xa1, 0x04, 0xa1, 0xaf, 0x6c, 0x37, 0x2a, 0xba, 0xe6, 0xcc, 0x76, 0x32, 0x2b, 0x78, 0x1f, 0xe6,
-	0xe9, 0xc2, 0x24, 0x34, 0x2a, 0xc2, 0x8b, 0x05, 0x9a, 0x7b, 0x0a, 0x94, 0xe1, 0x99, 0x6b, 0x64,
-	0x97, 0xf0, 0x0e, 0xcc, 0x0a, 0xb7, 0xd9, 0xdc, 0x4f, 0x68, 0xc6, 0x25, 0x8d, 0x51, 0xa0, 0x79,
-	0x18, 0x43, 0x32, 0x24, 0x33, 0xa2, 0xbb, 0x80, 0x6b, 0x30, 0x45, 0xc6, 0xea, 0xae, 0x9e, 0x2d,
-	0xde, 0x24, 0x95, 0x00, 0x02, 0x99, 0x21, 0xe5, 0x85, 0xa4, 0x0d, 0x5d, 0x5a, 0xb9, 0x76, 0x52,
-	0x19, 0xba, 0x9d, 0x98, 0x1f, 0x51, 0x7f, 0x4e, 0xfd, 0xd1, 0x41, 0xbc, 0x03, 0xa7, 0x08, 0x44,
-	0x47, 0x70, 0xae, 0x3c, 0x77, 0x4e, 0x02, 0x33, 0xbf, 0xc9, 0x33, 0xbd, 0xfc, 0x5b, 0xf1, 0x54,
-	0xa3, 0x1e, 0xdf, 0x55, 0x40, 0xc1, 0xac, 0xc3, 0x34, 0xa9, 0x4c, 0xee, 0x46, 0xaf, 0x68, 0x52,
-	0xdc, 0x8b, 0xbb, 0x21, 0xef, 0xc2, 0x82, 0x54, 0x25, 0xab, 0xc4, 0xf1, 0x78, 0xbb, 0x29, 0x46,
-	0x18, 0x82, 0xfa, 0x49, 0xdb, 0xf4, 0x84, 0x26, 0x65, 0x9d, 0xd1, 0xf9, 0x94, 0x16, 0x25, 0x99,
-	0x28, 0xe0, 0xfa, 0x71, 0x15, 0x26, 0x25, 0x1d, 0x7e, 0xa7, 0xc1, 0x6c, 0x76, 0xa4, 0xe3, 0x4a,
-	0xc1, 0xba, 0xd7, 0x83, 0xc0, 0x58, 0x1d, 0x0c, 0x54, 0xfa, 0xcc, 0xa5, 0x27, 0x7f, 0xff, 0xf7,
-	0x4b, 0xe5, 0x12, 0x5e, 0xb0, 0xf3, 0x6f, 0x92, 0xec, 0xf3, 0x00, 0xbf, 0xd5, 0x60, 0x3a, 0x99,
-	0x25, 0xb8, 0x54, 0xc6, 0x5d, 0x78, 0x38, 0x18, 0x6f, 0xf4, 0x07, 0x91, 0x73, 0x4b, 0x3a, 0x5f,
-	0xc5, 0xe5, 0x82, 0xf3, 0x74, 0x5a, 0xd9, 0x07, 0x99, 0xcc, 0x1f, 0xe2, 0xd7, 0x50, 0x4d, 0xe7,
-	0x20, 0xf6, 0x75, 0x91, 0xd4, 0xb5, 0x71, 0x65, 0x00, 0x8a, 0x94, 0x2c, 0x4a, 0x25, 0x06, 0xea,
-	0xbd, 0x94, 0xe0, 0xf7, 0x1a, 0x4c, 0xc4, 0xbd, 0x19, 0x2f, 0x97, 0x31, 0x66, 0x86, 0xa0, 0xb1,
-	0xd8, 0x1b, 0x40, 0xde, 0x6e, 0x4b, 0x6f, 0x37, 0xf1, 0xfa, 0x70, 0x71, 0xdb, 0x72, 0x1a, 0xd8,
-	0x07, 0x72, 0x24, 0x1e, 0xe2, 0x13, 0x0d, 0x26, 0xe5, 0x48, 0xc1, 0x9e, 0x9e, 0xd2, 0xf0, 0x5f,
-	0xef, 0x83, 0x20, 0x31, 0xd7, 0xa5, 0x18, 0x0b, 0xdf, 0x1e, 0x45, 0x0c, 0x3e, 0x86, 0x29, 0x6a,
-	0x9d, 0xa5, 0x2e, 0x72, 0x83, 0xc6, 0x30, 0xfb, 0x41, 0x48, 0xc6, 0x55, 0x29, 0xe3, 0x0a, 0x2e,
-	0x15, 0x65, 0x48, 0x98, 0x7d, 0x90, 0x99, 0x54, 0x87, 0xf8, 0x9b, 0x06, 0xa7, 0xa8, 0x19, 0x60,
-	0x29, 0x79, 0xbe, 0x31, 0x1b, 0x4b, 0x7d, 0x31, 0xa4, 0xe0, 0xae, 0x54, 0xf0, 0x3e, 0xbe, 0x37,
-	0x64, 0x22, 0x92, 0x26, 0x64, 0x1f, 0xa4, 0x8d, 0xfa, 0x10, 0x7f, 0xd4, 0x60, 0x3a, 0xe9, 0x6c,
-	0xd8, 0xcf, 0x2d, 0xef, 0x7b, 0x55, 0x8a, 0xcd, 0xd1, 0xbc, 0x25, 0xc5, 0xd5, 0xd1, 0x1e, 0x51,
-	0x1c, 0x3e, 0xd5, 0x60, 0x26, 0xd3, 0x65, 0x70, 0xb9, 0xcc, 0xdd, 0xc9, 0xae, 0x67, 0xac, 0x0c,
-	0xc4, 0x3d, 0x67, 0xfd, 0xc8, 0x2e, 0xb7, 0xf1, 0xe1, 0x9f, 0x47, 0x35, 0xed, 0xd9, 0x51, 0x4d,
-	0xfb, 0xf7, 0xa8, 0xa6, 0xfd, 0x7c, 0x5c, 0x1b, 0x7b, 0x76, 0x5c, 0x1b, 0xfb, 0xe7, 0xb8, 0x36,
-	0xf6, 0xf9, 0x55, 0x3f, 0x10, 0x5f, 0xb4, 0x77, 0xac, 0x5d, 0xb6, 0x97, 0x30, 0xaa, 0x9f, 0x35,
-	0xde, 0xf8, 0xd2, 0xfe, 0x4a, 0xd2, 0xc7, 0x55, 0xc0, 0xe3, 0x7f, 0x5f, 0x53, 0xf2, 0xcf, 0xd1,
-	0xb5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x34, 0xfb, 0x7f, 0x51, 0xc6, 0x0d, 0x00, 0x00,
+	// 1300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5b, 0x6f, 0xdc, 0x44,
+	0x14, 0xee, 0x6c, 0x36, 0x69, 0x72, 0x72, 0x69, 0x3a, 0x69, 0x93, 0x8d, 0xdb, 0x6c, 0x53, 0x87,
+	0xdc, 0x08, 0xb1, 0xc9, 0xad, 0x45, 0x25, 0x08, 0x9a, 0x96, 0x24, 0x95, 0x78, 0x08, 0x4e, 0xc4,
+	0x03, 0x2f, 0x2b, 0x67, 0x6d, 0x16, 0xab, 0x1b, 0x8f, 0xeb, 0x99, 0xdd, 0x12, 0xd2, 0xbc, 0x54,
+	0xe2, 0xf6, 0x02, 0x48, 0x20, 0x40, 0xfc, 0x04, 0x9e, 0xfb, 0xc4, 0x2f, 0x40, 0x48, 0x48, 0x55,
+	0x79, 0xe1, 0x09, 0xa1, 0x84, 0x1f, 0x82, 0x3c, 0x33, 0x76, 0x6c, 0xc7, 0x7b, 0x49, 0x54, 0xf1,
+	0xb4, 0xf2, 0x99, 0xef, 0x7c, 0xe7, 0x3b, 0x67, 0xce, 0xcc, 0x19, 0x2d, 0x8c, 0x96, 0x09, 0xdd,
+	0x23, 0x54, 0xaf, 0x90, 0xba, 0x5e, 0x5f, 0xd0, 0x1f, 0xd5, 0x6c, 0x7f, 0x5f, 0xf3, 0x7c, 0xc2,
+	0x08, 0xee, 0x17, 0x4b, 0x5a, 0x85, 0xd4, 0xb5, 0xfa, 0x82, 0xf2, 0xaa, 0x44, 0xee, 0x9a, 0xd4,
+	0x16, 0x38, 0xbd, 0xbe, 0xb0, 0x6b, 0x33, 0x73, 0x41, 0xf7, 0xcc, 0x8a, 0xe3, 0x9a, 0xcc, 0x21,
+	0xae, 0x70, 0x55, 0xae, 0x57, 0x08, 0xa9, 0x54, 0x6d, 0xdd, 0xf4, 0x1c, 0xdd, 0x74, 0x5d, 0xc2,
+	0xf8, 0x22, 0x95, 0xab, 0x23, 0xc9, 0x98, 0x01, 0xbf, 0x58, 0x90, 0x62, 0x4a, 0xfc, 0x4b, 0x97,
+	0xe1, 0xf9, 0x87, 0xaa, 0x40, 0xe1, 0xfd, 0x20, 0xe6, 0x3d, 0xe2, 0x52, 0xe6, 0xb0, 0x5a, 0xc0,
+	0x67, 0xd8, 0x8f, 0x6a, 0x36, 0x65, 0xea, 0xdb, 0x30, 0x9a, 0xb1, 0x46, 0x3d, 0xe2, 0x52, 0x1b,
+	0xab, 0xd0, 0x57, 0x8e, 0xd9, 0x0b, 0x68, 0x1c, 0xcd, 0xf4, 0x18, 0x09, 0x9b, 0x7a, 0x1b, 0xae,
+	0x70, 0x82, 0x2d, 0x9f, 0x78, 0x84, 0x9a, 0x55, 0x49, 0x8c, 0x6f, 0x40, 0xaf, 0x27, 0x4d, 0x25,
+	0xc7, 0xe2, 0xae, 0x79, 0x03, 0x42, 0xd3, 0x03, 0x4b, 0x7d, 0x0f, 0xae, 0xa6, 0x1c, 0x65, 0xd4,
+	0x25, 0xe8, 0x0e, 0x61, 0xdc, 0xad, 0x77, 0x71, 0x44, 0x4b, 0x94, 0x53, 0x8b, 0x5c, 0x22, 0xa0,
+	0xfa, 0x4d, 0x2e, 0x45, 0x47, 0x43, 0x21, 0xeb, 0x70, 0x29, 0x12, 0x42, 0x99, 0xc9, 0x6a, 0x94,
+	0xb3, 0x0e, 0x2c, 0x8e, 0x35, 0x60, 0xdd, 0xe6, 0x20, 0x63, 0xc0, 0x4b, 0x7c, 0x63, 0x0d, 0x3a,
+	0xeb, 0x84, 0xd9, 0x7e, 0x21, 0x17, 0x54, 0x61, 0xad, 0xf0, 0xe2, 0xd9, 0xfc, 0x15, 0x49, 0x70,
+	0xd7, 0xb2, 0x7c, 0x9b, 0xd2, 0x6d, 0xe6, 0x3b, 0x6e, 0xc5, 0x10, 0x30, 0x7c, 0x0b, 0x7a, 0x2c,
+	0xdb, 0x23, 0xd4, 0x61, 0xc4, 0x2f, 0x74, 0xb4, 0xf0, 0x39, 0x81, 0xe2, 0x75, 0x80, 0x93, 0x9e,
+	0x28, 0xe4, 0x79, 0x01, 0xa6, 0x42, 0xa9, 0x41, 0x03, 0x69, 0xa2, 0xd1, 0x64, 0x03, 0x69, 0x5b,
+	0x66, 0xc5, 0x96, 0xb9, 0x1a, 0x31, 0x4f, 0xf5, 0x27, 0x04, 0xc3, 0xe9, 0x8a, 0xc8, 0x0a, 0xaf,
+	0x40, 0x4f, 0x98, 0x5c, 0x50, 0x8c, 0x8e, 0x66, 0x25, 0x3e, 0x41, 0xe2, 0x8d, 0x84, 0xb2, 0x1c,
+	0x57, 0x36, 0xdd, 0x52, 0x99, 0x88, 0x99, 0x90, 0x56, 0x86, 0x41, 0xae, 0xec, 0x03, 0xc2, 0xec,
+	0x76, 0xfb, 0xe5, 0xac, 0xf5, 0x57, 0x57, 0xe1, 0x72, 0x2c, 0x88, 0xcc, 0x7c, 0x1a, 0xf2, 0xc1,
+	0xaa, 0xec, 0xab, 0xa1, 0x54, 0xd2, 0x1c, 0xca, 0x01, 0xea, 0x93, 0x98, 0x37, 0x6d, 0x5b, 0xe3,
+	0x7a, 0x46, 0x85, 0xce, 0xb3, 0x77, 0x5f, 0x22, 0xc0, 0xf1, 0xf0, 0x52, 0xfd, 0xac, 0x28, 0x41,
+	0xb8, 0x67, 0x99, 0xf2, 0x05, 0xe2, 0xe5, 0xed, 0xd5, 0x8a, 0x54, 0xb2, 0x65, 0xfa, 0xe6, 0x5e,
+	0xa2, 0x12, 0xdc, 0x50, 0x62, 0xfb, 0x9e, 0x2d, 0x2f, 0x06, 0x10, 0xa6, 0x9d, 0x7d, 0xcf, 0x56,
+	0x7f, 0xc8, 0xc1, 0x50, 0xc2, 0x4f, 0xa6, 0x70, 0x1f, 0xfa, 0xeb, 0x84, 0x39, 0x6e, 0xa5, 0x24,
+	0xc0, 0x72, 0x27, 0xae, 0x9d, 0x4e, 0xc5, 0x71, 0x2b, 0xc2, 0x77, 0x2d, 0x57, 0x40, 0x46, 0x5f,
+	0x3d, 0x66, 0xc1, 0x1b, 0x30, 0x20, 0x0f, 0x4c, 0x48, 0x23, 0x32, 0xbc, 0x9e, 0xa2, 0xb9, 0x2f,
+	0x40, 0x31, 0x9e, 0x7e, 0x2b, 0x6e, 0xc2, 0x77, 0xa1, 0x8f, 0x99, 0xd5, 0xea, 0x7e, 0x48, 0xd3,
+	0xc1, 0x69, 0x94, 0x14, 0xcd, 0x4e, 0x00, 0x89, 0x91, 0xf4, 0xb2, 0x13, 0x03, 0x9e, 0x87, 0x2e,
+	0xe9, 0x2c, 0xce, 0xea, 0xd5, 0xf4, 0x49, 0x12, 0x05, 0x90, 0x20, 0xd5, 0x95, 0x75, 0x91, 0xd2,
+	0xda, 0x6e, 0xad, 0xc4, 0x75, 0x92, 0x6b, 0xfb, 0x3a, 0x51, 0x37, 0xe1, 0x4a, 0x32, 0x9e, 0xdc,
+	0x88, 0xd7, 0xe1, 0xa2, 0x04, 0xc9, 0x2d, 0x18, 0xce, 0xae, 0x9d, 0x11, 0xc2, 0xd4, 0x5f, 0x50,
+	0x92, 0xea, 0x7f, 0x3f, 0x16, 0x78, 0x16, 0x06, 0x1d, 0xb7, 0x5c, 0xad, 0x59, 0x76, 0xc9, 0xb7,
+	0x3f, 0xaa, 0xb9, 0x96, 0x6d, 0xf1, 0x1d, 0xeb, 0x36, 0x2e, 0x49, 0xbb, 0x21, 0xcd, 0xea, 0xdf,
+	0x08, 0xae, 0xa6, 0xc4, 0xca, 0xc4, 0x17, 0xa1, 0x5b, 0x66, 0x14, 0x9e, 0xa3, 0x46, 0x99, 0x47,
+	0xb8, 0x97, 0x76, 0x9a, 0xf0, 0x26, 0x0c, 0x52, 0x9b, 0xb1, 0xaa, 0x6d, 0x95, 0x22, 0x11, 0x1d,
+	0x5c, 0x44, 0x7a, 0x1a, 0x6d, 0x0b, 0x58, 0xa8, 0xe5, 0x12, 0x4d, 0x7c, 0x53, 0xf5, 0x0e, 0x8c,
+	0xf0, 0xfc, 0x78, 0x6f, 0x1a, 0x36, 0xad, 0x55, 0xd9, 0x19, 0x46, 0x6f, 0xe1, 0xb4, 0x6f, 0xd4,
+	0x17, 0x9d, 0xbc, 0xbb, 0x0b, 0xa8, 0xf1, 0x51, 0x90, 0x2e, 0x02, 0xa8, 0x7e, 0x85, 0x60, 0x52,
+	0x5c, 0x56, 0x66, 0xd5, 0xb1, 0x4c, 0x46, 0xfc, 0x0d, 0x52, 0xdf, 0x32, 0x7d, 0xe6, 0x94, 0x1d,
+	0xcf, 0x8c, 0x3d, 0x36, 0xf0, 0x26, 0x0c, 0xd4, 0x43, 0x4c, 0xc9, 0xb4, 0x2c, 0x5f, 0x5c, 0x1c,
+	0x6b, 0x37, 0x5f, 0x3c, 0x9b, 0x1f, 0x93, 0x71, 0x22, 0x92, 0x64, 0x47, 0xf7, 0xd7, 0xe3, 0x76,
+	0x3c, 0x0c, 0x5d, 0x8f, 0x1d, 0xd7, 0x22, 0x8f, 0xf9, 0x66, 0xe4, 0x0d, 0xf9, 0xa5, 0xfe, 0x8a,
+	0x60, 0xaa, 0x95, 0x16, 0x99, 0xe8, 0x1c, 0x5c, 0x0e, 0xf4, 0x3b, 0xb6, 0x55, 0x8a, 0x0f, 0xc3,
+	0x80, 0x6d, 0x50, 0x2e, 0x44, 0x93, 0x13, 0x6b, 0x30, 0xe4, 0x12, 0xb7, 0x14, 0xdc, 0xad, 0x71,
+	0xb8, 0x08, 0x7e, 0xd9, 0x25, 0x6e, 0x70, 0xf7, 0xc6, 0xf0, 0x4b, 0x30, 0x7c, 0x1a, 0x5f, 0x72,
+	0x2c, 0xb1, 0xdb, 0x79, 0x63, 0x28, 0xed, 0xf2, 0xc0, 0xa2, 0xea, 0xaa, 0x7c, 0x8b, 0x85, 0xb6,
+	0x1d, 0xdf, 0x2c, 0x3f, 0x6c, 0x7b, 0x53, 0xb7, 0x40, 0xc9, 0xf2, 0x8e, 0xba, 0xbe, 0x93, 0x05,
+	0x86, 0x02, 0xca, 0xbc, 0x28, 0x93, 0x4e, 0x02, 0xba, 0xf8, 0x47, 0x1f, 0x74, 0x72, 0x4a, 0xfc,
+	0x39, 0x82, 0xbe, 0xf8, 0x0b, 0x11, 0x4f, 0xa7, 0xfc, 0x1b, 0xbd, 0x2f, 0x95, 0x99, 0xd6, 0x40,
+	0xa1, 0x50, 0x9d, 0x78, 0xfa, 0xe7, 0xbf, 0xdf, 0xe5, 0xc6, 0xf0, 0x35, 0x3d, 0xf9, 0xc4, 0x8d,
+	0xbf, 0x36, 0xf1, 0x67, 0x08, 0xba, 0x43, 0xad, 0x78, 0x22, 0x8b, 0x3b, 0xf5, 0x0e, 0x55, 0x5e,
+	0x69, 0x0e, 0x92, 0xc1, 0x35, 0x1e, 0x7c, 0x06, 0x4f, 0xa5, 0x82, 0x47, 0x5b, 0xad, 0x1f, 0xc4,
+	0xaa, 0x7f, 0x88, 0x3f, 0x85, 0x9e, 0x93, 0xcd, 0x6e, 0x1a, 0x22, 0xbc, 0x25, 0x95, 0xc9, 0x16,
+	0x28, 0xa9, 0x64, 0x9c, 0x2b, 0x51, 0x70, 0xa1, 0x91, 0x12, 0xfc, 0x05, 0x82, 0x7c, 0xd0, 0x3b,
+	0xf8, 0x46, 0x16, 0x63, 0xec, 0x4d, 0xa5, 0x8c, 0x37, 0x06, 0xc8, 0x68, 0xab, 0x3c, 0xda, 0x2d,
+	0xbc, 0xdc, 0x5e, 0xde, 0x3a, 0x7f, 0x5c, 0xe8, 0x07, 0xc1, 0x8f, 0x7f, 0x88, 0x9f, 0x22, 0xe8,
+	0x0c, 0xe8, 0x28, 0x6e, 0x18, 0x29, 0x4a, 0xff, 0x66, 0x13, 0x84, 0x14, 0xb3, 0xcc, 0xc5, 0x68,
+	0xf8, 0xb5, 0xb3, 0x88, 0xc1, 0x4f, 0xa0, 0x4b, 0x4e, 0xe2, 0xcc, 0x10, 0x89, 0x77, 0x8b, 0xa2,
+	0x36, 0x83, 0x48, 0x19, 0x73, 0x5c, 0xc6, 0x24, 0x9e, 0x48, 0xcb, 0xe0, 0x30, 0xfd, 0x20, 0xf6,
+	0xf0, 0x39, 0xc4, 0x3f, 0x22, 0xb8, 0x28, 0xef, 0x64, 0x9c, 0x49, 0x9e, 0x9c, 0xf3, 0xca, 0x44,
+	0x53, 0x8c, 0x54, 0x70, 0x8f, 0x2b, 0x78, 0x0b, 0xbf, 0xd9, 0x66, 0x21, 0xc2, 0x51, 0xa2, 0x1f,
+	0x44, 0x73, 0xff, 0x10, 0x7f, 0x8d, 0xa0, 0x5b, 0x12, 0x53, 0xdc, 0x2c, 0x2c, 0x6d, 0x7a, 0x54,
+	0xd2, 0xf3, 0x53, 0xbd, 0xcd, 0xc5, 0x2d, 0x60, 0xfd, 0x8c, 0xe2, 0xf0, 0xf7, 0x08, 0x7a, 0x63,
+	0xe3, 0x03, 0x4f, 0x65, 0x85, 0x3b, 0x3d, 0xce, 0x94, 0xe9, 0x96, 0xb8, 0x73, 0xf6, 0x0f, 0x1f,
+	0x5f, 0xf8, 0x77, 0x04, 0xa3, 0x0d, 0xa7, 0x05, 0x5e, 0xce, 0x6c, 0xdb, 0x16, 0x83, 0x4e, 0x59,
+	0x39, 0xa3, 0x97, 0x4c, 0xe0, 0x1d, 0x9e, 0xc0, 0x1d, 0xfc, 0x46, 0x2a, 0x81, 0x68, 0xf6, 0x05,
+	0x47, 0x2f, 0x31, 0x40, 0x0f, 0x75, 0x2f, 0xce, 0x84, 0x7f, 0x46, 0xd0, 0x9f, 0xb8, 0xcb, 0xf1,
+	0x4c, 0xb3, 0x6b, 0x27, 0x3e, 0x61, 0x94, 0xd9, 0x36, 0x90, 0xe7, 0xad, 0x74, 0xe0, 0xbd, 0xf6,
+	0xee, 0x6f, 0x47, 0x45, 0xf4, 0xfc, 0xa8, 0x88, 0xfe, 0x39, 0x2a, 0xa2, 0x6f, 0x8f, 0x8b, 0x17,
+	0x9e, 0x1f, 0x17, 0x2f, 0xfc, 0x75, 0x5c, 0xbc, 0xf0, 0xe1, 0x5c, 0xc5, 0x61, 0x1f, 0xd7, 0x76,
+	0xb5, 0x32, 0xd9, 0x0b, 0x19, 0xc5, 0xcf, 0x3c, 0xb5, 0x1e, 0xea, 0x9f, 0x70, 0xfa, 0xe0, 0xbc,
+	0xd1, 0xe0, 0x6f, 0x93, 0x2e, 0xfe, 0xaf, 0xc6, 0xd2, 0x7f, 0x03, 0x00, 0x81, 0x4b, 0xbd, 0x38,
+	0x7f, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1096,6 +1359,14 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ValidatorGovParticipation queries the participation of a validator in the concluded proposals
+	// tallied while it was bonded.
+	ValidatorGovParticipation(ctx context.Context, in *QueryValidatorGovParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorGovParticipationResponse, error)
+	// ProposalTrack queries the proposal track resolved for a proposal, with the parameters which
+	// apply to it.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalTrack(ctx context.Context, in *QueryProposalTrackRequest, opts ...grpc.CallOption) (*QueryProposalTrackResponse, error)
 }
 
 type queryClient struct {
@@ -1187,6 +1458,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ValidatorGovParticipation(ctx context.Context, in *QueryValidatorGovParticipationRequest, opts ...grpc.CallOption) (*QueryValidatorGovParticipationResponse, error) {
+	out := new(QueryValidatorGovParticipationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ValidatorGovParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalTrack(ctx context.Context, in *QueryProposalTrackRequest, opts ...grpc.CallOption) (*QueryProposalTrackResponse, error) {
+	out := new(QueryProposalTrackResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1207,6 +1496,14 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ValidatorGovParticipation queries the participation of a validator in the concluded proposals
+	// tallied while it was bonded.
+	ValidatorGovParticipation(context.Context, *QueryValidatorGovParticipationRequest) (*QueryValidatorGovParticipationResponse, error)
+	// ProposalTrack queries the proposal track resolved for a proposal, with the parameters which
+	// apply to it.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalTrack(context.Context, *QueryProposalTrackRequest) (*QueryProposalTrackResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1240,6 +1537,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorGovParticipation(ctx context.Context, req *QueryValidatorGovParticipationRequest) (*QueryValidatorGovParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorGovParticipation not implemented")
+}
+func (*UnimplementedQueryServer) ProposalTrack(ctx context.Context, req *QueryProposalTrackRequest) (*QueryProposalTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTrack not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1407,6 +1710,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorGovParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorGovParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorGovParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ValidatorGovParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorGovParticipation(ctx, req.(*QueryValidatorGovParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTrack(ctx, req.(*QueryProposalTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1447,6 +1786,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ValidatorGovParticipation",
+			Handler:    _Query_ValidatorGovParticipation_Handler,
+		},
+		{
+			MethodName: "ProposalTrack",
+			Handler:    _Query_ProposalTrack_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2021,6 +2368,16 @@ func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeRefunded {
+		i--
+		if m.IncludeRefunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2061,6 +2418,20 @@ func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SettledDeposits) > 0 {
+		for iNdEx := len(m.SettledDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2153,47 +2524,196 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorGovParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryConstitutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryValidatorGovParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConstitutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorGovParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Constitution)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorGovParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorGovParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorGovParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if len(m.NonVotedProposalIds) > 0 {
+		dAtA16 := make([]byte, len(m.NonVotedProposalIds)*10)
+		var j15 int
+		for _, num := range m.NonVotedProposalIds {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintQuery(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NonVotedProposals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NonVotedProposals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TalliedProposals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TalliedProposals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTrackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTrackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTrackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTrackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTrackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTrackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Track != nil {
+		{
+			size, err := m.Track.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
 	}
 	return n
 }
@@ -2398,6 +2918,9 @@ func (m *QueryDepositsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.IncludeRefunded {
+		n += 2
+	}
 	return n
 }
 
@@ -2417,6 +2940,12 @@ func (m *QueryDepositsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.SettledDeposits) > 0 {
+		for _, e := range m.SettledDeposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2445,6 +2974,69 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorGovParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryValidatorGovParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TalliedProposals != 0 {
+		n += 1 + sovQuery(uint64(m.TalliedProposals))
+	}
+	if m.NonVotedProposals != 0 {
+		n += 1 + sovQuery(uint64(m.NonVotedProposals))
+	}
+	if len(m.NonVotedProposalIds) > 0 {
+		l = 0
+		for _, e := range m.NonVotedProposalIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryProposalTrackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalTrackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Track != nil {
+		l = m.Track.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3986,6 +4578,26 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRefunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRefunded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4106,6 +4718,40 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledDeposits = append(m.SettledDeposits, &SettledDeposit{})
+			if err := m.SettledDeposits[len(m.SettledDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4282,6 +4928,426 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorGovParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorGovParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorGovParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorGovParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorGovParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorGovParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TalliedProposals", wireType)
+			}
+			m.TalliedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TalliedProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVotedProposals", wireType)
+			}
+			m.NonVotedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonVotedProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NonVotedProposalIds = append(m.NonVotedProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NonVotedProposalIds) == 0 {
+					m.NonVotedProposalIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NonVotedProposalIds = append(m.NonVotedProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVotedProposalIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalTrackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTrackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTrackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalTrackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTrackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTrackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Track", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Track == nil {
+				m.Track = &ProposalTrack{}
+			}
+			if err := m.Track.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
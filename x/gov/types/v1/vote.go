@@ -1,7 +1,10 @@
 package v1
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"cosmossdk.io/math"
@@ -22,6 +25,51 @@ func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOption
 	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options, Metadata: metadata}
 }
 
+// VoteRationale is the well-known JSON schema of a vote metadata publishing
+// the rationale of the voter: the URI of the rationale document and its hex
+// encoded SHA-256 hash.
+type VoteRationale struct {
+	URI  string `json:"rationale_uri"`
+	Hash string `json:"rationale_hash"`
+}
+
+// ParseVoteRationale returns the rationale published in a vote metadata. A
+// metadata which is not a JSON object with a rationale_uri or rationale_hash
+// field is free-form and has no rationale. Otherwise both fields are required,
+// the URI must be absolute and the hash must be a hex encoded SHA-256 hash.
+func ParseVoteRationale(metadata string) (VoteRationale, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(metadata), &fields); err != nil {
+		return VoteRationale{}, nil
+	}
+
+	_, hasURI := fields["rationale_uri"]
+	_, hasHash := fields["rationale_hash"]
+	if !hasURI && !hasHash {
+		return VoteRationale{}, nil
+	}
+
+	var rationale VoteRationale
+	if err := json.Unmarshal([]byte(metadata), &rationale); err != nil {
+		return VoteRationale{}, err
+	}
+
+	uri, err := url.Parse(rationale.URI)
+	if err != nil {
+		return VoteRationale{}, fmt.Errorf("invalid rationale uri: %w", err)
+	}
+	if !uri.IsAbs() {
+		return VoteRationale{}, fmt.Errorf("rationale uri must be absolute: %q", rationale.URI)
+	}
+
+	hash, err := hex.DecodeString(rationale.Hash)
+	if err != nil || len(hash) != 32 {
+		return VoteRationale{}, fmt.Errorf("rationale hash must be a hex encoded SHA-256 hash: %q", rationale.Hash)
+	}
+
+	return rationale, nil
+}
+
 // Empty returns whether a vote is empty.
 func (v Vote) Empty() bool {
 	return v.ProposalId == 0 || v.Voter == "" || len(v.Options) == 0
@@ -0,0 +1,125 @@
+package group
+
+import (
+	context "context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &GroupVoteAuthorization{}
+
+// ProposalGroupResolver resolves the group a proposal was submitted to.
+type ProposalGroupResolver interface {
+	ProposalGroupID(ctx sdk.Context, proposalID uint64) (uint64, error)
+}
+
+type proposalGroupResolverKey struct{}
+
+// AuthzAcceptContextDecorator returns an authz accept context decorator giving
+// GroupVoteAuthorization access to the group of the proposals voted on.
+func AuthzAcceptContextDecorator(resolver ProposalGroupResolver) authz.AcceptContextDecorator {
+	return func(ctx sdk.Context) sdk.Context {
+		return ctx.WithValue(proposalGroupResolverKey{}, resolver)
+	}
+}
+
+// NewGroupVoteAuthorization creates a new GroupVoteAuthorization object.
+func NewGroupVoteAuthorization(groupIDs []uint64, options ...VoteOption) *GroupVoteAuthorization {
+	return &GroupVoteAuthorization{
+		GroupIds: groupIDs,
+		Options:  options,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a GroupVoteAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgVote{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a GroupVoteAuthorization) ValidateBasic() error {
+	if len(a.GroupIds) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "group ids cannot be empty")
+	}
+
+	groupIDs := make(map[uint64]bool, len(a.GroupIds))
+	for _, groupID := range a.GroupIds {
+		if groupID == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "group id cannot be 0")
+		}
+		if groupIDs[groupID] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate group id %d", groupID)
+		}
+		groupIDs[groupID] = true
+	}
+
+	options := make(map[VoteOption]bool, len(a.Options))
+	for _, option := range a.Options {
+		if option == VOTE_OPTION_UNSPECIFIED {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vote option cannot be unspecified")
+		}
+		if _, ok := VoteOption_name[int32(option)]; !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid vote option %d", option)
+		}
+		if options[option] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate vote option %s", option)
+		}
+		options[option] = true
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. It checks that the vote option is
+// allowed, and that the proposal voted on belongs to one of the groups of the
+// authorization, which requires the authz keeper to be decorated with
+// AuthzAcceptContextDecorator.
+func (a GroupVoteAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mVote, ok := msg.(*MsgVote)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.Options) > 0 && !a.allowsOption(mVote.Option) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("vote option %s is not allowed", mVote.Option)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	resolver, ok := sdkCtx.Value(proposalGroupResolverKey{}).(ProposalGroupResolver)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrLogic.Wrap("group vote authorization requires a proposal group resolver")
+	}
+
+	groupID, err := resolver.ProposalGroupID(sdkCtx, mVote.ProposalId)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if !a.allowsGroup(groupID) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote in group %d", groupID)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a GroupVoteAuthorization) allowsOption(option VoteOption) bool {
+	for _, o := range a.Options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func (a GroupVoteAuthorization) allowsGroup(groupID uint64) bool {
+	for _, id := range a.GroupIds {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package group_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+type proposalGroups map[uint64]uint64
+
+func (p proposalGroups) ProposalGroupID(_ sdk.Context, proposalID uint64) (uint64, error) {
+	groupID, ok := p[proposalID]
+	if !ok {
+		return 0, sdkerrors.ErrNotFound.Wrapf("proposal %d", proposalID)
+	}
+	return groupID, nil
+}
+
+func TestGroupVoteAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		authz  *group.GroupVoteAuthorization
+		expErr string
+	}{
+		{
+			"valid",
+			group.NewGroupVoteAuthorization([]uint64{1, 2}, group.VOTE_OPTION_YES, group.VOTE_OPTION_NO),
+			"",
+		},
+		{
+			"valid without options",
+			group.NewGroupVoteAuthorization([]uint64{1}),
+			"",
+		},
+		{
+			"no group ids",
+			group.NewGroupVoteAuthorization(nil),
+			"group ids cannot be empty",
+		},
+		{
+			"zero group id",
+			group.NewGroupVoteAuthorization([]uint64{1, 0}),
+			"group id cannot be 0",
+		},
+		{
+			"duplicate group id",
+			group.NewGroupVoteAuthorization([]uint64{1, 1}),
+			"duplicate group id 1",
+		},
+		{
+			"unspecified option",
+			group.NewGroupVoteAuthorization([]uint64{1}, group.VOTE_OPTION_UNSPECIFIED),
+			"vote option cannot be unspecified",
+		},
+		{
+			"invalid option",
+			group.NewGroupVoteAuthorization([]uint64{1}, group.VoteOption(100)),
+			"invalid vote option 100",
+		},
+		{
+			"duplicate option",
+			group.NewGroupVoteAuthorization([]uint64{1}, group.VOTE_OPTION_YES, group.VOTE_OPTION_YES),
+			"duplicate vote option VOTE_OPTION_YES",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authz.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGroupVoteAuthorizationAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(group.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	resolver := proposalGroups{1: 1, 2: 2, 3: 3}
+	decoratedCtx := group.AuthzAcceptContextDecorator(resolver)(ctx)
+
+	voter := sdk.AccAddress("voter").String()
+	authorization := group.NewGroupVoteAuthorization([]uint64{1, 2}, group.VOTE_OPTION_YES, group.VOTE_OPTION_ABSTAIN)
+	require.Equal(t, sdk.MsgTypeURL(&group.MsgVote{}), authorization.MsgTypeURL())
+
+	testCases := []struct {
+		name   string
+		ctx    sdk.Context
+		authz  *group.GroupVoteAuthorization
+		msg    sdk.Msg
+		expErr error
+	}{
+		{
+			"allowed group and option",
+			decoratedCtx,
+			authorization,
+			&group.MsgVote{ProposalId: 2, Voter: voter, Option: group.VOTE_OPTION_ABSTAIN},
+			nil,
+		},
+		{
+			"all options allowed",
+			decoratedCtx,
+			group.NewGroupVoteAuthorization([]uint64{1}),
+			&group.MsgVote{ProposalId: 1, Voter: voter, Option: group.VOTE_OPTION_NO_WITH_VETO},
+			nil,
+		},
+		{
+			"out of scope group",
+			decoratedCtx,
+			authorization,
+			&group.MsgVote{ProposalId: 3, Voter: voter, Option: group.VOTE_OPTION_YES},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"option not allowed",
+			decoratedCtx,
+			authorization,
+			&group.MsgVote{ProposalId: 1, Voter: voter, Option: group.VOTE_OPTION_NO},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"unknown proposal",
+			decoratedCtx,
+			authorization,
+			&group.MsgVote{ProposalId: 4, Voter: voter, Option: group.VOTE_OPTION_YES},
+			sdkerrors.ErrNotFound,
+		},
+		{
+			"no proposal group resolver",
+			ctx,
+			authorization,
+			&group.MsgVote{ProposalId: 1, Voter: voter, Option: group.VOTE_OPTION_YES},
+			sdkerrors.ErrLogic,
+		},
+		{
+			"wrong message type",
+			decoratedCtx,
+			authorization,
+			&banktypes.MsgSend{},
+			sdkerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := tc.authz.Accept(tc.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			require.Nil(t, resp.Updated)
+		})
+	}
+}
@@ -34,7 +34,9 @@ func QueryCmd(name string) *cobra.Command {
 		QueryVotesByVoterCmd(),
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
+		QueryProposalTallyContextCmd(),
 		QueryGroupsCmd(),
+		QueryGroupPolicyTreasuryCmd(),
 	)
 
 	return queryCmd
@@ -483,6 +485,71 @@ func QueryTallyResultCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryProposalTallyContextCmd creates a CLI command for Query/ProposalTallyContext.
+func QueryProposalTallyContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-tally-context [proposal-id]",
+		Short: "Query the group members a proposal is tallied against",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalTallyContext(cmd.Context(), &group.QueryProposalTallyContextRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryGroupPolicyTreasuryCmd creates a CLI command for Query/GroupPolicyTreasury.
+func QueryGroupPolicyTreasuryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-policy-treasury [group-policy-account]",
+		Short: "Query the balances, committed spends and proposal counts of a group policy account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupPolicyTreasury(cmd.Context(), &group.QueryGroupPolicyTreasuryRequest{
+				PolicyAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryVotesByVoterCmd creates a CLI command for Query/VotesByVoter.
 func QueryVotesByVoterCmd() *cobra.Command {
 	cmd := &cobra.Command{
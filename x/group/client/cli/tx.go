@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"github.com/spf13/cobra"
@@ -12,7 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 )
@@ -21,6 +24,8 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagVoteOptions        = "vote-options"
+	FlagExpiration         = "expiration"
 )
 
 var errZeroGroupID = errors.New("group id cannot be 0")
@@ -48,7 +53,9 @@ func TxCmd(name string, ac address.Codec) *cobra.Command {
 		MsgWithdrawProposalCmd(),
 		MsgSubmitProposalCmd(),
 		MsgVoteCmd(),
+		MsgGrantVoteCmd(),
 		MsgExecCmd(),
+		MsgRetryExecCmd(),
 		MsgLeaveGroupCmd(),
 		NewCmdDraftProposal(),
 	)
@@ -405,6 +412,21 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
         "voting_period": "120h",
         "min_execution_period": "0s"
     }
+}
+
+Or a threshold decision policy with a veto role, held by the veto members and by
+the members with a weight of at least veto_weight_threshold (optional), who can
+reject a proposal immediately by voting VOTE_OPTION_VETO:
+
+{
+    "@type": "/cosmos.group.v1.ThresholdWithVetoDecisionPolicy",
+    "threshold": "2",
+    "windows": {
+        "voting_period": "120h",
+        "min_execution_period": "0s"
+    },
+    "veto_members": ["cosmos1..."],
+    "veto_weight_threshold": "5"
 }`, version.AppName),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -718,6 +740,7 @@ Parameters:
 				VOTE_OPTION_YES: yes
 				VOTE_OPTION_ABSTAIN: abstain
 				VOTE_OPTION_NO_WITH_VETO: no-with-veto
+				VOTE_OPTION_VETO: veto, only for members holding the veto role of the group policy
 			Metadata: metadata for the vote
 `,
 		Args: cobra.ExactArgs(4),
@@ -762,6 +785,94 @@ Parameters:
 	return cmd
 }
 
+// MsgGrantVoteCmd creates a CLI command granting a GroupVoteAuthorization
+// through authz Msg/Grant.
+func MsgGrantVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-vote [granter] [grantee] [group-ids]",
+		Short: "Allow the grantee to vote in the given groups on behalf of the granter",
+		Long: `Allow the grantee to vote on the proposals of the given groups on behalf of the granter,
+through authz MsgExec.
+
+Parameters:
+			granter: member account address of the groups.
+			grantee: account address allowed to vote on behalf of the granter.
+			group-ids: comma separated unique ids of the groups the grantee can vote in.
+			Note, the '--from' flag is ignored as it is implied from [granter].
+`,
+		Example: fmt.Sprintf(`%s tx group grant-vote cosmos1... cosmos1... 1,2 --%s VOTE_OPTION_YES,VOTE_OPTION_NO --%s 1690000000`,
+			version.AppName, FlagVoteOptions, FlagExpiration),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var groupIDs []uint64
+			for _, idStr := range strings.Split(args[2], ",") {
+				groupID, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 64)
+				if err != nil {
+					return err
+				}
+				groupIDs = append(groupIDs, groupID)
+			}
+
+			optionsStr, err := cmd.Flags().GetStringSlice(FlagVoteOptions)
+			if err != nil {
+				return err
+			}
+
+			var options []group.VoteOption
+			for _, optionStr := range optionsStr {
+				option, err := group.VoteOptionFromString(optionStr)
+				if err != nil {
+					return err
+				}
+				options = append(options, option)
+			}
+
+			authorization := group.NewGroupVoteAuthorization(groupIDs, options...)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagVoteOptions, []string{}, "Vote options the grantee is allowed to vote with separated by ,. All vote options are allowed if empty")
+	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgExecCmd creates a CLI command for Msg/Exec.
 func MsgExecCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -793,6 +904,37 @@ func MsgExecCmd() *cobra.Command {
 	return cmd
 }
 
+// MsgRetryExecCmd creates a CLI command for Msg/RetryExec.
+func MsgRetryExecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-exec [proposal-id]",
+		Short: "Retry the execution of an accepted proposal whose execution failed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgRetryExec{
+				ProposalId: proposalID,
+				Executor:   clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgLeaveGroupCmd creates a CLI command for Msg/LeaveGroup.
 func MsgLeaveGroupCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
@@ -705,6 +706,8 @@ func (s *CLITestSuite) TestTxCreateGroupPolicy() {
 	invalidNegativePercentageDecisionPolicyFile := testutil.WriteToNewTempFile(s.T(), `{"@type":"/cosmos.group.v1.PercentageDecisionPolicy", "percentage":"-0.5", "windows":{"voting_period":"1s"}}`)
 	invalidPercentageDecisionPolicyFile := testutil.WriteToNewTempFile(s.T(), `{"@type":"/cosmos.group.v1.PercentageDecisionPolicy", "percentage":"2", "windows":{"voting_period":"1s"}}`)
 
+	invalidVetoDecisionPolicyFile := testutil.WriteToNewTempFile(s.T(), `{"@type":"/cosmos.group.v1.ThresholdWithVetoDecisionPolicy", "threshold":"1", "windows":{"voting_period":"1s"}}`)
+
 	cmd := groupcli.MsgCreateGroupPolicyCmd()
 	cmd.SetOutput(io.Discard)
 
@@ -799,6 +802,20 @@ func (s *CLITestSuite) TestTxCreateGroupPolicy() {
 			"percentage must be > 0 and <= 1",
 			fmt.Sprintf("%s %s %s %s", val.Address.String(), fmt.Sprintf("%v", groupID), validMetadata, invalidPercentageDecisionPolicyFile.Name()),
 		},
+		{
+			"invalid threshold with veto decision policy without veto role",
+			append(
+				[]string{
+					val.Address.String(),
+					fmt.Sprintf("%v", groupID),
+					validMetadata,
+					invalidVetoDecisionPolicyFile.Name(),
+				},
+				s.commonFlags...,
+			),
+			"veto members and veto weight threshold",
+			fmt.Sprintf("%s %s %s %s", val.Address.String(), fmt.Sprintf("%v", groupID), validMetadata, invalidVetoDecisionPolicyFile.Name()),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1326,6 +1343,104 @@ func (s *CLITestSuite) TestTxVote() {
 	}
 }
 
+func (s *CLITestSuite) TestTxGrantVote() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+		expectErrMsg string
+	}{
+		{
+			"invalid group id",
+			append(
+				[]string{
+					accounts[0].Address.String(),
+					accounts[1].Address.String(),
+					"1,abc",
+				},
+				s.commonFlags...,
+			),
+			fmt.Sprintf("%s %s %s", accounts[0].Address.String(), accounts[1].Address.String(), "1,abc"),
+			"strconv.ParseUint",
+		},
+		{
+			"zero group id",
+			append(
+				[]string{
+					accounts[0].Address.String(),
+					accounts[1].Address.String(),
+					"0",
+				},
+				s.commonFlags...,
+			),
+			fmt.Sprintf("%s %s %s", accounts[0].Address.String(), accounts[1].Address.String(), "0"),
+			"group id cannot be 0",
+		},
+		{
+			"invalid vote option",
+			append(
+				[]string{
+					accounts[0].Address.String(),
+					accounts[1].Address.String(),
+					"1",
+					fmt.Sprintf("--%s=AYE", groupcli.FlagVoteOptions),
+				},
+				s.commonFlags...,
+			),
+			fmt.Sprintf("%s %s %s --%s=AYE", accounts[0].Address.String(), accounts[1].Address.String(), "1", groupcli.FlagVoteOptions),
+			"'AYE' is not a valid vote option",
+		},
+		{
+			"correct data",
+			append(
+				[]string{
+					accounts[0].Address.String(),
+					accounts[1].Address.String(),
+					"1,2",
+					fmt.Sprintf("--%s=VOTE_OPTION_YES,VOTE_OPTION_NO", groupcli.FlagVoteOptions),
+					fmt.Sprintf("--%s=%d", groupcli.FlagExpiration, time.Now().Add(time.Hour).Unix()),
+				},
+				s.commonFlags...,
+			),
+			fmt.Sprintf("%s %s %s", accounts[0].Address.String(), accounts[1].Address.String(), "1,2"),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			// flags are not reset between executions of a command
+			cmd := groupcli.MsgGrantVoteCmd()
+			cmd.SetOutput(io.Discard)
+
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			if len(tc.args) != 0 {
+				s.Require().Contains(fmt.Sprint(cmd), tc.expCmdOutput)
+			}
+
+			out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(out.String(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				msg := &sdk.TxResponse{}
+				s.Require().NoError(s.baseCtx.Codec.UnmarshalJSON(out.Bytes(), msg), out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestTxWithdrawProposal() {
 	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
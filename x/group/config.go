@@ -9,6 +9,10 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxExecRetries defines the max number of times the execution of a proposal
+	// can be retried with MsgRetryExec after a failed execution. Defaults to 3 if
+	// not explicitly set.
+	MaxExecRetries uint64
 }
 
 // DefaultConfig returns the default config for group.
@@ -16,5 +20,6 @@ func DefaultConfig() Config {
 	return Config{
 		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
 		MaxMetadataLen:     255,
+		MaxExecRetries:     3,
 	}
 }
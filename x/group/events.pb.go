@@ -409,6 +409,79 @@ func (m *EventExec) GetLogs() string {
 	return ""
 }
 
+// EventRetryExec is an event emitted when the execution of a proposal is retried.
+type EventRetryExec struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// attempt is the number of the retry, starting at 1.
+	Attempt uint64 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// result is the proposal execution result.
+	Result ProposalExecutorResult `protobuf:"varint,3,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// logs contains the failure reason in case the execution result is FAILURE.
+	Logs string `protobuf:"bytes,4,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *EventRetryExec) Reset()         { *m = EventRetryExec{} }
+func (m *EventRetryExec) String() string { return proto.CompactTextString(m) }
+func (*EventRetryExec) ProtoMessage()    {}
+func (*EventRetryExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{8}
+}
+func (m *EventRetryExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRetryExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRetryExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRetryExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRetryExec.Merge(m, src)
+}
+func (m *EventRetryExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRetryExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRetryExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRetryExec proto.InternalMessageInfo
+
+func (m *EventRetryExec) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventRetryExec) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *EventRetryExec) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *EventRetryExec) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
 // EventLeaveGroup is an event emitted when group member leaves the group.
 type EventLeaveGroup struct {
 	// group_id is the unique ID of the group.
@@ -421,7 +494,7 @@ func (m *EventLeaveGroup) Reset()         { *m = EventLeaveGroup{} }
 func (m *EventLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*EventLeaveGroup) ProtoMessage()    {}
 func (*EventLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{8}
+	return fileDescriptor_e8d753981546f032, []int{9}
 }
 func (m *EventLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,37 +546,41 @@ func init() {
 	proto.RegisterType((*EventWithdrawProposal)(nil), "cosmos.group.v1.EventWithdrawProposal")
 	proto.RegisterType((*EventVote)(nil), "cosmos.group.v1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
+	proto.RegisterType((*EventRetryExec)(nil), "cosmos.group.v1.EventRetryExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2f, 0xca, 0x2f, 0x2d, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd,
-	0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0xc8, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x24, 0x21, 0x02, 0xf1, 0x60, 0x69, 0x7d, 0xa8, 0x2c, 0x98, 0x23, 0x25, 0x8d,
-	0x6e, 0x52, 0x49, 0x65, 0x41, 0x2a, 0x54, 0x52, 0x49, 0x97, 0x4b, 0xc0, 0x15, 0x64, 0xb0, 0x73,
-	0x51, 0x6a, 0x62, 0x49, 0xaa, 0x3b, 0x48, 0x89, 0x90, 0x24, 0x17, 0x07, 0x58, 0x6d, 0x7c, 0x66,
-	0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x3b, 0x98, 0xef, 0x99, 0x02, 0x57, 0x1e, 0x5a,
-	0x90, 0x42, 0x8c, 0x72, 0x1f, 0x2e, 0x31, 0x74, 0xd3, 0x03, 0xf2, 0x73, 0x32, 0x93, 0x2b, 0x85,
-	0x8c, 0xb8, 0xd8, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0xc1, 0x7a, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0xba, 0xdb, 0x11, 0x22, 0x13, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04,
-	0x53, 0x08, 0x37, 0x0d, 0xc9, 0x72, 0x0a, 0x4c, 0x33, 0xe3, 0x12, 0x06, 0x9b, 0x16, 0x5c, 0x9a,
-	0x94, 0x9b, 0x59, 0x12, 0x50, 0x94, 0x5f, 0x90, 0x5f, 0x9c, 0x98, 0x23, 0x24, 0xcf, 0xc5, 0x5d,
-	0x00, 0x65, 0x23, 0x3c, 0xc4, 0x05, 0x13, 0xf2, 0x4c, 0x51, 0xb2, 0xe0, 0x12, 0x05, 0xeb, 0x0b,
-	0xcf, 0x2c, 0xc9, 0x48, 0x29, 0x4a, 0x2c, 0x27, 0x5e, 0xa7, 0x0e, 0x17, 0x27, 0x58, 0x67, 0x58,
-	0x7e, 0x49, 0x2a, 0x61, 0xd5, 0x8d, 0x8c, 0x50, 0xe5, 0xae, 0x15, 0xa9, 0xc9, 0x04, 0x95, 0x0b,
-	0xd9, 0x73, 0xb1, 0x15, 0xa5, 0x16, 0x97, 0xe6, 0x94, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x19,
-	0xa9, 0xeb, 0xa1, 0x25, 0x11, 0x3d, 0x98, 0x43, 0x41, 0xe6, 0x95, 0x96, 0xe4, 0x17, 0x05, 0x81,
-	0x95, 0x07, 0x41, 0xb5, 0x09, 0x09, 0x71, 0xb1, 0xe4, 0xe4, 0xa7, 0x17, 0x4b, 0x30, 0x83, 0x02,
-	0x30, 0x08, 0xcc, 0x56, 0x4a, 0xe0, 0xe2, 0x07, 0x3b, 0xc1, 0x27, 0x35, 0xb1, 0x8c, 0x60, 0x6c,
-	0x23, 0xc7, 0x02, 0x13, 0x91, 0xb1, 0xe0, 0x64, 0x77, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x2a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xd0, 0xf4,
-	0x0c, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b, 0x20, 0xc9, 0x39, 0x89, 0x0d, 0x9c, 0x8c, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xc0, 0x98, 0xf8, 0x2f, 0x03, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0xaa, 0xda, 0x40,
+	0x14, 0xc6, 0x1d, 0x0d, 0x5a, 0xa7, 0xa0, 0x25, 0xfd, 0x43, 0xb4, 0x25, 0x15, 0x29, 0xd4, 0x45,
+	0x4d, 0xd0, 0x42, 0xe9, 0xaa, 0xa5, 0x16, 0x29, 0x82, 0x0b, 0x89, 0xb4, 0x85, 0x6e, 0x6c, 0xcc,
+	0x0c, 0x31, 0x34, 0x71, 0xc2, 0xcc, 0x24, 0xd5, 0x65, 0xdf, 0xa0, 0x8f, 0xd0, 0x87, 0xe8, 0x43,
+	0xdc, 0xa5, 0xdc, 0xd5, 0x5d, 0x5e, 0xf4, 0x45, 0x2e, 0x99, 0x4c, 0xbc, 0xc1, 0x8d, 0x01, 0x57,
+	0x99, 0x33, 0xdf, 0x77, 0xbe, 0xfc, 0x12, 0xce, 0x81, 0x2f, 0x1c, 0xc2, 0x02, 0xc2, 0x4c, 0x97,
+	0x92, 0x28, 0x34, 0xe3, 0x81, 0x89, 0x63, 0xbc, 0xe6, 0xcc, 0x08, 0x29, 0xe1, 0x44, 0x6d, 0xa6,
+	0xaa, 0x21, 0x54, 0x23, 0x1e, 0xb4, 0x5b, 0xe9, 0xc5, 0x42, 0xc8, 0xa6, 0x54, 0x45, 0xd1, 0x7e,
+	0x7e, 0x9a, 0xc4, 0xb7, 0x21, 0x96, 0x62, 0xb7, 0x0f, 0x1f, 0x8d, 0x93, 0xe0, 0xcf, 0x14, 0xdb,
+	0x1c, 0x7f, 0x49, 0x2c, 0x6a, 0x0b, 0x3e, 0x10, 0xde, 0x85, 0x87, 0x34, 0xd0, 0x01, 0x3d, 0xc5,
+	0xaa, 0x89, 0x7a, 0x82, 0x8e, 0xf6, 0xaf, 0x21, 0x2a, 0x62, 0x9f, 0xc2, 0x67, 0xa7, 0xe9, 0x33,
+	0xe2, 0x7b, 0xce, 0x56, 0x1d, 0xc2, 0x9a, 0x8d, 0x10, 0xc5, 0x8c, 0x89, 0x9e, 0xfa, 0x48, 0xbb,
+	0xfe, 0xdf, 0x7f, 0x22, 0xb9, 0x3f, 0xa5, 0xca, 0x9c, 0x53, 0x6f, 0xed, 0x5a, 0x99, 0xf1, 0x98,
+	0x96, 0x7b, 0xf9, 0x05, 0x69, 0xef, 0xe0, 0x63, 0x91, 0x36, 0x8f, 0x96, 0x81, 0xc7, 0x67, 0x94,
+	0x84, 0x84, 0xd9, 0xbe, 0xfa, 0x12, 0x3e, 0x0c, 0xe5, 0xf9, 0xfe, 0x83, 0x60, 0x76, 0x35, 0x41,
+	0xdd, 0xf7, 0xf0, 0xa9, 0xe8, 0xfb, 0xee, 0xf1, 0x15, 0xa2, 0xf6, 0xef, 0xe2, 0x9d, 0x6f, 0x60,
+	0x5d, 0x74, 0x7e, 0x23, 0x1c, 0x9f, 0x77, 0xff, 0x01, 0xd2, 0x3e, 0xde, 0x60, 0xe7, 0xac, 0x5d,
+	0xfd, 0x08, 0xab, 0x14, 0xb3, 0xc8, 0xe7, 0x5a, 0xb9, 0x03, 0x7a, 0x8d, 0xe1, 0x6b, 0xe3, 0x64,
+	0x44, 0x8c, 0x0c, 0x34, 0xc9, 0x8b, 0x38, 0xa1, 0x96, 0xb0, 0x5b, 0xb2, 0x4d, 0x55, 0xa1, 0xe2,
+	0x13, 0x97, 0x69, 0x95, 0xe4, 0x07, 0x5a, 0xe2, 0xdc, 0xfd, 0x07, 0x60, 0x43, 0x30, 0x58, 0x98,
+	0xd3, 0x6d, 0x31, 0x10, 0x0d, 0xd6, 0x6c, 0xce, 0x71, 0x10, 0xa6, 0x24, 0x8a, 0x95, 0x95, 0x39,
+	0xc4, 0xca, 0x65, 0x88, 0x4a, 0x0e, 0xf1, 0x27, 0x6c, 0x0a, 0xc2, 0x29, 0xb6, 0xe3, 0xb3, 0x03,
+	0x99, 0x1f, 0x94, 0x72, 0xc1, 0x41, 0x19, 0x7d, 0xb8, 0xda, 0xeb, 0x60, 0xb7, 0xd7, 0xc1, 0xed,
+	0x5e, 0x07, 0x7f, 0x0f, 0x7a, 0x69, 0x77, 0xd0, 0x4b, 0x37, 0x07, 0xbd, 0xf4, 0xe3, 0x95, 0xeb,
+	0xf1, 0x55, 0xb4, 0x34, 0x1c, 0x12, 0xc8, 0x95, 0x93, 0x8f, 0x3e, 0x43, 0xbf, 0xcc, 0x4d, 0xba,
+	0x71, 0xcb, 0xaa, 0xd8, 0xb4, 0xb7, 0x77, 0x03, 0x00, 0x93, 0xd0, 0xde, 0x4e, 0xd2, 0x03, 0x00,
+	0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -746,6 +823,51 @@ func (m *EventExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRetryExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRetryExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRetryExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		i -= len(m.Logs)
+		copy(dAtA[i:], m.Logs)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logs)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventLeaveGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -897,6 +1019,28 @@ func (m *EventExec) Size() (n int) {
 	return n
 }
 
+func (m *EventRetryExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovEvents(uint64(m.Attempt))
+	}
+	if m.Result != 0 {
+		n += 1 + sovEvents(uint64(m.Result))
+	}
+	l = len(m.Logs)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventLeaveGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1548,6 +1692,145 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRetryExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRetryExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRetryExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
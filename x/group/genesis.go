@@ -79,6 +79,25 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, snapshot := range s.GroupMembersSnapshots {
+
+		// check that group with snapshot's GroupId exists
+		if _, exists := groups[snapshot.GroupId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group members snapshot with GroupId %d doesn't exist", snapshot.GroupId))
+		}
+
+		if err := snapshot.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "GroupMembersSnapshot validation failed")
+		}
+
+		// check that the proposals tallied against the snapshot exist
+		for _, proposalID := range snapshot.ProposalIds {
+			if _, exists := proposals[proposalID]; !exists {
+				return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", proposalID))
+			}
+		}
+	}
 	return nil
 }
 
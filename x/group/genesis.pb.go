@@ -43,6 +43,9 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// group_members_snapshots is the list of the group members snapshots the
+	// final tally of proposals was computed against.
+	GroupMembersSnapshots []*GroupMembersSnapshot `protobuf:"bytes,9,rep,name=group_members_snapshots,json=groupMembersSnapshots,proto3" json:"group_members_snapshots,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +137,13 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetGroupMembersSnapshots() []*GroupMembersSnapshot {
+	if m != nil {
+		return m.GroupMembersSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,29 +151,30 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
-	0x10, 0xc7, 0xe9, 0x8f, 0x3f, 0x3f, 0x58, 0xfe, 0x68, 0x36, 0x31, 0xa9, 0xa0, 0x0d, 0x1a, 0x0f,
-	0x24, 0xc6, 0x36, 0xe0, 0xc1, 0x9b, 0x89, 0x5e, 0x88, 0x07, 0x13, 0x52, 0x12, 0x0f, 0x5e, 0x0c,
-	0xe0, 0x58, 0x1b, 0x29, 0x53, 0x3a, 0x0b, 0x91, 0xb7, 0xf0, 0x09, 0x7c, 0x1e, 0x8f, 0x1c, 0x3d,
-	0x1a, 0x78, 0x11, 0xc3, 0x6c, 0x49, 0x0d, 0x70, 0xda, 0xdd, 0xd9, 0xcf, 0x77, 0x3e, 0x93, 0x8c,
-	0x38, 0x1e, 0x20, 0x05, 0x48, 0x8e, 0x17, 0xe1, 0x24, 0x74, 0xa6, 0x4d, 0xc7, 0x83, 0x11, 0x90,
-	0x4f, 0x76, 0x18, 0xa1, 0x42, 0xb9, 0xa7, 0xbf, 0x6d, 0xfe, 0xb6, 0xa7, 0xcd, 0x6a, 0x6d, 0x93,
-	0x57, 0xb3, 0x10, 0x62, 0xfa, 0xf4, 0x33, 0x2d, 0x4a, 0x6d, 0x9d, 0xef, 0xaa, 0x9e, 0x02, 0x59,
-	0x13, 0x05, 0x06, 0x9f, 0x08, 0xc6, 0xa6, 0x51, 0x37, 0x1a, 0x19, 0x37, 0xcf, 0x85, 0x2e, 0x8c,
-	0x65, 0x4b, 0xe4, 0xf8, 0x4e, 0xe6, 0xbf, 0x7a, 0xba, 0x51, 0x6c, 0x55, 0xed, 0x0d, 0x99, 0xdd,
-	0x5e, 0x5d, 0xee, 0x46, 0x2f, 0xe8, 0xc6, 0xa4, 0xbc, 0x11, 0x65, 0xdd, 0x30, 0x80, 0xa0, 0x0f,
-	0x11, 0x99, 0x69, 0x8e, 0x1e, 0xed, 0x8e, 0xde, 0x33, 0xe4, 0x96, 0xbc, 0xe4, 0x41, 0xb2, 0x21,
-	0xf6, 0x75, 0x8b, 0x10, 0x87, 0xfe, 0x60, 0xc6, 0xa3, 0x65, 0x78, 0xb4, 0x0a, 0xd7, 0x3b, 0x5c,
-	0x5e, 0x0d, 0xd8, 0x16, 0x95, 0x3f, 0xa4, 0x0f, 0x64, 0x66, 0xd9, 0x56, 0xdf, 0x6d, 0xd3, 0x41,
-	0x1e, 0xb7, 0x9c, 0x74, 0xf2, 0x81, 0xe4, 0x89, 0x28, 0x85, 0x11, 0x86, 0x48, 0xbd, 0x21, 0xeb,
-	0x72, 0xac, 0x2b, 0xae, 0x6b, 0x2b, 0xd7, 0x95, 0x28, 0xac, 0x9f, 0x64, 0xfe, 0x67, 0xcd, 0xe1,
-	0x96, 0xa6, 0x13, 0x13, 0x6e, 0xc2, 0xca, 0x73, 0x91, 0x9d, 0xa2, 0x02, 0x32, 0xf3, 0x1c, 0x3a,
-	0xd8, 0x0a, 0x3d, 0xa0, 0x02, 0x57, 0x33, 0xb7, 0xd7, 0x5f, 0x0b, 0xcb, 0x98, 0x2f, 0x2c, 0xe3,
-	0x67, 0x61, 0x19, 0x1f, 0x4b, 0x2b, 0x35, 0x5f, 0x5a, 0xa9, 0xef, 0xa5, 0x95, 0x7a, 0x3c, 0xf3,
-	0x7c, 0xf5, 0x3a, 0xe9, 0xdb, 0x03, 0x0c, 0x9c, 0x78, 0xc5, 0xfa, 0xb8, 0xa0, 0xe7, 0x37, 0xe7,
-	0x5d, 0xef, 0xbb, 0x9f, 0xe3, 0x3d, 0x5f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x99, 0x5b, 0x30,
-	0xc4, 0x36, 0x02, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x5d, 0x4b, 0x3a, 0x41,
+	0x14, 0xc6, 0xdd, 0xbf, 0x2f, 0x7f, 0x1d, 0x5f, 0x8a, 0x01, 0x69, 0xd2, 0x5a, 0x2c, 0x0a, 0x84,
+	0x68, 0x17, 0xed, 0xa2, 0xbb, 0xa0, 0x6e, 0xa4, 0x8b, 0x40, 0x56, 0xe8, 0x22, 0x08, 0x51, 0x9b,
+	0xd6, 0x25, 0xd7, 0x19, 0xf7, 0x8c, 0x92, 0xdf, 0xa2, 0x8f, 0xd5, 0xa5, 0x97, 0x5d, 0x86, 0x7e,
+	0x8e, 0x20, 0x3c, 0xb3, 0x62, 0xe9, 0xd2, 0xd5, 0xcc, 0x3c, 0xf3, 0x7b, 0xce, 0x73, 0x38, 0x1c,
+	0x72, 0xd8, 0x13, 0xe0, 0x0b, 0xb0, 0xdd, 0x40, 0x8c, 0xa5, 0x3d, 0xa9, 0xd9, 0x2e, 0x1f, 0x72,
+	0xf0, 0xc0, 0x92, 0x81, 0x50, 0x82, 0xee, 0xe8, 0x6f, 0x0b, 0xbf, 0xad, 0x49, 0xad, 0x54, 0xde,
+	0xe4, 0xd5, 0x54, 0xf2, 0x90, 0x3e, 0xfe, 0x8a, 0x93, 0x5c, 0x43, 0xfb, 0x5b, 0xaa, 0xa3, 0x38,
+	0x2d, 0x93, 0x0c, 0x82, 0x6d, 0xe0, 0x23, 0x66, 0x54, 0x8c, 0x6a, 0xc2, 0x49, 0xa3, 0xd0, 0xe2,
+	0x23, 0x5a, 0x27, 0x29, 0xbc, 0x03, 0xfb, 0x57, 0x89, 0x57, 0xb3, 0xf5, 0x92, 0xb5, 0x11, 0x66,
+	0x35, 0x96, 0x97, 0xdb, 0xe1, 0xb3, 0x70, 0x42, 0x92, 0x5e, 0x93, 0xbc, 0x2e, 0xe8, 0x73, 0xbf,
+	0xcb, 0x03, 0x60, 0x71, 0xb4, 0x1e, 0x44, 0x5b, 0xef, 0x10, 0x72, 0x72, 0xee, 0xfa, 0x01, 0xb4,
+	0x4a, 0x76, 0x75, 0x09, 0x29, 0x06, 0x5e, 0x6f, 0x8a, 0xad, 0x25, 0xb0, 0xb5, 0x02, 0xea, 0x4d,
+	0x94, 0x97, 0x0d, 0x36, 0x48, 0xe1, 0x07, 0xe9, 0x71, 0x60, 0x49, 0x4c, 0xab, 0x44, 0xa7, 0x69,
+	0x23, 0xb6, 0x9b, 0x5f, 0x57, 0xf2, 0x38, 0xd0, 0x23, 0x92, 0x93, 0x81, 0x90, 0x02, 0x3a, 0x03,
+	0x8c, 0x4b, 0x61, 0x5c, 0x76, 0xa5, 0x2d, 0xb3, 0x2e, 0x49, 0x66, 0xf5, 0x04, 0xf6, 0x1f, 0x63,
+	0xf6, 0xb7, 0x62, 0x9a, 0x21, 0xe1, 0xac, 0x59, 0x7a, 0x46, 0x92, 0x13, 0xa1, 0x38, 0xb0, 0x34,
+	0x9a, 0x8a, 0x5b, 0xa6, 0x7b, 0xa1, 0xb8, 0xa3, 0x19, 0xfa, 0x48, 0xf6, 0x7e, 0x8d, 0xaf, 0x0d,
+	0xc3, 0x8e, 0x84, 0xbe, 0x50, 0xc0, 0x32, 0x68, 0x3f, 0xfd, 0x6b, 0x90, 0xd0, 0x0a, 0x69, 0xa7,
+	0xe8, 0x46, 0xa8, 0x70, 0x73, 0xf5, 0x3e, 0x37, 0x8d, 0xd9, 0xdc, 0x34, 0x3e, 0xe7, 0xa6, 0xf1,
+	0xb6, 0x30, 0x63, 0xb3, 0x85, 0x19, 0xfb, 0x58, 0x98, 0xb1, 0x87, 0x13, 0xd7, 0x53, 0xfd, 0x71,
+	0xd7, 0xea, 0x09, 0xdf, 0x0e, 0x37, 0x48, 0x1f, 0xe7, 0xf0, 0xf4, 0x62, 0xbf, 0xea, 0x75, 0xea,
+	0xa6, 0x70, 0x8d, 0x2e, 0xbe, 0x07, 0x00, 0x79, 0x93, 0x78, 0x3c, 0x95, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +197,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GroupMembersSnapshots) > 0 {
+		for iNdEx := len(m.GroupMembersSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupMembersSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +355,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.GroupMembersSnapshots) > 0 {
+		for _, e := range m.GroupMembersSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +626,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembersSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupMembersSnapshots = append(m.GroupMembersSnapshots, &GroupMembersSnapshot{})
+			if err := m.GroupMembersSnapshots[len(m.GroupMembersSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -732,6 +732,165 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid group members snapshot",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				GroupMembersSnapshots: []*GroupMembersSnapshot{
+					{
+						GroupId:      1,
+						GroupVersion: 1,
+						TotalWeight:  "1",
+						Members: []*Member{
+							{Address: memberAddr.String(), Weight: "1"},
+						},
+						ProposalIds: []uint64{proposal.Id},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"group members snapshot of group that doesn't exist",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				GroupMembersSnapshots: []*GroupMembersSnapshot{
+					{
+						GroupId:      2,
+						GroupVersion: 1,
+						TotalWeight:  "1",
+						Members: []*Member{
+							{Address: memberAddr.String(), Weight: "1"},
+						},
+						ProposalIds: []uint64{proposal.Id},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"group members snapshot with invalid member weight",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				GroupMembersSnapshots: []*GroupMembersSnapshot{
+					{
+						GroupId:      1,
+						GroupVersion: 1,
+						TotalWeight:  "1",
+						Members: []*Member{
+							{Address: memberAddr.String(), Weight: "-1"},
+						},
+						ProposalIds: []uint64{proposal.Id},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"group members snapshot without proposal",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				GroupMembersSnapshots: []*GroupMembersSnapshot{
+					{
+						GroupId:      1,
+						GroupVersion: 1,
+						TotalWeight:  "1",
+						Members: []*Member{
+							{Address: memberAddr.String(), Weight: "1"},
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"group members snapshot of proposal that doesn't exist",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accAddr.String(),
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{
+					groupPolicy,
+				},
+				Proposals: []*Proposal{
+					proposal,
+				},
+				GroupMembersSnapshots: []*GroupMembersSnapshot{
+					{
+						GroupId:      1,
+						GroupVersion: 1,
+						TotalWeight:  "1",
+						Members: []*Member{
+							{Address: memberAddr.String(), Weight: "1"},
+						},
+						ProposalIds: []uint64{2},
+					},
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
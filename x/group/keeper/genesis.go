@@ -41,6 +41,17 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.groupMembersSnapshotTable.Import(ctx.KVStore(k.key), genesisState.GroupMembersSnapshots, 0); err != nil {
+		panic(errors.Wrap(err, "group members snapshots"))
+	}
+
+	// restore the references of the proposals to the snapshot of their final tally
+	for _, snapshot := range genesisState.GroupMembersSnapshots {
+		for _, proposalID := range snapshot.ProposalIds {
+			ctx.KVStore(k.key).Set(proposalTallyGroupVersionKey(proposalID), types.Uint64ToBigEndian(snapshot.GroupVersion))
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -87,5 +98,12 @@ func (k Keeper) ExportGenesis(ctx types.Context, cdc codec.JSONCodec) *group.Gen
 	}
 	genesisState.Votes = votes
 
+	var snapshots []*group.GroupMembersSnapshot
+	_, err = k.groupMembersSnapshotTable.Export(ctx.KVStore(k.key), &snapshots)
+	if err != nil {
+		panic(errors.Wrap(err, "group members snapshots"))
+	}
+	genesisState.GroupMembersSnapshots = snapshots
+
 	return genesisState
 }
@@ -73,7 +73,7 @@ func (s *GenesisTestSuite) SetupTest() {
 	s.cdc = codec.NewProtoCodec(encCfg.InterfaceRegistry)
 	s.ctx = s.sdkCtx
 
-	s.keeper = keeper.NewKeeper(key, s.cdc, bApp.MsgServiceRouter(), accountKeeper, group.DefaultConfig())
+	s.keeper = keeper.NewKeeper(key, s.cdc, bApp.MsgServiceRouter(), accountKeeper, nil, group.DefaultConfig())
 }
 
 func (s *GenesisTestSuite) TestInitExportGenesis() {
@@ -135,6 +135,13 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		ProposalSeq:    1,
 		Proposals:      []*group.Proposal{proposal},
 		Votes:          []*group.Vote{{ProposalId: proposal.Id, Voter: memberAddr.String(), SubmitTime: submittedAt, Option: group.VOTE_OPTION_YES}},
+		GroupMembersSnapshots: []*group.GroupMembersSnapshot{{
+			GroupId:      1,
+			GroupVersion: 1,
+			TotalWeight:  "1",
+			Members:      []*group.Member{{Address: memberAddr.String(), Weight: "1", Metadata: "member metadata"}},
+			ProposalIds:  []uint64{proposal.Id},
+		}},
 	}
 	genesisBytes, err := cdc.MarshalJSON(genesisState)
 	s.Require().NoError(err)
@@ -181,6 +188,12 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.Require().NoError(err)
 		s.Require().Equal(len(votesRes.Votes), 1)
 		s.Require().Equal(votesRes.Votes[0], genesisState.Votes[0])
+
+		tallyContextRes, err := s.keeper.ProposalTallyContext(ctx, &group.QueryProposalTallyContextRequest{
+			ProposalId: g.Id,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(genesisState.GroupMembersSnapshots[0], tallyContextRes.Snapshot)
 	}
 
 	exported := s.keeper.ExportGenesis(sdkCtx, cdc)
@@ -208,6 +221,7 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.assertProposalsEqual(g, res)
 	}
 	s.Require().Equal(genesisState.Votes, exportedGenesisState.Votes)
+	s.Require().Equal(genesisState.GroupMembersSnapshots, exportedGenesisState.GroupMembersSnapshots)
 
 	s.Require().Equal(genesisState.GroupSeq, exportedGenesisState.GroupSeq)
 	s.Require().Equal(genesisState.GroupPolicySeq, exportedGenesisState.GroupPolicySeq)
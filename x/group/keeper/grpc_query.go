@@ -7,10 +7,15 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	errorsmod "cosmossdk.io/errors"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
@@ -360,6 +365,51 @@ func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResu
 	}, nil
 }
 
+// ProposalTallyContext returns the group members the votes of a proposal are
+// tallied against: the snapshot taken when its final tally was computed, or
+// the current group members if its tally is not final yet.
+func (k Keeper) ProposalTallyContext(goCtx context.Context, request *group.QueryProposalTallyContextRequest) (*group.QueryProposalTallyContextResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.getProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	var snapshot group.GroupMembersSnapshot
+	if groupVersion, found := k.getProposalTallyGroupVersion(ctx, proposal.Id); found {
+		snapshot, err = k.getGroupMembersSnapshot(ctx, policyInfo.GroupId, groupVersion)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "load group members snapshot")
+		}
+	} else {
+		// The final tally of proposals finalized before snapshots were
+		// introduced can't be retrieved anymore.
+		if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED || proposal.Status == group.PROPOSAL_STATUS_REJECTED {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no tally context for proposal %d", proposal.Id)
+		}
+
+		groupInfo, err := k.getGroupInfo(ctx, policyInfo.GroupId)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "load group")
+		}
+
+		snapshot, err = k.currentGroupMembersSnapshot(ctx, groupInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &group.QueryProposalTallyContextResponse{
+		Snapshot: &snapshot,
+	}, nil
+}
+
 // Groups returns all the groups present in the state.
 func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest) (*group.QueryGroupsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -381,3 +431,83 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// GroupPolicyTreasury queries the balances of a group policy account, the
+// amounts its pending proposals would send from it and the number of its
+// proposals by stage.
+func (k Keeper) GroupPolicyTreasury(goCtx context.Context, request *group.QueryGroupPolicyTreasuryRequest) (*group.QueryGroupPolicyTreasuryResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.accKeeper.StringToBytes(request.PolicyAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getGroupPolicyInfo(ctx, request.PolicyAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	proposals, err := k.proposalsByGroupPolicy(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &group.QueryGroupPolicyTreasuryResponse{
+		Balances:          k.bankKeeper.GetAllBalances(ctx, addr),
+		CommittedSpends:   sdk.NewCoins(),
+		ExecutedProposals: k.getGroupPolicyExecutedCount(ctx, addr),
+	}
+	for _, proposal := range proposals {
+		switch proposal.Status {
+		case group.PROPOSAL_STATUS_SUBMITTED:
+			res.OpenProposals++
+		case group.PROPOSAL_STATUS_ACCEPTED:
+			// Successfully executed proposals are pruned, so accepted
+			// proposals still in state have not been executed yet.
+			res.AcceptedProposals++
+		default:
+			continue
+		}
+
+		res.CommittedSpends = res.CommittedSpends.Add(spendsFrom(request.PolicyAddress, proposal.Messages)...)
+	}
+
+	return res, nil
+}
+
+// spendsFrom returns the sum of the amounts sent from the given address by the
+// bank MsgSend and MsgMultiSend messages among msgs. Other messages, as well as
+// messages which can't be decoded, are skipped.
+func spendsFrom(addr string, msgs []*codectypes.Any) sdk.Coins {
+	spends := sdk.NewCoins()
+	for _, msgAny := range msgs {
+		if msgAny == nil {
+			continue
+		}
+
+		switch msgAny.TypeUrl {
+		case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+			var msg banktypes.MsgSend
+			if err := proto.Unmarshal(msgAny.Value, &msg); err != nil || msg.FromAddress != addr || !msg.Amount.IsValid() {
+				continue
+			}
+			spends = spends.Add(msg.Amount...)
+
+		case sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):
+			var msg banktypes.MsgMultiSend
+			if err := proto.Unmarshal(msgAny.Value, &msg); err != nil {
+				continue
+			}
+			for _, in := range msg.Inputs {
+				if in.Address == addr && in.Coins.IsValid() {
+					spends = spends.Add(in.Coins...)
+				}
+			}
+		}
+	}
+
+	return spends
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
@@ -28,6 +29,9 @@ const (
 	GroupMemberByGroupIndexPrefix  byte = 0x11
 	GroupMemberByMemberIndexPrefix byte = 0x12
 
+	// Group Members Snapshot Table
+	GroupMembersSnapshotTablePrefix byte = 0x13
+
 	// Group Policy Table
 	GroupPolicyTablePrefix        byte = 0x20
 	GroupPolicyTableSeqPrefix     byte = 0x21
@@ -39,6 +43,9 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalExecRetriesPrefix        byte = 0x34
+	ProposalTallyGroupVersionPrefix  byte = 0x35
+	GroupPolicyExecutedCountPrefix   byte = 0x36
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
@@ -49,7 +56,8 @@ const (
 type Keeper struct {
 	key storetypes.StoreKey
 
-	accKeeper group.AccountKeeper
+	accKeeper  group.AccountKeeper
+	bankKeeper group.BankKeeper
 
 	// Group Table
 	groupTable        orm.AutoUInt64Table
@@ -60,6 +68,9 @@ type Keeper struct {
 	groupMemberByGroupIndex  orm.Index
 	groupMemberByMemberIndex orm.Index
 
+	// Group Members Snapshot Table
+	groupMembersSnapshotTable orm.PrimaryKeyTable
+
 	// Group Policy Table
 	groupPolicySeq          orm.Sequence
 	groupPolicyTable        orm.PrimaryKeyTable
@@ -82,11 +93,12 @@ type Keeper struct {
 }
 
 // NewKeeper creates a new group keeper.
-func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.MessageRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.MessageRouter, accKeeper group.AccountKeeper, bankKeeper group.BankKeeper, config group.Config) Keeper {
 	k := Keeper{
-		key:       storeKey,
-		router:    router,
-		accKeeper: accKeeper,
+		key:        storeKey,
+		router:     router,
+		accKeeper:  accKeeper,
+		bankKeeper: bankKeeper,
 	}
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -130,6 +142,13 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.Mes
 	}
 	k.groupMemberTable = *groupMemberTable
 
+	// Group Members Snapshot Table
+	groupMembersSnapshotTable, err := orm.NewPrimaryKeyTable([2]byte{GroupMembersSnapshotTablePrefix}, &group.GroupMembersSnapshot{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.groupMembersSnapshotTable = *groupMembersSnapshotTable
+
 	// Group Policy Table
 	k.groupPolicySeq = orm.NewSequence(GroupPolicyTableSeqPrefix)
 	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc)
@@ -209,6 +228,9 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.Mes
 	if config.MaxExecutionPeriod == 0 {
 		config.MaxExecutionPeriod = group.DefaultConfig().MaxExecutionPeriod
 	}
+	if config.MaxExecRetries == 0 {
+		config.MaxExecRetries = group.DefaultConfig().MaxExecRetries
+	}
 	k.config = config
 
 	return k
@@ -229,6 +251,22 @@ func (k Keeper) GetGroupPolicySeq(ctx sdk.Context) uint64 {
 	return k.groupPolicySeq.CurVal(ctx.KVStore(k.key))
 }
 
+// ProposalGroupID returns the id of the group a proposal was submitted to,
+// implementing group.ProposalGroupResolver.
+func (k Keeper) ProposalGroupID(ctx sdk.Context, proposalID uint64) (uint64, error) {
+	proposal, err := k.getProposal(ctx, proposalID)
+	if err != nil {
+		return 0, err
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "load group policy")
+	}
+
+	return policyInfo.GroupId, nil
+}
+
 // proposalsByVPEnd returns all proposals whose voting_period_end is after the `endTime` time argument.
 func (k Keeper) proposalsByVPEnd(ctx sdk.Context, endTime time.Time) (proposals []group.Proposal, err error) {
 	timeBytes := sdk.FormatTimeBytes(endTime)
@@ -262,19 +300,80 @@ func (k Keeper) proposalsByVPEnd(ctx sdk.Context, endTime time.Time) (proposals
 	return proposals, nil
 }
 
-// pruneProposal deletes a proposal from state.
+// pruneProposal deletes a proposal from state, along with its reference to the
+// group members snapshot of its final tally.
 func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
 	store := ctx.KVStore(k.key)
 
-	err := k.proposalTable.Delete(store, proposalID)
+	proposal, err := k.getProposal(ctx, proposalID)
 	if err != nil {
 		return err
 	}
 
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "group policy")
+	}
+
+	err = k.proposalTable.Delete(store, proposalID)
+	if err != nil {
+		return err
+	}
+	store.Delete(proposalExecRetriesKey(proposalID))
+
+	if err := k.releaseProposalTally(ctx, proposalID, policyInfo.GroupId); err != nil {
+		return err
+	}
+
 	k.Logger(ctx).Debug(fmt.Sprintf("Pruned proposal %d", proposalID))
 	return nil
 }
 
+// proposalExecRetriesKey returns the key under which the number of execution
+// retries of a proposal is stored.
+func proposalExecRetriesKey(proposalID uint64) []byte {
+	return append([]byte{ProposalExecRetriesPrefix}, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// getProposalExecRetries returns the number of times the execution of a
+// proposal has been retried with MsgRetryExec.
+func (k Keeper) getProposalExecRetries(ctx sdk.Context, proposalID uint64) uint64 {
+	bz := ctx.KVStore(k.key).Get(proposalExecRetriesKey(proposalID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setProposalExecRetries sets the number of times the execution of a proposal
+// has been retried with MsgRetryExec.
+func (k Keeper) setProposalExecRetries(ctx sdk.Context, proposalID, retries uint64) {
+	ctx.KVStore(k.key).Set(proposalExecRetriesKey(proposalID), sdk.Uint64ToBigEndian(retries))
+}
+
+// groupPolicyExecutedCountKey returns the key under which the number of
+// successfully executed proposals of a group policy is stored.
+func groupPolicyExecutedCountKey(groupPolicyAddr sdk.AccAddress) []byte {
+	return append([]byte{GroupPolicyExecutedCountPrefix}, address.MustLengthPrefix(groupPolicyAddr)...)
+}
+
+// getGroupPolicyExecutedCount returns the number of successfully executed
+// proposals of a group policy.
+func (k Keeper) getGroupPolicyExecutedCount(ctx sdk.Context, groupPolicyAddr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.key).Get(groupPolicyExecutedCountKey(groupPolicyAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// incGroupPolicyExecutedCount increments the number of successfully executed
+// proposals of a group policy.
+func (k Keeper) incGroupPolicyExecutedCount(ctx sdk.Context, groupPolicyAddr sdk.AccAddress) {
+	count := k.getGroupPolicyExecutedCount(ctx, groupPolicyAddr)
+	ctx.KVStore(k.key).Set(groupPolicyExecutedCountKey(groupPolicyAddr), sdk.Uint64ToBigEndian(count+1))
+}
+
 // abortProposals iterates through all proposals by group policy index
 // and marks submitted proposals as aborted.
 func (k Keeper) abortProposals(ctx sdk.Context, groupPolicyAddr sdk.AccAddress) error {
@@ -80,7 +80,7 @@ func (s *TestSuite) SetupTest() {
 	banktypes.RegisterMsgServer(bApp.MsgServiceRouter(), s.bankKeeper)
 
 	config := group.DefaultConfig()
-	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, config)
+	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, s.bankKeeper, config)
 	s.ctx = testCtx.Ctx.WithBlockTime(s.blockTime)
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
@@ -536,3 +536,169 @@ func (s *TestSuite) TestTallyProposalsAtVPEnd_GroupMemberLeaving() {
 	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
 	s.NotPanics(func() { module.EndBlocker(ctx, s.groupKeeper) })
 }
+
+func (s *TestSuite) TestProposalTallyContext() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr3 := addrs[2]
+	votingPeriod := 4 * time.Minute
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{}).Codec
+
+	groupMsg := &group.MsgCreateGroupWithPolicy{
+		Admin: addr1.String(),
+		Members: []group.MemberRequest{
+			{Address: addr1.String(), Weight: "1"},
+			{Address: addr2.String(), Weight: "2"},
+			{Address: addr3.String(), Weight: "3"},
+		},
+	}
+	policy := group.NewThresholdDecisionPolicy("4", votingPeriod, 0)
+	s.Require().NoError(groupMsg.SetDecisionPolicy(policy))
+
+	s.setNextAccount()
+	groupRes, err := s.groupKeeper.CreateGroupWithPolicy(s.ctx, groupMsg)
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	var proposalIDs []uint64
+	for i := 0; i < 2; i++ {
+		proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: groupRes.GroupPolicyAddress,
+			Proposers:          []string{addr1.String()},
+		})
+		s.Require().NoError(err)
+		proposalIDs = append(proposalIDs, proposalRes.ProposalId)
+	}
+	proposalID := proposalIDs[0]
+
+	tallyContext := func(ctx context.Context, proposalID uint64) *group.GroupMembersSnapshot {
+		res, err := s.groupKeeper.ProposalTallyContext(ctx, &group.QueryProposalTallyContextRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Snapshot
+	}
+	weights := func(snapshot *group.GroupMembersSnapshot) map[string]string {
+		weights := make(map[string]string)
+		for _, m := range snapshot.Members {
+			weights[m.Address] = m.Weight
+		}
+		return weights
+	}
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: addr1.String(), Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+
+	// while voting, the proposal is tallied against the current members
+	snapshot := tallyContext(s.ctx, proposalID)
+	s.Require().Equal(uint64(1), snapshot.GroupVersion)
+	s.Require().Equal("6", snapshot.TotalWeight)
+	s.Require().Empty(snapshot.ProposalIds)
+
+	// membership changes mid-vote
+	_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:         addr1.String(),
+		GroupId:       groupID,
+		MemberUpdates: []group.MemberRequest{{Address: addr2.String(), Weight: "5"}},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: addr2.String(), Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+
+	snapshot = tallyContext(s.ctx, proposalID)
+	s.Require().Equal(uint64(2), snapshot.GroupVersion)
+	s.Require().Equal(map[string]string{addr1.String(): "1", addr2.String(): "5", addr3.String(): "3"}, weights(snapshot))
+
+	// the final tally freezes the members it was computed against
+	ctx := s.sdkCtx.WithBlockTime(s.sdkCtx.BlockTime().Add(votingPeriod + 1))
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+	proposalRes, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+	s.Require().Equal("6", proposalRes.Proposal.FinalTallyResult.YesCount)
+
+	_, err = s.groupKeeper.LeaveGroup(ctx, &group.MsgLeaveGroup{Address: addr3.String(), GroupId: groupID})
+	s.Require().NoError(err)
+
+	for _, id := range proposalIDs {
+		snapshot = tallyContext(ctx, id)
+		s.Require().Equal(uint64(2), snapshot.GroupVersion)
+		s.Require().Equal("9", snapshot.TotalWeight)
+		s.Require().Equal(map[string]string{addr1.String(): "1", addr2.String(): "5", addr3.String(): "3"}, weights(snapshot))
+		s.Require().Equal(proposalIDs, snapshot.ProposalIds)
+	}
+
+	// the snapshot is kept as long as a proposal references it
+	_, err = s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: addr1.String()})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+
+	snapshot = tallyContext(ctx, proposalIDs[1])
+	s.Require().Equal([]uint64{proposalIDs[1]}, snapshot.ProposalIds)
+
+	// and pruned along with the last proposal referencing it
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(group.DefaultConfig().MaxExecutionPeriod))
+	s.Require().NoError(s.groupKeeper.PruneProposals(ctx))
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalIDs[1]})
+	s.Require().Error(err)
+	s.Require().Empty(s.groupKeeper.ExportGenesis(ctx, cdc).GroupMembersSnapshots)
+}
+
+func (s *TestSuite) TestGroupPolicyTreasury() {
+	addrs := s.addrs
+	addr2 := addrs[1]
+	policyAddr := s.groupPolicyAddr.String()
+	proposers := []string{addr2.String()}
+
+	send := func(amount int64) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: policyAddr,
+			ToAddress:   addr2.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("test", amount)},
+		}
+	}
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{{Address: policyAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 30), sdk.NewInt64Coin("atom", 7))}},
+		Outputs: []banktypes.Output{
+			{Address: addr2.String(), Coins: sdk.Coins{sdk.NewInt64Coin("test", 30)}},
+			{Address: addrs[3].String(), Coins: sdk.Coins{sdk.NewInt64Coin("atom", 7)}},
+		},
+	}
+	updateMetadata := &group.MsgUpdateGroupMetadata{Admin: policyAddr, GroupId: s.groupID, Metadata: "treasury"}
+
+	// accepted, not executed yet
+	acceptedID := submitProposalAndVote(s.ctx, s, []sdk.Msg{send(200), updateMetadata}, proposers, group.VOTE_OPTION_YES)
+	// rejected, not counted
+	submitProposalAndVote(s.ctx, s, []sdk.Msg{send(1000)}, proposers, group.VOTE_OPTION_NO)
+	// accepted and executed
+	executedID := submitProposalAndVote(s.ctx, s, []sdk.Msg{send(500)}, proposers, group.VOTE_OPTION_YES)
+
+	ctx := s.sdkCtx.WithBlockTime(s.sdkCtx.BlockTime().Add(s.policy.GetVotingPeriod() + minExecutionPeriod + 1))
+	s.bankKeeper.EXPECT().Send(gomock.Any(), send(500)).Return(nil, nil)
+	_, err := s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: executedID, Executor: addr2.String()})
+	s.Require().NoError(err)
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+	proposalRes, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: acceptedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+
+	// open, mixing bank and non-bank messages
+	submitProposal(ctx, s, []sdk.Msg{send(100), multiSend, updateMetadata, send(20)}, proposers)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 9500))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), s.groupPolicyAddr).Return(balances)
+
+	res, err := s.groupKeeper.GroupPolicyTreasury(ctx, &group.QueryGroupPolicyTreasuryRequest{PolicyAddress: policyAddr})
+	s.Require().NoError(err)
+	s.Require().Equal(balances, res.Balances)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 350), sdk.NewInt64Coin("atom", 7)), res.CommittedSpends)
+	s.Require().Equal(uint64(1), res.OpenProposals)
+	s.Require().Equal(uint64(1), res.AcceptedProposals)
+	s.Require().Equal(uint64(1), res.ExecutedProposals)
+
+	_, err = s.groupKeeper.GroupPolicyTreasury(ctx, &group.QueryGroupPolicyTreasuryRequest{PolicyAddress: addr2.String()})
+	s.Require().Error(err)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -722,6 +723,13 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
 		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
 	}
+
+	if msg.Option == group.VOTE_OPTION_VETO {
+		if err := assertCanVeto(policyInfo, *voter.Member); err != nil {
+			return nil, err
+		}
+	}
+
 	newVote := group.Vote{
 		ProposalId: msg.ProposalId,
 		Voter:      msg.Voter,
@@ -740,8 +748,18 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 		return nil, err
 	}
 
+	// A veto rejects the proposal immediately.
+	if msg.Option == group.VOTE_OPTION_VETO {
+		if err := k.doTallyAndUpdate(ctx, &proposal, groupInfo, policyInfo); err != nil {
+			return nil, err
+		}
+		if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	// Try to execute proposal immediately
-	if msg.Exec == group.Exec_EXEC_TRY {
+	if msg.Exec == group.Exec_EXEC_TRY && proposal.Status == group.PROPOSAL_STATUS_SUBMITTED {
 		_, err = k.Exec(ctx, &group.MsgExec{ProposalId: msg.ProposalId, Executor: msg.Voter})
 		if err != nil {
 			return nil, err
@@ -751,8 +769,33 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 	return &group.MsgVoteResponse{}, nil
 }
 
+// assertCanVeto checks that the group policy has a veto role and that the given
+// group member holds it.
+func assertCanVeto(policyInfo group.GroupPolicyInfo, member group.Member) error {
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+
+	vetoPolicy, ok := policy.(*group.ThresholdWithVetoDecisionPolicy)
+	if !ok {
+		return errorsmod.Wrapf(errors.ErrInvalid, "decision policy %T has no veto role", policy)
+	}
+
+	canVeto, err := vetoPolicy.CanVeto(member)
+	if err != nil {
+		return err
+	}
+	if !canVeto {
+		return errorsmod.Wrapf(errors.ErrUnauthorized, "%s does not hold the veto role", member.Address)
+	}
+
+	return nil
+}
+
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
+// - sets the proposal's `ExecutableAt` field if it is accepted,
 // - prune all the votes.
 func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo group.GroupInfo, policyInfo group.GroupPolicyInfo) error {
 	policy, err := policyInfo.GetDecisionPolicy()
@@ -779,14 +822,32 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+			executableAt := proposalExecutableAt(ctx, *p, policy)
+			p.ExecutableAt = &executableAt
 		} else {
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
+
+		// Keep the group members the final tally was computed against.
+		if err := k.snapshotProposalTally(ctx, p.Id, groupInfo); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// proposalExecutableAt returns the earliest time at which a proposal accepted
+// at the current block time can be executed.
+func proposalExecutableAt(ctx sdk.Context, p group.Proposal, policy group.DecisionPolicy) time.Time {
+	executableAt := ctx.BlockTime().Add(policy.GetMinExecutionDelay())
+	if minExecutionDate := p.SubmitTime.Add(policy.GetMinExecutionPeriod()); minExecutionDate.After(executableAt) {
+		return minExecutionDate
+	}
+
+	return executableAt
+}
+
 // Exec executes the messages from a proposal.
 func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecResponse, error) {
 	if msg.ProposalId == 0 {
@@ -822,6 +883,86 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
+	logs, err := k.executeProposal(ctx, &proposal, policyInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       logs,
+		Result:     proposal.ExecutorResult,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgExecResponse{
+		Result: proposal.ExecutorResult,
+	}, nil
+}
+
+// RetryExec re-attempts the execution of an accepted proposal whose previous
+// execution failed, at most MaxExecRetries times per proposal.
+func (k Keeper) RetryExec(goCtx context.Context, msg *group.MsgRetryExec) (*group.MsgRetryExecResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if _, err := k.accKeeper.StringToBytes(msg.Executor); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid executor address: %s", msg.Executor)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED || proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "not possible to retry exec with proposal status %s and executor result %s", proposal.Status, proposal.ExecutorResult)
+	}
+
+	expiryDate := proposal.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod)
+	if expiryDate.Before(ctx.BlockTime()) {
+		return nil, errors.ErrExpired.Wrapf("proposal expired on %s", expiryDate)
+	}
+
+	attempt := k.getProposalExecRetries(ctx, proposal.Id) + 1
+	if attempt > k.config.MaxExecRetries {
+		return nil, errorsmod.Wrapf(errors.ErrMaxLimit, "proposal %d execution already retried %d times", proposal.Id, k.config.MaxExecRetries)
+	}
+	k.setProposalExecRetries(ctx, proposal.Id, attempt)
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	logs, err := k.executeProposal(ctx, &proposal, policyInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventRetryExec{
+		ProposalId: proposal.Id,
+		Attempt:    attempt,
+		Result:     proposal.ExecutorResult,
+		Logs:       logs,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgRetryExecResponse{
+		Result:  proposal.ExecutorResult,
+		Attempt: attempt,
+	}, nil
+}
+
+// executeProposal executes the messages of the proposal if it is accepted and
+// not successfully executed yet, and updates the proposal in state. A
+// successfully executed proposal is pruned. It returns the failure logs of the
+// execution, if any.
+func (k Keeper) executeProposal(ctx sdk.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) (string, error) {
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
@@ -830,17 +971,18 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 
 		addr, err := k.accKeeper.StringToBytes(policyInfo.Address)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
 		decisionPolicy := policyInfo.DecisionPolicy.GetCachedValue().(group.DecisionPolicy)
-		if results, err := k.doExecuteMsgs(cacheCtx, k.router, proposal, addr, decisionPolicy); err != nil {
+		if results, err := k.doExecuteMsgs(cacheCtx, k.router, *proposal, addr, decisionPolicy); err != nil {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
 			k.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
 		} else {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
 			flush()
+			k.incGroupPolicyExecutedCount(ctx, addr)
 
 			for _, res := range results {
 				// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
@@ -853,26 +995,16 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
-			return nil, err
+			return "", err
 		}
 	} else {
 		store := ctx.KVStore(k.key)
-		if err := k.proposalTable.Update(store, proposal.Id, &proposal); err != nil {
-			return nil, err
+		if err := k.proposalTable.Update(store, proposal.Id, proposal); err != nil {
+			return "", err
 		}
 	}
 
-	if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
-		ProposalId: proposal.Id,
-		Logs:       logs,
-		Result:     proposal.ExecutorResult,
-	}); err != nil {
-		return nil, err
-	}
-
-	return &group.MsgExecResponse{
-		Result: proposal.ExecutorResult,
-	}, nil
+	return logs, nil
 }
 
 // LeaveGroup implements the MsgServer/LeaveGroup method.
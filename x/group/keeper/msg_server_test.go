@@ -2236,7 +2236,7 @@ func (s *TestSuite) TestVote() {
 			req: &group.MsgVote{
 				ProposalId: myProposalID,
 				Voter:      addr4.String(),
-				Option:     5,
+				Option:     6,
 			},
 			expErr:    true,
 			expErrMsg: "ote option: invalid value",
@@ -2463,6 +2463,94 @@ func (s *TestSuite) TestVote() {
 	s.Require().NotEqual(tallyResult.String(), tallyResult1.String())
 }
 
+func (s *TestSuite) TestVoteWithVeto() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr3 := addrs[2]
+	addr4 := addrs[3]
+
+	members := []group.MemberRequest{
+		{Address: addr2.String(), Weight: "1"},
+		{Address: addr3.String(), Weight: "2"},
+		{Address: addr4.String(), Weight: "5"},
+	}
+	policy := group.NewThresholdWithVetoDecisionPolicy("2", time.Hour, 0, []string{addr2.String()}, "5")
+	policyAddr, _ := s.createGroupAndGroupPolicy(addr1, members, policy)
+
+	submitProposal := func() uint64 {
+		res, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{addr2.String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	specs := map[string]struct {
+		before    []group.VoteOption // votes of addr3 before the veto
+		voter     sdk.AccAddress
+		expErrMsg string
+	}{
+		"veto member vetoes before threshold is reached": {
+			voter: addr2,
+		},
+		"veto member vetoes after threshold is reached": {
+			before: []group.VoteOption{group.VOTE_OPTION_YES},
+			voter:  addr2,
+		},
+		"member above veto weight threshold vetoes after threshold is reached": {
+			before: []group.VoteOption{group.VOTE_OPTION_YES},
+			voter:  addr4,
+		},
+		"member without veto role": {
+			voter:     addr3,
+			expErrMsg: "does not hold the veto role",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			proposalID := submitProposal()
+			for _, option := range spec.before {
+				_, err := s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: addr3.String(), Option: option})
+				s.Require().NoError(err)
+			}
+
+			_, err := s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+				ProposalId: proposalID,
+				Voter:      spec.voter.String(),
+				Option:     group.VOTE_OPTION_VETO,
+			})
+			if spec.expErrMsg != "" {
+				s.Require().ErrorContains(err, spec.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, res.Proposal.Status)
+			vetoCount, err := res.Proposal.FinalTallyResult.GetVetoCount()
+			s.Require().NoError(err)
+			s.Require().False(vetoCount.IsZero())
+		})
+	}
+
+	// a group policy without veto role doesn't accept veto votes
+	res, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Proposers:          []string{addrs[1].String()},
+	})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: res.ProposalId,
+		Voter:      addrs[1].String(),
+		Option:     group.VOTE_OPTION_VETO,
+	})
+	s.Require().ErrorContains(err, "has no veto role")
+}
+
 func (s *TestSuite) TestExecProposal() {
 	addrs := s.addrs
 	addr1 := addrs[0]
@@ -2687,6 +2775,247 @@ func (s *TestSuite) TestExecProposal() {
 	}
 }
 
+func (s *TestSuite) TestRetryExecProposal() {
+	addr2 := s.addrs[1]
+	proposers := []string{addr2.String()}
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 20000)},
+	}
+	insufficientFunds := fmt.Errorf("insufficient funds")
+
+	retryExec := func(ctx sdk.Context, proposalID uint64) (*group.MsgRetryExecResponse, *group.EventRetryExec, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		res, err := s.groupKeeper.RetryExec(ctx, &group.MsgRetryExec{ProposalId: proposalID, Executor: addr2.String()})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type == sdk.EventTypeMessage {
+				continue
+			}
+			event, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+			if e, ok := event.(*group.EventRetryExec); ok {
+				return res, e, nil
+			}
+		}
+		s.FailNow("missing EventRetryExec")
+		return nil, nil, nil
+	}
+
+	s.Run("retry after funding the group policy account", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+		ctx := sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+
+		// retrying a proposal which hasn't been executed yet is not possible
+		_, _, err := retryExec(ctx, proposalID)
+		s.Require().ErrorContains(err, "not possible to retry exec")
+
+		// the group policy account doesn't have enough funds
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, insufficientFunds).Times(2)
+		execRes, err := s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: addr2.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, execRes.Result)
+
+		res, event, err := retryExec(ctx, proposalID)
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, res.Result)
+		s.Require().Equal(uint64(1), res.Attempt)
+		s.Require().Equal(uint64(1), event.Attempt)
+		s.Require().Contains(event.Logs, insufficientFunds.Error())
+
+		// the group policy account is funded
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+		res, event, err = retryExec(ctx, proposalID)
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+		s.Require().Equal(uint64(2), res.Attempt)
+		s.Require().Equal(uint64(2), event.Attempt)
+		s.Require().Empty(event.Logs)
+
+		// successfully executed proposals are pruned
+		_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().ErrorContains(err, "not found")
+	})
+
+	s.Run("retries are bounded", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+		ctx := sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+
+		maxRetries := group.DefaultConfig().MaxExecRetries
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, insufficientFunds).Times(int(maxRetries) + 1)
+		_, err := s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: addr2.String()})
+		s.Require().NoError(err)
+
+		for i := uint64(1); i <= maxRetries; i++ {
+			res, _, err := retryExec(ctx, proposalID)
+			s.Require().NoError(err)
+			s.Require().Equal(i, res.Attempt)
+		}
+
+		_, _, err = retryExec(ctx, proposalID)
+		s.Require().ErrorContains(err, "limit exceeded")
+	})
+
+	s.Run("retry after the execution window", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+		ctx := sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, insufficientFunds)
+		_, err := s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: addr2.String()})
+		s.Require().NoError(err)
+
+		res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		ctx = ctx.WithBlockTime(res.Proposal.VotingPeriodEnd.Add(group.DefaultConfig().MaxExecutionPeriod + 1))
+
+		_, _, err = retryExec(ctx, proposalID)
+		s.Require().ErrorContains(err, "expired")
+	})
+}
+
+func (s *TestSuite) TestExecProposalTimelock() {
+	admin, member := s.addrs[0], s.addrs[2]
+	minExecutionDelay := time.Hour
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:      time.Hour,
+			MinExecutionDelay: &minExecutionDelay,
+		},
+	}
+	policyAddr, _ := s.createGroupAndGroupPolicy(admin, []group.MemberRequest{{Address: member.String(), Weight: "1"}}, policy)
+	msgSend := &banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   member.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	exec := func(ctx sdk.Context, proposalID uint64) (group.ProposalExecutorResult, string) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		res, err := s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: member.String()})
+		s.Require().NoError(err)
+
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			if event.Type == sdk.EventTypeMessage {
+				continue
+			}
+			event, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+			if e, ok := event.(*group.EventExec); ok {
+				return res.Result, e.Logs
+			}
+		}
+		s.FailNow("missing EventExec")
+		return res.Result, ""
+	}
+
+	// acceptProposal submits a proposal which is accepted right away by the
+	// vote of the only group member, checks that it is timelocked for the
+	// given delay, and returns its id.
+	acceptProposal := func(ctx sdk.Context, delay time.Duration) uint64 {
+		req := &group.MsgSubmitProposal{GroupPolicyAddress: policyAddr, Proposers: []string{member.String()}}
+		s.Require().NoError(req.SetMsgs([]sdk.Msg{msgSend}))
+		res, err := s.groupKeeper.SubmitProposal(ctx, req)
+		s.Require().NoError(err)
+
+		// the proposal is accepted, but the execution tried at once fails
+		_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{
+			ProposalId: res.ProposalId,
+			Voter:      member.String(),
+			Option:     group.VOTE_OPTION_YES,
+			Exec:       group.Exec_EXEC_TRY,
+		})
+		s.Require().NoError(err)
+
+		proposal, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: res.ProposalId})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Proposal.Status)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.Proposal.ExecutorResult)
+		s.Require().NotNil(proposal.Proposal.ExecutableAt)
+		s.Require().Equal(ctx.BlockTime().Add(delay), *proposal.Proposal.ExecutableAt)
+
+		return res.ProposalId
+	}
+
+	acceptedAt := s.blockTime
+	executableAt := acceptedAt.Add(minExecutionDelay)
+
+	specs := map[string]struct {
+		srcBlockTime      time.Time
+		expExecutorResult group.ProposalExecutorResult
+	}{
+		"before the min execution delay": {
+			srcBlockTime:      executableAt.Add(-time.Second),
+			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_FAILURE,
+		},
+		"exactly at the min execution delay": {
+			srcBlockTime:      executableAt,
+			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_SUCCESS,
+		},
+		"after the min execution delay": {
+			srcBlockTime:      executableAt.Add(time.Second),
+			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_SUCCESS,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			proposalID := acceptProposal(sdkCtx.WithBlockTime(acceptedAt), minExecutionDelay)
+
+			if spec.expExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+				s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+			}
+			result, logs := exec(sdkCtx.WithBlockTime(spec.srcBlockTime), proposalID)
+			s.Require().Equal(spec.expExecutorResult, result)
+			if spec.expExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_FAILURE {
+				s.Require().Contains(logs, fmt.Sprintf("must wait until %s", executableAt))
+			}
+		})
+	}
+
+	s.Run("policy updates don't apply to accepted proposals", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := acceptProposal(sdkCtx.WithBlockTime(acceptedAt), minExecutionDelay)
+
+		// double the min execution delay after the proposal is accepted
+		longerDelay := 2 * minExecutionDelay
+		updatedPolicy := &group.ThresholdDecisionPolicy{
+			Threshold: "1",
+			Windows: &group.DecisionPolicyWindows{
+				VotingPeriod:      time.Hour,
+				MinExecutionDelay: &longerDelay,
+			},
+		}
+		req, err := group.NewMsgUpdateGroupPolicyDecisionPolicy(admin, sdk.MustAccAddressFromBech32(policyAddr), updatedPolicy)
+		s.Require().NoError(err)
+		_, err = s.groupKeeper.UpdateGroupPolicyDecisionPolicy(sdkCtx, req)
+		s.Require().NoError(err)
+
+		res, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, res.Proposal.Status)
+		s.Require().Equal(executableAt, *res.Proposal.ExecutableAt)
+
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+		result, _ := exec(sdkCtx.WithBlockTime(executableAt), proposalID)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, result)
+
+		// the longer delay applies to the proposals accepted afterwards
+		proposalID = acceptProposal(sdkCtx.WithBlockTime(acceptedAt), longerDelay)
+		result, logs := exec(sdkCtx.WithBlockTime(acceptedAt.Add(longerDelay).Add(-time.Second)), proposalID)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, result)
+		s.Require().Contains(logs, fmt.Sprintf("must wait until %s", acceptedAt.Add(longerDelay)))
+	})
+}
+
 func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	addrs := s.addrs
 	addr1 := addrs[0]
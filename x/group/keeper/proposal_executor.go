@@ -21,6 +21,12 @@ func (s Keeper) doExecuteMsgs(ctx sdk.Context, router baseapp.MessageRouter, pro
 		return nil, errors.ErrInvalid.Wrapf("must wait until %s to execute proposal %d", minExecutionDate, proposal.Id)
 	}
 
+	// Ensure the min execution delay after acceptance has passed. It is fixed
+	// at acceptance, so that decision policy updates don't apply retroactively.
+	if proposal.ExecutableAt != nil && ctx.BlockTime().Before(*proposal.ExecutableAt) {
+		return nil, errors.ErrInvalid.Wrapf("proposal %d is timelocked: must wait until %s to execute it", proposal.Id, *proposal.ExecutableAt)
+	}
+
 	// Ensure it's not too late to execute the messages.
 	// After https://github.com/cosmos/cosmos-sdk/issues/11245, proposals should
 	// be pruned automatically, so this function should not even be called, as
@@ -54,11 +60,31 @@ func (s Keeper) doExecuteMsgs(ctx sdk.Context, router baseapp.MessageRouter, pro
 			return nil, fmt.Errorf("got nil sdk.Result for message %q at position %d", msg, i)
 		}
 
+		// The message is not part of a transaction, so baseapp does not attribute
+		// it to its signer: emit the message event on behalf of the group policy.
+		r.Events = append(policyMsgEvents(msg, groupPolicyAcc).ToABCIEvents(), r.Events...)
 		results[i] = *r
 	}
 	return results, nil
 }
 
+// policyMsgEvents returns the message event of a message executed by a group
+// policy account, attributing it to the group policy account the same way
+// baseapp attributes the messages of a transaction to their signer.
+func policyMsgEvents(msg sdk.Msg, groupPolicyAcc sdk.AccAddress) sdk.Events {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	event := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyAction, msgTypeURL),
+		sdk.NewAttribute(sdk.AttributeKeySender, groupPolicyAcc.String()),
+	)
+	if moduleName := sdk.GetModuleNameFromTypeURL(msgTypeURL); moduleName != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyModule, moduleName))
+	}
+
+	return sdk.Events{event}
+}
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them
 // are equal to the given account address of group policy.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress) error {
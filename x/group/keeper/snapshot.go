@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+// proposalTallyGroupVersionKey returns the key under which the version of the
+// group the final tally of a proposal was computed against is stored.
+func proposalTallyGroupVersionKey(proposalID uint64) []byte {
+	return append([]byte{ProposalTallyGroupVersionPrefix}, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// getProposalTallyGroupVersion returns the version of the group the final
+// tally of a proposal was computed against, and false if the tally of the
+// proposal is not final or was computed before snapshots were introduced.
+func (k Keeper) getProposalTallyGroupVersion(ctx sdk.Context, proposalID uint64) (uint64, bool) {
+	bz := ctx.KVStore(k.key).Get(proposalTallyGroupVersionKey(proposalID))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// getGroupMembersSnapshot returns the snapshot of the members of a group at
+// the given group version.
+func (k Keeper) getGroupMembersSnapshot(ctx sdk.Context, groupID, groupVersion uint64) (group.GroupMembersSnapshot, error) {
+	var snapshot group.GroupMembersSnapshot
+	err := k.groupMembersSnapshotTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMembersSnapshot{
+		GroupId:      groupID,
+		GroupVersion: groupVersion,
+	}), &snapshot)
+	return snapshot, err
+}
+
+// currentGroupMembersSnapshot returns a snapshot of the current members of the
+// group, without storing it.
+func (k Keeper) currentGroupMembersSnapshot(ctx sdk.Context, groupInfo group.GroupInfo) (group.GroupMembersSnapshot, error) {
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupInfo.Id)
+	if err != nil {
+		return group.GroupMembersSnapshot{}, err
+	}
+	defer it.Close()
+
+	snapshot := group.GroupMembersSnapshot{
+		GroupId:      groupInfo.Id,
+		GroupVersion: groupInfo.Version,
+		TotalWeight:  groupInfo.TotalWeight,
+	}
+	for {
+		var member group.GroupMember
+		_, err := it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return group.GroupMembersSnapshot{}, err
+		}
+		snapshot.Members = append(snapshot.Members, member.Member)
+	}
+
+	return snapshot, nil
+}
+
+// snapshotProposalTally records that the final tally of a proposal was
+// computed against the current members of the group, taking a snapshot of
+// them if the current group version has none yet.
+func (k Keeper) snapshotProposalTally(ctx sdk.Context, proposalID uint64, groupInfo group.GroupInfo) error {
+	snapshot, err := k.getGroupMembersSnapshot(ctx, groupInfo.Id, groupInfo.Version)
+	if sdkerrors.ErrNotFound.Is(err) {
+		snapshot, err = k.currentGroupMembersSnapshot(ctx, groupInfo)
+	}
+	if err != nil {
+		return err
+	}
+
+	snapshot.ProposalIds = append(snapshot.ProposalIds, proposalID)
+	if err := k.groupMembersSnapshotTable.Set(ctx.KVStore(k.key), &snapshot); err != nil {
+		return errorsmod.Wrap(err, "group members snapshot")
+	}
+
+	ctx.KVStore(k.key).Set(proposalTallyGroupVersionKey(proposalID), sdk.Uint64ToBigEndian(groupInfo.Version))
+	return nil
+}
+
+// releaseProposalTally removes the reference of a proposal to the snapshot of
+// the group members its final tally was computed against, and deletes the
+// snapshot once no proposal references it anymore.
+func (k Keeper) releaseProposalTally(ctx sdk.Context, proposalID, groupID uint64) error {
+	groupVersion, found := k.getProposalTallyGroupVersion(ctx, proposalID)
+	if !found {
+		return nil
+	}
+	ctx.KVStore(k.key).Delete(proposalTallyGroupVersionKey(proposalID))
+
+	snapshot, err := k.getGroupMembersSnapshot(ctx, groupID, groupVersion)
+	if err != nil {
+		return errorsmod.Wrap(err, "group members snapshot")
+	}
+
+	proposalIDs := make([]uint64, 0, len(snapshot.ProposalIds))
+	for _, id := range snapshot.ProposalIds {
+		if id != proposalID {
+			proposalIDs = append(proposalIDs, id)
+		}
+	}
+
+	if len(proposalIDs) == 0 {
+		return k.groupMembersSnapshotTable.Delete(ctx.KVStore(k.key), &snapshot)
+	}
+
+	snapshot.ProposalIds = proposalIDs
+	return k.groupMembersSnapshotTable.Set(ctx.KVStore(k.key), &snapshot)
+}
@@ -23,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
@@ -213,8 +214,9 @@ type GroupInputs struct {
 type GroupOutputs struct {
 	depinject.Out
 
-	GroupKeeper keeper.Keeper
-	Module      appmodule.AppModule
+	GroupKeeper                 keeper.Keeper
+	Module                      appmodule.AppModule
+	AuthzAcceptContextDecorator authz.AcceptContextDecorator
 }
 
 func ProvideModule(in GroupInputs) GroupOutputs {
@@ -224,7 +226,11 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 		in.Config.MaxExecutionPeriod = "1209600s"
 	*/
 
-	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper, group.Config{MaxExecutionPeriod: in.Config.MaxExecutionPeriod.AsDuration(), MaxMetadataLen: in.Config.MaxMetadataLen})
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper, in.BankKeeper, group.Config{MaxExecutionPeriod: in.Config.MaxExecutionPeriod.AsDuration(), MaxMetadataLen: in.Config.MaxMetadataLen})
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
-	return GroupOutputs{GroupKeeper: k, Module: m}
+	return GroupOutputs{
+		GroupKeeper:                 k,
+		Module:                      m,
+		AuthzAcceptContextDecorator: group.AuthzAcceptContextDecorator(k),
+	}
 }
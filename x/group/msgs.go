@@ -452,6 +452,23 @@ func (m MsgExec) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+var (
+	_ sdk.Msg            = &MsgRetryExec{}
+	_ legacytx.LegacyMsg = &MsgRetryExec{}
+)
+
+// GetSignBytes Implements Msg.
+func (m MsgRetryExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRetryExec.
+func (m MsgRetryExec) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Executor)
+
+	return []sdk.AccAddress{signer}
+}
+
 var (
 	_ sdk.Msg            = &MsgLeaveGroup{}
 	_ legacytx.LegacyMsg = &MsgLeaveGroup{}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1381,6 +1383,99 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryProposalTallyContextRequest is the Query/ProposalTallyContext request type.
+type QueryProposalTallyContextRequest struct {
+	// proposal_id is the unique id of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalTallyContextRequest) Reset()         { *m = QueryProposalTallyContextRequest{} }
+func (m *QueryProposalTallyContextRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTallyContextRequest) ProtoMessage()    {}
+func (*QueryProposalTallyContextRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{26}
+}
+func (m *QueryProposalTallyContextRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTallyContextRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTallyContextRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTallyContextRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTallyContextRequest.Merge(m, src)
+}
+func (m *QueryProposalTallyContextRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTallyContextRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTallyContextRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTallyContextRequest proto.InternalMessageInfo
+
+func (m *QueryProposalTallyContextRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalTallyContextResponse is the Query/ProposalTallyContext response type.
+type QueryProposalTallyContextResponse struct {
+	// snapshot is the set of group members the proposal is tallied against. Its
+	// proposal_ids are empty if the tally of the proposal is not final yet.
+	Snapshot *GroupMembersSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *QueryProposalTallyContextResponse) Reset()         { *m = QueryProposalTallyContextResponse{} }
+func (m *QueryProposalTallyContextResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTallyContextResponse) ProtoMessage()    {}
+func (*QueryProposalTallyContextResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{27}
+}
+func (m *QueryProposalTallyContextResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTallyContextResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTallyContextResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTallyContextResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTallyContextResponse.Merge(m, src)
+}
+func (m *QueryProposalTallyContextResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTallyContextResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTallyContextResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTallyContextResponse proto.InternalMessageInfo
+
+func (m *QueryProposalTallyContextResponse) GetSnapshot() *GroupMembersSnapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
 // QueryGroupsRequest is the Query/Groups request type.
 //
 // Since: cosmos-sdk 0.47.1
@@ -1393,7 +1488,7 @@ func (m *QueryGroupsRequest) Reset()         { *m = QueryGroupsRequest{} }
 func (m *QueryGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsRequest) ProtoMessage()    {}
 func (*QueryGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{26}
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
 }
 func (m *QueryGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1443,7 +1538,7 @@ func (m *QueryGroupsResponse) Reset()         { *m = QueryGroupsResponse{} }
 func (m *QueryGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsResponse) ProtoMessage()    {}
 func (*QueryGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{27}
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
 }
 func (m *QueryGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,6 +1581,144 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGroupPolicyTreasuryRequest is the Query/GroupPolicyTreasury request type.
+//
+// Since: cosmos-sdk 0.48
+type QueryGroupPolicyTreasuryRequest struct {
+	// policy_address is the account address of the group policy.
+	PolicyAddress string `protobuf:"bytes,1,opt,name=policy_address,json=policyAddress,proto3" json:"policy_address,omitempty"`
+}
+
+func (m *QueryGroupPolicyTreasuryRequest) Reset()         { *m = QueryGroupPolicyTreasuryRequest{} }
+func (m *QueryGroupPolicyTreasuryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupPolicyTreasuryRequest) ProtoMessage()    {}
+func (*QueryGroupPolicyTreasuryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryGroupPolicyTreasuryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupPolicyTreasuryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupPolicyTreasuryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupPolicyTreasuryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupPolicyTreasuryRequest.Merge(m, src)
+}
+func (m *QueryGroupPolicyTreasuryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupPolicyTreasuryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupPolicyTreasuryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupPolicyTreasuryRequest proto.InternalMessageInfo
+
+func (m *QueryGroupPolicyTreasuryRequest) GetPolicyAddress() string {
+	if m != nil {
+		return m.PolicyAddress
+	}
+	return ""
+}
+
+// QueryGroupPolicyTreasuryResponse is the Query/GroupPolicyTreasury response type.
+//
+// Since: cosmos-sdk 0.48
+type QueryGroupPolicyTreasuryResponse struct {
+	// balances are the current balances of the group policy account.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// committed_spends is the sum of the amounts sent from the group policy
+	// account by the bank MsgSend and MsgMultiSend messages of its pending
+	// proposals, i.e. the proposals being voted on and the accepted proposals
+	// which are not executed yet. Other messages are ignored.
+	CommittedSpends github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=committed_spends,json=committedSpends,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"committed_spends"`
+	// open_proposals is the number of proposals of the group policy being voted on.
+	OpenProposals uint64 `protobuf:"varint,3,opt,name=open_proposals,json=openProposals,proto3" json:"open_proposals,omitempty"`
+	// accepted_proposals is the number of accepted proposals of the group policy
+	// which are not executed yet.
+	AcceptedProposals uint64 `protobuf:"varint,4,opt,name=accepted_proposals,json=acceptedProposals,proto3" json:"accepted_proposals,omitempty"`
+	// executed_proposals is the number of proposals of the group policy which
+	// were successfully executed. Executed proposals are pruned from state, so
+	// this is tracked by a counter incremented on each successful execution.
+	ExecutedProposals uint64 `protobuf:"varint,5,opt,name=executed_proposals,json=executedProposals,proto3" json:"executed_proposals,omitempty"`
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) Reset()         { *m = QueryGroupPolicyTreasuryResponse{} }
+func (m *QueryGroupPolicyTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupPolicyTreasuryResponse) ProtoMessage()    {}
+func (*QueryGroupPolicyTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryGroupPolicyTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupPolicyTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupPolicyTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupPolicyTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupPolicyTreasuryResponse.Merge(m, src)
+}
+func (m *QueryGroupPolicyTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupPolicyTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupPolicyTreasuryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupPolicyTreasuryResponse proto.InternalMessageInfo
+
+func (m *QueryGroupPolicyTreasuryResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) GetCommittedSpends() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommittedSpends
+	}
+	return nil
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) GetOpenProposals() uint64 {
+	if m != nil {
+		return m.OpenProposals
+	}
+	return 0
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) GetAcceptedProposals() uint64 {
+	if m != nil {
+		return m.AcceptedProposals
+	}
+	return 0
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) GetExecutedProposals() uint64 {
+	if m != nil {
+		return m.ExecutedProposals
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1513,96 +1746,117 @@ func init() {
 	proto.RegisterType((*QueryGroupsByMemberResponse)(nil), "cosmos.group.v1.QueryGroupsByMemberResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.group.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryProposalTallyContextRequest)(nil), "cosmos.group.v1.QueryProposalTallyContextRequest")
+	proto.RegisterType((*QueryProposalTallyContextResponse)(nil), "cosmos.group.v1.QueryProposalTallyContextResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryGroupPolicyTreasuryRequest)(nil), "cosmos.group.v1.QueryGroupPolicyTreasuryRequest")
+	proto.RegisterType((*QueryGroupPolicyTreasuryResponse)(nil), "cosmos.group.v1.QueryGroupPolicyTreasuryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0xa6, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x2d,
-	0xf9, 0x9d, 0xdd, 0xd8, 0x49, 0x53, 0x04, 0x14, 0x54, 0x4b, 0x10, 0x72, 0x28, 0x4a, 0x4d, 0x85,
-	0x04, 0x42, 0x8a, 0xd6, 0xf1, 0xc6, 0xac, 0xb0, 0x77, 0x5c, 0xef, 0x26, 0xc2, 0x8a, 0x7c, 0x41,
-	0x82, 0x03, 0xe2, 0x00, 0x2d, 0x42, 0x25, 0xe2, 0xd0, 0x03, 0x12, 0x3d, 0x72, 0x00, 0x21, 0x71,
-	0xeb, 0xad, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x23, 0xb8, 0xa0, 0x9d, 0x79, 0x6b,
-	0xef, 0x6f, 0x6f, 0x84, 0x45, 0x73, 0x69, 0xeb, 0x9d, 0x37, 0xf3, 0x3e, 0xf3, 0x7d, 0x6f, 0x67,
-	0xbf, 0x53, 0xb8, 0xbc, 0xcd, 0xac, 0x2a, 0xb3, 0xd4, 0x72, 0x9d, 0xed, 0xd6, 0xd4, 0xbd, 0xac,
-	0x7a, 0x77, 0x57, 0xaf, 0x37, 0x94, 0x5a, 0x9d, 0xd9, 0x8c, 0x8e, 0x88, 0x41, 0x85, 0x0f, 0x2a,
-	0x7b, 0x59, 0x69, 0xb4, 0xcc, 0xca, 0x8c, 0x8f, 0xa9, 0xce, 0xbf, 0x44, 0x98, 0x34, 0x51, 0x66,
-	0xac, 0x5c, 0xd1, 0x55, 0xad, 0x66, 0xa8, 0x9a, 0x69, 0x32, 0x5b, 0xb3, 0x0d, 0x66, 0x5a, 0x38,
-	0x1a, 0xca, 0x60, 0x37, 0x6a, 0xba, 0x3b, 0x38, 0x8f, 0x83, 0x45, 0xcd, 0xd2, 0x45, 0x6a, 0x75,
-	0x2f, 0x5b, 0xd4, 0x6d, 0x2d, 0xab, 0xd6, 0xb4, 0xb2, 0x61, 0xf2, 0x95, 0x30, 0x76, 0x5c, 0xc4,
-	0x6e, 0x89, 0xfc, 0x88, 0x26, 0x86, 0xce, 0x69, 0x55, 0xc3, 0x64, 0x2a, 0xff, 0x53, 0x3c, 0x92,
-	0x73, 0x70, 0xe1, 0xb6, 0xb3, 0xde, 0xba, 0x93, 0x76, 0xc3, 0xdc, 0x61, 0x05, 0xfd, 0xee, 0xae,
-	0x6e, 0xd9, 0x74, 0x1c, 0x06, 0x39, 0xca, 0x96, 0x51, 0x1a, 0x23, 0x53, 0x64, 0xb6, 0xb7, 0x30,
-	0xc0, 0x7f, 0x6f, 0x94, 0xe4, 0x37, 0xe1, 0x62, 0x70, 0x8e, 0x55, 0x63, 0xa6, 0xa5, 0x53, 0x05,
-	0x7a, 0x0d, 0x73, 0x87, 0xf1, 0x09, 0xc3, 0x39, 0x49, 0x09, 0x08, 0xa3, 0xb4, 0x67, 0xf0, 0x38,
-	0xf9, 0x36, 0x5c, 0x6e, 0xaf, 0xb4, 0xc9, 0x2a, 0xc6, 0x76, 0xc3, 0xcb, 0x90, 0x83, 0x01, 0xad,
-	0x54, 0xaa, 0xeb, 0x96, 0xc5, 0x57, 0x1c, 0xca, 0x8f, 0xfd, 0xfa, 0xe3, 0xd2, 0x28, 0x2e, 0x7a,
-	0x53, 0x8c, 0xbc, 0x6d, 0xd7, 0x0d, 0xb3, 0x5c, 0x70, 0x03, 0xe5, 0x3b, 0x30, 0x11, 0xbd, 0x24,
-	0x22, 0xae, 0xfa, 0x10, 0xa7, 0xa2, 0x11, 0x3d, 0xf3, 0x04, 0x68, 0x13, 0xc6, 0xda, 0xab, 0xde,
-	0xd2, 0xab, 0x45, 0xbd, 0x6e, 0x75, 0x56, 0x8a, 0xbe, 0x01, 0xd0, 0xae, 0xcf, 0xd8, 0x29, 0x9e,
-	0x72, 0xda, 0x4d, 0xe9, 0x14, 0x53, 0x11, 0x7d, 0x84, 0xc5, 0x54, 0x36, 0xb5, 0xb2, 0x8e, 0xcb,
-	0x16, 0x3c, 0x33, 0xe5, 0x6f, 0x09, 0x8c, 0x47, 0xe4, 0xc7, 0x2d, 0xad, 0xc1, 0x40, 0x55, 0x3c,
-	0x1a, 0x23, 0x53, 0xcf, 0xcd, 0x0e, 0xe7, 0x26, 0xa2, 0x77, 0x25, 0xe6, 0x15, 0xdc, 0x60, 0xba,
-	0x1e, 0x41, 0x37, 0xd3, 0x91, 0x4e, 0x24, 0xf5, 0xe1, 0xdd, 0xf7, 0xe1, 0x59, 0xf9, 0xc6, 0xcd,
-	0x52, 0xd5, 0x30, 0x5d, 0x7d, 0x14, 0xe8, 0xd3, 0x9c, 0xdf, 0x1d, 0x6b, 0x28, 0xc2, 0xba, 0x26,
-	0xda, 0x37, 0x04, 0xa4, 0x28, 0x2a, 0x54, 0x2d, 0x07, 0xfd, 0x5c, 0x1e, 0x57, 0xb4, 0xa4, 0x6e,
-	0xc5, 0xc8, 0xee, 0x29, 0xf6, 0x09, 0x81, 0xa9, 0x40, 0x9b, 0x1a, 0xba, 0x95, 0x17, 0x3f, 0xff,
-	0xc7, 0xc6, 0xfa, 0x89, 0xc0, 0xf3, 0x09, 0x1c, 0x28, 0xd5, 0x3a, 0x9c, 0x15, 0x20, 0x35, 0x0c,
-	0x40, 0xc9, 0x3a, 0xbf, 0x3d, 0x67, 0xca, 0xde, 0x75, 0xbb, 0xa7, 0xdf, 0x41, 0x8c, 0x7e, 0x27,
-	0xa2, 0xf1, 0xe2, 0x44, 0xf5, 0xf7, 0xdf, 0xc9, 0x13, 0xf5, 0x3a, 0x8c, 0x72, 0xec, 0xcd, 0x3a,
-	0xab, 0x31, 0x4b, 0xab, 0xb8, 0x3a, 0x4e, 0xc2, 0x70, 0x0d, 0x1f, 0xb5, 0x5b, 0x11, 0xdc, 0x47,
-	0x1b, 0x25, 0xf9, 0x2d, 0xfc, 0x88, 0xb4, 0x27, 0xe2, 0x1e, 0xaf, 0xc1, 0xa0, 0x1b, 0x86, 0x07,
-	0xee, 0x78, 0x68, 0x77, 0xad, 0x49, 0xad, 0x50, 0xf9, 0x21, 0x01, 0xd9, 0xb7, 0xa0, 0xdb, 0x91,
-	0x42, 0x84, 0xff, 0xf0, 0x79, 0xe8, 0x5a, 0x8d, 0xbf, 0x27, 0x70, 0x25, 0x11, 0x11, 0x15, 0xb8,
-	0x0e, 0x43, 0xee, 0xb6, 0xdc, 0x02, 0x27, 0x48, 0xd0, 0x8e, 0xed, 0x5e, 0x55, 0xeb, 0x30, 0xc9,
-	0x41, 0xdf, 0x61, 0xb6, 0x9e, 0x6f, 0xe1, 0x3a, 0xbf, 0xea, 0x69, 0x0b, 0xec, 0xbc, 0x49, 0x7b,
-	0xce, 0x04, 0xce, 0x91, 0xf8, 0x26, 0xf1, 0x30, 0xf9, 0x16, 0xbe, 0x9d, 0x91, 0x39, 0x51, 0x99,
-	0x39, 0xe8, 0x75, 0x82, 0xb1, 0x2f, 0x2e, 0x84, 0x44, 0x71, 0xa2, 0x0b, 0x3c, 0x44, 0xfe, 0x94,
-	0xa0, 0x4f, 0x70, 0x9e, 0x59, 0xf9, 0x63, 0x37, 0x68, 0xd7, 0xaa, 0xfe, 0x15, 0x41, 0x77, 0x11,
-	0x02, 0xc1, 0x4d, 0x2d, 0x08, 0xa1, 0xdc, 0x52, 0xc7, 0xec, 0x4a, 0xc4, 0x74, 0xaf, 0xc4, 0xf7,
-	0x08, 0xda, 0x13, 0xc4, 0xf2, 0x15, 0xb7, 0x55, 0x3b, 0x92, 0xaa, 0x76, 0x5d, 0xd3, 0xea, 0x4b,
-	0xd7, 0x14, 0xf8, 0xa1, 0x9e, 0xa9, 0x50, 0x0f, 0x82, 0x96, 0x00, 0x2d, 0xd1, 0x09, 0x38, 0x50,
-	0x0e, 0x88, 0xd7, 0x0b, 0x7b, 0xd0, 0x4e, 0x82, 0x5d, 0x79, 0x09, 0x2e, 0x71, 0xb6, 0x3b, 0x5a,
-	0xa5, 0xe2, 0x9c, 0x6d, 0xbb, 0x15, 0x3b, 0xf5, 0xc7, 0xe1, 0x5d, 0xec, 0x4d, 0xdf, 0x5c, 0xdc,
-	0xd4, 0x0d, 0xe8, 0xb3, 0x9d, 0xc7, 0x78, 0x08, 0x84, 0x7d, 0xab, 0x67, 0x52, 0x7e, 0xe8, 0xc9,
-	0x1f, 0x93, 0x3d, 0x8f, 0xfe, 0xfe, 0x61, 0x9e, 0x14, 0xc4, 0x2c, 0xf9, 0x7d, 0xa0, 0x1e, 0xc9,
-	0x5c, 0xa2, 0x6e, 0x55, 0xe4, 0x1e, 0x81, 0xf3, 0xbe, 0xe5, 0x4f, 0x40, 0x25, 0x72, 0xff, 0x9c,
-	0x83, 0x3e, 0x0e, 0x45, 0x3f, 0x27, 0x30, 0xd4, 0x4a, 0x44, 0xa7, 0x43, 0x10, 0x91, 0xd7, 0x3a,
-	0x69, 0xa6, 0x63, 0x9c, 0x48, 0x2a, 0x2b, 0x1f, 0xff, 0xf6, 0xd7, 0xfd, 0x53, 0xb3, 0x74, 0x5a,
-	0x0d, 0x5e, 0x4c, 0xd1, 0x93, 0x9a, 0x3b, 0x4c, 0xdd, 0x77, 0xfd, 0x69, 0x93, 0x7e, 0x47, 0x60,
-	0x24, 0x60, 0x54, 0xe8, 0x62, 0x42, 0xb2, 0xd0, 0x6d, 0x4f, 0x5a, 0x4a, 0x19, 0x8d, 0x80, 0xab,
-	0x1c, 0x50, 0xa1, 0x8b, 0x31, 0x80, 0xdc, 0x56, 0x35, 0x90, 0x13, 0xdf, 0xd6, 0x26, 0x7d, 0x40,
-	0xe0, 0xb4, 0xf7, 0x12, 0x45, 0xe7, 0x12, 0xb2, 0xfa, 0x2f, 0x7a, 0xd2, 0x7c, 0x9a, 0x50, 0xa4,
-	0xcb, 0x72, 0xba, 0x05, 0x3a, 0x17, 0x43, 0x87, 0x77, 0x30, 0xaf, 0x82, 0x07, 0x04, 0xce, 0xf8,
-	0xae, 0x2a, 0x34, 0x29, 0x61, 0xc0, 0xec, 0x4a, 0x0b, 0xa9, 0x62, 0x91, 0x6e, 0x99, 0xd3, 0xcd,
-	0xd3, 0xd9, 0x68, 0x3a, 0x6b, 0xab, 0xd8, 0xd8, 0xe2, 0x9e, 0xd8, 0x51, 0xae, 0x6a, 0x98, 0x4d,
-	0xfa, 0x0b, 0x81, 0xd1, 0xa8, 0x3b, 0x02, 0xcd, 0x76, 0xaa, 0x5a, 0xe8, 0x5e, 0x23, 0xe5, 0x8e,
-	0x33, 0x05, 0x89, 0x5f, 0xe6, 0xc4, 0xd7, 0xe8, 0x4a, 0x52, 0xb5, 0x0d, 0x9d, 0x93, 0x8b, 0x21,
-	0x8f, 0xb2, 0x3f, 0x87, 0xe1, 0x85, 0xc0, 0xe9, 0xe0, 0x7d, 0x3a, 0xe7, 0x8e, 0x33, 0x05, 0xe1,
-	0x5f, 0xe4, 0xf0, 0x39, 0xba, 0x9c, 0x02, 0xde, 0x2f, 0xfb, 0x67, 0x04, 0x06, 0x5d, 0x93, 0x41,
-	0x5f, 0x88, 0x4e, 0x1d, 0x70, 0x43, 0xd2, 0x74, 0xa7, 0x30, 0xa4, 0x52, 0x39, 0xd5, 0x1c, 0x9d,
-	0x09, 0x51, 0xb9, 0xa7, 0xb7, 0xba, 0xef, 0x39, 0xda, 0x9b, 0xf4, 0x31, 0x81, 0x8b, 0xd1, 0x76,
-	0x97, 0xae, 0x24, 0xe7, 0x8c, 0xf4, 0xef, 0xd2, 0xea, 0xf1, 0x26, 0x21, 0xf6, 0x2b, 0x1c, 0x7b,
-	0x8d, 0xae, 0xc6, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x98, 0xc0, 0xf9, 0x08,
-	0x57, 0x4a, 0x97, 0xa3, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0x3d, 0xc6, 0x0c, 0x44, 0x7f, 0x9d, 0xa3,
-	0xbf, 0x46, 0x6f, 0x84, 0xd0, 0x1d, 0x9f, 0xe3, 0x50, 0xb7, 0xf4, 0xe6, 0x5e, 0xcc, 0xaf, 0xbf,
-	0xba, 0xcf, 0x1f, 0x36, 0xe9, 0x23, 0x02, 0x23, 0x01, 0x03, 0x1a, 0x77, 0xd4, 0x46, 0x1b, 0xe6,
-	0xb8, 0xa3, 0x36, 0xc6, 0xd5, 0x26, 0xf4, 0x2f, 0xf7, 0x67, 0x5e, 0xf0, 0x40, 0xcb, 0x7c, 0x4d,
-	0xe0, 0xb4, 0xd7, 0xff, 0xc5, 0x1d, 0xb7, 0x11, 0xc6, 0x35, 0xee, 0xb8, 0x8d, 0xb2, 0x93, 0x09,
-	0xbd, 0xdc, 0x22, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0xfa, 0x9d, 0x16, 0xed, 0x70, 0x82,
-	0xfa, 0xac, 0xa2, 0xb4, 0x98, 0x2e, 0x18, 0xf1, 0x56, 0x38, 0xde, 0x12, 0x5d, 0x48, 0x38, 0x6f,
-	0xc5, 0x17, 0xc1, 0xd3, 0xaa, 0x07, 0x04, 0x86, 0x3d, 0xfe, 0x87, 0xce, 0x46, 0xa7, 0x0c, 0x7b,
-	0x32, 0x69, 0x2e, 0x45, 0x24, 0x92, 0xad, 0x71, 0xb2, 0x65, 0xaa, 0xc4, 0xbf, 0x4d, 0x81, 0x2e,
-	0xe4, 0xd6, 0x8b, 0xda, 0xd0, 0x2f, 0xf6, 0x4a, 0xaf, 0x24, 0x29, 0xe1, 0x12, 0x5d, 0x4d, 0x0e,
-	0x42, 0x98, 0x49, 0x0e, 0x33, 0x4e, 0x2f, 0xc5, 0xc8, 0x94, 0x7f, 0xf5, 0xc9, 0x61, 0x86, 0x3c,
-	0x3d, 0xcc, 0x90, 0x3f, 0x0f, 0x33, 0xe4, 0x8b, 0xa3, 0x4c, 0xcf, 0xd3, 0xa3, 0x4c, 0xcf, 0xef,
-	0x47, 0x99, 0x9e, 0xf7, 0xae, 0x96, 0x0d, 0xfb, 0x83, 0xdd, 0xa2, 0xb2, 0xcd, 0xaa, 0xee, 0x64,
-	0xf1, 0xd7, 0x92, 0x55, 0xfa, 0x50, 0xfd, 0x48, 0x2c, 0x50, 0xec, 0xe7, 0xff, 0xe9, 0xbd, 0xf2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb3, 0x86, 0xf8, 0xcf, 0x17, 0x00, 0x00,
+	// 1582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc0, 0x33, 0x69, 0xd2, 0x26, 0x2f, 0x4d, 0xf2, 0xed, 0x34, 0x6d, 0x9d, 0x6d, 0x94, 0xa4,
+	0xdb, 0x36, 0xcd, 0x8f, 0xc6, 0x1b, 0x3b, 0x69, 0xda, 0x2f, 0xd0, 0x56, 0x75, 0x05, 0xa5, 0x87,
+	0xa2, 0xd6, 0xad, 0x90, 0x40, 0x48, 0xd6, 0xda, 0xde, 0xb8, 0x2b, 0xec, 0x1d, 0xd7, 0xbb, 0x8e,
+	0x1a, 0x45, 0xbe, 0x20, 0xc1, 0x01, 0x71, 0x80, 0x16, 0xa1, 0x12, 0x71, 0xe8, 0x01, 0x89, 0x8a,
+	0x13, 0x07, 0x10, 0x12, 0x88, 0x43, 0x6f, 0x3d, 0x56, 0x70, 0xe1, 0x04, 0xa8, 0x45, 0xe2, 0x6f,
+	0xe0, 0x86, 0x76, 0xe6, 0xad, 0xbd, 0xeb, 0xfd, 0xe1, 0xb5, 0x30, 0x90, 0x4b, 0xeb, 0x9d, 0x79,
+	0x6f, 0xde, 0x67, 0xde, 0x7b, 0x33, 0xfb, 0xde, 0x06, 0x8e, 0x16, 0x98, 0x59, 0x61, 0xa6, 0x52,
+	0xaa, 0xb1, 0x7a, 0x55, 0xd9, 0x4c, 0x29, 0x77, 0xea, 0x5a, 0x6d, 0x2b, 0x59, 0xad, 0x31, 0x8b,
+	0xd1, 0x71, 0x31, 0x99, 0xe4, 0x93, 0xc9, 0xcd, 0x94, 0x34, 0x51, 0x62, 0x25, 0xc6, 0xe7, 0x14,
+	0xfb, 0x97, 0x10, 0x93, 0xa6, 0x4a, 0x8c, 0x95, 0xca, 0x9a, 0xa2, 0x56, 0x75, 0x45, 0x35, 0x0c,
+	0x66, 0xa9, 0x96, 0xce, 0x0c, 0x13, 0x67, 0x7d, 0x16, 0xac, 0xad, 0xaa, 0xe6, 0x4c, 0x2e, 0xe2,
+	0x64, 0x5e, 0x35, 0x35, 0x61, 0x5a, 0xd9, 0x4c, 0xe5, 0x35, 0x4b, 0x4d, 0x29, 0x55, 0xb5, 0xa4,
+	0x1b, 0x7c, 0x25, 0x94, 0x9d, 0x76, 0xcb, 0x3a, 0x52, 0x05, 0xa6, 0x3b, 0xf3, 0x93, 0x62, 0x3e,
+	0x27, 0xf8, 0x10, 0x5d, 0x4c, 0x1d, 0x50, 0x2b, 0xba, 0xc1, 0x14, 0xfe, 0xaf, 0x18, 0x92, 0xd3,
+	0x70, 0xe8, 0x86, 0x6d, 0xef, 0x8a, 0x8d, 0x75, 0xd5, 0xd8, 0x60, 0x59, 0xed, 0x4e, 0x5d, 0x33,
+	0x2d, 0x3a, 0x09, 0x43, 0x1c, 0x35, 0xa7, 0x17, 0x13, 0x64, 0x96, 0xcc, 0x0f, 0x64, 0xf7, 0xf1,
+	0xe7, 0xab, 0x45, 0xf9, 0x55, 0x38, 0xdc, 0xae, 0x63, 0x56, 0x99, 0x61, 0x6a, 0x34, 0x09, 0x03,
+	0xba, 0xb1, 0xc1, 0xb8, 0xc2, 0x48, 0x5a, 0x4a, 0xb6, 0x39, 0x2e, 0xd9, 0xd2, 0xe0, 0x72, 0xf2,
+	0x0d, 0x38, 0xda, 0x5a, 0xe9, 0x3a, 0x2b, 0xeb, 0x85, 0x2d, 0x37, 0x43, 0x1a, 0xf6, 0xa9, 0xc5,
+	0x62, 0x4d, 0x33, 0x4d, 0xbe, 0xe2, 0x70, 0x26, 0xf1, 0xe3, 0xd7, 0xcb, 0x13, 0xb8, 0xe8, 0x25,
+	0x31, 0x73, 0xd3, 0xaa, 0xe9, 0x46, 0x29, 0xeb, 0x08, 0xca, 0xb7, 0x60, 0x2a, 0x78, 0x49, 0x44,
+	0x5c, 0xf3, 0x20, 0xce, 0x06, 0x23, 0xba, 0xf4, 0x04, 0x68, 0x03, 0x12, 0xad, 0x55, 0xaf, 0x69,
+	0x95, 0xbc, 0x56, 0x33, 0x3b, 0x7b, 0x8a, 0xbe, 0x02, 0xd0, 0x8a, 0x5f, 0xa2, 0x9f, 0x9b, 0x9c,
+	0x73, 0x4c, 0xda, 0x01, 0x4c, 0x8a, 0x3c, 0xc3, 0x30, 0x26, 0xaf, 0xab, 0x25, 0x0d, 0x97, 0xcd,
+	0xba, 0x34, 0xe5, 0xcf, 0x08, 0x4c, 0x06, 0xd8, 0xc7, 0x2d, 0xad, 0xc3, 0xbe, 0x8a, 0x18, 0x4a,
+	0x90, 0xd9, 0x3d, 0xf3, 0x23, 0xe9, 0xa9, 0xe0, 0x5d, 0x09, 0xbd, 0xac, 0x23, 0x4c, 0xaf, 0x04,
+	0xd0, 0x9d, 0xea, 0x48, 0x27, 0x8c, 0x7a, 0xf0, 0xee, 0x7b, 0xf0, 0xcc, 0xcc, 0xd6, 0xa5, 0x62,
+	0x45, 0x37, 0x1c, 0xff, 0x24, 0x61, 0x50, 0xb5, 0x9f, 0x3b, 0xc6, 0x50, 0x88, 0xf5, 0xcc, 0x69,
+	0x9f, 0x12, 0x90, 0x82, 0xa8, 0xd0, 0x6b, 0x69, 0xd8, 0xcb, 0xdd, 0xe3, 0x38, 0x2d, 0x2a, 0x5b,
+	0x51, 0xb2, 0x77, 0x1e, 0x7b, 0x97, 0xc0, 0x6c, 0x5b, 0x9a, 0xea, 0x9a, 0x99, 0x11, 0x8f, 0xff,
+	0x62, 0x62, 0x7d, 0x43, 0xe0, 0x58, 0x04, 0x07, 0xba, 0xea, 0x0a, 0x8c, 0x09, 0x90, 0x2a, 0x0a,
+	0xa0, 0xcb, 0x3a, 0x9f, 0x9e, 0xd1, 0x92, 0x7b, 0xdd, 0xde, 0xf9, 0x6f, 0x27, 0xc4, 0x7f, 0xbb,
+	0x22, 0xf1, 0xc2, 0x9c, 0xea, 0xcd, 0xbf, 0xdd, 0xe7, 0xd4, 0xb3, 0x30, 0xc1, 0xb1, 0xaf, 0xd7,
+	0x58, 0x95, 0x99, 0x6a, 0xd9, 0xf1, 0xe3, 0x0c, 0x8c, 0x54, 0x71, 0xa8, 0x95, 0x8a, 0xe0, 0x0c,
+	0x5d, 0x2d, 0xca, 0xaf, 0xc1, 0xa1, 0x36, 0x45, 0xdc, 0xe3, 0x19, 0x18, 0x72, 0xc4, 0xf0, 0xc2,
+	0x9d, 0xf4, 0xed, 0xae, 0xa9, 0xd4, 0x14, 0x95, 0x1f, 0x12, 0x90, 0x3d, 0x0b, 0x3a, 0x19, 0x29,
+	0x9c, 0xf0, 0x37, 0x5e, 0x0f, 0x3d, 0x8b, 0xf1, 0x17, 0x04, 0x8e, 0x47, 0x22, 0xa2, 0x07, 0xce,
+	0xc2, 0xb0, 0xb3, 0x2d, 0x27, 0xc0, 0x11, 0x2e, 0x68, 0xc9, 0xf6, 0x2e, 0xaa, 0x35, 0x98, 0xe1,
+	0xa0, 0xaf, 0x33, 0x4b, 0xcb, 0x34, 0x71, 0xed, 0xa7, 0x5a, 0xdc, 0x00, 0xdb, 0x27, 0x69, 0xd3,
+	0x56, 0x48, 0xf4, 0x77, 0xf0, 0xb3, 0x10, 0x93, 0xaf, 0xe1, 0xe9, 0x0c, 0xb4, 0x89, 0x9e, 0x59,
+	0x80, 0x01, 0x5b, 0x18, 0xf3, 0xe2, 0x90, 0xcf, 0x29, 0xb6, 0x74, 0x96, 0x8b, 0xc8, 0xef, 0x11,
+	0xac, 0x13, 0xec, 0x31, 0x33, 0xd3, 0x75, 0x82, 0xf6, 0x2c, 0xea, 0x1f, 0x13, 0x98, 0x0a, 0x06,
+	0xc1, 0x4d, 0x2d, 0x09, 0x47, 0x39, 0xa1, 0x0e, 0xd9, 0x95, 0x90, 0xe9, 0x5d, 0x88, 0xef, 0x11,
+	0x2c, 0x4f, 0x10, 0xcb, 0x13, 0xdc, 0x66, 0xec, 0x48, 0xac, 0xd8, 0xf5, 0xcc, 0x57, 0x1f, 0x39,
+	0x45, 0x81, 0x17, 0xea, 0x3f, 0x75, 0xd4, 0x83, 0xf6, 0x92, 0x00, 0x4b, 0xa2, 0x5d, 0x70, 0xa1,
+	0xec, 0x10, 0x38, 0x1a, 0x88, 0xb6, 0x1b, 0xca, 0x95, 0x17, 0xe0, 0x08, 0x67, 0xbb, 0xa5, 0x96,
+	0xcb, 0xf6, 0xdd, 0x56, 0x2f, 0x5b, 0xb1, 0x5f, 0x0e, 0x6f, 0x40, 0xc2, 0xaf, 0x8b, 0x9b, 0x3a,
+	0x0f, 0x83, 0x96, 0x3d, 0x8c, 0x97, 0x80, 0xbf, 0x6e, 0x75, 0x29, 0x65, 0x86, 0x9f, 0xfc, 0x32,
+	0xd3, 0xf7, 0xe8, 0x8f, 0xaf, 0x16, 0x49, 0x56, 0x68, 0xc9, 0x97, 0xf1, 0x9a, 0x71, 0x8e, 0x21,
+	0x97, 0xbe, 0xcc, 0x0c, 0x4b, 0xbb, 0x1b, 0x9f, 0x6f, 0x03, 0x8e, 0x45, 0x2c, 0x82, 0xa0, 0x97,
+	0x60, 0xc8, 0x34, 0xd4, 0xaa, 0x79, 0x9b, 0x59, 0xc8, 0x7a, 0x32, 0xaa, 0xc6, 0x36, 0x6f, 0xa2,
+	0x70, 0xb6, 0xa9, 0x26, 0xbf, 0x05, 0xd4, 0x15, 0x5f, 0x07, 0xaf, 0x57, 0xe9, 0x73, 0x8f, 0xc0,
+	0x41, 0xcf, 0xf2, 0xbb, 0x21, 0x6d, 0xf2, 0xf8, 0xea, 0x71, 0xbd, 0x18, 0x6f, 0xd5, 0x34, 0xd5,
+	0xac, 0xd7, 0x9a, 0xef, 0xf0, 0x8b, 0x30, 0xc6, 0xeb, 0x9f, 0xad, 0x5c, 0xdc, 0x93, 0x37, 0x2a,
+	0xe4, 0x71, 0x50, 0xbe, 0xb7, 0x07, 0x66, 0xc3, 0x8d, 0xa0, 0x17, 0xca, 0x30, 0x94, 0x57, 0xcb,
+	0xaa, 0x51, 0xd0, 0x7c, 0x2f, 0x61, 0xbe, 0x1f, 0x67, 0x27, 0x97, 0x99, 0x6e, 0x64, 0xce, 0xd8,
+	0x79, 0xf6, 0xe5, 0xaf, 0x33, 0xf3, 0x25, 0xdd, 0xba, 0x5d, 0xcf, 0x27, 0x0b, 0xac, 0x82, 0x6d,
+	0x34, 0xfe, 0xb7, 0x6c, 0x16, 0xdf, 0xc6, 0xf6, 0xdd, 0x56, 0x30, 0x45, 0x4e, 0x36, 0x2d, 0xd0,
+	0x6d, 0xf8, 0x5f, 0x81, 0x55, 0x2a, 0xba, 0x65, 0x69, 0xc5, 0x9c, 0x59, 0xd5, 0x8c, 0xa2, 0x99,
+	0xe8, 0xff, 0x87, 0xac, 0x8e, 0x37, 0x2d, 0xdd, 0xe4, 0x86, 0xe8, 0x49, 0x18, 0x63, 0x55, 0xcd,
+	0xc8, 0xb5, 0xaa, 0x8e, 0x3d, 0x3c, 0xe5, 0x47, 0xed, 0xd1, 0x66, 0xb1, 0x42, 0x97, 0x81, 0xaa,
+	0x85, 0x82, 0x56, 0xb5, 0x11, 0x5b, 0xa2, 0x03, 0x5c, 0xf4, 0x80, 0x33, 0xe3, 0x11, 0xd7, 0xee,
+	0x6a, 0x85, 0xba, 0x57, 0x7c, 0x50, 0x88, 0x3b, 0x33, 0x4d, 0xf1, 0xf4, 0x9f, 0x13, 0x30, 0xc8,
+	0x83, 0x42, 0x3f, 0x20, 0x30, 0xdc, 0xcc, 0x30, 0x3a, 0xe7, 0xcb, 0xbe, 0xc0, 0x8f, 0x0f, 0xd2,
+	0xa9, 0x8e, 0x72, 0x22, 0xb0, 0x72, 0xf2, 0x9d, 0x9f, 0x7e, 0xbf, 0xdf, 0x3f, 0x4f, 0xe7, 0x94,
+	0xf6, 0xcf, 0x2b, 0xd8, 0x39, 0x19, 0x1b, 0x4c, 0xd9, 0xc6, 0xdf, 0xc5, 0x06, 0xfd, 0x9c, 0xc0,
+	0x78, 0x5b, 0x39, 0x4d, 0x4f, 0x47, 0x18, 0xf3, 0x7d, 0x93, 0x90, 0x96, 0x63, 0x4a, 0x23, 0xe0,
+	0x1a, 0x07, 0x4c, 0xd2, 0xd3, 0x21, 0x80, 0x98, 0xfc, 0x82, 0x13, 0x8f, 0x40, 0x83, 0x3e, 0x20,
+	0xb0, 0xdf, 0x7d, 0x9d, 0xd0, 0x85, 0x08, 0xab, 0xde, 0xcf, 0x11, 0xd2, 0x62, 0x1c, 0x51, 0xa4,
+	0x4b, 0x71, 0xba, 0x25, 0xba, 0x10, 0x42, 0x87, 0x5f, 0x0a, 0xdc, 0x1e, 0xdc, 0x21, 0x30, 0xea,
+	0x69, 0xa8, 0x69, 0x94, 0xc1, 0xb6, 0x96, 0x4c, 0x5a, 0x8a, 0x25, 0x8b, 0x74, 0x2b, 0x9c, 0x6e,
+	0x91, 0xce, 0x07, 0xd3, 0x99, 0xb9, 0xbc, 0x7d, 0x6b, 0x54, 0x74, 0xc3, 0xf6, 0x5c, 0x45, 0x37,
+	0x1a, 0xf4, 0x3b, 0x02, 0x13, 0x41, 0x9d, 0x2c, 0x4d, 0x75, 0x8a, 0x9a, 0xaf, 0xfb, 0x96, 0xd2,
+	0xdd, 0xa8, 0x20, 0xf1, 0x8b, 0x9c, 0xf8, 0x0c, 0x5d, 0x8d, 0x8a, 0xb6, 0xae, 0x71, 0x72, 0x31,
+	0xe5, 0xf2, 0xec, 0xb7, 0x7e, 0x78, 0xe1, 0xe0, 0x78, 0xf0, 0x1e, 0x3f, 0xa7, 0xbb, 0x51, 0x41,
+	0xf8, 0x73, 0x1c, 0x3e, 0x4d, 0x57, 0x62, 0xc0, 0x7b, 0xdd, 0xfe, 0x3e, 0x81, 0x21, 0xe7, 0xf0,
+	0xd3, 0x93, 0xc1, 0xa6, 0xdb, 0x6a, 0x76, 0x69, 0xae, 0x93, 0x18, 0x52, 0x29, 0x9c, 0x6a, 0x81,
+	0x9e, 0xf2, 0x51, 0x39, 0xf7, 0x90, 0xb2, 0xed, 0x7a, 0xc1, 0x37, 0xe8, 0x63, 0x02, 0x87, 0x83,
+	0x9b, 0x32, 0xba, 0x1a, 0x6d, 0x33, 0xb0, 0xcb, 0x94, 0xd6, 0xba, 0x53, 0x42, 0xec, 0x97, 0x38,
+	0xf6, 0x3a, 0x5d, 0x0b, 0xc5, 0x6e, 0x25, 0x01, 0x5e, 0x02, 0xae, 0xf3, 0xff, 0x98, 0xc0, 0xc1,
+	0x80, 0xde, 0x89, 0xae, 0x04, 0xb3, 0x84, 0xb7, 0x76, 0x52, 0xaa, 0x0b, 0x0d, 0x44, 0x7f, 0x99,
+	0xa3, 0x5f, 0xa4, 0xe7, 0x7d, 0xe8, 0x76, 0x35, 0x6e, 0x53, 0x37, 0xfd, 0x6d, 0x0f, 0xd4, 0xbc,
+	0xfe, 0x57, 0xb6, 0xf9, 0x60, 0x83, 0x3e, 0x22, 0x30, 0xde, 0xd6, 0x26, 0x85, 0x5d, 0xb5, 0xc1,
+	0x6d, 0x9d, 0xb4, 0x1c, 0x53, 0xba, 0x63, 0xfe, 0xda, 0x44, 0xa6, 0x1b, 0xbc, 0x2d, 0x65, 0x3e,
+	0x21, 0xb0, 0xdf, 0xdd, 0xa5, 0x84, 0x5d, 0xb7, 0x01, 0xed, 0x55, 0xd8, 0x75, 0x1b, 0xd4, 0xf4,
+	0x44, 0xe4, 0x72, 0x93, 0x10, 0x3d, 0x8a, 0x3e, 0x7c, 0x48, 0x60, 0xcc, 0xdb, 0x0f, 0xd0, 0x0e,
+	0x37, 0xa8, 0xa7, 0xa1, 0x91, 0x4e, 0xc7, 0x13, 0x46, 0xbc, 0x55, 0x8e, 0xb7, 0x4c, 0x97, 0x22,
+	0xee, 0x5b, 0xf1, 0x46, 0x70, 0xa5, 0xea, 0x0e, 0x81, 0x11, 0x57, 0x95, 0x4e, 0xe7, 0x83, 0x4d,
+	0xfa, 0x3b, 0x07, 0x69, 0x21, 0x86, 0x24, 0x92, 0xad, 0x73, 0xb2, 0x15, 0x9a, 0x0c, 0x3f, 0x4d,
+	0x6d, 0x59, 0xc8, 0x1b, 0x04, 0xfa, 0x03, 0x81, 0x89, 0xa0, 0xba, 0x3e, 0xec, 0x4a, 0x8d, 0x68,
+	0x24, 0xa4, 0x74, 0x37, 0x2a, 0xc8, 0x7d, 0x81, 0x73, 0x9f, 0xa3, 0xeb, 0xdd, 0x71, 0xe7, 0x0a,
+	0x88, 0x69, 0xc1, 0x5e, 0x11, 0x2b, 0x7a, 0x3c, 0x2a, 0x92, 0x0e, 0xe2, 0x89, 0x68, 0x21, 0x84,
+	0x9a, 0xe1, 0x50, 0x93, 0xf4, 0x48, 0x48, 0x98, 0xe9, 0xf7, 0x04, 0x0e, 0x06, 0x54, 0xd3, 0x61,
+	0xb7, 0x4f, 0x78, 0x75, 0x2f, 0xa5, 0xba, 0xd0, 0x40, 0xba, 0x8b, 0x9c, 0xee, 0xff, 0xf4, 0x6c,
+	0x74, 0xc1, 0x64, 0xa1, 0x9e, 0xb2, 0xed, 0x6d, 0x1f, 0x1a, 0x99, 0x0b, 0x4f, 0x9e, 0x4d, 0x93,
+	0xa7, 0xcf, 0xa6, 0xc9, 0x6f, 0xcf, 0xa6, 0xc9, 0x87, 0xcf, 0xa7, 0xfb, 0x9e, 0x3e, 0x9f, 0xee,
+	0xfb, 0xf9, 0xf9, 0x74, 0xdf, 0x9b, 0x27, 0x22, 0x4b, 0xeb, 0xbb, 0xc2, 0x40, 0x7e, 0x2f, 0xff,
+	0xc3, 0xd8, 0xea, 0x5f, 0x03, 0x00, 0x55, 0x99, 0x3f, 0xdf, 0x13, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1647,10 +1901,21 @@ type QueryClient interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalTallyContext returns the group members and weights the votes of a
+	// proposal are tallied against. For a proposal whose tally is final, these
+	// are the members of the group when the final tally was computed, otherwise
+	// the current members of the group.
+	ProposalTallyContext(ctx context.Context, in *QueryProposalTallyContextRequest, opts ...grpc.CallOption) (*QueryProposalTallyContextResponse, error)
 	// Groups queries all groups in state.
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// GroupPolicyTreasury queries the balances of a group policy account, the
+	// amounts its pending proposals would spend from it, and the number of its
+	// proposals by stage.
+	//
+	// Since: cosmos-sdk 0.48
+	GroupPolicyTreasury(ctx context.Context, in *QueryGroupPolicyTreasuryRequest, opts ...grpc.CallOption) (*QueryGroupPolicyTreasuryResponse, error)
 }
 
 type queryClient struct {
@@ -1778,6 +2043,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalTallyContext(ctx context.Context, in *QueryProposalTallyContextRequest, opts ...grpc.CallOption) (*QueryProposalTallyContextResponse, error) {
+	out := new(QueryProposalTallyContextResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalTallyContext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error) {
 	out := new(QueryGroupsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/Groups", in, out, opts...)
@@ -1787,6 +2061,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GroupPolicyTreasury(ctx context.Context, in *QueryGroupPolicyTreasuryRequest, opts ...grpc.CallOption) (*QueryGroupPolicyTreasuryResponse, error) {
+	out := new(QueryGroupPolicyTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupPolicyTreasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1819,10 +2102,21 @@ type QueryServer interface {
 	// then it simply returns the `final_tally_result` state stored in the
 	// proposal itself.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalTallyContext returns the group members and weights the votes of a
+	// proposal are tallied against. For a proposal whose tally is final, these
+	// are the members of the group when the final tally was computed, otherwise
+	// the current members of the group.
+	ProposalTallyContext(context.Context, *QueryProposalTallyContextRequest) (*QueryProposalTallyContextResponse, error)
 	// Groups queries all groups in state.
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// GroupPolicyTreasury queries the balances of a group policy account, the
+	// amounts its pending proposals would spend from it, and the number of its
+	// proposals by stage.
+	//
+	// Since: cosmos-sdk 0.48
+	GroupPolicyTreasury(context.Context, *QueryGroupPolicyTreasuryRequest) (*QueryGroupPolicyTreasuryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1868,9 +2162,15 @@ func (*UnimplementedQueryServer) GroupsByMember(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ProposalTallyContext(ctx context.Context, req *QueryProposalTallyContextRequest) (*QueryProposalTallyContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTallyContext not implemented")
+}
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) GroupPolicyTreasury(ctx context.Context, req *QueryGroupPolicyTreasuryRequest) (*QueryGroupPolicyTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupPolicyTreasury not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2110,6 +2410,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalTallyContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTallyContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTallyContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalTallyContext",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTallyContext(ctx, req.(*QueryProposalTallyContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGroupsRequest)
 	if err := dec(in); err != nil {
@@ -2128,6 +2446,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupPolicyTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupPolicyTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupPolicyTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/GroupPolicyTreasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupPolicyTreasury(ctx, req.(*QueryGroupPolicyTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2184,10 +2520,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalTallyContext",
+			Handler:    _Query_ProposalTallyContext_Handler,
+		},
 		{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "GroupPolicyTreasury",
+			Handler:    _Query_GroupPolicyTreasury_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3237,6 +3581,69 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposalTallyContextRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTallyContextRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTallyContextRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTallyContextResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTallyContextResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTallyContextResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGroupsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3321,27 +3728,123 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGroupPolicyTreasuryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
-	return n
+
+func (m *QueryGroupPolicyTreasuryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupPolicyTreasuryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PolicyAddress) > 0 {
+		i -= len(m.PolicyAddress)
+		copy(dAtA[i:], m.PolicyAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutedProposals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutedProposals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AcceptedProposals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AcceptedProposals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OpenProposals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OpenProposals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CommittedSpends) > 0 {
+		for iNdEx := len(m.CommittedSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommittedSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
 }
 
 func (m *QueryGroupInfoResponse) Size() (n int) {
@@ -3745,6 +4248,31 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalTallyContextRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalTallyContextResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGroupsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3777,6 +4305,49 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGroupPolicyTreasuryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CommittedSpends) > 0 {
+		for _, e := range m.CommittedSpends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.OpenProposals != 0 {
+		n += 1 + sovQuery(uint64(m.OpenProposals))
+	}
+	if m.AcceptedProposals != 0 {
+		n += 1 + sovQuery(uint64(m.AcceptedProposals))
+	}
+	if m.ExecutedProposals != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutedProposals))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6465,7 +7036,7 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalTallyContextRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6488,17 +7059,17 @@ func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalTallyContextRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalTallyContextRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6508,28 +7079,11 @@ func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6551,7 +7105,7 @@ func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalTallyContextResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6574,15 +7128,15 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalTallyContextResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalTallyContextResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6609,11 +7163,63 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &GroupInfo{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Snapshot == nil {
+				m.Snapshot = &GroupMembersSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -6644,7 +7250,7 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6671,6 +7277,383 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &GroupInfo{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyTreasuryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyTreasuryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommittedSpends = append(m.CommittedSpends, types.Coin{})
+			if err := m.CommittedSpends[len(m.CommittedSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenProposals", wireType)
+			}
+			m.OpenProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedProposals", wireType)
+			}
+			m.AcceptedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptedProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedProposals", wireType)
+			}
+			m.ExecutedProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
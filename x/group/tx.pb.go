@@ -1377,6 +1377,116 @@ func (m *MsgExecResponse) GetResult() ProposalExecutorResult {
 	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
 }
 
+// MsgRetryExec is the Msg/RetryExec request type.
+type MsgRetryExec struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executor is the account address used to execute the proposal.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *MsgRetryExec) Reset()         { *m = MsgRetryExec{} }
+func (m *MsgRetryExec) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryExec) ProtoMessage()    {}
+func (*MsgRetryExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{26}
+}
+func (m *MsgRetryExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryExec.Merge(m, src)
+}
+func (m *MsgRetryExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryExec proto.InternalMessageInfo
+
+func (m *MsgRetryExec) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgRetryExec) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+// MsgRetryExecResponse is the Msg/RetryExec response type.
+type MsgRetryExecResponse struct {
+	// result is the result of the proposal execution retry.
+	Result ProposalExecutorResult `protobuf:"varint,1,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// attempt is the number of the retry, starting at 1.
+	Attempt uint64 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (m *MsgRetryExecResponse) Reset()         { *m = MsgRetryExecResponse{} }
+func (m *MsgRetryExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryExecResponse) ProtoMessage()    {}
+func (*MsgRetryExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{27}
+}
+func (m *MsgRetryExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryExecResponse.Merge(m, src)
+}
+func (m *MsgRetryExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryExecResponse proto.InternalMessageInfo
+
+func (m *MsgRetryExecResponse) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *MsgRetryExecResponse) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 // MsgLeaveGroup is the Msg/LeaveGroup request type.
 type MsgLeaveGroup struct {
 	// address is the account address of the group member.
@@ -1389,7 +1499,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1550,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1497,6 +1607,8 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
+	proto.RegisterType((*MsgRetryExec)(nil), "cosmos.group.v1.MsgRetryExec")
+	proto.RegisterType((*MsgRetryExecResponse)(nil), "cosmos.group.v1.MsgRetryExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
 }
@@ -1504,98 +1616,101 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0xce, 0xaf, 0x97, 0x6f, 0xdd, 0x64, 0x9b, 0xb4, 0x9b, 0x6d, 0x6b, 0xbb, 0xd3,
-	0xb4, 0x49, 0xad, 0xc6, 0x6e, 0x9c, 0x6f, 0x2b, 0x61, 0x10, 0xa8, 0x49, 0x0d, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xde, 0x6e, 0x57, 0x64, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x3f, 0x2e, 0x80, 0x90, 0x40, 0x82, 0x3f, 0x00, 0x6e, 0x1c, 0x8b, 0xd4, 0x03, 0x37, 0x6e, 0xa8,
-	0x2a, 0x97, 0x8a, 0x13, 0x27, 0x04, 0xad, 0x50, 0x6f, 0xfc, 0x0b, 0xa0, 0x9d, 0xd9, 0x1d, 0xef,
-	0x78, 0x77, 0xbd, 0x5b, 0xcb, 0x82, 0x4b, 0x94, 0x9d, 0xf7, 0x79, 0xbf, 0x3e, 0xef, 0xcd, 0x9b,
-	0x19, 0x83, 0xb4, 0x6b, 0x61, 0xd3, 0xc2, 0x65, 0xbd, 0x63, 0x75, 0xdb, 0xe5, 0xfd, 0xb5, 0xb2,
-	0x7d, 0x50, 0x6a, 0x77, 0x2c, 0xdb, 0x12, 0x8f, 0x52, 0x49, 0x89, 0x48, 0x4a, 0xfb, 0x6b, 0xf2,
-	0xbc, 0x6e, 0xe9, 0x16, 0x91, 0x95, 0x9d, 0xff, 0x28, 0x4c, 0x5e, 0xa4, 0xb0, 0x06, 0x15, 0xb8,
-	0x3a, 0xae, 0x48, 0xb7, 0x2c, 0x7d, 0x4f, 0x2b, 0x93, 0xaf, 0x9d, 0xee, 0xed, 0xb2, 0xda, 0x3a,
-	0x74, 0x45, 0x27, 0x03, 0x6e, 0x0f, 0xdb, 0x9a, 0xa7, 0x77, 0xc2, 0x15, 0x9a, 0x58, 0x77, 0x44,
-	0x26, 0xd6, 0x5d, 0xc1, 0x9c, 0x6a, 0x1a, 0x2d, 0xab, 0x4c, 0xfe, 0xd2, 0x25, 0xf4, 0xb3, 0x00,
-	0xd9, 0x3a, 0xd6, 0x37, 0x3b, 0x9a, 0x6a, 0x6b, 0xaf, 0x38, 0xd6, 0xc4, 0x12, 0x8c, 0xab, 0x4d,
-	0xd3, 0x68, 0x49, 0x42, 0x41, 0x58, 0x99, 0xde, 0x90, 0x7e, 0xb9, 0xbf, 0x3a, 0xef, 0xc6, 0x75,
-	0xb5, 0xd9, 0xec, 0x68, 0x18, 0x5f, 0xb7, 0x3b, 0x46, 0x4b, 0x57, 0x28, 0x4c, 0xdc, 0x84, 0x49,
-	0x53, 0x33, 0x77, 0xb4, 0x0e, 0x96, 0x52, 0x85, 0xf4, 0xca, 0x4c, 0x25, 0x57, 0xea, 0x4b, 0xbd,
-	0x54, 0x27, 0x72, 0x45, 0x7b, 0xbf, 0xab, 0x61, 0x7b, 0x63, 0xfa, 0xc1, 0x6f, 0xf9, 0xb1, 0xef,
-	0x9e, 0xde, 0x2b, 0x0a, 0x8a, 0xa7, 0x29, 0xca, 0x30, 0x65, 0x6a, 0xb6, 0xda, 0x54, 0x6d, 0x55,
-	0x4a, 0x3b, 0x7e, 0x15, 0xf6, 0x5d, 0x5d, 0xf9, 0xe8, 0xe9, 0xbd, 0x22, 0x75, 0xf6, 0xd9, 0xd3,
-	0x7b, 0x45, 0x97, 0xb1, 0x55, 0xdc, 0x7c, 0xaf, 0xcc, 0x87, 0x8e, 0xd6, 0xe1, 0x38, 0xbf, 0xa2,
-	0x68, 0xb8, 0x6d, 0xb5, 0xb0, 0x26, 0x2e, 0xc2, 0x14, 0x89, 0xa6, 0x61, 0x34, 0x49, 0x5e, 0x19,
-	0x65, 0x92, 0x7c, 0x6f, 0x35, 0xd1, 0x9f, 0x02, 0x2c, 0xd4, 0xb1, 0x7e, 0xb3, 0xdd, 0xf4, 0xb4,
-	0xea, 0x6e, 0x50, 0xcf, 0xca, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x43, 0x96, 0xa6, 0xda,
-	0xe8, 0x12, 0x3f, 0x58, 0x4a, 0x3f, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x96, 0x79,
-	0x56, 0x0a, 0x3c, 0x2b, 0xc1, 0x6c, 0x50, 0x1e, 0x4e, 0x87, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x1c, 0xe3, 0x11, 0x57, 0x49, 0x5a, 0x23, 0xa4, 0xe1, 0x32, 0x4c, 0xb7, 0xb4, 0xbb, 0x0d, 0x6a,
-	0x2e, 0x1d, 0x63, 0x6e, 0xaa, 0xa5, 0xdd, 0x25, 0x11, 0x54, 0x57, 0xf9, 0x5c, 0x73, 0x91, 0xb9,
-	0x12, 0x38, 0x3a, 0x0d, 0x27, 0x43, 0x96, 0x59, 0x9e, 0xdf, 0x0b, 0xa4, 0x4d, 0x38, 0x26, 0x68,
-	0xab, 0x8d, 0x32, 0xd5, 0x41, 0x1d, 0x7d, 0x89, 0xcf, 0xe7, 0xcc, 0x80, 0xda, 0x51, 0x0d, 0x54,
-	0x80, 0x5c, 0xb8, 0x84, 0x65, 0xf5, 0x75, 0x0a, 0xe6, 0xf9, 0xe6, 0xdf, 0xb6, 0xf6, 0x8c, 0xdd,
-	0xc3, 0x7f, 0x29, 0x27, 0x51, 0x85, 0xa3, 0x4d, 0x6d, 0xd7, 0xc0, 0x86, 0xd5, 0x6a, 0xb4, 0x89,
-	0x67, 0x29, 0x53, 0x10, 0x56, 0x66, 0x2a, 0xf3, 0x25, 0x3a, 0xc7, 0x4a, 0xde, 0x1c, 0x2b, 0x5d,
-	0x6d, 0x1d, 0x6e, 0xa0, 0x87, 0xf7, 0x57, 0x73, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57,
-	0xb2, 0x4d, 0xee, 0xbb, 0x5a, 0xf9, 0xe4, 0x9b, 0xfc, 0x18, 0x4f, 0x5d, 0x3e, 0x72, 0x18, 0x50,
-	0x1d, 0xa4, 0xc0, 0xa9, 0xb0, 0x75, 0x36, 0x18, 0x2a, 0x30, 0xa9, 0x52, 0x16, 0x62, 0xf9, 0xf1,
-	0x80, 0xe8, 0xe3, 0x14, 0x2c, 0xf2, 0xd5, 0xa0, 0x46, 0x87, 0xdb, 0x2e, 0xaf, 0xc2, 0x3c, 0xe5,
-	0x9b, 0xb2, 0xd6, 0xf0, 0xc2, 0x49, 0xc5, 0xa8, 0x8b, 0xba, 0xdf, 0x33, 0x91, 0x0c, 0xbb, 0xbf,
-	0xd6, 0x79, 0x52, 0x97, 0x22, 0xfb, 0xd1, 0x97, 0x27, 0x3a, 0x0b, 0x67, 0x22, 0x85, 0xac, 0x2b,
-	0x7f, 0x48, 0x83, 0xc4, 0xf3, 0x7f, 0xcb, 0xb0, 0xef, 0x0c, 0xd9, 0x99, 0x23, 0x39, 0x69, 0xce,
-	0x41, 0x96, 0xd2, 0xdd, 0xd7, 0xc9, 0x47, 0x74, 0x6e, 0x12, 0x54, 0x60, 0x81, 0xab, 0x0a, 0x43,
-	0x67, 0x08, 0xfa, 0x98, 0x8f, 0x7c, 0xa6, 0xb3, 0xd6, 0xa7, 0xa3, 0x62, 0xb7, 0x12, 0xe3, 0x05,
-	0x61, 0x65, 0x8a, 0x2f, 0x18, 0xa6, 0xcd, 0x12, 0xb2, 0x6b, 0x26, 0x46, 0xbc, 0x6b, 0xae, 0x04,
-	0x77, 0xcd, 0xd9, 0xc8, 0x5d, 0xd3, 0xab, 0x0e, 0xfa, 0x54, 0x80, 0x42, 0x94, 0x30, 0xc1, 0xb9,
-	0x3a, 0xca, 0xbe, 0x46, 0x3f, 0xa6, 0x00, 0x85, 0x35, 0x1b, 0x9f, 0xfa, 0x7f, 0xba, 0xf5, 0x42,
-	0x2a, 0x99, 0x1e, 0x71, 0x25, 0xab, 0xc1, 0x4a, 0x2e, 0x47, 0x6e, 0x55, 0xde, 0x16, 0xba, 0x08,
-	0xc5, 0x78, 0x02, 0xd9, 0xb6, 0xfd, 0x4b, 0x20, 0x63, 0x33, 0x00, 0x1f, 0xfa, 0xa0, 0x1c, 0x25,
-	0xd3, 0x83, 0x4e, 0xd6, 0x2b, 0x49, 0xe9, 0xe1, 0xf3, 0x41, 0xe7, 0x61, 0x69, 0x90, 0x9c, 0x11,
-	0xf3, 0x47, 0x0a, 0xe6, 0xea, 0x58, 0xbf, 0xde, 0xdd, 0x31, 0x0d, 0x7b, 0xbb, 0x63, 0xb5, 0x2d,
-	0xac, 0xee, 0x45, 0x66, 0x27, 0x0c, 0x91, 0xdd, 0x29, 0x98, 0x6e, 0x13, 0xbb, 0xde, 0x98, 0x9b,
-	0x56, 0x7a, 0x0b, 0x03, 0x4f, 0xe0, 0x4b, 0x8e, 0x0c, 0x63, 0x55, 0xd7, 0xb0, 0x94, 0x21, 0xf3,
-	0x31, 0xb4, 0xf5, 0x14, 0x86, 0x12, 0x2f, 0x40, 0x46, 0x3b, 0xd0, 0x76, 0xc9, 0x7c, 0xca, 0x56,
-	0x16, 0x02, 0xd3, 0xb4, 0x76, 0xa0, 0xed, 0x2a, 0x04, 0x22, 0xce, 0xc3, 0xb8, 0x6d, 0xd8, 0x7b,
-	0x1a, 0x19, 0x4f, 0xd3, 0x0a, 0xfd, 0x10, 0x25, 0x98, 0xc4, 0x5d, 0xd3, 0x54, 0x3b, 0x87, 0xd2,
-	0x24, 0x59, 0xf7, 0x3e, 0xab, 0xcf, 0x79, 0xbd, 0xda, 0x0b, 0xde, 0x29, 0x08, 0xf2, 0x15, 0x84,
-	0x3e, 0x5e, 0x02, 0x6c, 0xa2, 0x17, 0xc8, 0xe9, 0xca, 0x2f, 0xb2, 0x81, 0x93, 0x87, 0x99, 0xb6,
-	0xbb, 0xd6, 0x9b, 0x39, 0xe0, 0x2d, 0x6d, 0x35, 0xd1, 0xb7, 0xf4, 0x16, 0xeb, 0xcc, 0xaa, 0x66,
-	0x47, 0xbd, 0xcb, 0x6a, 0x14, 0xa7, 0xe8, 0xbf, 0x09, 0xa4, 0x12, 0xde, 0x04, 0xaa, 0x97, 0x9d,
-	0x0c, 0xbd, 0xaf, 0xfe, 0xa3, 0x93, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x41, 0xed, 0x5f, 0x66, 0x4d,
-	0xf6, 0xb7, 0x00, 0x93, 0x75, 0xac, 0xbf, 0x69, 0xd9, 0xf1, 0xf9, 0x3a, 0x3b, 0x71, 0xdf, 0xb2,
-	0xb5, 0x4e, 0x6c, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb6, 0x0d, 0x8b, 0xde, 0x0f, 0xb2,
-	0x95, 0x93, 0x81, 0xaa, 0x3b, 0x7e, 0xdf, 0x20, 0x10, 0xc5, 0x85, 0x72, 0x6d, 0x97, 0xe9, 0x6b,
-	0xbb, 0xe4, 0x4d, 0x54, 0x5d, 0x26, 0xbb, 0x93, 0xc4, 0xe1, 0x90, 0x25, 0x85, 0x91, 0xe5, 0x78,
-	0x47, 0x73, 0x70, 0xd4, 0xfd, 0x97, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xff,
-	0x61, 0xca, 0x71, 0xd8, 0xb5, 0xad, 0x78, 0x5e, 0x18, 0xb2, 0x5a, 0x74, 0xc2, 0x63, 0x9f, 0x91,
-	0x11, 0x3a, 0x21, 0x20, 0x85, 0x44, 0x48, 0x72, 0xf3, 0x5a, 0xf3, 0x25, 0x98, 0xe8, 0x68, 0xb8,
-	0xbb, 0x67, 0x13, 0x97, 0xd9, 0xca, 0x72, 0x80, 0x0a, 0xaf, 0xd2, 0x35, 0xd7, 0x85, 0x42, 0xe0,
-	0x8a, 0xab, 0x86, 0xbe, 0x10, 0xe0, 0x48, 0x1d, 0xeb, 0xaf, 0x69, 0xea, 0xbe, 0xfb, 0x16, 0x1f,
-	0xe2, 0x76, 0x3a, 0xe0, 0xfe, 0x4e, 0xdf, 0x8c, 0xfe, 0x76, 0xcd, 0x85, 0xe5, 0xd7, 0xf3, 0x8f,
-	0x4e, 0x90, 0xa7, 0x71, 0x6f, 0xc1, 0xcb, 0xb5, 0x58, 0x84, 0x4c, 0x8d, 0x8e, 0x85, 0xd9, 0xda,
-	0x5b, 0xb5, 0xcd, 0xc6, 0xcd, 0xd7, 0xaf, 0x6f, 0xd7, 0x36, 0xb7, 0x5e, 0xde, 0xaa, 0x5d, 0x9b,
-	0x1d, 0x13, 0xff, 0x07, 0x53, 0x64, 0xf5, 0x86, 0xf2, 0xf6, 0xac, 0x50, 0x79, 0x38, 0x03, 0xe9,
-	0x3a, 0xd6, 0xc5, 0x5b, 0x30, 0xe3, 0xff, 0x9d, 0x21, 0x1f, 0xbc, 0xbc, 0x71, 0xb7, 0x0d, 0x79,
-	0x39, 0x06, 0xc0, 0x88, 0xdf, 0x03, 0x31, 0xe4, 0xf5, 0x7e, 0x3e, 0x4c, 0x3d, 0x88, 0x93, 0x4b,
-	0xc9, 0x70, 0xcc, 0xdb, 0x6d, 0x98, 0x0d, 0x3c, 0x91, 0x97, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x31,
-	0x09, 0x8a, 0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x39, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09,
-	0x81, 0xcc, 0xa1, 0x01, 0x73, 0xc1, 0xd7, 0xe3, 0xb9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x9a, 0x08,
-	0xc6, 0x5c, 0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x21, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x25, 0x86,
-	0x32, 0xb7, 0x07, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1,
-	0xcc, 0xf3, 0x57, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0xeb, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0xf3, 0x43,
-	0x28, 0xb1, 0xa8, 0x3e, 0x14, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x9a, 0xc8, 0x34, 0xeb, 0xb7, 0xcb,
-	0xcf, 0x04, 0x67, 0x31, 0xbc, 0x0b, 0xd9, 0xbe, 0xdb, 0x14, 0x0a, 0x33, 0xc4, 0x63, 0xe4, 0x62,
-	0x3c, 0xc6, 0xbf, 0x61, 0x03, 0xb7, 0x81, 0xd0, 0x0d, 0xdb, 0x8f, 0x0a, 0xdf, 0xb0, 0x51, 0xc7,
-	0xb6, 0xb8, 0x01, 0x19, 0x72, 0x64, 0x4b, 0x61, 0x5a, 0x8e, 0x44, 0x2e, 0x44, 0x49, 0xfc, 0x36,
-	0xc8, 0x5c, 0x0d, 0xb5, 0xe1, 0x48, 0xc2, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x00, 0xdf, 0x11, 0x92,
-	0x0b, 0xc3, 0xf7, 0xe4, 0xf2, 0xf9, 0xc1, 0x72, 0xcf, 0xaa, 0x3c, 0xfe, 0x81, 0xf3, 0x8e, 0xde,
-	0x78, 0xf1, 0xc1, 0xe3, 0x9c, 0xf0, 0xe8, 0x71, 0x4e, 0xf8, 0xfd, 0x71, 0x4e, 0xf8, 0xf2, 0x49,
-	0x6e, 0xec, 0xd1, 0x93, 0xdc, 0xd8, 0xaf, 0x4f, 0x72, 0x63, 0xef, 0x2c, 0xe9, 0x86, 0x7d, 0xa7,
-	0xbb, 0x53, 0xda, 0xb5, 0x4c, 0xf7, 0x77, 0xec, 0xb2, 0xef, 0x74, 0x39, 0xa0, 0xe7, 0xcb, 0xce,
-	0x04, 0xb9, 0x8a, 0xae, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x17, 0xcf, 0x29, 0x39, 0x17,
-	0x00, 0x00,
+	// 1500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xda, 0xce, 0x87, 0x9f, 0xb4, 0x4e, 0xb2, 0x75, 0x5a, 0x67, 0xdb, 0xd8, 0xee, 0x34,
+	0x6d, 0x52, 0xab, 0xb1, 0x1b, 0xe7, 0x6d, 0xa5, 0xd7, 0xef, 0x2b, 0x50, 0x93, 0x1a, 0x14, 0x84,
+	0x21, 0x6c, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xde, 0x6e, 0x2d, 0xb2, 0x5e, 0xd7, 0xb3, 0x4e, 0x93,
+	0x1b, 0x1f, 0x17, 0x40, 0x48, 0x20, 0x81, 0xb8, 0x21, 0xc1, 0x8d, 0x63, 0x91, 0x7a, 0xe0, 0xc6,
+	0x0d, 0x55, 0x70, 0xa9, 0x38, 0x71, 0x42, 0xd0, 0x0a, 0xf5, 0xc6, 0xbf, 0x00, 0xda, 0x99, 0xdd,
+	0xf1, 0x8e, 0x77, 0xd7, 0xbb, 0xb5, 0x0c, 0x5c, 0x22, 0xcf, 0x3c, 0xbf, 0xe7, 0xeb, 0xf7, 0x3c,
+	0xf3, 0xb5, 0x81, 0xcc, 0xae, 0x81, 0x75, 0x03, 0x97, 0xb4, 0x8e, 0xd1, 0x6d, 0x97, 0xf6, 0xd7,
+	0x4a, 0xe6, 0x41, 0xb1, 0xdd, 0x31, 0x4c, 0x43, 0x9c, 0xa1, 0x92, 0x22, 0x91, 0x14, 0xf7, 0xd7,
+	0xa4, 0xb4, 0x66, 0x68, 0x06, 0x91, 0x95, 0xac, 0x5f, 0x14, 0x26, 0x2d, 0x50, 0x58, 0x9d, 0x0a,
+	0x6c, 0x1d, 0x5b, 0xa4, 0x19, 0x86, 0xb6, 0xa7, 0x96, 0xc8, 0x68, 0xa7, 0x7b, 0xab, 0xa4, 0xb4,
+	0x0e, 0x6d, 0xd1, 0x49, 0x8f, 0xdb, 0xc3, 0xb6, 0xea, 0xe8, 0x9d, 0xb0, 0x85, 0x3a, 0xd6, 0x2c,
+	0x91, 0x8e, 0x35, 0x5b, 0x30, 0xa7, 0xe8, 0xcd, 0x96, 0x51, 0x22, 0x7f, 0xe9, 0x14, 0xfa, 0x51,
+	0x80, 0x54, 0x0d, 0x6b, 0x9b, 0x1d, 0x55, 0x31, 0xd5, 0xe7, 0x2d, 0x6b, 0x62, 0x11, 0xc6, 0x95,
+	0x86, 0xde, 0x6c, 0x65, 0x84, 0xbc, 0xb0, 0x92, 0xdc, 0xc8, 0xfc, 0x74, 0x7f, 0x35, 0x6d, 0xc7,
+	0x75, 0xa5, 0xd1, 0xe8, 0xa8, 0x18, 0x5f, 0x33, 0x3b, 0xcd, 0x96, 0x26, 0x53, 0x98, 0xb8, 0x09,
+	0x93, 0xba, 0xaa, 0xef, 0xa8, 0x1d, 0x9c, 0x89, 0xe5, 0xe3, 0x2b, 0xd3, 0xe5, 0x6c, 0xb1, 0x2f,
+	0xf5, 0x62, 0x8d, 0xc8, 0x65, 0xf5, 0x4e, 0x57, 0xc5, 0xe6, 0x46, 0xf2, 0xc1, 0x2f, 0xb9, 0xb1,
+	0xaf, 0x9f, 0xdc, 0x2b, 0x08, 0xb2, 0xa3, 0x29, 0x4a, 0x30, 0xa5, 0xab, 0xa6, 0xd2, 0x50, 0x4c,
+	0x25, 0x13, 0xb7, 0xfc, 0xca, 0x6c, 0x5c, 0x59, 0x79, 0xf7, 0xc9, 0xbd, 0x02, 0x75, 0xf6, 0xe1,
+	0x93, 0x7b, 0x05, 0x9b, 0xb1, 0x55, 0xdc, 0x78, 0xab, 0xc4, 0x87, 0x8e, 0xd6, 0xe1, 0x38, 0x3f,
+	0x23, 0xab, 0xb8, 0x6d, 0xb4, 0xb0, 0x2a, 0x2e, 0xc0, 0x14, 0x89, 0xa6, 0xde, 0x6c, 0x90, 0xbc,
+	0x12, 0xf2, 0x24, 0x19, 0x6f, 0x35, 0xd0, 0xef, 0x02, 0xcc, 0xd7, 0xb0, 0x76, 0xa3, 0xdd, 0x70,
+	0xb4, 0x6a, 0x76, 0x50, 0x4f, 0xcb, 0x84, 0xdb, 0x49, 0x8c, 0x73, 0x22, 0x6e, 0x43, 0x8a, 0xa6,
+	0x5a, 0xef, 0x12, 0x3f, 0x38, 0x13, 0x7f, 0x5a, 0xae, 0x8e, 0x52, 0x03, 0x34, 0x4e, 0x5c, 0x29,
+	0xf1, 0xac, 0xe4, 0x79, 0x56, 0xbc, 0xd9, 0xa0, 0x1c, 0x2c, 0xfa, 0x0a, 0x1c, 0x8e, 0xd0, 0xf7,
+	0x02, 0x1c, 0xe3, 0x11, 0x57, 0x48, 0x5a, 0x23, 0xa4, 0xe1, 0x12, 0x24, 0x5b, 0xea, 0xdd, 0x3a,
+	0x35, 0x17, 0x0f, 0x31, 0x37, 0xd5, 0x52, 0xef, 0x92, 0x08, 0x2a, 0xab, 0x7c, 0xae, 0xd9, 0xc0,
+	0x5c, 0x09, 0x1c, 0x2d, 0xc2, 0x49, 0x9f, 0x69, 0x96, 0xe7, 0x37, 0x02, 0x1c, 0xe7, 0xe5, 0x35,
+	0xbb, 0xd5, 0x46, 0x99, 0xea, 0xa0, 0x8e, 0xbe, 0xc8, 0xe7, 0x73, 0x7a, 0x40, 0xed, 0xa8, 0x06,
+	0xca, 0x43, 0xd6, 0x5f, 0xc2, 0xb2, 0xfa, 0x2c, 0x06, 0x69, 0xbe, 0xf9, 0xb7, 0x8d, 0xbd, 0xe6,
+	0xee, 0xe1, 0x3f, 0x94, 0x93, 0xa8, 0xc0, 0x4c, 0x43, 0xdd, 0x6d, 0xe2, 0xa6, 0xd1, 0xaa, 0xb7,
+	0x89, 0xe7, 0x4c, 0x22, 0x2f, 0xac, 0x4c, 0x97, 0xd3, 0x45, 0xba, 0x8f, 0x15, 0x9d, 0x7d, 0xac,
+	0x78, 0xa5, 0x75, 0xb8, 0x81, 0x7e, 0xb8, 0xbf, 0x9a, 0xed, 0xef, 0xfd, 0xab, 0xb6, 0x01, 0x1a,
+	0xb9, 0x9c, 0x6a, 0x70, 0xe3, 0x4a, 0xf9, 0xfd, 0x2f, 0x73, 0x63, 0x3c, 0x75, 0xb9, 0xc0, 0xcd,
+	0x80, 0xea, 0x20, 0x19, 0x4e, 0xf9, 0xcd, 0xb3, 0x8d, 0xa1, 0x0c, 0x93, 0x0a, 0x65, 0x21, 0x94,
+	0x1f, 0x07, 0x88, 0xde, 0x8b, 0xc1, 0x02, 0x5f, 0x0d, 0x6a, 0x74, 0xb8, 0xe5, 0xf2, 0x02, 0xa4,
+	0x29, 0xdf, 0x94, 0xb5, 0xba, 0x13, 0x4e, 0x2c, 0x44, 0x5d, 0xd4, 0xdc, 0x9e, 0x89, 0x64, 0xd8,
+	0xf5, 0xb5, 0xce, 0x93, 0xba, 0x14, 0xd8, 0x8f, 0xae, 0x3c, 0xd1, 0x19, 0x38, 0x1d, 0x28, 0x64,
+	0x5d, 0xf9, 0x6d, 0x1c, 0x32, 0x3c, 0xff, 0x37, 0x9b, 0xe6, 0xed, 0x21, 0x3b, 0x73, 0x24, 0x27,
+	0xcd, 0x59, 0x48, 0x51, 0xba, 0xfb, 0x3a, 0xf9, 0xa8, 0xc6, 0xed, 0x04, 0x65, 0x98, 0xe7, 0xaa,
+	0xc2, 0xd0, 0x09, 0x82, 0x3e, 0xe6, 0x22, 0x9f, 0xe9, 0xac, 0xf5, 0xe9, 0x28, 0xd8, 0xae, 0xc4,
+	0x78, 0x5e, 0x58, 0x99, 0xe2, 0x0b, 0x86, 0x69, 0xb3, 0xf8, 0xac, 0x9a, 0x89, 0x11, 0xaf, 0x9a,
+	0xcb, 0xde, 0x55, 0x73, 0x26, 0x70, 0xd5, 0xf4, 0xaa, 0x83, 0x3e, 0x10, 0x20, 0x1f, 0x24, 0x8c,
+	0x70, 0xae, 0x8e, 0xb2, 0xaf, 0xd1, 0x77, 0x31, 0x40, 0x7e, 0xcd, 0xc6, 0xa7, 0xfe, 0xaf, 0x2e,
+	0x3d, 0x9f, 0x4a, 0xc6, 0x47, 0x5c, 0xc9, 0x8a, 0xb7, 0x92, 0xcb, 0x81, 0x4b, 0x95, 0xb7, 0x85,
+	0x2e, 0x40, 0x21, 0x9c, 0x40, 0xb6, 0x6c, 0xff, 0x10, 0xe0, 0x94, 0x1f, 0x7c, 0xe8, 0x83, 0x72,
+	0x94, 0x4c, 0x0f, 0x3a, 0x59, 0x2f, 0x47, 0xa5, 0x87, 0xcf, 0x07, 0x9d, 0x83, 0xa5, 0x41, 0x72,
+	0x46, 0xcc, 0x6f, 0x31, 0x98, 0xab, 0x61, 0xed, 0x5a, 0x77, 0x47, 0x6f, 0x9a, 0xdb, 0x1d, 0xa3,
+	0x6d, 0x60, 0x65, 0x2f, 0x30, 0x3b, 0x61, 0x88, 0xec, 0x4e, 0x41, 0xb2, 0x4d, 0xec, 0x3a, 0xdb,
+	0x5c, 0x52, 0xee, 0x4d, 0x0c, 0x3c, 0x81, 0x2f, 0x5a, 0x32, 0x8c, 0x15, 0x4d, 0xc5, 0x99, 0x44,
+	0x3e, 0x1e, 0xd4, 0x7a, 0x32, 0x43, 0x89, 0xe7, 0x21, 0xa1, 0x1e, 0xa8, 0xbb, 0x64, 0x7f, 0x4a,
+	0x95, 0xe7, 0x3d, 0xbb, 0x69, 0xf5, 0x40, 0xdd, 0x95, 0x09, 0x44, 0x4c, 0xc3, 0xb8, 0xd9, 0x34,
+	0xf7, 0x54, 0xb2, 0x3d, 0x25, 0x65, 0x3a, 0x10, 0x33, 0x30, 0x89, 0xbb, 0xba, 0xae, 0x74, 0x0e,
+	0x33, 0x93, 0x64, 0xde, 0x19, 0x56, 0xfe, 0xeb, 0xf4, 0x6a, 0x2f, 0x78, 0xab, 0x20, 0xc8, 0x55,
+	0x10, 0xfa, 0x78, 0xf1, 0xb0, 0x89, 0xfe, 0x0f, 0x0b, 0x9e, 0x49, 0xb6, 0xe1, 0xe4, 0x60, 0xba,
+	0x6d, 0xcf, 0xf5, 0xf6, 0x1c, 0x70, 0xa6, 0xb6, 0x1a, 0xe8, 0x2b, 0x7a, 0x8b, 0xb5, 0xf6, 0xaa,
+	0x46, 0x47, 0xb9, 0xcb, 0x6a, 0x14, 0xa6, 0xe8, 0xbe, 0x09, 0xc4, 0x22, 0xde, 0x04, 0x2a, 0x97,
+	0xac, 0x0c, 0x9d, 0x51, 0xff, 0xd1, 0xc9, 0xf2, 0xeb, 0x8f, 0xc5, 0xbe, 0xa0, 0xf6, 0x4f, 0xb3,
+	0x26, 0xfb, 0x53, 0x80, 0xc9, 0x1a, 0xd6, 0x5e, 0x35, 0xcc, 0xf0, 0x7c, 0xad, 0x95, 0xb8, 0x6f,
+	0x98, 0x6a, 0x27, 0x34, 0x68, 0x0a, 0x13, 0xd7, 0x61, 0xc2, 0x68, 0x9b, 0x4d, 0x83, 0xde, 0x0f,
+	0x52, 0xe5, 0x93, 0x9e, 0xaa, 0x5b, 0x7e, 0x5f, 0x26, 0x10, 0xd9, 0x86, 0x72, 0x6d, 0x97, 0xe8,
+	0x6b, 0xbb, 0xe8, 0x4d, 0x54, 0x59, 0x26, 0xab, 0x93, 0xc4, 0x61, 0x91, 0x95, 0xf1, 0x23, 0xcb,
+	0xf2, 0x8e, 0xe6, 0x60, 0xc6, 0xfe, 0xc9, 0x48, 0xf9, 0x88, 0x92, 0x62, 0x59, 0x0b, 0x27, 0xe5,
+	0x3f, 0x30, 0x65, 0x39, 0xec, 0x9a, 0x46, 0x38, 0x2f, 0x0c, 0x59, 0x29, 0x58, 0xe1, 0xb1, 0x61,
+	0x60, 0x84, 0x56, 0x08, 0x48, 0x86, 0x19, 0xfb, 0x27, 0x6b, 0xcd, 0x67, 0x61, 0xa2, 0xa3, 0xe2,
+	0xee, 0x9e, 0x49, 0x5c, 0xa6, 0xca, 0xcb, 0x1e, 0x2a, 0x9c, 0x4a, 0x57, 0x6d, 0x17, 0x32, 0x81,
+	0xcb, 0xb6, 0x1a, 0xfa, 0x5c, 0x80, 0x23, 0x35, 0xac, 0xc9, 0xaa, 0xd9, 0x39, 0xfc, 0x3b, 0xf3,
+	0x2c, 0x79, 0xf2, 0x5c, 0xf4, 0xcb, 0x93, 0xc5, 0x81, 0xee, 0x40, 0xda, 0x3d, 0xf6, 0xc9, 0x58,
+	0x18, 0x2a, 0x63, 0x6b, 0xff, 0x50, 0x4c, 0x53, 0xd5, 0xdb, 0xa6, 0xf3, 0xd4, 0xb0, 0x87, 0xe8,
+	0x63, 0x01, 0x8e, 0xd6, 0xb0, 0xf6, 0xa2, 0xaa, 0xec, 0xdb, 0xdf, 0x25, 0x86, 0xb8, 0xa9, 0x0f,
+	0x78, 0xcb, 0x50, 0x12, 0xdc, 0x4b, 0x37, 0xeb, 0xc7, 0x41, 0xcf, 0x3f, 0x3a, 0x01, 0xf3, 0xdc,
+	0x84, 0xc3, 0x42, 0xa1, 0x00, 0x89, 0x2a, 0xdd, 0x22, 0x67, 0xab, 0xaf, 0x55, 0x37, 0xeb, 0x37,
+	0x5e, 0xba, 0xb6, 0x5d, 0xdd, 0xdc, 0x7a, 0x6e, 0xab, 0x7a, 0x75, 0x76, 0x4c, 0x3c, 0x02, 0x53,
+	0x64, 0xf6, 0xba, 0xfc, 0xfa, 0xac, 0x50, 0xfe, 0xe2, 0x08, 0xc4, 0x6b, 0x58, 0x13, 0x6f, 0xc2,
+	0xb4, 0xfb, 0x9b, 0x4b, 0xce, 0x7b, 0x91, 0xe5, 0x6e, 0x5e, 0xd2, 0x72, 0x08, 0x80, 0x95, 0x64,
+	0x0f, 0x44, 0x9f, 0x2f, 0x19, 0xe7, 0xfc, 0xd4, 0xbd, 0x38, 0xa9, 0x18, 0x0d, 0xc7, 0xbc, 0xdd,
+	0x82, 0x59, 0xcf, 0xe7, 0x82, 0xa5, 0x10, 0x1b, 0x04, 0x25, 0x5d, 0x88, 0x82, 0x62, 0x7e, 0x0c,
+	0x38, 0xe6, 0xf7, 0x5c, 0x5f, 0x0e, 0x0d, 0x97, 0x02, 0xa5, 0x52, 0x44, 0x20, 0x73, 0xd8, 0x84,
+	0x39, 0xef, 0x4b, 0xfa, 0x6c, 0x48, 0x11, 0x28, 0x4c, 0x5a, 0x8d, 0x04, 0x63, 0xae, 0xba, 0x30,
+	0xef, 0xff, 0x3c, 0x3a, 0x1f, 0x62, 0xa7, 0x07, 0x95, 0xd6, 0x22, 0x43, 0x99, 0xdb, 0x03, 0x38,
+	0x1e, 0xf0, 0x80, 0x2d, 0x84, 0x90, 0xe5, 0xc2, 0x4a, 0xe5, 0xe8, 0x58, 0xe6, 0xf9, 0x53, 0x01,
+	0x72, 0x61, 0x37, 0xf9, 0xf5, 0x48, 0x76, 0x79, 0x25, 0xe9, 0x7f, 0x43, 0x28, 0xb1, 0xa8, 0xde,
+	0x11, 0x60, 0x21, 0xf8, 0xbe, 0xbb, 0x1a, 0xc9, 0x34, 0xeb, 0xb7, 0x4b, 0x4f, 0x05, 0x67, 0x31,
+	0xbc, 0x09, 0xa9, 0xbe, 0x9b, 0x25, 0xf2, 0x33, 0xc4, 0x63, 0xa4, 0x42, 0x38, 0xc6, 0xbd, 0x60,
+	0x3d, 0x37, 0x23, 0xdf, 0x05, 0xdb, 0x8f, 0x92, 0x2e, 0x44, 0x41, 0x31, 0x3f, 0x1b, 0x90, 0x20,
+	0xd7, 0x97, 0x8c, 0x9f, 0x96, 0x25, 0x91, 0xf2, 0x41, 0x12, 0xb7, 0x0d, 0xb2, 0xaf, 0xfa, 0xda,
+	0xb0, 0x24, 0x52, 0x3e, 0x48, 0xc2, 0x6c, 0xbc, 0x02, 0xc9, 0xde, 0x71, 0xba, 0xe8, 0x07, 0x67,
+	0x62, 0xe9, 0xec, 0x40, 0x31, 0x33, 0x79, 0x1d, 0xc0, 0x75, 0x2a, 0x65, 0xfd, 0x94, 0x7a, 0x72,
+	0xe9, 0xdc, 0x60, 0xb9, 0x63, 0x55, 0x1a, 0x7f, 0xdb, 0xfa, 0x4c, 0xb1, 0xf1, 0xcc, 0x83, 0x47,
+	0x59, 0xe1, 0xe1, 0xa3, 0xac, 0xf0, 0xeb, 0xa3, 0xac, 0xf0, 0xc9, 0xe3, 0xec, 0xd8, 0xc3, 0xc7,
+	0xd9, 0xb1, 0x9f, 0x1f, 0x67, 0xc7, 0xde, 0x58, 0xd2, 0x9a, 0xe6, 0xed, 0xee, 0x4e, 0x71, 0xd7,
+	0xd0, 0xed, 0x7f, 0x13, 0x94, 0x5c, 0x07, 0xd6, 0x01, 0x3d, 0xb2, 0x76, 0x26, 0xc8, 0x4d, 0x7f,
+	0xfd, 0xaf, 0x01, 0x00, 0xdf, 0xf8, 0x31, 0xdc, 0x98, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1636,6 +1751,9 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// RetryExec re-attempts the execution of an accepted proposal whose
+	// previous execution failed.
+	RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
 }
@@ -1765,6 +1883,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error) {
+	out := new(MsgRetryExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/RetryExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
 	out := new(MsgLeaveGroupResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/LeaveGroup", in, out, opts...)
@@ -1802,6 +1929,9 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// RetryExec re-attempts the execution of an accepted proposal whose
+	// previous execution failed.
+	RetryExec(context.Context, *MsgRetryExec) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
 }
@@ -1849,6 +1979,9 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) RetryExec(ctx context.Context, req *MsgRetryExec) (*MsgRetryExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryExec not implemented")
+}
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
@@ -2091,6 +2224,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetryExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/RetryExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryExec(ctx, req.(*MsgRetryExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLeaveGroup)
 	if err := dec(in); err != nil {
@@ -2165,6 +2316,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "RetryExec",
+			Handler:    _Msg_RetryExec_Handler,
+		},
 		{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
@@ -3163,6 +3318,74 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRetryExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3673,6 +3896,37 @@ func (m *MsgExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRetryExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTx(uint64(m.Attempt))
+	}
+	return n
+}
+
 func (m *MsgLeaveGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6526,6 +6780,195 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRetryExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38,6 +38,9 @@ type DecisionPolicy interface {
 	// where we can execution a proposal. It can be set to 0 or to a value
 	// lesser than VotingPeriod to allow TRY_EXEC.
 	GetMinExecutionPeriod() time.Duration
+	// GetMinExecutionDelay returns the minimum duration after a proposal is
+	// accepted before it can be executed.
+	GetMinExecutionDelay() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the group's total power and the time since
 	// the proposal was submitted.
@@ -52,7 +55,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -65,6 +68,11 @@ func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetMinExecutionDelay returns the minimum execution delay of ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) GetMinExecutionDelay() time.Duration {
+	return p.Windows.minExecutionDelay()
+}
+
 // ValidateBasic does basic validation on ThresholdDecisionPolicy
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
@@ -146,18 +154,118 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 		return errorsmod.Wrap(err, "group total weight")
 	}
 
-	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
-		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	return p.Windows.validate(config)
+}
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &ThresholdWithVetoDecisionPolicy{}
+
+// NewThresholdWithVetoDecisionPolicy creates a threshold DecisionPolicy with a veto role
+// held by the given members and, if vetoWeightThreshold is not empty, by the members
+// with a weight greater or equal than vetoWeightThreshold.
+func NewThresholdWithVetoDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration, vetoMembers []string, vetoWeightThreshold string) DecisionPolicy {
+	return &ThresholdWithVetoDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}, vetoMembers, vetoWeightThreshold}
+}
+
+// GetVotingPeriod returns the voting period of ThresholdWithVetoDecisionPolicy
+func (p ThresholdWithVetoDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of ThresholdWithVetoDecisionPolicy
+func (p ThresholdWithVetoDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// GetMinExecutionDelay returns the minimum execution delay of ThresholdWithVetoDecisionPolicy
+func (p ThresholdWithVetoDecisionPolicy) GetMinExecutionDelay() time.Duration {
+	return p.Windows.minExecutionDelay()
+}
+
+// ValidateBasic does basic validation on ThresholdWithVetoDecisionPolicy
+func (p ThresholdWithVetoDecisionPolicy) ValidateBasic() error {
+	if err := p.thresholdPolicy().ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(p.VetoMembers) == 0 && p.VetoWeightThreshold == "" {
+		return errorsmod.Wrap(errors.ErrEmpty, "veto members and veto weight threshold")
+	}
+
+	index := make(map[string]struct{}, len(p.VetoMembers))
+	for _, member := range p.VetoMembers {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return errorsmod.Wrap(err, "veto member")
+		}
+		if _, exists := index[member]; exists {
+			return errorsmod.Wrapf(errors.ErrDuplicate, "veto member %s", member)
+		}
+		index[member] = struct{}{}
 	}
+
+	if p.VetoWeightThreshold != "" {
+		if _, err := math.NewPositiveDecFromString(p.VetoWeightThreshold); err != nil {
+			return errorsmod.Wrap(err, "veto weight threshold")
+		}
+	}
+
 	return nil
 }
 
+// Allow rejects a proposal as soon as a member holding the veto role votes VETO,
+// otherwise it behaves as ThresholdDecisionPolicy.Allow.
+func (p ThresholdWithVetoDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	vetoCount, err := tallyResult.GetVetoCount()
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "veto count")
+	}
+	if !vetoCount.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return p.thresholdPolicy().Allow(tallyResult, totalPower)
+}
+
+// Validate validates the policy against the group.
+func (p *ThresholdWithVetoDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return p.thresholdPolicy().Validate(g, config)
+}
+
+// CanVeto returns whether the given group member holds the veto role, i.e. if
+// it is one of the veto members or if its weight reaches the veto weight threshold.
+func (p ThresholdWithVetoDecisionPolicy) CanVeto(member Member) (bool, error) {
+	for _, vetoMember := range p.VetoMembers {
+		if vetoMember == member.Address {
+			return true, nil
+		}
+	}
+
+	if p.VetoWeightThreshold == "" {
+		return false, nil
+	}
+
+	vetoWeightThreshold, err := math.NewPositiveDecFromString(p.VetoWeightThreshold)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "veto weight threshold")
+	}
+	weight, err := math.NewNonNegativeDecFromString(member.Weight)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "member weight")
+	}
+
+	return weight.Cmp(vetoWeightThreshold) >= 0, nil
+}
+
+func (p ThresholdWithVetoDecisionPolicy) thresholdPolicy() *ThresholdDecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: p.Threshold, Windows: p.Windows}
+}
+
 // Implements DecisionPolicy Interface
 var _ DecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of PercentageDecisionPolicy
@@ -170,6 +278,11 @@ func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetMinExecutionDelay returns the minimum execution delay of PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) GetMinExecutionDelay() time.Duration {
+	return p.Windows.minExecutionDelay()
+}
+
 // ValidateBasic does basic validation on PercentageDecisionPolicy
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	percentage, err := math.NewPositiveDecFromString(p.Percentage)
@@ -189,12 +302,35 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 
 // Validate validates the policy against the group.
 func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
-	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+	return p.Windows.validate(config)
+}
+
+// validate checks that the proposals of a decision policy with the windows can
+// be executed before they expire.
+func (w DecisionPolicyWindows) validate(config Config) error {
+	if w.MinExecutionPeriod > w.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+
+	minExecutionDelay := w.minExecutionDelay()
+	if minExecutionDelay < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_delay cannot be negative")
+	}
+	if minExecutionDelay > 0 && w.VotingPeriod+minExecutionDelay > config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "voting_period + min_execution_delay should be smaller than max_execution_period")
+	}
+
 	return nil
 }
 
+// minExecutionDelay returns the min execution delay, which defaults to 0.
+func (w DecisionPolicyWindows) minExecutionDelay() time.Duration {
+	if w.MinExecutionDelay == nil {
+		return 0
+	}
+	return *w.MinExecutionDelay
+}
+
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage threshold before the timeout.
 func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
 	percentage, err := math.NewPositiveDecFromString(p.Percentage)
@@ -375,6 +511,37 @@ func (g GroupMember) ValidateBasic() error {
 	return nil
 }
 
+func (s GroupMembersSnapshot) PrimaryKeyFields() []interface{} {
+	return []interface{}{s.GroupId, s.GroupVersion}
+}
+
+// ValidateBasic does basic validation on a group members snapshot.
+func (s GroupMembersSnapshot) ValidateBasic() error {
+	if s.GroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "snapshot's group id")
+	}
+
+	if s.GroupVersion == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "snapshot's group version")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(s.TotalWeight); err != nil {
+		return errorsmod.Wrap(err, "total weight")
+	}
+
+	for _, m := range s.Members {
+		if err := (GroupMember{GroupId: s.GroupId, Member: m}).ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "snapshot's member")
+		}
+	}
+
+	if len(s.ProposalIds) == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "snapshot's proposal ids")
+	}
+
+	return nil
+}
+
 // MemberToMemberRequest converts a `Member` (used for storage)
 // to a `MemberRequest` (used in requests). The only difference
 // between the two is that `MemberRequest` doesn't have any `AddedAt` field
@@ -415,6 +582,10 @@ func (g Proposal) ValidateBasic() error {
 		return errorsmod.Wrap(err, "proposal FinalTallyResult abstain count")
 	}
 	_, err = g.FinalTallyResult.GetNoWithVetoCount()
+	if err != nil {
+		return errorsmod.Wrap(err, "proposal FinalTallyResult no with veto count")
+	}
+	_, err = g.FinalTallyResult.GetVetoCount()
 	if err != nil {
 		return errorsmod.Wrap(err, "proposal FinalTallyResult veto count")
 	}
@@ -488,7 +659,11 @@ func (t *TallyResult) operation(vote Vote, weight string, op operation) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "abstain count")
 	}
-	vetoCount, err := t.GetNoWithVetoCount()
+	noWithVetoCount, err := t.GetNoWithVetoCount()
+	if err != nil {
+		return errorsmod.Wrap(err, "no with veto count")
+	}
+	vetoCount, err := t.GetVetoCount()
 	if err != nil {
 		return errorsmod.Wrap(err, "veto count")
 	}
@@ -513,11 +688,17 @@ func (t *TallyResult) operation(vote Vote, weight string, op operation) error {
 		}
 		t.AbstainCount = abstainCount.String()
 	case VOTE_OPTION_NO_WITH_VETO:
+		noWithVetoCount, err := op(noWithVetoCount, weightDec)
+		if err != nil {
+			return errorsmod.Wrap(err, "no with veto count")
+		}
+		t.NoWithVetoCount = noWithVetoCount.String()
+	case VOTE_OPTION_VETO:
 		vetoCount, err := op(vetoCount, weightDec)
 		if err != nil {
 			return errorsmod.Wrap(err, "veto count")
 		}
-		t.NoWithVetoCount = vetoCount.String()
+		t.VetoCount = vetoCount.String()
 	default:
 		return errorsmod.Wrapf(errors.ErrInvalid, "unknown vote option %s", vote.Option.String())
 	}
@@ -560,6 +741,20 @@ func (t TallyResult) GetNoWithVetoCount() (math.Dec, error) {
 	return vetoCount, nil
 }
 
+// GetVetoCount returns the weight of the votes cast with the veto role from
+// tally result. An empty veto count, as found in tally results created before
+// the veto role was introduced, is zero.
+func (t TallyResult) GetVetoCount() (math.Dec, error) {
+	if t.VetoCount == "" {
+		return math.NewDecFromInt64(0), nil
+	}
+	vetoCount, err := math.NewNonNegativeDecFromString(t.VetoCount)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	return vetoCount, nil
+}
+
 func (t *TallyResult) Add(vote Vote, weight string) error {
 	if err := t.operation(vote, weight, math.Add); err != nil {
 		return err
@@ -581,7 +776,11 @@ func (t TallyResult) TotalCounts() (math.Dec, error) {
 	if err != nil {
 		return math.Dec{}, errorsmod.Wrap(err, "abstain count")
 	}
-	vetoCount, err := t.GetNoWithVetoCount()
+	noWithVetoCount, err := t.GetNoWithVetoCount()
+	if err != nil {
+		return math.Dec{}, errorsmod.Wrap(err, "no with veto count")
+	}
+	vetoCount, err := t.GetVetoCount()
 	if err != nil {
 		return math.Dec{}, errorsmod.Wrap(err, "veto count")
 	}
@@ -599,6 +798,10 @@ func (t TallyResult) TotalCounts() (math.Dec, error) {
 	if err != nil {
 		return math.Dec{}, err
 	}
+	totalCounts, err = totalCounts.Add(noWithVetoCount)
+	if err != nil {
+		return math.Dec{}, err
+	}
 	totalCounts, err = totalCounts.Add(vetoCount)
 	if err != nil {
 		return math.Dec{}, err
@@ -46,6 +46,10 @@ const (
 	VOTE_OPTION_NO VoteOption = 3
 	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
 	VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+	// VOTE_OPTION_VETO defines a veto vote option, which can only be cast by
+	// members holding the veto role of a ThresholdWithVetoDecisionPolicy and
+	// rejects the proposal immediately.
+	VOTE_OPTION_VETO VoteOption = 5
 )
 
 var VoteOption_name = map[int32]string{
@@ -54,6 +58,7 @@ var VoteOption_name = map[int32]string{
 	2: "VOTE_OPTION_ABSTAIN",
 	3: "VOTE_OPTION_NO",
 	4: "VOTE_OPTION_NO_WITH_VETO",
+	5: "VOTE_OPTION_VETO",
 }
 
 var VoteOption_value = map[string]int32{
@@ -62,6 +67,7 @@ var VoteOption_value = map[string]int32{
 	"VOTE_OPTION_ABSTAIN":      2,
 	"VOTE_OPTION_NO":           3,
 	"VOTE_OPTION_NO_WITH_VETO": 4,
+	"VOTE_OPTION_VETO":         5,
 }
 
 func (x VoteOption) String() string {
@@ -357,6 +363,85 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// ThresholdWithVetoDecisionPolicy is a threshold decision policy which
+// additionally gives a veto role to some group members. A proposal passes when
+// it satisfies the two conditions of ThresholdDecisionPolicy, unless a member
+// holding the veto role casts a `VETO` vote, in which case the proposal is
+// immediately rejected regardless of the `YES` votes.
+type ThresholdWithVetoDecisionPolicy struct {
+	// threshold is the minimum weighted sum of `YES` votes that must be met or
+	// exceeded for a proposal to succeed.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+	// veto_members are the addresses of the group members holding the veto role.
+	VetoMembers []string `protobuf:"bytes,3,rep,name=veto_members,json=vetoMembers,proto3" json:"veto_members,omitempty"`
+	// veto_weight_threshold is the minimum weight from which a group member holds
+	// the veto role. If empty, only the veto_members hold the veto role.
+	VetoWeightThreshold string `protobuf:"bytes,4,opt,name=veto_weight_threshold,json=vetoWeightThreshold,proto3" json:"veto_weight_threshold,omitempty"`
+}
+
+func (m *ThresholdWithVetoDecisionPolicy) Reset()         { *m = ThresholdWithVetoDecisionPolicy{} }
+func (m *ThresholdWithVetoDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ThresholdWithVetoDecisionPolicy) ProtoMessage()    {}
+func (*ThresholdWithVetoDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
+}
+func (m *ThresholdWithVetoDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdWithVetoDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdWithVetoDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdWithVetoDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdWithVetoDecisionPolicy.Merge(m, src)
+}
+func (m *ThresholdWithVetoDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdWithVetoDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdWithVetoDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdWithVetoDecisionPolicy proto.InternalMessageInfo
+
+func (m *ThresholdWithVetoDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *ThresholdWithVetoDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+func (m *ThresholdWithVetoDecisionPolicy) GetVetoMembers() []string {
+	if m != nil {
+		return m.VetoMembers
+	}
+	return nil
+}
+
+func (m *ThresholdWithVetoDecisionPolicy) GetVetoWeightThreshold() string {
+	if m != nil {
+		return m.VetoWeightThreshold
+	}
+	return ""
+}
+
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
 //  1. The percentage of all `YES` voters' weights out of the total group weight
@@ -375,7 +460,7 @@ func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPoli
 func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*PercentageDecisionPolicy) ProtoMessage()    {}
 func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
 }
 func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,13 +520,26 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// min_execution_delay is the minimum duration after a proposal is accepted
+	// before its messages can be executed, giving members time to react to a
+	// malicious proposal. The delay applies from the time the proposal reaches
+	// the `ACCEPTED` status, and is fixed at that time in its `executable_at`
+	// field, so that updating the decision policy doesn't change it for
+	// already accepted proposals. If not set, min_execution_delay will default
+	// to 0.
+	//
+	// When set, `voting_period + min_execution_delay` must not exceed
+	// max_execution_period.
+	//
+	// Since: cosmos-sdk 0.48
+	MinExecutionDelay *time.Duration `protobuf:"bytes,3,opt,name=min_execution_delay,json=minExecutionDelay,proto3,stdduration" json:"min_execution_delay,omitempty"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,6 +582,13 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetMinExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.MinExecutionDelay
+	}
+	return nil
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// id is the unique ID of the group.
@@ -507,7 +612,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +695,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,6 +738,91 @@ func (m *GroupMember) GetMember() *Member {
 	return nil
 }
 
+// GroupMembersSnapshot is the set of members of a group at a given group
+// version, which the final tally of proposals was computed against. It is kept
+// until all these proposals are pruned.
+type GroupMembersSnapshot struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// group_version is the version of the group the snapshot was taken at.
+	GroupVersion uint64 `protobuf:"varint,2,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
+	// total_weight is the sum of the group members' weights at group_version.
+	TotalWeight string `protobuf:"bytes,3,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// members is the list of the group members at group_version.
+	Members []*Member `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	// proposal_ids is the list of the proposals whose final tally was computed
+	// against the snapshot.
+	ProposalIds []uint64 `protobuf:"varint,5,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
+}
+
+func (m *GroupMembersSnapshot) Reset()         { *m = GroupMembersSnapshot{} }
+func (m *GroupMembersSnapshot) String() string { return proto.CompactTextString(m) }
+func (*GroupMembersSnapshot) ProtoMessage()    {}
+func (*GroupMembersSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+}
+func (m *GroupMembersSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupMembersSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupMembersSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupMembersSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMembersSnapshot.Merge(m, src)
+}
+func (m *GroupMembersSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupMembersSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMembersSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMembersSnapshot proto.InternalMessageInfo
+
+func (m *GroupMembersSnapshot) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *GroupMembersSnapshot) GetGroupVersion() uint64 {
+	if m != nil {
+		return m.GroupVersion
+	}
+	return 0
+}
+
+func (m *GroupMembersSnapshot) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return ""
+}
+
+func (m *GroupMembersSnapshot) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *GroupMembersSnapshot) GetProposalIds() []uint64 {
+	if m != nil {
+		return m.ProposalIds
+	}
+	return nil
+}
+
 // GroupPolicyInfo represents the high-level on-chain information for a group policy.
 type GroupPolicyInfo struct {
 	// address is the account address of group policy.
@@ -656,7 +846,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,13 +923,20 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// executable_at is the earliest time at which the messages of the proposal
+	// can be executed. It is only set once the proposal is accepted, from the
+	// time of its acceptance plus the min_execution_delay of its decision policy,
+	// and no earlier than its submission plus the min_execution_period.
+	//
+	// Since: cosmos-sdk 0.48
+	ExecutableAt *time.Time `protobuf:"bytes,15,opt,name=executable_at,json=executableAt,proto3,stdtime" json:"executable_at,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,13 +975,15 @@ type TallyResult struct {
 	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
 	// no_with_veto_count is the weighted sum of veto.
 	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// veto_count is the weighted sum of votes cast with the veto role.
+	VetoCount string `protobuf:"bytes,5,opt,name=veto_count,json=vetoCount,proto3" json:"veto_count,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +1030,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,10 +1101,12 @@ func init() {
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
+	proto.RegisterType((*ThresholdWithVetoDecisionPolicy)(nil), "cosmos.group.v1.ThresholdWithVetoDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
+	proto.RegisterType((*GroupMembersSnapshot)(nil), "cosmos.group.v1.GroupMembersSnapshot")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
@@ -915,93 +1116,105 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x1f, 0x87, 0x12, 0x49, 0x8f, 0x75, 0xad, 0x95, 0xe4, 0x4b, 0xea, 0xd2,
-	0xc6, 0xbd, 0x82, 0x2e, 0x4c, 0xda, 0x32, 0x90, 0x00, 0x2e, 0x82, 0x90, 0xd4, 0x3a, 0xa6, 0x60,
-	0x93, 0xc4, 0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x98, 0x5a, 0x84, 0xbb, 0xc3, 0xec, 0x0e,
-	0x25, 0xf3, 0x1f, 0x18, 0x69, 0xe2, 0x32, 0x4d, 0x00, 0x03, 0x69, 0x52, 0xba, 0x30, 0x52, 0xa4,
-	0x0c, 0x52, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95, 0x36, 0x41,
-	0xb0, 0x33, 0xb3, 0x14, 0x1f, 0x12, 0x1d, 0x19, 0x46, 0x1a, 0x41, 0x33, 0xdf, 0x77, 0xce, 0x9c,
-	0xef, 0xbc, 0x48, 0xc2, 0x5a, 0x9b, 0x78, 0x36, 0xf1, 0x8a, 0x1d, 0x97, 0xf4, 0x7b, 0xc5, 0xc3,
-	0x6b, 0x45, 0x3a, 0xe8, 0x61, 0xaf, 0xd0, 0x73, 0x09, 0x25, 0x28, 0xcd, 0xc1, 0x02, 0x03, 0x0b,
-	0x87, 0xd7, 0x56, 0x97, 0x3a, 0xa4, 0x43, 0x18, 0x56, 0xf4, 0xff, 0xe3, 0xb4, 0xd5, 0x6c, 0x87,
-	0x90, 0x4e, 0x17, 0x17, 0xd9, 0x69, 0xbf, 0x7f, 0xbf, 0x68, 0xf6, 0x5d, 0x83, 0x5a, 0xc4, 0x11,
-	0x78, 0x6e, 0x12, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x3d, 0x41, 0x58, 0xe1, 0xef, 0xe8, 0xdc,
-	0xb3, 0x78, 0x54, 0x40, 0x93, 0xb6, 0x86, 0x33, 0x10, 0xd0, 0x39, 0xc3, 0xb6, 0x1c, 0x52, 0x64,
-	0x7f, 0xf9, 0x55, 0xfe, 0x2b, 0x09, 0xa2, 0x77, 0xb0, 0xbd, 0x8f, 0x5d, 0xb4, 0x05, 0x31, 0xc3,
-	0x34, 0x5d, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0x24, 0xca, 0xf2, 0x0f, 0x4f, 0xaf, 0x2c, 0x09,
-	0xdf, 0x25, 0x8e, 0x34, 0xa9, 0x6b, 0x39, 0x1d, 0x35, 0x20, 0xa2, 0x0b, 0x10, 0x3d, 0xc2, 0x56,
-	0xe7, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x27, 0xb4, 0x0a, 0x71, 0x1b, 0x53, 0xc3, 0x34, 0xa8,
-	0x21, 0x87, 0x19, 0x32, 0x3c, 0xa3, 0x6d, 0x88, 0x1b, 0xa6, 0x89, 0x4d, 0xdd, 0xa0, 0x72, 0x64,
-	0x5d, 0xda, 0x48, 0x6e, 0xad, 0x16, 0x78, 0xcc, 0x85, 0x20, 0xe6, 0x82, 0x16, 0xe8, 0x2d, 0x2f,
-	0x3e, 0xfb, 0x29, 0x37, 0xf7, 0xe8, 0xe7, 0x9c, 0xf4, 0xe5, 0xab, 0x27, 0x9b, 0x12, 0x7b, 0x19,
-	0x9b, 0x25, 0x9a, 0x3f, 0x82, 0x45, 0x1e, 0xb7, 0x8a, 0x3f, 0xee, 0x63, 0x8f, 0xfe, 0x53, 0xe1,
-	0xe7, 0xbf, 0x95, 0x60, 0x59, 0x3b, 0x70, 0xb1, 0x77, 0x40, 0xba, 0xe6, 0x36, 0x6e, 0x5b, 0x9e,
-	0x45, 0x9c, 0x06, 0xe9, 0x5a, 0xed, 0x01, 0xba, 0x08, 0x09, 0x1a, 0x40, 0x3c, 0x0a, 0xf5, 0xf8,
-	0x02, 0xbd, 0x0f, 0xb1, 0x23, 0xcb, 0x31, 0xc9, 0x91, 0xc7, 0x9e, 0x4b, 0x6e, 0xfd, 0xb7, 0x30,
-	0xd1, 0x2e, 0x85, 0x71, 0x7f, 0x7b, 0x9c, 0xad, 0x06, 0x66, 0x37, 0xaa, 0xdf, 0x3d, 0xbd, 0x92,
-	0x9d, 0x6d, 0xf3, 0xc9, 0xab, 0x27, 0x9b, 0x79, 0x4e, 0xb9, 0xe2, 0x99, 0x1f, 0x15, 0x4f, 0x09,
-	0x35, 0xff, 0x4c, 0x02, 0xb9, 0x81, 0xdd, 0x36, 0x76, 0xa8, 0xd1, 0xc1, 0x13, 0x3a, 0xb2, 0x00,
-	0xbd, 0x21, 0x26, 0x84, 0x8c, 0xdc, 0xbc, 0x05, 0x25, 0x3b, 0x7f, 0x4f, 0xc9, 0xa5, 0x11, 0x25,
-	0xa7, 0x45, 0x9b, 0xff, 0x46, 0x82, 0x7f, 0x9d, 0xf8, 0x1c, 0xba, 0x03, 0x8b, 0x87, 0x84, 0x5a,
-	0x4e, 0x47, 0xef, 0x61, 0xd7, 0x22, 0xbc, 0x26, 0xc9, 0xad, 0x95, 0xa9, 0x7e, 0xdb, 0x16, 0xf3,
-	0xc7, 0xdb, 0xed, 0xb3, 0x61, 0xbb, 0x2d, 0x70, 0xf3, 0x06, 0xb3, 0x46, 0xf7, 0x60, 0xc9, 0xb6,
-	0x1c, 0x1d, 0x3f, 0xc0, 0xed, 0xbe, 0xcf, 0x0e, 0xbc, 0x86, 0xce, 0xe8, 0x15, 0xd9, 0x96, 0xa3,
-	0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x6f, 0x12, 0x24, 0x3e, 0xf0, 0x13, 0x51, 0x75, 0xee, 0x13, 0x94,
-	0x82, 0x90, 0xc5, 0xa3, 0x8d, 0xa8, 0x21, 0xcb, 0x44, 0x05, 0x98, 0x37, 0x4c, 0xdb, 0x72, 0x78,
-	0x9f, 0xce, 0x68, 0x6d, 0x4e, 0x9b, 0x39, 0x7f, 0x32, 0xc4, 0x0e, 0xb1, 0xeb, 0x27, 0x8b, 0x8d,
-	0x5f, 0x44, 0x0d, 0x8e, 0xe8, 0x3f, 0xb0, 0x40, 0x09, 0x35, 0xba, 0xba, 0x18, 0x8a, 0x79, 0x66,
-	0x99, 0x64, 0x77, 0x7b, 0x7c, 0x32, 0x6e, 0x01, 0xb4, 0x5d, 0x6c, 0x50, 0x3e, 0xbe, 0xd1, 0xb3,
-	0x8e, 0x6f, 0x42, 0x18, 0x97, 0x68, 0xfe, 0x2e, 0x24, 0x99, 0x5e, 0xb1, 0x7d, 0x56, 0x20, 0xce,
-	0xfa, 0x40, 0x1f, 0xea, 0x8e, 0xb1, 0x73, 0xd5, 0x44, 0x45, 0x88, 0xda, 0x8c, 0x24, 0x12, 0xbd,
-	0x3c, 0xd5, 0x6c, 0x62, 0x13, 0x08, 0x5a, 0xfe, 0x8f, 0x10, 0xa4, 0x99, 0x6f, 0xde, 0x0d, 0x2c,
-	0xa3, 0x6f, 0xb2, 0x1e, 0x46, 0x63, 0x0a, 0x8d, 0xc7, 0x34, 0x2c, 0x48, 0xf8, 0xec, 0x05, 0x89,
-	0x9c, 0x5e, 0x90, 0xf9, 0xf1, 0x82, 0x18, 0x90, 0x36, 0x45, 0x63, 0xeb, 0x3d, 0xa6, 0x45, 0xa4,
-	0x7c, 0x69, 0x2a, 0xe5, 0x25, 0x67, 0x50, 0xce, 0xbf, 0x7e, 0xa8, 0xd4, 0x94, 0x39, 0x3e, 0xea,
-	0xe3, 0x05, 0x8d, 0xbd, 0x79, 0x41, 0x6f, 0xc4, 0x1f, 0x3e, 0xce, 0xcd, 0xfd, 0xfa, 0x38, 0x27,
-	0xe5, 0xff, 0x9c, 0x87, 0x78, 0xc3, 0x25, 0x3d, 0xe2, 0x19, 0xdd, 0xa9, 0x56, 0xde, 0x81, 0x25,
-	0x9e, 0x54, 0x2e, 0x48, 0x0f, 0xaa, 0xf2, 0xba, 0xce, 0x46, 0x9d, 0xe3, 0x8a, 0x0a, 0x64, 0x66,
-	0x9b, 0xbf, 0x03, 0x89, 0x1e, 0x8b, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0xcf, 0x74, 0x7e, 0x4c,
-	0x45, 0x3b, 0x90, 0xf4, 0xfa, 0xfb, 0xb6, 0x45, 0x75, 0xff, 0x43, 0x97, 0x55, 0xe4, 0x4c, 0x19,
-	0x01, 0x6e, 0xed, 0xe3, 0xe8, 0x12, 0x2c, 0x72, 0xad, 0x41, 0x7d, 0xa3, 0x2c, 0x0d, 0x0b, 0xec,
-	0x72, 0x57, 0x14, 0xf9, 0xea, 0x44, 0x42, 0x02, 0x6e, 0x8c, 0x71, 0x47, 0x65, 0x07, 0x16, 0xef,
-	0x42, 0xd4, 0xa3, 0x06, 0xed, 0x7b, 0x72, 0x7c, 0x5d, 0xda, 0x48, 0x6d, 0xe5, 0xa6, 0x06, 0x22,
-	0xc8, 0x7e, 0x93, 0xd1, 0x54, 0x41, 0x47, 0x2d, 0x40, 0xf7, 0x2d, 0xc7, 0xe8, 0xea, 0xd4, 0xe8,
-	0x76, 0x07, 0xba, 0x8b, 0xbd, 0x7e, 0x97, 0xca, 0x09, 0x26, 0xf1, 0xe2, 0x94, 0x13, 0xcd, 0x27,
-	0xa9, 0x8c, 0x53, 0x4e, 0xf8, 0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x02, 0xa2, 0x16, 0x9c, 0x1b,
-	0x5b, 0xb3, 0x3a, 0x76, 0x4c, 0x19, 0xce, 0x9a, 0xb8, 0xf4, 0xe8, 0xae, 0x55, 0x1c, 0x13, 0x35,
-	0x20, 0xcd, 0x57, 0x2d, 0x71, 0x83, 0x50, 0x93, 0x4c, 0xef, 0xff, 0x4e, 0xd5, 0xab, 0x08, 0x3e,
-	0x0f, 0x4c, 0x4d, 0xe1, 0xb1, 0x33, 0xba, 0xea, 0xf7, 0x8b, 0xe7, 0x19, 0x1d, 0xec, 0xc9, 0x0b,
-	0xeb, 0xe1, 0xd3, 0x06, 0x49, 0x1d, 0xb2, 0xd0, 0x12, 0xcc, 0x53, 0x8b, 0x76, 0xb1, 0xbc, 0xc8,
-	0xda, 0x8b, 0x1f, 0xfc, 0x89, 0xf5, 0xfa, 0xb6, 0x6d, 0xb8, 0x03, 0x39, 0xc5, 0xee, 0x83, 0xe3,
-	0x8d, 0x88, 0x3f, 0x04, 0xf9, 0xcf, 0x25, 0x48, 0x8e, 0x26, 0x68, 0x0d, 0x12, 0x03, 0xec, 0xe9,
-	0x6d, 0xd2, 0x77, 0xa8, 0xf8, 0x38, 0x8d, 0x0f, 0xb0, 0x57, 0xf1, 0xcf, 0x7e, 0x93, 0x18, 0xfb,
-	0x1e, 0x35, 0x2c, 0x47, 0x10, 0xf8, 0x77, 0x91, 0x05, 0x71, 0xc9, 0x49, 0x2b, 0x10, 0x77, 0x88,
-	0xc0, 0x79, 0xa7, 0xc7, 0x1c, 0xc2, 0xa1, 0xff, 0x03, 0x72, 0x88, 0x7e, 0x64, 0xd1, 0x03, 0xfd,
-	0x10, 0xd3, 0x80, 0xc4, 0x97, 0x4c, 0xda, 0x21, 0x7b, 0x16, 0x3d, 0xd8, 0xc5, 0x94, 0x93, 0x45,
-	0x7c, 0xbf, 0x4b, 0x10, 0xd9, 0x25, 0x14, 0xa3, 0x1c, 0x24, 0x7b, 0x22, 0x75, 0xc7, 0x8b, 0x17,
-	0x82, 0x2b, 0xbe, 0xe7, 0x0e, 0x09, 0x15, 0xab, 0x77, 0xe6, 0x9e, 0x63, 0x34, 0x74, 0x1d, 0xa2,
-	0xa4, 0xe7, 0x7f, 0xac, 0xb1, 0x28, 0x53, 0x5b, 0x6b, 0x53, 0xa5, 0xf2, 0xdf, 0xad, 0x33, 0x8a,
-	0x2a, 0xa8, 0x33, 0x97, 0xe3, 0x5b, 0x1c, 0xc7, 0xcd, 0x4f, 0x25, 0x80, 0xe3, 0xe7, 0xd1, 0x1a,
-	0x2c, 0xef, 0xd6, 0x35, 0x45, 0xaf, 0x37, 0xb4, 0x6a, 0xbd, 0xa6, 0xb7, 0x6a, 0xcd, 0x86, 0x52,
-	0xa9, 0xde, 0xac, 0x2a, 0xdb, 0x99, 0x39, 0x74, 0x1e, 0xd2, 0xa3, 0xe0, 0x5d, 0xa5, 0x99, 0x91,
-	0xd0, 0x32, 0x9c, 0x1f, 0xbd, 0x2c, 0x95, 0x9b, 0x5a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
-	0x28, 0x50, 0xab, 0x67, 0xc2, 0xe8, 0x22, 0xc8, 0xe3, 0x77, 0xfa, 0x5e, 0x55, 0xbb, 0xa5, 0xef,
-	0x2a, 0x5a, 0x3d, 0x13, 0x59, 0x8d, 0x3c, 0xfc, 0x22, 0x3b, 0xb7, 0xf9, 0xbd, 0x04, 0xa9, 0xf1,
-	0x59, 0x45, 0x39, 0x58, 0x6b, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xba, 0xad, 0x37, 0xb5, 0x92, 0xd6,
-	0x6a, 0x4e, 0x44, 0xf6, 0x6f, 0x58, 0x99, 0x24, 0x34, 0x5b, 0xe5, 0x3b, 0x55, 0x4d, 0x53, 0xb6,
-	0x33, 0x92, 0xff, 0xec, 0x24, 0x5c, 0xaa, 0x54, 0x94, 0x86, 0x8f, 0x86, 0x4e, 0x42, 0x55, 0x65,
-	0x47, 0xa9, 0xf8, 0x68, 0xd8, 0xcf, 0xc8, 0x94, 0x6d, 0xb9, 0xae, 0xfa, 0x60, 0xe4, 0xa4, 0x77,
-	0x7d, 0x41, 0xdb, 0x6a, 0x69, 0xaf, 0x96, 0x99, 0x17, 0x82, 0xbe, 0x96, 0xe0, 0xc2, 0xc9, 0xc3,
-	0x88, 0x36, 0xe0, 0xf2, 0xd0, 0x5e, 0xf9, 0x50, 0xa9, 0xb4, 0xb4, 0xba, 0xaa, 0xab, 0x4a, 0xb3,
-	0x75, 0x5b, 0x9b, 0x50, 0x78, 0x19, 0xd6, 0x4f, 0x65, 0xd6, 0xea, 0x9a, 0xae, 0xb6, 0x6a, 0x19,
-	0x69, 0x26, 0xab, 0xd9, 0xaa, 0x54, 0x94, 0x66, 0x33, 0x13, 0x9a, 0xc9, 0xba, 0x59, 0xaa, 0xde,
-	0x6e, 0xa9, 0x4a, 0x26, 0xcc, 0x83, 0x2f, 0xbf, 0xf7, 0xec, 0x45, 0x56, 0x7a, 0xfe, 0x22, 0x2b,
-	0xfd, 0xf2, 0x22, 0x2b, 0x3d, 0x7a, 0x99, 0x9d, 0x7b, 0xfe, 0x32, 0x3b, 0xf7, 0xe3, 0xcb, 0xec,
-	0xdc, 0xbd, 0xcb, 0x1d, 0x8b, 0x1e, 0xf4, 0xf7, 0x0b, 0x6d, 0x62, 0x8b, 0x5f, 0x5b, 0xc5, 0x91,
-	0x6f, 0xa6, 0x0f, 0xf8, 0x8f, 0xc1, 0xfd, 0x28, 0x6b, 0xc7, 0xeb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xe9, 0x54, 0xdb, 0x23, 0x0e, 0x00, 0x00,
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x13, 0xd7,
+	0x16, 0xce, 0xd8, 0x8e, 0x7f, 0x1c, 0x27, 0xb6, 0xb9, 0x31, 0x64, 0x92, 0x80, 0x9d, 0x67, 0xd0,
+	0x7b, 0x51, 0x9e, 0xb0, 0x21, 0x48, 0xef, 0x49, 0xa9, 0x54, 0xd5, 0x76, 0x86, 0xe2, 0x08, 0x62,
+	0x77, 0x6c, 0x27, 0x85, 0xcd, 0x68, 0xe2, 0xb9, 0x38, 0xa3, 0xda, 0x73, 0x5d, 0xcf, 0x75, 0x82,
+	0xff, 0x03, 0xd4, 0x15, 0x52, 0x37, 0x5d, 0x22, 0x55, 0x95, 0xba, 0x64, 0x81, 0xba, 0xa8, 0xba,
+	0x2c, 0x12, 0xea, 0xa2, 0x45, 0x5d, 0x75, 0xd5, 0x56, 0xb0, 0xa0, 0xab, 0xae, 0xba, 0xad, 0x54,
+	0xcd, 0xbd, 0x77, 0x9c, 0xf1, 0x8f, 0x18, 0x82, 0x10, 0x1b, 0xc4, 0xbd, 0xdf, 0x77, 0xce, 0x3d,
+	0xe7, 0xdc, 0xef, 0x9c, 0x3b, 0x31, 0xac, 0x34, 0x88, 0xdd, 0x26, 0x76, 0xae, 0xd9, 0x25, 0xbd,
+	0x4e, 0xee, 0xf0, 0x6a, 0x8e, 0xf6, 0x3b, 0xd8, 0xce, 0x76, 0xba, 0x84, 0x12, 0x14, 0xe7, 0x60,
+	0x96, 0x81, 0xd9, 0xc3, 0xab, 0xcb, 0xc9, 0x26, 0x69, 0x12, 0x86, 0xe5, 0x9c, 0xff, 0x71, 0xda,
+	0x72, 0xaa, 0x49, 0x48, 0xb3, 0x85, 0x73, 0x6c, 0xb5, 0xdf, 0xbb, 0x9b, 0x33, 0x7a, 0x5d, 0x9d,
+	0x9a, 0xc4, 0x12, 0x78, 0x7a, 0x14, 0xa7, 0x66, 0x1b, 0xdb, 0x54, 0x6f, 0x77, 0x04, 0x61, 0x89,
+	0x9f, 0xa3, 0x71, 0xcf, 0xe2, 0x50, 0x01, 0x8d, 0xda, 0xea, 0x56, 0x5f, 0x40, 0x67, 0xf4, 0xb6,
+	0x69, 0x91, 0x1c, 0xfb, 0x97, 0x6f, 0x65, 0xbe, 0x91, 0x20, 0x78, 0x0b, 0xb7, 0xf7, 0x71, 0x17,
+	0x6d, 0x40, 0x48, 0x37, 0x8c, 0x2e, 0xb6, 0x6d, 0x59, 0x5a, 0x95, 0xd6, 0x22, 0x05, 0xf9, 0xe7,
+	0xc7, 0x97, 0x93, 0xc2, 0x77, 0x9e, 0x23, 0x55, 0xda, 0x35, 0xad, 0xa6, 0xea, 0x12, 0xd1, 0x39,
+	0x08, 0x1e, 0x61, 0xb3, 0x79, 0x40, 0x65, 0x9f, 0x63, 0xa2, 0x8a, 0x15, 0x5a, 0x86, 0x70, 0x1b,
+	0x53, 0xdd, 0xd0, 0xa9, 0x2e, 0xfb, 0x19, 0x32, 0x58, 0xa3, 0x2d, 0x08, 0xeb, 0x86, 0x81, 0x0d,
+	0x4d, 0xa7, 0x72, 0x60, 0x55, 0x5a, 0x8b, 0x6e, 0x2c, 0x67, 0x79, 0xcc, 0x59, 0x37, 0xe6, 0x6c,
+	0xcd, 0xcd, 0xb7, 0x30, 0xff, 0xf4, 0xd7, 0xf4, 0xcc, 0x83, 0xdf, 0xd2, 0xd2, 0xd7, 0x2f, 0x1f,
+	0xad, 0x4b, 0xec, 0x64, 0x6c, 0xe4, 0x69, 0xe6, 0x08, 0xe6, 0x79, 0xdc, 0x2a, 0xfe, 0xb4, 0x87,
+	0x6d, 0xfa, 0xae, 0xc2, 0xcf, 0x7c, 0x2f, 0xc1, 0x62, 0xed, 0xa0, 0x8b, 0xed, 0x03, 0xd2, 0x32,
+	0xb6, 0x70, 0xc3, 0xb4, 0x4d, 0x62, 0x55, 0x48, 0xcb, 0x6c, 0xf4, 0xd1, 0x79, 0x88, 0x50, 0x17,
+	0xe2, 0x51, 0xa8, 0xc7, 0x1b, 0xe8, 0x03, 0x08, 0x1d, 0x99, 0x96, 0x41, 0x8e, 0x6c, 0x76, 0x5c,
+	0x74, 0xe3, 0xdf, 0xd9, 0x11, 0xb9, 0x64, 0x87, 0xfd, 0xed, 0x71, 0xb6, 0xea, 0x9a, 0x6d, 0x96,
+	0x7e, 0x78, 0x7c, 0x39, 0x35, 0xdd, 0xe6, 0xb3, 0x97, 0x8f, 0xd6, 0x33, 0x9c, 0x72, 0xd9, 0x36,
+	0x3e, 0xc9, 0x9d, 0x10, 0x6a, 0xe6, 0x89, 0x0f, 0xd2, 0x03, 0x6c, 0xcf, 0xa4, 0x07, 0xbb, 0x98,
+	0x92, 0x77, 0x9b, 0x0e, 0x7a, 0x0f, 0xe6, 0x0e, 0x31, 0x25, 0x5a, 0x9b, 0x5d, 0xa4, 0x2d, 0xfb,
+	0x57, 0xfd, 0x53, 0xef, 0x2d, 0xea, 0xb0, 0xf9, 0xad, 0xdb, 0x68, 0x03, 0xce, 0x32, 0x63, 0x7e,
+	0x65, 0xda, 0x71, 0xa0, 0x01, 0x16, 0xe8, 0x82, 0x03, 0xee, 0x31, 0x6c, 0x90, 0xe6, 0xe6, 0x47,
+	0xaf, 0x57, 0xbf, 0xf5, 0x49, 0xf5, 0x9b, 0x5c, 0xa3, 0xcc, 0x53, 0x09, 0xe4, 0x0a, 0xee, 0x36,
+	0xb0, 0x45, 0xf5, 0x26, 0x1e, 0x29, 0x60, 0x0a, 0xa0, 0x33, 0xc0, 0x44, 0x05, 0x3d, 0x3b, 0x6f,
+	0x41, 0x11, 0xdb, 0xaf, 0x97, 0xd1, 0x45, 0x4f, 0x46, 0x27, 0x45, 0x9b, 0xf9, 0xdc, 0x07, 0x67,
+	0x27, 0x1e, 0x87, 0x6e, 0xc1, 0xfc, 0x21, 0xa1, 0xa6, 0xd5, 0xd4, 0x3a, 0xb8, 0x6b, 0x12, 0x2e,
+	0x86, 0xe8, 0xc6, 0xd2, 0x58, 0xdf, 0x6e, 0x89, 0x39, 0xc6, 0xdb, 0xf6, 0x8b, 0x41, 0xdb, 0xce,
+	0x71, 0xf3, 0x0a, 0xb3, 0x46, 0x77, 0x20, 0xd9, 0x36, 0x2d, 0x0d, 0xdf, 0xc3, 0x8d, 0x9e, 0xc3,
+	0x76, 0xbd, 0xfa, 0x4e, 0xe9, 0x15, 0xb5, 0x4d, 0x4b, 0x71, 0x9d, 0x08, 0xdf, 0x65, 0x58, 0x18,
+	0xf6, 0x6d, 0xe0, 0x96, 0xde, 0x67, 0x5d, 0x3c, 0xd5, 0x75, 0xc0, 0x71, 0xab, 0x9e, 0xf1, 0x7a,
+	0xdc, 0x72, 0x2c, 0x33, 0x7f, 0x4a, 0x10, 0xf9, 0xd0, 0xa9, 0x6c, 0xc9, 0xba, 0x4b, 0x50, 0x0c,
+	0x7c, 0x26, 0x4f, 0x3f, 0xa0, 0xfa, 0x4c, 0x03, 0x65, 0x61, 0x56, 0x37, 0xda, 0xa6, 0xc5, 0x07,
+	0xc8, 0x14, 0xed, 0x72, 0xda, 0xd4, 0xc1, 0x28, 0x43, 0xe8, 0x10, 0x77, 0x9d, 0xea, 0x33, 0x0d,
+	0x07, 0x54, 0x77, 0x89, 0xfe, 0x05, 0x73, 0x94, 0x50, 0xbd, 0x25, 0xc4, 0x2e, 0xcf, 0x32, 0xcb,
+	0x28, 0xdb, 0xe3, 0x1a, 0x47, 0x37, 0x00, 0x1a, 0x5d, 0xac, 0x53, 0x3e, 0x57, 0x83, 0xa7, 0x9d,
+	0xab, 0x11, 0x61, 0x9c, 0xa7, 0x99, 0xdb, 0x10, 0x65, 0xf9, 0x8a, 0x67, 0x61, 0x09, 0xc2, 0x4c,
+	0x58, 0xda, 0x20, 0xef, 0x10, 0x5b, 0x97, 0x0c, 0x94, 0x83, 0x20, 0x6f, 0x5d, 0x71, 0x73, 0x8b,
+	0x63, 0xea, 0x15, 0x23, 0x5a, 0xd0, 0x32, 0x3f, 0x49, 0x90, 0xf4, 0xf8, 0xb6, 0xab, 0x96, 0xde,
+	0xb1, 0x0f, 0x08, 0x9d, 0x76, 0xc8, 0x45, 0x98, 0xe7, 0x90, 0x5b, 0x1b, 0x1f, 0xc3, 0xe7, 0xd8,
+	0xe6, 0xee, 0x09, 0x05, 0xf2, 0x8f, 0x17, 0xe8, 0x2a, 0x84, 0xdc, 0x39, 0x13, 0x58, 0xf5, 0x4f,
+	0x8b, 0xd6, 0xe5, 0x39, 0x5e, 0x3b, 0x5d, 0xd2, 0x21, 0xb6, 0xde, 0xd2, 0x4c, 0xc3, 0x96, 0x67,
+	0x57, 0xfd, 0x6b, 0x01, 0x35, 0xea, 0xee, 0x95, 0x0c, 0x3b, 0xf3, 0xb7, 0x0f, 0xe2, 0x2c, 0x23,
+	0xde, 0x30, 0x4c, 0x23, 0x6f, 0xf2, 0x12, 0x79, 0x0b, 0xe0, 0x1b, 0x2e, 0xc0, 0x40, 0x62, 0xfe,
+	0xd3, 0x4b, 0x2c, 0x70, 0xb2, 0xc4, 0x66, 0x87, 0x25, 0xa6, 0x43, 0xdc, 0x10, 0xbd, 0xaf, 0x75,
+	0x58, 0x2e, 0x42, 0x44, 0xc9, 0x31, 0x11, 0xe5, 0xad, 0x7e, 0x21, 0xf3, 0xea, 0xb9, 0xa3, 0xc6,
+	0x8c, 0xe1, 0x69, 0x38, 0x2c, 0xd1, 0xd0, 0x9b, 0x4b, 0x74, 0x33, 0x7c, 0xff, 0x61, 0x7a, 0xe6,
+	0x8f, 0x87, 0x69, 0x29, 0xf3, 0x24, 0x08, 0xe1, 0x8a, 0xb8, 0x8f, 0xb1, 0xe6, 0xdc, 0x86, 0x24,
+	0x2f, 0x2a, 0x4f, 0x48, 0x73, 0x6f, 0xe5, 0x55, 0xbd, 0x8a, 0x9a, 0xc7, 0x37, 0x2a, 0x90, 0xa9,
+	0x8d, 0xfb, 0x3f, 0x88, 0x70, 0x4d, 0xb8, 0xe2, 0x9a, 0xe6, 0xfc, 0x98, 0x8a, 0xb6, 0x21, 0x6a,
+	0xf7, 0xf6, 0xdb, 0x26, 0xd5, 0x9c, 0xef, 0x3b, 0x76, 0x23, 0xa7, 0xaa, 0x08, 0x70, 0x6b, 0x07,
+	0x1f, 0x6f, 0x93, 0xe0, 0x84, 0x36, 0xb9, 0x32, 0x52, 0x10, 0x97, 0x1b, 0x62, 0x5c, 0x6f, 0xda,
+	0xae, 0xc5, 0xff, 0x21, 0x68, 0x53, 0x9d, 0xf6, 0x6c, 0x39, 0xbc, 0x2a, 0xad, 0xc5, 0x36, 0xd2,
+	0x63, 0x4d, 0xe3, 0x56, 0xbf, 0xca, 0x68, 0xaa, 0xa0, 0xa3, 0x3a, 0xa0, 0xbb, 0xa6, 0xa5, 0xb7,
+	0x34, 0xaa, 0xb7, 0x5a, 0x7d, 0xad, 0x8b, 0xed, 0x5e, 0x8b, 0xca, 0x11, 0x96, 0xe2, 0xf9, 0x31,
+	0x27, 0x35, 0x87, 0xa4, 0x32, 0x4e, 0x21, 0xe2, 0x24, 0xc9, 0x13, 0x4c, 0x30, 0x17, 0x1e, 0x10,
+	0xd5, 0xe1, 0xcc, 0xd0, 0x4b, 0xa4, 0x61, 0xcb, 0x90, 0xe1, 0xb4, 0x85, 0x8b, 0x7b, 0x9f, 0x23,
+	0xc5, 0x32, 0x50, 0x05, 0xe2, 0xfc, 0xc5, 0x20, 0x5d, 0x37, 0xd4, 0x28, 0xcb, 0xf7, 0x3f, 0x27,
+	0xe6, 0xab, 0x08, 0x3e, 0x0f, 0x4c, 0x8d, 0xe1, 0xa1, 0x35, 0xba, 0xe2, 0xe8, 0xc5, 0xb6, 0xf5,
+	0x26, 0xb6, 0xe5, 0x39, 0x36, 0x6f, 0x26, 0x36, 0x92, 0x3a, 0x60, 0xa1, 0x24, 0xcc, 0x52, 0x93,
+	0xb6, 0xb0, 0x3c, 0xcf, 0xe4, 0xc5, 0x17, 0x4e, 0xc7, 0xda, 0xbd, 0x76, 0x5b, 0xef, 0xf6, 0xe5,
+	0x18, 0xdb, 0x77, 0x97, 0x48, 0x81, 0x79, 0x7e, 0xa6, 0xbe, 0xdf, 0xc2, 0x4e, 0x47, 0xc5, 0x5f,
+	0x59, 0x86, 0x80, 0x53, 0x02, 0x75, 0xee, 0xd8, 0x2c, 0x4f, 0x37, 0x03, 0x4e, 0x2f, 0x65, 0xbe,
+	0x93, 0x20, 0xea, 0xad, 0xf3, 0x0a, 0x44, 0xfa, 0xd8, 0xd6, 0x1a, 0xa4, 0x67, 0x51, 0xf1, 0xe1,
+	0x12, 0xee, 0x63, 0xbb, 0xe8, 0xac, 0x1d, 0xad, 0xe9, 0xfb, 0x36, 0xd5, 0x4d, 0x4b, 0x10, 0xf8,
+	0xd7, 0xf3, 0x9c, 0xd8, 0xe4, 0xa4, 0x25, 0x08, 0x5b, 0x44, 0xe0, 0xbc, 0x61, 0x42, 0x16, 0xe1,
+	0xd0, 0x7f, 0x01, 0x59, 0x44, 0x3b, 0x32, 0xe9, 0x81, 0xc6, 0x3e, 0xe1, 0x38, 0x89, 0xcf, 0xaa,
+	0xb8, 0x45, 0xdc, 0x2f, 0x2d, 0x4e, 0xbe, 0x00, 0xe0, 0x21, 0xf1, 0x97, 0x2f, 0x72, 0xe8, 0xc2,
+	0x22, 0xfc, 0xbf, 0x24, 0x08, 0xec, 0x12, 0x8a, 0x51, 0x1a, 0xa2, 0x9e, 0x91, 0x2d, 0x66, 0x01,
+	0x1c, 0x4f, 0x6c, 0x67, 0x9a, 0x1e, 0x12, 0x2a, 0x9e, 0xac, 0xa9, 0xd3, 0x94, 0xd1, 0xd0, 0x35,
+	0x08, 0x92, 0x8e, 0xf3, 0x35, 0xc0, 0x92, 0x88, 0x6d, 0xac, 0x8c, 0x09, 0xc2, 0x39, 0xb7, 0xcc,
+	0x28, 0xaa, 0xa0, 0x4e, 0x1d, 0xc1, 0x6f, 0xb1, 0xe9, 0xd7, 0xbf, 0x92, 0x00, 0x8e, 0x8f, 0x47,
+	0x2b, 0xb0, 0xb8, 0x5b, 0xae, 0x29, 0x5a, 0xb9, 0x52, 0x2b, 0x95, 0x77, 0xb4, 0xfa, 0x4e, 0xb5,
+	0xa2, 0x14, 0x4b, 0xd7, 0x4b, 0xca, 0x56, 0x62, 0x06, 0x2d, 0x40, 0xdc, 0x0b, 0xde, 0x56, 0xaa,
+	0x09, 0x09, 0x2d, 0xc2, 0x82, 0x77, 0x33, 0x5f, 0xa8, 0xd6, 0xf2, 0xa5, 0x9d, 0x84, 0x0f, 0x21,
+	0x88, 0x79, 0x81, 0x9d, 0x72, 0xc2, 0x8f, 0xce, 0x83, 0x3c, 0xbc, 0xa7, 0xed, 0x95, 0x6a, 0x37,
+	0xb4, 0x5d, 0xa5, 0x56, 0x4e, 0x04, 0x50, 0x12, 0x12, 0x5e, 0x94, 0xed, 0xce, 0x2e, 0x07, 0xee,
+	0x7f, 0x99, 0x9a, 0x59, 0xff, 0x51, 0x82, 0xd8, 0xf0, 0x9c, 0x40, 0x69, 0x58, 0xa9, 0xa8, 0xe5,
+	0x4a, 0xb9, 0x9a, 0xbf, 0xa9, 0x55, 0x6b, 0xf9, 0x5a, 0xbd, 0x3a, 0x12, 0xef, 0x05, 0x58, 0x1a,
+	0x25, 0x54, 0xeb, 0x85, 0x5b, 0xa5, 0x5a, 0x4d, 0xd9, 0x4a, 0x48, 0x4e, 0x30, 0xa3, 0x70, 0xbe,
+	0x58, 0x54, 0x2a, 0x0e, 0xea, 0x9b, 0x84, 0xaa, 0xca, 0xb6, 0x52, 0x74, 0x50, 0xbf, 0x53, 0xa7,
+	0x31, 0xdb, 0x42, 0x59, 0x75, 0xc0, 0xc0, 0xa4, 0x73, 0x9d, 0x34, 0xb7, 0xd4, 0xfc, 0xde, 0xce,
+	0x20, 0xa1, 0x6f, 0x25, 0x38, 0x37, 0x79, 0x10, 0xa0, 0x35, 0xb8, 0x34, 0xb0, 0x57, 0x3e, 0x56,
+	0x8a, 0xf5, 0x5a, 0x59, 0xd5, 0x54, 0xa5, 0x5a, 0xbf, 0x59, 0x1b, 0xc9, 0xf0, 0x12, 0xac, 0x9e,
+	0xc8, 0xdc, 0x29, 0xd7, 0x34, 0xb5, 0xbe, 0x93, 0x90, 0xa6, 0xb2, 0xaa, 0xf5, 0x62, 0x51, 0xa9,
+	0x56, 0x13, 0xbe, 0xa9, 0xac, 0xeb, 0xf9, 0xd2, 0xcd, 0xba, 0xaa, 0x24, 0xfc, 0x3c, 0xf8, 0xc2,
+	0xfb, 0x4f, 0x9f, 0xa7, 0xa4, 0x67, 0xcf, 0x53, 0xd2, 0xef, 0xcf, 0x53, 0xd2, 0x83, 0x17, 0xa9,
+	0x99, 0x67, 0x2f, 0x52, 0x33, 0xbf, 0xbc, 0x48, 0xcd, 0xdc, 0xb9, 0xd4, 0x34, 0xe9, 0x41, 0x6f,
+	0x3f, 0xdb, 0x20, 0x6d, 0xf1, 0xa3, 0x42, 0xce, 0xf3, 0x87, 0xc3, 0x3d, 0xfe, 0x9b, 0xc7, 0x7e,
+	0x90, 0x89, 0xf4, 0xda, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x97, 0x75, 0xd9, 0x0a, 0x11,
+	0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1184,6 +1397,64 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ThresholdWithVetoDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdWithVetoDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdWithVetoDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoWeightThreshold) > 0 {
+		i -= len(m.VetoWeightThreshold)
+		copy(dAtA[i:], m.VetoWeightThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoWeightThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VetoMembers) > 0 {
+		for iNdEx := len(m.VetoMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VetoMembers[iNdEx])
+			copy(dAtA[i:], m.VetoMembers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PercentageDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1246,20 +1517,30 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	if m.MinExecutionDelay != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MinExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MinExecutionDelay):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1285,12 +1566,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1367,6 +1648,78 @@ func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GroupMembersSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMembersSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMembersSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalIds) > 0 {
+		dAtA11 := make([]byte, len(m.ProposalIds)*10)
+		var j10 int
+		for _, num := range m.ProposalIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTypes(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupPolicyInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1387,12 +1740,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1461,6 +1814,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutableAt != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutableAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutableAt):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTypes(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1494,12 +1857,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1527,12 +1890,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1586,6 +1949,13 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VetoCount) > 0 {
+		i -= len(m.VetoCount)
+		copy(dAtA[i:], m.VetoCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoCount)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.NoWithVetoCount) > 0 {
 		i -= len(m.NoWithVetoCount)
 		copy(dAtA[i:], m.NoWithVetoCount)
@@ -1637,12 +2007,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n18
+	i = encodeVarintTypes(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1744,6 +2114,33 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *ThresholdWithVetoDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.VetoMembers) > 0 {
+		for _, s := range m.VetoMembers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.VetoWeightThreshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *PercentageDecisionPolicy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1771,6 +2168,10 @@ func (m *DecisionPolicyWindows) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.MinExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MinExecutionDelay)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1819,6 +2220,38 @@ func (m *GroupMember) Size() (n int) {
 	return n
 }
 
+func (m *GroupMembersSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	if m.GroupVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupVersion))
+	}
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ProposalIds) > 0 {
+		l = 0
+		for _, e := range m.ProposalIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *GroupPolicyInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1907,6 +2340,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ExecutableAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutableAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1932,6 +2369,10 @@ func (m *TallyResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.VetoCount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2371,22 +2812,204 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdWithVetoDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdWithVetoDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdWithVetoDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoMembers = append(m.VetoMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoWeightThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Windows == nil {
-				m.Windows = &DecisionPolicyWindows{}
-			}
-			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VetoWeightThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2622,6 +3245,42 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinExecutionDelay == nil {
+				m.MinExecutionDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MinExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2965,6 +3624,236 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GroupMembersSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMembersSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMembersSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+			}
+			m.GroupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposalIds = append(m.ProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposalIds) == 0 {
+					m.ProposalIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposalIds = append(m.ProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3635,6 +4524,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutableAt == nil {
+				m.ExecutableAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutableAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3813,6 +4738,38 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			}
 			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
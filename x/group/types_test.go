@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/x/group"
 )
 
@@ -30,6 +31,39 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"min exec delay too big",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour * 24 * 7,
+					MinExecutionDelay: durationPtr(time.Hour*24*7 + time.Second),
+				},
+			},
+			true,
+		},
+		{
+			"negative min exec delay",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour,
+					MinExecutionDelay: durationPtr(-time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"min exec delay fitting in max exec period",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour * 24 * 7,
+					MinExecutionDelay: durationPtr(time.Hour * 24 * 7),
+				},
+			},
+			false,
+		},
 		{
 			"all good",
 			group.ThresholdDecisionPolicy{
@@ -73,6 +107,39 @@ func TestPercentageDecisionPolicyValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"min exec delay too big",
+			group.PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour * 24 * 7,
+					MinExecutionDelay: durationPtr(time.Hour*24*7 + time.Second),
+				},
+			},
+			true,
+		},
+		{
+			"negative min exec delay",
+			group.PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour,
+					MinExecutionDelay: durationPtr(-time.Hour),
+				},
+			},
+			true,
+		},
+		{
+			"min exec delay fitting in max exec period",
+			group.PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour * 24 * 7,
+					MinExecutionDelay: durationPtr(time.Hour * 24 * 7),
+				},
+			},
+			false,
+		},
 		{
 			"all good",
 			group.PercentageDecisionPolicy{
@@ -386,3 +453,128 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestThresholdWithVetoDecisionPolicyValidateBasic(t *testing.T) {
+	addrs := simtestutil.CreateIncrementalAccounts(1)
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+
+	testCases := []struct {
+		name   string
+		policy group.ThresholdWithVetoDecisionPolicy
+		expErr string
+	}{
+		{
+			"veto members",
+			group.ThresholdWithVetoDecisionPolicy{Threshold: "2", Windows: windows, VetoMembers: []string{addrs[0].String()}},
+			"",
+		},
+		{
+			"veto weight threshold",
+			group.ThresholdWithVetoDecisionPolicy{Threshold: "2", Windows: windows, VetoWeightThreshold: "3"},
+			"",
+		},
+		{
+			"no veto role",
+			group.ThresholdWithVetoDecisionPolicy{Threshold: "2", Windows: windows},
+			"veto members and veto weight threshold",
+		},
+		{
+			"invalid veto member",
+			group.ThresholdWithVetoDecisionPolicy{Threshold: "2", Windows: windows, VetoMembers: []string{"invalid"}},
+			"veto member",
+		},
+		{
+			"duplicate veto member",
+			group.ThresholdWithVetoDecisionPolicy{Threshold: "2", Windows: windows, VetoMembers: []string{addrs[0].String(), addrs[0].String()}},
+			"duplicate",
+		},
+		{
+			"invalid veto weight threshold",
+			group.ThresholdWithVetoDecisionPolicy{Threshold: "2", Windows: windows, VetoWeightThreshold: "0"},
+			"veto weight threshold",
+		},
+		{
+			"invalid threshold",
+			group.ThresholdWithVetoDecisionPolicy{Threshold: "-1", Windows: windows, VetoWeightThreshold: "3"},
+			"threshold",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestThresholdWithVetoDecisionPolicyAllow(t *testing.T) {
+	policy := &group.ThresholdWithVetoDecisionPolicy{
+		Threshold:           "2",
+		Windows:             &group.DecisionPolicyWindows{VotingPeriod: time.Second * 100},
+		VetoWeightThreshold: "1",
+	}
+
+	testCases := []struct {
+		name   string
+		tally  *group.TallyResult
+		result group.DecisionPolicyResult
+	}{
+		{
+			"YesCount >= threshold without veto",
+			&group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"YesCount < threshold without veto",
+			&group.TallyResult{YesCount: "1", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"veto before threshold is reached",
+			&group.TallyResult{YesCount: "1", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0", VetoCount: "1"},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"veto after threshold is reached",
+			&group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0", VetoCount: "1"},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.Allow(*tc.tally, "5")
+			require.NoError(t, err)
+			require.Equal(t, tc.result, policyResult)
+		})
+	}
+}
+
+func TestThresholdWithVetoDecisionPolicyCanVeto(t *testing.T) {
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+	policy := group.ThresholdWithVetoDecisionPolicy{
+		Threshold:           "2",
+		Windows:             &group.DecisionPolicyWindows{VotingPeriod: time.Hour},
+		VetoMembers:         []string{addrs[0].String()},
+		VetoWeightThreshold: "5",
+	}
+
+	canVeto, err := policy.CanVeto(group.Member{Address: addrs[0].String(), Weight: "1"})
+	require.NoError(t, err)
+	require.True(t, canVeto)
+
+	canVeto, err = policy.CanVeto(group.Member{Address: addrs[1].String(), Weight: "5"})
+	require.NoError(t, err)
+	require.True(t, canVeto)
+
+	canVeto, err = policy.CanVeto(group.Member{Address: addrs[2].String(), Weight: "4"})
+	require.NoError(t, err)
+	require.False(t, canVeto)
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
@@ -26,6 +26,14 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
+	if params.TimeBasedProvisions {
+		// fall back to the block based provisions when no previous mint time
+		// is known, i.e. on the first block of the chain
+		if lastMintTime, found := k.GetLastMintTime(ctx); found {
+			mintedCoin = minter.TimeProvision(params, ctx.BlockTime().Sub(lastMintTime))
+		}
+	}
+	k.SetLastMintTime(ctx, ctx.BlockTime())
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -45,18 +45,20 @@ func TestGetCmdQueryParams(t *testing.T) {
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
 			`[--height=1 --output=json]`,
-			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0"}`,
+			`{"mint_denom":"","inflation_rate_change":"0.000000000000000000","inflation_max":"0.000000000000000000","inflation_min":"0.000000000000000000","goal_bonded":"0.000000000000000000","blocks_per_year":"0","time_based_provisions":false,"max_block_duration":"0s"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", flags.FlagOutput)},
 			`[--height=1 --output=text]`,
 			`blocks_per_year: "0"
-goal_bonded: "0"
-inflation_max: "0"
-inflation_min: "0"
-inflation_rate_change: "0"
-mint_denom: ""`,
+goal_bonded: "0.000000000000000000"
+inflation_max: "0.000000000000000000"
+inflation_min: "0.000000000000000000"
+inflation_rate_change: "0.000000000000000000"
+max_block_duration: 0s
+mint_denom: ""
+time_based_provisions: false`,
 		},
 	}
 
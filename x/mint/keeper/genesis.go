@@ -13,6 +13,10 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 		panic(err)
 	}
 
+	if data.LastMintTime != nil {
+		keeper.SetLastMintTime(ctx, *data.LastMintTime)
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -20,5 +24,11 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(minter, params)
+	genesis := types.NewGenesisState(minter, params)
+
+	if lastMintTime, found := keeper.GetLastMintTime(ctx); found {
+		genesis.LastMintTime = &lastMintTime
+	}
+
+	return genesis
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/golang/mock/gomock"
@@ -68,6 +69,8 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 		math.LegacyNewDecWithPrec(69, 2),
 		uint64(60*60*8766/5),
 	)
+	lastMintTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	genesisState.LastMintTime = &lastMintTime
 
 	s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
 
@@ -79,6 +82,10 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 	params := s.keeper.GetParams(s.sdkCtx)
 	s.Require().Equal(genesisState.Params, params)
 
+	gotLastMintTime, found := s.keeper.GetLastMintTime(s.sdkCtx)
+	s.Require().True(found)
+	s.Require().Equal(lastMintTime, gotLastMintTime)
+
 	genesisState2 := s.keeper.ExportGenesis(s.sdkCtx)
 	s.Require().Equal(genesisState, genesisState2)
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -80,6 +81,28 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, bz)
 }
 
+// GetLastMintTime returns the block time at which tokens were last minted.
+func (k Keeper) GetLastMintTime(ctx sdk.Context) (lastMintTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastMintTimeKey)
+	if bz == nil {
+		return lastMintTime, false
+	}
+
+	lastMintTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return lastMintTime, true
+}
+
+// SetLastMintTime sets the block time at which tokens were last minted.
+func (k Keeper) SetLastMintTime(ctx sdk.Context, lastMintTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastMintTimeKey, sdk.FormatTimeBytes(lastMintTime))
+}
+
 // SetParams sets the x/mint module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	store := ctx.KVStore(k.storeKey)
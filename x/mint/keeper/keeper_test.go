@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -139,3 +141,82 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestLastMintTime() {
+	_, found := s.mintKeeper.GetLastMintTime(s.ctx)
+	s.Require().False(found)
+
+	lastMintTime := time.Unix(1000, 0).UTC()
+	s.mintKeeper.SetLastMintTime(s.ctx, lastMintTime)
+	got, found := s.mintKeeper.GetLastMintTime(s.ctx)
+	s.Require().True(found)
+	s.Require().Equal(lastMintTime, got)
+}
+
+func (s *IntegrationTestSuite) TestTimeBasedProvisions() {
+	params := types.DefaultParams()
+	params.TimeBasedProvisions = true
+	s.Require().NoError(s.mintKeeper.SetParams(s.ctx, params))
+
+	// keep the inflation and supply constant so that the annual provisions do
+	// not change from one block to the next
+	inflation := sdkmath.LegacyNewDecWithPrec(10, 2)
+	ic := func(sdk.Context, types.Minter, types.Params, sdkmath.LegacyDec) sdkmath.LegacyDec { return inflation }
+	stakingTokenSupply := sdkmath.NewInt(1_000_000_000_000)
+	annualProvisions := inflation.MulInt(stakingTokenSupply)
+
+	minted := sdkmath.ZeroInt()
+	s.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(stakingTokenSupply).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(params.GoalBonded).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, coins sdk.Coins) error {
+			minted = minted.Add(coins.AmountOf(params.MintDenom))
+			return nil
+		}).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).AnyTimes()
+
+	start := time.Unix(1_700_000_000, 0).UTC()
+	period := 24 * time.Hour
+	expected := annualProvisions.MulInt64(int64(period)).QuoInt64(int64(types.YearDuration)).TruncateInt()
+
+	// mintPeriod runs the begin blocker for blocks spaced by gap during period
+	// and returns the amount minted.
+	mintPeriod := func(gap time.Duration) (sdkmath.Int, int64) {
+		minted = sdkmath.ZeroInt()
+		s.mintKeeper.SetLastMintTime(s.ctx, start)
+		blocks := int64(period / gap)
+		for i := int64(1); i <= blocks; i++ {
+			ctx := s.ctx.WithBlockTime(start.Add(time.Duration(i) * gap))
+			s.Require().NoError(mint.BeginBlocker(ctx, s.mintKeeper, ic))
+		}
+		return minted, blocks
+	}
+
+	// fast and slow blocks mint the same amount over the same period, up to
+	// the truncation of the provisions of each block
+	fastMinted, fastBlocks := mintPeriod(2 * time.Second)
+	slowMinted, slowBlocks := mintPeriod(30 * time.Second)
+	s.Require().True(expected.Sub(fastMinted).Int64() >= 0 && expected.Sub(fastMinted).Int64() < fastBlocks,
+		"expected %s, minted %s with 2s blocks", expected, fastMinted)
+	s.Require().True(expected.Sub(slowMinted).Int64() >= 0 && expected.Sub(slowMinted).Int64() < slowBlocks,
+		"expected %s, minted %s with 30s blocks", expected, slowMinted)
+
+	// a block after a halt only mints the provisions of max_block_duration
+	minted = sdkmath.ZeroInt()
+	s.mintKeeper.SetLastMintTime(s.ctx, start)
+	ctx := s.ctx.WithBlockTime(start.Add(time.Hour))
+	s.Require().NoError(mint.BeginBlocker(ctx, s.mintKeeper, ic))
+	capped := annualProvisions.MulInt64(int64(params.MaxBlockDuration)).QuoInt64(int64(types.YearDuration)).TruncateInt()
+	s.Require().Equal(capped, minted)
+	lastMintTime, found := s.mintKeeper.GetLastMintTime(ctx)
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockTime(), lastMintTime)
+
+	// the block based provisions ignore the time elapsed since the last mint
+	params.TimeBasedProvisions = false
+	s.Require().NoError(s.mintKeeper.SetParams(s.ctx, params))
+	minted = sdkmath.ZeroInt()
+	ctx = s.ctx.WithBlockTime(start.Add(2 * time.Hour))
+	s.Require().NoError(mint.BeginBlocker(ctx, s.mintKeeper, ic))
+	s.Require().Equal(annualProvisions.QuoInt64(int64(params.BlocksPerYear)).TruncateInt(), minted)
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -42,6 +44,39 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 			},
 			expectErr: true,
 		},
+		{
+			name: "set invalid params (time based provisions without max block duration)",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params: types.Params{
+					MintDenom:           sdk.DefaultBondDenom,
+					InflationRateChange: sdkmath.LegacyNewDecWithPrec(8, 2),
+					InflationMax:        sdkmath.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        sdkmath.LegacyNewDecWithPrec(2, 2),
+					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
+					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					TimeBasedProvisions: true,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set valid params with time based provisions",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params: types.Params{
+					MintDenom:           sdk.DefaultBondDenom,
+					InflationRateChange: sdkmath.LegacyNewDecWithPrec(8, 2),
+					InflationMax:        sdkmath.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        sdkmath.LegacyNewDecWithPrec(2, 2),
+					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
+					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					TimeBasedProvisions: true,
+					MaxBlockDuration:    time.Minute,
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "set full valid params",
 			request: &types.MsgUpdateParams{
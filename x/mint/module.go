@@ -175,6 +175,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// Dependencies implements module.HasDependencies: the mint module creates its
+// module account when initialized from genesis.
+func (AppModule) Dependencies() []string {
+	return []string{authtypes.ModuleName}
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -19,6 +20,10 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key, types.LastMintTimeKey):
+			timeA, _ := sdk.ParseTimeBytes(kvA.Value)
+			timeB, _ := sdk.ParseTimeBytes(kvB.Value)
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
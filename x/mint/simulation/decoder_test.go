@@ -3,10 +3,12 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -20,10 +22,12 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(encCfg.Codec)
 
 	minter := types.NewMinter(math.LegacyOneDec(), math.LegacyNewDec(15))
+	lastMintTime := time.Unix(1000, 0).UTC()
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: encCfg.Codec.MustMarshal(&minter)},
+			{Key: types.LastMintTimeKey, Value: sdk.FormatTimeBytes(lastMintTime)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -32,6 +36,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"LastMintTime", fmt.Sprintf("%v\n%v", lastMintTime, lastMintTime)},
 		{"other", ""},
 	}
 
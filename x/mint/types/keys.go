@@ -2,8 +2,9 @@ package types
 
 var (
 	// MinterKey is the key to use for the keeper store.
-	MinterKey = []byte{0x00}
-	ParamsKey = []byte{0x01}
+	MinterKey       = []byte{0x00}
+	ParamsKey       = []byte{0x01}
+	LastMintTimeKey = []byte{0x02}
 )
 
 const (
@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -81,6 +85,18 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// time_based_provisions mints the provisions of a block from the time elapsed
+	// since the previous block instead of dividing the annual provisions by
+	// blocks_per_year.
+	//
+	// Since: cosmos-sdk 0.48
+	TimeBasedProvisions bool `protobuf:"varint,7,opt,name=time_based_provisions,json=timeBasedProvisions,proto3" json:"time_based_provisions,omitempty"`
+	// max_block_duration caps the time elapsed since the previous block used to
+	// compute time based provisions, so that a chain halt does not mint the
+	// provisions of the whole downtime in a single block.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxBlockDuration time.Duration `protobuf:"bytes,8,opt,name=max_block_duration,json=maxBlockDuration,proto3,stdduration" json:"max_block_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,6 +146,20 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetTimeBasedProvisions() bool {
+	if m != nil {
+		return m.TimeBasedProvisions
+	}
+	return false
+}
+
+func (m *Params) GetMaxBlockDuration() time.Duration {
+	if m != nil {
+		return m.MaxBlockDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
@@ -138,34 +168,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0x8a, 0x4d, 0x18, 0xdd, 0xe8, 0xce, 0x6a, 0x52, 0x37, 0xd9, 0xee, 0x66, 0x0f,
-	0x04, 0x49, 0x68, 0x43, 0xbc, 0x19, 0x2f, 0x02, 0x57, 0x12, 0xd2, 0x9b, 0x5c, 0x9a, 0x69, 0x3b,
-	0x94, 0x09, 0xed, 0x0c, 0x99, 0x19, 0x08, 0x7c, 0x05, 0x4f, 0x5e, 0xfd, 0x06, 0x1e, 0x39, 0xf8,
-	0x21, 0xb8, 0x49, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0x81, 0xaf, 0x61, 0x3a, 0xd3, 0x14, 0xe3, 0xc1,
-	0x8b, 0x78, 0xe9, 0x9f, 0xe7, 0x79, 0xfb, 0x7b, 0x9e, 0x36, 0x7d, 0xa1, 0x1b, 0x73, 0x99, 0x73,
-	0xe9, 0xe7, 0x94, 0x29, 0x7f, 0xd1, 0x89, 0x88, 0xc2, 0x1d, 0x7d, 0xe3, 0xcd, 0x04, 0x57, 0x1c,
-	0x5d, 0x19, 0xdf, 0xd3, 0x52, 0xe9, 0x5f, 0x3f, 0x4f, 0x79, 0xca, 0xb5, 0xef, 0x17, 0x57, 0x66,
-	0xf4, 0xfa, 0xa5, 0x19, 0x0d, 0x8d, 0x51, 0x3e, 0x67, 0xac, 0x4b, 0x9c, 0x53, 0xc6, 0x7d, 0x7d,
-	0x34, 0xd2, 0xfd, 0x57, 0x00, 0xed, 0x01, 0x65, 0x8a, 0x08, 0x34, 0x82, 0x75, 0xca, 0xc6, 0x19,
-	0x56, 0x94, 0x33, 0x07, 0xdc, 0x81, 0x66, 0xbd, 0xfb, 0x76, 0xb3, 0xbb, 0xb5, 0x7e, 0xec, 0x6e,
-	0x1b, 0x29, 0x55, 0x93, 0x79, 0xe4, 0xc5, 0x3c, 0x2f, 0x89, 0xe5, 0xa9, 0x2d, 0x93, 0xa9, 0xaf,
-	0x56, 0x33, 0x22, 0xbd, 0x3e, 0x89, 0xbf, 0x7d, 0x69, 0xc3, 0x32, 0xb0, 0x4f, 0xe2, 0xe0, 0x84,
-	0x43, 0x14, 0x5e, 0x62, 0xc6, 0xe6, 0x38, 0x2b, 0x6a, 0x2d, 0xa8, 0xa4, 0x9c, 0x49, 0xe7, 0xc1,
-	0x19, 0x32, 0x9e, 0x19, 0xec, 0xb0, 0xa2, 0xde, 0x7f, 0xaa, 0x41, 0x7b, 0x88, 0x05, 0xce, 0x25,
-	0xba, 0x81, 0xb0, 0xf8, 0x60, 0x61, 0x42, 0x18, 0xcf, 0xcd, 0x2b, 0x05, 0xf5, 0x42, 0xe9, 0x17,
-	0x02, 0x9a, 0xc3, 0x17, 0x55, 0xc3, 0x50, 0x60, 0x45, 0xc2, 0x78, 0x82, 0x59, 0x4a, 0xca, 0x62,
-	0xef, 0xfe, 0xa5, 0xd8, 0xe7, 0xe3, 0xba, 0x05, 0x82, 0xab, 0x8a, 0x1f, 0x60, 0x45, 0x7a, 0x9a,
-	0x8e, 0xc6, 0xf0, 0xe2, 0x14, 0x9b, 0xe3, 0xa5, 0xf3, 0xf0, 0x5c, 0x71, 0x4f, 0x2a, 0xee, 0x00,
-	0x2f, 0xff, 0xc8, 0xa1, 0xcc, 0xa9, 0xfd, 0x87, 0x1c, 0xca, 0x50, 0x04, 0x1f, 0xa7, 0x1c, 0x67,
-	0x61, 0xc4, 0x59, 0x42, 0x12, 0xe7, 0xd1, 0xb9, 0x52, 0x60, 0x41, 0xed, 0x6a, 0x28, 0x6a, 0xc0,
-	0xa7, 0x51, 0xc6, 0xe3, 0xa9, 0x0c, 0x67, 0x44, 0x84, 0x2b, 0x82, 0x85, 0x63, 0xdf, 0x81, 0x66,
-	0x2d, 0xb8, 0x30, 0xf2, 0x90, 0x88, 0xf7, 0x04, 0x8b, 0x37, 0x37, 0x1f, 0x8e, 0xeb, 0x96, 0xf3,
-	0x5b, 0xc2, 0xd2, 0xac, 0x94, 0xf9, 0x21, 0xba, 0xbd, 0xcd, 0xde, 0x05, 0xdb, 0xbd, 0x0b, 0x7e,
-	0xee, 0x5d, 0xf0, 0xf1, 0xe0, 0x5a, 0xdb, 0x83, 0x6b, 0x7d, 0x3f, 0xb8, 0xd6, 0xe8, 0xd5, 0x5f,
-	0x7b, 0x96, 0x14, 0x5d, 0x37, 0xb2, 0xf5, 0xe6, 0xbc, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0xf6, 0x61, 0x3e, 0xb4, 0x03, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x6b, 0xd8, 0xca, 0xea, 0x51, 0xb1, 0xb9, 0x4c, 0xca, 0x26, 0x2d, 0xad, 0x76, 0x98,
+	0xca, 0xa4, 0x25, 0xda, 0xb8, 0x21, 0x2e, 0x64, 0xbd, 0x4e, 0xaa, 0x72, 0x40, 0x62, 0x17, 0xcb,
+	0x49, 0xdc, 0xcc, 0x5a, 0x6c, 0x57, 0x89, 0x33, 0x65, 0xaf, 0xc0, 0x89, 0x23, 0x2f, 0x80, 0xc4,
+	0x71, 0x07, 0x1e, 0x62, 0x37, 0x26, 0x4e, 0x88, 0xc3, 0x40, 0xed, 0x61, 0xaf, 0x81, 0x6c, 0xa7,
+	0xed, 0xc4, 0x81, 0x0b, 0xe5, 0x92, 0xc4, 0xdf, 0xff, 0xcb, 0xef, 0xff, 0xb7, 0xe5, 0x0f, 0xba,
+	0xb1, 0x2c, 0xb8, 0x2c, 0x7c, 0xce, 0x84, 0xf2, 0x2f, 0x8f, 0x22, 0xaa, 0xc8, 0x91, 0x59, 0x78,
+	0xe3, 0x5c, 0x2a, 0x89, 0x3a, 0x56, 0xf7, 0x4c, 0xa9, 0xd6, 0x77, 0x9e, 0xa7, 0x32, 0x95, 0x46,
+	0xf7, 0xf5, 0x97, 0x6d, 0xdd, 0xd9, 0xb6, 0xad, 0xd8, 0x0a, 0xf5, 0x7f, 0x56, 0xda, 0x24, 0x9c,
+	0x09, 0xe9, 0x9b, 0x67, 0x5d, 0x72, 0x53, 0x29, 0xd3, 0x8c, 0xfa, 0x66, 0x15, 0x95, 0x23, 0x3f,
+	0x29, 0x73, 0xa2, 0x98, 0x14, 0x56, 0xdf, 0xfb, 0x0a, 0x60, 0xf3, 0x94, 0x09, 0x45, 0x73, 0x74,
+	0x06, 0x5b, 0x4c, 0x8c, 0x32, 0xa3, 0x3a, 0xa0, 0x07, 0xfa, 0xad, 0xe0, 0xf5, 0xcd, 0x5d, 0xb7,
+	0xf1, 0xe3, 0xae, 0xbb, 0x9f, 0x32, 0x75, 0x5e, 0x46, 0x5e, 0x2c, 0x79, 0xed, 0x58, 0xbf, 0x0e,
+	0x8b, 0xe4, 0xc2, 0x57, 0x57, 0x63, 0x5a, 0x78, 0x03, 0x1a, 0x7f, 0xfb, 0x72, 0x08, 0xeb, 0x40,
+	0x03, 0x1a, 0x87, 0x0b, 0x1c, 0x62, 0x70, 0x93, 0x08, 0x51, 0x92, 0x4c, 0xc7, 0xbe, 0x64, 0x05,
+	0x93, 0xa2, 0x70, 0x1e, 0x2d, 0xc1, 0x63, 0xc3, 0x62, 0x87, 0x73, 0xea, 0xde, 0xa7, 0x55, 0xd8,
+	0x1c, 0x92, 0x9c, 0xf0, 0x02, 0xed, 0x42, 0xa8, 0x0f, 0x14, 0x27, 0x54, 0x48, 0x6e, 0xb7, 0x14,
+	0xb6, 0x74, 0x65, 0xa0, 0x0b, 0xa8, 0x84, 0x5b, 0xf3, 0x84, 0x38, 0x27, 0x8a, 0xe2, 0xf8, 0x9c,
+	0x88, 0x94, 0xd6, 0xc1, 0xde, 0xfc, 0x4b, 0xb0, 0xcf, 0xf7, 0xd7, 0x07, 0x20, 0xec, 0xcc, 0xf9,
+	0x21, 0x51, 0xf4, 0xc4, 0xd0, 0xd1, 0x08, 0xb6, 0x17, 0xb6, 0x9c, 0x54, 0xce, 0xe3, 0x65, 0xd9,
+	0x3d, 0x9d, 0x73, 0x4f, 0x49, 0xf5, 0x87, 0x0f, 0x13, 0xce, 0xca, 0x7f, 0xf0, 0x61, 0x02, 0x45,
+	0x70, 0x3d, 0x95, 0x24, 0xc3, 0x91, 0x14, 0x09, 0x4d, 0x9c, 0xd5, 0x65, 0xb9, 0x40, 0x4d, 0x0d,
+	0x0c, 0x14, 0xed, 0xc3, 0x67, 0x51, 0x26, 0xe3, 0x8b, 0x02, 0x8f, 0x69, 0x8e, 0xaf, 0x28, 0xc9,
+	0x9d, 0x66, 0x0f, 0xf4, 0x57, 0xc2, 0xb6, 0x2d, 0x0f, 0x69, 0xfe, 0x8e, 0x92, 0x1c, 0x1d, 0xc3,
+	0x2d, 0xc5, 0x38, 0xc5, 0x11, 0x29, 0x68, 0xf2, 0xf0, 0xae, 0x3d, 0xe9, 0x81, 0xfe, 0x5a, 0xd8,
+	0xd1, 0x62, 0xa0, 0xb5, 0xc5, 0x85, 0x41, 0x6f, 0x21, 0xe2, 0xa4, 0xc2, 0x06, 0x84, 0x67, 0xe3,
+	0xe1, 0xac, 0xf5, 0x40, 0x7f, 0xfd, 0x78, 0xdb, 0xb3, 0xf3, 0xe3, 0xcd, 0xe6, 0xc7, 0x1b, 0xd4,
+	0x0d, 0x41, 0x5b, 0xef, 0xf0, 0xe3, 0xcf, 0x2e, 0xb0, 0x69, 0x37, 0x38, 0xa9, 0x02, 0x8d, 0x98,
+	0x35, 0xbc, 0xda, 0x7d, 0x7f, 0x7f, 0x7d, 0xe0, 0x3c, 0xd8, 0x6d, 0x65, 0xc7, 0xdf, 0x5e, 0xce,
+	0xe0, 0xe4, 0x66, 0xe2, 0x82, 0xdb, 0x89, 0x0b, 0x7e, 0x4d, 0x5c, 0xf0, 0x61, 0xea, 0x36, 0x6e,
+	0xa7, 0x6e, 0xe3, 0xfb, 0xd4, 0x6d, 0x9c, 0xbd, 0xf8, 0xeb, 0x99, 0xd5, 0x14, 0x73, 0x74, 0x51,
+	0xd3, 0xe4, 0x7a, 0xf9, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xfe, 0xb0, 0x1b, 0x60, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +267,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxBlockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBlockDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.TimeBasedProvisions {
+		i--
+		if m.TimeBasedProvisions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -331,6 +385,11 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.TimeBasedProvisions {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBlockDuration)
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -674,6 +733,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedProvisions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeBasedProvisions = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxBlockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -2,12 +2,18 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// YearDuration is the duration of a year used to compute time based
+// provisions, consistent with the 8766 hours per year assumed by the default
+// BlocksPerYear.
+const YearDuration = 8766 * time.Hour
+
 // NewMinter returns a new Minter object with the given inflation and annual
 // provisions values.
 func NewMinter(inflation, annualProvisions math.LegacyDec) Minter {
@@ -80,3 +86,18 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(math.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// TimeProvision returns the provisions for a block based on the annual
+// provisions rate and the time elapsed since the previous block. The elapsed
+// time is capped at the MaxBlockDuration param.
+func (m Minter) TimeProvision(params Params, elapsed time.Duration) sdk.Coin {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if elapsed > params.MaxBlockDuration {
+		elapsed = params.MaxBlockDuration
+	}
+
+	provisionAmt := m.AnnualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(YearDuration))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -31,6 +32,7 @@ func DefaultParams() Params {
 		InflationMin:        math.LegacyNewDecWithPrec(7, 2),
 		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MaxBlockDuration:    time.Minute,
 	}
 }
 
@@ -54,6 +56,12 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateMaxBlockDuration(p.MaxBlockDuration); err != nil {
+		return err
+	}
+	if p.TimeBasedProvisions && p.MaxBlockDuration == 0 {
+		return errors.New("max block duration must be positive when time based provisions are enabled")
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -168,3 +176,16 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxBlockDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max block duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
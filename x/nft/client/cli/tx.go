@@ -13,6 +13,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+// Flags of the nft tx commands
+const (
+	FlagURI     = "uri"
+	FlagURIHash = "uri-hash"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +32,9 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdBatchSend(),
+		NewCmdTransferClassAdmin(),
+		NewCmdMint(),
 	)
 
 	return nftTxCmd
@@ -63,3 +72,116 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdBatchSend creates a CLI command for MsgBatchSend.
+func NewCmdBatchSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [class-id] [nft-ids] [receiver] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "transfer ownership of a batch of nfts of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s batch-send <class-id> <nft-id>,<nft-id>... <receiver> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" || args[2] == "" {
+				return fmt.Errorf("class-id, nft-ids and receiver cannot be empty")
+			}
+
+			msg := nft.MsgBatchSend{
+				ClassId:  args[0],
+				Ids:      strings.Split(args[1], ","),
+				Sender:   clientCtx.GetFromAddress().String(),
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdTransferClassAdmin creates a CLI command for MsgTransferClassAdmin.
+func NewCmdTransferClassAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-class-admin [class-id] [new-admin] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "transfer the admin rights of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s transfer-class-admin <class-id> <new-admin> --from <admin> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return fmt.Errorf("class-id and new-admin cannot be empty")
+			}
+
+			msg := nft.MsgTransferClassAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				NewAdmin: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdMint creates a CLI command for MsgMint.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a nft of a class whose mint authority is the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --uri-hash <uri-hash> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return fmt.Errorf("class-id and nft-id cannot be empty")
+			}
+
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			uriHash, err := cmd.Flags().GetString(FlagURIHash)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Sender: clientCtx.GetFromAddress().String(),
+				Nft: nft.NFT{
+					ClassId: args[0],
+					Id:      args[1],
+					Uri:     uri,
+					UriHash: uriHash,
+				},
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "hash of the document pointed by the uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
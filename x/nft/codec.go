@@ -10,6 +10,10 @@ import (
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgBatchSend{},
+		&MsgUpdateClass{},
+		&MsgTransferClassAdmin{},
+		&MsgMint{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
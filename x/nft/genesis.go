@@ -2,6 +2,7 @@ package nft
 
 import (
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
 )
 
 // ValidateGenesis checks that the given genesis state has no integrity issues
@@ -10,6 +11,19 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		if len(class.Admin) != 0 {
+			if _, err := ac.StringToBytes(class.Admin); err != nil {
+				return err
+			}
+		}
+		if class.RoyaltyBasisPoints > MaxRoyaltyBasisPoints {
+			return errors.Wrapf(ErrInvalidRoyalty, "class %s: %d basis points exceeds %d", class.Id, class.RoyaltyBasisPoints, MaxRoyaltyBasisPoints)
+		}
+		if len(class.MintRestrictedTo) != 0 {
+			if _, err := ac.StringToBytes(class.MintRestrictedTo); err != nil {
+				return err
+			}
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
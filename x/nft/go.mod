@@ -153,6 +153,9 @@ require (
 	sigs.k8s.io/yaml v1.3.0 // indirect
 )
 
+// TODO remove after the release of the api module including Msg/BatchSend
+replace cosmossdk.io/api => ../../api
+
 // Below are the long-lived replace of the Cosmos SDK
 // Fix upstream GHSA-h395-qcrw-5vmq vulnerability.
 // TODO Remove it: https://github.com/cosmos/cosmos-sdk/issues/10409
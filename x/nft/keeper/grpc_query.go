@@ -67,6 +67,30 @@ func (k Keeper) Supply(goCtx context.Context, r *nft.QuerySupplyRequest) (*nft.Q
 	return &nft.QuerySupplyResponse{Amount: supply}, nil
 }
 
+// RemainingSupply return the number of NFTs of the given class which can still be minted before reaching its max supply.
+func (k Keeper) RemainingSupply(goCtx context.Context, r *nft.QueryRemainingSupplyRequest) (*nft.QueryRemainingSupplyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	remaining, capped := k.GetRemainingSupply(ctx, class)
+	return &nft.QueryRemainingSupplyResponse{
+		Amount:    remaining,
+		MaxSupply: class.MaxSupply,
+		Unlimited: !capped,
+	}, nil
+}
+
 // NFTs queries all NFTs of a given class or owner (at least one must be provided), similar to tokenByIndex in ERC721Enumerable
 func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.QueryNFTsResponse, error) {
 	if r == nil {
@@ -89,7 +113,8 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 
 	switch {
 	case len(r.ClassId) > 0 && len(r.Owner) > 0:
-		if pageRes, err = query.Paginate(k.getClassStoreByOwner(ctx, owner, r.ClassId), r.Pagination, func(key, _ []byte) error {
+		pageReq, countTotal := ownerPageRequest(r.Pagination)
+		if pageRes, err = query.Paginate(k.getClassStoreByOwner(ctx, owner, r.ClassId), pageReq, func(key, _ []byte) error {
 			nft, has := k.GetNFT(ctx, r.ClassId, string(key))
 			if has {
 				nfts = append(nfts, &nft)
@@ -98,6 +123,9 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 		}); err != nil {
 			return nil, err
 		}
+		if countTotal {
+			pageRes.Total = k.GetBalance(ctx, r.ClassId, owner)
+		}
 	case len(r.ClassId) > 0 && len(r.Owner) == 0:
 		nftStore := k.getNFTStore(ctx, r.ClassId)
 		if pageRes, err = query.Paginate(nftStore, r.Pagination, func(_, value []byte) error {
@@ -111,7 +139,8 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 			return nil, err
 		}
 	case len(r.ClassId) == 0 && len(r.Owner) > 0:
-		if pageRes, err = query.Paginate(k.prefixStoreNftOfClassByOwner(ctx, owner), r.Pagination, func(key, value []byte) error {
+		pageReq, countTotal := ownerPageRequest(r.Pagination)
+		if pageRes, err = query.Paginate(k.prefixStoreNftOfClassByOwner(ctx, owner), pageReq, func(key, value []byte) error {
 			classID, nftID := parseNftOfClassByOwnerStoreKey(key)
 			if n, has := k.GetNFT(ctx, classID, nftID); has {
 				nfts = append(nfts, &n)
@@ -120,6 +149,9 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 		}); err != nil {
 			return nil, err
 		}
+		if countTotal {
+			pageRes.Total = k.getOwnerTotalBalance(ctx, owner)
+		}
 	default:
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("must provide at least one of classID or owner")
 	}
@@ -195,3 +227,49 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// OwnerStats return the number of NFTs owned by the owner in each class
+func (k Keeper) OwnerStats(goCtx context.Context, r *nft.QueryOwnerStatsRequest) (*nft.QueryOwnerStatsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	owner, err := k.ac.StringToBytes(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var stats []*nft.OwnerClassStat
+	pageRes, err := query.Paginate(k.prefixStoreOwnerClassCount(ctx, owner), r.Pagination, func(key, value []byte) error {
+		stats = append(stats, &nft.OwnerClassStat{
+			ClassId: string(key),
+			Amount:  sdk.BigEndianToUint64(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryOwnerStatsResponse{
+		Stats:      stats,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ownerPageRequest returns a page request paginating the nfts of an owner without counting them,
+// and whether their total must be taken from the balances of the owner instead.
+func ownerPageRequest(pageReq *query.PageRequest) (*query.PageRequest, bool) {
+	req := query.PageRequest{}
+	if pageReq != nil {
+		req = *pageReq
+	}
+
+	countTotal := req.CountTotal
+	if req.Limit == 0 {
+		req.Limit = query.DefaultLimit
+		countTotal = true
+	}
+	req.CountTotal = false
+	return &req, countTotal && len(req.Key) == 0
+}
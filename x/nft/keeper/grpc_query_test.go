@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func TestGRPCQuery(t *testing.T) {
@@ -250,6 +251,35 @@ func (s *TestSuite) TestSupply() {
 	}
 }
 
+func (s *TestSuite) TestRemainingSupply() {
+	class := ExpClass
+	class.MaxSupply = 2
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+	unlimited := ExpClass
+	unlimited.Id = "unlimited"
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, unlimited))
+
+	_, err := s.queryClient.RemainingSupply(gocontext.Background(), &nft.QueryRemainingSupplyRequest{})
+	s.Require().ErrorContains(err, nft.ErrEmptyClassID.Error())
+
+	_, err = s.queryClient.RemainingSupply(gocontext.Background(), &nft.QueryRemainingSupplyRequest{ClassId: "kitty1"})
+	s.Require().ErrorContains(err, nft.ErrClassNotExists.Error())
+
+	res, err := s.queryClient.RemainingSupply(gocontext.Background(), &nft.QueryRemainingSupplyRequest{ClassId: unlimited.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.QueryRemainingSupplyResponse{Unlimited: true}, res)
+
+	for i, expRemaining := range []uint64{2, 1, 0} {
+		if i > 0 {
+			n := nft.NFT{ClassId: testClassID, Id: fmt.Sprintf("%s%d", testID, i)}
+			s.Require().NoError(s.nftKeeper.Mint(s.ctx, n, s.addrs[0]))
+		}
+		res, err := s.queryClient.RemainingSupply(gocontext.Background(), &nft.QueryRemainingSupplyRequest{ClassId: testClassID})
+		s.Require().NoError(err)
+		s.Require().Equal(&nft.QueryRemainingSupplyResponse{Amount: expRemaining, MaxSupply: 2}, res)
+	}
+}
+
 func (s *TestSuite) TestNFTs() {
 	var (
 		req  *nft.QueryNFTsRequest
@@ -591,3 +621,70 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestOwnerStats() {
+	require := s.Require()
+	for _, classID := range []string{testClassID, "kitty2"} {
+		require.NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: classID}))
+	}
+	require.NoError(s.nftKeeper.BatchMint(s.ctx, []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+		{ClassId: testClassID, Id: "kitty3"},
+		{ClassId: "kitty2", Id: "kitty1"},
+	}, s.addrs[0]))
+	require.NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty3", s.addrs[1]))
+
+	s.accountKeeper.EXPECT().StringToBytes("owner").Return(nil, fmt.Errorf("decoding bech32 failed"))
+	_, err := s.queryClient.OwnerStats(gocontext.Background(), &nft.QueryOwnerStatsRequest{Owner: "owner"})
+	require.ErrorContains(err, "decoding bech32 failed")
+
+	res, err := s.queryClient.OwnerStats(gocontext.Background(), &nft.QueryOwnerStatsRequest{Owner: s.addrs[0].String()})
+	require.NoError(err)
+	require.Equal([]*nft.OwnerClassStat{
+		{ClassId: testClassID, Amount: 2},
+		{ClassId: "kitty2", Amount: 1},
+	}, res.Stats)
+	require.Equal(uint64(2), res.Pagination.Total)
+
+	res, err = s.queryClient.OwnerStats(gocontext.Background(), &nft.QueryOwnerStatsRequest{
+		Owner:      s.addrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+	require.Equal([]*nft.OwnerClassStat{{ClassId: testClassID, Amount: 2}}, res.Stats)
+	require.NotNil(res.Pagination.NextKey)
+
+	res, err = s.queryClient.OwnerStats(gocontext.Background(), &nft.QueryOwnerStatsRequest{Owner: s.addrs[2].String()})
+	require.NoError(err)
+	require.Empty(res.Stats)
+
+	// the totals of the nfts of an owner are taken from its balances
+	nftsRes, err := s.queryClient.NFTs(gocontext.Background(), &nft.QueryNFTsRequest{
+		Owner:      s.addrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(nftsRes.Nfts, 1)
+	require.Equal(uint64(3), nftsRes.Pagination.Total)
+
+	nftsRes, err = s.queryClient.NFTs(gocontext.Background(), &nft.QueryNFTsRequest{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(nftsRes.Nfts, 1)
+	require.NotNil(nftsRes.Pagination.NextKey)
+	require.Equal(uint64(2), nftsRes.Pagination.Total)
+
+	nftsRes, err = s.queryClient.NFTs(gocontext.Background(), &nft.QueryNFTsRequest{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		Pagination: &query.PageRequest{Key: nftsRes.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(nftsRes.Nfts, 1)
+	require.Nil(nftsRes.Pagination.NextKey)
+	require.Zero(nftsRes.Pagination.Total)
+}
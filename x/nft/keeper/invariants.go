@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the nft module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(nft.ModuleName, "owner-balances", OwnerBalancesInvariant(k))
+}
+
+// OwnerBalancesInvariant checks that the balances of owners in each class match the
+// number of nfts of the class the owner index has for them
+func OwnerBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := k.countOwnerIndex(ctx)
+
+		var msg string
+		count := 0
+		store := k.storeService.OpenKVStore(ctx)
+		iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), OwnerClassCountKey)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			key := string(iterator.Key())
+			balance := sdk.BigEndianToUint64(iterator.Value())
+			if balance != expected[key] {
+				owner, classID := parseOwnerClassCountStoreKey([]byte(key))
+				count++
+				msg += fmt.Sprintf("\t%s has a balance of %d in class %s, owns %d\n", owner, balance, classID, expected[key])
+			}
+			delete(expected, key)
+		}
+
+		// the remaining owners have no balance recorded
+		keys := make([]string, 0, len(expected))
+		for key := range expected {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			owner, classID := parseOwnerClassCountStoreKey([]byte(key))
+			count++
+			msg += fmt.Sprintf("\t%s has no balance in class %s, owns %d\n", owner, classID, expected[key])
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			nft.ModuleName, "owner-balances",
+			fmt.Sprintf("amount of mismatched owner balances found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// countOwnerIndex returns the number of nfts the owner index has for each owner and class,
+// keyed by their balance store key.
+func (k Keeper) countOwnerIndex(ctx context.Context) map[string]uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), NFTOfClassByOwnerKey)
+	defer iterator.Close()
+
+	counts := make(map[string]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		owner, classID, _ := parseFullNftOfClassByOwnerStoreKey(iterator.Key())
+		counts[string(ownerClassCountStoreKey(owner, classID))]++
+	}
+	return counts
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func (s *TestSuite) TestOwnerBalancesInvariant() {
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+	}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty2", s.addrs[1]))
+
+	invariant := keeper.OwnerBalancesInvariant(s.nftKeeper)
+	_, broken := invariant(s.ctx)
+	s.Require().False(broken)
+
+	store := s.ctx.KVStore(s.storeKey)
+	balanceKey := func(owner sdk.AccAddress) []byte {
+		return append(append([]byte{}, keeper.OwnerClassCountKey...), append(address.MustLengthPrefix(owner), testClassID...)...)
+	}
+
+	// a wrong balance
+	store.Set(balanceKey(s.addrs[0]), sdk.Uint64ToBigEndian(2))
+	msg, broken := invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "amount of mismatched owner balances found 1")
+
+	// a missing balance
+	store.Delete(balanceKey(s.addrs[0]))
+	msg, broken = invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, s.addrs[0].String()+" has no balance in class "+testClassID)
+
+	// a balance without nfts
+	store.Set(balanceKey(s.addrs[2]), sdk.Uint64ToBigEndian(1))
+	msg, broken = invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "amount of mismatched owner balances found 2")
+}
+
+func (s *TestSuite) TestMigrate1to2() {
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "kitty2"}))
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+		{ClassId: "kitty2", Id: "kitty1"},
+	}, s.addrs[0]))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty2", s.addrs[1]))
+
+	// the balances are not recorded before the migration
+	store := s.ctx.KVStore(s.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keeper.OwnerClassCountKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	s.Require().Len(keys, 3)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	s.Require().Zero(s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+
+	s.Require().NoError(keeper.NewMigrator(s.nftKeeper).Migrate1to2(s.ctx))
+
+	s.Require().Equal(uint64(1), s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+	s.Require().Equal(uint64(1), s.nftKeeper.GetBalance(s.ctx, "kitty2", s.addrs[0]))
+	s.Require().Equal(uint64(1), s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[1]))
+	_, broken := keeper.OwnerBalancesInvariant(s.nftKeeper)(s.ctx)
+	s.Require().False(broken)
+}
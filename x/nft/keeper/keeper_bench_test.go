@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+	"cosmossdk.io/x/nft/module"
+	nfttestutil "cosmossdk.io/x/nft/testutil"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const benchBatchSize = 100
+
+// setupBenchKeeper returns a keeper with a class holding benchBatchSize nfts
+// owned by the first of the returned accounts, along with these nfts.
+func setupBenchKeeper(b *testing.B) (sdk.Context, keeper.Keeper, []sdk.AccAddress, []nft.NFT) {
+	b.Helper()
+
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(nft.StoreKey)
+	ctx := testutil.DefaultContextWithDB(b, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	ctrl := gomock.NewController(b)
+	accountKeeper := nfttestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(nft.ModuleName).Return(addrs[0]).AnyTimes()
+	k := keeper.NewKeeper(runtime.NewKVStoreService(key), encCfg.Codec, accountKeeper, nfttestutil.NewMockBankKeeper(ctrl))
+
+	if err := k.SaveClass(ctx, nft.Class{Id: testClassID}); err != nil {
+		b.Fatal(err)
+	}
+
+	tokens := make([]nft.NFT, benchBatchSize)
+	for i := range tokens {
+		tokens[i] = nft.NFT{ClassId: testClassID, Id: fmt.Sprintf("kitty%d", i), Uri: testURI}
+	}
+
+	return ctx, k, addrs, tokens
+}
+
+func tokenIDs(tokens []nft.NFT) []string {
+	ids := make([]string, len(tokens))
+	for i, token := range tokens {
+		ids[i] = token.Id
+	}
+	return ids
+}
+
+// benchmarkPerToken runs op on a new state set up by setup for each iteration,
+// and reports the gas consumed per token of the batch.
+func benchmarkPerToken(b *testing.B, setup func(sdk.Context, keeper.Keeper, []sdk.AccAddress, []nft.NFT), op func(sdk.Context, keeper.Keeper, []sdk.AccAddress, []nft.NFT) error) {
+	b.ReportAllocs()
+
+	var gas uint64
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		ctx, k, addrs, tokens := setupBenchKeeper(b)
+		setup(ctx, k, addrs, tokens)
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		b.StartTimer()
+
+		if err := op(ctx, k, addrs, tokens); err != nil {
+			b.Fatal(err)
+		}
+		gas += ctx.GasMeter().GasConsumed()
+	}
+
+	b.ReportMetric(float64(gas)/float64(b.N*benchBatchSize), "gas/token")
+}
+
+func noBenchSetup(sdk.Context, keeper.Keeper, []sdk.AccAddress, []nft.NFT) {}
+
+func mintBenchSetup(ctx sdk.Context, k keeper.Keeper, addrs []sdk.AccAddress, tokens []nft.NFT) {
+	if err := k.BatchMint(ctx, tokens, addrs[0]); err != nil {
+		panic(err)
+	}
+}
+
+func BenchmarkMint(b *testing.B) {
+	b.Run("Mint", func(b *testing.B) {
+		benchmarkPerToken(b, noBenchSetup, func(ctx sdk.Context, k keeper.Keeper, addrs []sdk.AccAddress, tokens []nft.NFT) error {
+			for _, token := range tokens {
+				if err := k.Mint(ctx, token, addrs[0]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+
+	b.Run("BatchMint", func(b *testing.B) {
+		benchmarkPerToken(b, noBenchSetup, func(ctx sdk.Context, k keeper.Keeper, addrs []sdk.AccAddress, tokens []nft.NFT) error {
+			return k.BatchMint(ctx, tokens, addrs[0])
+		})
+	})
+}
+
+func BenchmarkTransfer(b *testing.B) {
+	b.Run("Transfer", func(b *testing.B) {
+		benchmarkPerToken(b, mintBenchSetup, func(ctx sdk.Context, k keeper.Keeper, addrs []sdk.AccAddress, tokens []nft.NFT) error {
+			for _, token := range tokens {
+				if err := k.Transfer(ctx, token.ClassId, token.Id, addrs[1]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+
+	b.Run("BatchTransfer", func(b *testing.B) {
+		benchmarkPerToken(b, mintBenchSetup, func(ctx sdk.Context, k keeper.Keeper, addrs []sdk.AccAddress, tokens []nft.NFT) error {
+			return k.BatchTransfer(ctx, testClassID, tokenIDs(tokens), addrs[0], addrs[1])
+		})
+	})
+}
+
+func BenchmarkBurn(b *testing.B) {
+	b.Run("Burn", func(b *testing.B) {
+		benchmarkPerToken(b, mintBenchSetup, func(ctx sdk.Context, k keeper.Keeper, addrs []sdk.AccAddress, tokens []nft.NFT) error {
+			for _, token := range tokens {
+				if err := k.Burn(ctx, token.ClassId, token.Id); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+
+	b.Run("BatchBurn", func(b *testing.B) {
+		benchmarkPerToken(b, mintBenchSetup, func(ctx sdk.Context, k keeper.Keeper, addrs []sdk.AccAddress, tokens []nft.NFT) error {
+			return k.BatchBurn(ctx, testClassID, tokenIDs(tokens))
+		})
+	})
+}
@@ -38,6 +38,7 @@ type TestSuite struct {
 	suite.Suite
 
 	ctx           sdk.Context
+	storeKey      *storetypes.KVStoreKey
 	addrs         []sdk.AccAddress
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
@@ -74,6 +75,7 @@ func (s *TestSuite) SetupTest() {
 	s.nftKeeper = nftKeeper
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
+	s.storeKey = key
 }
 
 func TestTestSuite(t *testing.T) {
@@ -204,6 +206,40 @@ func (s *TestSuite) TestMint() {
 	s.Require().EqualValues(uint64(2), balance)
 }
 
+func (s *TestSuite) TestMintMaxSupply() {
+	class := ExpClass
+	class.MaxSupply = 2
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+
+	mint := func(id string) error {
+		return s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: id, Uri: testURI}, s.addrs[0])
+	}
+
+	// the class can be minted up to its max supply exactly
+	s.Require().NoError(mint(testID + "1"))
+	s.Require().NoError(mint(testID + "2"))
+	s.Require().ErrorIs(mint(testID+"3"), nft.ErrMaxSupplyExceeded)
+	s.Require().EqualValues(2, s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	// modules can mint beyond the max supply
+	err := s.nftKeeper.MintUnchecked(s.ctx, nft.NFT{ClassId: testClassID, Id: testID + "3", Uri: testURI}, s.addrs[0])
+	s.Require().NoError(err)
+	s.Require().EqualValues(3, s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+	remaining, capped := s.nftKeeper.GetRemainingSupply(s.ctx, class)
+	s.Require().True(capped)
+	s.Require().Zero(remaining)
+
+	// burnt nfts free the supply
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID+"1"))
+	s.Require().ErrorIs(mint(testID+"4"), nft.ErrMaxSupplyExceeded)
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID+"2"))
+	s.Require().NoError(mint(testID + "4"))
+
+	// the supply of classes without max supply is not capped
+	_, capped = s.nftKeeper.GetRemainingSupply(s.ctx, ExpClass)
+	s.Require().False(capped)
+}
+
 func (s *TestSuite) TestBurn() {
 	except := nft.Class{
 		Id:          testClassID,
@@ -326,6 +362,53 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+func (s *TestSuite) TestTransferBalances() {
+	for _, classID := range []string{testClassID, "kitty2"} {
+		s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: classID}))
+	}
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, []nft.NFT{
+		{ClassId: testClassID, Id: "kitty1"},
+		{ClassId: testClassID, Id: "kitty2"},
+		{ClassId: testClassID, Id: "kitty3"},
+		{ClassId: "kitty2", Id: "kitty1"},
+	}, s.addrs[0]))
+
+	requireBalances := func(owner sdk.AccAddress, expected map[string]uint64) {
+		for _, classID := range []string{testClassID, "kitty2"} {
+			s.Require().Equal(expected[classID], s.nftKeeper.GetBalance(s.ctx, classID, owner), "%s in %s", owner, classID)
+			s.Require().Len(s.nftKeeper.GetNFTsOfClassByOwner(s.ctx, classID, owner), int(expected[classID]))
+		}
+	}
+	requireBalances(s.addrs[0], map[string]uint64{testClassID: 3, "kitty2": 1})
+
+	// transfers update the balances of both sides
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty1", s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{"kitty2", "kitty3"}, s.addrs[0], s.addrs[2]))
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, "kitty2", "kitty1", s.addrs[1]))
+	requireBalances(s.addrs[0], nil)
+	requireBalances(s.addrs[1], map[string]uint64{testClassID: 1, "kitty2": 1})
+	requireBalances(s.addrs[2], map[string]uint64{testClassID: 2})
+
+	// transferring to the owner leaves its balance unchanged
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, "kitty1", s.addrs[1]))
+	requireBalances(s.addrs[1], map[string]uint64{testClassID: 1, "kitty2": 1})
+
+	s.Require().NoError(s.nftKeeper.BatchBurn(s.ctx, testClassID, []string{"kitty1", "kitty2"}))
+	requireBalances(s.addrs[1], map[string]uint64{"kitty2": 1})
+	requireBalances(s.addrs[2], map[string]uint64{testClassID: 1})
+
+	_, broken := keeper.OwnerBalancesInvariant(s.nftKeeper)(s.ctx)
+	s.Require().False(broken)
+
+	// the balances survive genesis export and import
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	requireBalances(s.addrs[0], nil)
+	requireBalances(s.addrs[1], map[string]uint64{"kitty2": 1})
+	requireBalances(s.addrs[2], map[string]uint64{testClassID: 1})
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	OwnerClassCountKey   = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -104,3 +105,46 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// ownerClassCountStoreKey returns the byte representation of the number of nfts of a class owned by an owner
+// Items are stored with the following key: values
+// 0x06<owner><classID>
+func ownerClassCountStoreKey(owner sdk.AccAddress, classID string) []byte {
+	prefix := prefixOwnerClassCountStoreKey(owner)
+	key := make([]byte, len(prefix)+len(classID))
+	copy(key, prefix)
+	copy(key[len(prefix):], classID)
+	return key
+}
+
+// prefixOwnerClassCountStoreKey returns the prefix of the result of the method ownerClassCountStoreKey
+// Items are stored with the following key: values
+// 0x06<owner>
+func prefixOwnerClassCountStoreKey(owner sdk.AccAddress) []byte {
+	owner = address.MustLengthPrefix(owner)
+
+	key := make([]byte, len(OwnerClassCountKey)+len(owner))
+	copy(key, OwnerClassCountKey)
+	copy(key[len(OwnerClassCountKey):], owner)
+	return key
+}
+
+// parseOwnerClassCountStoreKey returns the owner and the classID of a key built by ownerClassCountStoreKey
+func parseOwnerClassCountStoreKey(key []byte) (owner sdk.AccAddress, classID string) {
+	key = key[len(OwnerClassCountKey):]
+	ownerLen := int(key[0])
+	return sdk.AccAddress(key[1 : 1+ownerLen]), string(key[1+ownerLen:])
+}
+
+// parseFullNftOfClassByOwnerStoreKey returns the owner, the classID and the nftID of a key of the owner index
+// 0x03<owner><Delimiter><classID><Delimiter><nftID>
+func parseFullNftOfClassByOwnerStoreKey(key []byte) (owner sdk.AccAddress, classID, nftID string) {
+	key = key[len(NFTOfClassByOwnerKey):]
+	ownerLen := int(key[0])
+	owner = sdk.AccAddress(key[1 : 1+ownerLen])
+	ret := bytes.Split(key[1+ownerLen+len(Delimiter):], Delimiter)
+	if len(ret) != 2 {
+		panic("invalid nftOfClassByOwnerStoreKey")
+	}
+	return owner, string(ret[0]), string(ret[1])
+}
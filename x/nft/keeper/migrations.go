@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, recording the balances of the
+// owners in each class from the owner index.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	counts := m.keeper.countOwnerIndex(ctx)
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	store := m.keeper.storeService.OpenKVStore(ctx)
+	for _, key := range keys {
+		if err := store.Set([]byte(key), sdk.Uint64ToBigEndian(counts[key])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
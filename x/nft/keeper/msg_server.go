@@ -11,7 +11,17 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implements Send method of the types.MsgServer.
 func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
@@ -51,3 +61,167 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// BatchSend implements BatchSend method of the types.MsgServer.
+func (k Keeper) BatchSend(goCtx context.Context, msg *nft.MsgBatchSend) (*nft.MsgBatchSendResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Ids) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	for _, id := range msg.Ids {
+		if len(id) == 0 {
+			return nil, nft.ErrEmptyNFTID
+		}
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	if err := k.BatchTransfer(goCtx, msg.ClassId, msg.Ids, sender, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBatchSendResponse{}, nil
+}
+
+// UpdateClass implements UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(goCtx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if msg.RoyaltyBasisPoints > nft.MaxRoyaltyBasisPoints {
+		return nil, errorsmod.Wrapf(nft.ErrInvalidRoyalty, "%d basis points exceeds %d", msg.RoyaltyBasisPoints, nft.MaxRoyaltyBasisPoints)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.authorizeClassAdmin(ctx, msg.ClassId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class.Uri = msg.Uri
+	class.UriHash = msg.UriHash
+	class.Data = msg.Data
+	class.RoyaltyBasisPoints = msg.RoyaltyBasisPoints
+	if err := k.updateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// TransferClassAdmin implements TransferClassAdmin method of the types.MsgServer.
+func (k msgServer) TransferClassAdmin(goCtx context.Context, msg *nft.MsgTransferClassAdmin) (*nft.MsgTransferClassAdminResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.NewAdmin); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new admin address (%s)", msg.NewAdmin)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.authorizeClassAdmin(ctx, msg.ClassId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class.Admin = msg.NewAdmin
+	if err := k.updateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgTransferClassAdminResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	if len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, msg.Nft.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+	}
+
+	// Classes without a mint authority can only be minted by modules.
+	if len(class.MintRestrictedTo) == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "nfts of class %s can only be minted by modules", class.Id)
+	}
+
+	mintAuthority, err := k.ac.StringToBytes(class.MintRestrictedTo)
+	if err != nil || !bytes.Equal(mintAuthority, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nfts of class %s", msg.Sender, class.Id)
+	}
+
+	if err := k.Keeper.Mint(ctx, msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// authorizeClassAdmin returns the class of the given id if admin is its admin.
+// Classes without an admin are immutable.
+func (k msgServer) authorizeClassAdmin(ctx context.Context, classID, admin string) (nft.Class, error) {
+	adminAddr, err := k.ac.StringToBytes(admin)
+	if err != nil {
+		return nft.Class{}, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", admin)
+	}
+
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nft.Class{}, errorsmod.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if len(class.Admin) == 0 {
+		return nft.Class{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "class %s is immutable", classID)
+	}
+
+	classAdmin, err := k.ac.StringToBytes(class.Admin)
+	if err != nil || !bytes.Equal(classAdmin, adminAddr) {
+		return nft.Class{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of class %s", admin, classID)
+	}
+
+	return class, nil
+}
+
+// updateClass stores the updated class and emits EventClassUpdated.
+func (k msgServer) updateClass(ctx sdk.Context, class nft.Class) error {
+	if err := k.Keeper.UpdateClass(ctx, class); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventClassUpdated{
+		ClassId: class.Id,
+		Admin:   class.Admin,
+	})
+}
@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -130,3 +134,313 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestBatchSend() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+
+	tokens := []nft.NFT{ExpNFT, {ClassId: testClassID, Id: "kitty2", Uri: testURI}}
+	err = s.nftKeeper.BatchMint(s.ctx, tokens, s.addrs[0])
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgBatchSend
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "empty nft ids",
+			req: &nft.MsgBatchSend{
+				ClassId:  testClassID,
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "empty nft id",
+		},
+		{
+			name: "empty nft id",
+			req: &nft.MsgBatchSend{
+				ClassId:  testClassID,
+				Ids:      []string{testID, ""},
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "empty nft id",
+		},
+		{
+			name: "empty class id",
+			req: &nft.MsgBatchSend{
+				ClassId:  "",
+				Ids:      []string{testID},
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name: "invalid class id",
+			req: &nft.MsgBatchSend{
+				ClassId:  "invalid ClassId",
+				Ids:      []string{testID},
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "nft class does not exist",
+		},
+		{
+			name: "invalid nft id",
+			req: &nft.MsgBatchSend{
+				ClassId:  testClassID,
+				Ids:      []string{testID, "invalid Id"},
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "invalid Id: nft does not exist",
+		},
+		{
+			name: "unauthorized sender",
+			req: &nft.MsgBatchSend{
+				ClassId:  testClassID,
+				Ids:      []string{testID, "kitty2"},
+				Sender:   s.addrs[1].String(),
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of nft %s", s.addrs[1].String(), testID),
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgBatchSend{
+				ClassId:  testClassID,
+				Ids:      []string{testID, "kitty2"},
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.BatchSend(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().EqualValues(0, s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+	s.Require().EqualValues(2, s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[1]))
+}
+
+func (s *TestSuite) TestMsgUpdateClass() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	class := ExpClass
+	class.Admin = s.addrs[0].String()
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+
+	immutable := ExpClass
+	immutable.Id = "immutable"
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, immutable))
+
+	update := func(classID string, admin sdk.AccAddress, royalty uint32) *nft.MsgUpdateClass {
+		return &nft.MsgUpdateClass{
+			Admin:              admin.String(),
+			ClassId:            classID,
+			Uri:                "new uri",
+			UriHash:            "new uri hash",
+			RoyaltyBasisPoints: royalty,
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgUpdateClass
+		expErr error
+	}{
+		{
+			name:   "empty class id",
+			req:    update("", s.addrs[0], 250),
+			expErr: nft.ErrEmptyClassID,
+		},
+		{
+			name:   "class not exists",
+			req:    update("invalid ClassId", s.addrs[0], 250),
+			expErr: nft.ErrClassNotExists,
+		},
+		{
+			name:   "royalty exceeds 100%",
+			req:    update(testClassID, s.addrs[0], nft.MaxRoyaltyBasisPoints+1),
+			expErr: nft.ErrInvalidRoyalty,
+		},
+		{
+			name:   "non-admin rejected",
+			req:    update(testClassID, s.addrs[1], 250),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "class without admin rejects updates",
+			req:    update(immutable.Id, s.addrs[0], 250),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "valid update",
+			req:  update(testClassID, s.addrs[0], 250),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := msgServer.UpdateClass(ctx, tc.req)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().Empty(ctx.EventManager().Events())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(ctx.EventManager().Events(), 1)
+			s.Require().Equal("cosmos.nft.v1beta1.EventClassUpdated", ctx.EventManager().Events()[0].Type)
+		})
+	}
+
+	// the royalty is exposed in Query/Class, and the other fields are unchanged
+	res, err := s.queryClient.Class(s.ctx, &nft.QueryClassRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	class.Uri = "new uri"
+	class.UriHash = "new uri hash"
+	class.RoyaltyBasisPoints = 250
+	s.Require().Equal(&class, res.Class)
+
+	actual, has := s.nftKeeper.GetClass(s.ctx, immutable.Id)
+	s.Require().True(has)
+	s.Require().Equal(immutable, actual)
+}
+
+func (s *TestSuite) TestMsgTransferClassAdmin() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	class := ExpClass
+	class.Admin = s.addrs[0].String()
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+
+	immutable := ExpClass
+	immutable.Id = "immutable"
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, immutable))
+
+	// the class without admin cannot be claimed
+	_, err := msgServer.TransferClassAdmin(s.ctx, &nft.MsgTransferClassAdmin{
+		Admin:    s.addrs[0].String(),
+		ClassId:  immutable.Id,
+		NewAdmin: s.addrs[0].String(),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// non-admin rejected
+	_, err = msgServer.TransferClassAdmin(s.ctx, &nft.MsgTransferClassAdmin{
+		Admin:    s.addrs[1].String(),
+		ClassId:  testClassID,
+		NewAdmin: s.addrs[1].String(),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// admin handoff
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.TransferClassAdmin(ctx, &nft.MsgTransferClassAdmin{
+		Admin:    s.addrs[0].String(),
+		ClassId:  testClassID,
+		NewAdmin: s.addrs[1].String(),
+	})
+	s.Require().NoError(err)
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal("cosmos.nft.v1beta1.EventClassUpdated", events[0].Type)
+
+	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(s.addrs[1].String(), actual.Admin)
+
+	// the previous admin lost its rights, the new admin can update the class
+	_, err = msgServer.UpdateClass(s.ctx, &nft.MsgUpdateClass{Admin: s.addrs[0].String(), ClassId: testClassID, Uri: "new uri"})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.UpdateClass(s.ctx, &nft.MsgUpdateClass{Admin: s.addrs[1].String(), ClassId: testClassID, Uri: "new uri"})
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestMsgMint() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+	minter, receiver := s.addrs[0], s.addrs[1]
+
+	class := ExpClass
+	class.MaxSupply = 1
+	class.MintRestrictedTo = minter.String()
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+
+	unrestricted := ExpClass
+	unrestricted.Id = "unrestricted"
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, unrestricted))
+
+	testCases := []struct {
+		name   string
+		msg    *nft.MsgMint
+		expErr error
+	}{
+		{
+			"empty class id",
+			&nft.MsgMint{Sender: minter.String(), Nft: nft.NFT{Id: testID}, Receiver: receiver.String()},
+			nft.ErrEmptyClassID,
+		},
+		{
+			"empty nft id",
+			&nft.MsgMint{Sender: minter.String(), Nft: nft.NFT{ClassId: testClassID}, Receiver: receiver.String()},
+			nft.ErrEmptyNFTID,
+		},
+		{
+			"class not exists",
+			&nft.MsgMint{Sender: minter.String(), Nft: nft.NFT{ClassId: "kitty1", Id: testID}, Receiver: receiver.String()},
+			nft.ErrClassNotExists,
+		},
+		{
+			"class without mint authority",
+			&nft.MsgMint{Sender: minter.String(), Nft: nft.NFT{ClassId: unrestricted.Id, Id: testID}, Receiver: receiver.String()},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"sender is not the mint authority",
+			&nft.MsgMint{Sender: receiver.String(), Nft: ExpNFT, Receiver: receiver.String()},
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"valid",
+			&nft.MsgMint{Sender: minter.String(), Nft: ExpNFT, Receiver: receiver.String()},
+			nil,
+		},
+		{
+			"max supply reached",
+			&nft.MsgMint{Sender: minter.String(), Nft: nft.NFT{ClassId: testClassID, Id: testID + "2"}, Receiver: receiver.String()},
+			nft.ErrMaxSupplyExceeded,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.Mint(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(receiver, s.nftKeeper.GetOwner(s.ctx, tc.msg.Nft.ClassId, tc.msg.Nft.Id))
+		})
+	}
+}
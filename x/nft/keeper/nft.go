@@ -11,8 +11,29 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Mint defines a method for minting a new nft
+// Mint defines a method for minting a new nft.
+// It fails if the class of the nft reached its max supply.
 func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	class, has := k.GetClass(ctx, token.ClassId)
+	if !has {
+		return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+	}
+
+	if k.HasNFT(ctx, token.ClassId, token.Id) {
+		return errors.Wrap(nft.ErrNFTExists, token.Id)
+	}
+
+	if err := k.checkMaxSupply(ctx, class, 1); err != nil {
+		return err
+	}
+
+	k.mintWithNoCheck(ctx, token, receiver)
+	return nil
+}
+
+// MintUnchecked defines a method for minting a new nft without enforcing the max supply of its class.
+// Note: it is meant for the upper modules which mint nfts regardless of the max supply of their class.
+func (k Keeper) MintUnchecked(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
 	if !k.HasClass(ctx, token.ClassId) {
 		return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
 	}
@@ -178,8 +199,24 @@ func (k Keeper) GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddr
 
 // GetBalance returns the specified account, the number of all nfts under the specified classID
 func (k Keeper) GetBalance(ctx context.Context, classID string, owner sdk.AccAddress) uint64 {
-	nfts := k.GetNFTsOfClassByOwner(ctx, classID, owner)
-	return uint64(len(nfts))
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(ownerClassCountStoreKey(owner, classID))
+	if err != nil {
+		panic(err)
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// getOwnerTotalBalance returns the number of all nfts owned by the specified account
+func (k Keeper) getOwnerTotalBalance(ctx context.Context, owner sdk.AccAddress) uint64 {
+	iterator := k.prefixStoreOwnerClassCount(ctx, owner).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var total uint64
+	for ; iterator.Valid(); iterator.Next() {
+		total += sdk.BigEndianToUint64(iterator.Value())
+	}
+	return total
 }
 
 // GetTotalSupply returns the number of all nfts under the specified classID
@@ -192,6 +229,29 @@ func (k Keeper) GetTotalSupply(ctx context.Context, classID string) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// GetRemainingSupply returns the number of nfts of a class which can still be minted before reaching its max
+// supply, and false if the supply of the class is not capped.
+func (k Keeper) GetRemainingSupply(ctx context.Context, class nft.Class) (uint64, bool) {
+	if class.MaxSupply == 0 {
+		return 0, false
+	}
+
+	supply := k.GetTotalSupply(ctx, class.Id)
+	if supply >= class.MaxSupply {
+		return 0, true
+	}
+	return class.MaxSupply - supply, true
+}
+
+// checkMaxSupply returns an error if minting the given number of nfts of a class would exceed its max supply.
+func (k Keeper) checkMaxSupply(ctx context.Context, class nft.Class, count uint64) error {
+	remaining, capped := k.GetRemainingSupply(ctx, class)
+	if capped && count > remaining {
+		return errors.Wrapf(nft.ErrMaxSupplyExceeded, "class %s: cannot mint %d nfts, %d remaining", class.Id, count, remaining)
+	}
+	return nil
+}
+
 // HasNFT determines whether the specified classID and nftID exist
 func (k Keeper) HasNFT(ctx context.Context, classID, id string) bool {
 	store := k.getNFTStore(ctx, classID)
@@ -205,19 +265,35 @@ func (k Keeper) setNFT(ctx context.Context, token nft.NFT) {
 }
 
 func (k Keeper) setOwner(ctx context.Context, classID, nftID string, owner sdk.AccAddress) {
-	store := k.storeService.OpenKVStore(ctx)
-	store.Set(ownerStoreKey(classID, nftID), owner.Bytes())
+	k.setOwners(ctx, classID, []string{nftID}, owner)
+}
 
+// setOwners sets the owner of nfts of a class, writing to the owner index through a single prefix store,
+// and increments the balance of the owner in the class.
+func (k Keeper) setOwners(ctx context.Context, classID string, nftIDs []string, owner sdk.AccAddress) {
+	store := k.storeService.OpenKVStore(ctx)
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
-	ownerStore.Set([]byte(nftID), Placeholder)
+	for _, nftID := range nftIDs {
+		store.Set(ownerStoreKey(classID, nftID), owner.Bytes())
+		ownerStore.Set([]byte(nftID), Placeholder)
+	}
+	k.updateBalance(ctx, classID, owner, k.GetBalance(ctx, classID, owner)+uint64(len(nftIDs)))
 }
 
 func (k Keeper) deleteOwner(ctx context.Context, classID, nftID string, owner sdk.AccAddress) {
-	store := k.storeService.OpenKVStore(ctx)
-	store.Delete(ownerStoreKey(classID, nftID))
+	k.deleteOwners(ctx, classID, []string{nftID}, owner)
+}
 
+// deleteOwners deletes the owner of nfts of a class, removing them from the owner index through a single prefix store,
+// and decrements the balance of the owner in the class.
+func (k Keeper) deleteOwners(ctx context.Context, classID string, nftIDs []string, owner sdk.AccAddress) {
+	store := k.storeService.OpenKVStore(ctx)
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
-	ownerStore.Delete([]byte(nftID))
+	for _, nftID := range nftIDs {
+		store.Delete(ownerStoreKey(classID, nftID))
+		ownerStore.Delete([]byte(nftID))
+	}
+	k.updateBalance(ctx, classID, owner, k.GetBalance(ctx, classID, owner)-uint64(len(nftIDs)))
 }
 
 func (k Keeper) getNFTStore(ctx context.Context, classID string) prefix.Store {
@@ -255,3 +331,25 @@ func (k Keeper) updateTotalSupply(ctx context.Context, classID string, supply ui
 		panic(err)
 	}
 }
+
+// updateBalance sets the number of nfts of a class owned by an owner, deleting it once the owner has none left.
+func (k Keeper) updateBalance(ctx context.Context, classID string, owner sdk.AccAddress, balance uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	balanceKey := ownerClassCountStoreKey(owner, classID)
+
+	var err error
+	if balance == 0 {
+		err = store.Delete(balanceKey)
+	} else {
+		err = store.Set(balanceKey, sdk.Uint64ToBigEndian(balance))
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) prefixStoreOwnerClassCount(ctx context.Context, owner sdk.AccAddress) prefix.Store {
+	store := k.storeService.OpenKVStore(ctx)
+	key := prefixOwnerClassCountStoreKey(owner)
+	return prefix.NewStore(runtime.KVStoreAdapter(store), key)
+}
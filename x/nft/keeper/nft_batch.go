@@ -1,49 +1,109 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// BatchMint defines a method for minting a batch of nfts
+// BatchMint defines a method for minting a batch of nfts.
+// All nfts are checked before any is minted, so that the batch is minted entirely or not at all,
+// and one EventBatchMint is emitted per class. It fails if a class would exceed its max supply.
 func (k Keeper) BatchMint(ctx context.Context,
 	tokens []nft.NFT,
 	receiver sdk.AccAddress,
 ) error {
-	checked := make(map[string]bool, len(tokens))
+	var classes []nft.Class
+	tokensByClass := make(map[string][]nft.NFT)
+	seen := make(map[[2]string]bool, len(tokens))
 	for _, token := range tokens {
-		if !checked[token.ClassId] && !k.HasClass(ctx, token.ClassId) {
-			return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+		classTokens, checked := tokensByClass[token.ClassId]
+		if !checked {
+			class, has := k.GetClass(ctx, token.ClassId)
+			if !has {
+				return errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+			}
+			classes = append(classes, class)
 		}
 
-		if k.HasNFT(ctx, token.ClassId, token.Id) {
+		key := [2]string{token.ClassId, token.Id}
+		if seen[key] || k.HasNFT(ctx, token.ClassId, token.Id) {
 			return errors.Wrap(nft.ErrNFTExists, token.Id)
 		}
+		seen[key] = true
+		tokensByClass[token.ClassId] = append(classTokens, token)
+	}
 
-		checked[token.ClassId] = true
-		k.mintWithNoCheck(ctx, token, receiver)
+	for _, class := range classes {
+		if err := k.checkMaxSupply(ctx, class, uint64(len(tokensByClass[class.Id]))); err != nil {
+			return err
+		}
+	}
+
+	for _, class := range classes {
+		classID := class.Id
+		classTokens := tokensByClass[classID]
+		nftIDs := make([]string, len(classTokens))
+		nftStore := k.getNFTStore(ctx, classID)
+		for i, token := range classTokens {
+			nftStore.Set([]byte(token.Id), k.cdc.MustMarshal(&token))
+			nftIDs[i] = token.Id
+		}
+		k.setOwners(ctx, classID, nftIDs, receiver)
+		k.updateTotalSupply(ctx, classID, k.GetTotalSupply(ctx, classID)+uint64(len(nftIDs)))
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventBatchMint{
+			ClassId: classID,
+			Ids:     nftIDs,
+			Owner:   receiver.String(),
+		})
 	}
 	return nil
 }
 
 // BatchBurn defines a method for burning a batch of nfts from a specific classID.
+// All nfts are checked before any is burnt, so that the batch is burnt entirely or not at all.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) BatchBurn(ctx context.Context, classID string, nftIDs []string) error {
 	if !k.HasClass(ctx, classID) {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
-	for _, nftID := range nftIDs {
-		if !k.HasNFT(ctx, classID, nftID) {
-			return errors.Wrap(nft.ErrNFTNotExists, nftID)
-		}
-		if err := k.burnWithNoCheck(ctx, classID, nftID); err != nil {
-			return err
+	if err := k.checkBatchNFTs(ctx, classID, nftIDs); err != nil {
+		return err
+	}
+
+	var owners []sdk.AccAddress
+	idsByOwner := make(map[string][]string)
+	ownerStrs := make([]string, len(nftIDs))
+	for i, nftID := range nftIDs {
+		owner := k.GetOwner(ctx, classID, nftID)
+		ownerIDs, ok := idsByOwner[string(owner)]
+		if !ok {
+			owners = append(owners, owner)
 		}
+		idsByOwner[string(owner)] = append(ownerIDs, nftID)
+		ownerStrs[i] = owner.String()
+	}
+
+	nftStore := k.getNFTStore(ctx, classID)
+	for _, nftID := range nftIDs {
+		nftStore.Delete([]byte(nftID))
 	}
+	for _, owner := range owners {
+		k.deleteOwners(ctx, classID, idsByOwner[string(owner)], owner)
+	}
+	k.updateTotalSupply(ctx, classID, k.GetTotalSupply(ctx, classID)-uint64(len(nftIDs)))
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventBatchBurn{
+		ClassId: classID,
+		Ids:     nftIDs,
+		Owners:  ownerStrs,
+	})
 	return nil
 }
 
@@ -66,22 +126,50 @@ func (k Keeper) BatchUpdate(ctx context.Context, tokens []nft.NFT) error {
 }
 
 // BatchTransfer defines a method for sending a batch of nfts from one account to another account from a specific classID.
+// All nfts are checked to be owned by the sender before any is sent, so that the batch is sent entirely or not at all.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) BatchTransfer(ctx context.Context,
 	classID string,
 	nftIDs []string,
+	sender sdk.AccAddress,
 	receiver sdk.AccAddress,
 ) error {
 	if !k.HasClass(ctx, classID) {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
+	if err := k.checkBatchNFTs(ctx, classID, nftIDs); err != nil {
+		return err
+	}
 	for _, nftID := range nftIDs {
-		if !k.HasNFT(ctx, classID, nftID) {
-			return errors.Wrap(nft.ErrNFTNotExists, nftID)
+		if owner := k.GetOwner(ctx, classID, nftID); !bytes.Equal(owner, sender) {
+			return errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, nftID)
+		}
+	}
+
+	k.deleteOwners(ctx, classID, nftIDs, sender)
+	k.setOwners(ctx, classID, nftIDs, receiver)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventBatchSend{
+		ClassId:  classID,
+		Ids:      nftIDs,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	})
+	return nil
+}
+
+// checkBatchNFTs checks that the nfts of a batch exist in the given class and are not repeated.
+func (k Keeper) checkBatchNFTs(ctx context.Context, classID string, nftIDs []string) error {
+	nftStore := k.getNFTStore(ctx, classID)
+	seen := make(map[string]bool, len(nftIDs))
+	for _, nftID := range nftIDs {
+		if seen[nftID] {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate nft %s", nftID)
 		}
-		if err := k.transferWithNoCheck(ctx, classID, nftID, receiver); err != nil {
+		if !nftStore.Has([]byte(nftID)) {
 			return errors.Wrap(nft.ErrNFTNotExists, nftID)
 		}
+		seen[nftID] = true
 	}
 	return nil
 }
@@ -4,7 +4,12 @@ import (
 	"fmt"
 	"math/rand"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (s *TestSuite) TestBatchMint() {
@@ -178,6 +183,16 @@ func (s *TestSuite) TestBatchBurn() {
 			[]string{"nftID1", "nftID2"},
 			false,
 		},
+		{
+			"failed with repeated nftID",
+			func() {
+				s.saveClass(tokens)
+				s.nftKeeper.BatchMint(s.ctx, tokens, receiver)
+			},
+			"classID1",
+			[]string{"nftID1", "nftID1"},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -311,6 +326,26 @@ func (s *TestSuite) TestBatchTransfer() {
 			[]string{"nftID1", "nftID2"},
 			false,
 		},
+		{
+			"failed with nft not owned by sender",
+			func() {
+				s.saveClass(tokens)
+				s.nftKeeper.BatchMint(s.ctx, tokens, receiver)
+			},
+			"classID1",
+			[]string{"nftID1", "nftID2"},
+			false,
+		},
+		{
+			"failed with repeated nftID",
+			func() {
+				s.saveClass(tokens)
+				s.nftKeeper.BatchMint(s.ctx, tokens, owner)
+			},
+			"classID1",
+			[]string{"nftID1", "nftID1"},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -318,7 +353,7 @@ func (s *TestSuite) TestBatchTransfer() {
 			s.SetupTest() // reset
 			tc.malleate()
 
-			err := s.nftKeeper.BatchTransfer(s.ctx, tc.classID, tc.nftIDs, receiver)
+			err := s.nftKeeper.BatchTransfer(s.ctx, tc.classID, tc.nftIDs, owner, receiver)
 			if tc.expPass {
 				s.Require().NoError(err)
 				for _, nftID := range tc.nftIDs {
@@ -332,6 +367,102 @@ func (s *TestSuite) TestBatchTransfer() {
 	}
 }
 
+func (s *TestSuite) TestBatchPartialFailure() {
+	owner := s.addrs[0]
+	receiver := s.addrs[1]
+	tokens := []nft.NFT{
+		{ClassId: "classID1", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID2"},
+		{ClassId: "classID1", Id: "nftID3"},
+	}
+	s.saveClass(tokens)
+	s.Require().NoError(s.nftKeeper.BatchMint(s.ctx, tokens[:2], owner))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, tokens[2], receiver))
+
+	// nothing is minted when a single nft of the batch exists
+	err := s.nftKeeper.BatchMint(s.ctx, []nft.NFT{{ClassId: "classID1", Id: "nftID4"}, tokens[1]}, owner)
+	s.Require().ErrorIs(err, nft.ErrNFTExists)
+	s.Require().Contains(err.Error(), "nftID2")
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, "classID1", "nftID4"))
+
+	// nothing is sent when a single nft of the batch is not owned by the sender
+	err = s.nftKeeper.BatchTransfer(s.ctx, "classID1", []string{"nftID1", "nftID2", "nftID3"}, owner, receiver)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().Contains(err.Error(), "nftID3")
+	s.Require().EqualValues(2, s.nftKeeper.GetBalance(s.ctx, "classID1", owner))
+	s.Require().EqualValues(1, s.nftKeeper.GetBalance(s.ctx, "classID1", receiver))
+
+	// nothing is burnt when a single nft of the batch does not exist
+	err = s.nftKeeper.BatchBurn(s.ctx, "classID1", []string{"nftID1", "nftID5"})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+	s.Require().Contains(err.Error(), "nftID5")
+	s.Require().True(s.nftKeeper.HasNFT(s.ctx, "classID1", "nftID1"))
+	s.Require().EqualValues(3, s.nftKeeper.GetTotalSupply(s.ctx, "classID1"))
+}
+
+func (s *TestSuite) TestBatchMintMaxSupply() {
+	owner := s.addrs[0]
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "classID1", MaxSupply: 2}))
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "classID2"}))
+
+	// nothing is minted when a class of the batch would exceed its max supply
+	err := s.nftKeeper.BatchMint(s.ctx, []nft.NFT{
+		{ClassId: "classID2", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID2"},
+		{ClassId: "classID1", Id: "nftID3"},
+	}, owner)
+	s.Require().ErrorIs(err, nft.ErrMaxSupplyExceeded)
+	s.Require().Zero(s.nftKeeper.GetTotalSupply(s.ctx, "classID1"))
+	s.Require().Zero(s.nftKeeper.GetTotalSupply(s.ctx, "classID2"))
+
+	// the batch can reach the max supply exactly
+	err = s.nftKeeper.BatchMint(s.ctx, []nft.NFT{
+		{ClassId: "classID2", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID2"},
+	}, owner)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, s.nftKeeper.GetTotalSupply(s.ctx, "classID1"))
+
+	err = s.nftKeeper.BatchMint(s.ctx, []nft.NFT{{ClassId: "classID1", Id: "nftID3"}}, owner)
+	s.Require().ErrorIs(err, nft.ErrMaxSupplyExceeded)
+}
+
+func (s *TestSuite) TestBatchEvents() {
+	owner := s.addrs[0]
+	receiver := s.addrs[1]
+	tokens := []nft.NFT{
+		{ClassId: "classID1", Id: "nftID1"},
+		{ClassId: "classID2", Id: "nftID1"},
+		{ClassId: "classID1", Id: "nftID2"},
+	}
+	s.saveClass(tokens)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.nftKeeper.BatchMint(ctx, tokens, owner))
+	s.Require().NoError(s.nftKeeper.BatchTransfer(ctx, "classID1", []string{"nftID1"}, owner, receiver))
+	s.Require().NoError(s.nftKeeper.BatchBurn(ctx, "classID1", []string{"nftID1", "nftID2"}))
+
+	var events []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		s.Require().NoError(err)
+		events = append(events, msg)
+	}
+	s.Require().Equal([]proto.Message{
+		&nft.EventBatchMint{ClassId: "classID1", Ids: []string{"nftID1", "nftID2"}, Owner: owner.String()},
+		&nft.EventBatchMint{ClassId: "classID2", Ids: []string{"nftID1"}, Owner: owner.String()},
+		&nft.EventBatchSend{ClassId: "classID1", Ids: []string{"nftID1"}, Sender: owner.String(), Receiver: receiver.String()},
+		&nft.EventBatchBurn{ClassId: "classID1", Ids: []string{"nftID1", "nftID2"}, Owners: []string{receiver.String(), owner.String()}},
+	}, events)
+
+	s.Require().EqualValues(0, s.nftKeeper.GetTotalSupply(ctx, "classID1"))
+	s.Require().EqualValues(1, s.nftKeeper.GetTotalSupply(ctx, "classID2"))
+	s.Require().EqualValues(0, s.nftKeeper.GetBalance(ctx, "classID1", owner))
+	s.Require().EqualValues(0, s.nftKeeper.GetBalance(ctx, "classID1", receiver))
+}
+
 func groupByClassID(tokens []nft.NFT) map[string][]nft.NFT {
 	classMap := make(map[string][]nft.NFT, len(tokens))
 	for _, token := range tokens {
@@ -9,4 +9,7 @@ const (
 
 	// RouterKey is the message route for nft
 	RouterKey = ModuleName
+
+	// MaxRoyaltyBasisPoints is the maximum royalty of a class, 100% of the sale price
+	MaxRoyaltyBasisPoints = 10000
 )
@@ -78,6 +78,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "OwnerStats",
+					Use:       "owner-stats [owner]",
+					Short:     "Query the number of NFTs owned by the owner in each class.",
+					Example:   fmt.Sprintf(`%s query %s owner-stats <owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "RemainingSupply",
+					Use:       "remaining-supply [class-id]",
+					Short:     "Query the number of NFTs of a class which can still be minted before reaching its max supply.",
+					Example:   fmt.Sprintf(`%s query %s remaining-supply <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -9,10 +9,40 @@ const (
 	TypeMsgSend = "send"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgBatchSend{}
+	_ sdk.Msg = &MsgUpdateClass{}
+	_ sdk.Msg = &MsgTransferClassAdmin{}
+	_ sdk.Msg = &MsgMint{}
+)
 
 // GetSigners returns the expected signers for MsgSend.
 func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// GetSigners returns the expected signers for MsgBatchSend.
+func (m MsgBatchSend) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgUpdateClass.
+func (m MsgUpdateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgTransferClassAdmin.
+func (m MsgTransferClassAdmin) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgMint.
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
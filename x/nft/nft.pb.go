@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -39,6 +40,18 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// admin is the address allowed to update the uri, uri_hash, data and royalty_basis_points of the class, and to
+	// transfer its admin rights. The class is immutable when empty. Optional
+	Admin string `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty"`
+	// royalty_basis_points is the royalty owed to the creator of the class on sales of its NFTs, in basis points of the
+	// sale price, at most 10000. Optional
+	RoyaltyBasisPoints uint32 `protobuf:"varint,9,opt,name=royalty_basis_points,json=royaltyBasisPoints,proto3" json:"royalty_basis_points,omitempty"`
+	// max_supply is the maximum number of NFTs of the class which can exist at the same time. The supply is not capped
+	// when zero. Optional
+	MaxSupply uint64 `protobuf:"varint,10,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// mint_restricted_to is the only address allowed to mint NFTs of the class with MsgMint. NFTs of the class can only
+	// be minted by modules when empty. Optional
+	MintRestrictedTo string `protobuf:"bytes,11,opt,name=mint_restricted_to,json=mintRestrictedTo,proto3" json:"mint_restricted_to,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +136,34 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Class) GetRoyaltyBasisPoints() uint32 {
+	if m != nil {
+		return m.RoyaltyBasisPoints
+	}
+	return 0
+}
+
+func (m *Class) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *Class) GetMintRestrictedTo() string {
+	if m != nil {
+		return m.MintRestrictedTo
+	}
+	return ""
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -213,27 +254,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0xad, 0x9b, 0xb4, 0xdd, 0x4e, 0x05, 0x5a, 0x59, 0x15, 0x72, 0x57, 0x10, 0x45, 0x7b, 0xca,
+	0x01, 0x12, 0x16, 0xbe, 0x60, 0x8b, 0xb4, 0x82, 0x0b, 0x42, 0xd9, 0x3d, 0x71, 0x89, 0x9c, 0x38,
+	0xdb, 0x5a, 0x24, 0x76, 0x64, 0x3b, 0xa8, 0xf9, 0x00, 0xc4, 0x95, 0x8f, 0xe1, 0x23, 0x38, 0xae,
+	0x38, 0x71, 0x44, 0xed, 0x8f, 0x20, 0x3b, 0xa1, 0xda, 0xc3, 0x4a, 0x7b, 0x9b, 0x79, 0xef, 0x8d,
+	0xe5, 0x79, 0x6f, 0xe0, 0x79, 0x21, 0x75, 0x2d, 0x75, 0x22, 0x6e, 0x4d, 0xf2, 0xf5, 0x22, 0x2f,
+	0x0d, 0xbd, 0xb0, 0x75, 0xdc, 0x28, 0x69, 0x24, 0xc6, 0x3d, 0x1b, 0x5b, 0x64, 0x60, 0xcf, 0x56,
+	0x1b, 0x29, 0x37, 0x55, 0x99, 0x38, 0x45, 0xde, 0xde, 0x26, 0x54, 0x74, 0xbd, 0xfc, 0x6c, 0xd5,
+	0xcb, 0x33, 0xd7, 0x25, 0xc3, 0xac, 0x6b, 0xce, 0xbf, 0x79, 0x30, 0x79, 0x57, 0x51, 0xad, 0xf1,
+	0x53, 0x18, 0x73, 0x46, 0x50, 0x88, 0xa2, 0x79, 0x3a, 0xe6, 0x0c, 0x63, 0xf0, 0x05, 0xad, 0x4b,
+	0x32, 0x76, 0x88, 0xab, 0xf1, 0x33, 0x98, 0xea, 0xae, 0xce, 0x65, 0x45, 0x3c, 0x87, 0x0e, 0x1d,
+	0x0e, 0x61, 0xc1, 0x4a, 0x5d, 0x28, 0xde, 0x18, 0x2e, 0x05, 0xf1, 0x1d, 0x79, 0x1f, 0xc2, 0xa7,
+	0xe0, 0xb5, 0x8a, 0x93, 0x89, 0x63, 0x6c, 0x89, 0x57, 0x70, 0xd2, 0x2a, 0x9e, 0x6d, 0xa9, 0xde,
+	0x92, 0xa9, 0x83, 0x67, 0xad, 0xe2, 0xef, 0xa9, 0xde, 0xe2, 0x08, 0x7c, 0x46, 0x0d, 0x25, 0xb3,
+	0x10, 0x45, 0x8b, 0x37, 0xcb, 0xb8, 0xdf, 0x2c, 0xfe, 0xbf, 0x59, 0x7c, 0x29, 0xba, 0xd4, 0x29,
+	0x70, 0x0c, 0x13, 0xca, 0x6a, 0x2e, 0xc8, 0x89, 0x7d, 0x61, 0x4d, 0x7e, 0xff, 0x7c, 0xb5, 0x1c,
+	0xf6, 0xbb, 0x64, 0x4c, 0x95, 0x5a, 0x5f, 0x1b, 0xc5, 0xc5, 0x26, 0xed, 0x65, 0xf8, 0x35, 0x2c,
+	0x95, 0xec, 0x68, 0x65, 0xba, 0x2c, 0xa7, 0x9a, 0xeb, 0xac, 0x91, 0x5c, 0x18, 0x4d, 0xe6, 0x21,
+	0x8a, 0x9e, 0xa4, 0x78, 0xe0, 0xd6, 0x96, 0xfa, 0xe4, 0x18, 0xfc, 0x02, 0xa0, 0xa6, 0xbb, 0x4c,
+	0xb7, 0x4d, 0x53, 0x75, 0x04, 0x42, 0x14, 0xf9, 0xe9, 0xbc, 0xa6, 0xbb, 0x6b, 0x07, 0xe0, 0x2b,
+	0xc0, 0x35, 0x17, 0x26, 0x53, 0xa5, 0x36, 0x8a, 0x17, 0xa6, 0x64, 0x99, 0x91, 0x64, 0xf1, 0xc8,
+	0x6f, 0x4e, 0xed, 0x4c, 0x7a, 0x1c, 0xb9, 0x91, 0xe7, 0xdf, 0x11, 0x78, 0x1f, 0xaf, 0x6e, 0xac,
+	0x2b, 0x85, 0x8d, 0x23, 0x3b, 0x66, 0x31, 0x73, 0xfd, 0x07, 0x36, 0x04, 0x34, 0x3e, 0x06, 0x34,
+	0x58, 0xea, 0x3d, 0x6c, 0xa9, 0xff, 0xb0, 0xa5, 0xf0, 0x98, 0xa5, 0xeb, 0x97, 0xbf, 0xf6, 0x01,
+	0xba, 0xdb, 0x07, 0xe8, 0xef, 0x3e, 0x40, 0x3f, 0x0e, 0xc1, 0xe8, 0xee, 0x10, 0x8c, 0xfe, 0x1c,
+	0x82, 0xd1, 0xe7, 0xe1, 0xea, 0x34, 0xfb, 0x12, 0x73, 0x99, 0xec, 0xec, 0x3d, 0xe6, 0x53, 0xf7,
+	0xc2, 0xdb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x4f, 0x18, 0xbe, 0xb0, 0x02, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +304,30 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintRestrictedTo) > 0 {
+		i -= len(m.MintRestrictedTo)
+		copy(dAtA[i:], m.MintRestrictedTo)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.MintRestrictedTo)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.RoyaltyBasisPoints))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -421,6 +493,20 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.RoyaltyBasisPoints != 0 {
+		n += 1 + sovNft(uint64(m.RoyaltyBasisPoints))
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	l = len(m.MintRestrictedTo)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
 	return n
 }
 
@@ -716,6 +802,108 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyBasisPoints", wireType)
+			}
+			m.RoyaltyBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoyaltyBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRestrictedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRestrictedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
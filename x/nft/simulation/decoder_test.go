@@ -55,6 +55,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: keeper.NFTOfClassByOwnerKey, Value: nftOfClassByOwnerValue},
 			{Key: keeper.OwnerKey, Value: ownerAddr1},
 			{Key: keeper.ClassTotalSupply, Value: totalSupplyBz},
+			{Key: keeper.OwnerClassCountKey, Value: totalSupplyBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -69,6 +70,7 @@ func TestDecodeStore(t *testing.T) {
 		{"NFTOfClassByOwnerKey", false, fmt.Sprintf("%v\n%v", nftOfClassByOwnerValue, nftOfClassByOwnerValue)},
 		{"OwnerKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
 		{"ClassTotalSupply", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"OwnerClassCountKey", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
 		{"other", true, ""},
 	}
 
@@ -82,9 +82,9 @@ func SimulateMsgSend(
 			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "spend limit is nil"), nil, nil
 		}
 
-		n, err := randNFT(ctx, r, k, senderAcc.GetAddress())
-		if err != nil {
-			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, err
+		n, ok := randNFT(ctx, r, k, senderAcc.GetAddress())
+		if !ok {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "sender has no nft"), nil, nil
 		}
 
 		msg := &nft.MsgSend{
@@ -117,45 +117,18 @@ func SimulateMsgSend(
 	}
 }
 
-// randNFT picks a random NFT from a class belonging to the specified owner(minter).
-func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
-	c, err := randClass(ctx, r, k)
-	if err != nil {
-		return nft.NFT{}, err
-	}
-	ns := k.GetNFTsOfClassByOwner(ctx, c.Id, minter)
-	if len(ns) > 0 {
-		return ns[r.Intn(len(ns))], nil
+// randNFT picks a random NFT owned by the specified owner, if any. NFTs are not
+// minted by the simulation, so that it changes the state only through
+// transactions, they are minted at genesis.
+func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, owner sdk.AccAddress) (nft.NFT, bool) {
+	var ns []nft.NFT
+	for _, c := range k.GetClasses(ctx) {
+		ns = append(ns, k.GetNFTsOfClassByOwner(ctx, c.Id, owner)...)
 	}
 
-	n := nft.NFT{
-		ClassId: c.Id,
-		Id:      simtypes.RandStringOfLength(r, 10),
-		Uri:     simtypes.RandStringOfLength(r, 10),
+	if len(ns) == 0 {
+		return nft.NFT{}, false
 	}
-	err = k.Mint(ctx, n, minter)
-	if err != nil {
-		return nft.NFT{}, err
-	}
-	return n, nil
-}
 
-// randClass picks a random Class.
-func randClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) (nft.Class, error) {
-	classes := k.GetClasses(ctx)
-	if len(classes) == 0 {
-		c := nft.Class{
-			Id:          simtypes.RandStringOfLength(r, 10),
-			Name:        simtypes.RandStringOfLength(r, 10),
-			Symbol:      simtypes.RandStringOfLength(r, 10),
-			Description: simtypes.RandStringOfLength(r, 10),
-			Uri:         simtypes.RandStringOfLength(r, 10),
-		}
-		err := k.SaveClass(ctx, c)
-		if err != nil {
-			return nft.Class{}, err
-		}
-		return c, nil
-	}
-	return *classes[r.Intn(len(classes))], nil
+	return ns[r.Intn(len(ns))], true
 }
@@ -1,6 +1,7 @@
 package simulation_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -125,6 +126,14 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 	blockTime := time.Now().UTC()
 	ctx := suite.ctx.WithBlockTime(blockTime)
 
+	// mint an nft to the accounts
+	class := nft.Class{Id: "class"}
+	suite.Require().NoError(suite.nftKeeper.SaveClass(ctx, class))
+	for i, account := range accounts {
+		n := nft.NFT{ClassId: class.Id, Id: fmt.Sprintf("nft%d", i)}
+		suite.Require().NoError(suite.nftKeeper.Mint(ctx, n, account.Address))
+	}
+
 	// begin new block
 	suite.app.BeginBlock(abci.RequestBeginBlock{
 		Header: cmtproto.Header{
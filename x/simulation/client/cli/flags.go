@@ -16,6 +16,7 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagOperationStatsPathValue string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -25,6 +26,8 @@ var (
 	FlagOnOperationValue        bool // TODO: Remove in favor of binary search for invariant violation
 	FlagAllInvariantsValue      bool
 	FlagDBBackendValue          string
+	FlagLogOperationsValue      bool
+	FlagOperationLogPathValue   string
 
 	FlagEnabledValue     bool
 	FlagVerboseValue     bool
@@ -41,6 +44,7 @@ func GetSimulatorFlags() {
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagOperationStatsPathValue, "SimulationStatsOutput", "", "custom file path to save the per-operation statistics JSON")
 	flag.Int64Var(&FlagSeedValue, "Seed", DefaultSeedValue, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -50,6 +54,8 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagOnOperationValue, "SimulateEveryOperation", false, "run slow invariants every operation")
 	flag.BoolVar(&FlagAllInvariantsValue, "PrintAllInvariants", false, "print all invariants if a broken invariant is found")
 	flag.StringVar(&FlagDBBackendValue, "DBBackend", "goleveldb", "custom db backend type")
+	flag.BoolVar(&FlagLogOperationsValue, "SimulationLogOperations", false, "record the executed operations to a log file which can be replayed")
+	flag.StringVar(&FlagOperationLogPathValue, "OperationLogPath", "", "custom file path to save the operation log")
 
 	// simulation flags
 	flag.BoolVar(&FlagEnabledValue, "Enabled", false, "enable the simulation")
@@ -67,6 +73,7 @@ func NewConfigFromFlags() simulation.Config {
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		OperationStatsPath: FlagOperationStatsPathValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		NumBlocks:          FlagNumBlocksValue,
@@ -76,5 +83,7 @@ func NewConfigFromFlags() simulation.Config {
 		OnOperation:        FlagOnOperationValue,
 		AllInvariants:      FlagAllInvariantsValue,
 		DBBackend:          FlagDBBackendValue,
+		LogOperations:      FlagLogOperationsValue,
+		OperationLogPath:   FlagOperationLogPathValue,
 	}
 }
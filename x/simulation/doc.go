@@ -111,6 +111,30 @@ To export the simulation app state (i.e genesis) to a file:
 		-ExportStatePath=/path/to/genesis.json \
 		 v -timeout 24h
 
+To record the executed operations to an operation log:
+
+	 $ go test -mod=readonly github.com/cosmos/cosmos-sdk/simapp \
+	 	-run=TestFullAppSimulation \
+	 	-Enabled=true \
+	 	-NumBlocks=100 \
+	 	-BlockSize=200 \
+	 	-Commit=true \
+	 	-Seed=99 \
+	 	-Period=5 \
+		-SimulationLogOperations=true \
+		-OperationLogPath=/path/to/simulation.oplog \
+		 -v -timeout 24h
+
+The operation log can then be replayed against a fresh app with
+ReplaySimulation, which delivers the recorded transactions again up to the
+recorded failure, if any, without running the whole simulation. Operations
+must therefore only change the state by delivering transactions.
+
+At the end of a simulation, the number of runs of each operation is printed by
+outcome (ok, no-op with its most frequent reason, failed), along with the
+number of future operations scheduled and left pending. The statistics are
+also written as JSON with -SimulationStatsOutput=/path/to/stats.json.
+
 # Params
 
 Params that are provided to simulation from a JSON file are used to used to set
@@ -0,0 +1,152 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// maxNoOpReasons is the maximum number of distinct no-op reasons counted per
+// operation, the other reasons are counted together.
+const maxNoOpReasons = 20
+
+// otherNoOpReasons is the no-op reason bucket of the reasons past the first
+// maxNoOpReasons ones.
+const otherNoOpReasons = "(other reasons)"
+
+// OperationStat defines the statistics of an operation of a simulation.
+type OperationStat struct {
+	Submitted int `json:"submitted"` // number of times the operation ran
+	OK        int `json:"ok"`        // number of successful runs
+	NoOp      int `json:"no_op"`     // number of runs which did nothing, without error
+	Failed    int `json:"failed"`    // number of runs which returned an error
+
+	// NoOpReasons counts the no-op runs by reason, the comment of the operation
+	// message.
+	NoOpReasons map[string]int `json:"no_op_reasons,omitempty"`
+
+	// Queued is the number of runs from the future operations queues.
+	Queued int `json:"queued"`
+	// FutureOps is the number of future operations scheduled by the operation.
+	FutureOps int `json:"future_ops"`
+}
+
+// OperationStats defines the statistics of the operations of a simulation, by
+// module and operation name.
+type OperationStats struct {
+	Operations map[string]map[string]*OperationStat `json:"operations"`
+
+	// PendingFutureOps is the number of future operations left in the queues at
+	// the end of the simulation.
+	PendingFutureOps int `json:"pending_future_ops"`
+}
+
+// NewOperationStats creates a new empty OperationStats object.
+func NewOperationStats() *OperationStats {
+	return &OperationStats{Operations: make(map[string]map[string]*OperationStat)}
+}
+
+// Record adds a run of an operation to the statistics.
+func (s *OperationStats) Record(opMsg simulation.OperationMsg, queued bool, futureOps int, err error) {
+	ops, ok := s.Operations[opMsg.Route]
+	if !ok {
+		ops = make(map[string]*OperationStat)
+		s.Operations[opMsg.Route] = ops
+	}
+
+	stat, ok := ops[opMsg.Name]
+	if !ok {
+		stat = &OperationStat{}
+		ops[opMsg.Name] = stat
+	}
+
+	stat.Submitted++
+	stat.FutureOps += futureOps
+	if queued {
+		stat.Queued++
+	}
+
+	switch {
+	case err != nil:
+		stat.Failed++
+	case opMsg.OK:
+		stat.OK++
+	default:
+		stat.NoOp++
+		stat.addNoOpReason(opMsg.Comment)
+	}
+}
+
+// addNoOpReason counts a no-op run with the given reason.
+func (stat *OperationStat) addNoOpReason(reason string) {
+	if stat.NoOpReasons == nil {
+		stat.NoOpReasons = make(map[string]int)
+	}
+
+	if _, ok := stat.NoOpReasons[reason]; !ok && len(stat.NoOpReasons) >= maxNoOpReasons {
+		reason = otherNoOpReasons
+	}
+
+	stat.NoOpReasons[reason]++
+}
+
+// topNoOpReason returns the most frequent no-op reason of the operation.
+func (stat *OperationStat) topNoOpReason() (reason string, count int) {
+	for r, c := range stat.NoOpReasons {
+		if c > count || (c == count && r < reason) {
+			reason, count = r, c
+		}
+	}
+
+	return reason, count
+}
+
+// Print prints the statistics as a table, sorted by module and operation.
+func (s *OperationStats) Print(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tOPERATION\tSUBMITTED\tOK\tNO-OP\tFAILED\tQUEUED\tFUTURE OPS\tTOP NO-OP REASON")
+
+	modules := make([]string, 0, len(s.Operations))
+	for module := range s.Operations {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		names := make([]string, 0, len(s.Operations[module]))
+		for name := range s.Operations[module] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			stat := s.Operations[module][name]
+
+			var topReason string
+			if reason, count := stat.topNoOpReason(); count > 0 {
+				topReason = fmt.Sprintf("%q (%d)", reason, count)
+			}
+
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+				module, name, stat.Submitted, stat.OK, stat.NoOp, stat.Failed, stat.Queued, stat.FutureOps, topReason)
+		}
+	}
+
+	_ = tw.Flush()
+	fmt.Fprintf(w, "Pending future operations: %d\n", s.PendingFutureOps)
+}
+
+// ExportJSON saves the statistics as a JSON file on a given path.
+func (s *OperationStats) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(s, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
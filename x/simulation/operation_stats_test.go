@@ -0,0 +1,70 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestOperationStats(t *testing.T) {
+	stats := NewOperationStats()
+
+	stats.Record(simtypes.NewOperationMsgBasic("bank", "send", "", true, nil), false, 0, nil)
+	stats.Record(simtypes.NoOpMsg("bank", "send", "insufficient funds"), false, 0, nil)
+	stats.Record(simtypes.NoOpMsg("bank", "send", "insufficient funds"), true, 0, nil)
+	stats.Record(simtypes.NoOpMsg("bank", "send", "account is blocked"), false, 0, nil)
+	stats.Record(simtypes.NoOpMsg("gov", "submit_proposal", ""), false, 0, errors.New("failure"))
+	stats.Record(simtypes.NewOperationMsgBasic("gov", "submit_proposal", "", true, nil), false, 3, nil)
+
+	send := stats.Operations["bank"]["send"]
+	require.Equal(t, &OperationStat{
+		Submitted:   4,
+		OK:          1,
+		NoOp:        3,
+		NoOpReasons: map[string]int{"insufficient funds": 2, "account is blocked": 1},
+		Queued:      1,
+	}, send)
+
+	reason, count := send.topNoOpReason()
+	require.Equal(t, "insufficient funds", reason)
+	require.Equal(t, 2, count)
+
+	submitProposal := stats.Operations["gov"]["submit_proposal"]
+	require.Equal(t, &OperationStat{Submitted: 2, OK: 1, Failed: 1, FutureOps: 3}, submitProposal)
+
+	stats.PendingFutureOps = 3
+
+	var buf bytes.Buffer
+	stats.Print(&buf)
+	require.Contains(t, buf.String(), `"insufficient funds" (2)`)
+	require.Contains(t, buf.String(), "Pending future operations: 3")
+
+	path := filepath.Join(t.TempDir(), "operation_stats.json")
+	require.NoError(t, stats.ExportJSON(path))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var exported OperationStats
+	require.NoError(t, json.Unmarshal(bz, &exported))
+	require.Equal(t, stats, &exported)
+}
+
+func TestOperationStatsNoOpReasonsLimit(t *testing.T) {
+	stats := NewOperationStats()
+	for i := 0; i < maxNoOpReasons+5; i++ {
+		stats.Record(simtypes.NoOpMsg("bank", "send", fmt.Sprintf("reason %d", i)), false, 0, nil)
+	}
+
+	reasons := stats.Operations["bank"]["send"].NoOpReasons
+	require.Len(t, reasons, maxNoOpReasons+1)
+	require.Equal(t, 5, reasons[otherNoOpReasons])
+}
@@ -0,0 +1,350 @@
+package simulation
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// OperationLogVersion is the version of the format of the operation logs
+// written by simulations, see Config.LogOperations.
+const OperationLogVersion = 1
+
+// operation log entry kinds
+const (
+	InitChainLogKind  = "init_chain"
+	BeginBlockLogKind = "begin_block"
+	OperationLogKind  = "operation"
+	EndBlockLogKind   = "end_block"
+	CommitLogKind     = "commit"
+	FailureLogKind    = "failure"
+)
+
+// OperationLogHeader is the first line of an operation log.
+type OperationLogHeader struct {
+	Version int    `json:"version"`
+	Seed    int64  `json:"seed"`
+	ChainID string `json:"chain_id"`
+}
+
+// OperationLogEntry is an entry of an operation log, recording a step of a
+// simulation: an ABCI call or a simulated operation.
+type OperationLogEntry struct {
+	Kind   string    `json:"kind"`
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+
+	// Request is the proto encoded ABCI request of init_chain and begin_block
+	// entries.
+	Request []byte `json:"request,omitempty"`
+
+	// Route, Name, OK, Comment and Msg describe an operation, see
+	// simulation.OperationMsg.
+	Route   string          `json:"route,omitempty"`
+	Name    string          `json:"name,omitempty"`
+	OK      bool            `json:"ok,omitempty"`
+	Comment string          `json:"comment,omitempty"`
+	Msg     json.RawMessage `json:"msg,omitempty"`
+	// Queued is true for operations queued by previous operations.
+	Queued bool `json:"queued,omitempty"`
+	// Txs are the transactions delivered by an operation.
+	Txs [][]byte `json:"txs,omitempty"`
+	// Signers are the indices, in the simulation accounts, of the signers of
+	// the transactions, or -1 for signers which are not simulation accounts.
+	Signers []int `json:"signers,omitempty"`
+
+	// AppHash is the app hash of commit entries.
+	AppHash []byte `json:"app_hash,omitempty"`
+
+	// Error is the error of a failed operation or of a failure entry.
+	Error string `json:"error,omitempty"`
+}
+
+// OperationRecorder writes the operations executed by a simulation to an
+// operation log, which can be replayed with ReplaySimulation. Each entry is
+// written once complete so that the log is usable after a failure. A nil
+// recorder records nothing.
+type OperationRecorder struct {
+	f *os.File
+
+	accIndices map[string]int
+	txs        [][]byte
+	signers    []int
+}
+
+// NewOperationRecorder creates the operation log at filePath and writes its
+// header.
+func NewOperationRecorder(filePath string, seed int64, chainID string) (*OperationRecorder, error) {
+	if err := os.MkdirAll(path.Dir(filePath), os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(OperationLogHeader{Version: OperationLogVersion, Seed: seed, ChainID: chainID})
+	if err == nil {
+		_, err = f.Write(append(bz, '\n'))
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &OperationRecorder{f: f}, nil
+}
+
+// defaultOperationLogPath returns the path of the operation log of a
+// simulation with the given seed, next to the simulation logs.
+func defaultOperationLogPath(seed int64) string {
+	fileName := fmt.Sprintf("%s_seed%d.oplog", time.Now().Format("2006-01-02_15:04:05"), seed)
+	return path.Join(os.ExpandEnv("$HOME"), ".simapp", "simulations", fileName)
+}
+
+// SetAccounts sets the simulation accounts used to record the signers of the
+// transactions.
+func (r *OperationRecorder) SetAccounts(accs []simulation.Account) {
+	if r == nil {
+		return
+	}
+
+	r.accIndices = make(map[string]int, len(accs))
+	for i, acc := range accs {
+		r.accIndices[acc.Address.String()] = i
+	}
+}
+
+// RecordTx records a transaction delivered by the current operation. It is
+// meant to be set as the SimDeliver hook of the simulated app.
+func (r *OperationRecorder) RecordTx(tx sdk.Tx, txBytes []byte) {
+	if r == nil {
+		return
+	}
+
+	r.txs = append(r.txs, txBytes)
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return
+	}
+
+	for _, signer := range sigTx.GetSigners() {
+		idx, ok := r.accIndices[signer.String()]
+		if !ok {
+			idx = -1
+		}
+		r.signers = append(r.signers, idx)
+	}
+}
+
+// RecordInitChain records the InitChain request of the simulation.
+func (r *OperationRecorder) RecordInitChain(req abci.RequestInitChain) {
+	if r == nil {
+		return
+	}
+
+	bz, err := req.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	r.write(OperationLogEntry{Kind: InitChainLogKind, Height: req.InitialHeight, Time: req.Time, Request: bz})
+}
+
+// RecordBeginBlock records the BeginBlock request of a block.
+func (r *OperationRecorder) RecordBeginBlock(req abci.RequestBeginBlock) {
+	if r == nil {
+		return
+	}
+
+	bz, err := req.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	r.write(OperationLogEntry{Kind: BeginBlockLogKind, Height: req.Header.Height, Time: req.Header.Time, Request: bz})
+}
+
+// RecordOperation records an executed operation, along with the transactions
+// it delivered.
+func (r *OperationRecorder) RecordOperation(ctx sdk.Context, opMsg simulation.OperationMsg, queued bool, opErr error) {
+	if r == nil {
+		return
+	}
+
+	entry := OperationLogEntry{
+		Kind:    OperationLogKind,
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+		Route:   opMsg.Route,
+		Name:    opMsg.Name,
+		OK:      opMsg.OK,
+		Comment: opMsg.Comment,
+		Msg:     opMsg.Msg,
+		Queued:  queued,
+		Txs:     r.txs,
+		Signers: r.signers,
+	}
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+	r.txs, r.signers = nil, nil
+
+	r.write(entry)
+}
+
+// RecordEndBlock records the end of a block.
+func (r *OperationRecorder) RecordEndBlock(height int64) {
+	if r == nil {
+		return
+	}
+
+	r.write(OperationLogEntry{Kind: EndBlockLogKind, Height: height})
+}
+
+// RecordCommit records the commit of a block, with the resulting app hash.
+func (r *OperationRecorder) RecordCommit(height int64, appHash []byte) {
+	if r == nil {
+		return
+	}
+
+	r.write(OperationLogEntry{Kind: CommitLogKind, Height: height, AppHash: appHash})
+}
+
+// RecordFailure records a failure of the simulation out of an operation, e.g.
+// a panic.
+func (r *OperationRecorder) RecordFailure(height int64, failure string) {
+	if r == nil {
+		return
+	}
+
+	r.write(OperationLogEntry{Kind: FailureLogKind, Height: height, Error: failure})
+}
+
+// Close closes the operation log.
+func (r *OperationRecorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	return r.f.Close()
+}
+
+// write writes an entry to the operation log, panicking on error.
+func (r *OperationRecorder) write(entry OperationLogEntry) {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		panic(err)
+	}
+
+	if _, err := r.f.Write(append(bz, '\n')); err != nil {
+		panic(fmt.Errorf("failed to write operation log: %w", err))
+	}
+}
+
+// ReplaySimulation re-executes the steps of a simulation recorded in the
+// operation log at logFile against app, which must be a fresh application
+// with the configuration of the recorded one. The transactions delivered by
+// the operations are delivered again, without running the operations, so a
+// failure can be reproduced without running the whole simulation.
+//
+// The replay stops at the recorded failure, if any, which is returned as an
+// error, or at the first block committed with a different app hash.
+func ReplaySimulation(logFile string, app *baseapp.BaseApp) error {
+	f, err := os.Open(logFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// entries contain the genesis state and the transactions of the operations
+	scanner.Buffer(nil, 1<<30)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+		return errors.New("empty operation log")
+	}
+
+	var header OperationLogHeader
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return fmt.Errorf("invalid operation log header: %w", err)
+	}
+	if header.Version != OperationLogVersion {
+		return fmt.Errorf("unsupported operation log version %d, expected %d", header.Version, OperationLogVersion)
+	}
+
+	for scanner.Scan() {
+		var entry OperationLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("invalid operation log entry: %w", err)
+		}
+
+		if err := replayEntry(app, entry); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// replayEntry executes a recorded simulation step.
+func replayEntry(app *baseapp.BaseApp, entry OperationLogEntry) error {
+	switch entry.Kind {
+	case InitChainLogKind:
+		var req abci.RequestInitChain
+		if err := req.Unmarshal(entry.Request); err != nil {
+			return err
+		}
+		app.InitChain(req)
+
+	case BeginBlockLogKind:
+		var req abci.RequestBeginBlock
+		if err := req.Unmarshal(entry.Request); err != nil {
+			return err
+		}
+		app.BeginBlock(req)
+
+	case OperationLogKind:
+		for _, tx := range entry.Txs {
+			app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		}
+
+		if entry.Error != "" {
+			return fmt.Errorf("operation %s of x/%s failed at height %d: %s", entry.Name, entry.Route, entry.Height, entry.Error)
+		}
+
+	case EndBlockLogKind:
+		app.EndBlock(abci.RequestEndBlock{})
+
+	case CommitLogKind:
+		res := app.Commit()
+		if !bytes.Equal(res.Data, entry.AppHash) {
+			return fmt.Errorf("app hash mismatch at height %d: expected %X, got %X", entry.Height, entry.AppHash, res.Data)
+		}
+
+	case FailureLogKind:
+		return fmt.Errorf("simulation failed at height %d: %s", entry.Height, entry.Error)
+
+	default:
+		return fmt.Errorf("unknown operation log entry kind %q", entry.Kind)
+	}
+
+	return nil
+}
@@ -30,6 +30,7 @@ func initChain(
 	appStateFn simulation.AppStateFn,
 	config simulation.Config,
 	cdc codec.JSONCodec,
+	recorder *OperationRecorder,
 ) (mockValidators, time.Time, []simulation.Account, string) {
 	appState, accounts, chainID, genesisTimestamp := appStateFn(r, accounts, config)
 	consensusParams := randomConsensusParams(r, appState, cdc)
@@ -39,6 +40,7 @@ func initChain(
 		ConsensusParams: consensusParams,
 		Time:            genesisTimestamp,
 	}
+	recorder.RecordInitChain(req)
 	res := app.InitChain(req)
 	validators := newMockValidators(r, res.Validators, params)
 
@@ -71,9 +73,28 @@ func SimulateFromSeed(
 	accs := randAccFn(r, params.NumKeys())
 	eventStats := NewEventStats()
 
+	// record the executed operations, so that the simulation can be replayed
+	var recorder *OperationRecorder
+	if config.LogOperations {
+		logPath := config.OperationLogPath
+		if logPath == "" {
+			logPath = defaultOperationLogPath(config.Seed)
+		}
+
+		recorder, err = NewOperationRecorder(logPath, config.Seed, config.ChainID)
+		if err != nil {
+			return true, params, err
+		}
+		defer recorder.Close()
+
+		fmt.Fprintf(w, "Recording operations to %s\n", logPath)
+		app.SetSimDeliverHook(recorder.RecordTx)
+		defer app.SetSimDeliverHook(nil)
+	}
+
 	// Second variable to keep pending validator set (delayed one block since
 	// TM 0.24) Initially this is the same as the initial validator set
-	validators, genesisTimestamp, accs, chainID := initChain(r, params, accs, app, appStateFn, config, cdc)
+	validators, genesisTimestamp, accs, chainID := initChain(r, params, accs, app, appStateFn, config, cdc, recorder)
 	if len(accs) == 0 {
 		return true, params, fmt.Errorf("must have greater than zero genesis accounts")
 	}
@@ -95,6 +116,7 @@ func SimulateFromSeed(
 	}
 
 	accs = tmpAccs
+	recorder.SetAccounts(accs)
 	nextValidators := validators
 
 	header := cmtproto.Header{
@@ -129,11 +151,33 @@ func SimulateFromSeed(
 
 	var timeOperationQueue []simulation.FutureOperation
 
+	opStats := NewOperationStats()
+	observeOp := func(ctx sdk.Context, opMsg simulation.OperationMsg, queued bool, futureOps []simulation.FutureOperation, err error) {
+		recorder.RecordOperation(ctx, opMsg, queued, err)
+		opStats.Record(opMsg, queued, len(futureOps), err)
+	}
+
+	defer func() {
+		for _, queuedOps := range operationQueue {
+			opStats.PendingFutureOps += len(queuedOps)
+		}
+		opStats.PendingFutureOps += len(timeOperationQueue)
+
+		_, _ = fmt.Fprintln(w, "\nOperation statistics:")
+		opStats.Print(w)
+
+		if config.OperationStatsPath != "" {
+			if err := opStats.ExportJSON(config.OperationStatsPath); err != nil {
+				_, _ = fmt.Fprintf(w, "failed to export operation statistics: %v\n", err)
+			}
+		}
+	}()
+
 	logWriter := NewLogWriter(testingMode)
 
 	blockSimulator := createBlockSimulator(
 		testingMode, tb, w, params, eventStats.Tally,
-		ops, operationQueue, timeOperationQueue, logWriter, config)
+		ops, operationQueue, &timeOperationQueue, logWriter, observeOp, config)
 
 	if !testingMode {
 		b.ResetTimer()
@@ -148,6 +192,16 @@ func SimulateFromSeed(
 		}()
 	}
 
+	if recorder != nil {
+		// record the failure point in case of panic
+		defer func() {
+			if r := recover(); r != nil {
+				recorder.RecordFailure(header.Height, fmt.Sprintf("panic: %v", r))
+				panic(r)
+			}
+		}()
+	}
+
 	// set exported params to the initial state
 	if config.ExportParamsPath != "" && config.ExportParamsHeight == 0 {
 		exportedParams = params
@@ -162,6 +216,7 @@ func SimulateFromSeed(
 
 		// Run the BeginBlock handler
 		logWriter.AddEntry(BeginBlockEntry(int64(height)))
+		recorder.RecordBeginBlock(request)
 		app.BeginBlock(request)
 
 		ctx := app.NewContext(false, header)
@@ -169,22 +224,23 @@ func SimulateFromSeed(
 		// Run queued operations. Ignores blocksize if blocksize is too small
 		numQueuedOpsRan, futureOps := runQueuedOperations(
 			operationQueue, int(header.Height), tb, r, app, ctx, accs, logWriter,
-			eventStats.Tally, config.Lean, config.ChainID,
+			observeOp, eventStats.Tally, config.Lean, config.ChainID,
 		)
 
 		numQueuedTimeOpsRan, timeFutureOps := runQueuedTimeOperations(
-			timeOperationQueue, int(header.Height), header.Time,
-			tb, r, app, ctx, accs, logWriter, eventStats.Tally,
+			&timeOperationQueue, int(header.Height), header.Time,
+			tb, r, app, ctx, accs, logWriter, observeOp, eventStats.Tally,
 			config.Lean, config.ChainID,
 		)
 
 		futureOps = append(futureOps, timeFutureOps...)
-		queueOperations(operationQueue, timeOperationQueue, futureOps)
+		queueOperations(operationQueue, &timeOperationQueue, futureOps)
 
 		// run standard operations
 		operations := blockSimulator(r, app, ctx, accs, header)
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
 
+		recorder.RecordEndBlock(int64(height))
 		res := app.EndBlock(abci.RequestEndBlock{})
 		header.Height++
 		header.Time = header.Time.Add(
@@ -196,7 +252,8 @@ func SimulateFromSeed(
 		logWriter.AddEntry(EndBlockEntry(int64(height)))
 
 		if config.Commit {
-			app.Commit()
+			commitRes := app.Commit()
+			recorder.RecordCommit(int64(height), commitRes.Data)
 		}
 
 		if header.ProposerAddress == nil {
@@ -247,6 +304,11 @@ func SimulateFromSeed(
 	return false, exportedParams, nil
 }
 
+// operationObserver is called with the result of each operation run by a
+// simulation.
+type operationObserver func(ctx sdk.Context, opMsg simulation.OperationMsg, queued bool,
+	futureOps []simulation.FutureOperation, err error)
+
 type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 	accounts []simulation.Account, header cmtproto.Header) (opCount int)
 
@@ -254,8 +316,8 @@ type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 // parameters being passed everytime, to minimize memory overhead.
 func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params Params,
 	event func(route, op, evResult string), ops WeightedOperations,
-	operationQueue OperationQueue, timeOperationQueue []simulation.FutureOperation,
-	logWriter LogWriter, config simulation.Config,
+	operationQueue OperationQueue, timeOperationQueue *[]simulation.FutureOperation,
+	logWriter LogWriter, observeOp operationObserver, config simulation.Config,
 ) blockSimFn {
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
 	blocksize := 0
@@ -292,6 +354,7 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 			op, r2 := opAndR.op, opAndR.rand
 			opMsg, futureOps, err := op(r2, app, ctx, accounts, config.ChainID)
 			opMsg.LogEvent(event)
+			observeOp(ctx, opMsg, false, futureOps, err)
 
 			if !config.Lean || opMsg.OK {
 				logWriter.AddEntry(MsgEntry(header.Height, int64(i), opMsg))
@@ -322,7 +385,7 @@ Comment: %s`,
 func runQueuedOperations(queueOps map[int][]simulation.Operation,
 	height int, tb testing.TB, r *rand.Rand, app *baseapp.BaseApp,
 	ctx sdk.Context, accounts []simulation.Account, logWriter LogWriter,
-	event func(route, op, evResult string), lean bool, chainID string,
+	observeOp operationObserver, event func(route, op, evResult string), lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
 	queuedOp, ok := queueOps[height]
 	if !ok {
@@ -335,6 +398,7 @@ func runQueuedOperations(queueOps map[int][]simulation.Operation,
 	numOpsRan = len(queuedOp)
 	for i := 0; i < numOpsRan; i++ {
 		opMsg, futureOps, err := queuedOp[i](r, app, ctx, accounts, chainID)
+		observeOp(ctx, opMsg, true, futureOps, err)
 		if len(futureOps) > 0 {
 			allFutureOps = append(allFutureOps, futureOps...)
 		}
@@ -355,18 +419,19 @@ func runQueuedOperations(queueOps map[int][]simulation.Operation,
 	return numOpsRan, allFutureOps
 }
 
-func runQueuedTimeOperations(queueOps []simulation.FutureOperation,
+func runQueuedTimeOperations(queueOps *[]simulation.FutureOperation,
 	height int, currentTime time.Time, tb testing.TB, r *rand.Rand,
 	app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account,
-	logWriter LogWriter, event func(route, op, evResult string),
+	logWriter LogWriter, observeOp operationObserver, event func(route, op, evResult string),
 	lean bool, chainID string,
 ) (numOpsRan int, allFutureOps []simulation.FutureOperation) {
 	// Keep all future operations
 	allFutureOps = make([]simulation.FutureOperation, 0)
 
 	numOpsRan = 0
-	for len(queueOps) > 0 && currentTime.After(queueOps[0].BlockTime) {
-		opMsg, futureOps, err := queueOps[0].Op(r, app, ctx, accounts, chainID)
+	for len(*queueOps) > 0 && currentTime.After((*queueOps)[0].BlockTime) {
+		opMsg, futureOps, err := (*queueOps)[0].Op(r, app, ctx, accounts, chainID)
+		observeOp(ctx, opMsg, true, futureOps, err)
 
 		opMsg.LogEvent(event)
 
@@ -383,7 +448,7 @@ func runQueuedTimeOperations(queueOps []simulation.FutureOperation,
 			allFutureOps = append(allFutureOps, futureOps...)
 		}
 
-		queueOps = queueOps[1:]
+		*queueOps = (*queueOps)[1:]
 		numOpsRan++
 	}
 
@@ -42,6 +42,15 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, records := range data.SlashRecords {
+		valAddr, err := sdk.ValAddressFromBech32(records.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.setValidatorSlashRecords(ctx, valAddr, records.Height, records.Records)
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -71,5 +80,28 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	slashRecords := make([]types.ValidatorSlashRecords, 0)
+	keeper.IterateValidatorSlashRecords(ctx, func(valAddr sdk.ValAddress, height uint64, record types.ValidatorSlashRecord) (stop bool) {
+		bechAddr := valAddr.String()
+
+		// records are iterated by validator and height, so that the records of
+		// a validator at a height are contiguous
+		if n := len(slashRecords); n > 0 && slashRecords[n-1].ValidatorAddress == bechAddr && slashRecords[n-1].Height == height {
+			slashRecords[n-1].Records = append(slashRecords[n-1].Records, record)
+			return false
+		}
+
+		slashRecords = append(slashRecords, types.ValidatorSlashRecords{
+			ValidatorAddress: bechAddr,
+			Height:           height,
+			Records:          []types.ValidatorSlashRecord{record},
+		})
+
+		return false
+	})
+
+	genesisState := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesisState.SlashRecords = slashRecords
+
+	return genesisState
 }
@@ -5,9 +5,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (s *KeeperTestSuite) TestExportAndInitGenesis() {
@@ -55,3 +58,41 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
 }
+
+func (s *KeeperTestSuite) TestExportAndInitGenesisSlashRecords() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	keeper.SetParams(ctx, testutil.TestParams())
+
+	validator := stakingtypes.Validator{OperatorAddress: valAddr.String(), Tokens: sdkmath.NewInt(1000)}
+	fraction := sdkmath.LegacyNewDecWithPrec(1, 2)
+	slash := func(height int64, infraction stakingtypes.Infraction, burned int64) {
+		ctx := ctx.WithBlockHeight(height)
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(validator)
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, height-1, int64(10), fraction, infraction).Return(sdkmath.NewInt(burned))
+		keeper.SlashWithInfractionReason(ctx, consAddr, fraction, 10, height-1, infraction)
+	}
+
+	slash(5, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, 10)
+	slash(5, stakingtypes.Infraction_INFRACTION_DOWNTIME, 1)
+	slash(7, stakingtypes.Infraction_INFRACTION_DOWNTIME, 2)
+
+	genesisState := keeper.ExportGenesis(ctx)
+	require.NoError(types.ValidateGenesis(*genesisState))
+	require.Equal([]types.ValidatorSlashRecords{
+		{ValidatorAddress: valAddr.String(), Height: 5, Records: keeper.GetValidatorSlashRecords(ctx, valAddr, 5)},
+		{ValidatorAddress: valAddr.String(), Height: 7, Records: keeper.GetValidatorSlashRecords(ctx, valAddr, 7)},
+	}, genesisState.SlashRecords)
+	require.Len(genesisState.SlashRecords[0].Records, 2)
+	require.Equal(stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, genesisState.SlashRecords[0].Records[0].Infraction)
+
+	// the slash records are restored in the same order
+	s.SetupTest()
+	ctx, keeper = s.ctx, s.slashingKeeper
+	require.Empty(keeper.GetValidatorSlashRecords(ctx, valAddr, 5))
+
+	s.stakingKeeper.EXPECT().IterateValidators(ctx, gomock.Any()).Return()
+	keeper.InitGenesis(ctx, s.stakingKeeper, genesisState)
+	require.Equal(genesisState.SlashRecords, keeper.ExportGenesis(ctx).SlashRecords)
+}
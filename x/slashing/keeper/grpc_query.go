@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"google.golang.org/grpc/codes"
@@ -10,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -84,3 +86,95 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// ValidatorSlashes returns the slashes of a validator within a height range,
+// joining the slash events recorded by x/distribution with their records.
+func (k Keeper) ValidatorSlashes(c context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if k.dk == nil {
+		return nil, status.Errorf(codes.Unimplemented, "validator slashes require the distribution keeper")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	endingHeight := req.EndingHeight
+	if endingHeight == 0 {
+		endingHeight = uint64(ctx.BlockHeight())
+	}
+	if req.StartingHeight > endingHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "starting height greater than ending height (%d > %d)", req.StartingHeight, endingHeight)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal && len(pageReq.Key) == 0
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = len(pageReq.Key) == 0
+	}
+
+	var (
+		slashes       []types.ValidatorSlash
+		nextKey       []byte
+		count         uint64
+		records       []types.ValidatorSlashRecord
+		recordsHeight uint64
+		recordIndex   int
+	)
+	k.dk.IterateValidatorSlashEventsBetween(ctx, valAddr, req.StartingHeight, endingHeight, func(height uint64, event distrtypes.ValidatorSlashEvent) bool {
+		// the slash events of a height are matched with the records of the
+		// height in order, and have no record if they predate records
+		if recordsHeight != height || records == nil {
+			records, recordsHeight, recordIndex = k.GetValidatorSlashRecords(ctx, valAddr, height), height, 0
+		}
+		slash := types.ValidatorSlash{Height: height, Fraction: event.Fraction}
+		if recordIndex < len(records) {
+			slash.Record = &records[recordIndex]
+		}
+		recordIndex++
+
+		key := validatorSlashPageKey(height, event.ValidatorPeriod)
+		if len(pageReq.Key) > 0 && bytes.Compare(key, pageReq.Key) < 0 {
+			return false
+		}
+
+		count++
+		switch {
+		case count <= pageReq.Offset:
+		case uint64(len(slashes)) < limit:
+			slashes = append(slashes, slash)
+		default:
+			if nextKey == nil {
+				nextKey = key
+			}
+			return !countTotal
+		}
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return &types.QueryValidatorSlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// validatorSlashPageKey returns the pagination key of a slash event, ordered
+// the same way x/distribution stores slash events.
+func validatorSlashPageKey(height, period uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(period)...)
+}
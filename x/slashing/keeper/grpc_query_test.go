@@ -4,10 +4,20 @@ import (
 	gocontext "context"
 	"time"
 
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (s *KeeperTestSuite) TestGRPCQueryParams() {
@@ -89,3 +99,128 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCValidatorSlashes() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	validator := stakingtypes.Validator{OperatorAddress: valAddr.String(), Tokens: sdkmath.NewInt(1000)}
+	slash := func(height int64, infraction stakingtypes.Infraction, burned int64) slashingtypes.ValidatorSlashRecord {
+		ctx := ctx.WithBlockHeight(height)
+		fraction := sdkmath.LegacyNewDecWithPrec(1, 2)
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(validator)
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, height-1, int64(10), fraction, infraction).Return(sdkmath.NewInt(burned))
+		keeper.SlashWithInfractionReason(ctx, consAddr, fraction, 10, height-1, infraction)
+
+		return slashingtypes.ValidatorSlashRecord{
+			InfractionHeight: height - 1,
+			Power:            10,
+			SlashFactor:      fraction,
+			BurnedAmount:     sdkmath.NewInt(burned),
+			Infraction:       infraction,
+		}
+	}
+
+	// the slash at height 3 predates slash records
+	doubleSign := slash(5, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, 10)
+	downtime := slash(5, stakingtypes.Infraction_INFRACTION_DOWNTIME, 1)
+	laterDowntime := slash(7, stakingtypes.Infraction_INFRACTION_DOWNTIME, 2)
+
+	type slashEvent struct {
+		height uint64
+		event  distrtypes.ValidatorSlashEvent
+	}
+	events := []slashEvent{
+		{3, distrtypes.NewValidatorSlashEvent(2, sdkmath.LegacyNewDecWithPrec(5, 2))},
+		{5, distrtypes.NewValidatorSlashEvent(3, sdkmath.LegacyNewDecWithPrec(1, 2))},
+		{5, distrtypes.NewValidatorSlashEvent(4, sdkmath.LegacyNewDecWithPrec(1, 2))},
+		{7, distrtypes.NewValidatorSlashEvent(5, sdkmath.LegacyNewDecWithPrec(1, 2))},
+	}
+	s.distrKeeper.EXPECT().IterateValidatorSlashEventsBetween(gomock.Any(), valAddr, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ gocontext.Context, _ sdk.ValAddress, startingHeight, endingHeight uint64, handler func(uint64, distrtypes.ValidatorSlashEvent) bool) {
+			for _, e := range events {
+				if e.height < startingHeight || e.height > endingHeight {
+					continue
+				}
+				if handler(e.height, e.event) {
+					return
+				}
+			}
+		}).AnyTimes()
+
+	allSlashes := []slashingtypes.ValidatorSlash{
+		{Height: 3, Fraction: events[0].event.Fraction},
+		{Height: 5, Fraction: events[1].event.Fraction, Record: &doubleSign},
+		{Height: 5, Fraction: events[2].event.Fraction, Record: &downtime},
+		{Height: 7, Fraction: events[3].event.Fraction, Record: &laterDowntime},
+	}
+
+	_, err := queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{ValidatorAddress: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{
+		ValidatorAddress: valAddr.String(),
+		StartingHeight:   8,
+		EndingHeight:     7,
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// all slashes, with a count total by default
+	res, err := queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{
+		ValidatorAddress: valAddr.String(),
+		EndingHeight:     10,
+	})
+	require.NoError(err)
+	require.Equal(allSlashes, res.Slashes)
+	require.Nil(res.Pagination.NextKey)
+	require.Equal(uint64(4), res.Pagination.Total)
+
+	// slashes within a height range
+	res, err = queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{
+		ValidatorAddress: valAddr.String(),
+		StartingHeight:   4,
+		EndingHeight:     6,
+	})
+	require.NoError(err)
+	require.Equal(allSlashes[1:3], res.Slashes)
+
+	// paginated by key
+	res, err = queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{
+		ValidatorAddress: valAddr.String(),
+		EndingHeight:     10,
+		Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(allSlashes[:2], res.Slashes)
+	require.NotNil(res.Pagination.NextKey)
+	require.Equal(uint64(4), res.Pagination.Total)
+
+	res, err = queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{
+		ValidatorAddress: valAddr.String(),
+		EndingHeight:     10,
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(err)
+	require.Equal(allSlashes[2:], res.Slashes)
+	require.Nil(res.Pagination.NextKey)
+
+	// paginated by offset
+	res, err = queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{
+		ValidatorAddress: valAddr.String(),
+		EndingHeight:     10,
+		Pagination:       &query.PageRequest{Offset: 3, Limit: 1},
+	})
+	require.NoError(err)
+	require.Equal(allSlashes[3:], res.Slashes)
+
+	_, err = queryClient.ValidatorSlashes(gocontext.Background(), &slashingtypes.QueryValidatorSlashesRequest{
+		ValidatorAddress: valAddr.String(),
+		Pagination:       &query.PageRequest{Key: []byte("key"), Offset: 1},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// the query requires the distribution keeper
+	keeper = slashingkeeper.NewKeeper(nil, nil, storetypes.NewKVStoreKey(slashingtypes.StoreKey), s.stakingKeeper, nil, "")
+	_, err = keeper.ValidatorSlashes(ctx, &slashingtypes.QueryValidatorSlashesRequest{ValidatorAddress: valAddr.String()})
+	require.Equal(codes.Unimplemented, status.Code(err))
+}
@@ -6,6 +6,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -91,3 +92,32 @@ func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdkmath
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate migrates the signing info and the missed blocks
+// of a validator to its new consensus address and replaces the address-pubkey
+// relation of its old consensus pubkey.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, _ sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coin) error {
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	if signingInfo, found := h.k.GetValidatorSigningInfo(ctx, oldConsAddr); found {
+		signingInfo.Address = newConsAddr.String()
+		h.k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+		h.k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+
+		for _, missed := range h.k.GetValidatorMissedBlocks(ctx, oldConsAddr) {
+			if err := h.k.SetMissedBlockBitmapValue(ctx, newConsAddr, missed.Index, missed.Missed); err != nil {
+				return err
+			}
+		}
+		h.k.DeleteMissedBlockBitmap(ctx, oldConsAddr)
+	}
+
+	h.k.deleteAddrPubkeyRelation(ctx, oldPubKey.Address())
+
+	return nil
+}
@@ -16,8 +16,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
 
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
+	// fetch the validator public key, the votes of the blocks preceding a
+	// consensus pubkey rotation are still reported with the old address
+	consAddr := k.sk.ValidatorIdentifier(ctx, sdk.ConsAddress(addr))
 
 	// don't update missed blocks when validator's jailed
 	if k.sk.IsValidatorJailed(ctx, consAddr) {
@@ -104,7 +105,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx), stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			coinsBurned := k.slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx), stakingtypes.Infraction_INFRACTION_DOWNTIME)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -115,7 +116,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 				),
 			)
-			k.sk.Jail(ctx, consAddr)
+			k.sk.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOWNTIME, fmt.Sprintf(
+				"missed %d blocks of the %d blocks signing window at height %d", signInfo.MissedBlocksCounter, k.SignedBlocksWindow(ctx), height,
+			))
 
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
 
@@ -21,19 +21,22 @@ type Keeper struct {
 	cdc         codec.BinaryCodec
 	legacyAmino *codec.LegacyAmino
 	sk          types.StakingKeeper
+	dk          types.DistributionKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
 }
 
-// NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key storetypes.StoreKey, sk types.StakingKeeper, authority string) Keeper {
+// NewKeeper creates a slashing keeper. The distribution keeper is optional, and
+// required to query the slashes of validators.
+func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key storetypes.StoreKey, sk types.StakingKeeper, dk types.DistributionKeeper, authority string) Keeper {
 	return Keeper{
 		storeKey:    key,
 		cdc:         cdc,
 		legacyAmino: legacyAmino,
 		sk:          sk,
+		dk:          dk,
 		authority:   authority,
 	}
 }
@@ -80,7 +83,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdkmat
 // SlashWithInfractionReason attempts to slash a validator. The slash is delegated to the staking
 // module to make the necessary validator changes. It specifies an intraction reason.
 func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction stakingtypes.Infraction) {
-	coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+	coinsBurned := k.slash(ctx, consAddr, distributionHeight, power, fraction, infraction)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -92,10 +95,37 @@ func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddr
 	)
 }
 
+// slash slashes a validator through the staking module, and records the slash
+// alongside the slash event x/distribution records for it.
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, distributionHeight, power int64, fraction sdkmath.LegacyDec, infraction stakingtypes.Infraction) sdkmath.Int {
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+
+	// the staking module only has x/distribution record slash events for
+	// validators with tokens
+	if validator != nil && validator.GetTokens().IsPositive() {
+		k.appendValidatorSlashRecord(ctx, validator.GetOperator(), types.ValidatorSlashRecord{
+			InfractionHeight: distributionHeight,
+			Power:            power,
+			SlashFactor:      fraction,
+			BurnedAmount:     coinsBurned,
+			Infraction:       infraction,
+		})
+	}
+
+	return coinsBurned
+}
+
 // Jail attempts to jail a validator. The slash is delegated to the staking module
 // to make the necessary validator changes.
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
-	k.sk.Jail(ctx, consAddr)
+	k.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_UNSPECIFIED, "")
+}
+
+// JailWithReason attempts to jail a validator, recording the reason in its
+// jail info. The jailing is delegated to the staking module.
+func (k Keeper) JailWithReason(ctx sdk.Context, consAddr sdk.ConsAddress, reason stakingtypes.JailReason, details string) {
+	k.sk.JailWithReason(ctx, consAddr, reason, details)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -25,13 +25,17 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-var consAddr = sdk.ConsAddress(sdk.AccAddress([]byte("addr1_______________")))
+var (
+	consAddr = sdk.ConsAddress(sdk.AccAddress([]byte("addr1_______________")))
+	valAddr  = sdk.ValAddress([]byte("val1________________"))
+)
 
 type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx            sdk.Context
 	stakingKeeper  *slashingtestutil.MockStakingKeeper
+	distrKeeper    *slashingtestutil.MockDistributionKeeper
 	slashingKeeper slashingkeeper.Keeper
 	queryClient    slashingtypes.QueryClient
 	msgServer      slashingtypes.MsgServer
@@ -46,6 +50,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	// gomock initializations
 	ctrl := gomock.NewController(s.T())
 	s.stakingKeeper = slashingtestutil.NewMockStakingKeeper(ctrl)
+	s.distrKeeper = slashingtestutil.NewMockDistributionKeeper(ctrl)
 
 	s.ctx = ctx
 	s.slashingKeeper = slashingkeeper.NewKeeper(
@@ -53,6 +58,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		encCfg.Amino,
 		key,
 		s.stakingKeeper,
+		s.distrKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	// set test params
@@ -79,6 +85,7 @@ func (s *KeeperTestSuite) TestPubkey() {
 }
 
 func (s *KeeperTestSuite) TestJailAndSlash() {
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(nil)
 	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx,
 		consAddr,
 		s.ctx.BlockHeight(),
@@ -95,18 +102,20 @@ func (s *KeeperTestSuite) TestJailAndSlash() {
 		s.ctx.BlockHeight(),
 	)
 
-	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return()
+	s.stakingKeeper.EXPECT().JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_UNSPECIFIED, "").Return()
 	s.slashingKeeper.Jail(s.ctx, consAddr)
 }
 
 func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
+	validator := stakingtypes.Validator{OperatorAddress: valAddr.String(), Tokens: sdkmath.NewInt(100)}
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(validator)
 	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx,
 		consAddr,
 		s.ctx.BlockHeight(),
 		sdk.TokensToConsensusPower(sdkmath.NewInt(1), sdk.DefaultPowerReduction),
 		s.slashingKeeper.SlashFractionDoubleSign(s.ctx),
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
-	).Return(sdkmath.NewInt(0))
+	).Return(sdkmath.NewInt(5))
 
 	s.slashingKeeper.SlashWithInfractionReason(
 		s.ctx,
@@ -117,8 +126,17 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 
-	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return()
-	s.slashingKeeper.Jail(s.ctx, consAddr)
+	// the slash of a validator with tokens is recorded
+	s.Require().Equal([]slashingtypes.ValidatorSlashRecord{{
+		InfractionHeight: s.ctx.BlockHeight(),
+		Power:            sdk.TokensToConsensusPower(sdkmath.NewInt(1), sdk.DefaultPowerReduction),
+		SlashFactor:      s.slashingKeeper.SlashFractionDoubleSign(s.ctx),
+		BurnedAmount:     sdkmath.NewInt(5),
+		Infraction:       stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	}}, s.slashingKeeper.GetValidatorSlashRecords(s.ctx, valAddr, uint64(s.ctx.BlockHeight())))
+
+	s.stakingKeeper.EXPECT().JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, "double-signed").Return()
+	s.slashingKeeper.JailWithReason(s.ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOUBLE_SIGN, "double-signed")
 }
 
 func TestKeeperTestSuite(t *testing.T) {
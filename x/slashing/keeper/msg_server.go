@@ -58,3 +58,23 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// RemoveTombstone implements MsgServer.RemoveTombstone method.
+// It defines a governance operation removing the tombstone of a validator.
+func (k msgServer) RemoveTombstone(goCtx context.Context, msg *types.MsgRemoveTombstone) (*types.MsgRemoveTombstoneResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(msg.ConsAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("consensus address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RemoveTombstone(ctx, consAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveTombstoneResponse{}, nil
+}
@@ -332,3 +332,49 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRemoveTombstone() {
+	require := s.Require()
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	consAddr := sdk.ConsAddress(addr)
+	ctx := s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+
+	_, err := s.msgServer.RemoveTombstone(ctx, slashingtypes.NewMsgRemoveTombstone("foo", consAddr))
+	require.ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.RemoveTombstone(ctx, &slashingtypes.MsgRemoveTombstone{Authority: s.slashingKeeper.GetAuthority(), ConsAddress: "invalid"})
+	require.ErrorContains(err, "decoding bech32 failed")
+
+	msg := slashingtypes.NewMsgRemoveTombstone(s.slashingKeeper.GetAuthority(), consAddr)
+	_, err = s.msgServer.RemoveTombstone(ctx, msg)
+	require.ErrorIs(err, slashingtypes.ErrNoSigningInfoFound)
+
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, int64(4), int64(3), time.Unix(253402300799, 0), false, int64(2))
+	s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	_, err = s.msgServer.RemoveTombstone(ctx, msg)
+	require.ErrorIs(err, slashingtypes.ErrValidatorNotTombstoned)
+
+	s.slashingKeeper.Tombstone(ctx, consAddr)
+	require.NoError(s.slashingKeeper.SetMissedBlockBitmapValue(ctx, consAddr, 1, true))
+	_, err = s.msgServer.RemoveTombstone(ctx, msg)
+	require.NoError(err)
+
+	// the validator can be unjailed right away and its missed blocks window is reset
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(found)
+	require.False(info.Tombstoned)
+	require.Equal(ctx.BlockTime(), info.JailedUntil)
+	require.Equal(int64(0), info.MissedBlocksCounter)
+	require.Equal(int64(0), info.IndexOffset)
+	require.Equal(int64(10), info.TombstoneRemovedHeight)
+	require.Empty(s.slashingKeeper.GetValidatorMissedBlocks(ctx, consAddr))
+
+	events := ctx.EventManager().Events()
+	require.Len(events, 1)
+	require.Equal(slashingtypes.EventTypeTombstoneRemoved, events[0].Type)
+
+	// the tombstone is removed only once
+	_, err = s.msgServer.RemoveTombstone(ctx, msg)
+	require.ErrorIs(err, slashingtypes.ErrValidatorNotTombstoned)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	storetypes "cosmossdk.io/store/types"
@@ -40,6 +41,12 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// deleteValidatorSigningInfo removes the validator signing info of a consensus address
+func (k Keeper) deleteValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorSigningInfoKey(address))
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -85,6 +92,38 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
+// RemoveTombstone removes the tombstone of a validator, which can then be
+// unjailed right away. Its missed blocks window is reset so that it is not
+// slashed for downtime upon re-bonding. The slashed tokens are not refunded.
+func (k Keeper) RemoveTombstone(ctx sdk.Context, consAddr sdk.ConsAddress) error {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		return types.ErrNoSigningInfoFound.Wrap(consAddr.String())
+	}
+
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned.Wrap(consAddr.String())
+	}
+
+	signInfo.Tombstoned = false
+	signInfo.JailedUntil = ctx.BlockTime()
+	signInfo.MissedBlocksCounter = 0
+	signInfo.IndexOffset = 0
+	signInfo.TombstoneRemovedHeight = ctx.BlockHeight()
+	k.DeleteMissedBlockBitmap(ctx, consAddr)
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTombstoneRemoved,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	return nil
+}
+
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
 func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetValidatorSlashRecords returns the records of the slashes of a validator at
+// a height, in the order the validator was slashed.
+func (k Keeper) GetValidatorSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress, height uint64) []types.ValidatorSlashRecord {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.ValidatorSlashRecordsPrefixKey(valAddr, height))
+	defer iter.Close()
+
+	var records []types.ValidatorSlashRecord
+	for ; iter.Valid(); iter.Next() {
+		var record types.ValidatorSlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// IterateValidatorSlashRecords iterates over the records of the slashes of all
+// validators, by validator and height, in the order they were slashed.
+func (k Keeper) IterateValidatorSlashRecords(ctx sdk.Context,
+	handler func(valAddr sdk.ValAddress, height uint64, record types.ValidatorSlashRecord) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.ValidatorSlashRecordKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		valAddr, height, _ := types.ParseValidatorSlashRecordKey(iter.Key())
+		var record types.ValidatorSlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if handler(valAddr, height, record) {
+			break
+		}
+	}
+}
+
+// setValidatorSlashRecords sets the records of the slashes of a validator at a
+// height, in the order the validator was slashed.
+func (k Keeper) setValidatorSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress, height uint64, records []types.ValidatorSlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	for i := range records {
+		store.Set(types.ValidatorSlashRecordKey(valAddr, height, uint64(i)), k.cdc.MustMarshal(&records[i]))
+	}
+}
+
+// appendValidatorSlashRecord records a slash of a validator at the current
+// height, after the slashes it was already recorded at this height.
+func (k Keeper) appendValidatorSlashRecord(ctx sdk.Context, valAddr sdk.ValAddress, record types.ValidatorSlashRecord) {
+	height := uint64(ctx.BlockHeight())
+	index := uint64(len(k.GetValidatorSlashRecords(ctx, valAddr, height)))
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValidatorSlashRecordKey(valAddr, height, index), k.cdc.MustMarshal(&record))
+}
@@ -0,0 +1,63 @@
+package slashing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestDowntimeJailing takes a validator of an in-process network offline and
+// checks that it is jailed for downtime.
+func TestDowntimeJailing(t *testing.T) {
+	cfg, err := network.DefaultConfigWithAppConfig(testutil.AppConfig)
+	require.NoError(t, err)
+	cfg.NumValidators = 4
+	cfg.TimeoutCommit = 500 * time.Millisecond
+
+	var slashingGenesis slashingtypes.GenesisState
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[slashingtypes.ModuleName], &slashingGenesis))
+	slashingGenesis.Params.SignedBlocksWindow = 10
+	slashingGenesis.Params.MinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	cfg.GenesisState[slashingtypes.ModuleName], err = cfg.Codec.MarshalJSON(&slashingGenesis)
+	require.NoError(t, err)
+
+	network, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer network.Cleanup()
+
+	require.NoError(t, network.WaitForNextBlock())
+
+	// the first validator is the only one exposing a gRPC server, take the
+	// last one offline
+	downed := network.Validators[len(network.Validators)-1]
+	require.NoError(t, network.StopValidator(len(network.Validators)-1))
+
+	queryClient := stakingtypes.NewQueryClient(network.Validators[0].ClientCtx)
+	isJailed := func() bool {
+		res, err := queryClient.Validator(context.Background(), &stakingtypes.QueryValidatorRequest{
+			ValidatorAddr: downed.ValAddress.String(),
+		})
+		return err == nil && res.Validator.Jailed
+	}
+	require.NoError(t, network.WaitForCondition(isJailed, time.Minute))
+
+	res, err := queryClient.Validator(context.Background(), &stakingtypes.QueryValidatorRequest{
+		ValidatorAddr: downed.ValAddress.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.Unbonding, res.Validator.Status)
+
+	// the validator can be brought back online, and stays jailed
+	require.NoError(t, network.StartValidator(len(network.Validators)-1))
+	require.NoError(t, network.WaitForNextBlock())
+	require.True(t, isJailed())
+}
@@ -2,8 +2,9 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash            = "slash"
+	EventTypeLiveness         = "liveness"
+	EventTypeTombstoneRemoved = "tombstone_removed"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -42,11 +43,17 @@ type StakingKeeper interface {
 	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI            // get a particular validator by operator address
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI // get a particular validator by consensus address
 
+	// ValidatorIdentifier returns the current consensus address of the
+	// validator which used the given consensus address, following the
+	// consensus pubkey rotations of the last unbonding period.
+	ValidatorIdentifier(sdk.Context, sdk.ConsAddress) sdk.ConsAddress
+
 	// slash the validator and delegators of the validator, specifying offense height, offense power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, math.LegacyDec) math.Int
 	SlashWithInfractionReason(sdk.Context, sdk.ConsAddress, int64, int64, math.LegacyDec, stakingtypes.Infraction) math.Int
-	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
-	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
+	Jail(sdk.Context, sdk.ConsAddress)                                            // jail a validator
+	JailWithReason(sdk.Context, sdk.ConsAddress, stakingtypes.JailReason, string) // jail a validator, recording the reason
+	Unjail(sdk.Context, sdk.ConsAddress)                                          // unjail a validator
 
 	// Delegation allows for getting a particular delegation for a given validator
 	// and delegator outside the scope of the staking module.
@@ -75,3 +82,10 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
 }
+
+// DistributionKeeper expected distribution keeper (noalias)
+type DistributionKeeper interface {
+	// iterate through the slash events of a validator recorded between two heights, inclusive
+	IterateValidatorSlashEventsBetween(ctx context.Context, val sdk.ValAddress, startingHeight, endingHeight uint64,
+		handler func(height uint64, event distrtypes.ValidatorSlashEvent) (stop bool))
+}
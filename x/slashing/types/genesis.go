@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -32,6 +34,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		SlashRecords: []ValidatorSlashRecords{},
 	}
 }
 
@@ -62,5 +65,27 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	seen := make(map[string]bool, len(data.SlashRecords))
+	for _, records := range data.SlashRecords {
+		if _, err := sdk.ValAddressFromBech32(records.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid slash records validator address %s: %w", records.ValidatorAddress, err)
+		}
+
+		key := fmt.Sprintf("%s/%d", records.ValidatorAddress, records.Height)
+		if seen[key] {
+			return fmt.Errorf("duplicate slash records of validator %s at height %d", records.ValidatorAddress, records.Height)
+		}
+		seen[key] = true
+
+		for _, record := range records.Records {
+			if record.SlashFactor.IsNil() || record.SlashFactor.IsNegative() || record.SlashFactor.GT(math.LegacyOneDec()) {
+				return fmt.Errorf("slash factor of validator %s at height %d should be between zero and one, is %s", records.ValidatorAddress, records.Height, record.SlashFactor)
+			}
+			if record.BurnedAmount.IsNil() || record.BurnedAmount.IsNegative() {
+				return fmt.Errorf("burned amount of validator %s at height %d should not be negative, is %s", records.ValidatorAddress, records.Height, record.BurnedAmount)
+			}
+		}
+	}
+
 	return nil
 }
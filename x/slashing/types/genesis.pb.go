@@ -35,6 +35,9 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// slash_records defines the records of the slashes of the validators, by
+	// validator and height.
+	SlashRecords []ValidatorSlashRecords `protobuf:"bytes,4,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +94,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSlashRecords() []ValidatorSlashRecords {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -257,11 +267,77 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+// ValidatorSlashRecords contains the records of the slashes of a validator at
+// a height, in the order the validator was slashed.
+type ValidatorSlashRecords struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the height at which the validator was slashed.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// records are the records of the slashes of the validator at the height.
+	Records []ValidatorSlashRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+}
+
+func (m *ValidatorSlashRecords) Reset()         { *m = ValidatorSlashRecords{} }
+func (m *ValidatorSlashRecords) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSlashRecords) ProtoMessage()    {}
+func (*ValidatorSlashRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{4}
+}
+func (m *ValidatorSlashRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSlashRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSlashRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashRecords.Merge(m, src)
+}
+func (m *ValidatorSlashRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSlashRecords proto.InternalMessageInfo
+
+func (m *ValidatorSlashRecords) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorSlashRecords) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorSlashRecords) GetRecords() []ValidatorSlashRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorSlashRecords)(nil), "cosmos.slashing.v1beta1.ValidatorSlashRecords")
 }
 
 func init() {
@@ -269,35 +345,40 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x14, 0xe6, 0x6c, 0x07, 0xac, 0x32, 0xc2, 0x24, 0xb2, 0x51, 0x01, 0x9a,
-	0x90, 0x92, 0x68, 0xe3, 0xb8, 0x13, 0xe1, 0x30, 0x71, 0x40, 0x42, 0xe9, 0xc4, 0x81, 0x03, 0x91,
-	0xd3, 0x78, 0x9e, 0xb5, 0xc6, 0xae, 0xf2, 0x79, 0x55, 0x79, 0x03, 0x8e, 0x3c, 0x06, 0xe2, 0xc4,
-	0x81, 0x13, 0x4f, 0xd0, 0x63, 0xc5, 0x89, 0x13, 0x42, 0xed, 0x81, 0xd7, 0x40, 0xd8, 0x29, 0x0d,
-	0xa8, 0x51, 0x0f, 0xbb, 0x24, 0xb1, 0xfd, 0xfb, 0xff, 0xbf, 0xcf, 0xff, 0xd8, 0xf8, 0xd1, 0x40,
-	0x41, 0xa1, 0x20, 0x82, 0x21, 0x85, 0x0b, 0x21, 0x79, 0x34, 0x3e, 0xca, 0x98, 0xa6, 0x47, 0x11,
-	0x67, 0x92, 0x81, 0x80, 0x70, 0x54, 0x2a, 0xad, 0xc8, 0x5d, 0x8b, 0x85, 0x4b, 0x2c, 0xac, 0xb0,
-	0xbd, 0x2e, 0x57, 0x5c, 0x19, 0x26, 0xfa, 0xf3, 0x65, 0xf1, 0xbd, 0xc7, 0x4d, 0xae, 0x7f, 0xf5,
-	0x96, 0xbb, 0x67, 0xb9, 0xd4, 0x1a, 0x54, 0x35, 0xec, 0xd2, 0x6d, 0x5a, 0x08, 0xa9, 0x22, 0xf3,
-	0xb4, 0x53, 0xbd, 0xf7, 0x2d, 0xbc, 0x7d, 0x6a, 0xdb, 0xea, 0x6b, 0xaa, 0x19, 0x89, 0x71, 0x67,
-	0x44, 0x4b, 0x5a, 0x80, 0x87, 0x0e, 0xd0, 0xa1, 0x7b, 0xbc, 0x1f, 0x36, 0xb4, 0x19, 0xbe, 0x32,
-	0x58, 0xbc, 0x35, 0xfd, 0xb1, 0xef, 0x7c, 0xfc, 0xf5, 0xf9, 0x09, 0x4a, 0x2a, 0x25, 0x39, 0xc3,
-	0x3b, 0x20, 0xb8, 0x14, 0x92, 0xa7, 0x42, 0x9e, 0x2b, 0xf0, 0x5a, 0x07, 0xed, 0x43, 0xf7, 0xf8,
-	0x61, 0xa3, 0x55, 0xdf, 0xd2, 0x2f, 0xe4, 0xb9, 0xaa, 0xfb, 0x6d, 0xc3, 0x6a, 0x1e, 0xc8, 0x5b,
-	0xbc, 0x53, 0x08, 0x00, 0x96, 0xa7, 0xd9, 0x50, 0x0d, 0x2e, 0xc1, 0x6b, 0x1b, 0xd7, 0xb0, 0xd1,
-	0xf5, 0x35, 0x1d, 0x8a, 0x9c, 0x6a, 0x55, 0xbe, 0x34, 0xb2, 0xd8, 0xa8, 0xfe, 0xf1, 0x2f, 0x6a,
-	0x0b, 0xbd, 0xaf, 0x08, 0xbb, 0xb5, 0x46, 0xc8, 0x09, 0xbe, 0x49, 0xf3, 0xbc, 0x64, 0x60, 0xa3,
-	0xd8, 0x8a, 0x1f, 0x7c, 0xfb, 0x12, 0xdc, 0xaf, 0x8a, 0x3d, 0x57, 0x12, 0x98, 0x84, 0x2b, 0x78,
-	0x66, 0x91, 0xbe, 0x2e, 0x85, 0xe4, 0xc9, 0x52, 0x41, 0x24, 0xde, 0x1d, 0x2f, 0xcb, 0xa7, 0xf5,
-	0x30, 0xbc, 0x96, 0x89, 0x35, 0xd8, 0xdc, 0x75, 0x43, 0x28, 0xdd, 0xf1, 0x1a, 0xa0, 0xf7, 0x09,
-	0xe1, 0x3b, 0x6b, 0xf7, 0x7b, 0xbd, 0x6d, 0x9c, 0xfd, 0x9f, 0xf9, 0xa6, 0x3f, 0x59, 0x2b, 0xdd,
-	0x9c, 0xf4, 0x09, 0x76, 0x6b, 0x1c, 0xe9, 0xe2, 0x1b, 0x42, 0xe6, 0x6c, 0x62, 0xfa, 0x6b, 0x27,
-	0x76, 0x40, 0x76, 0x71, 0xc7, 0x8a, 0x4c, 0x62, 0xb7, 0x92, 0x6a, 0x14, 0x9f, 0x4e, 0xe7, 0x3e,
-	0x9a, 0xcd, 0x7d, 0xf4, 0x73, 0xee, 0xa3, 0x0f, 0x0b, 0xdf, 0x99, 0x2d, 0x7c, 0xe7, 0xfb, 0xc2,
-	0x77, 0xde, 0x04, 0x5c, 0xe8, 0x8b, 0xab, 0x2c, 0x1c, 0xa8, 0xa2, 0x3a, 0xf7, 0xd5, 0x2b, 0x80,
-	0xfc, 0x32, 0x9a, 0xac, 0x6e, 0x8e, 0x7e, 0x37, 0x62, 0x90, 0x75, 0xcc, 0x0d, 0x78, 0xfa, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0xc1, 0xf1, 0x72, 0x89, 0xaf, 0x03, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x93, 0x92, 0xd2, 0x4d, 0x2b, 0xd1, 0x55, 0x28, 0xa6, 0x12, 0x6e, 0xb1, 0x00, 0x55,
+	0x48, 0xb6, 0xd5, 0x72, 0xec, 0x09, 0x73, 0xa8, 0x38, 0x80, 0x90, 0x53, 0x71, 0xe0, 0x80, 0xe5,
+	0xc4, 0xdb, 0xcd, 0xaa, 0xf1, 0x6e, 0xe4, 0xd9, 0x46, 0xe5, 0x2f, 0xf8, 0x0c, 0xc4, 0x89, 0x03,
+	0x27, 0xbe, 0xa0, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0x0e, 0xf0, 0x19, 0x28, 0xbb, 0x76, 0xb3,
+	0xa9, 0x62, 0x11, 0xc4, 0x25, 0xf1, 0xee, 0xbc, 0xf7, 0x66, 0xe6, 0xcd, 0x68, 0xd1, 0xc3, 0x9e,
+	0x80, 0x4c, 0x40, 0x00, 0x83, 0x04, 0xfa, 0x8c, 0xd3, 0x60, 0xb4, 0xdf, 0x25, 0x32, 0xd9, 0x0f,
+	0x28, 0xe1, 0x04, 0x18, 0xf8, 0xc3, 0x5c, 0x48, 0x81, 0xef, 0x68, 0x98, 0x5f, 0xc2, 0xfc, 0x02,
+	0xb6, 0xdd, 0xa6, 0x82, 0x0a, 0x85, 0x09, 0xa6, 0x5f, 0x1a, 0xbe, 0xfd, 0xa8, 0x4a, 0xf5, 0x8a,
+	0xaf, 0x71, 0x77, 0x35, 0x2e, 0xd6, 0x02, 0x45, 0x0e, 0x1d, 0xda, 0x4c, 0x32, 0xc6, 0x45, 0xa0,
+	0x7e, 0xf5, 0x95, 0xfb, 0xbb, 0x8e, 0xd6, 0x8f, 0x74, 0x59, 0x1d, 0x99, 0x48, 0x82, 0x43, 0xd4,
+	0x1c, 0x26, 0x79, 0x92, 0x81, 0x6d, 0xed, 0x5a, 0x7b, 0xad, 0x83, 0x1d, 0xbf, 0xa2, 0x4c, 0xff,
+	0x95, 0x82, 0x85, 0x6b, 0x17, 0x3f, 0x76, 0x6a, 0x1f, 0x7e, 0x7d, 0x7a, 0x6c, 0x45, 0x05, 0x13,
+	0x1f, 0xa3, 0x0d, 0x60, 0x94, 0x33, 0x4e, 0x63, 0xc6, 0x4f, 0x04, 0xd8, 0xf5, 0xdd, 0xc6, 0x5e,
+	0xeb, 0xe0, 0x41, 0xa5, 0x54, 0x47, 0xa3, 0x9f, 0xf3, 0x13, 0x61, 0xea, 0xad, 0xc3, 0xec, 0x1e,
+	0xf0, 0x5b, 0xb4, 0x91, 0x31, 0x00, 0x92, 0xc6, 0xdd, 0x81, 0xe8, 0x9d, 0x82, 0xdd, 0x50, 0xaa,
+	0x7e, 0xa5, 0xea, 0xeb, 0x64, 0xc0, 0xd2, 0x44, 0x8a, 0xfc, 0x85, 0xa2, 0x85, 0x8a, 0x35, 0xa7,
+	0x9f, 0x19, 0x81, 0xa9, 0xbe, 0x92, 0x88, 0x73, 0xd2, 0x13, 0x79, 0x0a, 0xf6, 0xca, 0xb2, 0xfa,
+	0x9d, 0x69, 0x24, 0xd2, 0xac, 0xf9, 0xfa, 0x8d, 0x80, 0xfb, 0xc5, 0x42, 0x2d, 0xa3, 0x51, 0x7c,
+	0x88, 0x56, 0x93, 0x34, 0xcd, 0x09, 0x68, 0xab, 0xd7, 0xc2, 0xfb, 0x5f, 0x3f, 0x7b, 0xf7, 0x8a,
+	0x64, 0xcf, 0x04, 0x07, 0xc2, 0xe1, 0x0c, 0x9e, 0x6a, 0x48, 0x47, 0xe6, 0x8c, 0xd3, 0xa8, 0x64,
+	0x60, 0x8e, 0xb6, 0x46, 0x65, 0xfa, 0xd8, 0x34, 0xdb, 0xae, 0xab, 0xb1, 0x79, 0x4b, 0x54, 0xbd,
+	0xd8, 0xf4, 0xf6, 0x68, 0x01, 0xc0, 0xfd, 0x68, 0xa1, 0xdb, 0x0b, 0xfd, 0xfc, 0xbf, 0x36, 0x8e,
+	0xaf, 0xcf, 0xf4, 0x6f, 0x9b, 0x62, 0xa4, 0xae, 0x9c, 0xa4, 0x7b, 0x88, 0x5a, 0x06, 0x0e, 0xb7,
+	0xd1, 0x0d, 0xc6, 0x53, 0x72, 0xae, 0xea, 0x6b, 0x44, 0xfa, 0x80, 0xb7, 0x50, 0x53, 0x93, 0x94,
+	0x63, 0x37, 0xa3, 0xe2, 0xe4, 0x7e, 0x33, 0x3b, 0x35, 0x27, 0x8b, 0x5f, 0xa2, 0xcd, 0x99, 0xe7,
+	0xd5, 0x3d, 0x5f, 0x91, 0xe7, 0x7b, 0xbe, 0x35, 0xba, 0x76, 0x3f, 0xad, 0xa0, 0x4f, 0x18, 0xed,
+	0x4b, 0x55, 0xc1, 0x4a, 0x54, 0x9c, 0x70, 0x84, 0x56, 0xcb, 0x15, 0xd4, 0x2b, 0xee, 0xfd, 0xd3,
+	0x0a, 0x9a, 0xbe, 0x94, 0x42, 0xe1, 0xd1, 0xc5, 0xd8, 0xb1, 0x2e, 0xc7, 0x8e, 0xf5, 0x73, 0xec,
+	0x58, 0xef, 0x27, 0x4e, 0xed, 0x72, 0xe2, 0xd4, 0xbe, 0x4f, 0x9c, 0xda, 0x1b, 0x8f, 0x32, 0xd9,
+	0x3f, 0xeb, 0xfa, 0x3d, 0x91, 0x15, 0xaf, 0x45, 0xf1, 0xe7, 0x41, 0x7a, 0x1a, 0x9c, 0xcf, 0xde,
+	0x1b, 0xf9, 0x6e, 0x48, 0xa0, 0xdb, 0x54, 0xef, 0xc6, 0x93, 0x3f, 0x03, 0x00, 0x8b, 0xda, 0xa3,
+	0x25, 0xe5, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +401,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -483,6 +578,55 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSlashRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -514,6 +658,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -566,6 +716,28 @@ func (m *MissedBlock) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorSlashRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -702,6 +874,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, ValidatorSlashRecords{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1043,6 +1249,141 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorSlashRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ValidatorSlashRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
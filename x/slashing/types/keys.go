@@ -46,12 +46,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<valAddrLen (1 Byte)><valAddr_Bytes><height (8 Bytes)><index (8 Bytes)>: ValidatorSlashRecord
 
 var (
 	ParamsKey                           = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x02} // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorSlashRecordKeyPrefix       = []byte{0x04} // Prefix for validator slash records
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -87,3 +90,30 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// ValidatorSlashRecordsPrefixKey returns the key prefix for the records of the
+// slashes of a validator at a height.
+func ValidatorSlashRecordsPrefixKey(v sdk.ValAddress, height uint64) []byte {
+	return append(append(ValidatorSlashRecordKeyPrefix, address.MustLengthPrefix(v.Bytes())...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// ValidatorSlashRecordKey returns the key for the record of the index-th slash
+// of a validator at a height.
+func ValidatorSlashRecordKey(v sdk.ValAddress, height, index uint64) []byte {
+	return append(ValidatorSlashRecordsPrefixKey(v, height), sdk.Uint64ToBigEndian(index)...)
+}
+
+// ParseValidatorSlashRecordKey extracts the validator address, height and index
+// from a validator slash record key.
+func ParseValidatorSlashRecordKey(key []byte) (v sdk.ValAddress, height, index uint64) {
+	// Remove prefix, then read the length prefixed address.
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := int(key[1])
+	kv.AssertKeyLength(key, 2+addrLen+16)
+
+	v = sdk.ValAddress(key[2 : 2+addrLen])
+	height = sdk.BigEndianToUint64(key[2+addrLen : 2+addrLen+8])
+	index = sdk.BigEndianToUint64(key[2+addrLen+8:])
+
+	return v, height, index
+}
@@ -9,9 +9,11 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRemoveTombstone{}
 
 	_ legacytx.LegacyMsg = &MsgUnjail{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgRemoveTombstone{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -43,3 +45,22 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRemoveTombstone creates a new MsgRemoveTombstone instance
+func NewMsgRemoveTombstone(authority string, consAddr sdk.ConsAddress) *MsgRemoveTombstone {
+	return &MsgRemoveTombstone{
+		Authority:   authority,
+		ConsAddress: consAddr.String(),
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveTombstone) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveTombstone message.
+func (msg MsgRemoveTombstone) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -4,10 +4,12 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -50,6 +52,11 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Height at which the tombstone of the validator was last removed by
+	// governance with MsgRemoveTombstone, or 0 if it never was.
+	//
+	// Since: cosmos-sdk 0.48
+	TombstoneRemovedHeight int64 `protobuf:"varint,7,opt,name=tombstone_removed_height,json=tombstoneRemovedHeight,proto3" json:"tombstone_removed_height,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -127,6 +134,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetTombstoneRemovedHeight() int64 {
+	if m != nil {
+		return m.TombstoneRemovedHeight
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -183,9 +197,81 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+// ValidatorSlashRecord is the compact record of a slash of a validator, written
+// when the validator is slashed, alongside the slash event x/distribution
+// records at the same height.
+type ValidatorSlashRecord struct {
+	// infraction_height is the height at which the infraction was committed.
+	InfractionHeight int64 `protobuf:"varint,1,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// power is the consensus power of the validator at the infraction height.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// slash_factor is the fraction of the stake at the infraction height the
+	// validator was slashed by.
+	SlashFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_factor,json=slashFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_factor"`
+	// burned_amount is the amount of tokens burned by the slash.
+	BurnedAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=burned_amount,json=burnedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"burned_amount"`
+	// infraction is the infraction the validator was slashed for.
+	Infraction types.Infraction `protobuf:"varint,5,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+}
+
+func (m *ValidatorSlashRecord) Reset()         { *m = ValidatorSlashRecord{} }
+func (m *ValidatorSlashRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSlashRecord) ProtoMessage()    {}
+func (*ValidatorSlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *ValidatorSlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashRecord.Merge(m, src)
+}
+func (m *ValidatorSlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSlashRecord proto.InternalMessageInfo
+
+func (m *ValidatorSlashRecord) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+func (m *ValidatorSlashRecord) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *ValidatorSlashRecord) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*ValidatorSlashRecord)(nil), "cosmos.slashing.v1beta1.ValidatorSlashRecord")
 }
 
 func init() {
@@ -193,47 +279,58 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xbe, 0x01, 0x7e, 0xfc, 0x74, 0x0e, 0x13, 0x5d, 0x0f, 0x59, 0x2e, 0xba, 0x77, 0x50, 0x90,
-	0x0b, 0x09, 0xbb, 0x82, 0x1d, 0x56, 0x1e, 0xc4, 0xe0, 0x9f, 0x44, 0x72, 0xf8, 0x27, 0xb1, 0x70,
-	0x32, 0xbb, 0x33, 0xb7, 0x37, 0xb2, 0x3b, 0x73, 0xd9, 0x99, 0x15, 0x88, 0x8d, 0x8d, 0x8d, 0x15,
-	0xa5, 0xb1, 0xb2, 0xa4, 0xa4, 0xf0, 0x0b, 0x58, 0x98, 0x50, 0x12, 0x2b, 0x63, 0x81, 0xe6, 0x28,
-	0xf0, 0x63, 0x98, 0x9d, 0x99, 0x05, 0x02, 0x89, 0x15, 0xcd, 0xdd, 0xee, 0xf3, 0x3c, 0xef, 0xfb,
-	0xbc, 0xf3, 0xcc, 0x9b, 0x85, 0x33, 0x91, 0x90, 0xa9, 0x90, 0x81, 0x4c, 0xb0, 0xec, 0x31, 0x1e,
-	0x07, 0x6f, 0xe6, 0x43, 0xaa, 0xf0, 0xfc, 0x31, 0xe0, 0xf7, 0x33, 0xa1, 0x84, 0x33, 0x61, 0x74,
-	0xfe, 0x31, 0x6c, 0x75, 0xf5, 0x5a, 0x2c, 0x62, 0xa1, 0x35, 0x41, 0xf1, 0x64, 0xe4, 0x75, 0x2f,
-	0x16, 0x22, 0x4e, 0x68, 0xa0, 0xdf, 0xc2, 0xbc, 0x1b, 0x90, 0x3c, 0xc3, 0x8a, 0x09, 0x6e, 0xf9,
-	0xc6, 0x59, 0x5e, 0xb1, 0x94, 0x4a, 0x85, 0xd3, 0xbe, 0x15, 0x4c, 0x1a, 0x3f, 0x64, 0x3a, 0x5b,
-	0x73, 0x43, 0x5d, 0xc3, 0x29, 0xe3, 0x22, 0xd0, 0xbf, 0x06, 0x9a, 0xfe, 0x3a, 0x04, 0x6b, 0xcf,
-	0x71, 0xc2, 0x08, 0x56, 0x22, 0x5b, 0x63, 0x31, 0x67, 0x3c, 0x7e, 0xc0, 0xbb, 0xc2, 0xb9, 0x0b,
-	0xff, 0xc7, 0x84, 0x64, 0x54, 0x4a, 0x17, 0x34, 0x41, 0xeb, 0x72, 0x7b, 0xea, 0xfb, 0x97, 0xb9,
-	0x5b, 0xb6, 0xdd, 0x92, 0xe0, 0x92, 0x72, 0x99, 0xcb, 0x7b, 0x46, 0xb2, 0xa6, 0x32, 0xc6, 0xe3,
-	0x4e, 0x59, 0xe1, 0x4c, 0xc1, 0x31, 0xa9, 0x70, 0xa6, 0x50, 0x8f, 0xb2, 0xb8, 0xa7, 0xdc, 0xa1,
-	0x26, 0x68, 0x0d, 0x77, 0xaa, 0x1a, 0x5b, 0xd1, 0x50, 0x21, 0x61, 0x9c, 0xd0, 0x4d, 0x24, 0xba,
-	0x5d, 0x49, 0x95, 0x3b, 0x6c, 0x24, 0x1a, 0x7b, 0xa2, 0x21, 0xe7, 0x31, 0x1c, 0x7b, 0x8d, 0x59,
-	0x42, 0x09, 0xca, 0xb9, 0x62, 0x89, 0x3b, 0xd2, 0x04, 0xad, 0xea, 0x42, 0xdd, 0x37, 0x09, 0xf8,
-	0x65, 0x02, 0xfe, 0xd3, 0x32, 0x81, 0xf6, 0x95, 0xbd, 0x83, 0x46, 0x65, 0xfb, 0x57, 0x03, 0xec,
-	0x1c, 0xed, 0xce, 0x82, 0x4e, 0xd5, 0x94, 0x3f, 0x2b, 0xaa, 0x1d, 0x0f, 0x42, 0x25, 0xd2, 0x50,
-	0x2a, 0xc1, 0x29, 0x71, 0xff, 0x6b, 0x82, 0xd6, 0xa5, 0xce, 0x29, 0xc4, 0x59, 0x80, 0xe3, 0x29,
-	0x93, 0x92, 0x12, 0x14, 0x26, 0x22, 0x5a, 0x97, 0x28, 0x12, 0x39, 0x57, 0x34, 0x73, 0x47, 0xf5,
-	0x64, 0xd7, 0x0d, 0xd9, 0xd6, 0xdc, 0x92, 0xa1, 0x16, 0x47, 0xfe, 0x7c, 0x6e, 0x80, 0xe9, 0x6f,
-	0x23, 0x70, 0x74, 0x15, 0x67, 0x38, 0x95, 0xce, 0x6d, 0x58, 0x93, 0x2c, 0xe6, 0x27, 0x4d, 0x36,
-	0x18, 0x27, 0x62, 0x43, 0x47, 0x38, 0xdc, 0x71, 0x0c, 0x67, 0x7a, 0xbc, 0xd0, 0x8c, 0xf3, 0xb6,
-	0xb0, 0xe5, 0xc8, 0x56, 0xf5, 0x69, 0x56, 0x96, 0x14, 0x99, 0x8d, 0xb5, 0x57, 0x8a, 0x13, 0xfd,
-	0x3c, 0x68, 0xcc, 0xc4, 0x4c, 0xf5, 0xf2, 0xd0, 0x8f, 0x44, 0x6a, 0xef, 0xd4, 0xfe, 0xcd, 0x49,
-	0xb2, 0x1e, 0xa8, 0xad, 0x3e, 0x95, 0xfe, 0x32, 0x8d, 0x3e, 0x1d, 0xed, 0xce, 0x5e, 0xb5, 0x0b,
-	0x40, 0x68, 0x84, 0xc2, 0x2d, 0x45, 0xa5, 0x09, 0xc3, 0x49, 0x19, 0x5f, 0xd3, 0x2e, 0xab, 0x34,
-	0xb3, 0xe6, 0xaf, 0xe0, 0x0d, 0x22, 0x36, 0x78, 0xb1, 0x42, 0xa8, 0xc8, 0x0a, 0x95, 0xcb, 0xa6,
-	0xaf, 0xa3, 0xba, 0x30, 0x79, 0x2e, 0xeb, 0x65, 0x2b, 0x30, 0x51, 0x7f, 0x3c, 0x8e, 0xba, 0x56,
-	0xf6, 0x79, 0x88, 0x59, 0x52, 0x8a, 0x9c, 0xf7, 0x00, 0xd6, 0xf5, 0xde, 0xa3, 0x6e, 0x86, 0xa3,
-	0x02, 0x42, 0x44, 0xe4, 0x61, 0x42, 0xf5, 0x79, 0xf5, 0x85, 0x5e, 0xe4, 0x11, 0x27, 0xb4, 0xd7,
-	0x7d, 0x6b, 0xb5, 0xac, 0x9d, 0x8a, 0x23, 0x3b, 0xef, 0x00, 0x9c, 0x38, 0x37, 0x87, 0x99, 0x57,
-	0x6f, 0xc2, 0x45, 0x0e, 0x31, 0x7e, 0x66, 0x08, 0x63, 0xb3, 0x38, 0xf5, 0xe1, 0x68, 0x77, 0xf6,
-	0xe6, 0xa9, 0x5e, 0x9b, 0x27, 0x5f, 0x0e, 0xb3, 0x3c, 0xed, 0x47, 0x3b, 0x03, 0x0f, 0xec, 0x0d,
-	0x3c, 0xb0, 0x3f, 0xf0, 0xc0, 0xef, 0x81, 0x07, 0xb6, 0x0f, 0xbd, 0xca, 0xfe, 0xa1, 0x57, 0xf9,
-	0x71, 0xe8, 0x55, 0x5e, 0xce, 0xfd, 0x73, 0xb2, 0x53, 0xdd, 0xf4, 0x90, 0xe1, 0xa8, 0xbe, 0xb2,
-	0x3b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x8c, 0xa5, 0x44, 0xa7, 0x04, 0x00, 0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xf6, 0xe6, 0x2e, 0x17, 0x6e, 0xec, 0xa0, 0x64, 0xf0, 0xe5, 0x1c, 0x0b, 0xd6, 0x3e, 0x0b,
+	0x45, 0xd6, 0x21, 0xef, 0x26, 0xa6, 0x41, 0xa1, 0xba, 0x8d, 0x85, 0x62, 0x40, 0x22, 0xda, 0x23,
+	0x20, 0x51, 0xb0, 0x9a, 0xdd, 0x19, 0xaf, 0x07, 0xef, 0xce, 0x58, 0x33, 0xb3, 0x71, 0x22, 0x1a,
+	0x1a, 0x1a, 0xaa, 0x94, 0x88, 0x8a, 0x32, 0xe5, 0x15, 0xf9, 0x17, 0x90, 0x52, 0x46, 0xa9, 0x50,
+	0x8a, 0x80, 0x7c, 0xc5, 0xf1, 0x3f, 0xd0, 0xa0, 0x9d, 0x99, 0xb5, 0x9d, 0x44, 0x42, 0x8a, 0x94,
+	0xe6, 0xce, 0xfb, 0xbe, 0xef, 0xbd, 0xef, 0xfd, 0x1c, 0x70, 0x2d, 0xe1, 0x32, 0xe7, 0xd2, 0x97,
+	0x19, 0x92, 0x53, 0xca, 0x52, 0xff, 0xde, 0x8d, 0x98, 0x28, 0x74, 0x63, 0x65, 0xf0, 0xe6, 0x82,
+	0x2b, 0x0e, 0xf7, 0x0d, 0xcf, 0x5b, 0x99, 0x2d, 0xaf, 0xdd, 0x4c, 0x79, 0xca, 0x35, 0xc7, 0x2f,
+	0x7f, 0x19, 0x7a, 0xdb, 0x4d, 0x39, 0x4f, 0x33, 0xe2, 0xeb, 0xaf, 0xb8, 0x98, 0xf8, 0xb8, 0x10,
+	0x48, 0x51, 0xce, 0x2c, 0xde, 0x79, 0x15, 0x57, 0x34, 0x27, 0x52, 0xa1, 0x7c, 0x6e, 0x09, 0x57,
+	0x8d, 0x5e, 0x64, 0x22, 0x5b, 0x71, 0x03, 0x5d, 0x46, 0x39, 0x65, 0xdc, 0xd7, 0x7f, 0xad, 0xe9,
+	0xc3, 0xaa, 0x0a, 0x85, 0x66, 0x2f, 0x15, 0x61, 0xbe, 0x0d, 0xab, 0xf7, 0xef, 0x39, 0xd0, 0xfc,
+	0x06, 0x65, 0x14, 0x23, 0xc5, 0xc5, 0x31, 0x4d, 0x19, 0x65, 0xe9, 0x98, 0x4d, 0x38, 0xfc, 0x14,
+	0x5c, 0x40, 0x18, 0x0b, 0x22, 0x65, 0xcb, 0xe9, 0x3a, 0xfd, 0xdd, 0xe0, 0xe0, 0xd9, 0xe3, 0xc1,
+	0x07, 0x56, 0xf4, 0x16, 0x67, 0x92, 0x30, 0x59, 0xc8, 0x23, 0x43, 0x39, 0x56, 0x82, 0xb2, 0x34,
+	0xac, 0x3c, 0xe0, 0x01, 0x68, 0x48, 0x85, 0x84, 0x8a, 0xa6, 0x84, 0xa6, 0x53, 0xd5, 0x3a, 0xd7,
+	0x75, 0xfa, 0x5b, 0x61, 0x5d, 0xdb, 0x6e, 0x6b, 0x53, 0x49, 0xa1, 0x0c, 0x93, 0xfb, 0x11, 0x9f,
+	0x4c, 0x24, 0x51, 0xad, 0x2d, 0x43, 0xd1, 0xb6, 0xaf, 0xb4, 0x09, 0x7e, 0x09, 0x1a, 0x3f, 0x20,
+	0x9a, 0x11, 0x1c, 0x15, 0x4c, 0xd1, 0xac, 0xb5, 0xdd, 0x75, 0xfa, 0xf5, 0x61, 0xdb, 0x33, 0x7d,
+	0xf2, 0xaa, 0x3e, 0x79, 0x5f, 0x57, 0x7d, 0x0a, 0x2e, 0x3e, 0x79, 0xd1, 0xa9, 0x3d, 0xfc, 0xab,
+	0xe3, 0x3c, 0x3a, 0x3b, 0x39, 0x74, 0xc2, 0xba, 0x71, 0xbf, 0x5b, 0x7a, 0x43, 0x17, 0x00, 0xc5,
+	0xf3, 0x58, 0x2a, 0xce, 0x08, 0x6e, 0x9d, 0xef, 0x3a, 0xfd, 0x77, 0xc2, 0x0d, 0x0b, 0x1c, 0x82,
+	0xbd, 0x9c, 0x4a, 0x49, 0x70, 0x14, 0x67, 0x3c, 0x99, 0xc9, 0x28, 0xe1, 0x05, 0x53, 0x44, 0xb4,
+	0x76, 0x74, 0x66, 0xef, 0x19, 0x30, 0xd0, 0xd8, 0x2d, 0x03, 0xc1, 0x4f, 0x40, 0x6b, 0x15, 0x21,
+	0x12, 0x24, 0xe7, 0xf7, 0x08, 0xae, 0x6a, 0xbe, 0xa0, 0xdd, 0xae, 0xac, 0xf0, 0xd0, 0xc0, 0xa6,
+	0xfc, 0x9b, 0xdb, 0xff, 0xfc, 0xde, 0x71, 0x7a, 0x7f, 0x6c, 0x83, 0x9d, 0x3b, 0x48, 0xa0, 0x5c,
+	0xc2, 0xeb, 0xa0, 0x29, 0x69, 0xca, 0xd6, 0xf2, 0x0b, 0xca, 0x30, 0x5f, 0xe8, 0xe6, 0x6f, 0x85,
+	0xd0, 0x60, 0x46, 0xfd, 0x5b, 0x8d, 0xc0, 0x1f, 0xcb, 0x84, 0x59, 0x64, 0xbd, 0xe6, 0x44, 0x54,
+	0x2e, 0x65, 0xb7, 0x1b, 0xc1, 0xed, 0xb2, 0x17, 0xcf, 0x5f, 0x74, 0xae, 0xa5, 0x54, 0x4d, 0x8b,
+	0xd8, 0x4b, 0x78, 0x6e, 0x77, 0xc6, 0xfe, 0x1b, 0x48, 0x3c, 0xf3, 0xd5, 0x83, 0x39, 0x91, 0xde,
+	0x88, 0x24, 0xbf, 0x9d, 0x9d, 0x1c, 0x5e, 0xb2, 0x0b, 0x86, 0x49, 0x12, 0xc5, 0x0f, 0x14, 0x91,
+	0xa6, 0x8d, 0x30, 0xa7, 0xec, 0x58, 0xab, 0xdc, 0x21, 0xc2, 0x8a, 0x7f, 0x0f, 0xae, 0x60, 0xbe,
+	0x60, 0xe5, 0x8a, 0x46, 0x65, 0x97, 0xa3, 0x6a, 0x99, 0xf5, 0x20, 0xeb, 0xc3, 0xab, 0xaf, 0x4d,
+	0x69, 0x64, 0x09, 0x66, 0x48, 0xbf, 0xae, 0x86, 0xd4, 0xac, 0xe2, 0x7c, 0x8e, 0x68, 0x56, 0x91,
+	0xe0, 0xcf, 0x0e, 0x68, 0xeb, 0xbb, 0x8a, 0x26, 0x02, 0x25, 0xa5, 0x29, 0xc2, 0xbc, 0x88, 0x33,
+	0xa2, 0xeb, 0xd5, 0xab, 0xf0, 0x36, 0x4b, 0xdc, 0xd7, 0x5a, 0x9f, 0x59, 0xa9, 0x91, 0x56, 0x2a,
+	0x4b, 0x86, 0x3f, 0x39, 0x60, 0xff, 0xb5, 0x3c, 0x4c, 0xbe, 0x7a, 0x87, 0xde, 0x66, 0x12, 0x7b,
+	0xaf, 0x24, 0x61, 0x64, 0x6e, 0x1e, 0xfc, 0x72, 0x76, 0x72, 0xf8, 0xfe, 0x46, 0xac, 0xfb, 0xeb,
+	0x97, 0xc9, 0x2c, 0x4f, 0xef, 0xf9, 0x4b, 0x57, 0x5c, 0x82, 0x21, 0x49, 0xb8, 0xc0, 0xf0, 0x23,
+	0x70, 0x99, 0xb2, 0x55, 0xe6, 0x76, 0x33, 0xcd, 0x4a, 0x5d, 0x5a, 0x03, 0xf6, 0x24, 0x9b, 0xe0,
+	0xfc, 0x9c, 0x2f, 0x88, 0xb0, 0xe7, 0x6a, 0x3e, 0x20, 0x06, 0x0d, 0xdb, 0x00, 0x94, 0x28, 0x2e,
+	0xf4, 0x7c, 0x77, 0x83, 0xa3, 0x37, 0xab, 0xfa, 0xd9, 0xe3, 0x01, 0xb0, 0x6f, 0xc7, 0x88, 0x24,
+	0xf6, 0x3a, 0x4d, 0xb9, 0x3a, 0x2a, 0xbc, 0x0b, 0x2e, 0xc6, 0x85, 0x28, 0x17, 0x19, 0xe5, 0xe5,
+	0x71, 0xe9, 0x09, 0xef, 0x06, 0xd7, 0xad, 0xcc, 0x9e, 0x71, 0x96, 0x78, 0xe6, 0x51, 0xee, 0xe7,
+	0x48, 0x4d, 0xbd, 0x31, 0x53, 0x1b, 0x51, 0xc7, 0x4c, 0x99, 0xa8, 0x0d, 0x13, 0xe6, 0x48, 0x47,
+	0x81, 0x01, 0x00, 0xeb, 0x32, 0xf5, 0xc0, 0xde, 0x1d, 0xf6, 0xbc, 0xea, 0xdd, 0xb6, 0x2f, 0xa1,
+	0x7d, 0x19, 0xbd, 0xf1, 0x8a, 0x19, 0x6e, 0x78, 0x05, 0x5f, 0x3c, 0x5a, 0xba, 0xce, 0x93, 0xa5,
+	0xeb, 0x3c, 0x5d, 0xba, 0xce, 0xdf, 0x4b, 0xd7, 0x79, 0x78, 0xea, 0xd6, 0x9e, 0x9e, 0xba, 0xb5,
+	0x3f, 0x4f, 0xdd, 0xda, 0x77, 0x83, 0xff, 0x6d, 0xc0, 0xc6, 0xa8, 0x74, 0x2f, 0xe2, 0x1d, 0x7d,
+	0x0f, 0x1f, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x0e, 0x1a, 0xb4, 0x64, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -273,6 +370,9 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.TombstoneRemovedHeight != that1.TombstoneRemovedHeight {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -311,6 +411,42 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ValidatorSlashRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorSlashRecord)
+	if !ok {
+		that2, ok := that.(ValidatorSlashRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if !this.SlashFactor.Equal(that1.SlashFactor) {
+		return false
+	}
+	if !this.BurnedAmount.Equal(that1.BurnedAmount) {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	return true
+}
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -331,6 +467,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TombstoneRemovedHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.TombstoneRemovedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -440,6 +581,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.BurnedAmount.Size()
+		i -= size
+		if _, err := m.BurnedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SlashFactor.Size()
+		i -= size
+		if _, err := m.SlashFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Power != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -475,6 +674,9 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.TombstoneRemovedHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.TombstoneRemovedHeight))
+	}
 	return n
 }
 
@@ -498,6 +700,28 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorSlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InfractionHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHeight))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	l = m.SlashFactor.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	return n
+}
+
 func sovSlashing(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -675,6 +899,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstoneRemovedHeight", wireType)
+			}
+			m.TombstoneRemovedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TombstoneRemovedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -897,6 +1140,181 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorSlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSlashing(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -205,46 +205,151 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRemoveTombstone is the Msg/RemoveTombstone request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgRemoveTombstone struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// cons_address is the consensus address of the tombstoned validator.
+	ConsAddress string `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *MsgRemoveTombstone) Reset()         { *m = MsgRemoveTombstone{} }
+func (m *MsgRemoveTombstone) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTombstone) ProtoMessage()    {}
+func (*MsgRemoveTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgRemoveTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTombstone.Merge(m, src)
+}
+func (m *MsgRemoveTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveTombstone proto.InternalMessageInfo
+
+func (m *MsgRemoveTombstone) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveTombstone) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// MsgRemoveTombstoneResponse defines the response structure for executing a
+// MsgRemoveTombstone message.
+//
+// Since: cosmos-sdk 0.48
+type MsgRemoveTombstoneResponse struct {
+}
+
+func (m *MsgRemoveTombstoneResponse) Reset()         { *m = MsgRemoveTombstoneResponse{} }
+func (m *MsgRemoveTombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTombstoneResponse) ProtoMessage()    {}
+func (*MsgRemoveTombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgRemoveTombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveTombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTombstoneResponse.Merge(m, src)
+}
+func (m *MsgRemoveTombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTombstoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveTombstoneResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRemoveTombstone)(nil), "cosmos.slashing.v1beta1.MsgRemoveTombstone")
+	proto.RegisterType((*MsgRemoveTombstoneResponse)(nil), "cosmos.slashing.v1beta1.MsgRemoveTombstoneResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xc7, 0x19, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xb2, 0x00, 0x79, 0x09, 0x61, 0x26, 0xc8, 0x37, 0xaa,
-	0x48, 0xbe, 0xa9, 0x40, 0x44, 0x29, 0x9a, 0xab, 0x95, 0x24, 0xb9, 0xc4, 0xd1, 0x84, 0x60, 0x9e,
-	0x34, 0xba, 0xc4, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc1, 0xc5, 0x06, 0x8d, 0x23, 0x25,
-	0x9c, 0x4e, 0x83, 0x07, 0x91, 0x94, 0x16, 0x61, 0x35, 0x30, 0x1b, 0x84, 0xb2, 0xb8, 0x78, 0x50,
-	0x82, 0x50, 0x03, 0xaf, 0x5e, 0x24, 0x95, 0x52, 0x06, 0xc4, 0xaa, 0x84, 0xd9, 0x25, 0xc5, 0xda,
-	0x00, 0x0a, 0x31, 0x27, 0xef, 0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x9a,
-	0xae, 0xf5, 0xb1, 0x07, 0x63, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x21, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x3c, 0x10, 0x85, 0x8a, 0x03, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x15, 0x23, 0x99, 0x56, 0x4b, 0xd7, 0x42, 0xd3, 0xc5, 0x6e, 0xe2, 0x82, 0x12,
+	0x22, 0xd9, 0x4d, 0x5b, 0x10, 0x09, 0x78, 0x30, 0x7a, 0x93, 0x80, 0xc4, 0x1f, 0x88, 0x97, 0x30,
+	0xc9, 0x2e, 0x9b, 0xad, 0xd9, 0x9d, 0x65, 0xdf, 0x24, 0xb4, 0x37, 0xf1, 0x24, 0x9e, 0xfc, 0x13,
+	0x44, 0x2f, 0x3d, 0xe6, 0xd0, 0x3f, 0x41, 0x68, 0x8f, 0xa5, 0x27, 0x4f, 0x45, 0x92, 0x43, 0xc0,
+	0xbf, 0x42, 0x66, 0x67, 0x76, 0x93, 0x6c, 0x4d, 0xfd, 0x71, 0x49, 0x32, 0x6f, 0x3e, 0xef, 0x7d,
+	0xdf, 0x37, 0xef, 0x31, 0xb8, 0xd8, 0xa1, 0xe0, 0x51, 0x30, 0xa1, 0x47, 0xa0, 0xeb, 0xfa, 0x8e,
+	0x39, 0xd8, 0x6e, 0xdb, 0x8c, 0x6c, 0x9b, 0x6c, 0xdf, 0x08, 0x42, 0xca, 0xa8, 0xb2, 0x21, 0x08,
+	0x23, 0x26, 0x0c, 0x49, 0xa8, 0xeb, 0x0e, 0x75, 0x68, 0xc4, 0x98, 0xfc, 0x97, 0xc0, 0xd5, 0xbb,
+	0x8b, 0x0a, 0x26, 0xf9, 0x82, 0xdb, 0x14, 0x5c, 0x4b, 0x14, 0x90, 0x1a, 0xe2, 0x4a, 0x2a, 0x9a,
+	0x1e, 0xf0, 0x6c, 0xfe, 0x25, 0x2f, 0xd6, 0x88, 0xe7, 0xfa, 0xd4, 0x8c, 0x3e, 0x45, 0x48, 0xff,
+	0x8a, 0x70, 0xae, 0x01, 0xce, 0x4b, 0x7f, 0x8f, 0xb8, 0x3d, 0xc5, 0xc2, 0x37, 0x06, 0xa4, 0xe7,
+	0x5a, 0x84, 0xd1, 0xb0, 0x45, 0x2c, 0x2b, 0xcc, 0xa3, 0x22, 0x2a, 0xe5, 0xea, 0x0f, 0x7f, 0x9e,
+	0x17, 0xae, 0xf1, 0xb3, 0x0d, 0x70, 0x76, 0x54, 0xd9, 0x92, 0x72, 0xaf, 0x62, 0xf6, 0x91, 0xb8,
+	0x7a, 0xce, 0x42, 0xd7, 0x77, 0xbe, 0x4c, 0x86, 0xe5, 0x18, 0x3e, 0x9c, 0x0c, 0xcb, 0xa8, 0x79,
+	0x7d, 0x30, 0x0b, 0xd6, 0xaa, 0x1f, 0x3e, 0x17, 0x32, 0xef, 0x27, 0xc3, 0x72, 0x4a, 0xec, 0xe3,
+	0x64, 0x58, 0x5e, 0x17, 0xa5, 0x2b, 0x60, 0xbd, 0x35, 0x93, 0xbe, 0xf4, 0x9b, 0x78, 0x2d, 0x39,
+	0x34, 0x6d, 0x08, 0xa8, 0x0f, 0xb6, 0x7e, 0x8c, 0xf0, 0x2a, 0x8f, 0x06, 0x16, 0x61, 0xf6, 0x33,
+	0x12, 0x12, 0x0f, 0x94, 0xfb, 0x38, 0x47, 0xfa, 0xac, 0x4b, 0x43, 0x97, 0x1d, 0xc8, 0xde, 0xf3,
+	0x67, 0x47, 0x15, 0x59, 0xd5, 0x98, 0xeb, 0xb3, 0x39, 0x45, 0x95, 0x3a, 0xce, 0x06, 0x51, 0x85,
+	0xfc, 0x52, 0x11, 0x95, 0x96, 0x77, 0x0a, 0xc6, 0x82, 0xa9, 0x19, 0x42, 0xa8, 0x9e, 0x3b, 0x39,
+	0x2f, 0x64, 0x84, 0x3b, 0x99, 0x59, 0x7b, 0xc0, 0x2d, 0x4d, 0x6b, 0x72, 0x37, 0x77, 0x66, 0xdc,
+	0xec, 0x4f, 0x47, 0x9a, 0xea, 0x5a, 0xdf, 0xc4, 0x1b, 0xa9, 0x50, 0x62, 0xf2, 0x1b, 0xc2, 0x4a,
+	0x03, 0x9c, 0xa6, 0xed, 0xd1, 0x81, 0xfd, 0x82, 0x7a, 0x6d, 0x60, 0xd4, 0xb7, 0xff, 0xdb, 0xe7,
+	0x13, 0xbc, 0xd2, 0xa1, 0x3e, 0xb4, 0xe4, 0x78, 0x22, 0xb7, 0xb9, 0xfa, 0xed, 0x99, 0x99, 0x3e,
+	0xe6, 0xb2, 0x3e, 0xf4, 0x61, 0xbe, 0xc6, 0x32, 0x4f, 0x93, 0xa1, 0x5a, 0xf5, 0xa2, 0xd3, 0xad,
+	0xf9, 0xb9, 0xa5, 0xfa, 0xd5, 0x6f, 0x61, 0xf5, 0x62, 0x34, 0x36, 0xb9, 0x73, 0xbc, 0x84, 0xaf,
+	0x34, 0xc0, 0x51, 0x5e, 0xe3, 0xac, 0x5c, 0x44, 0x7d, 0xe1, 0xff, 0x9f, 0xec, 0x81, 0x5a, 0xfe,
+	0x33, 0x13, 0x2b, 0x28, 0x7b, 0x78, 0x65, 0x6e, 0x4f, 0x4a, 0x97, 0xe6, 0xce, 0x90, 0x6a, 0xf5,
+	0x6f, 0xc9, 0x44, 0x0b, 0xf0, 0x6a, 0x7a, 0x5c, 0xf7, 0x2e, 0x2b, 0x92, 0x82, 0xd5, 0xdd, 0x7f,
+	0x80, 0x63, 0x51, 0xf5, 0xea, 0x3b, 0xbe, 0x8b, 0xf5, 0xa7, 0x87, 0x23, 0x0d, 0x9d, 0x8c, 0x34,
+	0x74, 0x3a, 0xd2, 0xd0, 0x8f, 0x91, 0x86, 0x3e, 0x8d, 0xb5, 0xcc, 0xe9, 0x58, 0xcb, 0x7c, 0x1f,
+	0x6b, 0x99, 0x37, 0x15, 0xc7, 0x65, 0xdd, 0x7e, 0xdb, 0xe8, 0x50, 0x4f, 0xbe, 0x18, 0xe6, 0xef,
+	0x17, 0x94, 0x1d, 0x04, 0x36, 0xb4, 0xb3, 0xd1, 0x13, 0xb1, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff,
+	0x7a, 0x64, 0x49, 0x34, 0xe4, 0x04, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -340,6 +445,54 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgRemoveTombstone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRemoveTombstone)
+	if !ok {
+		that2, ok := that.(MsgRemoveTombstone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	return true
+}
+func (this *MsgRemoveTombstoneResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRemoveTombstoneResponse)
+	if !ok {
+		that2, ok := that.(MsgRemoveTombstoneResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -362,6 +515,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RemoveTombstone defines a governance operation for removing the tombstone
+	// of a validator, e.g. when its double-sign was provably caused by a bug. The
+	// slashed tokens are not refunded. The authority defaults to the x/gov module
+	// account.
+	//
+	// Since: cosmos-sdk 0.48
+	RemoveTombstone(ctx context.Context, in *MsgRemoveTombstone, opts ...grpc.CallOption) (*MsgRemoveTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -390,6 +550,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RemoveTombstone(ctx context.Context, in *MsgRemoveTombstone, opts ...grpc.CallOption) (*MsgRemoveTombstoneResponse, error) {
+	out := new(MsgRemoveTombstoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/RemoveTombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -401,6 +570,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RemoveTombstone defines a governance operation for removing the tombstone
+	// of a validator, e.g. when its double-sign was provably caused by a bug. The
+	// slashed tokens are not refunded. The authority defaults to the x/gov module
+	// account.
+	//
+	// Since: cosmos-sdk 0.48
+	RemoveTombstone(context.Context, *MsgRemoveTombstone) (*MsgRemoveTombstoneResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -413,6 +589,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RemoveTombstone(ctx context.Context, req *MsgRemoveTombstone) (*MsgRemoveTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTombstone not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -454,6 +633,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/RemoveTombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTombstone(ctx, req.(*MsgRemoveTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -466,6 +663,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RemoveTombstone",
+			Handler:    _Msg_RemoveTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -587,6 +788,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRemoveTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -644,6 +905,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRemoveTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -947,6 +1234,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRemoveTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveTombstoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveTombstoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveTombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
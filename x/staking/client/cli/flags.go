@@ -27,11 +27,14 @@ const (
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 
 	FlagMinSelfDelegation = "min-self-delegation"
+	FlagMinDelegation     = "min-delegation"
 
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
 	FlagP2PPort       = "p2p-port"
+
+	FlagJailInfo = "jail-info"
 )
 
 // common flagsets to add to various functions
@@ -67,6 +70,13 @@ func FlagSetMinSelfDelegation() *flag.FlagSet {
 	return fs
 }
 
+// FlagSetMinDelegation Returns the FlagSet used for minimum delegation.
+func FlagSetMinDelegation() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMinDelegation, "", "The minimum amount of a new delegation to the validator, 0 to remove it")
+	return fs
+}
+
 // FlagSetAmount Returns the FlagSet for amount related operations.
 func FlagSetAmount() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
@@ -37,8 +37,12 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
+		GetCmdQueryValidatorFlows(),
+		GetCmdQueryValidatorLiquidShares(),
+		GetCmdQueryValidatorJailInfo(),
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
+		GetCmdQueryBondDenom(),
 		GetCmdQueryPool(),
 	)
 
@@ -69,7 +73,7 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			addr, err := sdk.ValAddressFromBech32(args[0])
+			addr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -89,6 +93,142 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorFlows implements the query of the unbonding and
+// redelegating balances of a validator.
+func GetCmdQueryValidatorFlows() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-flows [validator-addr]",
+		Short: "Query the stake scheduled to leave a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total balance of the unbonding delegations and outbound redelegations of a validator.
+
+Example:
+$ %s query staking validator-flows %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := client.ReadValAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorFlowsRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorFlows(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Flows)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorLiquidShares implements the query of the delegator
+// shares of a validator held by liquid staking accounts.
+func GetCmdQueryValidatorLiquidShares() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-liquid-shares [validator-addr]",
+		Short: "Query the delegator shares of a validator held by module and contract accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegator shares of a validator held by module and contract accounts, and their tokens.
+
+Example:
+$ %s query staking validator-liquid-shares %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := client.ReadValAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorLiquidSharesRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorLiquidShares(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorJailInfo implements the query of the jail info of a
+// validator.
+func GetCmdQueryValidatorJailInfo() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-jail-info [validator-addr]",
+		Short: "Query why and when a validator was jailed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reason, height and time a validator was jailed at, and the jail info
+archived when it was last unjailed.
+
+Example:
+$ %s query staking validator-jail-info %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := client.ReadValAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorJailInfoRequest{ValidatorAddr: addr.String()}
+			res, err := queryClient.ValidatorJailInfo(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators() *cobra.Command {
 	cmd := &cobra.Command{
@@ -115,9 +255,15 @@ $ %s query staking validators
 				return err
 			}
 
+			jailInfo, err := cmd.Flags().GetBool(FlagJailInfo)
+			if err != nil {
+				return err
+			}
+
 			result, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
 				// Leaving status empty on purpose to query all validators.
-				Pagination: pageReq,
+				Pagination:      pageReq,
+				IncludeJailInfo: jailInfo,
 			})
 			if err != nil {
 				return err
@@ -127,6 +273,7 @@ $ %s query staking validators
 		},
 	}
 
+	cmd.Flags().Bool(FlagJailInfo, false, "Include the jail info of the jailed validators")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "validators")
 
@@ -157,7 +304,7 @@ $ %s query staking unbonding-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9l
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -212,7 +359,7 @@ $ %s query staking redelegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			valSrcAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -263,7 +410,7 @@ func GetCmdQueryDelegation(ac address.Codec) *cobra.Command {
 				return err
 			}
 
-			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			valAddr, err := client.ReadValAddress(cmd, args[1])
 			if err != nil {
 				return err
 			}
@@ -367,7 +514,7 @@ $ %s query staking delegations-to %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := client.ReadValAddress(cmd, args[0])
 			if err != nil {
 				return err
 			}
@@ -423,7 +570,7 @@ $ %s query staking unbonding-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			valAddr, err := client.ReadValAddress(cmd, args[1])
 			if err != nil {
 				return err
 			}
@@ -538,12 +685,12 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1l2r
 				return err
 			}
 
-			valSrcAddr, err := sdk.ValAddressFromBech32(args[1])
+			valSrcAddr, err := client.ReadValAddress(cmd, args[1])
 			if err != nil {
 				return err
 			}
 
-			valDstAddr, err := sdk.ValAddressFromBech32(args[2])
+			valDstAddr, err := client.ReadValAddress(cmd, args[2])
 			if err != nil {
 				return err
 			}
@@ -736,3 +883,40 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryBondDenom implements the bond denom query command.
+func GetCmdQueryBondDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bond-denom",
+		Args:  cobra.NoArgs,
+		Short: "Query the denom of the coins staked to validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the denom of the coins staked to validators. Delegations, redelegations
+and undelegations of coins of another denom are rejected.
+
+Example:
+$ %s query staking bond-denom
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BondDenom(cmd.Context(), &types.QueryBondDenomRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package staking_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/testutil/conformance"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGenesisConformance(t *testing.T) {
+	conformance.RunGenesisConformance(t, conformance.GenesisConfig{
+		ModuleName:  types.ModuleName,
+		Setup:       conformance.AppConfigGenesisSetup(testutil.AppConfig, types.ModuleName),
+		GenesisType: &types.GenesisState{},
+		Normalize: func(genesis proto.Message) {
+			// exported genesis states are flagged as such
+			genesis.(*types.GenesisState).Exported = true
+		},
+	})
+}
@@ -45,6 +45,10 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateJailInfos(data.Validators, data.JailInfos, data.LastJailInfos); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -85,3 +89,38 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateJailInfos(validators []types.Validator, jailInfos, lastJailInfos []types.ValidatorJailInfo) error {
+	jailed := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		jailed[val.OperatorAddress] = val.Jailed
+	}
+
+	seen := make(map[string]bool, len(jailInfos))
+	for _, info := range jailInfos {
+		isJailed, found := jailed[info.ValidatorAddress]
+		if !found {
+			return fmt.Errorf("jail info of unknown validator %s in genesis state", info.ValidatorAddress)
+		}
+		if !isJailed {
+			return fmt.Errorf("jail info of validator %s which is not jailed in genesis state", info.ValidatorAddress)
+		}
+		if seen[info.ValidatorAddress] {
+			return fmt.Errorf("duplicate jail info of validator %s in genesis state", info.ValidatorAddress)
+		}
+		seen[info.ValidatorAddress] = true
+	}
+
+	seen = make(map[string]bool, len(lastJailInfos))
+	for _, info := range lastJailInfos {
+		if _, found := jailed[info.ValidatorAddress]; !found {
+			return fmt.Errorf("last jail info of unknown validator %s in genesis state", info.ValidatorAddress)
+		}
+		if seen[info.ValidatorAddress] {
+			return fmt.Errorf("duplicate last jail info of validator %s in genesis state", info.ValidatorAddress)
+		}
+		seen[info.ValidatorAddress] = true
+	}
+
+	return nil
+}
@@ -40,6 +40,36 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		{"nil consensus pubkey", func(data *types.GenesisState) {
+			data.Validators = genValidators1
+			data.Validators[0].ConsensusPubkey = nil
+		}, true},
+		// validate jail infos
+		{"jail info of jailed validator", func(data *types.GenesisState) {
+			data.Validators = genValidators1
+			data.Validators[0].Jailed = true
+			data.JailInfos = []types.ValidatorJailInfo{{ValidatorAddress: genValidators1[0].OperatorAddress}}
+			data.LastJailInfos = []types.ValidatorJailInfo{{ValidatorAddress: genValidators1[0].OperatorAddress}}
+		}, false},
+		{"jail info of validator not jailed", func(data *types.GenesisState) {
+			data.Validators = genValidators1
+			data.JailInfos = []types.ValidatorJailInfo{{ValidatorAddress: genValidators1[0].OperatorAddress}}
+		}, true},
+		{"jail info of unknown validator", func(data *types.GenesisState) {
+			data.JailInfos = []types.ValidatorJailInfo{{ValidatorAddress: genValidators1[0].OperatorAddress}}
+		}, true},
+		{"duplicate jail info", func(data *types.GenesisState) {
+			data.Validators = genValidators1
+			data.Validators[0].Jailed = true
+			data.JailInfos = []types.ValidatorJailInfo{{ValidatorAddress: genValidators1[0].OperatorAddress}, {ValidatorAddress: genValidators1[0].OperatorAddress}}
+		}, true},
+		{"last jail info of validator not jailed", func(data *types.GenesisState) {
+			data.Validators = genValidators1
+			data.LastJailInfos = []types.ValidatorJailInfo{{ValidatorAddress: genValidators1[0].OperatorAddress}}
+		}, false},
+		{"last jail info of unknown validator", func(data *types.GenesisState) {
+			data.LastJailInfos = []types.ValidatorJailInfo{{ValidatorAddress: genValidators1[0].OperatorAddress}}
+		}, true},
 	}
 
 	for _, tt := range tests {
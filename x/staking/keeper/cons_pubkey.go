@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. The old
+// consensus address keeps resolving to the validator for an unbonding period
+// so that infractions committed with the old consensus pubkey are still
+// attributed to it. The validator set updates replacing the old consensus
+// pubkey by the new one are returned to CometBFT in the EndBlock of the
+// current block. The rotation fee is recorded, and charged to the operator by
+// the MsgRotateConsPubKey handler.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	valAddr := validator.GetOperator()
+	height := ctx.BlockHeight()
+	if _, found := k.GetConsPubKeyRotationHistory(ctx, height, valAddr); found {
+		return types.ErrConsPubKeyRotatedInBlock
+	}
+
+	params := k.GetParams(ctx)
+	if rotations := k.countConsPubKeyRotations(ctx, valAddr, params); rotations >= params.MaxConsPubkeyRotations {
+		return errorsmod.Wrapf(
+			types.ErrExceedingMaxConsPubKeyRotations,
+			"%d rotations within unbonding period, maximum is %d", rotations, params.MaxConsPubkeyRotations,
+		)
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	fee := params.KeyRotationFee
+	if fee.IsNil() {
+		fee = sdk.NewCoin(params.BondDenom, math.ZeroInt())
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(valAddr, oldPubKey, newPubKey, height, ctx.BlockTime(), fee)
+	if err != nil {
+		return err
+	}
+
+	validator.ConsensusPubkey, err = codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	// the index of the old consensus address is kept until the rotation matures
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	k.SetConsPubKeyRotationHistory(ctx, history)
+	k.setOldToNewConsAddr(ctx, sdk.GetConsAddress(oldPubKey), newConsAddr)
+
+	return k.Hooks().AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey, fee)
+}
+
+// ValidatorIdentifier returns the current consensus address of the validator
+// which used the given consensus address, following the consensus pubkey
+// rotations of the last unbonding period. The given consensus address is
+// returned when it wasn't rotated.
+func (k Keeper) ValidatorIdentifier(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ConsAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	for {
+		newConsAddr := store.Get(types.GetOldToNewConsAddrKey(consAddr))
+		if newConsAddr == nil {
+			return consAddr
+		}
+
+		consAddr = newConsAddr
+	}
+}
+
+// GetConsPubKeyRotationHistory returns the consensus pubkey rotation of a
+// validator at the given height.
+func (k Keeper) GetConsPubKeyRotationHistory(ctx sdk.Context, height int64, valAddr sdk.ValAddress) (history types.ConsPubKeyRotationHistory, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetConsPubKeyRotationHistoryKey(height, valAddr))
+	if value == nil {
+		return history, false
+	}
+
+	return types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, value), true
+}
+
+// SetConsPubKeyRotationHistory sets a consensus pubkey rotation and its
+// validator index.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := history.GetOperator()
+
+	store.Set(types.GetConsPubKeyRotationHistoryKey(history.Height, valAddr), k.cdc.MustMarshal(&history))
+	store.Set(types.GetConsPubKeyRotationByValIndexKey(valAddr, history.Height), []byte{})
+}
+
+// GetValidatorConsPubKeyRotations returns the consensus pubkey rotations of a
+// validator of the last unbonding period.
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetConsPubKeyRotationsByValIndexKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		height := int64(sdk.BigEndianToUint64(iterator.Key()[len(types.GetConsPubKeyRotationsByValIndexKey(valAddr)):]))
+		history, found := k.GetConsPubKeyRotationHistory(ctx, height, valAddr)
+		if !found {
+			panic("consensus pubkey rotation index without rotation")
+		}
+
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// IterateConsPubKeyRotationHistory iterates through the consensus pubkey
+// rotations of the last unbonding period, ordered by height.
+func (k Keeper) IterateConsPubKeyRotationHistory(ctx sdk.Context, cb func(history types.ConsPubKeyRotationHistory) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// PruneMatureConsPubKeyRotations removes the consensus pubkey rotations which
+// are older than an unbonding period, together with the index of their old
+// consensus address. Infractions committed with the old consensus pubkey can
+// no longer be submitted as evidence at that point.
+func (k Keeper) PruneMatureConsPubKeyRotations(ctx sdk.Context) {
+	unbondingTime := k.UnbondingTime(ctx)
+
+	var mature []types.ConsPubKeyRotationHistory
+	k.IterateConsPubKeyRotationHistory(ctx, func(history types.ConsPubKeyRotationHistory) bool {
+		// rotations are ordered by height, hence by time
+		if history.Time.Add(unbondingTime).After(ctx.BlockTime()) {
+			return true
+		}
+
+		mature = append(mature, history)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, history := range mature {
+		valAddr := history.GetOperator()
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+		oldConsAddr := sdk.GetConsAddress(oldPubKey)
+
+		store.Delete(types.GetConsPubKeyRotationHistoryKey(history.Height, valAddr))
+		store.Delete(types.GetConsPubKeyRotationByValIndexKey(valAddr, history.Height))
+		store.Delete(types.GetOldToNewConsAddrKey(oldConsAddr))
+		store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+	}
+}
+
+// getConsPubKeyRotationsAtHeight returns the consensus pubkey rotations at the
+// given height, by validator operator address.
+func (k Keeper) getConsPubKeyRotationsAtHeight(ctx sdk.Context, height int64) map[string]types.ConsPubKeyRotationHistory {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetConsPubKeyRotationHistoryHeightPrefix(height))
+	defer iterator.Close()
+
+	rotations := make(map[string]types.ConsPubKeyRotationHistory)
+	for ; iterator.Valid(); iterator.Next() {
+		history := types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, iterator.Value())
+		rotations[history.OperatorAddress] = history
+	}
+
+	return rotations
+}
+
+// countConsPubKeyRotations returns the number of consensus pubkey rotations of
+// a validator within the last unbonding period.
+func (k Keeper) countConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress, params types.Params) uint32 {
+	var count uint32
+	for _, history := range k.GetValidatorConsPubKeyRotations(ctx, valAddr) {
+		if history.Time.Add(params.UnbondingTime).After(ctx.BlockTime()) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (k Keeper) setOldToNewConsAddr(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOldToNewConsAddrKey(oldConsAddr), newConsAddr)
+}
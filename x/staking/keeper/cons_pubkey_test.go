@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestConsPubKeyRotationValidatorSetUpdates() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	validators := make([]stakingtypes.Validator, 2)
+	for i := range validators {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], true)
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validators[i]))
+	}
+
+	// the old consensus pubkey is removed and the new one added within the same block
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(keeper.RotateConsPubKey(ctx, validators[0], PKs[10]))
+
+	validator, found := keeper.GetValidator(ctx, validators[0].GetOperator())
+	require.True(found)
+
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+	history, found := keeper.GetConsPubKeyRotationHistory(ctx, 10, validators[0].GetOperator())
+	require.True(found)
+	require.Equal(history.ABCIValidatorUpdateZero(), updates[0])
+	require.Equal(validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
+
+	// no more updates in the following block
+	s.applyValidatorSetUpdates(ctx.WithBlockHeight(11), keeper, 0)
+
+	// the validator is still found by its old consensus address
+	resVal, found := keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
+	require.True(found)
+	require.Equal(validators[0].OperatorAddress, resVal.OperatorAddress)
+
+	// a validator leaving the set in the block of its rotation removes its old consensus pubkey
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(keeper.RotateConsPubKey(ctx, validators[1], PKs[11]))
+	validator, found = keeper.GetValidator(ctx, validators[1].GetOperator())
+	require.True(found)
+	keeper.Jail(ctx, sdk.GetConsAddress(PKs[11]))
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	updates = s.applyValidatorSetUpdates(ctx, keeper, 1)
+	require.Equal(validators[1].ABCIValidatorUpdateZero(), updates[0])
+	require.NotEqual(validator.ABCIValidatorUpdateZero(), updates[0])
+}
+
+func (s *KeeperTestSuite) TestPruneMatureConsPubKeyRotations() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address()), PKs[0])
+	keeper.SetValidator(ctx, validator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	require.NoError(keeper.RotateConsPubKey(ctx.WithBlockHeight(1), validator, PKs[1]))
+
+	oldConsAddr, newConsAddr := sdk.GetConsAddress(PKs[0]), sdk.GetConsAddress(PKs[1])
+	require.Equal(newConsAddr, keeper.ValidatorIdentifier(ctx, oldConsAddr))
+
+	// nothing is pruned within the unbonding period
+	keeper.PruneMatureConsPubKeyRotations(ctx)
+	require.Len(keeper.GetValidatorConsPubKeyRotations(ctx, validator.GetOperator()), 1)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(keeper.UnbondingTime(ctx)))
+	keeper.PruneMatureConsPubKeyRotations(ctx)
+	require.Empty(keeper.GetValidatorConsPubKeyRotations(ctx, validator.GetOperator()))
+	require.Equal(oldConsAddr, keeper.ValidatorIdentifier(ctx, oldConsAddr))
+
+	_, found := keeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.False(found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.True(found)
+}
@@ -110,7 +110,9 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
-	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+	key := types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr())
+	k.updateLiquidShares(ctx, key, delegatorAddress, delegation.GetValidatorAddr(), delegation.Shares)
+	store.Set(key, b)
 
 	// set the delegation in validator delegator index
 	store.Set(types.GetDelegationsByValKey(delegation.GetValidatorAddr(), delegatorAddress), []byte{})
@@ -129,7 +131,9 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	key := types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr())
+	k.updateLiquidShares(ctx, key, delegatorAddress, delegation.GetValidatorAddr(), math.LegacyZeroDec())
+	store.Delete(key)
 	store.Delete(types.GetDelegationsByValKey(delegation.GetValidatorAddr(), delegatorAddress))
 
 	return nil
@@ -306,6 +310,7 @@ func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDeleg
 		panic(err)
 	}
 	key := types.GetUBDKey(delAddr, valAddr)
+	k.updateUnbondingFlows(ctx, key, valAddr, ubd.TotalBalance())
 	store.Set(key, bz)
 	store.Set(types.GetUBDByValIndexKey(delAddr, valAddr), []byte{}) // index, store empty bytes
 }
@@ -323,6 +328,7 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 		panic(err)
 	}
 	key := types.GetUBDKey(delegatorAddress, addr)
+	k.updateUnbondingFlows(ctx, key, addr, math.ZeroInt())
 	store.Delete(key)
 	store.Delete(types.GetUBDByValIndexKey(delegatorAddress, addr))
 }
@@ -403,6 +409,14 @@ func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.
 // DequeueAllMatureUBDQueue returns a concatenated list of all the timeslices inclusively previous to
 // currTime, and deletes the timeslices from the queue.
 func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (matureUnbonds []types.DVPair) {
+	return k.DequeueMatureUBDQueue(ctx, currTime, 0)
+}
+
+// DequeueMatureUBDQueue returns at most limit entries of the timeslices
+// inclusively previous to currTime, oldest first, and removes them from the
+// queue. A limit of zero dequeues all of them. The iteration stops as soon as
+// the limit is reached, so that a large backlog is not read every block.
+func (k Keeper) DequeueMatureUBDQueue(ctx sdk.Context, currTime time.Time, limit uint32) (matureUnbonds []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
 
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
@@ -410,11 +424,27 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (m
 	defer unbondingTimesliceIterator.Close()
 
 	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
+		if limit != 0 && len(matureUnbonds) == int(limit) {
+			break
+		}
+
 		timeslice := types.DVPairs{}
 		value := unbondingTimesliceIterator.Value()
 		k.cdc.MustUnmarshal(value, &timeslice)
 
-		matureUnbonds = append(matureUnbonds, timeslice.Pairs...)
+		n := len(timeslice.Pairs)
+		if left := int(limit) - len(matureUnbonds); limit != 0 && left < n {
+			n = left
+		}
+
+		matureUnbonds = append(matureUnbonds, timeslice.Pairs[:n]...)
+
+		// the entries left over are kept in the timeslice for the next blocks
+		if n < len(timeslice.Pairs) {
+			timeslice.Pairs = timeslice.Pairs[n:]
+			store.Set(unbondingTimesliceIterator.Key(), k.cdc.MustMarshal(&timeslice))
+			break
+		}
 
 		store.Delete(unbondingTimesliceIterator.Key())
 	}
@@ -514,6 +544,7 @@ func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
 		panic(err)
 	}
 	key := types.GetREDKey(delegatorAddress, valSrcAddr, valDestAddr)
+	k.updateRedelegatingFlows(ctx, key, valSrcAddr, red.TotalBalance())
 	store.Set(key, bz)
 	store.Set(types.GetREDByValSrcIndexKey(delegatorAddress, valSrcAddr, valDestAddr), []byte{})
 	store.Set(types.GetREDByValDstIndexKey(delegatorAddress, valSrcAddr, valDestAddr), []byte{})
@@ -581,6 +612,7 @@ func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
 		panic(err)
 	}
 	redKey := types.GetREDKey(delegatorAddress, valSrcAddr, valDestAddr)
+	k.updateRedelegatingFlows(ctx, redKey, valSrcAddr, math.ZeroInt())
 	store.Delete(redKey)
 	store.Delete(types.GetREDByValSrcIndexKey(delegatorAddress, valSrcAddr, valDestAddr))
 	store.Delete(types.GetREDByValDstIndexKey(delegatorAddress, valSrcAddr, valDestAddr))
@@ -641,18 +673,43 @@ func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) st
 // timeslices inclusively previous to currTime, and deletes the timeslices from
 // the queue.
 func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time.Time) (matureRedelegations []types.DVVTriplet) {
+	return k.DequeueMatureRedelegationQueue(ctx, currTime, 0)
+}
+
+// DequeueMatureRedelegationQueue returns at most limit entries of the
+// timeslices inclusively previous to currTime, oldest first, and removes them
+// from the queue. A limit of zero dequeues all of them. The iteration stops as
+// soon as the limit is reached, so that a large backlog is not read every
+// block.
+func (k Keeper) DequeueMatureRedelegationQueue(ctx sdk.Context, currTime time.Time, limit uint32) (matureRedelegations []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
 
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
+	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, currTime)
 	defer redelegationTimesliceIterator.Close()
 
 	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
+		if limit != 0 && len(matureRedelegations) == int(limit) {
+			break
+		}
+
 		timeslice := types.DVVTriplets{}
 		value := redelegationTimesliceIterator.Value()
 		k.cdc.MustUnmarshal(value, &timeslice)
 
-		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
+		n := len(timeslice.Triplets)
+		if left := int(limit) - len(matureRedelegations); limit != 0 && left < n {
+			n = left
+		}
+
+		matureRedelegations = append(matureRedelegations, timeslice.Triplets[:n]...)
+
+		// the entries left over are kept in the timeslice for the next blocks
+		if n < len(timeslice.Triplets) {
+			timeslice.Triplets = timeslice.Triplets[n:]
+			store.Set(redelegationTimesliceIterator.Key(), k.cdc.MustMarshal(&timeslice))
+			break
+		}
 
 		store.Delete(redelegationTimesliceIterator.Key())
 	}
@@ -750,6 +807,123 @@ func (k Keeper) Delegate(
 	return newShares, nil
 }
 
+// checkMaxValidatorPowerFraction returns an error if delegating amount to the
+// validator would make it hold more than the max validator power fraction of
+// the total bonded tokens. Self-delegation top-ups the operator needs to reach
+// the min self delegation of the validator are exempt.
+func (k Keeper) checkMaxValidatorPowerFraction(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, amount math.Int) error {
+	maxFraction := k.MaxValidatorPowerFraction(ctx)
+	if !maxFraction.IsPositive() {
+		return nil
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	if !totalBonded.IsPositive() {
+		return nil
+	}
+
+	valAddr := validator.GetOperator()
+	if delAddr.Equals(sdk.AccAddress(valAddr)) {
+		selfBond := math.ZeroInt()
+		if delegation, found := k.GetDelegation(ctx, delAddr, valAddr); found && validator.DelegatorShares.IsPositive() {
+			selfBond = validator.TokensFromSharesTruncated(delegation.Shares).TruncateInt()
+		}
+
+		if selfBond.Add(amount).LTE(validator.MinSelfDelegation) {
+			return nil
+		}
+	}
+
+	fraction := math.LegacyNewDecFromInt(validator.Tokens.Add(amount)).QuoInt(totalBonded)
+	if fraction.GT(maxFraction) {
+		current := math.LegacyNewDecFromInt(validator.Tokens).QuoInt(totalBonded)
+		return errorsmod.Wrapf(
+			types.ErrMaxValidatorPowerFraction,
+			"validator %s holds %s of the total bonded tokens and would hold %s, max is %s", valAddr, current, fraction, maxFraction,
+		)
+	}
+
+	return nil
+}
+
+// checkMinDelegation returns an error if a new delegation of amount to the
+// validator is below the minimum delegation of the validator or the global
+// minimum delegation, whichever is higher. Existing delegations may be topped
+// up by any amount.
+func (k Keeper) checkMinDelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, amount math.Int) error {
+	if _, found := k.GetDelegation(ctx, delAddr, validator.GetOperator()); found {
+		return nil
+	}
+
+	minDelegation := k.GlobalMinDelegation(ctx)
+	if validator.GetMinDelegation().GT(minDelegation) {
+		minDelegation = validator.GetMinDelegation()
+	}
+
+	if amount.LT(minDelegation) {
+		return errorsmod.Wrapf(
+			types.ErrDelegationBelowMinimum,
+			"got %s, minimum delegation to validator %s is %s", amount, validator.OperatorAddress, minDelegation,
+		)
+	}
+
+	return nil
+}
+
+// checkMinSelfDelegationRatio returns an error if delegating amount to the
+// validator would bring the self-delegation of the validator below the min
+// self delegation ratio of its tokens. The error tells how much more the
+// operator must self-delegate for the delegation to be accepted.
+// Self-delegations only increase the ratio and are always accepted.
+func (k Keeper) checkMinSelfDelegationRatio(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, amount math.Int) error {
+	ratio := k.MinSelfDelegationRatio(ctx)
+	if !ratio.IsPositive() {
+		return nil
+	}
+
+	valAddr := validator.GetOperator()
+	if delAddr.Equals(sdk.AccAddress(valAddr)) {
+		return nil
+	}
+
+	selfBond := math.LegacyZeroDec()
+	if delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); found && validator.DelegatorShares.IsPositive() {
+		selfBond = validator.TokensFromSharesTruncated(delegation.Shares).TruncateDec()
+	}
+
+	minSelfBond := ratio.MulInt(validator.Tokens.Add(amount))
+	if selfBond.GTE(minSelfBond) {
+		return nil
+	}
+
+	// self-delegating x more tokens satisfies
+	// selfBond + x >= ratio * (tokens + amount + x)
+	required := minSelfBond.Sub(selfBond).Quo(math.LegacyOneDec().Sub(ratio)).Ceil().TruncateInt()
+
+	return errorsmod.Wrapf(
+		types.ErrMinSelfDelegationRatio,
+		"validator %s self-delegation of %s would be below %s of its %s tokens; %s additional self-delegation required",
+		valAddr, selfBond.TruncateInt(), ratio, validator.Tokens.Add(amount), required,
+	)
+}
+
+// minSelfDelegation returns the self-delegation the operator of the validator
+// must keep for the validator not to be jailed, given the tokens the
+// validator holds: the higher of the min self delegation of the validator and
+// the min self delegation ratio of the tokens.
+func (k Keeper) minSelfDelegation(ctx sdk.Context, validator types.Validator, tokens math.LegacyDec) math.Int {
+	minSelfDelegation := validator.MinSelfDelegation
+
+	ratio := k.MinSelfDelegationRatio(ctx)
+	if ratio.IsPositive() {
+		if minSelfBond := ratio.Mul(tokens).Ceil().TruncateInt(); minSelfBond.GT(minSelfDelegation) {
+			minSelfDelegation = minSelfBond
+		}
+	}
+
+	return minSelfDelegation
+}
+
 // Unbond unbonds a particular delegation and perform associated store operations.
 func (k Keeper) Unbond(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
@@ -787,11 +961,17 @@ func (k Keeper) Unbond(
 	isValidatorOperator := bytes.Equal(delegatorAddress, validator.GetOperator())
 
 	// If the delegation is the operator of the validator and undelegating will decrease the validator's
-	// self-delegation below their minimum, we jail the validator.
-	if isValidatorOperator && !validator.Jailed &&
-		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
-		k.jailValidator(ctx, validator)
-		validator = k.mustGetValidator(ctx, validator.GetOperator())
+	// self-delegation below their minimum, we jail the validator. The minimum is the higher of the
+	// validator's min self delegation and the min self delegation ratio of its remaining tokens.
+	if isValidatorOperator && !validator.Jailed {
+		remainingTokens := math.LegacyNewDecFromInt(validator.Tokens).Sub(validator.TokensFromShares(shares))
+		minSelfDelegation := k.minSelfDelegation(ctx, validator, remainingTokens)
+		if validator.TokensFromShares(delegation.Shares).TruncateInt().LT(minSelfDelegation) {
+			k.jailValidator(ctx, validator, types.JailReason_JAIL_REASON_MIN_SELF_DELEGATION, fmt.Sprintf(
+				"self-delegation fell below the minimum self-delegation of %s", minSelfDelegation,
+			))
+			validator = k.mustGetValidator(ctx, validator.GetOperator())
+		}
 	}
 
 	if delegation.Shares.IsZero() {
@@ -430,6 +430,59 @@ func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegation() {
 	require.Equal(keeper.TokensFromConsensusPower(ctx, 14), validator.Tokens)
 	require.Equal(stakingtypes.Unbonding, validator.Status)
 	require.True(validator.Jailed)
+
+	jailInfo, found := keeper.GetValidatorJailInfo(ctx, addrVals[0])
+	require.True(found)
+	require.Equal(stakingtypes.JailReason_JAIL_REASON_MIN_SELF_DELEGATION, jailInfo.Reason)
+}
+
+func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegationRatio() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(1)
+	valAccAddr := sdk.AccAddress(addrVals[0])
+	for _, addr := range []sdk.AccAddress{addrDels[0], valAccAddr} {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+	}
+
+	params := keeper.GetParams(ctx)
+	params.MinSelfDelegationRatio = math.LegacyNewDecWithPrec(4, 1)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// create an unbonded validator with a self-delegation and a delegation of
+	// 100 tokens each
+	validator := testutil.NewValidator(s.T(), addrVals[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(200))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(valAccAddr, addrVals[0], math.LegacyNewDec(100)))
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrDels[0], addrVals[0], math.LegacyNewDec(100)))
+
+	undelegate := func(delAddr sdk.AccAddress, shares int64) stakingtypes.Validator {
+		_, _, err := keeper.Undelegate(ctx, delAddr, addrVals[0], math.LegacyNewDec(shares))
+		require.NoError(err)
+
+		validator, found := keeper.GetValidator(ctx, addrVals[0])
+		require.True(found)
+		return validator
+	}
+
+	// undelegations of other delegators only raise the ratio
+	require.False(undelegate(addrDels[0], 50).Jailed)
+	require.False(undelegate(valAccAddr, 50).Jailed)
+
+	// a self-delegation of 34 is 40% of 84 tokens rounded up
+	require.False(undelegate(valAccAddr, 16).Jailed)
+
+	// the self-delegation of 33 is below 40% of the remaining 83 tokens, and
+	// above the min self delegation of the validator
+	require.True(undelegate(valAccAddr, 1).Jailed)
+
+	jailInfo, found := keeper.GetValidatorJailInfo(ctx, addrVals[0])
+	require.True(found)
+	require.Equal(stakingtypes.JailReason_JAIL_REASON_MIN_SELF_DELEGATION, jailInfo.Reason)
+	require.Equal("self-delegation fell below the minimum self-delegation of 34", jailInfo.InfractionDetails)
 }
 
 func (s *KeeperTestSuite) TestUndelegateFromUnbondingValidator() {
@@ -96,6 +96,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	}
 
 	for _, red := range data.Redelegations {
+		// genesis files exported before entries tracked their balance
+		for i, entry := range red.Entries {
+			if entry.Balance.IsNil() {
+				red.Entries[i].Balance = entry.InitialBalance
+			}
+		}
 		k.SetRedelegation(ctx, red)
 
 		for _, entry := range red.Entries {
@@ -103,6 +109,43 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, history := range data.RotationHistory {
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+
+		newPubKey, err := history.NewConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+
+		// the old consensus address keeps resolving to the validator until
+		// the rotation matures
+		oldConsAddr := sdk.GetConsAddress(oldPubKey)
+		k.SetConsPubKeyRotationHistory(ctx, history)
+		k.setOldToNewConsAddr(ctx, oldConsAddr, sdk.GetConsAddress(newPubKey))
+		ctx.KVStore(k.storeKey).Set(types.GetValidatorByConsAddrKey(oldConsAddr), history.GetOperator())
+	}
+
+	for _, jailInfo := range data.JailInfos {
+		valAddr, err := sdk.ValAddressFromBech32(jailInfo.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		ctx.KVStore(k.storeKey).Set(types.GetValidatorJailInfoKey(valAddr), k.cdc.MustMarshal(&jailInfo.JailInfo))
+	}
+
+	for _, jailInfo := range data.LastJailInfos {
+		valAddr, err := sdk.ValAddressFromBech32(jailInfo.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		ctx.KVStore(k.storeKey).Set(types.GetValidatorLastJailInfoKey(valAddr), k.cdc.MustMarshal(&jailInfo.JailInfo))
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -196,6 +239,25 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	var rotationHistory []types.ConsPubKeyRotationHistory
+
+	k.IterateConsPubKeyRotationHistory(ctx, func(history types.ConsPubKeyRotationHistory) (stop bool) {
+		rotationHistory = append(rotationHistory, history)
+		return false
+	})
+
+	var jailInfos, lastJailInfos []types.ValidatorJailInfo
+
+	k.IterateValidatorJailInfos(ctx, func(valAddr sdk.ValAddress, info types.JailInfo) (stop bool) {
+		jailInfos = append(jailInfos, types.ValidatorJailInfo{ValidatorAddress: valAddr.String(), JailInfo: info})
+		return false
+	})
+
+	k.IterateValidatorLastJailInfos(ctx, func(valAddr sdk.ValAddress, info types.JailInfo) (stop bool) {
+		lastJailInfos = append(lastJailInfos, types.ValidatorJailInfo{ValidatorAddress: valAddr.String(), JailInfo: info})
+		return false
+	})
+
 	return &types.GenesisState{
 		Params:               k.GetParams(ctx),
 		LastTotalPower:       k.GetLastTotalPower(ctx),
@@ -205,5 +267,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		RotationHistory:      rotationHistory,
+		JailInfos:            jailInfos,
+		LastJailInfos:        lastJailInfos,
 	}
 }
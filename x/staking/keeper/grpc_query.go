@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -56,11 +57,22 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 	}
 
 	vals := types.Validators{}
+	var jailInfos map[string]types.JailInfo
 	for _, val := range validators {
 		vals = append(vals, *val)
+
+		if !req.IncludeJailInfo || !val.Jailed {
+			continue
+		}
+		if info, found := k.GetValidatorJailInfo(ctx, val.GetOperator()); found {
+			if jailInfos == nil {
+				jailInfos = make(map[string]types.JailInfo)
+			}
+			jailInfos[val.OperatorAddress] = info
+		}
 	}
 
-	return &types.QueryValidatorsResponse{Validators: vals, Pagination: pageRes}, nil
+	return &types.QueryValidatorsResponse{Validators: vals, Pagination: pageRes, JailInfos: jailInfos}, nil
 }
 
 // Validator queries validator info for given validator address
@@ -483,7 +495,7 @@ func (k Querier) DelegatorValidators(c context.Context, req *types.QueryDelegato
 // Pool queries the pool info
 func (k Querier) Pool(c context.Context, _ *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	bondDenom := k.BondDenom(ctx)
+	bondDenom := k.Keeper.BondDenom(ctx)
 	bondedPool := k.GetBondedPool(ctx)
 	notBondedPool := k.GetNotBondedPool(ctx)
 
@@ -503,6 +515,97 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// BondDenom queries the bond denom of the staking module
+func (k Querier) BondDenom(c context.Context, _ *types.QueryBondDenomRequest) (*types.QueryBondDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBondDenomResponse{BondDenom: k.Keeper.BondDenom(ctx)}, nil
+}
+
+// ValidatorFlows queries the unbonding and redelegating balances of a validator
+func (k Querier) ValidatorFlows(c context.Context, req *types.QueryValidatorFlowsRequest) (*types.QueryValidatorFlowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorFlowsResponse{Flows: k.GetValidatorFlows(ctx, valAddr)}, nil
+}
+
+// ValidatorLiquidShares queries the delegator shares of a validator held by
+// liquid staking accounts
+func (k Querier) ValidatorLiquidShares(c context.Context, req *types.QueryValidatorLiquidSharesRequest) (*types.QueryValidatorLiquidSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	shares := k.GetValidatorLiquidShares(ctx, valAddr)
+	tokens := math.ZeroInt()
+	if validator.DelegatorShares.IsPositive() {
+		tokens = validator.TokensFromSharesTruncated(shares).TruncateInt()
+	}
+
+	return &types.QueryValidatorLiquidSharesResponse{LiquidShares: shares, LiquidTokens: tokens}, nil
+}
+
+// ValidatorJailInfo queries the jail info of a validator
+func (k Querier) ValidatorJailInfo(c context.Context, req *types.QueryValidatorJailInfoRequest) (*types.QueryValidatorJailInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	res := &types.QueryValidatorJailInfoResponse{}
+	if info, found := k.GetValidatorJailInfo(ctx, valAddr); found {
+		res.JailInfo = &info
+	}
+	if info, found := k.GetValidatorLastJailInfo(ctx, valAddr); found {
+		res.LastJailInfo = &info
+	}
+
+	return res, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -641,6 +744,7 @@ func RedelegationsToRedelegationResponses(ctx sdk.Context, k *Keeper, redels typ
 				val.TokensFromShares(entry.SharesDst).TruncateInt(),
 				entry.UnbondingId,
 			)
+			entryResponses[j].RedelegationEntry.Balance = entry.Balance
 		}
 
 		resp[i] = types.NewRedelegationResponse(
@@ -60,3 +60,20 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryBondDenom() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	res, err := queryClient.BondDenom(gocontext.Background(), &types.QueryBondDenomRequest{})
+	require.NoError(err)
+	require.Equal(sdk.DefaultBondDenom, res.BondDenom)
+
+	params := keeper.GetParams(ctx)
+	params.BondDenom = "stake2"
+	require.NoError(keeper.SetParams(ctx, params))
+
+	res, err = queryClient.BondDenom(gocontext.Background(), &types.QueryBondDenomRequest{})
+	require.NoError(err)
+	require.Equal("stake2", res.BondDenom)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,10 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-flows",
+		ValidatorFlowsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquid-shares",
+		LiquidSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -39,7 +44,17 @@ func AllInvariants(k *Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ValidatorFlowsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return LiquidSharesInvariant(k)(ctx)
 	}
 }
 
@@ -195,3 +210,131 @@ func DelegatorSharesInvariant(k *Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// ValidatorFlowsInvariant checks that the unbonding and redelegating balances
+// stored for each validator equal the sum of its unbonding delegation entries
+// and outbound redelegation entries.
+func ValidatorFlowsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		expected := map[string]types.ValidatorFlows{}
+		getFlows := func(valAddr string) types.ValidatorFlows {
+			flows, ok := expected[valAddr]
+			if !ok {
+				flows = types.ValidatorFlows{UnbondingBalance: math.ZeroInt(), RedelegatingBalance: math.ZeroInt()}
+			}
+			return flows
+		}
+
+		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) bool {
+			flows := getFlows(ubd.ValidatorAddress)
+			flows.UnbondingBalance = flows.UnbondingBalance.Add(ubd.TotalBalance())
+			expected[ubd.ValidatorAddress] = flows
+			return false
+		})
+
+		k.IterateRedelegations(ctx, func(_ int64, red types.Redelegation) bool {
+			flows := getFlows(red.ValidatorSrcAddress)
+			flows.RedelegatingBalance = flows.RedelegatingBalance.Add(red.TotalBalance())
+			expected[red.ValidatorSrcAddress] = flows
+			return false
+		})
+
+		k.IterateValidatorFlows(ctx, func(valAddr sdk.ValAddress, flows types.ValidatorFlows) bool {
+			exp := getFlows(valAddr.String())
+			delete(expected, valAddr.String())
+
+			if !flows.UnbondingBalance.Equal(exp.UnbondingBalance) || !flows.RedelegatingBalance.Equal(exp.RedelegatingBalance) {
+				broken = true
+				msg += fmt.Sprintf("broken validator flows invariance for validator %s:\n"+
+					"\tstored unbonding balance: %v, sum of unbonding delegations: %v\n"+
+					"\tstored redelegating balance: %v, sum of redelegations: %v\n",
+					valAddr, flows.UnbondingBalance, exp.UnbondingBalance, flows.RedelegatingBalance, exp.RedelegatingBalance)
+			}
+			return false
+		})
+
+		// validators with unbonding delegations or redelegations but no stored flows
+		missing := make([]string, 0, len(expected))
+		for valAddr := range expected {
+			missing = append(missing, valAddr)
+		}
+		sort.Strings(missing)
+
+		for _, valAddr := range missing {
+			exp := expected[valAddr]
+			if exp.UnbondingBalance.IsZero() && exp.RedelegatingBalance.IsZero() {
+				continue
+			}
+
+			broken = true
+			msg += fmt.Sprintf("missing validator flows for validator %s: expected unbonding balance %v, redelegating balance %v\n",
+				valAddr, exp.UnbondingBalance, exp.RedelegatingBalance)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator flows", msg), broken
+	}
+}
+
+// LiquidSharesInvariant checks that the liquid shares stored for each validator
+// equal the sum of the shares of its delegations from liquid staking accounts.
+func LiquidSharesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		expected := map[string]math.LegacyDec{}
+		k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+			if !k.IsLiquidStakingAccount(ctx, delegation.GetDelegatorAddr()) {
+				return false
+			}
+
+			shares, ok := expected[delegation.ValidatorAddress]
+			if !ok {
+				shares = math.LegacyZeroDec()
+			}
+			expected[delegation.ValidatorAddress] = shares.Add(delegation.Shares)
+			return false
+		})
+
+		k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares math.LegacyDec) bool {
+			exp, ok := expected[valAddr.String()]
+			if !ok {
+				exp = math.LegacyZeroDec()
+			}
+			delete(expected, valAddr.String())
+
+			if !shares.Equal(exp) {
+				broken = true
+				msg += fmt.Sprintf("broken liquid shares invariance for validator %s:\n"+
+					"\tstored liquid shares: %v, sum of liquid delegation shares: %v\n",
+					valAddr, shares, exp)
+			}
+			return false
+		})
+
+		// validators with liquid delegations but no stored liquid shares
+		missing := make([]string, 0, len(expected))
+		for valAddr := range expected {
+			missing = append(missing, valAddr)
+		}
+		sort.Strings(missing)
+
+		for _, valAddr := range missing {
+			if expected[valAddr].IsZero() {
+				continue
+			}
+
+			broken = true
+			msg += fmt.Sprintf("missing liquid shares for validator %s: expected %v\n", valAddr, expected[valAddr])
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "liquid shares", msg), broken
+	}
+}
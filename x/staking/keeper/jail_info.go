@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorJailInfo returns why and when a jailed validator was jailed.
+func (k Keeper) GetValidatorJailInfo(ctx sdk.Context, valAddr sdk.ValAddress) (info types.JailInfo, found bool) {
+	return k.getJailInfo(ctx, types.GetValidatorJailInfoKey(valAddr))
+}
+
+// GetValidatorLastJailInfo returns the jail info archived when a validator was
+// last unjailed.
+func (k Keeper) GetValidatorLastJailInfo(ctx sdk.Context, valAddr sdk.ValAddress) (info types.JailInfo, found bool) {
+	return k.getJailInfo(ctx, types.GetValidatorLastJailInfoKey(valAddr))
+}
+
+func (k Keeper) getJailInfo(ctx sdk.Context, key []byte) (info types.JailInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// IterateValidatorJailInfos iterates over the jail infos of the jailed
+// validators.
+func (k Keeper) IterateValidatorJailInfos(ctx sdk.Context, cb func(valAddr sdk.ValAddress, info types.JailInfo) (stop bool)) {
+	k.iterateJailInfos(ctx, types.ValidatorJailInfoKey, cb)
+}
+
+// IterateValidatorLastJailInfos iterates over the jail infos archived when the
+// validators were last unjailed.
+func (k Keeper) IterateValidatorLastJailInfos(ctx sdk.Context, cb func(valAddr sdk.ValAddress, info types.JailInfo) (stop bool)) {
+	k.iterateJailInfos(ctx, types.ValidatorLastJailInfoKey, cb)
+}
+
+func (k Keeper) iterateJailInfos(ctx sdk.Context, prefix []byte, cb func(valAddr sdk.ValAddress, info types.JailInfo) (stop bool)) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.JailInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+
+		if cb(types.AddressFromValidatorJailInfoKey(iterator.Key()), info) {
+			break
+		}
+	}
+}
+
+// setValidatorJailInfo records that a validator is jailed at the current
+// block for the given reason.
+func (k Keeper) setValidatorJailInfo(ctx sdk.Context, valAddr sdk.ValAddress, reason types.JailReason, details string) {
+	info := types.JailInfo{
+		Reason:            reason,
+		Height:            ctx.BlockHeight(),
+		Time:              ctx.BlockHeader().Time,
+		InfractionDetails: details,
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorJailInfoKey(valAddr), k.cdc.MustMarshal(&info))
+}
+
+// archiveValidatorJailInfo moves the jail info of an unjailed validator to
+// its last jail info, replacing the previously archived one.
+func (k Keeper) archiveValidatorJailInfo(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorJailInfoKey(valAddr))
+	if bz == nil {
+		return
+	}
+
+	store.Set(types.GetValidatorLastJailInfoKey(valAddr), bz)
+	store.Delete(types.GetValidatorJailInfoKey(valAddr))
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorJailInfo() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	jailInfoOf := func() *stakingtypes.QueryValidatorJailInfoResponse {
+		res, err := queryClient.ValidatorJailInfo(ctx, &stakingtypes.QueryValidatorJailInfoRequest{ValidatorAddr: valAddr.String()})
+		require.NoError(err)
+		return res
+	}
+	jailInfosOf := func() map[string]stakingtypes.JailInfo {
+		res, err := queryClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{IncludeJailInfo: true})
+		require.NoError(err)
+		return res.JailInfos
+	}
+
+	require.Nil(jailInfoOf().JailInfo)
+	require.Nil(jailInfoOf().LastJailInfo)
+	require.Empty(jailInfosOf())
+
+	// jailing records the reason given by the caller
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(100, 0).UTC())
+	keeper.JailWithReason(ctx, consAddr, stakingtypes.JailReason_JAIL_REASON_DOWNTIME, "missed blocks")
+	downtime := stakingtypes.JailInfo{
+		Reason:            stakingtypes.JailReason_JAIL_REASON_DOWNTIME,
+		Height:            10,
+		Time:              time.Unix(100, 0).UTC(),
+		InfractionDetails: "missed blocks",
+	}
+	require.Equal(&downtime, jailInfoOf().JailInfo)
+	require.Nil(jailInfoOf().LastJailInfo)
+	require.Equal(map[string]stakingtypes.JailInfo{valAddr.String(): downtime}, jailInfosOf())
+
+	// the jail info is only returned by the validators query on request
+	res, err := queryClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+	require.Empty(res.JailInfos)
+
+	// unjailing archives the jail info
+	keeper.Unjail(ctx, consAddr)
+	require.Nil(jailInfoOf().JailInfo)
+	require.Equal(&downtime, jailInfoOf().LastJailInfo)
+	require.Empty(jailInfosOf())
+
+	// jailing without a reason keeps the archived jail info until the next unjail
+	ctx = ctx.WithBlockHeight(20)
+	keeper.Jail(ctx, consAddr)
+	require.Equal(stakingtypes.JailReason_JAIL_REASON_UNSPECIFIED, jailInfoOf().JailInfo.Reason)
+	require.Equal(int64(20), jailInfoOf().JailInfo.Height)
+	require.Equal(&downtime, jailInfoOf().LastJailInfo)
+
+	keeper.Unjail(ctx, consAddr)
+	require.Nil(jailInfoOf().JailInfo)
+	require.Equal(int64(20), jailInfoOf().LastJailInfo.Height)
+
+	_, err = queryClient.ValidatorJailInfo(ctx, &stakingtypes.QueryValidatorJailInfoRequest{ValidatorAddr: sdk.ValAddress(PKs[1].Address()).String()})
+	require.ErrorContains(err, "not found")
+}
@@ -27,6 +27,8 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
 	authority  string
+
+	accountTypeChecker types.AccountTypeChecker
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -86,6 +88,17 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetAccountTypeChecker sets the checker telling apart the liquid staking
+// accounts whose delegation shares are tracked. The delegation shares aren't
+// tracked if it isn't set.
+func (k *Keeper) SetAccountTypeChecker(checker types.AccountTypeChecker) {
+	if k.accountTypeChecker != nil {
+		panic("cannot set account type checker twice")
+	}
+
+	k.accountTypeChecker = checker
+}
+
 // GetLastTotalPower Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) math.Int {
 	store := ctx.KVStore(k.storeKey)
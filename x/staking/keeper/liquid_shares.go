@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ types.AccountTypeChecker = moduleAccountTypeChecker{}
+
+// moduleAccountTypeChecker flags module accounts as liquid staking accounts.
+type moduleAccountTypeChecker struct {
+	ak types.AccountKeeper
+}
+
+// NewModuleAccountTypeChecker returns an AccountTypeChecker flagging module
+// accounts as liquid staking accounts. Apps with contract accounts should
+// provide their own checker.
+func NewModuleAccountTypeChecker(ak types.AccountKeeper) types.AccountTypeChecker {
+	return moduleAccountTypeChecker{ak: ak}
+}
+
+// IsModuleOrContractAccount implements AccountTypeChecker.
+func (c moduleAccountTypeChecker) IsModuleOrContractAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := c.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// IsLiquidStakingAccount returns whether the delegations of an address count
+// towards the liquid staking caps. No address does if no account type checker
+// is set.
+func (k Keeper) IsLiquidStakingAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.accountTypeChecker != nil && k.accountTypeChecker.IsModuleOrContractAccount(ctx, addr)
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held by
+// liquid staking accounts.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator held by
+// liquid staking accounts. Zero shares are removed from the store.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), k.cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+}
+
+// IterateValidatorLiquidShares iterates through the delegator shares held by
+// liquid staking accounts of all validators.
+func (k Keeper) IterateValidatorLiquidShares(ctx sdk.Context, cb func(valAddr sdk.ValAddress, shares math.LegacyDec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of format prefix || addrLen (1 byte) || valAddr
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorLiquidSharesKey)+1:])
+
+		dp := sdk.DecProto{}
+		k.cdc.MustUnmarshal(iterator.Value(), &dp)
+		if cb(valAddr, dp.Dec) {
+			break
+		}
+	}
+}
+
+// GetTotalLiquidStakedTokens returns the tokens delegated to all validators by
+// liquid staking accounts.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	total := math.ZeroInt()
+	k.IterateValidatorLiquidShares(ctx, func(valAddr sdk.ValAddress, shares math.LegacyDec) bool {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if found && validator.DelegatorShares.IsPositive() {
+			total = total.Add(validator.TokensFromSharesTruncated(shares).TruncateInt())
+		}
+		return false
+	})
+
+	return total
+}
+
+// CheckExceedsValidatorLiquidCap returns whether issuing newShares of a
+// validator to a liquid staking account would make liquid staking accounts
+// hold more than the validator liquid cap of its delegator shares. Modules
+// minting derivatives of delegations should call it beforehand.
+func (k Keeper) CheckExceedsValidatorLiquidCap(ctx sdk.Context, valAddr sdk.ValAddress, newShares math.LegacyDec) bool {
+	liquidCap := k.ValidatorLiquidCap(ctx)
+	if liquidCap.GTE(math.LegacyOneDec()) {
+		return false
+	}
+
+	totalShares := newShares
+	if validator, found := k.GetValidator(ctx, valAddr); found {
+		totalShares = totalShares.Add(validator.DelegatorShares)
+	}
+	if !totalShares.IsPositive() {
+		return false
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(newShares)
+	return liquidShares.Quo(totalShares).GT(liquidCap)
+}
+
+// CheckExceedsGlobalLiquidCap returns whether delegating tokens from a liquid
+// staking account would make liquid staking accounts hold more than the global
+// liquid cap of the total bonded tokens.
+func (k Keeper) CheckExceedsGlobalLiquidCap(ctx sdk.Context, tokens math.Int) bool {
+	liquidCap := k.GlobalLiquidCap(ctx)
+	if liquidCap.GTE(math.LegacyOneDec()) {
+		return false
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx).Add(tokens)
+	if !totalBonded.IsPositive() {
+		return false
+	}
+
+	liquidTokens := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	return math.LegacyNewDecFromInt(liquidTokens).QuoInt(totalBonded).GT(liquidCap)
+}
+
+// checkValidatorLiquidCap returns an error if delegating amount from a liquid
+// staking account to the validator would exceed the validator liquid cap.
+func (k Keeper) checkValidatorLiquidCap(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, amount math.Int) error {
+	if !k.IsLiquidStakingAccount(ctx, delAddr) {
+		return nil
+	}
+
+	newShares := math.LegacyNewDecFromInt(amount)
+	if validator.DelegatorShares.IsPositive() {
+		shares, err := validator.SharesFromTokens(amount)
+		if err != nil {
+			// rejected by the delegation itself
+			return nil
+		}
+		newShares = shares
+	}
+
+	if k.CheckExceedsValidatorLiquidCap(ctx, validator.GetOperator(), newShares) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorLiquidCapExceeded,
+			"validator %s, liquid cap is %s", validator.OperatorAddress, k.ValidatorLiquidCap(ctx),
+		)
+	}
+
+	return nil
+}
+
+// checkGlobalLiquidCap returns an error if delegating amount from a liquid
+// staking account would exceed the global liquid cap.
+func (k Keeper) checkGlobalLiquidCap(ctx sdk.Context, delAddr sdk.AccAddress, amount math.Int) error {
+	if !k.IsLiquidStakingAccount(ctx, delAddr) {
+		return nil
+	}
+
+	if k.CheckExceedsGlobalLiquidCap(ctx, amount) {
+		return errorsmod.Wrapf(types.ErrGlobalLiquidCapExceeded, "liquid cap is %s", k.GlobalLiquidCap(ctx))
+	}
+
+	return nil
+}
+
+// updateLiquidShares applies the change between the stored delegation (if any)
+// at the given key and its new shares to the liquid shares of its validator,
+// provided the delegator is a liquid staking account.
+func (k Keeper) updateLiquidShares(ctx sdk.Context, key []byte, delAddr sdk.AccAddress, valAddr sdk.ValAddress, newShares math.LegacyDec) {
+	if !k.IsLiquidStakingAccount(ctx, delAddr) {
+		return
+	}
+
+	delta := newShares
+	if bz := ctx.KVStore(k.storeKey).Get(key); bz != nil {
+		delta = delta.Sub(types.MustUnmarshalDelegation(k.cdc, bz).Shares)
+	}
+
+	if delta.IsZero() {
+		return
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delta))
+}
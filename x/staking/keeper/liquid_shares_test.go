@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorLiquidShares() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	liquidAcc := authtypes.NewEmptyModuleAccount("liquid")
+	liquidAddr := liquidAcc.GetAddress()
+	delAddrs, valAddrs := createValAddrs(1)
+	for _, addr := range []sdk.AccAddress{liquidAddr, delAddrs[0]} {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+	}
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), liquidAddr).Return(liquidAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(delAddrs[0])).AnyTimes()
+	keeper.SetAccountTypeChecker(stakingkeeper.NewModuleAccountTypeChecker(s.accountKeeper))
+	require.True(keeper.IsLiquidStakingAccount(ctx, liquidAddr))
+	require.False(keeper.IsLiquidStakingAccount(ctx, delAddrs[0]))
+
+	// the validator is worth half of its shares after a slash
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator.Tokens = math.NewInt(50)
+	validator.DelegatorShares = math.LegacyNewDec(100)
+	keeper.SetValidator(ctx, validator)
+
+	liquidSharesOf := func() (math.LegacyDec, math.Int) {
+		res, err := queryClient.ValidatorLiquidShares(ctx, &stakingtypes.QueryValidatorLiquidSharesRequest{ValidatorAddr: valAddrs[0].String()})
+		require.NoError(err)
+		return res.LiquidShares, res.LiquidTokens
+	}
+	requireInvariant := func() {
+		_, broken := stakingkeeper.LiquidSharesInvariant(keeper)(ctx)
+		require.False(broken)
+	}
+
+	shares, tokens := liquidSharesOf()
+	require.True(shares.IsZero())
+	require.True(tokens.IsZero())
+
+	// only the delegations of liquid staking accounts are counted
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(liquidAddr, valAddrs[0], math.LegacyNewDec(40)))
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddrs[0], valAddrs[0], math.LegacyNewDec(60)))
+	shares, tokens = liquidSharesOf()
+	require.Equal(math.LegacyNewDec(40), shares)
+	require.Equal(math.NewInt(20), tokens)
+	requireInvariant()
+
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(liquidAddr, valAddrs[0], math.LegacyNewDec(30)))
+	shares, _ = liquidSharesOf()
+	require.Equal(math.LegacyNewDec(30), shares)
+	requireInvariant()
+
+	// the caps are disabled by default
+	require.False(keeper.CheckExceedsValidatorLiquidCap(ctx, valAddrs[0], math.LegacyNewDec(1000)))
+	require.False(keeper.CheckExceedsGlobalLiquidCap(ctx, math.NewInt(1000)))
+
+	params := keeper.GetParams(ctx)
+	params.ValidatorLiquidCap = math.LegacyNewDecWithPrec(5, 1)
+	params.GlobalLiquidCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// (30 + 40) / (100 + 40) is at the cap, (30 + 60) / (100 + 60) above it
+	require.False(keeper.CheckExceedsValidatorLiquidCap(ctx, valAddrs[0], math.LegacyNewDec(40)))
+	require.True(keeper.CheckExceedsValidatorLiquidCap(ctx, valAddrs[0], math.LegacyNewDec(60)))
+
+	// 15 liquid tokens out of 100 bonded ones
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)).AnyTimes()
+	require.Equal(math.NewInt(15), keeper.GetTotalLiquidStakedTokens(ctx))
+	require.False(keeper.CheckExceedsGlobalLiquidCap(ctx, math.NewInt(70)))
+	require.True(keeper.CheckExceedsGlobalLiquidCap(ctx, math.NewInt(80)))
+
+	// delegations from liquid staking accounts exceeding the caps are rejected
+	_, err := s.msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: liquidAddr.String(),
+		ValidatorAddress: valAddrs[0].String(),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 30),
+	})
+	require.ErrorIs(err, stakingtypes.ErrValidatorLiquidCapExceeded)
+
+	params.ValidatorLiquidCap = math.LegacyOneDec()
+	require.NoError(keeper.SetParams(ctx, params))
+	_, err = s.msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: liquidAddr.String(),
+		ValidatorAddress: valAddrs[0].String(),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 80),
+	})
+	require.ErrorIs(err, stakingtypes.ErrGlobalLiquidCapExceeded)
+
+	// so is cancelling an unbonding delegation of a liquid staking account
+	keeper.SetUnbondingDelegation(ctx, stakingtypes.NewUnbondingDelegation(liquidAddr, valAddrs[0], 10, ctx.BlockTime().Add(time.Minute), math.NewInt(80), 1))
+	cancelUnbonding := func(amount int64) error {
+		_, err := s.msgServer.CancelUnbondingDelegation(ctx, &stakingtypes.MsgCancelUnbondingDelegation{
+			DelegatorAddress: liquidAddr.String(),
+			ValidatorAddress: valAddrs[0].String(),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+			CreationHeight:   10,
+		})
+		return err
+	}
+	require.ErrorIs(cancelUnbonding(80), stakingtypes.ErrGlobalLiquidCapExceeded)
+
+	params.ValidatorLiquidCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.SetParams(ctx, params))
+	require.ErrorIs(cancelUnbonding(30), stakingtypes.ErrValidatorLiquidCapExceeded)
+
+	// removing the delegation removes its shares
+	delegation, found := keeper.GetDelegation(ctx, liquidAddr, valAddrs[0])
+	require.True(found)
+	require.NoError(keeper.RemoveDelegation(ctx, delegation))
+	shares, _ = liquidSharesOf()
+	require.True(shares.IsZero())
+	requireInvariant()
+
+	// the invariant detects counters out of sync with the delegations
+	keeper.SetValidatorLiquidShares(ctx, valAddrs[0], math.LegacyNewDec(1))
+	_, broken := stakingkeeper.LiquidSharesInvariant(keeper)(ctx)
+	require.True(broken)
+}
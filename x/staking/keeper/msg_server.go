@@ -45,6 +45,10 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.validateBondDenom(ctx, msg.Value.Denom); err != nil {
+		return nil, err
+	}
+
 	if msg.Commission.Rate.LT(k.MinCommissionRate(ctx)) {
 		return nil, errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
@@ -63,33 +67,12 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, types.ErrValidatorPubKeyExists
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Value.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Value.Denom, bondDenom,
-		)
-	}
-
 	if _, err := msg.Description.EnsureLength(); err != nil {
 		return nil, err
 	}
 
-	cp := ctx.ConsensusParams()
-	if cp.Validator != nil {
-		pkType := pk.Type()
-		hasKeyType := false
-		for _, keyType := range cp.Validator.PubKeyTypes {
-			if pkType == keyType {
-				hasKeyType = true
-				break
-			}
-		}
-		if !hasKeyType {
-			return nil, errorsmod.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	if err := validatePubKeyType(ctx, pk); err != nil {
+		return nil, err
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
@@ -155,6 +138,13 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		)
 	}
 
+	if msg.MinDelegation != nil && msg.MinDelegation.IsNegative() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"minimum delegation cannot be negative",
+		)
+	}
+
 	if msg.CommissionRate != nil {
 		if msg.CommissionRate.GT(math.LegacyOneDec()) || msg.CommissionRate.IsNegative() {
 			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
@@ -203,6 +193,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		validator.MinSelfDelegation = *msg.MinSelfDelegation
 	}
 
+	if msg.MinDelegation != nil {
+		validator.MinDelegation = *msg.MinDelegation
+	}
+
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -210,6 +204,7 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			types.EventTypeEditValidator,
 			sdk.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.String()),
 			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyMinDelegation, validator.GetMinDelegation().String()),
 		),
 	})
 
@@ -237,16 +232,33 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.validateBondDenom(ctx, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
 		return nil, types.ErrNoValidatorFound
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
+	if err := k.checkMaxValidatorPowerFraction(ctx, delegatorAddress, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkMinDelegation(ctx, delegatorAddress, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkMinSelfDelegationRatio(ctx, delegatorAddress, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkValidatorLiquidCap(ctx, delegatorAddress, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkGlobalLiquidCap(ctx, delegatorAddress, msg.Amount.Amount); err != nil {
+		return nil, err
 	}
 
 	// NOTE: source funds are always unbonded
@@ -304,6 +316,10 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.validateBondDenom(ctx, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
 	shares, err := k.ValidateUnbondAmount(
 		ctx, delegatorAddress, valSrcAddr, msg.Amount.Amount,
 	)
@@ -311,11 +327,24 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
+	// a missing destination validator is rejected by BeginRedelegation
+	if dstValidator, found := k.GetValidator(ctx, valDstAddr); found {
+		if err := k.checkMaxValidatorPowerFraction(ctx, delegatorAddress, dstValidator, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.checkMinDelegation(ctx, delegatorAddress, dstValidator, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.checkMinSelfDelegationRatio(ctx, delegatorAddress, dstValidator, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+
+		// redelegations don't change the total liquid staked tokens
+		if err := k.checkValidatorLiquidCap(ctx, delegatorAddress, dstValidator, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
 	}
 
 	completionTime, err := k.BeginRedelegation(
@@ -372,6 +401,10 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.validateBondDenom(ctx, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
 	shares, err := k.ValidateUnbondAmount(
 		ctx, delegatorAddress, addr, msg.Amount.Amount,
 	)
@@ -379,13 +412,6 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		return nil, err
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
-	}
-
 	completionTime, undelegatedAmt, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -448,11 +474,8 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
+	if err := k.validateBondDenom(ctx, msg.Amount.Denom); err != nil {
+		return nil, err
 	}
 
 	validator, found := k.GetValidator(ctx, valAddr)
@@ -504,6 +527,23 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
 	}
 
+	// delegating back is subject to the same caps as a delegation
+	if err := k.checkMaxValidatorPowerFraction(ctx, delegatorAddress, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkMinSelfDelegationRatio(ctx, delegatorAddress, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkValidatorLiquidCap(ctx, delegatorAddress, validator, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkGlobalLiquidCap(ctx, delegatorAddress, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
 	// delegate back the unbonding delegation amount to the validator
 	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
 	if err != nil {
@@ -540,6 +580,61 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
 
+// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.NewPubkey == nil {
+		return nil, types.ErrEmptyValidatorPubKey
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := validatePubKeyType(ctx, pk); err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	// the operator pays the rotation fee to the community pool
+	if fee := k.GetParams(ctx).KeyRotationFee; !fee.IsNil() && fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.KeyRotationFeeRecipientName, sdk.NewCoins(fee)); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(pk).String()),
+		),
+	})
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
@@ -558,3 +653,34 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// validatePubKeyType checks that the consensus params allow the type of the
+// given consensus pubkey.
+func validatePubKeyType(ctx sdk.Context, pk cryptotypes.PubKey) error {
+	cp := ctx.ConsensusParams()
+	if cp.Validator == nil {
+		return nil
+	}
+
+	pkType := pk.Type()
+	for _, keyType := range cp.Validator.PubKeyTypes {
+		if pkType == keyType {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(
+		types.ErrValidatorPubKeyTypeNotSupported,
+		"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+	)
+}
+
+// validateBondDenom rejects coins of another denom than the bond denom before
+// any other state is read.
+func (k msgServer) validateBondDenom(ctx sdk.Context, denom string) error {
+	if bondDenom := k.BondDenom(ctx); denom != bondDenom {
+		return types.ErrInvalidBondDenom.Wrapf("got %s, expected %s", denom, bondDenom)
+	}
+
+	return nil
+}
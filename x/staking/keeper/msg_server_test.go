@@ -4,14 +4,19 @@ import (
 	"testing"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	"github.com/golang/mock/gomock"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -705,6 +710,280 @@ func (s *KeeperTestSuite) TestMsgBeginRedelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMaxValidatorPowerFraction() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	dstValAddr := sdk.ValAddress(PKS[2].Address())
+	s.accountKeeper.EXPECT().StringToBytes(delAddr.String()).Return(delAddr, nil).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	// the total bonded tokens are 1000, so validators may hold up to 200
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)).AnyTimes()
+
+	setMaxFraction := func(fraction math.LegacyDec) {
+		params := keeper.GetParams(ctx)
+		params.MaxValidatorPowerFraction = fraction
+		require.NoError(keeper.SetParams(ctx, params))
+	}
+	setMaxFraction(math.LegacyNewDecWithPrec(2, 1))
+
+	// the operator of the validator self-bonds 100 tokens but needs 150
+	validator := stakingtestutil.NewValidator(s.T(), ValAddr, PKS[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(100))
+	validator.MinSelfDelegation = math.NewInt(150)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(Addr, ValAddr, math.LegacyNewDec(100)))
+
+	dstValidator := stakingtestutil.NewValidator(s.T(), dstValAddr, PKS[2])
+	dstValidator, _ = dstValidator.AddTokensFromDel(math.NewInt(150))
+	keeper.SetValidator(ctx, dstValidator)
+
+	delegate := func(delAddr sdk.AccAddress, amount int64) error {
+		_, err := msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: ValAddr.String(),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		return err
+	}
+
+	redelegate := func(amount int64) error {
+		_, err := msgServer.BeginRedelegate(ctx, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorSrcAddress: ValAddr.String(),
+			ValidatorDstAddress: dstValAddr.String(),
+			Amount:              sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		return err
+	}
+
+	// above the cap
+	err := delegate(delAddr, 101)
+	require.ErrorIs(err, stakingtypes.ErrMaxValidatorPowerFraction)
+	require.ErrorContains(err, "holds 0.100000000000000000 of the total bonded tokens and would hold 0.201000000000000000, max is 0.200000000000000000")
+
+	// at the cap
+	require.NoError(delegate(delAddr, 100))
+
+	// the self-bond top-up to the min self delegation is exempt, but not more
+	require.NoError(delegate(Addr, 50))
+	require.ErrorIs(delegate(Addr, 1), stakingtypes.ErrMaxValidatorPowerFraction)
+
+	// the destination of a redelegation is capped
+	require.ErrorIs(redelegate(51), stakingtypes.ErrMaxValidatorPowerFraction)
+	require.NoError(redelegate(50))
+
+	// cancelling an unbonding delegation delegates back, which is capped too
+	keeper.SetUnbondingDelegation(ctx, stakingtypes.NewUnbondingDelegation(delAddr, ValAddr, 10, ctx.BlockTime().Add(10*time.Minute), math.NewInt(100), 1))
+	cancelUnbonding := func(amount int64) error {
+		_, err := msgServer.CancelUnbondingDelegation(ctx, &stakingtypes.MsgCancelUnbondingDelegation{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: ValAddr.String(),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+			CreationHeight:   10,
+		})
+		return err
+	}
+	require.ErrorIs(cancelUnbonding(1), stakingtypes.ErrMaxValidatorPowerFraction)
+
+	// disabled cap
+	setMaxFraction(math.LegacyZeroDec())
+	require.NoError(delegate(delAddr, 500))
+	require.NoError(redelegate(50))
+	require.NoError(cancelUnbonding(100))
+}
+
+func (s *KeeperTestSuite) TestMinDelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	newDelAddr := sdk.AccAddress(PKs[10].Address())
+	dstValAddr := sdk.ValAddress(PKS[2].Address())
+	for _, addr := range []sdk.AccAddress{delAddr, newDelAddr} {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+	}
+
+	validator := stakingtestutil.NewValidator(s.T(), ValAddr, PKS[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(100))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(Addr, ValAddr, math.LegacyNewDec(100)))
+
+	dstValidator := stakingtestutil.NewValidator(s.T(), dstValAddr, PKS[2])
+	dstValidator, _ = dstValidator.AddTokensFromDel(math.NewInt(100))
+	dstValidator.MinDelegation = math.NewInt(100)
+	keeper.SetValidator(ctx, dstValidator)
+
+	editMinDelegation := func(minDelegation math.Int) error {
+		msg := stakingtypes.NewMsgEditValidator(ValAddr, stakingtypes.Description{Moniker: "TestValidator"}, nil, nil)
+		msg.MinDelegation = &minDelegation
+		_, err := msgServer.EditValidator(ctx, msg)
+		return err
+	}
+
+	delegate := func(delAddr sdk.AccAddress, amount int64) error {
+		_, err := msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: ValAddr.String(),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		return err
+	}
+
+	redelegate := func(amount int64) error {
+		_, err := msgServer.BeginRedelegate(ctx, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorSrcAddress: ValAddr.String(),
+			ValidatorDstAddress: dstValAddr.String(),
+			Amount:              sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		return err
+	}
+
+	// the operator sets the minimum delegation of its validator
+	require.ErrorContains(editMinDelegation(math.NewInt(-1)), "minimum delegation cannot be negative")
+	require.NoError(editMinDelegation(math.NewInt(100)))
+	res, err := s.queryClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal(math.NewInt(100), res.Validator.MinDelegation)
+
+	// a new delegation below the minimum is rejected
+	err = delegate(delAddr, 99)
+	require.ErrorIs(err, stakingtypes.ErrDelegationBelowMinimum)
+	require.ErrorContains(err, "got 99, minimum delegation to validator "+ValAddr.String()+" is 100")
+	require.NoError(delegate(delAddr, 100))
+
+	// an existing delegation may be topped up by any amount
+	require.NoError(delegate(delAddr, 1))
+
+	// the existing self delegation of the operator is grandfathered
+	require.NoError(delegate(Addr, 1))
+
+	// the destination of a redelegation enforces its minimum
+	require.ErrorIs(redelegate(50), stakingtypes.ErrDelegationBelowMinimum)
+	require.NoError(redelegate(100))
+	require.NoError(redelegate(1))
+
+	// the global minimum applies when it is higher than the validator one
+	params := keeper.GetParams(ctx)
+	params.GlobalMinDelegation = math.NewInt(200)
+	require.NoError(keeper.SetParams(ctx, params))
+	require.ErrorIs(delegate(newDelAddr, 150), stakingtypes.ErrDelegationBelowMinimum)
+
+	// removing the validator minimum leaves the global one
+	require.NoError(editMinDelegation(math.ZeroInt()))
+	require.ErrorIs(delegate(newDelAddr, 199), stakingtypes.ErrDelegationBelowMinimum)
+	require.NoError(delegate(newDelAddr, 200))
+}
+
+func (s *KeeperTestSuite) TestMinSelfDelegationRatio() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	dstValAddr := sdk.ValAddress(PKS[2].Address())
+	s.accountKeeper.EXPECT().StringToBytes(delAddr.String()).Return(delAddr, nil).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	setRatio := func(ratio math.LegacyDec) {
+		params := keeper.GetParams(ctx)
+		params.MinSelfDelegationRatio = ratio
+		require.NoError(keeper.SetParams(ctx, params))
+	}
+	setRatio(math.LegacyNewDecWithPrec(5, 1))
+
+	validator := stakingtestutil.NewValidator(s.T(), ValAddr, PKS[0])
+	validator, _ = validator.AddTokensFromDel(math.NewInt(100))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(Addr, ValAddr, math.LegacyNewDec(100)))
+
+	dstValidator := stakingtestutil.NewValidator(s.T(), dstValAddr, PKS[2])
+	dstValidator, _ = dstValidator.AddTokensFromDel(math.NewInt(50))
+	keeper.SetValidator(ctx, dstValidator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(dstValAddr), dstValAddr, math.LegacyNewDec(50)))
+
+	delegate := func(delAddr sdk.AccAddress, amount int64) error {
+		_, err := msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: ValAddr.String(),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		return err
+	}
+
+	redelegate := func(amount int64) error {
+		_, err := msgServer.BeginRedelegate(ctx, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delAddr.String(),
+			ValidatorSrcAddress: ValAddr.String(),
+			ValidatorDstAddress: dstValAddr.String(),
+			Amount:              sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		return err
+	}
+
+	cancelUnbonding := func(delAddr sdk.AccAddress, amount int64) error {
+		_, err := msgServer.CancelUnbondingDelegation(ctx, &stakingtypes.MsgCancelUnbondingDelegation{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: ValAddr.String(),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+			CreationHeight:   10,
+		})
+		return err
+	}
+
+	// the self-delegation of 100 may back up to 200 tokens
+	err := delegate(delAddr, 101)
+	require.ErrorIs(err, stakingtypes.ErrMinSelfDelegationRatio)
+	require.ErrorContains(err, "self-delegation of 100 would be below 0.500000000000000000 of its 201 tokens; 1 additional self-delegation required")
+	require.NoError(delegate(delAddr, 100))
+	require.ErrorIs(delegate(delAddr, 1), stakingtypes.ErrMinSelfDelegationRatio)
+
+	// self-delegations are always accepted and make room for more delegations
+	require.NoError(delegate(Addr, 200))
+	require.NoError(delegate(delAddr, 100))
+
+	// slashing half of the 500 tokens halves the self-delegation, and so the
+	// room left for delegations from 100 to 50 tokens
+	validator, found := keeper.GetValidator(ctx, ValAddr)
+	require.True(found)
+	keeper.RemoveValidatorTokens(ctx, validator, math.NewInt(250))
+	err = delegate(delAddr, 100)
+	require.ErrorIs(err, stakingtypes.ErrMinSelfDelegationRatio)
+	require.ErrorContains(err, "self-delegation of 150 would be below 0.500000000000000000 of its 350 tokens; 50 additional self-delegation required")
+
+	// the required self-delegation makes the delegation acceptable
+	require.NoError(delegate(Addr, 50))
+	require.NoError(delegate(delAddr, 100))
+
+	// the destination of a redelegation enforces its ratio
+	require.ErrorIs(redelegate(51), stakingtypes.ErrMinSelfDelegationRatio)
+	require.NoError(redelegate(50))
+
+	// cancelling an unbonding delegation delegates back, which enforces the
+	// ratio too, except for the operator cancelling their own unbonding
+	completionTime := ctx.BlockTime().Add(10 * time.Minute)
+	keeper.SetUnbondingDelegation(ctx, stakingtypes.NewUnbondingDelegation(delAddr, ValAddr, 10, completionTime, math.NewInt(100), 1))
+	keeper.SetUnbondingDelegation(ctx, stakingtypes.NewUnbondingDelegation(Addr, ValAddr, 10, completionTime, math.NewInt(100), 2))
+	err = cancelUnbonding(delAddr, 51)
+	require.ErrorIs(err, stakingtypes.ErrMinSelfDelegationRatio)
+	require.ErrorContains(err, "self-delegation of 200 would be below 0.500000000000000000 of its 401 tokens; 1 additional self-delegation required")
+	require.NoError(cancelUnbonding(delAddr, 50))
+	require.ErrorIs(cancelUnbonding(delAddr, 1), stakingtypes.ErrMinSelfDelegationRatio)
+	require.NoError(cancelUnbonding(Addr, 100))
+
+	// disabled ratio
+	setRatio(math.LegacyZeroDec())
+	require.NoError(delegate(delAddr, 500))
+	require.NoError(redelegate(50))
+}
+
 func (s *KeeperTestSuite) TestMsgUndelegate() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
@@ -994,6 +1273,238 @@ func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegation() {
 	}
 }
 
+// TestInvalidBondDenom checks that every message moving stake rejects coins of
+// another denom than the bond denom with the same error, before reading the
+// validators or delegations.
+func (s *KeeperTestSuite) TestInvalidBondDenom() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	// no validator nor delegation exists
+	coin := sdk.NewInt64Coin("stake2", 100)
+	testCases := []struct {
+		name string
+		exec func() error
+	}{
+		{
+			name: "delegate",
+			exec: func() error {
+				_, err := msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+					DelegatorAddress: Addr.String(), ValidatorAddress: ValAddr.String(), Amount: coin,
+				})
+				return err
+			},
+		},
+		{
+			name: "begin redelegate",
+			exec: func() error {
+				_, err := msgServer.BeginRedelegate(ctx, &stakingtypes.MsgBeginRedelegate{
+					DelegatorAddress: Addr.String(), ValidatorSrcAddress: ValAddr.String(),
+					ValidatorDstAddress: sdk.ValAddress(PKS[1].Address()).String(), Amount: coin,
+				})
+				return err
+			},
+		},
+		{
+			name: "undelegate",
+			exec: func() error {
+				_, err := msgServer.Undelegate(ctx, &stakingtypes.MsgUndelegate{
+					DelegatorAddress: Addr.String(), ValidatorAddress: ValAddr.String(), Amount: coin,
+				})
+				return err
+			},
+		},
+		{
+			name: "cancel unbonding delegation",
+			exec: func() error {
+				_, err := msgServer.CancelUnbondingDelegation(ctx, &stakingtypes.MsgCancelUnbondingDelegation{
+					DelegatorAddress: Addr.String(), ValidatorAddress: ValAddr.String(), Amount: coin, CreationHeight: 1,
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			err := tc.exec()
+			require.ErrorIs(err, stakingtypes.ErrInvalidBondDenom)
+			require.ErrorContains(err, "got stake2, expected stake")
+
+			codespace, code, _ := errorsmod.ABCIInfo(err, false)
+			require.Equal(stakingtypes.ModuleName, codespace)
+			require.Equal(uint32(49), code)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRotateConsPubKey() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.MaxConsPubkeyRotations = 2
+	require.NoError(keeper.SetParams(ctx, params))
+
+	validators := make([]stakingtypes.Validator, 2)
+	for i := range validators {
+		validators[i] = stakingtestutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], true)
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validators[i]))
+	}
+
+	newPubKey := func(pk cryptotypes.PubKey) *codectypes.Any {
+		pkAny, err := codectypes.NewAnyWithValue(pk)
+		require.NoError(err)
+		return pkAny
+	}
+
+	// the operator of validators[0] pays the fee of its three rotations
+	fee := sdk.NewCoins(params.KeyRotationFee)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(validators[0].GetOperator()), stakingtypes.KeyRotationFeeRecipientName, fee).Times(3)
+
+	testCases := []struct {
+		name      string
+		height    int64
+		input     *stakingtypes.MsgRotateConsPubKey
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:   "invalid validator address",
+			height: 1,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: sdk.AccAddress([]byte("invalid")).String(),
+				NewPubkey:        newPubKey(PKs[10]),
+			},
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name:   "empty pubkey",
+			height: 1,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: validators[0].OperatorAddress,
+			},
+			expErr:    true,
+			expErrMsg: "empty validator public key",
+		},
+		{
+			name:   "validator not found",
+			height: 1,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: sdk.ValAddress(PKs[20].Address()).String(),
+				NewPubkey:        newPubKey(PKs[10]),
+			},
+			expErr:    true,
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name:   "pubkey used by another validator",
+			height: 1,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: validators[0].OperatorAddress,
+				NewPubkey:        newPubKey(PKs[1]),
+			},
+			expErr:    true,
+			expErrMsg: "validator already exist for this pubkey",
+		},
+		{
+			name:   "valid rotation",
+			height: 1,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: validators[0].OperatorAddress,
+				NewPubkey:        newPubKey(PKs[10]),
+			},
+		},
+		{
+			name:   "rotation twice in a block",
+			height: 1,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: validators[0].OperatorAddress,
+				NewPubkey:        newPubKey(PKs[11]),
+			},
+			expErr:    true,
+			expErrMsg: "already rotated in this block",
+		},
+		{
+			name:   "pubkey rotated out within unbonding period",
+			height: 2,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: validators[1].OperatorAddress,
+				NewPubkey:        newPubKey(PKs[0]),
+			},
+			expErr:    true,
+			expErrMsg: "validator already exist for this pubkey",
+		},
+		{
+			name:   "second rotation",
+			height: 2,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: validators[0].OperatorAddress,
+				NewPubkey:        newPubKey(PKs[11]),
+			},
+		},
+		{
+			name:   "exceeding maximum rotations",
+			height: 3,
+			input: &stakingtypes.MsgRotateConsPubKey{
+				ValidatorAddress: validators[0].OperatorAddress,
+				NewPubkey:        newPubKey(PKs[12]),
+			},
+			expErr:    true,
+			expErrMsg: "exceeding maximum consensus pubkey rotations",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.RotateConsPubKey(ctx.WithBlockHeight(tc.height), tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+
+				valAddr, err := sdk.ValAddressFromBech32(tc.input.ValidatorAddress)
+				require.NoError(err)
+
+				validator, found := keeper.GetValidator(ctx, valAddr)
+				require.True(found)
+				require.Equal(tc.input.NewPubkey.Value, validator.ConsensusPubkey.Value)
+			}
+		})
+	}
+
+	// the old consensus addresses resolve to the current one
+	newConsAddr := sdk.GetConsAddress(PKs[11])
+	require.Equal(newConsAddr, keeper.ValidatorIdentifier(ctx, sdk.GetConsAddress(PKs[0])))
+	require.Equal(newConsAddr, keeper.ValidatorIdentifier(ctx, sdk.GetConsAddress(PKs[10])))
+	require.Equal(newConsAddr, keeper.ValidatorIdentifier(ctx, newConsAddr))
+	require.Len(keeper.GetValidatorConsPubKeyRotations(ctx, validators[0].GetOperator()), 2)
+
+	// rotations no longer count once the unbonding period elapsed
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(ctx.BlockTime().Add(params.UnbondingTime))
+	_, err := msgServer.RotateConsPubKey(ctx, &stakingtypes.MsgRotateConsPubKey{
+		ValidatorAddress: validators[0].OperatorAddress,
+		NewPubkey:        newPubKey(PKs[12]),
+	})
+	require.NoError(err)
+
+	// the rotation fails when the operator can't pay the fee
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(validators[1].GetOperator()), stakingtypes.KeyRotationFeeRecipientName, fee).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = msgServer.RotateConsPubKey(ctx, &stakingtypes.MsgRotateConsPubKey{
+		ValidatorAddress: validators[1].OperatorAddress,
+		NewPubkey:        newPubKey(PKs[13]),
+	})
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
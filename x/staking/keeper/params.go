@@ -25,6 +25,12 @@ func (k Keeper) MaxEntries(ctx sdk.Context) uint32 {
 	return k.GetParams(ctx).MaxEntries
 }
 
+// MaxEntriesPerBlock - Maximum number of matured unbonding delegation
+// entries, and of matured redelegation entries, completed per block
+func (k Keeper) MaxEntriesPerBlock(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxEntriesPerBlock
+}
+
 // HistoricalEntries = number of historical info entries
 // to persist in store
 func (k Keeper) HistoricalEntries(ctx sdk.Context) uint32 {
@@ -49,6 +55,61 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// MaxValidatorPowerFraction - Maximum fraction of the total bonded tokens a
+// validator may hold after a delegation, zero if disabled
+func (k Keeper) MaxValidatorPowerFraction(ctx sdk.Context) math.LegacyDec {
+	fraction := k.GetParams(ctx).MaxValidatorPowerFraction
+	if fraction.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return fraction
+}
+
+// GlobalMinDelegation - Minimum amount of a new delegation to any validator,
+// zero if disabled
+func (k Keeper) GlobalMinDelegation(ctx sdk.Context) math.Int {
+	minDelegation := k.GetParams(ctx).GlobalMinDelegation
+	if minDelegation.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return minDelegation
+}
+
+// ValidatorLiquidCap - Maximum fraction of the delegator shares of a validator
+// held by liquid staking accounts, 100% if unset
+func (k Keeper) ValidatorLiquidCap(ctx sdk.Context) math.LegacyDec {
+	liquidCap := k.GetParams(ctx).ValidatorLiquidCap
+	if liquidCap.IsNil() {
+		return math.LegacyOneDec()
+	}
+
+	return liquidCap
+}
+
+// GlobalLiquidCap - Maximum fraction of the total bonded tokens delegated by
+// liquid staking accounts, 100% if unset
+func (k Keeper) GlobalLiquidCap(ctx sdk.Context) math.LegacyDec {
+	liquidCap := k.GetParams(ctx).GlobalLiquidCap
+	if liquidCap.IsNil() {
+		return math.LegacyOneDec()
+	}
+
+	return liquidCap
+}
+
+// MinSelfDelegationRatio - Minimum ratio of the self-delegation of a
+// validator to its tokens, zero if disabled
+func (k Keeper) MinSelfDelegationRatio(ctx sdk.Context) math.LegacyDec {
+	ratio := k.GetParams(ctx).MinSelfDelegationRatio
+	if ratio.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return ratio
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
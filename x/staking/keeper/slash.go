@@ -163,10 +163,16 @@ func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddr
 
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	k.JailWithReason(ctx, consAddr, types.JailReason_JAIL_REASON_UNSPECIFIED, "")
+}
+
+// JailWithReason jails a validator and records the reason, as given by the
+// caller, in its jail info.
+func (k Keeper) JailWithReason(ctx sdk.Context, consAddr sdk.ConsAddress, reason types.JailReason, details string) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
-	k.jailValidator(ctx, validator)
+	k.jailValidator(ctx, validator, reason, details)
 	logger := k.Logger(ctx)
-	logger.Info("validator jailed", "validator", consAddr)
+	logger.Info("validator jailed", "validator", consAddr, "reason", reason)
 }
 
 // unjail a validator
@@ -242,9 +248,10 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = math.ZeroInt()
 	bondedBurnedAmount, notBondedBurnedAmount := math.ZeroInt(), math.ZeroInt()
+	slashed := false
 
 	// perform slashing on all entries within the redelegation
-	for _, entry := range redelegation.Entries {
+	for i, entry := range redelegation.Entries {
 		// If redelegation started before this height, stake didn't contribute to infraction
 		if entry.CreationHeight < infractionHeight {
 			continue
@@ -306,6 +313,15 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		default:
 			panic("unknown validator status")
 		}
+
+		// the burned tokens no longer count towards the redelegating balance
+		// of the source validator
+		redelegation.Entries[i].Balance = entry.Balance.Sub(math.MinInt(tokensToBurn, entry.Balance))
+		slashed = true
+	}
+
+	if slashed {
+		k.SetRedelegation(ctx, redelegation)
 	}
 
 	if err := k.burnBondedTokens(ctx, bondedBurnedAmount); err != nil {
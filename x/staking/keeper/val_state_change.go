@@ -34,8 +34,10 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
-	// Remove all mature unbonding delegations from the ubd queue.
-	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
+	// Remove the mature unbonding delegations from the ubd queue, up to the
+	// maximum number of entries per block.
+	maxEntriesPerBlock := k.MaxEntriesPerBlock(ctx)
+	matureUnbonds := k.DequeueMatureUBDQueue(ctx, ctx.BlockHeader().Time, maxEntriesPerBlock)
 	for _, dvPair := range matureUnbonds {
 		addr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
 		if err != nil {
@@ -61,8 +63,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
-	// Remove all mature redelegations from the red queue.
-	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	// Remove the mature redelegations from the red queue, up to the maximum
+	// number of entries per block.
+	matureRedelegations := k.DequeueMatureRedelegationQueue(ctx, ctx.BlockHeader().Time, maxEntriesPerBlock)
 	for _, dvvTriplet := range matureRedelegations {
 		valSrcAddr, err := sdk.ValAddressFromBech32(dvvTriplet.ValidatorSrcAddress)
 		if err != nil {
@@ -98,6 +101,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
+	// Remove all consensus pubkey rotations older than an unbonding period.
+	k.PruneMatureConsPubKeyRotations(ctx)
+
 	return validatorUpdates
 }
 
@@ -128,6 +134,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the consensus pubkey rotations of the current block, the
+	// old consensus pubkeys of the rotated validators are replaced by the
+	// new ones in the CometBFT validator set.
+	rotations := k.getConsPubKeyRotationsAtHeight(ctx, ctx.BlockHeight())
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -177,8 +188,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// update the validator set if power or consensus pubkey has changed
+		history, rotated := rotations[valAddrStr]
+		switch {
+		case found && rotated:
+			updates = append(updates, history.ABCIValidatorUpdateZero(), validator.ABCIValidatorUpdate(powerReduction))
+
+			k.SetLastValidatorPower(ctx, valAddr, newPower)
+		case !found || !bytes.Equal(oldPowerBytes, newPowerBytes):
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -203,7 +220,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// CometBFT only knows the old consensus pubkey of a validator rotated
+		// in the current block
+		if history, rotated := rotations[validator.OperatorAddress]; rotated {
+			updates = append(updates, history.ABCIValidatorUpdateZero())
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -268,7 +292,7 @@ func (k Keeper) UnbondingToUnbonded(ctx sdk.Context, validator types.Validator)
 }
 
 // send a validator to jail
-func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
+func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator, reason types.JailReason, details string) {
 	if validator.Jailed {
 		panic(fmt.Sprintf("cannot jail already jailed validator, validator: %v\n", validator))
 	}
@@ -276,6 +300,7 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.DeleteValidatorByPowerIndex(ctx, validator)
+	k.setValidatorJailInfo(ctx, validator.GetOperator(), reason, details)
 }
 
 // remove a validator from jail
@@ -287,6 +312,7 @@ func (k Keeper) unjailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = false
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
+	k.archiveValidatorJailInfo(ctx, validator.GetOperator())
 }
 
 // perform all the store operations for when a validator status becomes bonded
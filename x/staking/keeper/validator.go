@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -47,6 +48,18 @@ func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress
 	return k.GetValidator(ctx, opAddr)
 }
 
+// GetPubKeyByConsAddr returns the consensus pubkey of the validator with the
+// given consensus address, e.g. to verify its vote extensions with
+// baseapp.ValidateVoteExtensions.
+func (k Keeper) GetPubKeyByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (cryptotypes.PubKey, error) {
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	return validator.ConsPubKey()
+}
+
 func (k Keeper) mustGetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) types.Validator {
 	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
 	if !found {
@@ -183,6 +196,8 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetValidatorJailInfoKey(address))
+	store.Delete(types.GetValidatorLastJailInfoKey(address))
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
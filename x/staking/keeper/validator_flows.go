@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorFlows returns the total balance of the unbonding delegations and
+// outbound redelegations of a validator.
+func (k Keeper) GetValidatorFlows(ctx sdk.Context, valAddr sdk.ValAddress) types.ValidatorFlows {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorFlowsKey(valAddr))
+	if bz == nil {
+		return types.ValidatorFlows{
+			UnbondingBalance:    math.ZeroInt(),
+			RedelegatingBalance: math.ZeroInt(),
+		}
+	}
+
+	var flows types.ValidatorFlows
+	k.cdc.MustUnmarshal(bz, &flows)
+	return flows
+}
+
+// SetValidatorFlows sets the unbonding and redelegating balances of a
+// validator. Empty flows are removed from the store.
+func (k Keeper) SetValidatorFlows(ctx sdk.Context, valAddr sdk.ValAddress, flows types.ValidatorFlows) {
+	store := ctx.KVStore(k.storeKey)
+
+	if flows.UnbondingBalance.IsZero() && flows.RedelegatingBalance.IsZero() {
+		store.Delete(types.GetValidatorFlowsKey(valAddr))
+		return
+	}
+
+	store.Set(types.GetValidatorFlowsKey(valAddr), k.cdc.MustMarshal(&flows))
+}
+
+// IterateValidatorFlows iterates through the unbonding and redelegating
+// balances of all validators.
+func (k Keeper) IterateValidatorFlows(ctx sdk.Context, cb func(valAddr sdk.ValAddress, flows types.ValidatorFlows) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorFlowsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of format prefix || addrLen (1 byte) || valAddr
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorFlowsKey)+1:])
+
+		var flows types.ValidatorFlows
+		k.cdc.MustUnmarshal(iterator.Value(), &flows)
+		if cb(valAddr, flows) {
+			break
+		}
+	}
+}
+
+// updateUnbondingFlows applies the change between the stored unbonding
+// delegation (if any) at the given key and its new version to the unbonding
+// balance of its validator.
+func (k Keeper) updateUnbondingFlows(ctx sdk.Context, key []byte, valAddr sdk.ValAddress, newBalance math.Int) {
+	delta := newBalance
+	if bz := ctx.KVStore(k.storeKey).Get(key); bz != nil {
+		delta = delta.Sub(types.MustUnmarshalUBD(k.cdc, bz).TotalBalance())
+	}
+
+	if delta.IsZero() {
+		return
+	}
+
+	flows := k.GetValidatorFlows(ctx, valAddr)
+	flows.UnbondingBalance = flows.UnbondingBalance.Add(delta)
+	k.SetValidatorFlows(ctx, valAddr, flows)
+}
+
+// updateRedelegatingFlows applies the change between the stored redelegation
+// (if any) at the given key and its new version to the redelegating balance of
+// its source validator.
+func (k Keeper) updateRedelegatingFlows(ctx sdk.Context, key []byte, valSrcAddr sdk.ValAddress, newBalance math.Int) {
+	delta := newBalance
+	if bz := ctx.KVStore(k.storeKey).Get(key); bz != nil {
+		delta = delta.Sub(types.MustUnmarshalRED(k.cdc, bz).TotalBalance())
+	}
+
+	if delta.IsZero() {
+		return
+	}
+
+	flows := k.GetValidatorFlows(ctx, valSrcAddr)
+	flows.RedelegatingBalance = flows.RedelegatingBalance.Add(delta)
+	k.SetValidatorFlows(ctx, valSrcAddr, flows)
+}
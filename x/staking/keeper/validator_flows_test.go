@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorFlows() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	for _, addr := range delAddrs {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+	}
+	keeper.SetValidator(ctx, testutil.NewValidator(s.T(), valAddrs[0], PKs[0]))
+
+	flowsOf := func() stakingtypes.ValidatorFlows {
+		res, err := queryClient.ValidatorFlows(ctx, &stakingtypes.QueryValidatorFlowsRequest{ValidatorAddr: valAddrs[0].String()})
+		require.NoError(err)
+		return res.Flows
+	}
+	requireInvariant := func() {
+		_, broken := stakingkeeper.ValidatorFlowsInvariant(keeper)(ctx)
+		require.False(broken)
+	}
+
+	require.True(flowsOf().UnbondingBalance.IsZero())
+	require.True(flowsOf().RedelegatingBalance.IsZero())
+
+	// create an unbonding delegation with two entries
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	ubd := stakingtypes.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 1, completionTime, math.NewInt(10), 1)
+	ubd.AddEntry(2, completionTime, math.NewInt(20), 2)
+	keeper.SetUnbondingDelegation(ctx, ubd)
+	require.Equal(math.NewInt(30), flowsOf().UnbondingBalance)
+	requireInvariant()
+
+	// slashing the unbonding delegation reduces the unbonding balance
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).Return(nil)
+	keeper.SlashUnbondingDelegation(ctx, ubd, 0, math.LegacyNewDecWithPrec(5, 1))
+	require.Equal(math.NewInt(15), flowsOf().UnbondingBalance)
+	requireInvariant()
+
+	// create an outbound redelegation, whose tokens live in the destination
+	// validator
+	dstVal := testutil.NewValidator(s.T(), valAddrs[1], PKs[1])
+	dstVal, _ = dstVal.AddTokensFromDel(math.NewInt(20))
+	keeper.SetValidator(ctx, dstVal)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddrs[0], valAddrs[1], math.LegacyNewDec(8)))
+	red := stakingtypes.NewRedelegation(delAddrs[0], valAddrs[0], valAddrs[1], 1, completionTime, math.NewInt(8), math.LegacyNewDec(8), 3)
+	keeper.SetRedelegation(ctx, red)
+	require.Equal(math.NewInt(8), flowsOf().RedelegatingBalance)
+	require.True(keeper.GetValidatorFlows(ctx, valAddrs[1]).RedelegatingBalance.IsZero())
+	requireInvariant()
+
+	// slashing the redelegation reduces the redelegating balance by the burned
+	// tokens
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).Return(nil)
+	slashAmount := keeper.SlashRedelegation(ctx, testutil.NewValidator(s.T(), valAddrs[0], PKs[0]), red, 0, math.LegacyNewDecWithPrec(5, 1))
+	require.Equal(math.NewInt(4), slashAmount)
+	require.Equal(math.NewInt(4), flowsOf().RedelegatingBalance)
+	red, found := keeper.GetRedelegation(ctx, delAddrs[0], valAddrs[0], valAddrs[1])
+	require.True(found)
+	require.Equal(math.NewInt(8), red.Entries[0].InitialBalance)
+	require.Equal(math.NewInt(4), red.Entries[0].Balance)
+	requireInvariant()
+
+	// completing an entry removes it from the balances
+	ubd, found = keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	ubd.RemoveEntry(0)
+	keeper.SetUnbondingDelegation(ctx, ubd)
+	require.Equal(math.NewInt(10), flowsOf().UnbondingBalance)
+	requireInvariant()
+
+	keeper.RemoveUnbondingDelegation(ctx, ubd)
+	keeper.RemoveRedelegation(ctx, red)
+	require.True(flowsOf().UnbondingBalance.IsZero())
+	require.True(flowsOf().RedelegatingBalance.IsZero())
+	requireInvariant()
+
+	// the invariant detects counters out of sync with the entries
+	keeper.SetValidatorFlows(ctx, valAddrs[0], stakingtypes.ValidatorFlows{
+		UnbondingBalance:    math.NewInt(1),
+		RedelegatingBalance: math.ZeroInt(),
+	})
+	_, broken := stakingkeeper.ValidatorFlowsInvariant(keeper)(ctx)
+	require.True(broken)
+}
@@ -42,13 +42,25 @@ func TestMigrateJSON(t *testing.T) {
 	"last_validator_powers": [],
 	"params": {
 		"bond_denom": "stake",
+		"global_liquid_cap": "1.000000000000000000",
+		"global_min_delegation": "0",
 		"historical_entries": 10000,
+		"key_rotation_fee": {
+			"amount": "1000000",
+			"denom": "stake"
+		},
+		"max_cons_pubkey_rotations": 1,
 		"max_entries": 7,
+		"max_entries_per_block": 0,
+		"max_validator_power_fraction": "0.000000000000000000",
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
-		"unbonding_time": "1814400s"
+		"min_self_delegation_ratio": "0.000000000000000000",
+		"unbonding_time": "1814400s",
+		"validator_liquid_cap": "1.000000000000000000"
 	},
 	"redelegations": [],
+	"rotation_history": [],
 	"unbonding_delegations": [],
 	"validators": []
 }`
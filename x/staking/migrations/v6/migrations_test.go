@@ -0,0 +1,120 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidatorFlowsMigration(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(stakingtypes.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	accAddrs := sims.CreateIncrementalAccounts(4)
+	valAddrs := sims.ConvertAddrsToValAddrs(accAddrs)
+	completionTime := time.Unix(100, 0).UTC()
+
+	// two unbonding delegations from validator 0, one of them slashed
+	ubd := stakingtypes.NewUnbondingDelegation(accAddrs[0], valAddrs[0], 1, completionTime, math.NewInt(10), 1)
+	ubd.AddEntry(2, completionTime, math.NewInt(20), 2)
+	ubd.Entries[1].Balance = math.NewInt(15)
+	store.Set(stakingtypes.GetUBDKey(accAddrs[0], valAddrs[0]), stakingtypes.MustMarshalUBD(cdc, ubd))
+
+	ubd = stakingtypes.NewUnbondingDelegation(accAddrs[1], valAddrs[0], 1, completionTime, math.NewInt(5), 3)
+	store.Set(stakingtypes.GetUBDKey(accAddrs[1], valAddrs[0]), stakingtypes.MustMarshalUBD(cdc, ubd))
+
+	// validator 1 is worth one token per share and validator 2 half a token
+	setValidator := func(valAddr sdk.ValAddress, tokens, shares int64) {
+		val := stakingtypes.Validator{OperatorAddress: valAddr.String(), Tokens: math.NewInt(tokens), DelegatorShares: math.LegacyNewDec(shares)}
+		store.Set(stakingtypes.GetValidatorKey(valAddr), stakingtypes.MustMarshalValidator(cdc, &val))
+	}
+	setValidator(valAddrs[1], 100, 100)
+	setValidator(valAddrs[2], 50, 100)
+
+	setDelegation := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares int64) {
+		del := stakingtypes.NewDelegation(delAddr, valAddr, math.LegacyNewDec(shares))
+		store.Set(stakingtypes.GetDelegationKey(delAddr, valAddr), stakingtypes.MustMarshalDelegation(cdc, del))
+	}
+	// v5 redelegation entries have no balance
+	setRedelegation := func(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, red stakingtypes.Redelegation) {
+		for i := range red.Entries {
+			red.Entries[i].Balance = math.Int{}
+		}
+		store.Set(stakingtypes.GetREDKey(delAddr, valSrcAddr, valDstAddr), stakingtypes.MustMarshalRED(cdc, red))
+	}
+	getRedelegation := func(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) stakingtypes.Redelegation {
+		return stakingtypes.MustUnmarshalRED(cdc, store.Get(stakingtypes.GetREDKey(delAddr, valSrcAddr, valDstAddr)))
+	}
+
+	// a redelegation from validator 0 to validator 1 which was slashed, the 2
+	// shares unbonded by slashing being taken from the delegation shared by its
+	// entries
+	red := stakingtypes.NewRedelegation(accAddrs[2], valAddrs[0], valAddrs[1], 1, completionTime, math.NewInt(7), math.LegacyNewDec(7), 4)
+	red.AddEntry(2, completionTime, math.NewInt(4), math.LegacyNewDec(4), 5)
+	setRedelegation(accAddrs[2], valAddrs[0], valAddrs[1], red)
+	setDelegation(accAddrs[2], valAddrs[1], 9)
+
+	// a redelegation from validator 0 to validator 2, whose shares are worth
+	// half of its initial balance
+	red = stakingtypes.NewRedelegation(accAddrs[1], valAddrs[0], valAddrs[2], 1, completionTime, math.NewInt(10), math.LegacyNewDec(10), 6)
+	setRedelegation(accAddrs[1], valAddrs[0], valAddrs[2], red)
+	setDelegation(accAddrs[1], valAddrs[2], 10)
+
+	// a redelegation from validator 1 to validator 2, whose shares are worth
+	// more than its initial balance, along with a direct delegation
+	red = stakingtypes.NewRedelegation(accAddrs[0], valAddrs[1], valAddrs[2], 1, completionTime, math.NewInt(1), math.LegacyNewDec(4), 7)
+	setRedelegation(accAddrs[0], valAddrs[1], valAddrs[2], red)
+	setDelegation(accAddrs[0], valAddrs[2], 20)
+
+	// a redelegation from validator 2 to a removed validator
+	red = stakingtypes.NewRedelegation(accAddrs[0], valAddrs[2], valAddrs[3], 1, completionTime, math.NewInt(8), math.LegacyNewDec(8), 8)
+	setRedelegation(accAddrs[0], valAddrs[2], valAddrs[3], red)
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	getFlows := func(valAddr sdk.ValAddress) stakingtypes.ValidatorFlows {
+		bz := store.Get(stakingtypes.GetValidatorFlowsKey(valAddr))
+		require.NotNil(t, bz)
+		var flows stakingtypes.ValidatorFlows
+		cdc.MustUnmarshal(bz, &flows)
+		return flows
+	}
+
+	// the balances of the redelegation entries are backfilled with the tokens
+	// their shares are worth, capped by their initial balance
+	red = getRedelegation(accAddrs[2], valAddrs[0], valAddrs[1])
+	require.Equal(t, math.NewInt(7), red.Entries[0].Balance)
+	require.Equal(t, math.NewInt(2), red.Entries[1].Balance)
+	red = getRedelegation(accAddrs[1], valAddrs[0], valAddrs[2])
+	require.Equal(t, math.NewInt(5), red.Entries[0].Balance)
+	red = getRedelegation(accAddrs[0], valAddrs[1], valAddrs[2])
+	require.Equal(t, math.NewInt(1), red.Entries[0].Balance)
+	red = getRedelegation(accAddrs[0], valAddrs[2], valAddrs[3])
+	require.True(t, red.Entries[0].Balance.IsZero())
+
+	flows := getFlows(valAddrs[0])
+	require.Equal(t, math.NewInt(30), flows.UnbondingBalance)
+	require.Equal(t, math.NewInt(14), flows.RedelegatingBalance)
+
+	flows = getFlows(valAddrs[1])
+	require.True(t, flows.UnbondingBalance.IsZero())
+	require.Equal(t, math.NewInt(1), flows.RedelegatingBalance)
+
+	// validators without outbound balance have no flows stored
+	require.Nil(t, store.Get(stakingtypes.GetValidatorFlowsKey(valAddrs[2])))
+	require.Nil(t, store.Get(stakingtypes.GetValidatorFlowsKey(valAddrs[3])))
+}
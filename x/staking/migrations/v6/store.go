@@ -0,0 +1,122 @@
+package v6
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. It sets the
+// balance of every redelegation entry, see setRedelegationBalances, and
+// backfills the unbonding and redelegating balances of every validator from the
+// existing unbonding delegations and redelegations.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var valAddrs []string
+	flows := map[string]types.ValidatorFlows{}
+	getFlows := func(valAddr string) types.ValidatorFlows {
+		f, ok := flows[valAddr]
+		if !ok {
+			valAddrs = append(valAddrs, valAddr)
+			f = types.ValidatorFlows{UnbondingBalance: math.ZeroInt(), RedelegatingBalance: math.ZeroInt()}
+		}
+		return f
+	}
+
+	ubdIter := storetypes.KVStorePrefixIterator(store, types.UnbondingDelegationKey)
+	for ; ubdIter.Valid(); ubdIter.Next() {
+		ubd := types.MustUnmarshalUBD(cdc, ubdIter.Value())
+		f := getFlows(ubd.ValidatorAddress)
+		f.UnbondingBalance = f.UnbondingBalance.Add(ubd.TotalBalance())
+		flows[ubd.ValidatorAddress] = f
+	}
+	ubdIter.Close()
+
+	var (
+		redKeys [][]byte
+		reds    []types.Redelegation
+	)
+	redIter := storetypes.KVStorePrefixIterator(store, types.RedelegationKey)
+	for ; redIter.Valid(); redIter.Next() {
+		redKeys = append(redKeys, redIter.Key())
+		reds = append(reds, types.MustUnmarshalRED(cdc, redIter.Value()))
+	}
+	redIter.Close()
+
+	for i := range reds {
+		red := &reds[i]
+		if err := setRedelegationBalances(store, cdc, red); err != nil {
+			return err
+		}
+		store.Set(redKeys[i], types.MustMarshalRED(cdc, *red))
+
+		f := getFlows(red.ValidatorSrcAddress)
+		f.RedelegatingBalance = f.RedelegatingBalance.Add(red.TotalBalance())
+		flows[red.ValidatorSrcAddress] = f
+	}
+
+	for _, valAddr := range valAddrs {
+		f := flows[valAddr]
+		if f.UnbondingBalance.IsZero() && f.RedelegatingBalance.IsZero() {
+			continue
+		}
+
+		addr, err := sdk.ValAddressFromBech32(valAddr)
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetValidatorFlowsKey(addr), cdc.MustMarshal(&f))
+	}
+
+	return nil
+}
+
+// setRedelegationBalances sets the balance of the redelegation entries without
+// one to the tokens their destination shares are worth, capped by their initial
+// balance. The shares of an entry are capped by the shares left in the
+// delegation to the destination validator, which the entries of the
+// redelegation share, since the shares unbonded by slashing the redelegation
+// were taken from the delegation rather than from the entries.
+func setRedelegationBalances(store storetypes.KVStore, cdc codec.BinaryCodec, red *types.Redelegation) error {
+	delAddr, err := sdk.AccAddressFromBech32(red.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		return err
+	}
+
+	var (
+		dstValidator types.Validator
+		sharesLeft   = math.LegacyZeroDec()
+	)
+	if bz := store.Get(types.GetValidatorKey(valDstAddr)); bz != nil {
+		dstValidator = types.MustUnmarshalValidator(cdc, bz)
+	}
+	if bz := store.Get(types.GetDelegationKey(delAddr, valDstAddr)); bz != nil {
+		sharesLeft = types.MustUnmarshalDelegation(cdc, bz).Shares
+	}
+
+	for i, entry := range red.Entries {
+		if !entry.Balance.IsNil() {
+			continue
+		}
+
+		shares := math.LegacyMinDec(entry.SharesDst, sharesLeft)
+		sharesLeft = sharesLeft.Sub(shares)
+
+		balance := math.ZeroInt()
+		if !dstValidator.DelegatorShares.IsNil() && dstValidator.DelegatorShares.IsPositive() {
+			balance = dstValidator.TokensFromSharesTruncated(shares).TruncateInt()
+		}
+		red.Entries[i].Balance = math.MinInt(balance, entry.InitialBalance)
+	}
+
+	return nil
+}
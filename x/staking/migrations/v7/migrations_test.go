@@ -0,0 +1,57 @@
+package v7_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type addrChecker map[string]bool
+
+func (c addrChecker) IsModuleOrContractAccount(_ context.Context, addr sdk.AccAddress) bool {
+	return c[addr.String()]
+}
+
+func TestValidatorLiquidSharesMigration(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(stakingtypes.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	accAddrs := sims.CreateIncrementalAccounts(3)
+	valAddrs := sims.ConvertAddrsToValAddrs(accAddrs)
+	checker := addrChecker{accAddrs[0].String(): true, accAddrs[1].String(): true}
+
+	// two liquid delegations to validator 0 and a regular one to validator 1
+	setDelegation := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares int64) {
+		delegation := stakingtypes.NewDelegation(delAddr, valAddr, math.LegacyNewDec(shares))
+		store.Set(stakingtypes.GetDelegationKey(delAddr, valAddr), stakingtypes.MustMarshalDelegation(cdc, delegation))
+	}
+	setDelegation(accAddrs[0], valAddrs[0], 10)
+	setDelegation(accAddrs[1], valAddrs[0], 5)
+	setDelegation(accAddrs[2], valAddrs[1], 7)
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc, checker))
+
+	var shares sdk.DecProto
+	bz := store.Get(stakingtypes.GetValidatorLiquidSharesKey(valAddrs[0]))
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &shares)
+	require.Equal(t, math.LegacyNewDec(15), shares.Dec)
+
+	// validators without liquid delegations have no liquid shares stored
+	require.Nil(t, store.Get(stakingtypes.GetValidatorLiquidSharesKey(valAddrs[1])))
+	require.Nil(t, store.Get(stakingtypes.GetValidatorLiquidSharesKey(valAddrs[2])))
+}
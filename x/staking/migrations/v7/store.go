@@ -0,0 +1,56 @@
+package v7
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. It backfills
+// the delegator shares of every validator held by liquid staking accounts from
+// the existing delegations. Nothing is tracked without an account type checker.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, checker types.AccountTypeChecker) error {
+	if checker == nil {
+		return nil
+	}
+
+	store := ctx.KVStore(storeKey)
+
+	var valAddrs []string
+	liquidShares := map[string]math.LegacyDec{}
+
+	delIter := storetypes.KVStorePrefixIterator(store, types.DelegationKey)
+	for ; delIter.Valid(); delIter.Next() {
+		delegation := types.MustUnmarshalDelegation(cdc, delIter.Value())
+		if !checker.IsModuleOrContractAccount(ctx, delegation.GetDelegatorAddr()) {
+			continue
+		}
+
+		shares, ok := liquidShares[delegation.ValidatorAddress]
+		if !ok {
+			valAddrs = append(valAddrs, delegation.ValidatorAddress)
+			shares = math.LegacyZeroDec()
+		}
+		liquidShares[delegation.ValidatorAddress] = shares.Add(delegation.Shares)
+	}
+	delIter.Close()
+
+	for _, valAddr := range valAddrs {
+		shares := liquidShares[valAddr]
+		if shares.IsZero() {
+			continue
+		}
+
+		addr, err := sdk.ValAddressFromBech32(valAddr)
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetValidatorLiquidSharesKey(addr), cdc.MustMarshal(&sdk.DecProto{Dec: shares}))
+	}
+
+	return nil
+}
@@ -64,7 +64,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate, types.DefaultMaxValidatorPowerFraction, types.DefaultMaxConsPubKeyRotations, sdk.NewInt64Coin(simState.BondDenom, 1000000), types.DefaultGlobalMinDelegation, types.DefaultValidatorLiquidCap, types.DefaultGlobalLiquidCap, types.DefaultMaxEntriesPerBlock, types.DefaultMinSelfDelegationRatio)
 
 	// validators & delegations
 	var (
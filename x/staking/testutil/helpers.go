@@ -9,7 +9,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -142,3 +145,45 @@ func (sh *Helper) TurnBlockTimeDiff(diff time.Duration) sdk.Context {
 func ZeroCommission() stakingtypes.CommissionRates {
 	return stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
 }
+
+// PopulateValidatorsAndDelegations writes numVals unbonded validators to the
+// staking store, each with a delegation of one consensus power from each of
+// delsPerVal delegators, the same for all validators. It returns the
+// validators and the delegators.
+//
+// The state is written directly through the keeper, without running the hooks,
+// and the delegated tokens are minted to the not bonded pool at once, so that
+// large states can be set up quickly, e.g. in benchmarks.
+func PopulateValidatorsAndDelegations(
+	tb testing.TB, ctx sdk.Context, bankKeeper bankkeeper.Keeper, k *keeper.Keeper, numVals, delsPerVal int,
+) ([]stakingtypes.Validator, []sdk.AccAddress) {
+	tb.Helper()
+
+	pks := simtestutil.CreateTestPubKeys(numVals + delsPerVal)
+	delAddrs := make([]sdk.AccAddress, delsPerVal)
+	for i := range delAddrs {
+		delAddrs[i] = sdk.AccAddress(pks[numVals+i].Address())
+	}
+
+	amount := k.TokensFromConsensusPower(ctx, 1)
+	total := amount.MulRaw(int64(numVals) * int64(delsPerVal))
+	notBondedPool := k.GetNotBondedPool(ctx)
+	require.NoError(tb, banktestutil.FundModuleAccount(ctx, bankKeeper, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), total))))
+
+	validators := make([]stakingtypes.Validator, numVals)
+	for i := range validators {
+		val := NewValidator(tb, sdk.ValAddress(pks[i].Address()), pks[i])
+		for _, delAddr := range delAddrs {
+			var shares math.LegacyDec
+			val, shares = val.AddTokensFromDel(amount)
+			k.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, val.GetOperator(), shares))
+		}
+
+		k.SetValidator(ctx, val)
+		require.NoError(tb, k.SetValidatorByConsAddr(ctx, val))
+		k.SetNewValidatorByPowerIndex(ctx, val)
+		validators[i] = val
+	}
+
+	return validators, delAddrs
+}
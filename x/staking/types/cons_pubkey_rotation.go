@@ -0,0 +1,112 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotationHistory{}
+
+// NewConsPubKeyRotationHistory creates a new ConsPubKeyRotationHistory instance
+func NewConsPubKeyRotationHistory(
+	operator sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, t time.Time, fee sdk.Coin,
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: operator.String(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Time:            t,
+		Fee:             fee,
+	}, nil
+}
+
+// MustUnmarshalConsPubKeyRotationHistory unmarshals a consensus pubkey rotation
+// from a store value, it panics on failure
+func MustUnmarshalConsPubKeyRotationHistory(cdc codec.BinaryCodec, value []byte) ConsPubKeyRotationHistory {
+	var history ConsPubKeyRotationHistory
+	cdc.MustUnmarshal(value, &history)
+
+	return history
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
+
+// GetOperator returns the address of the validator operator
+func (h ConsPubKeyRotationHistory) GetOperator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(h.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return addr
+}
+
+// OldConsPubKey returns the consensus pubkey of the validator before the rotation
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.OldConsPubkey)
+}
+
+// NewConsPubKey returns the consensus pubkey of the validator after the rotation
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.NewConsPubkey)
+}
+
+// ABCIValidatorUpdateZero returns an abci.ValidatorUpdate removing the old
+// consensus pubkey of the validator from the CometBFT validator set.
+func (h ConsPubKeyRotationHistory) ABCIValidatorUpdateZero() abci.ValidatorUpdate {
+	pk, err := h.OldConsPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	cmtPk, err := cryptocodec.ToCmtProtoPublicKey(pk)
+	if err != nil {
+		panic(err)
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: cmtPk,
+		Power:  0,
+	}
+}
+
+func unpackConsPubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidType, "consensus pubkey is nil")
+	}
+
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
@@ -156,6 +156,16 @@ func (ubd *UnbondingDelegation) RemoveEntry(i int64) {
 	ubd.Entries = append(ubd.Entries[:i], ubd.Entries[i+1:]...)
 }
 
+// TotalBalance returns the sum of the balances of the unbonding delegation entries.
+func (ubd UnbondingDelegation) TotalBalance() math.Int {
+	total := math.ZeroInt()
+	for _, entry := range ubd.Entries {
+		total = total.Add(entry.Balance)
+	}
+
+	return total
+}
+
 // return the unbonding delegation
 func MustMarshalUBD(cdc codec.BinaryCodec, ubd UnbondingDelegation) []byte {
 	return cdc.MustMarshal(&ubd)
@@ -193,6 +203,7 @@ func NewRedelegationEntry(creationHeight int64, completionTime time.Time, balanc
 		CreationHeight:          creationHeight,
 		CompletionTime:          completionTime,
 		InitialBalance:          balance,
+		Balance:                 balance,
 		SharesDst:               sharesDst,
 		UnbondingId:             id,
 		UnbondingOnHoldRefCount: 0,
@@ -234,6 +245,17 @@ func (red *Redelegation) RemoveEntry(i int64) {
 	red.Entries = append(red.Entries[:i], red.Entries[i+1:]...)
 }
 
+// TotalBalance returns the sum of the balances of the redelegation entries,
+// net of slashing.
+func (red Redelegation) TotalBalance() math.Int {
+	total := math.ZeroInt()
+	for _, entry := range red.Entries {
+		total = total.Add(entry.Balance)
+	}
+
+	return total
+}
+
 // MustMarshalRED returns the Redelegation bytes. Panics if fails.
 func MustMarshalRED(cdc codec.BinaryCodec, red Redelegation) []byte {
 	return cdc.MustMarshal(&red)
@@ -50,4 +50,12 @@ var (
 	ErrCommissionLTMinRate             = errors.Register(ModuleName, 40, "commission cannot be less than min rate")
 	ErrUnbondingNotFound               = errors.Register(ModuleName, 41, "unbonding operation not found")
 	ErrUnbondingOnHoldRefCountNegative = errors.Register(ModuleName, 42, "cannot un-hold unbonding operation that is not on hold")
+	ErrMaxValidatorPowerFraction       = errors.Register(ModuleName, 43, "validator would exceed the max validator power fraction")
+	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 44, "exceeding maximum consensus pubkey rotations within unbonding period")
+	ErrConsPubKeyRotatedInBlock        = errors.Register(ModuleName, 45, "validator consensus pubkey already rotated in this block")
+	ErrDelegationBelowMinimum          = errors.Register(ModuleName, 46, "delegation amount is below the validator minimum delegation")
+	ErrValidatorLiquidCapExceeded      = errors.Register(ModuleName, 47, "delegation would exceed the validator liquid staking cap")
+	ErrGlobalLiquidCapExceeded         = errors.Register(ModuleName, 48, "delegation would exceed the global liquid staking cap")
+	ErrInvalidBondDenom                = errors.Register(ModuleName, 49, "invalid coin denomination: not the bond denom")
+	ErrMinSelfDelegationRatio          = errors.Register(ModuleName, 50, "delegation would bring the validator below the min self delegation ratio")
 )
@@ -45,6 +45,14 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// rotation_history defines the consensus pubkey rotations of the last
+	// unbonding period.
+	RotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,9,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
+	// jail_infos defines the jail infos of the jailed validators.
+	JailInfos []ValidatorJailInfo `protobuf:"bytes,10,rep,name=jail_infos,json=jailInfos,proto3" json:"jail_infos"`
+	// last_jail_infos defines the jail infos archived when the validators were
+	// last unjailed.
+	LastJailInfos []ValidatorJailInfo `protobuf:"bytes,11,rep,name=last_jail_infos,json=lastJailInfos,proto3" json:"last_jail_infos"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +137,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.RotationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJailInfos() []ValidatorJailInfo {
+	if m != nil {
+		return m.JailInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastJailInfos() []ValidatorJailInfo {
+	if m != nil {
+		return m.LastJailInfos
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -170,9 +199,65 @@ func (m *LastValidatorPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
+// ValidatorJailInfo defines the jail info of a validator, used in genesis.
+type ValidatorJailInfo struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// jail_info is the jail info of the validator.
+	JailInfo JailInfo `protobuf:"bytes,2,opt,name=jail_info,json=jailInfo,proto3" json:"jail_info"`
+}
+
+func (m *ValidatorJailInfo) Reset()         { *m = ValidatorJailInfo{} }
+func (m *ValidatorJailInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJailInfo) ProtoMessage()    {}
+func (*ValidatorJailInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{2}
+}
+func (m *ValidatorJailInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorJailInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorJailInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorJailInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJailInfo.Merge(m, src)
+}
+func (m *ValidatorJailInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorJailInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJailInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorJailInfo proto.InternalMessageInfo
+
+func (m *ValidatorJailInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorJailInfo) GetJailInfo() JailInfo {
+	if m != nil {
+		return m.JailInfo
+	}
+	return JailInfo{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
+	proto.RegisterType((*ValidatorJailInfo)(nil), "cosmos.staking.v1beta1.ValidatorJailInfo")
 }
 
 func init() {
@@ -180,39 +265,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x4e, 0x13, 0x41,
+	0x1c, 0xc6, 0x77, 0x45, 0xa0, 0x9d, 0x82, 0xc0, 0x58, 0xcc, 0x4a, 0xe2, 0xb6, 0x34, 0xc4, 0x54,
+	0x94, 0xdd, 0x50, 0x6e, 0xde, 0xa8, 0x46, 0x41, 0x89, 0x92, 0x45, 0x3c, 0x18, 0xcd, 0x66, 0xca,
+	0x0e, 0xcb, 0xc0, 0x76, 0xa6, 0xd9, 0x99, 0x22, 0xbc, 0x81, 0x47, 0x1f, 0x81, 0xa3, 0x17, 0x13,
+	0x0f, 0xc4, 0x67, 0xe0, 0x48, 0x38, 0x19, 0x0f, 0xc4, 0xc0, 0x41, 0x1f, 0xc3, 0xec, 0xcc, 0xee,
+	0xb2, 0xb5, 0x5d, 0x3d, 0x78, 0x69, 0xbb, 0xf3, 0xff, 0xbe, 0xdf, 0xf7, 0x65, 0xba, 0x33, 0x60,
+	0x6e, 0x8b, 0xf1, 0x36, 0xe3, 0x36, 0x17, 0x68, 0x8f, 0x50, 0xdf, 0xde, 0x5f, 0x6c, 0x61, 0x81,
+	0x16, 0x6d, 0x1f, 0x53, 0xcc, 0x09, 0xb7, 0x3a, 0x21, 0x13, 0x0c, 0xde, 0x52, 0x2a, 0x2b, 0x56,
+	0x59, 0xb1, 0x6a, 0xa6, 0xec, 0x33, 0x9f, 0x49, 0x89, 0x1d, 0xfd, 0x52, 0xea, 0x99, 0x3c, 0x66,
+	0xe2, 0x56, 0xaa, 0xdb, 0x4a, 0xe5, 0x2a, 0x7b, 0x1c, 0xa0, 0x46, 0x53, 0xa8, 0x4d, 0x28, 0xb3,
+	0xe5, 0xa7, 0x5a, 0xaa, 0x7d, 0x1d, 0x05, 0x63, 0x4f, 0x55, 0xa7, 0x0d, 0x81, 0x04, 0x86, 0xcb,
+	0x60, 0xa4, 0x83, 0x42, 0xd4, 0xe6, 0x86, 0x5e, 0xd5, 0xeb, 0xa5, 0x86, 0x69, 0x0d, 0xee, 0x68,
+	0xad, 0x4b, 0x55, 0xb3, 0x78, 0x72, 0x5e, 0xd1, 0x3e, 0xfd, 0xfc, 0x32, 0xaf, 0x3b, 0xb1, 0x11,
+	0xbe, 0x03, 0x93, 0x01, 0xe2, 0xc2, 0x15, 0x4c, 0xa0, 0xc0, 0xed, 0xb0, 0xf7, 0x38, 0x34, 0xae,
+	0x55, 0xf5, 0xfa, 0x58, 0x73, 0x29, 0x12, 0x7f, 0x3f, 0xaf, 0xdc, 0xf5, 0x89, 0xd8, 0xe9, 0xb6,
+	0xac, 0x2d, 0xd6, 0x8e, 0x1b, 0xc6, 0x5f, 0x0b, 0xdc, 0xdb, 0xb3, 0xc5, 0x61, 0x07, 0x73, 0x6b,
+	0x95, 0x0a, 0x85, 0xbd, 0x11, 0xc1, 0x5e, 0x45, 0xac, 0xf5, 0x08, 0x05, 0x09, 0x98, 0x96, 0xf8,
+	0x7d, 0x14, 0x10, 0x0f, 0x09, 0x16, 0xaa, 0x08, 0x6e, 0x0c, 0x55, 0x87, 0xea, 0xa5, 0xc6, 0x7c,
+	0x5e, 0xe1, 0x35, 0xc4, 0xc5, 0xeb, 0xc4, 0x23, 0x51, 0xd9, 0xf2, 0x37, 0x83, 0xbe, 0x31, 0x87,
+	0x6b, 0x00, 0xa4, 0x29, 0xdc, 0xb8, 0x2e, 0xf9, 0xb3, 0x79, 0xfc, 0xd4, 0x9c, 0xc5, 0x66, 0xfc,
+	0xf0, 0x25, 0x28, 0x79, 0x38, 0xc0, 0x3e, 0x12, 0x84, 0x51, 0x6e, 0x0c, 0x4b, 0x5c, 0x2d, 0x0f,
+	0xf7, 0x38, 0x95, 0x66, 0x79, 0x59, 0x02, 0xdc, 0x03, 0xd3, 0x5d, 0xda, 0x62, 0xd4, 0x23, 0xd4,
+	0x77, 0xb3, 0xe8, 0x11, 0x89, 0xbe, 0x9f, 0x87, 0xde, 0x4c, 0x4c, 0x83, 0x33, 0xca, 0xdd, 0xfe,
+	0x39, 0x87, 0x9b, 0x60, 0x3c, 0xc4, 0xd9, 0x90, 0x51, 0x19, 0x32, 0x97, 0x17, 0xe2, 0x60, 0x6f,
+	0x20, 0xbd, 0x97, 0x02, 0x67, 0x40, 0x01, 0x1f, 0x74, 0x58, 0x28, 0xb0, 0x67, 0x14, 0xaa, 0x7a,
+	0xbd, 0xe0, 0xa4, 0xcf, 0xd0, 0x07, 0x93, 0x21, 0x13, 0x52, 0xe8, 0xee, 0x10, 0x2e, 0x58, 0x78,
+	0x68, 0x14, 0x65, 0xea, 0x62, 0x5e, 0xea, 0x23, 0x46, 0xf9, 0x7a, 0xb7, 0xf5, 0x1c, 0x1f, 0x3a,
+	0xb1, 0x73, 0x45, 0x19, 0xb3, 0x15, 0x26, 0xc2, 0xde, 0x19, 0xdc, 0x00, 0x60, 0x17, 0x91, 0xc0,
+	0x25, 0x74, 0x9b, 0x71, 0x03, 0xc8, 0x88, 0x7b, 0xff, 0xfc, 0x9f, 0x9f, 0x21, 0x12, 0xac, 0xd2,
+	0x6d, 0x96, 0x45, 0x17, 0x77, 0xe3, 0x45, 0x0e, 0xdf, 0x82, 0x09, 0xf9, 0x9e, 0x66, 0xc8, 0xa5,
+	0xff, 0x20, 0x8f, 0x47, 0xb0, 0x64, 0xc0, 0x6b, 0x3b, 0x00, 0xf6, 0xbf, 0xd0, 0xb0, 0x01, 0x46,
+	0x91, 0xe7, 0x85, 0x98, 0xab, 0xe3, 0x5b, 0x6c, 0x1a, 0x67, 0xc7, 0x0b, 0xe5, 0x38, 0x6e, 0x59,
+	0x4d, 0x36, 0x44, 0x48, 0xa8, 0xef, 0x24, 0x42, 0x58, 0x06, 0xc3, 0x57, 0x67, 0x74, 0xc8, 0x51,
+	0x0f, 0x0f, 0x0b, 0x1f, 0x8e, 0x2a, 0xda, 0xaf, 0xa3, 0x8a, 0x56, 0xfb, 0xac, 0x83, 0xa9, 0xbe,
+	0x66, 0xf0, 0x05, 0x98, 0xba, 0x3a, 0x80, 0xbd, 0x99, 0xb3, 0x67, 0xc7, 0x0b, 0x77, 0xe2, 0xcc,
+	0xd4, 0xd8, 0x1b, 0x3e, 0xb9, 0xff, 0xc7, 0x3a, 0x5c, 0x01, 0xc5, 0x74, 0xa3, 0x64, 0x93, 0x52,
+	0xa3, 0x9a, 0xb7, 0x4f, 0x83, 0xb6, 0xa7, 0x90, 0x6c, 0x7c, 0xf3, 0xc9, 0xc9, 0x85, 0xa9, 0x9f,
+	0x5e, 0x98, 0xfa, 0x8f, 0x0b, 0x53, 0xff, 0x78, 0x69, 0x6a, 0xa7, 0x97, 0xa6, 0xf6, 0xed, 0xd2,
+	0xd4, 0xde, 0x3c, 0xf8, 0xeb, 0xb5, 0x73, 0x90, 0x5e, 0xac, 0xf2, 0x02, 0x6a, 0x8d, 0xc8, 0x1b,
+	0x72, 0xe9, 0xf7, 0x00, 0xa5, 0xaf, 0xc4, 0x04, 0xcb, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +328,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LastJailInfos) > 0 {
+		for iNdEx := len(m.LastJailInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastJailInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.JailInfos) > 0 {
+		for iNdEx := len(m.JailInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RotationHistory) > 0 {
+		for iNdEx := len(m.RotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -373,6 +508,46 @@ func (m *LastValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorJailInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorJailInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorJailInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.JailInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -427,6 +602,24 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.RotationHistory) > 0 {
+		for _, e := range m.RotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JailInfos) > 0 {
+		for _, e := range m.JailInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastJailInfos) > 0 {
+		for _, e := range m.LastJailInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -446,6 +639,21 @@ func (m *LastValidatorPower) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorJailInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.JailInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -737,6 +945,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotationHistory = append(m.RotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.RotationHistory[len(m.RotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailInfos = append(m.JailInfos, ValidatorJailInfo{})
+			if err := m.JailInfos[len(m.JailInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJailInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastJailInfos = append(m.LastJailInfos, ValidatorJailInfo{})
+			if err := m.LastJailInfos[len(m.LastJailInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -859,6 +1169,121 @@ func (m *LastValidatorPower) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorJailInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorJailInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorJailInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
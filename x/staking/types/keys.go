@@ -22,6 +22,12 @@ const (
 
 	// RouterKey is the msg router key for the staking module
 	RouterKey = ModuleName
+
+	// KeyRotationFeeRecipientName is the name of the module account the
+	// consensus pubkey rotation fees are sent to. It is the x/distribution
+	// module account, whose AfterConsensusPubKeyUpdate hook adds the fees to
+	// the community pool.
+	KeyRotationFeeRecipientName = "distribution"
 )
 
 var (
@@ -54,6 +60,17 @@ var (
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	ValidatorFlowsKey = []byte{0x71} // prefix for the unbonding and redelegating balances of a validator
+
+	ConsPubKeyRotationHistoryKey    = []byte{0x72} // prefix for the consensus pubkey rotations, by height
+	ConsPubKeyRotationByValIndexKey = []byte{0x73} // prefix for each key to a consensus pubkey rotation, by validator operator
+	OldToNewConsAddrKey             = []byte{0x74} // prefix for the rotated consensus addresses of validators
+
+	ValidatorLiquidSharesKey = []byte{0x75} // prefix for the delegator shares of a validator held by liquid staking accounts
+
+	ValidatorJailInfoKey     = []byte{0x76} // prefix for the jail info of a jailed validator
+	ValidatorLastJailInfoKey = []byte{0x77} // prefix for the jail info archived when a validator was last unjailed
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -87,6 +104,67 @@ func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
 
+// GetValidatorFlowsKey creates the key for the unbonding and redelegating
+// balances of a validator.
+func GetValidatorFlowsKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorFlowsKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorLiquidSharesKey creates the key for the delegator shares of the
+// validator with address operatorAddr held by liquid staking accounts.
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorJailInfoKey creates the key for the jail info of the jailed
+// validator with address operatorAddr.
+func GetValidatorJailInfoKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorJailInfoKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorLastJailInfoKey creates the key for the jail info archived when
+// the validator with address operatorAddr was last unjailed.
+func GetValidatorLastJailInfoKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLastJailInfoKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryKey creates the key for the consensus pubkey
+// rotation of a validator at the given height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(height int64, operatorAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationHistoryHeightPrefix(height), address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryHeightPrefix creates the prefix of the consensus
+// pubkey rotations at the given height.
+func GetConsPubKeyRotationHistoryHeightPrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(ConsPubKeyRotationHistoryKey, bz...)
+}
+
+// GetConsPubKeyRotationByValIndexKey creates the index key of the consensus
+// pubkey rotation of a validator at the given height.
+// VALUE: none (key rearrangement used)
+func GetConsPubKeyRotationByValIndexKey(operatorAddr sdk.ValAddress, height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(GetConsPubKeyRotationsByValIndexKey(operatorAddr), bz...)
+}
+
+// GetConsPubKeyRotationsByValIndexKey creates the prefix of the consensus
+// pubkey rotations of a validator.
+func GetConsPubKeyRotationsByValIndexKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationByValIndexKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetOldToNewConsAddrKey creates the key for the consensus address a rotated
+// consensus address of a validator was rotated to.
+// VALUE: new consensus address ([]byte)
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
 // GetValidatorByConsAddrKey creates the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
@@ -99,6 +177,13 @@ func AddressFromValidatorsKey(key []byte) []byte {
 	return key[2:] // remove prefix bytes and address length
 }
 
+// AddressFromValidatorJailInfoKey creates the validator operator address from
+// ValidatorJailInfoKey or ValidatorLastJailInfoKey
+func AddressFromValidatorJailInfoKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
 // AddressFromLastValidatorPowerKey creates the validator operator address from LastValidatorPowerKey
 func AddressFromLastValidatorPowerKey(key []byte) []byte {
 	kv.AssertKeyAtLeastLength(key, 3)
@@ -29,20 +29,61 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMaxConsPubKeyRotations is the default maximum number of consensus
+	// pubkey rotations of a validator within an unbonding period
+	DefaultMaxConsPubKeyRotations uint32 = 1
+
+	// DefaultMaxEntriesPerBlock is 0, i.e. all the matured unbonding
+	// delegation and redelegation entries are completed in the same block
+	DefaultMaxEntriesPerBlock uint32 = 0
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultMaxValidatorPowerFraction is set to 0%, i.e. the cap is disabled
+	DefaultMaxValidatorPowerFraction = math.LegacyZeroDec()
+
+	// DefaultKeyRotationFee is fixed to 1000000 of the default bond denom
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalMinDelegation is set to 0, i.e. the minimum is disabled
+	DefaultGlobalMinDelegation = math.ZeroInt()
+
+	// DefaultValidatorLiquidCap is set to 100%, i.e. the cap is disabled
+	DefaultValidatorLiquidCap = math.LegacyOneDec()
+
+	// DefaultGlobalLiquidCap is set to 100%, i.e. the cap is disabled
+	DefaultGlobalLiquidCap = math.LegacyOneDec()
+
+	// DefaultMinSelfDelegationRatio is set to 0%, i.e. the minimum is disabled
+	DefaultMinSelfDelegationRatio = math.LegacyZeroDec()
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate, maxValidatorPowerFraction math.LegacyDec, maxConsPubKeyRotations uint32, keyRotationFee sdk.Coin,
+	globalMinDelegation math.Int, validatorLiquidCap, globalLiquidCap math.LegacyDec, maxEntriesPerBlock uint32,
+	minSelfDelegationRatio math.LegacyDec,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		MaxValidatorPowerFraction: maxValidatorPowerFraction,
+		MaxConsPubkeyRotations:    maxConsPubKeyRotations,
+		KeyRotationFee:            keyRotationFee,
+		GlobalMinDelegation:       globalMinDelegation,
+		ValidatorLiquidCap:        validatorLiquidCap,
+		GlobalLiquidCap:           globalLiquidCap,
+		MaxEntriesPerBlock:        maxEntriesPerBlock,
+		MinSelfDelegationRatio:    minSelfDelegationRatio,
 	}
 }
 
@@ -55,6 +96,14 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultMaxValidatorPowerFraction,
+		DefaultMaxConsPubKeyRotations,
+		DefaultKeyRotationFee,
+		DefaultGlobalMinDelegation,
+		DefaultValidatorLiquidCap,
+		DefaultGlobalLiquidCap,
+		DefaultMaxEntriesPerBlock,
+		DefaultMinSelfDelegationRatio,
 	)
 }
 
@@ -104,6 +153,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxValidatorPowerFraction(p.MaxValidatorPowerFraction); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateGlobalMinDelegation(p.GlobalMinDelegation); err != nil {
+		return err
+	}
+
+	if err := validateLiquidCap(p.ValidatorLiquidCap); err != nil {
+		return fmt.Errorf("invalid validator liquid cap: %w", err)
+	}
+
+	if err := validateLiquidCap(p.GlobalLiquidCap); err != nil {
+		return fmt.Errorf("invalid global liquid cap: %w", err)
+	}
+
+	if err := validateMinSelfDelegationRatio(p.MinSelfDelegationRatio); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +276,99 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxValidatorPowerFraction(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params stored before the cap was introduced don't set it
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max validator power fraction cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max validator power fraction cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params stored before the rotations were introduced don't set it
+	if v.Denom == "" && v.Amount.IsNil() {
+		return nil
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateGlobalMinDelegation(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params stored before the minimum was introduced don't set it
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("global min delegation cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateLiquidCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params stored before the caps were introduced don't set them
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegationRatio(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// params stored before the minimum was introduced don't set it
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min self delegation ratio cannot be negative: %s", v)
+	}
+	// a ratio of 100% would forbid any delegation from other delegators
+	if v.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("min self delegation ratio must be less than 100%%: %s", v)
+	}
+
+	return nil
+}
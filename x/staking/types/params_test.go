@@ -35,4 +35,27 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate global min delegation
+	params = types.DefaultParams()
+	params.GlobalMinDelegation = math.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	params.GlobalMinDelegation = math.Int{}
+	require.NoError(t, params.Validate())
+
+	// validate liquid caps
+	params = types.DefaultParams()
+	params.ValidatorLiquidCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidCap = math.LegacyNewDec(2)
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidCap = math.LegacyDec{}
+	params.GlobalLiquidCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidCap = math.LegacyDec{}
+	require.NoError(t, params.Validate())
 }
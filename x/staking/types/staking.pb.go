@@ -6,6 +6,7 @@ package types
 import (
 	bytes "bytes"
 	compress_gzip "compress/gzip"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types3 "github.com/cometbft/cometbft/abci/types"
 	types "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -108,6 +109,43 @@ func (Infraction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{1}
 }
 
+// JailReason indicates why a validator was jailed.
+type JailReason int32
+
+const (
+	// UNSPECIFIED defines a jailing whose reason was not provided by the caller.
+	JailReason_JAIL_REASON_UNSPECIFIED JailReason = 0
+	// MIN_SELF_DELEGATION defines a validator whose self-delegation fell below
+	// its minimum self-delegation.
+	JailReason_JAIL_REASON_MIN_SELF_DELEGATION JailReason = 1
+	// DOWNTIME defines a validator that missed signing too many blocks.
+	JailReason_JAIL_REASON_DOWNTIME JailReason = 2
+	// DOUBLE_SIGN defines a validator that double-signed a block.
+	JailReason_JAIL_REASON_DOUBLE_SIGN JailReason = 3
+)
+
+var JailReason_name = map[int32]string{
+	0: "JAIL_REASON_UNSPECIFIED",
+	1: "JAIL_REASON_MIN_SELF_DELEGATION",
+	2: "JAIL_REASON_DOWNTIME",
+	3: "JAIL_REASON_DOUBLE_SIGN",
+}
+
+var JailReason_value = map[string]int32{
+	"JAIL_REASON_UNSPECIFIED":         0,
+	"JAIL_REASON_MIN_SELF_DELEGATION": 1,
+	"JAIL_REASON_DOWNTIME":            2,
+	"JAIL_REASON_DOUBLE_SIGN":         3,
+}
+
+func (x JailReason) String() string {
+	return proto.EnumName(JailReason_name, int32(x))
+}
+
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
+}
+
 // HistoricalInfo contains header and validator information for a given block.
 // It is stored as part of staking module's state, which persists the `n` most
 // recent HistoricalInfo
@@ -375,6 +413,9 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// list of unbonding ids, each uniquely identifing an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// min_delegation is the validator's self declared minimum amount of a new
+	// delegation to it. The global_min_delegation param applies when it is lower.
+	MinDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=min_delegation,json=minDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -809,6 +850,10 @@ type RedelegationEntry struct {
 	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
 	// Strictly positive if this entry's unbonding has been stopped by external modules
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
+	// balance defines the initial balance of the redelegation net of slashing.
+	//
+	// Since: cosmos-sdk 0.48
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
 }
 
 func (m *RedelegationEntry) Reset()         { *m = RedelegationEntry{} }
@@ -932,6 +977,40 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// max_validator_power_fraction is the maximum fraction of the total bonded
+	// tokens a validator may hold after a delegation or redelegation to it.
+	// Zero disables the cap.
+	MaxValidatorPowerFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_validator_power_fraction,json=maxValidatorPowerFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_power_fraction"`
+	// max_cons_pubkey_rotations is the maximum number of consensus pubkey
+	// rotations a validator may perform within an unbonding period. Zero
+	// disables the rotations.
+	MaxConsPubkeyRotations uint32 `protobuf:"varint,8,opt,name=max_cons_pubkey_rotations,json=maxConsPubkeyRotations,proto3" json:"max_cons_pubkey_rotations,omitempty"`
+	// key_rotation_fee is the fee paid to the community pool by the validator
+	// operator on each consensus pubkey rotation.
+	KeyRotationFee types2.Coin `protobuf:"bytes,9,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_min_delegation is the minimum amount of a new delegation to any
+	// validator, validators may set a higher one. Zero disables the minimum.
+	GlobalMinDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=global_min_delegation,json=globalMinDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"global_min_delegation"`
+	// validator_liquid_cap is the maximum fraction of the delegator shares of a
+	// validator that may be held by module and contract accounts.
+	ValidatorLiquidCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=validator_liquid_cap,json=validatorLiquidCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_cap"`
+	// global_liquid_cap is the maximum fraction of the total bonded tokens that
+	// may be delegated by module and contract accounts.
+	GlobalLiquidCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=global_liquid_cap,json=globalLiquidCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_cap"`
+	// max_entries_per_block is the maximum number of matured unbonding
+	// delegation entries, and of matured redelegation entries, completed at the
+	// end of a block. The entries left over are completed in the next blocks.
+	// Zero disables the limit.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxEntriesPerBlock uint32 `protobuf:"varint,13,opt,name=max_entries_per_block,json=maxEntriesPerBlock,proto3" json:"max_entries_per_block,omitempty"`
+	// min_self_delegation_ratio is the minimum ratio of the self-delegation of a
+	// validator to its tokens. Delegations from other delegators bringing a
+	// validator below it are rejected, and validators whose operator undelegates
+	// below it are jailed. Zero disables the minimum.
+	//
+	// Since: cosmos-sdk 0.48
+	MinSelfDelegationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=min_self_delegation_ratio,json=minSelfDelegationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_self_delegation_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1002,6 +1081,27 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxConsPubkeyRotations() uint32 {
+	if m != nil {
+		return m.MaxConsPubkeyRotations
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetMaxEntriesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxEntriesPerBlock
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1199,6 +1299,175 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ValidatorFlows tracks the stake scheduled to leave a validator through
+// unbonding delegations and outbound redelegations.
+type ValidatorFlows struct {
+	// unbonding_balance is the total balance of the unbonding delegation entries
+	// of the validator, net of slashing.
+	UnbondingBalance cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=unbonding_balance,json=unbondingBalance,proto3,customtype=cosmossdk.io/math.Int" json:"unbonding_balance"`
+	// redelegating_balance is the total balance of the redelegation entries whose
+	// source is the validator, net of slashing.
+	RedelegatingBalance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=redelegating_balance,json=redelegatingBalance,proto3,customtype=cosmossdk.io/math.Int" json:"redelegating_balance"`
+}
+
+func (m *ValidatorFlows) Reset()         { *m = ValidatorFlows{} }
+func (m *ValidatorFlows) String() string { return proto.CompactTextString(m) }
+func (*ValidatorFlows) ProtoMessage()    {}
+func (*ValidatorFlows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *ValidatorFlows) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorFlows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorFlows.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorFlows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorFlows.Merge(m, src)
+}
+func (m *ValidatorFlows) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorFlows) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorFlows.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorFlows proto.InternalMessageInfo
+
+// ConsPubKeyRotationHistory records a rotation of the consensus pubkey of a
+// validator. It is kept for an unbonding period, during which infractions
+// committed with the old consensus pubkey are still attributed to the
+// validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the block height of the rotation.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the rotation fee paid by the validator operator.
+	Fee types2.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+// JailInfo records why and when a validator was jailed.
+type JailInfo struct {
+	// reason is the reason the validator was jailed for.
+	Reason JailReason `protobuf:"varint,1,opt,name=reason,proto3,enum=cosmos.staking.v1beta1.JailReason" json:"reason,omitempty"`
+	// height is the block height at which the validator was jailed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was jailed.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// infraction_details is a human readable description of the infraction.
+	InfractionDetails string `protobuf:"bytes,4,opt,name=infraction_details,json=infractionDetails,proto3" json:"infraction_details,omitempty"`
+}
+
+func (m *JailInfo) Reset()         { *m = JailInfo{} }
+func (m *JailInfo) String() string { return proto.CompactTextString(m) }
+func (*JailInfo) ProtoMessage()    {}
+func (*JailInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *JailInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailInfo.Merge(m, src)
+}
+func (m *JailInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailInfo proto.InternalMessageInfo
+
+func (m *JailInfo) GetReason() JailReason {
+	if m != nil {
+		return m.Reason
+	}
+	return JailReason_JAIL_REASON_UNSPECIFIED
+}
+
+func (m *JailInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JailInfo) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *JailInfo) GetInfractionDetails() string {
+	if m != nil {
+		return m.InfractionDetails
+	}
+	return ""
+}
+
 // ValidatorUpdates defines an array of abci.ValidatorUpdate objects.
 // TODO: explore moving this to proto/cosmos/base to separate modules from tendermint dependence
 type ValidatorUpdates struct {
@@ -1209,7 +1478,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,6 +1517,7 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.JailReason", JailReason_name, JailReason_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
@@ -1268,6 +1538,9 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*ValidatorFlows)(nil), "cosmos.staking.v1beta1.ValidatorFlows")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*JailInfo)(nil), "cosmos.staking.v1beta1.JailInfo")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 }
 
@@ -1276,125 +1549,155 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3d, 0x6c, 0x23, 0xc7,
-	0x15, 0xd6, 0x92, 0x32, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x27, 0xdf, 0xf1, 0x78, 0x89, 0x48, 0xd3,
-	0x17, 0x5b, 0x3e, 0xf8, 0xa8, 0x9c, 0x02, 0xa4, 0x50, 0x8c, 0x04, 0xa2, 0xa8, 0xf3, 0xd1, 0xb1,
-	0x25, 0x61, 0x29, 0x29, 0x71, 0x7e, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x89, 0xb8, 0xb3, 0xc4, 0xce,
-	0xf0, 0x2c, 0xb6, 0x41, 0x0a, 0x43, 0x45, 0x62, 0x20, 0x4d, 0x9a, 0x03, 0x0e, 0x48, 0x11, 0xa7,
-	0x73, 0x61, 0xc4, 0x45, 0x90, 0x22, 0x9d, 0x93, 0x34, 0x07, 0x57, 0x41, 0x0a, 0x25, 0xb8, 0x2b,
-	0x6c, 0xa4, 0x0a, 0xd2, 0x25, 0x55, 0x30, 0xb3, 0xb3, 0x3f, 0x14, 0xc5, 0x93, 0x74, 0x50, 0x0c,
-	0x03, 0x6e, 0xc8, 0x9d, 0x99, 0xf7, 0xbe, 0x99, 0xf7, 0xbd, 0x9f, 0xf9, 0x81, 0x9b, 0xb6, 0xc7,
-	0x5d, 0x8f, 0x2f, 0x71, 0x81, 0x0f, 0x28, 0x6b, 0x2f, 0xdd, 0xbf, 0xd3, 0x22, 0x02, 0xdf, 0x09,
-	0xdb, 0xd5, 0xae, 0xef, 0x09, 0x0f, 0x5d, 0x0d, 0xa4, 0xaa, 0x61, 0xaf, 0x96, 0x2a, 0xce, 0xb7,
-	0xbd, 0xb6, 0xa7, 0x44, 0x96, 0xe4, 0x57, 0x20, 0x5d, 0xbc, 0xde, 0xf6, 0xbc, 0x76, 0x87, 0x2c,
-	0xa9, 0x56, 0xab, 0xb7, 0xb7, 0x84, 0x59, 0x5f, 0x0f, 0x2d, 0x9c, 0x1c, 0x72, 0x7a, 0x3e, 0x16,
-	0xd4, 0x63, 0x7a, 0xbc, 0x74, 0x72, 0x5c, 0x50, 0x97, 0x70, 0x81, 0xdd, 0x6e, 0x88, 0x1d, 0xac,
-	0xc4, 0x0a, 0x26, 0xd5, 0xcb, 0xd2, 0xd8, 0xda, 0x94, 0x16, 0xe6, 0x24, 0xb2, 0xc3, 0xf6, 0x68,
-	0x88, 0x3d, 0x87, 0x5d, 0xca, 0xbc, 0x25, 0xf5, 0xab, 0xbb, 0xbe, 0x22, 0x08, 0x73, 0x88, 0xef,
-	0x52, 0x26, 0x96, 0x44, 0xbf, 0x4b, 0x78, 0xf0, 0xab, 0x47, 0x6f, 0x24, 0x46, 0x71, 0xcb, 0xa6,
-	0xc9, 0xc1, 0xca, 0x2f, 0x0d, 0x98, 0xbd, 0x47, 0xb9, 0xf0, 0x7c, 0x6a, 0xe3, 0x4e, 0x83, 0xed,
-	0x79, 0xe8, 0x5b, 0x90, 0xd9, 0x27, 0xd8, 0x21, 0x7e, 0xc1, 0x28, 0x1b, 0x8b, 0xd9, 0xe5, 0x42,
-	0x35, 0x06, 0xa8, 0x06, 0xba, 0xf7, 0xd4, 0x78, 0x6d, 0xea, 0xe3, 0xe3, 0xd2, 0xd8, 0xfb, 0x9f,
-	0x7e, 0x70, 0xcb, 0x30, 0xb5, 0x0a, 0xaa, 0x43, 0xe6, 0x3e, 0xee, 0x70, 0x22, 0x0a, 0xa9, 0x72,
-	0x7a, 0x31, 0xbb, 0xfc, 0x42, 0xf5, 0x74, 0xce, 0xab, 0xbb, 0xb8, 0x43, 0x1d, 0x2c, 0xbc, 0x41,
-	0x94, 0x40, 0xb7, 0xf2, 0x51, 0x0a, 0x72, 0x6b, 0x9e, 0xeb, 0x52, 0xce, 0xa9, 0xc7, 0x4c, 0x2c,
-	0x08, 0x47, 0x3b, 0x30, 0xee, 0x63, 0x41, 0xd4, 0xa2, 0xa6, 0x6a, 0xab, 0x52, 0xe9, 0x6f, 0xc7,
-	0xa5, 0x97, 0xda, 0x54, 0xec, 0xf7, 0x5a, 0x55, 0xdb, 0x73, 0x35, 0x8d, 0xfa, 0xef, 0x36, 0x77,
-	0x0e, 0xb4, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xe1, 0x6d, 0xd0, 0x0b, 0xa9, 0x13, 0x3b, 0x98, 0x4c,
-	0xc1, 0xa1, 0x1f, 0xc1, 0xa4, 0x8b, 0x0f, 0x2d, 0x05, 0x9d, 0xba, 0x2c, 0xe8, 0x09, 0x17, 0x1f,
-	0xca, 0x55, 0x23, 0x0a, 0x39, 0x89, 0x6e, 0xef, 0x63, 0xd6, 0x26, 0xc1, 0x24, 0xe9, 0xcb, 0x9a,
-	0x64, 0xc6, 0xc5, 0x87, 0x6b, 0x0a, 0x58, 0x4e, 0xb5, 0x32, 0xfe, 0xd9, 0xc3, 0x92, 0x51, 0xf9,
-	0xa3, 0x01, 0x10, 0x33, 0x87, 0x30, 0xe4, 0xed, 0xa8, 0xa5, 0xe6, 0xe7, 0xda, 0xab, 0x2f, 0x8f,
-	0x72, 0xcc, 0x09, 0xde, 0x6b, 0x33, 0x72, 0xa5, 0x8f, 0x8e, 0x4b, 0x46, 0x30, 0x6b, 0xce, 0x3e,
-	0xe1, 0x97, 0x37, 0x20, 0xdb, 0xeb, 0x3a, 0x58, 0x10, 0x4b, 0x06, 0xb9, 0xe2, 0x30, 0xbb, 0x5c,
-	0xac, 0x06, 0x19, 0x50, 0x0d, 0x33, 0xa0, 0xba, 0x1d, 0x66, 0x40, 0x00, 0xf8, 0xde, 0xdf, 0x43,
-	0x40, 0x08, 0xb4, 0xe5, 0xb8, 0xb6, 0xe1, 0x7d, 0x03, 0xb2, 0x75, 0xc2, 0x6d, 0x9f, 0x76, 0x65,
-	0x4e, 0xa1, 0x02, 0x4c, 0xb8, 0x1e, 0xa3, 0x07, 0x3a, 0x22, 0xa7, 0xcc, 0xb0, 0x89, 0x8a, 0x30,
-	0x49, 0x1d, 0xc2, 0x04, 0x15, 0xfd, 0xc0, 0x79, 0x66, 0xd4, 0x96, 0x5a, 0xef, 0x90, 0x16, 0xa7,
-	0x21, 0xe5, 0x66, 0xd8, 0x44, 0xaf, 0x40, 0x9e, 0x13, 0xbb, 0xe7, 0x53, 0xd1, 0xb7, 0x6c, 0x8f,
-	0x09, 0x6c, 0x8b, 0xc2, 0xb8, 0x12, 0xc9, 0x85, 0xfd, 0x6b, 0x41, 0xb7, 0x04, 0x71, 0x88, 0xc0,
-	0xb4, 0xc3, 0x0b, 0xcf, 0x05, 0x20, 0xba, 0xa9, 0x97, 0xfa, 0xd1, 0x04, 0x4c, 0x45, 0x91, 0x8c,
-	0xd6, 0x20, 0xef, 0x75, 0x89, 0x2f, 0xbf, 0x2d, 0xec, 0x38, 0x3e, 0xe1, 0x5c, 0x87, 0x6b, 0xe1,
-	0x93, 0x0f, 0x6f, 0xcf, 0x6b, 0xc2, 0x57, 0x83, 0x91, 0xa6, 0xf0, 0x29, 0x6b, 0x9b, 0xb9, 0x50,
-	0x43, 0x77, 0xa3, 0xb7, 0xa5, 0xcb, 0x18, 0x27, 0x8c, 0xf7, 0xb8, 0xd5, 0xed, 0xb5, 0x0e, 0x48,
-	0x5f, 0x93, 0x3a, 0x3f, 0x44, 0xea, 0x2a, 0xeb, 0xd7, 0x0a, 0x7f, 0x8e, 0xa1, 0x6d, 0xbf, 0xdf,
-	0x15, 0x5e, 0x75, 0xab, 0xd7, 0xfa, 0x2e, 0xe9, 0x4b, 0x57, 0x69, 0x9c, 0x2d, 0x05, 0x83, 0xae,
-	0x42, 0xe6, 0x27, 0x98, 0x76, 0x88, 0xa3, 0x18, 0x99, 0x34, 0x75, 0x0b, 0xad, 0x40, 0x86, 0x0b,
-	0x2c, 0x7a, 0x5c, 0xd1, 0x30, 0xbb, 0x5c, 0x19, 0x15, 0x1b, 0x35, 0x8f, 0x39, 0x4d, 0x25, 0x69,
-	0x6a, 0x0d, 0xb4, 0x0d, 0x19, 0xe1, 0x1d, 0x10, 0xa6, 0x09, 0xaa, 0xbd, 0x76, 0x81, 0xc0, 0x6e,
-	0x30, 0x91, 0x08, 0xec, 0x06, 0x13, 0xa6, 0xc6, 0x42, 0x6d, 0xc8, 0x3b, 0xa4, 0x43, 0xda, 0x8a,
-	0x4a, 0xbe, 0x8f, 0x7d, 0xc2, 0x0b, 0x99, 0x0b, 0xe3, 0x0f, 0x25, 0x8e, 0x99, 0x8b, 0x50, 0x9b,
-	0x0a, 0x14, 0x6d, 0x41, 0xd6, 0x89, 0x43, 0xad, 0x30, 0xa1, 0x88, 0x7e, 0x71, 0x94, 0xfd, 0x89,
-	0xa8, 0x4c, 0x96, 0xad, 0x24, 0x84, 0x8c, 0xae, 0x1e, 0x6b, 0x79, 0xcc, 0xa1, 0xac, 0x6d, 0xed,
-	0x13, 0xda, 0xde, 0x17, 0x85, 0xc9, 0xb2, 0xb1, 0x98, 0x36, 0x73, 0x51, 0xff, 0x3d, 0xd5, 0x8d,
-	0xb6, 0x60, 0x36, 0x16, 0x55, 0xd9, 0x33, 0x75, 0xd1, 0xec, 0x99, 0x89, 0x00, 0xa4, 0x08, 0x7a,
-	0x0b, 0x20, 0xce, 0xcf, 0x02, 0x28, 0xb4, 0xca, 0xd9, 0x99, 0x9e, 0x34, 0x26, 0x01, 0x80, 0x3a,
-	0x70, 0xc5, 0xa5, 0xcc, 0xe2, 0xa4, 0xb3, 0x67, 0x69, 0xe6, 0x24, 0x6e, 0xf6, 0x12, 0x3c, 0x3d,
-	0xe7, 0x52, 0xd6, 0x24, 0x9d, 0xbd, 0x7a, 0x04, 0x8b, 0x5e, 0x83, 0x1b, 0x31, 0x1d, 0x1e, 0xb3,
-	0xf6, 0xbd, 0x8e, 0x63, 0xf9, 0x64, 0xcf, 0xb2, 0xbd, 0x1e, 0x13, 0x85, 0x69, 0x45, 0xe2, 0xb5,
-	0x48, 0x64, 0x93, 0xdd, 0xf3, 0x3a, 0x8e, 0x49, 0xf6, 0xd6, 0xe4, 0x30, 0x7a, 0x11, 0x62, 0x2e,
-	0x2c, 0xea, 0xf0, 0xc2, 0x4c, 0x39, 0xbd, 0x38, 0x6e, 0x4e, 0x47, 0x9d, 0x0d, 0x87, 0xaf, 0x4c,
-	0xbe, 0xfb, 0xb0, 0x34, 0xf6, 0xd9, 0xc3, 0xd2, 0x58, 0xe5, 0x2e, 0x4c, 0xef, 0xe2, 0x8e, 0x4e,
-	0x3a, 0xc2, 0xd1, 0x37, 0x61, 0x0a, 0x87, 0x8d, 0x82, 0x51, 0x4e, 0x3f, 0x35, 0x69, 0x63, 0xd1,
-	0xca, 0x43, 0x03, 0x32, 0xf5, 0xdd, 0x2d, 0x4c, 0x7d, 0xb4, 0x0e, 0x73, 0x71, 0xd0, 0x9e, 0x37,
-	0xff, 0xe3, 0x38, 0x0f, 0x0b, 0xc0, 0x3a, 0xcc, 0xdd, 0x0f, 0x4b, 0x4a, 0x04, 0x93, 0x3a, 0x0b,
-	0x26, 0x52, 0xd1, 0xfd, 0x09, 0x53, 0xdf, 0x80, 0x89, 0x60, 0x85, 0x1c, 0x7d, 0x07, 0x9e, 0xeb,
-	0xca, 0x0f, 0x65, 0x61, 0x76, 0x79, 0x61, 0x64, 0xa0, 0x2b, 0xf9, 0x64, 0x58, 0x04, 0x7a, 0x95,
-	0xff, 0x18, 0x00, 0xf5, 0xdd, 0xdd, 0x6d, 0x9f, 0x76, 0x3b, 0x44, 0x5c, 0x96, 0xc9, 0x6f, 0xc2,
-	0xf3, 0xb1, 0xc9, 0xdc, 0xb7, 0xcf, 0x6d, 0xf6, 0x95, 0x48, 0xad, 0xe9, 0xdb, 0xa7, 0xa2, 0x39,
-	0x5c, 0x44, 0x68, 0xe9, 0x73, 0xa3, 0xd5, 0xb9, 0x18, 0xe6, 0xf1, 0xfb, 0x90, 0x8d, 0x4d, 0xe7,
-	0xa8, 0x01, 0x93, 0x42, 0x7f, 0x6b, 0x3a, 0x2b, 0xa3, 0xe9, 0x0c, 0xd5, 0x92, 0x94, 0x46, 0xea,
-	0x95, 0xff, 0x4a, 0x56, 0xe3, 0x44, 0xf8, 0x42, 0x05, 0x92, 0xac, 0xf0, 0xba, 0x02, 0xa7, 0x2f,
-	0xa1, 0x02, 0x6b, 0xac, 0x04, 0xad, 0x3f, 0x4b, 0xc1, 0x95, 0x9d, 0x30, 0x49, 0xbf, 0xb0, 0x2c,
-	0xec, 0xc0, 0x04, 0x61, 0xc2, 0xa7, 0x8a, 0x06, 0xe9, 0xec, 0xaf, 0x8f, 0x72, 0xf6, 0x29, 0xb6,
-	0xac, 0x33, 0xe1, 0xf7, 0x93, 0xae, 0x0f, 0xb1, 0x12, 0x34, 0xfc, 0x21, 0x0d, 0x85, 0x51, 0xaa,
-	0xe8, 0x65, 0xc8, 0xd9, 0x3e, 0x51, 0x1d, 0xe1, 0x9e, 0x62, 0xa8, 0x72, 0x38, 0x1b, 0x76, 0xeb,
-	0x2d, 0xc5, 0x04, 0x79, 0x40, 0x93, 0x51, 0x25, 0x45, 0x9f, 0xed, 0x44, 0x36, 0x1b, 0x23, 0xa8,
-	0x4d, 0x85, 0x40, 0x8e, 0x32, 0x2a, 0x28, 0xee, 0x58, 0x2d, 0xdc, 0xc1, 0xcc, 0x26, 0xcf, 0x10,
-	0x09, 0xc3, 0x3b, 0xc0, 0xac, 0x06, 0xad, 0x05, 0x98, 0x68, 0x17, 0x26, 0x42, 0xf8, 0xf1, 0x4b,
-	0x80, 0x0f, 0xc1, 0xd0, 0x0b, 0x30, 0x9d, 0xdc, 0x18, 0xd4, 0x39, 0x65, 0xdc, 0xcc, 0x26, 0xf6,
-	0x85, 0xb3, 0x76, 0x9e, 0xcc, 0x53, 0x77, 0x1e, 0x7d, 0x14, 0xfc, 0x7d, 0x1a, 0xe6, 0x4c, 0xe2,
-	0x7c, 0x09, 0x1d, 0xf7, 0x43, 0x80, 0x20, 0xa9, 0x65, 0xb1, 0x7d, 0x06, 0xdf, 0x0d, 0x17, 0x89,
-	0xa9, 0x00, 0xaf, 0xce, 0xc5, 0xe7, 0xe5, 0xbd, 0xbf, 0xa4, 0x60, 0x3a, 0xe9, 0xbd, 0x2f, 0xc1,
-	0xce, 0x86, 0x36, 0xe2, 0x92, 0x36, 0xae, 0x4a, 0xda, 0x2b, 0xa3, 0x4a, 0xda, 0x50, 0x5c, 0x9f,
-	0x51, 0xcb, 0x7e, 0x93, 0x86, 0xcc, 0x16, 0xf6, 0xb1, 0xcb, 0xd1, 0xe6, 0xd0, 0x19, 0x37, 0xb8,
-	0x7f, 0x5e, 0x1f, 0x0a, 0xeb, 0xba, 0x7e, 0x43, 0x09, 0xa2, 0xfa, 0x57, 0xa3, 0x8e, 0xb8, 0x5f,
-	0x83, 0x59, 0x79, 0xa5, 0x8e, 0x0c, 0x0a, 0xa8, 0x9c, 0x51, 0xd7, 0xe1, 0xe8, 0x2a, 0xc6, 0x51,
-	0x09, 0xb2, 0x52, 0x2c, 0xae, 0xd9, 0x52, 0x06, 0x5c, 0x7c, 0xb8, 0x1e, 0xf4, 0xa0, 0xdb, 0x80,
-	0xf6, 0xa3, 0x87, 0x0f, 0x2b, 0x26, 0x42, 0xca, 0xcd, 0xc5, 0x23, 0xa1, 0xf8, 0x57, 0x01, 0xe4,
-	0x2a, 0x2c, 0x87, 0x30, 0xcf, 0xd5, 0x97, 0xc1, 0x29, 0xd9, 0x53, 0x97, 0x1d, 0xe8, 0x17, 0x46,
-	0x70, 0x54, 0x3e, 0x71, 0xdb, 0xd6, 0x97, 0x16, 0xeb, 0x62, 0xd9, 0xf0, 0xef, 0xe3, 0x52, 0xb1,
-	0x8f, 0xdd, 0xce, 0x4a, 0xe5, 0x14, 0xc8, 0xca, 0x69, 0x6f, 0x01, 0xf2, 0x34, 0x3d, 0x78, 0x71,
-	0x5f, 0xb9, 0x29, 0xe3, 0xfa, 0xe8, 0xd3, 0x0f, 0x6e, 0xdd, 0x48, 0x4c, 0x71, 0x18, 0xbd, 0x90,
-	0x05, 0xee, 0xa9, 0xfc, 0xd6, 0x00, 0x14, 0x6f, 0x36, 0x26, 0xe1, 0x5d, 0x79, 0x67, 0x94, 0xf7,
-	0x88, 0xc4, 0x79, 0xdf, 0x78, 0xfa, 0x3d, 0x22, 0xd6, 0x1f, 0xb8, 0x47, 0x24, 0x92, 0xe9, 0xdb,
-	0x71, 0x69, 0x4f, 0x69, 0xef, 0x6b, 0xac, 0x16, 0xe6, 0x24, 0x71, 0x21, 0xa1, 0x03, 0x10, 0xa1,
-	0x92, 0xca, 0xd1, 0xb1, 0xca, 0xb1, 0x01, 0xd7, 0x87, 0x22, 0x31, 0x5a, 0xb2, 0x0d, 0xc8, 0x4f,
-	0x0c, 0x2a, 0x8f, 0xf6, 0xf5, 0xd2, 0x9f, 0x2d, 0xb0, 0xe7, 0xfc, 0xa1, 0x72, 0xfe, 0x7f, 0xda,
-	0xa3, 0x74, 0x11, 0xfa, 0x93, 0x01, 0xf3, 0xc9, 0x15, 0x45, 0xb6, 0x35, 0x61, 0x3a, 0xb9, 0x16,
-	0x6d, 0xd5, 0xcd, 0xf3, 0x58, 0x95, 0x34, 0x68, 0x00, 0x44, 0xda, 0x12, 0x46, 0x7d, 0xf0, 0x56,
-	0x77, 0xe7, 0xdc, 0x2c, 0x85, 0x0b, 0x3b, 0xb5, 0x0c, 0x04, 0xce, 0xfa, 0x79, 0x0a, 0xc6, 0xb7,
-	0x3c, 0xaf, 0x83, 0x7e, 0x6a, 0xc0, 0x1c, 0xf3, 0x84, 0x25, 0x73, 0x85, 0x38, 0x96, 0x7e, 0x2c,
-	0x08, 0x2a, 0xe9, 0xee, 0xc5, 0xd8, 0xfb, 0xe7, 0x71, 0x69, 0x18, 0x6a, 0x90, 0x52, 0xfd, 0x48,
-	0xc5, 0x3c, 0x51, 0x53, 0x42, 0xdb, 0xc1, 0x7b, 0xc2, 0x3b, 0x30, 0x33, 0x38, 0x7f, 0x50, 0x7e,
-	0xcd, 0x0b, 0xcf, 0x3f, 0x73, 0xe6, 0xdc, 0xd3, 0xad, 0xc4, 0xc4, 0x2b, 0x93, 0xd2, 0xb1, 0xff,
-	0x92, 0xce, 0x7d, 0x1b, 0xf2, 0x51, 0x79, 0xda, 0x51, 0x4f, 0x5e, 0xf2, 0x6c, 0x3a, 0x11, 0xbc,
-	0x7e, 0x85, 0x37, 0x88, 0x72, 0xf2, 0xad, 0x15, 0xb7, 0x6c, 0x5a, 0x3d, 0xa1, 0x33, 0xc0, 0xb8,
-	0xd6, 0xbd, 0xf5, 0x3b, 0x03, 0x20, 0x7e, 0x9a, 0x41, 0xaf, 0xc2, 0xb5, 0xda, 0xe6, 0x46, 0xdd,
-	0x6a, 0x6e, 0xaf, 0x6e, 0xef, 0x34, 0xad, 0x9d, 0x8d, 0xe6, 0xd6, 0xfa, 0x5a, 0xe3, 0x6e, 0x63,
-	0xbd, 0x9e, 0x1f, 0x2b, 0xe6, 0x8e, 0x1e, 0x94, 0xb3, 0x3b, 0x8c, 0x77, 0x89, 0x4d, 0xf7, 0x28,
-	0x71, 0xd0, 0x4b, 0x30, 0x3f, 0x28, 0x2d, 0x5b, 0xeb, 0xf5, 0xbc, 0x51, 0x9c, 0x3e, 0x7a, 0x50,
-	0x9e, 0x0c, 0x8e, 0xa4, 0xc4, 0x41, 0x8b, 0xf0, 0xfc, 0xb0, 0x5c, 0x63, 0xe3, 0xf5, 0x7c, 0xaa,
-	0x38, 0x73, 0xf4, 0xa0, 0x3c, 0x15, 0x9d, 0x5d, 0x51, 0x05, 0x50, 0x52, 0x52, 0xe3, 0xa5, 0x8b,
-	0x70, 0xf4, 0xa0, 0x9c, 0x09, 0xdc, 0x52, 0x1c, 0x7f, 0xf7, 0xd7, 0x0b, 0x63, 0xb7, 0x7e, 0x0c,
-	0xd0, 0x60, 0x7b, 0x3e, 0xb6, 0x55, 0x40, 0x16, 0xe1, 0x6a, 0x63, 0xe3, 0xae, 0xb9, 0xba, 0xb6,
-	0xdd, 0xd8, 0xdc, 0x18, 0x5c, 0xf6, 0x89, 0xb1, 0xfa, 0xe6, 0x4e, 0xed, 0xcd, 0x75, 0xab, 0xd9,
-	0x78, 0x7d, 0x23, 0x6f, 0xa0, 0x6b, 0x70, 0x65, 0x60, 0xec, 0x7b, 0x1b, 0xdb, 0x8d, 0xb7, 0xd6,
-	0xf3, 0xa9, 0xda, 0xdd, 0x8f, 0x1f, 0x2f, 0x18, 0x8f, 0x1e, 0x2f, 0x18, 0xff, 0x78, 0xbc, 0x60,
-	0xbc, 0xf7, 0x64, 0x61, 0xec, 0xd1, 0x93, 0x85, 0xb1, 0xbf, 0x3e, 0x59, 0x18, 0xfb, 0xc1, 0xab,
-	0x4f, 0x75, 0x78, 0x5c, 0x25, 0x95, 0xeb, 0x5b, 0x19, 0xb5, 0x47, 0x7d, 0xe3, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0xd7, 0xc2, 0x0d, 0x66, 0x18, 0x00, 0x00,
+	// 2364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x0c, 0x25, 0x3d, 0x8a, 0x1f, 0x1a, 0xc9, 0x32, 0x25, 0x27, 0x92, 0x4c, 0xfb,
+	0x9f, 0x38, 0x46, 0x44, 0xc5, 0xfe, 0x03, 0x01, 0xaa, 0xa6, 0x2d, 0x44, 0x91, 0xb2, 0xe9, 0xc8,
+	0xb2, 0xb0, 0x94, 0xd4, 0xa6, 0x6d, 0xb0, 0x58, 0xee, 0x8e, 0xa8, 0xad, 0x96, 0xbb, 0xec, 0xce,
+	0xd2, 0x32, 0x81, 0x9e, 0x8a, 0x16, 0x08, 0x7c, 0x68, 0x03, 0xe4, 0xd2, 0x43, 0x0d, 0x18, 0xe8,
+	0x25, 0xbd, 0x05, 0x85, 0xd1, 0x9e, 0x7a, 0x28, 0x7a, 0x49, 0x3f, 0x0e, 0x86, 0xd1, 0x43, 0xd1,
+	0x83, 0x5a, 0xd8, 0x87, 0x04, 0x3d, 0x15, 0xbd, 0xb5, 0xa7, 0x62, 0x3e, 0x76, 0x77, 0x48, 0x8a,
+	0xb6, 0x24, 0x30, 0x40, 0x80, 0x5c, 0x24, 0xce, 0xbc, 0xf7, 0x7e, 0x6f, 0xde, 0x9b, 0x37, 0xef,
+	0xcd, 0xbc, 0x85, 0xcb, 0x86, 0x4b, 0x9a, 0x2e, 0x59, 0x26, 0xbe, 0x7e, 0x60, 0x39, 0x8d, 0xe5,
+	0xbb, 0xd7, 0xea, 0xd8, 0xd7, 0xaf, 0x05, 0xe3, 0x62, 0xcb, 0x73, 0x7d, 0x17, 0xcd, 0x70, 0xae,
+	0x62, 0x30, 0x2b, 0xb8, 0xe6, 0xa6, 0x1b, 0x6e, 0xc3, 0x65, 0x2c, 0xcb, 0xf4, 0x17, 0xe7, 0x9e,
+	0x9b, 0x6d, 0xb8, 0x6e, 0xc3, 0xc6, 0xcb, 0x6c, 0x54, 0x6f, 0xef, 0x2d, 0xeb, 0x4e, 0x47, 0x90,
+	0xe6, 0x7b, 0x49, 0x66, 0xdb, 0xd3, 0x7d, 0xcb, 0x75, 0x04, 0x7d, 0xa1, 0x97, 0xee, 0x5b, 0x4d,
+	0x4c, 0x7c, 0xbd, 0xd9, 0x0a, 0xb0, 0xf9, 0x4a, 0x34, 0xae, 0x54, 0x2c, 0x4b, 0x60, 0x0b, 0x53,
+	0xea, 0x3a, 0xc1, 0xa1, 0x1d, 0x86, 0x6b, 0x05, 0xd8, 0x93, 0x7a, 0xd3, 0x72, 0xdc, 0x65, 0xf6,
+	0x57, 0x4c, 0xbd, 0xec, 0x63, 0xc7, 0xc4, 0x5e, 0xd3, 0x72, 0xfc, 0x65, 0xbf, 0xd3, 0xc2, 0x84,
+	0xff, 0x15, 0xd4, 0x0b, 0x12, 0x55, 0xaf, 0x1b, 0x96, 0x4c, 0x2c, 0x7c, 0xa8, 0x40, 0xe6, 0xa6,
+	0x45, 0x7c, 0xd7, 0xb3, 0x0c, 0xdd, 0xae, 0x3a, 0x7b, 0x2e, 0xfa, 0x2a, 0x24, 0xf7, 0xb1, 0x6e,
+	0x62, 0x2f, 0xaf, 0x2c, 0x2a, 0x57, 0x52, 0xd7, 0xf3, 0xc5, 0x08, 0xa0, 0xc8, 0x65, 0x6f, 0x32,
+	0x7a, 0x69, 0xfc, 0x93, 0xa3, 0x85, 0x91, 0x8f, 0x3e, 0xfd, 0xf8, 0xaa, 0xa2, 0x0a, 0x11, 0x54,
+	0x86, 0xe4, 0x5d, 0xdd, 0x26, 0xd8, 0xcf, 0xc7, 0x16, 0xe3, 0x57, 0x52, 0xd7, 0x2f, 0x16, 0x8f,
+	0xf7, 0x79, 0x71, 0x57, 0xb7, 0x2d, 0x53, 0xf7, 0xdd, 0x6e, 0x14, 0x2e, 0x5b, 0xf8, 0x4d, 0x0c,
+	0xb2, 0x6b, 0x6e, 0xb3, 0x69, 0x11, 0x62, 0xb9, 0x8e, 0xaa, 0xfb, 0x98, 0xa0, 0x1d, 0x48, 0x78,
+	0xba, 0x8f, 0xd9, 0xa2, 0xc6, 0x4b, 0xab, 0x54, 0xe8, 0x6f, 0x47, 0x0b, 0xaf, 0x36, 0x2c, 0x7f,
+	0xbf, 0x5d, 0x2f, 0x1a, 0x6e, 0x53, 0xb8, 0x51, 0xfc, 0x5b, 0x22, 0xe6, 0x81, 0xb0, 0xb4, 0x8c,
+	0x8d, 0x27, 0x8f, 0x96, 0x40, 0x2c, 0xa4, 0x8c, 0x0d, 0xae, 0x8c, 0xc1, 0xa1, 0xef, 0xc2, 0x58,
+	0x53, 0xbf, 0xa7, 0x31, 0xe8, 0xd8, 0xb0, 0xa0, 0x47, 0x9b, 0xfa, 0x3d, 0xba, 0x6a, 0x64, 0x41,
+	0x96, 0xa2, 0x1b, 0xfb, 0xba, 0xd3, 0xc0, 0x5c, 0x49, 0x7c, 0x58, 0x4a, 0xd2, 0x4d, 0xfd, 0xde,
+	0x1a, 0x03, 0xa6, 0xaa, 0x56, 0x12, 0x9f, 0x3d, 0x5c, 0x50, 0x0a, 0xbf, 0x53, 0x00, 0x22, 0xcf,
+	0x21, 0x1d, 0x72, 0x46, 0x38, 0x62, 0xfa, 0x89, 0xd8, 0xd5, 0xd7, 0x06, 0x6d, 0x4c, 0x8f, 0xdf,
+	0x4b, 0x69, 0xba, 0xd2, 0xc7, 0x47, 0x0b, 0x0a, 0xd7, 0x9a, 0x35, 0x7a, 0xf6, 0xe5, 0x16, 0xa4,
+	0xda, 0x2d, 0x53, 0xf7, 0xb1, 0x46, 0x83, 0x9c, 0xf9, 0x30, 0x75, 0x7d, 0xae, 0xc8, 0x4f, 0x40,
+	0x31, 0x38, 0x01, 0xc5, 0xed, 0xe0, 0x04, 0x70, 0xc0, 0x0f, 0xfe, 0x1e, 0x00, 0x02, 0x97, 0xa6,
+	0x74, 0x61, 0xc3, 0x47, 0x0a, 0xa4, 0xca, 0x98, 0x18, 0x9e, 0xd5, 0xa2, 0x67, 0x0a, 0xe5, 0x61,
+	0xb4, 0xe9, 0x3a, 0xd6, 0x81, 0x88, 0xc8, 0x71, 0x35, 0x18, 0xa2, 0x39, 0x18, 0xb3, 0x4c, 0xec,
+	0xf8, 0x96, 0xdf, 0xe1, 0x9b, 0xa7, 0x86, 0x63, 0x2a, 0x75, 0x88, 0xeb, 0xc4, 0x0a, 0x5c, 0xae,
+	0x06, 0x43, 0xf4, 0x3a, 0xe4, 0x08, 0x36, 0xda, 0x9e, 0xe5, 0x77, 0x34, 0xc3, 0x75, 0x7c, 0xdd,
+	0xf0, 0xf3, 0x09, 0xc6, 0x92, 0x0d, 0xe6, 0xd7, 0xf8, 0x34, 0x05, 0x31, 0xb1, 0xaf, 0x5b, 0x36,
+	0xc9, 0xbf, 0xc4, 0x41, 0xc4, 0x50, 0x2c, 0xf5, 0xe7, 0x63, 0x30, 0x1e, 0x46, 0x32, 0x5a, 0x83,
+	0x9c, 0xdb, 0xc2, 0x1e, 0xfd, 0xad, 0xe9, 0xa6, 0xe9, 0x61, 0x42, 0x44, 0xb8, 0xe6, 0x9f, 0x3c,
+	0x5a, 0x9a, 0x16, 0x0e, 0x5f, 0xe5, 0x94, 0x9a, 0xef, 0x59, 0x4e, 0x43, 0xcd, 0x06, 0x12, 0x62,
+	0x1a, 0xbd, 0x4b, 0xb7, 0xcc, 0x21, 0xd8, 0x21, 0x6d, 0xa2, 0xb5, 0xda, 0xf5, 0x03, 0xdc, 0x11,
+	0x4e, 0x9d, 0xee, 0x73, 0xea, 0xaa, 0xd3, 0x29, 0xe5, 0xff, 0x18, 0x41, 0x1b, 0x5e, 0xa7, 0xe5,
+	0xbb, 0xc5, 0xad, 0x76, 0xfd, 0x1d, 0xdc, 0x51, 0xb3, 0x21, 0xce, 0x16, 0x83, 0x41, 0x33, 0x90,
+	0xfc, 0x9e, 0x6e, 0xd9, 0xd8, 0x64, 0x1e, 0x19, 0x53, 0xc5, 0x08, 0xad, 0x40, 0x92, 0xf8, 0xba,
+	0xdf, 0x26, 0xcc, 0x0d, 0x99, 0xeb, 0x85, 0x41, 0xb1, 0x51, 0x72, 0x1d, 0xb3, 0xc6, 0x38, 0x55,
+	0x21, 0x81, 0xb6, 0x21, 0xe9, 0xbb, 0x07, 0xd8, 0x11, 0x0e, 0x2a, 0xbd, 0x7d, 0x8a, 0xc0, 0xae,
+	0x3a, 0xbe, 0x14, 0xd8, 0x55, 0xc7, 0x57, 0x05, 0x16, 0x6a, 0x40, 0xce, 0xc4, 0x36, 0x6e, 0x30,
+	0x57, 0x92, 0x7d, 0xdd, 0xc3, 0x24, 0x9f, 0x3c, 0x35, 0x7e, 0xdf, 0xc1, 0x51, 0xb3, 0x21, 0x6a,
+	0x8d, 0x81, 0xa2, 0x2d, 0x48, 0x99, 0x51, 0xa8, 0xe5, 0x47, 0x99, 0xa3, 0x2f, 0x0d, 0xb2, 0x5f,
+	0x8a, 0x4a, 0x39, 0x6d, 0xc9, 0x10, 0x34, 0xba, 0xda, 0x4e, 0xdd, 0x75, 0x4c, 0xcb, 0x69, 0x68,
+	0xfb, 0xd8, 0x6a, 0xec, 0xfb, 0xf9, 0xb1, 0x45, 0xe5, 0x4a, 0x5c, 0xcd, 0x86, 0xf3, 0x37, 0xd9,
+	0x34, 0xda, 0x82, 0x4c, 0xc4, 0xca, 0x4e, 0xcf, 0xf8, 0x69, 0x4f, 0x4f, 0x3a, 0x04, 0xa0, 0x2c,
+	0xe8, 0x36, 0x40, 0x74, 0x3e, 0xf3, 0xc0, 0xd0, 0x0a, 0x2f, 0x3e, 0xe9, 0xb2, 0x31, 0x12, 0x00,
+	0xb2, 0x61, 0xaa, 0x69, 0x39, 0x1a, 0xc1, 0xf6, 0x9e, 0x26, 0x3c, 0x47, 0x71, 0x53, 0x43, 0xd8,
+	0xe9, 0xc9, 0xa6, 0xe5, 0xd4, 0xb0, 0xbd, 0x57, 0x0e, 0x61, 0xd1, 0xdb, 0x70, 0x21, 0x72, 0x87,
+	0xeb, 0x68, 0xfb, 0xae, 0x6d, 0x6a, 0x1e, 0xde, 0xd3, 0x0c, 0xb7, 0xed, 0xf8, 0xf9, 0x09, 0xe6,
+	0xc4, 0xf3, 0x21, 0xcb, 0x1d, 0xe7, 0xa6, 0x6b, 0x9b, 0x2a, 0xde, 0x5b, 0xa3, 0x64, 0x74, 0x09,
+	0x22, 0x5f, 0x68, 0x96, 0x49, 0xf2, 0xe9, 0xc5, 0xf8, 0x95, 0x84, 0x3a, 0x11, 0x4e, 0x56, 0x4d,
+	0x82, 0x0c, 0xc8, 0x50, 0x83, 0x24, 0x5b, 0x32, 0x43, 0xb0, 0x25, 0xdd, 0xb4, 0x9c, 0xc8, 0x8e,
+	0x95, 0xb1, 0xf7, 0x1f, 0x2e, 0x8c, 0x7c, 0xf6, 0x70, 0x61, 0xa4, 0xb0, 0x0e, 0x13, 0xbb, 0xba,
+	0x2d, 0x4e, 0x36, 0x26, 0xe8, 0x2d, 0x18, 0xd7, 0x83, 0x41, 0x5e, 0x59, 0x8c, 0x3f, 0x37, 0x33,
+	0x44, 0xac, 0x85, 0x87, 0x0a, 0x24, 0xcb, 0xbb, 0x5b, 0xba, 0xe5, 0xa1, 0x0a, 0x4c, 0x46, 0x27,
+	0xe3, 0xa4, 0x49, 0x26, 0x3a, 0x4c, 0x62, 0x9e, 0xc2, 0xdc, 0x0d, 0xf2, 0x56, 0x08, 0x13, 0x7b,
+	0x11, 0x4c, 0x28, 0x22, 0xe6, 0x25, 0x53, 0x6f, 0xc1, 0x28, 0x5f, 0x21, 0x41, 0xdf, 0x80, 0x97,
+	0x5a, 0xf4, 0x07, 0xb3, 0x30, 0x75, 0x7d, 0x7e, 0xe0, 0x69, 0x62, 0xfc, 0x72, 0xec, 0x71, 0xb9,
+	0xc2, 0x7f, 0x14, 0x80, 0xf2, 0xee, 0xee, 0xb6, 0x67, 0xb5, 0x6c, 0xec, 0x0f, 0xcb, 0xe4, 0x0d,
+	0x38, 0x17, 0x99, 0x4c, 0x3c, 0xe3, 0xc4, 0x66, 0x4f, 0x85, 0x62, 0x35, 0xcf, 0x38, 0x16, 0xcd,
+	0x24, 0x7e, 0x88, 0x16, 0x3f, 0x31, 0x5a, 0x99, 0xf8, 0xfd, 0x7e, 0xfc, 0x16, 0xa4, 0x22, 0xd3,
+	0x09, 0xaa, 0xc2, 0x98, 0x2f, 0x7e, 0x0b, 0x77, 0x16, 0x06, 0xbb, 0x33, 0x10, 0x93, 0x5d, 0x1a,
+	0x8a, 0x17, 0xfe, 0x4b, 0xbd, 0x1a, 0x9d, 0xb6, 0x2f, 0x54, 0x20, 0xd1, 0x32, 0x22, 0xd2, 0x7c,
+	0x7c, 0x08, 0x69, 0x5e, 0x60, 0x49, 0x6e, 0xfd, 0x51, 0x0c, 0xa6, 0x76, 0x82, 0x4c, 0xf0, 0x85,
+	0xf5, 0xc2, 0x0e, 0x8c, 0x62, 0xc7, 0xf7, 0x2c, 0xe6, 0x06, 0xba, 0xd9, 0x6f, 0x0e, 0xda, 0xec,
+	0x63, 0x6c, 0xa9, 0x38, 0xbe, 0xd7, 0x91, 0xb7, 0x3e, 0xc0, 0x92, 0xdc, 0xf0, 0xdb, 0x38, 0xe4,
+	0x07, 0x89, 0xa2, 0xd7, 0x20, 0x6b, 0x78, 0x98, 0x4d, 0x04, 0x85, 0x4b, 0x61, 0x39, 0x37, 0x13,
+	0x4c, 0x8b, 0xba, 0xa5, 0x02, 0xbd, 0x05, 0xd2, 0xa8, 0xa2, 0xac, 0x67, 0xbb, 0xf6, 0x65, 0x22,
+	0x04, 0x56, 0xb9, 0x30, 0x64, 0x2d, 0xc7, 0xf2, 0x2d, 0xdd, 0xd6, 0xea, 0xba, 0xad, 0x3b, 0x06,
+	0xce, 0xc7, 0x87, 0x90, 0x9a, 0x33, 0x02, 0xb4, 0xc4, 0x31, 0xd1, 0x2e, 0x8c, 0x06, 0xf0, 0x89,
+	0x21, 0xc0, 0x07, 0x60, 0xe8, 0x22, 0x4c, 0xc8, 0xd5, 0x87, 0x5d, 0x86, 0x12, 0x6a, 0x4a, 0x2a,
+	0x3e, 0x2f, 0x2a, 0x6f, 0xc9, 0xe7, 0x96, 0x37, 0x71, 0xdf, 0xfc, 0x30, 0x01, 0x93, 0x2a, 0x36,
+	0xbf, 0x84, 0x1b, 0xf7, 0x1d, 0x00, 0x7e, 0xa8, 0x69, 0xb2, 0xcd, 0x27, 0x86, 0x90, 0x24, 0xc6,
+	0x39, 0x5e, 0x99, 0xf8, 0x9f, 0xfb, 0xee, 0xc9, 0x61, 0x37, 0x3a, 0xc4, 0xb0, 0x13, 0x51, 0xf1,
+	0xa7, 0x18, 0x4c, 0xc8, 0x51, 0xf1, 0x25, 0xa8, 0x98, 0x68, 0x33, 0x4a, 0x95, 0x09, 0x96, 0x2a,
+	0x5f, 0x1f, 0x94, 0x2a, 0xfb, 0xce, 0xcb, 0x0b, 0x72, 0xe4, 0xef, 0xc7, 0x21, 0xb9, 0xa5, 0x7b,
+	0x7a, 0x93, 0xa0, 0x3b, 0x7d, 0x17, 0x74, 0xfe, 0x78, 0x9e, 0xed, 0x3b, 0x2e, 0x65, 0xd1, 0x00,
+	0xe2, 0xa7, 0xe5, 0x67, 0x83, 0xee, 0xe7, 0xff, 0x07, 0x19, 0xda, 0x0f, 0x08, 0x0d, 0xe2, 0xae,
+	0x4c, 0xb3, 0xb7, 0x7c, 0xf8, 0x8e, 0x24, 0x68, 0x01, 0x52, 0x94, 0x2d, 0xaa, 0x05, 0x94, 0x07,
+	0x9a, 0xfa, 0xbd, 0x0a, 0x9f, 0x41, 0x4b, 0x80, 0xf6, 0xc3, 0xae, 0x8d, 0x16, 0x39, 0x82, 0xf2,
+	0x4d, 0x46, 0x94, 0x80, 0xfd, 0x15, 0x00, 0xba, 0x0a, 0xcd, 0xc4, 0x8e, 0xdb, 0x14, 0x2f, 0xd9,
+	0x71, 0x3a, 0x53, 0xa6, 0x13, 0xe8, 0xa7, 0x0a, 0xbf, 0xe7, 0xf7, 0xb4, 0x0a, 0xc4, 0x8b, 0x4b,
+	0x3b, 0xdd, 0x29, 0xfb, 0xf7, 0xd1, 0xc2, 0x5c, 0x47, 0x6f, 0xda, 0x2b, 0x85, 0x63, 0x20, 0x0b,
+	0xc7, 0x35, 0x32, 0xe8, 0x53, 0xa0, 0xbb, 0xeb, 0x80, 0x7e, 0xa8, 0xc0, 0xcb, 0x5d, 0x8e, 0xd2,
+	0x5a, 0xee, 0x21, 0xf6, 0xb4, 0x3d, 0x4f, 0x37, 0xc2, 0x87, 0xda, 0x50, 0xba, 0x28, 0xb3, 0xb2,
+	0xe7, 0xb7, 0xa8, 0x92, 0x75, 0xa1, 0x03, 0x7d, 0x05, 0x28, 0x91, 0xb6, 0x08, 0x82, 0x87, 0xb8,
+	0xe6, 0xb9, 0x3e, 0xdb, 0x66, 0xc2, 0x9e, 0x74, 0x69, 0x75, 0x86, 0xf6, 0x60, 0x5c, 0x47, 0x3c,
+	0xb0, 0xd5, 0x80, 0x8a, 0x36, 0x21, 0x27, 0xb3, 0x6b, 0x7b, 0x38, 0x78, 0xdb, 0xcd, 0x06, 0x61,
+	0x4a, 0xfb, 0x7b, 0xd2, 0x53, 0xcc, 0xea, 0x7a, 0x84, 0x65, 0x24, 0xb4, 0x75, 0x8c, 0x51, 0x1b,
+	0xce, 0x35, 0x6c, 0xb7, 0xae, 0xdb, 0x5a, 0xcf, 0xf3, 0x05, 0x4e, 0xed, 0x87, 0xbe, 0x6c, 0xc2,
+	0x35, 0x4e, 0x71, 0xfc, 0xdb, 0xf2, 0x4b, 0x06, 0x11, 0x98, 0x8e, 0x76, 0xc0, 0xb6, 0xbe, 0xdf,
+	0xb6, 0x4c, 0xcd, 0xd0, 0x5b, 0xf9, 0xd4, 0xa9, 0xb5, 0x0e, 0xf0, 0x3e, 0x0a, 0xe1, 0x37, 0x18,
+	0xfa, 0x9a, 0xde, 0x42, 0x4d, 0x98, 0x14, 0xb6, 0x4a, 0x1a, 0x27, 0x86, 0xa5, 0x31, 0xcb, 0xb1,
+	0x23, 0x75, 0xd7, 0xe0, 0x9c, 0x74, 0xd6, 0xb4, 0x16, 0xf6, 0xb4, 0xba, 0xed, 0x1a, 0x07, 0xf9,
+	0x34, 0xdb, 0x61, 0x14, 0x9d, 0xba, 0x2d, 0xec, 0x95, 0x28, 0x05, 0xfd, 0x00, 0x66, 0x8f, 0x79,
+	0x16, 0x6b, 0x2c, 0x03, 0xe4, 0x33, 0xc3, 0x5a, 0xe9, 0x4c, 0xdf, 0x0b, 0x59, 0xa5, 0x7f, 0x57,
+	0x2e, 0xd3, 0x9c, 0x7f, 0xff, 0xd3, 0x8f, 0xaf, 0x5e, 0x90, 0x90, 0xee, 0x85, 0xad, 0x6f, 0x9e,
+	0xba, 0x0a, 0xbf, 0x54, 0x00, 0x49, 0x92, 0x98, 0xb4, 0x5c, 0x87, 0xb0, 0x06, 0x81, 0x14, 0x3d,
+	0xca, 0xf3, 0x1b, 0x04, 0x91, 0x7c, 0x57, 0x83, 0x40, 0x2a, 0x34, 0x5f, 0x8f, 0xea, 0x5a, 0xec,
+	0x14, 0xe1, 0xdd, 0x55, 0xbf, 0x46, 0x0a, 0x47, 0x0a, 0xcc, 0xf6, 0x65, 0xe9, 0x70, 0xc9, 0x06,
+	0x20, 0x4f, 0x22, 0xb2, 0x9d, 0xea, 0x88, 0xa5, 0x9f, 0x2d, 0xe9, 0x4f, 0x7a, 0xbd, 0xd4, 0xcf,
+	0xeb, 0x5e, 0x28, 0x0a, 0xf4, 0x1f, 0x14, 0x98, 0x96, 0x57, 0x14, 0xda, 0x56, 0x83, 0x09, 0x79,
+	0x2d, 0xc2, 0xaa, 0xcb, 0x27, 0xb1, 0x4a, 0x36, 0xa8, 0x0b, 0x84, 0xda, 0x12, 0x54, 0x04, 0xde,
+	0x84, 0xbf, 0x76, 0x62, 0x2f, 0x05, 0x0b, 0x3b, 0xb6, 0x44, 0xf2, 0xcd, 0xfa, 0x49, 0x0c, 0x12,
+	0x5b, 0xae, 0x6b, 0xd3, 0x1c, 0x3d, 0xe9, 0xb8, 0xbe, 0x46, 0xeb, 0x08, 0x36, 0x35, 0xd1, 0x05,
+	0xe4, 0xb7, 0x8c, 0xdd, 0xd3, 0x79, 0xef, 0x9f, 0x47, 0x0b, 0xfd, 0x50, 0xc7, 0x65, 0xa9, 0xac,
+	0xe3, 0xfa, 0x25, 0xc6, 0xb4, 0xcd, 0x78, 0xd0, 0x21, 0xa4, 0xbb, 0xf5, 0xf3, 0xab, 0x89, 0x7a,
+	0x6a, 0xfd, 0xe9, 0x17, 0xea, 0x9e, 0xa8, 0x4b, 0x8a, 0x57, 0xc6, 0xe8, 0xc6, 0xfe, 0x8b, 0x6e,
+	0xee, 0x5f, 0x14, 0xc8, 0x84, 0x15, 0x64, 0xdd, 0x76, 0x0f, 0x09, 0x7a, 0x0f, 0x26, 0xa3, 0x7b,
+	0x43, 0x10, 0x57, 0xdc, 0x33, 0x6f, 0x8a, 0x95, 0x9d, 0xe3, 0xf0, 0xc4, 0x3c, 0x28, 0x5a, 0xee,
+	0x72, 0x53, 0xf7, 0xf7, 0x07, 0x65, 0xe6, 0xa8, 0x9d, 0x18, 0xdc, 0x85, 0x0d, 0x98, 0x8e, 0x36,
+	0x5c, 0xd2, 0x10, 0x3b, 0xa3, 0x86, 0x29, 0x19, 0x4d, 0x28, 0x29, 0xfc, 0x2a, 0x0e, 0xb3, 0xa2,
+	0xb4, 0xbd, 0x13, 0x15, 0x23, 0xfe, 0xad, 0xa8, 0x83, 0x36, 0x06, 0xb6, 0xba, 0x2f, 0x3e, 0x79,
+	0xb4, 0xf4, 0x8a, 0xd0, 0xb0, 0xdb, 0xf3, 0xc0, 0x1d, 0xd4, 0xf3, 0xde, 0x85, 0x2c, 0xbd, 0x4e,
+	0x4b, 0x95, 0xf6, 0x8c, 0x2d, 0xef, 0xb4, 0x6b, 0x9b, 0x51, 0x3d, 0xa6, 0xb8, 0x0e, 0x3e, 0xec,
+	0xc2, 0x8d, 0x9f, 0x0d, 0xd7, 0xc1, 0x87, 0x12, 0xee, 0x0c, 0xfd, 0x44, 0xc6, 0xde, 0x59, 0x09,
+	0x76, 0xef, 0x17, 0x23, 0xf4, 0x35, 0x48, 0xb0, 0x5b, 0xe2, 0x4b, 0xa7, 0x7d, 0x54, 0x31, 0x31,
+	0xf4, 0x16, 0xc4, 0xf7, 0x30, 0xbf, 0x76, 0x9d, 0x34, 0x93, 0x52, 0x01, 0xe9, 0xee, 0xfa, 0x67,
+	0x05, 0xc6, 0x6e, 0xe9, 0x16, 0xff, 0x90, 0xb7, 0x02, 0x49, 0x0f, 0xeb, 0x44, 0xa4, 0x95, 0xe7,
+	0xb4, 0xf5, 0xa9, 0x84, 0xca, 0x38, 0x55, 0x21, 0x21, 0x59, 0x18, 0x3b, 0xd6, 0xc2, 0xf8, 0xd9,
+	0x2c, 0x5c, 0x02, 0x64, 0x39, 0xc1, 0x25, 0x4e, 0x0b, 0x3e, 0xad, 0xf0, 0x8f, 0x2f, 0x93, 0x11,
+	0xa5, 0xcc, 0x09, 0x85, 0x77, 0x21, 0x17, 0x86, 0xd0, 0x0e, 0xfb, 0x4c, 0x44, 0x5b, 0x2d, 0xa3,
+	0xfc, 0x8b, 0x51, 0xd0, 0x10, 0x5b, 0x94, 0xbf, 0x4f, 0xd2, 0x0f, 0x9c, 0xc5, 0x1e, 0x99, 0xae,
+	0x64, 0x26, 0x64, 0xaf, 0xfe, 0x5a, 0x01, 0x88, 0x3e, 0x67, 0xa0, 0x37, 0xe0, 0x7c, 0xe9, 0xce,
+	0x66, 0x59, 0xab, 0x6d, 0xaf, 0x6e, 0xef, 0xd4, 0xb4, 0x9d, 0xcd, 0xda, 0x56, 0x65, 0xad, 0xba,
+	0x5e, 0xad, 0x94, 0x73, 0x23, 0x73, 0xd9, 0xfb, 0x0f, 0x16, 0x53, 0x3b, 0x0e, 0x69, 0x61, 0xc3,
+	0xda, 0xb3, 0xb0, 0x89, 0x5e, 0x85, 0xe9, 0x6e, 0x6e, 0x3a, 0xaa, 0x94, 0x73, 0xca, 0xdc, 0xc4,
+	0xfd, 0x07, 0x8b, 0x63, 0xbc, 0xc3, 0x82, 0x4d, 0x74, 0x05, 0xce, 0xf5, 0xf3, 0x55, 0x37, 0x6f,
+	0xe4, 0x62, 0x73, 0xe9, 0xfb, 0x0f, 0x16, 0xc7, 0xc3, 0x56, 0x0c, 0x2a, 0x00, 0x92, 0x39, 0x05,
+	0x5e, 0x7c, 0x0e, 0xee, 0x3f, 0x58, 0x4c, 0xf2, 0x8c, 0x37, 0x97, 0x78, 0xff, 0x17, 0xf3, 0x23,
+	0x57, 0xdf, 0x03, 0xa8, 0x86, 0x8e, 0x42, 0x73, 0x30, 0x53, 0xdd, 0x5c, 0x57, 0x57, 0xd7, 0xb6,
+	0xab, 0x77, 0x36, 0xbb, 0x97, 0xdd, 0x43, 0x2b, 0xdf, 0xd9, 0x29, 0x6d, 0x54, 0xb4, 0x5a, 0xf5,
+	0xc6, 0x66, 0x4e, 0x41, 0xe7, 0x61, 0xaa, 0x8b, 0xf6, 0xcd, 0xcd, 0xed, 0xea, 0xed, 0x4a, 0x2e,
+	0x76, 0xf5, 0xc7, 0x0a, 0x40, 0x14, 0x0f, 0xe8, 0x02, 0x9c, 0xbf, 0xb5, 0x5a, 0xdd, 0xd0, 0xd4,
+	0xca, 0x6a, 0xad, 0x4f, 0xc1, 0x25, 0x58, 0x90, 0x89, 0xb7, 0xab, 0x9b, 0x5a, 0xad, 0xb2, 0xb1,
+	0xae, 0x95, 0x2b, 0x1b, 0x95, 0x1b, 0xab, 0x14, 0x39, 0xa7, 0xa0, 0x3c, 0x4c, 0xcb, 0x4c, 0x91,
+	0xaa, 0x5e, 0x6c, 0x79, 0x81, 0xf1, 0xd2, 0xfa, 0x27, 0x4f, 0xe7, 0x95, 0xc7, 0x4f, 0xe7, 0x95,
+	0x7f, 0x3c, 0x9d, 0x57, 0x3e, 0x78, 0x36, 0x3f, 0xf2, 0xf8, 0xd9, 0xfc, 0xc8, 0x5f, 0x9f, 0xcd,
+	0x8f, 0x7c, 0xfb, 0x8d, 0xe7, 0xe6, 0xf4, 0xe8, 0x22, 0xc4, 0xb2, 0x7b, 0x3d, 0xc9, 0x42, 0xf3,
+	0xff, 0xff, 0x37, 0x00, 0x3f, 0x97, 0x39, 0x88, 0x22, 0x20, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1403,690 +1706,717 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10925 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x69, 0x90, 0x1c, 0xd7,
-		0x79, 0xd8, 0xce, 0xb1, 0x73, 0x7c, 0x73, 0xf5, 0xbe, 0x5d, 0x00, 0x8b, 0x01, 0xb9, 0xbb, 0x6c,
-		0x1e, 0x38, 0x48, 0x2e, 0x48, 0x90, 0x00, 0xc9, 0x85, 0x28, 0x7a, 0x66, 0x76, 0xb0, 0x18, 0x60,
-		0x2f, 0xf5, 0xcc, 0x82, 0x87, 0x6d, 0xb5, 0x7b, 0x7b, 0xde, 0xee, 0x36, 0x31, 0xd3, 0x3d, 0x9a,
-		0xee, 0x01, 0x76, 0x59, 0xa9, 0x14, 0x6d, 0xd9, 0x8e, 0x8c, 0x38, 0xb6, 0x1c, 0xa7, 0x6c, 0xf9,
-		0x80, 0x43, 0xd9, 0xb1, 0x25, 0x3b, 0x97, 0xaf, 0x58, 0x76, 0x54, 0x76, 0xd9, 0xa9, 0x1c, 0xb2,
-		0x93, 0x4a, 0xc9, 0xae, 0x54, 0xe2, 0x4a, 0x25, 0x8c, 0x4d, 0xb9, 0x2c, 0x45, 0x92, 0x63, 0x5b,
-		0xa1, 0x93, 0x38, 0xaa, 0x1c, 0xf5, 0xae, 0x3e, 0xe6, 0xd8, 0x9e, 0x85, 0x48, 0x46, 0x29, 0xfd,
-		0x01, 0xe6, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xef, 0x7b, 0xdf, 0xfb, 0xae, 0xf7, 0xfa, 0x2d, 0xfc,
-		0x61, 0x19, 0x16, 0x76, 0x2d, 0x6b, 0xb7, 0x85, 0xcf, 0x77, 0xba, 0x96, 0x63, 0x6d, 0xf7, 0x76,
-		0xce, 0x37, 0xb1, 0xad, 0x77, 0x8d, 0x8e, 0x63, 0x75, 0x17, 0x69, 0x1d, 0x2a, 0x30, 0x88, 0x45,
-		0x01, 0x21, 0xaf, 0xc1, 0xd4, 0x15, 0xa3, 0x85, 0x97, 0x5d, 0xc0, 0x3a, 0x76, 0xd0, 0xb3, 0x10,
-		0xdf, 0x31, 0x5a, 0x78, 0x36, 0xb2, 0x10, 0x3b, 0x93, 0xb9, 0xf0, 0xd0, 0x62, 0x1f, 0xd2, 0x62,
-		0x10, 0x63, 0x93, 0x54, 0x2b, 0x14, 0x43, 0xfe, 0xdf, 0x71, 0x98, 0x1e, 0xd2, 0x8a, 0x10, 0xc4,
-		0x4d, 0xad, 0x4d, 0x28, 0x46, 0xce, 0xa4, 0x15, 0xfa, 0x1b, 0xcd, 0x42, 0xb2, 0xa3, 0xe9, 0x37,
-		0xb5, 0x5d, 0x3c, 0x1b, 0xa5, 0xd5, 0xa2, 0x88, 0xe6, 0x00, 0x9a, 0xb8, 0x83, 0xcd, 0x26, 0x36,
-		0xf5, 0x83, 0xd9, 0xd8, 0x42, 0xec, 0x4c, 0x5a, 0xf1, 0xd5, 0xa0, 0x47, 0x61, 0xaa, 0xd3, 0xdb,
-		0x6e, 0x19, 0xba, 0xea, 0x03, 0x83, 0x85, 0xd8, 0x99, 0x49, 0x45, 0x62, 0x0d, 0xcb, 0x1e, 0xf0,
-		0x69, 0x28, 0xdc, 0xc6, 0xda, 0x4d, 0x3f, 0x68, 0x86, 0x82, 0xe6, 0x49, 0xb5, 0x0f, 0xb0, 0x02,
-		0xd9, 0x36, 0xb6, 0x6d, 0x6d, 0x17, 0xab, 0xce, 0x41, 0x07, 0xcf, 0xc6, 0xe9, 0xec, 0x17, 0x06,
-		0x66, 0xdf, 0x3f, 0xf3, 0x0c, 0xc7, 0x6a, 0x1c, 0x74, 0x30, 0x2a, 0x41, 0x1a, 0x9b, 0xbd, 0x36,
-		0xa3, 0x30, 0x39, 0x82, 0x7f, 0x55, 0xb3, 0xd7, 0xee, 0xa7, 0x92, 0x22, 0x68, 0x9c, 0x44, 0xd2,
-		0xc6, 0xdd, 0x5b, 0x86, 0x8e, 0x67, 0x13, 0x94, 0xc0, 0xe9, 0x01, 0x02, 0x75, 0xd6, 0xde, 0x4f,
-		0x43, 0xe0, 0xa1, 0x0a, 0xa4, 0xf1, 0xbe, 0x83, 0x4d, 0xdb, 0xb0, 0xcc, 0xd9, 0x24, 0x25, 0xf2,
-		0xf0, 0x90, 0x55, 0xc4, 0xad, 0x66, 0x3f, 0x09, 0x0f, 0x0f, 0x5d, 0x82, 0xa4, 0xd5, 0x71, 0x0c,
-		0xcb, 0xb4, 0x67, 0x53, 0x0b, 0x91, 0x33, 0x99, 0x0b, 0xf7, 0x0d, 0x15, 0x84, 0x0d, 0x06, 0xa3,
-		0x08, 0x60, 0x54, 0x03, 0xc9, 0xb6, 0x7a, 0x5d, 0x1d, 0xab, 0xba, 0xd5, 0xc4, 0xaa, 0x61, 0xee,
-		0x58, 0xb3, 0x69, 0x4a, 0x60, 0x7e, 0x70, 0x22, 0x14, 0xb0, 0x62, 0x35, 0x71, 0xcd, 0xdc, 0xb1,
-		0x94, 0xbc, 0x1d, 0x28, 0xa3, 0xe3, 0x90, 0xb0, 0x0f, 0x4c, 0x47, 0xdb, 0x9f, 0xcd, 0x52, 0x09,
-		0xe1, 0x25, 0x22, 0x3a, 0xb8, 0x69, 0x90, 0xee, 0x66, 0x73, 0x4c, 0x74, 0x78, 0x51, 0xfe, 0xb5,
-		0x04, 0x14, 0xc6, 0x11, 0xbe, 0xcb, 0x30, 0xb9, 0x43, 0xe6, 0x3f, 0x1b, 0x3d, 0x0a, 0x77, 0x18,
-		0x4e, 0x90, 0xbd, 0x89, 0x7b, 0x64, 0x6f, 0x09, 0x32, 0x26, 0xb6, 0x1d, 0xdc, 0x64, 0xb2, 0x12,
-		0x1b, 0x53, 0xda, 0x80, 0x21, 0x0d, 0x0a, 0x5b, 0xfc, 0x9e, 0x84, 0xed, 0x25, 0x28, 0xb8, 0x43,
-		0x52, 0xbb, 0x9a, 0xb9, 0x2b, 0xa4, 0xf6, 0x7c, 0xd8, 0x48, 0x16, 0xab, 0x02, 0x4f, 0x21, 0x68,
-		0x4a, 0x1e, 0x07, 0xca, 0x68, 0x19, 0xc0, 0x32, 0xb1, 0xb5, 0xa3, 0x36, 0xb1, 0xde, 0x9a, 0x4d,
-		0x8d, 0xe0, 0xd2, 0x06, 0x01, 0x19, 0xe0, 0x92, 0xc5, 0x6a, 0xf5, 0x16, 0x7a, 0xce, 0x13, 0xc2,
-		0xe4, 0x08, 0x19, 0x5a, 0x63, 0xdb, 0x6f, 0x40, 0x0e, 0xb7, 0x20, 0xdf, 0xc5, 0x64, 0x47, 0xe0,
-		0x26, 0x9f, 0x59, 0x9a, 0x0e, 0x62, 0x31, 0x74, 0x66, 0x0a, 0x47, 0x63, 0x13, 0xcb, 0x75, 0xfd,
-		0x45, 0xf4, 0x20, 0xb8, 0x15, 0x2a, 0x15, 0x2b, 0xa0, 0xfa, 0x29, 0x2b, 0x2a, 0xd7, 0xb5, 0x36,
-		0x2e, 0xbe, 0x06, 0xf9, 0x20, 0x7b, 0xd0, 0x0c, 0x4c, 0xda, 0x8e, 0xd6, 0x75, 0xa8, 0x14, 0x4e,
-		0x2a, 0xac, 0x80, 0x24, 0x88, 0x61, 0xb3, 0x49, 0xf5, 0xdf, 0xa4, 0x42, 0x7e, 0xa2, 0x6f, 0xf2,
-		0x26, 0x1c, 0xa3, 0x13, 0x7e, 0x64, 0x70, 0x45, 0x03, 0x94, 0xfb, 0xe7, 0x5d, 0x7c, 0x06, 0x72,
-		0x81, 0x09, 0x8c, 0xdb, 0xb5, 0xfc, 0x57, 0xe0, 0xd8, 0x50, 0xd2, 0xe8, 0x25, 0x98, 0xe9, 0x99,
-		0x86, 0xe9, 0xe0, 0x6e, 0xa7, 0x8b, 0x89, 0xc4, 0xb2, 0xae, 0x66, 0x3f, 0x9f, 0x1c, 0x21, 0x73,
-		0x5b, 0x7e, 0x68, 0x46, 0x45, 0x99, 0xee, 0x0d, 0x56, 0x9e, 0x4b, 0xa7, 0xbe, 0x90, 0x94, 0x5e,
-		0x7f, 0xfd, 0xf5, 0xd7, 0xa3, 0xf2, 0x6f, 0x25, 0x60, 0x66, 0xd8, 0x9e, 0x19, 0xba, 0x7d, 0x8f,
-		0x43, 0xc2, 0xec, 0xb5, 0xb7, 0x71, 0x97, 0x32, 0x69, 0x52, 0xe1, 0x25, 0x54, 0x82, 0xc9, 0x96,
-		0xb6, 0x8d, 0x5b, 0xb3, 0xf1, 0x85, 0xc8, 0x99, 0xfc, 0x85, 0x47, 0xc7, 0xda, 0x95, 0x8b, 0xab,
-		0x04, 0x45, 0x61, 0x98, 0xe8, 0xfd, 0x10, 0xe7, 0xca, 0x9b, 0x50, 0x38, 0x37, 0x1e, 0x05, 0xb2,
-		0x97, 0x14, 0x8a, 0x87, 0x4e, 0x41, 0x9a, 0xfc, 0xcf, 0x64, 0x23, 0x41, 0xc7, 0x9c, 0x22, 0x15,
-		0x44, 0x2e, 0x50, 0x11, 0x52, 0x74, 0x9b, 0x34, 0xb1, 0x30, 0x7a, 0x6e, 0x99, 0x08, 0x56, 0x13,
-		0xef, 0x68, 0xbd, 0x96, 0xa3, 0xde, 0xd2, 0x5a, 0x3d, 0x4c, 0x05, 0x3e, 0xad, 0x64, 0x79, 0xe5,
-		0x0d, 0x52, 0x87, 0xe6, 0x21, 0xc3, 0x76, 0x95, 0x61, 0x36, 0xf1, 0x3e, 0xd5, 0xab, 0x93, 0x0a,
-		0xdb, 0x68, 0x35, 0x52, 0x43, 0xba, 0x7f, 0xd5, 0xb6, 0x4c, 0x21, 0x9a, 0xb4, 0x0b, 0x52, 0x41,
-		0xbb, 0x7f, 0xa6, 0x5f, 0xa5, 0xdf, 0x3f, 0x7c, 0x7a, 0x03, 0x7b, 0xe9, 0x34, 0x14, 0x28, 0xc4,
-		0x53, 0x7c, 0xe9, 0xb5, 0xd6, 0xec, 0xd4, 0x42, 0xe4, 0x4c, 0x4a, 0xc9, 0xb3, 0xea, 0x0d, 0x5e,
-		0x2b, 0xff, 0x4a, 0x14, 0xe2, 0x54, 0xb1, 0x14, 0x20, 0xd3, 0x78, 0x79, 0xb3, 0xaa, 0x2e, 0x6f,
-		0x6c, 0x95, 0x57, 0xab, 0x52, 0x04, 0xe5, 0x01, 0x68, 0xc5, 0x95, 0xd5, 0x8d, 0x52, 0x43, 0x8a,
-		0xba, 0xe5, 0xda, 0x7a, 0xe3, 0xd2, 0xd3, 0x52, 0xcc, 0x45, 0xd8, 0x62, 0x15, 0x71, 0x3f, 0xc0,
-		0x53, 0x17, 0xa4, 0x49, 0x24, 0x41, 0x96, 0x11, 0xa8, 0xbd, 0x54, 0x5d, 0xbe, 0xf4, 0xb4, 0x94,
-		0x08, 0xd6, 0x3c, 0x75, 0x41, 0x4a, 0xa2, 0x1c, 0xa4, 0x69, 0x4d, 0x79, 0x63, 0x63, 0x55, 0x4a,
-		0xb9, 0x34, 0xeb, 0x0d, 0xa5, 0xb6, 0xbe, 0x22, 0xa5, 0x5d, 0x9a, 0x2b, 0xca, 0xc6, 0xd6, 0xa6,
-		0x04, 0x2e, 0x85, 0xb5, 0x6a, 0xbd, 0x5e, 0x5a, 0xa9, 0x4a, 0x19, 0x17, 0xa2, 0xfc, 0x72, 0xa3,
-		0x5a, 0x97, 0xb2, 0x81, 0x61, 0x3d, 0x75, 0x41, 0xca, 0xb9, 0x5d, 0x54, 0xd7, 0xb7, 0xd6, 0xa4,
-		0x3c, 0x9a, 0x82, 0x1c, 0xeb, 0x42, 0x0c, 0xa2, 0xd0, 0x57, 0x75, 0xe9, 0x69, 0x49, 0xf2, 0x06,
-		0xc2, 0xa8, 0x4c, 0x05, 0x2a, 0x2e, 0x3d, 0x2d, 0x21, 0xb9, 0x02, 0x93, 0x54, 0x0c, 0x11, 0x82,
-		0xfc, 0x6a, 0xa9, 0x5c, 0x5d, 0x55, 0x37, 0x36, 0x1b, 0xb5, 0x8d, 0xf5, 0xd2, 0xaa, 0x14, 0xf1,
-		0xea, 0x94, 0xea, 0x07, 0xb6, 0x6a, 0x4a, 0x75, 0x59, 0x8a, 0xfa, 0xeb, 0x36, 0xab, 0xa5, 0x46,
-		0x75, 0x59, 0x8a, 0xc9, 0x3a, 0xcc, 0x0c, 0x53, 0xa8, 0x43, 0xb7, 0x90, 0x4f, 0x16, 0xa2, 0x23,
-		0x64, 0x81, 0xd2, 0xea, 0x97, 0x05, 0xf9, 0x73, 0x51, 0x98, 0x1e, 0x62, 0x54, 0x86, 0x76, 0xf2,
-		0x02, 0x4c, 0x32, 0x59, 0x66, 0x66, 0xf6, 0xec, 0x50, 0xeb, 0x44, 0x25, 0x7b, 0xc0, 0xd4, 0x52,
-		0x3c, 0xbf, 0x13, 0x12, 0x1b, 0xe1, 0x84, 0x10, 0x12, 0x03, 0x02, 0xfb, 0xad, 0x03, 0xca, 0x9f,
-		0xd9, 0xc7, 0x4b, 0xe3, 0xd8, 0x47, 0x5a, 0x77, 0x34, 0x23, 0x30, 0x39, 0xc4, 0x08, 0x5c, 0x86,
-		0xa9, 0x01, 0x42, 0x63, 0x2b, 0xe3, 0x0f, 0x47, 0x60, 0x76, 0x14, 0x73, 0x42, 0x54, 0x62, 0x34,
-		0xa0, 0x12, 0x2f, 0xf7, 0x73, 0xf0, 0x81, 0xd1, 0x8b, 0x30, 0xb0, 0xd6, 0x9f, 0x88, 0xc0, 0xf1,
-		0xe1, 0xce, 0xe6, 0xd0, 0x31, 0xbc, 0x1f, 0x12, 0x6d, 0xec, 0xec, 0x59, 0xc2, 0xad, 0x7a, 0x64,
-		0x88, 0xb1, 0x26, 0xcd, 0xfd, 0x8b, 0xcd, 0xb1, 0xfc, 0xd6, 0x3e, 0x36, 0xca, 0x63, 0x64, 0xa3,
-		0x19, 0x18, 0xe9, 0xf7, 0x44, 0xe1, 0xd8, 0x50, 0xe2, 0x43, 0x07, 0x7a, 0x3f, 0x80, 0x61, 0x76,
-		0x7a, 0x0e, 0x73, 0x9d, 0x98, 0x26, 0x4e, 0xd3, 0x1a, 0xaa, 0xbc, 0x88, 0x96, 0xed, 0x39, 0x6e,
-		0x7b, 0x8c, 0xb6, 0x03, 0xab, 0xa2, 0x00, 0xcf, 0x7a, 0x03, 0x8d, 0xd3, 0x81, 0xce, 0x8d, 0x98,
-		0xe9, 0x80, 0x60, 0x3e, 0x01, 0x92, 0xde, 0x32, 0xb0, 0xe9, 0xa8, 0xb6, 0xd3, 0xc5, 0x5a, 0xdb,
-		0x30, 0x77, 0xa9, 0xa9, 0x49, 0x2d, 0x4d, 0xee, 0x68, 0x2d, 0x1b, 0x2b, 0x05, 0xd6, 0x5c, 0x17,
-		0xad, 0x04, 0x83, 0x0a, 0x50, 0xd7, 0x87, 0x91, 0x08, 0x60, 0xb0, 0x66, 0x17, 0x43, 0xfe, 0x81,
-		0x34, 0x64, 0x7c, 0xae, 0x39, 0x7a, 0x00, 0xb2, 0xaf, 0x6a, 0xb7, 0x34, 0x55, 0x84, 0x5b, 0x8c,
-		0x13, 0x19, 0x52, 0xb7, 0xc9, 0x43, 0xae, 0x27, 0x60, 0x86, 0x82, 0x58, 0x3d, 0x07, 0x77, 0x55,
-		0xbd, 0xa5, 0xd9, 0x36, 0x65, 0x5a, 0x8a, 0x82, 0x22, 0xd2, 0xb6, 0x41, 0x9a, 0x2a, 0xa2, 0x05,
-		0x5d, 0x84, 0x69, 0x8a, 0xd1, 0xee, 0xb5, 0x1c, 0xa3, 0xd3, 0xc2, 0x2a, 0x09, 0x00, 0x6d, 0x6a,
-		0x72, 0xdc, 0x91, 0x4d, 0x11, 0x88, 0x35, 0x0e, 0x40, 0x46, 0x64, 0xa3, 0x65, 0xb8, 0x9f, 0xa2,
-		0xed, 0x62, 0x13, 0x77, 0x35, 0x07, 0xab, 0xf8, 0x43, 0x3d, 0xad, 0x65, 0xab, 0x9a, 0xd9, 0x54,
-		0xf7, 0x34, 0x7b, 0x6f, 0x76, 0x86, 0x10, 0x28, 0x47, 0x67, 0x23, 0xca, 0x49, 0x02, 0xb8, 0xc2,
-		0xe1, 0xaa, 0x14, 0xac, 0x64, 0x36, 0xaf, 0x6a, 0xf6, 0x1e, 0x5a, 0x82, 0xe3, 0x94, 0x8a, 0xed,
-		0x74, 0x0d, 0x73, 0x57, 0xd5, 0xf7, 0xb0, 0x7e, 0x53, 0xed, 0x39, 0x3b, 0xcf, 0xce, 0x9e, 0xf2,
-		0xf7, 0x4f, 0x47, 0x58, 0xa7, 0x30, 0x15, 0x02, 0xb2, 0xe5, 0xec, 0x3c, 0x8b, 0xea, 0x90, 0x25,
-		0x8b, 0xd1, 0x36, 0x5e, 0xc3, 0xea, 0x8e, 0xd5, 0xa5, 0x36, 0x34, 0x3f, 0x44, 0x35, 0xf9, 0x38,
-		0xb8, 0xb8, 0xc1, 0x11, 0xd6, 0xac, 0x26, 0x5e, 0x9a, 0xac, 0x6f, 0x56, 0xab, 0xcb, 0x4a, 0x46,
-		0x50, 0xb9, 0x62, 0x75, 0x89, 0x40, 0xed, 0x5a, 0x2e, 0x83, 0x33, 0x4c, 0xa0, 0x76, 0x2d, 0xc1,
-		0xde, 0x8b, 0x30, 0xad, 0xeb, 0x6c, 0xce, 0x86, 0xae, 0xf2, 0x30, 0xcd, 0x9e, 0x95, 0x02, 0xcc,
-		0xd2, 0xf5, 0x15, 0x06, 0xc0, 0x65, 0xdc, 0x46, 0xcf, 0xc1, 0x31, 0x8f, 0x59, 0x7e, 0xc4, 0xa9,
-		0x81, 0x59, 0xf6, 0xa3, 0x5e, 0x84, 0xe9, 0xce, 0xc1, 0x20, 0x22, 0x0a, 0xf4, 0xd8, 0x39, 0xe8,
-		0x47, 0x7b, 0x06, 0x66, 0x3a, 0x7b, 0x9d, 0x41, 0xbc, 0x73, 0x7e, 0x3c, 0xd4, 0xd9, 0xeb, 0xf4,
-		0x23, 0x3e, 0x4c, 0x63, 0xf6, 0x2e, 0xd6, 0x35, 0x07, 0x37, 0x67, 0x4f, 0xf8, 0xc1, 0x7d, 0x0d,
-		0x68, 0x11, 0x24, 0x5d, 0x57, 0xb1, 0xa9, 0x6d, 0xb7, 0xb0, 0xaa, 0x75, 0xb1, 0xa9, 0xd9, 0xb3,
-		0xf3, 0x14, 0x38, 0xee, 0x74, 0x7b, 0x58, 0xc9, 0xeb, 0x7a, 0x95, 0x36, 0x96, 0x68, 0x1b, 0x3a,
-		0x07, 0x53, 0xd6, 0xf6, 0xab, 0x3a, 0x93, 0x48, 0xb5, 0xd3, 0xc5, 0x3b, 0xc6, 0xfe, 0xec, 0x43,
-		0x94, 0xbd, 0x05, 0xd2, 0x40, 0xe5, 0x71, 0x93, 0x56, 0xa3, 0xb3, 0x20, 0xe9, 0xf6, 0x9e, 0xd6,
-		0xed, 0x50, 0x95, 0x6c, 0x77, 0x34, 0x1d, 0xcf, 0x3e, 0xcc, 0x40, 0x59, 0xfd, 0xba, 0xa8, 0x26,
-		0x3b, 0xc2, 0xbe, 0x6d, 0xec, 0x38, 0x82, 0xe2, 0x69, 0xb6, 0x23, 0x68, 0x1d, 0xa7, 0x76, 0x06,
-		0x24, 0xc2, 0x89, 0x40, 0xc7, 0x67, 0x28, 0x58, 0xbe, 0xb3, 0xd7, 0xf1, 0xf7, 0xfb, 0x20, 0xe4,
-		0x08, 0xa4, 0xd7, 0xe9, 0x59, 0xe6, 0xb8, 0x75, 0xf6, 0x7c, 0x3d, 0x3e, 0x0d, 0xc7, 0x09, 0x50,
-		0x1b, 0x3b, 0x5a, 0x53, 0x73, 0x34, 0x1f, 0xf4, 0x63, 0x14, 0x9a, 0xb0, 0x7d, 0x8d, 0x37, 0x06,
-		0xc6, 0xd9, 0xed, 0x6d, 0x1f, 0xb8, 0x82, 0xf5, 0x38, 0x1b, 0x27, 0xa9, 0x13, 0xa2, 0xf5, 0xae,
-		0x39, 0xe7, 0xf2, 0x12, 0x64, 0xfd, 0x72, 0x8f, 0xd2, 0xc0, 0x24, 0x5f, 0x8a, 0x10, 0x27, 0xa8,
-		0xb2, 0xb1, 0x4c, 0xdc, 0x97, 0x57, 0xaa, 0x52, 0x94, 0xb8, 0x51, 0xab, 0xb5, 0x46, 0x55, 0x55,
-		0xb6, 0xd6, 0x1b, 0xb5, 0xb5, 0xaa, 0x14, 0xf3, 0x39, 0xf6, 0xd7, 0xe2, 0xa9, 0x47, 0xa4, 0xd3,
-		0xf2, 0xa7, 0x63, 0x90, 0x0f, 0x46, 0x6a, 0xe8, 0x7d, 0x70, 0x42, 0x24, 0x5c, 0x6c, 0xec, 0xa8,
-		0xb7, 0x8d, 0x2e, 0xdd, 0x90, 0x6d, 0x8d, 0x19, 0x47, 0x57, 0x7e, 0x66, 0x38, 0x54, 0x1d, 0x3b,
-		0x2f, 0x1a, 0x5d, 0xb2, 0xdd, 0xda, 0x9a, 0x83, 0x56, 0x61, 0xde, 0xb4, 0x54, 0xdb, 0xd1, 0xcc,
-		0xa6, 0xd6, 0x6d, 0xaa, 0x5e, 0xaa, 0x4b, 0xd5, 0x74, 0x1d, 0xdb, 0xb6, 0xc5, 0x0c, 0xa1, 0x4b,
-		0xe5, 0x3e, 0xd3, 0xaa, 0x73, 0x60, 0xcf, 0x42, 0x94, 0x38, 0x68, 0x9f, 0xf8, 0xc6, 0x46, 0x89,
-		0xef, 0x29, 0x48, 0xb7, 0xb5, 0x8e, 0x8a, 0x4d, 0xa7, 0x7b, 0x40, 0xfd, 0xf3, 0x94, 0x92, 0x6a,
-		0x6b, 0x9d, 0x2a, 0x29, 0xa3, 0x1b, 0xf0, 0x88, 0x07, 0xaa, 0xb6, 0xf0, 0xae, 0xa6, 0x1f, 0xa8,
-		0xd4, 0x19, 0xa7, 0x69, 0x03, 0x55, 0xb7, 0xcc, 0x9d, 0x96, 0xa1, 0x3b, 0x36, 0xd5, 0x0f, 0x4c,
-		0xc7, 0xc9, 0x1e, 0xc6, 0x2a, 0x45, 0xb8, 0x66, 0x5b, 0x26, 0xf5, 0xc1, 0x2b, 0x02, 0xfa, 0x3d,
-		0x09, 0xbf, 0xae, 0xc5, 0x53, 0x71, 0x69, 0xf2, 0x5a, 0x3c, 0x35, 0x29, 0x25, 0xae, 0xc5, 0x53,
-		0x09, 0x29, 0x79, 0x2d, 0x9e, 0x4a, 0x49, 0xe9, 0x6b, 0xf1, 0x54, 0x5a, 0x02, 0xf9, 0x53, 0x29,
-		0xc8, 0xfa, 0x23, 0x03, 0x12, 0x68, 0xe9, 0xd4, 0x36, 0x46, 0xa8, 0xf6, 0x7c, 0xf0, 0xd0, 0x38,
-		0x62, 0xb1, 0x42, 0x8c, 0xe6, 0x52, 0x82, 0xb9, 0xe1, 0x0a, 0xc3, 0x24, 0x0e, 0x0b, 0x11, 0x6b,
-		0xcc, 0xdc, 0x9e, 0x94, 0xc2, 0x4b, 0x68, 0x05, 0x12, 0xaf, 0xda, 0x94, 0x76, 0x82, 0xd2, 0x7e,
-		0xe8, 0x70, 0xda, 0xd7, 0xea, 0x94, 0x78, 0xfa, 0x5a, 0x5d, 0x5d, 0xdf, 0x50, 0xd6, 0x4a, 0xab,
-		0x0a, 0x47, 0x47, 0x27, 0x21, 0xde, 0xd2, 0x5e, 0x3b, 0x08, 0x9a, 0x57, 0x5a, 0x85, 0x16, 0xa1,
-		0xd0, 0x33, 0x6f, 0xe1, 0xae, 0xb1, 0x63, 0x90, 0xa5, 0x22, 0x50, 0x05, 0x3f, 0x54, 0xde, 0x6b,
-		0x5d, 0x25, 0xf0, 0x63, 0x8a, 0xc7, 0x49, 0x88, 0xdf, 0xc6, 0xda, 0xcd, 0xa0, 0x11, 0xa4, 0x55,
-		0xe8, 0x0c, 0x64, 0x9b, 0x78, 0xbb, 0xb7, 0xab, 0x76, 0x71, 0x53, 0xd3, 0x9d, 0xa0, 0xea, 0xcf,
-		0xd0, 0x26, 0x85, 0xb6, 0xa0, 0xeb, 0x90, 0x26, 0x6b, 0x64, 0xd2, 0x35, 0x9e, 0xa2, 0x2c, 0x78,
-		0xfc, 0x70, 0x16, 0xf0, 0x25, 0x16, 0x48, 0x8a, 0x87, 0x8f, 0xae, 0x40, 0xc2, 0xd1, 0xba, 0xbb,
-		0xd8, 0xa1, 0x9a, 0x3f, 0x3f, 0x24, 0xf9, 0x31, 0x84, 0x52, 0x83, 0x62, 0xd0, 0x98, 0x96, 0x63,
-		0xbf, 0x8b, 0x5a, 0xe6, 0x3c, 0x4c, 0x52, 0xf1, 0x40, 0x00, 0x5c, 0x40, 0xa4, 0x09, 0x94, 0x82,
-		0x78, 0x65, 0x43, 0x21, 0x9a, 0x46, 0x82, 0x2c, 0xab, 0x55, 0x37, 0x6b, 0xd5, 0x4a, 0x55, 0x8a,
-		0xca, 0x17, 0x21, 0xc1, 0xd6, 0x9c, 0x68, 0x21, 0x77, 0xd5, 0xa5, 0x09, 0x5e, 0xe4, 0x34, 0x22,
-		0xa2, 0x75, 0x6b, 0xad, 0x5c, 0x55, 0xa4, 0xa8, 0xbc, 0x05, 0x85, 0x3e, 0x3e, 0xa1, 0x63, 0x30,
-		0xa5, 0x54, 0x1b, 0xd5, 0x75, 0x12, 0x67, 0xa9, 0x5b, 0xeb, 0xd7, 0xd7, 0x37, 0x5e, 0x5c, 0x97,
-		0x26, 0x82, 0xd5, 0x42, 0xa5, 0x45, 0xd0, 0x0c, 0x48, 0x5e, 0x75, 0x7d, 0x63, 0x4b, 0xa1, 0xa3,
-		0xf9, 0xde, 0x28, 0x48, 0xfd, 0x5c, 0x43, 0x27, 0x60, 0xba, 0x51, 0x52, 0x56, 0xaa, 0x0d, 0x95,
-		0xc5, 0x8e, 0x2e, 0xe9, 0x19, 0x90, 0xfc, 0x0d, 0x57, 0x6a, 0x34, 0x34, 0x9e, 0x87, 0x53, 0xfe,
-		0xda, 0xea, 0x4b, 0x8d, 0xea, 0x7a, 0x9d, 0x76, 0x5e, 0x5a, 0x5f, 0x21, 0xfa, 0xb5, 0x8f, 0x9e,
-		0x88, 0x56, 0x63, 0x64, 0xa8, 0x41, 0x7a, 0xd5, 0xd5, 0x65, 0x29, 0xde, 0x5f, 0xbd, 0xb1, 0x5e,
-		0xdd, 0xb8, 0x22, 0x4d, 0xf6, 0xf7, 0x4e, 0x23, 0xd8, 0x04, 0x2a, 0xc2, 0xf1, 0xfe, 0x5a, 0xb5,
-		0xba, 0xde, 0x50, 0x5e, 0x96, 0x92, 0xfd, 0x1d, 0xd7, 0xab, 0xca, 0x8d, 0x5a, 0xa5, 0x2a, 0xa5,
-		0xd0, 0x71, 0x40, 0xc1, 0x11, 0x35, 0xae, 0x6e, 0x2c, 0x4b, 0xe9, 0x01, 0x8d, 0x22, 0xdb, 0x90,
-		0xf5, 0x87, 0x91, 0xef, 0x4d, 0x2e, 0xe9, 0x63, 0x51, 0xc8, 0xf8, 0xc2, 0x42, 0xe2, 0xcf, 0x6b,
-		0xad, 0x96, 0x75, 0x5b, 0xd5, 0x5a, 0x86, 0x66, 0x73, 0x7d, 0x03, 0xb4, 0xaa, 0x44, 0x6a, 0xc6,
-		0xdd, 0xdf, 0xe3, 0x6b, 0xf8, 0xc4, 0xd7, 0xa3, 0x86, 0x9f, 0x94, 0x12, 0xf2, 0x4f, 0x44, 0x40,
-		0xea, 0x8f, 0xf7, 0xfa, 0xa6, 0x1f, 0x19, 0x35, 0xfd, 0xf7, 0x64, 0xed, 0x7e, 0x3c, 0x02, 0xf9,
-		0x60, 0x90, 0xd7, 0x37, 0xbc, 0x07, 0xfe, 0x9f, 0x0e, 0xef, 0x0f, 0xa2, 0x90, 0x0b, 0x84, 0x76,
-		0xe3, 0x8e, 0xee, 0x43, 0x30, 0x65, 0x34, 0x71, 0xbb, 0x63, 0x39, 0xd8, 0xd4, 0x0f, 0xd4, 0x16,
-		0xbe, 0x85, 0x5b, 0xb3, 0x32, 0x55, 0xca, 0xe7, 0x0f, 0x0f, 0x1e, 0x17, 0x6b, 0x1e, 0xde, 0x2a,
-		0x41, 0x5b, 0x9a, 0xae, 0x2d, 0x57, 0xd7, 0x36, 0x37, 0x1a, 0xd5, 0xf5, 0xca, 0xcb, 0x42, 0xbb,
-		0x28, 0x92, 0xd1, 0x07, 0xf6, 0x2e, 0x2a, 0xed, 0x4d, 0x90, 0xfa, 0x07, 0x45, 0x74, 0xc5, 0x90,
-		0x61, 0x49, 0x13, 0x68, 0x1a, 0x0a, 0xeb, 0x1b, 0x6a, 0xbd, 0xb6, 0x5c, 0x55, 0xab, 0x57, 0xae,
-		0x54, 0x2b, 0x8d, 0x3a, 0x4b, 0x07, 0xba, 0xd0, 0x0d, 0x29, 0xea, 0x67, 0xf1, 0x8f, 0xc6, 0x60,
-		0x7a, 0xc8, 0x48, 0x50, 0x89, 0x07, 0xf2, 0x2c, 0xb7, 0xf0, 0xf8, 0x38, 0xa3, 0x5f, 0x24, 0xae,
-		0xf4, 0xa6, 0xd6, 0x75, 0x78, 0xdc, 0x7f, 0x16, 0x08, 0x97, 0x4c, 0x87, 0x58, 0xf6, 0x2e, 0x4f,
-		0xb3, 0xb2, 0xe8, 0xbe, 0xe0, 0xd5, 0xb3, 0x4c, 0xeb, 0x63, 0x80, 0x3a, 0x96, 0x6d, 0x38, 0xc6,
-		0x2d, 0xac, 0x1a, 0xa6, 0xc8, 0xc9, 0x92, 0x68, 0x3f, 0xae, 0x48, 0xa2, 0xa5, 0x66, 0x3a, 0x2e,
-		0xb4, 0x89, 0x77, 0xb5, 0x3e, 0x68, 0xe2, 0x79, 0xc4, 0x14, 0x49, 0xb4, 0xb8, 0xd0, 0x0f, 0x40,
-		0xb6, 0x69, 0xf5, 0x48, 0x08, 0xc4, 0xe0, 0x88, 0xb6, 0x88, 0x28, 0x19, 0x56, 0xe7, 0x82, 0xf0,
-		0xe0, 0xd6, 0x4b, 0x06, 0x67, 0x95, 0x0c, 0xab, 0x63, 0x20, 0xa7, 0xa1, 0xa0, 0xed, 0xee, 0x76,
-		0x09, 0x71, 0x41, 0x88, 0x85, 0xeb, 0x79, 0xb7, 0x9a, 0x02, 0x16, 0xaf, 0x41, 0x4a, 0xf0, 0x81,
-		0x78, 0xb0, 0x84, 0x13, 0x6a, 0x87, 0xe5, 0xa0, 0xa2, 0x67, 0xd2, 0x4a, 0xca, 0x14, 0x8d, 0x0f,
-		0x40, 0xd6, 0xb0, 0x55, 0xef, 0x6c, 0x2b, 0xba, 0x10, 0x3d, 0x93, 0x52, 0x32, 0x86, 0xed, 0x9e,
-		0x0b, 0xc8, 0x9f, 0x88, 0x42, 0x3e, 0x78, 0x6a, 0x87, 0x96, 0x21, 0xd5, 0xb2, 0x74, 0x8d, 0x8a,
-		0x16, 0x3b, 0x32, 0x3e, 0x13, 0x72, 0xd0, 0xb7, 0xb8, 0xca, 0xe1, 0x15, 0x17, 0xb3, 0xf8, 0xaf,
-		0x23, 0x90, 0x12, 0xd5, 0xe8, 0x38, 0xc4, 0x3b, 0x9a, 0xb3, 0x47, 0xc9, 0x4d, 0x96, 0xa3, 0x52,
-		0x44, 0xa1, 0x65, 0x52, 0x6f, 0x77, 0x34, 0x93, 0x8a, 0x00, 0xaf, 0x27, 0x65, 0xb2, 0xae, 0x2d,
-		0xac, 0x35, 0x69, 0x2e, 0xc0, 0x6a, 0xb7, 0xb1, 0xe9, 0xd8, 0x62, 0x5d, 0x79, 0x7d, 0x85, 0x57,
-		0xa3, 0x47, 0x61, 0xca, 0xe9, 0x6a, 0x46, 0x2b, 0x00, 0x1b, 0xa7, 0xb0, 0x92, 0x68, 0x70, 0x81,
-		0x97, 0xe0, 0xa4, 0xa0, 0xdb, 0xc4, 0x8e, 0xa6, 0xef, 0xe1, 0xa6, 0x87, 0x94, 0xa0, 0x39, 0xbf,
-		0x13, 0x1c, 0x60, 0x99, 0xb7, 0x0b, 0x5c, 0xf9, 0xb3, 0x51, 0x98, 0x12, 0xd9, 0x8b, 0xa6, 0xcb,
-		0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0xc7, 0xcf, 0xae, 0x41, 0x51, 0x1e, 0xc0, 0x5b, 0x2c, 0xb9,
-		0x48, 0x8a, 0x8f, 0x40, 0xf1, 0x4b, 0x11, 0x00, 0xaf, 0x69, 0x24, 0xdf, 0xe6, 0x21, 0xc3, 0xcf,
-		0x64, 0xe9, 0xc1, 0x3e, 0x4b, 0x78, 0x01, 0xab, 0xba, 0x62, 0xb4, 0x68, 0x5a, 0x72, 0x1b, 0xef,
-		0x1a, 0x26, 0x3f, 0x4f, 0x61, 0x05, 0x91, 0x96, 0x8c, 0x7b, 0xc7, 0x53, 0x0a, 0xa4, 0x6c, 0xdc,
-		0xd6, 0x4c, 0xc7, 0xd0, 0xf9, 0x09, 0xc9, 0xa5, 0x23, 0x0d, 0x7e, 0xb1, 0xce, 0xb1, 0x15, 0x97,
-		0x8e, 0x7c, 0x06, 0x52, 0xa2, 0x96, 0x38, 0x7e, 0xeb, 0x1b, 0xeb, 0x55, 0x69, 0x02, 0x25, 0x21,
-		0x56, 0xaf, 0x36, 0xa4, 0x08, 0x09, 0x3b, 0x4b, 0xab, 0xb5, 0x52, 0x5d, 0x8a, 0x96, 0xff, 0x2a,
-		0x4c, 0xeb, 0x56, 0xbb, 0xbf, 0xc3, 0xb2, 0xd4, 0x97, 0xf2, 0xb3, 0xaf, 0x46, 0x5e, 0x79, 0x9c,
-		0x03, 0xed, 0x5a, 0x2d, 0xcd, 0xdc, 0x5d, 0xb4, 0xba, 0xbb, 0xde, 0xb5, 0x08, 0x12, 0x1d, 0xd8,
-		0xbe, 0xcb, 0x11, 0x9d, 0xed, 0xff, 0x11, 0x89, 0xfc, 0x64, 0x34, 0xb6, 0xb2, 0x59, 0xfe, 0xb9,
-		0x68, 0x71, 0x85, 0x21, 0x6e, 0x8a, 0xe9, 0x28, 0x78, 0xa7, 0x85, 0x75, 0x32, 0x78, 0xf8, 0x93,
-		0x47, 0x61, 0x66, 0xd7, 0xda, 0xb5, 0x28, 0xa5, 0xf3, 0xe4, 0x17, 0xbf, 0x57, 0x91, 0x76, 0x6b,
-		0x8b, 0xa1, 0x97, 0x30, 0x96, 0xd6, 0x61, 0x9a, 0x03, 0xab, 0xf4, 0xf8, 0x96, 0x25, 0x17, 0xd0,
-		0xa1, 0x99, 0xed, 0xd9, 0x5f, 0xf8, 0x23, 0xea, 0x95, 0x28, 0x53, 0x1c, 0x95, 0xb4, 0xb1, 0xfc,
-		0xc3, 0x92, 0x02, 0xc7, 0x02, 0xf4, 0x98, 0x8e, 0xc0, 0xdd, 0x10, 0x8a, 0xff, 0x8c, 0x53, 0x9c,
-		0xf6, 0x51, 0xac, 0x73, 0xd4, 0xa5, 0x0a, 0xe4, 0x8e, 0x42, 0xeb, 0x9f, 0x73, 0x5a, 0x59, 0xec,
-		0x27, 0xb2, 0x02, 0x05, 0x4a, 0x44, 0xef, 0xd9, 0x8e, 0xd5, 0xa6, 0x0a, 0xf8, 0x70, 0x32, 0xff,
-		0xe2, 0x8f, 0xd8, 0xa6, 0xcd, 0x13, 0xb4, 0x8a, 0x8b, 0xb5, 0xb4, 0x04, 0xf4, 0xc4, 0xba, 0x89,
-		0xf5, 0x56, 0x08, 0x85, 0xcf, 0xf0, 0x81, 0xb8, 0xf0, 0x4b, 0x37, 0x60, 0x86, 0xfc, 0xa6, 0xfa,
-		0xd1, 0x3f, 0x92, 0xf0, 0x34, 0xf8, 0xec, 0xef, 0x7e, 0x98, 0xe9, 0x85, 0x69, 0x97, 0x80, 0x6f,
-		0x4c, 0xbe, 0x55, 0xdc, 0xc5, 0x8e, 0x83, 0xbb, 0xb6, 0xaa, 0xb5, 0x86, 0x0d, 0xcf, 0x97, 0x47,
-		0x9c, 0xfd, 0x91, 0x2f, 0x07, 0x57, 0x71, 0x85, 0x61, 0x96, 0x5a, 0xad, 0xa5, 0x2d, 0x38, 0x31,
-		0x44, 0x2a, 0xc6, 0xa0, 0xf9, 0xa3, 0x9c, 0xe6, 0xcc, 0x80, 0x64, 0x10, 0xb2, 0x9b, 0x20, 0xea,
-		0xdd, 0xb5, 0x1c, 0x83, 0xe6, 0x8f, 0x71, 0x9a, 0x88, 0xe3, 0x8a, 0x25, 0x25, 0x14, 0xaf, 0xc1,
-		0xd4, 0x2d, 0xdc, 0xdd, 0xb6, 0x6c, 0x9e, 0xbb, 0x1d, 0x83, 0xdc, 0x8f, 0x73, 0x72, 0x05, 0x8e,
-		0x48, 0x93, 0xb9, 0x84, 0xd6, 0x73, 0x90, 0xda, 0xd1, 0x74, 0x3c, 0x06, 0x89, 0xbb, 0x9c, 0x44,
-		0x92, 0xc0, 0x13, 0xd4, 0x12, 0x64, 0x77, 0x2d, 0x6e, 0x22, 0xc3, 0xd1, 0x7f, 0x82, 0xa3, 0x67,
-		0x04, 0x0e, 0x27, 0xd1, 0xb1, 0x3a, 0xbd, 0x16, 0xb1, 0x9f, 0xe1, 0x24, 0xfe, 0xb6, 0x20, 0x21,
-		0x70, 0x38, 0x89, 0x23, 0xb0, 0xf5, 0x0d, 0x41, 0xc2, 0xf6, 0xf1, 0xf3, 0x05, 0xc8, 0x58, 0x66,
-		0xeb, 0xc0, 0x32, 0xc7, 0x19, 0xc4, 0xc7, 0x39, 0x05, 0xe0, 0x28, 0x84, 0xc0, 0x65, 0x48, 0x8f,
-		0xbb, 0x10, 0x3f, 0xfd, 0x65, 0xb1, 0x3d, 0xc4, 0x0a, 0xac, 0x40, 0x41, 0x28, 0x28, 0xc3, 0x32,
-		0xc7, 0x20, 0xf1, 0x33, 0x9c, 0x44, 0xde, 0x87, 0xc6, 0xa7, 0xe1, 0x60, 0xdb, 0xd9, 0xc5, 0xe3,
-		0x10, 0xf9, 0x84, 0x98, 0x06, 0x47, 0xe1, 0xac, 0xdc, 0xc6, 0xa6, 0xbe, 0x37, 0x1e, 0x85, 0x4f,
-		0x0a, 0x56, 0x0a, 0x1c, 0x42, 0xa2, 0x02, 0xb9, 0xb6, 0xd6, 0xb5, 0xf7, 0xb4, 0xd6, 0x58, 0xcb,
-		0xf1, 0xb3, 0x9c, 0x46, 0xd6, 0x45, 0xe2, 0x1c, 0xe9, 0x99, 0x47, 0x21, 0xf3, 0x73, 0x82, 0x23,
-		0x3e, 0x34, 0xbe, 0xf5, 0x6c, 0x87, 0x26, 0xba, 0x8f, 0x42, 0xed, 0xef, 0x8a, 0xad, 0xc7, 0x70,
-		0xd7, 0xfc, 0x14, 0x2f, 0x43, 0xda, 0x36, 0x5e, 0x1b, 0x8b, 0xcc, 0xdf, 0x13, 0x2b, 0x4d, 0x11,
-		0x08, 0xf2, 0xcb, 0x70, 0x72, 0xa8, 0x99, 0x18, 0x83, 0xd8, 0xdf, 0xe7, 0xc4, 0x8e, 0x0f, 0x31,
-		0x15, 0x5c, 0x25, 0x1c, 0x95, 0xe4, 0x3f, 0x10, 0x2a, 0x01, 0xf7, 0xd1, 0xda, 0x24, 0x41, 0x8b,
-		0xad, 0xed, 0x1c, 0x8d, 0x6b, 0xff, 0x50, 0x70, 0x8d, 0xe1, 0x06, 0xb8, 0xd6, 0x80, 0xe3, 0x9c,
-		0xe2, 0xd1, 0xd6, 0xf5, 0xe7, 0x85, 0x62, 0x65, 0xd8, 0x5b, 0xc1, 0xd5, 0xfd, 0x66, 0x28, 0xba,
-		0xec, 0x14, 0xde, 0xb1, 0xad, 0xb6, 0xb5, 0xce, 0x18, 0x94, 0x7f, 0x81, 0x53, 0x16, 0x1a, 0xdf,
-		0x75, 0xaf, 0xed, 0x35, 0xad, 0x43, 0x88, 0xbf, 0x04, 0xb3, 0x82, 0x78, 0xcf, 0xec, 0x62, 0xdd,
-		0xda, 0x35, 0x8d, 0xd7, 0x70, 0x73, 0x0c, 0xd2, 0xbf, 0xd8, 0xb7, 0x54, 0x5b, 0x3e, 0x74, 0x42,
-		0xb9, 0x06, 0x92, 0xeb, 0xab, 0xa8, 0x46, 0xbb, 0x63, 0x75, 0x9d, 0x10, 0x8a, 0xbf, 0x24, 0x56,
-		0xca, 0xc5, 0xab, 0x51, 0xb4, 0xa5, 0x2a, 0xb0, 0xdb, 0x1f, 0xe3, 0x8a, 0xe4, 0x2f, 0x73, 0x42,
-		0x39, 0x0f, 0x8b, 0x2b, 0x0e, 0xdd, 0x6a, 0x77, 0xb4, 0xee, 0x38, 0xfa, 0xef, 0x1f, 0x09, 0xc5,
-		0xc1, 0x51, 0xb8, 0xe2, 0x20, 0x1e, 0x1d, 0xb1, 0xf6, 0x63, 0x50, 0xf8, 0x15, 0xa1, 0x38, 0x04,
-		0x0e, 0x27, 0x21, 0x1c, 0x86, 0x31, 0x48, 0x7c, 0x4a, 0x90, 0x10, 0x38, 0x84, 0xc4, 0x07, 0x3c,
-		0x43, 0xdb, 0xc5, 0xbb, 0x86, 0xed, 0x74, 0x99, 0x4b, 0x7e, 0x38, 0xa9, 0x5f, 0xfd, 0x72, 0xd0,
-		0x09, 0x53, 0x7c, 0xa8, 0x44, 0x13, 0xf1, 0xa3, 0x0f, 0x1a, 0xb2, 0x85, 0x0f, 0xec, 0xd7, 0x84,
-		0x26, 0xf2, 0xa1, 0x91, 0xb1, 0xf9, 0x3c, 0x44, 0xc2, 0x76, 0x9d, 0x04, 0x2a, 0x63, 0x90, 0xfb,
-		0xc7, 0x7d, 0x83, 0xab, 0x0b, 0x5c, 0x42, 0xd3, 0xe7, 0xff, 0xf4, 0xcc, 0x9b, 0xf8, 0x60, 0x2c,
-		0xe9, 0xfc, 0x74, 0x9f, 0xff, 0xb3, 0xc5, 0x30, 0x99, 0x0e, 0x29, 0xf4, 0xf9, 0x53, 0x28, 0xec,
-		0xae, 0xdf, 0xec, 0xb7, 0xbf, 0xcd, 0xe7, 0x1b, 0x74, 0xa7, 0x96, 0x56, 0x89, 0x90, 0x07, 0x9d,
-		0x9e, 0x70, 0x62, 0x1f, 0x7e, 0xdb, 0x95, 0xf3, 0x80, 0xcf, 0xb3, 0x74, 0x05, 0x72, 0x01, 0x87,
-		0x27, 0x9c, 0xd4, 0x77, 0x72, 0x52, 0x59, 0xbf, 0xbf, 0xb3, 0x74, 0x11, 0xe2, 0xc4, 0x79, 0x09,
-		0x47, 0xff, 0x2e, 0x8e, 0x4e, 0xc1, 0x97, 0x9e, 0x87, 0x94, 0x70, 0x5a, 0xc2, 0x51, 0xbf, 0x9b,
-		0xa3, 0xba, 0x28, 0x04, 0x5d, 0x38, 0x2c, 0xe1, 0xe8, 0x7f, 0x4d, 0xa0, 0x0b, 0x14, 0x82, 0x3e,
-		0x3e, 0x0b, 0x7f, 0xf3, 0xaf, 0xc7, 0xb9, 0xd1, 0x11, 0xbc, 0xbb, 0x0c, 0x49, 0xee, 0xa9, 0x84,
-		0x63, 0x7f, 0x0f, 0xef, 0x5c, 0x60, 0x2c, 0x3d, 0x03, 0x93, 0x63, 0x32, 0xfc, 0x6f, 0x70, 0x54,
-		0x06, 0xbf, 0x54, 0x81, 0x8c, 0xcf, 0x3b, 0x09, 0x47, 0xff, 0x3e, 0x8e, 0xee, 0xc7, 0x22, 0x43,
-		0xe7, 0xde, 0x49, 0x38, 0x81, 0xef, 0x17, 0x43, 0xe7, 0x18, 0x84, 0x6d, 0xc2, 0x31, 0x09, 0xc7,
-		0xfe, 0xa8, 0xe0, 0xba, 0x40, 0x59, 0x7a, 0x01, 0xd2, 0xae, 0xb1, 0x09, 0xc7, 0xff, 0x01, 0x8e,
-		0xef, 0xe1, 0x10, 0x0e, 0xf8, 0x8c, 0x5d, 0x38, 0x89, 0xbf, 0x29, 0x38, 0xe0, 0xc3, 0x22, 0xdb,
-		0xa8, 0xdf, 0x81, 0x09, 0xa7, 0xf4, 0x83, 0x62, 0x1b, 0xf5, 0xf9, 0x2f, 0x64, 0x35, 0xa9, 0xce,
-		0x0f, 0x27, 0xf1, 0xb7, 0xc4, 0x6a, 0x52, 0x78, 0x32, 0x8c, 0x7e, 0x8f, 0x20, 0x9c, 0xc6, 0x0f,
-		0x8b, 0x61, 0xf4, 0x39, 0x04, 0x4b, 0x9b, 0x80, 0x06, 0xbd, 0x81, 0x70, 0x7a, 0x1f, 0xe3, 0xf4,
-		0xa6, 0x06, 0x9c, 0x81, 0xa5, 0x17, 0xe1, 0xf8, 0x70, 0x4f, 0x20, 0x9c, 0xea, 0x8f, 0xbc, 0xdd,
-		0x17, 0xbb, 0xf9, 0x1d, 0x81, 0xa5, 0x86, 0x67, 0x52, 0xfc, 0x5e, 0x40, 0x38, 0xd9, 0x1f, 0x7d,
-		0x3b, 0xa8, 0xb8, 0xfd, 0x4e, 0xc0, 0x52, 0x09, 0xc0, 0x33, 0xc0, 0xe1, 0xb4, 0x7e, 0x9c, 0xd3,
-		0xf2, 0x21, 0x91, 0xad, 0xc1, 0xed, 0x6f, 0x38, 0xfe, 0x5d, 0xb1, 0x35, 0x38, 0x06, 0xd9, 0x1a,
-		0xc2, 0xf4, 0x86, 0x63, 0xff, 0x84, 0xd8, 0x1a, 0x02, 0x85, 0x48, 0xb6, 0xcf, 0xba, 0x85, 0x53,
-		0xf8, 0xb8, 0x90, 0x6c, 0x1f, 0xd6, 0xd2, 0x3a, 0x4c, 0x0d, 0x18, 0xc4, 0x70, 0x52, 0x3f, 0xc9,
-		0x49, 0x49, 0xfd, 0xf6, 0xd0, 0x6f, 0xbc, 0xb8, 0x31, 0x0c, 0xa7, 0xf6, 0x53, 0x7d, 0xc6, 0x8b,
-		0xdb, 0xc2, 0xa5, 0xcb, 0x90, 0x32, 0x7b, 0xad, 0x16, 0xd9, 0x3c, 0xe8, 0xf0, 0xfb, 0xb9, 0xb3,
-		0xff, 0xf9, 0xab, 0x9c, 0x3b, 0x02, 0x61, 0xe9, 0x22, 0x4c, 0xe2, 0xf6, 0x36, 0x6e, 0x86, 0x61,
-		0x7e, 0xf1, 0xab, 0x42, 0x61, 0x12, 0xe8, 0xa5, 0x17, 0x00, 0x58, 0x6a, 0x84, 0x1e, 0x9c, 0x87,
-		0xe0, 0x7e, 0xe9, 0xab, 0xfc, 0x42, 0x9c, 0x87, 0xe2, 0x11, 0x60, 0xd7, 0xeb, 0x0e, 0x27, 0xf0,
-		0xe5, 0x20, 0x01, 0xba, 0x22, 0xcf, 0x41, 0xf2, 0x55, 0xdb, 0x32, 0x1d, 0x6d, 0x37, 0x0c, 0xfb,
-		0x4f, 0x38, 0xb6, 0x80, 0x27, 0x0c, 0x6b, 0x5b, 0x5d, 0xec, 0x68, 0xbb, 0x76, 0x18, 0xee, 0x7f,
-		0xe1, 0xb8, 0x2e, 0x02, 0x41, 0xd6, 0x35, 0xdb, 0x19, 0x67, 0xde, 0x7f, 0x2a, 0x90, 0x05, 0x02,
-		0x19, 0x34, 0xf9, 0x7d, 0x13, 0x1f, 0x84, 0xe1, 0xfe, 0x99, 0x18, 0x34, 0x87, 0x5f, 0x7a, 0x1e,
-		0xd2, 0xe4, 0x27, 0xbb, 0xe5, 0x1a, 0x82, 0xfc, 0xe7, 0x1c, 0xd9, 0xc3, 0x20, 0x3d, 0xdb, 0x4e,
-		0xd3, 0x31, 0xc2, 0x99, 0xfd, 0x15, 0xbe, 0xd2, 0x02, 0x7e, 0xa9, 0x04, 0x19, 0xdb, 0x69, 0x36,
-		0x7b, 0xdc, 0x3f, 0x0d, 0x41, 0xff, 0xaf, 0x5f, 0x75, 0x53, 0x16, 0x2e, 0x0e, 0x59, 0xed, 0xdb,
-		0x37, 0x9d, 0x8e, 0x45, 0xcf, 0x5b, 0xc2, 0x28, 0xbc, 0xcd, 0x29, 0xf8, 0x50, 0x96, 0x2a, 0x90,
-		0x25, 0x73, 0xe9, 0xe2, 0x0e, 0xa6, 0x87, 0x63, 0x21, 0x24, 0xfe, 0x82, 0x33, 0x20, 0x80, 0x54,
-		0xfe, 0xb6, 0xcf, 0xbc, 0x35, 0x17, 0xf9, 0xec, 0x5b, 0x73, 0x91, 0x3f, 0x78, 0x6b, 0x2e, 0xf2,
-		0xd1, 0xcf, 0xcd, 0x4d, 0x7c, 0xf6, 0x73, 0x73, 0x13, 0xbf, 0xff, 0xb9, 0xb9, 0x89, 0xe1, 0x59,
-		0x62, 0x58, 0xb1, 0x56, 0x2c, 0x96, 0x1f, 0x7e, 0xe5, 0xe1, 0x5d, 0xc3, 0xd9, 0xeb, 0x6d, 0x2f,
-		0xea, 0x56, 0xfb, 0xbc, 0x6e, 0xd9, 0x6d, 0xcb, 0x3e, 0x1f, 0xcc, 0xeb, 0xd2, 0x5f, 0xf0, 0xbf,
-		0x22, 0x24, 0x66, 0x0e, 0xa6, 0x73, 0x35, 0xf3, 0x60, 0xd4, 0xc7, 0x74, 0x97, 0x20, 0x56, 0x32,
-		0x0f, 0xd0, 0x49, 0xa6, 0xe0, 0xd4, 0x5e, 0xb7, 0xc5, 0xaf, 0x5a, 0x26, 0x49, 0x79, 0xab, 0xdb,
-		0x42, 0x33, 0xde, 0x7d, 0xe8, 0xc8, 0x99, 0x2c, 0xbf, 0xe4, 0x5c, 0xfe, 0xbe, 0xc8, 0xd1, 0x66,
-		0x92, 0x2a, 0x99, 0x07, 0x74, 0x22, 0x9b, 0x91, 0x57, 0x1e, 0x0b, 0xcd, 0x73, 0xdf, 0x34, 0xad,
-		0xdb, 0x26, 0x19, 0x76, 0x67, 0x5b, 0xe4, 0xb8, 0xe7, 0xfa, 0x73, 0xdc, 0x2f, 0xe2, 0x56, 0xeb,
-		0x3a, 0x81, 0x6b, 0x10, 0x94, 0xed, 0x04, 0xbb, 0xd5, 0x0f, 0x3f, 0x18, 0x85, 0xb9, 0x81, 0x74,
-		0x36, 0x17, 0x82, 0x51, 0x4c, 0x58, 0x82, 0xd4, 0xb2, 0x90, 0xad, 0x59, 0x48, 0xda, 0x58, 0xb7,
-		0xcc, 0xa6, 0x4d, 0x19, 0x11, 0x53, 0x44, 0x91, 0x30, 0xc2, 0xd4, 0x4c, 0xcb, 0xe6, 0x97, 0x95,
-		0x59, 0xa1, 0xfc, 0x63, 0x47, 0x64, 0x44, 0x4e, 0xf4, 0x24, 0xb8, 0xf1, 0xe4, 0x98, 0xdc, 0x10,
-		0x93, 0x08, 0x64, 0xfe, 0xc7, 0xe5, 0xca, 0x0f, 0x47, 0x61, 0xbe, 0x9f, 0x2b, 0x64, 0x67, 0xd9,
-		0x8e, 0xd6, 0xee, 0x8c, 0x62, 0xcb, 0x65, 0x48, 0x37, 0x04, 0xcc, 0x91, 0xf9, 0x72, 0xf7, 0x88,
-		0x7c, 0xc9, 0xbb, 0x5d, 0x09, 0xc6, 0x5c, 0x18, 0x93, 0x31, 0xee, 0x3c, 0xee, 0x89, 0x33, 0x7f,
-		0x99, 0x80, 0x93, 0x6c, 0x3b, 0xa9, 0x6c, 0x2b, 0xb1, 0x02, 0xe7, 0x49, 0xd6, 0xdf, 0x14, 0x7e,
-		0x4e, 0x22, 0x5f, 0x87, 0xe9, 0x1a, 0xd1, 0x16, 0x24, 0x0a, 0xf2, 0x4e, 0x78, 0x86, 0xde, 0xe7,
-		0x5e, 0x08, 0x38, 0xfc, 0xfc, 0x7c, 0xcb, 0x5f, 0x25, 0x7f, 0x7b, 0x04, 0xa4, 0xba, 0xae, 0xb5,
-		0xb4, 0xee, 0xd7, 0x4a, 0x0a, 0x3d, 0x03, 0xc0, 0xae, 0x7b, 0xb8, 0x1f, 0xee, 0xe5, 0x2f, 0xcc,
-		0x2e, 0xfa, 0x27, 0xb7, 0xc8, 0x7a, 0xa2, 0x37, 0xa8, 0xd2, 0x14, 0x96, 0xfc, 0x3c, 0xf7, 0x12,
-		0x80, 0xd7, 0x80, 0x4e, 0xc1, 0x89, 0x7a, 0xa5, 0xb4, 0x5a, 0x52, 0xc4, 0x25, 0xa1, 0xfa, 0x66,
-		0xb5, 0x52, 0xbb, 0x52, 0xab, 0x2e, 0x4b, 0x13, 0xe8, 0x38, 0x20, 0x7f, 0xa3, 0x7b, 0xa9, 0xe9,
-		0x18, 0x4c, 0xf9, 0xeb, 0xd9, 0x57, 0x2a, 0x51, 0xe2, 0x29, 0x1a, 0xed, 0x4e, 0x0b, 0xd3, 0x93,
-		0x47, 0xd5, 0x10, 0x5c, 0x0b, 0x77, 0x42, 0x7e, 0xfb, 0xdf, 0xb2, 0x2f, 0x17, 0xa6, 0x3d, 0x74,
-		0x97, 0xe7, 0x4b, 0xab, 0x30, 0xa5, 0xe9, 0x3a, 0xee, 0x04, 0x48, 0x86, 0xa8, 0x6a, 0x42, 0x90,
-		0x9e, 0xa5, 0x72, 0x4c, 0x8f, 0xda, 0x33, 0x90, 0xb0, 0xe9, 0xec, 0xc3, 0x48, 0xfc, 0x0e, 0x27,
-		0xc1, 0xc1, 0x97, 0x4c, 0x98, 0x22, 0x9e, 0x9f, 0xd6, 0xc5, 0xbe, 0x61, 0x1c, 0x9e, 0x67, 0xf8,
-		0xf5, 0x5f, 0x7a, 0x82, 0x9e, 0xac, 0x3e, 0x10, 0x5c, 0x96, 0x21, 0xe2, 0xa4, 0x48, 0x9c, 0xb6,
-		0x37, 0x50, 0x0c, 0x79, 0xd1, 0x1f, 0x1f, 0xf0, 0xe1, 0x9d, 0xfd, 0x06, 0xef, 0x6c, 0x6e, 0x98,
-		0x0c, 0xf8, 0x7a, 0xca, 0x71, 0xaa, 0xac, 0xa1, 0x5c, 0x1d, 0xb5, 0xa7, 0x5f, 0x79, 0x74, 0xd0,
-		0x3a, 0xb1, 0xff, 0x1e, 0xa7, 0x94, 0x2f, 0xfb, 0xbb, 0x71, 0xf7, 0xde, 0x8f, 0xc5, 0x61, 0x4a,
-		0x6b, 0x1b, 0xa6, 0x75, 0x9e, 0xfe, 0xcb, 0xf7, 0xdc, 0x24, 0x2d, 0x8c, 0x71, 0x28, 0x79, 0x89,
-		0x6d, 0x85, 0x70, 0x89, 0xf9, 0xf3, 0xef, 0xfd, 0x99, 0x49, 0x6f, 0xbb, 0x2c, 0xad, 0x81, 0x24,
-		0x2e, 0xf1, 0x62, 0x53, 0xb7, 0x9a, 0x63, 0x65, 0x29, 0xbe, 0x22, 0x68, 0x88, 0xfc, 0x56, 0x95,
-		0xa3, 0x2e, 0xbd, 0x0f, 0x52, 0x2e, 0x99, 0x30, 0xcf, 0x44, 0x10, 0x71, 0x31, 0x88, 0x5f, 0xc2,
-		0x76, 0xe6, 0x38, 0x5e, 0xe8, 0xdb, 0x02, 0x9f, 0xed, 0xd0, 0x75, 0x32, 0x9b, 0x15, 0xc8, 0x37,
-		0x2d, 0xd3, 0x51, 0xad, 0xb6, 0xe1, 0xe0, 0x76, 0xc7, 0x09, 0xf5, 0xeb, 0xfe, 0x82, 0x11, 0x49,
-		0x29, 0x39, 0x82, 0xb7, 0x21, 0xd0, 0xc8, 0x48, 0xd8, 0x77, 0x7a, 0xe3, 0x8c, 0xe4, 0xbf, 0xb9,
-		0x23, 0xa1, 0x38, 0x64, 0x24, 0xf7, 0x24, 0x1d, 0x76, 0xf3, 0x26, 0x57, 0xe6, 0xce, 0x3e, 0x93,
-		0x02, 0x57, 0x3a, 0xfe, 0x34, 0x06, 0x73, 0x1c, 0x78, 0x5b, 0xb3, 0xf1, 0xf9, 0x5b, 0x4f, 0x6e,
-		0x63, 0x47, 0x7b, 0xf2, 0xbc, 0x6e, 0x19, 0xc2, 0x92, 0x4f, 0x73, 0x65, 0x4d, 0xda, 0x17, 0x79,
-		0x7b, 0x71, 0xe8, 0x71, 0x77, 0x71, 0xb4, 0x92, 0x2f, 0x0e, 0xca, 0xa0, 0xfc, 0x0a, 0xc4, 0x2b,
-		0x96, 0x61, 0x12, 0xdb, 0xd6, 0xc4, 0xa6, 0xd5, 0xe6, 0xea, 0x96, 0x15, 0xd0, 0x25, 0x48, 0x68,
-		0x6d, 0xab, 0x67, 0x3a, 0x4c, 0xd5, 0x96, 0xe7, 0x3e, 0xf3, 0xe6, 0xfc, 0xc4, 0xbf, 0x7f, 0x73,
-		0x3e, 0x56, 0x33, 0x9d, 0xdf, 0xfb, 0xe5, 0xc7, 0x81, 0x53, 0xaf, 0x99, 0xce, 0x27, 0x3f, 0xff,
-		0xf3, 0xe7, 0x22, 0x0a, 0x87, 0x5e, 0x8a, 0x7f, 0xe1, 0x8d, 0xf9, 0x88, 0xfc, 0x12, 0x24, 0x97,
-		0xb1, 0x7e, 0x08, 0xf9, 0x27, 0xfb, 0xc8, 0x9f, 0x14, 0xe4, 0x97, 0xb1, 0xee, 0x23, 0xbf, 0x8c,
-		0xf5, 0x3e, 0xca, 0xcf, 0x40, 0xaa, 0x66, 0x3a, 0xec, 0x13, 0xa2, 0x47, 0x21, 0x66, 0x98, 0xec,
-		0x56, 0xba, 0x8f, 0xc2, 0xc0, 0x00, 0x15, 0x02, 0x45, 0x10, 0x97, 0xb1, 0xee, 0x22, 0x36, 0xb1,
-		0xde, 0x8f, 0x38, 0xd8, 0x35, 0x81, 0x2a, 0x2f, 0xff, 0xfe, 0x1f, 0xce, 0x4d, 0xbc, 0xfe, 0xd6,
-		0xdc, 0xc4, 0xc8, 0xa5, 0x97, 0xc3, 0x97, 0xde, 0x5d, 0xf1, 0x9f, 0x89, 0xc3, 0xfd, 0xf4, 0xcb,
-		0xd2, 0x6e, 0xdb, 0x30, 0x9d, 0xf3, 0x7a, 0xf7, 0xa0, 0xe3, 0x58, 0x44, 0x01, 0x58, 0x3b, 0x7c,
-		0xc1, 0xa7, 0xbc, 0xe6, 0x45, 0xd6, 0x3c, 0x7c, 0xb9, 0xe5, 0x1d, 0x98, 0xdc, 0x24, 0x78, 0x84,
-		0xc5, 0x8e, 0xe5, 0x68, 0x2d, 0xee, 0xb5, 0xb0, 0x02, 0xa9, 0x65, 0x5f, 0xa3, 0x46, 0x59, 0xad,
-		0x21, 0x3e, 0x44, 0x6d, 0x61, 0x6d, 0x87, 0x7d, 0xd4, 0x13, 0xa3, 0xee, 0x6e, 0x8a, 0x54, 0xd0,
-		0xef, 0x77, 0x66, 0x60, 0x52, 0xeb, 0xb1, 0x8b, 0x37, 0x31, 0xe2, 0x07, 0xd3, 0x82, 0x7c, 0x1d,
-		0x92, 0xfc, 0xfc, 0x1d, 0x49, 0x10, 0xbb, 0x89, 0x0f, 0x68, 0x3f, 0x59, 0x85, 0xfc, 0x44, 0x8b,
-		0x30, 0x49, 0x07, 0xcf, 0xbf, 0x56, 0x9c, 0x5d, 0x1c, 0x18, 0xfd, 0x22, 0x1d, 0xa4, 0xc2, 0xc0,
-		0xe4, 0x6b, 0x90, 0x5a, 0xb6, 0x88, 0x14, 0x06, 0xa9, 0xa5, 0x19, 0x35, 0x3a, 0xe6, 0x4e, 0x8f,
-		0x4b, 0x85, 0xc2, 0x0a, 0xe8, 0x38, 0x24, 0xd8, 0x47, 0x5e, 0xfc, 0xf2, 0x10, 0x2f, 0xc9, 0x15,
-		0x48, 0x52, 0xda, 0x1b, 0x1d, 0xe2, 0x32, 0xb8, 0xf7, 0xde, 0xd3, 0xfc, 0x93, 0x5f, 0x4e, 0x3e,
-		0xea, 0x0d, 0x16, 0x41, 0xbc, 0xa9, 0x39, 0x1a, 0x9f, 0x37, 0xfd, 0x2d, 0xbf, 0x1f, 0x52, 0x9c,
-		0x88, 0x8d, 0x2e, 0x40, 0xcc, 0xea, 0xd8, 0xfc, 0xfa, 0x4f, 0x71, 0xd4, 0x54, 0x36, 0x3a, 0xe5,
-		0x38, 0x91, 0x19, 0x85, 0x00, 0x97, 0xd7, 0x47, 0x8a, 0xc5, 0xd3, 0x01, 0xb1, 0x68, 0x63, 0x67,
-		0x7b, 0xc7, 0xf1, 0x7e, 0xb0, 0xe5, 0x1c, 0x10, 0x05, 0x57, 0x50, 0xee, 0x46, 0x61, 0xce, 0xd7,
-		0x7a, 0x0b, 0x77, 0x6d, 0xc3, 0x32, 0x99, 0x34, 0x71, 0x49, 0x41, 0xbe, 0x01, 0xf2, 0xf6, 0x11,
-		0xa2, 0xf2, 0x3c, 0xc4, 0x4a, 0x9d, 0x0e, 0x2a, 0x42, 0x8a, 0x96, 0x75, 0x8b, 0xc9, 0x4a, 0x5c,
-		0x71, 0xcb, 0xa4, 0xcd, 0xb6, 0x76, 0x9c, 0xdb, 0x5a, 0xd7, 0xfd, 0x06, 0x5a, 0x94, 0xe5, 0xe7,
-		0x20, 0x5d, 0xb1, 0x4c, 0x1b, 0x9b, 0x76, 0x8f, 0xfa, 0xc2, 0xdb, 0x2d, 0x4b, 0xbf, 0xc9, 0x29,
-		0xb0, 0x02, 0x61, 0xb6, 0xd6, 0xe9, 0x50, 0xcc, 0xb8, 0x42, 0x7e, 0xb2, 0xfd, 0x5a, 0xde, 0x18,
-		0xc9, 0x9e, 0x8b, 0x47, 0x63, 0x0f, 0x9f, 0xa0, 0xe7, 0xd4, 0x46, 0xe0, 0xbe, 0xc1, 0x8d, 0x74,
-		0x13, 0x1f, 0xd8, 0x47, 0xdd, 0x47, 0x2f, 0x41, 0x7a, 0x93, 0x3e, 0x4f, 0x72, 0x1d, 0x1f, 0xa0,
-		0x22, 0x24, 0x71, 0xf3, 0xc2, 0xc5, 0x8b, 0x4f, 0x3e, 0xc7, 0xa4, 0xfc, 0xea, 0x84, 0x22, 0x2a,
-		0xd0, 0x1c, 0xa4, 0x6d, 0xac, 0x77, 0x2e, 0x5c, 0xbc, 0x74, 0xf3, 0x49, 0x26, 0x56, 0x57, 0x27,
-		0x14, 0xaf, 0x6a, 0x29, 0x45, 0x66, 0xfc, 0x85, 0x8f, 0xcf, 0x47, 0xca, 0x93, 0x10, 0xb3, 0x7b,
-		0xed, 0x77, 0x4d, 0x36, 0x7e, 0x68, 0x12, 0x16, 0x7c, 0xad, 0xcc, 0xb8, 0xdc, 0xd2, 0x5a, 0x46,
-		0x53, 0xf3, 0x1e, 0x95, 0x91, 0x7c, 0xf3, 0xa7, 0x10, 0x23, 0xac, 0xc6, 0xa1, 0x5c, 0x94, 0x7f,
-		0x31, 0x02, 0xd9, 0x1b, 0x82, 0x72, 0x1d, 0x3b, 0xe8, 0x32, 0x80, 0xdb, 0x93, 0xd8, 0x2a, 0xa7,
-		0x16, 0xfb, 0xfb, 0x5a, 0x74, 0x71, 0x14, 0x1f, 0x38, 0x7a, 0x86, 0x0a, 0x60, 0xc7, 0xb2, 0xf9,
-		0xf7, 0xb0, 0x21, 0xa8, 0x2e, 0x30, 0x7a, 0x0c, 0x10, 0xd5, 0x6a, 0xea, 0x2d, 0xcb, 0x31, 0xcc,
-		0x5d, 0xb5, 0x63, 0xdd, 0xe6, 0xaf, 0x0c, 0xc4, 0x14, 0x89, 0xb6, 0xdc, 0xa0, 0x0d, 0x9b, 0xa4,
-		0x9e, 0x0c, 0x3a, 0xed, 0x52, 0x21, 0x61, 0x9d, 0xd6, 0x6c, 0x76, 0xb1, 0x6d, 0x73, 0xc5, 0x25,
-		0x8a, 0xe8, 0x32, 0x24, 0x3b, 0xbd, 0x6d, 0x55, 0x68, 0x89, 0xcc, 0x85, 0xfb, 0x86, 0xed, 0x79,
-		0x21, 0x1b, 0x7c, 0xd7, 0x27, 0x3a, 0xbd, 0x6d, 0x22, 0x29, 0x0f, 0x40, 0x76, 0xc8, 0x60, 0x32,
-		0xb7, 0xbc, 0x71, 0xd0, 0x17, 0x71, 0xf8, 0x0c, 0xd4, 0x4e, 0xd7, 0xb0, 0xba, 0x86, 0x73, 0x40,
-		0xaf, 0xed, 0xc5, 0x14, 0x49, 0x34, 0x6c, 0xf2, 0x7a, 0xf9, 0x26, 0x14, 0xea, 0xd4, 0xdd, 0xf7,
-		0x46, 0x7e, 0xd1, 0x1b, 0x5f, 0x24, 0x7c, 0x7c, 0x23, 0x47, 0x16, 0x1d, 0x18, 0x59, 0x79, 0x6d,
-		0xa4, 0x64, 0x3e, 0x75, 0x34, 0xc9, 0x0c, 0x5a, 0xb7, 0x2f, 0x9d, 0x0c, 0x6c, 0x4a, 0xee, 0xf5,
-		0xf8, 0x54, 0xd6, 0xb8, 0x42, 0x19, 0x16, 0xc9, 0x17, 0x0f, 0x37, 0xa2, 0xc5, 0x10, 0xd5, 0x59,
-		0x0c, 0xdd, 0x3e, 0xf2, 0x73, 0x90, 0xdb, 0xd4, 0xba, 0x4e, 0x1d, 0x3b, 0x57, 0xb1, 0xd6, 0xc4,
-		0xdd, 0xa0, 0x95, 0xcd, 0x09, 0x2b, 0x8b, 0x20, 0x4e, 0x4d, 0x29, 0xb3, 0x32, 0xf4, 0xb7, 0xbc,
-		0x07, 0x71, 0x7a, 0x6f, 0xd7, 0xb5, 0xc0, 0x1c, 0x83, 0x59, 0x60, 0xa2, 0x3f, 0x0f, 0x1c, 0x6c,
-		0x8b, 0x64, 0x13, 0x2d, 0xa0, 0xa7, 0x85, 0x1d, 0x8d, 0x1d, 0x6e, 0x47, 0xb9, 0x10, 0x72, 0x6b,
-		0xda, 0x82, 0x64, 0x99, 0xa8, 0xdf, 0xda, 0xb2, 0x3b, 0x90, 0x88, 0x37, 0x10, 0xb4, 0x06, 0x85,
-		0x8e, 0xd6, 0x75, 0xe8, 0x77, 0x7c, 0x7b, 0x74, 0x16, 0x5c, 0xce, 0xe7, 0x07, 0x77, 0x5d, 0x60,
-		0xb2, 0xbc, 0x97, 0x5c, 0xc7, 0x5f, 0x29, 0xff, 0x71, 0x1c, 0x12, 0x9c, 0x19, 0xcf, 0x43, 0x92,
-		0xb3, 0x95, 0x4b, 0xe6, 0xfd, 0x8b, 0x83, 0xc6, 0x68, 0xd1, 0x35, 0x1a, 0x9c, 0x9e, 0xc0, 0x41,
-		0x8f, 0x40, 0x4a, 0xdf, 0xd3, 0x0c, 0x53, 0x35, 0x9a, 0xdc, 0x01, 0xcc, 0xbc, 0xf5, 0xe6, 0x7c,
-		0xb2, 0x42, 0xea, 0x6a, 0xcb, 0x4a, 0x92, 0x36, 0xd6, 0x9a, 0xc4, 0xf2, 0xef, 0x61, 0x63, 0x77,
-		0xcf, 0xe1, 0xbb, 0x8b, 0x97, 0xd0, 0xb3, 0x10, 0x27, 0x02, 0xc1, 0xbf, 0xf2, 0x2e, 0x0e, 0xf8,
-		0xf1, 0x6e, 0xa2, 0xa5, 0x9c, 0x22, 0x1d, 0x7f, 0xf4, 0x3f, 0xcd, 0x47, 0x14, 0x8a, 0x81, 0x2a,
-		0x90, 0x6b, 0x69, 0xb6, 0xa3, 0x52, 0xab, 0x45, 0xba, 0x9f, 0xa4, 0x24, 0x4e, 0x0e, 0x32, 0x84,
-		0x33, 0x96, 0x0f, 0x3d, 0x43, 0xb0, 0x58, 0x55, 0x13, 0x9d, 0x01, 0x89, 0x12, 0xd1, 0xad, 0x76,
-		0xdb, 0x70, 0x98, 0x2f, 0x95, 0xa0, 0x7c, 0xcf, 0x93, 0xfa, 0x0a, 0xad, 0xa6, 0x1e, 0xd5, 0x29,
-		0x48, 0xd3, 0xef, 0x4a, 0x29, 0x08, 0xbb, 0x2c, 0x9e, 0x22, 0x15, 0xb4, 0xf1, 0x34, 0x14, 0x3c,
-		0xdd, 0xc8, 0x40, 0x52, 0x8c, 0x8a, 0x57, 0x4d, 0x01, 0x9f, 0x80, 0x19, 0x13, 0xef, 0xd3, 0xeb,
-		0xeb, 0x01, 0xe8, 0x34, 0x85, 0x46, 0xa4, 0xed, 0x46, 0x10, 0xe3, 0x61, 0xc8, 0xeb, 0x82, 0xf9,
-		0x0c, 0x16, 0x28, 0x6c, 0xce, 0xad, 0xa5, 0x60, 0x27, 0x21, 0xa5, 0x75, 0x3a, 0x0c, 0x20, 0xc3,
-		0x75, 0x63, 0xa7, 0x43, 0x9b, 0xce, 0xc1, 0x14, 0x9d, 0x63, 0x17, 0xdb, 0xbd, 0x96, 0xc3, 0x89,
-		0x64, 0x29, 0x4c, 0x81, 0x34, 0x28, 0xac, 0x9e, 0xc2, 0x3e, 0x08, 0x39, 0x7c, 0xcb, 0x68, 0x62,
-		0x53, 0xc7, 0x0c, 0x2e, 0x47, 0xe1, 0xb2, 0xa2, 0x92, 0x02, 0x9d, 0x05, 0x57, 0xe7, 0xa9, 0x42,
-		0x1f, 0xe7, 0x19, 0x3d, 0x51, 0x5f, 0x62, 0xd5, 0xf2, 0x2c, 0xc4, 0x97, 0x35, 0x47, 0x23, 0x4e,
-		0x85, 0xb3, 0xcf, 0x8c, 0x4c, 0x56, 0x21, 0x3f, 0xe5, 0x2f, 0x44, 0x21, 0x7e, 0xc3, 0x72, 0x30,
-		0x7a, 0xca, 0xe7, 0xf0, 0xe5, 0x87, 0xc9, 0x73, 0xdd, 0xd8, 0x35, 0x71, 0x73, 0xcd, 0xde, 0xf5,
-		0x3d, 0x02, 0xe3, 0x89, 0x53, 0x34, 0x20, 0x4e, 0x33, 0x30, 0xd9, 0xb5, 0x7a, 0x66, 0x53, 0x5c,
-		0xb3, 0xa6, 0x05, 0x54, 0x85, 0x94, 0x2b, 0x25, 0xf1, 0x30, 0x29, 0x29, 0x10, 0x29, 0x21, 0x32,
-		0xcc, 0x2b, 0x94, 0xe4, 0x36, 0x17, 0x96, 0x32, 0xa4, 0x5d, 0xe5, 0xc5, 0xa5, 0x6d, 0x3c, 0x81,
-		0xf5, 0xd0, 0x88, 0x21, 0x71, 0xd7, 0xde, 0x65, 0x1e, 0x93, 0x38, 0xc9, 0x6d, 0xe0, 0xdc, 0x0b,
-		0x88, 0x15, 0x7f, 0x90, 0x26, 0x49, 0xe7, 0xe5, 0x89, 0x15, 0x7b, 0x94, 0xe6, 0x3e, 0x48, 0xdb,
-		0xc6, 0xae, 0xa9, 0x39, 0xbd, 0x2e, 0xe6, 0x92, 0xe7, 0x55, 0xc8, 0xbf, 0x19, 0x81, 0x04, 0x93,
-		0x64, 0x1f, 0xdf, 0x22, 0xc3, 0xf9, 0x16, 0x1d, 0xc5, 0xb7, 0xd8, 0xbd, 0xf3, 0xad, 0x04, 0xe0,
-		0x0e, 0xc6, 0xe6, 0xef, 0x84, 0x0c, 0xf1, 0x16, 0xd8, 0x10, 0xeb, 0xc6, 0x2e, 0xdf, 0xa8, 0x3e,
-		0x24, 0xf9, 0x3f, 0x46, 0x88, 0xe3, 0xca, 0xdb, 0x51, 0x09, 0x72, 0x62, 0x5c, 0xea, 0x4e, 0x4b,
-		0xdb, 0xe5, 0xb2, 0x73, 0xff, 0xc8, 0xc1, 0x5d, 0x69, 0x69, 0xbb, 0x4a, 0x86, 0x8f, 0x87, 0x14,
-		0x86, 0xaf, 0x43, 0x74, 0xc4, 0x3a, 0x04, 0x16, 0x3e, 0x76, 0x6f, 0x0b, 0x1f, 0x58, 0xa2, 0x78,
-		0xff, 0x12, 0xfd, 0x52, 0x94, 0x06, 0x2f, 0x1d, 0xcb, 0xd6, 0x5a, 0xef, 0xc5, 0x8e, 0x38, 0x05,
-		0xe9, 0x8e, 0xd5, 0x52, 0x59, 0x0b, 0xfb, 0xfc, 0x20, 0xd5, 0xb1, 0x5a, 0xca, 0xc0, 0xb2, 0x4f,
-		0xbe, 0x43, 0xdb, 0x25, 0xf1, 0x0e, 0x70, 0x2d, 0xd9, 0xcf, 0xb5, 0x2e, 0x64, 0x19, 0x2b, 0xb8,
-		0x2d, 0x7b, 0x82, 0xf0, 0x80, 0x1a, 0xc7, 0xc8, 0xa0, 0xed, 0x65, 0xc3, 0x66, 0x90, 0x0a, 0x87,
-		0x23, 0x18, 0x4c, 0xf5, 0x0f, 0x8b, 0x7a, 0xfd, 0x62, 0xa9, 0x70, 0x38, 0xf9, 0x87, 0x22, 0x00,
-		0xab, 0x84, 0xb3, 0x74, 0xbe, 0xc4, 0x0a, 0xd9, 0x74, 0x08, 0x6a, 0xa0, 0xe7, 0xb9, 0x51, 0x8b,
-		0xc6, 0xfb, 0xcf, 0xda, 0xfe, 0x71, 0x57, 0x20, 0xe7, 0x09, 0xa3, 0x8d, 0xc5, 0x60, 0xe6, 0x0e,
-		0xf1, 0xa8, 0xeb, 0xd8, 0x51, 0xb2, 0xb7, 0x7c, 0x25, 0xf9, 0x9f, 0x44, 0x20, 0x4d, 0xc7, 0xb4,
-		0x86, 0x1d, 0x2d, 0xb0, 0x86, 0x91, 0x7b, 0x5f, 0xc3, 0xfb, 0x01, 0x18, 0x19, 0xdb, 0x78, 0x0d,
-		0x73, 0xc9, 0x4a, 0xd3, 0x9a, 0xba, 0xf1, 0x1a, 0x46, 0x97, 0x5c, 0x86, 0xc7, 0x0e, 0x67, 0xb8,
-		0xf0, 0xb8, 0x39, 0xdb, 0x4f, 0x40, 0x92, 0xbe, 0xab, 0xb7, 0x6f, 0x73, 0x27, 0x3a, 0x61, 0xf6,
-		0xda, 0x8d, 0x7d, 0x5b, 0x7e, 0x15, 0x92, 0x8d, 0x7d, 0x96, 0x0b, 0x39, 0x05, 0xe9, 0xae, 0x65,
-		0x71, 0x9b, 0xcc, 0x7c, 0xa1, 0x14, 0xa9, 0xa0, 0x26, 0x48, 0xc4, 0xff, 0x51, 0x2f, 0xfe, 0xf7,
-		0x12, 0x18, 0xb1, 0xb1, 0x12, 0x18, 0xe7, 0xfe, 0x5d, 0x04, 0x32, 0x3e, 0xfd, 0x80, 0x9e, 0x84,
-		0x63, 0xe5, 0xd5, 0x8d, 0xca, 0x75, 0xb5, 0xb6, 0xac, 0x5e, 0x59, 0x2d, 0xad, 0x78, 0x5f, 0xd8,
-		0x15, 0x8f, 0xdf, 0xb9, 0xbb, 0x80, 0x7c, 0xb0, 0x5b, 0x26, 0x3d, 0xcd, 0x41, 0xe7, 0x61, 0x26,
-		0x88, 0x52, 0x2a, 0xd7, 0xab, 0xeb, 0x0d, 0x29, 0x52, 0x3c, 0x76, 0xe7, 0xee, 0xc2, 0x94, 0x0f,
-		0xa3, 0xb4, 0x6d, 0x63, 0xd3, 0x19, 0x44, 0xa8, 0x6c, 0xac, 0xad, 0xd5, 0x1a, 0x52, 0x74, 0x00,
-		0x81, 0x2b, 0xec, 0xb3, 0x30, 0x15, 0x44, 0x58, 0xaf, 0xad, 0x4a, 0xb1, 0x22, 0xba, 0x73, 0x77,
-		0x21, 0xef, 0x83, 0x5e, 0x37, 0x5a, 0xc5, 0xd4, 0x47, 0x7e, 0x6a, 0x6e, 0xe2, 0x93, 0x7f, 0x67,
-		0x2e, 0x42, 0x66, 0x96, 0x0b, 0xe8, 0x08, 0xf4, 0x18, 0x9c, 0xa8, 0xd7, 0x56, 0xd6, 0xab, 0xcb,
-		0xea, 0x5a, 0x7d, 0xa5, 0xef, 0xa3, 0xe9, 0x62, 0xe1, 0xce, 0xdd, 0x85, 0x0c, 0x9f, 0xd2, 0x28,
-		0xe8, 0x4d, 0xa5, 0x7a, 0x63, 0xa3, 0x51, 0x95, 0x22, 0x0c, 0x7a, 0xb3, 0x8b, 0x6f, 0x59, 0x0e,
-		0x7b, 0x92, 0xf3, 0x09, 0x38, 0x39, 0x04, 0xda, 0x9d, 0xd8, 0xd4, 0x9d, 0xbb, 0x0b, 0xb9, 0xcd,
-		0x2e, 0x66, 0xfb, 0x87, 0x62, 0x2c, 0xc2, 0xec, 0x20, 0xc6, 0xc6, 0xe6, 0x46, 0xbd, 0xb4, 0x2a,
-		0x2d, 0x14, 0xa5, 0x3b, 0x77, 0x17, 0xb2, 0x42, 0x19, 0x12, 0x78, 0x6f, 0x66, 0xef, 0x56, 0xb4,
-		0xf3, 0xdd, 0xc9, 0x40, 0x2e, 0x8f, 0xc5, 0x11, 0x1d, 0xad, 0xab, 0xb5, 0x8f, 0x1a, 0xee, 0x84,
-		0x1c, 0xe7, 0xca, 0xaf, 0x47, 0xa1, 0xe0, 0x3a, 0xd3, 0x9b, 0xb4, 0x07, 0xf4, 0x94, 0x3f, 0x0f,
-		0x93, 0x19, 0x69, 0xc6, 0x18, 0xb4, 0x48, 0xd3, 0xbc, 0x0f, 0x52, 0xc2, 0x29, 0xe3, 0xea, 0x62,
-		0x61, 0x10, 0xaf, 0xca, 0x21, 0x38, 0xaa, 0x8b, 0x81, 0x5e, 0x80, 0xb4, 0xab, 0x3c, 0xdc, 0x67,
-		0xab, 0x46, 0x6b, 0x1b, 0x8e, 0xef, 0xe1, 0xa0, 0xe7, 0xbc, 0xb0, 0x21, 0x3e, 0x2a, 0x10, 0xb9,
-		0xc1, 0x00, 0x38, 0xb2, 0x80, 0x97, 0x6b, 0x7c, 0xdb, 0xf1, 0xd9, 0xd3, 0x27, 0x3f, 0xf6, 0x55,
-		0x16, 0x49, 0x31, 0xaf, 0x24, 0xd5, 0xd6, 0xf6, 0xcb, 0x34, 0x98, 0x3a, 0x01, 0x49, 0xd2, 0xb8,
-		0xcb, 0x3f, 0x2a, 0x8f, 0x29, 0x89, 0xb6, 0xb6, 0xbf, 0xa2, 0xd9, 0xd7, 0xe2, 0xa9, 0x98, 0x14,
-		0x97, 0x7f, 0x36, 0x02, 0xf9, 0xe0, 0x1c, 0xd1, 0xa3, 0x80, 0x08, 0x86, 0xb6, 0x8b, 0x55, 0xa2,
-		0x62, 0x28, 0xb3, 0x04, 0xdd, 0x42, 0x5b, 0xdb, 0x2f, 0xed, 0xe2, 0xf5, 0x5e, 0x9b, 0x0e, 0xc0,
-		0x46, 0x6b, 0x20, 0x09, 0x60, 0xb1, 0x4e, 0x9c, 0x99, 0x27, 0x07, 0x1f, 0xb1, 0xe4, 0x00, 0xcc,
-		0x50, 0x7d, 0x8c, 0x18, 0xaa, 0x3c, 0xa3, 0xe7, 0x1e, 0xc7, 0x07, 0xa6, 0x12, 0x0b, 0x4e, 0x45,
-		0x7e, 0x01, 0x0a, 0x7d, 0xfc, 0x44, 0x32, 0xe4, 0x78, 0x56, 0x80, 0x1e, 0x75, 0x32, 0xff, 0x38,
-		0xad, 0x64, 0x58, 0xf4, 0x4f, 0x8f, 0x7e, 0x97, 0x52, 0xbf, 0xfa, 0xc6, 0x7c, 0x84, 0x26, 0xcc,
-		0x1f, 0x85, 0x5c, 0x80, 0xa3, 0x22, 0x53, 0x17, 0xf1, 0x32, 0x75, 0x1e, 0xf0, 0x2b, 0x90, 0x25,
-		0x8a, 0x12, 0x37, 0x39, 0xec, 0x23, 0x50, 0x60, 0x8a, 0xbc, 0x9f, 0xd7, 0xcc, 0x93, 0x5a, 0x13,
-		0x0c, 0x97, 0x85, 0x6b, 0x15, 0x64, 0x7b, 0x46, 0x40, 0xad, 0x68, 0x76, 0xf9, 0x03, 0x9f, 0x7c,
-		0x6b, 0x2e, 0xf2, 0xee, 0x6c, 0xc4, 0xdf, 0xfd, 0x00, 0x9c, 0xf2, 0x35, 0x6a, 0xdb, 0xba, 0x11,
-		0xc8, 0x3a, 0x14, 0x7c, 0x42, 0x46, 0x1a, 0xc3, 0xb2, 0x07, 0x87, 0xe6, 0x30, 0x0e, 0x4f, 0x98,
-		0x15, 0x0f, 0xd7, 0x08, 0xe1, 0x89, 0x8d, 0xe1, 0x39, 0xca, 0xff, 0x93, 0x82, 0xa4, 0x82, 0x3f,
-		0xd4, 0xc3, 0xb6, 0x83, 0x2e, 0x40, 0x1c, 0xeb, 0x7b, 0xd6, 0xb0, 0x94, 0x10, 0x99, 0xdc, 0x22,
-		0x87, 0xab, 0xea, 0x7b, 0xd6, 0xd5, 0x09, 0x85, 0xc2, 0xa2, 0x8b, 0x30, 0xb9, 0xd3, 0xea, 0xf1,
-		0x3c, 0x45, 0x9f, 0xb2, 0xf0, 0x23, 0x5d, 0x21, 0x40, 0x57, 0x27, 0x14, 0x06, 0x4d, 0xba, 0xa2,
-		0x0f, 0x05, 0xc7, 0x0e, 0xef, 0xaa, 0x66, 0xee, 0xd0, 0xae, 0x08, 0x2c, 0x2a, 0x03, 0x18, 0xa6,
-		0xe1, 0xa8, 0x34, 0x86, 0xe7, 0x9e, 0xe0, 0x03, 0xa3, 0x31, 0x0d, 0x87, 0x46, 0xfd, 0x57, 0x27,
-		0x94, 0xb4, 0x21, 0x0a, 0x64, 0xb8, 0x1f, 0xea, 0xe1, 0xee, 0x01, 0x77, 0x00, 0x47, 0x0e, 0xf7,
-		0x03, 0x04, 0x88, 0x0c, 0x97, 0x42, 0xa3, 0x2a, 0x64, 0xe8, 0x17, 0xb2, 0x6c, 0xff, 0xf2, 0xa7,
-		0x69, 0xe5, 0x51, 0xc8, 0x65, 0x02, 0x4a, 0xb7, 0xf4, 0xd5, 0x09, 0x05, 0xb6, 0xdd, 0x12, 0x51,
-		0x92, 0xec, 0xe9, 0x32, 0x67, 0x9f, 0x3f, 0xc8, 0x39, 0x3f, 0x8a, 0x06, 0x7d, 0xbf, 0xac, 0xb1,
-		0x7f, 0x75, 0x42, 0x49, 0xea, 0xec, 0x27, 0x99, 0x7f, 0x13, 0xb7, 0x8c, 0x5b, 0xb8, 0x4b, 0xf0,
-		0xd3, 0x87, 0xcf, 0x7f, 0x99, 0x41, 0x52, 0x0a, 0xe9, 0xa6, 0x28, 0x10, 0x45, 0x8b, 0xcd, 0x26,
-		0x9f, 0x06, 0x0c, 0xea, 0xe9, 0xc0, 0x3a, 0x9b, 0x4d, 0x31, 0x89, 0x14, 0xe6, 0xbf, 0xd1, 0xb3,
-		0xae, 0x87, 0x9a, 0x19, 0x74, 0x0a, 0x03, 0x13, 0x60, 0xb9, 0x8a, 0x09, 0xe1, 0xa9, 0xa2, 0x75,
-		0xc8, 0xb7, 0x0c, 0xdb, 0x51, 0x6d, 0x53, 0xeb, 0xd8, 0x7b, 0x96, 0x63, 0xd3, 0xa0, 0x3f, 0x73,
-		0xe1, 0xe1, 0x51, 0x14, 0x56, 0x0d, 0xdb, 0xa9, 0x0b, 0xe0, 0xab, 0x13, 0x4a, 0xae, 0xe5, 0xaf,
-		0x20, 0xf4, 0xac, 0x9d, 0x1d, 0xdc, 0x75, 0x09, 0xd2, 0xe4, 0xc0, 0x21, 0xf4, 0x36, 0x08, 0xb4,
-		0xc0, 0x27, 0xf4, 0x2c, 0x7f, 0x05, 0xfa, 0x66, 0x98, 0x6e, 0x59, 0x5a, 0xd3, 0x25, 0xa7, 0xea,
-		0x7b, 0x3d, 0xf3, 0x26, 0xcd, 0x24, 0x64, 0x2e, 0x9c, 0x1d, 0x39, 0x48, 0x4b, 0x6b, 0x0a, 0x12,
-		0x15, 0x82, 0x70, 0x75, 0x42, 0x99, 0x6a, 0xf5, 0x57, 0xa2, 0x0f, 0xc2, 0x8c, 0xd6, 0xe9, 0xb4,
-		0x0e, 0xfa, 0xa9, 0x17, 0x28, 0xf5, 0x73, 0xa3, 0xa8, 0x97, 0x08, 0x4e, 0x3f, 0x79, 0xa4, 0x0d,
-		0xd4, 0xa2, 0x06, 0x48, 0x9d, 0x2e, 0xa6, 0x1f, 0xd5, 0x74, 0xb8, 0xab, 0x42, 0x9f, 0x1c, 0xca,
-		0x5c, 0x38, 0x3d, 0x8a, 0xf6, 0x26, 0x83, 0x17, 0x9e, 0xcd, 0xd5, 0x09, 0xa5, 0xd0, 0x09, 0x56,
-		0x31, 0xaa, 0x96, 0x8e, 0xe9, 0x8b, 0x68, 0x9c, 0xea, 0x54, 0x18, 0x55, 0x0a, 0x1f, 0xa4, 0x1a,
-		0xa8, 0x2a, 0x27, 0xf9, 0xa5, 0x38, 0xfe, 0x62, 0xcc, 0x69, 0xc8, 0xf8, 0x14, 0x0b, 0x9a, 0x85,
-		0x24, 0xbf, 0x24, 0x20, 0x2e, 0xd3, 0xf1, 0xa2, 0x9c, 0x87, 0xac, 0x5f, 0x99, 0xc8, 0x1f, 0x8d,
-		0xb8, 0x98, 0xf4, 0x53, 0xfb, 0xd9, 0x60, 0xea, 0x30, 0xed, 0x65, 0x05, 0x1f, 0x14, 0x56, 0x44,
-		0xb4, 0xb3, 0xd3, 0xa4, 0x2c, 0xad, 0xe4, 0x46, 0x0c, 0xcd, 0x43, 0xa6, 0x73, 0xa1, 0xe3, 0x82,
-		0xc4, 0x28, 0x08, 0x74, 0x2e, 0x74, 0x04, 0xc0, 0x03, 0x90, 0x25, 0x33, 0x55, 0xfd, 0x8e, 0x46,
-		0x5a, 0xc9, 0x90, 0x3a, 0x0e, 0x22, 0xff, 0xab, 0x28, 0x48, 0xfd, 0x0a, 0xc8, 0xcd, 0x29, 0x46,
-		0x8e, 0x9c, 0x53, 0x3c, 0xd9, 0x9f, 0xcd, 0xf4, 0x12, 0x98, 0xab, 0x20, 0x79, 0x79, 0x38, 0x66,
-		0x08, 0x46, 0x3b, 0x4e, 0x7d, 0x1e, 0x9e, 0x52, 0xd0, 0xfb, 0x5c, 0xbe, 0x2b, 0x81, 0xb3, 0x17,
-		0xf1, 0x12, 0x7e, 0xff, 0x12, 0xbb, 0xfe, 0xc2, 0x56, 0xa7, 0xa9, 0x39, 0x58, 0xe4, 0x45, 0x7c,
-		0xc7, 0x30, 0x8f, 0x40, 0x41, 0xeb, 0x74, 0x54, 0xdb, 0xd1, 0x1c, 0xcc, 0xcd, 0xfa, 0x24, 0x4b,
-		0x0f, 0x6a, 0x9d, 0x4e, 0x9d, 0xd4, 0x32, 0xb3, 0xfe, 0x30, 0xe4, 0x89, 0x4e, 0x36, 0xb4, 0x96,
-		0xca, 0xb3, 0x04, 0x09, 0x66, 0xfd, 0x79, 0xed, 0x55, 0x5a, 0x29, 0x37, 0xdd, 0x15, 0xa7, 0xfa,
-		0xd8, 0x0d, 0xb3, 0x22, 0xbe, 0x30, 0x0b, 0xf1, 0x27, 0x10, 0x18, 0x7f, 0xc4, 0xb3, 0x11, 0xc3,
-		0xb3, 0xbb, 0x33, 0x34, 0x24, 0xbb, 0xc5, 0x12, 0x1e, 0x29, 0x85, 0x15, 0xe4, 0x0f, 0x47, 0x61,
-		0x6a, 0x40, 0x73, 0x0f, 0x4d, 0x7b, 0x7b, 0xf1, 0x65, 0xf4, 0x48, 0xf1, 0xe5, 0xf5, 0x60, 0x5a,
-		0xd7, 0x67, 0xf9, 0x4e, 0x0d, 0x30, 0x99, 0xe9, 0x4d, 0x22, 0xd0, 0x9c, 0x88, 0x2f, 0xf3, 0x4b,
-		0xc5, 0x7c, 0x0b, 0x66, 0xb6, 0x0f, 0x5e, 0xd3, 0x4c, 0xc7, 0x30, 0xb1, 0x3a, 0xb0, 0x6a, 0x83,
-		0xa6, 0x74, 0xcd, 0xb0, 0xb7, 0xf1, 0x9e, 0x76, 0xcb, 0xb0, 0xc4, 0xb0, 0xa6, 0x5d, 0x7c, 0x2f,
-		0xb9, 0x2b, 0x2b, 0x90, 0x0f, 0x9a, 0x1e, 0x94, 0x87, 0xa8, 0xb3, 0xcf, 0xe7, 0x1f, 0x75, 0xf6,
-		0xd1, 0x13, 0x3c, 0x0f, 0x14, 0xa5, 0x79, 0xa0, 0xc1, 0x8e, 0x38, 0x9e, 0x97, 0x04, 0x92, 0x65,
-		0x77, 0x37, 0xb8, 0xe6, 0xa8, 0x9f, 0xaa, 0x7c, 0x16, 0x0a, 0x7d, 0xf6, 0x66, 0x54, 0x56, 0x50,
-		0x2e, 0x40, 0x2e, 0x60, 0x5c, 0xe4, 0xe3, 0x30, 0x33, 0xcc, 0x56, 0xc8, 0x7b, 0x6e, 0x7d, 0x40,
-		0xe7, 0xa3, 0x8b, 0x90, 0x72, 0x8d, 0xc5, 0x90, 0x2c, 0x04, 0x9d, 0x85, 0x00, 0x56, 0x5c, 0xd0,
-		0x40, 0x32, 0x3b, 0x1a, 0x48, 0x66, 0xcb, 0xdf, 0x06, 0xb3, 0xa3, 0x0c, 0x41, 0xdf, 0x34, 0xe2,
-		0xae, 0x14, 0x1e, 0x87, 0x04, 0x7f, 0xf6, 0x30, 0x4a, 0x8f, 0x6f, 0x78, 0x89, 0x48, 0x27, 0x33,
-		0x0a, 0x31, 0x76, 0xaa, 0x43, 0x0b, 0xb2, 0x0a, 0x27, 0x47, 0x1a, 0x83, 0xd1, 0x07, 0x41, 0x8c,
-		0x10, 0x3f, 0x08, 0xd2, 0xc5, 0x70, 0x6c, 0x3a, 0x57, 0x71, 0xd9, 0x81, 0x95, 0xe4, 0x8f, 0xc5,
-		0xe0, 0xf8, 0x70, 0x93, 0x80, 0x16, 0x20, 0x4b, 0xfc, 0x6e, 0x27, 0xe8, 0xa2, 0x43, 0x5b, 0xdb,
-		0x6f, 0x70, 0xff, 0x9c, 0x27, 0xd2, 0xa3, 0x6e, 0x22, 0x1d, 0x6d, 0xc1, 0x54, 0xcb, 0xd2, 0xb5,
-		0x96, 0xea, 0x93, 0x78, 0x2e, 0xec, 0x0f, 0x0e, 0x30, 0xbb, 0xca, 0x1e, 0x43, 0x6f, 0x0e, 0x08,
-		0x7d, 0x81, 0xd2, 0x58, 0x75, 0x25, 0x1f, 0x2d, 0x43, 0xa6, 0xed, 0x09, 0xf2, 0x11, 0x84, 0xdd,
-		0x8f, 0xe6, 0x5b, 0x92, 0xc9, 0xa1, 0xc7, 0x3e, 0x89, 0x23, 0xab, 0xe8, 0x51, 0x27, 0x28, 0xc9,
-		0x91, 0x27, 0x28, 0xc3, 0x8e, 0x2b, 0x52, 0xc3, 0x8f, 0x2b, 0x3e, 0xe2, 0x5f, 0x9a, 0x80, 0x11,
-		0x1d, 0x3c, 0xc1, 0x40, 0x75, 0x98, 0xe1, 0xf8, 0xcd, 0x00, 0xef, 0xa3, 0xe3, 0x2a, 0x1a, 0x24,
-		0xd0, 0x47, 0xb3, 0x3d, 0x76, 0x6f, 0x6c, 0x17, 0xba, 0x34, 0xee, 0xd3, 0xa5, 0xff, 0x9f, 0x2d,
-		0xc5, 0xbf, 0x49, 0x43, 0x4a, 0xc1, 0x76, 0x87, 0x18, 0x4e, 0x54, 0x86, 0x34, 0xde, 0xd7, 0x71,
-		0xc7, 0xf1, 0x8e, 0x29, 0x87, 0x05, 0x03, 0x0c, 0xba, 0x2a, 0x20, 0x89, 0x27, 0xee, 0xa2, 0xa1,
-		0xa7, 0x78, 0xb0, 0x35, 0x3a, 0x6e, 0xe2, 0xe8, 0xfe, 0x68, 0xeb, 0x92, 0x88, 0xb6, 0x62, 0x23,
-		0x9d, 0x6f, 0x86, 0xd5, 0x17, 0x6e, 0x3d, 0xc5, 0xc3, 0xad, 0x78, 0x48, 0x67, 0x81, 0x78, 0xab,
-		0x12, 0x88, 0xb7, 0x12, 0x21, 0xd3, 0x1c, 0x11, 0x70, 0x5d, 0x12, 0x01, 0x57, 0x32, 0x64, 0xc4,
-		0x7d, 0x11, 0xd7, 0x95, 0x60, 0xc4, 0x95, 0x1a, 0xa1, 0x40, 0x04, 0xf6, 0xc8, 0x90, 0xeb, 0x79,
-		0x5f, 0xc8, 0x95, 0x1e, 0x19, 0xef, 0x30, 0x22, 0x43, 0x62, 0xae, 0x4a, 0x20, 0xe6, 0x82, 0x10,
-		0x1e, 0x8c, 0x08, 0xba, 0xbe, 0xc9, 0x1f, 0x74, 0x65, 0x46, 0xc6, 0x6d, 0x7c, 0xbd, 0x87, 0x45,
-		0x5d, 0xcf, 0xb9, 0x51, 0x57, 0x76, 0x64, 0xd8, 0xc8, 0xe7, 0xd0, 0x1f, 0x76, 0x6d, 0x0c, 0x84,
-		0x5d, 0x39, 0xfe, 0x77, 0x46, 0x46, 0x91, 0x08, 0x89, 0xbb, 0x36, 0x06, 0xe2, 0xae, 0x7c, 0x08,
-		0xc1, 0x90, 0xc0, 0xeb, 0x5b, 0x86, 0x07, 0x5e, 0xa3, 0x43, 0x23, 0x3e, 0xcc, 0xf1, 0x22, 0x2f,
-		0x75, 0x44, 0xe4, 0xc5, 0xa2, 0xa3, 0x47, 0x47, 0x92, 0x1f, 0x3b, 0xf4, 0xda, 0x1a, 0x12, 0x7a,
-		0xb1, 0x20, 0xe9, 0xcc, 0x48, 0xe2, 0x63, 0xc4, 0x5e, 0x5b, 0x43, 0x62, 0x2f, 0x14, 0x4a, 0xf6,
-		0x28, 0xc1, 0xd7, 0xa4, 0x94, 0x90, 0xcf, 0x12, 0xd7, 0xb7, 0x4f, 0x4f, 0x11, 0xff, 0x01, 0x77,
-		0xbb, 0x56, 0x57, 0xdc, 0xac, 0xa5, 0x05, 0xf9, 0x0c, 0x71, 0xc6, 0x3d, 0x9d, 0x74, 0x48, 0xa0,
-		0x46, 0xfd, 0x34, 0x9f, 0x1e, 0x92, 0x7f, 0x35, 0xe2, 0xe1, 0x52, 0x1f, 0xd6, 0xef, 0xc8, 0xa7,
-		0xb9, 0x23, 0xef, 0x0b, 0xdf, 0xa2, 0xc1, 0xf0, 0x6d, 0x1e, 0x32, 0xc4, 0xff, 0xea, 0x8b, 0xcc,
-		0xb4, 0x8e, 0x1b, 0x99, 0x89, 0x2b, 0x05, 0x2c, 0xc8, 0xe3, 0x66, 0x85, 0x9d, 0xe4, 0x14, 0xdc,
-		0xeb, 0x15, 0x2c, 0xa6, 0x40, 0x8f, 0xc3, 0xb4, 0x0f, 0xd6, 0xf5, 0xeb, 0x58, 0x98, 0x22, 0xb9,
-		0xd0, 0x25, 0xee, 0xe0, 0xfd, 0xd3, 0x88, 0xc7, 0x21, 0x2f, 0xa4, 0x1b, 0x16, 0x7d, 0x45, 0xde,
-		0xa1, 0xe8, 0x2b, 0x7a, 0xcf, 0xd1, 0x97, 0xdf, 0x4f, 0x8d, 0x05, 0xfd, 0xd4, 0xff, 0x1e, 0xf1,
-		0xd6, 0xc4, 0x8d, 0xa5, 0x74, 0xab, 0x89, 0xb9, 0xe7, 0x48, 0x7f, 0x13, 0xa7, 0xa2, 0x65, 0xed,
-		0x72, 0xff, 0x90, 0xfc, 0x24, 0x50, 0xae, 0xe1, 0x48, 0x73, 0xbb, 0xe0, 0x3a, 0x9d, 0x93, 0xfe,
-		0xfb, 0xbf, 0xfc, 0x52, 0x6c, 0xc2, 0xbb, 0x14, 0xeb, 0x7e, 0xfc, 0x96, 0xf4, 0x7d, 0xfc, 0x86,
-		0x9e, 0x85, 0x34, 0xcd, 0x88, 0xaa, 0x56, 0x47, 0xfc, 0x55, 0x9a, 0x53, 0xa3, 0x2f, 0xc4, 0xda,
-		0xf4, 0xaa, 0x1e, 0xbb, 0x44, 0xeb, 0x79, 0x0c, 0xe9, 0x80, 0xc7, 0x70, 0x1f, 0xa4, 0xc9, 0xe8,
-		0xd9, 0xeb, 0xea, 0xc0, 0xbf, 0x9c, 0x14, 0x15, 0xf2, 0x07, 0x01, 0x0d, 0x1a, 0x09, 0x74, 0x15,
-		0x12, 0xf8, 0x16, 0x7d, 0xd5, 0x8f, 0x5d, 0x34, 0x3c, 0x3e, 0xe8, 0x9a, 0x92, 0xe6, 0xf2, 0x2c,
-		0x61, 0xf2, 0x17, 0xdf, 0x9c, 0x97, 0x18, 0xf4, 0x63, 0xee, 0x87, 0x02, 0x0a, 0xc7, 0x97, 0xff,
-		0x43, 0x94, 0x04, 0x30, 0x01, 0x03, 0x32, 0x94, 0xb7, 0xc3, 0x8e, 0x08, 0xc7, 0xe3, 0xf7, 0x1c,
-		0xc0, 0xae, 0x66, 0xab, 0xb7, 0x35, 0xd3, 0xc1, 0x4d, 0xce, 0x74, 0x5f, 0x0d, 0x2a, 0x42, 0x8a,
-		0x94, 0x7a, 0x36, 0x6e, 0xf2, 0x30, 0xda, 0x2d, 0xfb, 0xe6, 0x99, 0xfc, 0xda, 0xe6, 0x19, 0xe4,
-		0x72, 0xaa, 0x8f, 0xcb, 0xbe, 0xe0, 0x22, 0xed, 0x0f, 0x2e, 0xd8, 0xc5, 0x60, 0x7e, 0x3f, 0x11,
-		0xd8, 0xd8, 0x44, 0x19, 0x3d, 0x08, 0xb9, 0x36, 0x6e, 0x77, 0x2c, 0xab, 0xa5, 0x32, 0x75, 0xc3,
-		0xfe, 0x8c, 0x42, 0x96, 0x57, 0x56, 0xa9, 0xd6, 0xf9, 0xae, 0xa8, 0xb7, 0xff, 0xbc, 0x20, 0xf2,
-		0x1b, 0x8e, 0xc1, 0xf4, 0xdd, 0xe9, 0x7e, 0x17, 0x01, 0xd5, 0xfd, 0xb7, 0x46, 0x7a, 0x54, 0x2d,
-		0x08, 0x81, 0x1e, 0x57, 0x7f, 0x78, 0xb7, 0x4b, 0x58, 0xb5, 0x8d, 0x5e, 0x86, 0x13, 0x7d, 0xba,
-		0xcd, 0x25, 0x1d, 0x1d, 0x57, 0xc5, 0x1d, 0x0b, 0xaa, 0x38, 0x41, 0xda, 0x63, 0x56, 0xec, 0x6b,
-		0xdc, 0x75, 0x35, 0xc8, 0x07, 0x3d, 0x9e, 0xa1, 0xcb, 0x4f, 0xff, 0x6c, 0x8f, 0xa3, 0x19, 0xa6,
-		0x1a, 0x48, 0x07, 0x65, 0x59, 0x25, 0x4f, 0x32, 0x6d, 0xc2, 0xb1, 0xa1, 0x9e, 0x0f, 0x7a, 0x06,
-		0xd2, 0x9e, 0xd3, 0xc4, 0xb8, 0x7a, 0x48, 0xba, 0xc0, 0x83, 0x95, 0x7f, 0x23, 0xe2, 0x91, 0x0c,
-		0x26, 0x20, 0xaa, 0x90, 0x60, 0xd7, 0xde, 0xf8, 0x65, 0x9a, 0xc7, 0xc7, 0xf3, 0x99, 0x16, 0xd9,
-		0x9d, 0x38, 0x85, 0x23, 0xcb, 0x1f, 0x84, 0x04, 0xab, 0x41, 0x19, 0x48, 0x7a, 0x0f, 0xef, 0x02,
-		0x24, 0x4a, 0x95, 0x4a, 0x75, 0x53, 0xbc, 0xa2, 0x59, 0xde, 0x50, 0x1a, 0x52, 0x94, 0x54, 0x2b,
-		0xd5, 0x6b, 0xd5, 0x4a, 0x43, 0x8a, 0xa1, 0x29, 0xc8, 0xb1, 0xdf, 0xea, 0x95, 0x0d, 0x65, 0xad,
-		0xd4, 0x90, 0xe2, 0xbe, 0xaa, 0x7a, 0x75, 0x7d, 0xb9, 0xaa, 0x48, 0x93, 0xf2, 0x93, 0x70, 0x72,
-		0xa4, 0x97, 0xe5, 0x65, 0x17, 0x22, 0xbe, 0xec, 0x82, 0xfc, 0xb1, 0x28, 0x14, 0x47, 0xbb, 0x4e,
-		0xe8, 0x5a, 0xdf, 0xc4, 0x2f, 0x1c, 0xc1, 0xef, 0xea, 0x9b, 0x3d, 0x7a, 0x18, 0xf2, 0x5d, 0xbc,
-		0x83, 0x1d, 0x7d, 0x8f, 0xb9, 0x72, 0xcc, 0x64, 0xe6, 0x94, 0x1c, 0xaf, 0xa5, 0x48, 0x36, 0x03,
-		0x7b, 0x15, 0xeb, 0x8e, 0xca, 0x74, 0x91, 0xcd, 0xff, 0xb2, 0x68, 0x8e, 0xd5, 0xd6, 0x59, 0xa5,
-		0xfc, 0x6d, 0x47, 0xe2, 0x65, 0x1a, 0x26, 0x95, 0x6a, 0x43, 0x79, 0x59, 0x8a, 0x21, 0x04, 0x79,
-		0xfa, 0x53, 0xad, 0xaf, 0x97, 0x36, 0xeb, 0x57, 0x37, 0x08, 0x2f, 0xa7, 0xa1, 0x20, 0x78, 0x29,
-		0x2a, 0x27, 0xe5, 0x47, 0xe1, 0xc4, 0x08, 0xbf, 0x6f, 0xc8, 0x3d, 0xc4, 0x8f, 0x47, 0xfc, 0xd0,
-		0xc1, 0x98, 0x7f, 0x03, 0x12, 0xb6, 0xa3, 0x39, 0x3d, 0x9b, 0x33, 0xf1, 0x99, 0x71, 0x1d, 0xc1,
-		0x45, 0xf1, 0xa3, 0x4e, 0xd1, 0x15, 0x4e, 0x46, 0xbe, 0x08, 0xf9, 0x60, 0xcb, 0x68, 0x1e, 0x78,
-		0x42, 0x14, 0x95, 0x5f, 0x06, 0xf0, 0xe5, 0x23, 0xdd, 0x1b, 0x5d, 0x11, 0xff, 0x8d, 0xae, 0x8b,
-		0x30, 0x79, 0xcb, 0x62, 0x3a, 0x63, 0xf8, 0xc6, 0xb9, 0x61, 0x39, 0xd8, 0x97, 0x7c, 0x60, 0xd0,
-		0xb2, 0x01, 0x68, 0x30, 0x27, 0x34, 0xa2, 0x8b, 0xe7, 0x83, 0x5d, 0x3c, 0x30, 0x32, 0xbb, 0x34,
-		0xbc, 0xab, 0xd7, 0x60, 0x92, 0x6a, 0x9b, 0xa1, 0x9f, 0xf8, 0x7c, 0x2b, 0x80, 0xe6, 0x38, 0x5d,
-		0x63, 0xbb, 0xe7, 0x75, 0x30, 0x3f, 0x5c, 0x5b, 0x95, 0x04, 0x5c, 0xf9, 0x3e, 0xae, 0xb6, 0x66,
-		0x3c, 0x54, 0x9f, 0xea, 0xf2, 0x11, 0x94, 0xd7, 0x21, 0x1f, 0xc4, 0x1d, 0xfe, 0xc9, 0x92, 0xf7,
-		0x76, 0x40, 0x5a, 0xb8, 0x4f, 0xae, 0xf3, 0xc5, 0xdf, 0xf3, 0xa0, 0x05, 0xf9, 0x4e, 0x04, 0x52,
-		0x8d, 0x7d, 0x2e, 0xc7, 0x87, 0x5c, 0xaa, 0xf4, 0xbe, 0xdb, 0x72, 0x93, 0x85, 0x2c, 0x1f, 0x1b,
-		0x73, 0xb3, 0xbc, 0xdf, 0xe4, 0xee, 0xd4, 0xf8, 0xb8, 0xd1, 0xae, 0xc8, 0x76, 0x73, 0xed, 0x74,
-		0x79, 0xbc, 0x6f, 0x24, 0x66, 0x60, 0xd2, 0xff, 0x7d, 0x03, 0x2b, 0xc8, 0x4d, 0xdf, 0xb5, 0x04,
-		0x66, 0x36, 0xfc, 0x1f, 0x53, 0x44, 0x8e, 0xfc, 0x31, 0x85, 0xdb, 0x4b, 0xd4, 0xdf, 0xcb, 0x2d,
-		0x48, 0x09, 0xa1, 0x40, 0xef, 0xf7, 0xdf, 0x3d, 0x11, 0x67, 0x34, 0x23, 0x8d, 0x27, 0x27, 0xef,
-		0xbb, 0x7a, 0x72, 0x0e, 0xa6, 0xf8, 0x95, 0x3b, 0x2f, 0xae, 0xe0, 0x7f, 0x72, 0xa0, 0xc0, 0x1a,
-		0x56, 0x45, 0x50, 0x21, 0xff, 0x74, 0x04, 0xa4, 0x7e, 0xa9, 0x7c, 0x2f, 0x07, 0x40, 0x94, 0x22,
-		0x91, 0x7e, 0xdf, 0x9b, 0xdf, 0x6c, 0xe5, 0x73, 0xa4, 0xd6, 0x7b, 0xf5, 0xfb, 0xc3, 0x51, 0xc8,
-		0xf8, 0x72, 0x7a, 0xe8, 0xe9, 0xc0, 0x15, 0xd0, 0x85, 0xc3, 0xf2, 0x7f, 0xbe, 0x3b, 0xa0, 0x81,
-		0x89, 0x45, 0x8f, 0x3e, 0xb1, 0x77, 0xfe, 0x92, 0xfe, 0xf0, 0xaf, 0x7d, 0x26, 0x47, 0x7c, 0xed,
-		0xf3, 0x1d, 0x11, 0x48, 0xb9, 0xa6, 0xfb, 0xa8, 0xd9, 0xfc, 0xe3, 0x90, 0xe0, 0xd6, 0x89, 0xa5,
-		0xf3, 0x79, 0x69, 0x68, 0x2e, 0xb4, 0x08, 0x29, 0xf1, 0x87, 0xa2, 0x78, 0x20, 0xea, 0x96, 0xcf,
-		0x3d, 0x07, 0x19, 0xdf, 0xc1, 0x0a, 0xd1, 0x13, 0xeb, 0xd5, 0x17, 0xa5, 0x89, 0x62, 0xf2, 0xce,
-		0xdd, 0x85, 0xd8, 0x3a, 0xbe, 0x4d, 0x76, 0x98, 0x52, 0xad, 0x5c, 0xad, 0x56, 0xae, 0x4b, 0x91,
-		0x62, 0xe6, 0xce, 0xdd, 0x85, 0xa4, 0x82, 0x69, 0xfa, 0xea, 0xdc, 0x75, 0x28, 0xf4, 0x2d, 0x4c,
-		0x50, 0xbf, 0x23, 0xc8, 0x2f, 0x6f, 0x6d, 0xae, 0xd6, 0x2a, 0xa5, 0x46, 0x55, 0x65, 0xd7, 0xe9,
-		0xd0, 0x09, 0x98, 0x5e, 0xad, 0xad, 0x5c, 0x6d, 0xa8, 0x95, 0xd5, 0x5a, 0x75, 0xbd, 0xa1, 0x96,
-		0x1a, 0x8d, 0x52, 0xe5, 0xba, 0x14, 0xbd, 0xf0, 0x55, 0x80, 0x42, 0xa9, 0x5c, 0xa9, 0x11, 0xfb,
-		0x6c, 0xf0, 0x67, 0xdb, 0x2b, 0x10, 0xa7, 0xa9, 0x80, 0x43, 0xaf, 0x8a, 0x14, 0x0f, 0xcf, 0x6d,
-		0xa2, 0x2b, 0x30, 0x49, 0xb3, 0x04, 0xe8, 0xf0, 0xbb, 0x23, 0xc5, 0x90, 0x64, 0x27, 0x19, 0x0c,
-		0xdd, 0x4e, 0x87, 0x5e, 0x26, 0x29, 0x1e, 0x9e, 0xfb, 0x44, 0x0a, 0xa4, 0xbd, 0x28, 0x23, 0xfc,
-		0x72, 0x45, 0x71, 0x0c, 0xed, 0x88, 0x56, 0x21, 0x29, 0x02, 0xc3, 0xb0, 0xeb, 0x1e, 0xc5, 0xd0,
-		0xe4, 0x24, 0x61, 0x17, 0x0b, 0xe0, 0x0f, 0xbf, 0xbb, 0x52, 0x0c, 0xc9, 0xb4, 0xa2, 0x9a, 0x7b,
-		0x09, 0x3f, 0xe4, 0x0a, 0x47, 0x31, 0x2c, 0xd9, 0x48, 0x98, 0xe6, 0xa5, 0x46, 0xc2, 0x6f, 0xe4,
-		0x14, 0xc7, 0x48, 0x22, 0xa3, 0x2d, 0x00, 0x5f, 0xb8, 0x3e, 0xc6, 0x55, 0x9b, 0xe2, 0x38, 0xc9,
-		0x61, 0xb4, 0x01, 0x29, 0x37, 0x7a, 0x0a, 0xbd, 0xf8, 0x52, 0x0c, 0xcf, 0xd2, 0xa2, 0x0f, 0x42,
-		0x2e, 0x18, 0x35, 0x8c, 0x77, 0x9d, 0xa5, 0x38, 0x66, 0xfa, 0x95, 0xd0, 0x0f, 0x86, 0x10, 0xe3,
-		0x5d, 0x6f, 0x29, 0x8e, 0x99, 0x8d, 0x45, 0xaf, 0xc2, 0xd4, 0xa0, 0x8b, 0x3f, 0xfe, 0x6d, 0x97,
-		0xe2, 0x11, 0xf2, 0xb3, 0xa8, 0x0d, 0x68, 0x48, 0x68, 0x70, 0x84, 0xcb, 0x2f, 0xc5, 0xa3, 0xa4,
-		0x6b, 0x51, 0x13, 0x0a, 0xfd, 0xfe, 0xf6, 0xb8, 0x97, 0x61, 0x8a, 0x63, 0xa7, 0x6e, 0x59, 0x2f,
-		0x41, 0x3f, 0x7d, 0xdc, 0xcb, 0x31, 0xc5, 0xb1, 0x33, 0xb9, 0xe5, 0xd2, 0xc8, 0xfb, 0x8d, 0xa7,
-		0x0f, 0xbd, 0xdf, 0xe8, 0xdd, 0x58, 0x74, 0xef, 0x34, 0xfe, 0xf1, 0x13, 0xf0, 0x10, 0x7f, 0x4c,
-		0xc0, 0x76, 0xb4, 0x9b, 0x86, 0xb9, 0xeb, 0xbe, 0x0e, 0xc1, 0xcb, 0xfc, 0x72, 0xe3, 0x71, 0xfe,
-		0x60, 0x81, 0xa8, 0x0d, 0x79, 0x23, 0x62, 0xe4, 0xc3, 0x59, 0x61, 0x97, 0x90, 0xc3, 0xaf, 0x2e,
-		0x1e, 0xf2, 0xfe, 0x44, 0xc8, 0x2b, 0x17, 0x43, 0xde, 0xa7, 0x08, 0xb9, 0x84, 0x79, 0xd8, 0x7d,
-		0x4f, 0xf9, 0x07, 0x23, 0x90, 0xbf, 0x6a, 0xd8, 0x8e, 0xd5, 0x35, 0x74, 0xad, 0x45, 0x2d, 0xc6,
-		0xe5, 0x71, 0x3f, 0xf2, 0x28, 0xa7, 0x89, 0x33, 0xc2, 0x1f, 0xb5, 0xe0, 0x17, 0x43, 0x96, 0x21,
-		0x71, 0x4b, 0x6b, 0xb1, 0x4f, 0x2c, 0xfc, 0xef, 0xd7, 0xf4, 0xf3, 0xdc, 0xe7, 0x25, 0xf9, 0xa9,
-		0x30, 0x5c, 0xf9, 0x53, 0xf4, 0x12, 0x77, 0xbb, 0x6d, 0xd8, 0xec, 0x4f, 0xb9, 0x3b, 0xd8, 0x46,
-		0x5b, 0x10, 0xef, 0x6a, 0x0e, 0x0f, 0x6a, 0xca, 0x25, 0xfe, 0x14, 0xc5, 0x23, 0xe1, 0x0f, 0x4a,
-		0x2c, 0x0e, 0xbc, 0x56, 0xc1, 0x3a, 0xa3, 0xe4, 0xd0, 0xb7, 0x40, 0xaa, 0xad, 0xed, 0xab, 0x94,
-		0x74, 0xf4, 0x9d, 0x22, 0x9d, 0x6c, 0x6b, 0xfb, 0x64, 0xd4, 0xc8, 0x80, 0x02, 0xa1, 0xae, 0xef,
-		0x69, 0xe6, 0x2e, 0x66, 0x9d, 0xc4, 0xde, 0xa9, 0x4e, 0x72, 0x6d, 0x6d, 0xbf, 0x42, 0x09, 0x93,
-		0xae, 0xf8, 0xa3, 0x1f, 0xbf, 0x15, 0xe1, 0xa1, 0x2c, 0xe5, 0x1c, 0xd2, 0x40, 0xd2, 0xdd, 0x12,
-		0xed, 0x5f, 0xa4, 0xf5, 0x4f, 0x8f, 0x5a, 0x98, 0x3e, 0xbe, 0x97, 0x73, 0x64, 0xa4, 0x9f, 0x7d,
-		0x73, 0x3e, 0xc2, 0x7a, 0x2d, 0xe8, 0x7d, 0xeb, 0x72, 0x0d, 0x32, 0x2c, 0x9b, 0xa6, 0x52, 0x17,
-		0x36, 0x1a, 0xea, 0xc2, 0xe6, 0x84, 0x0b, 0xcb, 0x08, 0x02, 0xc3, 0x26, 0xed, 0x7c, 0x0e, 0x9f,
-		0x8c, 0x40, 0x66, 0xd9, 0xf7, 0x5c, 0xd5, 0x2c, 0x24, 0xdb, 0x96, 0x69, 0xdc, 0xc4, 0x5d, 0xf7,
-		0x5c, 0x86, 0x15, 0x89, 0x9b, 0xc9, 0xfe, 0xea, 0x91, 0x73, 0x20, 0x5e, 0x62, 0x10, 0x65, 0x82,
-		0x75, 0x1b, 0x6f, 0xdb, 0x86, 0x60, 0xb9, 0x22, 0x8a, 0xe8, 0x2c, 0x48, 0x36, 0xd6, 0x7b, 0x5d,
-		0xc3, 0x39, 0x50, 0x75, 0xcb, 0x74, 0x34, 0xdd, 0xe1, 0xd9, 0xd3, 0x82, 0xa8, 0xaf, 0xb0, 0x6a,
-		0x42, 0xa4, 0x89, 0x1d, 0xcd, 0x68, 0xb1, 0x6b, 0x5f, 0x69, 0x45, 0x14, 0xf9, 0x50, 0x3f, 0x95,
-		0xf4, 0x87, 0x86, 0x15, 0x90, 0xac, 0x0e, 0xee, 0x06, 0x3e, 0x79, 0x63, 0xe2, 0x3a, 0xfb, 0x7b,
-		0xbf, 0xfc, 0xf8, 0x0c, 0x67, 0x38, 0x3f, 0x80, 0x67, 0xaf, 0x33, 0x2b, 0x05, 0x81, 0x21, 0xbe,
-		0x85, 0x7b, 0x39, 0x70, 0x12, 0xd3, 0xdb, 0xf6, 0x3e, 0xb9, 0x9f, 0x19, 0x60, 0x6a, 0xc9, 0x3c,
-		0x28, 0xcf, 0xfe, 0x8e, 0x47, 0xda, 0x0b, 0x1d, 0xaf, 0xe3, 0x03, 0xff, 0xb1, 0x0c, 0x25, 0x43,
-		0x3c, 0xf8, 0x57, 0x35, 0xa3, 0x25, 0xfe, 0x40, 0x9c, 0xc2, 0x4b, 0x68, 0xc9, 0x4d, 0xc3, 0xc4,
-		0x69, 0x38, 0x24, 0x8f, 0x92, 0x8d, 0xb2, 0x65, 0x36, 0x83, 0x19, 0x17, 0xd4, 0x80, 0x84, 0x63,
-		0xdd, 0xc4, 0x26, 0x67, 0x50, 0xf9, 0x7d, 0x47, 0x10, 0xec, 0xc1, 0xf7, 0x67, 0x38, 0x2d, 0xb4,
-		0x0b, 0x52, 0x13, 0xb7, 0xf0, 0x2e, 0xfb, 0x60, 0x6b, 0x4f, 0xeb, 0x62, 0xf6, 0x11, 0xe7, 0xd1,
-		0xe8, 0x0f, 0x3e, 0x53, 0x53, 0x70, 0xa9, 0xd6, 0x29, 0x51, 0xb4, 0x19, 0x7c, 0x2c, 0x2d, 0xc9,
-		0x4f, 0xe5, 0x47, 0xcc, 0xdf, 0x27, 0x95, 0x7e, 0xb5, 0x15, 0x78, 0x5c, 0xed, 0x2c, 0x48, 0x3d,
-		0x73, 0xdb, 0x32, 0xe9, 0xdf, 0x5c, 0xe2, 0x01, 0x56, 0x8a, 0x9d, 0xdc, 0xb9, 0xf5, 0xfc, 0xe4,
-		0x6e, 0x13, 0xf2, 0x1e, 0x28, 0xdd, 0x3d, 0xe9, 0xa3, 0xee, 0x9e, 0x9c, 0x4b, 0x80, 0x80, 0xa0,
-		0x35, 0x00, 0x6f, 0x7f, 0xba, 0xa7, 0xfb, 0xa1, 0x3b, 0xdd, 0x3f, 0x19, 0x1f, 0x01, 0xd4, 0x82,
-		0xe9, 0xb6, 0x61, 0xaa, 0x36, 0x6e, 0xed, 0xa8, 0x9c, 0x73, 0x84, 0x6e, 0xe6, 0x1d, 0x58, 0xe9,
-		0xa9, 0xb6, 0x61, 0xd6, 0x71, 0x6b, 0x67, 0xd9, 0x25, 0x8b, 0xde, 0x07, 0xa7, 0x3c, 0x76, 0x58,
-		0xa6, 0xba, 0x67, 0xb5, 0x9a, 0x6a, 0x17, 0xef, 0xa8, 0x3a, 0x7d, 0xfe, 0x28, 0x4b, 0x99, 0x78,
-		0xc2, 0x05, 0xd9, 0x30, 0xaf, 0x5a, 0xad, 0xa6, 0x82, 0x77, 0x2a, 0xa4, 0x19, 0x3d, 0x08, 0x1e,
-		0x2f, 0x54, 0xa3, 0x69, 0xcf, 0xe6, 0x16, 0x62, 0x67, 0xe2, 0x4a, 0xd6, 0xad, 0xac, 0x35, 0xed,
-		0xa5, 0xd4, 0x47, 0xde, 0x98, 0x9f, 0xf8, 0xc2, 0x1b, 0xf3, 0x13, 0xf2, 0x15, 0xfa, 0x58, 0x07,
-		0xdf, 0x74, 0xd8, 0x46, 0x97, 0x20, 0xad, 0x89, 0x02, 0xfb, 0x4c, 0xe4, 0x90, 0x4d, 0xeb, 0x81,
-		0xca, 0x6f, 0x44, 0x20, 0xb1, 0x7c, 0x63, 0x53, 0x33, 0xba, 0xa8, 0x0a, 0x53, 0x9e, 0xd0, 0x8e,
-		0xbb, 0xff, 0x3d, 0x39, 0x17, 0x0a, 0xa0, 0x3a, 0xea, 0xcb, 0xd9, 0x43, 0xc9, 0xf4, 0x7f, 0x53,
-		0xeb, 0x9b, 0xea, 0x35, 0x48, 0xb2, 0x11, 0xda, 0xe8, 0x05, 0x98, 0xec, 0x90, 0x1f, 0x3c, 0xfb,
-		0x3f, 0x37, 0x52, 0xd0, 0x29, 0xbc, 0x5f, 0x2c, 0x18, 0x9e, 0xfc, 0x97, 0x11, 0x80, 0xe5, 0x1b,
-		0x37, 0x1a, 0x5d, 0xa3, 0xd3, 0xc2, 0xce, 0x3b, 0x35, 0xe5, 0x55, 0x38, 0xe6, 0xfb, 0x3e, 0xb3,
-		0xab, 0x8f, 0x3d, 0xed, 0x69, 0xef, 0x0b, 0xcd, 0xae, 0x3e, 0x94, 0x5a, 0xd3, 0x76, 0x5c, 0x6a,
-		0xb1, 0xb1, 0xa9, 0x2d, 0xdb, 0xce, 0x20, 0x1f, 0x5f, 0x82, 0x8c, 0x37, 0x75, 0x1b, 0xd5, 0x20,
-		0xe5, 0xf0, 0xdf, 0x9c, 0x9d, 0xf2, 0x68, 0x76, 0x0a, 0x34, 0x3f, 0x4b, 0x5d, 0x74, 0xf9, 0x7f,
-		0x12, 0xae, 0x7a, 0x1b, 0xe1, 0xeb, 0x4a, 0x90, 0x88, 0x86, 0xe7, 0x1a, 0x38, 0xf6, 0x0e, 0x68,
-		0x60, 0x4e, 0xcb, 0xc7, 0xd6, 0xef, 0x8c, 0xc2, 0xf4, 0x96, 0xd8, 0xa4, 0x5f, 0xb7, 0x5c, 0xd8,
-		0x82, 0x24, 0x36, 0x9d, 0xae, 0x81, 0xc5, 0x51, 0xdf, 0x13, 0xa3, 0x16, 0x7b, 0xc8, 0x5c, 0xe8,
-		0x9f, 0xd7, 0xf6, 0x2f, 0xbd, 0xa0, 0xe5, 0x63, 0xc3, 0xaf, 0xc7, 0x60, 0x76, 0x14, 0x2a, 0x3a,
-		0x0d, 0x05, 0xbd, 0x8b, 0x69, 0x85, 0x1a, 0x48, 0x85, 0xe7, 0x45, 0x35, 0x37, 0x29, 0x0a, 0x10,
-		0x07, 0x8d, 0x48, 0x15, 0x01, 0xbd, 0x37, 0x8f, 0x2c, 0xef, 0x51, 0xa0, 0x46, 0x05, 0x43, 0x41,
-		0xdc, 0x6d, 0xdf, 0xd6, 0x5a, 0x9a, 0xa9, 0xe3, 0x7b, 0x90, 0x84, 0x41, 0x0b, 0x20, 0x2e, 0xcc,
-		0x97, 0x19, 0x4d, 0x74, 0x03, 0x92, 0x82, 0x7c, 0xfc, 0x1d, 0x20, 0x2f, 0x88, 0xa1, 0x07, 0x20,
-		0xeb, 0x37, 0x0c, 0xd4, 0x4f, 0x89, 0x2b, 0x19, 0x9f, 0x5d, 0x08, 0xb3, 0x3c, 0x89, 0x43, 0x2d,
-		0x0f, 0x77, 0x05, 0x3f, 0x1d, 0x83, 0x29, 0x05, 0x37, 0xbf, 0x01, 0x17, 0xee, 0x9b, 0x01, 0xd8,
-		0xa6, 0x26, 0xca, 0xf6, 0x1e, 0xd6, 0x6e, 0x50, 0x49, 0xa4, 0x19, 0xbd, 0x65, 0xdb, 0x79, 0xaf,
-		0x56, 0xef, 0x5f, 0x46, 0x21, 0xeb, 0x5f, 0xbd, 0x6f, 0x00, 0xcb, 0x86, 0xd6, 0x3d, 0x95, 0xc6,
-		0x6e, 0x9f, 0x9f, 0x1d, 0xa5, 0xd2, 0x06, 0xe4, 0x3a, 0x44, 0x97, 0x7d, 0x22, 0x06, 0x09, 0x7e,
-		0x15, 0x6c, 0x63, 0xc0, 0xc7, 0x8d, 0x84, 0x7d, 0xff, 0x9b, 0x13, 0xdf, 0xff, 0x0e, 0x75, 0x71,
-		0x1f, 0x86, 0x3c, 0x09, 0xa9, 0x03, 0xf7, 0xcb, 0x22, 0x67, 0x72, 0x34, 0x1c, 0xf6, 0x6e, 0x43,
-		0xa3, 0x79, 0xc8, 0x10, 0x30, 0x4f, 0x67, 0x13, 0x18, 0x68, 0x6b, 0xfb, 0x55, 0x56, 0x83, 0x1e,
-		0x07, 0xb4, 0xe7, 0x26, 0x3e, 0x54, 0x8f, 0x11, 0x04, 0x6e, 0xca, 0x6b, 0x11, 0xe0, 0xf7, 0x03,
-		0x90, 0x51, 0xa8, 0xec, 0x85, 0x4e, 0xfe, 0xda, 0x29, 0xa9, 0x59, 0xa6, 0xaf, 0x74, 0x7e, 0x7f,
-		0x84, 0xb9, 0xca, 0x7d, 0xd1, 0x36, 0x0f, 0x5a, 0xd4, 0xa3, 0xed, 0x86, 0xaf, 0xbc, 0x39, 0x5f,
-		0x3c, 0xd0, 0xda, 0xad, 0x25, 0x79, 0x08, 0x49, 0x79, 0x58, 0x2e, 0x80, 0x78, 0xd3, 0xc1, 0xc0,
-		0x7d, 0xe9, 0x21, 0x22, 0xd7, 0x77, 0x3e, 0xff, 0xf3, 0xe7, 0x4e, 0xf9, 0xba, 0xd8, 0x77, 0x33,
-		0x64, 0x6c, 0x79, 0xe4, 0x9f, 0x8d, 0x00, 0xf2, 0x8c, 0x8d, 0x7b, 0x1f, 0x7c, 0x8d, 0xde, 0x12,
-		0x16, 0xfe, 0x7e, 0xe4, 0xf0, 0x38, 0xc2, 0xc3, 0x0f, 0xc4, 0x11, 0xbe, 0xcd, 0xf4, 0x7e, 0x4f,
-		0xb5, 0x8b, 0xaf, 0xbf, 0x87, 0xbc, 0xd5, 0xba, 0x58, 0xb1, 0x8c, 0x00, 0x09, 0x81, 0x44, 0xf7,
-		0xe8, 0x84, 0xfc, 0x66, 0x04, 0x4e, 0x0e, 0x48, 0xa2, 0x3b, 0x64, 0x1d, 0x50, 0xd7, 0xd7, 0x48,
-		0x57, 0x54, 0x9c, 0xaf, 0xde, 0x9b, 0x60, 0x4f, 0x75, 0x07, 0xd4, 0xf9, 0xbb, 0x64, 0xa3, 0xb8,
-		0x12, 0xfa, 0xed, 0x08, 0xcc, 0xf8, 0x47, 0xe4, 0xce, 0xad, 0x0e, 0x59, 0xff, 0x58, 0xf8, 0xac,
-		0x1e, 0x1a, 0x67, 0x56, 0xfe, 0x09, 0x05, 0x88, 0x90, 0xb9, 0x08, 0xa9, 0x67, 0xb9, 0xba, 0x27,
-		0xc7, 0xe6, 0x92, 0x7b, 0x0e, 0x31, 0x4c, 0x0d, 0xb0, 0xc5, 0xfa, 0xbe, 0x28, 0xc4, 0x37, 0x2d,
-		0xab, 0x85, 0xbe, 0x23, 0x02, 0x53, 0xa6, 0xe5, 0xa8, 0x64, 0xaf, 0xe0, 0xa6, 0xca, 0x93, 0x05,
-		0x4c, 0x93, 0xde, 0x38, 0x1a, 0xf7, 0xbe, 0xf8, 0xe6, 0xfc, 0x20, 0xa9, 0x61, 0x4f, 0xec, 0x16,
-		0x4c, 0xcb, 0x29, 0x53, 0xa0, 0x06, 0xcb, 0x27, 0xdc, 0x86, 0x5c, 0xb0, 0x7f, 0xa6, 0x7e, 0x95,
-		0x23, 0xf7, 0x9f, 0x0b, 0xed, 0x3b, 0xbb, 0xed, 0xeb, 0x98, 0x3d, 0x74, 0xf9, 0x67, 0x64, 0x71,
-		0x5f, 0x06, 0xe9, 0x46, 0xff, 0xcd, 0xb4, 0x2a, 0x24, 0x8f, 0x7a, 0xc9, 0xcd, 0xcf, 0x71, 0x8e,
-		0x7b, 0xee, 0x57, 0x22, 0x00, 0x5e, 0x6a, 0x06, 0x3d, 0x06, 0x27, 0xca, 0x1b, 0xeb, 0xcb, 0x6a,
-		0xbd, 0x51, 0x6a, 0x6c, 0xd5, 0x83, 0xef, 0xb2, 0x8b, 0xb7, 0x48, 0xec, 0x0e, 0xd6, 0x8d, 0x1d,
-		0x03, 0x37, 0xd1, 0x23, 0x30, 0x13, 0x84, 0x26, 0xa5, 0xea, 0xb2, 0x14, 0x29, 0x66, 0xef, 0xdc,
-		0x5d, 0x48, 0x31, 0x97, 0x14, 0x37, 0xd1, 0x19, 0x38, 0x36, 0x08, 0x57, 0x5b, 0x5f, 0x91, 0xa2,
-		0xc5, 0xdc, 0x9d, 0xbb, 0x0b, 0x69, 0xd7, 0x77, 0x45, 0x32, 0x20, 0x3f, 0x24, 0xa7, 0x17, 0x2b,
-		0xc2, 0x9d, 0xbb, 0x0b, 0x09, 0xb6, 0x2c, 0xc5, 0xf8, 0x47, 0x7e, 0x6a, 0x6e, 0xe2, 0xdc, 0xb7,
-		0x02, 0xd4, 0xcc, 0x9d, 0xae, 0x46, 0xff, 0x38, 0x31, 0x2a, 0xc2, 0xf1, 0xda, 0xfa, 0x15, 0xa5,
-		0x54, 0x69, 0xd4, 0x36, 0xd6, 0xfb, 0x9e, 0x93, 0x0f, 0xb6, 0x2d, 0x6f, 0x6c, 0x95, 0x57, 0xab,
-		0x6a, 0xbd, 0xb6, 0xb2, 0xce, 0x4e, 0x78, 0x03, 0x6d, 0x2f, 0xae, 0x37, 0x6a, 0x6b, 0x55, 0x29,
-		0x5a, 0xbe, 0x32, 0xf2, 0x8c, 0xe1, 0xb1, 0x43, 0x17, 0xdc, 0xd3, 0x92, 0x81, 0x83, 0x86, 0xff,
-		0x1b, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xcb, 0xff, 0x85, 0xa2, 0x9a, 0x00, 0x00,
+		// 11351 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x90, 0x5c, 0xc7,
+		0x75, 0x18, 0xbc, 0xf3, 0xd8, 0xd9, 0x99, 0x33, 0xaf, 0xbb, 0xbd, 0x0b, 0x70, 0x30, 0x20, 0x81,
+		0xe5, 0xa5, 0x48, 0x82, 0xa0, 0xb8, 0x20, 0x41, 0x02, 0x14, 0x97, 0xa2, 0xa8, 0xd9, 0xd9, 0x01,
+		0x30, 0xe0, 0xbe, 0x74, 0x67, 0x17, 0x22, 0x61, 0x4b, 0xd7, 0x77, 0x67, 0x7a, 0x77, 0x2f, 0x31,
+		0x73, 0xef, 0x68, 0xee, 0x1d, 0x00, 0xcb, 0x72, 0x7d, 0x25, 0x5b, 0xb6, 0x3f, 0x1b, 0x71, 0x6c,
+		0x39, 0x4a, 0xd9, 0xb2, 0x2d, 0x38, 0x92, 0x1d, 0xdb, 0xb2, 0xf3, 0xf2, 0x43, 0xf1, 0x23, 0x2e,
+		0xbb, 0xec, 0x3c, 0x6d, 0xc7, 0x95, 0x92, 0x5d, 0xae, 0xc4, 0x95, 0x4a, 0x18, 0x5b, 0x72, 0xc5,
+		0x8a, 0x2d, 0xc7, 0x8f, 0xd0, 0x49, 0x1c, 0x55, 0x1e, 0x75, 0xfa, 0x71, 0x1f, 0xf3, 0xd8, 0x3b,
+		0x0b, 0x81, 0x8a, 0x53, 0xfa, 0x03, 0xcc, 0xed, 0x3e, 0xe7, 0x74, 0xf7, 0xe9, 0xd3, 0xa7, 0xcf,
+		0x39, 0x7d, 0xba, 0x17, 0x7e, 0x6f, 0x19, 0x16, 0xf6, 0x6c, 0x7b, 0xaf, 0x4d, 0xcf, 0x75, 0x7b,
+		0xb6, 0x6b, 0xef, 0xf4, 0x77, 0xcf, 0xb5, 0xa8, 0xd3, 0xec, 0x99, 0x5d, 0xd7, 0xee, 0x2d, 0xb2,
+		0x32, 0x52, 0xe4, 0x10, 0x8b, 0x12, 0x42, 0x5d, 0x83, 0xd9, 0x4b, 0x66, 0x9b, 0xae, 0x78, 0x80,
+		0x0d, 0xea, 0x92, 0x77, 0x41, 0x72, 0xd7, 0x6c, 0xd3, 0x52, 0x6c, 0x21, 0x71, 0x26, 0x7b, 0xfe,
+		0x1d, 0x8b, 0x03, 0x48, 0x8b, 0x61, 0x8c, 0x4d, 0x2c, 0xd6, 0x18, 0x86, 0xfa, 0xbf, 0x92, 0x30,
+		0x37, 0xa2, 0x96, 0x10, 0x48, 0x5a, 0x46, 0x07, 0x29, 0xc6, 0xce, 0x64, 0x34, 0xf6, 0x9b, 0x94,
+		0x60, 0xa6, 0x6b, 0x34, 0x6f, 0x18, 0x7b, 0xb4, 0x14, 0x67, 0xc5, 0xf2, 0x93, 0x9c, 0x02, 0x68,
+		0xd1, 0x2e, 0xb5, 0x5a, 0xd4, 0x6a, 0x1e, 0x94, 0x12, 0x0b, 0x89, 0x33, 0x19, 0x2d, 0x50, 0x42,
+		0x9e, 0x84, 0xd9, 0x6e, 0x7f, 0xa7, 0x6d, 0x36, 0xf5, 0x00, 0x18, 0x2c, 0x24, 0xce, 0x4c, 0x6b,
+		0x0a, 0xaf, 0x58, 0xf1, 0x81, 0x1f, 0x87, 0xe2, 0x2d, 0x6a, 0xdc, 0x08, 0x82, 0x66, 0x19, 0x68,
+		0x01, 0x8b, 0x03, 0x80, 0x55, 0xc8, 0x75, 0xa8, 0xe3, 0x18, 0x7b, 0x54, 0x77, 0x0f, 0xba, 0xb4,
+		0x94, 0x64, 0xa3, 0x5f, 0x18, 0x1a, 0xfd, 0xe0, 0xc8, 0xb3, 0x02, 0x6b, 0xeb, 0xa0, 0x4b, 0x49,
+		0x05, 0x32, 0xd4, 0xea, 0x77, 0x38, 0x85, 0xe9, 0x31, 0xfc, 0xab, 0x59, 0xfd, 0xce, 0x20, 0x95,
+		0x34, 0xa2, 0x09, 0x12, 0x33, 0x0e, 0xed, 0xdd, 0x34, 0x9b, 0xb4, 0x94, 0x62, 0x04, 0x1e, 0x1f,
+		0x22, 0xd0, 0xe0, 0xf5, 0x83, 0x34, 0x24, 0x1e, 0xa9, 0x42, 0x86, 0xde, 0x76, 0xa9, 0xe5, 0x98,
+		0xb6, 0x55, 0x9a, 0x61, 0x44, 0x1e, 0x1d, 0x31, 0x8b, 0xb4, 0xdd, 0x1a, 0x24, 0xe1, 0xe3, 0x91,
+		0x8b, 0x30, 0x63, 0x77, 0x5d, 0xd3, 0xb6, 0x9c, 0x52, 0x7a, 0x21, 0x76, 0x26, 0x7b, 0xfe, 0xc1,
+		0x91, 0x82, 0xb0, 0xc1, 0x61, 0x34, 0x09, 0x4c, 0xea, 0xa0, 0x38, 0x76, 0xbf, 0xd7, 0xa4, 0x7a,
+		0xd3, 0x6e, 0x51, 0xdd, 0xb4, 0x76, 0xed, 0x52, 0x86, 0x11, 0x38, 0x3d, 0x3c, 0x10, 0x06, 0x58,
+		0xb5, 0x5b, 0xb4, 0x6e, 0xed, 0xda, 0x5a, 0xc1, 0x09, 0x7d, 0x93, 0xe3, 0x90, 0x72, 0x0e, 0x2c,
+		0xd7, 0xb8, 0x5d, 0xca, 0x31, 0x09, 0x11, 0x5f, 0x28, 0x3a, 0xb4, 0x65, 0x62, 0x73, 0xa5, 0x3c,
+		0x17, 0x1d, 0xf1, 0xa9, 0xfe, 0x7c, 0x0a, 0x8a, 0x93, 0x08, 0xdf, 0x8b, 0x30, 0xbd, 0x8b, 0xe3,
+		0x2f, 0xc5, 0x8f, 0xc2, 0x1d, 0x8e, 0x13, 0x66, 0x6f, 0xea, 0x1e, 0xd9, 0x5b, 0x81, 0xac, 0x45,
+		0x1d, 0x97, 0xb6, 0xb8, 0xac, 0x24, 0x26, 0x94, 0x36, 0xe0, 0x48, 0xc3, 0xc2, 0x96, 0xbc, 0x27,
+		0x61, 0x7b, 0x15, 0x8a, 0x5e, 0x97, 0xf4, 0x9e, 0x61, 0xed, 0x49, 0xa9, 0x3d, 0x17, 0xd5, 0x93,
+		0xc5, 0x9a, 0xc4, 0xd3, 0x10, 0x4d, 0x2b, 0xd0, 0xd0, 0x37, 0x59, 0x01, 0xb0, 0x2d, 0x6a, 0xef,
+		0xea, 0x2d, 0xda, 0x6c, 0x97, 0xd2, 0x63, 0xb8, 0xb4, 0x81, 0x20, 0x43, 0x5c, 0xb2, 0x79, 0x69,
+		0xb3, 0x4d, 0x5e, 0xf0, 0x85, 0x70, 0x66, 0x8c, 0x0c, 0xad, 0xf1, 0xe5, 0x37, 0x24, 0x87, 0xdb,
+		0x50, 0xe8, 0x51, 0x5c, 0x11, 0xb4, 0x25, 0x46, 0x96, 0x61, 0x9d, 0x58, 0x8c, 0x1c, 0x99, 0x26,
+		0xd0, 0xf8, 0xc0, 0xf2, 0xbd, 0xe0, 0x27, 0x79, 0x04, 0xbc, 0x02, 0x9d, 0x89, 0x15, 0x30, 0xfd,
+		0x94, 0x93, 0x85, 0xeb, 0x46, 0x87, 0x96, 0xdf, 0x80, 0x42, 0x98, 0x3d, 0x64, 0x1e, 0xa6, 0x1d,
+		0xd7, 0xe8, 0xb9, 0x4c, 0x0a, 0xa7, 0x35, 0xfe, 0x41, 0x14, 0x48, 0x50, 0xab, 0xc5, 0xf4, 0xdf,
+		0xb4, 0x86, 0x3f, 0xc9, 0x7b, 0xfd, 0x01, 0x27, 0xd8, 0x80, 0x1f, 0x1b, 0x9e, 0xd1, 0x10, 0xe5,
+		0xc1, 0x71, 0x97, 0x9f, 0x87, 0x7c, 0x68, 0x00, 0x93, 0x36, 0xad, 0x7e, 0x3d, 0x1c, 0x1b, 0x49,
+		0x9a, 0xbc, 0x0a, 0xf3, 0x7d, 0xcb, 0xb4, 0x5c, 0xda, 0xeb, 0xf6, 0x28, 0x4a, 0x2c, 0x6f, 0xaa,
+		0xf4, 0x07, 0x33, 0x63, 0x64, 0x6e, 0x3b, 0x08, 0xcd, 0xa9, 0x68, 0x73, 0xfd, 0xe1, 0xc2, 0xb3,
+		0x99, 0xf4, 0x17, 0x66, 0x94, 0x0f, 0x7f, 0xf8, 0xc3, 0x1f, 0x8e, 0xab, 0xbf, 0x92, 0x82, 0xf9,
+		0x51, 0x6b, 0x66, 0xe4, 0xf2, 0x3d, 0x0e, 0x29, 0xab, 0xdf, 0xd9, 0xa1, 0x3d, 0xc6, 0xa4, 0x69,
+		0x4d, 0x7c, 0x91, 0x0a, 0x4c, 0xb7, 0x8d, 0x1d, 0xda, 0x2e, 0x25, 0x17, 0x62, 0x67, 0x0a, 0xe7,
+		0x9f, 0x9c, 0x68, 0x55, 0x2e, 0xae, 0x22, 0x8a, 0xc6, 0x31, 0xc9, 0x7b, 0x20, 0x29, 0x94, 0x37,
+		0x52, 0x38, 0x3b, 0x19, 0x05, 0x5c, 0x4b, 0x1a, 0xc3, 0x23, 0x27, 0x21, 0x83, 0xff, 0x73, 0xd9,
+		0x48, 0xb1, 0x3e, 0xa7, 0xb1, 0x00, 0xe5, 0x82, 0x94, 0x21, 0xcd, 0x96, 0x49, 0x8b, 0xca, 0x4d,
+		0xcf, 0xfb, 0x46, 0xc1, 0x6a, 0xd1, 0x5d, 0xa3, 0xdf, 0x76, 0xf5, 0x9b, 0x46, 0xbb, 0x4f, 0x99,
+		0xc0, 0x67, 0xb4, 0x9c, 0x28, 0xbc, 0x86, 0x65, 0xe4, 0x34, 0x64, 0xf9, 0xaa, 0x32, 0xad, 0x16,
+		0xbd, 0xcd, 0xf4, 0xea, 0xb4, 0xc6, 0x17, 0x5a, 0x1d, 0x4b, 0xb0, 0xf9, 0xd7, 0x1d, 0xdb, 0x92,
+		0xa2, 0xc9, 0x9a, 0xc0, 0x02, 0xd6, 0xfc, 0xf3, 0x83, 0x2a, 0xfd, 0xa1, 0xd1, 0xc3, 0x1b, 0x5a,
+		0x4b, 0x8f, 0x43, 0x91, 0x41, 0x3c, 0x2b, 0xa6, 0xde, 0x68, 0x97, 0x66, 0x17, 0x62, 0x67, 0xd2,
+		0x5a, 0x81, 0x17, 0x6f, 0x88, 0x52, 0xf5, 0x67, 0xe2, 0x90, 0x64, 0x8a, 0xa5, 0x08, 0xd9, 0xad,
+		0xd7, 0x36, 0x6b, 0xfa, 0xca, 0xc6, 0xf6, 0xf2, 0x6a, 0x4d, 0x89, 0x91, 0x02, 0x00, 0x2b, 0xb8,
+		0xb4, 0xba, 0x51, 0xd9, 0x52, 0xe2, 0xde, 0x77, 0x7d, 0x7d, 0xeb, 0xe2, 0x73, 0x4a, 0xc2, 0x43,
+		0xd8, 0xe6, 0x05, 0xc9, 0x20, 0xc0, 0xb3, 0xe7, 0x95, 0x69, 0xa2, 0x40, 0x8e, 0x13, 0xa8, 0xbf,
+		0x5a, 0x5b, 0xb9, 0xf8, 0x9c, 0x92, 0x0a, 0x97, 0x3c, 0x7b, 0x5e, 0x99, 0x21, 0x79, 0xc8, 0xb0,
+		0x92, 0xe5, 0x8d, 0x8d, 0x55, 0x25, 0xed, 0xd1, 0x6c, 0x6c, 0x69, 0xf5, 0xf5, 0xcb, 0x4a, 0xc6,
+		0xa3, 0x79, 0x59, 0xdb, 0xd8, 0xde, 0x54, 0xc0, 0xa3, 0xb0, 0x56, 0x6b, 0x34, 0x2a, 0x97, 0x6b,
+		0x4a, 0xd6, 0x83, 0x58, 0x7e, 0x6d, 0xab, 0xd6, 0x50, 0x72, 0xa1, 0x6e, 0x3d, 0x7b, 0x5e, 0xc9,
+		0x7b, 0x4d, 0xd4, 0xd6, 0xb7, 0xd7, 0x94, 0x02, 0x99, 0x85, 0x3c, 0x6f, 0x42, 0x76, 0xa2, 0x38,
+		0x50, 0x74, 0xf1, 0x39, 0x45, 0xf1, 0x3b, 0xc2, 0xa9, 0xcc, 0x86, 0x0a, 0x2e, 0x3e, 0xa7, 0x10,
+		0xb5, 0x0a, 0xd3, 0x4c, 0x0c, 0x09, 0x81, 0xc2, 0x6a, 0x65, 0xb9, 0xb6, 0xaa, 0x6f, 0x6c, 0x6e,
+		0xd5, 0x37, 0xd6, 0x2b, 0xab, 0x4a, 0xcc, 0x2f, 0xd3, 0x6a, 0xef, 0xdb, 0xae, 0x6b, 0xb5, 0x15,
+		0x25, 0x1e, 0x2c, 0xdb, 0xac, 0x55, 0xb6, 0x6a, 0x2b, 0x4a, 0x42, 0x6d, 0xc2, 0xfc, 0x28, 0x85,
+		0x3a, 0x72, 0x09, 0x05, 0x64, 0x21, 0x3e, 0x46, 0x16, 0x18, 0xad, 0x41, 0x59, 0x50, 0x3f, 0x1f,
+		0x87, 0xb9, 0x11, 0x9b, 0xca, 0xc8, 0x46, 0x5e, 0x86, 0x69, 0x2e, 0xcb, 0x7c, 0x9b, 0x7d, 0x62,
+		0xe4, 0xee, 0xc4, 0x24, 0x7b, 0x68, 0xab, 0x65, 0x78, 0x41, 0x23, 0x24, 0x31, 0xc6, 0x08, 0x41,
+		0x12, 0x43, 0x02, 0xfb, 0x81, 0x21, 0xe5, 0xcf, 0xf7, 0xc7, 0x8b, 0x93, 0xec, 0x8f, 0xac, 0xec,
+		0x68, 0x9b, 0xc0, 0xf4, 0x88, 0x4d, 0xe0, 0x45, 0x98, 0x1d, 0x22, 0x34, 0xb1, 0x32, 0xfe, 0x48,
+		0x0c, 0x4a, 0xe3, 0x98, 0x13, 0xa1, 0x12, 0xe3, 0x21, 0x95, 0xf8, 0xe2, 0x20, 0x07, 0x1f, 0x1e,
+		0x3f, 0x09, 0x43, 0x73, 0xfd, 0xa3, 0x31, 0x38, 0x3e, 0xda, 0xd8, 0x1c, 0xd9, 0x87, 0xf7, 0x40,
+		0xaa, 0x43, 0xdd, 0x7d, 0x5b, 0x9a, 0x55, 0x8f, 0x8d, 0xd8, 0xac, 0xb1, 0x7a, 0x70, 0xb2, 0x05,
+		0x16, 0x79, 0x61, 0xb0, 0xaf, 0xa7, 0xc7, 0x99, 0xbe, 0x43, 0x3d, 0xfd, 0xb6, 0x38, 0x1c, 0x1b,
+		0x49, 0x7c, 0x64, 0x47, 0x1f, 0x02, 0x30, 0xad, 0x6e, 0xdf, 0xe5, 0xa6, 0x13, 0xd7, 0xc4, 0x19,
+		0x56, 0xc2, 0x94, 0x17, 0x6a, 0xd9, 0xbe, 0xeb, 0xd5, 0x27, 0x58, 0x3d, 0xf0, 0x22, 0x06, 0xf0,
+		0x2e, 0xbf, 0xa3, 0x49, 0xd6, 0xd1, 0x53, 0x63, 0x46, 0x3a, 0x24, 0x98, 0x4f, 0x83, 0xd2, 0x6c,
+		0x9b, 0xd4, 0x72, 0x75, 0xc7, 0xed, 0x51, 0xa3, 0x63, 0x5a, 0x7b, 0x6c, 0xab, 0x49, 0x2f, 0x4d,
+		0xef, 0x1a, 0x6d, 0x87, 0x6a, 0x45, 0x5e, 0xdd, 0x90, 0xb5, 0x88, 0xc1, 0x04, 0xa8, 0x17, 0xc0,
+		0x48, 0x85, 0x30, 0x78, 0xb5, 0x87, 0xa1, 0x7e, 0x57, 0x06, 0xb2, 0x01, 0xd3, 0x9c, 0x3c, 0x0c,
+		0xb9, 0xd7, 0x8d, 0x9b, 0x86, 0x2e, 0xdd, 0x2d, 0xce, 0x89, 0x2c, 0x96, 0x6d, 0xf2, 0x22, 0xf2,
+		0x34, 0xcc, 0x33, 0x10, 0xbb, 0xef, 0xd2, 0x9e, 0xde, 0x6c, 0x1b, 0x8e, 0xc3, 0x98, 0x96, 0x66,
+		0xa0, 0x04, 0xeb, 0x36, 0xb0, 0xaa, 0x2a, 0x6b, 0xc8, 0x05, 0x98, 0x63, 0x18, 0x9d, 0x7e, 0xdb,
+		0x35, 0xbb, 0x6d, 0xaa, 0xa3, 0x03, 0xe8, 0x94, 0x20, 0xd8, 0xb3, 0x59, 0x84, 0x58, 0x13, 0x00,
+		0xd8, 0x23, 0x87, 0xac, 0xc0, 0x43, 0x0c, 0x6d, 0x8f, 0x5a, 0xb4, 0x67, 0xb8, 0x54, 0xa7, 0x1f,
+		0xea, 0x1b, 0x6d, 0x47, 0x37, 0xac, 0x96, 0xbe, 0x6f, 0x38, 0xfb, 0xa5, 0x79, 0x24, 0xb0, 0x1c,
+		0x2f, 0xc5, 0xb4, 0x13, 0x08, 0x78, 0x59, 0xc0, 0xd5, 0x18, 0x58, 0xc5, 0x6a, 0x5d, 0x31, 0x9c,
+		0x7d, 0xb2, 0x04, 0xc7, 0x19, 0x15, 0xc7, 0xed, 0x99, 0xd6, 0x9e, 0xde, 0xdc, 0xa7, 0xcd, 0x1b,
+		0x7a, 0xdf, 0xdd, 0x7d, 0x57, 0xe9, 0x64, 0xb0, 0x7d, 0xd6, 0xc3, 0x06, 0x83, 0xa9, 0x22, 0xc8,
+		0xb6, 0xbb, 0xfb, 0x2e, 0xd2, 0x80, 0x1c, 0x4e, 0x46, 0xc7, 0x7c, 0x83, 0xea, 0xbb, 0x76, 0x8f,
+		0xed, 0xa1, 0x85, 0x11, 0xaa, 0x29, 0xc0, 0xc1, 0xc5, 0x0d, 0x81, 0xb0, 0x66, 0xb7, 0xe8, 0xd2,
+		0x74, 0x63, 0xb3, 0x56, 0x5b, 0xd1, 0xb2, 0x92, 0xca, 0x25, 0xbb, 0x87, 0x02, 0xb5, 0x67, 0x7b,
+		0x0c, 0xce, 0x72, 0x81, 0xda, 0xb3, 0x25, 0x7b, 0x2f, 0xc0, 0x5c, 0xb3, 0xc9, 0xc7, 0x6c, 0x36,
+		0x75, 0xe1, 0xa6, 0x39, 0x25, 0x25, 0xc4, 0xac, 0x66, 0xf3, 0x32, 0x07, 0x10, 0x32, 0xee, 0x90,
+		0x17, 0xe0, 0x98, 0xcf, 0xac, 0x20, 0xe2, 0xec, 0xd0, 0x28, 0x07, 0x51, 0x2f, 0xc0, 0x5c, 0xf7,
+		0x60, 0x18, 0x91, 0x84, 0x5a, 0xec, 0x1e, 0x0c, 0xa2, 0x3d, 0x0f, 0xf3, 0xdd, 0xfd, 0xee, 0x30,
+		0xde, 0xd9, 0x20, 0x1e, 0xe9, 0xee, 0x77, 0x07, 0x11, 0x1f, 0x65, 0x3e, 0x7b, 0x8f, 0x36, 0x0d,
+		0x97, 0xb6, 0x4a, 0x0f, 0x04, 0xc1, 0x03, 0x15, 0x64, 0x11, 0x94, 0x66, 0x53, 0xa7, 0x96, 0xb1,
+		0xd3, 0xa6, 0xba, 0xd1, 0xa3, 0x96, 0xe1, 0x94, 0x4e, 0x33, 0xe0, 0xa4, 0xdb, 0xeb, 0x53, 0xad,
+		0xd0, 0x6c, 0xd6, 0x58, 0x65, 0x85, 0xd5, 0x91, 0xb3, 0x30, 0x6b, 0xef, 0xbc, 0xde, 0xe4, 0x12,
+		0xa9, 0x77, 0x7b, 0x74, 0xd7, 0xbc, 0x5d, 0x7a, 0x07, 0x63, 0x6f, 0x11, 0x2b, 0x98, 0x3c, 0x6e,
+		0xb2, 0x62, 0xf2, 0x04, 0x28, 0x4d, 0x67, 0xdf, 0xe8, 0x75, 0x99, 0x4a, 0x76, 0xba, 0x46, 0x93,
+		0x96, 0x1e, 0xe5, 0xa0, 0xbc, 0x7c, 0x5d, 0x16, 0xe3, 0x8a, 0x70, 0x6e, 0x99, 0xbb, 0xae, 0xa4,
+		0xf8, 0x38, 0x5f, 0x11, 0xac, 0x4c, 0x50, 0x3b, 0x03, 0x0a, 0x72, 0x22, 0xd4, 0xf0, 0x19, 0x06,
+		0x56, 0xe8, 0xee, 0x77, 0x83, 0xed, 0x3e, 0x02, 0xf9, 0xee, 0x7e, 0xb0, 0xd1, 0x27, 0xb8, 0xe1,
+		0xd6, 0xdd, 0x0f, 0xb4, 0xf8, 0x1c, 0x1c, 0x47, 0xa0, 0x0e, 0x75, 0x8d, 0x96, 0xe1, 0x1a, 0x01,
+		0xe8, 0x77, 0x32, 0x68, 0x64, 0xfb, 0x9a, 0xa8, 0x0c, 0xf5, 0xb3, 0xd7, 0xdf, 0x39, 0xf0, 0x04,
+		0xeb, 0x29, 0xde, 0x4f, 0x2c, 0x93, 0xa2, 0xf5, 0xb6, 0x19, 0xe7, 0xea, 0x12, 0xe4, 0x82, 0x72,
+		0x4f, 0x32, 0xc0, 0x25, 0x5f, 0x89, 0xa1, 0x11, 0x54, 0xdd, 0x58, 0x41, 0xf3, 0xe5, 0x7a, 0x4d,
+		0x89, 0xa3, 0x19, 0xb5, 0x5a, 0xdf, 0xaa, 0xe9, 0xda, 0xf6, 0xfa, 0x56, 0x7d, 0xad, 0xa6, 0x24,
+		0x02, 0x86, 0xfd, 0xd5, 0x64, 0xfa, 0x31, 0xe5, 0x71, 0xf5, 0x17, 0x12, 0x50, 0x08, 0x7b, 0x6a,
+		0xe4, 0xdd, 0xf0, 0x80, 0x0c, 0xb8, 0x38, 0xd4, 0xd5, 0x6f, 0x99, 0x3d, 0xb6, 0x20, 0x3b, 0x06,
+		0xdf, 0x1c, 0x3d, 0xf9, 0x99, 0x17, 0x50, 0x0d, 0xea, 0xbe, 0xdf, 0xec, 0xe1, 0x72, 0xeb, 0x18,
+		0x2e, 0x59, 0x85, 0xd3, 0x96, 0xad, 0x3b, 0xae, 0x61, 0xb5, 0x8c, 0x5e, 0x4b, 0xf7, 0x43, 0x5d,
+		0xba, 0xd1, 0x6c, 0x52, 0xc7, 0xb1, 0xf9, 0x46, 0xe8, 0x51, 0x79, 0xd0, 0xb2, 0x1b, 0x02, 0xd8,
+		0xdf, 0x21, 0x2a, 0x02, 0x74, 0x40, 0x7c, 0x13, 0xe3, 0xc4, 0xf7, 0x24, 0x64, 0x3a, 0x46, 0x57,
+		0xa7, 0x96, 0xdb, 0x3b, 0x60, 0xf6, 0x79, 0x5a, 0x4b, 0x77, 0x8c, 0x6e, 0x0d, 0xbf, 0xc9, 0x35,
+		0x78, 0xcc, 0x07, 0xd5, 0xdb, 0x74, 0xcf, 0x68, 0x1e, 0xe8, 0xcc, 0x18, 0x67, 0x61, 0x03, 0xbd,
+		0x69, 0x5b, 0xbb, 0x6d, 0xb3, 0xe9, 0x3a, 0xa5, 0xac, 0xa7, 0xe3, 0x54, 0x1f, 0x63, 0x95, 0x21,
+		0x5c, 0x75, 0x6c, 0x8b, 0xd9, 0xe0, 0x55, 0x09, 0xfd, 0x15, 0x71, 0xbf, 0xae, 0x26, 0xd3, 0x49,
+		0x65, 0xfa, 0x6a, 0x32, 0x3d, 0xad, 0xa4, 0xae, 0x26, 0xd3, 0x29, 0x65, 0xe6, 0x6a, 0x32, 0x9d,
+		0x56, 0x32, 0x57, 0x93, 0xe9, 0x8c, 0x02, 0xea, 0xcf, 0xa6, 0x21, 0x17, 0xf4, 0x0c, 0xd0, 0xd1,
+		0x6a, 0xb2, 0xbd, 0x31, 0xc6, 0xb4, 0xe7, 0x23, 0x87, 0xfa, 0x11, 0x8b, 0x55, 0xdc, 0x34, 0x97,
+		0x52, 0xdc, 0x0c, 0xd7, 0x38, 0x26, 0x1a, 0x2c, 0x28, 0xd6, 0x94, 0x9b, 0x3d, 0x69, 0x4d, 0x7c,
+		0x91, 0xcb, 0x90, 0x7a, 0xdd, 0x61, 0xb4, 0x53, 0x8c, 0xf6, 0x3b, 0x0e, 0xa7, 0x7d, 0xb5, 0xc1,
+		0x88, 0x67, 0xae, 0x36, 0xf4, 0xf5, 0x0d, 0x6d, 0xad, 0xb2, 0xaa, 0x09, 0x74, 0x72, 0x02, 0x92,
+		0x6d, 0xe3, 0x8d, 0x83, 0xf0, 0xf6, 0xca, 0x8a, 0xc8, 0x22, 0x14, 0xfb, 0xd6, 0x4d, 0xda, 0x33,
+		0x77, 0x4d, 0x9c, 0x2a, 0x84, 0x2a, 0x06, 0xa1, 0x0a, 0x7e, 0xed, 0x2a, 0xc2, 0x4f, 0x28, 0x1e,
+		0x27, 0x20, 0x89, 0x41, 0xc5, 0xf0, 0x26, 0xc8, 0x8a, 0xc8, 0x19, 0xc8, 0xb5, 0xe8, 0x4e, 0x7f,
+		0x4f, 0xef, 0xd1, 0x96, 0xd1, 0x74, 0xc3, 0xaa, 0x3f, 0xcb, 0xaa, 0x34, 0x56, 0x43, 0x5e, 0x81,
+		0x0c, 0xce, 0x91, 0xc5, 0xe6, 0x78, 0x96, 0xb1, 0xe0, 0xa9, 0xc3, 0x59, 0x20, 0xa6, 0x58, 0x22,
+		0x69, 0x3e, 0x3e, 0xb9, 0x04, 0x29, 0xd7, 0xe8, 0xed, 0x51, 0x97, 0x69, 0xfe, 0xc2, 0xf9, 0xc5,
+		0x49, 0x28, 0x6d, 0x31, 0x0c, 0xe6, 0xd3, 0x0a, 0xec, 0xb7, 0x51, 0xcb, 0x9c, 0x83, 0x69, 0x26,
+		0x1e, 0x04, 0x40, 0x08, 0x88, 0x32, 0x45, 0xd2, 0x90, 0xac, 0x6e, 0x68, 0xa8, 0x69, 0x14, 0xc8,
+		0xf1, 0x52, 0x7d, 0xb3, 0x5e, 0xab, 0xd6, 0x94, 0xb8, 0x7a, 0x01, 0x52, 0x7c, 0xce, 0x51, 0x0b,
+		0x79, 0xb3, 0xae, 0x4c, 0x89, 0x4f, 0x41, 0x23, 0x26, 0x6b, 0xb7, 0xd7, 0x96, 0x6b, 0x9a, 0x12,
+		0x57, 0xb7, 0xa1, 0x38, 0xc0, 0x27, 0x72, 0x0c, 0x66, 0xb5, 0xda, 0x56, 0x6d, 0x1d, 0xfd, 0x2c,
+		0x7d, 0x7b, 0xfd, 0x95, 0xf5, 0x8d, 0xf7, 0xaf, 0x2b, 0x53, 0xe1, 0x62, 0xa9, 0xd2, 0x62, 0x64,
+		0x1e, 0x14, 0xbf, 0xb8, 0xb1, 0xb1, 0xad, 0xb1, 0xde, 0x7c, 0x7b, 0x1c, 0x94, 0x41, 0xae, 0x91,
+		0x07, 0x60, 0x6e, 0xab, 0xa2, 0x5d, 0xae, 0x6d, 0xe9, 0xdc, 0x77, 0xf4, 0x48, 0xcf, 0x83, 0x12,
+		0xac, 0xb8, 0x54, 0x67, 0xae, 0xf1, 0x69, 0x38, 0x19, 0x2c, 0xad, 0xbd, 0xba, 0x55, 0x5b, 0x6f,
+		0xb0, 0xc6, 0x2b, 0xeb, 0x97, 0x51, 0xbf, 0x0e, 0xd0, 0x93, 0xde, 0x6a, 0x02, 0xbb, 0x1a, 0xa6,
+		0x57, 0x5b, 0x5d, 0x51, 0x92, 0x83, 0xc5, 0x1b, 0xeb, 0xb5, 0x8d, 0x4b, 0xca, 0xf4, 0x60, 0xeb,
+		0xcc, 0x83, 0x4d, 0x91, 0x32, 0x1c, 0x1f, 0x2c, 0xd5, 0x6b, 0xeb, 0x5b, 0xda, 0x6b, 0xca, 0xcc,
+		0x60, 0xc3, 0x8d, 0x9a, 0x76, 0xad, 0x5e, 0xad, 0x29, 0x69, 0x72, 0x1c, 0x48, 0xb8, 0x47, 0x5b,
+		0x57, 0x36, 0x56, 0x94, 0xcc, 0x90, 0x46, 0x51, 0x1d, 0xc8, 0x05, 0xdd, 0xc8, 0xaf, 0x4c, 0x2c,
+		0xe9, 0xe3, 0x71, 0xc8, 0x06, 0xdc, 0x42, 0xb4, 0xe7, 0x8d, 0x76, 0xdb, 0xbe, 0xa5, 0x1b, 0x6d,
+		0xd3, 0x70, 0x84, 0xbe, 0x01, 0x56, 0x54, 0xc1, 0x92, 0x49, 0xd7, 0xf7, 0xe4, 0x1a, 0x3e, 0xf5,
+		0x57, 0x51, 0xc3, 0x4f, 0x2b, 0x29, 0xf5, 0x07, 0x62, 0xa0, 0x0c, 0xfa, 0x7b, 0x03, 0xc3, 0x8f,
+		0x8d, 0x1b, 0xfe, 0x57, 0x64, 0xee, 0x3e, 0x11, 0x83, 0x42, 0xd8, 0xc9, 0x1b, 0xe8, 0xde, 0xc3,
+		0xff, 0x57, 0xbb, 0xf7, 0xbb, 0x71, 0xc8, 0x87, 0x5c, 0xbb, 0x49, 0x7b, 0xf7, 0x21, 0x98, 0x35,
+		0x5b, 0xb4, 0xd3, 0xb5, 0x5d, 0x3c, 0x6d, 0xd2, 0xdb, 0xf4, 0x26, 0x6d, 0x97, 0x54, 0xa6, 0x94,
+		0xcf, 0x1d, 0xee, 0x3c, 0x2e, 0xd6, 0x7d, 0xbc, 0x55, 0x44, 0x5b, 0x9a, 0xab, 0xaf, 0xd4, 0xd6,
+		0x36, 0x37, 0xb6, 0x6a, 0xeb, 0xd5, 0xd7, 0xa4, 0x76, 0xd1, 0x14, 0x73, 0x00, 0xec, 0x6d, 0x54,
+		0xda, 0x9b, 0xa0, 0x0c, 0x76, 0x0a, 0x75, 0xc5, 0x88, 0x6e, 0x29, 0x53, 0x64, 0x0e, 0x8a, 0xeb,
+		0x1b, 0x7a, 0xa3, 0xbe, 0x52, 0xd3, 0x6b, 0x97, 0x2e, 0xd5, 0xaa, 0x5b, 0x0d, 0x1e, 0x0e, 0xf4,
+		0xa0, 0xb7, 0x94, 0x78, 0x90, 0xc5, 0xdf, 0x97, 0x80, 0xb9, 0x11, 0x3d, 0x21, 0x15, 0xe1, 0xc8,
+		0xf3, 0xd8, 0xc2, 0x53, 0x93, 0xf4, 0x7e, 0x11, 0x4d, 0xe9, 0x4d, 0xa3, 0xe7, 0x0a, 0xbf, 0xff,
+		0x09, 0x40, 0x2e, 0x59, 0x2e, 0xee, 0xec, 0x3d, 0x11, 0x66, 0xe5, 0xde, 0x7d, 0xd1, 0x2f, 0xe7,
+		0x91, 0xd6, 0x77, 0x02, 0xe9, 0xda, 0x8e, 0xe9, 0x9a, 0x37, 0xf1, 0x0c, 0x4b, 0xc6, 0x64, 0xd1,
+		0xdb, 0x4f, 0x6a, 0x8a, 0xac, 0xa9, 0x5b, 0xae, 0x07, 0x6d, 0xd1, 0x3d, 0x63, 0x00, 0x1a, 0x2d,
+		0x8f, 0x84, 0xa6, 0xc8, 0x1a, 0x0f, 0xfa, 0x61, 0xc8, 0xb5, 0xec, 0x3e, 0xba, 0x40, 0x1c, 0x0e,
+		0xb5, 0x45, 0x4c, 0xcb, 0xf2, 0x32, 0x0f, 0x44, 0x38, 0xb7, 0x7e, 0x30, 0x38, 0xa7, 0x65, 0x79,
+		0x19, 0x07, 0x79, 0x1c, 0x8a, 0xc6, 0xde, 0x5e, 0x0f, 0x89, 0x4b, 0x42, 0xdc, 0x5d, 0x2f, 0x78,
+		0xc5, 0x0c, 0xb0, 0x7c, 0x15, 0xd2, 0x92, 0x0f, 0x68, 0xc1, 0x22, 0x27, 0xf4, 0x2e, 0x8f, 0x41,
+		0xc5, 0x31, 0x3e, 0x6c, 0xc9, 0xca, 0x87, 0x21, 0x67, 0x3a, 0xba, 0x7f, 0xb6, 0x15, 0x5f, 0x88,
+		0x9f, 0x49, 0x6b, 0x59, 0xd3, 0xf1, 0xce, 0x05, 0xd4, 0x1f, 0x8d, 0x43, 0x21, 0x7c, 0x6a, 0x47,
+		0x56, 0x20, 0xdd, 0xb6, 0x9b, 0x06, 0x13, 0x2d, 0x7e, 0x64, 0x7c, 0x26, 0xe2, 0xa0, 0x6f, 0x71,
+		0x55, 0xc0, 0x6b, 0x1e, 0x66, 0xf9, 0x5f, 0xc5, 0x20, 0x2d, 0x8b, 0xc9, 0x71, 0x48, 0x76, 0x0d,
+		0x77, 0x9f, 0x91, 0x9b, 0x5e, 0x8e, 0x2b, 0x31, 0x8d, 0x7d, 0x63, 0xb9, 0xd3, 0x35, 0xac, 0x52,
+		0xdc, 0x2f, 0xc7, 0x6f, 0x9c, 0xd7, 0x36, 0x35, 0x5a, 0x2c, 0x16, 0x60, 0x77, 0x3a, 0xd4, 0x72,
+		0x1d, 0x39, 0xaf, 0xa2, 0xbc, 0x2a, 0x8a, 0xf1, 0xf0, 0xd8, 0xed, 0x19, 0x66, 0x3b, 0x04, 0x9b,
+		0x64, 0xb0, 0x8a, 0xac, 0xf0, 0x80, 0x97, 0xe0, 0x84, 0xa4, 0xdb, 0xa2, 0xae, 0xd1, 0xdc, 0xa7,
+		0x2d, 0x1f, 0x29, 0xc5, 0x62, 0x7e, 0x0f, 0x08, 0x80, 0x15, 0x51, 0x2f, 0x71, 0xd5, 0xcf, 0xc6,
+		0x61, 0x56, 0x46, 0x2f, 0x5a, 0x1e, 0xb3, 0xd6, 0x00, 0x0c, 0xcb, 0xb2, 0xdd, 0x20, 0xbb, 0x86,
+		0x45, 0x79, 0x08, 0x6f, 0xb1, 0xe2, 0x21, 0x69, 0x01, 0x02, 0xe5, 0x3f, 0x8a, 0x01, 0xf8, 0x55,
+		0x63, 0xf9, 0x76, 0x1a, 0xb2, 0xe2, 0x4c, 0x96, 0x1d, 0xec, 0xf3, 0x80, 0x17, 0xf0, 0x22, 0x8c,
+		0x73, 0x60, 0x58, 0x72, 0x87, 0xee, 0x99, 0x96, 0x38, 0x4f, 0xe1, 0x1f, 0x32, 0x2c, 0x99, 0xf4,
+		0x8f, 0xa7, 0x34, 0x48, 0x3b, 0xb4, 0x63, 0x58, 0xae, 0xd9, 0x14, 0x27, 0x24, 0x17, 0x8f, 0xd4,
+		0xf9, 0xc5, 0x86, 0xc0, 0xd6, 0x3c, 0x3a, 0xea, 0x19, 0x48, 0xcb, 0x52, 0x34, 0xfc, 0xd6, 0x37,
+		0xd6, 0x6b, 0xca, 0x14, 0x99, 0x81, 0x44, 0xa3, 0xb6, 0xa5, 0xc4, 0xd0, 0xed, 0xac, 0xac, 0xd6,
+		0x2b, 0x0d, 0x25, 0xbe, 0xfc, 0xff, 0xc1, 0x5c, 0xd3, 0xee, 0x0c, 0x36, 0xb8, 0xac, 0x0c, 0x84,
+		0xfc, 0x9c, 0x2b, 0xb1, 0xeb, 0x4f, 0x09, 0xa0, 0x3d, 0xbb, 0x6d, 0x58, 0x7b, 0x8b, 0x76, 0x6f,
+		0xcf, 0x4f, 0x8b, 0x40, 0xef, 0xc0, 0x09, 0x24, 0x47, 0x74, 0x77, 0xfe, 0x7b, 0x2c, 0xf6, 0x83,
+		0xf1, 0xc4, 0xe5, 0xcd, 0xe5, 0x1f, 0x8f, 0x97, 0x2f, 0x73, 0xc4, 0x4d, 0x39, 0x1c, 0x8d, 0xee,
+		0xb6, 0x69, 0x13, 0x3b, 0x0f, 0x7f, 0xfc, 0x24, 0xcc, 0xef, 0xd9, 0x7b, 0x36, 0xa3, 0x74, 0x0e,
+		0x7f, 0xf1, 0x4e, 0x90, 0x8c, 0x57, 0x5a, 0x8e, 0x4c, 0xc2, 0x58, 0x5a, 0x87, 0x39, 0x01, 0xac,
+		0xb3, 0xe3, 0x5b, 0x1e, 0x5c, 0x20, 0x87, 0x46, 0xb6, 0x4b, 0x3f, 0xf9, 0xfb, 0xcc, 0x2a, 0xd1,
+		0x66, 0x05, 0x2a, 0xd6, 0xf1, 0xf8, 0xc3, 0x92, 0x06, 0xc7, 0x42, 0xf4, 0xb8, 0x8e, 0xa0, 0xbd,
+		0x08, 0x8a, 0xff, 0x4c, 0x50, 0x9c, 0x0b, 0x50, 0x6c, 0x08, 0xd4, 0xa5, 0x2a, 0xe4, 0x8f, 0x42,
+		0xeb, 0x9f, 0x0b, 0x5a, 0x39, 0x1a, 0x24, 0x72, 0x19, 0x8a, 0x8c, 0x48, 0xb3, 0xef, 0xb8, 0x76,
+		0x87, 0x29, 0xe0, 0xc3, 0xc9, 0xfc, 0x8b, 0xdf, 0xe7, 0x8b, 0xb6, 0x80, 0x68, 0x55, 0x0f, 0x6b,
+		0x69, 0x09, 0xd8, 0x89, 0x35, 0x9e, 0x24, 0x47, 0x50, 0xf8, 0x55, 0xd1, 0x11, 0x0f, 0x7e, 0xe9,
+		0x1a, 0xcc, 0xe3, 0x6f, 0xa6, 0x1f, 0x83, 0x3d, 0x89, 0x0e, 0x83, 0x97, 0x7e, 0xf3, 0x23, 0x5c,
+		0x2f, 0xcc, 0x79, 0x04, 0x02, 0x7d, 0x0a, 0xcc, 0xe2, 0x1e, 0x75, 0x5d, 0xda, 0x73, 0x74, 0xa3,
+		0x3d, 0xaa, 0x7b, 0x81, 0x38, 0x62, 0xe9, 0x7b, 0xbf, 0x18, 0x9e, 0xc5, 0xcb, 0x1c, 0xb3, 0xd2,
+		0x6e, 0x2f, 0x6d, 0xc3, 0x03, 0x23, 0xa4, 0x62, 0x02, 0x9a, 0xdf, 0x27, 0x68, 0xce, 0x0f, 0x49,
+		0x06, 0x92, 0xdd, 0x04, 0x59, 0xee, 0xcd, 0xe5, 0x04, 0x34, 0xbf, 0x5f, 0xd0, 0x24, 0x02, 0x57,
+		0x4e, 0x29, 0x52, 0xbc, 0x0a, 0xb3, 0x37, 0x69, 0x6f, 0xc7, 0x76, 0x44, 0xec, 0x76, 0x02, 0x72,
+		0x9f, 0x10, 0xe4, 0x8a, 0x02, 0x91, 0x05, 0x73, 0x91, 0xd6, 0x0b, 0x90, 0xde, 0x35, 0x9a, 0x74,
+		0x02, 0x12, 0x77, 0x05, 0x89, 0x19, 0x84, 0x47, 0xd4, 0x0a, 0xe4, 0xf6, 0x6c, 0xb1, 0x45, 0x46,
+		0xa3, 0xff, 0x80, 0x40, 0xcf, 0x4a, 0x1c, 0x41, 0xa2, 0x6b, 0x77, 0xfb, 0x6d, 0xdc, 0x3f, 0xa3,
+		0x49, 0xfc, 0x2d, 0x49, 0x42, 0xe2, 0x08, 0x12, 0x47, 0x60, 0xeb, 0x27, 0x25, 0x09, 0x27, 0xc0,
+		0xcf, 0x97, 0xf1, 0x48, 0xb7, 0x7d, 0x60, 0x5b, 0x93, 0x74, 0xe2, 0x53, 0x82, 0x02, 0x08, 0x14,
+		0x24, 0xf0, 0x22, 0x64, 0x26, 0x9d, 0x88, 0x1f, 0xfe, 0xa2, 0x5c, 0x1e, 0x72, 0x06, 0x2e, 0x43,
+		0x51, 0x2a, 0x28, 0x4c, 0x01, 0x89, 0x26, 0xf1, 0x23, 0x82, 0x44, 0x21, 0x80, 0x26, 0x86, 0xe1,
+		0x52, 0xc7, 0xdd, 0xa3, 0x93, 0x10, 0xf9, 0x51, 0x39, 0x0c, 0x81, 0x22, 0x58, 0xb9, 0x43, 0xad,
+		0xe6, 0xfe, 0x64, 0x14, 0x3e, 0x2d, 0x59, 0x29, 0x71, 0x90, 0x44, 0x15, 0xf2, 0x1d, 0xa3, 0xe7,
+		0xec, 0x1b, 0xed, 0x89, 0xa6, 0xe3, 0xc7, 0x04, 0x8d, 0x9c, 0x87, 0x24, 0x38, 0xd2, 0xb7, 0x8e,
+		0x42, 0xe6, 0xc7, 0x25, 0x47, 0xfa, 0x56, 0x88, 0xd0, 0x26, 0xcc, 0x3b, 0x2e, 0x0b, 0x74, 0x1f,
+		0x85, 0xda, 0xdf, 0x91, 0x4b, 0x8f, 0xe3, 0xae, 0x05, 0x29, 0xbe, 0x08, 0x19, 0xc7, 0x7c, 0x63,
+		0x22, 0x32, 0x7f, 0x57, 0xce, 0x34, 0x43, 0x40, 0xe4, 0xd7, 0xe0, 0xc4, 0xc8, 0x6d, 0x62, 0x02,
+		0x62, 0x7f, 0x4f, 0x10, 0x3b, 0x3e, 0x62, 0xab, 0x10, 0x2a, 0xe1, 0xa8, 0x24, 0xff, 0xbe, 0x54,
+		0x09, 0x74, 0x80, 0xd6, 0x26, 0x3a, 0x2d, 0x8e, 0xb1, 0x7b, 0x34, 0xae, 0xfd, 0x03, 0xc9, 0x35,
+		0x8e, 0x1b, 0xe2, 0xda, 0x16, 0x1c, 0x17, 0x14, 0x8f, 0x36, 0xaf, 0x3f, 0x21, 0x15, 0x2b, 0xc7,
+		0xde, 0x0e, 0xcf, 0xee, 0xd7, 0x40, 0xd9, 0x63, 0xa7, 0xb4, 0x8e, 0x1d, 0x1d, 0xa3, 0xc3, 0xd1,
+		0x94, 0x7f, 0x52, 0x50, 0x96, 0x1a, 0xdf, 0x33, 0xaf, 0x9d, 0x35, 0xa3, 0x8b, 0xc4, 0x5f, 0x85,
+		0x92, 0x24, 0xde, 0xb7, 0x7a, 0xb4, 0x69, 0xef, 0x59, 0xe6, 0x1b, 0xb4, 0x35, 0x01, 0xe9, 0x9f,
+		0x1a, 0x98, 0xaa, 0xed, 0x00, 0x3a, 0x52, 0xae, 0x83, 0xe2, 0xd9, 0x2a, 0xba, 0xd9, 0xe9, 0xda,
+		0x3d, 0x37, 0x82, 0xe2, 0x4f, 0xcb, 0x99, 0xf2, 0xf0, 0xea, 0x0c, 0x6d, 0xa9, 0x06, 0x3c, 0xfb,
+		0x63, 0x52, 0x91, 0xfc, 0x8c, 0x20, 0x94, 0xf7, 0xb1, 0x84, 0xe2, 0x68, 0xda, 0x9d, 0xae, 0xd1,
+		0x9b, 0x44, 0xff, 0xfd, 0x43, 0xa9, 0x38, 0x04, 0x8a, 0x50, 0x1c, 0x68, 0xd1, 0xe1, 0x6e, 0x3f,
+		0x01, 0x85, 0x9f, 0x91, 0x8a, 0x43, 0xe2, 0x08, 0x12, 0xd2, 0x60, 0x98, 0x80, 0xc4, 0xcf, 0x4a,
+		0x12, 0x12, 0x07, 0x49, 0xbc, 0xcf, 0xdf, 0x68, 0x7b, 0x74, 0xcf, 0x74, 0xdc, 0x1e, 0x37, 0xc9,
+		0x0f, 0x27, 0xf5, 0x73, 0x5f, 0x0c, 0x1b, 0x61, 0x5a, 0x00, 0x15, 0x35, 0x91, 0x38, 0xfa, 0x60,
+		0x2e, 0x5b, 0x74, 0xc7, 0x7e, 0x5e, 0x6a, 0xa2, 0x00, 0x1a, 0xf6, 0x2d, 0x60, 0x21, 0x22, 0xdb,
+		0x9b, 0xe8, 0xa8, 0x4c, 0x40, 0xee, 0x1f, 0x0d, 0x74, 0xae, 0x21, 0x71, 0x91, 0x66, 0xc0, 0xfe,
+		0xe9, 0x5b, 0x37, 0xe8, 0xc1, 0x44, 0xd2, 0xf9, 0x0b, 0x03, 0xf6, 0xcf, 0x36, 0xc7, 0xe4, 0x3a,
+		0xa4, 0x38, 0x60, 0x4f, 0x91, 0xa8, 0x5c, 0xbf, 0xd2, 0x37, 0xbc, 0x25, 0xc6, 0x1b, 0x36, 0xa7,
+		0x96, 0x56, 0x41, 0x11, 0x25, 0xbe, 0x01, 0x1b, 0x49, 0xec, 0x23, 0x6f, 0x79, 0x72, 0x1e, 0xb2,
+		0x79, 0x96, 0x2e, 0x41, 0x3e, 0x64, 0xf0, 0x44, 0x93, 0xfa, 0x26, 0x41, 0x2a, 0x17, 0xb4, 0x77,
+		0x96, 0x2e, 0x40, 0x12, 0x8d, 0x97, 0x68, 0xf4, 0x6f, 0x16, 0xe8, 0x0c, 0x7c, 0xe9, 0x25, 0x48,
+		0x4b, 0xa3, 0x25, 0x1a, 0xf5, 0x5b, 0x04, 0xaa, 0x87, 0x82, 0xe8, 0xd2, 0x60, 0x89, 0x46, 0xff,
+		0xff, 0x25, 0xba, 0x44, 0x41, 0xf4, 0xc9, 0x59, 0xf8, 0xcb, 0x7f, 0x2d, 0xc9, 0xd1, 0x25, 0xca,
+		0x12, 0x66, 0x9f, 0x70, 0x4b, 0x25, 0x1a, 0xfb, 0xdb, 0x44, 0xe3, 0x12, 0x63, 0xe9, 0x79, 0x98,
+		0x9e, 0x90, 0xe1, 0x7f, 0x5d, 0xa0, 0x72, 0xf8, 0xa5, 0x2a, 0x64, 0x03, 0xd6, 0x49, 0x34, 0xfa,
+		0x77, 0x08, 0xf4, 0x20, 0x16, 0x76, 0x5d, 0x58, 0x27, 0xd1, 0x04, 0xbe, 0x53, 0x76, 0x5d, 0x60,
+		0x20, 0xdb, 0xa4, 0x61, 0x12, 0x8d, 0xfd, 0x51, 0xc9, 0x75, 0x89, 0xb2, 0xf4, 0x32, 0x64, 0xbc,
+		0xcd, 0x26, 0x1a, 0xff, 0xbb, 0x04, 0xbe, 0x8f, 0x83, 0x1c, 0xe8, 0x5b, 0x47, 0x20, 0xf1, 0x37,
+		0x24, 0x07, 0x02, 0x58, 0xb8, 0x8c, 0x06, 0x0d, 0x98, 0x68, 0x4a, 0x1f, 0x93, 0xcb, 0x68, 0xc0,
+		0x7e, 0xc1, 0xd9, 0x64, 0x3a, 0x3f, 0x9a, 0xc4, 0xdf, 0x94, 0xb3, 0xc9, 0xe0, 0xb1, 0x1b, 0x83,
+		0x16, 0x41, 0x34, 0x8d, 0xef, 0x91, 0xdd, 0x18, 0x30, 0x08, 0x96, 0x36, 0x81, 0x0c, 0x5b, 0x03,
+		0xd1, 0xf4, 0x3e, 0x2e, 0xe8, 0xcd, 0x0e, 0x19, 0x03, 0x4b, 0xef, 0x87, 0xe3, 0xa3, 0x2d, 0x81,
+		0x68, 0xaa, 0xdf, 0xfb, 0xd6, 0x80, 0xef, 0x16, 0x34, 0x04, 0x96, 0xb6, 0x60, 0x7e, 0x94, 0x15,
+		0x10, 0x4d, 0xf6, 0xfb, 0xde, 0x0a, 0x2b, 0xee, 0xa0, 0x11, 0xb0, 0x54, 0x01, 0xf0, 0x37, 0xe0,
+		0x68, 0x5a, 0x9f, 0x10, 0xb4, 0x02, 0x48, 0xb8, 0x34, 0xc4, 0xfe, 0x1b, 0x8d, 0x7f, 0x57, 0x2e,
+		0x0d, 0x81, 0x81, 0x4b, 0x43, 0x6e, 0xbd, 0xd1, 0xd8, 0x3f, 0x20, 0x97, 0x86, 0x44, 0x41, 0xc9,
+		0x0e, 0xec, 0x6e, 0xd1, 0x14, 0x3e, 0x25, 0x25, 0x3b, 0x80, 0xb5, 0xb4, 0x0e, 0xb3, 0x43, 0x1b,
+		0x62, 0x34, 0xa9, 0x1f, 0x14, 0xa4, 0x94, 0xc1, 0xfd, 0x30, 0xb8, 0x79, 0x89, 0xcd, 0x30, 0x9a,
+		0xda, 0x0f, 0x0d, 0x6c, 0x5e, 0x62, 0x2f, 0x5c, 0x7a, 0x11, 0xd2, 0x56, 0xbf, 0xdd, 0xc6, 0xc5,
+		0x43, 0x0e, 0xcf, 0xcf, 0x2d, 0xfd, 0xa7, 0x2f, 0x09, 0xee, 0x48, 0x84, 0xa5, 0x0b, 0x30, 0x4d,
+		0x3b, 0x3b, 0xb4, 0x15, 0x85, 0xf9, 0x87, 0x5f, 0x92, 0x0a, 0x13, 0xa1, 0x97, 0x5e, 0x06, 0xe0,
+		0xa1, 0x11, 0x76, 0x70, 0x1e, 0x81, 0xfb, 0x47, 0x5f, 0x12, 0x09, 0x71, 0x3e, 0x8a, 0x4f, 0x80,
+		0xa7, 0xd7, 0x1d, 0x4e, 0xe0, 0x8b, 0x61, 0x02, 0x6c, 0x46, 0x5e, 0x80, 0x19, 0x3c, 0x48, 0x73,
+		0x8d, 0xbd, 0x28, 0xec, 0x3f, 0x16, 0xd8, 0x12, 0x1e, 0x19, 0xd6, 0xb1, 0x7b, 0xd4, 0x35, 0xf6,
+		0x9c, 0x28, 0xdc, 0xff, 0x2c, 0x70, 0x3d, 0x04, 0x44, 0x6e, 0x1a, 0x8e, 0x3b, 0xc9, 0xb8, 0xff,
+		0x44, 0x22, 0x4b, 0x04, 0xec, 0x34, 0xfe, 0xbe, 0x41, 0x0f, 0xa2, 0x70, 0xff, 0x54, 0x76, 0x5a,
+		0xc0, 0x2f, 0xbd, 0x04, 0x19, 0xfc, 0xc9, 0xb3, 0x5c, 0x23, 0x90, 0xff, 0x4c, 0x20, 0xfb, 0x18,
+		0xd8, 0xb2, 0xe3, 0xb6, 0x5c, 0x33, 0x9a, 0xd9, 0x7f, 0x2e, 0x66, 0x5a, 0xc2, 0x2f, 0x55, 0x20,
+		0xeb, 0xb8, 0xad, 0x56, 0x5f, 0xd8, 0xa7, 0x11, 0xe8, 0xff, 0xe5, 0x4b, 0x5e, 0xc8, 0xc2, 0xc3,
+		0xc1, 0xd9, 0xbe, 0x75, 0xc3, 0xed, 0xda, 0xec, 0xbc, 0x25, 0x8a, 0xc2, 0x5b, 0x82, 0x42, 0x00,
+		0x65, 0xa9, 0x0a, 0x39, 0x1c, 0x4b, 0x8f, 0x76, 0x29, 0x3b, 0x1c, 0x8b, 0x20, 0xf1, 0x17, 0x82,
+		0x01, 0x21, 0xa4, 0xe5, 0xaf, 0x1b, 0x1d, 0x0d, 0x86, 0xcb, 0xf6, 0x65, 0x9b, 0xc7, 0x81, 0xaf,
+		0x3f, 0xba, 0x67, 0xba, 0xfb, 0xfd, 0x9d, 0xc5, 0xa6, 0xdd, 0x39, 0xd7, 0xb4, 0x9d, 0x8e, 0xed,
+		0x9c, 0x0b, 0xc7, 0x6f, 0xd9, 0xaf, 0x5f, 0xfd, 0xdc, 0xa9, 0xd8, 0x67, 0x3f, 0x77, 0x2a, 0xf6,
+		0xbb, 0x9f, 0x3b, 0x15, 0xfb, 0xe8, 0xe7, 0x4f, 0x4d, 0x7d, 0xf6, 0xf3, 0xa7, 0xa6, 0x7e, 0xe7,
+		0xf3, 0xa7, 0xa6, 0xe0, 0x7f, 0xc6, 0xe0, 0x04, 0x27, 0xef, 0x87, 0x73, 0x0d, 0xeb, 0x60, 0xdc,
+		0x65, 0xba, 0x8b, 0x90, 0xa8, 0x58, 0x07, 0xe4, 0x04, 0x57, 0x70, 0x7a, 0xbf, 0xd7, 0x16, 0xa9,
+		0x96, 0x33, 0xf8, 0xbd, 0xdd, 0x6b, 0x63, 0x98, 0x5d, 0xe6, 0x43, 0xe3, 0x71, 0x0e, 0xff, 0x58,
+		0xfe, 0x8e, 0xd8, 0xe8, 0x91, 0xa4, 0x2b, 0xd6, 0x01, 0x1b, 0xc8, 0x66, 0xec, 0xfa, 0x3b, 0x23,
+		0xe3, 0xd9, 0x37, 0x2c, 0xfb, 0x96, 0x85, 0xdd, 0xeb, 0xee, 0xc8, 0x58, 0xf6, 0xa9, 0xc1, 0x58,
+		0xf6, 0xfb, 0x69, 0xbb, 0xfd, 0x0a, 0xc2, 0x61, 0x9a, 0x82, 0x33, 0x6e, 0xdc, 0x3b, 0x29, 0x9e,
+		0xd5, 0x0f, 0x1f, 0x8b, 0xc3, 0xa9, 0xa1, 0x70, 0xb6, 0x10, 0x82, 0x71, 0x4c, 0x58, 0x82, 0xf4,
+		0x8a, 0x94, 0xad, 0x12, 0x5e, 0x65, 0x6b, 0xda, 0x56, 0xcb, 0x61, 0x8c, 0x48, 0x68, 0xf2, 0x13,
+		0x19, 0x61, 0x19, 0x96, 0xed, 0x88, 0x64, 0x65, 0xfe, 0xb1, 0xfc, 0xfd, 0x63, 0x18, 0x91, 0x97,
+		0x14, 0x25, 0x37, 0x9e, 0x99, 0x90, 0x1b, 0xb2, 0xb3, 0xa1, 0x08, 0xff, 0x97, 0xcb, 0x95, 0xef,
+		0x89, 0xc3, 0xe9, 0x41, 0xae, 0xe0, 0xca, 0x72, 0x5c, 0xa3, 0xd3, 0x1d, 0xc7, 0x96, 0x17, 0x21,
+		0xb3, 0x25, 0x61, 0x8e, 0xcc, 0x97, 0xbb, 0x63, 0xf8, 0x52, 0xf0, 0x48, 0x4a, 0xc6, 0x9c, 0x9f,
+		0x90, 0x31, 0x5e, 0x7f, 0xef, 0x2b, 0x67, 0xfe, 0x32, 0x05, 0x27, 0xf8, 0x32, 0xd3, 0xf9, 0x12,
+		0xe3, 0x1f, 0x82, 0x27, 0xb9, 0x60, 0x55, 0xf4, 0x39, 0x89, 0xfa, 0x0a, 0xcc, 0xd5, 0x51, 0x5b,
+		0xa0, 0x17, 0xe4, 0x9f, 0xf0, 0x8c, 0xcc, 0xe7, 0x5e, 0x08, 0x19, 0xfc, 0xe2, 0x7c, 0x2b, 0x58,
+		0xa4, 0x7e, 0x43, 0x0c, 0x94, 0x46, 0xd3, 0x68, 0x1b, 0xbd, 0x2f, 0x97, 0x14, 0x79, 0x1e, 0x80,
+		0xa7, 0x7b, 0x78, 0x17, 0xf7, 0x0a, 0xe7, 0x4b, 0x8b, 0xc1, 0xc1, 0x2d, 0xf2, 0x96, 0x58, 0x06,
+		0x55, 0x86, 0xc1, 0xe2, 0xcf, 0xb3, 0xaf, 0x02, 0xf8, 0x15, 0xe4, 0x24, 0x3c, 0xd0, 0xa8, 0x56,
+		0x56, 0x2b, 0x9a, 0x4c, 0x12, 0x6a, 0x6c, 0xd6, 0xaa, 0xf5, 0x4b, 0xf5, 0xda, 0x8a, 0x32, 0x85,
+		0xf9, 0x35, 0xc1, 0x4a, 0x2f, 0xa9, 0xe9, 0x18, 0xcc, 0x06, 0xcb, 0xf9, 0x2d, 0x95, 0x38, 0x5a,
+		0x8a, 0x66, 0xa7, 0xdb, 0xa6, 0xec, 0xe4, 0x51, 0x37, 0x25, 0xd7, 0xa2, 0x8d, 0x90, 0x5f, 0xfb,
+		0xd7, 0xfc, 0xe6, 0xc2, 0x9c, 0x8f, 0xee, 0xf1, 0x7c, 0x69, 0x15, 0x66, 0x31, 0x97, 0xb2, 0x1b,
+		0x22, 0x19, 0xa1, 0xaa, 0x91, 0x20, 0x3b, 0x4b, 0x15, 0x98, 0x3e, 0xb5, 0xe7, 0x21, 0xe5, 0xb0,
+		0xd1, 0x47, 0x91, 0xf8, 0x75, 0x41, 0x42, 0x80, 0x2f, 0x59, 0x30, 0x8b, 0x96, 0x1f, 0x06, 0x88,
+		0xfc, 0x6e, 0x1c, 0x1e, 0x67, 0xf8, 0xc5, 0x9f, 0x7e, 0x9a, 0x9d, 0xac, 0x3e, 0x1c, 0x9e, 0x96,
+		0x11, 0xe2, 0xa4, 0x29, 0x82, 0xb6, 0xdf, 0x51, 0x0a, 0x05, 0xd9, 0x9e, 0xe8, 0xf0, 0xe1, 0x8d,
+		0xfd, 0x92, 0x68, 0xec, 0xd4, 0x28, 0x19, 0x08, 0xb4, 0x94, 0x17, 0x54, 0x79, 0xc5, 0x72, 0xed,
+		0xfa, 0x93, 0xc3, 0xbb, 0x13, 0xff, 0xef, 0x29, 0x46, 0xe1, 0xc5, 0x20, 0xb9, 0xc8, 0xb5, 0xf7,
+		0xfd, 0x49, 0x98, 0xc5, 0xf4, 0x7f, 0xfb, 0x1c, 0xfb, 0x57, 0xac, 0xb9, 0x69, 0xf6, 0x31, 0xc1,
+		0xa1, 0xe4, 0x45, 0xbe, 0x14, 0xa2, 0x25, 0xe6, 0xcf, 0xbe, 0xfd, 0x47, 0xa6, 0xfd, 0xe5, 0xb2,
+		0xb4, 0x06, 0x8a, 0x4c, 0xe2, 0xa5, 0x56, 0xd3, 0x6e, 0x4d, 0x14, 0xa5, 0xf8, 0x73, 0x49, 0x43,
+		0xc6, 0xb7, 0x6a, 0x02, 0x75, 0xe9, 0xdd, 0x78, 0xd2, 0x27, 0xc8, 0x44, 0x59, 0x26, 0x92, 0x88,
+		0x87, 0x81, 0x76, 0x09, 0x5f, 0x99, 0x93, 0x58, 0xa1, 0x6f, 0x49, 0x7c, 0xbe, 0x42, 0x31, 0x43,
+		0x62, 0xe9, 0x32, 0x14, 0x5a, 0xb6, 0xe5, 0xea, 0x76, 0xc7, 0x74, 0x69, 0xa7, 0xeb, 0x46, 0xda,
+		0x75, 0x7f, 0xc1, 0x89, 0xa4, 0xb5, 0x3c, 0xe2, 0x6d, 0x48, 0x34, 0xec, 0x09, 0xbf, 0xa7, 0x37,
+		0x49, 0x4f, 0xfe, 0xab, 0xd7, 0x13, 0x86, 0x83, 0x3d, 0x39, 0x54, 0x3a, 0x9c, 0xd6, 0x0d, 0xa1,
+		0xcc, 0xdd, 0xdb, 0x7c, 0xb6, 0x23, 0xa5, 0xe3, 0x4f, 0x12, 0x70, 0x4a, 0x10, 0xd9, 0x31, 0x1c,
+		0x7a, 0xee, 0xe6, 0x33, 0x3b, 0xd4, 0x35, 0x9e, 0x39, 0xd7, 0xb4, 0x4d, 0xb9, 0x93, 0xcf, 0xf1,
+		0xfa, 0x45, 0xac, 0x5f, 0x14, 0xf5, 0xe5, 0x91, 0xc7, 0xdd, 0xe5, 0xf1, 0x4a, 0xbe, 0x3c, 0x2c,
+		0x83, 0xea, 0x75, 0x48, 0x56, 0x6d, 0xd3, 0xc2, 0xbd, 0xad, 0x45, 0x2d, 0xbb, 0x23, 0xd4, 0x2d,
+		0xff, 0x20, 0x17, 0x21, 0x65, 0x74, 0xec, 0xbe, 0xe5, 0x72, 0x55, 0xbb, 0x7c, 0xea, 0x57, 0xdf,
+		0x3c, 0x3d, 0xf5, 0x6f, 0xdf, 0x3c, 0x9d, 0xa8, 0x5b, 0xee, 0x6f, 0x7d, 0xe6, 0x29, 0x10, 0xd4,
+		0xeb, 0x96, 0xfb, 0xe9, 0x3f, 0xf8, 0x89, 0xb3, 0x31, 0x4d, 0x40, 0x2f, 0x25, 0xbf, 0xf0, 0xc9,
+		0xd3, 0x31, 0xf5, 0x55, 0x98, 0x59, 0xa1, 0xcd, 0x43, 0xc8, 0x3f, 0x33, 0x40, 0xfe, 0x84, 0x24,
+		0xbf, 0x42, 0x9b, 0x01, 0xf2, 0x2b, 0xb4, 0x39, 0x40, 0xf9, 0x79, 0x48, 0xd7, 0x2d, 0x97, 0x5f,
+		0x21, 0x7a, 0x12, 0x12, 0xa6, 0xc5, 0xb3, 0xd2, 0x03, 0x14, 0x86, 0x3a, 0xa8, 0x21, 0x14, 0x22,
+		0xae, 0xd0, 0xa6, 0x87, 0xd8, 0xa2, 0xcd, 0x52, 0x2c, 0xaa, 0x69, 0x84, 0x5a, 0x5e, 0xb9, 0xae,
+		0x46, 0x4f, 0xf9, 0xef, 0xfc, 0xde, 0xa9, 0xa9, 0x0f, 0x7f, 0xee, 0xd4, 0x54, 0xe4, 0x8c, 0xff,
+		0x48, 0x12, 0x1e, 0x62, 0x37, 0x4b, 0x7b, 0x1d, 0xd3, 0x72, 0xcf, 0x35, 0x7b, 0x07, 0x5d, 0xd7,
+		0x46, 0x05, 0x60, 0xef, 0x8a, 0x09, 0x9f, 0xf5, 0xab, 0x17, 0x79, 0xf5, 0xe8, 0xe9, 0x56, 0x77,
+		0x61, 0x7a, 0x13, 0xf1, 0x90, 0xc5, 0xae, 0xed, 0x1a, 0x6d, 0x61, 0xb5, 0xf0, 0x0f, 0x2c, 0xe5,
+		0xb7, 0x51, 0xe3, 0xbc, 0xd4, 0x94, 0x17, 0x51, 0xdb, 0xd4, 0xd8, 0xe5, 0x97, 0x7a, 0x12, 0xcc,
+		0xdc, 0x4d, 0x63, 0x01, 0xbb, 0xbf, 0x33, 0x0f, 0xd3, 0x46, 0x9f, 0x27, 0xde, 0x24, 0xd0, 0x0e,
+		0x66, 0x1f, 0xea, 0x2b, 0x30, 0x23, 0xce, 0xdf, 0x31, 0xf3, 0xe4, 0x06, 0x3d, 0x60, 0xed, 0xe4,
+		0x34, 0xfc, 0x49, 0x16, 0x61, 0x9a, 0x75, 0x5e, 0xdc, 0x56, 0x2c, 0x2d, 0x0e, 0xf5, 0x7e, 0x91,
+		0x75, 0x52, 0xe3, 0x60, 0xea, 0x55, 0x48, 0xaf, 0xd8, 0x28, 0x85, 0x61, 0x6a, 0x19, 0x4e, 0x8d,
+		0xf5, 0xb9, 0xdb, 0x17, 0x52, 0xa1, 0xf1, 0x0f, 0x4c, 0x49, 0xe7, 0x97, 0xbc, 0x44, 0xf2, 0x90,
+		0xf8, 0x52, 0xab, 0x30, 0xc3, 0x68, 0x6f, 0x74, 0xd1, 0x64, 0xf0, 0xf2, 0xde, 0x33, 0xe2, 0xca,
+		0xaf, 0x20, 0x1f, 0xf7, 0x3b, 0x4b, 0x20, 0x89, 0x17, 0x39, 0xc4, 0xb8, 0xd9, 0x6f, 0xf5, 0x3d,
+		0x90, 0x16, 0x44, 0x1c, 0x72, 0x1e, 0x12, 0x76, 0xd7, 0x11, 0xe9, 0x3f, 0xe5, 0x71, 0x43, 0xd9,
+		0xe8, 0x2e, 0x27, 0x51, 0x66, 0x34, 0x04, 0x5e, 0x5e, 0xbf, 0xfe, 0x5c, 0x48, 0x3c, 0x3a, 0xd4,
+		0xdd, 0xd9, 0x75, 0xfd, 0x1f, 0x7c, 0xda, 0x86, 0xa6, 0x3c, 0x52, 0x50, 0xee, 0xc6, 0xe1, 0x54,
+		0x00, 0xeb, 0x26, 0xed, 0x61, 0x00, 0x8a, 0x4b, 0x9b, 0x90, 0x14, 0x12, 0xe8, 0xa0, 0xa8, 0x1f,
+		0x23, 0x2a, 0x2f, 0x41, 0xa2, 0xd2, 0xed, 0xe2, 0x3d, 0x67, 0xf6, 0xdd, 0xb4, 0xb9, 0xac, 0x24,
+		0x35, 0xef, 0x1b, 0xeb, 0x1c, 0x7b, 0xd7, 0xbd, 0x65, 0xf4, 0xbc, 0x3b, 0xd0, 0xf2, 0x5b, 0x7d,
+		0x01, 0x32, 0x55, 0xdb, 0x72, 0xa8, 0xe5, 0xf4, 0x99, 0x2d, 0xbc, 0xd3, 0xb6, 0x9b, 0x37, 0x04,
+		0x05, 0xfe, 0x81, 0xcc, 0x36, 0xba, 0x5d, 0x86, 0x99, 0xd4, 0xf0, 0x27, 0x5f, 0xaf, 0xcb, 0x1b,
+		0xd7, 0x2f, 0x1c, 0x8d, 0x3d, 0x62, 0x20, 0xd1, 0x46, 0x6d, 0x0c, 0x1e, 0x1c, 0x5e, 0x48, 0x37,
+		0xe8, 0x81, 0x73, 0xd4, 0x75, 0xf4, 0x2a, 0x64, 0x36, 0xd9, 0xf3, 0x24, 0xaf, 0xd0, 0x03, 0x52,
+		0xc6, 0x37, 0x2c, 0xce, 0x5f, 0xb8, 0xf0, 0xcc, 0x0b, 0x5c, 0xca, 0xaf, 0x4c, 0x69, 0xb2, 0x80,
+		0x9c, 0x82, 0x8c, 0x43, 0x9b, 0xdd, 0xf3, 0x17, 0x2e, 0xde, 0x78, 0x86, 0x8b, 0xd5, 0x95, 0x29,
+		0xcd, 0x2f, 0x5a, 0x4a, 0xe3, 0x88, 0xbf, 0xf0, 0xa9, 0xd3, 0xb1, 0xe5, 0x69, 0x48, 0x38, 0xfd,
+		0xce, 0xdb, 0x26, 0x1b, 0xdf, 0x3d, 0x0d, 0x0b, 0x01, 0x2c, 0xbe, 0xe9, 0xdc, 0x34, 0xda, 0x66,
+		0xcb, 0xf0, 0x1f, 0x95, 0x51, 0x02, 0xe3, 0x67, 0x10, 0x63, 0x76, 0x8d, 0x43, 0xb9, 0xa8, 0xfe,
+		0x54, 0x0c, 0x72, 0xd7, 0x24, 0x65, 0x7c, 0x85, 0xe6, 0x45, 0x00, 0xaf, 0x25, 0xb9, 0x54, 0x4e,
+		0x2e, 0x0e, 0xb6, 0xb5, 0xe8, 0xe1, 0x68, 0x01, 0x70, 0xf2, 0x3c, 0x13, 0xc0, 0xae, 0xed, 0x88,
+		0xfb, 0xb0, 0x11, 0xa8, 0x1e, 0x30, 0x26, 0x72, 0x32, 0xad, 0xa6, 0xdf, 0xb4, 0x5d, 0x4c, 0x2d,
+		0xe9, 0xda, 0xb7, 0xc4, 0x2b, 0x03, 0x09, 0x4d, 0x61, 0x35, 0xd7, 0x58, 0xc5, 0x26, 0x96, 0x63,
+		0xa7, 0x33, 0x1e, 0x15, 0x74, 0xeb, 0x8c, 0x56, 0xab, 0x47, 0x1d, 0x47, 0x28, 0x2e, 0xf9, 0x89,
+		0x97, 0x70, 0xbb, 0xfd, 0x1d, 0x5d, 0x6a, 0x09, 0xbc, 0xc6, 0x3c, 0x62, 0xcd, 0x4b, 0xd9, 0x10,
+		0xab, 0x3e, 0xd5, 0xed, 0xef, 0xa0, 0xa4, 0x3c, 0x0c, 0xb9, 0x11, 0x9d, 0xc9, 0xde, 0xf4, 0xfb,
+		0xc1, 0x5e, 0xc4, 0x11, 0x23, 0xd0, 0xbb, 0x3d, 0xd3, 0xee, 0x99, 0xee, 0x01, 0x4b, 0xdb, 0x4b,
+		0x68, 0x8a, 0xac, 0xd8, 0x14, 0xe5, 0xea, 0x0d, 0x28, 0x36, 0x98, 0xb9, 0xef, 0xf7, 0xfc, 0x82,
+		0xdf, 0xbf, 0x58, 0x74, 0xff, 0xc6, 0xf6, 0x2c, 0x3e, 0xd4, 0xb3, 0xe5, 0xb5, 0xeb, 0xcf, 0x1e,
+		0x4d, 0x32, 0xdd, 0x89, 0x3c, 0xcd, 0x3f, 0x3a, 0x01, 0x0f, 0x0e, 0x22, 0x85, 0x54, 0xd6, 0xa4,
+		0x42, 0x19, 0xe5, 0xc9, 0x97, 0x0f, 0xdf, 0x44, 0xcb, 0x11, 0xaa, 0xb3, 0x1c, 0xb9, 0x7c, 0xd4,
+		0x17, 0x20, 0x8f, 0xc9, 0xb7, 0x0d, 0xea, 0x5e, 0xa1, 0x46, 0x8b, 0xf6, 0xc2, 0xbb, 0x6c, 0x5e,
+		0xee, 0xb2, 0x04, 0x92, 0x6c, 0x2b, 0xe5, 0xbb, 0x0c, 0xfb, 0xad, 0xee, 0x43, 0x12, 0x51, 0xfd,
+		0x1d, 0x58, 0x60, 0xb0, 0x0f, 0x2c, 0xdd, 0x39, 0x70, 0xa9, 0x23, 0x50, 0xf8, 0x07, 0x79, 0x4e,
+		0xee, 0xa3, 0x89, 0xc3, 0xf7, 0x51, 0x21, 0x84, 0x62, 0x37, 0x6d, 0xc3, 0xcc, 0x32, 0xaa, 0xdf,
+		0xfa, 0x8a, 0xd7, 0x91, 0x98, 0xdf, 0x11, 0xb2, 0x06, 0xc5, 0xae, 0xd1, 0x73, 0xd9, 0x3d, 0xbe,
+		0x7d, 0x36, 0x0a, 0x21, 0xe7, 0xa7, 0x87, 0x57, 0x5d, 0x68, 0xb0, 0xa2, 0x95, 0x7c, 0x37, 0x58,
+		0xa8, 0xfe, 0xc7, 0x24, 0xa4, 0xf8, 0x4f, 0xf2, 0x12, 0xcc, 0x08, 0xb6, 0x0a, 0xc9, 0x7c, 0x68,
+		0x71, 0x78, 0x33, 0x5a, 0xf4, 0x36, 0x0d, 0x41, 0x4f, 0xe2, 0x90, 0xc7, 0x20, 0xdd, 0xdc, 0x37,
+		0x4c, 0x4b, 0x37, 0x5b, 0xc2, 0x00, 0xcc, 0x7e, 0xee, 0xcd, 0xd3, 0x33, 0x55, 0x2c, 0xab, 0xaf,
+		0x68, 0x33, 0xac, 0xb2, 0xde, 0xc2, 0x9d, 0x7f, 0x9f, 0x9a, 0x7b, 0xfb, 0xae, 0x58, 0x5d, 0xe2,
+		0x0b, 0x9f, 0xc2, 0x42, 0x81, 0x10, 0xb7, 0xbc, 0xcb, 0x43, 0x76, 0xbc, 0x17, 0x80, 0x59, 0x4e,
+		0x63, 0xc3, 0x1f, 0xfd, 0x0f, 0xa7, 0x63, 0x1a, 0xc3, 0x20, 0x55, 0xc8, 0xb7, 0x0d, 0xc7, 0xd5,
+		0xd9, 0xae, 0x85, 0xcd, 0x4f, 0x33, 0x12, 0x27, 0x86, 0x19, 0x22, 0x18, 0x2b, 0xba, 0x9e, 0x45,
+		0x2c, 0x5e, 0xd4, 0xc2, 0x4b, 0xa8, 0x8c, 0x08, 0xe6, 0x1c, 0x9b, 0x2e, 0xb7, 0xa5, 0x52, 0x8c,
+		0xef, 0x05, 0x2c, 0xaf, 0xb2, 0x62, 0x66, 0x51, 0x9d, 0x84, 0x0c, 0xbb, 0x57, 0xca, 0x40, 0x78,
+		0xb2, 0x78, 0x1a, 0x0b, 0x58, 0xe5, 0xe3, 0x50, 0xf4, 0x75, 0x23, 0x07, 0x49, 0x73, 0x2a, 0x7e,
+		0x31, 0x03, 0x7c, 0x1a, 0xe6, 0x2d, 0x7a, 0xdb, 0xd5, 0xfd, 0x62, 0x0e, 0x9d, 0x61, 0xd0, 0x04,
+		0xeb, 0xae, 0x85, 0x31, 0x1e, 0x85, 0x42, 0x53, 0x32, 0x9f, 0xc3, 0x02, 0x83, 0xcd, 0x7b, 0xa5,
+		0x0c, 0xec, 0x04, 0xa4, 0x8d, 0x6e, 0x97, 0x03, 0x64, 0x85, 0x6e, 0xec, 0x76, 0x59, 0xd5, 0x59,
+		0x98, 0x65, 0x63, 0xec, 0x51, 0xa7, 0xdf, 0x76, 0x05, 0x91, 0x1c, 0x83, 0x29, 0x62, 0x85, 0xc6,
+		0xcb, 0x19, 0xec, 0x23, 0x90, 0xa7, 0x37, 0x31, 0x53, 0xbf, 0x49, 0x39, 0x5c, 0x9e, 0xc1, 0xe5,
+		0x64, 0x21, 0x03, 0x7a, 0x02, 0x3c, 0x9d, 0xa7, 0x4b, 0x7d, 0x5c, 0xe0, 0xf4, 0x64, 0x79, 0x85,
+		0x17, 0xab, 0x25, 0x48, 0xae, 0x18, 0xae, 0x81, 0x46, 0x85, 0x7b, 0x9b, 0x6f, 0x32, 0x39, 0x0d,
+		0x7f, 0xaa, 0x5f, 0x88, 0x43, 0xf2, 0x9a, 0xed, 0x52, 0xf2, 0x6c, 0xc0, 0xe0, 0x2b, 0x8c, 0x92,
+		0xe7, 0x86, 0xb9, 0x67, 0xd1, 0xd6, 0x9a, 0xb3, 0x17, 0x78, 0x04, 0xc6, 0x17, 0xa7, 0x78, 0x48,
+		0x9c, 0xe6, 0x61, 0xba, 0x67, 0xf7, 0xad, 0x96, 0x4c, 0xb3, 0x66, 0x1f, 0xa4, 0x06, 0x69, 0x4f,
+		0x4a, 0x92, 0x51, 0x52, 0x52, 0x44, 0x29, 0x41, 0x19, 0x16, 0x05, 0xda, 0xcc, 0x8e, 0x10, 0x96,
+		0x65, 0xc8, 0x78, 0xca, 0xab, 0x34, 0x7d, 0x04, 0x81, 0xf5, 0xd1, 0x70, 0x23, 0xf1, 0xe6, 0xde,
+		0x63, 0x1e, 0x97, 0x38, 0xc5, 0xab, 0x10, 0xdc, 0x0b, 0x89, 0x95, 0x78, 0x90, 0x66, 0x86, 0x8d,
+		0xcb, 0x17, 0x2b, 0xfe, 0x28, 0xcd, 0x83, 0x98, 0xb7, 0xb6, 0x67, 0x19, 0x6e, 0xbf, 0x47, 0x85,
+		0xe4, 0xf9, 0x05, 0xea, 0x2f, 0xc7, 0x20, 0xc5, 0x25, 0x39, 0xc0, 0xb7, 0xd8, 0x68, 0xbe, 0xc5,
+		0xc7, 0xf1, 0x2d, 0x71, 0xef, 0x7c, 0xab, 0x00, 0x78, 0x9d, 0x71, 0xc4, 0x3b, 0x21, 0x23, 0xac,
+		0x05, 0xde, 0xc5, 0x86, 0xb9, 0x27, 0x16, 0x6a, 0x00, 0x49, 0xfd, 0xf7, 0x31, 0xc8, 0x78, 0xf5,
+		0xa4, 0x02, 0x79, 0xd9, 0x2f, 0x7d, 0xb7, 0x6d, 0xec, 0x09, 0xd9, 0x79, 0x68, 0x6c, 0xe7, 0x2e,
+		0xb5, 0x8d, 0x3d, 0x2d, 0x2b, 0xfa, 0x83, 0x1f, 0xa3, 0xe7, 0x21, 0x3e, 0x66, 0x1e, 0x42, 0x13,
+		0x9f, 0xb8, 0xb7, 0x89, 0x0f, 0x4d, 0x51, 0x72, 0x70, 0x8a, 0x7e, 0x3a, 0xce, 0x9c, 0x97, 0xae,
+		0xed, 0x18, 0xed, 0xaf, 0xc4, 0x8a, 0x38, 0x09, 0x99, 0xae, 0xdd, 0xd6, 0x79, 0x0d, 0xbf, 0x7e,
+		0x90, 0xee, 0xda, 0x6d, 0x6d, 0x68, 0xda, 0xa7, 0xef, 0xd3, 0x72, 0x49, 0xdd, 0x07, 0xae, 0xcd,
+		0x0c, 0x72, 0xad, 0x07, 0x39, 0xce, 0x0a, 0xb1, 0x97, 0x3d, 0x8d, 0x3c, 0xc0, 0x5f, 0xa5, 0xd8,
+		0xf0, 0xde, 0xcb, 0xbb, 0xcd, 0x21, 0xb5, 0xd4, 0xbe, 0x87, 0xc1, 0x55, 0x7f, 0x29, 0x3e, 0x0e,
+		0x83, 0x8b, 0x9d, 0x26, 0xe0, 0xd4, 0xef, 0x8e, 0x01, 0xac, 0x22, 0x67, 0xd9, 0x78, 0x71, 0x17,
+		0x72, 0x58, 0x17, 0xf4, 0x50, 0xcb, 0xa7, 0xc6, 0x4d, 0x9a, 0x68, 0x3f, 0xe7, 0x04, 0xfb, 0x5d,
+		0x85, 0xbc, 0x2f, 0x8c, 0x0e, 0x95, 0x9d, 0x39, 0x75, 0x88, 0x45, 0xdd, 0xa0, 0xae, 0x96, 0xbb,
+		0x19, 0xf8, 0x52, 0xff, 0x71, 0x0c, 0x32, 0xac, 0x4f, 0xf8, 0xca, 0x41, 0x68, 0x0e, 0x63, 0xf7,
+		0x3e, 0x87, 0x0f, 0x01, 0x70, 0x32, 0x78, 0x8a, 0x2f, 0x24, 0x2b, 0xc3, 0x4a, 0xf0, 0x6c, 0x1e,
+		0x63, 0x4b, 0x62, 0xd8, 0x89, 0xc3, 0x19, 0x2e, 0x2d, 0x6e, 0xc1, 0xf6, 0x07, 0x60, 0x86, 0xbd,
+		0xab, 0x77, 0xdb, 0x11, 0x46, 0x34, 0x3e, 0xa6, 0xb3, 0x75, 0xdb, 0x51, 0x5f, 0x87, 0x99, 0xad,
+		0xdb, 0x3c, 0x16, 0x72, 0x12, 0x32, 0x3d, 0xdb, 0x16, 0x7b, 0x32, 0xb7, 0x85, 0xd2, 0x58, 0xc0,
+		0xb6, 0x20, 0xe9, 0xff, 0xc7, 0x7d, 0xff, 0xdf, 0x0f, 0x60, 0x24, 0x26, 0x0a, 0x60, 0x9c, 0xfd,
+		0x37, 0x31, 0xc8, 0x06, 0xf4, 0x03, 0x79, 0x06, 0x8e, 0x2d, 0xaf, 0x6e, 0x54, 0x5f, 0xd1, 0xeb,
+		0x2b, 0xfa, 0xa5, 0xd5, 0xca, 0x65, 0xff, 0x86, 0x5d, 0xf9, 0xf8, 0x9d, 0xbb, 0x0b, 0x24, 0x00,
+		0xbb, 0x6d, 0xb1, 0x53, 0x1e, 0x72, 0x0e, 0xe6, 0xc3, 0x28, 0x95, 0xe5, 0x06, 0x5e, 0xb7, 0x8b,
+		0x95, 0x8f, 0xdd, 0xb9, 0xbb, 0x30, 0x1b, 0xc0, 0xa8, 0xec, 0x38, 0xd4, 0x72, 0x87, 0x11, 0xaa,
+		0x1b, 0x6b, 0x6b, 0xf5, 0x2d, 0x25, 0x3e, 0x84, 0x20, 0x14, 0xf6, 0x13, 0x30, 0x1b, 0x46, 0x58,
+		0xaf, 0xaf, 0x2a, 0x89, 0x32, 0xb9, 0x73, 0x77, 0xa1, 0x10, 0x80, 0x5e, 0x37, 0xdb, 0xe5, 0xf4,
+		0xb7, 0xfe, 0xd0, 0xa9, 0xa9, 0x4f, 0xff, 0xed, 0x53, 0x31, 0x1c, 0x59, 0x3e, 0xa4, 0x23, 0xc8,
+		0x3b, 0xe1, 0x81, 0x46, 0xfd, 0xf2, 0x7a, 0x6d, 0x45, 0x5f, 0x6b, 0x5c, 0x1e, 0xb8, 0x34, 0x5d,
+		0x2e, 0xde, 0xb9, 0xbb, 0x90, 0x15, 0x43, 0x1a, 0x07, 0xbd, 0xa9, 0xd5, 0xae, 0x6d, 0x6c, 0xd5,
+		0x94, 0x18, 0x87, 0xde, 0xec, 0xd1, 0x9b, 0xb6, 0xcb, 0x9f, 0xe4, 0x7c, 0x1a, 0x4e, 0x8c, 0x80,
+		0xf6, 0x06, 0x36, 0x7b, 0xe7, 0xee, 0x42, 0x7e, 0xb3, 0x47, 0xf9, 0xfa, 0x61, 0x18, 0x8b, 0x50,
+		0x1a, 0xc6, 0xd8, 0xd8, 0xdc, 0x68, 0x54, 0x56, 0x95, 0x85, 0xb2, 0x72, 0xe7, 0xee, 0x42, 0x4e,
+		0x2a, 0x43, 0x84, 0xf7, 0x47, 0xf6, 0x76, 0x79, 0x3b, 0xdf, 0x32, 0x03, 0x0f, 0x0d, 0x22, 0x9d,
+		0xeb, 0x1a, 0x3d, 0xa3, 0x73, 0x54, 0x77, 0x27, 0xe2, 0x38, 0x57, 0xfd, 0x70, 0x1c, 0x8a, 0x9e,
+		0x31, 0xbd, 0xc9, 0x5a, 0x20, 0xcf, 0x06, 0xe3, 0x30, 0xd9, 0xb1, 0xdb, 0x18, 0x87, 0x96, 0x61,
+		0x1a, 0x0c, 0xe4, 0x0b, 0xa3, 0x4c, 0xa8, 0x8b, 0x85, 0x61, 0xbc, 0x9a, 0x80, 0x10, 0xa8, 0x1e,
+		0x06, 0x79, 0x19, 0x32, 0x9e, 0xf2, 0xf0, 0x9e, 0xad, 0x1a, 0xaf, 0x6d, 0x04, 0xbe, 0x8f, 0x83,
+		0x2f, 0x49, 0x49, 0xb7, 0x21, 0x39, 0xce, 0x11, 0xb9, 0xc6, 0x01, 0x04, 0xb2, 0x84, 0x57, 0xeb,
+		0x62, 0xd9, 0x89, 0xd1, 0xb3, 0x27, 0x3f, 0x6e, 0xeb, 0xdc, 0x93, 0xe2, 0x56, 0x49, 0xba, 0x63,
+		0xdc, 0x5e, 0xc6, 0x6f, 0x54, 0x14, 0x58, 0xb9, 0x27, 0x2e, 0x95, 0x27, 0xb4, 0x54, 0xc7, 0xb8,
+		0x7d, 0xd9, 0x70, 0xae, 0x26, 0xd3, 0x09, 0x25, 0xa9, 0xfe, 0x58, 0x0c, 0x0a, 0xe1, 0x31, 0x92,
+		0x27, 0x81, 0x20, 0x06, 0x9e, 0x97, 0xa0, 0x8a, 0x61, 0xcc, 0x92, 0x74, 0x8b, 0x1d, 0xe3, 0x76,
+		0x65, 0x8f, 0xae, 0xf7, 0x3b, 0xac, 0x03, 0x0e, 0xc1, 0xc3, 0x15, 0x01, 0x2c, 0xe7, 0x49, 0x30,
+		0xf3, 0xc4, 0xf0, 0x23, 0x96, 0x02, 0x80, 0x6f, 0x54, 0x1f, 0xc7, 0x8d, 0xaa, 0xc0, 0xe9, 0xc9,
+		0x9a, 0xf0, 0x50, 0x12, 0xe1, 0xa1, 0xa8, 0x2f, 0x43, 0x71, 0x80, 0x9f, 0x44, 0x85, 0xbc, 0x88,
+		0x0a, 0xb0, 0xa3, 0x4e, 0x6e, 0x1f, 0x67, 0xb4, 0x2c, 0xf7, 0xfe, 0xd9, 0x91, 0xf0, 0x52, 0xfa,
+		0xe7, 0x30, 0x14, 0x85, 0x01, 0xf3, 0x27, 0x21, 0x1f, 0xe2, 0xa8, 0x8c, 0xd4, 0xc5, 0xfc, 0x48,
+		0x9d, 0x0f, 0x7c, 0x1d, 0x72, 0xa8, 0x28, 0x69, 0x4b, 0xc0, 0x3e, 0x06, 0x45, 0xae, 0xc8, 0x07,
+		0x79, 0xcd, 0x2d, 0xa9, 0x35, 0xc9, 0x70, 0x15, 0xf2, 0x3e, 0x9c, 0xcf, 0xf6, 0xac, 0x84, 0xba,
+		0x6c, 0x38, 0xcb, 0xef, 0xbb, 0xa7, 0x45, 0xf8, 0xe9, 0xcf, 0x9d, 0x8a, 0x45, 0x2e, 0xc4, 0xdf,
+		0x7c, 0x1f, 0x9c, 0x0c, 0x20, 0x1a, 0x3b, 0x4d, 0x33, 0x14, 0x75, 0x28, 0xfa, 0x95, 0x8b, 0x58,
+		0x19, 0x15, 0x3d, 0x38, 0x34, 0x86, 0x71, 0x78, 0xc0, 0xac, 0x7c, 0xb8, 0x46, 0x88, 0x0e, 0x6c,
+		0x8c, 0x8e, 0x51, 0xfe, 0xef, 0x34, 0xcc, 0x68, 0xf4, 0x43, 0x7d, 0xea, 0xb8, 0xe4, 0x3c, 0x24,
+		0x69, 0x73, 0xdf, 0x1e, 0x15, 0x12, 0xc2, 0xc1, 0x2d, 0x0a, 0xb8, 0x5a, 0x73, 0xdf, 0xbe, 0x32,
+		0xa5, 0x31, 0x58, 0x72, 0x01, 0xa6, 0x77, 0xdb, 0x7d, 0x11, 0xa7, 0x18, 0x50, 0x16, 0x41, 0xa4,
+		0x4b, 0x08, 0x74, 0x65, 0x4a, 0xe3, 0xd0, 0xd8, 0x14, 0x7b, 0x28, 0x38, 0x71, 0x78, 0x53, 0x78,
+		0x9b, 0x14, 0x9b, 0x42, 0x58, 0xb2, 0x0c, 0x60, 0x5a, 0xa6, 0xab, 0x33, 0x1f, 0xbe, 0x34, 0x3d,
+		0xac, 0x25, 0xc2, 0x98, 0xa6, 0xcb, 0xbc, 0x7e, 0x8c, 0xa4, 0x9a, 0xf2, 0x03, 0xbb, 0xfb, 0xa1,
+		0x3e, 0xed, 0x1d, 0x94, 0x52, 0x87, 0x77, 0xf7, 0x7d, 0x08, 0x84, 0xdd, 0x65, 0xd0, 0xa4, 0x06,
+		0x59, 0x76, 0x43, 0x96, 0xaf, 0x5f, 0xf1, 0x34, 0xad, 0x3a, 0x0e, 0x79, 0x19, 0x41, 0xd9, 0x92,
+		0xbe, 0x32, 0xa5, 0xc1, 0x8e, 0xf7, 0x85, 0x4a, 0x92, 0x3f, 0x5d, 0xe6, 0xde, 0x2e, 0xa5, 0x87,
+		0xd5, 0x54, 0x90, 0x06, 0x7b, 0xbf, 0x6c, 0xeb, 0x36, 0x46, 0x89, 0x9b, 0xfc, 0x27, 0x8e, 0xbf,
+		0x45, 0xdb, 0x26, 0xbe, 0x0c, 0xe7, 0xde, 0x2e, 0x65, 0x0e, 0x1f, 0xff, 0x0a, 0x87, 0x64, 0x14,
+		0x32, 0x2d, 0xf9, 0x81, 0x8a, 0x96, 0x5a, 0x2d, 0x31, 0x0c, 0x18, 0xd6, 0xd3, 0xa1, 0x79, 0xb6,
+		0x5a, 0x72, 0x10, 0x69, 0x2a, 0x7e, 0x93, 0x77, 0x79, 0x16, 0x6a, 0x76, 0xd8, 0x28, 0x0c, 0x0d,
+		0x80, 0xc7, 0x2a, 0xa6, 0xa4, 0xa5, 0x4a, 0xd6, 0xa1, 0xd0, 0x36, 0x1d, 0x57, 0x77, 0x2c, 0xa3,
+		0xeb, 0xec, 0xdb, 0xae, 0xc3, 0x9c, 0x7e, 0x7c, 0x24, 0x78, 0x0c, 0x85, 0x55, 0xd3, 0x71, 0x1b,
+		0x12, 0xf8, 0xca, 0x94, 0x96, 0x6f, 0x07, 0x0b, 0x90, 0x9e, 0xbd, 0xbb, 0x4b, 0x7b, 0x1e, 0xc1,
+		0x52, 0xfe, 0x70, 0x7a, 0x1b, 0x08, 0x2d, 0xf1, 0x91, 0x9e, 0x1d, 0x2c, 0x20, 0x5f, 0x03, 0x73,
+		0x6d, 0xdb, 0x68, 0x79, 0xe4, 0xf4, 0xe6, 0x7e, 0xdf, 0xba, 0xc1, 0x22, 0x09, 0xf8, 0x92, 0xe5,
+		0xb8, 0x4e, 0xda, 0x46, 0x4b, 0x92, 0xa8, 0x22, 0xc2, 0x95, 0x29, 0x6d, 0xb6, 0x3d, 0x58, 0x48,
+		0x3e, 0x08, 0xf3, 0x46, 0xb7, 0xdb, 0x3e, 0x18, 0xa4, 0x5e, 0x64, 0xd4, 0xcf, 0x8e, 0xa3, 0x5e,
+		0x41, 0x9c, 0x41, 0xf2, 0xc4, 0x18, 0x2a, 0x25, 0x5b, 0x18, 0x03, 0xa1, 0xec, 0x52, 0x4d, 0x57,
+		0x98, 0x2a, 0xec, 0xc9, 0x21, 0x7c, 0x4d, 0x7c, 0x0c, 0xed, 0x4d, 0x0e, 0x2f, 0x2d, 0x9b, 0x2b,
+		0x53, 0x18, 0x2e, 0x09, 0x15, 0x71, 0xaa, 0x76, 0x93, 0xb2, 0x17, 0xd1, 0x04, 0xd5, 0xd9, 0x28,
+		0xaa, 0x0c, 0x3e, 0x4c, 0x35, 0x54, 0xb4, 0x3c, 0x23, 0x92, 0xe2, 0xc4, 0x8b, 0x31, 0x8f, 0x43,
+		0x36, 0xa0, 0x58, 0x30, 0xa8, 0x2e, 0x92, 0x04, 0x64, 0x32, 0x9d, 0xf8, 0x54, 0x0b, 0x90, 0x0b,
+		0x2a, 0x13, 0xf5, 0xa3, 0x31, 0xc8, 0x06, 0xf4, 0x04, 0x62, 0x06, 0x43, 0x87, 0x19, 0x3f, 0x2a,
+		0xf8, 0x88, 0xdc, 0x45, 0x64, 0x3d, 0x3f, 0x4d, 0xca, 0xb1, 0x42, 0xb1, 0x89, 0xe1, 0x9d, 0xf9,
+		0xee, 0xf9, 0xae, 0x07, 0x92, 0x60, 0x20, 0xd0, 0x3d, 0xdf, 0x95, 0x00, 0x0f, 0x43, 0x0e, 0x47,
+		0xaa, 0x07, 0x0d, 0x8d, 0x8c, 0x96, 0xc5, 0x32, 0x01, 0xa2, 0xfe, 0x46, 0x1c, 0x94, 0x41, 0x05,
+		0xe4, 0xc5, 0x14, 0x63, 0x47, 0x8e, 0x29, 0x9e, 0x18, 0x8c, 0x66, 0xfa, 0x01, 0xcc, 0x55, 0x50,
+		0xfc, 0x38, 0x1c, 0xdf, 0x08, 0xc6, 0x1b, 0x4e, 0x03, 0x16, 0x9e, 0x56, 0x6c, 0x86, 0x0b, 0xc8,
+		0xa5, 0xd0, 0xd9, 0x8b, 0x7c, 0x09, 0x7f, 0x70, 0x8a, 0x3d, 0x7b, 0x61, 0xbb, 0xdb, 0x32, 0x5c,
+		0x2a, 0xe3, 0x22, 0x3e, 0x26, 0x6e, 0xeb, 0x18, 0xf6, 0x73, 0x5c, 0xc3, 0xa5, 0x62, 0x5b, 0x9f,
+		0xe6, 0xe1, 0x41, 0xa3, 0xdb, 0x6d, 0x60, 0x29, 0xdf, 0xd6, 0x1f, 0x85, 0x02, 0xea, 0x64, 0xd3,
+		0x68, 0xeb, 0x22, 0x4a, 0x90, 0xe2, 0xbb, 0xbf, 0x28, 0xbd, 0xc2, 0x0a, 0xd5, 0x16, 0xe4, 0x82,
+		0xfa, 0xd8, 0x73, 0xb3, 0x62, 0x01, 0x37, 0x8b, 0x88, 0x27, 0x10, 0x38, 0x7f, 0xe4, 0xb3, 0x11,
+		0xa3, 0xa3, 0xbb, 0xf3, 0xcc, 0x25, 0xbb, 0xc9, 0x03, 0x1e, 0x69, 0x8d, 0x7f, 0xa8, 0x1f, 0x89,
+		0xc3, 0xec, 0x90, 0xe6, 0x1e, 0x19, 0xf6, 0xf6, 0xfd, 0xcb, 0xf8, 0x91, 0xfc, 0xcb, 0x57, 0xc2,
+		0x61, 0xdd, 0xc0, 0xce, 0x77, 0x72, 0x88, 0xc9, 0x5c, 0x6f, 0xa2, 0x40, 0x0b, 0x22, 0x81, 0xc8,
+		0x2f, 0x96, 0x92, 0x6d, 0x98, 0xdf, 0x39, 0x78, 0xc3, 0xb0, 0x5c, 0xd3, 0xa2, 0xfa, 0xd0, 0xac,
+		0x0d, 0x6f, 0xa5, 0x6b, 0xa6, 0xb3, 0x43, 0xf7, 0x8d, 0x9b, 0xa6, 0x2d, 0xbb, 0x35, 0xe7, 0xe1,
+		0xfb, 0xc1, 0x5d, 0x55, 0x83, 0x42, 0x78, 0xeb, 0x21, 0x05, 0x88, 0xbb, 0xb7, 0xc5, 0xf8, 0xe3,
+		0xee, 0x6d, 0xf2, 0xb4, 0x88, 0x03, 0xc5, 0x59, 0x1c, 0x68, 0xb8, 0x21, 0x81, 0xe7, 0x07, 0x81,
+		0x54, 0x15, 0x94, 0xc1, 0xed, 0x68, 0x90, 0xaa, 0xfa, 0x04, 0x14, 0x07, 0xf6, 0x9b, 0x71, 0x51,
+		0x41, 0xb5, 0x08, 0x79, 0x01, 0xca, 0xd9, 0xa1, 0x1e, 0x87, 0xf9, 0x51, 0x7b, 0x85, 0xba, 0x0f,
+		0xf3, 0xa3, 0x74, 0x3e, 0xb9, 0x00, 0x69, 0x6f, 0xb3, 0x18, 0x11, 0x85, 0x60, 0xa3, 0x90, 0xc0,
+		0x9a, 0x07, 0x1a, 0x0a, 0x66, 0xc7, 0x43, 0xc1, 0x6c, 0xf5, 0xeb, 0xa0, 0x34, 0x6e, 0x23, 0x18,
+		0x18, 0x46, 0xd2, 0x93, 0xc2, 0xe3, 0x90, 0x12, 0xcf, 0x1e, 0xc6, 0xd9, 0xf1, 0x8d, 0xf8, 0x42,
+		0xe9, 0xe4, 0x9b, 0x42, 0x82, 0x15, 0xf3, 0x0f, 0x55, 0x87, 0x13, 0x63, 0x37, 0x83, 0xf1, 0x07,
+		0x41, 0x9c, 0x90, 0x38, 0x08, 0x6a, 0xca, 0xee, 0x38, 0x6c, 0xac, 0x32, 0xd9, 0x81, 0x7f, 0xa9,
+		0x1f, 0x4f, 0xc0, 0xf1, 0xd1, 0x5b, 0x02, 0x59, 0x80, 0x1c, 0xda, 0xdd, 0x6e, 0xd8, 0x44, 0x87,
+		0x8e, 0x71, 0x7b, 0x4b, 0xd8, 0xe7, 0x22, 0x90, 0x1e, 0xf7, 0x02, 0xe9, 0x64, 0x1b, 0x66, 0xdb,
+		0x76, 0xd3, 0x68, 0xeb, 0x01, 0x89, 0x17, 0xc2, 0xfe, 0xc8, 0x10, 0xb3, 0x6b, 0xfc, 0x31, 0xf4,
+		0xd6, 0x90, 0xd0, 0x17, 0x19, 0x8d, 0x55, 0x4f, 0xf2, 0xc9, 0x0a, 0x64, 0x3b, 0xbe, 0x20, 0x1f,
+		0x41, 0xd8, 0x83, 0x68, 0x81, 0x29, 0x99, 0x1e, 0x79, 0xec, 0x93, 0x3a, 0xb2, 0x8a, 0x1e, 0x77,
+		0x82, 0x32, 0x33, 0xf6, 0x04, 0x65, 0xd4, 0x71, 0x45, 0x7a, 0xf4, 0x71, 0xc5, 0xb7, 0x06, 0xa7,
+		0x26, 0xb4, 0x89, 0x0e, 0x9f, 0x60, 0x90, 0x06, 0xcc, 0x0b, 0xfc, 0x56, 0x88, 0xf7, 0xf1, 0x49,
+		0x15, 0x0d, 0x91, 0xe8, 0xe3, 0xd9, 0x9e, 0xb8, 0x37, 0xb6, 0x4b, 0x5d, 0x9a, 0x0c, 0xe8, 0xd2,
+		0xff, 0xc7, 0xa6, 0xe2, 0xb7, 0x33, 0x90, 0xd6, 0xa8, 0xd3, 0xc5, 0x8d, 0x13, 0x43, 0xc9, 0xf4,
+		0x76, 0x93, 0x76, 0x5d, 0xff, 0x98, 0x72, 0x94, 0x33, 0xc0, 0xa1, 0x6b, 0x12, 0x12, 0x2d, 0x71,
+		0x0f, 0x0d, 0xa3, 0xea, 0xcc, 0xd9, 0x1a, 0xef, 0x37, 0x09, 0xf4, 0xa0, 0xb7, 0x75, 0x51, 0x7a,
+		0x5b, 0x89, 0xb1, 0xc6, 0x37, 0xc7, 0x1a, 0x70, 0xb7, 0x9e, 0x15, 0xee, 0x56, 0x32, 0xa2, 0xb1,
+		0x90, 0xbf, 0x55, 0x0d, 0xf9, 0x5b, 0xa9, 0x88, 0x61, 0x8e, 0x71, 0xb8, 0x2e, 0x4a, 0x87, 0x6b,
+		0x26, 0xa2, 0xc7, 0x03, 0x1e, 0xd7, 0xa5, 0xb0, 0xc7, 0x95, 0x1e, 0xa3, 0x40, 0x24, 0xf6, 0x58,
+		0x97, 0xeb, 0xa5, 0x80, 0xcb, 0x95, 0x19, 0xeb, 0xef, 0x70, 0x22, 0x23, 0x7c, 0xae, 0x6a, 0xc8,
+		0xe7, 0x82, 0x08, 0x1e, 0x8c, 0x71, 0xba, 0xde, 0x1b, 0x74, 0xba, 0xb2, 0x63, 0xfd, 0x36, 0x31,
+		0xdf, 0xa3, 0xbc, 0xae, 0x17, 0x3c, 0xaf, 0x2b, 0x37, 0xd6, 0x6d, 0x14, 0x63, 0x18, 0x74, 0xbb,
+		0x36, 0x86, 0xdc, 0xae, 0xbc, 0xf8, 0x3b, 0x23, 0xe3, 0x48, 0x44, 0xf8, 0x5d, 0x1b, 0x43, 0x7e,
+		0x57, 0x21, 0x82, 0x60, 0x84, 0xe3, 0xf5, 0xb5, 0xa3, 0x1d, 0xaf, 0xf1, 0xae, 0x91, 0xe8, 0xe6,
+		0x64, 0x9e, 0x97, 0x3e, 0xc6, 0xf3, 0xe2, 0xde, 0xd1, 0x93, 0x63, 0xc9, 0x4f, 0xec, 0x7a, 0x6d,
+		0x8f, 0x70, 0xbd, 0xb8, 0x93, 0x74, 0x66, 0x2c, 0xf1, 0x09, 0x7c, 0xaf, 0xed, 0x11, 0xbe, 0x17,
+		0x89, 0x24, 0x7b, 0x14, 0xe7, 0x0b, 0x9f, 0x87, 0x7c, 0x02, 0x66, 0x25, 0xb2, 0xa7, 0xa7, 0xd0,
+		0x7e, 0xa0, 0xbd, 0x9e, 0xdd, 0x93, 0x99, 0xb5, 0xec, 0x43, 0x3d, 0x03, 0x39, 0x0f, 0xf4, 0x70,
+		0x47, 0x8d, 0xd9, 0x69, 0x01, 0x3d, 0xa4, 0xfe, 0x5c, 0x0c, 0x72, 0x41, 0x15, 0x13, 0x32, 0xe4,
+		0x33, 0xc2, 0x90, 0x0f, 0xb8, 0x6f, 0xf1, 0xb0, 0xfb, 0x86, 0xcf, 0x79, 0x76, 0x87, 0x3c, 0x33,
+		0xa3, 0xeb, 0x79, 0x66, 0x32, 0xa5, 0x80, 0x3b, 0x79, 0x62, 0x5b, 0xe1, 0x27, 0x39, 0x45, 0x2f,
+		0xbd, 0x82, 0xfb, 0x14, 0xe4, 0x29, 0x98, 0x0b, 0xc0, 0x7a, 0x76, 0x1d, 0x77, 0x53, 0x14, 0x0f,
+		0xba, 0x22, 0x0c, 0xbc, 0x7f, 0x1a, 0x83, 0xd9, 0x21, 0x15, 0x37, 0xd2, 0xfb, 0x8a, 0xdd, 0x27,
+		0xef, 0x2b, 0x7e, 0xcf, 0xde, 0x57, 0xd0, 0x4e, 0x4d, 0x84, 0xed, 0xd4, 0xff, 0x16, 0x83, 0x7c,
+		0x48, 0xd3, 0xe2, 0x14, 0x34, 0xed, 0x16, 0x15, 0x96, 0x23, 0xfb, 0x8d, 0x46, 0x45, 0xdb, 0xde,
+		0x13, 0xf6, 0x21, 0xfe, 0x44, 0x28, 0x6f, 0xe3, 0xc8, 0x88, 0x7d, 0xc1, 0x33, 0x3a, 0xa7, 0x83,
+		0xf9, 0xbf, 0x22, 0x29, 0x36, 0xe5, 0x27, 0xc5, 0x7a, 0x97, 0xdf, 0x66, 0x02, 0x97, 0xdf, 0xc8,
+		0xbb, 0x20, 0xc3, 0x22, 0xa2, 0x3a, 0x26, 0xc4, 0xa6, 0x87, 0x6d, 0x93, 0x70, 0x42, 0xac, 0xc3,
+		0x52, 0xf5, 0xd8, 0xaf, 0x80, 0xc5, 0x90, 0x09, 0x59, 0x0c, 0x0f, 0x42, 0x06, 0x7b, 0xcf, 0x5f,
+		0x57, 0x07, 0x71, 0x73, 0x52, 0x16, 0xa8, 0x1f, 0x04, 0x32, 0xbc, 0x49, 0x90, 0x2b, 0x90, 0xa2,
+		0x37, 0xd9, 0xab, 0x7e, 0x3c, 0xd1, 0xf0, 0xf8, 0xb0, 0x69, 0x8a, 0xd5, 0xcb, 0x25, 0x64, 0xf2,
+		0x1f, 0xbe, 0x79, 0x5a, 0xe1, 0xd0, 0xef, 0xf4, 0x2e, 0x0a, 0x68, 0x02, 0x5f, 0xfd, 0x77, 0x71,
+		0x28, 0xca, 0x06, 0xa4, 0xe7, 0x34, 0x8a, 0xb7, 0xa3, 0x8e, 0x08, 0x27, 0xe3, 0xf7, 0x29, 0x80,
+		0x3d, 0xc3, 0xd1, 0x6f, 0x19, 0x96, 0x4b, 0x5b, 0x82, 0xe9, 0x81, 0x12, 0x4c, 0xb0, 0xc5, 0xaf,
+		0xbe, 0x43, 0x5b, 0xc2, 0x8d, 0xf6, 0xbe, 0x03, 0xe3, 0x9c, 0xf9, 0xf2, 0xc6, 0x19, 0xe6, 0x72,
+		0x7a, 0x80, 0xcb, 0x01, 0xe7, 0x22, 0x13, 0x74, 0x2e, 0x78, 0x62, 0xb0, 0xc8, 0x4f, 0x04, 0xde,
+		0x37, 0xf9, 0x8d, 0x51, 0x99, 0x0e, 0xed, 0x74, 0x6d, 0xbb, 0xad, 0x73, 0x75, 0xc3, 0xff, 0x8c,
+		0x42, 0x4e, 0x14, 0xd6, 0x98, 0xd6, 0xf9, 0xe6, 0x38, 0xcc, 0x0e, 0x6d, 0xaf, 0x5f, 0x7d, 0x0c,
+		0x66, 0xef, 0x4e, 0x0f, 0x9a, 0x08, 0xa4, 0x11, 0xcc, 0x1a, 0xe9, 0x33, 0xb5, 0x20, 0x05, 0x7a,
+		0x52, 0xfd, 0xa1, 0xdc, 0x0c, 0x17, 0x3b, 0xe4, 0x35, 0x78, 0x60, 0x40, 0xb7, 0x79, 0xa4, 0xe3,
+		0x93, 0xaa, 0xb8, 0x63, 0x61, 0x15, 0x27, 0x49, 0xfb, 0xcc, 0x4a, 0x7c, 0x99, 0xab, 0xae, 0x0e,
+		0x05, 0xc9, 0x0d, 0xe1, 0xa9, 0x8c, 0x9a, 0x7e, 0xf6, 0x67, 0x7b, 0x5c, 0x0c, 0xa0, 0x85, 0xc2,
+		0x41, 0x39, 0x5e, 0x28, 0x82, 0x4c, 0x9b, 0x70, 0x6c, 0xa4, 0xe5, 0x43, 0x9e, 0x87, 0x8c, 0x6f,
+		0x34, 0x71, 0xae, 0x1e, 0x12, 0x2e, 0xf0, 0x61, 0xd5, 0x5f, 0x8a, 0xc1, 0xb1, 0x91, 0xb6, 0x0f,
+		0xa9, 0x41, 0x8a, 0xa7, 0xbd, 0x89, 0x64, 0x9a, 0xa7, 0x26, 0xb3, 0x99, 0x16, 0x79, 0x4e, 0x9c,
+		0x26, 0x90, 0xd5, 0x0f, 0x42, 0x8a, 0x97, 0x90, 0x2c, 0xcc, 0xf8, 0x0f, 0xef, 0x02, 0xa4, 0x2a,
+		0xd5, 0x6a, 0x6d, 0x53, 0xbe, 0xa2, 0xb9, 0xbc, 0xa1, 0xe1, 0x5f, 0xde, 0x02, 0x48, 0x69, 0xb5,
+		0xab, 0xb5, 0xea, 0x96, 0x92, 0xc0, 0xbf, 0x55, 0xc5, 0x7f, 0xeb, 0x97, 0xf0, 0x19, 0xf5, 0x2d,
+		0x25, 0x19, 0x28, 0x6a, 0xd4, 0xd6, 0x57, 0x6a, 0x9a, 0x32, 0xad, 0x3e, 0x03, 0x27, 0x64, 0x3f,
+		0x86, 0xc3, 0x1a, 0x5e, 0x74, 0x21, 0x16, 0x88, 0x2e, 0xe0, 0x13, 0xdc, 0xe5, 0xf1, 0xa6, 0x13,
+		0xb9, 0x3a, 0x30, 0xf0, 0xf3, 0x47, 0xb0, 0xbb, 0x06, 0x46, 0x8f, 0xc1, 0xc3, 0x1e, 0xdd, 0xa5,
+		0x6e, 0x73, 0x9f, 0x9b, 0x72, 0x7c, 0xcb, 0xcc, 0x6b, 0x79, 0x51, 0xca, 0x90, 0x1c, 0x0e, 0xf6,
+		0x3a, 0x6d, 0xba, 0x3a, 0xd7, 0x45, 0x8e, 0xf8, 0xcb, 0xa2, 0x79, 0x5e, 0xda, 0xe0, 0x85, 0xea,
+		0xd7, 0x1d, 0x89, 0x97, 0x19, 0x98, 0xd6, 0x6a, 0xf8, 0x56, 0x7a, 0x02, 0xff, 0x20, 0x17, 0xfb,
+		0xa9, 0x37, 0xd6, 0x2b, 0x9b, 0x8d, 0x2b, 0x1b, 0xc8, 0xcb, 0x39, 0x28, 0x4a, 0x5e, 0xca, 0xc2,
+		0x69, 0xf5, 0x49, 0x78, 0x60, 0x8c, 0xdd, 0x37, 0x22, 0x0f, 0xf1, 0x53, 0xb1, 0x20, 0x74, 0xd8,
+		0xe7, 0xdf, 0x80, 0x94, 0xe3, 0x1a, 0x6e, 0xdf, 0x11, 0x4c, 0x7c, 0x7e, 0x52, 0x43, 0x70, 0x51,
+		0xfe, 0x68, 0x30, 0x74, 0x4d, 0x90, 0x51, 0x2f, 0x40, 0x21, 0x5c, 0x33, 0x9e, 0x07, 0xbe, 0x10,
+		0xc5, 0xd5, 0xd7, 0x00, 0x02, 0xf1, 0x48, 0x2f, 0xa3, 0x2b, 0x16, 0xcc, 0xe8, 0xba, 0x00, 0xd3,
+		0x37, 0x6d, 0xae, 0x33, 0x46, 0x2f, 0x1c, 0x4c, 0xb6, 0x0c, 0x04, 0x1f, 0x38, 0xb4, 0x6a, 0x02,
+		0x19, 0x8e, 0x09, 0x8d, 0x69, 0xe2, 0xa5, 0x70, 0x13, 0x0f, 0x8f, 0x8d, 0x2e, 0x8d, 0x6e, 0xea,
+		0x0d, 0x98, 0x66, 0xda, 0x66, 0xe4, 0x15, 0x9f, 0x0f, 0x00, 0x18, 0xae, 0xdb, 0x33, 0x77, 0xfa,
+		0x7e, 0x03, 0xa7, 0x47, 0x6b, 0xab, 0x8a, 0x84, 0x5b, 0x7e, 0x50, 0xa8, 0xad, 0x79, 0x1f, 0x35,
+		0xa0, 0xba, 0x02, 0x04, 0xd5, 0x75, 0x28, 0x84, 0x71, 0x47, 0x5f, 0x59, 0xf2, 0xdf, 0x0e, 0xc8,
+		0x48, 0xf3, 0xc9, 0x33, 0xbe, 0xc4, 0x7b, 0x1e, 0xec, 0x43, 0xbd, 0x13, 0x83, 0xf4, 0xd6, 0x6d,
+		0x21, 0xc7, 0x87, 0x24, 0x55, 0xfa, 0xf7, 0xb6, 0xbc, 0x60, 0x21, 0x8f, 0xc7, 0x26, 0xbc, 0x28,
+		0xef, 0x7b, 0xbd, 0x95, 0x9a, 0x9c, 0xd4, 0xdb, 0x95, 0xd1, 0x6e, 0xa1, 0x9d, 0x5e, 0x9c, 0xec,
+		0x8e, 0x04, 0x06, 0xe3, 0x03, 0xf7, 0x1b, 0xf8, 0x87, 0xda, 0x0a, 0xa4, 0x25, 0xf0, 0x6d, 0x23,
+		0x78, 0x99, 0x22, 0x76, 0xe4, 0xcb, 0x14, 0x5e, 0x2b, 0xf1, 0x60, 0x2b, 0x37, 0x21, 0x2d, 0x85,
+		0x82, 0xbc, 0x27, 0x98, 0x7b, 0x22, 0xcf, 0x68, 0xc6, 0x6e, 0x9e, 0x82, 0xbc, 0x8f, 0x82, 0xce,
+		0x87, 0x48, 0xb9, 0xf3, 0xfd, 0x0a, 0xf1, 0x27, 0x07, 0x8a, 0xbc, 0x62, 0x55, 0x3a, 0x15, 0xea,
+		0x0f, 0xe3, 0x6b, 0xfc, 0x03, 0x52, 0xf9, 0x95, 0xec, 0x00, 0x2a, 0x45, 0x94, 0xfe, 0xc0, 0x9b,
+		0xdf, 0x7c, 0xe6, 0xf3, 0x58, 0xea, 0xbf, 0xfa, 0xfd, 0x91, 0x38, 0x64, 0x03, 0x31, 0x3d, 0xf2,
+		0x5c, 0x28, 0x05, 0x74, 0xe1, 0xb0, 0xf8, 0x5f, 0x20, 0x07, 0x34, 0x34, 0xb0, 0xf8, 0xd1, 0x07,
+		0x76, 0xff, 0x93, 0xf4, 0x47, 0xdf, 0xf6, 0x99, 0x1e, 0x73, 0xdb, 0xe7, 0x1b, 0x63, 0x90, 0xf6,
+		0xb6, 0xee, 0xa3, 0x46, 0xf3, 0x8f, 0x43, 0x4a, 0xec, 0x4e, 0x3c, 0x9c, 0x2f, 0xbe, 0x46, 0xc6,
+		0x42, 0xcb, 0x90, 0x96, 0x7f, 0x28, 0x4a, 0x38, 0xa2, 0xde, 0xf7, 0xd9, 0x17, 0x20, 0x1b, 0x38,
+		0x58, 0x41, 0x3d, 0xb1, 0x5e, 0x7b, 0xbf, 0x32, 0x55, 0x9e, 0xb9, 0x73, 0x77, 0x21, 0xb1, 0x4e,
+		0x6f, 0xe1, 0x0a, 0xd3, 0x6a, 0xd5, 0x2b, 0xb5, 0xea, 0x2b, 0x4a, 0xac, 0x9c, 0xbd, 0x73, 0x77,
+		0x61, 0x46, 0xa3, 0x2c, 0x7c, 0x75, 0xf6, 0x15, 0x28, 0x0e, 0x4c, 0x4c, 0x58, 0xbf, 0x13, 0x28,
+		0xac, 0x6c, 0x6f, 0xae, 0xd6, 0xab, 0x95, 0xad, 0x9a, 0xce, 0xd3, 0xe9, 0xf0, 0x55, 0xff, 0xd5,
+		0xfa, 0xe5, 0x2b, 0x5b, 0x7a, 0x75, 0xb5, 0x5e, 0x5b, 0xdf, 0xd2, 0x2b, 0x5b, 0x5b, 0x95, 0xea,
+		0x2b, 0x4a, 0xfc, 0xfc, 0x97, 0x00, 0x8a, 0x95, 0xe5, 0x6a, 0x1d, 0xf7, 0x67, 0x53, 0x3c, 0xdb,
+		0x5e, 0x85, 0x24, 0x0b, 0x05, 0x1c, 0x9a, 0x2a, 0x52, 0x3e, 0x3c, 0xb6, 0x49, 0x2e, 0xc1, 0x34,
+		0x8b, 0x12, 0x90, 0xc3, 0x73, 0x47, 0xca, 0x11, 0xc1, 0x4e, 0xec, 0x0c, 0x5b, 0x4e, 0x87, 0x26,
+		0x93, 0x94, 0x0f, 0x8f, 0x7d, 0x12, 0x0d, 0x32, 0xbe, 0x97, 0x11, 0x9d, 0x5c, 0x51, 0x9e, 0x40,
+		0x3b, 0x92, 0x55, 0x98, 0x91, 0x8e, 0x61, 0x54, 0xba, 0x47, 0x39, 0x32, 0x38, 0x89, 0xec, 0xe2,
+		0x0e, 0xfc, 0xe1, 0xb9, 0x2b, 0xe5, 0x88, 0x48, 0x2b, 0xa9, 0x7b, 0x49, 0xf8, 0x11, 0x29, 0x1c,
+		0xe5, 0xa8, 0x60, 0x23, 0x32, 0xcd, 0x0f, 0x8d, 0x44, 0x67, 0xe4, 0x94, 0x27, 0x08, 0x22, 0x93,
+		0x6d, 0x80, 0x80, 0xbb, 0x3e, 0x41, 0xaa, 0x4d, 0x79, 0x92, 0xe0, 0x30, 0xd9, 0x80, 0xb4, 0xe7,
+		0x3d, 0x45, 0x26, 0xbe, 0x94, 0xa3, 0xa3, 0xb4, 0xe4, 0x83, 0x90, 0x0f, 0x7b, 0x0d, 0x93, 0xa5,
+		0xb3, 0x94, 0x27, 0x0c, 0xbf, 0x22, 0xfd, 0xb0, 0x0b, 0x31, 0x59, 0x7a, 0x4b, 0x79, 0xc2, 0x68,
+		0x2c, 0x79, 0x1d, 0x66, 0x87, 0x4d, 0xfc, 0xc9, 0xb3, 0x5d, 0xca, 0x47, 0x88, 0xcf, 0x92, 0x0e,
+		0x90, 0x11, 0xae, 0xc1, 0x11, 0x92, 0x5f, 0xca, 0x47, 0x09, 0xd7, 0x92, 0x16, 0x14, 0x07, 0xed,
+		0xed, 0x49, 0x93, 0x61, 0xca, 0x13, 0x87, 0x6e, 0x79, 0x2b, 0x61, 0x3b, 0x7d, 0xd2, 0xe4, 0x98,
+		0xf2, 0xc4, 0x91, 0xdc, 0xe5, 0xca, 0xf5, 0xc7, 0x0f, 0xcd, 0x71, 0xf4, 0x33, 0x13, 0x23, 0x73,
+		0x1a, 0x7f, 0xf0, 0xbd, 0xf0, 0x0e, 0xf1, 0xd8, 0x80, 0xe3, 0x1a, 0x37, 0x4c, 0x6b, 0xcf, 0x7b,
+		0x1d, 0x42, 0x7c, 0x8b, 0xe4, 0xc6, 0xe3, 0x1c, 0x6a, 0x51, 0x96, 0x46, 0xbc, 0x11, 0x31, 0xf6,
+		0xe1, 0xac, 0xa8, 0x24, 0xe4, 0xe8, 0xd4, 0xc5, 0x43, 0xde, 0x9f, 0x88, 0x78, 0xe5, 0x62, 0xc4,
+		0xfb, 0x14, 0x11, 0x49, 0x98, 0x87, 0xe5, 0x7b, 0xaa, 0x1f, 0x8b, 0x41, 0xe1, 0x8a, 0xe9, 0xb8,
+		0x76, 0xcf, 0x6c, 0x1a, 0x6d, 0xb6, 0x63, 0xbc, 0x38, 0xe9, 0x25, 0x8f, 0xe5, 0x0c, 0x1a, 0x23,
+		0xe2, 0x51, 0x0b, 0x8e, 0x42, 0x56, 0x20, 0x75, 0xd3, 0x68, 0xf3, 0x2b, 0x16, 0xc1, 0xf7, 0x6b,
+		0x06, 0x79, 0x1e, 0xb0, 0x92, 0x82, 0x54, 0x38, 0xae, 0xfa, 0xb3, 0x2c, 0x89, 0xbb, 0xd3, 0x31,
+		0x1d, 0xfe, 0xa7, 0xdc, 0x5d, 0x8a, 0xc7, 0xf0, 0xc9, 0x9e, 0xe1, 0x0a, 0xa7, 0x66, 0xb9, 0x22,
+		0x9e, 0xa2, 0x78, 0x2c, 0xfa, 0xc1, 0x89, 0xc5, 0xa1, 0xd7, 0x2a, 0x78, 0x63, 0x8c, 0x1c, 0xf9,
+		0x5a, 0xc0, 0x0c, 0x62, 0x9d, 0x91, 0x8e, 0xdf, 0x2f, 0xd2, 0x98, 0x53, 0x8d, 0xbd, 0x26, 0x26,
+		0x60, 0x4a, 0x34, 0x1e, 0x38, 0x5a, 0x7b, 0x94, 0x37, 0x92, 0xb8, 0x5f, 0x8d, 0xe4, 0x3b, 0xc6,
+		0xed, 0x2a, 0x23, 0x8c, 0x4d, 0x89, 0x47, 0x3f, 0x7e, 0x25, 0x06, 0xe0, 0x73, 0x8e, 0x18, 0x18,
+		0xd6, 0x97, 0x5f, 0xac, 0x7d, 0x19, 0xd6, 0x7f, 0x7c, 0xdc, 0xc4, 0x0c, 0xf0, 0x7d, 0x39, 0x8f,
+		0x3d, 0xfd, 0xec, 0x9b, 0xa7, 0x63, 0xbc, 0xd5, 0x62, 0x73, 0x60, 0x5e, 0xae, 0x42, 0x96, 0x47,
+		0xd3, 0x74, 0x66, 0xc2, 0xc6, 0x23, 0x4d, 0xd8, 0xbc, 0x34, 0x61, 0x39, 0x41, 0xe0, 0xd8, 0x58,
+		0x2f, 0xc6, 0xf0, 0xe9, 0x18, 0x64, 0x57, 0x02, 0xcf, 0x55, 0xe1, 0xb9, 0x8c, 0x6d, 0x99, 0x37,
+		0x68, 0xcf, 0x3b, 0x97, 0xe1, 0x9f, 0x68, 0x66, 0xf2, 0xbf, 0x7a, 0xe4, 0x1e, 0xc8, 0x97, 0x18,
+		0xe4, 0x37, 0x62, 0xdd, 0xa2, 0x3b, 0x8e, 0x29, 0x59, 0xae, 0xc9, 0x4f, 0x3c, 0x24, 0x77, 0x68,
+		0xb3, 0x8f, 0x21, 0x5b, 0xfc, 0x83, 0x67, 0x2e, 0xfe, 0x35, 0x43, 0x1e, 0x3d, 0x2d, 0xca, 0xf2,
+		0x2a, 0x2f, 0x46, 0x22, 0x2d, 0xea, 0x1a, 0x66, 0x9b, 0xa7, 0x7d, 0x65, 0x34, 0xf9, 0x29, 0xba,
+		0xfa, 0x89, 0x74, 0xd0, 0x35, 0xac, 0x82, 0x62, 0x77, 0x69, 0x2f, 0x74, 0xe5, 0x8d, 0x8b, 0x6b,
+		0xe9, 0xb7, 0x3e, 0xf3, 0xd4, 0xbc, 0x60, 0xb8, 0x38, 0x80, 0xe7, 0xaf, 0x33, 0x6b, 0x45, 0x89,
+		0x21, 0x8a, 0xc9, 0x6b, 0xa1, 0x93, 0x98, 0xfe, 0x8e, 0x7f, 0xe5, 0x7e, 0x7e, 0x88, 0xa9, 0x15,
+		0xeb, 0x60, 0xb9, 0xf4, 0xeb, 0x3e, 0x69, 0xdf, 0x75, 0xc4, 0x4b, 0xee, 0x81, 0x63, 0x19, 0x46,
+		0x06, 0x2d, 0xf8, 0xd7, 0x0d, 0xb3, 0x2d, 0xff, 0x40, 0x9c, 0x26, 0xbe, 0xc8, 0x92, 0x17, 0x86,
+		0x49, 0x32, 0x77, 0x48, 0x1d, 0x27, 0x1b, 0xcb, 0xb6, 0xd5, 0x0a, 0x47, 0x5c, 0xc8, 0x16, 0xa4,
+		0x5c, 0xfb, 0x06, 0xb5, 0x04, 0x83, 0x96, 0xdf, 0x7d, 0x04, 0xc1, 0x1e, 0x7e, 0x7f, 0x46, 0xd0,
+		0x22, 0x7b, 0xa0, 0xb4, 0x68, 0x9b, 0xee, 0x31, 0x56, 0xe2, 0x9f, 0xbe, 0xa5, 0xfc, 0x12, 0xe7,
+		0xd1, 0xe8, 0x0f, 0x3f, 0x53, 0x53, 0xf4, 0xa8, 0x36, 0x18, 0x51, 0xb2, 0x19, 0x7e, 0x2c, 0x6d,
+		0x46, 0x9c, 0xca, 0x8f, 0x19, 0x7f, 0x40, 0x2a, 0x83, 0x6a, 0x2b, 0x48, 0x02, 0xa5, 0xab, 0x6f,
+		0xed, 0xd8, 0x16, 0xfb, 0x9b, 0x4b, 0xc2, 0xc1, 0x4a, 0xf3, 0x93, 0x3b, 0xaf, 0x5c, 0x9c, 0xdc,
+		0x6d, 0x42, 0xc1, 0x07, 0x65, 0xab, 0x27, 0x73, 0xd4, 0xd5, 0x93, 0xf7, 0x08, 0x20, 0x08, 0xfe,
+		0x71, 0x26, 0x7f, 0x7d, 0x7a, 0xa7, 0xfb, 0x91, 0x2b, 0x3d, 0x38, 0x98, 0x00, 0x01, 0xd2, 0x86,
+		0xb9, 0x8e, 0x69, 0xe9, 0x0e, 0x6d, 0xef, 0xea, 0x82, 0x73, 0x48, 0x37, 0x7b, 0x1f, 0x66, 0x7a,
+		0xb6, 0x63, 0x5a, 0x0d, 0xda, 0xde, 0x5d, 0xf1, 0xc8, 0x92, 0x77, 0xc3, 0x49, 0x9f, 0x1d, 0xb6,
+		0xa5, 0xef, 0xdb, 0xed, 0x96, 0xde, 0xa3, 0xbb, 0x7a, 0x93, 0x3d, 0x7f, 0x94, 0x63, 0x4c, 0x7c,
+		0xc0, 0x03, 0xd9, 0xb0, 0xae, 0xd8, 0xed, 0x96, 0x46, 0x77, 0xab, 0x58, 0x8d, 0xa1, 0x71, 0x1f,
+		0xdb, 0x6c, 0x61, 0x56, 0x40, 0x02, 0x53, 0x62, 0xbd, 0xc2, 0x7a, 0xcb, 0x21, 0x4d, 0x28, 0xe0,
+		0x80, 0x02, 0x63, 0x29, 0xdc, 0x87, 0xb1, 0xe4, 0x3b, 0xa6, 0xe5, 0x8f, 0x63, 0x29, 0xfd, 0xad,
+		0x9f, 0x3c, 0x3d, 0xf5, 0x85, 0x4f, 0x9e, 0x9e, 0x52, 0x2f, 0xb1, 0x17, 0x41, 0xc4, 0xca, 0xa6,
+		0x0e, 0xb9, 0x08, 0x19, 0x43, 0x7e, 0xf0, 0xbb, 0x28, 0x87, 0x68, 0x06, 0x1f, 0x54, 0xfd, 0x64,
+		0x0c, 0x52, 0x2b, 0xd7, 0x36, 0x0d, 0xb3, 0x47, 0x6a, 0xf8, 0x96, 0x9c, 0x5c, 0x19, 0x93, 0x2a,
+		0x19, 0x7f, 0x31, 0x89, 0x72, 0x24, 0x33, 0xfa, 0x7a, 0xee, 0xa1, 0x64, 0x06, 0x2f, 0xee, 0x06,
+		0x86, 0x7a, 0x15, 0x66, 0x78, 0x0f, 0x1d, 0xf2, 0x32, 0x4c, 0x77, 0xf1, 0x47, 0x29, 0x16, 0x7a,
+		0x55, 0x6e, 0x78, 0x35, 0x31, 0xf8, 0xa0, 0xec, 0x71, 0x3c, 0xf5, 0x2f, 0x63, 0x00, 0x2b, 0xd7,
+		0xae, 0x6d, 0xf5, 0xcc, 0x6e, 0x9b, 0xba, 0xf7, 0x6b, 0xc8, 0xab, 0x70, 0xcc, 0x1f, 0xb2, 0xd3,
+		0x6b, 0x4e, 0x3c, 0xec, 0x39, 0x0f, 0xad, 0xd1, 0x6b, 0x8e, 0xa4, 0xd6, 0x72, 0x5c, 0x8f, 0x5a,
+		0x62, 0x62, 0x6a, 0x2b, 0x8e, 0x3b, 0xcc, 0xc7, 0x57, 0x21, 0xeb, 0x0f, 0xdd, 0x21, 0x75, 0x48,
+		0xbb, 0xe2, 0xb7, 0x60, 0xa7, 0x3a, 0x9e, 0x9d, 0x12, 0x2d, 0xc8, 0x52, 0x0f, 0x5d, 0xfd, 0x1f,
+		0xc8, 0x55, 0x7f, 0xb5, 0xfd, 0x95, 0x12, 0x24, 0xdc, 0x46, 0x84, 0x9a, 0x4f, 0xdc, 0x07, 0x35,
+		0x2f, 0x68, 0x05, 0xd8, 0xfa, 0x4d, 0x71, 0xfc, 0x93, 0x8c, 0x42, 0x13, 0xfc, 0x95, 0xe5, 0xc2,
+		0x36, 0xcc, 0x50, 0xcb, 0xed, 0x99, 0x54, 0x9e, 0x27, 0x3e, 0x3d, 0x6e, 0xb2, 0x47, 0x8c, 0x85,
+		0xfd, 0x0d, 0xef, 0xe0, 0xd4, 0x4b, 0x5a, 0x01, 0x36, 0xfc, 0x62, 0x02, 0x4a, 0xe3, 0x50, 0xf1,
+		0x35, 0x84, 0x66, 0x8f, 0xb2, 0x02, 0x3d, 0x14, 0x6f, 0x2f, 0xc8, 0x62, 0xb1, 0x6f, 0x69, 0x80,
+		0x56, 0x20, 0x4a, 0x15, 0x82, 0xde, 0x9b, 0xd9, 0x57, 0xf0, 0x29, 0x20, 0x0c, 0xa1, 0x50, 0x94,
+		0x09, 0xf4, 0x3b, 0x46, 0xdb, 0xc0, 0x5b, 0x97, 0x89, 0xfb, 0xa0, 0x9a, 0x65, 0x56, 0xfe, 0x32,
+		0xa7, 0x49, 0xae, 0xc1, 0x8c, 0x24, 0x9f, 0xbc, 0x0f, 0xe4, 0x25, 0x31, 0xbc, 0x4a, 0x11, 0xdc,
+		0x7d, 0x98, 0x31, 0x94, 0xd4, 0xb2, 0x5e, 0x59, 0xbd, 0x15, 0xb5, 0xbd, 0xa5, 0x0e, 0xdd, 0xde,
+		0x84, 0xbd, 0xf9, 0xb1, 0x24, 0x26, 0x0f, 0xb4, 0xbe, 0x0a, 0x27, 0xee, 0x6b, 0x00, 0xf8, 0xa2,
+		0x46, 0x65, 0x5b, 0x4a, 0xde, 0x07, 0x25, 0x91, 0xe1, 0xf4, 0x56, 0x1c, 0xf7, 0x6d, 0x9f, 0xbd,
+		0xa0, 0xd8, 0xcd, 0xdc, 0x47, 0xb1, 0x13, 0x52, 0xf1, 0x2f, 0xe3, 0x90, 0x0b, 0x4a, 0xc5, 0x57,
+		0xc1, 0x8e, 0x49, 0xd6, 0x7d, 0x55, 0xc9, 0x53, 0xe7, 0x9f, 0x18, 0xa7, 0x2a, 0x87, 0xd6, 0x4b,
+		0x84, 0x8e, 0xfc, 0x27, 0x19, 0x48, 0x89, 0x3c, 0xb6, 0x8d, 0x21, 0x03, 0x3d, 0x16, 0x75, 0x79,
+		0x39, 0x2f, 0x2f, 0x2f, 0x8f, 0xb4, 0xcf, 0x1f, 0x05, 0xbc, 0xd2, 0xac, 0x87, 0x92, 0xe3, 0xf0,
+		0x2c, 0x05, 0x7d, 0x79, 0x3f, 0x95, 0x1b, 0xf3, 0x03, 0x11, 0xcc, 0xdf, 0x0b, 0x10, 0x06, 0x6f,
+		0x29, 0xd4, 0x78, 0x09, 0x79, 0x0a, 0xc8, 0xbe, 0x17, 0xb5, 0xd1, 0x7d, 0x46, 0x20, 0xdc, 0xac,
+		0x5f, 0x23, 0xc1, 0xf1, 0x95, 0x09, 0xdb, 0x6a, 0xe9, 0xfc, 0x79, 0x51, 0xf1, 0x54, 0x2b, 0x96,
+		0xac, 0x60, 0x01, 0xf9, 0xce, 0x18, 0xb7, 0xf3, 0x07, 0x42, 0x05, 0xc2, 0xe3, 0xd2, 0x8f, 0xb6,
+		0xca, 0xfe, 0xfc, 0xcd, 0xd3, 0xe5, 0x03, 0xa3, 0xd3, 0x5e, 0x52, 0x47, 0x90, 0x54, 0x47, 0x05,
+		0x32, 0xd0, 0x15, 0x08, 0x47, 0x1d, 0xc8, 0x37, 0xc6, 0xe0, 0xc1, 0x10, 0xa3, 0xf8, 0xc1, 0x96,
+		0xbe, 0xdb, 0x33, 0x9a, 0x9e, 0xa3, 0x76, 0x5f, 0xa2, 0x28, 0x27, 0x82, 0x9c, 0x67, 0xa7, 0x64,
+		0x97, 0x44, 0x1b, 0xe4, 0x05, 0x38, 0xc1, 0x82, 0x37, 0xb6, 0x25, 0x1d, 0x71, 0xbd, 0x27, 0xfe,
+		0x0a, 0x2c, 0x4f, 0xf4, 0xcb, 0x6b, 0xc7, 0x31, 0x06, 0x63, 0x5b, 0xc2, 0xc1, 0xd6, 0x64, 0x2d,
+		0x59, 0x07, 0x25, 0x08, 0xae, 0xef, 0x52, 0xe9, 0xdb, 0x9d, 0x58, 0x1c, 0xf1, 0x4a, 0xed, 0x22,
+		0xbe, 0xff, 0x1a, 0x14, 0xcb, 0x42, 0x80, 0xda, 0x25, 0x4a, 0x49, 0x1f, 0x8e, 0xed, 0xb5, 0xed,
+		0x1d, 0xa3, 0xad, 0x0f, 0xb8, 0x2f, 0x70, 0x64, 0x3e, 0x8c, 0x79, 0x95, 0x76, 0x8e, 0xd3, 0x5f,
+		0x0b, 0x7a, 0x32, 0xc4, 0x81, 0x79, 0x7f, 0x06, 0xda, 0xe6, 0x87, 0xfa, 0x66, 0x4b, 0x6f, 0x1a,
+		0xdd, 0x52, 0xf6, 0xc8, 0xad, 0x8e, 0xe1, 0x3e, 0xf1, 0xc8, 0xaf, 0x32, 0xea, 0x55, 0xa3, 0x4b,
+		0x3a, 0x30, 0x2b, 0xc6, 0x1a, 0x68, 0x31, 0x77, 0xbf, 0x5a, 0x2c, 0x72, 0xda, 0x7e, 0x73, 0xcf,
+		0xc0, 0xb1, 0xc0, 0x5a, 0xd3, 0xbb, 0xb4, 0x27, 0x0e, 0x9c, 0xf3, 0x6c, 0x86, 0x89, 0xbf, 0xea,
+		0x36, 0x69, 0x8f, 0x1f, 0x91, 0x7c, 0x3d, 0x9c, 0x18, 0xe1, 0x16, 0xeb, 0x4c, 0x03, 0x94, 0x0a,
+		0xf7, 0xab, 0xa7, 0xc7, 0x87, 0x3c, 0x64, 0x0d, 0xff, 0x5d, 0x7a, 0x07, 0xea, 0xfc, 0x3b, 0x7f,
+		0xf0, 0x13, 0x67, 0x4f, 0x06, 0x28, 0xdd, 0xf6, 0x42, 0xdf, 0x5c, 0x75, 0xe1, 0xcb, 0x0d, 0x24,
+		0x80, 0x29, 0x2f, 0x7a, 0xac, 0xb1, 0xf4, 0x7f, 0x29, 0x3d, 0xb1, 0xc3, 0x03, 0x04, 0x3e, 0x7e,
+		0x28, 0x40, 0xe0, 0x13, 0x20, 0xef, 0xf1, 0xf7, 0xb5, 0xf8, 0x11, 0xc4, 0x3b, 0xb4, 0x7f, 0x4d,
+		0xa9, 0x6f, 0xc6, 0x30, 0x3d, 0x6b, 0x40, 0x4b, 0x7b, 0x5d, 0x6e, 0x02, 0xe9, 0x05, 0x2a, 0xd9,
+		0x4c, 0xc9, 0xc4, 0x89, 0x7b, 0x53, 0xfa, 0xb3, 0xbd, 0xc1, 0xda, 0xb7, 0xcb, 0x2e, 0x14, 0x1b,
+		0xf4, 0xaf, 0xc5, 0xf0, 0xfe, 0x5e, 0x6b, 0x78, 0x3a, 0x1a, 0x90, 0x0b, 0xf6, 0x45, 0x8c, 0xea,
+		0x1d, 0x93, 0x8c, 0x2a, 0x38, 0xa0, 0x10, 0x11, 0x1c, 0x8b, 0xdc, 0x11, 0x78, 0x10, 0xfe, 0x99,
+		0x89, 0xb9, 0xe4, 0x1d, 0x30, 0x8e, 0xda, 0x22, 0xf9, 0x64, 0x7d, 0x47, 0x1c, 0x92, 0x9b, 0xb6,
+		0xdd, 0x46, 0x1d, 0x3d, 0x6b, 0xd9, 0xae, 0x8e, 0xfb, 0x08, 0x6d, 0xe9, 0x22, 0x0a, 0xc8, 0xad,
+		0x8c, 0x6b, 0x47, 0xe3, 0xde, 0x1f, 0xbe, 0x79, 0x7a, 0x98, 0xd4, 0x28, 0x2d, 0x55, 0xb4, 0x6c,
+		0x77, 0x99, 0x01, 0x6d, 0x31, 0x18, 0x72, 0x0b, 0xf2, 0xe1, 0xf6, 0xb9, 0x69, 0xa2, 0x1d, 0xb9,
+		0xfd, 0x7c, 0x64, 0xdb, 0xb9, 0x9d, 0x40, 0xc3, 0xfc, 0x05, 0xdb, 0x3f, 0xc5, 0xc9, 0xfd, 0xed,
+		0x18, 0x14, 0xbc, 0x1d, 0xe4, 0x52, 0xdb, 0xbe, 0xe5, 0x90, 0x0f, 0xc0, 0xac, 0x6f, 0x37, 0x48,
+		0xb9, 0xe2, 0x9c, 0x79, 0x5a, 0xf4, 0xec, 0x18, 0x27, 0xef, 0xb4, 0x6e, 0x2c, 0x9a, 0xf6, 0xb9,
+		0x8e, 0xe1, 0xee, 0x8f, 0xd3, 0xcc, 0x7e, 0x38, 0x51, 0xda, 0xc2, 0x4d, 0x98, 0xf7, 0x27, 0x3c,
+		0xd0, 0x42, 0xfc, 0x1e, 0x5b, 0x98, 0x0b, 0x52, 0x13, 0x8d, 0xa8, 0x3f, 0x95, 0x80, 0x13, 0x62,
+		0x6b, 0x7b, 0xc5, 0xdf, 0x8c, 0xf8, 0x59, 0xd1, 0x01, 0xde, 0x17, 0x18, 0x13, 0xea, 0x7e, 0xf8,
+		0xb7, 0x3e, 0xf3, 0xd4, 0x43, 0xa2, 0x85, 0x6b, 0x03, 0x0e, 0xee, 0xb8, 0x98, 0xf7, 0x35, 0x28,
+		0xa2, 0x39, 0x1d, 0xd8, 0x69, 0xef, 0x31, 0xe4, 0x9d, 0xb7, 0xdb, 0x2d, 0x7f, 0x3f, 0x46, 0xba,
+		0x16, 0xbd, 0x15, 0xa2, 0x9b, 0xb8, 0x37, 0xba, 0x16, 0xbd, 0x15, 0xa0, 0xeb, 0xa7, 0xce, 0x24,
+		0x43, 0x79, 0x3c, 0x2f, 0x89, 0x3c, 0x9e, 0xe9, 0xa3, 0x3a, 0x55, 0x0c, 0x8d, 0x5c, 0x84, 0xc4,
+		0x2e, 0xe5, 0x66, 0xd7, 0xa4, 0x9a, 0x14, 0x11, 0x02, 0xb6, 0xeb, 0x6f, 0xc4, 0x20, 0x7d, 0xd5,
+		0x30, 0xf9, 0x41, 0xde, 0x12, 0x26, 0xbe, 0x19, 0x8e, 0x50, 0x2b, 0x87, 0x84, 0xf5, 0x11, 0x43,
+		0x63, 0x90, 0x9a, 0xc0, 0x18, 0xfb, 0xda, 0x9d, 0x1c, 0x61, 0xe2, 0xde, 0x46, 0xf8, 0x14, 0x10,
+		0xd3, 0x92, 0x46, 0x9c, 0x2e, 0x8f, 0x56, 0xf8, 0xe1, 0xcb, 0xac, 0x5f, 0xb3, 0xc2, 0x2b, 0xd4,
+		0xd7, 0x40, 0xb9, 0x36, 0x98, 0xcd, 0x5d, 0x83, 0x99, 0xa3, 0x26, 0x86, 0x07, 0x95, 0x99, 0xc0,
+		0x3d, 0xfb, 0x33, 0x31, 0x00, 0xff, 0x38, 0x03, 0x5f, 0xe4, 0x5a, 0xde, 0x58, 0x5f, 0xd1, 0x1b,
+		0x5b, 0x95, 0xad, 0xed, 0x46, 0xf8, 0x6f, 0x99, 0xc8, 0xf7, 0xbb, 0x9c, 0x2e, 0x6d, 0x9a, 0xbb,
+		0x26, 0x6d, 0x91, 0xc7, 0x60, 0x3e, 0x0c, 0x8d, 0x5f, 0xb5, 0x15, 0x25, 0x56, 0xce, 0xdd, 0xb9,
+		0xbb, 0x90, 0xe6, 0x11, 0x16, 0x8a, 0xaf, 0x9f, 0x1e, 0x1b, 0x86, 0xc3, 0xbf, 0x83, 0x12, 0x2f,
+		0xe7, 0xef, 0xdc, 0x5d, 0xc8, 0x78, 0xa1, 0x18, 0xa2, 0x02, 0x09, 0x42, 0x0a, 0x7a, 0x89, 0x32,
+		0xdc, 0xb9, 0xbb, 0x90, 0xe2, 0x1a, 0xaf, 0x9c, 0xc4, 0x57, 0xba, 0xce, 0x7e, 0x00, 0xa0, 0xee,
+		0x31, 0x8a, 0x94, 0xe1, 0x78, 0x7d, 0xfd, 0x92, 0x56, 0xa9, 0x6e, 0xd5, 0x37, 0xd6, 0xc3, 0xdd,
+		0x1e, 0xa8, 0x5b, 0xd9, 0xd8, 0x5e, 0x5e, 0xad, 0xe9, 0xf8, 0x2e, 0x18, 0xcf, 0x8a, 0x0a, 0xd5,
+		0xbd, 0x7f, 0x7d, 0xab, 0xbe, 0x56, 0x53, 0xe2, 0x67, 0xbf, 0x39, 0x06, 0xe0, 0xcb, 0x03, 0xfe,
+		0x8d, 0x97, 0xab, 0x95, 0xfa, 0xaa, 0xae, 0xd5, 0x2a, 0x8d, 0xa1, 0x06, 0x1e, 0x81, 0xd3, 0xc1,
+		0xca, 0xb5, 0xfa, 0xba, 0xde, 0xa8, 0xad, 0x5e, 0xd2, 0x57, 0x6a, 0xab, 0xb5, 0xcb, 0x15, 0xa4,
+		0xac, 0xc4, 0x48, 0x09, 0xe6, 0x83, 0x40, 0x7e, 0x53, 0x83, 0xb4, 0x83, 0x1d, 0x4c, 0x2c, 0x5f,
+		0xba, 0xfe, 0xce, 0x43, 0x75, 0xb7, 0x6f, 0xf0, 0x4c, 0x94, 0x24, 0xf0, 0x7f, 0x06, 0x00, 0x9a,
+		0xc0, 0x86, 0x4c, 0x5e, 0xa2, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2271,6 +2601,9 @@ func (this *RedelegationEntry) Equal(that interface{}) bool {
 	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
 		return false
 	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -2310,6 +2643,30 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.MaxValidatorPowerFraction.Equal(that1.MaxValidatorPowerFraction) {
+		return false
+	}
+	if this.MaxConsPubkeyRotations != that1.MaxConsPubkeyRotations {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if !this.GlobalMinDelegation.Equal(that1.GlobalMinDelegation) {
+		return false
+	}
+	if !this.ValidatorLiquidCap.Equal(that1.ValidatorLiquidCap) {
+		return false
+	}
+	if !this.GlobalLiquidCap.Equal(that1.GlobalLiquidCap) {
+		return false
+	}
+	if this.MaxEntriesPerBlock != that1.MaxEntriesPerBlock {
+		return false
+	}
+	if !this.MinSelfDelegationRatio.Equal(that1.MinSelfDelegationRatio) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2585,6 +2942,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinDelegation.Size()
+		i -= size
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if len(m.UnbondingIds) > 0 {
 		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
 		var j4 int
@@ -3079,6 +3446,16 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.UnbondingOnHoldRefCount != 0 {
 		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
 		i--
@@ -3203,6 +3580,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinSelfDelegationRatio.Size()
+		i -= size
+		if _, err := m.MinSelfDelegationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.MaxEntriesPerBlock != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxEntriesPerBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.GlobalLiquidCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.ValidatorLiquidCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.GlobalMinDelegation.Size()
+		i -= size
+		if _, err := m.GlobalMinDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxConsPubkeyRotations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxConsPubkeyRotations))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxValidatorPowerFraction.Size()
+		i -= size
+		if _, err := m.MaxValidatorPowerFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3235,12 +3682,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3422,7 +3869,7 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorFlows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3432,54 +3879,222 @@ func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorFlows) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorFlows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.RedelegatingBalance.Size()
+		i -= size
+		if _, err := m.RedelegatingBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UnbondingBalance.Size()
+		i -= size
+		if _, err := m.UnbondingBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InfractionDetails) > 0 {
+		i -= len(m.InfractionDetails)
+		copy(dAtA[i:], m.InfractionDetails)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.InfractionDetails)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintStaking(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Reason != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
 			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
@@ -3589,6 +4204,8 @@ func (m *Validator) Size() (n int) {
 		}
 		n += 1 + sovStaking(uint64(l)) + l
 	}
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3762,6 +4379,8 @@ func (m *RedelegationEntry) Size() (n int) {
 	if m.UnbondingOnHoldRefCount != 0 {
 		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
 	}
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3815,6 +4434,24 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxValidatorPowerFraction.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxConsPubkeyRotations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxConsPubkeyRotations))
+	}
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalMinDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxEntriesPerBlock != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntriesPerBlock))
+	}
+	l = m.MinSelfDelegationRatio.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3874,6 +4511,68 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorFlows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnbondingBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.RedelegatingBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *JailInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovStaking(uint64(m.Reason))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.InfractionDetails)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorUpdates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4941,6 +5640,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6163,16 +6896,50 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -6549,6 +7316,247 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorPowerFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubkeyRotations", wireType)
+			}
+			m.MaxConsPubkeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsPubkeyRotations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalMinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntriesPerBlock", wireType)
+			}
+			m.MaxEntriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntriesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7038,6 +8046,516 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorFlows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorFlows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorFlows: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegatingBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegatingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JailInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= JailReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionDetails", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfractionDetails = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
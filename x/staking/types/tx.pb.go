@@ -132,6 +132,9 @@ type MsgEditValidator struct {
 	// REF: #2373
 	CommissionRate    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty"`
 	MinSelfDelegation *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation,omitempty"`
+	// min_delegation is the minimum amount of a new delegation to the validator,
+	// zero removes it.
+	MinDelegation *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_delegation,json=minDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation,omitempty"`
 }
 
 func (m *MsgEditValidator) Reset()         { *m = MsgEditValidator{} }
@@ -649,6 +652,83 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRotateConsPubKey is the Msg/RotateConsPubKey request type.
+type MsgRotateConsPubKey struct {
+	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	NewPubkey        *types.Any `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty"`
+}
+
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{14}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
+}
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+// MsgRotateConsPubKeyResponse defines the response structure for executing a
+// MsgRotateConsPubKey message.
+type MsgRotateConsPubKeyResponse struct {
+}
+
+func (m *MsgRotateConsPubKeyResponse) Reset()         { *m = MsgRotateConsPubKeyResponse{} }
+func (m *MsgRotateConsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKeyResponse) ProtoMessage()    {}
+func (*MsgRotateConsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{15}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -664,83 +744,90 @@ func init() {
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.staking.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKey")
+	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKeyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1128 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8d, 0x21, 0x13, 0xf2, 0xb5, 0x49, 0x5a, 0x7b, 0x69, 0xed, 0xb2, 0x0d, 0x4d,
-	0x14, 0xf0, 0x9a, 0x06, 0xc4, 0x87, 0xa9, 0x50, 0xe3, 0xba, 0x85, 0x82, 0x8c, 0xa2, 0x0d, 0xe1,
-	0x80, 0x90, 0xac, 0xf1, 0xee, 0x64, 0xbd, 0x8a, 0x77, 0x67, 0xb3, 0x33, 0x8e, 0xea, 0x1b, 0xe2,
-	0x04, 0x08, 0x89, 0xfe, 0x81, 0x4a, 0xe5, 0x06, 0xb7, 0x1c, 0xf2, 0x07, 0x38, 0x20, 0x55, 0x9c,
-	0xaa, 0x9c, 0x10, 0x87, 0x82, 0x92, 0x43, 0xfa, 0x33, 0xd0, 0xee, 0xce, 0x7e, 0xfa, 0x33, 0x81,
-	0x5e, 0x7a, 0x89, 0x9d, 0x99, 0xe7, 0x7d, 0xde, 0x8f, 0xe7, 0x7d, 0x67, 0xc6, 0xa0, 0xa8, 0x60,
-	0x62, 0x60, 0x52, 0x26, 0x14, 0xee, 0xea, 0xa6, 0x56, 0xde, 0xbf, 0xd1, 0x44, 0x14, 0xde, 0x28,
-	0xd3, 0xfb, 0x92, 0x65, 0x63, 0x8a, 0xf9, 0x8b, 0x1e, 0x40, 0x62, 0x00, 0x89, 0x01, 0x84, 0xbc,
-	0x86, 0xb1, 0xd6, 0x46, 0x65, 0x17, 0xd5, 0xec, 0xec, 0x94, 0xa1, 0xd9, 0xf5, 0x4c, 0x84, 0x62,
-	0x72, 0x8b, 0xea, 0x06, 0x22, 0x14, 0x1a, 0x16, 0x03, 0x2c, 0x6a, 0x58, 0xc3, 0xee, 0xd7, 0xb2,
-	0xf3, 0x8d, 0xad, 0xe6, 0x3d, 0x4f, 0x0d, 0x6f, 0x83, 0xb9, 0xf5, 0xb6, 0x0a, 0x2c, 0xca, 0x26,
-	0x24, 0x28, 0x08, 0x51, 0xc1, 0xba, 0xc9, 0xf6, 0x97, 0x07, 0x64, 0xe1, 0x07, 0xed, 0xa1, 0x2e,
-	0x31, 0x94, 0x41, 0x1c, 0x84, 0xf3, 0xc1, 0x36, 0xe6, 0xa1, 0xa1, 0x9b, 0xb8, 0xec, 0xfe, 0xf5,
-	0x96, 0xc4, 0x1f, 0x27, 0x00, 0x5f, 0x27, 0xda, 0x6d, 0x1b, 0x41, 0x8a, 0xbe, 0x84, 0x6d, 0x5d,
-	0x85, 0x14, 0xdb, 0xfc, 0x26, 0x98, 0x52, 0x11, 0x51, 0x6c, 0xdd, 0xa2, 0x3a, 0x36, 0x73, 0xdc,
-	0x55, 0x6e, 0x75, 0x6a, 0xfd, 0x9a, 0xd4, 0xbf, 0x46, 0x52, 0x2d, 0x84, 0x56, 0x27, 0x1f, 0x3f,
-	0x2d, 0xa6, 0x7e, 0x39, 0x3d, 0x58, 0xe3, 0xe4, 0x28, 0x05, 0x2f, 0x03, 0xa0, 0x60, 0xc3, 0xd0,
-	0x09, 0x71, 0x08, 0xd3, 0x2e, 0xe1, 0xca, 0x20, 0xc2, 0xdb, 0x01, 0x52, 0x86, 0x14, 0x91, 0x28,
-	0x69, 0x84, 0x85, 0xdf, 0x03, 0x0b, 0x86, 0x6e, 0x36, 0x08, 0x6a, 0xef, 0x34, 0x54, 0xd4, 0x46,
-	0x1a, 0x74, 0xa3, 0xcd, 0x5c, 0xe5, 0x56, 0x27, 0xab, 0x1b, 0x8e, 0xcd, 0x5f, 0x4f, 0x8b, 0xd7,
-	0x35, 0x9d, 0xb6, 0x3a, 0x4d, 0x49, 0xc1, 0x06, 0x2b, 0x36, 0xfb, 0x28, 0x11, 0x75, 0xb7, 0x4c,
-	0xbb, 0x16, 0x22, 0xd2, 0x3d, 0x93, 0x1e, 0x1d, 0x96, 0x00, 0x8b, 0xe6, 0x9e, 0x49, 0x3d, 0x5f,
-	0xf3, 0x86, 0x6e, 0x6e, 0xa1, 0xf6, 0x4e, 0x2d, 0xe0, 0xe6, 0x3f, 0x06, 0xf3, 0xcc, 0x13, 0xb6,
-	0x1b, 0x50, 0x55, 0x6d, 0x44, 0x48, 0xee, 0x82, 0xeb, 0x50, 0x38, 0x3a, 0x2c, 0x2d, 0x32, 0x8a,
-	0x0d, 0x6f, 0x67, 0x8b, 0xda, 0xba, 0xa9, 0xe5, 0x38, 0x79, 0x2e, 0x30, 0x62, 0x3b, 0xfc, 0xe7,
-	0x60, 0x7e, 0xdf, 0x2f, 0x77, 0x40, 0x34, 0xe1, 0x12, 0xbd, 0x76, 0x74, 0x58, 0xba, 0xc2, 0x88,
-	0x02, 0x49, 0x62, 0x8c, 0xf2, 0xdc, 0x7e, 0x62, 0x9d, 0xbf, 0x0b, 0xb2, 0x56, 0xa7, 0xb9, 0x8b,
-	0xba, 0xb9, 0xac, 0x5b, 0xdb, 0x45, 0xc9, 0xeb, 0x4e, 0xc9, 0xef, 0x4e, 0x69, 0xc3, 0xec, 0x56,
-	0x73, 0x7f, 0x84, 0x31, 0x2a, 0x76, 0xd7, 0xa2, 0x58, 0xda, 0xec, 0x34, 0x3f, 0x43, 0x5d, 0x99,
-	0x59, 0xf3, 0x15, 0x30, 0xb1, 0x0f, 0xdb, 0x1d, 0x94, 0x7b, 0xc9, 0xa5, 0xc9, 0xfb, 0x12, 0x39,
-	0x2d, 0x19, 0xd1, 0x47, 0x8f, 0x29, 0xed, 0x99, 0x54, 0x6e, 0x7d, 0xf7, 0xa8, 0x98, 0x7a, 0xf6,
-	0xa8, 0x98, 0xfa, 0xf6, 0xf4, 0x60, 0xad, 0x37, 0xbd, 0x1f, 0x4e, 0x0f, 0xd6, 0xae, 0x44, 0x6a,
-	0xdf, 0xdb, 0x77, 0xe2, 0x65, 0x20, 0xf4, 0xae, 0xca, 0x88, 0x58, 0xd8, 0x24, 0x48, 0xfc, 0x2d,
-	0x03, 0xe6, 0xea, 0x44, 0xbb, 0xa3, 0xea, 0xf4, 0x79, 0xb6, 0x6a, 0x5f, 0x69, 0xd2, 0xe7, 0x97,
-	0x06, 0x82, 0xd9, 0xb0, 0x69, 0x1b, 0x36, 0xa4, 0x88, 0xb5, 0xe8, 0xfb, 0x63, 0xb6, 0x67, 0x0d,
-	0x29, 0x91, 0xf6, 0xac, 0x21, 0x45, 0x9e, 0x51, 0x62, 0x13, 0xc2, 0xb7, 0xfa, 0x4f, 0xc2, 0x85,
-	0x33, 0xb9, 0xe9, 0x99, 0x82, 0x3e, 0x03, 0x50, 0xf9, 0x68, 0xb4, 0xc6, 0xaf, 0xc6, 0x35, 0x8e,
-	0xc9, 0x25, 0x0a, 0x20, 0x97, 0x5c, 0x0b, 0xf4, 0x7d, 0x98, 0x06, 0x53, 0x75, 0xa2, 0x31, 0x6f,
-	0x88, 0xbf, 0xd3, 0x6f, 0xd8, 0x38, 0x37, 0xa7, 0xdc, 0xa0, 0x61, 0x1b, 0x77, 0xd4, 0xfe, 0x83,
-	0x9e, 0x37, 0x41, 0x16, 0x1a, 0xb8, 0x63, 0x52, 0x57, 0xc6, 0x71, 0x67, 0x84, 0xd9, 0x54, 0x3e,
-	0x88, 0x15, 0xb0, 0x27, 0x3f, 0xa7, 0x80, 0x17, 0xe3, 0x05, 0xf4, 0xeb, 0x21, 0x2e, 0x81, 0x85,
-	0xc8, 0xbf, 0x41, 0xd9, 0xbe, 0xcf, 0xb8, 0x67, 0x78, 0x15, 0x69, 0xba, 0x29, 0x23, 0xf5, 0x7f,
-	0xae, 0xde, 0x36, 0x58, 0x0a, 0xab, 0x47, 0x6c, 0xe5, 0xec, 0x15, 0x5c, 0x08, 0xec, 0xb7, 0x6c,
-	0xa5, 0x2f, 0xad, 0x4a, 0x68, 0x40, 0x9b, 0x39, 0x3b, 0x6d, 0x8d, 0xd0, 0x5e, 0x6d, 0x2e, 0x9c,
-	0x43, 0x9b, 0x5b, 0xa3, 0xb5, 0x49, 0x1c, 0x60, 0x89, 0xa2, 0x8b, 0x96, 0x7b, 0x80, 0x25, 0x56,
-	0x7d, 0xa5, 0x78, 0xd9, 0x3d, 0x09, 0xac, 0x36, 0x72, 0x46, 0xa9, 0xe1, 0x3c, 0x17, 0xd8, 0x79,
-	0x25, 0xf4, 0x9c, 0xd6, 0x5f, 0xf8, 0x6f, 0x89, 0xea, 0xb4, 0x13, 0xe7, 0x83, 0xbf, 0x8b, 0x9c,
-	0x17, 0xeb, 0x4c, 0xc8, 0xe0, 0x60, 0xc4, 0x9f, 0xd3, 0x60, 0xba, 0x4e, 0xb4, 0x6d, 0x53, 0x7d,
-	0xa1, 0xc7, 0xe6, 0xc3, 0xd1, 0xd2, 0xe4, 0xe2, 0xd2, 0x84, 0x15, 0x11, 0x7f, 0xe5, 0xc0, 0x52,
-	0x6c, 0xe5, 0x79, 0x2a, 0x12, 0x49, 0x34, 0x7d, 0xf6, 0x44, 0xc5, 0x67, 0x69, 0x70, 0xd9, 0xb9,
-	0x03, 0xa1, 0xa9, 0xa0, 0xf6, 0xb6, 0xd9, 0xc4, 0xa6, 0xaa, 0x9b, 0x5a, 0xe4, 0x09, 0xf2, 0x22,
-	0xca, 0xcb, 0xaf, 0x80, 0x59, 0xc5, 0xb9, 0xf5, 0x1d, 0x15, 0x5a, 0x48, 0xd7, 0x5a, 0xde, 0x00,
-	0x67, 0xe4, 0x19, 0x7f, 0xf9, 0x13, 0x77, 0xb5, 0xf2, 0xe9, 0xe8, 0x3e, 0x58, 0x49, 0xbc, 0x31,
-	0x06, 0x55, 0x52, 0xbc, 0x0e, 0x96, 0x87, 0xed, 0x07, 0x07, 0xec, 0xef, 0x1c, 0x98, 0x75, 0xda,
-	0xc7, 0x52, 0x21, 0x45, 0x9b, 0xd0, 0x86, 0x06, 0xe1, 0xdf, 0x05, 0x93, 0xb0, 0x43, 0x5b, 0xd8,
-	0xd6, 0x69, 0x77, 0x64, 0xf5, 0x43, 0x28, 0xbf, 0x01, 0xb2, 0x96, 0xcb, 0xc0, 0x9a, 0xa3, 0x30,
-	0xe8, 0xa5, 0xe2, 0xf9, 0x89, 0xd5, 0xca, 0x33, 0xac, 0xbc, 0xe7, 0xa4, 0x1e, 0x52, 0x3a, 0x29,
-	0x2f, 0x47, 0x52, 0xbe, 0x1f, 0xfc, 0x3c, 0x48, 0xc4, 0x2c, 0xe6, 0xc1, 0xa5, 0xc4, 0x92, 0x9f,
-	0xe2, 0xfa, 0xc3, 0x2c, 0xc8, 0xd4, 0x89, 0xc6, 0xef, 0x81, 0xd9, 0xe4, 0x6f, 0x81, 0xb5, 0x41,
-	0x11, 0xf6, 0xbe, 0xd4, 0x84, 0xf5, 0xf1, 0xb1, 0xc1, 0x08, 0xee, 0x82, 0xe9, 0xf8, 0x8b, 0x6e,
-	0x75, 0x08, 0x49, 0x0c, 0x29, 0xbc, 0x35, 0x2e, 0x32, 0x70, 0xf6, 0x35, 0x78, 0x39, 0x78, 0x5e,
-	0x5c, 0x1b, 0x62, 0xed, 0x83, 0x84, 0x37, 0xc6, 0x00, 0x05, 0xec, 0x7b, 0x60, 0x36, 0x79, 0x0b,
-	0x0f, 0xab, 0x5e, 0x02, 0x3b, 0xb4, 0x7a, 0x83, 0xae, 0x94, 0x26, 0x00, 0x91, 0xa3, 0xff, 0xf5,
-	0x21, 0x0c, 0x21, 0x4c, 0x28, 0x8d, 0x05, 0x0b, 0x7c, 0xfc, 0xc4, 0x81, 0xfc, 0xe0, 0xf3, 0xe8,
-	0x9d, 0x61, 0x9a, 0x0f, 0xb2, 0x12, 0x6e, 0x9e, 0xc7, 0x2a, 0x88, 0xa8, 0x05, 0x5e, 0x89, 0x4d,
-	0xe3, 0xca, 0xb0, 0x84, 0x22, 0x40, 0xa1, 0x3c, 0x26, 0xd0, 0xf7, 0x24, 0x4c, 0x7c, 0xe3, 0xcc,
-	0x5e, 0xf5, 0xee, 0xe3, 0xe3, 0x02, 0xf7, 0xe4, 0xb8, 0xc0, 0xfd, 0x73, 0x5c, 0xe0, 0x1e, 0x9c,
-	0x14, 0x52, 0x4f, 0x4e, 0x0a, 0xa9, 0x3f, 0x4f, 0x0a, 0xa9, 0xaf, 0xde, 0x1c, 0xfa, 0xb2, 0x0e,
-	0x87, 0xd1, 0x7d, 0x63, 0x37, 0xb3, 0xee, 0x75, 0xf2, 0xf6, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4a, 0x8f, 0x88, 0xf2, 0x90, 0x10, 0x00, 0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf7, 0xda, 0x49, 0xfa, 0xcf, 0xe4, 0x9f, 0xb7, 0x4d, 0xd2, 0x3a, 0xdb, 0xc6, 0x2e, 0xdb,
+	0xd0, 0x44, 0x81, 0xd8, 0x34, 0x45, 0xbc, 0x98, 0x0a, 0x35, 0x4e, 0x5a, 0x28, 0xc8, 0x28, 0xda,
+	0x10, 0x0e, 0x08, 0xc9, 0x1a, 0xef, 0x4e, 0xd6, 0xab, 0x78, 0x67, 0x9c, 0x9d, 0x71, 0x5a, 0xdf,
+	0x10, 0x27, 0x40, 0x48, 0x54, 0xe2, 0x8c, 0x54, 0x38, 0xc1, 0x2d, 0x87, 0x7c, 0x05, 0xa4, 0x8a,
+	0x53, 0x95, 0x13, 0xe2, 0x50, 0x50, 0x72, 0x48, 0xbf, 0x01, 0x57, 0xb4, 0xbb, 0xb3, 0xaf, 0x7e,
+	0x4f, 0xe9, 0xa5, 0x97, 0x78, 0xf3, 0xcc, 0xef, 0xf9, 0x3d, 0xf3, 0xbc, 0xee, 0xb3, 0x20, 0xab,
+	0x12, 0x6a, 0x12, 0x9a, 0xa7, 0x0c, 0xee, 0x19, 0x58, 0xcf, 0x1f, 0xdc, 0xa8, 0x20, 0x06, 0x6f,
+	0xe4, 0xd9, 0x83, 0x5c, 0xdd, 0x22, 0x8c, 0x88, 0x17, 0x5d, 0x40, 0x8e, 0x03, 0x72, 0x1c, 0x20,
+	0xcd, 0xeb, 0x84, 0xe8, 0x35, 0x94, 0x77, 0x50, 0x95, 0xc6, 0x6e, 0x1e, 0xe2, 0xa6, 0xab, 0x22,
+	0x65, 0xe3, 0x47, 0xcc, 0x30, 0x11, 0x65, 0xd0, 0xac, 0x73, 0xc0, 0xac, 0x4e, 0x74, 0xe2, 0x3c,
+	0xe6, 0xed, 0x27, 0x2e, 0x9d, 0x77, 0x2d, 0x95, 0xdd, 0x03, 0x6e, 0xd6, 0x3d, 0xca, 0xf0, 0x5b,
+	0x56, 0x20, 0x45, 0xfe, 0x15, 0x55, 0x62, 0x60, 0x7e, 0xbe, 0xd8, 0xc1, 0x0b, 0xef, 0xd2, 0x2e,
+	0xea, 0x12, 0x47, 0x99, 0xd4, 0x46, 0xd8, 0x3f, 0xfc, 0x60, 0x1a, 0x9a, 0x06, 0x26, 0x79, 0xe7,
+	0xaf, 0x2b, 0x92, 0xbf, 0x1b, 0x06, 0x62, 0x89, 0xea, 0x1b, 0x16, 0x82, 0x0c, 0x7d, 0x06, 0x6b,
+	0x86, 0x06, 0x19, 0xb1, 0xc4, 0x2d, 0x30, 0xa6, 0x21, 0xaa, 0x5a, 0x46, 0x9d, 0x19, 0x04, 0xa7,
+	0x85, 0xab, 0xc2, 0xf2, 0xd8, 0xda, 0xb5, 0x5c, 0xfb, 0x18, 0xe5, 0x36, 0x03, 0x68, 0x71, 0xf4,
+	0xf1, 0xd3, 0x6c, 0xe2, 0x97, 0xb3, 0xc3, 0x15, 0x41, 0x09, 0x53, 0x88, 0x0a, 0x00, 0x2a, 0x31,
+	0x4d, 0x83, 0x52, 0x9b, 0x30, 0xe9, 0x10, 0x2e, 0x75, 0x22, 0xdc, 0xf0, 0x91, 0x0a, 0x64, 0x88,
+	0x86, 0x49, 0x43, 0x2c, 0xe2, 0x3e, 0x98, 0x31, 0x0d, 0x5c, 0xa6, 0xa8, 0xb6, 0x5b, 0xd6, 0x50,
+	0x0d, 0xe9, 0xd0, 0xb9, 0x6d, 0xea, 0xaa, 0xb0, 0x3c, 0x5a, 0x5c, 0xb7, 0x75, 0xfe, 0x7c, 0x9a,
+	0xbd, 0xae, 0x1b, 0xac, 0xda, 0xa8, 0xe4, 0x54, 0x62, 0xf2, 0x60, 0xf3, 0x9f, 0x55, 0xaa, 0xed,
+	0xe5, 0x59, 0xb3, 0x8e, 0x68, 0xee, 0x1e, 0x66, 0xc7, 0x47, 0xab, 0x80, 0xdf, 0xe6, 0x1e, 0x66,
+	0xae, 0xad, 0x69, 0xd3, 0xc0, 0xdb, 0xa8, 0xb6, 0xbb, 0xe9, 0x73, 0x8b, 0x1f, 0x80, 0x69, 0x6e,
+	0x89, 0x58, 0x65, 0xa8, 0x69, 0x16, 0xa2, 0x34, 0x3d, 0xe4, 0x18, 0x94, 0x8e, 0x8f, 0x56, 0x67,
+	0x39, 0xc5, 0xba, 0x7b, 0xb2, 0xcd, 0x2c, 0x03, 0xeb, 0x69, 0x41, 0x99, 0xf2, 0x95, 0xf8, 0x89,
+	0xf8, 0x09, 0x98, 0x3e, 0xf0, 0xc2, 0xed, 0x13, 0x0d, 0x3b, 0x44, 0xaf, 0x1c, 0x1f, 0xad, 0x2e,
+	0x70, 0x22, 0x3f, 0x25, 0x11, 0x46, 0x65, 0xea, 0x20, 0x26, 0x17, 0xef, 0x82, 0x91, 0x7a, 0xa3,
+	0xb2, 0x87, 0x9a, 0xe9, 0x11, 0x27, 0xb6, 0xb3, 0x39, 0xb7, 0x3a, 0x73, 0x5e, 0x75, 0xe6, 0xd6,
+	0x71, 0xb3, 0x98, 0xfe, 0x3d, 0xb8, 0xa3, 0x6a, 0x35, 0xeb, 0x8c, 0xe4, 0xb6, 0x1a, 0x95, 0x8f,
+	0x51, 0x53, 0xe1, 0xda, 0x62, 0x01, 0x0c, 0x1f, 0xc0, 0x5a, 0x03, 0xa5, 0x2f, 0x38, 0x34, 0xf3,
+	0x5e, 0x8a, 0xec, 0x92, 0x0c, 0xe5, 0xc7, 0x88, 0x64, 0xda, 0x55, 0x29, 0xdc, 0xfe, 0xfa, 0x51,
+	0x36, 0xf1, 0xec, 0x51, 0x36, 0xf1, 0xd5, 0xd9, 0xe1, 0x4a, 0xab, 0x7b, 0xdf, 0x9e, 0x1d, 0xae,
+	0x2c, 0x84, 0x62, 0xdf, 0x5a, 0x77, 0xf2, 0x15, 0x20, 0xb5, 0x4a, 0x15, 0x44, 0xeb, 0x04, 0x53,
+	0x24, 0xff, 0x3c, 0x04, 0xa6, 0x4a, 0x54, 0xbf, 0xa3, 0x19, 0xec, 0x45, 0x96, 0x6a, 0xdb, 0xd4,
+	0x24, 0xcf, 0x9f, 0x1a, 0x08, 0x26, 0x83, 0xa2, 0x2d, 0x5b, 0x90, 0x21, 0x5e, 0xa2, 0xef, 0xf4,
+	0x59, 0x9e, 0x9b, 0x48, 0x0d, 0x95, 0xe7, 0x26, 0x52, 0x95, 0x09, 0x35, 0xd2, 0x21, 0x62, 0xb5,
+	0x7d, 0x27, 0x0c, 0x0d, 0x64, 0xa6, 0xa5, 0x0b, 0xda, 0x35, 0x40, 0x19, 0x4c, 0xd8, 0x96, 0x42,
+	0x46, 0x86, 0x9f, 0xd3, 0xc8, 0xb8, 0x69, 0xe0, 0xc0, 0x40, 0xe1, 0xfd, 0xde, 0x45, 0x74, 0x39,
+	0x5a, 0x44, 0x91, 0x7a, 0x90, 0x25, 0x90, 0x8e, 0xcb, 0xfc, 0x02, 0xfa, 0x31, 0x09, 0xc6, 0x4a,
+	0x54, 0xe7, 0xd6, 0x90, 0x78, 0xa7, 0x5d, 0x37, 0x0b, 0x8e, 0x3f, 0xe9, 0x4e, 0xdd, 0xdc, 0x6f,
+	0x2f, 0x3f, 0x47, 0xc1, 0xdc, 0x02, 0x23, 0xd0, 0x24, 0x0d, 0xcc, 0xd2, 0xa9, 0x01, 0x9a, 0x90,
+	0xeb, 0x14, 0xde, 0x8d, 0x04, 0xb0, 0xc5, 0x3f, 0x3b, 0x80, 0x17, 0xa3, 0x01, 0xf4, 0xe2, 0x21,
+	0xcf, 0x81, 0x99, 0xd0, 0xbf, 0x7e, 0xd8, 0xbe, 0x49, 0x39, 0x2f, 0x89, 0x22, 0xd2, 0x0d, 0xac,
+	0x20, 0xed, 0x3f, 0x8e, 0xde, 0x0e, 0x98, 0x0b, 0xa2, 0x47, 0x2d, 0x75, 0xf0, 0x08, 0xce, 0xf8,
+	0xfa, 0xdb, 0x96, 0xda, 0x96, 0x56, 0xa3, 0xcc, 0xa7, 0x4d, 0x0d, 0x4e, 0xbb, 0x49, 0x59, 0x6b,
+	0x6e, 0x86, 0xce, 0x91, 0x9b, 0xdb, 0xbd, 0x73, 0x13, 0x9b, 0x90, 0xb1, 0xa0, 0xcb, 0x75, 0x20,
+	0xb5, 0x4a, 0xbd, 0x4c, 0x89, 0x8a, 0x33, 0x6a, 0xea, 0x35, 0x64, 0xb7, 0x52, 0xd9, 0xde, 0x47,
+	0xf8, 0x40, 0x94, 0x5a, 0x5e, 0x07, 0x9f, 0x7a, 0xcb, 0x4a, 0x71, 0xdc, 0xbe, 0xe7, 0xc3, 0xbf,
+	0xb2, 0x82, 0x7b, 0xd7, 0x89, 0x80, 0xc1, 0xc6, 0xc8, 0x3f, 0x25, 0xc1, 0x78, 0x89, 0xea, 0x3b,
+	0x58, 0x7b, 0xa9, 0xdb, 0xe6, 0xbd, 0xde, 0xa9, 0x49, 0x47, 0x53, 0x13, 0x44, 0x44, 0xfe, 0x55,
+	0x00, 0x73, 0x11, 0xc9, 0x8b, 0xcc, 0x48, 0xc8, 0xd1, 0xe4, 0xe0, 0x8e, 0xca, 0xcf, 0x92, 0xe0,
+	0x8a, 0xfd, 0x92, 0x85, 0x58, 0x45, 0xb5, 0x1d, 0x5c, 0x21, 0x58, 0x33, 0xb0, 0x1e, 0x1a, 0xf1,
+	0x2f, 0x63, 0x7a, 0xc5, 0x25, 0x30, 0xa9, 0x5a, 0xc8, 0x71, 0xb0, 0x5c, 0x45, 0x86, 0x5e, 0x75,
+	0x1b, 0x38, 0xa5, 0x4c, 0x78, 0xe2, 0x0f, 0x1d, 0x69, 0xe1, 0xa3, 0xde, 0x75, 0xb0, 0x14, 0x5b,
+	0x62, 0x3a, 0x45, 0x52, 0xbe, 0x0e, 0x16, 0xbb, 0x9d, 0xfb, 0x03, 0xf6, 0x37, 0x01, 0x4c, 0xda,
+	0xe5, 0x53, 0xd7, 0x20, 0x43, 0x5b, 0xd0, 0x82, 0x26, 0x15, 0xdf, 0x02, 0xa3, 0xb0, 0xc1, 0xaa,
+	0xc4, 0x32, 0x58, 0xb3, 0x67, 0xf4, 0x03, 0xa8, 0xb8, 0x0e, 0x46, 0xea, 0x0e, 0x03, 0x2f, 0x8e,
+	0x4c, 0xa7, 0x55, 0xc8, 0xb5, 0x13, 0x89, 0x95, 0xab, 0x58, 0x78, 0xdb, 0x76, 0x3d, 0xa0, 0xb4,
+	0x5d, 0x5e, 0x0c, 0xb9, 0xfc, 0xc0, 0xff, 0xfe, 0x88, 0xdd, 0x59, 0x9e, 0x07, 0x97, 0x62, 0x22,
+	0xdf, 0xc5, 0x7f, 0x04, 0xe7, 0xdd, 0xa2, 0x10, 0x06, 0x19, 0xda, 0x20, 0x98, 0xba, 0x7b, 0x67,
+	0xfb, 0x2a, 0x11, 0xce, 0x5f, 0x25, 0x25, 0x00, 0x30, 0xba, 0x5f, 0xe6, 0xbb, 0x70, 0xf2, 0x5c,
+	0xbb, 0xf0, 0x28, 0x46, 0xf7, 0xb7, 0x1c, 0x82, 0xc2, 0x7a, 0xef, 0x6d, 0x24, 0x13, 0xad, 0x86,
+	0xb8, 0x87, 0xf2, 0x02, 0xb8, 0xdc, 0x46, 0xec, 0x05, 0x66, 0xed, 0x87, 0x0b, 0x20, 0x55, 0xa2,
+	0xba, 0xb8, 0x0f, 0x26, 0xe3, 0x5f, 0x61, 0x2b, 0x9d, 0x52, 0xd7, 0xba, 0x23, 0x4b, 0x6b, 0xfd,
+	0x63, 0xfd, 0xd9, 0xb4, 0x07, 0xc6, 0xa3, 0xbb, 0xf4, 0x72, 0x17, 0x92, 0x08, 0x52, 0x7a, 0xa3,
+	0x5f, 0xa4, 0x6f, 0xec, 0x0b, 0xf0, 0x3f, 0x7f, 0xef, 0xba, 0xd6, 0x45, 0xdb, 0x03, 0x49, 0xaf,
+	0xf5, 0x01, 0xf2, 0xd9, 0xf7, 0xc1, 0x64, 0x7c, 0x3d, 0xe9, 0x16, 0xbd, 0x18, 0x56, 0x5a, 0xeb,
+	0x1f, 0xeb, 0x9b, 0xac, 0x00, 0x10, 0x7a, 0x27, 0xbe, 0xda, 0x85, 0x21, 0x80, 0x49, 0xab, 0x7d,
+	0xc1, 0x7c, 0x1b, 0xdf, 0x0b, 0x60, 0xbe, 0xf3, 0xa0, 0x7e, 0xb3, 0x5b, 0xce, 0x3b, 0x69, 0x49,
+	0xb7, 0xce, 0xa3, 0xe5, 0xdf, 0xa8, 0x0a, 0xfe, 0x1f, 0x19, 0x53, 0x4b, 0xdd, 0x1c, 0x0a, 0x01,
+	0xa5, 0x7c, 0x9f, 0x40, 0xdf, 0x12, 0x03, 0x53, 0x2d, 0xd3, 0xa2, 0x5b, 0x4d, 0xc4, 0xc1, 0xd2,
+	0xcd, 0x01, 0xc0, 0x9e, 0x55, 0x69, 0xf8, 0x4b, 0x7b, 0x14, 0x16, 0xef, 0x3e, 0x3e, 0xc9, 0x08,
+	0x4f, 0x4e, 0x32, 0xc2, 0xdf, 0x27, 0x19, 0xe1, 0xe1, 0x69, 0x26, 0xf1, 0xe4, 0x34, 0x93, 0xf8,
+	0xe3, 0x34, 0x93, 0xf8, 0xfc, 0xf5, 0xae, 0x1f, 0x39, 0xc1, 0x6c, 0x74, 0x3e, 0x77, 0x2a, 0x23,
+	0xce, 0xc8, 0xb9, 0xf9, 0xef, 0x00, 0xf6, 0x6c, 0x5f, 0xd9, 0x80, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -777,6 +864,9 @@ type MsgClient interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RotateConsPubKey defines an operation for rotating the consensus pubkey
+	// of a validator.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -850,6 +940,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/RotateConsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -874,6 +973,9 @@ type MsgServer interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RotateConsPubKey defines an operation for rotating the consensus pubkey
+	// of a validator.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -901,6 +1003,9 @@ func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, re
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1032,6 +1137,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/RotateConsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1064,6 +1187,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1201,6 +1328,18 @@ func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinDelegation != nil {
+		{
+			size := m.MinDelegation.Size()
+			i -= size
+			if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.MinSelfDelegation != nil {
 		{
 			size := m.MinSelfDelegation.Size()
@@ -1649,6 +1788,71 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubkey != nil {
+		{
+			size, err := m.NewPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1718,6 +1922,10 @@ func (m *MsgEditValidator) Size() (n int) {
 		l = m.MinSelfDelegation.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MinDelegation != nil {
+		l = m.MinDelegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1879,6 +2087,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubkey != nil {
+		l = m.NewPubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2384,6 +2618,42 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegation = &v
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3558,6 +3828,174 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubkey == nil {
+				m.NewPubkey = &types.Any{}
+			}
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -58,6 +58,7 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 		Commission:              NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
 		MinSelfDelegation:       math.OneInt(),
 		UnbondingOnHoldRefCount: 0,
+		MinDelegation:           math.ZeroInt(),
 	}, nil
 }
 
@@ -458,6 +459,10 @@ func (v Validator) GetOperator() sdk.ValAddress {
 
 // ConsPubKey returns the validator PubKey as a cryptotypes.PubKey.
 func (v Validator) ConsPubKey() (cryptotypes.PubKey, error) {
+	if v.ConsensusPubkey == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidType, "consensus pubkey is nil")
+	}
+
 	pk, ok := v.ConsensusPubkey.GetCachedValue().(cryptotypes.PubKey)
 	if !ok {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
@@ -505,6 +510,16 @@ func (v Validator) GetCommission() math.LegacyDec      { return v.Commission.Rat
 func (v Validator) GetMinSelfDelegation() math.Int     { return v.MinSelfDelegation }
 func (v Validator) GetDelegatorShares() math.LegacyDec { return v.DelegatorShares }
 
+// GetMinDelegation returns the minimum amount of a new delegation to the
+// validator, zero for validators stored before the minimum was introduced.
+func (v Validator) GetMinDelegation() math.Int {
+	if v.MinDelegation.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return v.MinDelegation
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (v Validator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var pk cryptotypes.PubKey
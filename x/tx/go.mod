@@ -31,3 +31,6 @@ require (
 	google.golang.org/grpc v1.54.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// temporary replace, until core/coins conversion helpers are tagged
+replace cosmossdk.io/core => ../../core
@@ -155,9 +155,8 @@ func (vr coinsValueRenderer) parseCoins(ctx context.Context, coinsStr string) ([
 }
 
 // parseCoin parses a single value-rendered coin into the Coin struct.
-// It shares a lot of code with `cosmos-sdk.io/core/coins.Format`,
-// so this code might be refactored once we have
-// a core Parse function for coins.
+// The conversion to the base denom is done by `cosmossdk.io/core/coins`,
+// which is also used to format coins.
 func parseCoin(coinStr string, metadata *bankv1beta1.Metadata) (*basev1beta1.Coin, error) {
 	coinArr := strings.Split(coinStr, " ")
 	amt1 := coinArr[0] // Contains potentially some thousandSeparators
@@ -167,8 +166,7 @@ func parseCoin(coinStr string, metadata *bankv1beta1.Metadata) (*basev1beta1.Coi
 	if err != nil {
 		return nil, err
 	}
-	amtDec, err := math.LegacyNewDecFromStr(amtDecStr)
-	if err != nil {
+	if _, err := math.LegacyNewDecFromStr(amtDecStr); err != nil {
 		return nil, err
 	}
 
@@ -181,18 +179,8 @@ func parseCoin(coinStr string, metadata *bankv1beta1.Metadata) (*basev1beta1.Coi
 	baseDenom := metadata.Base
 
 	// Find exponents of both denoms.
-	foundCoinExp, foundBaseExp := false, false
-	var coinExp, baseExp uint32
-	for _, unit := range metadata.DenomUnits {
-		if coinDenom == unit.Denom {
-			coinExp = unit.Exponent
-			foundCoinExp = true
-		}
-		if baseDenom == unit.Denom {
-			baseExp = unit.Exponent
-			foundBaseExp = true
-		}
-	}
+	coinExp, foundCoinExp := corecoins.DenomExponent(metadata, coinDenom)
+	baseExp, foundBaseExp := corecoins.DenomExponent(metadata, baseDenom)
 
 	// If we didn't find either exponent, then we return early.
 	if !foundCoinExp || !foundBaseExp {
@@ -202,18 +190,17 @@ func parseCoin(coinStr string, metadata *bankv1beta1.Metadata) (*basev1beta1.Coi
 		}, nil
 	}
 
-	if coinExp > baseExp {
-		amtDec = amtDec.Mul(math.LegacyNewDec(10).Power(uint64(coinExp - baseExp)))
-	} else {
-		amtDec = amtDec.Quo(math.LegacyNewDec(10).Power(uint64(baseExp - coinExp)))
+	amt, err := corecoins.ConvertAmount(amtDecStr, coinExp, baseExp)
+	if err != nil {
+		return nil, err
 	}
 
-	if !amtDec.TruncateDec().Equal(amtDec) {
-		return nil, fmt.Errorf("got non-integer coin amount %s", amtDec)
+	if strings.Contains(amt, ".") {
+		return nil, fmt.Errorf("got non-integer coin amount %s", amt)
 	}
 
 	return &basev1beta1.Coin{
-		Amount: amtDec.TruncateInt().String(),
+		Amount: amt,
 		Denom:  baseDenom,
 	}, nil
 }
@@ -13,7 +13,8 @@ import (
 )
 
 // BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
-// If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
+// If the current height is in the provided set of heights to skip or in the governance-approved
+// skip heights, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
 //
@@ -32,7 +33,7 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context) {
 		// 1. If there is no scheduled upgrade.
 		// 2. If the plan is not ready.
 		// 3. If the plan is ready and skip upgrade height is set for current height.
-		if !found || !plan.ShouldExecute(ctx) || (plan.ShouldExecute(ctx) && k.IsSkipHeight(ctx.BlockHeight())) {
+		if !found || !plan.ShouldExecute(ctx) || (plan.ShouldExecute(ctx) && k.ShouldSkipUpgrade(ctx, ctx.BlockHeight())) {
 			lastAppliedPlan, _ := k.GetLastCompletedUpgrade(ctx)
 			if lastAppliedPlan != "" && !k.HasHandler(lastAppliedPlan) {
 				var appVersion uint64
@@ -54,8 +55,9 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context) {
 
 	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(ctx) {
-		// If skip upgrade has been set for current height, we clear the upgrade plan
-		if k.IsSkipHeight(ctx.BlockHeight()) {
+		// If skip upgrade has been set for current height, either by the node operator or
+		// through governance, we clear the upgrade plan
+		if k.ShouldSkipUpgrade(ctx, ctx.BlockHeight()) {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
 			logger.Info(skipUpgradeMsg)
 
@@ -356,6 +356,39 @@ func TestUpgradeSkippingOnlyTwo(t *testing.T) {
 	VerifyDone(t, s.ctx, "test3")
 }
 
+func TestUpgradeSkippingGovernanceHeight(t *testing.T) {
+	var (
+		skipOne int64 = 11
+		doTwo   int64 = 20
+	)
+	s := setupTest(t, 10, map[int64]bool{})
+	govAccAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, err := keeper.NewMsgServerImpl(s.keeper).SkipUpgradeHeight(s.ctx, &types.MsgSkipUpgradeHeight{
+		Authority: govAccAddr,
+		Heights:   []int64{skipOne},
+	})
+	require.NoError(t, err)
+
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: skipOne}}) //nolint:staticcheck // we're testing deprecated code
+	require.NoError(t, err)
+
+	t.Log("Verify the governance-approved height clears the plan although the node flag is not set")
+	require.False(t, s.keeper.IsSkipHeight(skipOne))
+	newCtx := s.ctx.WithBlockHeight(skipOne)
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx)
+	})
+	VerifyCleared(t, newCtx)
+	VerifyNotDone(t, s.ctx, "test")
+
+	t.Log("Verify an upgrade at a height not approved for skipping is applied")
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop2", Plan: types.Plan{Name: "test2", Height: doTwo}}) //nolint:staticcheck // we're testing deprecated code
+	require.NoError(t, err)
+	VerifyDoUpgradeWithCtx(t, newCtx.WithBlockHeight(doTwo), "test2")
+	VerifyDone(t, s.ctx, "test2")
+}
+
 func TestUpgradeWithoutSkip(t *testing.T) {
 	s := setupTest(t, 10, map[int64]bool{})
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
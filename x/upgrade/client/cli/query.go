@@ -23,6 +23,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetSkipHeightsCmd(),
+		GetParamsCmd(),
+		GetPlanBinariesCmd(),
 	)
 
 	return cmd
@@ -155,3 +158,89 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetSkipHeightsCmd returns the governance-approved upgrade skip heights
+func GetSkipHeightsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "skip-heights",
+		Short: "get the governance-approved upgrade skip heights",
+		Long: "Gets the list of heights at which scheduled upgrades are skipped, as approved through governance.\n" +
+			"Heights passed to nodes with --unsafe-skip-upgrades are node-local and not included.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SkipHeights(cmd.Context(), &types.QuerySkipHeightsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetParamsCmd returns the parameters of the upgrade module
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "get the upgrade module parameters",
+		Long:  "Gets the current parameters of the upgrade module.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetPlanBinariesCmd returns the binaries of the current upgrade plan
+func GetPlanBinariesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan-binaries",
+		Short: "get the binaries of the current upgrade plan",
+		Long: "Gets the binaries of the currently scheduled upgrade plan, with their download URL and checksum,\n" +
+			"as parsed from the binaries JSON of the plan info.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.UpgradePlanBinaries(cmd.Context(), &types.QueryUpgradePlanBinariesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/upgrade"
+	"cosmossdk.io/x/upgrade/types"
+)
+
+func TestGenesisSkipHeights(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	am := s.module.(upgrade.AppModule)
+
+	t.Log("Verify the default genesis is valid and exports empty skip heights")
+	defaultGenesis := am.DefaultGenesis(s.encCfg.Codec)
+	require.NoError(t, am.ValidateGenesis(s.encCfg.Codec, nil, defaultGenesis))
+	require.JSONEq(t, string(defaultGenesis), string(am.ExportGenesis(s.ctx, s.encCfg.Codec)))
+
+	t.Log("Verify skip heights are imported and exported")
+	gs := s.encCfg.Codec.MustMarshalJSON(&types.GenesisState{SkipHeights: []int64{30, 15}})
+	require.NoError(t, am.ValidateGenesis(s.encCfg.Codec, nil, gs))
+	am.InitGenesis(s.ctx, s.encCfg.Codec, gs)
+	require.True(t, s.keeper.ShouldSkipUpgrade(s.ctx, 15))
+	require.True(t, s.keeper.ShouldSkipUpgrade(s.ctx, 30))
+
+	var exported types.GenesisState
+	s.encCfg.Codec.MustUnmarshalJSON(am.ExportGenesis(s.ctx, s.encCfg.Codec), &exported)
+	require.Equal(t, []int64{15, 30}, exported.SkipHeights)
+
+	t.Log("Verify params are imported and exported")
+	params := types.Params{RequireChecksummedUpgradeInfo: true}
+	gs = s.encCfg.Codec.MustMarshalJSON(&types.GenesisState{SkipHeights: []int64{}, Params: params})
+	am.InitGenesis(s.ctx, s.encCfg.Codec, gs)
+	require.Equal(t, params, s.keeper.GetParams(s.ctx))
+	s.encCfg.Codec.MustUnmarshalJSON(am.ExportGenesis(s.ctx, s.encCfg.Codec), &exported)
+	require.Equal(t, params, exported.Params)
+
+	t.Log("Verify invalid skip heights are rejected")
+	invalid := s.encCfg.Codec.MustMarshalJSON(&types.GenesisState{SkipHeights: []int64{15, 15}})
+	require.ErrorContains(t, am.ValidateGenesis(s.encCfg.Codec, nil, invalid), "duplicate skip height")
+	invalid = s.encCfg.Codec.MustMarshalJSON(&types.GenesisState{SkipHeights: []int64{-1}})
+	require.ErrorContains(t, am.ValidateGenesis(s.encCfg.Codec, nil, invalid), "must be positive")
+}
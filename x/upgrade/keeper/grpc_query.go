@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	neturl "net/url"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/upgrade/plan"
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -76,3 +79,63 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// SkipHeights implements the Query/SkipHeights gRPC method
+func (k Keeper) SkipHeights(c context.Context, req *types.QuerySkipHeightsRequest) (*types.QuerySkipHeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySkipHeightsResponse{Heights: k.GetSkipHeights(ctx)}, nil
+}
+
+// MigrationProgress implements the Query/MigrationProgress gRPC method
+func (k Keeper) MigrationProgress(c context.Context, req *types.QueryMigrationProgressRequest) (*types.QueryMigrationProgressResponse, error) {
+	return &types.QueryMigrationProgressResponse{Progress: k.GetMigrationProgress()}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// UpgradePlanBinaries implements the Query/UpgradePlanBinaries gRPC method
+func (k Keeper) UpgradePlanBinaries(c context.Context, req *types.QueryUpgradePlanBinariesRequest) (*types.QueryUpgradePlanBinariesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	currentPlan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return nil, errorsmod.Wrap(errors.ErrNotFound, "x/upgrade: no upgrade plan is scheduled")
+	}
+
+	res := &types.QueryUpgradePlanBinariesResponse{
+		Name:     currentPlan.Name,
+		Height:   currentPlan.Height,
+		Binaries: []types.UpgradeBinary{},
+	}
+
+	planInfo, err := plan.ParseInfoJSON(currentPlan.Info)
+	if err != nil {
+		// the info isn't binaries JSON, so there are no binaries to return
+		return res, nil
+	}
+
+	platforms := make([]string, 0, len(planInfo.Binaries))
+	for platform := range planInfo.Binaries {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+
+	for _, platform := range platforms {
+		binary := types.UpgradeBinary{
+			Platform: platform,
+			Url:      planInfo.Binaries[platform],
+		}
+		if url, err := neturl.Parse(binary.Url); err == nil {
+			binary.Checksum = url.Query().Get("checksum")
+		}
+		res.Binaries = append(res.Binaries, binary)
+	}
+
+	return res, nil
+}
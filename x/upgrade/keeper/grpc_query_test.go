@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -225,6 +226,76 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestSkipHeights() {
+	res, err := suite.queryClient.SkipHeights(context.Background(), &types.QuerySkipHeightsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Heights)
+
+	suite.upgradeKeeper.SetSkipHeight(suite.ctx, 20)
+	suite.upgradeKeeper.SetSkipHeight(suite.ctx, 5)
+
+	res, err = suite.queryClient.SkipHeights(context.Background(), &types.QuerySkipHeightsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{5, 20}, res.Heights)
+}
+
+func (suite *UpgradeTestSuite) TestMigrationProgress() {
+	res, err := suite.queryClient.MigrationProgress(context.Background(), &types.QueryMigrationProgressRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Progress)
+
+	suite.upgradeKeeper.ReportMigrationProgress("staking", 2, 10, 100)
+	suite.upgradeKeeper.ReportMigrationProgress("bank", 3, 1, 2)
+	suite.upgradeKeeper.ReportMigrationProgress("staking", 2, 50, 100)
+
+	res, err = suite.queryClient.MigrationProgress(context.Background(), &types.QueryMigrationProgressRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.MigrationProgress{
+		{ModuleName: "bank", Step: 3, Processed: 1, Total: 2},
+		{ModuleName: "staking", Step: 2, Processed: 50, Total: 100},
+	}, res.Progress)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
+
+func (suite *UpgradeTestSuite) TestParams() {
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+
+	params := types.Params{RequireChecksummedUpgradeInfo: true}
+	suite.upgradeKeeper.SetParams(suite.ctx, params)
+
+	res, err = suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
+
+func (suite *UpgradeTestSuite) TestUpgradePlanBinaries() {
+	_, err := suite.queryClient.UpgradePlanBinaries(context.Background(), &types.QueryUpgradePlanBinariesRequest{})
+	suite.Require().ErrorContains(err, "no upgrade plan is scheduled")
+
+	suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, types.Plan{Name: "text", Info: "some text", Height: 100}))
+
+	res, err := suite.queryClient.UpgradePlanBinaries(context.Background(), &types.QueryUpgradePlanBinariesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal("text", res.Name)
+	suite.Require().Equal(int64(100), res.Height)
+	suite.Require().Empty(res.Binaries)
+
+	linuxChecksum := "sha256:" + strings.Repeat("0", 64)
+	darwinChecksum := "sha256:" + strings.Repeat("f", 64)
+	info := fmt.Sprintf(`{"binaries":{"linux/amd64":"https://example.com/simd-linux.zip?checksum=%s","darwin/arm64":"https://example.com/simd-darwin.zip?checksum=%s"}}`, linuxChecksum, darwinChecksum)
+	suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, types.Plan{Name: "binaries", Info: info, Height: 200}))
+
+	res, err = suite.queryClient.UpgradePlanBinaries(context.Background(), &types.QueryUpgradePlanBinariesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal("binaries", res.Name)
+	suite.Require().Equal(int64(200), res.Height)
+	suite.Require().Equal([]types.UpgradeBinary{
+		{Platform: "darwin/arm64", Url: "https://example.com/simd-darwin.zip?checksum=" + darwinChecksum, Checksum: darwinChecksum},
+		{Platform: "linux/amd64", Url: "https://example.com/simd-linux.zip?checksum=" + linuxChecksum, Checksum: linuxChecksum},
+	}, res.Binaries)
+}
@@ -15,6 +15,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	xp "cosmossdk.io/x/upgrade/exported"
+	"cosmossdk.io/x/upgrade/plan"
 	"cosmossdk.io/x/upgrade/types"
 
 	"github.com/armon/go-metrics"
@@ -40,6 +41,7 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	migrationProgress  *migrationProgress              // the last reported progress of the store migrations run by this node
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -57,6 +59,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
 		authority:          authority,
+		migrationProgress:  newMigrationProgress(),
 	}
 
 	if upgradePlan, err := k.ReadUpgradeInfoFromDisk(); err == nil && upgradePlan.Height > 0 {
@@ -215,6 +218,10 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	if err := k.validatePlanInfo(ctx, plan.Info); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	store := ctx.KVStore(k.storeKey)
 
 	// clear any old IBC state stored by previous plan
@@ -231,6 +238,47 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 	return nil
 }
 
+// validatePlanInfo validates the info of a plan which holds the binaries JSON consumed by
+// cosmovisor, so that typos are caught when the plan is scheduled rather than at halt time.
+// Any other info is accepted, unless RequireChecksummedUpgradeInfo is set, in which case the
+// info must be binaries JSON with a sha256 checksum for the binary of each platform.
+func (k Keeper) validatePlanInfo(ctx sdk.Context, info string) error {
+	requireChecksums := k.GetParams(ctx).RequireChecksummedUpgradeInfo
+
+	planInfo, err := plan.ParseInfoJSON(info)
+	if err != nil {
+		if requireChecksums {
+			return fmt.Errorf("plan info must be binaries JSON: %w", err)
+		}
+		return nil
+	}
+
+	if requireChecksums {
+		return planInfo.Binaries.ValidateChecksummed()
+	}
+
+	return planInfo.Binaries.ValidateBasic()
+}
+
+// GetParams returns the parameters of the upgrade module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey())
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the upgrade module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey(), k.cdc.MustMarshal(&params))
+}
+
 // SetUpgradedClient sets the expected upgraded client for the next version of this chain at the last height the current chain will commit.
 func (k Keeper) SetUpgradedClient(ctx sdk.Context, planHeight int64, bz []byte) error {
 	store := ctx.KVStore(k.storeKey)
@@ -395,6 +443,38 @@ func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
 }
 
+// SetSkipHeight stores a governance-approved height at which a scheduled upgrade must be skipped.
+func (k Keeper) SetSkipHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SkipHeightKey(height), []byte{1})
+}
+
+// HasSkipHeight checks if the given height is part of the governance-approved skip heights.
+func (k Keeper) HasSkipHeight(ctx sdk.Context, height int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SkipHeightKey(height))
+}
+
+// GetSkipHeights returns all governance-approved skip heights in ascending order.
+func (k Keeper) GetSkipHeights(ctx sdk.Context) []int64 {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, []byte{types.SkipHeightByte})
+	defer it.Close()
+
+	var heights []int64
+	for ; it.Valid(); it.Next() {
+		heights = append(heights, int64(binary.BigEndian.Uint64(it.Key()[1:])))
+	}
+
+	return heights
+}
+
+// ShouldSkipUpgrade checks if a plan scheduled at the given height must be skipped, either because
+// the node was started with the height in --unsafe-skip-upgrades or because governance approved it.
+func (k Keeper) ShouldSkipUpgrade(ctx sdk.Context, height int64) bool {
+	return k.IsSkipHeight(height) || k.HasSkipHeight(ctx, height)
+}
+
 // DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName.
 func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
 	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
@@ -234,6 +234,53 @@ func (s *KeeperTestSuite) TestIsSkipHeight() {
 	s.Require().False(upgradeKeeper.IsSkipHeight(10))
 }
 
+func (s *KeeperTestSuite) TestMigrationCheckpoints() {
+	checkpoints, err := s.upgradeKeeper.OpenMigrationCheckpointDB()
+	s.Require().NoError(err)
+
+	batches, err := checkpoints.MigrationBatches(10, "bank", 2)
+	s.Require().NoError(err)
+	s.Require().Empty(batches)
+
+	first := module.MigrationBatch{
+		Writes: []module.MigrationWrite{{StoreKey: "bank", Key: []byte("a"), Value: []byte{1}}, {StoreKey: "bank", Key: []byte("b"), Delete: true}},
+		Next:   []byte("cursor"),
+	}
+	second := module.MigrationBatch{Writes: []module.MigrationWrite{{StoreKey: "bank", Key: []byte("c"), Value: []byte{}}}}
+	s.Require().NoError(checkpoints.AddMigrationBatch(10, "bank", 2, first))
+	s.Require().NoError(checkpoints.AddMigrationBatch(10, "bank", 2, second))
+	s.Require().NoError(checkpoints.AddMigrationBatch(10, "staking", 3, module.MigrationBatch{}))
+
+	// the checkpoints survive a restart of the node
+	s.Require().NoError(checkpoints.Close())
+	checkpoints, err = s.upgradeKeeper.OpenMigrationCheckpointDB()
+	s.Require().NoError(err)
+	defer checkpoints.Close()
+
+	batches, err = checkpoints.MigrationBatches(10, "bank", 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]module.MigrationBatch{first, second}, batches)
+
+	// the checkpoints of other heights and steps are distinct
+	batches, err = checkpoints.MigrationBatches(11, "bank", 2)
+	s.Require().NoError(err)
+	s.Require().Empty(batches)
+	batches, err = checkpoints.MigrationBatches(10, "bank", 3)
+	s.Require().NoError(err)
+	s.Require().Empty(batches)
+	batches, err = checkpoints.MigrationBatches(10, "staking", 3)
+	s.Require().NoError(err)
+	s.Require().Len(batches, 1)
+
+	s.Require().NoError(checkpoints.ClearMigrationCheckpoints())
+	batches, err = checkpoints.MigrationBatches(10, "bank", 2)
+	s.Require().NoError(err)
+	s.Require().Empty(batches)
+	batches, err = checkpoints.MigrationBatches(10, "staking", 3)
+	s.Require().NoError(err)
+	s.Require().Empty(batches)
+}
+
 func (s *KeeperTestSuite) TestUpgradedConsensusState() {
 	cs := []byte("IBC consensus state")
 	s.Require().NoError(s.upgradeKeeper.SetUpgradedConsensusState(s.ctx, 10, cs))
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// MigrationCheckpointDBName is the name of the node-local database, in the
+// data directory of the node home, which keeps the store migration
+// checkpoints.
+const MigrationCheckpointDBName = "migrations"
+
+var _ module.MigrationCheckpointStore = MigrationCheckpointDB{}
+
+// MigrationCheckpointDB is a module.MigrationCheckpointStore keeping the
+// checkpoints in a node-local database. It is written outside of the block
+// state, so that the checkpoints survive a restart of the node in the middle
+// of an upgrade.
+type MigrationCheckpointDB struct {
+	db dbm.DB
+}
+
+// NewMigrationCheckpointDB returns a MigrationCheckpointDB keeping the
+// checkpoints in the given database.
+func NewMigrationCheckpointDB(db dbm.DB) MigrationCheckpointDB {
+	return MigrationCheckpointDB{db: db}
+}
+
+// OpenMigrationCheckpointDB opens the migration checkpoint database in the
+// data directory of the node home. It must be closed once the migrations have
+// been run.
+func (k Keeper) OpenMigrationCheckpointDB() (MigrationCheckpointDB, error) {
+	if k.homePath == "" {
+		return MigrationCheckpointDB{}, fmt.Errorf("cannot open the migration checkpoint database without home directory")
+	}
+
+	db, err := dbm.NewGoLevelDB(MigrationCheckpointDBName, filepath.Join(k.homePath, "data"), nil)
+	if err != nil {
+		return MigrationCheckpointDB{}, fmt.Errorf("failed to open the migration checkpoint database: %w", err)
+	}
+
+	return NewMigrationCheckpointDB(db), nil
+}
+
+// Close closes the database.
+func (c MigrationCheckpointDB) Close() error {
+	return c.db.Close()
+}
+
+// MigrationBatches implements the module.MigrationCheckpointStore interface.
+func (c MigrationCheckpointDB) MigrationBatches(height int64, moduleName string, fromVersion uint64) ([]module.MigrationBatch, error) {
+	it, err := c.iteratePrefix(types.MigrationCheckpointPrefix(height, moduleName, fromVersion))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var batches []module.MigrationBatch
+	for ; it.Valid(); it.Next() {
+		var batch module.MigrationBatch
+		if err := json.Unmarshal(it.Value(), &batch); err != nil {
+			return nil, fmt.Errorf("failed to decode the migration checkpoint %X: %w", it.Key(), err)
+		}
+		batches = append(batches, batch)
+	}
+
+	return batches, it.Error()
+}
+
+// AddMigrationBatch implements the module.MigrationCheckpointStore interface.
+// The batch is synced to disk before it returns.
+func (c MigrationCheckpointDB) AddMigrationBatch(height int64, moduleName string, fromVersion uint64, batch module.MigrationBatch) error {
+	keys, err := c.keys(types.MigrationCheckpointPrefix(height, moduleName, fromVersion))
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	return c.db.SetSync(types.MigrationCheckpointKey(height, moduleName, fromVersion, uint64(len(keys))), bz)
+}
+
+// ClearMigrationCheckpoints implements the module.MigrationCheckpointStore
+// interface.
+func (c MigrationCheckpointDB) ClearMigrationCheckpoints() error {
+	keys, err := c.keys([]byte{types.MigrationCheckpointByte})
+	if err != nil {
+		return err
+	}
+
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+func (c MigrationCheckpointDB) iteratePrefix(prefix []byte) (dbm.Iterator, error) {
+	return c.db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+}
+
+// keys returns the keys with the given prefix.
+func (c MigrationCheckpointDB) keys(prefix []byte) ([][]byte, error) {
+	it, err := c.iteratePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+
+	return keys, it.Error()
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"sort"
+	"sync"
+
+	"cosmossdk.io/x/upgrade/types"
+)
+
+// migrationProgress keeps the last reported progress of every module
+// migration. It is shared by all copies of the keeper and guarded by a mutex,
+// as it is queried while the upgrade handler is running.
+type migrationProgress struct {
+	mtx      sync.RWMutex
+	progress map[string]types.MigrationProgress
+}
+
+func newMigrationProgress() *migrationProgress {
+	return &migrationProgress{progress: map[string]types.MigrationProgress{}}
+}
+
+// ReportMigrationProgress records the progress of a module store migration, it
+// implements the module.MigrationProgressReporter interface so that the keeper
+// can be passed to module.Manager#RunMigrations.
+func (k Keeper) ReportMigrationProgress(moduleName string, step, processed, total uint64) {
+	k.migrationProgress.mtx.Lock()
+	defer k.migrationProgress.mtx.Unlock()
+
+	k.migrationProgress.progress[moduleName] = types.MigrationProgress{
+		ModuleName: moduleName,
+		Step:       step,
+		Processed:  processed,
+		Total:      total,
+	}
+}
+
+// GetMigrationProgress returns the last reported progress of every module
+// store migration run by this node, sorted by module name.
+func (k Keeper) GetMigrationProgress() []*types.MigrationProgress {
+	k.migrationProgress.mtx.RLock()
+	defer k.migrationProgress.mtx.RUnlock()
+
+	progress := make([]*types.MigrationProgress, 0, len(k.migrationProgress.progress))
+	for _, p := range k.migrationProgress.progress {
+		p := p
+		progress = append(progress, &p)
+	}
+	sort.Slice(progress, func(i, j int) bool { return progress[i].ModuleName < progress[j].ModuleName })
+
+	return progress
+}
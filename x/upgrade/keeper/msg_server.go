@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -54,3 +55,37 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// SkipUpgradeHeight implements the Msg/SkipUpgradeHeight Msg service.
+func (k msgServer) SkipUpgradeHeight(ctx context.Context, msg *types.MsgSkipUpgradeHeight) (*types.MsgSkipUpgradeHeightResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(gov.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if len(msg.Heights) == 0 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "heights cannot be empty")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, height := range msg.Heights {
+		if height <= 0 {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "skip height must be positive, got %d", height)
+		}
+
+		k.SetSkipHeight(sdkCtx, height)
+	}
+
+	return &types.MsgSkipUpgradeHeightResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(gov.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.SetParams(sdkCtx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"strings"
+
 	"cosmossdk.io/x/upgrade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -136,3 +138,148 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSkipUpgradeHeight() {
+	govAccAddr := sdk.AccAddress(address.Module("gov")).String()
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSkipUpgradeHeight
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgSkipUpgradeHeight{
+				Authority: s.addrs[0].String(),
+				Heights:   []int64{100},
+			},
+			true,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"empty heights",
+			&types.MsgSkipUpgradeHeight{
+				Authority: govAccAddr,
+			},
+			true,
+			"heights cannot be empty",
+		},
+		{
+			"non-positive height",
+			&types.MsgSkipUpgradeHeight{
+				Authority: govAccAddr,
+				Heights:   []int64{100, 0},
+			},
+			true,
+			"skip height must be positive",
+		},
+		{
+			"skip heights stored successfully",
+			&types.MsgSkipUpgradeHeight{
+				Authority: govAccAddr,
+				Heights:   []int64{300, 100},
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.SkipUpgradeHeight(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal([]int64{100, 300}, s.upgradeKeeper.GetSkipHeights(s.ctx))
+				s.Require().True(s.upgradeKeeper.ShouldSkipUpgrade(s.ctx, 300))
+				s.Require().False(s.upgradeKeeper.ShouldSkipUpgrade(s.ctx, 200))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSoftwareUpgradeChecksummedInfo() {
+	govAccAddr := sdk.AccAddress(address.Module("gov")).String()
+	checksum := "sha256:" + strings.Repeat("ab", 32)
+
+	checksummedInfo := `{"binaries":{"linux/amd64":"https://example.com/simd-linux-amd64.zip?checksum=` + checksum + `","darwin/arm64":"https://example.com/simd-darwin-arm64.zip?checksum=` + checksum + `"}}`
+	md5Info := `{"binaries":{"linux/amd64":"https://example.com/simd.zip?checksum=md5:d41d8cd98f00b204e9800998ecf8427e"}}`
+	missingChecksumInfo := `{"binaries":{"linux/amd64":"https://example.com/simd.zip"}}`
+	badPlatformInfo := `{"binaries":{"linux-amd64":"https://example.com/simd.zip?checksum=` + checksum + `"}}`
+	relativeURLInfo := `{"binaries":{"linux/amd64":"simd.zip?checksum=` + checksum + `"}}`
+	shortChecksumInfo := `{"binaries":{"linux/amd64":"https://example.com/simd.zip?checksum=sha256:abcd"}}`
+	malformedJSONInfo := `{"binaries":{"linux/amd64":`
+
+	testCases := []struct {
+		name             string
+		requireChecksums bool
+		info             string
+		errMsg           string
+	}{
+		{"free text accepted when not required", false, "some text here", ""},
+		{"malformed JSON accepted when not required", false, malformedJSONInfo, ""},
+		{"checksummed binaries accepted when not required", false, checksummedInfo, ""},
+		{"other checksum types accepted when not required", false, md5Info, ""},
+		{"binaries without checksum rejected when not required", false, missingChecksumInfo, "missing checksum query parameter"},
+		{"invalid platform rejected when not required", false, badPlatformInfo, "invalid os/arch format"},
+		{"free text rejected when required", true, "some text here", "plan info must be binaries JSON"},
+		{"malformed JSON rejected when required", true, malformedJSONInfo, "plan info must be binaries JSON"},
+		{"empty binaries rejected when required", true, `{"binaries":{}}`, "plan info must be binaries JSON"},
+		{"checksummed binaries accepted when required", true, checksummedInfo, ""},
+		{"other checksum types rejected when required", true, md5Info, "must be sha256:<hex>"},
+		{"short checksum rejected when required", true, shortChecksumInfo, "must be sha256:<hex>"},
+		{"binaries without checksum rejected when required", true, missingChecksumInfo, "missing checksum query parameter"},
+		{"relative url rejected when required", true, relativeURLInfo, "must be an absolute url"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.UpdateParams(s.ctx, &types.MsgUpdateParams{
+				Authority: govAccAddr,
+				Params:    types.Params{RequireChecksummedUpgradeInfo: tc.requireChecksums},
+			})
+			s.Require().NoError(err)
+			s.upgradeKeeper.ClearUpgradePlan(s.ctx)
+
+			req := &types.MsgSoftwareUpgrade{
+				Authority: govAccAddr,
+				Plan: types.Plan{
+					Name:   "checksummed",
+					Info:   tc.info,
+					Height: 123450000,
+				},
+			}
+			_, err = s.msgSrvr.SoftwareUpgrade(s.ctx, req)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+				_, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+				s.Require().False(found)
+			} else {
+				s.Require().NoError(err)
+				plan, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+				s.Require().True(found)
+				s.Require().Equal(req.Plan, plan)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	govAccAddr := sdk.AccAddress(address.Module("gov")).String()
+	params := types.Params{RequireChecksummedUpgradeInfo: true}
+
+	_, err := s.msgSrvr.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: s.addrs[0].String(),
+		Params:    params,
+	})
+	s.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+	s.Require().Equal(types.DefaultParams(), s.upgradeKeeper.GetParams(s.ctx))
+
+	_, err = s.msgSrvr.UpdateParams(s.ctx, &types.MsgUpdateParams{
+		Authority: govAccAddr,
+		Params:    params,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.upgradeKeeper.GetParams(s.ctx))
+}
@@ -107,3 +107,45 @@ func (m BinaryDownloadURLMap) CheckURLs(daemonName string) error {
 	}
 	return nil
 }
+
+// sha256ChecksumRx matches a sha256 checksum query parameter, e.g. sha256:<64 hex digits>.
+var sha256ChecksumRx = regexp.MustCompile(`^sha256:[0-9a-fA-F]{64}$`)
+
+// ParseInfoJSON parses an info string holding the binaries JSON.
+// Unlike ParseInfo, it never downloads anything, so it is safe to use in state machine code.
+// It returns an error if the info isn't a JSON object with at least one "binaries" entry.
+func ParseInfoJSON(infoStr string) (*Info, error) {
+	var planInfo Info
+	if err := json.Unmarshal([]byte(strings.TrimSpace(infoStr)), &planInfo); err != nil {
+		return nil, fmt.Errorf("could not parse plan info: %v", err)
+	}
+	if len(planInfo.Binaries) == 0 {
+		return nil, errors.New("no \"binaries\" entries found")
+	}
+
+	return &planInfo, nil
+}
+
+// ValidateChecksummed does the same validation as ValidateBasic, and additionally checks that
+// every entry value is an absolute URL whose checksum query parameter is a sha256 checksum,
+// e.g. sha256:<64 hex digits>.
+func (m BinaryDownloadURLMap) ValidateChecksummed() error {
+	if err := m.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for key, val := range m {
+		url, err := neturl.Parse(val)
+		if err != nil {
+			return fmt.Errorf("invalid url \"%s\" in binaries[%s]: %v", val, key, err)
+		}
+		if !url.IsAbs() || url.Host == "" {
+			return fmt.Errorf("invalid url \"%s\" in binaries[%s]: must be an absolute url", val, key)
+		}
+		if checksum := url.Query().Get("checksum"); !sha256ChecksumRx.MatchString(checksum) {
+			return fmt.Errorf("invalid checksum \"%s\" in binaries[%s]: must be sha256:<hex>", checksum, key)
+		}
+	}
+
+	return nil
+}
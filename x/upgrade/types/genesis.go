@@ -0,0 +1,28 @@
+package types
+
+import "fmt"
+
+// DefaultGenesisState returns the upgrade module's default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		SkipHeights: []int64{},
+		Params:      DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[int64]bool, len(gs.SkipHeights))
+	for _, height := range gs.SkipHeights {
+		if height <= 0 {
+			return fmt.Errorf("skip height must be positive, got %d", height)
+		}
+		if seen[height] {
+			return fmt.Errorf("duplicate skip height %d", height)
+		}
+		seen[height] = true
+	}
+
+	return nil
+}
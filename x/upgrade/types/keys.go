@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -26,6 +31,17 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// SkipHeightByte is a prefix to look up governance-approved upgrade skip heights
+	SkipHeightByte = 0x4
+
+	// MigrationCheckpointByte is the prefix of the store migration checkpoints.
+	// They are kept in the node-local migration checkpoint database rather than
+	// in the upgrade store, where the prefix is reserved.
+	MigrationCheckpointByte = 0x5
+
+	// ParamsByte specifies the Byte under which the module parameters are stored in the store
+	ParamsByte = 0x6
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +58,29 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// ParamsKey is the key under which the module parameters are saved
+func ParamsKey() []byte {
+	return []byte{ParamsByte}
+}
+
+// SkipHeightKey is the key under which a governance-approved skip height is saved
+func SkipHeightKey(height int64) []byte {
+	return append([]byte{SkipHeightByte}, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// MigrationCheckpointPrefix is the prefix under which the checkpointed batches
+// of the migration of a module from a version run at a given height are saved
+func MigrationCheckpointPrefix(height int64, moduleName string, fromVersion uint64) []byte {
+	key := append([]byte{MigrationCheckpointByte}, sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, address.MustLengthPrefix([]byte(moduleName))...)
+	return append(key, sdk.Uint64ToBigEndian(fromVersion)...)
+}
+
+// MigrationCheckpointKey is the key under which a checkpointed migration batch is saved
+func MigrationCheckpointKey(height int64, moduleName string, fromVersion, batch uint64) []byte {
+	return append(MigrationCheckpointPrefix(height, moduleName, fromVersion), sdk.Uint64ToBigEndian(batch)...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
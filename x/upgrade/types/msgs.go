@@ -6,8 +6,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
-	_, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+	_, _, _, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSkipUpgradeHeight{}, &MsgUpdateParams{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSkipUpgradeHeight{}, &MsgUpdateParams{}
 )
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -31,3 +31,25 @@ func (m *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSkipUpgradeHeight) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for MsgSkipUpgradeHeight.
+func (m *MsgSkipUpgradeHeight) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
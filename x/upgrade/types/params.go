@@ -0,0 +1,8 @@
+package types
+
+// DefaultParams returns the default parameters of the upgrade module.
+func DefaultParams() Params {
+	return Params{
+		RequireChecksummedUpgradeInfo: false,
+	}
+}
@@ -225,11 +225,53 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// Params defines the parameters of the upgrade module.
+type Params struct {
+	// require_checksummed_upgrade_info, when true, requires the info of every
+	// scheduled upgrade plan to be the binaries JSON consumed by cosmovisor, with
+	// a sha256 checksum for the binary of each platform.
+	RequireChecksummedUpgradeInfo bool `protobuf:"varint,1,opt,name=require_checksummed_upgrade_info,json=requireChecksummedUpgradeInfo,proto3" json:"require_checksummed_upgrade_info,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*Params)(nil), "cosmos.upgrade.v1beta1.Params")
 }
 
 func init() {
@@ -237,40 +279,43 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0x94, 0x6b, 0x54, 0x9c, 0xc8, 0x62, 0x88,
-	0x2a, 0xd5, 0x56, 0xcb, 0x66, 0x06, 0x44, 0x32, 0x02, 0x52, 0x71, 0x81, 0x81, 0x25, 0xba, 0xc4,
-	0x17, 0xf7, 0x54, 0xfb, 0xce, 0xf2, 0x5d, 0x02, 0x79, 0x05, 0xa6, 0x3e, 0x02, 0x23, 0x62, 0xea,
-	0xc0, 0x43, 0x44, 0x4c, 0x1d, 0x91, 0x90, 0xf8, 0x93, 0x0c, 0x65, 0xe3, 0x15, 0xd0, 0xdd, 0xd9,
-	0x55, 0x04, 0x05, 0x31, 0x74, 0xb1, 0xbe, 0xef, 0xbb, 0xef, 0xe7, 0xdf, 0x1f, 0x9f, 0xe1, 0xdd,
-	0x21, 0x17, 0x19, 0x17, 0xc1, 0x38, 0x4f, 0x0a, 0x1c, 0x93, 0x60, 0xb2, 0x37, 0x20, 0x12, 0xef,
-	0x55, 0xbd, 0x9f, 0x17, 0x5c, 0x72, 0x67, 0xd3, 0x6c, 0xf9, 0xd5, 0xb4, 0xdc, 0x6a, 0x6e, 0x25,
-	0x9c, 0x27, 0x29, 0x09, 0xf4, 0xd6, 0x60, 0x3c, 0x0a, 0x30, 0x9b, 0x1a, 0x48, 0xb3, 0x91, 0xf0,
-	0x84, 0xeb, 0x32, 0x50, 0x55, 0x39, 0x6d, 0xfd, 0x0e, 0x90, 0x34, 0x23, 0x42, 0xe2, 0x2c, 0x2f,
-	0x17, 0xb6, 0x0c, 0x53, 0xdf, 0x20, 0x4b, 0x5a, 0x73, 0x74, 0x03, 0x67, 0x94, 0xf1, 0x40, 0x3f,
-	0xcd, 0xc8, 0xfb, 0x09, 0xa0, 0x7d, 0x90, 0x62, 0xe6, 0x38, 0xd0, 0x66, 0x38, 0x23, 0x08, 0xb4,
-	0x41, 0xa7, 0x16, 0xe9, 0xda, 0x79, 0x00, 0x6d, 0xf5, 0x76, 0xb4, 0xd2, 0x06, 0x9d, 0xfa, 0x7e,
-	0xd3, 0x37, 0xd4, 0x7e, 0x45, 0xed, 0x3f, 0xab, 0xa8, 0xbb, 0x1b, 0xb3, 0x2f, 0x2d, 0xeb, 0xe4,
-	0x6b, 0x0b, 0xbc, 0x3b, 0x3f, 0xdd, 0x01, 0x08, 0x44, 0x1a, 0xe8, 0x6c, 0xc2, 0xf5, 0x23, 0x42,
-	0x93, 0x23, 0x89, 0x56, 0xdb, 0xa0, 0xb3, 0x1a, 0x95, 0x9d, 0x22, 0xa3, 0x6c, 0xc4, 0x91, 0x6d,
-	0xc8, 0x54, 0xed, 0x3c, 0x86, 0xb7, 0xca, 0x70, 0xe2, 0xfe, 0x30, 0xa5, 0x84, 0xc9, 0xbe, 0x90,
-	0x58, 0x12, 0xb4, 0xa6, 0xd9, 0x1b, 0x7f, 0xb0, 0x3f, 0x64, 0xd3, 0xee, 0x0a, 0x02, 0xd1, 0xcd,
-	0x0a, 0xd6, 0xd3, 0xa8, 0x43, 0x05, 0x0a, 0xd1, 0x8f, 0xb7, 0x2d, 0xf0, 0xe6, 0xfc, 0x74, 0x67,
-	0xc3, 0x24, 0xb0, 0x2b, 0xe2, 0xe3, 0x40, 0x19, 0xf5, 0x3e, 0x03, 0x78, 0xfb, 0x90, 0x8f, 0xe4,
-	0x2b, 0x5c, 0x90, 0xe7, 0x06, 0x79, 0x50, 0xf0, 0x9c, 0x0b, 0x9c, 0x3a, 0x0d, 0xb8, 0x26, 0xa9,
-	0x4c, 0xab, 0x14, 0x4c, 0xe3, 0xb4, 0x61, 0x3d, 0x26, 0x62, 0x58, 0xd0, 0x5c, 0x52, 0xce, 0x74,
-	0x1a, 0xb5, 0x68, 0x79, 0xe4, 0xdc, 0x87, 0x76, 0x9e, 0x62, 0xa6, 0x5d, 0xd6, 0xf7, 0xb7, 0xfd,
-	0xcb, 0x3f, 0xb6, 0xaf, 0xf8, 0xbb, 0x35, 0x15, 0x95, 0x8e, 0x29, 0xd2, 0xa0, 0xf0, 0x91, 0x92,
-	0xfa, 0xf1, 0xc3, 0x6e, 0xb3, 0x44, 0x25, 0x7c, 0x72, 0x81, 0xe8, 0x71, 0x26, 0x09, 0x93, 0xca,
-	0x88, 0xb7, 0x64, 0xe4, 0x2f, 0xfa, 0x11, 0xf0, 0xde, 0x03, 0x78, 0xa7, 0x87, 0xd9, 0x90, 0xa4,
-	0x57, 0xec, 0x31, 0x7c, 0xfa, 0x7f, 0x32, 0x3b, 0x4b, 0x32, 0xff, 0x29, 0x04, 0x01, 0xaf, 0x07,
-	0xaf, 0x3f, 0xe1, 0xf1, 0x38, 0x25, 0x2f, 0x48, 0x21, 0x54, 0x8e, 0x97, 0x5d, 0x42, 0x04, 0xaf,
-	0x4d, 0xcc, 0xb1, 0x56, 0x65, 0x47, 0x55, 0x1b, 0xda, 0x4a, 0x51, 0x37, 0x9c, 0x7d, 0x77, 0xad,
-	0xd9, 0xdc, 0x05, 0x67, 0x73, 0x17, 0x7c, 0x9b, 0xbb, 0xe0, 0x64, 0xe1, 0x5a, 0x67, 0x0b, 0xd7,
-	0xfa, 0xb4, 0x70, 0xad, 0x97, 0xdb, 0x46, 0x8e, 0x88, 0x8f, 0x7d, 0xca, 0x83, 0xd7, 0x17, 0x7f,
-	0xa9, 0x9c, 0xe6, 0x44, 0x0c, 0xd6, 0xf5, 0x65, 0xba, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x0e, 0x9c, 0x59, 0xc4, 0x03, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0xb5, 0x6e, 0xa1, 0x57, 0xa1, 0x0a, 0x13, 0x8a, 0x1b, 0x5a, 0xc7, 0xb2, 0x18, 0xa2,
+	0x4a, 0xb5, 0xd5, 0xb2, 0x99, 0x01, 0x91, 0x0c, 0x08, 0x01, 0x52, 0x70, 0x81, 0x81, 0xc5, 0xba,
+	0xd8, 0x17, 0xc7, 0x8a, 0x7d, 0x67, 0x7c, 0x97, 0x40, 0xbe, 0x02, 0x53, 0x3f, 0x02, 0x23, 0x62,
+	0xea, 0xc0, 0x87, 0x88, 0x98, 0x3a, 0x22, 0x21, 0xf1, 0x27, 0x19, 0xca, 0xc6, 0x57, 0x40, 0x77,
+	0x67, 0x97, 0x08, 0x0a, 0x62, 0x60, 0xb1, 0xde, 0x7b, 0xf7, 0x7e, 0xf7, 0xfb, 0xfd, 0xde, 0x3d,
+	0xc3, 0x1b, 0x21, 0x65, 0x19, 0x65, 0xee, 0x28, 0x8f, 0x0b, 0x14, 0x61, 0x77, 0xbc, 0xdf, 0xc3,
+	0x1c, 0xed, 0x57, 0xb9, 0x93, 0x17, 0x94, 0x53, 0x7d, 0x53, 0x75, 0x39, 0x55, 0xb5, 0xec, 0x6a,
+	0x6c, 0xc5, 0x94, 0xc6, 0x29, 0x76, 0x65, 0x57, 0x6f, 0xd4, 0x77, 0x11, 0x99, 0x28, 0x48, 0xa3,
+	0x1e, 0xd3, 0x98, 0xca, 0xd0, 0x15, 0x51, 0x59, 0x6d, 0xfe, 0x0a, 0xe0, 0x49, 0x86, 0x19, 0x47,
+	0x59, 0x5e, 0x36, 0x6c, 0x29, 0xa6, 0x40, 0x21, 0x4b, 0x5a, 0x75, 0x74, 0x19, 0x65, 0x09, 0xa1,
+	0xae, 0xfc, 0xaa, 0x92, 0xfd, 0x1d, 0x40, 0xad, 0x9b, 0x22, 0xa2, 0xeb, 0x50, 0x23, 0x28, 0xc3,
+	0x06, 0xb0, 0x40, 0x6b, 0xcd, 0x97, 0xb1, 0x7e, 0x1b, 0x6a, 0xe2, 0x76, 0x63, 0xc9, 0x02, 0xad,
+	0xf5, 0x83, 0x86, 0xa3, 0xa8, 0x9d, 0x8a, 0xda, 0x79, 0x5c, 0x51, 0xb7, 0x37, 0xa6, 0x9f, 0x9a,
+	0xb5, 0xa3, 0xcf, 0x4d, 0xf0, 0xe6, 0xf4, 0x78, 0x17, 0x18, 0xc0, 0x97, 0x40, 0x7d, 0x13, 0xae,
+	0x0e, 0x70, 0x12, 0x0f, 0xb8, 0xb1, 0x6c, 0x81, 0xd6, 0xb2, 0x5f, 0x66, 0x82, 0x2c, 0x21, 0x7d,
+	0x6a, 0x68, 0x8a, 0x4c, 0xc4, 0xfa, 0x03, 0x78, 0xb5, 0x1c, 0x4e, 0x14, 0x84, 0x69, 0x82, 0x09,
+	0x0f, 0x18, 0x47, 0x1c, 0x1b, 0x2b, 0x92, 0xbd, 0xfe, 0x1b, 0xfb, 0x1d, 0x32, 0x69, 0x2f, 0x19,
+	0xc0, 0xbf, 0x52, 0xc1, 0x3a, 0x12, 0x75, 0x28, 0x40, 0x9e, 0xf1, 0xed, 0x75, 0x13, 0xbc, 0x3a,
+	0x3d, 0xde, 0xdd, 0x50, 0x13, 0xd8, 0x63, 0xd1, 0xd0, 0x15, 0x46, 0xed, 0x8f, 0x00, 0x5e, 0x3b,
+	0xa4, 0x7d, 0xfe, 0x02, 0x15, 0xf8, 0x89, 0x42, 0x76, 0x0b, 0x9a, 0x53, 0x86, 0x52, 0xbd, 0x0e,
+	0x57, 0x78, 0xc2, 0xd3, 0x6a, 0x0a, 0x2a, 0xd1, 0x2d, 0xb8, 0x1e, 0x61, 0x16, 0x16, 0x49, 0xce,
+	0x13, 0x4a, 0xe4, 0x34, 0xd6, 0xfc, 0xc5, 0x92, 0x7e, 0x0b, 0x6a, 0x79, 0x8a, 0x88, 0x74, 0xb9,
+	0x7e, 0xb0, 0xed, 0x9c, 0xff, 0xd8, 0x8e, 0xe0, 0x6f, 0xaf, 0x89, 0x51, 0xc9, 0x31, 0xf9, 0x12,
+	0xe4, 0xdd, 0x17, 0x52, 0xdf, 0xbf, 0xdb, 0x6b, 0x94, 0xa8, 0x98, 0x8e, 0xcf, 0x10, 0x1d, 0x4a,
+	0x38, 0x26, 0x5c, 0x18, 0xb1, 0x17, 0x8c, 0xfc, 0x41, 0xbf, 0x01, 0xec, 0xb7, 0x00, 0xee, 0x74,
+	0x10, 0x09, 0x71, 0xfa, 0x9f, 0x3d, 0x7a, 0x8f, 0xfe, 0x4d, 0x66, 0x6b, 0x41, 0xe6, 0x5f, 0x85,
+	0x18, 0xc0, 0xee, 0xc0, 0x4b, 0x0f, 0x69, 0x34, 0x4a, 0xf1, 0x53, 0x5c, 0xb0, 0x84, 0x9e, 0xbf,
+	0x84, 0x06, 0xbc, 0x30, 0x56, 0xc7, 0x52, 0x95, 0xe6, 0x57, 0xa9, 0xa7, 0x09, 0x45, 0x36, 0x83,
+	0xab, 0x5d, 0x54, 0xa0, 0x8c, 0xe9, 0x77, 0xa1, 0x55, 0xe0, 0xe7, 0xa3, 0xa4, 0xc0, 0x41, 0x38,
+	0xc0, 0xe1, 0x90, 0x8d, 0xb2, 0x0c, 0x47, 0x41, 0xf9, 0x0a, 0x81, 0xdc, 0x38, 0x71, 0xf3, 0x45,
+	0x7f, 0xa7, 0xec, 0xeb, 0xfc, 0x6c, 0x2b, 0xd5, 0xdd, 0x23, 0x7d, 0xea, 0x59, 0xc2, 0xc8, 0xf5,
+	0x05, 0x23, 0x2f, 0xcf, 0xfe, 0x6e, 0x45, 0xd5, 0xf6, 0xa6, 0x5f, 0xcd, 0xda, 0x74, 0x66, 0x82,
+	0x93, 0x99, 0x09, 0xbe, 0xcc, 0x4c, 0x70, 0x34, 0x37, 0x6b, 0x27, 0x73, 0xb3, 0xf6, 0x61, 0x6e,
+	0xd6, 0x9e, 0x6d, 0x2b, 0x28, 0x8b, 0x86, 0x4e, 0x42, 0x17, 0xc0, 0x7c, 0x92, 0x63, 0xd6, 0x5b,
+	0x95, 0x1b, 0x7c, 0xf3, 0xc7, 0x00, 0xd1, 0xa1, 0x45, 0xf6, 0x39, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -574,6 +619,39 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireChecksummedUpgradeInfo {
+		i--
+		if m.RequireChecksummedUpgradeInfo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -663,6 +741,18 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequireChecksummedUpgradeInfo {
+		n += 2
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1233,6 +1323,76 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireChecksummedUpgradeInfo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireChecksummedUpgradeInfo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0